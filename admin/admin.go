@@ -3,6 +3,7 @@ package admin
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/rilldata/rill/admin/ai"
@@ -10,6 +11,7 @@ import (
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/provisioner"
 	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/mtls"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.uber.org/zap"
 )
@@ -17,14 +19,43 @@ import (
 type Options struct {
 	DatabaseDriver     string
 	DatabaseDSN        string
+	DatabaseReplicaDSN string
 	ProvisionerSetJSON string
 	DefaultProvisioner string
 	ExternalURL        string
+	FrontendURL        string
 	VersionNumber      string
 	VersionCommit      string
 	MetricsProjectOrg  string
 	MetricsProjectName string
 	AutoscalerCron     string
+	// RuntimeVersions restricts the runtime versions that projects can be pinned to.
+	// The latest version is always allowed. If empty, projects can be pinned to any valid version.
+	RuntimeVersions []string
+	// RuntimeMTLSCertPath, RuntimeMTLSKeyPath and RuntimeMTLSCAPath configure the client certificate presented to runtimes
+	// and the CA used to verify them. Mutual TLS is disabled if they're empty.
+	RuntimeMTLSCertPath string
+	RuntimeMTLSKeyPath  string
+	RuntimeMTLSCAPath   string
+	// GithubDeployFeedback enables posting commit statuses and pull request comments with the results of deploys triggered by Github pushes.
+	GithubDeployFeedback bool
+	// OpsAlertsWebhookURL is a Slack-compatible webhook that receives alerts on operational conditions (see CheckOpsAlerts).
+	OpsAlertsWebhookURL string
+	// OpsAlertsEmailOrgAdmins enables emailing operational alerts to the admins of the affected organization.
+	OpsAlertsEmailOrgAdmins bool
+	// OpsAlertsStuckReconcile is how long a deployment must be continuously reconciling before it's considered stuck.
+	OpsAlertsStuckReconcile time.Duration
+	// OpsAlertsSlotQuotaThreshold is the fraction of an organization's slot quota above which it is considered near its quota.
+	OpsAlertsSlotQuotaThreshold float64
+	// RedeployHandoff enables restoring a snapshot of the previous deployment's DuckDB data into the new deployment when a project is redeployed.
+	// The new deployment then serves stale data while it refreshes, instead of being empty until it has re-ingested everything.
+	// It requires the runtimes to share backup storage.
+	RedeployHandoff bool
+	// RuntimeHeartbeatTimeout is how long a runtime host can go without sending a heartbeat before its deployments are flagged as unreachable.
+	// Liveness tracking is disabled if it's zero.
+	RuntimeHeartbeatTimeout time.Duration
+	// ReprovisionUnreachable enables redeploying the prod deployments of unreachable runtime hosts to other hosts.
+	ReprovisionUnreachable bool
 }
 
 type Service struct {
@@ -43,11 +74,13 @@ type Service struct {
 	metricsProjectID string
 	AutoscalerCron   string
 	Biller           billing.Biller
+	runtimeMTLS      *mtls.Reloader
+	deplHealth       *deploymentHealthCache
 }
 
 func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Issuer, emailClient *email.Client, github Github, aiClient ai.Client, assets *storage.BucketHandle, biller billing.Biller) (*Service, error) {
 	// Init db
-	db, err := database.Open(opts.DatabaseDriver, opts.DatabaseDSN)
+	db, err := database.Open(opts.DatabaseDriver, opts.DatabaseDSN, opts.DatabaseReplicaDSN)
 	if err != nil {
 		logger.Fatal("error connecting to database", zap.Error(err))
 	}
@@ -93,6 +126,15 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Is
 		metricsProjectID = proj.ID
 	}
 
+	// Load certificates for mutual TLS with runtimes
+	var runtimeMTLS *mtls.Reloader
+	if opts.RuntimeMTLSCertPath != "" {
+		runtimeMTLS, err = mtls.NewReloader(opts.RuntimeMTLSCertPath, opts.RuntimeMTLSKeyPath, opts.RuntimeMTLSCAPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Service{
 		DB:               db,
 		ProvisionerSet:   provSet,
@@ -109,6 +151,8 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Is
 		metricsProjectID: metricsProjectID,
 		AutoscalerCron:   opts.AutoscalerCron,
 		Biller:           biller,
+		runtimeMTLS:      runtimeMTLS,
+		deplHealth:       newDeploymentHealthCache(),
 	}, nil
 }
 
@@ -204,6 +204,59 @@ func (s *Service) IssueMagicAuthToken(ctx context.Context, opts *IssueMagicAuthT
 	return &magicAuthToken{model: dat, token: tkn}, nil
 }
 
+// projectAuthToken implements AuthToken for tokens belonging to a project.
+type projectAuthToken struct {
+	model *database.ProjectAuthToken
+	token *authtoken.Token
+}
+
+func (t *projectAuthToken) Token() *authtoken.Token {
+	return t.token
+}
+
+func (t *projectAuthToken) TokenModel() any {
+	return t.model
+}
+
+func (t *projectAuthToken) OwnerID() string {
+	return t.model.ID
+}
+
+// IssueProjectAuthTokenOptions provides options for IssueProjectAuthToken.
+type IssueProjectAuthTokenOptions struct {
+	ProjectID       string
+	ProjectRoleID   string
+	DisplayName     string
+	TTL             *time.Duration
+	CreatedByUserID *string
+}
+
+// IssueProjectAuthToken generates and persists a new auth token that grants the permissions of a project role in a project.
+func (s *Service) IssueProjectAuthToken(ctx context.Context, opts *IssueProjectAuthTokenOptions) (AuthToken, error) {
+	tkn := authtoken.NewRandom(authtoken.TypeProject)
+
+	var expiresOn *time.Time
+	if opts.TTL != nil {
+		t := time.Now().Add(*opts.TTL)
+		expiresOn = &t
+	}
+
+	pat, err := s.DB.InsertProjectAuthToken(ctx, &database.InsertProjectAuthTokenOptions{
+		ID:              tkn.ID.String(),
+		SecretHash:      tkn.SecretHash(),
+		ProjectID:       opts.ProjectID,
+		ProjectRoleID:   opts.ProjectRoleID,
+		DisplayName:     opts.DisplayName,
+		ExpiresOn:       expiresOn,
+		CreatedByUserID: opts.CreatedByUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &projectAuthToken{model: pat, token: tkn}, nil
+}
+
 // ValidateAuthToken validates an auth token against persistent storage.
 func (s *Service) ValidateAuthToken(ctx context.Context, token string) (AuthToken, error) {
 	parsed, err := authtoken.FromString(token)
@@ -283,6 +336,23 @@ func (s *Service) ValidateAuthToken(ctx context.Context, token string) (AuthToke
 		s.Used.MagicAuthToken(mat.ID)
 
 		return &magicAuthToken{model: mat, token: parsed}, nil
+	case authtoken.TypeProject:
+		pat, err := s.DB.FindProjectAuthToken(ctx, parsed.ID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		if pat.ExpiresOn != nil && pat.ExpiresOn.Before(time.Now()) {
+			return nil, fmt.Errorf("auth token is expired")
+		}
+
+		if !bytes.Equal(pat.SecretHash, parsed.SecretHash()) {
+			return nil, fmt.Errorf("invalid auth token")
+		}
+
+		s.Used.ProjectAuthToken(pat.ID)
+
+		return &projectAuthToken{model: pat, token: parsed}, nil
 	default:
 		return nil, fmt.Errorf("unknown auth token type %q", parsed.Type)
 	}
@@ -303,6 +373,8 @@ func (s *Service) RevokeAuthToken(ctx context.Context, token string) error {
 		return fmt.Errorf("deployment auth tokens cannot be revoked")
 	case authtoken.TypeMagic:
 		return s.DB.DeleteMagicAuthToken(ctx, parsed.ID.String())
+	case authtoken.TypeProject:
+		return s.DB.DeleteProjectAuthToken(ctx, parsed.ID.String())
 	default:
 		return fmt.Errorf("unknown auth token type %q", parsed.Type)
 	}
@@ -0,0 +1,192 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	// blueGreenTimeout is the maximum time a blue/green deployment may take to reconcile before it's abandoned.
+	blueGreenTimeout = 2 * time.Hour
+	// blueGreenPollInterval is the interval between checks of whether a blue/green deployment is ready to be promoted.
+	blueGreenPollInterval = 30 * time.Second
+)
+
+// PromoteDeploymentJobArgs are the args for a JobKindPromoteDeployment job.
+type PromoteDeploymentJobArgs struct {
+	ProjectID string `json:"project_id"`
+	// DeploymentID is the new deployment to promote.
+	DeploymentID string `json:"deployment_id"`
+	// PreviousDeploymentID is the prod deployment that DeploymentID replaces.
+	PreviousDeploymentID string `json:"previous_deployment_id"`
+	// Deadline is when the new deployment is abandoned if it hasn't been promoted.
+	Deadline time.Time `json:"deadline"`
+}
+
+// deployCommit deploys the latest commit of a project's prod branch (or its pinned commit) and returns the deployment that will reconcile it.
+// By default, it triggers a reconcile of the prod deployment. If the project has DeployBlueGreen set, it starts a blue/green deploy instead.
+func (s *Service) deployCommit(ctx context.Context, proj *database.Project, depl *database.Deployment) (*database.Deployment, error) {
+	if !proj.DeployBlueGreen {
+		return depl, s.TriggerReconcile(ctx, depl)
+	}
+	return s.startBlueGreenDeploy(ctx, proj, depl)
+}
+
+// startBlueGreenDeploy creates a new deployment of the project next to its current prod deployment.
+// The new deployment is seeded with the prod deployment's data where possible (see Options.RedeployHandoff), so only changed resources need to be reconciled.
+// It enqueues a job that makes the new deployment the prod deployment once it has reconciled without errors, and then tears down the old deployment.
+// If there are other blue/green deploys in progress for the project, they are superseded and torn down.
+func (s *Service) startBlueGreenDeploy(ctx context.Context, proj *database.Project, prodDepl *database.Deployment) (*database.Deployment, error) {
+	ds, err := s.DB.FindDeploymentsForProject(ctx, proj.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range ds {
+		if d.ID == prodDepl.ID {
+			continue
+		}
+		s.Logger.Info("blue/green: tearing down superseded deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", d.ID), observability.ZapCtx(ctx))
+		err := s.TeardownDeployment(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The prod deployment's reconciliation is paused by the handoff until it's torn down or resumed.
+	// It still serves queries in the meantime.
+	newDepl, err := s.createDeployment(ctx, &createDeploymentOptions{
+		ProjectID:      proj.ID,
+		Provisioner:    proj.Provisioner,
+		Annotations:    s.NewDeploymentAnnotations(org, proj),
+		ProdVersion:    proj.ProdVersion,
+		ProdBranch:     proj.ProdBranch,
+		ProdVariables:  proj.ProdVariables,
+		ProdOLAPDriver: proj.ProdOLAPDriver,
+		ProdOLAPDSN:    proj.ProdOLAPDSN,
+		ProdSlots:      proj.ProdSlots,
+		RuntimeConfig:  prodDepl.RuntimeConfig,
+		HandoffFrom:    prodDepl,
+	})
+	if err != nil {
+		s.resumeDeployment(ctx, prodDepl)
+		return nil, err
+	}
+
+	_, err = s.enqueueJob(ctx, JobKindPromoteDeployment, &PromoteDeploymentJobArgs{
+		ProjectID:            proj.ID,
+		DeploymentID:         newDepl.ID,
+		PreviousDeploymentID: prodDepl.ID,
+		Deadline:             time.Now().Add(blueGreenTimeout),
+	}, time.Now().Add(blueGreenPollInterval))
+	if err != nil {
+		err2 := s.TeardownDeployment(ctx, newDepl)
+		s.resumeDeployment(ctx, prodDepl)
+		return nil, multierr.Combine(err, err2)
+	}
+
+	s.Logger.Info("blue/green: started deploy", zap.String("project_id", proj.ID), zap.String("deployment_id", newDepl.ID), zap.String("prod_deployment_id", prodDepl.ID), observability.ZapCtx(ctx))
+	return newDepl, nil
+}
+
+// promoteDeployment checks whether a blue/green deployment has finished reconciling.
+// If it has reconciled without errors, it atomically replaces the project's prod deployment, which is then torn down.
+// If it has errors or misses its deadline, it's torn down and the prod deployment resumes reconciling.
+// Otherwise, it enqueues another check.
+func (s *Service) promoteDeployment(ctx context.Context, args *PromoteDeploymentJobArgs) error {
+	logger := s.Logger.With(zap.String("project_id", args.ProjectID), zap.String("deployment_id", args.DeploymentID), zap.String("prod_deployment_id", args.PreviousDeploymentID), observability.ZapCtx(ctx))
+
+	depl, err := s.DB.FindDeployment(ctx, args.DeploymentID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// Superseded or torn down
+			return nil
+		}
+		return err
+	}
+
+	prevDepl, err := s.DB.FindDeployment(ctx, args.PreviousDeploymentID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// The prod deployment was replaced in the meantime (e.g. by a redeploy), so the new deployment is stale
+			logger.Info("blue/green: prod deployment no longer exists, abandoning deploy")
+			return s.TeardownDeployment(ctx, depl)
+		}
+		return err
+	}
+
+	abandon := func(reason string) error {
+		logger.Warn("blue/green: abandoning deploy", zap.String("reason", reason))
+		err := s.TeardownDeployment(ctx, depl)
+		s.resumeDeployment(ctx, prevDepl)
+		return err
+	}
+
+	ready, err := s.blueGreenDeploymentReady(ctx, depl)
+	if err != nil {
+		return abandon(err.Error())
+	}
+	if !ready {
+		if time.Now().After(args.Deadline) {
+			return abandon("timed out waiting for the deployment to reconcile")
+		}
+		_, err = s.enqueueJob(ctx, JobKindPromoteDeployment, args, time.Now().Add(blueGreenPollInterval))
+		return err
+	}
+
+	_, err = s.DB.UpdateProjectProdDeployment(ctx, args.ProjectID, prevDepl.ID, depl.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			logger.Info("blue/green: prod deployment changed, abandoning deploy")
+			return s.TeardownDeployment(ctx, depl)
+		}
+		return err
+	}
+	logger.Info("blue/green: promoted deployment")
+
+	return s.TeardownDeployment(ctx, prevDepl)
+}
+
+// blueGreenDeploymentReady returns true if the deployment's project has been parsed and all of its resources are idle.
+// It returns an error if the deployment is idle with parse or reconcile errors.
+// Unreachable deployments are reported as not ready, since the runtime may be temporarily unavailable.
+func (s *Service) blueGreenDeploymentReady(ctx context.Context, depl *database.Deployment) (bool, error) {
+	resources, err := s.listDeploymentResources(ctx, depl)
+	if err != nil {
+		return false, nil
+	}
+
+	var parsed bool
+	var parseErrs, reconcileErrs int
+	for _, r := range resources {
+		if r.Meta.ReconcileStatus != runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE {
+			return false, nil
+		}
+		if pp := r.GetProjectParser(); pp != nil {
+			parsed = true
+			parseErrs += len(pp.State.ParseErrors)
+		}
+		if r.Meta.ReconcileError != "" {
+			reconcileErrs++
+		}
+	}
+	if !parsed {
+		return false, nil
+	}
+	if parseErrs > 0 || reconcileErrs > 0 {
+		return false, fmt.Errorf("deployment has %d parse errors and %d reconcile errors", parseErrs, reconcileErrs)
+	}
+	return true, nil
+}
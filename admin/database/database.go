@@ -4,28 +4,52 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 )
 
-// Drivers is a registry of drivers
+// Drivers is a registry of drivers.
+//
+// A driver is added by implementing Driver in a separate package and calling Register from the package's init function.
+// The package must then be imported for side effects by the binary that runs the admin service (see cli/cmd/admin/start.go),
+// after which it can be selected with the RILL_ADMIN_DATABASE_DRIVER setting.
+//
+// Postgres (in admin/database/postgres) is currently the only driver. There is no shared SQL dialect layer:
+// its queries and migrations are written for Postgres, so a driver for another database must implement all of DB and its own migrations.
 var Drivers = make(map[string]Driver)
 
 // Register registers a new driver.
+// It panics if the driver is nil or if a driver with the same name has already been registered.
 func Register(name string, driver Driver) {
+	if driver == nil {
+		panic(fmt.Errorf("database driver with name '%s' is nil", name))
+	}
 	if Drivers[name] != nil {
 		panic(fmt.Errorf("already registered database driver with name '%s'", name))
 	}
 	Drivers[name] = driver
 }
 
+// RegisteredDrivers returns the sorted names of the registered drivers.
+func RegisteredDrivers() []string {
+	names := make([]string, 0, len(Drivers))
+	for name := range Drivers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Open opens a new database connection.
-func Open(driver, dsn string) (DB, error) {
+// If replicaDSN is not empty, the driver may route expensive reads that tolerate replication lag to it.
+func Open(driver, dsn, replicaDSN string) (DB, error) {
 	d, ok := Drivers[driver]
 	if !ok {
-		return nil, fmt.Errorf("unknown database driver: %s", driver)
+		return nil, fmt.Errorf("unknown database driver %q (registered drivers: %s)", driver, strings.Join(RegisteredDrivers(), ", "))
 	}
 
-	db, err := d.Open(dsn)
+	db, err := d.Open(dsn, replicaDSN)
 	if err != nil {
 		return nil, err
 	}
@@ -34,13 +58,24 @@ func Open(driver, dsn string) (DB, error) {
 }
 
 // Driver is the interface for DB drivers.
+//
+// Open should parse the DSN and establish a connection pool, but it should not apply migrations.
+// The admin service calls DB.Migrate separately on startup.
+// The replicaDSN is optional. Drivers that don't support read replicas may ignore it.
 type Driver interface {
-	Open(dsn string) (DB, error)
+	Open(dsn, replicaDSN string) (DB, error)
 }
 
 // DB is the interface for a database connection.
+//
+// Implementations own their schema and migrations: Migrate must bring the schema to the latest version
+// and FindMigrationVersion must report the version that has been applied.
+// Methods must return ErrNotFound when a looked up entity doesn't exist and ErrNotUnique on unique constraint violations,
+// since the admin service maps these to user-facing errors. Insert and update options should be checked with Validate.
+// Methods must run in the transaction of the context if one was started with NewTx.
 type DB interface {
 	Close() error
+	Ping(ctx context.Context) error
 	NewTx(ctx context.Context) (context.Context, Tx, error)
 
 	Migrate(ctx context.Context) error
@@ -50,6 +85,7 @@ type DB interface {
 	FindOrganizationsForUser(ctx context.Context, userID string, afterName string, limit int) ([]*Organization, error)
 	FindOrganization(ctx context.Context, id string) (*Organization, error)
 	FindOrganizationByName(ctx context.Context, name string) (*Organization, error)
+	FindOrganizationByExternalID(ctx context.Context, externalID string) (*Organization, error)
 	CheckOrganizationHasOutsideUser(ctx context.Context, orgID, userID string) (bool, error)
 	CheckOrganizationHasPublicProjects(ctx context.Context, orgID string) (bool, error)
 	InsertOrganization(ctx context.Context, opts *InsertOrganizationOptions) (*Organization, error)
@@ -63,22 +99,37 @@ type DB interface {
 	InsertOrganizationWhitelistedDomain(ctx context.Context, opts *InsertOrganizationWhitelistedDomainOptions) (*OrganizationWhitelistedDomain, error)
 	DeleteOrganizationWhitelistedDomain(ctx context.Context, id string) error
 
+	FindOrganizationNotifiers(ctx context.Context, orgID string) ([]*OrganizationNotifier, error)
+	FindOrganizationNotifierByName(ctx context.Context, orgID, name string) (*OrganizationNotifier, error)
+	// CheckOrganizationNotifiersExist returns true if any organization has configured a notifier.
+	CheckOrganizationNotifiersExist(ctx context.Context) (bool, error)
+	InsertOrganizationNotifier(ctx context.Context, opts *InsertOrganizationNotifierOptions) (*OrganizationNotifier, error)
+	DeleteOrganizationNotifier(ctx context.Context, id string) error
+
 	FindProjects(ctx context.Context, afterName string, limit int) ([]*Project, error)
 	FindProjectsByVersion(ctx context.Context, version, afterName string, limit int) ([]*Project, error)
 	FindProjectPathsByPattern(ctx context.Context, namePattern, afterName string, limit int) ([]string, error)
 	FindProjectPathsByPatternAndAnnotations(ctx context.Context, namePattern, afterName string, annotationKeys []string, annotationPairs map[string]string, limit int) ([]string, error)
 	FindProjectsForUser(ctx context.Context, userID string) ([]*Project, error)
-	FindProjectsForOrganization(ctx context.Context, orgID, afterProjectName string, limit int) ([]*Project, error)
+	FindProjectsForOrganization(ctx context.Context, orgID, afterProjectName string, labels map[string]string, limit int) ([]*Project, error)
 	// FindProjectsForOrgAndUser lists the public projects in the org and the projects where user is added as an external user
-	FindProjectsForOrgAndUser(ctx context.Context, orgID, userID, afterProjectName string, limit int) ([]*Project, error)
-	FindPublicProjectsInOrganization(ctx context.Context, orgID, afterProjectName string, limit int) ([]*Project, error)
+	FindProjectsForOrgAndUser(ctx context.Context, orgID, userID, afterProjectName string, labels map[string]string, limit int) ([]*Project, error)
+	FindPublicProjectsInOrganization(ctx context.Context, orgID, afterProjectName string, labels map[string]string, limit int) ([]*Project, error)
 	FindProjectsByGithubURL(ctx context.Context, githubURL string) ([]*Project, error)
 	FindProjectsByGithubInstallationID(ctx context.Context, id int64) ([]*Project, error)
 	FindProject(ctx context.Context, id string) (*Project, error)
 	FindProjectByName(ctx context.Context, orgName string, name string) (*Project, error)
+	FindProjectByExternalID(ctx context.Context, orgID, externalID string) (*Project, error)
 	InsertProject(ctx context.Context, opts *InsertProjectOptions) (*Project, error)
 	DeleteProject(ctx context.Context, id string) error
 	UpdateProject(ctx context.Context, id string, opts *UpdateProjectOptions) (*Project, error)
+	// UpdateProjectVariables sets the project's prod variables if they currently equal prevVariables. It returns ErrNotFound if they don't.
+	UpdateProjectVariables(ctx context.Context, id string, variables, prevVariables map[string]string) (*Project, error)
+	UpdateProjectDeployState(ctx context.Context, id string, opts *UpdateProjectDeployStateOptions) (*Project, error)
+	// UpdateProjectProdDeployment atomically switches a project's prod deployment from prevDeploymentID to deploymentID.
+	// It returns ErrNotFound if the project's prod deployment is no longer prevDeploymentID.
+	UpdateProjectProdDeployment(ctx context.Context, id, prevDeploymentID, deploymentID string) (*Project, error)
+	FindProjectsWithPendingDeploys(ctx context.Context, afterID string, limit int) ([]*Project, error)
 	CountProjectsForOrganization(ctx context.Context, orgID string) (int, error)
 	FindProjectWhitelistedDomain(ctx context.Context, projectID, domain string) (*ProjectWhitelistedDomain, error)
 	FindProjectWhitelistedDomainForProjectWithJoinedRoleNames(ctx context.Context, projectID string) ([]*ProjectWhitelistedDomainWithJoinedRoleNames, error)
@@ -86,9 +137,15 @@ type DB interface {
 	InsertProjectWhitelistedDomain(ctx context.Context, opts *InsertProjectWhitelistedDomainOptions) (*ProjectWhitelistedDomain, error)
 	DeleteProjectWhitelistedDomain(ctx context.Context, id string) error
 
+	// FindProjectPublicMetricsViews returns the names of the metrics views in a project that can be accessed by anyone, even if the project is private.
+	FindProjectPublicMetricsViews(ctx context.Context, projectID string) ([]string, error)
+	InsertProjectPublicMetricsView(ctx context.Context, projectID, metricsView string) error
+	DeleteProjectPublicMetricsViews(ctx context.Context, projectID string) error
+
 	FindDeployments(ctx context.Context, afterID string, limit int) ([]*Deployment, error)
 	FindExpiredDeployments(ctx context.Context) ([]*Deployment, error)
 	FindDeploymentsForProject(ctx context.Context, projectID string) ([]*Deployment, error)
+	FindDeploymentsForRuntimeHost(ctx context.Context, host string) ([]*Deployment, error)
 	FindDeployment(ctx context.Context, id string) (*Deployment, error)
 	FindDeploymentByInstanceID(ctx context.Context, instanceID string) (*Deployment, error)
 	InsertDeployment(ctx context.Context, opts *InsertDeploymentOptions) (*Deployment, error)
@@ -96,11 +153,21 @@ type DB interface {
 	UpdateDeploymentStatus(ctx context.Context, id string, status DeploymentStatus, msg string) (*Deployment, error)
 	UpdateDeploymentRuntimeVersion(ctx context.Context, id, version string) (*Deployment, error)
 	UpdateDeploymentBranch(ctx context.Context, id, branch string) (*Deployment, error)
+	UpdateDeploymentRuntimeConfig(ctx context.Context, id string, runtimeConfig map[string]string) (*Deployment, error)
+	UpdateDeploymentRuntime(ctx context.Context, id string, opts *UpdateDeploymentRuntimeOptions) (*Deployment, error)
 	UpdateDeploymentUsedOn(ctx context.Context, ids []string) error
 	CountDeploymentsForOrganization(ctx context.Context, orgID string) (*DeploymentsCount, error)
 
 	ResolveRuntimeSlotsUsed(ctx context.Context) ([]*RuntimeSlotsUsed, error)
 
+	// FindRuntimeHosts returns the runtime hosts that have sent a heartbeat.
+	FindRuntimeHosts(ctx context.Context) ([]*RuntimeHost, error)
+	// UpsertRuntimeHostHeartbeat records a heartbeat from a runtime host.
+	UpsertRuntimeHostHeartbeat(ctx context.Context, host, version string) (*RuntimeHost, error)
+	DeleteRuntimeHost(ctx context.Context, host string) error
+	// FindOrganizationsNearSlotQuota returns organizations whose deployments use at least the given fraction of their slot quota.
+	FindOrganizationsNearSlotQuota(ctx context.Context, threshold float64) ([]*OrganizationSlotsUsed, error)
+
 	FindUsers(ctx context.Context) ([]*User, error)
 	FindUsersByEmailPattern(ctx context.Context, emailPattern, afterEmail string, limit int) ([]*User, error)
 	FindUser(ctx context.Context, id string) (*User, error)
@@ -115,6 +182,16 @@ type DB interface {
 	CheckUserIsAnOrganizationMember(ctx context.Context, userID, orgID string) (bool, error)
 	CheckUserIsAProjectMember(ctx context.Context, userID, projectID string) (bool, error)
 
+	// FindFavoriteProjectsForUser returns the projects the user has favorited, in the order they were favorited.
+	FindFavoriteProjectsForUser(ctx context.Context, userID string) ([]*Project, error)
+	InsertUserFavoriteProject(ctx context.Context, userID, projectID string) error
+	DeleteUserFavoriteProject(ctx context.Context, userID, projectID string) error
+
+	// FindStarredDashboardsForUser returns the dashboards the user has starred, in the order they were starred.
+	FindStarredDashboardsForUser(ctx context.Context, userID string) ([]*StarredDashboard, error)
+	InsertStarredDashboard(ctx context.Context, opts *InsertStarredDashboardOptions) (*StarredDashboard, error)
+	DeleteStarredDashboard(ctx context.Context, userID, projectID, resourceKind, resourceName string) error
+
 	InsertUsergroup(ctx context.Context, opts *InsertUsergroupOptions) (*Usergroup, error)
 	UpdateUsergroupName(ctx context.Context, name, groupID string) (*Usergroup, error)
 	UpdateUsergroupDescription(ctx context.Context, description, groupID string) (*Usergroup, error)
@@ -160,6 +237,13 @@ type DB interface {
 	DeleteMagicAuthToken(ctx context.Context, id string) error
 	DeleteExpiredMagicAuthTokens(ctx context.Context, retention time.Duration) error
 
+	FindProjectAuthTokensWithUser(ctx context.Context, projectID, afterID string, limit int) ([]*ProjectAuthTokenWithUser, error)
+	FindProjectAuthToken(ctx context.Context, id string) (*ProjectAuthToken, error)
+	InsertProjectAuthToken(ctx context.Context, opts *InsertProjectAuthTokenOptions) (*ProjectAuthToken, error)
+	UpdateProjectAuthTokenUsedOn(ctx context.Context, ids []string) error
+	DeleteProjectAuthToken(ctx context.Context, id string) error
+	DeleteExpiredProjectAuthTokens(ctx context.Context, retention time.Duration) error
+
 	FindDeviceAuthCodeByDeviceCode(ctx context.Context, deviceCode string) (*DeviceAuthCode, error)
 	FindPendingDeviceAuthCodeByUserCode(ctx context.Context, userCode string) (*DeviceAuthCode, error)
 	InsertDeviceAuthCode(ctx context.Context, deviceCode, userCode, clientID string, expiresOn time.Time) (*DeviceAuthCode, error)
@@ -178,6 +262,8 @@ type DB interface {
 	ResolveProjectRolesForUser(ctx context.Context, userID, projectID string) ([]*ProjectRole, error)
 
 	FindOrganizationMemberUsers(ctx context.Context, orgID, afterEmail string, limit int) ([]*MemberUser, error)
+	// FindOrganizationMemberUsersActivity returns login, activity and seat info for the given members of an org.
+	FindOrganizationMemberUsersActivity(ctx context.Context, orgID string, userIDs []string) ([]*MemberUserActivity, error)
 	FindOrganizationMemberUsersByRole(ctx context.Context, orgID, roleID string) ([]*User, error)
 	InsertOrganizationMemberUser(ctx context.Context, orgID, userID, roleID string) error
 	DeleteOrganizationMemberUser(ctx context.Context, orgID, userID string) error
@@ -240,6 +326,38 @@ type DB interface {
 	CountBillingProjectsForOrganization(ctx context.Context, orgID string, createdBefore time.Time) (int, error)
 	FindBillingUsageReportedOn(ctx context.Context) (time.Time, error)
 	UpdateBillingUsageReportedOn(ctx context.Context, usageReportedOn time.Time) error
+
+	// UpsertQueryUsage adds the given query usage to the totals already recorded for its hourly bucket.
+	UpsertQueryUsage(ctx context.Context, opts *UpsertQueryUsageOptions) error
+	// FindProjectQueryUsage returns the query usage of a project aggregated by subject for buckets in [start, end).
+	FindProjectQueryUsage(ctx context.Context, projectID string, start, end time.Time) ([]*QueryUsage, error)
+
+	FindOpsAlerts(ctx context.Context) ([]*OpsAlert, error)
+	InsertOpsAlert(ctx context.Context, opts *InsertOpsAlertOptions) (*OpsAlert, error)
+	UpdateOpsAlertNotifiedOn(ctx context.Context, id string, notifiedOn time.Time) error
+	DeleteOpsAlert(ctx context.Context, id string) error
+
+	// FindJobs returns jobs ordered by descending creation time. If status is JobStatusUnspecified, jobs in all states are returned.
+	FindJobs(ctx context.Context, status JobStatus, beforeCreatedOn time.Time, beforeID string, limit int) ([]*Job, error)
+	InsertJob(ctx context.Context, opts *InsertJobOptions) (*Job, error)
+	// ClaimJob marks the next runnable job as running and increments its attempts.
+	// A running job whose lock has expired (e.g. because its worker crashed) is considered runnable.
+	// It returns ErrNotFound if no job is runnable.
+	ClaimJob(ctx context.Context, lockDuration time.Duration) (*Job, error)
+	UpdateJobSucceeded(ctx context.Context, id string) error
+	// UpdateJobFailed records a failed attempt. If retryAfter is nil, the job is marked as failed; otherwise it's rescheduled.
+	UpdateJobFailed(ctx context.Context, id, errMsg string, retryAfter *time.Time) error
+	DeleteFinishedJobs(ctx context.Context, retention time.Duration) error
+
+	// FindUserNotifications returns a user's notifications ordered by descending creation time.
+	FindUserNotifications(ctx context.Context, userID string, unreadOnly bool, beforeCreatedOn time.Time, beforeID string, limit int) ([]*UserNotification, error)
+	// FindUserNotificationsCreatedAfter returns a user's notifications created after the given time, ordered by ascending creation time.
+	FindUserNotificationsCreatedAfter(ctx context.Context, userID string, after time.Time, limit int) ([]*UserNotification, error)
+	CountUnreadUserNotifications(ctx context.Context, userID string) (int, error)
+	InsertUserNotification(ctx context.Context, opts *InsertUserNotificationOptions) (*UserNotification, error)
+	// UpdateUserNotificationsRead marks the given notifications of a user as read. If ids is empty, all the user's notifications are marked as read.
+	UpdateUserNotificationsRead(ctx context.Context, userID string, ids []string) error
+	DeleteExpiredUserNotifications(ctx context.Context, retention time.Duration) error
 }
 
 // Tx represents a database transaction. It can only be used to commit and rollback transactions.
@@ -274,6 +392,18 @@ type Organization struct {
 	QuotaOutstandingInvites             int       `db:"quota_outstanding_invites"`
 	QuotaStorageLimitBytesPerDeployment int64     `db:"quota_storage_limit_bytes_per_deployment"`
 	BillingCustomerID                   string    `db:"billing_customer_id"`
+	// ExternalID is a stable identifier assigned by the client that created the org (e.g. an infrastructure-as-code tool).
+	// It is unique across orgs and used to make org creation idempotent.
+	ExternalID *string `db:"external_id"`
+	// MaintenanceWindow is the default maintenance window for the org's projects. See Project.MaintenanceWindow.
+	MaintenanceWindow string `db:"maintenance_window"`
+	// LogoURL, PrimaryColor and SupportEmail brand the org's dashboards, including when they are embedded.
+	LogoURL      string `db:"logo_url"`
+	PrimaryColor string `db:"primary_color"`
+	SupportEmail string `db:"support_email"`
+	// CustomDomain is a domain, like "analytics.example.com", that serves the org's dashboards instead of Rill's frontend domain.
+	// It is unique across orgs.
+	CustomDomain string `db:"custom_domain"`
 }
 
 // InsertOrganizationOptions defines options for inserting a new org
@@ -287,6 +417,7 @@ type InsertOrganizationOptions struct {
 	QuotaOutstandingInvites             int
 	QuotaStorageLimitBytesPerDeployment int64
 	BillingCustomerID                   string
+	ExternalID                          *string
 }
 
 // UpdateOrganizationOptions defines options for updating an existing org
@@ -300,6 +431,11 @@ type UpdateOrganizationOptions struct {
 	QuotaOutstandingInvites             int
 	QuotaStorageLimitBytesPerDeployment int64
 	BillingCustomerID                   string
+	MaintenanceWindow                   string
+	LogoURL                             string `validate:"omitempty,url"`
+	PrimaryColor                        string `validate:"omitempty,hexcolor"`
+	SupportEmail                        string `validate:"omitempty,email"`
+	CustomDomain                        string `validate:"omitempty,domain"`
 }
 
 // Project represents one Git connection.
@@ -327,10 +463,42 @@ type Project struct {
 	ProdTTLSeconds       *int64            `db:"prod_ttl_seconds"`
 	ProdDeploymentID     *string           `db:"prod_deployment_id"`
 	Annotations          map[string]string `db:"annotations"`
-	CreatedOn            time.Time         `db:"created_on"`
-	UpdatedOn            time.Time         `db:"updated_on"`
+	Labels               map[string]string `db:"labels"`
+	// DeployTrigger is the policy for deploying new commits pushed to ProdBranch. See the DeployTrigger* constants.
+	DeployTrigger string `db:"deploy_trigger"`
+	// DeploySchedule is a cron expression used when DeployTrigger is DeployTriggerSchedule. If empty, DefaultDeploySchedule is used.
+	DeploySchedule string `db:"deploy_schedule"`
+	// DeployBlueGreen enables deploying new commits to a parallel deployment that replaces the prod deployment once it has reconciled without errors.
+	DeployBlueGreen bool `db:"deploy_blue_green"`
+	// DeploySha pins the prod deployment to a specific commit on ProdBranch.
+	// It is only set when DeployTrigger is not DeployTriggerPush and a push has been held back.
+	DeploySha *string `db:"deploy_sha"`
+	// PendingDeploySha is the latest commit pushed to ProdBranch that has not yet been deployed.
+	PendingDeploySha *string    `db:"pending_deploy_sha"`
+	PendingDeployOn  *time.Time `db:"pending_deploy_on"`
+	// ExternalID is a stable identifier assigned by the client that created the project (e.g. an infrastructure-as-code tool).
+	// It is unique within the project's org and used to make project creation idempotent.
+	ExternalID *string `db:"external_id"`
+	// MaintenanceWindow restricts scheduled refreshes and runtime upgrades of the project's deployments to a recurring window, e.g. "Mon-Fri 22:00-06:00 UTC".
+	// If empty, the org's MaintenanceWindow applies. See runtime/pkg/maintenancewindow for the format.
+	MaintenanceWindow string    `db:"maintenance_window"`
+	CreatedOn         time.Time `db:"created_on"`
+	UpdatedOn         time.Time `db:"updated_on"`
 }
 
+// Deploy triggers control how pushes to a project's production branch are deployed.
+const (
+	// DeployTriggerPush deploys new commits as soon as they are pushed.
+	DeployTriggerPush = "push"
+	// DeployTriggerManual holds new commits until a deployment is explicitly approved.
+	DeployTriggerManual = "manual"
+	// DeployTriggerSchedule holds new commits until the next tick of the project's deploy schedule.
+	DeployTriggerSchedule = "schedule"
+)
+
+// DefaultDeploySchedule is the schedule used for DeployTriggerSchedule when the project doesn't specify one (nightly at midnight UTC).
+const DefaultDeploySchedule = "0 0 * * *"
+
 // InsertProjectOptions defines options for inserting a new Project.
 type InsertProjectOptions struct {
 	OrganizationID       string `validate:"required"`
@@ -350,6 +518,10 @@ type InsertProjectOptions struct {
 	ProdOLAPDSN          string
 	ProdSlots            int
 	ProdTTLSeconds       *int64
+	Labels               map[string]string
+	DeployTrigger        string `validate:"omitempty,oneof=push manual schedule"`
+	DeploySchedule       string
+	ExternalID           *string
 }
 
 // UpdateProjectOptions defines options for updating a Project.
@@ -368,6 +540,18 @@ type UpdateProjectOptions struct {
 	ProdSlots            int
 	ProdTTLSeconds       *int64
 	Annotations          map[string]string
+	Labels               map[string]string
+	DeployTrigger        string `validate:"omitempty,oneof=push manual schedule"`
+	DeploySchedule       string
+	DeployBlueGreen      bool
+	MaintenanceWindow    string
+}
+
+// UpdateProjectDeployStateOptions defines options for updating the deploy state of a project with a non-push DeployTrigger.
+type UpdateProjectDeployStateOptions struct {
+	DeploySha        *string
+	PendingDeploySha *string
+	PendingDeployOn  *time.Time
 }
 
 // DeploymentStatus is an enum representing the state of a deployment
@@ -378,6 +562,8 @@ const (
 	DeploymentStatusPending     DeploymentStatus = 1
 	DeploymentStatusOK          DeploymentStatus = 2
 	DeploymentStatusError       DeploymentStatus = 4
+	// DeploymentStatusUnreachable means the deployment's runtime host has stopped sending heartbeats.
+	DeploymentStatusUnreachable DeploymentStatus = 5
 )
 
 func (d DeploymentStatus) String() string {
@@ -388,6 +574,8 @@ func (d DeploymentStatus) String() string {
 		return "OK"
 	case DeploymentStatusError:
 		return "Error"
+	case DeploymentStatusUnreachable:
+		return "Unreachable"
 	default:
 		return "Unspecified"
 	}
@@ -408,9 +596,12 @@ type Deployment struct {
 	RuntimeAudience   string           `db:"runtime_audience"`
 	Status            DeploymentStatus `db:"status"`
 	StatusMessage     string           `db:"status_message"`
-	CreatedOn         time.Time        `db:"created_on"`
-	UpdatedOn         time.Time        `db:"updated_on"`
-	UsedOn            time.Time        `db:"used_on"`
+	// RuntimeConfig contains instance config overrides set by an admin, e.g. "rill.metrics.max_execution_timeout_seconds".
+	// They take precedence over the project's variables.
+	RuntimeConfig map[string]string `db:"runtime_config"`
+	CreatedOn     time.Time         `db:"created_on"`
+	UpdatedOn     time.Time         `db:"updated_on"`
+	UsedOn        time.Time         `db:"used_on"`
 }
 
 // InsertDeploymentOptions defines options for inserting a new Deployment.
@@ -424,31 +615,59 @@ type InsertDeploymentOptions struct {
 	RuntimeHost       string `validate:"required"`
 	RuntimeInstanceID string `validate:"required"`
 	RuntimeAudience   string
+	RuntimeConfig     map[string]string
 	Status            DeploymentStatus
 	StatusMessage     string
 }
 
+// UpdateDeploymentRuntimeOptions defines options for moving a Deployment to another runtime.
+type UpdateDeploymentRuntimeOptions struct {
+	Provisioner       string `validate:"required"`
+	ProvisionID       string
+	RuntimeHost       string `validate:"required"`
+	RuntimeInstanceID string `validate:"required"`
+	RuntimeAudience   string
+}
+
 // RuntimeSlotsUsed is the result of a ResolveRuntimeSlotsUsed query.
 type RuntimeSlotsUsed struct {
 	RuntimeHost string `db:"runtime_host"`
 	SlotsUsed   int    `db:"slots_used"`
 }
 
+// RuntimeHost tracks the liveness of a runtime host based on the heartbeats it sends.
+type RuntimeHost struct {
+	Host        string    `db:"host"`
+	Version     string    `db:"version"`
+	HeartbeatOn time.Time `db:"heartbeat_on"`
+	CreatedOn   time.Time `db:"created_on"`
+}
+
+// OrganizationSlotsUsed is the result of a FindOrganizationsNearSlotQuota query.
+type OrganizationSlotsUsed struct {
+	OrganizationID   string `db:"org_id"`
+	OrganizationName string `db:"org_name"`
+	QuotaSlotsTotal  int    `db:"quota_slots_total"`
+	SlotsUsed        int    `db:"slots_used"`
+}
+
 // User is a person registered in Rill.
 // Users may belong to multiple organizations and projects.
 type User struct {
-	ID                  string
-	Email               string
-	DisplayName         string    `db:"display_name"`
-	PhotoURL            string    `db:"photo_url"`
-	GithubUsername      string    `db:"github_username"`
-	GithubRefreshToken  string    `db:"github_refresh_token"`
-	CreatedOn           time.Time `db:"created_on"`
-	UpdatedOn           time.Time `db:"updated_on"`
-	ActiveOn            time.Time `db:"active_on"`
-	QuotaSingleuserOrgs int       `db:"quota_singleuser_orgs"`
-	PreferenceTimeZone  string    `db:"preference_time_zone"`
-	Superuser           bool      `db:"superuser"`
+	ID                     string
+	Email                  string
+	DisplayName            string    `db:"display_name"`
+	PhotoURL               string    `db:"photo_url"`
+	GithubUsername         string    `db:"github_username"`
+	GithubRefreshToken     string    `db:"github_refresh_token"`
+	CreatedOn              time.Time `db:"created_on"`
+	UpdatedOn              time.Time `db:"updated_on"`
+	ActiveOn               time.Time `db:"active_on"`
+	QuotaSingleuserOrgs    int       `db:"quota_singleuser_orgs"`
+	PreferenceTimeZone     string    `db:"preference_time_zone"`
+	PreferenceDefaultOrgID *string   `db:"preference_default_org_id"`
+	PreferenceLocale       string    `db:"preference_locale"`
+	Superuser              bool      `db:"superuser"`
 }
 
 // InsertUserOptions defines options for inserting a new user
@@ -462,12 +681,14 @@ type InsertUserOptions struct {
 
 // UpdateUserOptions defines options for updating an existing user
 type UpdateUserOptions struct {
-	DisplayName         string
-	PhotoURL            string
-	GithubUsername      string
-	GithubRefreshToken  string
-	QuotaSingleuserOrgs int
-	PreferenceTimeZone  string
+	DisplayName            string
+	PhotoURL               string
+	GithubUsername         string
+	GithubRefreshToken     string
+	QuotaSingleuserOrgs    int
+	PreferenceTimeZone     string
+	PreferenceDefaultOrgID *string
+	PreferenceLocale       string
 }
 
 // Service represents a service account.
@@ -602,6 +823,37 @@ type InsertMagicAuthTokenOptions struct {
 	MetricsViewFields     []string
 }
 
+// ProjectAuthToken is a persistent API token that grants the permissions of a project role in a specific project.
+type ProjectAuthToken struct {
+	ID              string
+	SecretHash      []byte     `db:"secret_hash"`
+	ProjectID       string     `db:"project_id"`
+	ProjectRoleID   string     `db:"project_role_id"`
+	ProjectRoleName string     `db:"project_role_name"`
+	DisplayName     string     `db:"display_name"`
+	CreatedOn       time.Time  `db:"created_on"`
+	ExpiresOn       *time.Time `db:"expires_on"`
+	UsedOn          time.Time  `db:"used_on"`
+	CreatedByUserID *string    `db:"created_by_user_id"`
+}
+
+// ProjectAuthTokenWithUser is a ProjectAuthToken with additional information about the user who created it.
+type ProjectAuthTokenWithUser struct {
+	*ProjectAuthToken
+	CreatedByUserEmail *string `db:"created_by_user_email"`
+}
+
+// InsertProjectAuthTokenOptions defines options for creating a ProjectAuthToken.
+type InsertProjectAuthTokenOptions struct {
+	ID              string
+	SecretHash      []byte
+	ProjectID       string `validate:"required"`
+	ProjectRoleID   string `validate:"required"`
+	DisplayName     string
+	ExpiresOn       *time.Time
+	CreatedByUserID *string
+}
+
 // AuthClient is a client that requests and consumes auth tokens.
 type AuthClient struct {
 	ID          string
@@ -712,6 +964,17 @@ type MemberUser struct {
 	UpdatedOn   time.Time `db:"updated_on"`
 }
 
+// MemberUserActivity describes the recent activity and seat of an org member.
+type MemberUserActivity struct {
+	UserID       string     `db:"user_id"`
+	LastLoginOn  *time.Time `db:"last_login_on"`
+	LastActiveOn time.Time  `db:"last_active_on"`
+	// LastActiveProject is the name of the project in the org that the user most recently queried.
+	LastActiveProject *string `db:"last_active_project"`
+	// Editor is true if the user can create projects in the org or develop any of its projects.
+	Editor bool `db:"editor"`
+}
+
 type MemberUsergroup struct {
 	ID        string    `db:"id"`
 	Name      string    `db:"name" validate:"slug"`
@@ -773,6 +1036,38 @@ type OrganizationWhitelistedDomainWithJoinedRoleNames struct {
 	RoleName string `db:"name"`
 }
 
+// OrganizationNotifier is a channel that receives notifications about events in an organization, such as failed deployments.
+type OrganizationNotifier struct {
+	ID    string
+	OrgID string `db:"org_id"`
+	Name  string `db:"name"`
+	// Connector is the type of notifier. See the OrganizationNotifierConnector* constants.
+	Connector string `db:"connector"`
+	// Properties configure the notifier, such as the recipients or webhook URLs. They depend on the Connector.
+	Properties map[string]any `db:"properties"`
+	// Events the notifier is subscribed to. If empty, it receives all events.
+	Events    []string  `db:"events"`
+	CreatedOn time.Time `db:"created_on"`
+	UpdatedOn time.Time `db:"updated_on"`
+}
+
+// Connectors supported for organization notifiers.
+const (
+	OrganizationNotifierConnectorEmail   = "email"
+	OrganizationNotifierConnectorSlack   = "slack"
+	OrganizationNotifierConnectorTeams   = "teams"
+	OrganizationNotifierConnectorWebhook = "webhook"
+)
+
+// InsertOrganizationNotifierOptions defines options for inserting an OrganizationNotifier.
+type InsertOrganizationNotifierOptions struct {
+	OrgID      string `validate:"required"`
+	Name       string `validate:"slug"`
+	Connector  string `validate:"oneof=email slack teams webhook"`
+	Properties map[string]any
+	Events     []string
+}
+
 type ProjectWhitelistedDomain struct {
 	ID            string
 	ProjectID     string `db:"project_id"`
@@ -854,6 +1149,44 @@ type UpdateBookmarkOptions struct {
 	Shared      bool   `json:"shared"`
 }
 
+// StarredDashboard is a dashboard a user has starred.
+// It references a runtime resource in the project's prod deployment by kind and name, so it's not validated against the deployment.
+type StarredDashboard struct {
+	UserID       string    `db:"user_id"`
+	ProjectID    string    `db:"project_id"`
+	ResourceKind string    `db:"resource_kind"`
+	ResourceName string    `db:"resource_name"`
+	CreatedOn    time.Time `db:"created_on"`
+}
+
+// InsertStarredDashboardOptions defines options for starring a dashboard
+type InsertStarredDashboardOptions struct {
+	UserID       string `validate:"required"`
+	ProjectID    string `validate:"required"`
+	ResourceKind string `validate:"required"`
+	ResourceName string `validate:"required"`
+}
+
+// QueryUsage represents aggregated query cost for a subject (user, service or token) in a project.
+type QueryUsage struct {
+	Subject         string `db:"subject"`
+	Queries         int64  `db:"queries"`
+	ExecutionTimeMs int64  `db:"execution_time_ms"`
+	ScannedBytes    int64  `db:"scanned_bytes"`
+}
+
+// UpsertQueryUsageOptions defines options for recording query usage reported by a runtime.
+// The usage is accumulated into the hourly bucket containing Time.
+type UpsertQueryUsageOptions struct {
+	ProjectID       string `validate:"required"`
+	Branch          string
+	Subject         string
+	Time            time.Time `validate:"required"`
+	Queries         int64     `validate:"min=0"`
+	ExecutionTimeMs int64     `validate:"min=0"`
+	ScannedBytes    int64     `validate:"min=0"`
+}
+
 // VirtualFile represents an ad-hoc file for a project (not managed in Git)
 type VirtualFile struct {
 	Path      string    `db:"path"`
@@ -877,3 +1210,110 @@ type Asset struct {
 	OwnerID        string    `db:"owner_id"`
 	CreatedOn      time.Time `db:"created_on"`
 }
+
+// OpsAlert is an operational condition (such as a failing deployment) that has been observed by the admin service.
+// It exists while the condition persists, and is used to avoid notifying about the same condition more than once.
+type OpsAlert struct {
+	ID        string
+	Kind      string  `db:"kind"`
+	SubjectID string  `db:"subject_id"`
+	OrgID     *string `db:"org_id"`
+	Message   string  `db:"message"`
+	// NotifiedOn is set when notifications have been sent for the alert.
+	NotifiedOn *time.Time `db:"notified_on"`
+	CreatedOn  time.Time  `db:"created_on"`
+}
+
+// InsertOpsAlertOptions defines options for inserting an OpsAlert.
+type InsertOpsAlertOptions struct {
+	Kind      string `validate:"required"`
+	SubjectID string `validate:"required"`
+	OrgID     *string
+	Message   string
+}
+
+// JobStatus is an enum representing the state of a job in the job queue.
+type JobStatus int
+
+const (
+	JobStatusUnspecified JobStatus = 0
+	JobStatusPending     JobStatus = 1
+	JobStatusRunning     JobStatus = 2
+	JobStatusSucceeded   JobStatus = 3
+	JobStatusFailed      JobStatus = 4
+)
+
+func (s JobStatus) String() string {
+	switch s {
+	case JobStatusPending:
+		return "Pending"
+	case JobStatusRunning:
+		return "Running"
+	case JobStatusSucceeded:
+		return "Succeeded"
+	case JobStatusFailed:
+		return "Failed"
+	default:
+		return "Unspecified"
+	}
+}
+
+// Job is an asynchronous task in the admin job queue.
+// Jobs are run by the admin worker, which retries them with a backoff until they succeed or MaxAttempts is reached.
+type Job struct {
+	ID   string
+	Kind string `db:"kind"`
+	// Args is the JSON-encoded arguments of the job.
+	Args        string    `db:"args"`
+	Status      JobStatus `db:"status"`
+	Attempts    int       `db:"attempts"`
+	MaxAttempts int       `db:"max_attempts"`
+	// Error is the error of the last failed attempt.
+	Error       string     `db:"error"`
+	RunAfter    time.Time  `db:"run_after"`
+	LockedUntil *time.Time `db:"locked_until"`
+	CreatedOn   time.Time  `db:"created_on"`
+	UpdatedOn   time.Time  `db:"updated_on"`
+	FinishedOn  *time.Time `db:"finished_on"`
+}
+
+// InsertJobOptions defines options for inserting a Job.
+type InsertJobOptions struct {
+	Kind        string `validate:"required"`
+	Args        string `validate:"required,json"`
+	MaxAttempts int    `validate:"min=1"`
+	RunAfter    time.Time
+}
+
+// User notification types.
+const (
+	UserNotificationTypeDeployFailed   = "deploy_failed"
+	UserNotificationTypeInviteReceived = "invite_received"
+	UserNotificationTypeAlertFired     = "alert_fired"
+	UserNotificationTypeReportReady    = "report_ready"
+)
+
+// UserNotification is an in-app notification shown in a user's notification center.
+type UserNotification struct {
+	ID        string
+	UserID    string     `db:"user_id"`
+	OrgID     *string    `db:"org_id"`
+	ProjectID *string    `db:"project_id"`
+	Type      string     `db:"type"`
+	Title     string     `db:"title"`
+	Body      string     `db:"body"`
+	URL       string     `db:"url"`
+	ReadOn    *time.Time `db:"read_on"`
+	CreatedOn time.Time  `db:"created_on"`
+}
+
+// InsertUserNotificationOptions defines options for inserting a UserNotification.
+type InsertUserNotificationOptions struct {
+	UserID    string `validate:"required"`
+	OrgID     *string
+	ProjectID *string
+	Type      string `validate:"required"`
+	Title     string `validate:"required"`
+	Body      string
+	URL       string
+}
@@ -0,0 +1,29 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockDriver struct{}
+
+func (mockDriver) Open(dsn, replicaDSN string) (DB, error) {
+	return nil, nil
+}
+
+func TestRegistry(t *testing.T) {
+	Register("test_mock", mockDriver{})
+	defer delete(Drivers, "test_mock")
+
+	require.Contains(t, RegisteredDrivers(), "test_mock")
+	require.Panics(t, func() { Register("test_mock", mockDriver{}) })
+	require.Panics(t, func() { Register("test_nil", nil) })
+
+	_, err := Open("test_mock", "", "")
+	require.NoError(t, err)
+
+	_, err = Open("test_unknown", "", "")
+	require.ErrorContains(t, err, `unknown database driver "test_unknown"`)
+	require.ErrorContains(t, err, "test_mock")
+}
@@ -0,0 +1,12 @@
+CREATE TABLE query_usage (
+    project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    branch TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    bucket TIMESTAMPTZ NOT NULL,
+    queries BIGINT NOT NULL DEFAULT 0,
+    execution_time_ms BIGINT NOT NULL DEFAULT 0,
+    scanned_bytes BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (project_id, branch, subject, bucket)
+);
+
+CREATE INDEX query_usage_project_bucket_idx ON query_usage (project_id, bucket);
@@ -0,0 +1 @@
+CREATE INDEX query_usage_subject_bucket_idx ON query_usage (subject, bucket);
@@ -0,0 +1,6 @@
+ALTER TABLE projects ADD deploy_trigger TEXT NOT NULL DEFAULT 'push';
+ALTER TABLE projects ADD deploy_schedule TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD deploy_sha TEXT;
+ALTER TABLE projects ADD pending_deploy_sha TEXT;
+ALTER TABLE projects ADD pending_deploy_on TIMESTAMPTZ;
+CREATE INDEX projects_pending_deploy_idx ON projects (pending_deploy_on) WHERE pending_deploy_on IS NOT NULL;
@@ -0,0 +1,10 @@
+CREATE TABLE ops_alerts (
+	id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+	kind TEXT NOT NULL,
+	subject_id TEXT NOT NULL,
+	org_id UUID REFERENCES orgs (id) ON DELETE CASCADE,
+	message TEXT NOT NULL,
+	notified_on TIMESTAMPTZ,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX ops_alerts_kind_subject_idx ON ops_alerts (kind, subject_id);
@@ -0,0 +1,12 @@
+CREATE TABLE project_auth_tokens (
+	id UUID PRIMARY KEY,
+	secret_hash BYTEA NOT NULL,
+	project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+	project_role_id UUID NOT NULL REFERENCES project_roles (id) ON DELETE CASCADE,
+	display_name TEXT NOT NULL DEFAULT '',
+	created_by_user_id UUID REFERENCES users (id) ON DELETE SET NULL,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	expires_on TIMESTAMPTZ,
+	used_on TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX project_auth_tokens_project_id_idx ON project_auth_tokens (project_id);
@@ -0,0 +1,16 @@
+CREATE TABLE jobs (
+	id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+	kind TEXT NOT NULL,
+	args JSONB NOT NULL DEFAULT '{}'::JSONB,
+	status INTEGER NOT NULL DEFAULT 1,
+	attempts INTEGER NOT NULL DEFAULT 0,
+	max_attempts INTEGER NOT NULL,
+	error TEXT NOT NULL DEFAULT '',
+	run_after TIMESTAMPTZ NOT NULL DEFAULT now(),
+	locked_until TIMESTAMPTZ,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	finished_on TIMESTAMPTZ
+);
+CREATE INDEX jobs_status_run_after_idx ON jobs (status, run_after);
+CREATE INDEX jobs_created_on_idx ON jobs (created_on, id);
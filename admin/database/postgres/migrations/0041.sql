@@ -0,0 +1,6 @@
+CREATE TABLE projects_public_metrics_views (
+	project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+	metrics_view TEXT NOT NULL,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (project_id, metrics_view)
+);
@@ -0,0 +1 @@
+ALTER TABLE projects ADD COLUMN deploy_blue_green BOOLEAN NOT NULL DEFAULT false;
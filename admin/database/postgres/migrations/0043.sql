@@ -0,0 +1,11 @@
+CREATE TABLE org_notifiers (
+	id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	connector TEXT NOT NULL,
+	properties JSONB NOT NULL DEFAULT '{}'::JSONB,
+	events TEXT[] NOT NULL DEFAULT '{}',
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_on TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX org_notifiers_org_id_name_idx ON org_notifiers (org_id, lower(name));
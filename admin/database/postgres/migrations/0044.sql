@@ -0,0 +1,5 @@
+ALTER TABLE orgs ADD external_id TEXT;
+CREATE UNIQUE INDEX orgs_external_id_idx ON orgs (external_id) WHERE external_id IS NOT NULL;
+
+ALTER TABLE projects ADD external_id TEXT;
+CREATE UNIQUE INDEX projects_org_id_external_id_idx ON projects (org_id, external_id) WHERE external_id IS NOT NULL;
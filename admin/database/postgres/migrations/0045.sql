@@ -0,0 +1,2 @@
+ALTER TABLE orgs ADD maintenance_window TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD maintenance_window TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,7 @@
+CREATE TABLE runtime_hosts (
+	host TEXT PRIMARY KEY,
+	version TEXT NOT NULL,
+	heartbeat_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX deployments_runtime_host_idx ON deployments (runtime_host);
@@ -0,0 +1 @@
+ALTER TABLE deployments ADD COLUMN runtime_config JSONB NOT NULL DEFAULT '{}'::JSONB;
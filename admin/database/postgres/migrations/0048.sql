@@ -0,0 +1,9 @@
+ALTER TABLE users ADD COLUMN preference_default_org_id UUID REFERENCES orgs (id) ON DELETE SET NULL;
+ALTER TABLE users ADD COLUMN preference_locale TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE users_favorite_projects (
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (user_id, project_id)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE users_starred_dashboards (
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+	resource_kind TEXT NOT NULL,
+	resource_name TEXT NOT NULL,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (user_id, project_id, resource_kind, resource_name)
+);
@@ -0,0 +1,15 @@
+CREATE TABLE user_notifications (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	org_id UUID REFERENCES orgs (id) ON DELETE CASCADE,
+	project_id UUID REFERENCES projects (id) ON DELETE CASCADE,
+	type TEXT NOT NULL,
+	title TEXT NOT NULL,
+	body TEXT NOT NULL DEFAULT '',
+	url TEXT NOT NULL DEFAULT '',
+	read_on TIMESTAMPTZ,
+	created_on TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX user_notifications_user_created_on_idx ON user_notifications (user_id, created_on);
+CREATE INDEX user_notifications_user_unread_idx ON user_notifications (user_id) WHERE read_on IS NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE orgs ADD logo_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE orgs ADD primary_color TEXT NOT NULL DEFAULT '';
+ALTER TABLE orgs ADD support_email TEXT NOT NULL DEFAULT '';
+ALTER TABLE orgs ADD custom_domain TEXT NOT NULL DEFAULT '';
+CREATE UNIQUE INDEX orgs_custom_domain_idx ON orgs (lower(custom_domain)) WHERE custom_domain <> '';
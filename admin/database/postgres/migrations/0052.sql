@@ -0,0 +1,9 @@
+-- Auto-invite domains are now stored in lower case.
+-- The unique indexes on lower(domain) should prevent duplicates, but we defensively keep only the oldest entry per domain before lowercasing.
+DELETE FROM orgs_autoinvite_domains d USING orgs_autoinvite_domains o
+WHERE d.org_id = o.org_id AND lower(d.domain) = lower(o.domain) AND (d.created_on, d.id) > (o.created_on, o.id);
+UPDATE orgs_autoinvite_domains SET domain = lower(domain), updated_on = now() WHERE domain <> lower(domain);
+
+DELETE FROM projects_autoinvite_domains d USING projects_autoinvite_domains o
+WHERE d.project_id = o.project_id AND lower(d.domain) = lower(o.domain) AND (d.created_on, d.id) > (o.created_on, o.id);
+UPDATE projects_autoinvite_domains SET domain = lower(domain), updated_on = now() WHERE domain <> lower(domain);
@@ -25,7 +25,25 @@ func init() {
 
 type driver struct{}
 
-func (d driver) Open(dsn string) (database.DB, error) {
+func (d driver) Open(dsn, replicaDSN string) (database.DB, error) {
+	db, err := openDB(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	var replica *sqlx.DB
+	if replicaDSN != "" {
+		replica, err = openDB(replicaDSN)
+		if err != nil {
+			db.Close()
+			return nil, fmt.Errorf("failed to open read replica: %w", err)
+		}
+	}
+
+	return &connection{db: db, replica: replica}, nil
+}
+
+func openDB(dsn string) (*sqlx.DB, error) {
 	db, err := otelsql.Open("pgx", dsn)
 	if err != nil {
 		return nil, err
@@ -33,21 +51,34 @@ func (d driver) Open(dsn string) (database.DB, error) {
 
 	err = otelsql.RegisterDBStatsMetrics(db, otelsql.WithAttributes(semconv.DBSystemPostgreSQL))
 	if err != nil {
+		db.Close()
 		return nil, err
 	}
 
-	dbx := sqlx.NewDb(db, "pgx")
-	return &connection{db: dbx}, nil
+	return sqlx.NewDb(db, "pgx"), nil
 }
 
 type connection struct {
 	db *sqlx.DB
+	// replica is an optional read replica. It is nil if no replica is configured.
+	replica *sqlx.DB
 }
 
 func (c *connection) Close() error {
+	if c.replica != nil {
+		err := c.replica.Close()
+		if err != nil {
+			c.db.Close()
+			return err
+		}
+	}
 	return c.db.Close()
 }
 
+func (c *connection) Ping(ctx context.Context) error {
+	return c.db.PingContext(ctx)
+}
+
 func (c *connection) FindOrganizations(ctx context.Context, afterName string, limit int) ([]*database.Organization, error) {
 	var res []*database.Organization
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM orgs WHERE lower(name) > lower($1) ORDER BY lower(name) LIMIT $2", afterName, limit)
@@ -59,7 +90,7 @@ func (c *connection) FindOrganizations(ctx context.Context, afterName string, li
 
 func (c *connection) FindOrganizationsForUser(ctx context.Context, userID, afterName string, limit int) ([]*database.Organization, error) {
 	var res []*database.Organization
-	err := c.getDB(ctx).SelectContext(ctx, &res, `
+	err := c.getReadDB(ctx).SelectContext(ctx, &res, `
 		SELECT u.* FROM (SELECT o.* FROM orgs o JOIN users_orgs_roles uor ON o.id = uor.org_id
 		WHERE uor.user_id = $1
 		UNION
@@ -96,6 +127,15 @@ func (c *connection) FindOrganizationByName(ctx context.Context, name string) (*
 	return res, nil
 }
 
+func (c *connection) FindOrganizationByExternalID(ctx context.Context, externalID string) (*database.Organization, error) {
+	res := &database.Organization{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM orgs WHERE external_id=$1", externalID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org", err)
+	}
+	return res, nil
+}
+
 func (c *connection) CheckOrganizationHasOutsideUser(ctx context.Context, orgID, userID string) (bool, error) {
 	var res bool
 	err := c.getDB(ctx).QueryRowxContext(ctx,
@@ -122,9 +162,9 @@ func (c *connection) InsertOrganization(ctx context.Context, opts *database.Inse
 	}
 
 	res := &database.Organization{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, `INSERT INTO orgs(name, description, quota_projects, quota_deployments, quota_slots_total, quota_slots_per_deployment, quota_outstanding_invites, quota_storage_limit_bytes_per_deployment, billing_customer_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING *`,
-		opts.Name, opts.Description, opts.QuotaProjects, opts.QuotaDeployments, opts.QuotaSlotsTotal, opts.QuotaSlotsPerDeployment, opts.QuotaOutstandingInvites, opts.QuotaStorageLimitBytesPerDeployment, opts.BillingCustomerID).StructScan(res)
+	err := c.getDB(ctx).QueryRowxContext(ctx, `INSERT INTO orgs(name, description, quota_projects, quota_deployments, quota_slots_total, quota_slots_per_deployment, quota_outstanding_invites, quota_storage_limit_bytes_per_deployment, billing_customer_id, external_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING *`,
+		opts.Name, opts.Description, opts.QuotaProjects, opts.QuotaDeployments, opts.QuotaSlotsTotal, opts.QuotaSlotsPerDeployment, opts.QuotaOutstandingInvites, opts.QuotaStorageLimitBytesPerDeployment, opts.BillingCustomerID, opts.ExternalID).StructScan(res)
 	if err != nil {
 		return nil, parseErr("org", err)
 	}
@@ -142,7 +182,7 @@ func (c *connection) UpdateOrganization(ctx context.Context, id string, opts *da
 	}
 
 	res := &database.Organization{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE orgs SET name=$1, description=$2, quota_projects=$3, quota_deployments=$4, quota_slots_total=$5, quota_slots_per_deployment=$6, quota_outstanding_invites=$7, quota_storage_limit_bytes_per_deployment=$8, billing_customer_id=$9, updated_on=now() WHERE id=$10 RETURNING *", opts.Name, opts.Description, opts.QuotaProjects, opts.QuotaDeployments, opts.QuotaSlotsTotal, opts.QuotaSlotsPerDeployment, opts.QuotaOutstandingInvites, opts.QuotaStorageLimitBytesPerDeployment, opts.BillingCustomerID, id).StructScan(res)
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE orgs SET name=$1, description=$2, quota_projects=$3, quota_deployments=$4, quota_slots_total=$5, quota_slots_per_deployment=$6, quota_outstanding_invites=$7, quota_storage_limit_bytes_per_deployment=$8, billing_customer_id=$9, maintenance_window=$10, logo_url=$11, primary_color=$12, support_email=$13, custom_domain=$14, updated_on=now() WHERE id=$15 RETURNING *", opts.Name, opts.Description, opts.QuotaProjects, opts.QuotaDeployments, opts.QuotaSlotsTotal, opts.QuotaSlotsPerDeployment, opts.QuotaOutstandingInvites, opts.QuotaStorageLimitBytesPerDeployment, opts.BillingCustomerID, opts.MaintenanceWindow, opts.LogoURL, opts.PrimaryColor, opts.SupportEmail, opts.CustomDomain, id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("org", err)
 	}
@@ -203,6 +243,60 @@ func (c *connection) DeleteOrganizationWhitelistedDomain(ctx context.Context, id
 	return checkDeleteRow("org whitelist domain", res, err)
 }
 
+func (c *connection) FindOrganizationNotifiers(ctx context.Context, orgID string) ([]*database.OrganizationNotifier, error) {
+	var res []*orgNotifierDTO
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM org_notifiers WHERE org_id=$1 ORDER BY lower(name)", orgID)
+	if err != nil {
+		return nil, parseErr("org notifiers", err)
+	}
+	return orgNotifiersFromDTOs(res)
+}
+
+func (c *connection) FindOrganizationNotifierByName(ctx context.Context, orgID, name string) (*database.OrganizationNotifier, error) {
+	res := &orgNotifierDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM org_notifiers WHERE org_id=$1 AND lower(name)=lower($2)", orgID, name).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org notifier", err)
+	}
+	return res.AsModel()
+}
+
+func (c *connection) CheckOrganizationNotifiersExist(ctx context.Context) (bool, error) {
+	var res bool
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT EXISTS (SELECT 1 FROM org_notifiers)").Scan(&res)
+	if err != nil {
+		return false, parseErr("org notifiers", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertOrganizationNotifier(ctx context.Context, opts *database.InsertOrganizationNotifierOptions) (*database.OrganizationNotifier, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	if opts.Properties == nil {
+		opts.Properties = map[string]any{}
+	}
+	if opts.Events == nil {
+		opts.Events = []string{}
+	}
+
+	res := &orgNotifierDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `INSERT INTO org_notifiers (org_id, name, connector, properties, events) VALUES ($1, $2, $3, $4, $5) RETURNING *`,
+		opts.OrgID, opts.Name, opts.Connector, opts.Properties, opts.Events,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("org notifier", err)
+	}
+	return res.AsModel()
+}
+
+func (c *connection) DeleteOrganizationNotifier(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM org_notifiers WHERE id=$1", id)
+	return checkDeleteRow("org notifier", res, err)
+}
+
 func (c *connection) FindProjects(ctx context.Context, afterName string, limit int) ([]*database.Project, error) {
 	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE lower(name) > lower($1) ORDER BY lower(p.name) LIMIT $2", afterName, limit)
@@ -268,42 +362,54 @@ func (c *connection) FindProjectsForUser(ctx context.Context, userID string) ([]
 	return projectsFromDTOs(res)
 }
 
-func (c *connection) FindProjectsForOrganization(ctx context.Context, orgID, afterProjectName string, limit int) ([]*database.Project, error) {
+func (c *connection) FindProjectsForOrganization(ctx context.Context, orgID, afterProjectName string, labels map[string]string, limit int) ([]*database.Project, error) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
 	var res []*projectDTO
-	err := c.getDB(ctx).SelectContext(ctx, &res, `
+	err := c.getReadDB(ctx).SelectContext(ctx, &res, `
 		SELECT p.* FROM projects p
-		WHERE p.org_id=$1 AND lower(p.name) > lower($2)
-		ORDER BY lower(p.name) LIMIT $3
-	`, orgID, afterProjectName, limit)
+		WHERE p.org_id=$1 AND lower(p.name) > lower($2) AND p.labels @> $3
+		ORDER BY lower(p.name) LIMIT $4
+	`, orgID, afterProjectName, labels, limit)
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
 	return projectsFromDTOs(res)
 }
 
-func (c *connection) FindProjectsForOrgAndUser(ctx context.Context, orgID, userID, afterProjectName string, limit int) ([]*database.Project, error) {
+func (c *connection) FindProjectsForOrgAndUser(ctx context.Context, orgID, userID, afterProjectName string, labels map[string]string, limit int) ([]*database.Project, error) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
 	var res []*projectDTO
-	err := c.getDB(ctx).SelectContext(ctx, &res, `
+	err := c.getReadDB(ctx).SelectContext(ctx, &res, `
 		SELECT p.* FROM projects p
-		WHERE p.org_id = $1 AND lower(p.name) > lower($2) AND (p.public = true OR p.id IN (
+		WHERE p.org_id = $1 AND lower(p.name) > lower($2) AND p.labels @> $5 AND (p.public = true OR p.id IN (
 			SELECT upr.project_id FROM users_projects_roles upr WHERE upr.user_id = $3
 			UNION
 			SELECT ugpr.project_id FROM usergroups_projects_roles ugpr JOIN usergroups_users uug ON ugpr.usergroup_id = uug.usergroup_id WHERE uug.user_id = $3
 		))  ORDER BY lower(p.name) LIMIT $4
-	`, orgID, afterProjectName, userID, limit)
+	`, orgID, afterProjectName, userID, limit, labels)
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
 	return projectsFromDTOs(res)
 }
 
-func (c *connection) FindPublicProjectsInOrganization(ctx context.Context, orgID, afterProjectName string, limit int) ([]*database.Project, error) {
+func (c *connection) FindPublicProjectsInOrganization(ctx context.Context, orgID, afterProjectName string, labels map[string]string, limit int) ([]*database.Project, error) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
 	var res []*projectDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT p.* FROM projects p
-		WHERE p.org_id = $1 AND p.public = true AND lower(p.name) > lower($2)
-		ORDER BY lower(p.name) LIMIT $3
-	`, orgID, afterProjectName, limit)
+		WHERE p.org_id = $1 AND p.public = true AND lower(p.name) > lower($2) AND p.labels @> $3
+		ORDER BY lower(p.name) LIMIT $4
+	`, orgID, afterProjectName, labels, limit)
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
@@ -346,16 +452,32 @@ func (c *connection) FindProjectByName(ctx context.Context, orgName, name string
 	return res.AsModel()
 }
 
+func (c *connection) FindProjectByExternalID(ctx context.Context, orgID, externalID string) (*database.Project, error) {
+	res := &projectDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM projects WHERE org_id=$1 AND external_id=$2", orgID, externalID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project", err)
+	}
+	return res.AsModel()
+}
+
 func (c *connection) InsertProject(ctx context.Context, opts *database.InsertProjectOptions) (*database.Project, error) {
 	if err := database.Validate(opts); err != nil {
 		return nil, err
 	}
 
+	if opts.Labels == nil {
+		opts.Labels = make(map[string]string, 0)
+	}
+	if opts.DeployTrigger == "" {
+		opts.DeployTrigger = database.DeployTriggerPush
+	}
+
 	res := &projectDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO projects (org_id, name, description, public, created_by_user_id, provisioner, prod_olap_driver, prod_olap_dsn, prod_slots, subpath, prod_branch, prod_variables, archive_asset_id, github_url, github_installation_id, prod_ttl_seconds, prod_version)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17) RETURNING *`,
-		opts.OrganizationID, opts.Name, opts.Description, opts.Public, opts.CreatedByUserID, opts.Provisioner, opts.ProdOLAPDriver, opts.ProdOLAPDSN, opts.ProdSlots, opts.Subpath, opts.ProdBranch, opts.ProdVariables, opts.ArchiveAssetID, opts.GithubURL, opts.GithubInstallationID, opts.ProdTTLSeconds, opts.ProdVersion,
+		INSERT INTO projects (org_id, name, description, public, created_by_user_id, provisioner, prod_olap_driver, prod_olap_dsn, prod_slots, subpath, prod_branch, prod_variables, archive_asset_id, github_url, github_installation_id, prod_ttl_seconds, prod_version, labels, deploy_trigger, deploy_schedule, external_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21) RETURNING *`,
+		opts.OrganizationID, opts.Name, opts.Description, opts.Public, opts.CreatedByUserID, opts.Provisioner, opts.ProdOLAPDriver, opts.ProdOLAPDSN, opts.ProdSlots, opts.Subpath, opts.ProdBranch, opts.ProdVariables, opts.ArchiveAssetID, opts.GithubURL, opts.GithubInstallationID, opts.ProdTTLSeconds, opts.ProdVersion, opts.Labels, opts.DeployTrigger, opts.DeploySchedule, opts.ExternalID,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
@@ -375,12 +497,38 @@ func (c *connection) UpdateProject(ctx context.Context, id string, opts *databas
 	if opts.Annotations == nil {
 		opts.Annotations = make(map[string]string, 0)
 	}
+	if opts.Labels == nil {
+		opts.Labels = make(map[string]string, 0)
+	}
+	if opts.DeployTrigger == "" {
+		opts.DeployTrigger = database.DeployTriggerPush
+	}
+
+	res := &projectDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, github_url=$6, github_installation_id=$7, archive_asset_id=$8, prod_deployment_id=$9, provisioner=$10, prod_slots=$11, prod_ttl_seconds=$12, annotations=$13, prod_version=$14, labels=$15, deploy_trigger=$16, deploy_schedule=$17, deploy_blue_green=$18, maintenance_window=$19, updated_on=now()
+		WHERE id=$20 RETURNING *`,
+		opts.Name, opts.Description, opts.Public, opts.ProdBranch, opts.ProdVariables, opts.GithubURL, opts.GithubInstallationID, opts.ArchiveAssetID, opts.ProdDeploymentID, opts.Provisioner, opts.ProdSlots, opts.ProdTTLSeconds, opts.Annotations, opts.ProdVersion, opts.Labels, opts.DeployTrigger, opts.DeploySchedule, opts.DeployBlueGreen, opts.MaintenanceWindow, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project", err)
+	}
+	return res.AsModel()
+}
+
+func (c *connection) UpdateProjectVariables(ctx context.Context, id string, variables, prevVariables map[string]string) (*database.Project, error) {
+	if variables == nil {
+		variables = make(map[string]string)
+	}
+	if prevVariables == nil {
+		prevVariables = make(map[string]string)
+	}
 
 	res := &projectDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, github_url=$6, github_installation_id=$7, archive_asset_id=$8, prod_deployment_id=$9, provisioner=$10, prod_slots=$11, prod_ttl_seconds=$12, annotations=$13, prod_version=$14, updated_on=now()
-		WHERE id=$15 RETURNING *`,
-		opts.Name, opts.Description, opts.Public, opts.ProdBranch, opts.ProdVariables, opts.GithubURL, opts.GithubInstallationID, opts.ArchiveAssetID, opts.ProdDeploymentID, opts.Provisioner, opts.ProdSlots, opts.ProdTTLSeconds, opts.Annotations, opts.ProdVersion, id,
+		UPDATE projects SET prod_variables=$1, updated_on=now()
+		WHERE id=$2 AND prod_variables=$3::JSONB RETURNING *`,
+		variables, id, prevVariables,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
@@ -388,6 +536,47 @@ func (c *connection) UpdateProject(ctx context.Context, id string, opts *databas
 	return res.AsModel()
 }
 
+func (c *connection) UpdateProjectDeployState(ctx context.Context, id string, opts *database.UpdateProjectDeployStateOptions) (*database.Project, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &projectDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE projects SET deploy_sha=$1, pending_deploy_sha=$2, pending_deploy_on=$3 WHERE id=$4 RETURNING *", opts.DeploySha, opts.PendingDeploySha, opts.PendingDeployOn, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project", err)
+	}
+	return res.AsModel()
+}
+
+func (c *connection) UpdateProjectProdDeployment(ctx context.Context, id, prevDeploymentID, deploymentID string) (*database.Project, error) {
+	res := &projectDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE projects SET prod_deployment_id=$1, updated_on=now() WHERE id=$2 AND prod_deployment_id=$3 RETURNING *", deploymentID, id, prevDeploymentID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project", err)
+	}
+	return res.AsModel()
+}
+
+func (c *connection) FindProjectsWithPendingDeploys(ctx context.Context, afterID string, limit int) ([]*database.Project, error) {
+	var qry strings.Builder
+	var args []any
+	qry.WriteString("SELECT p.* FROM projects p WHERE p.pending_deploy_on IS NOT NULL ")
+	if afterID != "" {
+		qry.WriteString("AND p.id > $1 ORDER BY p.id LIMIT $2")
+		args = []any{afterID, limit}
+	} else {
+		qry.WriteString("ORDER BY p.id LIMIT $1")
+		args = []any{limit}
+	}
+	var res []*projectDTO
+	err := c.getDB(ctx).SelectContext(ctx, &res, qry.String(), args...)
+	if err != nil {
+		return nil, parseErr("projects", err)
+	}
+	return projectsFromDTOs(res)
+}
+
 func (c *connection) CountProjectsForOrganization(ctx context.Context, orgID string) (int, error) {
 	var count int
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT COUNT(*) FROM projects WHERE org_id = $1", orgID).Scan(&count)
@@ -442,6 +631,25 @@ func (c *connection) DeleteProjectWhitelistedDomain(ctx context.Context, id stri
 	return checkDeleteRow("project whitelist domain", res, err)
 }
 
+func (c *connection) FindProjectPublicMetricsViews(ctx context.Context, projectID string) ([]string, error) {
+	var res []string
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT metrics_view FROM projects_public_metrics_views WHERE project_id=$1 ORDER BY metrics_view", projectID)
+	if err != nil {
+		return nil, parseErr("project public metrics views", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertProjectPublicMetricsView(ctx context.Context, projectID, metricsView string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO projects_public_metrics_views (project_id, metrics_view) VALUES ($1, $2) ON CONFLICT DO NOTHING", projectID, metricsView)
+	return parseErr("project public metrics view", err)
+}
+
+func (c *connection) DeleteProjectPublicMetricsViews(ctx context.Context, projectID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM projects_public_metrics_views WHERE project_id=$1", projectID)
+	return parseErr("project public metrics views", err)
+}
+
 func (c *connection) FindDeployments(ctx context.Context, afterID string, limit int) ([]*database.Deployment, error) {
 	var qry strings.Builder
 	var args []any
@@ -453,17 +661,17 @@ func (c *connection) FindDeployments(ctx context.Context, afterID string, limit
 		qry.WriteString("ORDER BY d.id LIMIT $1")
 		args = []any{limit}
 	}
-	var res []*database.Deployment
+	var res []*deploymentDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, qry.String(), args...)
 	if err != nil {
 		return nil, parseErr("deployments", err)
 	}
-	return res, nil
+	return deploymentsFromDTOs(res)
 }
 
 // FindExpiredDeployments returns all the deployments which are expired as per prod ttl
 func (c *connection) FindExpiredDeployments(ctx context.Context) ([]*database.Deployment, error) {
-	var res []*database.Deployment
+	var res []*deploymentDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT d.* FROM deployments d
 		JOIN projects p ON d.project_id = p.id
@@ -472,34 +680,43 @@ func (c *connection) FindExpiredDeployments(ctx context.Context) ([]*database.De
 	if err != nil {
 		return nil, parseErr("deployments", err)
 	}
-	return res, nil
+	return deploymentsFromDTOs(res)
 }
 
 func (c *connection) FindDeploymentsForProject(ctx context.Context, projectID string) ([]*database.Deployment, error) {
-	var res []*database.Deployment
+	var res []*deploymentDTO
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM deployments d WHERE d.project_id=$1", projectID)
 	if err != nil {
 		return nil, parseErr("deployments", err)
 	}
-	return res, nil
+	return deploymentsFromDTOs(res)
+}
+
+func (c *connection) FindDeploymentsForRuntimeHost(ctx context.Context, host string) ([]*database.Deployment, error) {
+	var res []*deploymentDTO
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM deployments d WHERE d.runtime_host=$1", host)
+	if err != nil {
+		return nil, parseErr("deployments", err)
+	}
+	return deploymentsFromDTOs(res)
 }
 
 func (c *connection) FindDeployment(ctx context.Context, id string) (*database.Deployment, error) {
-	res := &database.Deployment{}
+	res := &deploymentDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT d.* FROM deployments d WHERE d.id=$1", id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
 	}
-	return res, nil
+	return res.AsModel()
 }
 
 func (c *connection) FindDeploymentByInstanceID(ctx context.Context, instanceID string) (*database.Deployment, error) {
-	res := &database.Deployment{}
+	res := &deploymentDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM deployments d WHERE d.runtime_instance_id=$1", instanceID).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
 	}
-	return res, nil
+	return res.AsModel()
 }
 
 func (c *connection) InsertDeployment(ctx context.Context, opts *database.InsertDeploymentOptions) (*database.Deployment, error) {
@@ -507,16 +724,20 @@ func (c *connection) InsertDeployment(ctx context.Context, opts *database.Insert
 		return nil, err
 	}
 
-	res := &database.Deployment{}
+	if opts.RuntimeConfig == nil {
+		opts.RuntimeConfig = make(map[string]string)
+	}
+
+	res := &deploymentDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO deployments (project_id, provisioner, provision_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, runtime_version, status, status_message)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING *`,
-		opts.ProjectID, opts.Provisioner, opts.ProvisionID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.RuntimeVersion, opts.Status, opts.StatusMessage,
+		INSERT INTO deployments (project_id, provisioner, provision_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, runtime_version, runtime_config, status, status_message)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING *`,
+		opts.ProjectID, opts.Provisioner, opts.ProvisionID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.RuntimeVersion, opts.RuntimeConfig, opts.Status, opts.StatusMessage,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
 	}
-	return res, nil
+	return res.AsModel()
 }
 
 func (c *connection) DeleteDeployment(ctx context.Context, id string) error {
@@ -525,21 +746,38 @@ func (c *connection) DeleteDeployment(ctx context.Context, id string) error {
 }
 
 func (c *connection) UpdateDeploymentStatus(ctx context.Context, id string, status database.DeploymentStatus, message string) (*database.Deployment, error) {
-	res := &database.Deployment{}
+	res := &deploymentDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET status=$1, status_message=$2, updated_on=now() WHERE id=$3 RETURNING *", status, message, id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
 	}
-	return res, nil
+	return res.AsModel()
 }
 
 func (c *connection) UpdateDeploymentRuntimeVersion(ctx context.Context, id, version string) (*database.Deployment, error) {
-	res := &database.Deployment{}
+	res := &deploymentDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET runtime_version=$1, updated_on=now() WHERE id=$2 RETURNING *", version, id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
 	}
-	return res, nil
+	return res.AsModel()
+}
+
+func (c *connection) UpdateDeploymentRuntime(ctx context.Context, id string, opts *database.UpdateDeploymentRuntimeOptions) (*database.Deployment, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &deploymentDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE deployments SET provisioner=$1, provision_id=$2, runtime_host=$3, runtime_instance_id=$4, runtime_audience=$5, updated_on=now()
+		WHERE id=$6 RETURNING *`,
+		opts.Provisioner, opts.ProvisionID, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("deployment", err)
+	}
+	return res.AsModel()
 }
 
 func (c *connection) UpdateDeploymentUsedOn(ctx context.Context, ids []string) error {
@@ -551,12 +789,25 @@ func (c *connection) UpdateDeploymentUsedOn(ctx context.Context, ids []string) e
 }
 
 func (c *connection) UpdateDeploymentBranch(ctx context.Context, id, branch string) (*database.Deployment, error) {
-	res := &database.Deployment{}
+	res := &deploymentDTO{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET branch=$1, updated_on=now() WHERE id=$2 RETURNING *", branch, id).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
 	}
-	return res, nil
+	return res.AsModel()
+}
+
+func (c *connection) UpdateDeploymentRuntimeConfig(ctx context.Context, id string, runtimeConfig map[string]string) (*database.Deployment, error) {
+	if runtimeConfig == nil {
+		runtimeConfig = make(map[string]string)
+	}
+
+	res := &deploymentDTO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET runtime_config=$1, updated_on=now() WHERE id=$2 RETURNING *", runtimeConfig, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("deployment", err)
+	}
+	return res.AsModel()
 }
 
 func (c *connection) CountDeploymentsForOrganization(ctx context.Context, orgID string) (*database.DeploymentsCount, error) {
@@ -578,6 +829,48 @@ func (c *connection) ResolveRuntimeSlotsUsed(ctx context.Context) ([]*database.R
 	return res, nil
 }
 
+func (c *connection) FindRuntimeHosts(ctx context.Context) ([]*database.RuntimeHost, error) {
+	var res []*database.RuntimeHost
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM runtime_hosts ORDER BY host")
+	if err != nil {
+		return nil, parseErr("runtime hosts", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpsertRuntimeHostHeartbeat(ctx context.Context, host, version string) (*database.RuntimeHost, error) {
+	res := &database.RuntimeHost{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO runtime_hosts (host, version) VALUES ($1, $2)
+		ON CONFLICT (host) DO UPDATE SET version=EXCLUDED.version, heartbeat_on=now()
+		RETURNING *
+	`, host, version).StructScan(res)
+	if err != nil {
+		return nil, parseErr("runtime host", err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteRuntimeHost(ctx context.Context, host string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM runtime_hosts WHERE host=$1", host)
+	return checkDeleteRow("runtime host", res, err)
+}
+
+func (c *connection) FindOrganizationsNearSlotQuota(ctx context.Context, threshold float64) ([]*database.OrganizationSlotsUsed, error) {
+	var res []*database.OrganizationSlotsUsed
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT o.id AS org_id, o.name AS org_name, o.quota_slots_total, SUM(d.slots) AS slots_used
+		FROM orgs o JOIN projects p ON p.org_id = o.id JOIN deployments d ON d.project_id = p.id
+		WHERE o.quota_slots_total > 0
+		GROUP BY o.id, o.name, o.quota_slots_total
+		HAVING SUM(d.slots) >= $1 * o.quota_slots_total
+	`, threshold)
+	if err != nil {
+		return nil, parseErr("slots used", err)
+	}
+	return res, nil
+}
+
 func (c *connection) FindUsers(ctx context.Context) ([]*database.User, error) {
 	var res []*database.User
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT u.* FROM users u")
@@ -668,14 +961,16 @@ func (c *connection) UpdateUser(ctx context.Context, id string, opts *database.U
 	}
 
 	res := &database.User{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE users SET display_name=$2, photo_url=$3, github_username=$4, github_refresh_token=$5, quota_singleuser_orgs=$6, preference_time_zone=$7, updated_on=now() WHERE id=$1 RETURNING *",
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE users SET display_name=$2, photo_url=$3, github_username=$4, github_refresh_token=$5, quota_singleuser_orgs=$6, preference_time_zone=$7, preference_default_org_id=$8, preference_locale=$9, updated_on=now() WHERE id=$1 RETURNING *",
 		id,
 		opts.DisplayName,
 		opts.PhotoURL,
 		opts.GithubUsername,
 		opts.GithubRefreshToken,
 		opts.QuotaSingleuserOrgs,
-		opts.PreferenceTimeZone).StructScan(res)
+		opts.PreferenceTimeZone,
+		opts.PreferenceDefaultOrgID,
+		opts.PreferenceLocale).StructScan(res)
 	if err != nil {
 		return nil, parseErr("user", err)
 	}
@@ -708,6 +1003,60 @@ func (c *connection) CheckUserIsAProjectMember(ctx context.Context, userID, proj
 	return res, nil
 }
 
+func (c *connection) FindFavoriteProjectsForUser(ctx context.Context, userID string) ([]*database.Project, error) {
+	var res []*projectDTO
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT p.* FROM projects p JOIN users_favorite_projects ufp ON p.id = ufp.project_id
+		WHERE ufp.user_id = $1 ORDER BY ufp.created_on, p.name
+	`, userID)
+	if err != nil {
+		return nil, parseErr("projects", err)
+	}
+	return projectsFromDTOs(res)
+}
+
+func (c *connection) InsertUserFavoriteProject(ctx context.Context, userID, projectID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO users_favorite_projects (user_id, project_id) VALUES ($1, $2) ON CONFLICT DO NOTHING", userID, projectID)
+	return parseErr("favorite project", err)
+}
+
+func (c *connection) DeleteUserFavoriteProject(ctx context.Context, userID, projectID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM users_favorite_projects WHERE user_id=$1 AND project_id=$2", userID, projectID)
+	return checkDeleteRow("favorite project", res, err)
+}
+
+func (c *connection) FindStarredDashboardsForUser(ctx context.Context, userID string) ([]*database.StarredDashboard, error) {
+	var res []*database.StarredDashboard
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM users_starred_dashboards WHERE user_id=$1 ORDER BY created_on, resource_name", userID)
+	if err != nil {
+		return nil, parseErr("starred dashboards", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertStarredDashboard(ctx context.Context, opts *database.InsertStarredDashboardOptions) (*database.StarredDashboard, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	// The no-op update makes RETURNING return the existing row if the dashboard is already starred
+	res := &database.StarredDashboard{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO users_starred_dashboards (user_id, project_id, resource_kind, resource_name) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, project_id, resource_kind, resource_name) DO UPDATE SET user_id=EXCLUDED.user_id
+		RETURNING *
+	`, opts.UserID, opts.ProjectID, opts.ResourceKind, opts.ResourceName).StructScan(res)
+	if err != nil {
+		return nil, parseErr("starred dashboard", err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteStarredDashboard(ctx context.Context, userID, projectID, resourceKind, resourceName string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM users_starred_dashboards WHERE user_id=$1 AND project_id=$2 AND resource_kind=$3 AND resource_name=$4", userID, projectID, resourceKind, resourceName)
+	return checkDeleteRow("starred dashboard", res, err)
+}
+
 func (c *connection) InsertUsergroup(ctx context.Context, opts *database.InsertUsergroupOptions) (*database.Usergroup, error) {
 	if err := database.Validate(opts); err != nil {
 		return nil, err
@@ -1129,6 +1478,83 @@ func (c *connection) DeleteExpiredMagicAuthTokens(ctx context.Context, retention
 	return parseErr("magic auth token", err)
 }
 
+func (c *connection) FindProjectAuthTokensWithUser(ctx context.Context, projectID, afterID string, limit int) ([]*database.ProjectAuthTokenWithUser, error) {
+	where := "t.project_id=$1"
+	args := []any{projectID}
+	if afterID != "" {
+		where += " AND t.id>$2"
+		args = append(args, afterID)
+	}
+	where += " AND (t.expires_on IS NULL OR t.expires_on > now())"
+
+	qry := fmt.Sprintf(`
+		SELECT t.*, r.name AS project_role_name, u.email AS created_by_user_email
+		FROM project_auth_tokens t
+		JOIN project_roles r ON t.project_role_id=r.id
+		LEFT JOIN users u ON t.created_by_user_id=u.id
+		WHERE %s ORDER BY t.id LIMIT $%d
+	`, where, len(args)+1)
+	args = append(args, limit)
+
+	var res []*database.ProjectAuthTokenWithUser
+	err := c.getDB(ctx).SelectContext(ctx, &res, qry, args...)
+	if err != nil {
+		return nil, parseErr("project auth tokens", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindProjectAuthToken(ctx context.Context, id string) (*database.ProjectAuthToken, error) {
+	res := &database.ProjectAuthToken{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT t.*, r.name AS project_role_name FROM project_auth_tokens t
+		JOIN project_roles r ON t.project_role_id=r.id
+		WHERE t.id=$1
+	`, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project auth token", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertProjectAuthToken(ctx context.Context, opts *database.InsertProjectAuthTokenOptions) (*database.ProjectAuthToken, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.ProjectAuthToken{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		WITH t AS (
+			INSERT INTO project_auth_tokens (id, secret_hash, project_id, project_role_id, display_name, expires_on, created_by_user_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *
+		)
+		SELECT t.*, r.name AS project_role_name FROM t JOIN project_roles r ON t.project_role_id=r.id`,
+		opts.ID, opts.SecretHash, opts.ProjectID, opts.ProjectRoleID, opts.DisplayName, opts.ExpiresOn, opts.CreatedByUserID,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("project auth token", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateProjectAuthTokenUsedOn(ctx context.Context, ids []string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "UPDATE project_auth_tokens SET used_on=now() WHERE id=ANY($1)", ids)
+	if err != nil {
+		return parseErr("project auth token", err)
+	}
+	return nil
+}
+
+func (c *connection) DeleteProjectAuthToken(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM project_auth_tokens WHERE id=$1", id)
+	return checkDeleteRow("project auth token", res, err)
+}
+
+func (c *connection) DeleteExpiredProjectAuthTokens(ctx context.Context, retention time.Duration) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM project_auth_tokens WHERE expires_on IS NOT NULL AND expires_on + $1 < now()", retention)
+	return parseErr("project auth token", err)
+}
+
 func (c *connection) FindDeviceAuthCodeByDeviceCode(ctx context.Context, deviceCode string) (*database.DeviceAuthCode, error) {
 	authCode := &database.DeviceAuthCode{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM device_auth_codes WHERE device_code = $1", deviceCode).StructScan(authCode)
@@ -1270,6 +1696,40 @@ func (c *connection) FindOrganizationMemberUsers(ctx context.Context, orgID, aft
 	return res, nil
 }
 
+func (c *connection) FindOrganizationMemberUsersActivity(ctx context.Context, orgID string, userIDs []string) ([]*database.MemberUserActivity, error) {
+	var res []*database.MemberUserActivity
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT
+			u.id AS user_id,
+			u.active_on AS last_active_on,
+			(SELECT max(t.created_on) FROM user_auth_tokens t WHERE t.user_id = u.id AND t.representing_user_id IS NULL) AS last_login_on,
+			(
+				SELECT p.name FROM query_usage q JOIN projects p ON p.id = q.project_id
+				WHERE q.subject = u.id::TEXT AND p.org_id = $1
+				ORDER BY q.bucket DESC LIMIT 1
+			) AS last_active_project,
+			(
+				EXISTS (
+					SELECT 1 FROM users_orgs_roles uor JOIN org_roles r ON r.id = uor.org_role_id
+					WHERE uor.user_id = u.id AND uor.org_id = $1 AND r.create_projects
+				) OR EXISTS (
+					SELECT 1 FROM users_projects_roles upr JOIN project_roles r ON r.id = upr.project_role_id JOIN projects p ON p.id = upr.project_id
+					WHERE upr.user_id = u.id AND p.org_id = $1 AND r.manage_dev
+				) OR EXISTS (
+					SELECT 1 FROM usergroups_users uug JOIN usergroups_projects_roles ugpr ON ugpr.usergroup_id = uug.usergroup_id
+					JOIN project_roles r ON r.id = ugpr.project_role_id JOIN projects p ON p.id = ugpr.project_id
+					WHERE uug.user_id = u.id AND p.org_id = $1 AND r.manage_dev
+				)
+			) AS editor
+		FROM users u
+		WHERE u.id = ANY($2)
+	`, orgID, userIDs)
+	if err != nil {
+		return nil, parseErr("org members activity", err)
+	}
+	return res, nil
+}
+
 func (c *connection) FindOrganizationMemberUsersByRole(ctx context.Context, orgID, roleID string) ([]*database.User, error) {
 	var res []*database.User
 	err := c.getDB(ctx).SelectContext(
@@ -1322,7 +1782,7 @@ func (c *connection) CountSingleuserOrganizationsForMemberUser(ctx context.Conte
 
 func (c *connection) FindProjectMemberUsers(ctx context.Context, projectID, afterEmail string, limit int) ([]*database.MemberUser, error) {
 	var res []*database.MemberUser
-	err := c.getDB(ctx).SelectContext(ctx, &res, `
+	err := c.getReadDB(ctx).SelectContext(ctx, &res, `
 		SELECT u.id, u.email, u.display_name, u.created_on, u.updated_on, r.name FROM users u
     	JOIN users_projects_roles upr ON u.id = upr.user_id
 		JOIN project_roles r ON r.id = upr.project_role_id
@@ -1784,11 +2244,158 @@ func (c *connection) UpdateBillingUsageReportedOn(ctx context.Context, usageRepo
 	return checkUpdateRow("billing usage", res, err)
 }
 
+func (c *connection) UpsertQueryUsage(ctx context.Context, opts *database.UpsertQueryUsageOptions) error {
+	if err := database.Validate(opts); err != nil {
+		return err
+	}
+
+	_, err := c.getDB(ctx).ExecContext(ctx, `
+		INSERT INTO query_usage (project_id, branch, subject, bucket, queries, execution_time_ms, scanned_bytes)
+		VALUES ($1, $2, $3, date_trunc('hour', $4::TIMESTAMPTZ), $5, $6, $7)
+		ON CONFLICT (project_id, branch, subject, bucket) DO UPDATE SET
+			queries = query_usage.queries + EXCLUDED.queries,
+			execution_time_ms = query_usage.execution_time_ms + EXCLUDED.execution_time_ms,
+			scanned_bytes = query_usage.scanned_bytes + EXCLUDED.scanned_bytes
+	`, opts.ProjectID, opts.Branch, opts.Subject, opts.Time, opts.Queries, opts.ExecutionTimeMs, opts.ScannedBytes)
+	if err != nil {
+		return parseErr("query usage", err)
+	}
+	return nil
+}
+
+func (c *connection) FindProjectQueryUsage(ctx context.Context, projectID string, start, end time.Time) ([]*database.QueryUsage, error) {
+	var res []*database.QueryUsage
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT subject, SUM(queries)::BIGINT AS queries, SUM(execution_time_ms)::BIGINT AS execution_time_ms, SUM(scanned_bytes)::BIGINT AS scanned_bytes
+		FROM query_usage
+		WHERE project_id = $1 AND bucket >= date_trunc('hour', $2::TIMESTAMPTZ) AND bucket < $3
+		GROUP BY subject
+		ORDER BY queries DESC, subject
+	`, projectID, start, end)
+	if err != nil {
+		return nil, parseErr("query usage", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindOpsAlerts(ctx context.Context) ([]*database.OpsAlert, error) {
+	var res []*database.OpsAlert
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM ops_alerts ORDER BY created_on")
+	if err != nil {
+		return nil, parseErr("ops alerts", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertOpsAlert(ctx context.Context, opts *database.InsertOpsAlertOptions) (*database.OpsAlert, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.OpsAlert{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `INSERT INTO ops_alerts (kind, subject_id, org_id, message) VALUES ($1, $2, $3, $4) RETURNING *`, opts.Kind, opts.SubjectID, opts.OrgID, opts.Message).StructScan(res)
+	if err != nil {
+		return nil, parseErr("ops alert", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateOpsAlertNotifiedOn(ctx context.Context, id string, notifiedOn time.Time) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "UPDATE ops_alerts SET notified_on=$1 WHERE id=$2", notifiedOn, id)
+	return checkUpdateRow("ops alert", res, err)
+}
+
+func (c *connection) DeleteOpsAlert(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM ops_alerts WHERE id=$1", id)
+	return checkDeleteRow("ops alert", res, err)
+}
+
+func (c *connection) FindJobs(ctx context.Context, status database.JobStatus, beforeCreatedOn time.Time, beforeID string, limit int) ([]*database.Job, error) {
+	var qry strings.Builder
+	var args []any
+	qry.WriteString("SELECT * FROM jobs WHERE true")
+	if status != database.JobStatusUnspecified {
+		args = append(args, status)
+		fmt.Fprintf(&qry, " AND status=$%d", len(args))
+	}
+	if beforeID != "" {
+		args = append(args, beforeCreatedOn, beforeID)
+		fmt.Fprintf(&qry, " AND (created_on, id) < ($%d, $%d)", len(args)-1, len(args))
+	}
+	args = append(args, limit)
+	fmt.Fprintf(&qry, " ORDER BY created_on DESC, id DESC LIMIT $%d", len(args))
+
+	var res []*database.Job
+	err := c.getReadDB(ctx).SelectContext(ctx, &res, qry.String(), args...)
+	if err != nil {
+		return nil, parseErr("jobs", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertJob(ctx context.Context, opts *database.InsertJobOptions) (*database.Job, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	var runAfter *time.Time
+	if !opts.RunAfter.IsZero() {
+		runAfter = &opts.RunAfter
+	}
+
+	res := &database.Job{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `INSERT INTO jobs (kind, args, max_attempts, run_after) VALUES ($1, $2, $3, COALESCE($4, now())) RETURNING *`, opts.Kind, opts.Args, opts.MaxAttempts, runAfter).StructScan(res)
+	if err != nil {
+		return nil, parseErr("job", err)
+	}
+	return res, nil
+}
+
+func (c *connection) ClaimJob(ctx context.Context, lockDuration time.Duration) (*database.Job, error) {
+	res := &database.Job{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE jobs SET status=$1, attempts=attempts+1, locked_until=now()+$3, updated_on=now()
+		WHERE id = (
+			SELECT id FROM jobs
+			WHERE (status=$2 AND run_after <= now()) OR (status=$1 AND locked_until < now())
+			ORDER BY run_after LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	`, database.JobStatusRunning, database.JobStatusPending, lockDuration).StructScan(res)
+	if err != nil {
+		return nil, parseErr("job", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateJobSucceeded(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, `UPDATE jobs SET status=$2, error='', locked_until=NULL, updated_on=now(), finished_on=now() WHERE id=$1`, id, database.JobStatusSucceeded)
+	return checkUpdateRow("job", res, err)
+}
+
+func (c *connection) UpdateJobFailed(ctx context.Context, id, errMsg string, retryAfter *time.Time) error {
+	var res sql.Result
+	var err error
+	if retryAfter == nil {
+		res, err = c.getDB(ctx).ExecContext(ctx, `UPDATE jobs SET status=$2, error=$3, locked_until=NULL, updated_on=now(), finished_on=now() WHERE id=$1`, id, database.JobStatusFailed, errMsg)
+	} else {
+		res, err = c.getDB(ctx).ExecContext(ctx, `UPDATE jobs SET status=$2, error=$3, run_after=$4, locked_until=NULL, updated_on=now() WHERE id=$1`, id, database.JobStatusPending, errMsg, *retryAfter)
+	}
+	return checkUpdateRow("job", res, err)
+}
+
+func (c *connection) DeleteFinishedJobs(ctx context.Context, retention time.Duration) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM jobs WHERE finished_on IS NOT NULL AND finished_on + $1 < now()", retention)
+	return parseErr("jobs", err)
+}
+
 // projectDTO wraps database.Project, using the pgtype package to handle types that pgx can't read directly into their native Go types.
 type projectDTO struct {
 	*database.Project
 	ProdVariables pgtype.JSON `db:"prod_variables"`
 	Annotations   pgtype.JSON `db:"annotations"`
+	Labels        pgtype.JSON `db:"labels"`
 }
 
 func (p *projectDTO) AsModel() (*database.Project, error) {
@@ -1802,6 +2409,11 @@ func (p *projectDTO) AsModel() (*database.Project, error) {
 		return nil, err
 	}
 
+	err = p.Labels.AssignTo(&p.Project.Labels)
+	if err != nil {
+		return nil, err
+	}
+
 	return p.Project, nil
 }
 
@@ -1817,6 +2429,63 @@ func projectsFromDTOs(dtos []*projectDTO) ([]*database.Project, error) {
 	return res, nil
 }
 
+// deploymentDTO wraps database.Deployment, using the pgtype package to handle types that pgx can't read directly into their native Go types.
+type deploymentDTO struct {
+	*database.Deployment
+	RuntimeConfig pgtype.JSON `db:"runtime_config"`
+}
+
+func (d *deploymentDTO) AsModel() (*database.Deployment, error) {
+	err := d.RuntimeConfig.AssignTo(&d.Deployment.RuntimeConfig)
+	if err != nil {
+		return nil, err
+	}
+	return d.Deployment, nil
+}
+
+func deploymentsFromDTOs(dtos []*deploymentDTO) ([]*database.Deployment, error) {
+	res := make([]*database.Deployment, len(dtos))
+	for i, dto := range dtos {
+		var err error
+		res[i], err = dto.AsModel()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// orgNotifierDTO wraps database.OrganizationNotifier, using the pgtype package to handle types that pgx can't read directly into their native Go types.
+type orgNotifierDTO struct {
+	*database.OrganizationNotifier
+	Properties pgtype.JSON      `db:"properties"`
+	Events     pgtype.TextArray `db:"events"`
+}
+
+func (n *orgNotifierDTO) AsModel() (*database.OrganizationNotifier, error) {
+	err := n.Properties.AssignTo(&n.OrganizationNotifier.Properties)
+	if err != nil {
+		return nil, err
+	}
+	err = n.Events.AssignTo(&n.OrganizationNotifier.Events)
+	if err != nil {
+		return nil, err
+	}
+	return n.OrganizationNotifier, nil
+}
+
+func orgNotifiersFromDTOs(dtos []*orgNotifierDTO) ([]*database.OrganizationNotifier, error) {
+	res := make([]*database.OrganizationNotifier, len(dtos))
+	for i, dto := range dtos {
+		var err error
+		res[i], err = dto.AsModel()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 // magicAuthTokenDTO wraps database.MagicAuthToken, using the pgtype package to handly types that pgx can't read directly into their native Go types.
 type magicAuthTokenDTO struct {
 	*database.MagicAuthToken
@@ -1935,6 +2604,8 @@ func parseErr(target string, err error) error {
 			return newAlreadyExistsErr("email has already been invited to the org")
 		case "project_invites_email_project_idx":
 			return newAlreadyExistsErr("email has already been invited to the project")
+		case "orgs_custom_domain_idx":
+			return newAlreadyExistsErr("the custom domain is already used by another org")
 		case "orgs_autoinvite_domains_org_id_domain_idx":
 			return newAlreadyExistsErr("domain has already been added for the org")
 		case "service_name_idx":
@@ -1968,3 +2639,73 @@ func (e *wrappedError) Error() string {
 func (e *wrappedError) Unwrap() error {
 	return e.err
 }
+
+func (c *connection) FindUserNotifications(ctx context.Context, userID string, unreadOnly bool, beforeCreatedOn time.Time, beforeID string, limit int) ([]*database.UserNotification, error) {
+	var qry strings.Builder
+	args := []any{userID}
+	qry.WriteString("SELECT * FROM user_notifications WHERE user_id=$1")
+	if unreadOnly {
+		qry.WriteString(" AND read_on IS NULL")
+	}
+	if beforeID != "" {
+		args = append(args, beforeCreatedOn, beforeID)
+		fmt.Fprintf(&qry, " AND (created_on, id) < ($%d, $%d)", len(args)-1, len(args))
+	}
+	args = append(args, limit)
+	fmt.Fprintf(&qry, " ORDER BY created_on DESC, id DESC LIMIT $%d", len(args))
+
+	var res []*database.UserNotification
+	err := c.getDB(ctx).SelectContext(ctx, &res, qry.String(), args...)
+	if err != nil {
+		return nil, parseErr("user notifications", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindUserNotificationsCreatedAfter(ctx context.Context, userID string, after time.Time, limit int) ([]*database.UserNotification, error) {
+	var res []*database.UserNotification
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM user_notifications WHERE user_id=$1 AND created_on > $2 ORDER BY created_on, id LIMIT $3", userID, after, limit)
+	if err != nil {
+		return nil, parseErr("user notifications", err)
+	}
+	return res, nil
+}
+
+func (c *connection) CountUnreadUserNotifications(ctx context.Context, userID string) (int, error) {
+	var res int
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT COUNT(*) FROM user_notifications WHERE user_id=$1 AND read_on IS NULL", userID).Scan(&res)
+	if err != nil {
+		return 0, parseErr("user notifications", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertUserNotification(ctx context.Context, opts *database.InsertUserNotificationOptions) (*database.UserNotification, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.UserNotification{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO user_notifications (user_id, org_id, project_id, type, title, body, url) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *
+	`, opts.UserID, opts.OrgID, opts.ProjectID, opts.Type, opts.Title, opts.Body, opts.URL).StructScan(res)
+	if err != nil {
+		return nil, parseErr("user notification", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateUserNotificationsRead(ctx context.Context, userID string, ids []string) error {
+	var err error
+	if len(ids) == 0 {
+		_, err = c.getDB(ctx).ExecContext(ctx, "UPDATE user_notifications SET read_on=now() WHERE user_id=$1 AND read_on IS NULL", userID)
+	} else {
+		_, err = c.getDB(ctx).ExecContext(ctx, "UPDATE user_notifications SET read_on=now() WHERE user_id=$1 AND id=ANY($2) AND read_on IS NULL", userID, ids)
+	}
+	return parseErr("user notifications", err)
+}
+
+func (c *connection) DeleteExpiredUserNotifications(ctx context.Context, retention time.Duration) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM user_notifications WHERE created_on + $1 < now()", retention)
+	return parseErr("user notifications", err)
+}
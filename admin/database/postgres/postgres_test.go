@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -18,7 +19,7 @@ func TestPostgres(t *testing.T) {
 	pg := pgtestcontainer.New(t)
 	defer pg.Terminate(t)
 
-	db, err := database.Open("postgres", pg.DatabaseURL)
+	db, err := database.Open("postgres", pg.DatabaseURL, "")
 	require.NoError(t, err)
 	require.NotNil(t, db)
 
@@ -29,9 +30,21 @@ func TestPostgres(t *testing.T) {
 	t.Run("TestProjects", func(t *testing.T) { testProjects(t, db) })
 	t.Run("TestProjectsWithVariables", func(t *testing.T) { testProjectsWithVariables(t, db) })
 	t.Run("TestProjectsWithAnnotations", func(t *testing.T) { testProjectsWithAnnotations(t, db) })
+	t.Run("TestProjectsWithLabels", func(t *testing.T) { testProjectsWithLabels(t, db) })
 	t.Run("TestProjectsWithPagination", func(t *testing.T) { testProjectsWithPagination(t, db) })
 	t.Run("TestProjectsForUsersWithPagination", func(t *testing.T) { testProjectsForUserWithPagination(t, db) })
 	t.Run("TestMembersWithPagination", func(t *testing.T) { testOrgsMembersPagination(t, db) })
+	t.Run("TestMembersActivity", func(t *testing.T) { testOrgMembersActivity(t, db) })
+	t.Run("TestJobs", func(t *testing.T) { testJobs(t, db) })
+	t.Run("TestProjectPublicMetricsViews", func(t *testing.T) { testProjectPublicMetricsViews(t, db) })
+	t.Run("TestProjectProdDeploymentSwap", func(t *testing.T) { testProjectProdDeploymentSwap(t, db) })
+	t.Run("TestExternalIDs", func(t *testing.T) { testExternalIDs(t, db) })
+	t.Run("TestRuntimeHosts", func(t *testing.T) { testRuntimeHosts(t, db) })
+	t.Run("TestDeploymentRuntimeConfig", func(t *testing.T) { testDeploymentRuntimeConfig(t, db) })
+	t.Run("TestUserPreferences", func(t *testing.T) { testUserPreferences(t, db) })
+	t.Run("TestStarredDashboards", func(t *testing.T) { testStarredDashboards(t, db) })
+	t.Run("TestUserNotifications", func(t *testing.T) { testUserNotifications(t, db) })
+	t.Run("TestWhitelistedDomainsLowercaseMigration", func(t *testing.T) { testWhitelistedDomainsLowercaseMigration(t, db) })
 	// Add new tests here
 
 	require.NoError(t, db.Close())
@@ -79,6 +92,28 @@ func testOrganizations(t *testing.T, db database.DB) {
 	require.Equal(t, "foo", org.Name)
 	require.Equal(t, "", org.Description)
 
+	org, err = db.UpdateOrganization(ctx, org.ID, &database.UpdateOrganizationOptions{
+		Name:         org.Name,
+		LogoURL:      "https://example.com/logo.png",
+		PrimaryColor: "#1e40af",
+		SupportEmail: "support@example.com",
+		CustomDomain: "analytics.example.com",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/logo.png", org.LogoURL)
+	require.Equal(t, "#1e40af", org.PrimaryColor)
+	require.Equal(t, "support@example.com", org.SupportEmail)
+	require.Equal(t, "analytics.example.com", org.CustomDomain)
+
+	// Custom domains are unique across orgs
+	bar, err := db.FindOrganizationByName(ctx, "bar")
+	require.NoError(t, err)
+	_, err = db.UpdateOrganization(ctx, bar.ID, &database.UpdateOrganizationOptions{
+		Name:         bar.Name,
+		CustomDomain: "Analytics.example.com",
+	})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
 	err = db.DeleteOrganization(ctx, org.Name)
 	require.NoError(t, err)
 
@@ -210,6 +245,19 @@ func testProjectsWithVariables(t *testing.T, db database.DB) {
 	require.NoError(t, err)
 	require.Equal(t, opts.ProdVariables, proj.ProdVariables)
 
+	vars := map[string]string{"hello": "world", "foo": "bar"}
+	proj, err = db.UpdateProjectVariables(ctx, proj.ID, vars, opts.ProdVariables)
+	require.NoError(t, err)
+	require.Equal(t, vars, proj.ProdVariables)
+
+	// Fails if the variables were changed concurrently
+	_, err = db.UpdateProjectVariables(ctx, proj.ID, map[string]string{}, opts.ProdVariables)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	proj, err = db.UpdateProjectVariables(ctx, proj.ID, nil, vars)
+	require.NoError(t, err)
+	require.Empty(t, proj.ProdVariables)
+
 	err = db.DeleteProject(ctx, proj.ID)
 	require.NoError(t, err)
 
@@ -263,6 +311,50 @@ func testProjectsWithAnnotations(t *testing.T, db database.DB) {
 	require.NoError(t, err)
 }
 
+func testProjectsWithLabels(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "foo"})
+	require.NoError(t, err)
+
+	proj1, err := db.InsertProject(ctx, &database.InsertProjectOptions{
+		OrganizationID: org.ID,
+		Name:           "alpha",
+		Labels:         map[string]string{"team": "growth"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"team": "growth"}, proj1.Labels)
+
+	proj2, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "beta"})
+	require.NoError(t, err)
+	require.Empty(t, proj2.Labels)
+
+	labels := map[string]string{"team": "growth", "cost_center": "42"}
+	proj2, err = db.UpdateProject(ctx, proj2.ID, &database.UpdateProjectOptions{
+		Name:   proj2.Name,
+		Labels: labels,
+	})
+	require.NoError(t, err)
+	require.Equal(t, labels, proj2.Labels)
+
+	projs, err := db.FindProjectsForOrganization(ctx, org.ID, "", map[string]string{"team": "growth"}, 10)
+	require.NoError(t, err)
+	require.Len(t, projs, 2)
+
+	projs, err = db.FindProjectsForOrganization(ctx, org.ID, "", map[string]string{"team": "growth", "cost_center": "42"}, 10)
+	require.NoError(t, err)
+	require.Len(t, projs, 1)
+	require.Equal(t, "beta", projs[0].Name)
+
+	projs, err = db.FindProjectsForOrganization(ctx, org.ID, "", map[string]string{"team": "sales"}, 10)
+	require.NoError(t, err)
+	require.Len(t, projs, 0)
+
+	require.NoError(t, db.DeleteProject(ctx, proj1.ID))
+	require.NoError(t, db.DeleteProject(ctx, proj2.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
 func testProjectsWithPagination(t *testing.T, db database.DB) {
 	ctx := context.Background()
 
@@ -294,14 +386,14 @@ func testProjectsWithPagination(t *testing.T, db database.DB) {
 	require.Equal(t, "other", proj3.Name)
 
 	// fetch project name without name filter
-	projs, err := db.FindProjectsForOrganization(ctx, org.ID, "", 2)
+	projs, err := db.FindProjectsForOrganization(ctx, org.ID, "", nil, 2)
 	require.NoError(t, err)
 	require.Equal(t, len(projs), 2)
 	require.Equal(t, "alpha", projs[0].Name)
 	require.Equal(t, "beta", projs[1].Name)
 
 	// fetch project with name filter
-	projs, err = db.FindProjectsForOrganization(ctx, org.ID, projs[1].Name, 2)
+	projs, err = db.FindProjectsForOrganization(ctx, org.ID, projs[1].Name, nil, 2)
 	require.NoError(t, err)
 	require.Equal(t, len(projs), 1)
 	require.Equal(t, "gamma", projs[0].Name)
@@ -354,14 +446,14 @@ func testProjectsForUserWithPagination(t *testing.T, db database.DB) {
 	require.Equal(t, "internal", proj3.Name)
 
 	// fetch projects without name filter
-	projs, err := db.FindProjectsForOrgAndUser(ctx, org.ID, user.ID, "", 2)
+	projs, err := db.FindProjectsForOrgAndUser(ctx, org.ID, user.ID, "", nil, 2)
 	require.NoError(t, err)
 	require.Equal(t, len(projs), 2)
 	require.Equal(t, "alpha", projs[0].Name)
 	require.Equal(t, "beta", projs[1].Name)
 
 	// fetch project with name filter
-	projs, err = db.FindProjectsForOrgAndUser(ctx, org.ID, user.ID, projs[1].Name, 2)
+	projs, err = db.FindProjectsForOrgAndUser(ctx, org.ID, user.ID, projs[1].Name, nil, 2)
 	require.NoError(t, err)
 	require.Equal(t, len(projs), 1)
 	require.Equal(t, "gamma", projs[0].Name)
@@ -419,3 +511,497 @@ func testOrgsMembersPagination(t *testing.T, db database.DB) {
 	//cleanup
 	require.NoError(t, db.DeleteOrganization(ctx, "alpha"))
 }
+
+func testOrgMembersActivity(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	editorUser, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "activity1@rilldata.com"})
+	require.NoError(t, err)
+	viewerUser, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "activity2@rilldata.com"})
+	require.NoError(t, err)
+
+	collaborator, err := db.FindOrganizationRole(ctx, database.OrganizationRoleNameCollaborator)
+	require.NoError(t, err)
+	viewer, err := db.FindOrganizationRole(ctx, database.OrganizationRoleNameViewer)
+	require.NoError(t, err)
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "activity"})
+	require.NoError(t, err)
+	require.NoError(t, db.InsertOrganizationMemberUser(ctx, org.ID, editorUser.ID, collaborator.ID))
+	require.NoError(t, db.InsertOrganizationMemberUser(ctx, org.ID, viewerUser.ID, viewer.ID))
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "proj"})
+	require.NoError(t, err)
+	require.NoError(t, db.UpsertQueryUsage(ctx, &database.UpsertQueryUsageOptions{
+		ProjectID: proj.ID,
+		Subject:   viewerUser.ID,
+		Time:      time.Now(),
+		Queries:   1,
+	}))
+
+	activity, err := db.FindOrganizationMemberUsersActivity(ctx, org.ID, []string{editorUser.ID, viewerUser.ID})
+	require.NoError(t, err)
+	require.Len(t, activity, 2)
+	for _, a := range activity {
+		require.Nil(t, a.LastLoginOn)
+		switch a.UserID {
+		case editorUser.ID:
+			require.True(t, a.Editor)
+			require.Nil(t, a.LastActiveProject)
+		case viewerUser.ID:
+			require.False(t, a.Editor)
+			require.NotNil(t, a.LastActiveProject)
+			require.Equal(t, "proj", *a.LastActiveProject)
+		default:
+			t.Fatalf("unexpected user %q", a.UserID)
+		}
+	}
+
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testJobs(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	job, err := db.InsertJob(ctx, &database.InsertJobOptions{Kind: "test", Args: `{"foo":"bar"}`, MaxAttempts: 2})
+	require.NoError(t, err)
+	require.Equal(t, database.JobStatusPending, job.Status)
+
+	// Claim the job and fail it with a retry in the future
+	claimed, err := db.ClaimJob(ctx, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, job.ID, claimed.ID)
+	require.Equal(t, database.JobStatusRunning, claimed.Status)
+	require.Equal(t, 1, claimed.Attempts)
+	require.JSONEq(t, `{"foo":"bar"}`, claimed.Args)
+
+	_, err = db.ClaimJob(ctx, time.Minute)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	retryAfter := time.Now().Add(time.Hour)
+	require.NoError(t, db.UpdateJobFailed(ctx, job.ID, "boom", &retryAfter))
+	_, err = db.ClaimJob(ctx, time.Minute)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// Make it runnable again and let it succeed
+	retryAfter = time.Now().Add(-time.Second)
+	require.NoError(t, db.UpdateJobFailed(ctx, job.ID, "boom", &retryAfter))
+	claimed, err = db.ClaimJob(ctx, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 2, claimed.Attempts)
+	require.NoError(t, db.UpdateJobSucceeded(ctx, job.ID))
+
+	jobs, err := db.FindJobs(ctx, database.JobStatusSucceeded, time.Time{}, "", 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.NotNil(t, jobs[0].FinishedOn)
+
+	jobs, err = db.FindJobs(ctx, database.JobStatusUnspecified, jobs[0].CreatedOn, jobs[0].ID, 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 0)
+
+	require.NoError(t, db.DeleteFinishedJobs(ctx, -time.Hour))
+	jobs, err = db.FindJobs(ctx, database.JobStatusUnspecified, time.Time{}, "", 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 0)
+}
+
+func testProjectPublicMetricsViews(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "public-mvs"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "proj"})
+	require.NoError(t, err)
+
+	mvs, err := db.FindProjectPublicMetricsViews(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Empty(t, mvs)
+
+	require.NoError(t, db.InsertProjectPublicMetricsView(ctx, proj.ID, "b"))
+	require.NoError(t, db.InsertProjectPublicMetricsView(ctx, proj.ID, "a"))
+	require.NoError(t, db.InsertProjectPublicMetricsView(ctx, proj.ID, "a"))
+	mvs, err = db.FindProjectPublicMetricsViews(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, mvs)
+
+	require.NoError(t, db.DeleteProjectPublicMetricsViews(ctx, proj.ID))
+	mvs, err = db.FindProjectPublicMetricsViews(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Empty(t, mvs)
+
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testProjectProdDeploymentSwap(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "blue-green"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "proj"})
+	require.NoError(t, err)
+
+	blue, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{ProjectID: proj.ID, Provisioner: "static", RuntimeHost: "host", RuntimeInstanceID: "blue"})
+	require.NoError(t, err)
+	green, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{ProjectID: proj.ID, Provisioner: "static", RuntimeHost: "host", RuntimeInstanceID: "green"})
+	require.NoError(t, err)
+
+	proj, err = db.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{Name: proj.Name, ProdDeploymentID: &blue.ID, DeployBlueGreen: true})
+	require.NoError(t, err)
+	require.True(t, proj.DeployBlueGreen)
+
+	proj, err = db.UpdateProjectProdDeployment(ctx, proj.ID, blue.ID, green.ID)
+	require.NoError(t, err)
+	require.Equal(t, green.ID, *proj.ProdDeploymentID)
+
+	// The swap fails if the prod deployment has changed
+	_, err = db.UpdateProjectProdDeployment(ctx, proj.ID, blue.ID, green.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, db.DeleteDeployment(ctx, blue.ID))
+	require.NoError(t, db.DeleteDeployment(ctx, green.ID))
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testExternalIDs(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	extID := "tf-org-1"
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "ext-org", ExternalID: &extID})
+	require.NoError(t, err)
+	require.Equal(t, extID, *org.ExternalID)
+
+	_, err = db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "ext-org-2", ExternalID: &extID})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	found, err := db.FindOrganizationByExternalID(ctx, extID)
+	require.NoError(t, err)
+	require.Equal(t, org.ID, found.ID)
+
+	_, err = db.FindOrganizationByExternalID(ctx, "missing")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	projExtID := "tf-proj-1"
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "ext-proj", ExternalID: &projExtID})
+	require.NoError(t, err)
+	require.Equal(t, projExtID, *proj.ExternalID)
+
+	_, err = db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "ext-proj-2", ExternalID: &projExtID})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	foundProj, err := db.FindProjectByExternalID(ctx, org.ID, projExtID)
+	require.NoError(t, err)
+	require.Equal(t, proj.ID, foundProj.ID)
+
+	// Project external IDs are scoped to the org
+	other, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "ext-org-other"})
+	require.NoError(t, err)
+	_, err = db.FindProjectByExternalID(ctx, other.ID, projExtID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+	require.NoError(t, db.DeleteOrganization(ctx, other.Name))
+}
+
+func testRuntimeHosts(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "runtime-hosts"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "proj"})
+	require.NoError(t, err)
+	depl, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{ProjectID: proj.ID, Provisioner: "static", RuntimeHost: "http://host-1", RuntimeInstanceID: "inst"})
+	require.NoError(t, err)
+
+	host, err := db.UpsertRuntimeHostHeartbeat(ctx, "http://host-1", "v0.1.0")
+	require.NoError(t, err)
+	require.Equal(t, "v0.1.0", host.Version)
+
+	// A subsequent heartbeat updates the version and heartbeat time
+	updated, err := db.UpsertRuntimeHostHeartbeat(ctx, "http://host-1", "v0.2.0")
+	require.NoError(t, err)
+	require.Equal(t, "v0.2.0", updated.Version)
+	require.Equal(t, host.CreatedOn, updated.CreatedOn)
+	require.False(t, updated.HeartbeatOn.Before(host.HeartbeatOn))
+
+	hosts, err := db.FindRuntimeHosts(ctx)
+	require.NoError(t, err)
+	require.Len(t, hosts, 1)
+	require.Equal(t, "http://host-1", hosts[0].Host)
+
+	depls, err := db.FindDeploymentsForRuntimeHost(ctx, "http://host-1")
+	require.NoError(t, err)
+	require.Len(t, depls, 1)
+	require.Equal(t, depl.ID, depls[0].ID)
+
+	depl, err = db.UpdateDeploymentStatus(ctx, depl.ID, database.DeploymentStatusUnreachable, "unreachable")
+	require.NoError(t, err)
+	require.Equal(t, database.DeploymentStatusUnreachable, depl.Status)
+
+	require.NoError(t, db.DeleteRuntimeHost(ctx, "http://host-1"))
+	require.ErrorIs(t, db.DeleteRuntimeHost(ctx, "http://host-1"), database.ErrNotFound)
+
+	require.NoError(t, db.DeleteDeployment(ctx, depl.ID))
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testDeploymentRuntimeConfig(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "runtime-config"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "proj"})
+	require.NoError(t, err)
+
+	depl, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{ProjectID: proj.ID, Provisioner: "static", RuntimeHost: "host", RuntimeInstanceID: "inst"})
+	require.NoError(t, err)
+	require.Empty(t, depl.RuntimeConfig)
+
+	cfg := map[string]string{"rill.metrics.max_execution_timeout_seconds": "60"}
+	depl, err = db.UpdateDeploymentRuntimeConfig(ctx, depl.ID, cfg)
+	require.NoError(t, err)
+	require.Equal(t, cfg, depl.RuntimeConfig)
+
+	depl, err = db.FindDeployment(ctx, depl.ID)
+	require.NoError(t, err)
+	require.Equal(t, cfg, depl.RuntimeConfig)
+
+	// Overrides can be passed when inserting a deployment, e.g. when carried over on redeploy
+	other, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{ProjectID: proj.ID, Provisioner: "static", RuntimeHost: "host", RuntimeInstanceID: "other", RuntimeConfig: cfg})
+	require.NoError(t, err)
+	require.Equal(t, cfg, other.RuntimeConfig)
+
+	depls, err := db.FindDeploymentsForProject(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, depls, 2)
+	for _, d := range depls {
+		require.Equal(t, cfg, d.RuntimeConfig)
+	}
+
+	require.NoError(t, db.DeleteDeployment(ctx, depl.ID))
+	require.NoError(t, db.DeleteDeployment(ctx, other.ID))
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testUserPreferences(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "prefs@example.org"})
+	require.NoError(t, err)
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "prefs-org"})
+	require.NoError(t, err)
+	p1, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "p1"})
+	require.NoError(t, err)
+	p2, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "p2"})
+	require.NoError(t, err)
+
+	user, err = db.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
+		PreferenceTimeZone:     "Europe/Copenhagen",
+		PreferenceDefaultOrgID: &org.ID,
+		PreferenceLocale:       "da-DK",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Europe/Copenhagen", user.PreferenceTimeZone)
+	require.Equal(t, org.ID, *user.PreferenceDefaultOrgID)
+	require.Equal(t, "da-DK", user.PreferenceLocale)
+
+	projs, err := db.FindFavoriteProjectsForUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, projs)
+
+	require.NoError(t, db.InsertUserFavoriteProject(ctx, user.ID, p2.ID))
+	require.NoError(t, db.InsertUserFavoriteProject(ctx, user.ID, p1.ID))
+	require.NoError(t, db.InsertUserFavoriteProject(ctx, user.ID, p2.ID))
+	projs, err = db.FindFavoriteProjectsForUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, projs, 2)
+	require.Equal(t, p2.ID, projs[0].ID)
+	require.Equal(t, p1.ID, projs[1].ID)
+
+	require.NoError(t, db.DeleteUserFavoriteProject(ctx, user.ID, p2.ID))
+	require.ErrorIs(t, db.DeleteUserFavoriteProject(ctx, user.ID, p2.ID), database.ErrNotFound)
+
+	// Deleting a project removes it from favorites
+	require.NoError(t, db.DeleteProject(ctx, p1.ID))
+	projs, err = db.FindFavoriteProjectsForUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, projs)
+
+	// Deleting the default org clears the preference
+	require.NoError(t, db.DeleteProject(ctx, p2.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+	user, err = db.FindUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Nil(t, user.PreferenceDefaultOrgID)
+
+	require.NoError(t, db.DeleteUser(ctx, user.ID))
+}
+
+func testStarredDashboards(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "stars@example.org"})
+	require.NoError(t, err)
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "stars-org"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "p1"})
+	require.NoError(t, err)
+
+	opts := &database.InsertStarredDashboardOptions{
+		UserID:       user.ID,
+		ProjectID:    proj.ID,
+		ResourceKind: "rill.runtime.v1.MetricsView",
+		ResourceName: "dash",
+	}
+	d1, err := db.InsertStarredDashboard(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, "dash", d1.ResourceName)
+
+	// Starring again is a no-op
+	d2, err := db.InsertStarredDashboard(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, d1.CreatedOn, d2.CreatedOn)
+
+	_, err = db.InsertStarredDashboard(ctx, &database.InsertStarredDashboardOptions{
+		UserID:       user.ID,
+		ProjectID:    proj.ID,
+		ResourceKind: "rill.runtime.v1.Dashboard",
+		ResourceName: "canvas",
+	})
+	require.NoError(t, err)
+
+	ds, err := db.FindStarredDashboardsForUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, ds, 2)
+	require.Equal(t, "dash", ds[0].ResourceName)
+	require.Equal(t, "canvas", ds[1].ResourceName)
+
+	require.NoError(t, db.DeleteStarredDashboard(ctx, user.ID, proj.ID, "rill.runtime.v1.MetricsView", "dash"))
+	require.ErrorIs(t, db.DeleteStarredDashboard(ctx, user.ID, proj.ID, "rill.runtime.v1.MetricsView", "dash"), database.ErrNotFound)
+
+	// Deleting the project removes its starred dashboards
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	ds, err = db.FindStarredDashboardsForUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, ds)
+
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+	require.NoError(t, db.DeleteUser(ctx, user.ID))
+}
+
+func testUserNotifications(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "notifications@example.org"})
+	require.NoError(t, err)
+
+	start := time.Now()
+	var ns []*database.UserNotification
+	for i := 0; i < 3; i++ {
+		n, err := db.InsertUserNotification(ctx, &database.InsertUserNotificationOptions{
+			UserID: user.ID,
+			Type:   database.UserNotificationTypeDeployFailed,
+			Title:  fmt.Sprintf("n%d", i),
+		})
+		require.NoError(t, err)
+		require.Nil(t, n.ReadOn)
+		ns = append(ns, n)
+	}
+
+	// Newest first with pagination
+	res, err := db.FindUserNotifications(ctx, user.ID, false, time.Now().Add(time.Hour), "", 2)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "n2", res[0].Title)
+	require.Equal(t, "n1", res[1].Title)
+	res, err = db.FindUserNotifications(ctx, user.ID, false, res[1].CreatedOn, res[1].ID, 2)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, "n0", res[0].Title)
+
+	// Oldest first after a time
+	res, err = db.FindUserNotificationsCreatedAfter(ctx, user.ID, ns[0].CreatedOn, 10)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "n1", res[0].Title)
+	res, err = db.FindUserNotificationsCreatedAfter(ctx, user.ID, start.Add(-time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+
+	// Mark one as read
+	require.NoError(t, db.UpdateUserNotificationsRead(ctx, user.ID, []string{ns[1].ID}))
+	count, err := db.CountUnreadUserNotifications(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	res, err = db.FindUserNotifications(ctx, user.ID, true, time.Now().Add(time.Hour), "", 10)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "n2", res[0].Title)
+	require.Equal(t, "n0", res[1].Title)
+
+	// Mark all as read
+	require.NoError(t, db.UpdateUserNotificationsRead(ctx, user.ID, nil))
+	count, err = db.CountUnreadUserNotifications(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+
+	// Notifications within the retention period are kept
+	require.NoError(t, db.DeleteExpiredUserNotifications(ctx, time.Hour))
+	res, err = db.FindUserNotifications(ctx, user.ID, false, time.Now().Add(time.Hour), "", 10)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	require.NoError(t, db.DeleteExpiredUserNotifications(ctx, 0))
+	res, err = db.FindUserNotifications(ctx, user.ID, false, time.Now().Add(time.Hour), "", 10)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	require.NoError(t, db.DeleteUser(ctx, user.ID))
+}
+
+func testWhitelistedDomainsLowercaseMigration(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "autoinvite-domains"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "proj"})
+	require.NoError(t, err)
+	orgRole, err := db.FindOrganizationRole(ctx, database.OrganizationRoleNameViewer)
+	require.NoError(t, err)
+	projRole, err := db.FindProjectRole(ctx, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+
+	// Insert domains in mixed case, as stored before domains were lowercased on write
+	_, err = db.InsertOrganizationWhitelistedDomain(ctx, &database.InsertOrganizationWhitelistedDomainOptions{OrgID: org.ID, OrgRoleID: orgRole.ID, Domain: "Example.ORG"})
+	require.NoError(t, err)
+	_, err = db.InsertProjectWhitelistedDomain(ctx, &database.InsertProjectWhitelistedDomainOptions{ProjectID: proj.ID, ProjectRoleID: projRole.ID, Domain: "Example.ORG"})
+	require.NoError(t, err)
+
+	// Lowercasing must not create duplicates
+	_, err = db.InsertOrganizationWhitelistedDomain(ctx, &database.InsertOrganizationWhitelistedDomainOptions{OrgID: org.ID, OrgRoleID: orgRole.ID, Domain: "example.org"})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	// Re-run the migration
+	migration, err := migrationsFS.ReadFile("migrations/0052.sql")
+	require.NoError(t, err)
+	_, err = db.(*connection).db.ExecContext(ctx, string(migration))
+	require.NoError(t, err)
+
+	orgDomains, err := db.FindOrganizationWhitelistedDomainForOrganizationWithJoinedRoleNames(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, orgDomains, 1)
+	require.Equal(t, "example.org", orgDomains[0].Domain)
+
+	projDomains, err := db.FindProjectWhitelistedDomainForProjectWithJoinedRoleNames(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, projDomains, 1)
+	require.Equal(t, "example.org", projDomains[0].Domain)
+
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	// retryMaxAttempts is the maximum number of times a statement is attempted when it fails with a transient error.
+	retryMaxAttempts = 4
+	// retryInitialBackoff is the delay before the first retry. It doubles for each subsequent retry.
+	retryInitialBackoff = 100 * time.Millisecond
+)
+
+// retryDB wraps a *sqlx.DB and retries statements that fail with transient errors, such as those returned during a database failover.
+// It must not be used for statements in a transaction since a failed transaction must be retried as a whole.
+type retryDB struct {
+	*sqlx.DB
+}
+
+var _ dbHandle = retryDB{}
+
+func (r retryDB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	var res *sql.Rows
+	err := retry(ctx, true, func() error {
+		var err error
+		res, err = r.DB.QueryContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+func (r retryDB) QueryxContext(ctx context.Context, query string, args ...any) (*sqlx.Rows, error) {
+	var res *sqlx.Rows
+	err := retry(ctx, true, func() error {
+		var err error
+		res, err = r.DB.QueryxContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+func (r retryDB) QueryRowxContext(ctx context.Context, query string, args ...any) *sqlx.Row {
+	var res *sqlx.Row
+	_ = retry(ctx, true, func() error {
+		res = r.DB.QueryRowxContext(ctx, query, args...)
+		return res.Err()
+	})
+	return res
+}
+
+func (r retryDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	var res sql.Result
+	err := retry(ctx, false, func() error {
+		var err error
+		res, err = r.DB.ExecContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+func (r retryDB) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	return retry(ctx, true, func() error {
+		return r.DB.SelectContext(ctx, dest, query, args...)
+	})
+}
+
+// retry calls fn until it succeeds, returns a non-transient error, or the maximum number of attempts is reached.
+// If idempotent is false, errors where the statement may already have been applied by the server are not retried.
+func retry(ctx context.Context, idempotent bool, fn func() error) error {
+	backoff := retryInitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= retryMaxAttempts || !isTransientErr(err, idempotent) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// isTransientErr returns true if err may succeed when retried.
+// Errors reported by the server with one of the SQLSTATE codes below mean the statement was not applied, so they are always safe to retry.
+// Other connection errors are only safe to retry for idempotent statements, unless the driver reports that nothing was sent to the server.
+func isTransientErr(err error, idempotent bool) bool {
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var pgerr *pgconn.PgError
+	if errors.As(err, &pgerr) {
+		switch pgerr.Code {
+		case "40001", // serialization_failure
+			"40P01", // deadlock_detected
+			"25006", // read_only_sql_transaction (connected to a former primary after a failover)
+			"57P01", // admin_shutdown
+			"57P02", // crash_shutdown
+			"57P03", // cannot_connect_now
+			"08000", // connection_exception
+			"08003", // connection_does_not_exist
+			"08006": // connection_failure
+			return true
+		}
+		return false
+	}
+
+	if pgconn.SafeToRetry(err) {
+		return true
+	}
+	if !idempotent {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, sqldriver.ErrBadConn) || pgconn.Timeout(err)
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTransientErr(t *testing.T) {
+	require.True(t, isTransientErr(&pgconn.PgError{Code: "57P01"}, false))
+	require.True(t, isTransientErr(&pgconn.PgError{Code: "40001"}, true))
+	require.False(t, isTransientErr(&pgconn.PgError{Code: "23505"}, true))
+	require.False(t, isTransientErr(sql.ErrNoRows, true))
+	require.False(t, isTransientErr(context.Canceled, true))
+	require.True(t, isTransientErr(io.ErrUnexpectedEOF, true))
+	require.False(t, isTransientErr(io.ErrUnexpectedEOF, false))
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	var n int
+	err := retry(ctx, true, func() error {
+		n++
+		if n < 3 {
+			return &pgconn.PgError{Code: "57P03"}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	n = 0
+	err = retry(ctx, true, func() error {
+		n++
+		return &pgconn.PgError{Code: "57P03"}
+	})
+	require.Error(t, err)
+	require.Equal(t, retryMaxAttempts, n)
+
+	n = 0
+	errPermanent := errors.New("permanent")
+	err = retry(ctx, true, func() error {
+		n++
+		return errPermanent
+	})
+	require.ErrorIs(t, err, errPermanent)
+	require.Equal(t, 1, n)
+}
@@ -15,7 +15,12 @@ func (c *connection) NewTx(ctx context.Context) (context.Context, database.Tx, e
 	}
 
 	// Start a new tx
-	tx, err := c.db.BeginTxx(ctx, nil)
+	var tx *sqlx.Tx
+	err := retry(ctx, true, func() error {
+		var err error
+		tx, err = c.db.BeginTxx(ctx, nil)
+		return err
+	})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -51,10 +56,20 @@ type dbHandle interface {
 }
 
 // getDB either returns the current tx (if one is present) or c.db.
+// Statements that run outside of a tx are retried on transient errors.
 func (c *connection) getDB(ctx context.Context) dbHandle {
 	tx := txFromContext(ctx)
 	if tx == nil {
-		return c.db
+		return retryDB{c.db}
 	}
 	return tx
 }
+
+// getReadDB is similar to getDB, but returns the read replica (if one is configured) when there's no tx in the context.
+// It should only be used for expensive reads that can tolerate replication lag, such as listing resources.
+func (c *connection) getReadDB(ctx context.Context) dbHandle {
+	if c.replica == nil || txFromContext(ctx) != nil {
+		return c.getDB(ctx)
+	}
+	return retryDB{c.replica}
+}
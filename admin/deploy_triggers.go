@@ -0,0 +1,159 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+)
+
+// zeroGitSha is the commit SHA Github reports as the "before" commit when a branch is created.
+const zeroGitSha = "0000000000000000000000000000000000000000"
+
+// ValidateDeployTrigger validates a deploy trigger policy and its schedule.
+func ValidateDeployTrigger(trigger, schedule string) error {
+	switch trigger {
+	case database.DeployTriggerPush, database.DeployTriggerManual:
+		if schedule != "" {
+			return fmt.Errorf("a deploy schedule can only be set when the deploy trigger is %q", database.DeployTriggerSchedule)
+		}
+	case database.DeployTriggerSchedule:
+		if schedule != "" {
+			_, err := cron.ParseStandard(schedule)
+			if err != nil {
+				return fmt.Errorf("invalid deploy schedule %q: %w", schedule, err)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid deploy trigger %q", trigger)
+	}
+	return nil
+}
+
+// deployScheduleDue returns true if a scheduled deploy of a commit pushed at pendingOn should run at the given time.
+// A held commit is deployed at the first tick of the schedule (evaluated in UTC) after it was pushed.
+func deployScheduleDue(schedule string, pendingOn, now time.Time) (bool, error) {
+	if schedule == "" {
+		schedule = database.DefaultDeploySchedule
+	}
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return false, err
+	}
+	return !now.Before(sched.Next(pendingOn.UTC())), nil
+}
+
+// holdGithubPush records a commit pushed to the production branch of a project that doesn't deploy on push.
+// The deployment is pinned to the previous commit (if not already pinned) until the held commit is approved or scheduled.
+func (s *Service) holdGithubPush(ctx context.Context, proj *database.Project, before, sha string) error {
+	deploySha := proj.DeploySha
+	if deploySha == nil && before != "" && before != zeroGitSha {
+		deploySha = &before
+	}
+
+	pendingOn := proj.PendingDeployOn
+	if pendingOn == nil {
+		now := time.Now()
+		pendingOn = &now
+	}
+
+	_, err := s.DB.UpdateProjectDeployState(ctx, proj.ID, &database.UpdateProjectDeployStateOptions{
+		DeploySha:        deploySha,
+		PendingDeploySha: &sha,
+		PendingDeployOn:  pendingOn,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Info("github push held for deploy", zap.String("project_id", proj.ID), zap.String("trigger", proj.DeployTrigger), zap.String("sha", sha), observability.ZapCtx(ctx))
+	return nil
+}
+
+// DeployPendingCommit deploys the commit held back by a project's deploy trigger.
+// It pins the prod deployment to the held commit and triggers a reconcile.
+// It returns the deployed commit, which is empty if there was no pending deploy.
+func (s *Service) DeployPendingCommit(ctx context.Context, proj *database.Project) (string, error) {
+	if proj.PendingDeploySha == nil {
+		return "", nil
+	}
+	sha := *proj.PendingDeploySha
+
+	// Keep the deployment pinned to the deployed commit, so later pushes are also held back.
+	// If the project has since switched to deploy on push, release the pin instead.
+	opts := &database.UpdateProjectDeployStateOptions{DeploySha: &sha}
+	if proj.DeployTrigger == database.DeployTriggerPush {
+		opts.DeploySha = nil
+	}
+	proj, err := s.DB.UpdateProjectDeployState(ctx, proj.ID, opts)
+	if err != nil {
+		return "", err
+	}
+
+	if proj.ProdDeploymentID == nil {
+		// Hibernated. The pinned commit will be used when the project is redeployed.
+		return sha, nil
+	}
+
+	depl, err := s.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	if err != nil {
+		return "", err
+	}
+
+	gd := s.prepareGithubDeploy(ctx, proj, depl, sha)
+
+	depl, err = s.deployCommit(ctx, proj, depl)
+	if err != nil {
+		return "", err
+	}
+
+	if gd != nil {
+		gd.depl = depl
+		go s.reportGithubDeploy(gd)
+	}
+
+	return sha, nil
+}
+
+// DeployScheduledCommits deploys held commits for projects with a scheduled deploy trigger whose schedule is due.
+func (s *Service) DeployScheduledCommits(ctx context.Context) error {
+	limit := 100
+	afterID := ""
+	for {
+		projs, err := s.DB.FindProjectsWithPendingDeploys(ctx, afterID, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, proj := range projs {
+			if proj.DeployTrigger != database.DeployTriggerSchedule {
+				continue
+			}
+
+			due, err := deployScheduleDue(proj.DeploySchedule, *proj.PendingDeployOn, time.Now())
+			if err != nil {
+				s.Logger.Error("scheduled deploy: invalid schedule", zap.String("project_id", proj.ID), zap.String("schedule", proj.DeploySchedule), zap.Error(err), observability.ZapCtx(ctx))
+				continue
+			}
+			if !due {
+				continue
+			}
+
+			sha, err := s.DeployPendingCommit(ctx, proj)
+			if err != nil {
+				s.Logger.Error("scheduled deploy: failed to deploy", zap.String("project_id", proj.ID), zap.Error(err), observability.ZapCtx(ctx))
+				continue
+			}
+			s.Logger.Info("scheduled deploy: deployed", zap.String("project_id", proj.ID), zap.String("sha", sha), observability.ZapCtx(ctx))
+		}
+
+		if len(projs) < limit {
+			return nil
+		}
+		afterID = projs[len(projs)-1].ID
+	}
+}
@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDeployTrigger(t *testing.T) {
+	require.NoError(t, ValidateDeployTrigger(database.DeployTriggerPush, ""))
+	require.NoError(t, ValidateDeployTrigger(database.DeployTriggerManual, ""))
+	require.NoError(t, ValidateDeployTrigger(database.DeployTriggerSchedule, ""))
+	require.NoError(t, ValidateDeployTrigger(database.DeployTriggerSchedule, "30 2 * * 1-5"))
+	require.Error(t, ValidateDeployTrigger(database.DeployTriggerSchedule, "not a cron"))
+	require.Error(t, ValidateDeployTrigger(database.DeployTriggerManual, "0 0 * * *"))
+	require.Error(t, ValidateDeployTrigger("nightly", ""))
+}
+
+func TestDeployScheduleDue(t *testing.T) {
+	pushedOn := time.Date(2024, 5, 1, 15, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		schedule string
+		now      time.Time
+		want     bool
+	}{
+		// Defaults to nightly at midnight UTC
+		{"", time.Date(2024, 5, 1, 23, 59, 0, 0, time.UTC), false},
+		{"", time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), true},
+		{"", time.Date(2024, 5, 3, 12, 0, 0, 0, time.UTC), true},
+		// Custom schedule
+		{"0 16 * * *", time.Date(2024, 5, 1, 15, 59, 0, 0, time.UTC), false},
+		{"0 16 * * *", time.Date(2024, 5, 1, 16, 1, 0, 0, time.UTC), true},
+		{"0 9 * * 1", time.Date(2024, 5, 3, 9, 0, 0, 0, time.UTC), false},
+		{"0 9 * * 1", time.Date(2024, 5, 6, 9, 0, 0, 0, time.UTC), true},
+	}
+	for _, tc := range cases {
+		due, err := deployScheduleDue(tc.schedule, pushedOn, tc.now)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, due, "schedule %q at %s", tc.schedule, tc.now)
+	}
+
+	_, err := deployScheduleDue("bad", pushedOn, pushedOn)
+	require.Error(t, err)
+}
@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/rilldata/rill/admin/database"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+)
+
+// deploymentHealthTTL is how long a deployment's health summary is cached.
+// It avoids calling the runtime for every project on pages that show the health of many projects.
+const deploymentHealthTTL = 30 * time.Second
+
+// deploymentHealthCacheSize is the max number of deployments that health summaries are cached for.
+const deploymentHealthCacheSize = 1000
+
+// DeploymentHealth summarizes the state of the resources in a deployment.
+type DeploymentHealth struct {
+	// ErroredResources is the number of resources that failed to reconcile.
+	ErroredResources int
+	// ParseErrors are the errors from parsing the project's files.
+	ParseErrors []*runtimev1.ParseError
+	// LastReconciledOn is the time of the most recent reconcile of any resource.
+	LastReconciledOn time.Time
+	// Reconciling is true if any resource is currently reconciling.
+	Reconciling bool
+}
+
+// DeploymentHealth returns a health summary of the deployment's resources.
+// The summary may be cached for up to deploymentHealthTTL.
+func (s *Service) DeploymentHealth(ctx context.Context, depl *database.Deployment) (*DeploymentHealth, error) {
+	if h, ok := s.deplHealth.get(depl.ID); ok {
+		return h, nil
+	}
+
+	resources, err := s.listDeploymentResources(ctx, depl)
+	if err != nil {
+		return nil, err
+	}
+
+	h := newDeploymentHealth(resources)
+	s.deplHealth.add(depl.ID, h)
+	return h, nil
+}
+
+// newDeploymentHealth builds a health summary from a deployment's resources.
+func newDeploymentHealth(resources []*runtimev1.Resource) *DeploymentHealth {
+	h := &DeploymentHealth{}
+	for _, r := range resources {
+		if pp := r.GetProjectParser(); pp != nil {
+			h.ParseErrors = pp.State.ParseErrors
+		}
+
+		if r.Meta.ReconcileError != "" {
+			h.ErroredResources++
+		}
+
+		if r.Meta.ReconcileStatus != runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE {
+			h.Reconciling = true
+		}
+
+		if r.Meta.StateUpdatedOn != nil && r.Meta.StateUpdatedOn.AsTime().After(h.LastReconciledOn) {
+			h.LastReconciledOn = r.Meta.StateUpdatedOn.AsTime()
+		}
+	}
+	return h
+}
+
+// deploymentHealthCache is a thread-safe LRU cache of deployment health summaries that expire after deploymentHealthTTL.
+type deploymentHealthCache struct {
+	mu  sync.Mutex
+	lru *simplelru.LRU
+}
+
+type deploymentHealthEntry struct {
+	health    *DeploymentHealth
+	fetchedOn time.Time
+}
+
+func newDeploymentHealthCache() *deploymentHealthCache {
+	lru, err := simplelru.NewLRU(deploymentHealthCacheSize, nil)
+	if err != nil {
+		panic(err)
+	}
+	return &deploymentHealthCache{lru: lru}
+}
+
+func (c *deploymentHealthCache) get(deploymentID string) (*DeploymentHealth, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.lru.Get(deploymentID)
+	if !ok {
+		return nil, false
+	}
+	e := v.(*deploymentHealthEntry)
+	if time.Since(e.fetchedOn) > deploymentHealthTTL {
+		c.lru.Remove(deploymentID)
+		return nil, false
+	}
+	return e.health, true
+}
+
+func (c *deploymentHealthCache) add(deploymentID string, h *DeploymentHealth) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.Add(deploymentID, &deploymentHealthEntry{health: h, fetchedOn: time.Now()})
+}
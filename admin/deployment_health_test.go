@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDeploymentHealth(t *testing.T) {
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	resource := func(name string, stateUpdatedOn time.Time, status runtimev1.ReconcileStatus, reconcileErr string) *runtimev1.Resource {
+		return &runtimev1.Resource{
+			Meta: &runtimev1.ResourceMeta{
+				Name:            &runtimev1.ResourceName{Kind: "rill.runtime.v1.Model", Name: name},
+				StateUpdatedOn:  timestamppb.New(stateUpdatedOn),
+				ReconcileStatus: status,
+				ReconcileError:  reconcileErr,
+			},
+		}
+	}
+
+	parser := resource("parser", t1, runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE, "")
+	parser.Resource = &runtimev1.Resource_ProjectParser{
+		ProjectParser: &runtimev1.ProjectParser{
+			State: &runtimev1.ProjectParserState{
+				ParseErrors: []*runtimev1.ParseError{{Message: "syntax error", FilePath: "/models/bad.sql"}},
+			},
+		},
+	}
+
+	h := newDeploymentHealth([]*runtimev1.Resource{
+		parser,
+		resource("orders", t2, runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE, "table not found"),
+		resource("customers", t1, runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE, ""),
+	})
+	require.Equal(t, 1, h.ErroredResources)
+	require.Len(t, h.ParseErrors, 1)
+	require.Equal(t, t2, h.LastReconciledOn)
+	require.False(t, h.Reconciling)
+
+	h = newDeploymentHealth([]*runtimev1.Resource{
+		resource("orders", t1, runtimev1.ReconcileStatus_RECONCILE_STATUS_RUNNING, ""),
+	})
+	require.Equal(t, 0, h.ErroredResources)
+	require.Empty(t, h.ParseErrors)
+	require.True(t, h.Reconciling)
+}
+
+func TestDeploymentHealthCache(t *testing.T) {
+	c := newDeploymentHealthCache()
+	_, ok := c.get("d1")
+	require.False(t, ok)
+
+	h := &DeploymentHealth{ErroredResources: 2}
+	c.add("d1", h)
+	res, ok := c.get("d1")
+	require.True(t, ok)
+	require.Equal(t, h, res)
+
+	// Expired entries are not returned
+	c.lru.Add("d2", &deploymentHealthEntry{health: h, fetchedOn: time.Now().Add(-2 * deploymentHealthTTL)})
+	_, ok = c.get("d2")
+	require.False(t, ok)
+}
@@ -2,8 +2,10 @@ package admin
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -14,10 +16,13 @@ import (
 	"github.com/rilldata/rill/admin/provisioner"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/client"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type createDeploymentOptions struct {
@@ -30,6 +35,11 @@ type createDeploymentOptions struct {
 	ProdOLAPDSN    string
 	ProdSlots      int
 	ProdVersion    string
+	// RuntimeConfig contains instance config overrides to apply on top of the project's variables (see database.Deployment.RuntimeConfig).
+	RuntimeConfig map[string]string
+	// HandoffFrom is a deployment whose DuckDB data should be restored into the new deployment (see Options.RedeployHandoff).
+	// Its reconciliation is paused by the handoff, so the caller must tear it down or resume it with resumeDeployment.
+	HandoffFrom *database.Deployment
 }
 
 func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOptions) (*database.Deployment, error) {
@@ -61,12 +71,19 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 	instanceID := strings.ReplaceAll(uuid.New().String(), "-", "")
 	provisionID := instanceID
 
+	// Skip runtime hosts that have stopped sending heartbeats
+	excludeHosts, err := s.unreachableRuntimeHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get a runtime with capacity for the deployment
 	alloc, err := p.Provision(ctx, &provisioner.ProvisionOptions{
 		ProvisionID:    provisionID,
 		RuntimeVersion: runtimeVersion,
 		Slots:          opts.ProdSlots,
 		Annotations:    opts.Annotations.toMap(),
+		ExcludeHosts:   excludeHosts,
 	})
 	if err != nil {
 		s.Logger.Error("provisioner: failed provisioning", zap.String("project_id", opts.ProjectID), zap.String("provisioner", opts.Provisioner), zap.String("provision_id", provisionID), zap.Error(err), observability.ZapCtx(ctx))
@@ -120,6 +137,7 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		RuntimeInstanceID: instanceID,
 		RuntimeAudience:   alloc.Audience,
 		RuntimeVersion:    runtimeVersion,
+		RuntimeConfig:     opts.RuntimeConfig,
 		Status:            database.DeploymentStatusPending,
 	})
 	if err != nil {
@@ -133,6 +151,7 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		err2 := p.Deprovision(ctx, provisionID)
 		// Mark deployment error
 		_, err3 := s.DB.UpdateDeploymentStatus(ctx, depl.ID, database.DeploymentStatusError, err.Error())
+		s.notifyDeploymentFailed(ctx, depl, err)
 		return nil, multierr.Combine(err, err2, err3)
 	}
 
@@ -167,8 +186,18 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		},
 	})
 
+	// Snapshot the previous deployment's data if it should be handed off to the new deployment.
+	// If the snapshot fails, we fall back to deploying without a handoff.
+	var handoffBackupID string
+	if opts.HandoffFrom != nil && olapConnector == "duckdb" {
+		handoffBackupID, err = s.snapshotDeployment(ctx, opts.HandoffFrom)
+		if err != nil {
+			s.Logger.Warn("handoff: failed to snapshot previous deployment, deploying without handoff", zap.String("project_id", opts.ProjectID), zap.String("deployment_id", opts.HandoffFrom.ID), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}
+
 	// Create the instance
-	_, err = rt.CreateInstance(ctx, &runtimev1.CreateInstanceRequest{
+	req := &runtimev1.CreateInstanceRequest{
 		InstanceId:     instanceID,
 		Environment:    "prod",
 		OlapConnector:  olapConnector,
@@ -176,10 +205,15 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		AdminConnector: "admin",
 		AiConnector:    "admin",
 		Connectors:     connectors,
-		Variables:      opts.ProdVariables,
+		Variables:      applyRuntimeConfig(opts.Annotations.applyToVariables(opts.ProdVariables), opts.RuntimeConfig),
 		Annotations:    opts.Annotations.toMap(),
 		EmbedCatalog:   false,
-	})
+	}
+	if handoffBackupID != "" {
+		req.RestoreInstanceId = opts.HandoffFrom.RuntimeInstanceID
+		req.RestoreBackupId = handoffBackupID
+	}
+	err = s.createInstance(ctx, rt, req)
 	if err != nil {
 		err2 := p.Deprovision(ctx, provisionID)
 		err3 := s.DB.DeleteDeployment(ctx, depl.ID)
@@ -193,9 +227,74 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		return nil, err
 	}
 
+	// The handed off data may be stale, so refresh all sources and models in the background.
+	// Refreshed tables are swapped in when they're ready, so the stale data stays available until then.
+	if req.RestoreBackupId != "" {
+		s.Logger.Info("handoff: restored previous deployment's data", zap.String("project_id", opts.ProjectID), zap.String("deployment_id", depl.ID), zap.String("previous_deployment_id", opts.HandoffFrom.ID), zap.String("backup_id", handoffBackupID), observability.ZapCtx(ctx))
+		err = s.TriggerRefreshSources(ctx, depl, nil)
+		if err != nil {
+			s.Logger.Warn("handoff: failed to trigger refresh", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}
+
 	return depl, nil
 }
 
+// createInstance creates a runtime instance.
+// If the request restores a snapshot and the restore fails, it falls back to creating the instance without the snapshot.
+// In that case, it clears the restore fields of req.
+func (s *Service) createInstance(ctx context.Context, rt runtimev1.RuntimeServiceClient, req *runtimev1.CreateInstanceRequest) error {
+	_, err := rt.CreateInstance(ctx, req)
+	if err == nil || req.RestoreBackupId == "" || ctx.Err() != nil {
+		return err
+	}
+
+	s.Logger.Warn("handoff: failed to restore previous deployment's data, deploying without handoff", zap.String("instance_id", req.InstanceId), zap.String("backup_id", req.RestoreBackupId), zap.Error(err), observability.ZapCtx(ctx))
+
+	// The failed restore may have left the instance behind
+	_, err = rt.DeleteInstance(ctx, &runtimev1.DeleteInstanceRequest{InstanceId: req.InstanceId})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return fmt.Errorf("failed to delete instance after failed restore: %w", err)
+	}
+
+	req.RestoreInstanceId = ""
+	req.RestoreBackupId = ""
+	_, err = rt.CreateInstance(ctx, req)
+	return err
+}
+
+// snapshotDeployment creates a snapshot of a deployment's instance that can be restored into another instance, and returns the backup ID.
+// It pauses the instance's reconciliation until the instance is deleted or resumeDeployment is called.
+func (s *Service) snapshotDeployment(ctx context.Context, depl *database.Deployment) (string, error) {
+	rt, err := s.openRuntimeClientForDeployment(depl)
+	if err != nil {
+		return "", err
+	}
+	defer rt.Close()
+
+	res, err := rt.CreateBackup(ctx, &runtimev1.CreateBackupRequest{
+		InstanceId: depl.RuntimeInstanceID,
+		Snapshot:   true,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.Backup.Id, nil
+}
+
+// resumeDeployment resumes the reconciliation of a deployment paused by snapshotDeployment.
+// Failures are only logged since the deployment keeps serving queries while paused.
+func (s *Service) resumeDeployment(ctx context.Context, depl *database.Deployment) {
+	rt, err := s.openRuntimeClientForDeployment(depl)
+	if err == nil {
+		defer rt.Close()
+		_, err = rt.ResumeInstance(ctx, &runtimev1.ResumeInstanceRequest{InstanceId: depl.RuntimeInstanceID})
+	}
+	if err != nil {
+		s.Logger.Error("failed to resume deployment", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+	}
+}
+
 type UpdateDeploymentOptions struct {
 	Version         string
 	Branch          string
@@ -226,6 +325,7 @@ func (s *Service) UpdateDeployment(ctx context.Context, depl *database.Deploymen
 			s.Logger.Error("provisioner: failed awaiting runtime to be ready after update", zap.String("deployment_id", depl.ID), zap.String("provisioner", depl.Provisioner), zap.String("provision_id", depl.ProvisionID), zap.Error(err), observability.ZapCtx(ctx))
 			// Mark deployment error
 			_, err2 := s.DB.UpdateDeploymentStatus(ctx, depl.ID, database.DeploymentStatusError, err.Error())
+			s.notifyDeploymentFailed(ctx, depl, err)
 			return multierr.Combine(err, err2)
 		}
 
@@ -273,7 +373,7 @@ func (s *Service) UpdateDeployment(ctx context.Context, depl *database.Deploymen
 		InstanceId:  depl.RuntimeInstanceID,
 		Connectors:  connectors,
 		Annotations: opts.Annotations.toMap(),
-		Variables:   opts.Variables,
+		Variables:   applyRuntimeConfig(opts.Annotations.applyToVariables(opts.Variables), depl.RuntimeConfig),
 	})
 	if err != nil {
 		return err
@@ -293,6 +393,209 @@ func (s *Service) UpdateDeployment(ctx context.Context, depl *database.Deploymen
 	return nil
 }
 
+// UpdateDeploymentRuntimeConfig replaces the instance config overrides of a deployment and applies them to its instance without redeploying.
+// The overrides are validated with ValidateRuntimeConfig.
+func (s *Service) UpdateDeploymentRuntimeConfig(ctx context.Context, depl *database.Deployment, runtimeConfig map[string]string) (*database.Deployment, error) {
+	err := ValidateRuntimeConfig(runtimeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, err := s.DB.FindProject(ctx, depl.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	depl, err = s.DB.UpdateDeploymentRuntimeConfig(ctx, depl.ID, runtimeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.UpdateDeployment(ctx, depl, &UpdateDeploymentOptions{
+		Version:         depl.RuntimeVersion,
+		Branch:          depl.Branch,
+		Variables:       proj.ProdVariables,
+		Annotations:     s.NewDeploymentAnnotations(org, proj),
+		EvictCachedRepo: false,
+	})
+	if err != nil {
+		// The overrides are persisted, so they'll be applied on the deployment's next update.
+		return nil, err
+	}
+
+	return depl, nil
+}
+
+// ValidateRuntimeConfig validates instance config overrides for a deployment.
+// Only the runtime's "rill." config properties (including "rill.features.<flag>" feature flags) can be overridden, and their values must be valid for the runtime.
+func ValidateRuntimeConfig(runtimeConfig map[string]string) error {
+	for k := range runtimeConfig {
+		if !strings.HasPrefix(k, runtimeConfigPrefix) {
+			return fmt.Errorf("invalid runtime config key %q: must start with %q", k, runtimeConfigPrefix)
+		}
+	}
+
+	inst := &drivers.Instance{Variables: runtimeConfig}
+	_, err := inst.Config()
+	if err != nil {
+		return err
+	}
+	_, err = inst.ResolveFeatureFlags()
+	return err
+}
+
+// MigrateDeployment moves a deployment to a newly provisioned runtime without re-ingesting its data.
+// It creates a snapshot of the deployment's instance, which pauses its reconciliation so the deployment is read-only (but still queryable) during the move.
+// It then creates an instance from the snapshot on the new runtime, points the deployment at it, and tears down the old instance.
+// The snapshot is transferred through the runtimes' backup storage, so the old and new runtime must be configured with the same backup URL.
+// If provisionerName is empty, the deployment's current provisioner is used.
+func (s *Service) MigrateDeployment(ctx context.Context, depl *database.Deployment, provisionerName string) (*database.Deployment, error) {
+	if provisionerName == "" {
+		provisionerName = depl.Provisioner
+	}
+
+	// Get provisioner from the set
+	p, ok := s.ProvisionerSet[provisionerName]
+	if !ok {
+		return nil, fmt.Errorf("provisioner: %q is not in the provisioner set", provisionerName)
+	}
+
+	// Get the config of the current instance
+	src, err := s.openRuntimeClientForDeployment(depl)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	res, err := src.GetInstance(ctx, &runtimev1.GetInstanceRequest{
+		InstanceId: depl.RuntimeInstanceID,
+		Sensitive:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	inst := res.Instance
+
+	// Get a runtime on another reachable host with capacity for the deployment
+	excludeHosts, err := s.unreachableRuntimeHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instanceID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	provisionID := instanceID
+	alloc, err := p.Provision(ctx, &provisioner.ProvisionOptions{
+		ProvisionID:    provisionID,
+		RuntimeVersion: depl.RuntimeVersion,
+		Slots:          depl.Slots,
+		Annotations:    inst.Annotations,
+		ExcludeHosts:   append(excludeHosts, depl.RuntimeHost),
+	})
+	if err != nil {
+		s.Logger.Error("provisioner: failed provisioning", zap.String("deployment_id", depl.ID), zap.String("provisioner", provisionerName), zap.String("provision_id", provisionID), zap.Error(err), observability.ZapCtx(ctx))
+		return nil, err
+	}
+
+	err = p.AwaitReady(ctx, provisionID)
+	if err != nil {
+		s.Logger.Error("provisioner: failed awaiting runtime to be ready", zap.String("deployment_id", depl.ID), zap.String("provisioner", provisionerName), zap.String("provision_id", provisionID), zap.Error(err), observability.ZapCtx(ctx))
+		err2 := p.Deprovision(ctx, provisionID)
+		return nil, multierr.Combine(err, err2)
+	}
+
+	dst, err := s.OpenRuntimeClient(alloc.Host, alloc.Audience)
+	if err != nil {
+		err2 := p.Deprovision(ctx, provisionID)
+		return nil, multierr.Combine(err, err2)
+	}
+	defer dst.Close()
+
+	// Size the DuckDB connector for the new allocation
+	for _, c := range inst.Connectors {
+		if c.Name == "duckdb" && c.Type == "duckdb" {
+			if c.Config == nil {
+				c.Config = make(map[string]string)
+			}
+			c.Config["cpu"] = strconv.Itoa(alloc.CPU)
+			c.Config["memory_limit_gb"] = strconv.Itoa(alloc.MemoryGB)
+			c.Config["storage_limit_bytes"] = strconv.FormatInt(alloc.StorageBytes, 10)
+		}
+	}
+
+	// Snapshot the current instance. This pauses its reconciliation until it's resumed or deleted.
+	snapshot, err := src.CreateBackup(ctx, &runtimev1.CreateBackupRequest{
+		InstanceId: depl.RuntimeInstanceID,
+		Snapshot:   true,
+	})
+	if err != nil {
+		err2 := p.Deprovision(ctx, provisionID)
+		return nil, multierr.Combine(err, err2)
+	}
+
+	// abort cleans up the new instance and resumes the current instance
+	abort := func(err error) error {
+		_, err2 := dst.DeleteInstance(ctx, &runtimev1.DeleteInstanceRequest{InstanceId: instanceID})
+		err3 := p.Deprovision(ctx, provisionID)
+		_, err4 := src.ResumeInstance(ctx, &runtimev1.ResumeInstanceRequest{InstanceId: depl.RuntimeInstanceID})
+		return multierr.Combine(err, err2, err3, err4)
+	}
+
+	// Create the new instance from the snapshot.
+	// The admin connector's access token is tied to the deployment, so it remains valid.
+	_, err = dst.CreateInstance(ctx, &runtimev1.CreateInstanceRequest{
+		InstanceId:        instanceID,
+		Environment:       inst.Environment,
+		OlapConnector:     inst.OlapConnector,
+		RepoConnector:     inst.RepoConnector,
+		AdminConnector:    inst.AdminConnector,
+		AiConnector:       inst.AiConnector,
+		Connectors:        inst.Connectors,
+		Variables:         inst.Variables,
+		Annotations:       inst.Annotations,
+		EmbedCatalog:      inst.EmbedCatalog,
+		WatchRepo:         inst.WatchRepo,
+		RestoreInstanceId: depl.RuntimeInstanceID,
+		RestoreBackupId:   snapshot.Backup.Id,
+	})
+	if err != nil {
+		return nil, abort(err)
+	}
+
+	// Point the deployment at the new instance
+	newDepl, err := s.DB.UpdateDeploymentRuntime(ctx, depl.ID, &database.UpdateDeploymentRuntimeOptions{
+		Provisioner:       provisionerName,
+		ProvisionID:       provisionID,
+		RuntimeHost:       alloc.Host,
+		RuntimeInstanceID: instanceID,
+		RuntimeAudience:   alloc.Audience,
+	})
+	if err != nil {
+		return nil, abort(err)
+	}
+
+	s.Logger.Info("migrated deployment", zap.String("deployment_id", depl.ID), zap.String("from_host", depl.RuntimeHost), zap.String("to_host", alloc.Host), zap.String("runtime_instance_id", instanceID), zap.String("backup_id", snapshot.Backup.Id), observability.ZapCtx(ctx))
+
+	// Tear down the old instance. Failures are only logged since the deployment has already moved.
+	_, err = src.DeleteInstance(ctx, &runtimev1.DeleteInstanceRequest{
+		InstanceId: depl.RuntimeInstanceID,
+	})
+	if err != nil {
+		s.Logger.Error("failed to delete instance", zap.String("deployment_id", depl.ID), zap.String("runtime_instance_id", depl.RuntimeInstanceID), zap.Error(err), observability.ZapCtx(ctx))
+	}
+	if oldP, ok := s.ProvisionerSet[depl.Provisioner]; ok {
+		err = oldP.Deprovision(ctx, depl.ProvisionID)
+		if err != nil {
+			s.Logger.Error("provisioner: failed to deprovision", zap.String("deployment_id", depl.ID), zap.String("provisioner", depl.Provisioner), zap.String("provision_id", depl.ProvisionID), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}
+
+	return newDepl, nil
+}
+
 // HibernateDeployments tears down unused deployments
 func (s *Service) HibernateDeployments(ctx context.Context) error {
 	depls, err := s.DB.FindExpiredDeployments(ctx)
@@ -337,6 +640,11 @@ func (s *Service) HibernateDeployments(ctx context.Context) error {
 			ProdTTLSeconds:       proj.ProdTTLSeconds,
 			ProdDeploymentID:     nil,
 			Annotations:          proj.Annotations,
+			Labels:               proj.Labels,
+			DeployTrigger:        proj.DeployTrigger,
+			DeploySchedule:       proj.DeploySchedule,
+			DeployBlueGreen:      proj.DeployBlueGreen,
+			MaintenanceWindow:    proj.MaintenanceWindow,
 		})
 		if err != nil {
 			return err
@@ -358,7 +666,13 @@ func (s *Service) OpenRuntimeClient(host, audience string) (*client.Client, erro
 		return nil, err
 	}
 
-	rt, err := client.New(host, jwt)
+	// Get a fresh TLS config for each client, so rotated certificates are picked up
+	var tlsConfig *tls.Config
+	if s.runtimeMTLS != nil {
+		tlsConfig = s.runtimeMTLS.ClientConfig()
+	}
+
+	rt, err := client.NewWithTLS(host, jwt, tlsConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -366,6 +680,8 @@ func (s *Service) OpenRuntimeClient(host, audience string) (*client.Client, erro
 	return rt, nil
 }
 
+// TeardownDeployment deletes a deployment and enqueues a job that deletes its runtime instance and deprovisions its resources.
+// If the job can't be enqueued, the deployment is deprovisioned synchronously.
 func (s *Service) TeardownDeployment(ctx context.Context, depl *database.Deployment) error {
 	// Delete the deployment
 	err := s.DB.DeleteDeployment(ctx, depl.ID)
@@ -373,31 +689,24 @@ func (s *Service) TeardownDeployment(ctx context.Context, depl *database.Deploym
 		return err
 	}
 
-	// Connect to the deployment's runtime and delete the instance
-	rt, err := s.openRuntimeClientForDeployment(depl)
-	if err != nil {
-		s.Logger.Error("failed to open runtime client", zap.String("deployment_id", depl.ID), zap.String("runtime_instance_id", depl.RuntimeInstanceID), zap.Error(err), observability.ZapCtx(ctx))
-	} else {
-		defer rt.Close()
-		_, err = rt.DeleteInstance(ctx, &runtimev1.DeleteInstanceRequest{
-			InstanceId: depl.RuntimeInstanceID,
-		})
-		if err != nil {
-			s.Logger.Error("failed to delete instance", zap.String("deployment_id", depl.ID), zap.String("runtime_instance_id", depl.RuntimeInstanceID), zap.Error(err), observability.ZapCtx(ctx))
-		}
+	args := &DeprovisionDeploymentJobArgs{
+		DeploymentID:      depl.ID,
+		RuntimeHost:       depl.RuntimeHost,
+		RuntimeAudience:   depl.RuntimeAudience,
+		RuntimeInstanceID: depl.RuntimeInstanceID,
+		Provisioner:       depl.Provisioner,
+		ProvisionID:       depl.ProvisionID,
 	}
-
-	// Get provisioner and deprovision, skip if the provisioner is no longer defined in the provisioner set
-	p, ok := s.ProvisionerSet[depl.Provisioner]
-	if ok {
-		err = p.Deprovision(ctx, depl.ProvisionID)
-		if err != nil {
-			s.Logger.Error("provisioner: failed to deprovision", zap.String("deployment_id", depl.ID), zap.String("provisioner", depl.Provisioner), zap.String("provision_id", depl.ProvisionID), zap.Error(err), observability.ZapCtx(ctx))
-		}
-	} else {
-		s.Logger.Warn("provisioner: deprovisioning skipped, provisioner not found", zap.String("deployment_id", depl.ID), zap.String("provisioner", depl.Provisioner), zap.String("provision_id", depl.ProvisionID), zap.Error(err), observability.ZapCtx(ctx))
+	_, err = s.EnqueueJob(ctx, JobKindDeprovisionDeployment, args)
+	if err == nil {
+		return nil
 	}
+	s.Logger.Error("failed to enqueue deprovision job", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
 
+	err = s.deprovisionDeployment(ctx, args)
+	if err != nil {
+		s.Logger.Error("failed to deprovision deployment", zap.String("deployment_id", depl.ID), zap.String("runtime_instance_id", depl.RuntimeInstanceID), zap.Error(err), observability.ZapCtx(ctx))
+	}
 	return nil
 }
 
@@ -406,23 +715,34 @@ func (s *Service) openRuntimeClientForDeployment(d *database.Deployment) (*clien
 }
 
 type DeploymentAnnotations struct {
-	orgID           string
-	orgName         string
-	projID          string
-	projName        string
-	projAnnotations map[string]string
+	orgID             string
+	orgName           string
+	projID            string
+	projName          string
+	projAnnotations   map[string]string
+	maintenanceWindow string
 }
 
 func (s *Service) NewDeploymentAnnotations(org *database.Organization, proj *database.Project) DeploymentAnnotations {
 	return DeploymentAnnotations{
-		orgID:           org.ID,
-		orgName:         org.Name,
-		projID:          proj.ID,
-		projName:        proj.Name,
-		projAnnotations: proj.Annotations,
+		orgID:             org.ID,
+		orgName:           org.Name,
+		projID:            proj.ID,
+		projName:          proj.Name,
+		projAnnotations:   proj.Annotations,
+		maintenanceWindow: MaintenanceWindow(org, proj),
 	}
 }
 
+// MaintenanceWindow returns the maintenance window that applies to a project's deployments.
+// The project's maintenance window takes precedence over the org's. It returns an empty string if neither is set.
+func MaintenanceWindow(org *database.Organization, proj *database.Project) string {
+	if proj.MaintenanceWindow != "" {
+		return proj.MaintenanceWindow
+	}
+	return org.MaintenanceWindow
+}
+
 func (s *Service) ResolveLatestRuntimeVersion() string {
 	if s.VersionNumber != "" {
 		return s.VersionNumber
@@ -433,6 +753,23 @@ func (s *Service) ResolveLatestRuntimeVersion() string {
 	return "latest"
 }
 
+// AvailableRuntimeVersions returns the runtime versions that projects can be pinned to, including the latest version.
+// It returns nil if projects can be pinned to any valid version.
+func (s *Service) AvailableRuntimeVersions() []string {
+	if s.opts == nil || len(s.opts.RuntimeVersions) == 0 {
+		return nil
+	}
+
+	latest := s.ResolveLatestRuntimeVersion()
+	res := []string{latest}
+	for _, v := range s.opts.RuntimeVersions {
+		if v != latest && !slices.Contains(res, v) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
 func (s *Service) ValidateRuntimeVersion(ver string) error {
 	// Verify version is a valid SemVer, a full Git commit hash or 'latest'
 	if ver != "latest" {
@@ -447,11 +784,56 @@ func (s *Service) ValidateRuntimeVersion(ver string) error {
 				return fmt.Errorf("not a valid version %q", ver)
 			}
 		}
+
+		// Verify the version is available for pinning
+		available := s.AvailableRuntimeVersions()
+		if available != nil && !slices.Contains(available, ver) {
+			return fmt.Errorf("version %q is not available (available versions: %s)", ver, strings.Join(available, ", "))
+		}
 	}
 
 	return nil
 }
 
+// applyToVariables returns the variables to set on the deployment's instance.
+// It passes the maintenance window to the runtime, unless the project's variables explicitly configure one.
+func (da *DeploymentAnnotations) applyToVariables(vars map[string]string) map[string]string {
+	if da.maintenanceWindow == "" {
+		return vars
+	}
+	if _, ok := vars[maintenanceWindowVariable]; ok {
+		return vars
+	}
+	res := make(map[string]string, len(vars)+1)
+	for k, v := range vars {
+		res[k] = v
+	}
+	res[maintenanceWindowVariable] = da.maintenanceWindow
+	return res
+}
+
+// applyRuntimeConfig returns the variables with the deployment's instance config overrides applied on top.
+// The overrides take precedence over both the project's variables and variables derived from annotations.
+func applyRuntimeConfig(vars, runtimeConfig map[string]string) map[string]string {
+	if len(runtimeConfig) == 0 {
+		return vars
+	}
+	res := make(map[string]string, len(vars)+len(runtimeConfig))
+	for k, v := range vars {
+		res[k] = v
+	}
+	for k, v := range runtimeConfig {
+		res[k] = v
+	}
+	return res
+}
+
+// runtimeConfigPrefix is the prefix of the runtime instance variables that configure the runtime (see drivers.InstanceConfig).
+const runtimeConfigPrefix = "rill."
+
+// maintenanceWindowVariable is the runtime instance variable that restricts scheduled refreshes to a maintenance window.
+const maintenanceWindowVariable = "rill.refresh.maintenance_window"
+
 func (da *DeploymentAnnotations) toMap() map[string]string {
 	res := make(map[string]string, len(da.projAnnotations)+4)
 	for k, v := range da.projAnnotations {
@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidateRuntimeVersion(t *testing.T) {
+	s := &Service{VersionNumber: "0.48.0", opts: &Options{}}
+	require.Nil(t, s.AvailableRuntimeVersions())
+	require.NoError(t, s.ValidateRuntimeVersion("latest"))
+	require.NoError(t, s.ValidateRuntimeVersion("0.40.1"))
+	require.Error(t, s.ValidateRuntimeVersion("not-a-version"))
+
+	s.opts.RuntimeVersions = []string{"0.47.0", "0.48.0"}
+	require.Equal(t, []string{"0.48.0", "0.47.0"}, s.AvailableRuntimeVersions())
+	require.NoError(t, s.ValidateRuntimeVersion("latest"))
+	require.NoError(t, s.ValidateRuntimeVersion("0.47.0"))
+	require.NoError(t, s.ValidateRuntimeVersion("0.48.0"))
+	require.Error(t, s.ValidateRuntimeVersion("0.40.1"))
+}
+
+func TestValidateRuntimeConfig(t *testing.T) {
+	require.NoError(t, ValidateRuntimeConfig(nil))
+	require.NoError(t, ValidateRuntimeConfig(map[string]string{
+		"rill.metrics.max_execution_timeout_seconds": "60",
+		"rill.features.exports":                      "false",
+	}))
+	require.Error(t, ValidateRuntimeConfig(map[string]string{"foo": "bar"}))
+	require.Error(t, ValidateRuntimeConfig(map[string]string{"rill.metrics.max_execution_timeout_seconds": "soon"}))
+	require.Error(t, ValidateRuntimeConfig(map[string]string{"rill.features.exports": "maybe"}))
+}
+
+func TestApplyRuntimeConfig(t *testing.T) {
+	vars := map[string]string{"foo": "bar", "rill.stage_changes": "true"}
+	require.Equal(t, vars, applyRuntimeConfig(vars, nil))
+
+	res := applyRuntimeConfig(vars, map[string]string{"rill.stage_changes": "false"})
+	require.Equal(t, map[string]string{"foo": "bar", "rill.stage_changes": "false"}, res)
+	require.Equal(t, "true", vars["rill.stage_changes"])
+}
+
+func TestCreateInstanceRestoreFallback(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{Logger: zap.NewNop()}
+	newReq := func() *runtimev1.CreateInstanceRequest {
+		return &runtimev1.CreateInstanceRequest{
+			InstanceId:        "new",
+			RestoreInstanceId: "old",
+			RestoreBackupId:   "backup",
+		}
+	}
+
+	// A successful restore is not retried
+	rt := &mockInstanceClient{}
+	req := newReq()
+	require.NoError(t, s.createInstance(ctx, rt, req))
+	require.Len(t, rt.created, 1)
+	require.Equal(t, "backup", req.RestoreBackupId)
+
+	// A failed restore is retried without the snapshot
+	rt = &mockInstanceClient{restoreErr: errors.New("restore failed")}
+	req = newReq()
+	require.NoError(t, s.createInstance(ctx, rt, req))
+	require.Len(t, rt.created, 2)
+	require.Equal(t, []string{"new"}, rt.deleted)
+	require.Empty(t, rt.created[1].RestoreInstanceId)
+	require.Empty(t, rt.created[1].RestoreBackupId)
+	require.Empty(t, req.RestoreBackupId)
+
+	// Failures unrelated to a restore are not retried
+	rt = &mockInstanceClient{createErr: errors.New("create failed")}
+	req = newReq()
+	req.RestoreInstanceId = ""
+	req.RestoreBackupId = ""
+	require.Error(t, s.createInstance(ctx, rt, req))
+	require.Len(t, rt.created, 1)
+	require.Empty(t, rt.deleted)
+}
+
+// mockInstanceClient is a runtime client that records CreateInstance and DeleteInstance calls.
+type mockInstanceClient struct {
+	runtimev1.RuntimeServiceClient
+	restoreErr error
+	createErr  error
+	created    []*runtimev1.CreateInstanceRequest
+	deleted    []string
+}
+
+func (c *mockInstanceClient) CreateInstance(ctx context.Context, req *runtimev1.CreateInstanceRequest, opts ...grpc.CallOption) (*runtimev1.CreateInstanceResponse, error) {
+	c.created = append(c.created, proto.Clone(req).(*runtimev1.CreateInstanceRequest))
+	if req.RestoreBackupId != "" {
+		return nil, c.restoreErr
+	}
+	return &runtimev1.CreateInstanceResponse{}, c.createErr
+}
+
+func (c *mockInstanceClient) DeleteInstance(ctx context.Context, req *runtimev1.DeleteInstanceRequest, opts ...grpc.CallOption) (*runtimev1.DeleteInstanceResponse, error) {
+	c.deleted = append(c.deleted, req.InstanceId)
+	return nil, status.Error(codes.NotFound, "instance not found")
+}
@@ -209,6 +209,12 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 		return nil
 	}
 
+	// The commit that was pushed (empty if the branch was deleted)
+	sha := event.GetAfter()
+	if event.GetDeleted() {
+		sha = ""
+	}
+
 	// Iterate over all projects and trigger reconcile
 	for _, project := range projects {
 		if branch != project.ProdBranch {
@@ -216,6 +222,18 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 			continue
 		}
 
+		// Hold the push if the project doesn't deploy on push. It will be deployed when approved or on schedule.
+		if project.DeployTrigger != database.DeployTriggerPush {
+			if sha == "" {
+				continue
+			}
+			err = s.holdGithubPush(ctx, project, event.GetBefore(), sha)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
 		// Trigger reconcile (runs in the background)
 		if project.ProdDeploymentID != nil {
 			depl, err := s.DB.FindDeployment(ctx, *project.ProdDeploymentID)
@@ -224,10 +242,18 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 				continue
 			}
 
-			err = s.TriggerReconcile(ctx, depl)
+			// Capture the deployment's state before the reconcile, so we can report the changes back to Github
+			gd := s.prepareGithubDeploy(ctx, project, depl, sha)
+
+			depl, err = s.deployCommit(ctx, project, depl)
 			if err != nil {
 				return err
 			}
+
+			if gd != nil {
+				gd.depl = depl
+				go s.reportGithubDeploy(gd)
+			}
 		}
 	}
 
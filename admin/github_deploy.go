@@ -0,0 +1,378 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v50/github"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/gitutil"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"go.uber.org/zap"
+)
+
+const (
+	// githubDeployStatusContext is the context of the commit statuses posted for deploys.
+	githubDeployStatusContext = "rill/deploy"
+	// githubDeployTimeout is the maximum time to wait for a deploy to finish reconciling before reporting it as errored.
+	githubDeployTimeout = 30 * time.Minute
+	// githubDeployPollInterval is the interval between checks of a deploy's reconcile status.
+	githubDeployPollInterval = 5 * time.Second
+	// githubDeployMaxListed is the maximum number of resources or errors listed in a PR comment.
+	githubDeployMaxListed = 50
+)
+
+// githubDeploy tracks a redeploy triggered by a push, so its result can be reported back to Github.
+type githubDeploy struct {
+	project *database.Project
+	depl    *database.Deployment
+	sha     string
+	// specVersions contains the spec version of each non-hidden resource before the deploy was triggered.
+	specVersions map[string]int64
+	// prevCommitSha is the commit the deployment was on before the deploy was triggered.
+	prevCommitSha string
+}
+
+// prepareGithubDeploy captures the state of a deployment before a reconcile is triggered for a push.
+// It returns nil if deploy feedback is disabled or not possible for the project.
+func (s *Service) prepareGithubDeploy(ctx context.Context, proj *database.Project, depl *database.Deployment, sha string) *githubDeploy {
+	if !s.opts.GithubDeployFeedback || sha == "" || proj.GithubURL == nil || proj.GithubInstallationID == nil {
+		return nil
+	}
+
+	resources, err := s.listDeploymentResources(ctx, depl)
+	if err != nil {
+		s.Logger.Warn("github deploy: failed to list resources", zap.String("project_id", proj.ID), zap.Error(err))
+		return nil
+	}
+
+	gd := &githubDeploy{
+		project:      proj,
+		depl:         depl,
+		sha:          sha,
+		specVersions: make(map[string]int64, len(resources)),
+	}
+	for _, r := range resources {
+		if pp := r.GetProjectParser(); pp != nil {
+			gd.prevCommitSha = pp.State.CurrentCommitSha
+			continue
+		}
+		if r.Meta.Hidden {
+			continue
+		}
+		gd.specVersions[githubDeployResourceKey(r.Meta.Name)] = r.Meta.SpecVersion
+	}
+	return gd
+}
+
+// reportGithubDeploy posts a pending commit status for the deploy, waits for the deployment to finish reconciling the pushed commit,
+// and then updates the commit status and comments on the pull requests containing the commit with a summary of the deploy.
+// It runs in the background, so errors are only logged.
+func (s *Service) reportGithubDeploy(gd *githubDeploy) {
+	ctx, cancel := context.WithTimeout(context.Background(), githubDeployTimeout+time.Minute)
+	defer cancel()
+
+	logger := s.Logger.With(zap.String("project_id", gd.project.ID), zap.String("deployment_id", gd.depl.ID), zap.String("sha", gd.sha))
+
+	owner, repo, ok := gitutil.SplitGithubURL(*gd.project.GithubURL)
+	if !ok {
+		logger.Warn("github deploy: invalid github url", zap.String("github_url", *gd.project.GithubURL))
+		return
+	}
+
+	client, err := s.Github.InstallationClient(*gd.project.GithubInstallationID)
+	if err != nil {
+		logger.Warn("github deploy: failed to create installation client", zap.Error(err))
+		return
+	}
+
+	targetURL := s.githubDeployTargetURL(ctx, gd.project)
+
+	err = createGithubDeployStatus(ctx, client, owner, repo, gd.sha, "pending", "Deploying to Rill", targetURL)
+	if err != nil {
+		logger.Warn("github deploy: failed to create pending status", zap.Error(err))
+		return
+	}
+
+	summary, err := s.awaitGithubDeploy(ctx, gd)
+	if err != nil {
+		logger.Warn("github deploy: failed to await deploy", zap.Error(err))
+		err = createGithubDeployStatus(ctx, client, owner, repo, gd.sha, "error", truncateGithubDeployDescription(err.Error()), targetURL)
+		if err != nil {
+			logger.Warn("github deploy: failed to create error status", zap.Error(err))
+		}
+		return
+	}
+
+	err = createGithubDeployStatus(ctx, client, owner, repo, gd.sha, summary.state(), summary.description(), targetURL)
+	if err != nil {
+		logger.Warn("github deploy: failed to create status", zap.Error(err))
+	}
+
+	prs, _, err := client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, gd.sha, nil)
+	if err != nil {
+		logger.Warn("github deploy: failed to list pull requests for commit", zap.Error(err))
+		return
+	}
+	body := summary.markdown(gd.sha, targetURL)
+	for _, pr := range prs {
+		_, _, err := client.Issues.CreateComment(ctx, owner, repo, pr.GetNumber(), &github.IssueComment{Body: github.String(body)})
+		if err != nil {
+			logger.Warn("github deploy: failed to comment on pull request", zap.Int("pull_request", pr.GetNumber()), zap.Error(err))
+		}
+	}
+}
+
+// awaitGithubDeploy polls the deployment until the project parser has reconciled the pushed commit and all resources are idle.
+func (s *Service) awaitGithubDeploy(ctx context.Context, gd *githubDeploy) (*githubDeploySummary, error) {
+	ctx, cancel := context.WithTimeout(ctx, githubDeployTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(githubDeployPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for the deploy to finish")
+		case <-ticker.C:
+		}
+
+		resources, err := s.listDeploymentResources(ctx, gd.depl)
+		if err != nil {
+			// The runtime may be temporarily unavailable, so keep polling
+			continue
+		}
+
+		var commitSha string
+		idle := true
+		for _, r := range resources {
+			if pp := r.GetProjectParser(); pp != nil {
+				commitSha = pp.State.CurrentCommitSha
+			}
+			if r.Meta.ReconcileStatus != runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE {
+				idle = false
+			}
+		}
+
+		if commitSha != gd.sha && commitSha != gd.prevCommitSha {
+			return nil, fmt.Errorf("superseded by a newer commit")
+		}
+		if commitSha == gd.sha && idle {
+			return newGithubDeploySummary(gd.specVersions, resources), nil
+		}
+	}
+}
+
+func (s *Service) listDeploymentResources(ctx context.Context, depl *database.Deployment) ([]*runtimev1.Resource, error) {
+	rt, err := s.openRuntimeClientForDeployment(depl)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Close()
+
+	res, err := rt.ListResources(ctx, &runtimev1.ListResourcesRequest{InstanceId: depl.RuntimeInstanceID})
+	if err != nil {
+		return nil, err
+	}
+	return res.Resources, nil
+}
+
+// githubDeployTargetURL returns the URL of the project in the frontend, or an empty string if it's not known.
+func (s *Service) githubDeployTargetURL(ctx context.Context, proj *database.Project) string {
+	if s.opts.FrontendURL == "" {
+		return ""
+	}
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return ""
+	}
+	return urlutil.MustJoinURL(s.opts.FrontendURL, org.Name, proj.Name)
+}
+
+func createGithubDeployStatus(ctx context.Context, client *github.Client, owner, repo, sha, state, description, targetURL string) error {
+	status := &github.RepoStatus{
+		State:       github.String(state),
+		Description: github.String(description),
+		Context:     github.String(githubDeployStatusContext),
+	}
+	if targetURL != "" {
+		status.TargetURL = github.String(targetURL)
+	}
+	_, _, err := client.Repositories.CreateStatus(ctx, owner, repo, sha, status)
+	return err
+}
+
+// truncateGithubDeployDescription truncates a commit status description to the maximum length accepted by Github.
+func truncateGithubDeployDescription(s string) string {
+	if len(s) > 140 {
+		return s[:137] + "..."
+	}
+	return s
+}
+
+// githubDeploySummary summarizes the result of a deploy.
+type githubDeploySummary struct {
+	Created         []string
+	Updated         []string
+	Deleted         []string
+	ParseErrors     []*runtimev1.ParseError
+	ReconcileErrors []githubDeployReconcileError
+}
+
+type githubDeployReconcileError struct {
+	Resource string
+	Error    string
+}
+
+// newGithubDeploySummary builds a summary by comparing resources after the deploy with their spec versions before the deploy.
+// Hidden resources and the project parser are not reported as changed.
+func newGithubDeploySummary(specVersions map[string]int64, resources []*runtimev1.Resource) *githubDeploySummary {
+	s := &githubDeploySummary{}
+	seen := make(map[string]bool, len(resources))
+	for _, r := range resources {
+		key := githubDeployResourceKey(r.Meta.Name)
+		seen[key] = true
+
+		if pp := r.GetProjectParser(); pp != nil {
+			s.ParseErrors = append(s.ParseErrors, pp.State.ParseErrors...)
+			continue
+		}
+		if r.Meta.Hidden {
+			continue
+		}
+
+		prev, ok := specVersions[key]
+		if !ok {
+			s.Created = append(s.Created, key)
+		} else if prev != r.Meta.SpecVersion {
+			s.Updated = append(s.Updated, key)
+		}
+
+		if r.Meta.ReconcileError != "" {
+			s.ReconcileErrors = append(s.ReconcileErrors, githubDeployReconcileError{Resource: key, Error: r.Meta.ReconcileError})
+		}
+	}
+	for key := range specVersions {
+		if !seen[key] {
+			s.Deleted = append(s.Deleted, key)
+		}
+	}
+
+	sort.Strings(s.Created)
+	sort.Strings(s.Updated)
+	sort.Strings(s.Deleted)
+	sort.Slice(s.ReconcileErrors, func(i, j int) bool { return s.ReconcileErrors[i].Resource < s.ReconcileErrors[j].Resource })
+	return s
+}
+
+// state returns the commit status state for the deploy.
+func (s *githubDeploySummary) state() string {
+	if len(s.ParseErrors) > 0 || len(s.ReconcileErrors) > 0 {
+		return "failure"
+	}
+	return "success"
+}
+
+// description returns a short description for the commit status.
+func (s *githubDeploySummary) description() string {
+	if s.state() == "failure" {
+		return truncateGithubDeployDescription(fmt.Sprintf("Deployed with %d parse errors and %d reconcile errors", len(s.ParseErrors), len(s.ReconcileErrors)))
+	}
+	changed := len(s.Created) + len(s.Updated) + len(s.Deleted)
+	if changed == 1 {
+		return "Deployed 1 changed resource"
+	}
+	return fmt.Sprintf("Deployed %d changed resources", changed)
+}
+
+// markdown returns a Markdown summary of the deploy for a PR comment.
+func (s *githubDeploySummary) markdown(sha, targetURL string) string {
+	b := &strings.Builder{}
+
+	if s.state() == "success" {
+		fmt.Fprintf(b, "### :white_check_mark: Rill deploy succeeded for %s\n\n", shortGithubSha(sha))
+	} else {
+		fmt.Fprintf(b, "### :x: Rill deploy failed for %s\n\n", shortGithubSha(sha))
+	}
+	if targetURL != "" {
+		fmt.Fprintf(b, "[Open project](%s)\n\n", targetURL)
+	}
+
+	if len(s.Created)+len(s.Updated)+len(s.Deleted) == 0 {
+		b.WriteString("No resources changed.\n")
+	} else {
+		b.WriteString("**Changed resources**\n\n")
+		n := 0
+		write := func(change string, keys []string) {
+			for _, k := range keys {
+				if n == githubDeployMaxListed {
+					return
+				}
+				fmt.Fprintf(b, "- `%s` (%s)\n", k, change)
+				n++
+			}
+		}
+		write("created", s.Created)
+		write("updated", s.Updated)
+		write("deleted", s.Deleted)
+		if total := len(s.Created) + len(s.Updated) + len(s.Deleted); total > n {
+			fmt.Fprintf(b, "- ...and %d more\n", total-n)
+		}
+	}
+
+	if len(s.ParseErrors) > 0 {
+		b.WriteString("\n**Parse errors**\n\n")
+		for i, pe := range s.ParseErrors {
+			if i == githubDeployMaxListed {
+				fmt.Fprintf(b, "- ...and %d more\n", len(s.ParseErrors)-i)
+				break
+			}
+			if pe.FilePath != "" {
+				fmt.Fprintf(b, "- `%s`: %s\n", pe.FilePath, singleLine(pe.Message))
+			} else {
+				fmt.Fprintf(b, "- %s\n", singleLine(pe.Message))
+			}
+		}
+	}
+
+	if len(s.ReconcileErrors) > 0 {
+		b.WriteString("\n**Reconcile errors**\n\n")
+		for i, re := range s.ReconcileErrors {
+			if i == githubDeployMaxListed {
+				fmt.Fprintf(b, "- ...and %d more\n", len(s.ReconcileErrors)-i)
+				break
+			}
+			fmt.Fprintf(b, "- `%s`: %s\n", re.Resource, singleLine(re.Error))
+		}
+	}
+
+	return b.String()
+}
+
+// githubDeployResourceKey returns a readable identifier for a resource, like "Model/orders".
+func githubDeployResourceKey(n *runtimev1.ResourceName) string {
+	return githubDeployKindName(n.Kind) + "/" + n.Name
+}
+
+// githubDeployKindName strips the package prefix from a resource kind, e.g. "rill.runtime.v1.Model" becomes "Model".
+func githubDeployKindName(kind string) string {
+	if i := strings.LastIndex(kind, "."); i >= 0 {
+		return kind[i+1:]
+	}
+	return kind
+}
+
+func shortGithubSha(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
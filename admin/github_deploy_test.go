@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGithubDeploySummary(t *testing.T) {
+	resource := func(kind, name string, specVersion int64, reconcileErr string) *runtimev1.Resource {
+		return &runtimev1.Resource{
+			Meta: &runtimev1.ResourceMeta{
+				Name:           &runtimev1.ResourceName{Kind: kind, Name: name},
+				SpecVersion:    specVersion,
+				ReconcileError: reconcileErr,
+			},
+		}
+	}
+
+	parser := resource("rill.runtime.v1.ProjectParser", "parser", 3, "")
+	parser.Resource = &runtimev1.Resource_ProjectParser{
+		ProjectParser: &runtimev1.ProjectParser{
+			State: &runtimev1.ProjectParserState{
+				ParseErrors: []*runtimev1.ParseError{{Message: "syntax error\nat line 2", FilePath: "/models/bad.sql"}},
+			},
+		},
+	}
+
+	before := map[string]int64{
+		"Source/raw":     1,
+		"Model/orders":   1,
+		"Model/obsolete": 1,
+	}
+	after := []*runtimev1.Resource{
+		parser,
+		resource("rill.runtime.v1.Source", "raw", 1, ""),
+		resource("rill.runtime.v1.Model", "orders", 2, "table not found"),
+		resource("rill.runtime.v1.MetricsView", "orders_metrics", 1, ""),
+	}
+
+	s := newGithubDeploySummary(before, after)
+	require.Equal(t, []string{"MetricsView/orders_metrics"}, s.Created)
+	require.Equal(t, []string{"Model/orders"}, s.Updated)
+	require.Equal(t, []string{"Model/obsolete"}, s.Deleted)
+	require.Len(t, s.ParseErrors, 1)
+	require.Equal(t, []githubDeployReconcileError{{Resource: "Model/orders", Error: "table not found"}}, s.ReconcileErrors)
+	require.Equal(t, "failure", s.state())
+	require.Equal(t, "Deployed with 1 parse errors and 1 reconcile errors", s.description())
+
+	md := s.markdown("0123456789abcdef", "https://ui.rilldata.com/org/proj")
+	require.Contains(t, md, "Rill deploy failed for 0123456")
+	require.Contains(t, md, "[Open project](https://ui.rilldata.com/org/proj)")
+	require.Contains(t, md, "- `MetricsView/orders_metrics` (created)")
+	require.Contains(t, md, "- `Model/obsolete` (deleted)")
+	require.Contains(t, md, "- `/models/bad.sql`: syntax error at line 2")
+	require.Contains(t, md, "- `Model/orders`: table not found")
+
+	s = newGithubDeploySummary(before, after[1:2])
+	require.Equal(t, "success", (&githubDeploySummary{Updated: []string{"Model/orders"}}).state())
+	require.Equal(t, "Deployed 1 changed resource", (&githubDeploySummary{Updated: []string{"Model/orders"}}).description())
+	require.Equal(t, []string{"Model/obsolete", "Model/orders"}, s.Deleted)
+}
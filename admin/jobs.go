@@ -0,0 +1,155 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Kinds of jobs in the job queue.
+const (
+	JobKindDeprovisionDeployment  = "deprovision_deployment"
+	JobKindSendOrganizationInvite = "send_organization_invite"
+	JobKindSendProjectInvite      = "send_project_invite"
+	JobKindPromoteDeployment      = "promote_deployment"
+)
+
+// jobMaxAttempts is the number of times a job is attempted before it's marked as failed.
+const jobMaxAttempts = 5
+
+// DeprovisionDeploymentJobArgs are the args for a JobKindDeprovisionDeployment job.
+// They contain the details of the deployment since its database row has already been deleted when the job runs.
+type DeprovisionDeploymentJobArgs struct {
+	DeploymentID      string `json:"deployment_id"`
+	RuntimeHost       string `json:"runtime_host"`
+	RuntimeAudience   string `json:"runtime_audience"`
+	RuntimeInstanceID string `json:"runtime_instance_id"`
+	Provisioner       string `json:"provisioner"`
+	ProvisionID       string `json:"provision_id"`
+}
+
+// EnqueueJob adds a job to the job queue. The args are encoded as JSON.
+// The job is run asynchronously by the admin worker.
+func (s *Service) EnqueueJob(ctx context.Context, kind string, args any) (*database.Job, error) {
+	return s.enqueueJob(ctx, kind, args, time.Time{})
+}
+
+// enqueueJob adds a job to the job queue that won't run before runAfter. A zero runAfter runs the job as soon as possible.
+func (s *Service) enqueueJob(ctx context.Context, kind string, args any, runAfter time.Time) (*database.Job, error) {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode job args: %w", err)
+	}
+
+	job, err := s.DB.InsertJob(ctx, &database.InsertJobOptions{
+		Kind:        kind,
+		Args:        string(data),
+		MaxAttempts: jobMaxAttempts,
+		RunAfter:    runAfter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("enqueued job", zap.String("job_id", job.ID), zap.String("kind", kind), observability.ZapCtx(ctx))
+	return job, nil
+}
+
+// ExecuteJob runs a job from the job queue. It is called by the admin worker.
+func (s *Service) ExecuteJob(ctx context.Context, job *database.Job) error {
+	switch job.Kind {
+	case JobKindDeprovisionDeployment:
+		args := &DeprovisionDeploymentJobArgs{}
+		if err := json.Unmarshal([]byte(job.Args), args); err != nil {
+			return err
+		}
+		return s.deprovisionDeployment(ctx, args)
+	case JobKindSendOrganizationInvite:
+		args := &email.OrganizationInvite{}
+		if err := json.Unmarshal([]byte(job.Args), args); err != nil {
+			return err
+		}
+		err := s.Email.SendOrganizationInvite(args)
+		if err != nil {
+			return err
+		}
+		s.notifyOrganizationByName(ctx, args.OrgName, &Notification{
+			Event: NotificationEventInvite,
+			Title: "User invited",
+			Body:  fmt.Sprintf("%s invited %s to join the organization as %s", args.InvitedByName, args.ToEmail, args.RoleName),
+			Link:  urlutil.MustJoinURL(args.FrontendURL, args.OrgName),
+		})
+		return nil
+	case JobKindSendProjectInvite:
+		args := &email.ProjectInvite{}
+		if err := json.Unmarshal([]byte(job.Args), args); err != nil {
+			return err
+		}
+		err := s.Email.SendProjectInvite(args)
+		if err != nil {
+			return err
+		}
+		s.notifyOrganizationByName(ctx, args.OrgName, &Notification{
+			Event: NotificationEventInvite,
+			Title: "User invited",
+			Body:  fmt.Sprintf("%s invited %s to the %s project as %s", args.InvitedByName, args.ToEmail, args.ProjectName, args.RoleName),
+			Link:  urlutil.MustJoinURL(args.FrontendURL, args.OrgName, args.ProjectName),
+		})
+		return nil
+	case JobKindPromoteDeployment:
+		args := &PromoteDeploymentJobArgs{}
+		if err := json.Unmarshal([]byte(job.Args), args); err != nil {
+			return err
+		}
+		return s.promoteDeployment(ctx, args)
+	default:
+		return fmt.Errorf("unknown job kind %q", job.Kind)
+	}
+}
+
+// JobRetryBackoff returns the delay before a job is retried after the given number of failed attempts.
+func JobRetryBackoff(attempts int) time.Duration {
+	backoff := 30 * time.Second
+	for i := 1; i < attempts && backoff < time.Hour; i++ {
+		backoff *= 2
+	}
+	return min(backoff, time.Hour)
+}
+
+// deprovisionDeployment deletes a deployment's runtime instance and releases its provisioned resources.
+func (s *Service) deprovisionDeployment(ctx context.Context, args *DeprovisionDeploymentJobArgs) error {
+	// Connect to the deployment's runtime and delete the instance
+	rt, err := s.OpenRuntimeClient(args.RuntimeHost, args.RuntimeAudience)
+	if err != nil {
+		return fmt.Errorf("failed to open runtime client: %w", err)
+	}
+	defer rt.Close()
+	_, err = rt.DeleteInstance(ctx, &runtimev1.DeleteInstanceRequest{
+		InstanceId: args.RuntimeInstanceID,
+	})
+	if err != nil && status.Code(err) != codes.NotFound { // The instance may have been deleted in a previous attempt
+		return fmt.Errorf("failed to delete instance: %w", err)
+	}
+
+	// Get provisioner and deprovision, skip if the provisioner is no longer defined in the provisioner set
+	p, ok := s.ProvisionerSet[args.Provisioner]
+	if !ok {
+		s.Logger.Warn("provisioner: deprovisioning skipped, provisioner not found", zap.String("deployment_id", args.DeploymentID), zap.String("provisioner", args.Provisioner), zap.String("provision_id", args.ProvisionID), observability.ZapCtx(ctx))
+		return nil
+	}
+	err = p.Deprovision(ctx, args.ProvisionID)
+	if err != nil {
+		return fmt.Errorf("provisioner: failed to deprovision: %w", err)
+	}
+	return nil
+}
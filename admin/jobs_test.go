@@ -0,0 +1,15 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRetryBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, JobRetryBackoff(1))
+	require.Equal(t, time.Minute, JobRetryBackoff(2))
+	require.Equal(t, 2*time.Minute, JobRetryBackoff(3))
+	require.Equal(t, time.Hour, JobRetryBackoff(20))
+}
@@ -0,0 +1,245 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/mail"
+	"slices"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/teams"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	slackapi "github.com/slack-go/slack"
+	"go.uber.org/zap"
+)
+
+// NotificationEventInvite is sent when a user is invited to an organization or one of its projects.
+const NotificationEventInvite = "invite"
+
+// NotificationEvents are the events that organization notifiers can subscribe to.
+// The kinds of operational alerts are also notification events.
+var NotificationEvents = []string{
+	OpsAlertKindDeploymentError,
+	OpsAlertKindDeploymentStuck,
+	OpsAlertKindDeploymentUnreachable,
+	OpsAlertKindSlotQuota,
+	NotificationEventInvite,
+}
+
+// Notification is a message about an event in an organization.
+type Notification struct {
+	Event   string
+	OrgName string
+	Title   string
+	Body    string
+	// Link is an optional URL to open for details about the event.
+	Link string
+}
+
+// Notifier sends notifications to one of the channels configured for an organization.
+// Implementations exist for each of the database.OrganizationNotifierConnector* connectors.
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+}
+
+// OpenNotifier returns a Notifier for a connector and its properties. It returns an error if the properties are invalid.
+func (s *Service) OpenNotifier(connector string, props map[string]any) (Notifier, error) {
+	switch connector {
+	case database.OrganizationNotifierConnectorEmail:
+		var recipients []string
+		if err := mapstructure.WeakDecode(props["recipients"], &recipients); err != nil {
+			return nil, fmt.Errorf("invalid recipients: %w", err)
+		}
+		if len(recipients) == 0 {
+			return nil, errors.New(`the "recipients" property is required`)
+		}
+		for _, r := range recipients {
+			if _, err := mail.ParseAddress(r); err != nil {
+				return nil, fmt.Errorf("invalid recipient email address %q", r)
+			}
+		}
+		return &emailNotifier{client: s.Email, recipients: recipients}, nil
+	case database.OrganizationNotifierConnectorSlack:
+		p, err := slack.DecodeProps(props)
+		if err != nil {
+			return nil, err
+		}
+		// The admin service doesn't have a Slack bot token, so only webhooks are supported.
+		if len(p.Users) != 0 || len(p.Channels) != 0 {
+			return nil, errors.New("slack notifiers for an organization only support webhooks")
+		}
+		if len(p.Webhooks) == 0 {
+			return nil, errors.New(`the "webhooks" property is required`)
+		}
+		return &slackNotifier{webhooks: p.Webhooks}, nil
+	case database.OrganizationNotifierConnectorTeams:
+		p, err := teams.DecodeProps(props)
+		if err != nil {
+			return nil, err
+		}
+		if len(p.Webhooks) == 0 {
+			return nil, errors.New(`the "webhooks" property is required`)
+		}
+		return &teamsNotifier{webhooks: p.Webhooks}, nil
+	case database.OrganizationNotifierConnectorWebhook:
+		p := &webhookNotifierProperties{}
+		if err := mapstructure.WeakDecode(props, p); err != nil {
+			return nil, err
+		}
+		if len(p.URLs) == 0 {
+			return nil, errors.New(`the "urls" property is required`)
+		}
+		return &webhookNotifier{props: p}, nil
+	default:
+		return nil, fmt.Errorf("unsupported notifier connector %q", connector)
+	}
+}
+
+// ValidateNotificationEvents returns an error if any of the events is not one of NotificationEvents.
+func ValidateNotificationEvents(events []string) error {
+	for _, e := range events {
+		if !slices.Contains(NotificationEvents, e) {
+			return fmt.Errorf("invalid notification event %q", e)
+		}
+	}
+	return nil
+}
+
+// NotifyOrganization sends a notification to the organization's notifiers that are subscribed to its event.
+// It attempts to send to all notifiers, and returns the errors of those that failed.
+func (s *Service) NotifyOrganization(ctx context.Context, org *database.Organization, n *Notification) error {
+	notifiers, err := s.DB.FindOrganizationNotifiers(ctx, org.ID)
+	if err != nil {
+		return err
+	}
+
+	n.OrgName = org.Name
+
+	var errs []error
+	for _, on := range notifiers {
+		if len(on.Events) > 0 && !slices.Contains(on.Events, n.Event) {
+			continue
+		}
+
+		notifier, err := s.OpenNotifier(on.Connector, on.Properties)
+		if err == nil {
+			err = notifier.Notify(ctx, n)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("notifier %q: %w", on.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// notifyOrganizationByName is a best-effort variant of NotifyOrganization that logs errors instead of returning them.
+func (s *Service) notifyOrganizationByName(ctx context.Context, orgName string, n *Notification) {
+	org, err := s.DB.FindOrganizationByName(ctx, orgName)
+	if err == nil {
+		err = s.NotifyOrganization(ctx, org, n)
+	}
+	if err != nil {
+		s.Logger.Warn("failed to notify organization", zap.String("org", orgName), zap.String("event", n.Event), zap.Error(err), observability.ZapCtx(ctx))
+	}
+}
+
+type emailNotifier struct {
+	client     *email.Client
+	recipients []string
+}
+
+func (e *emailNotifier) Notify(ctx context.Context, n *Notification) error {
+	for _, r := range e.recipients {
+		err := e.client.SendCallToAction(&email.CallToAction{
+			ToEmail:    r,
+			Subject:    fmt.Sprintf("Rill: %s", n.Title),
+			Title:      n.Title,
+			Body:       template.HTML(template.HTMLEscapeString(n.Body)),
+			ButtonText: "Open in Rill",
+			ButtonLink: n.Link,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to email %q: %w", r, err)
+		}
+	}
+	return nil
+}
+
+type slackNotifier struct {
+	webhooks []string
+}
+
+func (s *slackNotifier) Notify(ctx context.Context, n *Notification) error {
+	txt := fmt.Sprintf("*%s*: %s", n.Title, n.Body)
+	if n.Link != "" {
+		txt += fmt.Sprintf(" (<%s|open>)", n.Link)
+	}
+	for _, u := range s.webhooks {
+		err := slackapi.PostWebhookContext(ctx, u, &slackapi.WebhookMessage{Text: txt})
+		if err != nil {
+			return fmt.Errorf("slack webhook error: %w", err)
+		}
+	}
+	return nil
+}
+
+type teamsNotifier struct {
+	webhooks []string
+}
+
+func (t *teamsNotifier) Notify(ctx context.Context, n *Notification) error {
+	msg := &teams.Message{
+		Title: n.Title,
+		Text:  n.Body,
+		Links: []teams.Link{{Name: "Open in Rill", URL: n.Link}},
+	}
+	for _, u := range t.webhooks {
+		err := teams.PostWebhook(ctx, u, msg)
+		if err != nil {
+			return fmt.Errorf("teams webhook error: %w", err)
+		}
+	}
+	return nil
+}
+
+type webhookNotifierProperties struct {
+	URLs []string `mapstructure:"urls"`
+	// Secret is used to sign the payloads. See webhook.SignatureHeader.
+	Secret string `mapstructure:"secret"`
+}
+
+// webhookNotificationPayload is the JSON payload posted by a webhook notifier.
+type webhookNotificationPayload struct {
+	Event        string `json:"event"`
+	Organization string `json:"organization"`
+	Title        string `json:"title"`
+	Message      string `json:"message"`
+	Link         string `json:"link,omitempty"`
+}
+
+type webhookNotifier struct {
+	props *webhookNotifierProperties
+}
+
+func (w *webhookNotifier) Notify(ctx context.Context, n *Notification) error {
+	payload := &webhookNotificationPayload{
+		Event:        n.Event,
+		Organization: n.OrgName,
+		Title:        n.Title,
+		Message:      n.Body,
+		Link:         n.Link,
+	}
+	for _, u := range w.props.URLs {
+		err := webhook.Post(ctx, u, w.props.Secret, payload)
+		if err != nil {
+			return fmt.Errorf("webhook error: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,377 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/slack-go/slack"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// Kinds of operational alerts.
+const (
+	OpsAlertKindDeploymentError       = "deployment_error"
+	OpsAlertKindDeploymentStuck       = "deployment_stuck"
+	OpsAlertKindDeploymentUnreachable = "deployment_unreachable"
+	OpsAlertKindSlotQuota             = "org_slot_quota"
+)
+
+// opsAlertsMaxListed is the maximum number of resources named in a stuck deployment alert.
+const opsAlertsMaxListed = 5
+
+// opsCondition is an operational condition observed while checking for ops alerts.
+type opsCondition struct {
+	kind      string
+	subjectID string
+	org       *database.Organization
+	project   *database.Project // Nil for org-level conditions
+	message   string
+	// grace is how long the condition must persist before notifications are sent.
+	grace time.Duration
+}
+
+func (c *opsCondition) title() string {
+	switch c.kind {
+	case OpsAlertKindDeploymentError:
+		return "Deployment failed"
+	case OpsAlertKindDeploymentStuck:
+		return "Deployment stuck reconciling"
+	case OpsAlertKindDeploymentUnreachable:
+		return "Deployment unreachable"
+	case OpsAlertKindSlotQuota:
+		return "Organization near slot quota"
+	default:
+		return "Operational alert"
+	}
+}
+
+// CheckOpsAlerts checks for operational conditions that need attention and notifies about new ones.
+// It checks for deployments in an error state, deployments on unreachable runtime hosts, deployments that have been reconciling for longer than Options.OpsAlertsStuckReconcile,
+// and organizations that use more than Options.OpsAlertsSlotQuotaThreshold of their slot quota.
+// Each condition is notified once; when it no longer holds, it's cleared (and a resolve message is posted to the webhook).
+// Besides the globally configured channels, conditions are sent to the notifiers configured for the affected org.
+func (s *Service) CheckOpsAlerts(ctx context.Context) error {
+	if s.opts.OpsAlertsWebhookURL == "" && !s.opts.OpsAlertsEmailOrgAdmins {
+		ok, err := s.DB.CheckOrganizationNotifiersExist(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	conds, err := s.collectOpsConditions(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.DB.FindOpsAlerts(ctx)
+	if err != nil {
+		return err
+	}
+	alerts := make(map[string]*database.OpsAlert, len(existing))
+	for _, a := range existing {
+		alerts[a.Kind+"/"+a.SubjectID] = a
+	}
+
+	seen := make(map[string]bool, len(conds))
+	for _, c := range conds {
+		key := c.kind + "/" + c.subjectID
+		seen[key] = true
+
+		a, ok := alerts[key]
+		if !ok {
+			a, err = s.DB.InsertOpsAlert(ctx, &database.InsertOpsAlertOptions{
+				Kind:      c.kind,
+				SubjectID: c.subjectID,
+				OrgID:     &c.org.ID,
+				Message:   c.message,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if a.NotifiedOn != nil || time.Since(a.CreatedOn) < c.grace {
+			continue
+		}
+
+		err = s.notifyOpsAlert(ctx, c)
+		if err != nil {
+			s.Logger.Error("ops alerts: failed to notify", zap.String("kind", c.kind), zap.String("subject_id", c.subjectID), zap.Error(err))
+			continue
+		}
+
+		err = s.DB.UpdateOpsAlertNotifiedOn(ctx, a.ID, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	// Clear alerts for conditions that no longer hold
+	for key, a := range alerts {
+		if seen[key] {
+			continue
+		}
+
+		if a.NotifiedOn != nil && s.opts.OpsAlertsWebhookURL != "" {
+			err := s.postOpsAlertWebhook(ctx, fmt.Sprintf(":white_check_mark: Resolved: %s", a.Message))
+			if err != nil {
+				s.Logger.Warn("ops alerts: failed to post resolve message", zap.String("kind", a.Kind), zap.String("subject_id", a.SubjectID), zap.Error(err))
+			}
+		}
+
+		err := s.DB.DeleteOpsAlert(ctx, a.ID)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectOpsConditions returns the operational conditions that currently hold.
+func (s *Service) collectOpsConditions(ctx context.Context) ([]*opsCondition, error) {
+	var mu sync.Mutex
+	var conds []*opsCondition
+
+	projects := make(map[string]*database.Project)
+	orgs := make(map[string]*database.Organization)
+
+	afterID := ""
+	limit := 100
+	for {
+		depls, err := s.DB.FindDeployments(ctx, afterID, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		group, cctx := errgroup.WithContext(ctx)
+		group.SetLimit(8)
+		for _, depl := range depls {
+			if depl.Status != database.DeploymentStatusOK && depl.Status != database.DeploymentStatusError && depl.Status != database.DeploymentStatusUnreachable {
+				continue
+			}
+
+			proj, org, err := s.lookupProjectAndOrg(ctx, depl.ProjectID, projects, orgs)
+			if err != nil {
+				if errors.Is(err, database.ErrNotFound) {
+					continue
+				}
+				return nil, err
+			}
+
+			if depl.Status == database.DeploymentStatusError {
+				mu.Lock()
+				conds = append(conds, &opsCondition{
+					kind:      OpsAlertKindDeploymentError,
+					subjectID: depl.ID,
+					org:       org,
+					project:   proj,
+					message:   fmt.Sprintf("Deployment of project %s/%s failed: %s", org.Name, proj.Name, depl.StatusMessage),
+				})
+				mu.Unlock()
+				continue
+			}
+
+			if depl.Status == database.DeploymentStatusUnreachable {
+				mu.Lock()
+				conds = append(conds, &opsCondition{
+					kind:      OpsAlertKindDeploymentUnreachable,
+					subjectID: depl.ID,
+					org:       org,
+					project:   proj,
+					message:   fmt.Sprintf("Deployment of project %s/%s is unreachable: %s", org.Name, proj.Name, depl.StatusMessage),
+				})
+				mu.Unlock()
+				continue
+			}
+
+			depl := depl
+			group.Go(func() error {
+				resources, err := s.listDeploymentResources(cctx, depl)
+				if err != nil {
+					// Unreachable runtimes are reported by the deployments health check
+					s.Logger.Warn("ops alerts: failed to list resources", zap.String("deployment_id", depl.ID), zap.Error(err))
+					return nil
+				}
+
+				names := reconcilingResources(resources)
+				if len(names) == 0 {
+					return nil
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				conds = append(conds, &opsCondition{
+					kind:      OpsAlertKindDeploymentStuck,
+					subjectID: depl.ID,
+					org:       org,
+					project:   proj,
+					message:   fmt.Sprintf("Deployment of project %s/%s has been reconciling for more than %s (%s)", org.Name, proj.Name, s.opts.OpsAlertsStuckReconcile, formatResourceNames(names)),
+					grace:     s.opts.OpsAlertsStuckReconcile,
+				})
+				return nil
+			})
+		}
+		if err := group.Wait(); err != nil {
+			return nil, err
+		}
+
+		if len(depls) < limit {
+			break
+		}
+		afterID = depls[len(depls)-1].ID
+	}
+
+	if s.opts.OpsAlertsSlotQuotaThreshold > 0 {
+		usages, err := s.DB.FindOrganizationsNearSlotQuota(ctx, s.opts.OpsAlertsSlotQuotaThreshold)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, u := range usages {
+			org, ok := orgs[u.OrganizationID]
+			if !ok {
+				org, err = s.DB.FindOrganization(ctx, u.OrganizationID)
+				if err != nil {
+					return nil, err
+				}
+				orgs[org.ID] = org
+			}
+
+			conds = append(conds, &opsCondition{
+				kind:      OpsAlertKindSlotQuota,
+				subjectID: org.ID,
+				org:       org,
+				message:   fmt.Sprintf("Organization %s is using %d of its %d slots", org.Name, u.SlotsUsed, u.QuotaSlotsTotal),
+			})
+		}
+	}
+
+	return conds, nil
+}
+
+// lookupProjectAndOrg finds a project and its organization, caching the results in the provided maps.
+func (s *Service) lookupProjectAndOrg(ctx context.Context, projectID string, projects map[string]*database.Project, orgs map[string]*database.Organization) (*database.Project, *database.Organization, error) {
+	proj, ok := projects[projectID]
+	if !ok {
+		var err error
+		proj, err = s.DB.FindProject(ctx, projectID)
+		if err != nil {
+			return nil, nil, err
+		}
+		projects[projectID] = proj
+	}
+
+	org, ok := orgs[proj.OrganizationID]
+	if !ok {
+		var err error
+		org, err = s.DB.FindOrganization(ctx, proj.OrganizationID)
+		if err != nil {
+			return nil, nil, err
+		}
+		orgs[org.ID] = org
+	}
+
+	return proj, org, nil
+}
+
+// notifyOpsAlert sends notifications for an operational condition to the configured webhook, the admins of the affected org, and the org's notifiers.
+func (s *Service) notifyOpsAlert(ctx context.Context, c *opsCondition) error {
+	link := ""
+	if s.opts.FrontendURL != "" {
+		if c.project != nil {
+			link = urlutil.MustJoinURL(s.opts.FrontendURL, c.org.Name, c.project.Name)
+		} else {
+			link = urlutil.MustJoinURL(s.opts.FrontendURL, c.org.Name)
+		}
+	}
+
+	var errs []error
+	if s.opts.OpsAlertsWebhookURL != "" {
+		txt := fmt.Sprintf(":rotating_light: *%s*: %s", c.title(), c.message)
+		if link != "" {
+			txt += fmt.Sprintf(" (<%s|open>)", link)
+		}
+		err := s.postOpsAlertWebhook(ctx, txt)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if s.opts.OpsAlertsEmailOrgAdmins {
+		role, err := s.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+		if err != nil {
+			return err
+		}
+
+		admins, err := s.DB.FindOrganizationMemberUsersByRole(ctx, c.org.ID, role.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, u := range admins {
+			err := s.Email.SendCallToAction(&email.CallToAction{
+				ToEmail:    u.Email,
+				ToName:     u.DisplayName,
+				Subject:    fmt.Sprintf("Rill alert: %s", c.title()),
+				Title:      c.title(),
+				Body:       template.HTML(template.HTMLEscapeString(c.message)),
+				ButtonText: "Open in Rill",
+				ButtonLink: link,
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to email %q: %w", u.Email, err))
+			}
+		}
+	}
+
+	err := s.NotifyOrganization(ctx, c.org, &Notification{
+		Event: c.kind,
+		Title: c.title(),
+		Body:  c.message,
+		Link:  link,
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// postOpsAlertWebhook posts a message to the ops alerts webhook.
+func (s *Service) postOpsAlertWebhook(ctx context.Context, txt string) error {
+	return slack.PostWebhookContext(ctx, s.opts.OpsAlertsWebhookURL, &slack.WebhookMessage{Text: txt})
+}
+
+// reconcilingResources returns the names of resources that are not idle.
+func reconcilingResources(rs []*runtimev1.Resource) []string {
+	var res []string
+	for _, r := range rs {
+		if r.Meta.ReconcileStatus == runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE {
+			continue
+		}
+		res = append(res, fmt.Sprintf("%s/%s", r.Meta.Name.Kind, r.Meta.Name.Name))
+	}
+	return res
+}
+
+// formatResourceNames formats resource names for an alert message, truncating long lists.
+func formatResourceNames(names []string) string {
+	if len(names) <= opsAlertsMaxListed {
+		return fmt.Sprintf("reconciling: %s", strings.Join(names, ", "))
+	}
+	return fmt.Sprintf("reconciling: %s and %d more", strings.Join(names[:opsAlertsMaxListed], ", "), len(names)-opsAlertsMaxListed)
+}
@@ -0,0 +1,33 @@
+package admin
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcilingResources(t *testing.T) {
+	res := func(kind, name string, status runtimev1.ReconcileStatus) *runtimev1.Resource {
+		return &runtimev1.Resource{Meta: &runtimev1.ResourceMeta{
+			Name:            &runtimev1.ResourceName{Kind: kind, Name: name},
+			ReconcileStatus: status,
+		}}
+	}
+
+	names := reconcilingResources([]*runtimev1.Resource{
+		res("rill.runtime.v1.Source", "orders", runtimev1.ReconcileStatus_RECONCILE_STATUS_RUNNING),
+		res("rill.runtime.v1.Model", "orders_daily", runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE),
+		res("rill.runtime.v1.Model", "customers", runtimev1.ReconcileStatus_RECONCILE_STATUS_PENDING),
+	})
+	require.Equal(t, []string{"rill.runtime.v1.Source/orders", "rill.runtime.v1.Model/customers"}, names)
+
+	require.Empty(t, reconcilingResources([]*runtimev1.Resource{
+		res("rill.runtime.v1.Model", "orders_daily", runtimev1.ReconcileStatus_RECONCILE_STATUS_IDLE),
+	}))
+}
+
+func TestFormatResourceNames(t *testing.T) {
+	require.Equal(t, "reconciling: a, b", formatResourceNames([]string{"a", "b"}))
+	require.Equal(t, "reconciling: a, b, c, d, e and 2 more", formatResourceNames([]string{"a", "b", "c", "d", "e", "f", "g"}))
+}
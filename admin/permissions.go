@@ -75,6 +75,12 @@ func (s *Service) OrganizationPermissionsForMagicAuthToken(ctx context.Context,
 	return &adminv1.OrganizationPermissions{}, nil
 }
 
+// OrganizationPermissionsForProjectAuthToken resolves organization permissions for a project auth token.
+// Like for magic auth tokens, it grants basic read access to only the org of the project the token belongs to.
+func (s *Service) OrganizationPermissionsForProjectAuthToken(ctx context.Context, orgID, tokenProjectID string) (*adminv1.OrganizationPermissions, error) {
+	return s.OrganizationPermissionsForMagicAuthToken(ctx, orgID, tokenProjectID)
+}
+
 // ProjectPermissionsForUser resolves project permissions for a user.
 func (s *Service) ProjectPermissionsForUser(ctx context.Context, projectID, userID string, orgPerms *adminv1.OrganizationPermissions) (*adminv1.ProjectPermissions, error) {
 	// ManageProjects permission on the org gives full access to all projects in the org (only org admins have this)
@@ -208,6 +214,22 @@ func (s *Service) ProjectPermissionsForMagicAuthToken(ctx context.Context, proje
 	}, nil
 }
 
+// ProjectPermissionsForProjectAuthToken resolves project permissions for a project auth token.
+// The token gets the permissions of its project role in the project it belongs to.
+func (s *Service) ProjectPermissionsForProjectAuthToken(ctx context.Context, projectID string, tkn *database.ProjectAuthToken) (*adminv1.ProjectPermissions, error) {
+	// No access if the token belongs to another project
+	if projectID != tkn.ProjectID {
+		return &adminv1.ProjectPermissions{}, nil
+	}
+
+	role, err := s.DB.FindProjectRole(ctx, tkn.ProjectRoleName)
+	if err != nil {
+		return nil, err
+	}
+
+	return unionProjectRoles(&adminv1.ProjectPermissions{}, role), nil
+}
+
 func unionOrgRoles(a *adminv1.OrganizationPermissions, b *database.OrganizationRole) *adminv1.OrganizationPermissions {
 	return &adminv1.OrganizationPermissions{
 		ReadOrg:          a.ReadOrg || b.ReadOrg,
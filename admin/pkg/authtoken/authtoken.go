@@ -25,12 +25,13 @@ const (
 	TypeService    Type = "svc"
 	TypeDeployment Type = "dpl"
 	TypeMagic      Type = "mgc"
+	TypeProject    Type = "prj"
 )
 
 // Validate checks that the type is a known enum value.
 func (t Type) Validate() bool {
 	switch t {
-	case TypeUser, TypeService, TypeDeployment, TypeMagic:
+	case TypeUser, TypeService, TypeDeployment, TypeMagic, TypeProject:
 		return true
 	default:
 		return false
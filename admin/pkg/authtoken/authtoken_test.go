@@ -29,6 +29,7 @@ func TestValidity(t *testing.T) {
 	valid := []string{
 		"rill_usr_2Dws32dc2FxTThgCQjHerGM1rx9pJLCPQh5QbWjUiwpkZNkCCRrlrK",
 		"rill_svc_2Dws32dc2FxTThgCQjHerGM1rx9pJLCPQh5QbWjUiwpkZNkCCRrlrK",
+		"rill_prj_2Dws32dc2FxTThgCQjHerGM1rx9pJLCPQh5QbWjUiwpkZNkCCRrlrK",
 	}
 	for _, tt := range valid {
 		_, err := FromString(tt)
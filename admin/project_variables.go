@@ -0,0 +1,322 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rilldata/rill/admin/database"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// ErrInvalidProjectVariables is returned by UpdateProjectVariables when the new variables fail validation.
+var ErrInvalidProjectVariables = errors.New("invalid project variables")
+
+// projectVariableNameRegexp matches valid project variable names, e.g. "aws_access_key_id" or "connector.s3.region".
+var projectVariableNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.\-]*$`)
+
+// maxProjectVariablesUpdateAttempts is the number of times UpdateProjectVariables retries when the variables are concurrently modified.
+const maxProjectVariablesUpdateAttempts = 3
+
+// legacyConnectorVariables lists root-level variables that configure implicit connectors.
+// NOTE: This must be kept in sync with runtime.ConnectorConfig.
+var legacyConnectorVariables = map[string][]string{
+	"aws_access_key_id":               {"s3", "athena", "redshift"},
+	"aws_secret_access_key":           {"s3", "athena", "redshift"},
+	"aws_session_token":               {"s3", "athena", "redshift"},
+	"azure_storage_account":           {"azure"},
+	"azure_storage_key":               {"azure"},
+	"azure_storage_sas_token":         {"azure"},
+	"azure_storage_connection_string": {"azure"},
+	"google_application_credentials":  {"gcs", "bigquery"},
+	"token":                           {"motherduck"},
+}
+
+type UpdateProjectVariablesOptions struct {
+	// Variables to set. Unless Merge is true, they replace all the project's variables.
+	Variables map[string]string
+	// Merge merges Variables into the project's current variables instead of replacing them.
+	Merge bool
+	// Unset lists variables to remove. It only applies if Merge is true.
+	Unset []string
+	// DryRun validates the update and computes its impact without applying it.
+	DryRun bool
+}
+
+type UpdateProjectVariablesResult struct {
+	Project *database.Project
+	// Variables are the project's variables after the update (or what they would be for a dry run).
+	Variables map[string]string
+	// ChangedVariables are the names of the variables that were added, changed or removed.
+	ChangedVariables []string
+	// AffectedResources are the resources in the prod deployment that use connectors configured by the changed variables.
+	AffectedResources []*runtimev1.ResourceName
+	// DeploymentUpdated is true if the update was applied to the project's running deployments (which restarts their instances).
+	// It's false if nothing changed or the project doesn't have a running deployment, in which case the variables are applied when it's next deployed.
+	DeploymentUpdated bool
+}
+
+// UpdateProjectVariables validates and applies an update to the project's prod variables.
+// The variables are updated atomically, so concurrent updates to different variables don't overwrite each other.
+// Running deployments are only updated (and restarted) if the variables actually changed.
+func (s *Service) UpdateProjectVariables(ctx context.Context, proj *database.Project, opts *UpdateProjectVariablesOptions) (*UpdateProjectVariablesResult, error) {
+	for attempt := 1; ; attempt++ {
+		vars := nextProjectVariables(proj.ProdVariables, opts)
+		changed := changedProjectVariables(proj.ProdVariables, vars)
+
+		err := validateProjectVariables(vars, changed)
+		if err != nil {
+			return nil, err
+		}
+
+		res := &UpdateProjectVariablesResult{
+			Project:          proj,
+			Variables:        vars,
+			ChangedVariables: changed,
+		}
+		if len(changed) == 0 {
+			return res, nil
+		}
+
+		// Validate against and compute the impact on the prod deployment's connectors
+		depl, err := s.runningProdDeployment(ctx, proj)
+		if err != nil {
+			return nil, err
+		}
+		if depl != nil {
+			res.AffectedResources, err = s.checkDeploymentVariables(ctx, depl, vars, changed)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if opts.DryRun {
+			return res, nil
+		}
+
+		updated, err := s.DB.UpdateProjectVariables(ctx, proj.ID, vars, proj.ProdVariables)
+		if err != nil {
+			if !errors.Is(err, database.ErrNotFound) || attempt >= maxProjectVariablesUpdateAttempts {
+				return nil, err
+			}
+			// The variables were modified concurrently. Reload the project and try again.
+			proj, err = s.DB.FindProject(ctx, proj.ID)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		res.Project = updated
+		res.Variables = updated.ProdVariables
+
+		res.DeploymentUpdated, err = s.updateDeploymentsVariables(ctx, updated)
+		if err != nil {
+			return nil, err
+		}
+
+		return res, nil
+	}
+}
+
+// runningProdDeployment returns the project's prod deployment if it's running, and nil otherwise.
+func (s *Service) runningProdDeployment(ctx context.Context, proj *database.Project) (*database.Deployment, error) {
+	if proj.ProdDeploymentID == nil {
+		return nil, nil
+	}
+	depl, err := s.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if depl.Status != database.DeploymentStatusOK {
+		return nil, nil
+	}
+	return depl, nil
+}
+
+// checkDeploymentVariables checks that the variables satisfy the requirements of the deployment's connectors,
+// and returns the deployment's resources that use connectors configured by the changed variables.
+// If the deployment's runtime can't be reached, the checks are skipped.
+func (s *Service) checkDeploymentVariables(ctx context.Context, depl *database.Deployment, vars map[string]string, changed []string) ([]*runtimev1.ResourceName, error) {
+	rt, err := s.openRuntimeClientForDeployment(depl)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Close()
+
+	inst, err := rt.GetInstance(ctx, &runtimev1.GetInstanceRequest{InstanceId: depl.RuntimeInstanceID, Sensitive: true})
+	if err != nil {
+		s.Logger.Warn("update variables: failed to get instance, skipping connector checks", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+		return nil, nil
+	}
+	resources, err := rt.ListResources(ctx, &runtimev1.ListResourcesRequest{InstanceId: depl.RuntimeInstanceID})
+	if err != nil {
+		s.Logger.Warn("update variables: failed to list resources, skipping connector checks", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+		return nil, nil
+	}
+
+	err = checkConnectorVariables(inst.Instance.ProjectConnectors, inst.Instance.ProjectVariables, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return affectedResources(inst.Instance.ProjectConnectors, resources.Resources, changed), nil
+}
+
+// updateDeploymentsVariables applies the project's variables to its running deployments.
+func (s *Service) updateDeploymentsVariables(ctx context.Context, proj *database.Project) (bool, error) {
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return false, err
+	}
+	annotations := s.NewDeploymentAnnotations(org, proj)
+
+	ds, err := s.DB.FindDeploymentsForProject(ctx, proj.ID)
+	if err != nil {
+		return false, err
+	}
+
+	var updated bool
+	for _, d := range ds {
+		if d.Status != database.DeploymentStatusOK {
+			// The variables are applied when the deployment is next updated or redeployed.
+			continue
+		}
+		err := s.UpdateDeployment(ctx, d, &UpdateDeploymentOptions{
+			Version:         d.RuntimeVersion,
+			Branch:          d.Branch,
+			Variables:       proj.ProdVariables,
+			Annotations:     annotations,
+			EvictCachedRepo: false,
+		})
+		if err != nil {
+			return updated, err
+		}
+		updated = true
+	}
+	return updated, nil
+}
+
+// nextProjectVariables returns the variables that result from applying the update to the current variables.
+func nextProjectVariables(current map[string]string, opts *UpdateProjectVariablesOptions) map[string]string {
+	if !opts.Merge {
+		return maps.Clone(opts.Variables)
+	}
+	res := maps.Clone(current)
+	if res == nil {
+		res = make(map[string]string, len(opts.Variables))
+	}
+	for k, v := range opts.Variables {
+		res[k] = v
+	}
+	for _, k := range opts.Unset {
+		delete(res, k)
+	}
+	return res
+}
+
+// changedProjectVariables returns the sorted names of the variables that differ between prev and next.
+func changedProjectVariables(prev, next map[string]string) []string {
+	var res []string
+	for k, v := range next {
+		if pv, ok := prev[k]; !ok || pv != v {
+			res = append(res, k)
+		}
+	}
+	for k := range prev {
+		if _, ok := next[k]; !ok {
+			res = append(res, k)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// validateProjectVariables validates the names of the changed variables and the values of changed variables that configure the runtime.
+// Unchanged variables are not validated, so existing variables with legacy names don't block other updates.
+func validateProjectVariables(vars map[string]string, changed []string) error {
+	runtimeConfig := make(map[string]string)
+	for _, k := range changed {
+		v, ok := vars[k]
+		if !ok {
+			// Removed variables don't need validation
+			continue
+		}
+		if !projectVariableNameRegexp.MatchString(k) {
+			return fmt.Errorf("%w: invalid variable name %q", ErrInvalidProjectVariables, k)
+		}
+		if strings.HasPrefix(k, runtimeConfigPrefix) {
+			runtimeConfig[k] = v
+		}
+	}
+
+	err := ValidateRuntimeConfig(runtimeConfig)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidProjectVariables, err)
+	}
+	return nil
+}
+
+// checkConnectorVariables checks that the variables referenced by the project's connectors are set.
+// Variables with defaults in the project files (projectVars) don't need to be set.
+func checkConnectorVariables(connectors []*runtimev1.Connector, projectVars, vars map[string]string) error {
+	for _, c := range connectors {
+		for prop, v := range c.ConfigFromVariables {
+			if _, ok := vars[v]; ok {
+				continue
+			}
+			if _, ok := projectVars[v]; ok {
+				continue
+			}
+			return fmt.Errorf("%w: variable %q is required by property %q of connector %q", ErrInvalidProjectVariables, v, prop, c.Name)
+		}
+	}
+	return nil
+}
+
+// affectedResources returns the sources and models that use connectors configured by the changed variables.
+func affectedResources(connectors []*runtimev1.Connector, resources []*runtimev1.Resource, changed []string) []*runtimev1.ResourceName {
+	isAffected := func(connector string) bool {
+		if connector == "" {
+			return false
+		}
+		for _, k := range changed {
+			if strings.HasPrefix(k, fmt.Sprintf("connector.%s.", connector)) || slices.Contains(legacyConnectorVariables[k], connector) {
+				return true
+			}
+		}
+		for _, c := range connectors {
+			if c.Name != connector {
+				continue
+			}
+			for _, v := range c.ConfigFromVariables {
+				if slices.Contains(changed, v) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	var res []*runtimev1.ResourceName
+	for _, r := range resources {
+		var affected bool
+		if spec := r.GetSource().GetSpec(); spec != nil {
+			affected = isAffected(spec.SourceConnector) || isAffected(spec.SinkConnector)
+		} else if spec := r.GetModel().GetSpec(); spec != nil {
+			affected = isAffected(spec.InputConnector) || isAffected(spec.OutputConnector)
+		}
+		if affected {
+			res = append(res, r.Meta.Name)
+		}
+	}
+	return res
+}
@@ -0,0 +1,99 @@
+package admin
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextProjectVariables(t *testing.T) {
+	current := map[string]string{"foo": "1", "bar": "2"}
+
+	res := nextProjectVariables(current, &UpdateProjectVariablesOptions{Variables: map[string]string{"baz": "3"}})
+	require.Equal(t, map[string]string{"baz": "3"}, res)
+
+	res = nextProjectVariables(current, &UpdateProjectVariablesOptions{
+		Variables: map[string]string{"foo": "10", "baz": "3"},
+		Merge:     true,
+		Unset:     []string{"bar", "missing"},
+	})
+	require.Equal(t, map[string]string{"foo": "10", "baz": "3"}, res)
+	require.Equal(t, map[string]string{"foo": "1", "bar": "2"}, current)
+
+	res = nextProjectVariables(nil, &UpdateProjectVariablesOptions{Variables: map[string]string{"foo": "1"}, Merge: true})
+	require.Equal(t, map[string]string{"foo": "1"}, res)
+}
+
+func TestChangedProjectVariables(t *testing.T) {
+	require.Empty(t, changedProjectVariables(nil, map[string]string{}))
+	require.Empty(t, changedProjectVariables(map[string]string{"foo": "1"}, map[string]string{"foo": "1"}))
+	require.Equal(t, []string{"bar", "baz", "foo"}, changedProjectVariables(
+		map[string]string{"foo": "1", "bar": "2", "qux": "4"},
+		map[string]string{"foo": "10", "baz": "3", "qux": "4"},
+	))
+}
+
+func TestValidateProjectVariables(t *testing.T) {
+	validate := func(vars map[string]string) error {
+		return validateProjectVariables(vars, changedProjectVariables(nil, vars))
+	}
+	require.NoError(t, validate(nil))
+	require.NoError(t, validate(map[string]string{
+		"aws_access_key_id":     "key",
+		"connector.s3.region":   "us-east-1",
+		"rill.features.exports": "false",
+	}))
+	require.ErrorIs(t, validate(map[string]string{"1foo": "bar"}), ErrInvalidProjectVariables)
+	require.ErrorIs(t, validate(map[string]string{"foo bar": "baz"}), ErrInvalidProjectVariables)
+	require.ErrorIs(t, validate(map[string]string{"rill.features.exports": "maybe"}), ErrInvalidProjectVariables)
+
+	// Only changed variables are validated, so existing legacy names don't block updates
+	vars := map[string]string{"legacy name": "1", "foo": "2"}
+	require.NoError(t, validateProjectVariables(vars, []string{"foo"}))
+	require.NoError(t, validateProjectVariables(vars, []string{"removed"}))
+	require.ErrorIs(t, validateProjectVariables(vars, []string{"legacy name"}), ErrInvalidProjectVariables)
+}
+
+func TestCheckConnectorVariables(t *testing.T) {
+	connectors := []*runtimev1.Connector{
+		{Name: "pg", Type: "postgres", ConfigFromVariables: map[string]string{"dsn": "pg_dsn"}},
+	}
+	require.NoError(t, checkConnectorVariables(connectors, nil, map[string]string{"pg_dsn": "postgres://"}))
+	require.NoError(t, checkConnectorVariables(connectors, map[string]string{"pg_dsn": "postgres://localhost"}, nil))
+	require.ErrorIs(t, checkConnectorVariables(connectors, nil, map[string]string{"foo": "bar"}), ErrInvalidProjectVariables)
+}
+
+func TestAffectedResources(t *testing.T) {
+	connectors := []*runtimev1.Connector{
+		{Name: "pg", Type: "postgres", ConfigFromVariables: map[string]string{"dsn": "pg_dsn"}},
+	}
+	resources := []*runtimev1.Resource{
+		{
+			Meta:     &runtimev1.ResourceMeta{Name: &runtimev1.ResourceName{Kind: "rill.runtime.v1.Source", Name: "s3_source"}},
+			Resource: &runtimev1.Resource_Source{Source: &runtimev1.SourceV2{Spec: &runtimev1.SourceSpec{SourceConnector: "s3", SinkConnector: "duckdb"}}},
+		},
+		{
+			Meta:     &runtimev1.ResourceMeta{Name: &runtimev1.ResourceName{Kind: "rill.runtime.v1.Model", Name: "pg_model"}},
+			Resource: &runtimev1.Resource_Model{Model: &runtimev1.ModelV2{Spec: &runtimev1.ModelSpec{InputConnector: "pg", OutputConnector: "duckdb"}}},
+		},
+		{
+			Meta:     &runtimev1.ResourceMeta{Name: &runtimev1.ResourceName{Kind: "rill.runtime.v1.MetricsView", Name: "mv"}},
+			Resource: &runtimev1.Resource_MetricsView{MetricsView: &runtimev1.MetricsViewV2{Spec: &runtimev1.MetricsViewSpec{Connector: "duckdb"}}},
+		},
+	}
+
+	names := func(rs []*runtimev1.ResourceName) []string {
+		var res []string
+		for _, r := range rs {
+			res = append(res, r.Name)
+		}
+		return res
+	}
+
+	require.Empty(t, affectedResources(connectors, resources, []string{"foo"}))
+	require.Equal(t, []string{"s3_source"}, names(affectedResources(connectors, resources, []string{"aws_access_key_id"})))
+	require.Equal(t, []string{"s3_source"}, names(affectedResources(connectors, resources, []string{"connector.s3.region"})))
+	require.Equal(t, []string{"pg_model"}, names(affectedResources(connectors, resources, []string{"pg_dsn"})))
+	require.Equal(t, []string{"s3_source", "pg_model"}, names(affectedResources(connectors, resources, []string{"connector.duckdb.dsn"})))
+}
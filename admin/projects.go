@@ -98,6 +98,11 @@ func (s *Service) CreateProject(ctx context.Context, org *database.Organization,
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
 		ProdDeploymentID:     &depl.ID,
 		Annotations:          proj.Annotations,
+		Labels:               proj.Labels,
+		DeployTrigger:        proj.DeployTrigger,
+		DeploySchedule:       proj.DeploySchedule,
+		DeployBlueGreen:      proj.DeployBlueGreen,
+		MaintenanceWindow:    proj.MaintenanceWindow,
 	})
 	if err != nil {
 		err2 := s.TeardownDeployment(ctx, depl)
@@ -143,15 +148,28 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 		(proj.ProdBranch != opts.ProdBranch) ||
 		!reflect.DeepEqual(proj.Annotations, opts.Annotations) ||
 		!reflect.DeepEqual(proj.ProdVariables, opts.ProdVariables) ||
+		(proj.MaintenanceWindow != opts.MaintenanceWindow) ||
 		!reflect.DeepEqual(proj.GithubURL, opts.GithubURL) ||
 		!reflect.DeepEqual(proj.GithubInstallationID, opts.GithubInstallationID) ||
 		!reflect.DeepEqual(proj.ArchiveAssetID, opts.ArchiveAssetID))
 
+	// Changing the branch or switching to deploy on push releases any commit pinned by the deploy trigger.
+	releaseDeployPin := (proj.DeploySha != nil || proj.PendingDeploySha != nil) &&
+		(proj.ProdBranch != opts.ProdBranch || opts.DeployTrigger == "" || opts.DeployTrigger == database.DeployTriggerPush)
+	impactsDeployments = impactsDeployments || releaseDeployPin
+
 	proj, err := s.DB.UpdateProject(ctx, proj.ID, opts)
 	if err != nil {
 		return nil, err
 	}
 
+	if releaseDeployPin {
+		proj, err = s.DB.UpdateProjectDeployState(ctx, proj.ID, &database.UpdateProjectDeployStateOptions{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if !impactsDeployments {
 		return proj, nil
 	}
@@ -209,7 +227,7 @@ func (s *Service) UpdateOrgDeploymentAnnotations(ctx context.Context, org *datab
 	limit := 10
 	afterProjectName := ""
 	for {
-		projs, err := s.DB.FindProjectsForOrganization(ctx, org.ID, afterProjectName, limit)
+		projs, err := s.DB.FindProjectsForOrganization(ctx, org.ID, afterProjectName, nil, limit)
 		if err != nil {
 			return err
 		}
@@ -251,6 +269,18 @@ func (s *Service) TriggerRedeploy(ctx context.Context, proj *database.Project, p
 		return nil, err
 	}
 
+	// Hand off the previous deployment's data to the new deployment if enabled
+	var handoffFrom *database.Deployment
+	if s.opts.RedeployHandoff && prevDepl != nil && prevDepl.Status == database.DeploymentStatusOK {
+		handoffFrom = prevDepl
+	}
+
+	// Carry over the previous deployment's instance config overrides
+	var prevRuntimeConfig map[string]string
+	if prevDepl != nil {
+		prevRuntimeConfig = prevDepl.RuntimeConfig
+	}
+
 	// Provision new deployment
 	newDepl, err := s.createDeployment(ctx, &createDeploymentOptions{
 		ProjectID:      proj.ID,
@@ -262,8 +292,13 @@ func (s *Service) TriggerRedeploy(ctx context.Context, proj *database.Project, p
 		ProdOLAPDriver: proj.ProdOLAPDriver,
 		ProdOLAPDSN:    proj.ProdOLAPDSN,
 		ProdSlots:      proj.ProdSlots,
+		RuntimeConfig:  prevRuntimeConfig,
+		HandoffFrom:    handoffFrom,
 	})
 	if err != nil {
+		if handoffFrom != nil {
+			s.resumeDeployment(ctx, handoffFrom)
+		}
 		return nil, err
 	}
 
@@ -283,9 +318,17 @@ func (s *Service) TriggerRedeploy(ctx context.Context, proj *database.Project, p
 		ProdSlots:            proj.ProdSlots,
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
 		Annotations:          proj.Annotations,
+		Labels:               proj.Labels,
+		DeployTrigger:        proj.DeployTrigger,
+		DeploySchedule:       proj.DeploySchedule,
+		DeployBlueGreen:      proj.DeployBlueGreen,
+		MaintenanceWindow:    proj.MaintenanceWindow,
 	})
 	if err != nil {
 		err2 := s.TeardownDeployment(ctx, newDepl)
+		if handoffFrom != nil {
+			s.resumeDeployment(ctx, handoffFrom)
+		}
 		return nil, multierr.Combine(err, err2)
 	}
 
@@ -24,6 +24,9 @@ type ProvisionOptions struct {
 	RuntimeVersion string
 	Slots          int
 	Annotations    map[string]string
+	// ExcludeHosts lists runtime hosts that must not be allocated, such as the current host when moving a deployment or unreachable hosts.
+	// It only applies to provisioners that allocate from a shared set of runtimes.
+	ExcludeHosts []string
 }
 
 type Allocation struct {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"slices"
 
 	"github.com/c2h5oh/datasize"
 	"github.com/rilldata/rill/admin/database"
@@ -20,6 +21,9 @@ type StaticRuntimeSpec struct {
 	Host     string `json:"host"`
 	Slots    int    `json:"slots"`
 	Audience string `json:"audience_url"`
+	// Version is the runtime version running on the host.
+	// If set, only deployments pinned to the same version (or to "latest") are allocated to the host.
+	Version string `json:"version"`
 }
 
 type StaticProvisioner struct {
@@ -57,12 +61,21 @@ func (p *StaticProvisioner) Provision(ctx context.Context, opts *ProvisionOption
 	// Find runtime with available capacity
 	targets := make([]*StaticRuntimeSpec, 0)
 	for _, candidate := range p.Spec.Runtimes {
+		if !candidate.runsVersion(opts.RuntimeVersion) {
+			continue
+		}
+		if slices.Contains(opts.ExcludeHosts, candidate.Host) {
+			continue
+		}
 		if hostToSlotsUsed[candidate.Host]+opts.Slots <= candidate.Slots {
 			targets = append(targets, candidate)
 		}
 	}
 
 	if len(targets) == 0 {
+		if opts.RuntimeVersion != "" && opts.RuntimeVersion != "latest" {
+			return nil, fmt.Errorf("no runtimes found with sufficient available slots for version %q", opts.RuntimeVersion)
+		}
 		return nil, fmt.Errorf("no runtimes found with sufficient available slots")
 	}
 
@@ -138,3 +151,8 @@ func (p *StaticProvisioner) ValidateConfig(ctx context.Context, provisionID stri
 func (p *StaticProvisioner) Type() string {
 	return "static"
 }
+
+// runsVersion returns true if deployments pinned to the given version can be allocated to the runtime.
+func (r *StaticRuntimeSpec) runsVersion(version string) bool {
+	return r.Version == "" || version == "" || version == "latest" || r.Version == version
+}
@@ -17,7 +17,7 @@ func Test_staticProvisioner_Provision(t *testing.T) {
 	pg := pgtestcontainer.New(t)
 	defer pg.Terminate(t)
 
-	db, err := database.Open("postgres", pg.DatabaseURL)
+	db, err := database.Open("postgres", pg.DatabaseURL, "")
 	require.NoError(t, err)
 	require.NotNil(t, db)
 	defer db.Close()
@@ -81,6 +81,13 @@ func Test_staticProvisioner_Provision(t *testing.T) {
 		},
 	}
 
+	versionedSpec := &StaticSpec{
+		Runtimes: []*StaticRuntimeSpec{
+			{Host: "host_1", Slots: 6, Version: "0.48.0"},
+			{Host: "host_2", Slots: 12, Version: "0.47.0"},
+		},
+	}
+
 	tests := []struct {
 		name    string
 		spec    *StaticSpec
@@ -109,6 +116,34 @@ func Test_staticProvisioner_Provision(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name:    "excluded hosts ",
+			spec:    spec,
+			opts:    &ProvisionOptions{Slots: 1, ExcludeHosts: []string{"host_2", "host_3"}},
+			want:    &Allocation{CPU: 1, MemoryGB: 4, StorageBytes: int64(40) * int64(datasize.GB), Host: "host_1"},
+			wantErr: false,
+		},
+		{
+			name:    "only applicable host excluded ",
+			spec:    spec,
+			opts:    &ProvisionOptions{Slots: 4, ExcludeHosts: []string{"host_1"}},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "pinned version applicable ",
+			spec:    versionedSpec,
+			opts:    &ProvisionOptions{Slots: 1, RuntimeVersion: "0.47.0"},
+			want:    &Allocation{CPU: 1, MemoryGB: 4, StorageBytes: int64(40) * int64(datasize.GB), Host: "host_2"},
+			wantErr: false,
+		},
+		{
+			name:    "pinned version not applicable ",
+			spec:    versionedSpec,
+			opts:    &ProvisionOptions{Slots: 1, RuntimeVersion: "0.46.0"},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// runtimeHostRetention is how long a runtime host without deployments is tracked after its last heartbeat.
+const runtimeHostRetention = 7 * 24 * time.Hour
+
+// RecordRuntimeHeartbeat records a heartbeat sent by the runtime hosting the given deployment.
+func (s *Service) RecordRuntimeHeartbeat(ctx context.Context, depl *database.Deployment, version string) error {
+	_, err := s.DB.UpsertRuntimeHostHeartbeat(ctx, depl.RuntimeHost, version)
+	return err
+}
+
+// CheckRuntimeHosts checks the liveness of the runtime hosts that send heartbeats.
+// Deployments on hosts that haven't sent a heartbeat within Options.RuntimeHeartbeatTimeout are flagged as unreachable,
+// and flagged deployments are restored when their host starts sending heartbeats again.
+// If Options.ReprovisionUnreachable is set, unreachable prod deployments are redeployed to other hosts.
+// Hosts that have never sent a heartbeat (e.g. runtimes running an older version) are not checked.
+func (s *Service) CheckRuntimeHosts(ctx context.Context) error {
+	if s.opts.RuntimeHeartbeatTimeout == 0 {
+		return nil
+	}
+
+	hosts, err := s.DB.FindRuntimeHosts(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, host := range hosts {
+		depls, err := s.DB.FindDeploymentsForRuntimeHost(ctx, host.Host)
+		if err != nil {
+			return err
+		}
+
+		alive := time.Since(host.HeartbeatOn) < s.opts.RuntimeHeartbeatTimeout
+		if alive {
+			for _, depl := range depls {
+				if depl.Status != database.DeploymentStatusUnreachable {
+					continue
+				}
+				_, err := s.DB.UpdateDeploymentStatus(ctx, depl.ID, database.DeploymentStatusOK, "")
+				if err != nil {
+					return err
+				}
+				s.Logger.Info("runtime hosts: deployment is reachable again", zap.String("deployment_id", depl.ID), zap.String("host", host.Host), observability.ZapCtx(ctx))
+			}
+			continue
+		}
+
+		if len(depls) == 0 && time.Since(host.HeartbeatOn) > runtimeHostRetention {
+			err := s.DB.DeleteRuntimeHost(ctx, host.Host)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, depl := range depls {
+			if depl.Status == database.DeploymentStatusOK {
+				msg := fmt.Sprintf("runtime host has not sent a heartbeat since %s", host.HeartbeatOn.UTC().Format(time.RFC3339))
+				depl, err = s.DB.UpdateDeploymentStatus(ctx, depl.ID, database.DeploymentStatusUnreachable, msg)
+				if err != nil {
+					return err
+				}
+				s.Logger.Warn("runtime hosts: deployment is unreachable", zap.String("deployment_id", depl.ID), zap.String("host", host.Host), zap.Time("heartbeat_on", host.HeartbeatOn), observability.ZapCtx(ctx))
+			}
+
+			if s.opts.ReprovisionUnreachable && depl.Status == database.DeploymentStatusUnreachable {
+				err := s.reprovisionUnreachableDeployment(ctx, depl)
+				if err != nil {
+					s.Logger.Error("runtime hosts: failed to reprovision unreachable deployment", zap.String("deployment_id", depl.ID), zap.String("host", host.Host), zap.Error(err), observability.ZapCtx(ctx))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// reprovisionUnreachableDeployment redeploys an unreachable deployment if it's the prod deployment of its project.
+// Other deployments are left for their owners to clean up.
+func (s *Service) reprovisionUnreachableDeployment(ctx context.Context, depl *database.Deployment) error {
+	proj, err := s.DB.FindProject(ctx, depl.ProjectID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if proj.ProdDeploymentID == nil || *proj.ProdDeploymentID != depl.ID {
+		return nil
+	}
+
+	s.Logger.Info("runtime hosts: reprovisioning unreachable deployment", zap.String("deployment_id", depl.ID), zap.String("project_id", proj.ID), zap.String("host", depl.RuntimeHost), observability.ZapCtx(ctx))
+	_, err = s.TriggerRedeploy(ctx, proj, depl)
+	return err
+}
+
+// unreachableRuntimeHosts returns the runtime hosts that have stopped sending heartbeats.
+// New deployments are not allocated to these hosts.
+func (s *Service) unreachableRuntimeHosts(ctx context.Context) ([]string, error) {
+	if s.opts.RuntimeHeartbeatTimeout == 0 {
+		return nil, nil
+	}
+
+	hosts, err := s.DB.FindRuntimeHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	for _, host := range hosts {
+		if time.Since(host.HeartbeatOn) >= s.opts.RuntimeHeartbeatTimeout {
+			res = append(res, host.Host)
+		}
+	}
+	return res, nil
+}
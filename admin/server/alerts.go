@@ -19,6 +19,8 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/teams"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"go.opentelemetry.io/otel/attribute"
@@ -301,7 +303,8 @@ func (s *Server) UnsubscribeAlert(ctx context.Context, req *adminv1.UnsubscribeA
 		return nil, status.Error(codes.InvalidArgument, "user is not subscribed to alert")
 	}
 
-	if len(opts.EmailRecipients) == 0 && len(opts.SlackUsers) == 0 && len(opts.SlackChannels) == 0 && len(opts.SlackWebhooks) == 0 {
+	if len(opts.EmailRecipients) == 0 && len(opts.SlackUsers) == 0 && len(opts.SlackChannels) == 0 && len(opts.SlackWebhooks) == 0 &&
+		len(opts.TeamsWebhooks) == 0 && len(opts.WebhookUrls) == 0 {
 		err = s.admin.DB.UpdateVirtualFileDeleted(ctx, proj.ID, proj.ProdBranch, virtualFilePathForManagedAlert(req.Name))
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to update virtual file: %s", err.Error())
@@ -468,6 +471,8 @@ func (s *Server) yamlForManagedAlert(opts *adminv1.AlertOptions, ownerUserID str
 	res.Notify.Slack.Channels = opts.SlackChannels
 	res.Notify.Slack.Users = opts.SlackUsers
 	res.Notify.Slack.Webhooks = opts.SlackWebhooks
+	res.Notify.Teams.Webhooks = opts.TeamsWebhooks
+	res.Notify.Webhook.URLs = opts.WebhookUrls
 	res.Annotations.AdminOwnerUserID = ownerUserID
 	res.Annotations.AdminManaged = true
 	res.Annotations.AdminNonce = time.Now().Format(time.RFC3339Nano)
@@ -501,6 +506,8 @@ func (s *Server) yamlForCommittedAlert(opts *adminv1.AlertOptions) ([]byte, erro
 	res.Notify.Slack.Channels = opts.SlackChannels
 	res.Notify.Slack.Users = opts.SlackUsers
 	res.Notify.Slack.Webhooks = opts.SlackWebhooks
+	res.Notify.Teams.Webhooks = opts.TeamsWebhooks
+	res.Notify.Webhook.URLs = opts.WebhookUrls
 	res.Annotations.WebOpenState = opts.WebOpenState
 	return yaml.Marshal(res)
 }
@@ -563,6 +570,18 @@ func recreateAlertOptionsFromSpec(spec *runtimev1.AlertSpec) (*adminv1.AlertOpti
 			opts.SlackUsers = props.Users
 			opts.SlackChannels = props.Channels
 			opts.SlackWebhooks = props.Webhooks
+		case "teams":
+			props, err := teams.DecodeProps(notifier.Properties.AsMap())
+			if err != nil {
+				return nil, err
+			}
+			opts.TeamsWebhooks = props.Webhooks
+		case "webhook":
+			props, err := webhook.DecodeProps(notifier.Properties.AsMap())
+			if err != nil {
+				return nil, err
+			}
+			opts.WebhookUrls = props.URLs
 		default:
 			return nil, fmt.Errorf("unknown notifier connector: %s", notifier.Connector)
 		}
@@ -598,6 +617,12 @@ type alertYAML struct {
 			Channels []string `yaml:"channels"`
 			Webhooks []string `yaml:"webhooks"`
 		}
+		Teams struct {
+			Webhooks []string `yaml:"webhooks"`
+		} `yaml:"teams,omitempty"`
+		Webhook struct {
+			URLs []string `yaml:"urls"`
+		} `yaml:"webhook,omitempty"`
 	}
 	Annotations alertAnnotations `yaml:"annotations,omitempty"`
 }
@@ -22,6 +22,7 @@ const (
 	OwnerTypeService        OwnerType = "service"
 	OwnerTypeDeployment     OwnerType = "deployment"
 	OwnerTypeMagicAuthToken OwnerType = "magic_auth_token" // nolint:gosec // It's not a credential
+	OwnerTypeProjectToken   OwnerType = "project_token"    // nolint:gosec // It's not a credential
 )
 
 // Claims resolves permissions for a requester.
@@ -117,6 +118,8 @@ func (c *authTokenClaims) OwnerType() OwnerType {
 		return OwnerTypeDeployment
 	case authtoken.TypeMagic:
 		return OwnerTypeMagicAuthToken
+	case authtoken.TypeProject:
+		return OwnerTypeProjectToken
 	default:
 		panic(fmt.Errorf("unexpected token type %q", t))
 	}
@@ -147,6 +150,9 @@ func (c *authTokenClaims) Superuser(ctx context.Context) bool {
 	case authtoken.TypeMagic:
 		// magic tokens can't be superusers
 		return false
+	case authtoken.TypeProject:
+		// project tokens can't be superusers
+		return false
 	default:
 		panic(fmt.Errorf("unexpected token type %q", c.token.Token().Type))
 	}
@@ -208,6 +214,13 @@ func (c *authTokenClaims) ProjectPermissions(ctx context.Context, orgID, project
 		} else {
 			err = fmt.Errorf("unexpected token model type %T", c.token.TokenModel())
 		}
+	case authtoken.TypeProject:
+		mdl, ok := c.token.TokenModel().(*database.ProjectAuthToken)
+		if ok {
+			perm, err = c.admin.ProjectPermissionsForProjectAuthToken(ctx, projectID, mdl)
+		} else {
+			err = fmt.Errorf("unexpected token model type %T", c.token.TokenModel())
+		}
 	default:
 		err = fmt.Errorf("unexpected token type %q", c.token.Token().Type)
 	}
@@ -245,6 +258,13 @@ func (c *authTokenClaims) organizationPermissionsUnsafe(ctx context.Context, org
 		} else {
 			err = fmt.Errorf("unexpected token model type %T", c.token.TokenModel())
 		}
+	case authtoken.TypeProject:
+		mdl, ok := c.token.TokenModel().(*database.ProjectAuthToken)
+		if ok {
+			perm, err = c.admin.OrganizationPermissionsForProjectAuthToken(ctx, orgID, mdl.ProjectID)
+		} else {
+			err = fmt.Errorf("unexpected token model type %T", c.token.TokenModel())
+		}
 	default:
 		err = fmt.Errorf("unexpected token type %q", c.token.Token().Type)
 	}
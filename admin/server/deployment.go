@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/urlutil"
 	"github.com/rilldata/rill/admin/server/auth"
@@ -47,6 +48,30 @@ func (s *Server) TriggerReconcile(ctx context.Context, req *adminv1.TriggerRecon
 	return &adminv1.TriggerReconcileResponse{}, nil
 }
 
+func (s *Server) ApproveDeployment(ctx context.Context, req *adminv1.ApproveDeploymentRequest) (*adminv1.ApproveDeploymentResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProd {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to approve deployments")
+	}
+
+	sha, err := s.admin.DeployPendingCommit(ctx, proj)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.ApproveDeploymentResponse{DeployedSha: sha}, nil
+}
+
 func (s *Server) TriggerRefreshSources(ctx context.Context, req *adminv1.TriggerRefreshSourcesRequest) (*adminv1.TriggerRefreshSourcesResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.deployment_id", req.DeploymentId),
@@ -125,6 +150,90 @@ func (s *Server) TriggerRedeploy(ctx context.Context, req *adminv1.TriggerRedepl
 	return &adminv1.TriggerRedeployResponse{}, nil
 }
 
+// SudoMigrateDeployment moves a project's prod deployment to another runtime host.
+func (s *Server) SudoMigrateDeployment(ctx context.Context, req *adminv1.SudoMigrateDeploymentRequest) (*adminv1.SudoMigrateDeploymentResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.provisioner", req.Provisioner),
+	)
+
+	// Check the request is made by a superuser
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to migrate deployments")
+	}
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if proj.ProdDeploymentID == nil {
+		return nil, status.Error(codes.FailedPrecondition, "project does not have a prod deployment")
+	}
+
+	depl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if depl.Status != database.DeploymentStatusOK {
+		return nil, status.Errorf(codes.FailedPrecondition, "deployment is not healthy (status: %s)", depl.Status.String())
+	}
+
+	depl, err = s.admin.MigrateDeployment(ctx, depl, req.Provisioner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.SudoMigrateDeploymentResponse{
+		Deployment: deploymentToDTO(depl),
+	}, nil
+}
+
+func (s *Server) SudoUpdateRuntimeConfig(ctx context.Context, req *adminv1.SudoUpdateRuntimeConfigRequest) (*adminv1.SudoUpdateRuntimeConfigResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.Int("args.runtime_config", len(req.RuntimeConfig)),
+	)
+
+	// Check the request is made by a superuser
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to update runtime config")
+	}
+
+	err := admin.ValidateRuntimeConfig(req.RuntimeConfig)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if proj.ProdDeploymentID == nil {
+		return nil, status.Error(codes.FailedPrecondition, "project does not have a prod deployment")
+	}
+
+	depl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	depl, err = s.admin.UpdateDeploymentRuntimeConfig(ctx, depl, req.RuntimeConfig)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.SudoUpdateRuntimeConfigResponse{
+		Deployment: deploymentToDTO(depl),
+	}, nil
+}
+
 // GetDeploymentCredentials returns runtime info and JWT on behalf of a specific user, or alternatively for a raw set of JWT attributes
 func (s *Server) GetDeploymentCredentials(ctx context.Context, req *adminv1.GetDeploymentCredentialsRequest) (*adminv1.GetDeploymentCredentialsResponse, error) {
 	observability.AddRequestAttributes(ctx,
@@ -257,6 +366,11 @@ func (s *Server) GetIFrame(ctx context.Context, req *adminv1.GetIFrameRequest) (
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to manage deployment")
 	}
 
+	org, err := s.admin.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	var attr map[string]any
 	if req.For != nil {
 		switch forVal := req.For.(type) {
@@ -331,7 +445,13 @@ func (s *Server) GetIFrame(ctx context.Context, req *adminv1.GetIFrameRequest) (
 		iframeQuery[k] = v
 	}
 
-	iFrameURL, err := urlutil.WithQuery(urlutil.MustJoinURL(s.opts.FrontendURL, "/-/embed"), iframeQuery)
+	// Serve the embed from the org's custom domain if it has one
+	frontendURL := s.opts.FrontendURL
+	if org.CustomDomain != "" {
+		frontendURL = "https://" + org.CustomDomain
+	}
+
+	iFrameURL, err := urlutil.WithQuery(urlutil.MustJoinURL(frontendURL, "/-/embed"), iframeQuery)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not construct iframe url: %s", err.Error())
 	}
@@ -342,6 +462,7 @@ func (s *Server) GetIFrame(ctx context.Context, req *adminv1.GetIFrameRequest) (
 		InstanceId:  prodDepl.RuntimeInstanceID,
 		AccessToken: jwt,
 		TtlSeconds:  uint32(ttlDuration.Seconds()),
+		Branding:    organizationBrandingToDTO(org),
 	}, nil
 }
 
@@ -76,12 +76,14 @@ func (s *Server) GetGithubUserStatus(ctx context.Context, req *adminv1.GetGithub
 	// refresh token changes after using it for getting a new token
 	// so saving the updated refresh token
 	user, err = s.admin.DB.UpdateUser(ctx, claims.OwnerID(), &database.UpdateUserOptions{
-		DisplayName:         user.DisplayName,
-		PhotoURL:            user.PhotoURL,
-		GithubUsername:      user.GithubUsername,
-		GithubRefreshToken:  refreshToken,
-		QuotaSingleuserOrgs: user.QuotaSingleuserOrgs,
-		PreferenceTimeZone:  user.PreferenceTimeZone,
+		DisplayName:            user.DisplayName,
+		PhotoURL:               user.PhotoURL,
+		GithubUsername:         user.GithubUsername,
+		GithubRefreshToken:     refreshToken,
+		QuotaSingleuserOrgs:    user.QuotaSingleuserOrgs,
+		PreferenceTimeZone:     user.PreferenceTimeZone,
+		PreferenceDefaultOrgID: user.PreferenceDefaultOrgID,
+		PreferenceLocale:       user.PreferenceLocale,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
@@ -350,12 +352,14 @@ func (s *Server) githubConnectCallback(w http.ResponseWriter, r *http.Request) {
 	}
 
 	user, err = s.admin.DB.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
-		DisplayName:         user.DisplayName,
-		PhotoURL:            user.PhotoURL,
-		GithubUsername:      githubUser.GetLogin(),
-		GithubRefreshToken:  refreshToken,
-		QuotaSingleuserOrgs: user.QuotaSingleuserOrgs,
-		PreferenceTimeZone:  user.PreferenceTimeZone,
+		DisplayName:            user.DisplayName,
+		PhotoURL:               user.PhotoURL,
+		GithubUsername:         githubUser.GetLogin(),
+		GithubRefreshToken:     refreshToken,
+		QuotaSingleuserOrgs:    user.QuotaSingleuserOrgs,
+		PreferenceTimeZone:     user.PreferenceTimeZone,
+		PreferenceDefaultOrgID: user.PreferenceDefaultOrgID,
+		PreferenceLocale:       user.PreferenceLocale,
 	})
 	if err != nil {
 		s.logger.Error("failed to update user's github username")
@@ -530,12 +534,14 @@ func (s *Server) githubAuthCallback(w http.ResponseWriter, r *http.Request) {
 	}
 
 	_, err = s.admin.DB.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
-		DisplayName:         user.DisplayName,
-		PhotoURL:            user.PhotoURL,
-		GithubUsername:      gitUser.GetLogin(),
-		GithubRefreshToken:  refreshToken,
-		QuotaSingleuserOrgs: user.QuotaSingleuserOrgs,
-		PreferenceTimeZone:  user.PreferenceTimeZone,
+		DisplayName:            user.DisplayName,
+		PhotoURL:               user.PhotoURL,
+		GithubUsername:         gitUser.GetLogin(),
+		GithubRefreshToken:     refreshToken,
+		QuotaSingleuserOrgs:    user.QuotaSingleuserOrgs,
+		PreferenceTimeZone:     user.PreferenceTimeZone,
+		PreferenceDefaultOrgID: user.PreferenceDefaultOrgID,
+		PreferenceLocale:       user.PreferenceLocale,
 	})
 	if err != nil {
 		http.Error(w, fmt.Sprintf("failed to save user information %s", err.Error()), http.StatusInternalServerError)
@@ -0,0 +1,24 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/healthcheck"
+)
+
+// readinessTimeout bounds the time spent checking dependencies in readinessHandler.
+const readinessTimeout = 10 * time.Second
+
+// readinessHandler serves a structured readiness report for load balancers and k8s probes.
+// The admin server is unready if it can't reach its database or rate limiter.
+func (s *Server) readinessHandler(w http.ResponseWriter, req *http.Request) {
+	ctx, cancel := context.WithTimeout(req.Context(), readinessTimeout)
+	defer cancel()
+
+	report := healthcheck.NewReport()
+	report.Add("database", s.admin.DB.Ping(ctx), true)
+	report.Add("limiter", s.limiter.Ping(ctx), true)
+	report.WriteHTTP(w)
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListJobs(ctx context.Context, req *adminv1.ListJobsRequest) (*adminv1.ListJobsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.status", req.Status.String()),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only superusers can list jobs")
+	}
+
+	pageToken, err := unmarshalStringTimestampPageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	jobs, err := s.admin.DB.FindJobs(ctx, jobStatusFromPB(req.Status), pageToken.Ts.AsTime(), pageToken.Str, pageSize)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextPageToken := ""
+	if len(jobs) >= pageSize {
+		last := jobs[len(jobs)-1]
+		nextPageToken = marshalStringTimestampPageToken(last.ID, last.CreatedOn)
+	}
+
+	pbs := make([]*adminv1.Job, len(jobs))
+	for i, job := range jobs {
+		pbs[i] = jobToPB(job)
+	}
+
+	return &adminv1.ListJobsResponse{
+		Jobs:          pbs,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func jobToPB(j *database.Job) *adminv1.Job {
+	var s adminv1.JobStatus
+	switch j.Status {
+	case database.JobStatusUnspecified:
+		s = adminv1.JobStatus_JOB_STATUS_UNSPECIFIED
+	case database.JobStatusPending:
+		s = adminv1.JobStatus_JOB_STATUS_PENDING
+	case database.JobStatusRunning:
+		s = adminv1.JobStatus_JOB_STATUS_RUNNING
+	case database.JobStatusSucceeded:
+		s = adminv1.JobStatus_JOB_STATUS_SUCCEEDED
+	case database.JobStatusFailed:
+		s = adminv1.JobStatus_JOB_STATUS_FAILED
+	default:
+		panic(fmt.Errorf("unhandled job status %d", j.Status))
+	}
+
+	res := &adminv1.Job{
+		Id:          j.ID,
+		Kind:        j.Kind,
+		Args:        j.Args,
+		Status:      s,
+		Attempts:    int32(j.Attempts),
+		MaxAttempts: int32(j.MaxAttempts),
+		Error:       j.Error,
+		RunAfter:    timestamppb.New(j.RunAfter),
+		CreatedOn:   timestamppb.New(j.CreatedOn),
+		UpdatedOn:   timestamppb.New(j.UpdatedOn),
+	}
+	if j.FinishedOn != nil {
+		res.FinishedOn = timestamppb.New(*j.FinishedOn)
+	}
+	return res
+}
+
+func jobStatusFromPB(s adminv1.JobStatus) database.JobStatus {
+	switch s {
+	case adminv1.JobStatus_JOB_STATUS_PENDING:
+		return database.JobStatusPending
+	case adminv1.JobStatus_JOB_STATUS_RUNNING:
+		return database.JobStatusRunning
+	case adminv1.JobStatus_JOB_STATUS_SUCCEEDED:
+		return database.JobStatusSucceeded
+	case adminv1.JobStatus_JOB_STATUS_FAILED:
+		return database.JobStatusFailed
+	default:
+		return database.JobStatusUnspecified
+	}
+}
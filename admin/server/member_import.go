@@ -0,0 +1,374 @@
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// importMemberUsersLimit is the max number of rows in a single ImportMemberUsers request.
+const importMemberUsersLimit = 1000
+
+// memberImport is a validated row of an ImportMemberUsers request.
+type memberImport struct {
+	result *adminv1.ImportMemberUserResult
+	user   *database.User // nil if the user must be invited
+	roleID string
+}
+
+func (s *Server) ImportMemberUsers(ctx context.Context, req *adminv1.ImportMemberUsersRequest) (*adminv1.ImportMemberUsersResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.Int("args.members", len(req.Members)),
+	)
+
+	members := req.Members
+	if req.Csv != "" {
+		if len(members) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "only one of members and csv can be set")
+		}
+		var err error
+		members, err = parseMemberUsersCSV(req.Csv)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid csv: %s", err.Error())
+		}
+	}
+	if len(members) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no members to import")
+	}
+	if len(members) > importMemberUsersLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot import more than %d members at a time", importMemberUsersLimit)
+	}
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var proj *database.Project
+	claims := auth.GetClaims(ctx)
+	if req.Project != "" {
+		proj, err = s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProjectMembers {
+			return nil, status.Error(codes.PermissionDenied, "not allowed to add project members")
+		}
+	} else if !claims.OrganizationPermissions(ctx, org.ID).ManageOrgMembers {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to add org members")
+	}
+
+	var invitedByUserID, invitedByName string
+	if claims.OwnerType() == auth.OwnerTypeUser {
+		user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		invitedByUserID = user.ID
+		invitedByName = user.DisplayName
+	}
+
+	// Validate all rows before making any changes
+	rows, valid, err := s.validateMemberImports(ctx, org, proj, members)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results := make([]*adminv1.ImportMemberUserResult, len(rows))
+	invites := 0
+	for i, r := range rows {
+		results[i] = r.result
+		if r.user == nil {
+			invites++
+		}
+	}
+	if !valid {
+		return &adminv1.ImportMemberUsersResponse{Imported: false, Results: results}, nil
+	}
+
+	// Check outstanding invites quota
+	count, err := s.admin.DB.CountInvitesForOrganization(ctx, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if org.QuotaOutstandingInvites >= 0 && count+invites > org.QuotaOutstandingInvites {
+		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: org %q can at most have %d outstanding invitations", org.Name, org.QuotaOutstandingInvites)
+	}
+
+	// Add all the members in one transaction
+	txCtx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, r := range rows {
+		err := s.insertMemberImport(txCtx, org, proj, invitedByUserID, r)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to add %q: %s", r.result.Email, err.Error())
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Send emails after the transaction has been committed.
+	// A failed email doesn't undo the import, so we just log it.
+	for _, r := range rows {
+		err := s.sendMemberImportEmail(org, proj, invitedByName, r)
+		if err != nil {
+			s.logger.Warn("failed to send email for imported member", zap.String("org", org.Name), zap.String("email", r.result.Email), zap.Error(err), observability.ZapCtx(ctx))
+		}
+		if r.user != nil {
+			s.admin.NotifyInviteReceived(ctx, r.user.ID, org, proj, r.result.Role, invitedByName)
+		}
+	}
+
+	return &adminv1.ImportMemberUsersResponse{Imported: true, Results: results}, nil
+}
+
+// validateMemberImports validates and resolves each row of an import. It returns false if any of the rows are invalid.
+func (s *Server) validateMemberImports(ctx context.Context, org *database.Organization, proj *database.Project, members []*adminv1.ImportMemberUser) ([]*memberImport, bool, error) {
+	roles := make(map[string]string) // Role name to ID
+	seen := make(map[string]bool)
+	valid := true
+
+	rows := make([]*memberImport, len(members))
+	for i, m := range members {
+		r := &memberImport{
+			result: &adminv1.ImportMemberUserResult{
+				Row:   int32(i + 1),
+				Email: strings.TrimSpace(m.Email),
+				Role:  strings.ToLower(strings.TrimSpace(m.Role)),
+			},
+		}
+		rows[i] = r
+
+		rowErr, err := s.validateMemberImport(ctx, org, proj, r, roles, seen)
+		if err != nil {
+			return nil, false, err
+		}
+		if rowErr != "" {
+			r.result.Error = rowErr
+			valid = false
+			continue
+		}
+		r.result.PendingSignup = r.user == nil
+	}
+
+	return rows, valid, nil
+}
+
+// validateMemberImport validates a single row of an import. It returns a non-empty message if the row is invalid.
+func (s *Server) validateMemberImport(ctx context.Context, org *database.Organization, proj *database.Project, r *memberImport, roles map[string]string, seen map[string]bool) (string, error) {
+	addr, err := mail.ParseAddress(r.result.Email)
+	if err != nil || addr.Address != r.result.Email {
+		return "invalid email address", nil
+	}
+
+	key := strings.ToLower(r.result.Email)
+	if seen[key] {
+		return "duplicate email address", nil
+	}
+	seen[key] = true
+
+	if r.result.Role == "" {
+		return "role is required", nil
+	}
+	roleID, ok := roles[r.result.Role]
+	if !ok {
+		if proj != nil {
+			role, err := s.admin.DB.FindProjectRole(ctx, r.result.Role)
+			if err == nil {
+				roleID = role.ID
+			} else if !errors.Is(err, database.ErrNotFound) {
+				return "", err
+			}
+		} else {
+			role, err := s.admin.DB.FindOrganizationRole(ctx, r.result.Role)
+			if err == nil {
+				roleID = role.ID
+			} else if !errors.Is(err, database.ErrNotFound) {
+				return "", err
+			}
+		}
+		roles[r.result.Role] = roleID
+	}
+	if roleID == "" {
+		return fmt.Sprintf("invalid role %q", r.result.Role), nil
+	}
+	r.roleID = roleID
+
+	user, err := s.admin.DB.FindUserByEmail(ctx, r.result.Email)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return "", err
+		}
+
+		// The user will be invited, so check there isn't already an invite
+		if proj != nil {
+			_, err = s.admin.DB.FindProjectInvite(ctx, proj.ID, r.result.Email)
+		} else {
+			_, err = s.admin.DB.FindOrganizationInvite(ctx, org.ID, r.result.Email)
+		}
+		if err == nil {
+			return "user has already been invited", nil
+		}
+		if !errors.Is(err, database.ErrNotFound) {
+			return "", err
+		}
+		return "", nil
+	}
+	r.user = user
+
+	var isMember bool
+	if proj != nil {
+		isMember, err = s.admin.DB.CheckUserIsAProjectMember(ctx, user.ID, proj.ID)
+	} else {
+		isMember, err = s.admin.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+	}
+	if err != nil {
+		return "", err
+	}
+	if isMember {
+		return "user is already a member", nil
+	}
+
+	return "", nil
+}
+
+// insertMemberImport adds or invites the user of a validated row. It should be called in a transaction.
+func (s *Server) insertMemberImport(ctx context.Context, org *database.Organization, proj *database.Project, invitedByUserID string, r *memberImport) error {
+	if proj != nil {
+		if r.user == nil {
+			return s.admin.DB.InsertProjectInvite(ctx, &database.InsertProjectInviteOptions{
+				Email:     r.result.Email,
+				InviterID: invitedByUserID,
+				ProjectID: proj.ID,
+				RoleID:    r.roleID,
+			})
+		}
+		return s.admin.DB.InsertProjectMemberUser(ctx, proj.ID, r.user.ID, r.roleID)
+	}
+
+	if r.user == nil {
+		return s.admin.DB.InsertOrganizationInvite(ctx, &database.InsertOrganizationInviteOptions{
+			Email:     r.result.Email,
+			InviterID: invitedByUserID,
+			OrgID:     org.ID,
+			RoleID:    r.roleID,
+		})
+	}
+
+	err := s.admin.DB.InsertOrganizationMemberUser(ctx, org.ID, r.user.ID, r.roleID)
+	if err != nil {
+		return err
+	}
+	return s.admin.DB.InsertUsergroupMemberUser(ctx, *org.AllUsergroupID, r.user.ID)
+}
+
+// sendMemberImportEmail notifies the user of an imported row that they were added or invited.
+func (s *Server) sendMemberImportEmail(org *database.Organization, proj *database.Project, invitedByName string, r *memberImport) error {
+	if proj != nil {
+		if r.user == nil {
+			return s.admin.Email.SendProjectInvite(&email.ProjectInvite{
+				ToEmail:       r.result.Email,
+				AdminURL:      s.opts.ExternalURL,
+				FrontendURL:   s.opts.FrontendURL,
+				OrgName:       org.Name,
+				ProjectName:   proj.Name,
+				RoleName:      r.result.Role,
+				InvitedByName: invitedByName,
+			})
+		}
+		return s.admin.Email.SendProjectAddition(&email.ProjectAddition{
+			ToEmail:       r.result.Email,
+			FrontendURL:   s.opts.FrontendURL,
+			OrgName:       org.Name,
+			ProjectName:   proj.Name,
+			RoleName:      r.result.Role,
+			InvitedByName: invitedByName,
+		})
+	}
+
+	if r.user == nil {
+		return s.admin.Email.SendOrganizationInvite(&email.OrganizationInvite{
+			ToEmail:       r.result.Email,
+			AdminURL:      s.opts.ExternalURL,
+			FrontendURL:   s.opts.FrontendURL,
+			OrgName:       org.Name,
+			RoleName:      r.result.Role,
+			InvitedByName: invitedByName,
+		})
+	}
+	return s.admin.Email.SendOrganizationAddition(&email.OrganizationAddition{
+		ToEmail:       r.result.Email,
+		FrontendURL:   s.opts.FrontendURL,
+		OrgName:       org.Name,
+		RoleName:      r.result.Role,
+		InvitedByName: invitedByName,
+	})
+}
+
+// parseMemberUsersCSV parses a CSV payload with the columns email and role.
+// If the first row is a header (i.e. it has the values "email" and "role"), the columns may appear in any order.
+func parseMemberUsersCSV(data string) ([]*adminv1.ImportMemberUser, error) {
+	r := csv.NewReader(strings.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	emailIdx, roleIdx := 0, 1
+	var res []*adminv1.ImportMemberUser
+	first := true
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if first {
+			first = false
+			header := make(map[string]int, len(record))
+			for i, v := range record {
+				header[strings.ToLower(strings.TrimSpace(v))] = i
+			}
+			ei, eok := header["email"]
+			ri, rok := header["role"]
+			if eok && rok {
+				emailIdx, roleIdx = ei, ri
+				continue
+			}
+		}
+
+		m := &adminv1.ImportMemberUser{}
+		if emailIdx < len(record) {
+			m.Email = record[emailIdx]
+		}
+		if roleIdx < len(record) {
+			m.Role = record[roleIdx]
+		}
+		res = append(res, m)
+	}
+	return res, nil
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemberUsersCSV(t *testing.T) {
+	// Without header
+	members, err := parseMemberUsersCSV("a@example.com,admin\nb@example.com, viewer\n")
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	require.Equal(t, "a@example.com", members[0].Email)
+	require.Equal(t, "admin", members[0].Role)
+	require.Equal(t, "b@example.com", members[1].Email)
+	require.Equal(t, "viewer", members[1].Role)
+
+	// With header in a different order
+	members, err = parseMemberUsersCSV("Role,Email\nviewer,c@example.com\n")
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, "c@example.com", members[0].Email)
+	require.Equal(t, "viewer", members[0].Role)
+
+	// Missing role
+	members, err = parseMemberUsersCSV("d@example.com\n")
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, "", members[0].Role)
+
+	// Malformed
+	_, err = parseMemberUsersCSV("\"e@example.com,admin\n")
+	require.Error(t, err)
+}
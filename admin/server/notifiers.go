@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// redactedNotifierProperties are the notifier properties that are not returned by the API.
+var redactedNotifierProperties = []string{"secret"}
+
+func (s *Server) ListOrganizationNotifiers(ctx context.Context, req *adminv1.ListOrganizationNotifiersRequest) (*adminv1.ListOrganizationNotifiersResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !(claims.OrganizationPermissions(ctx, org.ID).ManageOrg || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can list notifiers")
+	}
+
+	notifiers, err := s.admin.DB.FindOrganizationNotifiers(ctx, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.OrganizationNotifier, len(notifiers))
+	for i, n := range notifiers {
+		dtos[i], err = orgNotifierToPB(n)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &adminv1.ListOrganizationNotifiersResponse{
+		Notifiers: dtos,
+	}, nil
+}
+
+func (s *Server) CreateOrganizationNotifier(ctx context.Context, req *adminv1.CreateOrganizationNotifierRequest) (*adminv1.CreateOrganizationNotifierResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+		attribute.String("args.connector", req.Connector),
+		attribute.StringSlice("args.events", req.Events),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !(claims.OrganizationPermissions(ctx, org.ID).ManageOrg || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can create notifiers")
+	}
+
+	props := req.Properties.AsMap()
+	_, err = s.admin.OpenNotifier(req.Connector, props)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = admin.ValidateNotificationEvents(req.Events)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	n, err := s.admin.DB.InsertOrganizationNotifier(ctx, &database.InsertOrganizationNotifierOptions{
+		OrgID:      org.ID,
+		Name:       req.Name,
+		Connector:  req.Connector,
+		Properties: props,
+		Events:     req.Events,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dto, err := orgNotifierToPB(n)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.CreateOrganizationNotifierResponse{
+		Notifier: dto,
+	}, nil
+}
+
+func (s *Server) DeleteOrganizationNotifier(ctx context.Context, req *adminv1.DeleteOrganizationNotifierRequest) (*adminv1.DeleteOrganizationNotifierResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !(claims.OrganizationPermissions(ctx, org.ID).ManageOrg || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can delete notifiers")
+	}
+
+	n, err := s.admin.DB.FindOrganizationNotifierByName(ctx, org.ID, req.Name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "notifier not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.admin.DB.DeleteOrganizationNotifier(ctx, n.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DeleteOrganizationNotifierResponse{}, nil
+}
+
+func orgNotifierToPB(n *database.OrganizationNotifier) (*adminv1.OrganizationNotifier, error) {
+	props := make(map[string]any, len(n.Properties))
+	for k, v := range n.Properties {
+		props[k] = v
+	}
+	for _, k := range redactedNotifierProperties {
+		if _, ok := props[k]; ok {
+			props[k] = "[redacted]"
+		}
+	}
+
+	propsPB, err := structpb.NewStruct(props)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.OrganizationNotifier{
+		Id:         n.ID,
+		Name:       n.Name,
+		Connector:  n.Connector,
+		Properties: propsPB,
+		Events:     n.Events,
+		CreatedOn:  timestamppb.New(n.CreatedOn),
+		UpdatedOn:  timestamppb.New(n.UpdatedOn),
+	}, nil
+}
@@ -3,16 +3,21 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/billing"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/publicemail"
 	"github.com/rilldata/rill/admin/server/auth"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/maintenancewindow"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
@@ -21,6 +26,12 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// primaryColorRegexp matches the hex colors allowed for an org's branding, e.g. "#1e40af" or "#fff".
+var primaryColorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// customDomainRegexp matches the domains allowed as an org's custom domain, e.g. "analytics.example.com".
+var customDomainRegexp = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
 func (s *Server) ListOrganizations(ctx context.Context, req *adminv1.ListOrganizationsRequest) (*adminv1.ListOrganizationsResponse, error) {
 	// Check the request is made by an authenticated user
 	claims := auth.GetClaims(ctx)
@@ -105,6 +116,8 @@ func (s *Server) CreateOrganization(ctx context.Context, req *adminv1.CreateOrga
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.org", req.Name),
 		attribute.String("args.description", req.Description),
+		attribute.String("args.external_id", req.ExternalId),
+		attribute.Bool("args.upsert", req.Upsert),
 	)
 
 	// Check the request is made by an authenticated user
@@ -113,6 +126,22 @@ func (s *Server) CreateOrganization(ctx context.Context, req *adminv1.CreateOrga
 		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
 	}
 
+	// If the org already exists, return or update it instead of failing.
+	// This makes retries from infrastructure-as-code tools idempotent.
+	var existing *database.Organization
+	var err error
+	if req.ExternalId != "" {
+		existing, err = s.admin.DB.FindOrganizationByExternalID(ctx, req.ExternalId)
+	} else if req.Upsert {
+		existing, err = s.admin.DB.FindOrganizationByName(ctx, req.Name)
+	}
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if existing != nil {
+		return s.upsertOrganization(ctx, existing, req)
+	}
+
 	// check single user org limit for this user
 	user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
 	if err != nil {
@@ -126,7 +155,12 @@ func (s *Server) CreateOrganization(ctx context.Context, req *adminv1.CreateOrga
 		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: you can only create %d single-user orgs", user.QuotaSingleuserOrgs)
 	}
 
-	org, err := s.admin.CreateOrganizationForUser(ctx, user.ID, req.Name, req.Description)
+	var externalID *string
+	if req.ExternalId != "" {
+		externalID = &req.ExternalId
+	}
+
+	org, err := s.admin.CreateOrganizationForUser(ctx, user.ID, req.Name, req.Description, externalID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -136,6 +170,58 @@ func (s *Server) CreateOrganization(ctx context.Context, req *adminv1.CreateOrga
 	}, nil
 }
 
+// upsertOrganization handles a CreateOrganization request for an org that already exists.
+// The org is returned as is unless the request sets upsert, in which case its name and description are updated to match the request.
+func (s *Server) upsertOrganization(ctx context.Context, org *database.Organization, req *adminv1.CreateOrganizationRequest) (*adminv1.CreateOrganizationResponse, error) {
+	claims := auth.GetClaims(ctx)
+	perms := claims.OrganizationPermissions(ctx, org.ID)
+	if !perms.ReadOrg {
+		return nil, status.Error(codes.AlreadyExists, "org already exists")
+	}
+
+	if !req.Upsert || (org.Name == req.Name && org.Description == req.Description) {
+		return &adminv1.CreateOrganizationResponse{
+			Organization: organizationToDTO(org),
+		}, nil
+	}
+
+	if !perms.ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to update org")
+	}
+
+	nameChanged := org.Name != req.Name
+	org, err := s.admin.DB.UpdateOrganization(ctx, org.ID, &database.UpdateOrganizationOptions{
+		Name:                                req.Name,
+		Description:                         req.Description,
+		QuotaProjects:                       org.QuotaProjects,
+		QuotaDeployments:                    org.QuotaDeployments,
+		QuotaSlotsTotal:                     org.QuotaSlotsTotal,
+		QuotaSlotsPerDeployment:             org.QuotaSlotsPerDeployment,
+		QuotaOutstandingInvites:             org.QuotaOutstandingInvites,
+		QuotaStorageLimitBytesPerDeployment: org.QuotaStorageLimitBytesPerDeployment,
+		BillingCustomerID:                   org.BillingCustomerID,
+		MaintenanceWindow:                   org.MaintenanceWindow,
+		LogoURL:                             org.LogoURL,
+		PrimaryColor:                        org.PrimaryColor,
+		SupportEmail:                        org.SupportEmail,
+		CustomDomain:                        org.CustomDomain,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if nameChanged {
+		err := s.admin.UpdateOrgDeploymentAnnotations(ctx, org)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &adminv1.CreateOrganizationResponse{
+		Organization: organizationToDTO(org),
+	}, nil
+}
+
 func (s *Server) DeleteOrganization(ctx context.Context, req *adminv1.DeleteOrganizationRequest) (*adminv1.DeleteOrganizationResponse, error) {
 	observability.AddRequestAttributes(ctx, attribute.String("args.org", req.Name))
 
@@ -173,6 +259,21 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 	if req.NewName != nil {
 		observability.AddRequestAttributes(ctx, attribute.String("args.new_name", *req.NewName))
 	}
+	if req.MaintenanceWindow != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.maintenance_window", *req.MaintenanceWindow))
+	}
+	if req.LogoUrl != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.logo_url", *req.LogoUrl))
+	}
+	if req.PrimaryColor != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.primary_color", *req.PrimaryColor))
+	}
+	if req.SupportEmail != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.support_email", *req.SupportEmail))
+	}
+	if req.CustomDomain != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.custom_domain", *req.CustomDomain))
+	}
 
 	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Name)
 	if err != nil {
@@ -184,7 +285,28 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 		return nil, status.Error(codes.PermissionDenied, "not allowed to update org")
 	}
 
+	if req.MaintenanceWindow != nil {
+		err = maintenancewindow.Validate(*req.MaintenanceWindow)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	err = validateOrganizationBranding(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.CustomDomain != nil {
+		domain := strings.ToLower(strings.TrimSpace(*req.CustomDomain))
+		req.CustomDomain = &domain
+		if domain != org.CustomDomain && !claims.Superuser(ctx) {
+			return nil, status.Error(codes.PermissionDenied, "only superusers can change the custom domain")
+		}
+	}
+
 	nameChanged := req.NewName != nil && *req.NewName != org.Name
+	windowChanged := req.MaintenanceWindow != nil && *req.MaintenanceWindow != org.MaintenanceWindow
 	org, err = s.admin.DB.UpdateOrganization(ctx, org.ID, &database.UpdateOrganizationOptions{
 		Name:                                valOrDefault(req.NewName, org.Name),
 		Description:                         valOrDefault(req.Description, org.Description),
@@ -195,12 +317,20 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 		QuotaOutstandingInvites:             org.QuotaOutstandingInvites,
 		QuotaStorageLimitBytesPerDeployment: org.QuotaStorageLimitBytesPerDeployment,
 		BillingCustomerID:                   org.BillingCustomerID,
+		MaintenanceWindow:                   valOrDefault(req.MaintenanceWindow, org.MaintenanceWindow),
+		LogoURL:                             valOrDefault(req.LogoUrl, org.LogoURL),
+		PrimaryColor:                        valOrDefault(req.PrimaryColor, org.PrimaryColor),
+		SupportEmail:                        valOrDefault(req.SupportEmail, org.SupportEmail),
+		CustomDomain:                        valOrDefault(req.CustomDomain, org.CustomDomain),
 	})
 	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	if nameChanged {
+	if nameChanged || windowChanged {
 		err := s.admin.UpdateOrgDeploymentAnnotations(ctx, org)
 		if err != nil {
 			return nil, err
@@ -307,6 +437,11 @@ func (s *Server) UpdateOrganizationBillingSubscription(ctx context.Context, req
 		QuotaOutstandingInvites:             valOrDefault(plan.Quotas.NumOutstandingInvites, org.QuotaOutstandingInvites),
 		QuotaStorageLimitBytesPerDeployment: valOrDefault(plan.Quotas.StorageLimitBytesPerDeployment, org.QuotaStorageLimitBytesPerDeployment),
 		BillingCustomerID:                   org.BillingCustomerID,
+		MaintenanceWindow:                   org.MaintenanceWindow,
+		LogoURL:                             org.LogoURL,
+		PrimaryColor:                        org.PrimaryColor,
+		SupportEmail:                        org.SupportEmail,
+		CustomDomain:                        org.CustomDomain,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -395,9 +530,25 @@ func (s *Server) ListOrganizationMemberUsers(ctx context.Context, req *adminv1.L
 		nextToken = marshalPageToken(members[len(members)-1].Email)
 	}
 
+	userIDs := make([]string, len(members))
+	for i, user := range members {
+		userIDs[i] = user.ID
+	}
+	activity, err := s.admin.DB.FindOrganizationMemberUsersActivity(ctx, org.ID, userIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	activityByUser := make(map[string]*database.MemberUserActivity, len(activity))
+	for _, a := range activity {
+		activityByUser[a.UserID] = a
+	}
+
 	dtos := make([]*adminv1.MemberUser, len(members))
 	for i, user := range members {
 		dtos[i] = memberUserToPB(user)
+		if a, ok := activityByUser[user.ID]; ok {
+			setMemberUserActivityPB(dtos[i], a)
+		}
 	}
 
 	return &adminv1.ListOrganizationMemberUsersResponse{
@@ -508,8 +659,8 @@ func (s *Server) AddOrganizationMemberUser(ctx context.Context, req *adminv1.Add
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
-		// Send invitation email
-		err = s.admin.Email.SendOrganizationInvite(&email.OrganizationInvite{
+		// Enqueue invitation email
+		_, err = s.admin.EnqueueJob(ctx, admin.JobKindSendOrganizationInvite, &email.OrganizationInvite{
 			ToEmail:       req.Email,
 			ToName:        "",
 			AdminURL:      s.opts.ExternalURL,
@@ -563,6 +714,8 @@ func (s *Server) AddOrganizationMemberUser(ctx context.Context, req *adminv1.Add
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.admin.NotifyInviteReceived(ctx, user.ID, org, nil, role.Name, invitedByName)
+
 	return &adminv1.AddOrganizationMemberUserResponse{
 		PendingSignup: false,
 	}, nil
@@ -797,6 +950,9 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		attribute.String("args.role", req.Role),
 	)
 
+	// Domains are matched case-insensitively, so we store them in lower case
+	req.Domain = strings.ToLower(req.Domain)
+
 	claims := auth.GetClaims(ctx)
 	if claims.OwnerType() != auth.OwnerTypeUser {
 		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
@@ -819,7 +975,7 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		if !strings.HasSuffix(user.Email, "@"+req.Domain) {
+		if !strings.HasSuffix(strings.ToLower(user.Email), "@"+req.Domain) {
 			return nil, status.Error(codes.PermissionDenied, "Domain name doesn’t match verified email domain. Please contact Rill support.")
 		}
 
@@ -998,6 +1154,11 @@ func (s *Server) SudoUpdateOrganizationQuotas(ctx context.Context, req *adminv1.
 		QuotaSlotsPerDeployment:             int(valOrDefault(req.SlotsPerDeployment, uint32(org.QuotaSlotsPerDeployment))),
 		QuotaOutstandingInvites:             int(valOrDefault(req.OutstandingInvites, uint32(org.QuotaOutstandingInvites))),
 		QuotaStorageLimitBytesPerDeployment: int64(valOrDefault(req.StorageLimitBytesPerDeployment, uint64(org.QuotaStorageLimitBytesPerDeployment))),
+		MaintenanceWindow:                   org.MaintenanceWindow,
+		LogoURL:                             org.LogoURL,
+		PrimaryColor:                        org.PrimaryColor,
+		SupportEmail:                        org.SupportEmail,
+		CustomDomain:                        org.CustomDomain,
 	}
 
 	updatedOrg, err := s.admin.DB.UpdateOrganization(ctx, org.ID, opts)
@@ -1039,6 +1200,11 @@ func (s *Server) SudoUpdateOrganizationBillingCustomer(ctx context.Context, req
 		QuotaOutstandingInvites:             org.QuotaOutstandingInvites,
 		QuotaStorageLimitBytesPerDeployment: org.QuotaStorageLimitBytesPerDeployment,
 		BillingCustomerID:                   req.BillingCustomerId,
+		MaintenanceWindow:                   org.MaintenanceWindow,
+		LogoURL:                             org.LogoURL,
+		PrimaryColor:                        org.PrimaryColor,
+		SupportEmail:                        org.SupportEmail,
+		CustomDomain:                        org.CustomDomain,
 	}
 
 	org, err = s.admin.DB.UpdateOrganization(ctx, org.ID, opts)
@@ -1096,11 +1262,44 @@ func organizationToDTO(o *database.Organization) *adminv1.Organization {
 			StorageLimitBytesPerDeployment: uint64(o.QuotaStorageLimitBytesPerDeployment),
 		},
 		BillingCustomerId: o.BillingCustomerID,
+		ExternalId:        safeStr(o.ExternalID),
+		MaintenanceWindow: o.MaintenanceWindow,
+		Branding:          organizationBrandingToDTO(o),
 		CreatedOn:         timestamppb.New(o.CreatedOn),
 		UpdatedOn:         timestamppb.New(o.UpdatedOn),
 	}
 }
 
+func organizationBrandingToDTO(o *database.Organization) *adminv1.OrganizationBranding {
+	return &adminv1.OrganizationBranding{
+		LogoUrl:      o.LogoURL,
+		PrimaryColor: o.PrimaryColor,
+		SupportEmail: o.SupportEmail,
+		CustomDomain: o.CustomDomain,
+	}
+}
+
+// validateOrganizationBranding validates the branding settings in an org update. Empty values are allowed and clear the setting.
+func validateOrganizationBranding(req *adminv1.UpdateOrganizationRequest) error {
+	if req.LogoUrl != nil && *req.LogoUrl != "" {
+		u, err := url.Parse(*req.LogoUrl)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("invalid logo URL %q: must be an https URL", *req.LogoUrl)
+		}
+	}
+	if req.PrimaryColor != nil && *req.PrimaryColor != "" {
+		if !primaryColorRegexp.MatchString(*req.PrimaryColor) {
+			return fmt.Errorf("invalid primary color %q: must be a hex color like \"#1e40af\"", *req.PrimaryColor)
+		}
+	}
+	if req.CustomDomain != nil && *req.CustomDomain != "" {
+		if !customDomainRegexp.MatchString(strings.ToLower(strings.TrimSpace(*req.CustomDomain))) {
+			return fmt.Errorf("invalid custom domain %q: must be a domain name like \"analytics.example.com\"", *req.CustomDomain)
+		}
+	}
+	return nil
+}
+
 func subscriptionToDTO(sub *billing.Subscription) *adminv1.Subscription {
 	return &adminv1.Subscription{
 		Id:                           sub.ID,
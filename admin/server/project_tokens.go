@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) IssueProjectToken(ctx context.Context, req *adminv1.IssueProjectTokenRequest) (*adminv1.IssueProjectTokenResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.role", req.Role),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("project %q not found", req.Project))
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Only project admins can issue project tokens. Project tokens can't be used to issue other project tokens.
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser && claims.OwnerType() != auth.OwnerTypeService {
+		return nil, status.Error(codes.PermissionDenied, "only users and services can issue project tokens")
+	}
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProject {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to issue project tokens")
+	}
+
+	role, err := s.admin.DB.FindProjectRole(ctx, req.Role)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("project role %q not found", req.Role))
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	opts := &admin.IssueProjectAuthTokenOptions{
+		ProjectID:     proj.ID,
+		ProjectRoleID: role.ID,
+		DisplayName:   req.DisplayName,
+	}
+
+	if req.TtlMinutes != 0 {
+		ttl := time.Duration(req.TtlMinutes) * time.Minute
+		opts.TTL = &ttl
+	}
+
+	if claims.OwnerType() == auth.OwnerTypeUser {
+		id := claims.OwnerID()
+		opts.CreatedByUserID = &id
+	}
+
+	token, err := s.admin.IssueProjectAuthToken(ctx, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	mdl, ok := token.TokenModel().(*database.ProjectAuthToken)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected type %T for project token model", token.TokenModel())
+	}
+
+	return &adminv1.IssueProjectTokenResponse{
+		Token:        token.Token().String(),
+		ProjectToken: projectTokenToPB(&database.ProjectAuthTokenWithUser{ProjectAuthToken: mdl}),
+	}, nil
+}
+
+func (s *Server) ListProjectTokens(ctx context.Context, req *adminv1.ListProjectTokensRequest) (*adminv1.ListProjectTokensResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+	)
+
+	token, err := unmarshalPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("project %q not found", req.Project))
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProject {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to list project tokens")
+	}
+
+	tokens, err := s.admin.DB.FindProjectAuthTokensWithUser(ctx, proj.ID, token.Val, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPageToken := ""
+	if len(tokens) >= pageSize {
+		nextPageToken = marshalPageToken(tokens[len(tokens)-1].ID)
+	}
+
+	pbs := make([]*adminv1.ProjectToken, len(tokens))
+	for i, tkn := range tokens {
+		pbs[i] = projectTokenToPB(tkn)
+	}
+
+	return &adminv1.ListProjectTokensResponse{
+		Tokens:        pbs,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Server) RevokeProjectToken(ctx context.Context, req *adminv1.RevokeProjectTokenRequest) (*adminv1.RevokeProjectTokenResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.token_id", req.TokenId),
+	)
+
+	tkn, err := s.admin.DB.FindProjectAuthToken(ctx, req.TokenId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	proj, err := s.admin.DB.FindProject(ctx, tkn.ProjectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find project for token: %v", err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProject {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to revoke project tokens")
+	}
+
+	err = s.admin.DB.DeleteProjectAuthToken(ctx, tkn.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.RevokeProjectTokenResponse{}, nil
+}
+
+func projectTokenToPB(tkn *database.ProjectAuthTokenWithUser) *adminv1.ProjectToken {
+	res := &adminv1.ProjectToken{
+		Id:                 tkn.ID,
+		ProjectId:          tkn.ProjectID,
+		Role:               tkn.ProjectRoleName,
+		DisplayName:        tkn.DisplayName,
+		CreatedOn:          timestamppb.New(tkn.CreatedOn),
+		UsedOn:             timestamppb.New(tkn.UsedOn),
+		CreatedByUserId:    safeStr(tkn.CreatedByUserID),
+		CreatedByUserEmail: safeStr(tkn.CreatedByUserEmail),
+	}
+	if tkn.ExpiresOn != nil {
+		res.ExpiresOn = timestamppb.New(*tkn.ExpiresOn)
+	}
+	return res
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"net/url"
+	"regexp"
 	"strings"
 	"time"
 
@@ -16,11 +18,12 @@ import (
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
-	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
 	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/maintenancewindow"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -38,9 +41,25 @@ const runtimeAccessTokenDefaultTTL = 30 * time.Minute
 // It can be overridden to a lower value when issued for high-risk embed users.
 const runtimeAccessTokenEmbedTTL = 24 * time.Hour
 
+// maxProjectLabels is the max number of labels that can be set on a project.
+const maxProjectLabels = 64
+
+// projectHealthTimeout bounds the time GetProject waits for the runtime when adding a health summary to its response.
+const projectHealthTimeout = 5 * time.Second
+
+// maxParseErrorSnippets is the max number of parse errors included in a project's health summary.
+const maxParseErrorSnippets = 5
+
+// maxParseErrorSnippetLength is the max length of a parse error snippet in a project's health summary.
+const maxParseErrorSnippetLength = 200
+
+// projectLabelKeyRegexp matches valid project label keys.
+var projectLabelKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.\-]{0,62}$`)
+
 func (s *Server) ListProjectsForOrganization(ctx context.Context, req *adminv1.ListProjectsForOrganizationRequest) (*adminv1.ListProjectsForOrganizationResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.org", req.OrganizationName),
+		attribute.Int("args.labels", len(req.Labels)),
 	)
 
 	org, err := s.admin.DB.FindOrganizationByName(ctx, req.OrganizationName)
@@ -58,13 +77,13 @@ func (s *Server) ListProjectsForOrganization(ctx context.Context, req *adminv1.L
 	claims := auth.GetClaims(ctx)
 	var projs []*database.Project
 	if claims.OrganizationPermissions(ctx, org.ID).ManageProjects {
-		projs, err = s.admin.DB.FindProjectsForOrganization(ctx, org.ID, token.Val, pageSize)
+		projs, err = s.admin.DB.FindProjectsForOrganization(ctx, org.ID, token.Val, req.Labels, pageSize)
 	} else if claims.OwnerType() == auth.OwnerTypeUser {
 		// Get projects the user is a (direct or group) member of (note: the user can be a member of a project in the org, without being a member of org - we call this an "outside member")
 		// plus all public projects
-		projs, err = s.admin.DB.FindProjectsForOrgAndUser(ctx, org.ID, claims.OwnerID(), token.Val, pageSize)
+		projs, err = s.admin.DB.FindProjectsForOrgAndUser(ctx, org.ID, claims.OwnerID(), token.Val, req.Labels, pageSize)
 	} else {
-		projs, err = s.admin.DB.FindPublicProjectsInOrganization(ctx, org.ID, token.Val, pageSize)
+		projs, err = s.admin.DB.FindPublicProjectsInOrganization(ctx, org.ID, token.Val, req.Labels, pageSize)
 	}
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -117,6 +136,18 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		permissions.ReadProject = true
 		permissions.ReadProd = true
 	}
+
+	// If the client can't otherwise read the project, it may still be allowed to read the project's public metrics views.
+	if !permissions.ReadProject && !claims.Superuser(ctx) {
+		publicMetricsViews, err := s.admin.DB.FindProjectPublicMetricsViews(ctx, proj.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if len(publicMetricsViews) > 0 {
+			return s.getProjectForPublicMetricsViews(ctx, req, org, proj, permissions, publicMetricsViews)
+		}
+	}
+
 	if claims.Superuser(ctx) {
 		permissions.ReadProject = true
 		permissions.ReadProd = true
@@ -148,6 +179,7 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 
 	var attr map[string]any
 	var rules []*runtimev1.SecurityRule
+	var resources []*runtimev1.ResourceName
 	if claims.OwnerType() == auth.OwnerTypeUser {
 		attr, err = s.jwtAttributesForUser(ctx, claims.OwnerID(), proj.OrganizationID, permissions)
 		if err != nil {
@@ -155,6 +187,9 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		}
 	} else if claims.OwnerType() == auth.OwnerTypeService {
 		attr = map[string]any{"admin": true}
+	} else if claims.OwnerType() == auth.OwnerTypeProjectToken {
+		// Project tokens are only admins if their role can manage the project
+		attr = map[string]any{"admin": permissions.ManageProject}
 	} else if claims.OwnerType() == auth.OwnerTypeMagicAuthToken {
 		mdl, ok := claims.AuthTokenModel().(*database.MagicAuthToken)
 		if !ok {
@@ -163,20 +198,8 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 
 		attr = mdl.Attributes
 
-		// Deny access to all resources except themes and mdl.MetricsView
-		rules = append(rules, &runtimev1.SecurityRule{
-			Rule: &runtimev1.SecurityRule_Access{
-				Access: &runtimev1.SecurityRuleAccess{
-					Condition: fmt.Sprintf(
-						"NOT ('{{.self.kind}}'='%s' OR '{{.self.kind}}'='%s' AND '{{ .self.name }}'=%s)",
-						runtime.ResourceKindTheme,
-						runtime.ResourceKindMetricsView,
-						duckdbsql.EscapeStringValue(mdl.MetricsView),
-					),
-					Allow: false,
-				},
-			},
-		})
+		// Restrict access to mdl.MetricsView (themes remain accessible)
+		resources = append(resources, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: mdl.MetricsView})
 
 		if mdl.MetricsViewFilterJSON != "" {
 			expr := &runtimev1.Expression{}
@@ -225,8 +248,9 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 				runtimeauth.ReadAPI,
 			},
 		},
-		Attributes:    attr,
-		SecurityRules: rules,
+		Attributes:       attr,
+		SecurityRules:    rules,
+		AllowedResources: resources,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
@@ -234,11 +258,82 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 
 	s.admin.Used.Deployment(depl.ID)
 
+	// Add a health summary of the deployment's resources.
+	// It's best effort since the project is still usable if the runtime can't be reached.
+	var health *adminv1.ProjectHealth
+	if permissions.ReadProdStatus && depl.Status == database.DeploymentStatusOK {
+		healthCtx, cancel := context.WithTimeout(ctx, projectHealthTimeout)
+		h, err := s.admin.DeploymentHealth(healthCtx, depl)
+		cancel()
+		if err != nil {
+			s.logger.Warn("failed to get deployment health", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+		} else {
+			health = deploymentHealthToDTO(h)
+		}
+	}
+
 	return &adminv1.GetProjectResponse{
 		Project:            s.projToDTO(proj, org.Name),
 		ProdDeployment:     deploymentToDTO(depl),
 		Jwt:                jwt,
 		ProjectPermissions: permissions,
+		ProdHealth:         health,
+	}, nil
+}
+
+// getProjectForPublicMetricsViews serves GetProject for clients that can't read the project, but where the project has public metrics views.
+// The returned JWT can only read the public metrics views, and the response omits the deployment's details.
+func (s *Server) getProjectForPublicMetricsViews(ctx context.Context, req *adminv1.GetProjectRequest, org *database.Organization, proj *database.Project, permissions *adminv1.ProjectPermissions, publicMetricsViews []string) (*adminv1.GetProjectResponse, error) {
+	if proj.ProdDeploymentID == nil {
+		return &adminv1.GetProjectResponse{
+			Project:            s.projToDTO(proj, org.Name),
+			ProjectPermissions: permissions,
+		}, nil
+	}
+
+	depl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Restrict access to the public metrics views (themes remain accessible)
+	resources := make([]*runtimev1.ResourceName, len(publicMetricsViews))
+	for i, mv := range publicMetricsViews {
+		resources[i] = &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: mv}
+	}
+
+	ttlDuration := runtimeAccessTokenDefaultTTL
+	if req.AccessTokenTtlSeconds != 0 {
+		ttlDuration = time.Duration(req.AccessTokenTtlSeconds) * time.Second
+	}
+
+	jwt, err := s.issuer.NewToken(runtimeauth.TokenOptions{
+		AudienceURL: depl.RuntimeAudience,
+		Subject:     auth.GetClaims(ctx).OwnerID(),
+		TTL:         ttlDuration,
+		InstancePermissions: map[string][]runtimeauth.Permission{
+			depl.RuntimeInstanceID: {
+				runtimeauth.ReadObjects,
+				runtimeauth.ReadMetrics,
+			},
+		},
+		AllowedResources: resources,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
+	}
+
+	s.admin.Used.Deployment(depl.ID)
+
+	return &adminv1.GetProjectResponse{
+		Project: s.projToDTO(proj, org.Name),
+		// Only include what's needed to connect to the runtime with the JWT
+		ProdDeployment: &adminv1.Deployment{
+			RuntimeHost:       depl.RuntimeHost,
+			RuntimeInstanceId: depl.RuntimeInstanceID,
+		},
+		Jwt:                jwt,
+		ProjectPermissions: permissions,
 	}, nil
 }
 
@@ -249,6 +344,9 @@ func (s *Server) GetProjectByID(ctx context.Context, req *adminv1.GetProjectByID
 
 	proj, err := s.admin.DB.FindProject(ctx, req.Id)
 	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "project %q not found", req.Id)
+		}
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -329,6 +427,8 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 		attribute.String("args.prod_branch", req.ProdBranch),
 		attribute.String("args.github_url", req.GithubUrl),
 		attribute.String("args.archive_asset_id", req.ArchiveAssetId),
+		attribute.String("args.external_id", req.ExternalId),
+		attribute.Bool("args.upsert", req.Upsert),
 	)
 
 	// Check the request is made by a user
@@ -344,35 +444,30 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Check permissions
-	if !claims.OrganizationPermissions(ctx, org.ID).CreateProjects {
-		return nil, status.Error(codes.PermissionDenied, "does not have permission to create projects")
+	// If the project already exists, return or update it instead of failing.
+	// This makes retries from infrastructure-as-code tools idempotent.
+	var existing *database.Project
+	if req.ExternalId != "" {
+		existing, err = s.admin.DB.FindProjectByExternalID(ctx, org.ID, req.ExternalId)
+	} else if req.Upsert {
+		existing, err = s.admin.DB.FindProjectByName(ctx, org.Name, req.Name)
 	}
-
-	// Check projects quota
-	count, err := s.admin.DB.CountProjectsForOrganization(ctx, org.ID)
-	if err != nil {
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	if org.QuotaProjects >= 0 && count >= org.QuotaProjects {
-		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: org %q is limited to %d projects", org.Name, org.QuotaProjects)
+	if existing != nil {
+		return s.upsertProject(ctx, org, existing, req)
 	}
 
-	// Check slots per deployment quota
-	if org.QuotaSlotsPerDeployment >= 0 && int(req.ProdSlots) > org.QuotaSlotsPerDeployment {
-		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: org can't provision more than %d slots per deployment", org.QuotaSlotsPerDeployment)
+	// Check permissions
+	if !claims.OrganizationPermissions(ctx, org.ID).CreateProjects {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to create projects")
 	}
 
-	// Check per project deployments and slots limit
-	stats, err := s.admin.DB.CountDeploymentsForOrganization(ctx, org.ID)
+	// Check quotas
+	err = s.checkCreateProjectQuotas(ctx, org, int(req.ProdSlots))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	if org.QuotaDeployments >= 0 && stats.Deployments >= org.QuotaDeployments {
-		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: org %q is limited to %d deployments", org.Name, org.QuotaDeployments)
-	}
-	if org.QuotaSlotsTotal >= 0 && stats.Slots+int(req.ProdSlots) > org.QuotaSlotsTotal {
-		return nil, status.Errorf(codes.FailedPrecondition, "quota exceeded: org %q is limited to %d total slots", org.Name, org.QuotaSlotsTotal)
+		return nil, err
 	}
 
 	// Add prod TTL as 7 days if not a public project else infinite
@@ -386,6 +481,16 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 	if req.ProdVersion == "" {
 		req.ProdVersion = "latest"
 	}
+	err = s.admin.ValidateRuntimeVersion(req.ProdVersion)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	deployTrigger := deployTriggerFromDTO(req.DeployTrigger)
+	err = admin.ValidateDeployTrigger(deployTrigger, req.DeploySchedule)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	opts := &database.InsertProjectOptions{
 		OrganizationID:  org.ID,
@@ -400,6 +505,11 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 		ProdSlots:       int(req.ProdSlots),
 		ProdVariables:   req.Variables,
 		ProdTTLSeconds:  prodTTL,
+		DeployTrigger:   deployTrigger,
+		DeploySchedule:  req.DeploySchedule,
+	}
+	if req.ExternalId != "" {
+		opts.ExternalID = &req.ExternalId
 	}
 
 	if req.GithubUrl != "" {
@@ -433,6 +543,243 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 	}, nil
 }
 
+// upsertProject handles a CreateProject request for a project that already exists.
+// The project is returned as is unless the request sets upsert, in which case its settings are replaced with the request's,
+// applying the same defaults as when creating a project.
+// Fields that can only be set on creation (such as the GitHub URL or archive) must be empty or match the project.
+func (s *Server) upsertProject(ctx context.Context, org *database.Organization, proj *database.Project, req *adminv1.CreateProjectRequest) (*adminv1.CreateProjectResponse, error) {
+	claims := auth.GetClaims(ctx)
+	perms := claims.ProjectPermissions(ctx, org.ID, proj.ID)
+	if !perms.ReadProject {
+		return nil, status.Error(codes.AlreadyExists, "project already exists")
+	}
+
+	if !req.Upsert {
+		return &adminv1.CreateProjectResponse{
+			Project: s.projToDTO(proj, org.Name),
+		}, nil
+	}
+
+	if !perms.ManageProject {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to update project")
+	}
+
+	err := checkUpsertImmutableFields(proj, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Backwards compatibility: if prod version is not set, default to "latest"
+	if req.ProdVersion == "" {
+		req.ProdVersion = "latest"
+	}
+	err = s.admin.ValidateRuntimeVersion(req.ProdVersion)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	deployTrigger := deployTriggerFromDTO(req.DeployTrigger)
+	err = admin.ValidateDeployTrigger(deployTrigger, req.DeploySchedule)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var prodBranch string
+	if proj.GithubURL != nil {
+		if req.ProdBranch == "" {
+			return nil, status.Error(codes.InvalidArgument, "prod_branch must be set for projects connected to GitHub")
+		}
+		prodBranch = req.ProdBranch
+	}
+
+	// Add prod TTL as 7 days if not a public project else infinite
+	var prodTTL *int64
+	if !req.Public {
+		tmp := int64(prodDeplTTL.Seconds())
+		prodTTL = &tmp
+	}
+
+	opts := &database.UpdateProjectOptions{
+		Name:                 req.Name,
+		Description:          req.Description,
+		Public:               req.Public,
+		ArchiveAssetID:       proj.ArchiveAssetID,
+		GithubURL:            proj.GithubURL,
+		GithubInstallationID: proj.GithubInstallationID,
+		ProdVersion:          req.ProdVersion,
+		ProdBranch:           prodBranch,
+		ProdVariables:        proj.ProdVariables,
+		ProdDeploymentID:     proj.ProdDeploymentID,
+		ProdSlots:            int(req.ProdSlots),
+		ProdTTLSeconds:       prodTTL,
+		Provisioner:          req.Provisioner,
+		Annotations:          proj.Annotations,
+		Labels:               proj.Labels,
+		DeployTrigger:        deployTrigger,
+		DeploySchedule:       req.DeploySchedule,
+		DeployBlueGreen:      proj.DeployBlueGreen,
+		MaintenanceWindow:    proj.MaintenanceWindow,
+	}
+	proj, err = s.admin.UpdateProject(ctx, proj, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.CreateProjectResponse{
+		Project: s.projToDTO(proj, org.Name),
+	}, nil
+}
+
+// checkUpsertImmutableFields returns an InvalidArgument error if the request changes a field that can't be changed through an upsert.
+func checkUpsertImmutableFields(proj *database.Project, req *adminv1.CreateProjectRequest) error {
+	var field string
+	switch {
+	case req.GithubUrl != "" && (proj.GithubURL == nil || *proj.GithubURL != req.GithubUrl):
+		field = "github_url"
+	case req.ArchiveAssetId != "" && (proj.ArchiveAssetID == nil || *proj.ArchiveAssetID != req.ArchiveAssetId):
+		field = "archive_asset_id"
+	case req.Subpath != "" && req.Subpath != proj.Subpath:
+		field = "subpath"
+	case req.ProdOlapDriver != "" && req.ProdOlapDriver != proj.ProdOLAPDriver:
+		field = "prod_olap_driver"
+	case req.ProdOlapDsn != "" && req.ProdOlapDsn != proj.ProdOLAPDSN:
+		field = "prod_olap_dsn"
+	case len(req.Variables) > 0 && !maps.Equal(req.Variables, proj.ProdVariables):
+		field = "variables"
+	default:
+		return nil
+	}
+	return status.Errorf(codes.InvalidArgument, "%s can't be changed when upserting a project", field)
+}
+
+func (s *Server) CloneProject(ctx context.Context, req *adminv1.CloneProjectRequest) (*adminv1.CloneProjectResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.OrganizationName),
+		attribute.String("args.project", req.Name),
+		attribute.String("args.new_org", req.NewOrganizationName),
+		attribute.String("args.new_project", req.NewName),
+		attribute.String("args.prod_branch", req.ProdBranch),
+		attribute.Bool("args.strip_secrets", req.StripSecrets),
+		attribute.Bool("args.copy_members", req.CopyMembers),
+	)
+
+	// Check the request is made by a user
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
+	}
+	userID := claims.OwnerID()
+
+	// Find the source project
+	src, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !claims.ProjectPermissions(ctx, src.OrganizationID, src.ID).ManageProject {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to clone project")
+	}
+
+	// Find the target org
+	if req.NewOrganizationName == "" {
+		req.NewOrganizationName = req.OrganizationName
+	}
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.NewOrganizationName)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !claims.OrganizationPermissions(ctx, org.ID).CreateProjects {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to create projects")
+	}
+	sameOrg := org.ID == src.OrganizationID
+	if req.CopyMembers && !sameOrg {
+		return nil, status.Error(codes.InvalidArgument, "members can only be copied when cloning within the same organization")
+	}
+	// Credentials must not leak to another organization, so cross-org clones must strip variable values
+	if !req.StripSecrets && !sameOrg {
+		return nil, status.Error(codes.InvalidArgument, "strip_secrets must be set when cloning to another organization")
+	}
+
+	// Check quotas
+	err = s.checkCreateProjectQuotas(ctx, org, src.ProdSlots)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := cloneProjectVariables(src.ProdVariables, req.StripSecrets)
+
+	// The OLAP DSN may contain credentials for the source organization's OLAP, so it's not copied across organizations
+	olapDSN := src.ProdOLAPDSN
+	if !sameOrg {
+		olapDSN = ""
+	}
+
+	if req.Description == "" {
+		req.Description = src.Description
+	}
+
+	opts := &database.InsertProjectOptions{
+		OrganizationID:  org.ID,
+		Name:            req.NewName,
+		Description:     req.Description,
+		Public:          src.Public,
+		CreatedByUserID: &userID,
+		Provisioner:     src.Provisioner,
+		ProdVersion:     src.ProdVersion,
+		ProdOLAPDriver:  src.ProdOLAPDriver,
+		ProdOLAPDSN:     olapDSN,
+		ProdSlots:       src.ProdSlots,
+		ProdVariables:   variables,
+		ProdTTLSeconds:  src.ProdTTLSeconds,
+		Labels:          src.Labels,
+		DeployTrigger:   src.DeployTrigger,
+		DeploySchedule:  src.DeploySchedule,
+	}
+
+	if src.GithubURL != nil {
+		// Check Github app is installed and caller has access on the repo
+		installationID, err := s.getAndCheckGithubInstallationID(ctx, *src.GithubURL, userID)
+		if err != nil {
+			return nil, err
+		}
+		opts.GithubInstallationID = &installationID
+		opts.GithubURL = src.GithubURL
+		opts.ProdBranch = src.ProdBranch
+		opts.Subpath = src.Subpath
+		if req.ProdBranch != "" {
+			opts.ProdBranch = req.ProdBranch
+		}
+	} else {
+		if req.ProdBranch != "" {
+			return nil, status.Error(codes.InvalidArgument, "prod_branch can only be set for projects backed by Github")
+		}
+		if src.ArchiveAssetID == nil {
+			return nil, status.Error(codes.FailedPrecondition, "project has neither a Github repository nor an archive")
+		}
+		// Assets are scoped to an organization, so an archive can't be shared across organizations.
+		if !sameOrg {
+			return nil, status.Error(codes.InvalidArgument, "projects not backed by Github can only be cloned within the same organization")
+		}
+		opts.ArchiveAssetID = src.ArchiveAssetID
+	}
+
+	// Create the project
+	proj, err := s.admin.CreateProject(ctx, org, opts)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.CopyMembers {
+		err = s.copyProjectMembers(ctx, src, proj)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "project %q was created, but failed to copy members: %s", proj.Name, err.Error())
+		}
+	}
+
+	return &adminv1.CloneProjectResponse{
+		Project: s.projToDTO(proj, org.Name),
+	}, nil
+}
+
 func (s *Server) DeleteProject(ctx context.Context, req *adminv1.DeleteProjectRequest) (*adminv1.DeleteProjectResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.org", req.OrganizationName),
@@ -494,6 +841,9 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 	if req.NewName != nil {
 		observability.AddRequestAttributes(ctx, attribute.String("args.new_name", *req.NewName))
 	}
+	if len(req.Labels) > 0 {
+		observability.AddRequestAttributes(ctx, attribute.Int("args.labels", len(req.Labels)))
+	}
 
 	// Check the request is made by a user
 	claims := auth.GetClaims(ctx)
@@ -547,6 +897,41 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 		}
 	}
 
+	if req.ProdVersion != nil {
+		err = s.admin.ValidateRuntimeVersion(*req.ProdVersion)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	labels, err := mergeProjectLabels(proj.Labels, req.Labels)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	deployTrigger := proj.DeployTrigger
+	deploySchedule := proj.DeploySchedule
+	if req.DeployTrigger != nil {
+		deployTrigger = deployTriggerFromDTO(*req.DeployTrigger)
+		if deployTrigger != database.DeployTriggerSchedule {
+			deploySchedule = ""
+		}
+	}
+	if req.DeploySchedule != nil {
+		deploySchedule = *req.DeploySchedule
+	}
+	err = admin.ValidateDeployTrigger(deployTrigger, deploySchedule)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.MaintenanceWindow != nil {
+		err = maintenancewindow.Validate(*req.MaintenanceWindow)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	opts := &database.UpdateProjectOptions{
 		Name:                 valOrDefault(req.NewName, proj.Name),
 		Description:          valOrDefault(req.Description, proj.Description),
@@ -562,6 +947,11 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 		ProdTTLSeconds:       prodTTLSeconds,
 		Provisioner:          valOrDefault(req.Provisioner, proj.Provisioner),
 		Annotations:          proj.Annotations,
+		Labels:               labels,
+		DeployTrigger:        deployTrigger,
+		DeploySchedule:       deploySchedule,
+		DeployBlueGreen:      valOrDefault(req.DeployBlueGreen, proj.DeployBlueGreen),
+		MaintenanceWindow:    valOrDefault(req.MaintenanceWindow, proj.MaintenanceWindow),
 	}
 	proj, err = s.admin.UpdateProject(ctx, proj, opts)
 	if err != nil {
@@ -593,6 +983,13 @@ func (s *Server) GetProjectVariables(ctx context.Context, req *adminv1.GetProjec
 }
 
 func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.UpdateProjectVariablesRequest) (*adminv1.UpdateProjectVariablesResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.OrganizationName),
+		attribute.String("args.project", req.Name),
+		attribute.Bool("args.merge", req.Merge),
+		attribute.Bool("args.dry_run", req.DryRun),
+	)
+
 	proj, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Name)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -608,27 +1005,34 @@ func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.Update
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to update project variables")
 	}
 
-	proj, err = s.admin.UpdateProject(ctx, proj, &database.UpdateProjectOptions{
-		Name:                 proj.Name,
-		Description:          proj.Description,
-		Public:               proj.Public,
-		ArchiveAssetID:       proj.ArchiveAssetID,
-		GithubURL:            proj.GithubURL,
-		GithubInstallationID: proj.GithubInstallationID,
-		ProdVersion:          proj.ProdVersion,
-		ProdBranch:           proj.ProdBranch,
-		ProdVariables:        req.Variables,
-		ProdDeploymentID:     proj.ProdDeploymentID,
-		ProdSlots:            proj.ProdSlots,
-		ProdTTLSeconds:       proj.ProdTTLSeconds,
-		Provisioner:          proj.Provisioner,
-		Annotations:          proj.Annotations,
+	if len(req.UnsetVariables) > 0 && !req.Merge {
+		return nil, status.Error(codes.InvalidArgument, "unset_variables can only be used with merge")
+	}
+
+	res, err := s.admin.UpdateProjectVariables(ctx, proj, &admin.UpdateProjectVariablesOptions{
+		Variables: req.Variables,
+		Merge:     req.Merge,
+		Unset:     req.UnsetVariables,
+		DryRun:    req.DryRun,
 	})
 	if err != nil {
+		if errors.Is(err, admin.ErrInvalidProjectVariables) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "variables updated failed with error %s", err.Error())
 	}
 
-	return &adminv1.UpdateProjectVariablesResponse{Variables: proj.ProdVariables}, nil
+	affected := make([]*adminv1.AffectedResource, len(res.AffectedResources))
+	for i, r := range res.AffectedResources {
+		affected[i] = &adminv1.AffectedResource{Kind: r.Kind, Name: r.Name}
+	}
+
+	return &adminv1.UpdateProjectVariablesResponse{
+		Variables:         res.Variables,
+		ChangedVariables:  res.ChangedVariables,
+		AffectedResources: affected,
+		DeploymentUpdated: res.DeploymentUpdated,
+	}, nil
 }
 
 func (s *Server) ListProjectMemberUsers(ctx context.Context, req *adminv1.ListProjectMemberUsersRequest) (*adminv1.ListProjectMemberUsersResponse, error) {
@@ -780,8 +1184,8 @@ func (s *Server) AddProjectMemberUser(ctx context.Context, req *adminv1.AddProje
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
-		// Send invitation email
-		err = s.admin.Email.SendProjectInvite(&email.ProjectInvite{
+		// Enqueue invitation email
+		_, err = s.admin.EnqueueJob(ctx, admin.JobKindSendProjectInvite, &email.ProjectInvite{
 			ToEmail:       req.Email,
 			ToName:        "",
 			AdminURL:      s.opts.ExternalURL,
@@ -818,6 +1222,8 @@ func (s *Server) AddProjectMemberUser(ctx context.Context, req *adminv1.AddProje
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	s.admin.NotifyInviteReceived(ctx, user.ID, org, proj, role.Name, invitedByName)
+
 	return &adminv1.AddProjectMemberUserResponse{
 		PendingSignup: false,
 	}, nil
@@ -1045,6 +1451,11 @@ func (s *Server) SudoUpdateAnnotations(ctx context.Context, req *adminv1.SudoUpd
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
 		Provisioner:          proj.Provisioner,
 		Annotations:          req.Annotations,
+		Labels:               proj.Labels,
+		DeployTrigger:        proj.DeployTrigger,
+		DeploySchedule:       proj.DeploySchedule,
+		DeployBlueGreen:      proj.DeployBlueGreen,
+		MaintenanceWindow:    proj.MaintenanceWindow,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -1063,6 +1474,9 @@ func (s *Server) CreateProjectWhitelistedDomain(ctx context.Context, req *adminv
 		attribute.String("args.role", req.Role),
 	)
 
+	// Domains are matched case-insensitively, so we store them in lower case
+	req.Domain = strings.ToLower(req.Domain)
+
 	claims := auth.GetClaims(ctx)
 	if claims.OwnerType() != auth.OwnerTypeUser {
 		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
@@ -1085,7 +1499,7 @@ func (s *Server) CreateProjectWhitelistedDomain(ctx context.Context, req *adminv
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		if !strings.HasSuffix(user.Email, "@"+req.Domain) {
+		if !strings.HasSuffix(strings.ToLower(user.Email), "@"+req.Domain) {
 			return nil, status.Error(codes.PermissionDenied, "Domain name doesn’t match verified email domain. Please contact Rill support.")
 		}
 
@@ -1223,35 +1637,258 @@ func (s *Server) ListProjectWhitelistedDomains(ctx context.Context, req *adminv1
 	return &adminv1.ListProjectWhitelistedDomainsResponse{Domains: dtos}, nil
 }
 
+func (s *Server) ListProjectPublicMetricsViews(ctx context.Context, req *adminv1.ListProjectPublicMetricsViewsRequest) (*adminv1.ListProjectPublicMetricsViewsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !(claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ReadProject || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project")
+	}
+
+	mvs, err := s.admin.DB.FindProjectPublicMetricsViews(ctx, proj.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.ListProjectPublicMetricsViewsResponse{MetricsViews: mvs}, nil
+}
+
+func (s *Server) SetProjectPublicMetricsViews(ctx context.Context, req *adminv1.SetProjectPublicMetricsViewsRequest) (*adminv1.SetProjectPublicMetricsViewsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.StringSlice("args.metrics_views", req.MetricsViews),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !(claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProject || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only project admins can set public metrics views")
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.DeleteProjectPublicMetricsViews(ctx, proj.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, mv := range req.MetricsViews {
+		err = s.admin.DB.InsertProjectPublicMetricsView(ctx, proj.ID, mv)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.SetProjectPublicMetricsViewsResponse{}, nil
+}
+
 func (s *Server) projToDTO(p *database.Project, orgName string) *adminv1.Project {
 	frontendURL, _ := url.JoinPath(s.opts.FrontendURL, orgName, p.Name)
 
+	var pendingDeployOn *timestamppb.Timestamp
+	if p.PendingDeployOn != nil {
+		pendingDeployOn = timestamppb.New(*p.PendingDeployOn)
+	}
+
 	return &adminv1.Project{
-		Id:               p.ID,
-		Name:             p.Name,
-		OrgId:            p.OrganizationID,
-		OrgName:          orgName,
-		Description:      p.Description,
-		Public:           p.Public,
-		CreatedByUserId:  safeStr(p.CreatedByUserID),
-		Provisioner:      p.Provisioner,
-		ProdVersion:      p.ProdVersion,
-		ProdOlapDriver:   p.ProdOLAPDriver,
-		ProdOlapDsn:      p.ProdOLAPDSN,
-		ProdSlots:        int64(p.ProdSlots),
-		ProdBranch:       p.ProdBranch,
-		Subpath:          p.Subpath,
-		GithubUrl:        safeStr(p.GithubURL),
-		ArchiveAssetId:   safeStr(p.ArchiveAssetID),
-		ProdDeploymentId: safeStr(p.ProdDeploymentID),
-		ProdTtlSeconds:   safeInt64(p.ProdTTLSeconds),
-		FrontendUrl:      frontendURL,
-		Annotations:      p.Annotations,
-		CreatedOn:        timestamppb.New(p.CreatedOn),
-		UpdatedOn:        timestamppb.New(p.UpdatedOn),
+		Id:                p.ID,
+		Name:              p.Name,
+		OrgId:             p.OrganizationID,
+		OrgName:           orgName,
+		Description:       p.Description,
+		Public:            p.Public,
+		CreatedByUserId:   safeStr(p.CreatedByUserID),
+		Provisioner:       p.Provisioner,
+		ProdVersion:       p.ProdVersion,
+		ProdOlapDriver:    p.ProdOLAPDriver,
+		ProdOlapDsn:       p.ProdOLAPDSN,
+		ProdSlots:         int64(p.ProdSlots),
+		ProdBranch:        p.ProdBranch,
+		Subpath:           p.Subpath,
+		GithubUrl:         safeStr(p.GithubURL),
+		ArchiveAssetId:    safeStr(p.ArchiveAssetID),
+		ProdDeploymentId:  safeStr(p.ProdDeploymentID),
+		ProdTtlSeconds:    safeInt64(p.ProdTTLSeconds),
+		FrontendUrl:       frontendURL,
+		Annotations:       p.Annotations,
+		Labels:            p.Labels,
+		DeployTrigger:     deployTriggerToDTO(p.DeployTrigger),
+		DeploySchedule:    p.DeploySchedule,
+		DeployBlueGreen:   p.DeployBlueGreen,
+		MaintenanceWindow: p.MaintenanceWindow,
+		PendingDeploySha:  safeStr(p.PendingDeploySha),
+		PendingDeployOn:   pendingDeployOn,
+		ExternalId:        safeStr(p.ExternalID),
+		CreatedOn:         timestamppb.New(p.CreatedOn),
+		UpdatedOn:         timestamppb.New(p.UpdatedOn),
 	}
 }
 
+// mergeProjectLabels applies label updates to a project's current labels.
+// Updates with an empty value remove the label.
+func mergeProjectLabels(current, updates map[string]string) (map[string]string, error) {
+	res := make(map[string]string, len(current)+len(updates))
+	for k, v := range current {
+		res[k] = v
+	}
+
+	for k, v := range updates {
+		if v == "" {
+			delete(res, k)
+			continue
+		}
+		if !projectLabelKeyRegexp.MatchString(k) {
+			return nil, fmt.Errorf("invalid label key %q: must start with a letter or digit and contain at most 63 letters, digits, '-', '_' or '.'", k)
+		}
+		if len(v) > 255 {
+			return nil, fmt.Errorf("invalid value for label %q: must be at most 255 characters", k)
+		}
+		res[k] = v
+	}
+
+	if len(res) > maxProjectLabels {
+		return nil, fmt.Errorf("a project can have at most %d labels", maxProjectLabels)
+	}
+
+	return res, nil
+}
+
+// checkCreateProjectQuotas checks that the org has capacity for a new project with a prod deployment of the given number of slots.
+func (s *Server) checkCreateProjectQuotas(ctx context.Context, org *database.Organization, prodSlots int) error {
+	// Check projects quota
+	count, err := s.admin.DB.CountProjectsForOrganization(ctx, org.ID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if org.QuotaProjects >= 0 && count >= org.QuotaProjects {
+		return status.Errorf(codes.FailedPrecondition, "quota exceeded: org %q is limited to %d projects", org.Name, org.QuotaProjects)
+	}
+
+	// Check slots per deployment quota
+	if org.QuotaSlotsPerDeployment >= 0 && prodSlots > org.QuotaSlotsPerDeployment {
+		return status.Errorf(codes.FailedPrecondition, "quota exceeded: org can't provision more than %d slots per deployment", org.QuotaSlotsPerDeployment)
+	}
+
+	// Check per project deployments and slots limit
+	stats, err := s.admin.DB.CountDeploymentsForOrganization(ctx, org.ID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if org.QuotaDeployments >= 0 && stats.Deployments >= org.QuotaDeployments {
+		return status.Errorf(codes.FailedPrecondition, "quota exceeded: org %q is limited to %d deployments", org.Name, org.QuotaDeployments)
+	}
+	if org.QuotaSlotsTotal >= 0 && stats.Slots+prodSlots > org.QuotaSlotsTotal {
+		return status.Errorf(codes.FailedPrecondition, "quota exceeded: org %q is limited to %d total slots", org.Name, org.QuotaSlotsTotal)
+	}
+
+	return nil
+}
+
+// cloneProjectVariables copies a project's variables for a cloned project.
+// If strip is true, all values are cleared (not only secrets), so only the variable names are copied.
+func cloneProjectVariables(vars map[string]string, strip bool) map[string]string {
+	res := make(map[string]string, len(vars))
+	for k, v := range vars {
+		if strip {
+			v = ""
+		}
+		res[k] = v
+	}
+	return res
+}
+
+// copyProjectMembers adds the member users and usergroups of src to dst with the same roles.
+// Members that already exist in dst (such as the project creator) are skipped.
+func (s *Server) copyProjectMembers(ctx context.Context, src, dst *database.Project) error {
+	roles := make(map[string]string)
+	roleID := func(name string) (string, error) {
+		if id, ok := roles[name]; ok {
+			return id, nil
+		}
+		role, err := s.admin.DB.FindProjectRole(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		roles[name] = role.ID
+		return role.ID, nil
+	}
+
+	const pageSize = 1000
+
+	var afterEmail string
+	for {
+		users, err := s.admin.DB.FindProjectMemberUsers(ctx, src.ID, afterEmail, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, u := range users {
+			id, err := roleID(u.RoleName)
+			if err != nil {
+				return err
+			}
+			err = s.admin.DB.InsertProjectMemberUser(ctx, dst.ID, u.ID, id)
+			if err != nil && !errors.Is(err, database.ErrNotUnique) {
+				return err
+			}
+		}
+		if len(users) < pageSize {
+			break
+		}
+		afterEmail = users[len(users)-1].Email
+	}
+
+	var afterName string
+	for {
+		groups, err := s.admin.DB.FindProjectMemberUsergroups(ctx, src.ID, afterName, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, g := range groups {
+			id, err := roleID(g.RoleName)
+			if err != nil {
+				return err
+			}
+			err = s.admin.DB.InsertProjectMemberUsergroup(ctx, g.ID, dst.ID, id)
+			if err != nil && !errors.Is(err, database.ErrNotUnique) {
+				return err
+			}
+		}
+		if len(groups) < pageSize {
+			break
+		}
+		afterName = groups[len(groups)-1].Name
+	}
+
+	return nil
+}
+
 func (s *Server) hasAssetUsagePermission(ctx context.Context, id, orgID, ownerID string) bool {
 	asset, err := s.admin.DB.FindAsset(ctx, id)
 	if err != nil {
@@ -1260,6 +1897,60 @@ func (s *Server) hasAssetUsagePermission(ctx context.Context, id, orgID, ownerID
 	return asset.OrganizationID == orgID && asset.OwnerID == ownerID
 }
 
+func deployTriggerToDTO(t string) adminv1.DeployTrigger {
+	switch t {
+	case database.DeployTriggerPush:
+		return adminv1.DeployTrigger_DEPLOY_TRIGGER_PUSH
+	case database.DeployTriggerManual:
+		return adminv1.DeployTrigger_DEPLOY_TRIGGER_MANUAL
+	case database.DeployTriggerSchedule:
+		return adminv1.DeployTrigger_DEPLOY_TRIGGER_SCHEDULE
+	default:
+		return adminv1.DeployTrigger_DEPLOY_TRIGGER_UNSPECIFIED
+	}
+}
+
+// deployTriggerFromDTO converts a deploy trigger from the API to the database representation.
+// An unspecified trigger defaults to deploying on push.
+func deployTriggerFromDTO(t adminv1.DeployTrigger) string {
+	switch t {
+	case adminv1.DeployTrigger_DEPLOY_TRIGGER_MANUAL:
+		return database.DeployTriggerManual
+	case adminv1.DeployTrigger_DEPLOY_TRIGGER_SCHEDULE:
+		return database.DeployTriggerSchedule
+	default:
+		return database.DeployTriggerPush
+	}
+}
+
+func deploymentHealthToDTO(h *admin.DeploymentHealth) *adminv1.ProjectHealth {
+	n := min(len(h.ParseErrors), maxParseErrorSnippets)
+	snippets := make([]string, n)
+	for i, e := range h.ParseErrors[:n] {
+		snippet := e.Message
+		if e.FilePath != "" {
+			snippet = fmt.Sprintf("%s: %s", e.FilePath, e.Message)
+		}
+		if len(snippet) > maxParseErrorSnippetLength {
+			snippet = snippet[:maxParseErrorSnippetLength-3] + "..."
+		}
+		snippets[i] = snippet
+	}
+
+	var lastReconciledOn *timestamppb.Timestamp
+	if !h.LastReconciledOn.IsZero() {
+		lastReconciledOn = timestamppb.New(h.LastReconciledOn)
+	}
+
+	return &adminv1.ProjectHealth{
+		ErroredResources:   uint32(h.ErroredResources),
+		ParseErrors:        uint32(len(h.ParseErrors)),
+		ParseErrorSnippets: snippets,
+		LastReconciledOn:   lastReconciledOn,
+		Reconciling:        h.Reconciling,
+	}
+}
+
 func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 	var s adminv1.DeploymentStatus
 	switch d.Status {
@@ -1271,6 +1962,8 @@ func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 		s = adminv1.DeploymentStatus_DEPLOYMENT_STATUS_OK
 	case database.DeploymentStatusError:
 		s = adminv1.DeploymentStatus_DEPLOYMENT_STATUS_ERROR
+	case database.DeploymentStatusUnreachable:
+		s = adminv1.DeploymentStatus_DEPLOYMENT_STATUS_UNREACHABLE
 	default:
 		panic(fmt.Errorf("unhandled deployment status %d", d.Status))
 	}
@@ -1286,6 +1979,7 @@ func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 		StatusMessage:     d.StatusMessage,
 		CreatedOn:         timestamppb.New(d.CreatedOn),
 		UpdatedOn:         timestamppb.New(d.UpdatedOn),
+		RuntimeConfig:     d.RuntimeConfig,
 	}
 }
 
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/rilldata/rill/admin/database"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime"
+	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetProjectPublicMetricsViews(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestServer(t)
+
+	owner, ownerClient := srv.newUser(t, "owner@test.io")
+	_, otherClient := srv.newUser(t, "other@test.io")
+	anonClient := srv.client(t, "")
+
+	org, err := srv.admin.CreateOrganizationForUser(ctx, owner.ID, "org", "", nil)
+	require.NoError(t, err)
+	proj := srv.newArchiveProject(t, org, owner.ID, &database.InsertProjectOptions{Name: "proj"})
+
+	depl := srv.newProdDeployment(t, proj)
+
+	// Without public metrics views, non-members can't read the project
+	_, err = anonClient.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: "org", Name: "proj"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.NoError(t, srv.admin.DB.InsertProjectPublicMetricsView(ctx, proj.ID, "public_mv"))
+
+	for name, client := range map[string]adminv1.AdminServiceClient{"anonymous": anonClient, "non-member": otherClient} {
+		t.Run(name, func(t *testing.T) {
+			res, err := client.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: "org", Name: "proj"})
+			require.NoError(t, err)
+			require.False(t, res.ProjectPermissions.ReadProject)
+			require.False(t, res.ProjectPermissions.ReadProd)
+			require.False(t, res.ProjectPermissions.ReadProdStatus)
+			require.Nil(t, res.ProdHealth)
+
+			// Only the runtime connection details of the deployment are returned
+			require.Equal(t, depl.RuntimeHost, res.ProdDeployment.RuntimeHost)
+			require.Equal(t, depl.RuntimeInstanceID, res.ProdDeployment.RuntimeInstanceId)
+			require.Empty(t, res.ProdDeployment.Id)
+			require.Empty(t, res.ProdDeployment.Branch)
+			require.Empty(t, res.ProdDeployment.StatusMessage)
+
+			// The JWT can only read metrics and objects, and only for the public metrics views
+			claims := parseRuntimeJWT(t, res.Jwt)
+			require.ElementsMatch(t, []runtimeauth.Permission{runtimeauth.ReadObjects, runtimeauth.ReadMetrics}, claims.Instances[depl.RuntimeInstanceID])
+			require.Equal(t, []runtimeJWTResource{{Kind: runtime.ResourceKindMetricsView, Name: "public_mv"}}, claims.Resources)
+			require.Empty(t, claims.Attrs)
+		})
+	}
+
+	// Members still get full access
+	res, err := ownerClient.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: "org", Name: "proj"})
+	require.NoError(t, err)
+	require.True(t, res.ProjectPermissions.ReadProd)
+	require.Equal(t, depl.ID, res.ProdDeployment.Id)
+	claims := parseRuntimeJWT(t, res.Jwt)
+	require.Contains(t, claims.Instances[depl.RuntimeInstanceID], runtimeauth.ReadRepo)
+	require.Empty(t, claims.Resources)
+}
+
+// runtimeJWTClaims mirrors the claims of JWTs issued by runtimeauth.Issuer.
+type runtimeJWTClaims struct {
+	jwt.RegisteredClaims
+	Instances map[string][]runtimeauth.Permission `json:"ins"`
+	Attrs     map[string]any                      `json:"attr"`
+	Resources []runtimeJWTResource                `json:"res"`
+}
+
+type runtimeJWTResource struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// parseRuntimeJWT returns the claims of a runtime JWT without verifying its signature.
+func parseRuntimeJWT(t *testing.T, token string) *runtimeJWTClaims {
+	claims := &runtimeJWTClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(token, claims)
+	require.NoError(t, err)
+	return claims
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/admin/database"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckUpsertImmutableFields(t *testing.T) {
+	githubURL := "https://github.com/rilldata/example"
+	proj := &database.Project{
+		GithubURL:      &githubURL,
+		Subpath:        "project",
+		ProdOLAPDriver: "duckdb",
+		ProdVariables:  map[string]string{"foo": "bar"},
+	}
+
+	// Empty and unchanged fields are allowed
+	require.NoError(t, checkUpsertImmutableFields(proj, &adminv1.CreateProjectRequest{}))
+	require.NoError(t, checkUpsertImmutableFields(proj, &adminv1.CreateProjectRequest{
+		GithubUrl:      githubURL,
+		Subpath:        "project",
+		ProdOlapDriver: "duckdb",
+		Variables:      map[string]string{"foo": "bar"},
+	}))
+
+	// Changed fields are rejected
+	for field, req := range map[string]*adminv1.CreateProjectRequest{
+		"github_url":       {GithubUrl: "https://github.com/rilldata/other"},
+		"archive_asset_id": {ArchiveAssetId: "asset"},
+		"subpath":          {Subpath: "other"},
+		"prod_olap_driver": {ProdOlapDriver: "clickhouse"},
+		"prod_olap_dsn":    {ProdOlapDsn: "clickhouse://localhost"},
+		"variables":        {Variables: map[string]string{"foo": "baz"}},
+	} {
+		err := checkUpsertImmutableFields(proj, req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), field)
+		require.Contains(t, err.Error(), field)
+	}
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultProjectUsageWindow is the time range returned by GetProjectUsage when no start time is provided.
+const defaultProjectUsageWindow = 30 * 24 * time.Hour
+
+func (s *Server) ReportQueryUsage(ctx context.Context, req *adminv1.ReportQueryUsageRequest) (*adminv1.ReportQueryUsageResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.project_id", req.ProjectId),
+		attribute.String("args.branch", req.Branch),
+		attribute.Int("args.usage", len(req.Usage)),
+	)
+
+	proj, err := s.admin.DB.FindProject(ctx, req.ProjectId)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	permissions := auth.GetClaims(ctx).ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if !permissions.ReadProdStatus {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to report query usage")
+	}
+
+	if proj.ProdBranch != req.Branch {
+		return nil, status.Error(codes.InvalidArgument, "branch not found")
+	}
+
+	for _, u := range req.Usage {
+		if u.Queries < 0 || u.ExecutionTimeMs < 0 || u.ScannedBytes < 0 {
+			return nil, status.Error(codes.InvalidArgument, "query usage can't be negative")
+		}
+
+		t := time.Now()
+		if u.EndTime != nil {
+			t = u.EndTime.AsTime()
+		}
+
+		err := s.admin.DB.UpsertQueryUsage(ctx, &database.UpsertQueryUsageOptions{
+			ProjectID:       proj.ID,
+			Branch:          req.Branch,
+			Subject:         u.Subject,
+			Time:            t,
+			Queries:         u.Queries,
+			ExecutionTimeMs: u.ExecutionTimeMs,
+			ScannedBytes:    u.ScannedBytes,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &adminv1.ReportQueryUsageResponse{}, nil
+}
+
+func (s *Server) GetProjectUsage(ctx context.Context, req *adminv1.GetProjectUsageRequest) (*adminv1.GetProjectUsageResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProject && !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project usage")
+	}
+
+	end := time.Now()
+	if req.EndTime != nil {
+		end = req.EndTime.AsTime()
+	}
+	start := end.Add(-defaultProjectUsageWindow)
+	if req.StartTime != nil {
+		start = req.StartTime.AsTime()
+	}
+	if !start.Before(end) {
+		return nil, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+
+	usage, err := s.admin.DB.FindProjectQueryUsage(ctx, proj.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	total := &adminv1.QueryUsage{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+	}
+	pbs := make([]*adminv1.QueryUsage, len(usage))
+	for i, u := range usage {
+		pbs[i] = &adminv1.QueryUsage{
+			Subject:         u.Subject,
+			StartTime:       total.StartTime,
+			EndTime:         total.EndTime,
+			Queries:         u.Queries,
+			ExecutionTimeMs: u.ExecutionTimeMs,
+			ScannedBytes:    u.ScannedBytes,
+		}
+		total.Queries += u.Queries
+		total.ExecutionTimeMs += u.ExecutionTimeMs
+		total.ScannedBytes += u.ScannedBytes
+	}
+
+	return &adminv1.GetProjectUsageResponse{
+		Usage: pbs,
+		Total: total,
+	}, nil
+}
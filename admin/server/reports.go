@@ -18,6 +18,8 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/teams"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"go.opentelemetry.io/otel/attribute"
@@ -273,7 +275,8 @@ func (s *Server) UnsubscribeReport(ctx context.Context, req *adminv1.Unsubscribe
 		return nil, status.Error(codes.InvalidArgument, "user is not subscribed to report")
 	}
 
-	if len(opts.EmailRecipients) == 0 && len(opts.SlackUsers) == 0 && len(opts.SlackChannels) == 0 && len(opts.SlackWebhooks) == 0 {
+	if len(opts.EmailRecipients) == 0 && len(opts.SlackUsers) == 0 && len(opts.SlackChannels) == 0 && len(opts.SlackWebhooks) == 0 &&
+		len(opts.TeamsWebhooks) == 0 && len(opts.WebhookUrls) == 0 {
 		err = s.admin.DB.UpdateVirtualFileDeleted(ctx, proj.ID, proj.ProdBranch, virtualFilePathForManagedReport(req.Name))
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to update virtual file: %s", err.Error())
@@ -448,6 +451,8 @@ func (s *Server) yamlForManagedReport(opts *adminv1.ReportOptions, ownerUserID s
 	res.Notify.Slack.Channels = opts.SlackChannels
 	res.Notify.Slack.Users = opts.SlackUsers
 	res.Notify.Slack.Webhooks = opts.SlackWebhooks
+	res.Notify.Teams.Webhooks = opts.TeamsWebhooks
+	res.Notify.Webhook.URLs = opts.WebhookUrls
 	res.Annotations.AdminOwnerUserID = ownerUserID
 	res.Annotations.AdminManaged = true
 	res.Annotations.AdminNonce = time.Now().Format(time.RFC3339Nano)
@@ -494,6 +499,8 @@ func (s *Server) yamlForCommittedReport(opts *adminv1.ReportOptions) ([]byte, er
 	res.Notify.Slack.Channels = opts.SlackChannels
 	res.Notify.Slack.Users = opts.SlackUsers
 	res.Notify.Slack.Webhooks = opts.SlackWebhooks
+	res.Notify.Teams.Webhooks = opts.TeamsWebhooks
+	res.Notify.Webhook.URLs = opts.WebhookUrls
 	res.Annotations.WebOpenProjectSubpath = opts.OpenProjectSubpath
 	res.Annotations.WebOpenState = opts.WebOpenState
 	return yaml.Marshal(res)
@@ -564,6 +571,18 @@ func recreateReportOptionsFromSpec(spec *runtimev1.ReportSpec) (*adminv1.ReportO
 			opts.SlackUsers = props.Users
 			opts.SlackChannels = props.Channels
 			opts.SlackWebhooks = props.Webhooks
+		case "teams":
+			props, err := teams.DecodeProps(notifier.Properties.AsMap())
+			if err != nil {
+				return nil, err
+			}
+			opts.TeamsWebhooks = props.Webhooks
+		case "webhook":
+			props, err := webhook.DecodeProps(notifier.Properties.AsMap())
+			if err != nil {
+				return nil, err
+			}
+			opts.WebhookUrls = props.URLs
 		default:
 			return nil, fmt.Errorf("unknown notifier connector: %s", notifier.Connector)
 		}
@@ -601,6 +620,12 @@ type reportYAML struct {
 			Channels []string `yaml:"channels"`
 			Webhooks []string `yaml:"webhooks"`
 		} `yaml:"slack"`
+		Teams struct {
+			Webhooks []string `yaml:"webhooks"`
+		} `yaml:"teams,omitempty"`
+		Webhook struct {
+			URLs []string `yaml:"urls"`
+		} `yaml:"webhook,omitempty"`
 	} `yaml:"notify"`
 	Annotations reportAnnotations `yaml:"annotations,omitempty"`
 }
@@ -80,6 +80,7 @@ func (s *Server) GetRepoMeta(ctx context.Context, req *adminv1.GetRepoMetaReques
 		GitUrl:          gitURL,
 		GitUrlExpiresOn: timestamppb.New(time.Now().Add(gitURLTTL)),
 		GitSubpath:      proj.Subpath,
+		GitCommitSha:    safeStr(proj.DeploySha),
 	}, nil
 }
 
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) SendRuntimeHeartbeat(ctx context.Context, req *adminv1.SendRuntimeHeartbeatRequest) (*adminv1.SendRuntimeHeartbeatResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.project_id", req.ProjectId),
+		attribute.String("args.runtime_version", req.RuntimeVersion),
+	)
+
+	proj, err := s.admin.DB.FindProject(ctx, req.ProjectId)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ReadProdStatus {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to send runtime heartbeats")
+	}
+
+	// Heartbeats are attributed to the host of the deployment that sent them.
+	if claims.OwnerType() != auth.OwnerTypeDeployment {
+		return nil, status.Error(codes.PermissionDenied, "runtime heartbeats must be sent with a deployment access token")
+	}
+
+	depl, err := s.admin.DB.FindDeployment(ctx, claims.OwnerID())
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "deployment not found")
+		}
+		return nil, err
+	}
+	if depl.ProjectID != proj.ID {
+		return nil, status.Error(codes.PermissionDenied, "deployment does not belong to the project")
+	}
+
+	err = s.admin.RecordRuntimeHeartbeat(ctx, depl, req.RuntimeVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.SendRuntimeHeartbeatResponse{}, nil
+}
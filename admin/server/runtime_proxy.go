@@ -49,7 +49,7 @@ func (s *Server) runtimeProxyForOrgAndProject(w http.ResponseWriter, r *http.Req
 		if len(authorizationHeader) >= 6 && strings.EqualFold(authorizationHeader[0:6], "bearer") {
 			jwt = strings.TrimSpace(authorizationHeader[6:])
 		}
-	case auth.OwnerTypeUser, auth.OwnerTypeService:
+	case auth.OwnerTypeUser, auth.OwnerTypeService, auth.OwnerTypeProjectToken:
 		// If the client is authenticated with the admin service, we issue a new ephemeral runtime JWT.
 		// The JWT should have the same permissions/configuration as one they would get by calling AdminService.GetProject.
 
@@ -66,6 +66,8 @@ func (s *Server) runtimeProxyForOrgAndProject(w http.ResponseWriter, r *http.Req
 			}
 		} else if claims.OwnerType() == auth.OwnerTypeService {
 			attr = map[string]any{"admin": true}
+		} else if claims.OwnerType() == auth.OwnerTypeProjectToken {
+			attr = map[string]any{"admin": permissions.ManageProject}
 		}
 
 		jwt, err = s.issuer.NewToken(runtimeauth.TokenOptions{
@@ -19,9 +19,11 @@ import (
 	"github.com/rilldata/rill/admin/pkg/urlutil"
 	"github.com/rilldata/rill/admin/server/auth"
 	"github.com/rilldata/rill/admin/server/cookies"
+	rillproto "github.com/rilldata/rill/proto"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/graceful"
+	"github.com/rilldata/rill/runtime/pkg/healthcheck"
 	"github.com/rilldata/rill/runtime/pkg/httputil"
 	"github.com/rilldata/rill/runtime/pkg/middleware"
 	"github.com/rilldata/rill/runtime/pkg/observability"
@@ -217,6 +219,16 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 	mux := http.NewServeMux()
 	mux.Handle("/v1/", gwMux)
 
+	// Serve the OpenAPI spec for the REST gateway
+	mux.HandleFunc("/v1/openapi.yaml", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = w.Write(rillproto.AdminOpenAPISpec)
+	})
+
 	// Add runtime proxy
 	observability.MuxHandle(mux, "/v1/orgs/{org}/projects/{project}/runtime/{path...}",
 		observability.Middleware(
@@ -226,6 +238,15 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 		),
 	)
 
+	// Add notifications stream (server-sent events can't be served by the gRPC gateway)
+	observability.MuxHandle(mux, "/v1/users/current/notifications/stream",
+		observability.Middleware(
+			"notifications-stream",
+			s.logger,
+			s.authenticator.HTTPMiddleware(httputil.Handler(s.notificationsStreamHandler)),
+		),
+	)
+
 	// Temporary endpoint for testing headers.
 	// TODO: Remove this.
 	mux.HandleFunc("/v1/dump-headers", func(w http.ResponseWriter, r *http.Request) {
@@ -239,6 +260,10 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 		mux.Handle("/metrics", promhttp.Handler())
 	}
 
+	// Add liveness and readiness probes
+	mux.Handle("/healthz", healthcheck.LivenessHandler())
+	mux.HandleFunc("/readyz", s.readinessHandler)
+
 	// Server public JWKS for runtime JWT verification
 	mux.Handle("/.well-known/jwks.json", s.issuer.WellKnownHandler())
 
@@ -296,6 +321,18 @@ func (s *Server) Ping(ctx context.Context, req *adminv1.PingRequest) (*adminv1.P
 	return resp, nil
 }
 
+func (s *Server) ListRuntimeVersions(ctx context.Context, req *adminv1.ListRuntimeVersionsRequest) (*adminv1.ListRuntimeVersionsResponse, error) {
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() == auth.OwnerTypeAnon {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated")
+	}
+
+	return &adminv1.ListRuntimeVersionsResponse{
+		Latest:   s.admin.ResolveLatestRuntimeVersion(),
+		Versions: s.admin.AvailableRuntimeVersions(),
+	}, nil
+}
+
 func (s *Server) checkRateLimit(ctx context.Context) (context.Context, error) {
 	method, ok := grpc.Method(ctx)
 	if !ok {
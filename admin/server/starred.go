@@ -0,0 +1,279 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxStarredDashboards is the maximum number of dashboards a user can star.
+const maxStarredDashboards = 100
+
+func (s *Server) StarProject(ctx context.Context, req *adminv1.StarProjectRequest) (*adminv1.StarProjectResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
+	}
+
+	proj, err := s.findStarrableProject(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	projs, err := s.admin.DB.FindFavoriteProjectsForUser(ctx, claims.OwnerID())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(projs) >= maxFavoriteProjects {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot star more than %d projects", maxFavoriteProjects)
+	}
+
+	err = s.admin.DB.InsertUserFavoriteProject(ctx, claims.OwnerID(), proj.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.StarProjectResponse{}, nil
+}
+
+func (s *Server) UnstarProject(ctx context.Context, req *adminv1.UnstarProjectRequest) (*adminv1.UnstarProjectResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
+	}
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.admin.DB.DeleteUserFavoriteProject(ctx, claims.OwnerID(), proj.ID)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.UnstarProjectResponse{}, nil
+}
+
+func (s *Server) StarDashboard(ctx context.Context, req *adminv1.StarDashboardRequest) (*adminv1.StarDashboardResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.resource_kind", req.ResourceKind),
+		attribute.String("args.resource_name", req.ResourceName),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
+	}
+
+	kind, err := dashboardResourceKind(req.ResourceKind)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, err := s.findStarrableProject(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if !permissions.ReadProd && !proj.Public {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read the project's dashboards")
+	}
+
+	dashboards, err := s.admin.DB.FindStarredDashboardsForUser(ctx, claims.OwnerID())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(dashboards) >= maxStarredDashboards {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot star more than %d dashboards", maxStarredDashboards)
+	}
+
+	dashboard, err := s.admin.DB.InsertStarredDashboard(ctx, &database.InsertStarredDashboardOptions{
+		UserID:       claims.OwnerID(),
+		ProjectID:    proj.ID,
+		ResourceKind: kind,
+		ResourceName: req.ResourceName,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.StarDashboardResponse{
+		Dashboard: starredDashboardToPB(dashboard, req.Organization, proj.Name),
+	}, nil
+}
+
+func (s *Server) UnstarDashboard(ctx context.Context, req *adminv1.UnstarDashboardRequest) (*adminv1.UnstarDashboardResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.resource_kind", req.ResourceKind),
+		attribute.String("args.resource_name", req.ResourceName),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
+	}
+
+	kind, err := dashboardResourceKind(req.ResourceKind)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.admin.DB.DeleteStarredDashboard(ctx, claims.OwnerID(), proj.ID, kind, req.ResourceName)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.UnstarDashboardResponse{}, nil
+}
+
+func (s *Server) ListStarred(ctx context.Context, req *adminv1.ListStarredRequest) (*adminv1.ListStarredResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
+	}
+
+	// Cache lookups since starred items are usually concentrated in a few orgs and projects
+	orgNames := make(map[string]string)
+	orgName := func(orgID string) (string, error) {
+		if name, ok := orgNames[orgID]; ok {
+			return name, nil
+		}
+		org, err := s.admin.DB.FindOrganization(ctx, orgID)
+		if err != nil {
+			return "", err
+		}
+		orgNames[orgID] = org.Name
+		return org.Name, nil
+	}
+	projs := make(map[string]*database.Project)
+
+	starredProjs, err := s.admin.DB.FindFavoriteProjectsForUser(ctx, claims.OwnerID())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &adminv1.ListStarredResponse{}
+	for _, p := range starredProjs {
+		projs[p.ID] = p
+		if !p.Public && !claims.ProjectPermissions(ctx, p.OrganizationID, p.ID).ReadProject {
+			continue
+		}
+		org, err := orgName(p.OrganizationID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if req.Organization != "" && req.Organization != org {
+			continue
+		}
+		res.Projects = append(res.Projects, s.projToDTO(p, org))
+	}
+
+	dashboards, err := s.admin.DB.FindStarredDashboardsForUser(ctx, claims.OwnerID())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, d := range dashboards {
+		p, ok := projs[d.ProjectID]
+		if !ok {
+			p, err = s.admin.DB.FindProject(ctx, d.ProjectID)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			projs[p.ID] = p
+		}
+		if !p.Public && !claims.ProjectPermissions(ctx, p.OrganizationID, p.ID).ReadProd {
+			continue
+		}
+		org, err := orgName(p.OrganizationID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if req.Organization != "" && req.Organization != org {
+			continue
+		}
+		res.Dashboards = append(res.Dashboards, starredDashboardToPB(d, org, p.Name))
+	}
+
+	return res, nil
+}
+
+// findStarrableProject finds a project that the current user can star.
+func (s *Server) findStarrableProject(ctx context.Context, org, project string) (*database.Project, error) {
+	proj, err := s.admin.DB.FindProjectByName(ctx, org, project)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !proj.Public && !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ReadProject {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read the project")
+	}
+
+	return proj, nil
+}
+
+// dashboardResourceKind validates and returns the resource kind of a dashboard, defaulting to metrics views.
+func dashboardResourceKind(kind string) (string, error) {
+	switch kind {
+	case "":
+		return runtime.ResourceKindMetricsView, nil
+	case runtime.ResourceKindMetricsView, runtime.ResourceKindDashboard:
+		return kind, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("resource kind %q is not a dashboard", kind))
+	}
+}
+
+func starredDashboardToPB(d *database.StarredDashboard, orgName, projectName string) *adminv1.StarredDashboard {
+	return &adminv1.StarredDashboard{
+		Organization: orgName,
+		Project:      projectName,
+		ResourceKind: d.ResourceKind,
+		ResourceName: d.ResourceName,
+		CreatedOn:    timestamppb.New(d.CreatedOn),
+	}
+}
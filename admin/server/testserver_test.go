@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/ai"
+	"github.com/rilldata/rill/admin/billing"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
+	"github.com/rilldata/rill/admin/server/auth"
+	"github.com/rilldata/rill/admin/server/cookies"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	_ "github.com/rilldata/rill/admin/database/postgres"
+)
+
+// testServer is an admin server backed by a Postgres testcontainer and served over an in-memory listener.
+type testServer struct {
+	admin  *admin.Service
+	server *Server
+	lis    *bufconn.Listener
+}
+
+// newTestServer creates a testServer. It is stopped when the test finishes.
+func newTestServer(t *testing.T) *testServer {
+	pg := pgtestcontainer.New(t)
+	t.Cleanup(func() { pg.Terminate(t) })
+
+	logger := zap.NewNop()
+
+	sender, err := email.NewConsoleSender(logger, "rill-test@rilldata.io", "")
+	require.NoError(t, err)
+
+	issuer, err := runtimeauth.NewEphemeralIssuer("")
+	require.NoError(t, err)
+
+	provisionerSetJSON := "{\"static\":{\"type\":\"static\",\"spec\":{\"runtimes\":[{\"host\":\"http://localhost:9091\",\"slots\":50,\"data_dir\":\"\",\"audience_url\":\"http://localhost:8081\"}]}}}"
+
+	service, err := admin.New(context.Background(),
+		&admin.Options{
+			DatabaseDriver:     "postgres",
+			DatabaseDSN:        pg.DatabaseURL,
+			ProvisionerSetJSON: provisionerSetJSON,
+			DefaultProvisioner: "static",
+			ExternalURL:        "http://localhost:9090",
+			VersionNumber:      ""},
+		logger,
+		issuer,
+		email.New(sender),
+		&mockGithub{},
+		ai.NewNoop(),
+		nil,
+		billing.NewNoop(),
+	)
+	require.NoError(t, err)
+
+	authenticator, err := auth.NewAuthenticator(logger, service, cookies.New(logger, nil), &auth.AuthenticatorOptions{
+		AuthDomain: "gorillio-stage.auth0.com",
+	})
+	require.NoError(t, err)
+
+	opts := &Options{
+		ExternalURL: "http://localhost:9090",
+		FrontendURL: "http://localhost:3000",
+	}
+	server := &Server{
+		admin:         service,
+		opts:          opts,
+		authenticator: authenticator,
+		issuer:        issuer,
+		urls:          newURLRegistry(opts),
+		logger:        logger,
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(
+		grpc.ChainStreamInterceptor(server.authenticator.StreamServerInterceptor()),
+		grpc.ChainUnaryInterceptor(server.authenticator.UnaryServerInterceptor()),
+	)
+	adminv1.RegisterAdminServiceServer(s, server)
+	go func() {
+		err := s.Serve(lis)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	t.Cleanup(s.Stop)
+
+	return &testServer{
+		admin:  service,
+		server: server,
+		lis:    lis,
+	}
+}
+
+// newUser creates a user and returns a client authenticated as the user.
+func (s *testServer) newUser(t *testing.T, email string) (*database.User, adminv1.AdminServiceClient) {
+	ctx := context.Background()
+
+	user, err := s.admin.DB.InsertUser(ctx, &database.InsertUserOptions{
+		Email:               email,
+		DisplayName:         email,
+		QuotaSingleuserOrgs: 3,
+	})
+	require.NoError(t, err)
+
+	tkn, err := s.admin.IssueUserAuthToken(ctx, user.ID, database.AuthClientIDRillWeb, "test", nil, nil)
+	require.NoError(t, err)
+
+	return user, s.client(t, tkn.Token().String())
+}
+
+// client returns a client authenticated with the given token. If the token is empty, the client is anonymous.
+func (s *testServer) client(t *testing.T, token string) adminv1.AdminServiceClient {
+	opts := []grpc.DialOption{
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return s.lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(newBearerTokenCredential(token)))
+	}
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet", opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return adminv1.NewAdminServiceClient(conn)
+}
+
+// newArchiveProject inserts a project backed by an archive directly in the database, without provisioning a deployment.
+func (s *testServer) newArchiveProject(t *testing.T, org *database.Organization, userID string, opts *database.InsertProjectOptions) *database.Project {
+	ctx := context.Background()
+
+	asset, err := s.admin.DB.InsertAsset(ctx, org.ID, "gs://test/archive.tar.gz", userID)
+	require.NoError(t, err)
+
+	opts.OrganizationID = org.ID
+	opts.CreatedByUserID = &userID
+	opts.ArchiveAssetID = &asset.ID
+	if opts.Provisioner == "" {
+		opts.Provisioner = "static"
+	}
+	if opts.ProdOLAPDriver == "" {
+		opts.ProdOLAPDriver = "duckdb"
+	}
+	if opts.ProdSlots == 0 {
+		opts.ProdSlots = 1
+	}
+
+	proj, err := s.admin.DB.InsertProject(ctx, opts)
+	require.NoError(t, err)
+
+	adminRole, err := s.admin.DB.FindProjectRole(ctx, database.ProjectRoleNameAdmin)
+	require.NoError(t, err)
+	err = s.admin.DB.InsertProjectMemberUser(ctx, proj.ID, userID, adminRole.ID)
+	require.NoError(t, err)
+
+	return proj
+}
+
+// newProdDeployment inserts a deployment for the project directly in the database and makes it the project's prod deployment.
+func (s *testServer) newProdDeployment(t *testing.T, proj *database.Project) *database.Deployment {
+	ctx := context.Background()
+
+	depl, err := s.admin.DB.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Provisioner:       "static",
+		Slots:             1,
+		Branch:            "main",
+		RuntimeHost:       "http://localhost:9091",
+		RuntimeInstanceID: "instance-" + proj.Name,
+		RuntimeAudience:   "http://localhost:8081",
+		Status:            database.DeploymentStatusOK,
+		StatusMessage:     "internal details",
+	})
+	require.NoError(t, err)
+
+	_, err = s.admin.DB.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{
+		Name:             proj.Name,
+		Provisioner:      proj.Provisioner,
+		ArchiveAssetID:   proj.ArchiveAssetID,
+		ProdBranch:       proj.ProdBranch,
+		ProdSlots:        proj.ProdSlots,
+		ProdDeploymentID: &depl.ID,
+	})
+	require.NoError(t, err)
+
+	return depl
+}
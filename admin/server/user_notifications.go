@@ -0,0 +1,371 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// notificationsStreamPollInterval is how often the notifications stream checks for new notifications.
+	// Polling the database (instead of an in-memory pubsub) ensures notifications created on other admin replicas are streamed too.
+	notificationsStreamPollInterval = 5 * time.Second
+	// notificationsStreamKeepaliveInterval is how often the notifications stream sends a comment to keep idle connections open.
+	notificationsStreamKeepaliveInterval = 30 * time.Second
+	// notificationsStreamBatchSize is the max number of notifications streamed per poll.
+	notificationsStreamBatchSize = 100
+	// notificationsStreamOverlap is how far back each poll of the notifications stream overlaps the notifications already sent.
+	// It's needed because notifications are not necessarily committed in the order of their creation time.
+	notificationsStreamOverlap = 30 * time.Second
+)
+
+func (s *Server) ListNotifications(ctx context.Context, req *adminv1.ListNotificationsRequest) (*adminv1.ListNotificationsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.Bool("args.unread_only", req.UnreadOnly),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
+	}
+
+	pageToken, err := unmarshalStringTimestampPageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	ns, err := s.admin.DB.FindUserNotifications(ctx, claims.OwnerID(), req.UnreadOnly, pageToken.Ts.AsTime(), pageToken.Str, pageSize)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	unread, err := s.admin.DB.CountUnreadUserNotifications(ctx, claims.OwnerID())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextPageToken := ""
+	if len(ns) >= pageSize {
+		last := ns[len(ns)-1]
+		nextPageToken = marshalStringTimestampPageToken(last.ID, last.CreatedOn)
+	}
+
+	pbs := make([]*adminv1.Notification, len(ns))
+	for i, n := range ns {
+		pbs[i] = userNotificationToPB(n)
+	}
+
+	return &adminv1.ListNotificationsResponse{
+		Notifications: pbs,
+		UnreadCount:   uint32(unread),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Server) AckNotifications(ctx context.Context, req *adminv1.AckNotificationsRequest) (*adminv1.AckNotificationsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.Int("args.notification_ids", len(req.NotificationIds)),
+		attribute.Bool("args.all", req.All),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
+	}
+
+	if !req.All && len(req.NotificationIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "either notification_ids or all must be set")
+	}
+
+	ids := req.NotificationIds
+	if req.All {
+		ids = nil
+	}
+	err := s.admin.DB.UpdateUserNotificationsRead(ctx, claims.OwnerID(), ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	unread, err := s.admin.DB.CountUnreadUserNotifications(ctx, claims.OwnerID())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.AckNotificationsResponse{
+		UnreadCount: uint32(unread),
+	}, nil
+}
+
+func (s *Server) SendResourceNotification(ctx context.Context, req *adminv1.SendResourceNotificationRequest) (*adminv1.SendResourceNotificationResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.project_id", req.ProjectId),
+		attribute.String("args.branch", req.Branch),
+		attribute.String("args.type", req.Type.String()),
+		attribute.String("args.resource_name", req.ResourceName),
+		attribute.Int("args.recipient_emails", len(req.RecipientEmails)),
+	)
+
+	proj, err := s.admin.DB.FindProject(ctx, req.ProjectId)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Only the project's prod deployment can send notifications for its resources
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeDeployment || proj.ProdDeploymentID == nil || claims.OwnerID() != *proj.ProdDeploymentID {
+		return nil, status.Error(codes.PermissionDenied, "resource notifications must be sent with the prod deployment's access token")
+	}
+
+	if proj.ProdBranch != req.Branch {
+		return nil, status.Error(codes.InvalidArgument, "branch not found")
+	}
+
+	org, err := s.admin.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var typ, link string
+	switch req.Type {
+	case adminv1.NotificationType_NOTIFICATION_TYPE_ALERT_FIRED:
+		typ = database.UserNotificationTypeAlertFired
+		link = s.urls.alertOpen(org.Name, proj.Name, req.ResourceName)
+	case adminv1.NotificationType_NOTIFICATION_TYPE_REPORT_READY:
+		typ = database.UserNotificationTypeReportReady
+		link = s.urls.reportOpen(org.Name, proj.Name, req.ResourceName, time.Now())
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported notification type %q", req.Type.String())
+	}
+
+	// Find the recipients that are users with access to the project's dashboards.
+	// The annotations are not used to find recipients since they're set in the project's files.
+	candidates := make(map[string]bool)
+	for _, email := range req.RecipientEmails {
+		u, err := s.admin.DB.FindUserByEmail(ctx, strings.TrimSpace(email))
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				continue
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		candidates[u.ID] = true
+	}
+
+	var userIDs []string
+	for id := range candidates {
+		orgPerms, err := s.admin.OrganizationPermissionsForUser(ctx, org.ID, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		projPerms, err := s.admin.ProjectPermissionsForUser(ctx, proj.ID, id, orgPerms)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if projPerms.ReadProd {
+			userIDs = append(userIDs, id)
+		}
+	}
+
+	s.admin.NotifyUsers(ctx, userIDs, &database.InsertUserNotificationOptions{
+		OrgID:     &org.ID,
+		ProjectID: &proj.ID,
+		Type:      typ,
+		Title:     req.Title,
+		Body:      req.Body,
+		URL:       link,
+	})
+
+	return &adminv1.SendResourceNotificationResponse{
+		NotifiedUsers: uint32(len(userIDs)),
+	}, nil
+}
+
+// notificationsStreamHandler streams the current user's new notifications as server-sent events.
+// Each event has the latest creation time sent so far as its ID, so clients that reconnect with the Last-Event-ID header
+// receive the notifications they missed. Otherwise, only notifications created after the stream was opened are sent.
+func (s *Server) notificationsStreamHandler(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return httputil.Errorf(http.StatusUnauthorized, "not authenticated as a user")
+	}
+	userID := claims.OwnerID()
+
+	after := time.Now()
+	if id := r.Header.Get("Last-Event-ID"); id != "" {
+		t, err := time.Parse(time.RFC3339Nano, id)
+		if err != nil {
+			return httputil.Errorf(http.StatusBadRequest, "invalid Last-Event-ID header: %s", id)
+		}
+		after = t
+	}
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	err := rc.Flush()
+	if err != nil {
+		return err
+	}
+
+	poll := time.NewTicker(notificationsStreamPollInterval)
+	defer poll.Stop()
+	lastWrite := time.Now()
+	cursor := newNotificationsCursor(after)
+	lastEventID := after
+	for {
+		ns, err := s.admin.DB.FindUserNotificationsCreatedAfter(ctx, userID, cursor.after(), cursor.limit())
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			s.logger.Warn("notifications stream: failed to find notifications", zap.String("user_id", userID), zap.Error(err), observability.ZapCtx(ctx))
+		}
+
+		for _, n := range cursor.next(ns) {
+			data, err := protojson.Marshal(userNotificationToPB(n))
+			if err != nil {
+				return err
+			}
+			if n.CreatedOn.After(lastEventID) {
+				lastEventID = n.CreatedOn
+			}
+			_, err = fmt.Fprintf(w, "id: %s\nevent: notification\ndata: %s\n\n", lastEventID.Format(time.RFC3339Nano), data)
+			if err != nil {
+				return nil
+			}
+			lastWrite = time.Now()
+		}
+
+		if time.Since(lastWrite) >= notificationsStreamKeepaliveInterval {
+			_, err := fmt.Fprint(w, ": keepalive\n\n")
+			if err != nil {
+				return nil
+			}
+			lastWrite = time.Now()
+		}
+
+		err = rc.Flush()
+		if err != nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-poll.C:
+		}
+	}
+}
+
+// notificationsCursor tracks the notifications sent on a notifications stream.
+// Since notifications may be committed after notifications with a later creation time, each poll overlaps the notifications
+// already sent by notificationsStreamOverlap, and notifications that were already sent are skipped by ID.
+type notificationsCursor struct {
+	// watermark is the latest creation time of the sent notifications.
+	watermark time.Time
+	// sent contains the creation times of the sent notifications that are within the overlap window, keyed by ID.
+	sent map[string]time.Time
+	// overlap is false until the first poll, so a reconnecting client doesn't receive notifications it already received.
+	overlap bool
+}
+
+func newNotificationsCursor(after time.Time) *notificationsCursor {
+	return &notificationsCursor{watermark: after, sent: make(map[string]time.Time)}
+}
+
+// after returns the creation time to find notifications after.
+func (c *notificationsCursor) after() time.Time {
+	if !c.overlap {
+		return c.watermark
+	}
+	return c.watermark.Add(-notificationsStreamOverlap)
+}
+
+// limit returns the max number of notifications to find, which ensures progress when the overlap only contains sent notifications.
+func (c *notificationsCursor) limit() int {
+	return notificationsStreamBatchSize + len(c.sent)
+}
+
+// next returns the notifications that have not already been sent and marks them as sent.
+// The notifications must be the result of a query for c.after() and c.limit().
+func (c *notificationsCursor) next(ns []*database.UserNotification) []*database.UserNotification {
+	var res []*database.UserNotification
+	for _, n := range ns {
+		if _, ok := c.sent[n.ID]; ok {
+			continue
+		}
+		c.sent[n.ID] = n.CreatedOn
+		if n.CreatedOn.After(c.watermark) {
+			c.watermark = n.CreatedOn
+		}
+		res = append(res, n)
+	}
+	c.overlap = true
+
+	// Forget notifications that are no longer in the overlap window
+	after := c.after()
+	for id, t := range c.sent {
+		if !t.After(after) {
+			delete(c.sent, id)
+		}
+	}
+
+	return res
+}
+
+func userNotificationToPB(n *database.UserNotification) *adminv1.Notification {
+	var typ adminv1.NotificationType
+	switch n.Type {
+	case database.UserNotificationTypeDeployFailed:
+		typ = adminv1.NotificationType_NOTIFICATION_TYPE_DEPLOY_FAILED
+	case database.UserNotificationTypeInviteReceived:
+		typ = adminv1.NotificationType_NOTIFICATION_TYPE_INVITE_RECEIVED
+	case database.UserNotificationTypeAlertFired:
+		typ = adminv1.NotificationType_NOTIFICATION_TYPE_ALERT_FIRED
+	case database.UserNotificationTypeReportReady:
+		typ = adminv1.NotificationType_NOTIFICATION_TYPE_REPORT_READY
+	}
+
+	var orgID, projectID string
+	if n.OrgID != nil {
+		orgID = *n.OrgID
+	}
+	if n.ProjectID != nil {
+		projectID = *n.ProjectID
+	}
+
+	return &adminv1.Notification{
+		Id:             n.ID,
+		Type:           typ,
+		Title:          n.Title,
+		Body:           n.Body,
+		Url:            n.URL,
+		OrganizationId: orgID,
+		ProjectId:      projectID,
+		Read:           n.ReadOn != nil,
+		CreatedOn:      timestamppb.New(n.CreatedOn),
+	}
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSendResourceNotification(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestServer(t)
+
+	owner, ownerClient := srv.newUser(t, "owner@test.io")
+	member, _ := srv.newUser(t, "member@test.io")
+
+	org, err := srv.admin.CreateOrganizationForUser(ctx, owner.ID, "org", "", nil)
+	require.NoError(t, err)
+	proj := srv.newArchiveProject(t, org, owner.ID, &database.InsertProjectOptions{Name: "proj"})
+	depl := srv.newProdDeployment(t, proj)
+	other := srv.newArchiveProject(t, org, owner.ID, &database.InsertProjectOptions{Name: "other"})
+	otherDepl := srv.newProdDeployment(t, other)
+
+	viewerRole, err := srv.admin.DB.FindProjectRole(ctx, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+	require.NoError(t, srv.admin.DB.InsertProjectMemberUser(ctx, proj.ID, member.ID, viewerRole.ID))
+
+	deploymentClient := func(deploymentID string) adminv1.AdminServiceClient {
+		tkn, err := srv.admin.IssueDeploymentAuthToken(ctx, deploymentID, nil)
+		require.NoError(t, err)
+		return srv.client(t, tkn.Token().String())
+	}
+
+	req := &adminv1.SendResourceNotificationRequest{
+		ProjectId:       proj.ID,
+		Branch:          proj.ProdBranch,
+		Type:            adminv1.NotificationType_NOTIFICATION_TYPE_ALERT_FIRED,
+		ResourceName:    "alert",
+		Title:           "Alert fired",
+		RecipientEmails: []string{"member@test.io", "unknown@test.io"},
+	}
+
+	// Users can't send notifications, even if they're project admins
+	_, err = ownerClient.SendResourceNotification(ctx, req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Other projects' deployments can't send notifications
+	_, err = deploymentClient(otherDepl.ID).SendResourceNotification(ctx, req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The prod deployment notifies the recipients that are project members
+	client := deploymentClient(depl.ID)
+	res, err := client.SendResourceNotification(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.NotifiedUsers)
+
+	// Annotations are not trusted to identify recipients
+	req.RecipientEmails = nil
+	req.Annotations = map[string]string{"admin_owner_user_id": owner.ID}
+	res, err = client.SendResourceNotification(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.NotifiedUsers)
+}
+
+func TestNotificationsCursor(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	n := func(id string, createdOn time.Time) *database.UserNotification {
+		return &database.UserNotification{ID: id, CreatedOn: createdOn}
+	}
+	ids := func(ns []*database.UserNotification) []string {
+		var res []string
+		for _, n := range ns {
+			res = append(res, n.ID)
+		}
+		return res
+	}
+
+	c := newNotificationsCursor(t0)
+
+	// The first poll doesn't overlap the start time
+	require.Equal(t, t0, c.after())
+	require.Equal(t, notificationsStreamBatchSize, c.limit())
+	require.Equal(t, []string{"a", "b"}, ids(c.next([]*database.UserNotification{n("a", t0.Add(time.Second)), n("b", t0.Add(2*time.Second))})))
+
+	// Later polls overlap the sent notifications and skip them.
+	// Notifications committed late with an earlier or equal creation time are still sent.
+	require.Equal(t, t0.Add(2*time.Second-notificationsStreamOverlap), c.after())
+	require.Equal(t, notificationsStreamBatchSize+2, c.limit())
+	require.Equal(t, []string{"late", "same"}, ids(c.next([]*database.UserNotification{
+		n("a", t0.Add(time.Second)),
+		n("late", t0.Add(time.Second)),
+		n("b", t0.Add(2*time.Second)),
+		n("same", t0.Add(2*time.Second)),
+	})))
+	require.Empty(t, c.next([]*database.UserNotification{n("a", t0.Add(time.Second)), n("same", t0.Add(2*time.Second))}))
+
+	// Sent notifications are forgotten when they leave the overlap window
+	later := t0.Add(time.Minute)
+	require.Equal(t, []string{"c"}, ids(c.next([]*database.UserNotification{n("c", later)})))
+	require.Equal(t, later.Add(-notificationsStreamOverlap), c.after())
+	require.Equal(t, notificationsStreamBatchSize+1, c.limit())
+}
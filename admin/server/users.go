@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/rilldata/rill/admin/database"
@@ -11,6 +13,7 @@ import (
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/text/language"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -19,6 +22,9 @@ import (
 	_ "time/tzdata"
 )
 
+// maxFavoriteProjects is the maximum number of projects a user can favorite.
+const maxFavoriteProjects = 100
+
 func (s *Server) ListSuperusers(ctx context.Context, req *adminv1.ListSuperusersRequest) (*adminv1.ListSuperusersResponse, error) {
 	claims := auth.GetClaims(ctx)
 	if !claims.Superuser(ctx) {
@@ -115,11 +121,14 @@ func (s *Server) GetCurrentUser(ctx context.Context, req *adminv1.GetCurrentUser
 		return nil, err
 	}
 
+	prefs, err := s.userPreferencesToPB(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.GetCurrentUserResponse{
-		User: userToPB(u),
-		Preferences: &adminv1.UserPreferences{
-			TimeZone: &u.PreferenceTimeZone,
-		},
+		User:        userToPB(u),
+		Preferences: prefs,
 	}, nil
 }
 
@@ -131,13 +140,18 @@ func (s *Server) UpdateUserPreferences(ctx context.Context, req *adminv1.UpdateU
 		return nil, fmt.Errorf("not authenticated as a user")
 	}
 
-	if req.Preferences.TimeZone != nil {
-		_, err := time.LoadLocation(*req.Preferences.TimeZone)
+	prefs := req.Preferences
+	if prefs == nil {
+		prefs = &adminv1.UserPreferences{}
+	}
+
+	if prefs.TimeZone != nil {
+		_, err := time.LoadLocation(*prefs.TimeZone)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid time zone: %s", *req.Preferences.TimeZone))
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid time zone: %s", *prefs.TimeZone))
 		}
 
-		observability.AddRequestAttributes(ctx, attribute.String("preferences_time_zone", *req.Preferences.TimeZone))
+		observability.AddRequestAttributes(ctx, attribute.String("preferences_time_zone", *prefs.TimeZone))
 	}
 
 	// Owner is a user
@@ -146,26 +160,170 @@ func (s *Server) UpdateUserPreferences(ctx context.Context, req *adminv1.UpdateU
 		return nil, err
 	}
 
-	// Update user quota here
+	defaultOrgID := user.PreferenceDefaultOrgID
+	if prefs.DefaultOrganization != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("preferences_default_organization", *prefs.DefaultOrganization))
+
+		defaultOrgID = nil
+		if *prefs.DefaultOrganization != "" {
+			org, err := s.admin.DB.FindOrganizationByName(ctx, *prefs.DefaultOrganization)
+			if err != nil {
+				if errors.Is(err, database.ErrNotFound) {
+					return nil, status.Errorf(codes.InvalidArgument, "organization %q not found", *prefs.DefaultOrganization)
+				}
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if !claims.OrganizationPermissions(ctx, org.ID).ReadOrg {
+				return nil, status.Errorf(codes.InvalidArgument, "organization %q not found", *prefs.DefaultOrganization)
+			}
+			defaultOrgID = &org.ID
+		}
+	}
+
+	locale := user.PreferenceLocale
+	if prefs.Locale != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("preferences_locale", *prefs.Locale))
+
+		locale = ""
+		if *prefs.Locale != "" {
+			tag, err := language.Parse(*prefs.Locale)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid locale: %s", *prefs.Locale)
+			}
+			locale = tag.String()
+		}
+	}
+
+	var favoriteProjectIDs []string
+	if req.UpdateFavoriteProjects {
+		observability.AddRequestAttributes(ctx, attribute.Int("preferences_favorite_projects", len(prefs.FavoriteProjects)))
+
+		if len(prefs.FavoriteProjects) > maxFavoriteProjects {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot favorite more than %d projects", maxFavoriteProjects)
+		}
+		for _, path := range prefs.FavoriteProjects {
+			org, name, ok := strings.Cut(path, "/")
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid project %q: must be formatted as <organization>/<project>", path)
+			}
+			proj, err := s.admin.DB.FindProjectByName(ctx, org, name)
+			if err != nil {
+				if errors.Is(err, database.ErrNotFound) {
+					return nil, status.Errorf(codes.InvalidArgument, "project %q not found", path)
+				}
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if !proj.Public && !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ReadProject {
+				return nil, status.Errorf(codes.InvalidArgument, "project %q not found", path)
+			}
+			if !slices.Contains(favoriteProjectIDs, proj.ID) {
+				favoriteProjectIDs = append(favoriteProjectIDs, proj.ID)
+			}
+		}
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	updatedUser, err := s.admin.DB.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
-		DisplayName:         user.DisplayName,
-		PhotoURL:            user.PhotoURL,
-		GithubUsername:      user.GithubUsername,
-		GithubRefreshToken:  user.GithubRefreshToken,
-		QuotaSingleuserOrgs: user.QuotaSingleuserOrgs,
-		PreferenceTimeZone:  valOrDefault(req.Preferences.TimeZone, user.PreferenceTimeZone),
+		DisplayName:            user.DisplayName,
+		PhotoURL:               user.PhotoURL,
+		GithubUsername:         user.GithubUsername,
+		GithubRefreshToken:     user.GithubRefreshToken,
+		QuotaSingleuserOrgs:    user.QuotaSingleuserOrgs,
+		PreferenceTimeZone:     valOrDefault(prefs.TimeZone, user.PreferenceTimeZone),
+		PreferenceDefaultOrgID: defaultOrgID,
+		PreferenceLocale:       locale,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	if req.UpdateFavoriteProjects {
+		// Only add and remove the changed favorites so the remaining favorites keep their order
+		current, err := s.admin.DB.FindFavoriteProjectsForUser(ctx, user.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, p := range current {
+			if slices.Contains(favoriteProjectIDs, p.ID) {
+				continue
+			}
+			err := s.admin.DB.DeleteUserFavoriteProject(ctx, user.ID, p.ID)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+		for _, id := range favoriteProjectIDs {
+			err := s.admin.DB.InsertUserFavoriteProject(ctx, user.ID, id)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res, err := s.userPreferencesToPB(ctx, updatedUser)
+	if err != nil {
+		return nil, err
+	}
+
 	return &adminv1.UpdateUserPreferencesResponse{
-		Preferences: &adminv1.UserPreferences{
-			TimeZone: &updatedUser.PreferenceTimeZone,
-		},
+		Preferences: res,
 	}, nil
 }
 
+// userPreferencesToPB returns the user's preferences.
+// The user's default organization and favorite projects are omitted if the user no longer has access to them.
+func (s *Server) userPreferencesToPB(ctx context.Context, user *database.User) (*adminv1.UserPreferences, error) {
+	claims := auth.GetClaims(ctx)
+
+	res := &adminv1.UserPreferences{
+		TimeZone: &user.PreferenceTimeZone,
+		Locale:   &user.PreferenceLocale,
+	}
+
+	if user.PreferenceDefaultOrgID != nil && claims.OrganizationPermissions(ctx, *user.PreferenceDefaultOrgID).ReadOrg {
+		org, err := s.admin.DB.FindOrganization(ctx, *user.PreferenceDefaultOrgID)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if org != nil {
+			res.DefaultOrganization = &org.Name
+		}
+	}
+
+	projs, err := s.admin.DB.FindFavoriteProjectsForUser(ctx, user.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	orgNames := make(map[string]string)
+	for _, p := range projs {
+		if !p.Public && !claims.ProjectPermissions(ctx, p.OrganizationID, p.ID).ReadProject {
+			continue
+		}
+		orgName, ok := orgNames[p.OrganizationID]
+		if !ok {
+			org, err := s.admin.DB.FindOrganization(ctx, p.OrganizationID)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			orgName = org.Name
+			orgNames[p.OrganizationID] = orgName
+		}
+		res.FavoriteProjects = append(res.FavoriteProjects, fmt.Sprintf("%s/%s", orgName, p.Name))
+	}
+
+	return res, nil
+}
+
 // IssueRepresentativeAuthToken returns the temporary auth token for representing email
 func (s *Server) IssueRepresentativeAuthToken(ctx context.Context, req *adminv1.IssueRepresentativeAuthTokenRequest) (*adminv1.IssueRepresentativeAuthTokenResponse, error) {
 	observability.AddRequestAttributes(ctx,
@@ -305,12 +463,14 @@ func (s *Server) SudoUpdateUserQuotas(ctx context.Context, req *adminv1.SudoUpda
 
 	// Update user quota here
 	updatedUser, err := s.admin.DB.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
-		DisplayName:         user.DisplayName,
-		PhotoURL:            user.PhotoURL,
-		GithubUsername:      user.GithubUsername,
-		GithubRefreshToken:  user.GithubRefreshToken,
-		QuotaSingleuserOrgs: int(valOrDefault(req.SingleuserOrgs, uint32(user.QuotaSingleuserOrgs))),
-		PreferenceTimeZone:  user.PreferenceTimeZone,
+		DisplayName:            user.DisplayName,
+		PhotoURL:               user.PhotoURL,
+		GithubUsername:         user.GithubUsername,
+		GithubRefreshToken:     user.GithubRefreshToken,
+		QuotaSingleuserOrgs:    int(valOrDefault(req.SingleuserOrgs, uint32(user.QuotaSingleuserOrgs))),
+		PreferenceTimeZone:     user.PreferenceTimeZone,
+		PreferenceDefaultOrgID: user.PreferenceDefaultOrgID,
+		PreferenceLocale:       user.PreferenceLocale,
 	})
 	if err != nil {
 		return nil, err
@@ -390,6 +550,19 @@ func memberUserToPB(m *database.MemberUser) *adminv1.MemberUser {
 	}
 }
 
+func setMemberUserActivityPB(pb *adminv1.MemberUser, a *database.MemberUserActivity) {
+	if a.LastLoginOn != nil {
+		pb.LastLoginOn = timestamppb.New(*a.LastLoginOn)
+	}
+	pb.LastActiveOn = timestamppb.New(a.LastActiveOn)
+	pb.LastActiveProject = safeStr(a.LastActiveProject)
+	if a.Editor {
+		pb.SeatType = adminv1.SeatType_SEAT_TYPE_EDITOR
+	} else {
+		pb.SeatType = adminv1.SeatType_SEAT_TYPE_VIEWER
+	}
+}
+
 func inviteToPB(i *database.Invite) *adminv1.UserInvite {
 	return &adminv1.UserInvite{
 		Email:     i.Email,
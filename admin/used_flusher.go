@@ -26,6 +26,7 @@ type usedFlusher struct {
 	serviceTokens    map[string]bool
 	deploymentTokens map[string]bool
 	magicAuthTokens  map[string]bool
+	projectTokens    map[string]bool
 	ctx              context.Context
 	cancel           context.CancelFunc
 	flushWg          sync.WaitGroup
@@ -44,6 +45,7 @@ func newUsedFlusher(logger *zap.Logger, db database.DB) *usedFlusher {
 		serviceTokens:    make(map[string]bool),
 		deploymentTokens: make(map[string]bool),
 		magicAuthTokens:  make(map[string]bool),
+		projectTokens:    make(map[string]bool),
 		ctx:              ctx,
 		cancel:           cancel,
 	}
@@ -101,6 +103,13 @@ func (u *usedFlusher) MagicAuthToken(id string) {
 	u.magicAuthTokens[id] = true
 }
 
+func (u *usedFlusher) ProjectAuthToken(id string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.projectTokens[id] = true
+}
+
 func (u *usedFlusher) Close() {
 	u.cancel()
 	u.flush()
@@ -166,6 +175,12 @@ func (u *usedFlusher) flush() {
 		magicAuthTokens = u.magicAuthTokens
 		u.magicAuthTokens = make(map[string]bool)
 	}
+
+	var projectTokens map[string]bool
+	if len(u.projectTokens) > 0 {
+		projectTokens = u.projectTokens
+		u.projectTokens = make(map[string]bool)
+	}
 	u.mu.Unlock()
 
 	// Flush deployments
@@ -188,6 +203,9 @@ func (u *usedFlusher) flush() {
 
 	// Flush magic auth tokens
 	u.flushToDB(magicAuthTokens, u.db.UpdateMagicAuthTokenUsedOn, "magic auth tokens")
+
+	// Flush project tokens
+	u.flushToDB(projectTokens, u.db.UpdateProjectAuthTokenUsedOn, "project tokens")
 }
 
 // Helper function to perform the flushing of used_on to the database.
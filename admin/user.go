@@ -22,12 +22,14 @@ func (s *Service) CreateOrUpdateUser(ctx context.Context, email, name, photoURL
 	user, err := s.DB.FindUserByEmail(ctx, email)
 	if err == nil {
 		return s.DB.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
-			DisplayName:         name,
-			PhotoURL:            photoURL,
-			GithubUsername:      user.GithubUsername,
-			GithubRefreshToken:  user.GithubRefreshToken,
-			QuotaSingleuserOrgs: user.QuotaSingleuserOrgs,
-			PreferenceTimeZone:  user.PreferenceTimeZone,
+			DisplayName:            name,
+			PhotoURL:               photoURL,
+			GithubUsername:         user.GithubUsername,
+			GithubRefreshToken:     user.GithubRefreshToken,
+			QuotaSingleuserOrgs:    user.QuotaSingleuserOrgs,
+			PreferenceTimeZone:     user.PreferenceTimeZone,
+			PreferenceDefaultOrgID: user.PreferenceDefaultOrgID,
+			PreferenceLocale:       user.PreferenceLocale,
 		})
 	} else if !errors.Is(err, database.ErrNotFound) {
 		return nil, err
@@ -95,7 +97,7 @@ func (s *Service) CreateOrUpdateUser(ctx context.Context, email, name, photoURL
 	}
 
 	// check if users email domain is whitelisted for some organizations
-	domain := email[strings.LastIndex(email, "@")+1:]
+	domain := strings.ToLower(email[strings.LastIndex(email, "@")+1:])
 	organizationWhitelistedDomains, err := s.DB.FindOrganizationWhitelistedDomainsForDomain(ctx, domain)
 	if err != nil {
 		return nil, err
@@ -179,7 +181,9 @@ func (s *Service) CreateOrUpdateUser(ctx context.Context, email, name, photoURL
 	return user, nil
 }
 
-func (s *Service) CreateOrganizationForUser(ctx context.Context, userID, orgName, description string) (*database.Organization, error) {
+// CreateOrganizationForUser creates a new org with the default quotas and adds the user as an admin.
+// The externalID is optional and may be nil.
+func (s *Service) CreateOrganizationForUser(ctx context.Context, userID, orgName, description string, externalID *string) (*database.Organization, error) {
 	txCtx, tx, err := s.DB.NewTx(ctx)
 	if err != nil {
 		return nil, err
@@ -202,6 +206,7 @@ func (s *Service) CreateOrganizationForUser(ctx context.Context, userID, orgName
 		QuotaSlotsPerDeployment:             quotaSlotsPerDeployment,
 		QuotaOutstandingInvites:             quotaOutstandingInvites,
 		QuotaStorageLimitBytesPerDeployment: quotaStorageLimitBytesPerDeployment,
+		ExternalID:                          externalID,
 	})
 	if err != nil {
 		return nil, err
@@ -272,6 +277,11 @@ func (s *Service) CreateOrganizationForUser(ctx context.Context, userID, orgName
 		QuotaOutstandingInvites:             quotaOutstandingInvites,
 		QuotaStorageLimitBytesPerDeployment: quotaStorageLimitBytesPerDeployment,
 		BillingCustomerID:                   customerID,
+		MaintenanceWindow:                   org.MaintenanceWindow,
+		LogoURL:                             org.LogoURL,
+		PrimaryColor:                        org.PrimaryColor,
+		SupportEmail:                        org.SupportEmail,
+		CustomDomain:                        org.CustomDomain,
 	})
 	if err != nil {
 		s.Logger.Error("failed to update organization with billing info", zap.String("org", orgName), zap.Error(err))
@@ -0,0 +1,99 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// NotifyUsers adds a notification to the notification center of each of the given users.
+// It's best-effort: failures are logged instead of returned, so it doesn't break the operation that triggered the notification.
+// The opts.UserID field is ignored.
+func (s *Service) NotifyUsers(ctx context.Context, userIDs []string, opts *database.InsertUserNotificationOptions) {
+	for _, id := range userIDs {
+		n := *opts
+		n.UserID = id
+		_, err := s.DB.InsertUserNotification(ctx, &n)
+		if err != nil {
+			s.Logger.Warn("failed to insert user notification", zap.String("user_id", id), zap.String("type", opts.Type), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}
+}
+
+// notifyDeploymentFailed notifies the admins of a deployment's organization that the deployment failed.
+func (s *Service) notifyDeploymentFailed(ctx context.Context, depl *database.Deployment, deplErr error) {
+	proj, err := s.DB.FindProject(ctx, depl.ProjectID)
+	if err != nil {
+		s.Logger.Warn("failed to notify about failed deployment", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+		return
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		s.Logger.Warn("failed to notify about failed deployment", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+		return
+	}
+
+	role, err := s.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+	if err != nil {
+		s.Logger.Warn("failed to notify about failed deployment", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+		return
+	}
+
+	admins, err := s.DB.FindOrganizationMemberUsersByRole(ctx, org.ID, role.ID)
+	if err != nil {
+		s.Logger.Warn("failed to notify about failed deployment", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+		return
+	}
+
+	userIDs := make([]string, len(admins))
+	for i, u := range admins {
+		userIDs[i] = u.ID
+	}
+
+	var link string
+	if s.opts.FrontendURL != "" {
+		link = urlutil.MustJoinURL(s.opts.FrontendURL, org.Name, proj.Name, "-", "status")
+	}
+
+	s.NotifyUsers(ctx, userIDs, &database.InsertUserNotificationOptions{
+		OrgID:     &org.ID,
+		ProjectID: &proj.ID,
+		Type:      database.UserNotificationTypeDeployFailed,
+		Title:     fmt.Sprintf("Deployment of %s/%s failed", org.Name, proj.Name),
+		Body:      deplErr.Error(),
+		URL:       link,
+	})
+}
+
+// NotifyInviteReceived notifies a user that they were added to an organization, or to one of its projects if proj is not nil.
+func (s *Service) NotifyInviteReceived(ctx context.Context, userID string, org *database.Organization, proj *database.Project, roleName, invitedByName string) {
+	if invitedByName == "" {
+		invitedByName = "Rill"
+	}
+
+	n := &database.InsertUserNotificationOptions{
+		OrgID: &org.ID,
+		Type:  database.UserNotificationTypeInviteReceived,
+		Title: fmt.Sprintf("You were added to the %s organization", org.Name),
+		Body:  fmt.Sprintf("%s added you to the %s organization as %s", invitedByName, org.Name, roleName),
+	}
+	if proj != nil {
+		n.ProjectID = &proj.ID
+		n.Title = fmt.Sprintf("You were added to the %s/%s project", org.Name, proj.Name)
+		n.Body = fmt.Sprintf("%s added you to the %s/%s project as %s", invitedByName, org.Name, proj.Name, roleName)
+	}
+	if s.opts.FrontendURL != "" {
+		if proj != nil {
+			n.URL = urlutil.MustJoinURL(s.opts.FrontendURL, org.Name, proj.Name)
+		} else {
+			n.URL = urlutil.MustJoinURL(s.opts.FrontendURL, org.Name)
+		}
+	}
+
+	s.NotifyUsers(ctx, []string{userID}, n)
+}
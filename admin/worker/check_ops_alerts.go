@@ -0,0 +1,7 @@
+package worker
+
+import "context"
+
+func (w *Worker) checkOpsAlerts(ctx context.Context) error {
+	return w.admin.CheckOpsAlerts(ctx)
+}
@@ -0,0 +1,7 @@
+package worker
+
+import "context"
+
+func (w *Worker) checkRuntimeHosts(ctx context.Context) error {
+	return w.admin.CheckRuntimeHosts(ctx)
+}
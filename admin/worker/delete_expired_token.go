@@ -24,5 +24,9 @@ func (w *Worker) deleteExpiredAuthTokens(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	err = w.admin.DB.DeleteExpiredProjectAuthTokens(ctx, retention)
+	if err != nil {
+		return err
+	}
 	return nil
 }
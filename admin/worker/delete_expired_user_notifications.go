@@ -0,0 +1,12 @@
+package worker
+
+import (
+	"context"
+	"time"
+)
+
+func (w *Worker) deleteExpiredUserNotifications(ctx context.Context) error {
+	// Delete notifications that are more than 90 days old
+	retention := 90 * 24 * time.Hour
+	return w.admin.DB.DeleteExpiredUserNotifications(ctx, retention)
+}
@@ -0,0 +1,7 @@
+package worker
+
+import "context"
+
+func (w *Worker) deployScheduledCommits(ctx context.Context) error {
+	return w.admin.DeployScheduledCommits(ctx)
+}
@@ -0,0 +1,87 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	// queuePollInterval is how often the job queue is polled when it's empty.
+	queuePollInterval = 5 * time.Second
+	// queuedJobTimeout is the maximum duration of a queued job.
+	queuedJobTimeout = 15 * time.Minute
+	// queuedJobLockDuration is how long a claimed job is locked for. Jobs that are still running after that (e.g. because the worker crashed) are claimed again.
+	queuedJobLockDuration = queuedJobTimeout + 5*time.Minute
+	// finishedJobsRetention is how long succeeded and failed jobs are kept for inspection.
+	finishedJobsRetention = 7 * 24 * time.Hour
+)
+
+// processQueuedJobs runs jobs from the job queue until ctx is cancelled.
+func (w *Worker) processQueuedJobs(ctx context.Context) error {
+	for {
+		job, err := w.admin.DB.ClaimJob(ctx, queuedJobLockDuration)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if !errors.Is(err, database.ErrNotFound) {
+				w.logger.Error("failed to claim job", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(queuePollInterval):
+			}
+			continue
+		}
+
+		w.runQueuedJob(ctx, job)
+	}
+}
+
+// runQueuedJob runs a claimed job and records its outcome.
+func (w *Worker) runQueuedJob(ctx context.Context, job *database.Job) {
+	ctx, span := tracer.Start(ctx, fmt.Sprintf("runQueuedJob %s", job.Kind), trace.WithAttributes(attribute.String("kind", job.Kind), attribute.String("job_id", job.ID)))
+	defer span.End()
+
+	start := time.Now()
+	jobCtx, cancel := context.WithTimeout(ctx, queuedJobTimeout)
+	err := w.admin.ExecuteJob(jobCtx, job)
+	cancel()
+	jobLatencyHistogram.Record(ctx, time.Since(start).Milliseconds(), metric.WithAttributes(attribute.String("name", job.Kind), attribute.Bool("failed", err != nil)))
+
+	if err == nil {
+		w.logger.Info("queued job completed", zap.String("job_id", job.ID), zap.String("kind", job.Kind), zap.Duration("duration", time.Since(start)), observability.ZapCtx(ctx))
+		err = w.admin.DB.UpdateJobSucceeded(ctx, job.ID)
+		if err != nil {
+			w.logger.Error("failed to mark job as succeeded", zap.String("job_id", job.ID), zap.Error(err), observability.ZapCtx(ctx))
+		}
+		return
+	}
+
+	var retryAfter *time.Time
+	if job.Attempts < job.MaxAttempts {
+		t := time.Now().Add(admin.JobRetryBackoff(job.Attempts))
+		retryAfter = &t
+	}
+	w.logger.Error("queued job failed", zap.String("job_id", job.ID), zap.String("kind", job.Kind), zap.Int("attempt", job.Attempts), zap.Bool("retry", retryAfter != nil), zap.Error(err), observability.ZapCtx(ctx))
+
+	err = w.admin.DB.UpdateJobFailed(ctx, job.ID, err.Error(), retryAfter)
+	if err != nil {
+		w.logger.Error("failed to record job failure", zap.String("job_id", job.ID), zap.Error(err), observability.ZapCtx(ctx))
+	}
+}
+
+func (w *Worker) deleteFinishedJobs(ctx context.Context) error {
+	return w.admin.DB.DeleteFinishedJobs(ctx, finishedJobsRetention)
+}
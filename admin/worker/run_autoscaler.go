@@ -73,6 +73,11 @@ func (w *Worker) runAutoscaler(ctx context.Context) error {
 			ProdTTLSeconds:       targetProject.ProdTTLSeconds,
 			Provisioner:          targetProject.Provisioner,
 			Annotations:          targetProject.Annotations,
+			Labels:               targetProject.Labels,
+			DeployTrigger:        targetProject.DeployTrigger,
+			DeploySchedule:       targetProject.DeploySchedule,
+			DeployBlueGreen:      targetProject.DeployBlueGreen,
+			MaintenanceWindow:    targetProject.MaintenanceWindow,
 		})
 		if err != nil {
 			w.logger.Error("failed to autoscale: error updating the project", zap.String("project_name", targetProject.Name), zap.String("org_name", projectOrg.Name), zap.Error(err))
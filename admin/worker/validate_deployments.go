@@ -7,6 +7,7 @@ import (
 
 	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/maintenancewindow"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"go.uber.org/zap"
 )
@@ -102,6 +103,12 @@ func (w *Worker) reconcileAllDeploymentsForProject(ctx context.Context, proj *da
 
 			// If project is running 'latest' version then update if needed, skip if 'static' provisioner type
 			if p.Type() != "static" && proj.ProdVersion == "latest" && depl.RuntimeVersion != latestVersion {
+				// Defer the upgrade if it's outside the project's maintenance window
+				if !w.inMaintenanceWindow(ctx, org, proj, time.Now()) {
+					w.logger.Debug("validate deployments: deferring upgrade to maintenance window", zap.String("organization_id", org.ID), zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.String("version", latestVersion), observability.ZapCtx(ctx))
+					continue
+				}
+
 				w.logger.Info("validate deployments: upgrading deployment", zap.String("organization_id", org.ID), zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.String("provisioner", depl.Provisioner), zap.String("provision_id", depl.ProvisionID), zap.String("instance_id", depl.RuntimeInstanceID), zap.String("version", latestVersion), observability.ZapCtx(ctx))
 
 				// Update deployment to latest version
@@ -130,3 +137,18 @@ func (w *Worker) reconcileAllDeploymentsForProject(ctx context.Context, proj *da
 
 	return nil
 }
+
+// inMaintenanceWindow returns true if t is inside the maintenance window that applies to the project.
+// Projects without a maintenance window are always considered to be inside it.
+func (w *Worker) inMaintenanceWindow(ctx context.Context, org *database.Organization, proj *database.Project, t time.Time) bool {
+	mw := admin.MaintenanceWindow(org, proj)
+	if mw == "" {
+		return true
+	}
+	win, err := maintenancewindow.Parse(mw)
+	if err != nil {
+		w.logger.Warn("validate deployments: invalid maintenance window", zap.String("project_id", proj.ID), zap.String("maintenance_window", mw), zap.Error(err), observability.ZapCtx(ctx))
+		return true
+	}
+	return win.Contains(t)
+}
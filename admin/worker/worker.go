@@ -70,6 +70,24 @@ func (w *Worker) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return w.schedule(ctx, "deployments_health_check", w.deploymentsHealthCheck, 10*time.Minute)
 	})
+	group.Go(func() error {
+		return w.schedule(ctx, "deploy_scheduled_commits", w.deployScheduledCommits, time.Minute)
+	})
+	group.Go(func() error {
+		return w.schedule(ctx, "check_ops_alerts", w.checkOpsAlerts, 5*time.Minute)
+	})
+	group.Go(func() error {
+		return w.schedule(ctx, "delete_finished_jobs", w.deleteFinishedJobs, 6*time.Hour)
+	})
+	group.Go(func() error {
+		return w.schedule(ctx, "check_runtime_hosts", w.checkRuntimeHosts, time.Minute)
+	})
+	group.Go(func() error {
+		return w.schedule(ctx, "delete_expired_user_notifications", w.deleteExpiredUserNotifications, 6*time.Hour)
+	})
+	group.Go(func() error {
+		return w.processQueuedJobs(ctx)
+	})
 
 	if w.admin.Biller.GetReportingWorkerCron() != "" {
 		group.Go(func() error {
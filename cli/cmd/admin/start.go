@@ -42,6 +42,7 @@ import (
 type Config struct {
 	DatabaseDriver         string                 `default:"postgres" split_words:"true"`
 	DatabaseURL            string                 `split_words:"true"`
+	DatabaseReplicaURL     string                 `split_words:"true"`
 	RedisURL               string                 `default:"" split_words:"true"`
 	ProvisionerSetJSON     string                 `split_words:"true"`
 	DefaultProvisioner     string                 `split_words:"true"`
@@ -71,21 +72,33 @@ type Config struct {
 	AssetsBucket           string                 `split_words:"true"`
 	// AssetsBucketGoogleCredentialsJSON is only required to be set for local development.
 	// For production use cases the service account will be directly attached to pods which is the recommended way of setting credentials.
-	AssetsBucketGoogleCredentialsJSON string `split_words:"true"`
-	EmailSMTPHost                     string `split_words:"true"`
-	EmailSMTPPort                     int    `split_words:"true"`
-	EmailSMTPUsername                 string `split_words:"true"`
-	EmailSMTPPassword                 string `split_words:"true"`
-	EmailSenderEmail                  string `split_words:"true"`
-	EmailSenderName                   string `split_words:"true"`
-	EmailBCC                          string `split_words:"true"`
-	OpenAIAPIKey                      string `envconfig:"openai_api_key"`
-	ActivitySinkType                  string `default:"" split_words:"true"`
-	ActivitySinkKafkaBrokers          string `default:"" split_words:"true"`
-	ActivityUISinkKafkaTopic          string `default:"" split_words:"true"`
-	MetricsProject                    string `default:"" split_words:"true"`
-	AutoscalerCron                    string `default:"CRON_TZ=America/Los_Angeles 0 0 * * 1" split_words:"true"`
-	OrbAPIKey                         string `split_words:"true"`
+	AssetsBucketGoogleCredentialsJSON string   `split_words:"true"`
+	EmailSMTPHost                     string   `split_words:"true"`
+	EmailSMTPPort                     int      `split_words:"true"`
+	EmailSMTPUsername                 string   `split_words:"true"`
+	EmailSMTPPassword                 string   `split_words:"true"`
+	EmailSenderEmail                  string   `split_words:"true"`
+	EmailSenderName                   string   `split_words:"true"`
+	EmailBCC                          string   `split_words:"true"`
+	OpenAIAPIKey                      string   `envconfig:"openai_api_key"`
+	ActivitySinkType                  string   `default:"" split_words:"true"`
+	ActivitySinkKafkaBrokers          string   `default:"" split_words:"true"`
+	ActivityUISinkKafkaTopic          string   `default:"" split_words:"true"`
+	MetricsProject                    string   `default:"" split_words:"true"`
+	AutoscalerCron                    string   `default:"CRON_TZ=America/Los_Angeles 0 0 * * 1" split_words:"true"`
+	RuntimeVersions                   []string `split_words:"true"`
+	RuntimeMTLSCertPath               string   `split_words:"true"`
+	RuntimeMTLSKeyPath                string   `split_words:"true"`
+	RuntimeMTLSCAPath                 string   `split_words:"true"`
+	OrbAPIKey                         string   `split_words:"true"`
+	GithubDeployFeedback              bool     `split_words:"true"`
+	OpsAlertsWebhookURL               string   `split_words:"true"`
+	OpsAlertsEmailOrgAdmins           bool     `split_words:"true"`
+	OpsAlertsStuckReconcileMinutes    int      `default:"60" split_words:"true"`
+	OpsAlertsSlotQuotaThreshold       float64  `default:"0.9" split_words:"true"`
+	RedeployHandoff                   bool     `split_words:"true"`
+	RuntimeHeartbeatTimeoutMinutes    int      `default:"10" split_words:"true"`
+	ReprovisionUnreachableDeployments bool     `split_words:"true"`
 }
 
 // StartCmd starts an admin server. It only allows configuration using environment variables.
@@ -262,16 +275,30 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 
 			// Init admin service
 			admOpts := &admin.Options{
-				DatabaseDriver:     conf.DatabaseDriver,
-				DatabaseDSN:        conf.DatabaseURL,
-				ProvisionerSetJSON: conf.ProvisionerSetJSON,
-				DefaultProvisioner: conf.DefaultProvisioner,
-				ExternalURL:        conf.ExternalGRPCURL, // NOTE: using gRPC url
-				VersionNumber:      ch.Version.Number,
-				VersionCommit:      ch.Version.Commit,
-				MetricsProjectOrg:  metricsProjectOrg,
-				MetricsProjectName: metricsProjectName,
-				AutoscalerCron:     conf.AutoscalerCron,
+				DatabaseDriver:              conf.DatabaseDriver,
+				DatabaseDSN:                 conf.DatabaseURL,
+				DatabaseReplicaDSN:          conf.DatabaseReplicaURL,
+				ProvisionerSetJSON:          conf.ProvisionerSetJSON,
+				DefaultProvisioner:          conf.DefaultProvisioner,
+				ExternalURL:                 conf.ExternalGRPCURL, // NOTE: using gRPC url
+				FrontendURL:                 conf.FrontendURL,
+				VersionNumber:               ch.Version.Number,
+				VersionCommit:               ch.Version.Commit,
+				MetricsProjectOrg:           metricsProjectOrg,
+				MetricsProjectName:          metricsProjectName,
+				AutoscalerCron:              conf.AutoscalerCron,
+				RuntimeVersions:             conf.RuntimeVersions,
+				RuntimeMTLSCertPath:         conf.RuntimeMTLSCertPath,
+				RuntimeMTLSKeyPath:          conf.RuntimeMTLSKeyPath,
+				RuntimeMTLSCAPath:           conf.RuntimeMTLSCAPath,
+				GithubDeployFeedback:        conf.GithubDeployFeedback,
+				OpsAlertsWebhookURL:         conf.OpsAlertsWebhookURL,
+				OpsAlertsEmailOrgAdmins:     conf.OpsAlertsEmailOrgAdmins,
+				OpsAlertsStuckReconcile:     time.Duration(conf.OpsAlertsStuckReconcileMinutes) * time.Minute,
+				OpsAlertsSlotQuotaThreshold: conf.OpsAlertsSlotQuotaThreshold,
+				RedeployHandoff:             conf.RedeployHandoff,
+				RuntimeHeartbeatTimeout:     time.Duration(conf.RuntimeHeartbeatTimeoutMinutes) * time.Minute,
+				ReprovisionUnreachable:      conf.ReprovisionUnreachableDeployments,
 			}
 			adm, err := admin.New(cmd.Context(), admOpts, logger, issuer, emailClient, gh, aiClient, assetsBucket, biller)
 			if err != nil {
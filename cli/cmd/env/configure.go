@@ -62,28 +62,12 @@ func ConfigureCmd(ch *cmdutil.Helper) *cobra.Command {
 				return fmt.Errorf("failed to get variables: %w", err)
 			}
 
-			// get existing variables
-			varResp, err := client.GetProjectVariables(ctx, &adminv1.GetProjectVariablesRequest{
-				OrganizationName: ch.Org,
-				Name:             projectName,
-			})
-			if err != nil {
-				return fmt.Errorf("failed to list existing variables %w", err)
-			}
-
-			if varResp.Variables == nil {
-				varResp.Variables = make(map[string]string)
-			}
-
-			// update with new variables
-			for key, value := range variables {
-				varResp.Variables[key] = value
-			}
-
+			// merge the new variables into the existing variables
 			_, err = client.UpdateProjectVariables(ctx, &adminv1.UpdateProjectVariablesRequest{
 				OrganizationName: ch.Org,
 				Name:             projectName,
-				Variables:        varResp.Variables,
+				Variables:        variables,
+				Merge:            true,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to update variables %w", err)
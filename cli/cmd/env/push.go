@@ -60,20 +60,15 @@ func PushCmd(ch *cmdutil.Helper) *cobra.Command {
 				return err
 			}
 
-			// Merge the current .env file with the cloud variables
-			vars := make(map[string]string)
-			for k, v := range res.Variables {
-				vars[k] = v
-			}
+			// Count the variables in the .env file that are new or differ from the cloud variables
 			added := 0
 			changed := 0
 			for k, v := range parser.DotEnv {
-				if _, ok := vars[k]; !ok {
+				if cv, ok := res.Variables[k]; !ok {
 					added++
-				} else if vars[k] != v {
+				} else if cv != v {
 					changed++
 				}
-				vars[k] = v
 			}
 
 			// If there were no changes, exit early
@@ -94,16 +89,15 @@ func PushCmd(ch *cmdutil.Helper) *cobra.Command {
 				}
 			}
 
-			// Write the merged variables back to the cloud project
-			if added+changed != 0 {
-				_, err = client.UpdateProjectVariables(cmd.Context(), &adminv1.UpdateProjectVariablesRequest{
-					OrganizationName: ch.Org,
-					Name:             projectName,
-					Variables:        vars,
-				})
-				if err != nil {
-					return err
-				}
+			// Merge the .env variables into the cloud project's variables
+			_, err = client.UpdateProjectVariables(cmd.Context(), &adminv1.UpdateProjectVariablesRequest{
+				OrganizationName: ch.Org,
+				Name:             projectName,
+				Variables:        parser.DotEnv,
+				Merge:            true,
+			})
+			if err != nil {
+				return err
 			}
 
 			ch.Printf("Updated cloud env for project %q with variables from %q.\n", projectName, filepath.Join(projectPath, ".env"))
@@ -42,11 +42,11 @@ func RmCmd(ch *cmdutil.Helper) *cobra.Command {
 				return nil
 			}
 
-			delete(resp.Variables, key)
 			_, err = client.UpdateProjectVariables(ctx, &adminv1.UpdateProjectVariablesRequest{
 				OrganizationName: ch.Org,
 				Name:             projectName,
-				Variables:        resp.Variables,
+				Merge:            true,
+				UnsetVariables:   []string{key},
 			})
 			if err != nil {
 				return err
@@ -43,14 +43,11 @@ func SetCmd(ch *cmdutil.Helper) *cobra.Command {
 				return nil
 			}
 
-			if resp.Variables == nil {
-				resp.Variables = make(map[string]string)
-			}
-			resp.Variables[key] = value
 			_, err = client.UpdateProjectVariables(ctx, &adminv1.UpdateProjectVariablesRequest{
 				OrganizationName: ch.Org,
 				Name:             projectName,
-				Variables:        resp.Variables,
+				Variables:        map[string]string{key: value},
+				Merge:            true,
 			})
 			if err != nil {
 				return err
@@ -11,7 +11,7 @@ import (
 )
 
 func EditCmd(ch *cmdutil.Helper) *cobra.Command {
-	var orgName, description string
+	var orgName, description, logoURL, primaryColor, supportEmail, customDomain string
 
 	editCmd := &cobra.Command{
 		Use:   "edit [<org-name>]",
@@ -62,6 +62,22 @@ func EditCmd(ch *cmdutil.Helper) *cobra.Command {
 				promptFlagValues = false
 				req.Description = &description
 			}
+			if cmd.Flags().Changed("logo-url") {
+				promptFlagValues = false
+				req.LogoUrl = &logoURL
+			}
+			if cmd.Flags().Changed("primary-color") {
+				promptFlagValues = false
+				req.PrimaryColor = &primaryColor
+			}
+			if cmd.Flags().Changed("support-email") {
+				promptFlagValues = false
+				req.SupportEmail = &supportEmail
+			}
+			if cmd.Flags().Changed("custom-domain") {
+				promptFlagValues = false
+				req.CustomDomain = &customDomain
+			}
 
 			if promptFlagValues {
 				description, err = cmdutil.InputPrompt("Enter the description", org.Description)
@@ -85,6 +101,10 @@ func EditCmd(ch *cmdutil.Helper) *cobra.Command {
 	editCmd.Flags().SortFlags = false
 	editCmd.Flags().StringVar(&orgName, "org", ch.Org, "Organization name")
 	editCmd.Flags().StringVar(&description, "description", "", "Description")
+	editCmd.Flags().StringVar(&logoURL, "logo-url", "", "URL of a logo to use instead of Rill's logo (pass an empty value to clear)")
+	editCmd.Flags().StringVar(&primaryColor, "primary-color", "", "Primary brand color as a hex color, like \"#1e40af\"")
+	editCmd.Flags().StringVar(&supportEmail, "support-email", "", "Email address users can contact for support")
+	editCmd.Flags().StringVar(&customDomain, "custom-domain", "", "Custom domain for the organization's dashboards (superusers only)")
 
 	return editCmd
 }
@@ -0,0 +1,78 @@
+package notifier
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func AddCmd(ch *cmdutil.Helper) *cobra.Command {
+	var connector, secret string
+	var recipients, webhooks, urls, events []string
+
+	addCmd := &cobra.Command{
+		Use:   "add <name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Add a notifier for deployment failures, invites and other events in the org",
+		Example: `  rill org notifier add ops --type email --recipients ops@example.com
+  rill org notifier add alerts --type slack --webhooks https://hooks.slack.com/services/... --events deployment_error
+  rill org notifier add teams --type teams --webhooks https://example.webhook.office.com/...
+  rill org notifier add hook --type webhook --urls https://example.com/rill --secret s3cret`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			props := map[string]any{}
+			switch connector {
+			case "email":
+				props["recipients"] = pbutil.ToSliceAny(recipients)
+			case "slack", "teams":
+				props["webhooks"] = pbutil.ToSliceAny(webhooks)
+			case "webhook":
+				props["urls"] = pbutil.ToSliceAny(urls)
+				if secret != "" {
+					props["secret"] = secret
+				}
+			default:
+				return fmt.Errorf("invalid notifier type %q (expected one of email, slack, teams or webhook)", connector)
+			}
+
+			propsPB, err := structpb.NewStruct(props)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.CreateOrganizationNotifier(ctx, &adminv1.CreateOrganizationNotifierRequest{
+				Organization: ch.Org,
+				Name:         args[0],
+				Connector:    connector,
+				Properties:   propsPB,
+				Events:       events,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Added notifier %q to organization %q\n", args[0], ch.Org)
+			return nil
+		},
+	}
+
+	addCmd.Flags().StringVar(&ch.Org, "org", ch.Org, "Organization")
+	addCmd.Flags().StringVar(&connector, "type", "", "Notifier type (email, slack, teams or webhook)")
+	addCmd.Flags().StringSliceVar(&recipients, "recipients", nil, "Email recipients (for email notifiers)")
+	addCmd.Flags().StringSliceVar(&webhooks, "webhooks", nil, "Incoming webhook URLs (for slack and teams notifiers)")
+	addCmd.Flags().StringSliceVar(&urls, "urls", nil, "URLs to post JSON payloads to (for webhook notifiers)")
+	addCmd.Flags().StringVar(&secret, "secret", "", "Secret used to sign payloads (for webhook notifiers)")
+	addCmd.Flags().StringSliceVar(&events, "events", nil, "Events to notify about (deployment_error, deployment_stuck, deployment_unreachable, org_slot_quota, invite). Defaults to all events.")
+
+	return addCmd
+}
@@ -0,0 +1,48 @@
+package notifier
+
+import (
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd(ch *cmdutil.Helper) *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List notifiers for the org",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			res, err := client.ListOrganizationNotifiers(ctx, &adminv1.ListOrganizationNotifiersRequest{Organization: ch.Org})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Notifiers) == 0 {
+				ch.PrintfSuccess("No notifiers for organization %q\n", ch.Org)
+				return nil
+			}
+
+			ch.PrintfSuccess("Notifiers for organization %q:\n", ch.Org)
+			for _, n := range res.Notifiers {
+				events := "all events"
+				if len(n.Events) > 0 {
+					events = strings.Join(n.Events, ", ")
+				}
+				ch.PrintfSuccess("%q (%s, %s)\n", n.Name, n.Connector, events)
+			}
+			return nil
+		},
+	}
+
+	listCmd.Flags().StringVar(&ch.Org, "org", ch.Org, "Organization")
+
+	return listCmd
+}
@@ -0,0 +1,20 @@
+package notifier
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NotifierCmd(ch *cmdutil.Helper) *cobra.Command {
+	notifierCmd := &cobra.Command{
+		Use:               "notifier",
+		Short:             "Manage where notifications about the org are sent",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(ch), cmdutil.CheckOrganization(ch)),
+	}
+
+	notifierCmd.AddCommand(AddCmd(ch))
+	notifierCmd.AddCommand(RemoveCmd(ch))
+	notifierCmd.AddCommand(ListCmd(ch))
+
+	return notifierCmd
+}
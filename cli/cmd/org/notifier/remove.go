@@ -0,0 +1,38 @@
+package notifier
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func RemoveCmd(ch *cmdutil.Helper) *cobra.Command {
+	removeCmd := &cobra.Command{
+		Use:   "remove <name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove a notifier from the org",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.DeleteOrganizationNotifier(ctx, &adminv1.DeleteOrganizationNotifierRequest{
+				Organization: ch.Org,
+				Name:         args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Removed notifier %q from organization %q\n", args[0], ch.Org)
+			return nil
+		},
+	}
+
+	removeCmd.Flags().StringVar(&ch.Org, "org", ch.Org, "Organization")
+
+	return removeCmd
+}
@@ -0,0 +1,59 @@
+package project
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ApproveCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path string
+
+	approveCmd := &cobra.Command{
+		Use:   "approve [<project-name>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Deploy the latest commit held back by the project's deploy trigger",
+		Long:  "Deploy the latest commit pushed to the production branch of a project that doesn't deploy on push (see the --deploy-trigger flag of `rill project edit`)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if len(args) > 0 {
+				project = args[0]
+			}
+
+			if !cmd.Flags().Changed("project") && len(args) == 0 && ch.Interactive {
+				var err error
+				project, err = ch.InferProjectName(ctx, ch.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := client.ApproveDeployment(ctx, &adminv1.ApproveDeploymentRequest{Organization: ch.Org, Project: project})
+			if err != nil {
+				return err
+			}
+
+			if res.DeployedSha == "" {
+				ch.PrintfWarn("No pending deployment found for project %q\n", project)
+				return nil
+			}
+
+			fmt.Printf("Deploying commit %s. To see status, run `rill project status --project %s`.\n", res.DeployedSha, project)
+
+			return nil
+		},
+	}
+
+	approveCmd.Flags().SortFlags = false
+	approveCmd.Flags().StringVar(&project, "project", "", "Project name")
+	approveCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	return approveCmd
+}
@@ -0,0 +1,83 @@
+package project
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func CloneCmd(ch *cmdutil.Helper) *cobra.Command {
+	var name, newName, newOrg, description, prodBranch string
+	var stripSecrets, copyMembers bool
+
+	cloneCmd := &cobra.Command{
+		Use:   "clone [<project-name>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Create a new project from an existing project",
+		Long:  "Create a new project that deploys the same files and settings as an existing project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			if name == "" && ch.Interactive {
+				projectNames, err := projectNames(ctx, ch)
+				if err != nil {
+					return err
+				}
+
+				name, err = cmdutil.SelectPrompt("Select project to clone", projectNames, "")
+				if err != nil {
+					return err
+				}
+			}
+
+			if ch.Interactive {
+				err = cmdutil.SetFlagsByInputPrompts(*cmd, "new-name")
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := client.CloneProject(ctx, &adminv1.CloneProjectRequest{
+				OrganizationName:    ch.Org,
+				Name:                name,
+				NewOrganizationName: newOrg,
+				NewName:             newName,
+				Description:         description,
+				ProdBranch:          prodBranch,
+				StripSecrets:        stripSecrets,
+				CopyMembers:         copyMembers,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Cloned project %q to %q\n", name, res.Project.Name)
+			if stripSecrets {
+				ch.PrintfWarn("Variable values were not copied. Set them with `rill env set` before the new project can ingest data.\n")
+			}
+			ch.PrintProjects([]*adminv1.Project{res.Project})
+
+			return nil
+		},
+	}
+
+	cloneCmd.Flags().SortFlags = false
+	cloneCmd.Flags().StringVar(&name, "project", "", "Project to clone")
+	cloneCmd.Flags().StringVar(&newName, "new-name", "", "Name of the new project")
+	cloneCmd.Flags().StringVar(&newOrg, "new-org", "", "Organization for the new project (defaults to the current organization)")
+	cloneCmd.Flags().StringVar(&description, "description", "", "Description of the new project (defaults to the description of the cloned project)")
+	cloneCmd.Flags().StringVar(&prodBranch, "prod-branch", "", "Git branch to deploy for the new project (defaults to the branch of the cloned project)")
+	cloneCmd.Flags().BoolVar(&stripSecrets, "strip-secrets", false, "Copy all variable names without their values (required when cloning to another organization)")
+	cloneCmd.Flags().BoolVar(&copyMembers, "copy-members", false, "Add the members of the cloned project to the new project")
+
+	return cloneCmd
+}
@@ -115,6 +115,8 @@ func parseResourceKind(k string) string {
 		return runtime.ResourceKindAPI
 	case "connector":
 		return runtime.ResourceKindConnector
+	case "segment":
+		return runtime.ResourceKindSegment
 	default:
 		return k
 	}
@@ -2,6 +2,7 @@ package project
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -9,10 +10,11 @@ import (
 )
 
 func EditCmd(ch *cmdutil.Helper) *cobra.Command {
-	var name, description, prodVersion, prodBranch, path, provisioner string
-	var public bool
+	var name, description, prodVersion, prodBranch, path, provisioner, deployTrigger, deploySchedule string
+	var public, blueGreen bool
 	var slots int
 	var prodTTL int64
+	var labels map[string]string
 
 	editCmd := &cobra.Command{
 		Use:   "edit [<project-name>]",
@@ -81,6 +83,27 @@ func EditCmd(ch *cmdutil.Helper) *cobra.Command {
 				promptFlagValues = false
 				req.ProdTtlSeconds = &prodTTL
 			}
+			if cmd.Flags().Changed("label") {
+				promptFlagValues = false
+				req.Labels = labels
+			}
+			if cmd.Flags().Changed("deploy-trigger") {
+				promptFlagValues = false
+				t, ok := adminv1.DeployTrigger_value["DEPLOY_TRIGGER_"+strings.ToUpper(deployTrigger)]
+				if !ok || t == int32(adminv1.DeployTrigger_DEPLOY_TRIGGER_UNSPECIFIED) {
+					return fmt.Errorf("invalid deploy trigger %q (options: push, manual, schedule)", deployTrigger)
+				}
+				trigger := adminv1.DeployTrigger(t)
+				req.DeployTrigger = &trigger
+			}
+			if cmd.Flags().Changed("deploy-schedule") {
+				promptFlagValues = false
+				req.DeploySchedule = &deploySchedule
+			}
+			if cmd.Flags().Changed("blue-green") {
+				promptFlagValues = false
+				req.DeployBlueGreen = &blueGreen
+			}
 
 			if promptFlagValues {
 				resp, err := client.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: ch.Org, Name: name})
@@ -131,6 +154,10 @@ func EditCmd(ch *cmdutil.Helper) *cobra.Command {
 	editCmd.Flags().StringVar(&provisioner, "provisioner", "", "Project provisioner (default: current provisioner)")
 	editCmd.Flags().Int64Var(&prodTTL, "prod-ttl-seconds", 0, "Prod deployment TTL in seconds")
 	editCmd.Flags().StringVar(&prodVersion, "prod-version", "", "Rill version (default: current version)")
+	editCmd.Flags().StringToStringVar(&labels, "label", nil, "Labels to set on the project as key=value (pass an empty value to remove a label)")
+	editCmd.Flags().StringVar(&deployTrigger, "deploy-trigger", "", "When to deploy pushes to the production branch: push, manual (deploy with rill project approve) or schedule")
+	editCmd.Flags().StringVar(&deploySchedule, "deploy-schedule", "", "Cron schedule for deploys when --deploy-trigger is schedule (default: nightly at midnight UTC)")
+	editCmd.Flags().BoolVar(&blueGreen, "blue-green", false, "Deploy new commits to a parallel deployment that replaces production once it has reconciled without errors")
 	editCmd.Flags().IntVar(&slots, "prod-slots", 0, "Slots to allocate for production deployments (default: current slots)")
 	if !ch.IsDev() {
 		if err := editCmd.Flags().MarkHidden("prod-slots"); err != nil {
@@ -9,6 +9,7 @@ import (
 func ListCmd(ch *cmdutil.Helper) *cobra.Command {
 	var pageSize uint32
 	var pageToken string
+	var labels map[string]string
 
 	listCmd := &cobra.Command{
 		Use:   "list",
@@ -23,6 +24,7 @@ func ListCmd(ch *cmdutil.Helper) *cobra.Command {
 				OrganizationName: ch.Org,
 				PageSize:         pageSize,
 				PageToken:        pageToken,
+				Labels:           labels,
 			})
 			if err != nil {
 				return err
@@ -47,6 +49,7 @@ func ListCmd(ch *cmdutil.Helper) *cobra.Command {
 
 	listCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of projects to return per page")
 	listCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+	listCmd.Flags().StringToStringVar(&labels, "label", nil, "Only list projects with these labels (key=value)")
 
 	return listCmd
 }
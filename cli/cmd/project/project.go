@@ -21,6 +21,7 @@ func ProjectCmd(ch *cmdutil.Helper) *cobra.Command {
 	projectCmd.AddCommand(ShowCmd(ch))
 	projectCmd.AddCommand(EditCmd(ch))
 	projectCmd.AddCommand(RenameCmd(ch))
+	projectCmd.AddCommand(CloneCmd(ch))
 	projectCmd.AddCommand(DeleteCmd(ch))
 	projectCmd.AddCommand(StatusCmd(ch))
 	projectCmd.AddCommand(LogsCmd(ch))
@@ -28,6 +29,7 @@ func ProjectCmd(ch *cmdutil.Helper) *cobra.Command {
 	projectCmd.AddCommand(RefreshCmd(ch))
 	projectCmd.AddCommand(ReconcileCmd(ch))
 	projectCmd.AddCommand(ResetCmd(ch))
+	projectCmd.AddCommand(ApproveCmd(ch))
 	projectCmd.AddCommand(JwtCmd(ch))
 
 	return projectCmd
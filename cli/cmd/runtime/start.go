@@ -19,6 +19,7 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/graceful"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/pkg/sharedcache"
 	"github.com/rilldata/rill/runtime/server"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -45,6 +46,8 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/slack"
 	_ "github.com/rilldata/rill/runtime/drivers/snowflake"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
+	_ "github.com/rilldata/rill/runtime/drivers/teams"
+	_ "github.com/rilldata/rill/runtime/drivers/webhook"
 	_ "github.com/rilldata/rill/runtime/reconcilers"
 	_ "github.com/rilldata/rill/runtime/resolvers"
 )
@@ -53,32 +56,45 @@ import (
 // Env var keys must be prefixed with RILL_RUNTIME_ and are converted from snake_case to CamelCase.
 // For example RILL_RUNTIME_HTTP_PORT is mapped to Config.HTTPPort.
 type Config struct {
-	MetastoreDriver         string                 `default:"sqlite" split_words:"true"`
-	MetastoreURL            string                 `default:"file:rill?mode=memory&cache=shared" split_words:"true"`
-	RedisURL                string                 `default:"" split_words:"true"`
-	MetricsExporter         observability.Exporter `default:"prometheus" split_words:"true"`
-	TracesExporter          observability.Exporter `default:"" split_words:"true"`
-	LogLevel                zapcore.Level          `default:"info" split_words:"true"`
-	HTTPPort                int                    `default:"8080" split_words:"true"`
-	GRPCPort                int                    `default:"9090" split_words:"true"`
-	DebugPort               int                    `default:"6060" split_words:"true"`
-	AllowedOrigins          []string               `default:"*" split_words:"true"`
-	SessionKeyPairs         []string               `split_words:"true"`
-	AuthEnable              bool                   `default:"false" split_words:"true"`
-	AuthIssuerURL           string                 `default:"" split_words:"true"`
-	AuthAudienceURL         string                 `default:"" split_words:"true"`
-	EmailSMTPHost           string                 `split_words:"true"`
-	EmailSMTPPort           int                    `split_words:"true"`
-	EmailSMTPUsername       string                 `split_words:"true"`
-	EmailSMTPPassword       string                 `split_words:"true"`
-	EmailSenderEmail        string                 `split_words:"true"`
-	EmailSenderName         string                 `split_words:"true"`
-	EmailBCC                string                 `split_words:"true"`
-	ConnectionCacheSize     int                    `default:"100" split_words:"true"`
-	QueryCacheSizeBytes     int64                  `default:"104857600" split_words:"true"` // 100MB by default
-	SecurityEngineCacheSize int                    `default:"1000" split_words:"true"`
-	LogBufferCapacity       int                    `default:"10000" split_words:"true"`    // 10k log lines
-	LogBufferSizeBytes      int64                  `default:"16777216" split_words:"true"` // 16MB by default
+	MetastoreDriver              string                 `default:"sqlite" split_words:"true"`
+	MetastoreURL                 string                 `default:"file:rill?mode=memory&cache=shared" split_words:"true"`
+	RedisURL                     string                 `default:"" split_words:"true"`
+	MetricsExporter              observability.Exporter `default:"prometheus" split_words:"true"`
+	TracesExporter               observability.Exporter `default:"" split_words:"true"`
+	LogLevel                     zapcore.Level          `default:"info" split_words:"true"`
+	HTTPPort                     int                    `default:"8080" split_words:"true"`
+	GRPCPort                     int                    `default:"9090" split_words:"true"`
+	DebugPort                    int                    `default:"6060" split_words:"true"`
+	AllowedOrigins               []string               `default:"*" split_words:"true"`
+	SessionKeyPairs              []string               `split_words:"true"`
+	AuthEnable                   bool                   `default:"false" split_words:"true"`
+	AuthIssuerURL                string                 `default:"" split_words:"true"`
+	AuthAudienceURL              string                 `default:"" split_words:"true"`
+	MTLSCertPath                 string                 `split_words:"true"`
+	MTLSKeyPath                  string                 `split_words:"true"`
+	MTLSClientCAPath             string                 `split_words:"true"`
+	EmailSMTPHost                string                 `split_words:"true"`
+	EmailSMTPPort                int                    `split_words:"true"`
+	EmailSMTPUsername            string                 `split_words:"true"`
+	EmailSMTPPassword            string                 `split_words:"true"`
+	EmailSenderEmail             string                 `split_words:"true"`
+	EmailSenderName              string                 `split_words:"true"`
+	EmailBCC                     string                 `split_words:"true"`
+	ConnectionCacheSize          int                    `default:"100" split_words:"true"`
+	QueryCacheSizeBytes          int64                  `default:"104857600" split_words:"true"` // 100MB by default
+	QueryCacheInstanceSizeBytes  int64                  `default:"0" split_words:"true"`         // No per-instance limit by default
+	QueryCacheDiskSizeBytes      int64                  `default:"0" split_words:"true"`         // Disabled by default
+	QueryCacheDiskThresholdBytes int64                  `default:"10485760" split_words:"true"`  // 10MB by default
+	QueryCacheRedisURL           string                 `default:"" split_words:"true"`          // Shares query results between replicas if set
+	QueryCacheWarmingLimit       int                    `default:"10" split_words:"true"`        // Frequent queries replayed after a metrics view is refreshed
+	SecurityEngineCacheSize      int                    `default:"1000" split_words:"true"`
+	LogBufferCapacity            int                    `default:"10000" split_words:"true"`    // 10k log lines
+	LogBufferSizeBytes           int64                  `default:"16777216" split_words:"true"` // 16MB by default
+	SlowQueryThreshold           time.Duration          `default:"5s" split_words:"true"`
+	DrainTimeout                 time.Duration          `default:"15s" split_words:"true"`
+	BackupURL                    string                 `split_words:"true"`
+	BackupInterval               time.Duration          `default:"24h" split_words:"true"`
+	BackupRetention              int                    `default:"7" split_words:"true"`
 	// AllowHostAccess controls whether instance can use host credentials and
 	// local_file sources can access directory outside repo
 	AllowHostAccess bool `default:"false" split_words:"true"`
@@ -198,16 +214,37 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 			// Create ctx that cancels on termination signals
 			ctx := graceful.WithCancelOnTerminate(context.Background())
 
+			// Init shared query cache
+			var sharedQueryCache sharedcache.Cache
+			if conf.QueryCacheRedisURL != "" {
+				redisOpts, err := redis.ParseURL(conf.QueryCacheRedisURL)
+				if err != nil {
+					logger.Fatal("failed to parse query cache redis url", zap.Error(err))
+				}
+				sharedQueryCache = sharedcache.NewRedis(redis.NewClient(redisOpts), sharedcache.RedisOptions{Prefix: "rill:query_cache:"})
+			}
+
 			// Init runtime
 			opts := &runtime.Options{
 				ConnectionCacheSize:          conf.ConnectionCacheSize,
 				MetastoreConnector:           "metastore",
 				QueryCacheSizeBytes:          conf.QueryCacheSizeBytes,
+				QueryCacheInstanceSizeBytes:  conf.QueryCacheInstanceSizeBytes,
+				QueryCacheDiskSizeBytes:      conf.QueryCacheDiskSizeBytes,
+				QueryCacheDiskThresholdBytes: conf.QueryCacheDiskThresholdBytes,
+				SharedQueryCache:             sharedQueryCache,
+				QueryCacheWarmingLimit:       conf.QueryCacheWarmingLimit,
 				SecurityEngineCacheSize:      conf.SecurityEngineCacheSize,
 				ControllerLogBufferCapacity:  conf.LogBufferCapacity,
 				ControllerLogBufferSizeBytes: conf.LogBufferSizeBytes,
 				AllowHostAccess:              conf.AllowHostAccess,
 				DataDir:                      conf.DataDir,
+				SlowQueryThreshold:           conf.SlowQueryThreshold,
+				DrainTimeout:                 conf.DrainTimeout,
+				BackupURL:                    conf.BackupURL,
+				BackupInterval:               conf.BackupInterval,
+				BackupRetention:              conf.BackupRetention,
+				Version:                      ch.Version.Number,
 				SystemConnectors: []*runtimev1.Connector{
 					{
 						Type:   conf.MetastoreDriver,
@@ -222,6 +259,10 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 			}
 			defer rt.Close()
 
+			// Stop accepting new queries as soon as a termination signal is received.
+			// In-flight requests are allowed to finish during the servers' graceful shutdown, and in-flight reconciles during rt.Close.
+			context.AfterFunc(ctx, rt.Drain)
+
 			var limiter ratelimit.Limiter
 			if conf.RedisURL == "" {
 				limiter = ratelimit.NewNoop()
@@ -235,14 +276,17 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 
 			// Init server
 			srvOpts := &server.Options{
-				HTTPPort:        conf.HTTPPort,
-				GRPCPort:        conf.GRPCPort,
-				AllowedOrigins:  conf.AllowedOrigins,
-				ServePrometheus: conf.MetricsExporter == observability.PrometheusExporter,
-				SessionKeyPairs: keyPairs,
-				AuthEnable:      conf.AuthEnable,
-				AuthIssuerURL:   conf.AuthIssuerURL,
-				AuthAudienceURL: conf.AuthAudienceURL,
+				HTTPPort:         conf.HTTPPort,
+				GRPCPort:         conf.GRPCPort,
+				AllowedOrigins:   conf.AllowedOrigins,
+				ServePrometheus:  conf.MetricsExporter == observability.PrometheusExporter,
+				SessionKeyPairs:  keyPairs,
+				AuthEnable:       conf.AuthEnable,
+				AuthIssuerURL:    conf.AuthIssuerURL,
+				AuthAudienceURL:  conf.AuthAudienceURL,
+				MTLSCertPath:     conf.MTLSCertPath,
+				MTLSKeyPath:      conf.MTLSKeyPath,
+				MTLSClientCAPath: conf.MTLSClientCAPath,
 			}
 			s, err := server.NewServer(ctx, srvOpts, rt, logger, limiter, activityClient)
 			if err != nil {
@@ -0,0 +1,49 @@
+package project
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func MigrateCmd(ch *cmdutil.Helper) *cobra.Command {
+	var provisioner string
+
+	migrateCmd := &cobra.Command{
+		Use:   "migrate <org> <project>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Move a project's deployment to another runtime without re-ingesting its data",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfWarn("The project will not refresh or apply changes until the migration completes.\n")
+			ok, err := cmdutil.ConfirmPrompt("Do you want to continue?", "", false)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+
+			res, err := client.SudoMigrateDeployment(ctx, &adminv1.SudoMigrateDeploymentRequest{
+				Organization: args[0],
+				Project:      args[1],
+				Provisioner:  provisioner,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Migrated deployment %q to runtime %q (instance ID: %s)\n", res.Deployment.Id, res.Deployment.RuntimeHost, res.Deployment.RuntimeInstanceId)
+			return nil
+		},
+	}
+	migrateCmd.Flags().StringVar(&provisioner, "provisioner", "", "Provisioner to allocate the new runtime from (defaults to the current provisioner)")
+
+	return migrateCmd
+}
@@ -13,6 +13,8 @@ func ProjectCmd(ch *cmdutil.Helper) *cobra.Command {
 
 	projectCmd.AddCommand(GetCmd(ch))
 	projectCmd.AddCommand(SearchCmd(ch))
+	projectCmd.AddCommand(MigrateCmd(ch))
+	projectCmd.AddCommand(RuntimeConfigCmd(ch))
 
 	return projectCmd
 }
@@ -0,0 +1,52 @@
+package project
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func RuntimeConfigCmd(ch *cmdutil.Helper) *cobra.Command {
+	var runtimeConfig map[string]string
+
+	runtimeConfigCmd := &cobra.Command{
+		Use:   "runtime-config <org> <project>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set runtime config overrides for a project's deployment",
+		Long:  "Set runtime config overrides for a project's deployment, e.g. --set rill.metrics.max_execution_timeout_seconds=60 or --set rill.features.<flag>=false.\nThe overrides replace any previously set overrides and are applied without redeploying.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if len(runtimeConfig) == 0 {
+				ch.PrintfWarn("Setting an empty runtime config will remove all overrides from the deployment.\n")
+				ok, err := cmdutil.ConfirmPrompt("Do you want to continue?", "", false)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return nil
+				}
+			}
+
+			res, err := client.SudoUpdateRuntimeConfig(ctx, &adminv1.SudoUpdateRuntimeConfigRequest{
+				Organization:  args[0],
+				Project:       args[1],
+				RuntimeConfig: runtimeConfig,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Updated runtime config of deployment %q\n", res.Deployment.Id)
+			return nil
+		},
+	}
+	runtimeConfigCmd.Flags().StringToStringVar(&runtimeConfig, "set", nil, "Runtime config override(s) to set on the deployment")
+
+	return runtimeConfigCmd
+}
@@ -0,0 +1,85 @@
+package user
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ImportCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectName string
+	var file string
+
+	importCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Add users in bulk from a CSV or JSON file",
+		Long: `Add users in bulk from a CSV or JSON file.
+
+A CSV file must have the columns "email" and "role" (in that order, or with a header row).
+A JSON file must contain a list of objects with the keys "email" and "role".
+
+The import is all-or-nothing: if any row is invalid, no users are added.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmdutil.StringPromptIfEmpty(&file, "Enter path to CSV or JSON file")
+			if err != nil {
+				return err
+			}
+
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+
+			req := &adminv1.ImportMemberUsersRequest{
+				Organization: ch.Org,
+				Project:      projectName,
+			}
+			if strings.EqualFold(filepath.Ext(file), ".json") {
+				var members []*adminv1.ImportMemberUser
+				err := json.Unmarshal(data, &members)
+				if err != nil {
+					return fmt.Errorf("failed to parse %q: %w", file, err)
+				}
+				req.Members = members
+			} else {
+				req.Csv = string(data)
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			res, err := client.ImportMemberUsers(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			ch.PrintMemberImportResults(res.Results)
+
+			if !res.Imported {
+				return fmt.Errorf("no users were imported because some rows are invalid")
+			}
+
+			if projectName != "" {
+				ch.PrintfSuccess("Imported %d users to the project \"%s/%s\"\n", len(res.Results), ch.Org, projectName)
+			} else {
+				ch.PrintfSuccess("Imported %d users to the organization %q\n", len(res.Results), ch.Org)
+			}
+
+			return nil
+		},
+	}
+
+	importCmd.Flags().StringVar(&ch.Org, "org", ch.Org, "Organization")
+	importCmd.Flags().StringVar(&projectName, "project", "", "Project")
+	importCmd.Flags().StringVar(&file, "file", "", "Path to a CSV or JSON file with the columns email and role")
+
+	return importCmd
+}
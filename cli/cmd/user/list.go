@@ -186,7 +186,7 @@ func listOrgMembers(cmd *cobra.Command, ch *cmdutil.Helper, org, pageToken strin
 		return err
 	}
 
-	ch.PrintMemberUsers(members.Members)
+	ch.PrintOrgMemberUsers(members.Members)
 
 	if members.NextPageToken != "" {
 		cmd.Println()
@@ -17,6 +17,7 @@ func UserCmd(ch *cmdutil.Helper) *cobra.Command {
 	userCmd.AddCommand(AddCmd(ch))
 	userCmd.AddCommand(RemoveCmd(ch))
 	userCmd.AddCommand(SetRoleCmd(ch))
+	userCmd.AddCommand(ImportCmd(ch))
 	userCmd.AddCommand(whitelist.WhitelistCmd(ch))
 
 	return userCmd
@@ -1,7 +1,9 @@
 package printer
 
 import (
+	"fmt"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -69,11 +71,18 @@ func toProjectRow(o *adminv1.Project) *project {
 		githubURL = filepath.Join(o.GithubUrl, "tree", o.ProdBranch, o.Subpath)
 	}
 
+	labels := make([]string, 0, len(o.Labels))
+	for k, v := range o.Labels {
+		labels = append(labels, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(labels)
+
 	return &project{
 		Name:         o.Name,
 		Public:       o.Public,
 		GithubURL:    githubURL,
 		Organization: o.OrgName,
+		Labels:       strings.Join(labels, ","),
 	}
 }
 
@@ -82,6 +91,7 @@ type project struct {
 	Public       bool   `header:"public" json:"public"`
 	GithubURL    string `header:"github" json:"github"`
 	Organization string `header:"organization" json:"organization"`
+	Labels       string `header:"labels" json:"labels"`
 }
 
 func (p *Printer) PrintUsers(users []*adminv1.User) {
@@ -152,6 +162,54 @@ type memberUser struct {
 	UpdatedOn string `header:"updated_on,timestamp(ms|utc|human)" json:"updated_on"`
 }
 
+func (p *Printer) PrintOrgMemberUsers(members []*adminv1.MemberUser) {
+	if len(members) == 0 {
+		p.PrintfWarn("No members found\n")
+		return
+	}
+
+	rows := make([]*orgMemberUser, 0, len(members))
+	for _, m := range members {
+		var lastLoginOn, lastActiveOn string
+		if m.LastLoginOn != nil {
+			lastLoginOn = m.LastLoginOn.AsTime().Local().Format(time.DateTime)
+		}
+		if m.LastActiveOn != nil {
+			lastActiveOn = m.LastActiveOn.AsTime().Local().Format(time.DateTime)
+		}
+
+		var seat string
+		switch m.SeatType {
+		case adminv1.SeatType_SEAT_TYPE_EDITOR:
+			seat = "editor"
+		case adminv1.SeatType_SEAT_TYPE_VIEWER:
+			seat = "viewer"
+		}
+
+		rows = append(rows, &orgMemberUser{
+			Name:              m.UserName,
+			Email:             m.UserEmail,
+			RoleName:          m.RoleName,
+			Seat:              seat,
+			LastLoginOn:       lastLoginOn,
+			LastActiveOn:      lastActiveOn,
+			LastActiveProject: m.LastActiveProject,
+		})
+	}
+
+	p.PrintData(rows)
+}
+
+type orgMemberUser struct {
+	Name              string `header:"name" json:"display_name"`
+	Email             string `header:"email" json:"email"`
+	RoleName          string `header:"role" json:"role_name"`
+	Seat              string `header:"seat" json:"seat"`
+	LastLoginOn       string `header:"last_login_on,timestamp(ms|utc|human)" json:"last_login_on"`
+	LastActiveOn      string `header:"last_active_on,timestamp(ms|utc|human)" json:"last_active_on"`
+	LastActiveProject string `header:"last_active_project" json:"last_active_project"`
+}
+
 func (p *Printer) PrintInvites(invites []*adminv1.UserInvite) {
 	if len(invites) == 0 {
 		return
@@ -456,3 +514,36 @@ type usergroupMember struct {
 	Name  string `header:"name" json:"name"`
 	Email string `header:"email" json:"email"`
 }
+
+func (p *Printer) PrintMemberImportResults(results []*adminv1.ImportMemberUserResult) {
+	if len(results) == 0 {
+		return
+	}
+
+	rows := make([]*memberImportResult, 0, len(results))
+	for _, r := range results {
+		status := "added"
+		if r.Error != "" {
+			status = "error"
+		} else if r.PendingSignup {
+			status = "invited"
+		}
+		rows = append(rows, &memberImportResult{
+			Row:    r.Row,
+			Email:  r.Email,
+			Role:   r.Role,
+			Status: status,
+			Error:  r.Error,
+		})
+	}
+
+	p.PrintData(rows)
+}
+
+type memberImportResult struct {
+	Row    int32  `header:"row" json:"row"`
+	Email  string `header:"email" json:"email"`
+	Role   string `header:"role" json:"role"`
+	Status string `header:"status" json:"status"`
+	Error  string `header:"error" json:"error"`
+}
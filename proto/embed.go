@@ -0,0 +1,9 @@
+// Package proto embeds artifacts generated from the protocol buffer definitions that are served at runtime.
+package proto
+
+import _ "embed"
+
+// AdminOpenAPISpec is the OpenAPI (Swagger 2.0) spec of the admin server's REST gateway in YAML format.
+//
+//go:embed gen/rill/admin/v1/admin.swagger.yaml
+var AdminOpenAPISpec []byte
@@ -268,6 +268,213 @@ var _ interface {
 	ErrorName() string
 } = PingResponseValidationError{}
 
+// Validate checks the field values on ListRuntimeVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRuntimeVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRuntimeVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRuntimeVersionsRequestMultiError, or nil if none found.
+func (m *ListRuntimeVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRuntimeVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListRuntimeVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRuntimeVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListRuntimeVersionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListRuntimeVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRuntimeVersionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRuntimeVersionsRequestMultiError) AllErrors() []error { return m }
+
+// ListRuntimeVersionsRequestValidationError is the validation error returned
+// by ListRuntimeVersionsRequest.Validate if the designated constraints aren't met.
+type ListRuntimeVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRuntimeVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRuntimeVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRuntimeVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRuntimeVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRuntimeVersionsRequestValidationError) ErrorName() string {
+	return "ListRuntimeVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRuntimeVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRuntimeVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRuntimeVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRuntimeVersionsRequestValidationError{}
+
+// Validate checks the field values on ListRuntimeVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRuntimeVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRuntimeVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRuntimeVersionsResponseMultiError, or nil if none found.
+func (m *ListRuntimeVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRuntimeVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Latest
+
+	if len(errors) > 0 {
+		return ListRuntimeVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRuntimeVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRuntimeVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRuntimeVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRuntimeVersionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRuntimeVersionsResponseMultiError) AllErrors() []error { return m }
+
+// ListRuntimeVersionsResponseValidationError is the validation error returned
+// by ListRuntimeVersionsResponse.Validate if the designated constraints
+// aren't met.
+type ListRuntimeVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRuntimeVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRuntimeVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRuntimeVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRuntimeVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRuntimeVersionsResponseValidationError) ErrorName() string {
+	return "ListRuntimeVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRuntimeVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRuntimeVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRuntimeVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRuntimeVersionsResponseValidationError{}
+
 // Validate checks the field values on ListOrganizationsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -824,6 +1031,10 @@ func (m *CreateOrganizationRequest) validate(all bool) error {
 
 	// no validation rules for Description
 
+	// no validation rules for ExternalId
+
+	// no validation rules for Upsert
+
 	if len(errors) > 0 {
 		return CreateOrganizationRequestMultiError(errors)
 	}
@@ -1282,6 +1493,42 @@ func (m *UpdateOrganizationRequest) validate(all bool) error {
 		// no validation rules for NewName
 	}
 
+	if m.MaintenanceWindow != nil {
+		// no validation rules for MaintenanceWindow
+	}
+
+	if m.LogoUrl != nil {
+		// no validation rules for LogoUrl
+	}
+
+	if m.PrimaryColor != nil {
+		// no validation rules for PrimaryColor
+	}
+
+	if m.SupportEmail != nil {
+
+		if m.GetSupportEmail() != "" {
+
+			if err := m._validateEmail(m.GetSupportEmail()); err != nil {
+				err = UpdateOrganizationRequestValidationError{
+					field:  "SupportEmail",
+					reason: "value must be a valid email address",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if m.CustomDomain != nil {
+		// no validation rules for CustomDomain
+	}
+
 	if len(errors) > 0 {
 		return UpdateOrganizationRequestMultiError(errors)
 	}
@@ -1289,6 +1536,56 @@ func (m *UpdateOrganizationRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *UpdateOrganizationRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *UpdateOrganizationRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
 // UpdateOrganizationRequestMultiError is an error wrapping multiple validation
 // errors returned by UpdateOrganizationRequest.ValidateAll() if the
 // designated constraints aren't met.
@@ -2099,6 +2396,8 @@ func (m *ListProjectsForOrganizationRequest) validate(all bool) error {
 
 	// no validation rules for PageToken
 
+	// no validation rules for Labels
+
 	if len(errors) > 0 {
 		return ListProjectsForOrganizationRequestMultiError(errors)
 	}
@@ -2541,6 +2840,35 @@ func (m *GetProjectResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetProdHealth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProjectResponseValidationError{
+					field:  "ProdHealth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProjectResponseValidationError{
+					field:  "ProdHealth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProdHealth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProjectResponseValidationError{
+				field:  "ProdHealth",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return GetProjectResponseMultiError(errors)
 	}
@@ -3294,6 +3622,335 @@ var _ interface {
 	ErrorName() string
 } = GetProjectVariablesResponseValidationError{}
 
+// Validate checks the field values on GetProjectUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProjectUsageRequestMultiError, or nil if none found.
+func (m *GetProjectUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProjectUsageRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProjectUsageRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProjectUsageRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProjectUsageRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProjectUsageRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProjectUsageRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProjectUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetProjectUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetProjectUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectUsageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetProjectUsageRequestValidationError is the validation error returned by
+// GetProjectUsageRequest.Validate if the designated constraints aren't met.
+type GetProjectUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectUsageRequestValidationError) ErrorName() string {
+	return "GetProjectUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectUsageRequestValidationError{}
+
+// Validate checks the field values on GetProjectUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectUsageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProjectUsageResponseMultiError, or nil if none found.
+func (m *GetProjectUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsage() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProjectUsageResponseValidationError{
+						field:  fmt.Sprintf("Usage[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProjectUsageResponseValidationError{
+						field:  fmt.Sprintf("Usage[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProjectUsageResponseValidationError{
+					field:  fmt.Sprintf("Usage[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProjectUsageResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProjectUsageResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProjectUsageResponseValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProjectUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetProjectUsageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetProjectUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectUsageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectUsageResponseMultiError) AllErrors() []error { return m }
+
+// GetProjectUsageResponseValidationError is the validation error returned by
+// GetProjectUsageResponse.Validate if the designated constraints aren't met.
+type GetProjectUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectUsageResponseValidationError) ErrorName() string {
+	return "GetProjectUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectUsageResponseValidationError{}
+
 // Validate checks the field values on SearchProjectUsersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -4197,6 +4854,35 @@ func (m *GetIFrameResponse) validate(all bool) error {
 
 	// no validation rules for TtlSeconds
 
+	if all {
+		switch v := interface{}(m.GetBranding()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIFrameResponseValidationError{
+					field:  "Branding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIFrameResponseValidationError{
+					field:  "Branding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBranding()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIFrameResponseValidationError{
+				field:  "Branding",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return GetIFrameResponseMultiError(errors)
 	}
@@ -5363,6 +6049,14 @@ func (m *CreateProjectRequest) validate(all bool) error {
 
 	// no validation rules for ProdVersion
 
+	// no validation rules for DeployTrigger
+
+	// no validation rules for DeploySchedule
+
+	// no validation rules for ExternalId
+
+	// no validation rules for Upsert
+
 	if len(errors) > 0 {
 		return CreateProjectRequestMultiError(errors)
 	}
@@ -5574,6 +6268,282 @@ var _ interface {
 	ErrorName() string
 } = CreateProjectResponseValidationError{}
 
+// Validate checks the field values on CloneProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloneProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneProjectRequestMultiError, or nil if none found.
+func (m *CloneProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := CloneProjectRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CloneProjectRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for NewOrganizationName
+
+	if utf8.RuneCountInString(m.GetNewName()) < 1 {
+		err := CloneProjectRequestValidationError{
+			field:  "NewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for ProdBranch
+
+	// no validation rules for StripSecrets
+
+	// no validation rules for CopyMembers
+
+	if len(errors) > 0 {
+		return CloneProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloneProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by CloneProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CloneProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneProjectRequestMultiError) AllErrors() []error { return m }
+
+// CloneProjectRequestValidationError is the validation error returned by
+// CloneProjectRequest.Validate if the designated constraints aren't met.
+type CloneProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneProjectRequestValidationError) ErrorName() string {
+	return "CloneProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneProjectRequestValidationError{}
+
+// Validate checks the field values on CloneProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloneProjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneProjectResponseMultiError, or nil if none found.
+func (m *CloneProjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneProjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CloneProjectResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CloneProjectResponseValidationError{
+					field:  "Project",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CloneProjectResponseValidationError{
+				field:  "Project",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CloneProjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloneProjectResponseMultiError is an error wrapping multiple validation
+// errors returned by CloneProjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CloneProjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneProjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneProjectResponseMultiError) AllErrors() []error { return m }
+
+// CloneProjectResponseValidationError is the validation error returned by
+// CloneProjectResponse.Validate if the designated constraints aren't met.
+type CloneProjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneProjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneProjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneProjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneProjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneProjectResponseValidationError) ErrorName() string {
+	return "CloneProjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneProjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneProjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneProjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneProjectResponseValidationError{}
+
 // Validate checks the field values on DeleteProjectRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -5828,6 +6798,8 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Labels
+
 	if m.Description != nil {
 		// no validation rules for Description
 	}
@@ -5868,6 +6840,22 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 		// no validation rules for ProdVersion
 	}
 
+	if m.DeployTrigger != nil {
+		// no validation rules for DeployTrigger
+	}
+
+	if m.DeploySchedule != nil {
+		// no validation rules for DeploySchedule
+	}
+
+	if m.DeployBlueGreen != nil {
+		// no validation rules for DeployBlueGreen
+	}
+
+	if m.MaintenanceWindow != nil {
+		// no validation rules for MaintenanceWindow
+	}
+
 	if len(errors) > 0 {
 		return UpdateProjectRequestMultiError(errors)
 	}
@@ -6125,6 +7113,10 @@ func (m *UpdateProjectVariablesRequest) validate(all bool) error {
 
 	// no validation rules for Variables
 
+	// no validation rules for Merge
+
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
 		return UpdateProjectVariablesRequestMultiError(errors)
 	}
@@ -6230,6 +7222,42 @@ func (m *UpdateProjectVariablesResponse) validate(all bool) error {
 
 	// no validation rules for Variables
 
+	for idx, item := range m.GetAffectedResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateProjectVariablesResponseValidationError{
+						field:  fmt.Sprintf("AffectedResources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateProjectVariablesResponseValidationError{
+						field:  fmt.Sprintf("AffectedResources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateProjectVariablesResponseValidationError{
+					field:  fmt.Sprintf("AffectedResources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DeploymentUpdated
+
 	if len(errors) > 0 {
 		return UpdateProjectVariablesResponseMultiError(errors)
 	}
@@ -6311,6 +7339,110 @@ var _ interface {
 	ErrorName() string
 } = UpdateProjectVariablesResponseValidationError{}
 
+// Validate checks the field values on AffectedResource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AffectedResource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AffectedResource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AffectedResourceMultiError, or nil if none found.
+func (m *AffectedResource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AffectedResource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return AffectedResourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// AffectedResourceMultiError is an error wrapping multiple validation errors
+// returned by AffectedResource.ValidateAll() if the designated constraints
+// aren't met.
+type AffectedResourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AffectedResourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AffectedResourceMultiError) AllErrors() []error { return m }
+
+// AffectedResourceValidationError is the validation error returned by
+// AffectedResource.Validate if the designated constraints aren't met.
+type AffectedResourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AffectedResourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AffectedResourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AffectedResourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AffectedResourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AffectedResourceValidationError) ErrorName() string { return "AffectedResourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AffectedResourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAffectedResource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AffectedResourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AffectedResourceValidationError{}
+
 // Validate checks the field values on CreateAssetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -6766,6 +7898,234 @@ var _ interface {
 	ErrorName() string
 } = TriggerReconcileResponseValidationError{}
 
+// Validate checks the field values on ApproveDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveDeploymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveDeploymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveDeploymentRequestMultiError, or nil if none found.
+func (m *ApproveDeploymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveDeploymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ApproveDeploymentRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ApproveDeploymentRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApproveDeploymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveDeploymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveDeploymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveDeploymentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveDeploymentRequestMultiError) AllErrors() []error { return m }
+
+// ApproveDeploymentRequestValidationError is the validation error returned by
+// ApproveDeploymentRequest.Validate if the designated constraints aren't met.
+type ApproveDeploymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveDeploymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveDeploymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveDeploymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveDeploymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveDeploymentRequestValidationError) ErrorName() string {
+	return "ApproveDeploymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveDeploymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveDeploymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveDeploymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveDeploymentRequestValidationError{}
+
+// Validate checks the field values on ApproveDeploymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveDeploymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveDeploymentResponseMultiError, or nil if none found.
+func (m *ApproveDeploymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveDeploymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeployedSha
+
+	if len(errors) > 0 {
+		return ApproveDeploymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveDeploymentResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveDeploymentResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ApproveDeploymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveDeploymentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveDeploymentResponseMultiError) AllErrors() []error { return m }
+
+// ApproveDeploymentResponseValidationError is the validation error returned by
+// ApproveDeploymentResponse.Validate if the designated constraints aren't met.
+type ApproveDeploymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveDeploymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveDeploymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveDeploymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveDeploymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveDeploymentResponseValidationError) ErrorName() string {
+	return "ApproveDeploymentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveDeploymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveDeploymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveDeploymentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveDeploymentResponseValidationError{}
+
 // Validate checks the field values on TriggerRefreshSourcesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -10824,22 +12184,22 @@ var _ interface {
 	ErrorName() string
 } = SudoUpdateAnnotationsResponseValidationError{}
 
-// Validate checks the field values on ListProjectMemberUsersRequest with the
+// Validate checks the field values on SudoMigrateDeploymentRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectMemberUsersRequest) Validate() error {
+func (m *SudoMigrateDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectMemberUsersRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListProjectMemberUsersRequestMultiError, or nil if none found.
-func (m *ListProjectMemberUsersRequest) ValidateAll() error {
+// ValidateAll checks the field values on SudoMigrateDeploymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SudoMigrateDeploymentRequestMultiError, or nil if none found.
+func (m *SudoMigrateDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectMemberUsersRequest) validate(all bool) error {
+func (m *SudoMigrateDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10847,7 +12207,7 @@ func (m *ListProjectMemberUsersRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectMemberUsersRequestValidationError{
+		err := SudoMigrateDeploymentRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -10858,7 +12218,7 @@ func (m *ListProjectMemberUsersRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectMemberUsersRequestValidationError{
+		err := SudoMigrateDeploymentRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -10868,37 +12228,22 @@ func (m *ListProjectMemberUsersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListProjectMemberUsersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
+	// no validation rules for Provisioner
 
 	if len(errors) > 0 {
-		return ListProjectMemberUsersRequestMultiError(errors)
+		return SudoMigrateDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectMemberUsersRequestMultiError is an error wrapping multiple
-// validation errors returned by ListProjectMemberUsersRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListProjectMemberUsersRequestMultiError []error
+// SudoMigrateDeploymentRequestMultiError is an error wrapping multiple
+// validation errors returned by SudoMigrateDeploymentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SudoMigrateDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectMemberUsersRequestMultiError) Error() string {
+func (m SudoMigrateDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10907,12 +12252,12 @@ func (m ListProjectMemberUsersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectMemberUsersRequestMultiError) AllErrors() []error { return m }
+func (m SudoMigrateDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectMemberUsersRequestValidationError is the validation error
-// returned by ListProjectMemberUsersRequest.Validate if the designated
-// constraints aren't met.
-type ListProjectMemberUsersRequestValidationError struct {
+// SudoMigrateDeploymentRequestValidationError is the validation error returned
+// by SudoMigrateDeploymentRequest.Validate if the designated constraints
+// aren't met.
+type SudoMigrateDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10920,24 +12265,24 @@ type ListProjectMemberUsersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectMemberUsersRequestValidationError) Field() string { return e.field }
+func (e SudoMigrateDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectMemberUsersRequestValidationError) Reason() string { return e.reason }
+func (e SudoMigrateDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectMemberUsersRequestValidationError) Cause() error { return e.cause }
+func (e SudoMigrateDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectMemberUsersRequestValidationError) Key() bool { return e.key }
+func (e SudoMigrateDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectMemberUsersRequestValidationError) ErrorName() string {
-	return "ListProjectMemberUsersRequestValidationError"
+func (e SudoMigrateDeploymentRequestValidationError) ErrorName() string {
+	return "SudoMigrateDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectMemberUsersRequestValidationError) Error() string {
+func (e SudoMigrateDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10949,14 +12294,14 @@ func (e ListProjectMemberUsersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectMemberUsersRequest.%s: %s%s",
+		"invalid %sSudoMigrateDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectMemberUsersRequestValidationError{}
+var _ error = SudoMigrateDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10964,80 +12309,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectMemberUsersRequestValidationError{}
+} = SudoMigrateDeploymentRequestValidationError{}
 
-// Validate checks the field values on ListProjectMemberUsersResponse with the
+// Validate checks the field values on SudoMigrateDeploymentResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectMemberUsersResponse) Validate() error {
+func (m *SudoMigrateDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectMemberUsersResponse with
+// ValidateAll checks the field values on SudoMigrateDeploymentResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListProjectMemberUsersResponseMultiError, or nil if none found.
-func (m *ListProjectMemberUsersResponse) ValidateAll() error {
+// SudoMigrateDeploymentResponseMultiError, or nil if none found.
+func (m *SudoMigrateDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectMemberUsersResponse) validate(all bool) error {
+func (m *SudoMigrateDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMembers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectMemberUsersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectMemberUsersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SudoMigrateDeploymentResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListProjectMemberUsersResponseValidationError{
-					field:  fmt.Sprintf("Members[%v]", idx),
+				errors = append(errors, SudoMigrateDeploymentResponseValidationError{
+					field:  "Deployment",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SudoMigrateDeploymentResponseValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListProjectMemberUsersResponseMultiError(errors)
+		return SudoMigrateDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectMemberUsersResponseMultiError is an error wrapping multiple
-// validation errors returned by ListProjectMemberUsersResponse.ValidateAll()
+// SudoMigrateDeploymentResponseMultiError is an error wrapping multiple
+// validation errors returned by SudoMigrateDeploymentResponse.ValidateAll()
 // if the designated constraints aren't met.
-type ListProjectMemberUsersResponseMultiError []error
+type SudoMigrateDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectMemberUsersResponseMultiError) Error() string {
+func (m SudoMigrateDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11046,12 +12384,12 @@ func (m ListProjectMemberUsersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectMemberUsersResponseMultiError) AllErrors() []error { return m }
+func (m SudoMigrateDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectMemberUsersResponseValidationError is the validation error
-// returned by ListProjectMemberUsersResponse.Validate if the designated
+// SudoMigrateDeploymentResponseValidationError is the validation error
+// returned by SudoMigrateDeploymentResponse.Validate if the designated
 // constraints aren't met.
-type ListProjectMemberUsersResponseValidationError struct {
+type SudoMigrateDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11059,24 +12397,24 @@ type ListProjectMemberUsersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectMemberUsersResponseValidationError) Field() string { return e.field }
+func (e SudoMigrateDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectMemberUsersResponseValidationError) Reason() string { return e.reason }
+func (e SudoMigrateDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectMemberUsersResponseValidationError) Cause() error { return e.cause }
+func (e SudoMigrateDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectMemberUsersResponseValidationError) Key() bool { return e.key }
+func (e SudoMigrateDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectMemberUsersResponseValidationError) ErrorName() string {
-	return "ListProjectMemberUsersResponseValidationError"
+func (e SudoMigrateDeploymentResponseValidationError) ErrorName() string {
+	return "SudoMigrateDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectMemberUsersResponseValidationError) Error() string {
+func (e SudoMigrateDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11088,14 +12426,14 @@ func (e ListProjectMemberUsersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectMemberUsersResponse.%s: %s%s",
+		"invalid %sSudoMigrateDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectMemberUsersResponseValidationError{}
+var _ error = SudoMigrateDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11103,24 +12441,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectMemberUsersResponseValidationError{}
+} = SudoMigrateDeploymentResponseValidationError{}
 
-// Validate checks the field values on ListProjectInvitesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SudoUpdateRuntimeConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectInvitesRequest) Validate() error {
+func (m *SudoUpdateRuntimeConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectInvitesRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListProjectInvitesRequestMultiError, or nil if none found.
-func (m *ListProjectInvitesRequest) ValidateAll() error {
+// ValidateAll checks the field values on SudoUpdateRuntimeConfigRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SudoUpdateRuntimeConfigRequestMultiError, or nil if none found.
+func (m *SudoUpdateRuntimeConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectInvitesRequest) validate(all bool) error {
+func (m *SudoUpdateRuntimeConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11128,7 +12466,7 @@ func (m *ListProjectInvitesRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectInvitesRequestValidationError{
+		err := SudoUpdateRuntimeConfigRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11139,7 +12477,7 @@ func (m *ListProjectInvitesRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectInvitesRequestValidationError{
+		err := SudoUpdateRuntimeConfigRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11149,37 +12487,22 @@ func (m *ListProjectInvitesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListProjectInvitesRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
+	// no validation rules for RuntimeConfig
 
 	if len(errors) > 0 {
-		return ListProjectInvitesRequestMultiError(errors)
+		return SudoUpdateRuntimeConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectInvitesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListProjectInvitesRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListProjectInvitesRequestMultiError []error
+// SudoUpdateRuntimeConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by SudoUpdateRuntimeConfigRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SudoUpdateRuntimeConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectInvitesRequestMultiError) Error() string {
+func (m SudoUpdateRuntimeConfigRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11188,11 +12511,12 @@ func (m ListProjectInvitesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectInvitesRequestMultiError) AllErrors() []error { return m }
+func (m SudoUpdateRuntimeConfigRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectInvitesRequestValidationError is the validation error returned by
-// ListProjectInvitesRequest.Validate if the designated constraints aren't met.
-type ListProjectInvitesRequestValidationError struct {
+// SudoUpdateRuntimeConfigRequestValidationError is the validation error
+// returned by SudoUpdateRuntimeConfigRequest.Validate if the designated
+// constraints aren't met.
+type SudoUpdateRuntimeConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11200,24 +12524,24 @@ type ListProjectInvitesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectInvitesRequestValidationError) Field() string { return e.field }
+func (e SudoUpdateRuntimeConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectInvitesRequestValidationError) Reason() string { return e.reason }
+func (e SudoUpdateRuntimeConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectInvitesRequestValidationError) Cause() error { return e.cause }
+func (e SudoUpdateRuntimeConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectInvitesRequestValidationError) Key() bool { return e.key }
+func (e SudoUpdateRuntimeConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectInvitesRequestValidationError) ErrorName() string {
-	return "ListProjectInvitesRequestValidationError"
+func (e SudoUpdateRuntimeConfigRequestValidationError) ErrorName() string {
+	return "SudoUpdateRuntimeConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectInvitesRequestValidationError) Error() string {
+func (e SudoUpdateRuntimeConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11229,14 +12553,14 @@ func (e ListProjectInvitesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectInvitesRequest.%s: %s%s",
+		"invalid %sSudoUpdateRuntimeConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectInvitesRequestValidationError{}
+var _ error = SudoUpdateRuntimeConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11244,80 +12568,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectInvitesRequestValidationError{}
+} = SudoUpdateRuntimeConfigRequestValidationError{}
 
-// Validate checks the field values on ListProjectInvitesResponse with the
+// Validate checks the field values on SudoUpdateRuntimeConfigResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListProjectInvitesResponse) Validate() error {
+func (m *SudoUpdateRuntimeConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectInvitesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListProjectInvitesResponseMultiError, or nil if none found.
-func (m *ListProjectInvitesResponse) ValidateAll() error {
+// ValidateAll checks the field values on SudoUpdateRuntimeConfigResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SudoUpdateRuntimeConfigResponseMultiError, or nil if none found.
+func (m *SudoUpdateRuntimeConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectInvitesResponse) validate(all bool) error {
+func (m *SudoUpdateRuntimeConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetInvites() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectInvitesResponseValidationError{
-						field:  fmt.Sprintf("Invites[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SudoUpdateRuntimeConfigResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListProjectInvitesResponseValidationError{
-					field:  fmt.Sprintf("Invites[%v]", idx),
+				errors = append(errors, SudoUpdateRuntimeConfigResponseValidationError{
+					field:  "Deployment",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SudoUpdateRuntimeConfigResponseValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListProjectInvitesResponseMultiError(errors)
+		return SudoUpdateRuntimeConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectInvitesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListProjectInvitesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListProjectInvitesResponseMultiError []error
+// SudoUpdateRuntimeConfigResponseMultiError is an error wrapping multiple
+// validation errors returned by SudoUpdateRuntimeConfigResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SudoUpdateRuntimeConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectInvitesResponseMultiError) Error() string {
+func (m SudoUpdateRuntimeConfigResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11326,11 +12643,12 @@ func (m ListProjectInvitesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectInvitesResponseMultiError) AllErrors() []error { return m }
+func (m SudoUpdateRuntimeConfigResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectInvitesResponseValidationError is the validation error returned
-// by ListProjectInvitesResponse.Validate if the designated constraints aren't met.
-type ListProjectInvitesResponseValidationError struct {
+// SudoUpdateRuntimeConfigResponseValidationError is the validation error
+// returned by SudoUpdateRuntimeConfigResponse.Validate if the designated
+// constraints aren't met.
+type SudoUpdateRuntimeConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11338,24 +12656,24 @@ type ListProjectInvitesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectInvitesResponseValidationError) Field() string { return e.field }
+func (e SudoUpdateRuntimeConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectInvitesResponseValidationError) Reason() string { return e.reason }
+func (e SudoUpdateRuntimeConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectInvitesResponseValidationError) Cause() error { return e.cause }
+func (e SudoUpdateRuntimeConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectInvitesResponseValidationError) Key() bool { return e.key }
+func (e SudoUpdateRuntimeConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectInvitesResponseValidationError) ErrorName() string {
-	return "ListProjectInvitesResponseValidationError"
+func (e SudoUpdateRuntimeConfigResponseValidationError) ErrorName() string {
+	return "SudoUpdateRuntimeConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectInvitesResponseValidationError) Error() string {
+func (e SudoUpdateRuntimeConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11367,14 +12685,14 @@ func (e ListProjectInvitesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectInvitesResponse.%s: %s%s",
+		"invalid %sSudoUpdateRuntimeConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectInvitesResponseValidationError{}
+var _ error = SudoUpdateRuntimeConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11382,139 +12700,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectInvitesResponseValidationError{}
+} = SudoUpdateRuntimeConfigResponseValidationError{}
 
-// Validate checks the field values on AddProjectMemberUserRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddProjectMemberUserRequest) Validate() error {
+// Validate checks the field values on ListJobsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListJobsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectMemberUserRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddProjectMemberUserRequestMultiError, or nil if none found.
-func (m *AddProjectMemberUserRequest) ValidateAll() error {
+// ListJobsRequestMultiError, or nil if none found.
+func (m *ListJobsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectMemberUserRequest) validate(all bool) error {
+func (m *ListJobsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := AddProjectMemberUserRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := AddProjectMemberUserRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = AddProjectMemberUserRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := AddProjectMemberUserRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return AddProjectMemberUserRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *AddProjectMemberUserRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+	// no validation rules for Status
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+	if m.GetPageSize() != 0 {
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+		if m.GetPageSize() > 1000 {
+			err := ListJobsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-	}
-
-	return nil
-}
 
-func (m *AddProjectMemberUserRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
+	// no validation rules for PageToken
 
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return ListJobsRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// AddProjectMemberUserRequestMultiError is an error wrapping multiple
-// validation errors returned by AddProjectMemberUserRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AddProjectMemberUserRequestMultiError []error
+// ListJobsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListJobsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListJobsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectMemberUserRequestMultiError) Error() string {
+func (m ListJobsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11523,12 +12765,11 @@ func (m AddProjectMemberUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectMemberUserRequestMultiError) AllErrors() []error { return m }
+func (m ListJobsRequestMultiError) AllErrors() []error { return m }
 
-// AddProjectMemberUserRequestValidationError is the validation error returned
-// by AddProjectMemberUserRequest.Validate if the designated constraints
-// aren't met.
-type AddProjectMemberUserRequestValidationError struct {
+// ListJobsRequestValidationError is the validation error returned by
+// ListJobsRequest.Validate if the designated constraints aren't met.
+type ListJobsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11536,24 +12777,22 @@ type AddProjectMemberUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectMemberUserRequestValidationError) Field() string { return e.field }
+func (e ListJobsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectMemberUserRequestValidationError) Reason() string { return e.reason }
+func (e ListJobsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectMemberUserRequestValidationError) Cause() error { return e.cause }
+func (e ListJobsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectMemberUserRequestValidationError) Key() bool { return e.key }
+func (e ListJobsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectMemberUserRequestValidationError) ErrorName() string {
-	return "AddProjectMemberUserRequestValidationError"
-}
+func (e ListJobsRequestValidationError) ErrorName() string { return "ListJobsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AddProjectMemberUserRequestValidationError) Error() string {
+func (e ListJobsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11565,14 +12804,14 @@ func (e AddProjectMemberUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProjectMemberUserRequest.%s: %s%s",
+		"invalid %sListJobsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddProjectMemberUserRequestValidationError{}
+var _ error = ListJobsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11580,46 +12819,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProjectMemberUserRequestValidationError{}
+} = ListJobsRequestValidationError{}
 
-// Validate checks the field values on AddProjectMemberUserResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddProjectMemberUserResponse) Validate() error {
+// Validate checks the field values on ListJobsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListJobsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectMemberUserResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddProjectMemberUserResponseMultiError, or nil if none found.
-func (m *AddProjectMemberUserResponse) ValidateAll() error {
+// ListJobsResponseMultiError, or nil if none found.
+func (m *ListJobsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectMemberUserResponse) validate(all bool) error {
+func (m *ListJobsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PendingSignup
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListJobsResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return AddProjectMemberUserResponseMultiError(errors)
+		return ListJobsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddProjectMemberUserResponseMultiError is an error wrapping multiple
-// validation errors returned by AddProjectMemberUserResponse.ValidateAll() if
-// the designated constraints aren't met.
-type AddProjectMemberUserResponseMultiError []error
+// ListJobsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListJobsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListJobsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectMemberUserResponseMultiError) Error() string {
+func (m ListJobsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11628,12 +12901,11 @@ func (m AddProjectMemberUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectMemberUserResponseMultiError) AllErrors() []error { return m }
+func (m ListJobsResponseMultiError) AllErrors() []error { return m }
 
-// AddProjectMemberUserResponseValidationError is the validation error returned
-// by AddProjectMemberUserResponse.Validate if the designated constraints
-// aren't met.
-type AddProjectMemberUserResponseValidationError struct {
+// ListJobsResponseValidationError is the validation error returned by
+// ListJobsResponse.Validate if the designated constraints aren't met.
+type ListJobsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11641,24 +12913,22 @@ type AddProjectMemberUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectMemberUserResponseValidationError) Field() string { return e.field }
+func (e ListJobsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectMemberUserResponseValidationError) Reason() string { return e.reason }
+func (e ListJobsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectMemberUserResponseValidationError) Cause() error { return e.cause }
+func (e ListJobsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectMemberUserResponseValidationError) Key() bool { return e.key }
+func (e ListJobsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectMemberUserResponseValidationError) ErrorName() string {
-	return "AddProjectMemberUserResponseValidationError"
-}
+func (e ListJobsResponseValidationError) ErrorName() string { return "ListJobsResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AddProjectMemberUserResponseValidationError) Error() string {
+func (e ListJobsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11670,14 +12940,14 @@ func (e AddProjectMemberUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProjectMemberUserResponse.%s: %s%s",
+		"invalid %sListJobsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddProjectMemberUserResponseValidationError{}
+var _ error = ListJobsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11685,24 +12955,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProjectMemberUserResponseValidationError{}
+} = ListJobsResponseValidationError{}
 
-// Validate checks the field values on RemoveProjectMemberUserRequest with the
+// Validate checks the field values on ListProjectMemberUsersRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveProjectMemberUserRequest) Validate() error {
+func (m *ListProjectMemberUsersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectMemberUserRequest with
+// ValidateAll checks the field values on ListProjectMemberUsersRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RemoveProjectMemberUserRequestMultiError, or nil if none found.
-func (m *RemoveProjectMemberUserRequest) ValidateAll() error {
+// ListProjectMemberUsersRequestMultiError, or nil if none found.
+func (m *ListProjectMemberUsersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectMemberUserRequest) validate(all bool) error {
+func (m *ListProjectMemberUsersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11710,7 +12980,7 @@ func (m *RemoveProjectMemberUserRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveProjectMemberUserRequestValidationError{
+		err := ListProjectMemberUsersRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11721,7 +12991,7 @@ func (m *RemoveProjectMemberUserRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := RemoveProjectMemberUserRequestValidationError{
+		err := ListProjectMemberUsersRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11731,82 +13001,37 @@ func (m *RemoveProjectMemberUserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = RemoveProjectMemberUserRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return RemoveProjectMemberUserRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *RemoveProjectMemberUserRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+	if m.GetPageSize() != 0 {
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+		if m.GetPageSize() > 1000 {
+			err := ListProjectMemberUsersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-	}
-
-	return nil
-}
-
-func (m *RemoveProjectMemberUserRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
 
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
+	// no validation rules for PageToken
 
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return ListProjectMemberUsersRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// RemoveProjectMemberUserRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveProjectMemberUserRequest.ValidateAll()
+// ListProjectMemberUsersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListProjectMemberUsersRequest.ValidateAll()
 // if the designated constraints aren't met.
-type RemoveProjectMemberUserRequestMultiError []error
+type ListProjectMemberUsersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectMemberUserRequestMultiError) Error() string {
+func (m ListProjectMemberUsersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11815,12 +13040,12 @@ func (m RemoveProjectMemberUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectMemberUserRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectMemberUsersRequestMultiError) AllErrors() []error { return m }
 
-// RemoveProjectMemberUserRequestValidationError is the validation error
-// returned by RemoveProjectMemberUserRequest.Validate if the designated
+// ListProjectMemberUsersRequestValidationError is the validation error
+// returned by ListProjectMemberUsersRequest.Validate if the designated
 // constraints aren't met.
-type RemoveProjectMemberUserRequestValidationError struct {
+type ListProjectMemberUsersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11828,24 +13053,24 @@ type RemoveProjectMemberUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectMemberUserRequestValidationError) Field() string { return e.field }
+func (e ListProjectMemberUsersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectMemberUserRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectMemberUsersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectMemberUserRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectMemberUsersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectMemberUserRequestValidationError) Key() bool { return e.key }
+func (e ListProjectMemberUsersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectMemberUserRequestValidationError) ErrorName() string {
-	return "RemoveProjectMemberUserRequestValidationError"
+func (e ListProjectMemberUsersRequestValidationError) ErrorName() string {
+	return "ListProjectMemberUsersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectMemberUserRequestValidationError) Error() string {
+func (e ListProjectMemberUsersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11857,14 +13082,14 @@ func (e RemoveProjectMemberUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectMemberUserRequest.%s: %s%s",
+		"invalid %sListProjectMemberUsersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectMemberUserRequestValidationError{}
+var _ error = ListProjectMemberUsersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11872,44 +13097,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectMemberUserRequestValidationError{}
+} = ListProjectMemberUsersRequestValidationError{}
 
-// Validate checks the field values on RemoveProjectMemberUserResponse with the
+// Validate checks the field values on ListProjectMemberUsersResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveProjectMemberUserResponse) Validate() error {
+func (m *ListProjectMemberUsersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectMemberUserResponse with
+// ValidateAll checks the field values on ListProjectMemberUsersResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// RemoveProjectMemberUserResponseMultiError, or nil if none found.
-func (m *RemoveProjectMemberUserResponse) ValidateAll() error {
+// ListProjectMemberUsersResponseMultiError, or nil if none found.
+func (m *ListProjectMemberUsersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectMemberUserResponse) validate(all bool) error {
+func (m *ListProjectMemberUsersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectMemberUsersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectMemberUsersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectMemberUsersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return RemoveProjectMemberUserResponseMultiError(errors)
+		return ListProjectMemberUsersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveProjectMemberUserResponseMultiError is an error wrapping multiple
-// validation errors returned by RemoveProjectMemberUserResponse.ValidateAll()
+// ListProjectMemberUsersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProjectMemberUsersResponse.ValidateAll()
 // if the designated constraints aren't met.
-type RemoveProjectMemberUserResponseMultiError []error
+type ListProjectMemberUsersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectMemberUserResponseMultiError) Error() string {
+func (m ListProjectMemberUsersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11918,12 +13179,12 @@ func (m RemoveProjectMemberUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectMemberUserResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectMemberUsersResponseMultiError) AllErrors() []error { return m }
 
-// RemoveProjectMemberUserResponseValidationError is the validation error
-// returned by RemoveProjectMemberUserResponse.Validate if the designated
+// ListProjectMemberUsersResponseValidationError is the validation error
+// returned by ListProjectMemberUsersResponse.Validate if the designated
 // constraints aren't met.
-type RemoveProjectMemberUserResponseValidationError struct {
+type ListProjectMemberUsersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11931,24 +13192,24 @@ type RemoveProjectMemberUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectMemberUserResponseValidationError) Field() string { return e.field }
+func (e ListProjectMemberUsersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectMemberUserResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectMemberUsersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectMemberUserResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectMemberUsersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectMemberUserResponseValidationError) Key() bool { return e.key }
+func (e ListProjectMemberUsersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectMemberUserResponseValidationError) ErrorName() string {
-	return "RemoveProjectMemberUserResponseValidationError"
+func (e ListProjectMemberUsersResponseValidationError) ErrorName() string {
+	return "ListProjectMemberUsersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectMemberUserResponseValidationError) Error() string {
+func (e ListProjectMemberUsersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11960,14 +13221,14 @@ func (e RemoveProjectMemberUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectMemberUserResponse.%s: %s%s",
+		"invalid %sListProjectMemberUsersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectMemberUserResponseValidationError{}
+var _ error = ListProjectMemberUsersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11975,24 +13236,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectMemberUserResponseValidationError{}
+} = ListProjectMemberUsersResponseValidationError{}
 
-// Validate checks the field values on SetProjectMemberUserRoleRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListProjectInvitesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetProjectMemberUserRoleRequest) Validate() error {
+func (m *ListProjectInvitesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetProjectMemberUserRoleRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// SetProjectMemberUserRoleRequestMultiError, or nil if none found.
-func (m *SetProjectMemberUserRoleRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectInvitesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectInvitesRequestMultiError, or nil if none found.
+func (m *ListProjectInvitesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetProjectMemberUserRoleRequest) validate(all bool) error {
+func (m *ListProjectInvitesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12000,7 +13261,7 @@ func (m *SetProjectMemberUserRoleRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := SetProjectMemberUserRoleRequestValidationError{
+		err := ListProjectInvitesRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -12011,7 +13272,7 @@ func (m *SetProjectMemberUserRoleRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := SetProjectMemberUserRoleRequestValidationError{
+		err := ListProjectInvitesRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -12021,132 +13282,75 @@ func (m *SetProjectMemberUserRoleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = SetProjectMemberUserRoleRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	if m.GetPageSize() != 0 {
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := SetProjectMemberUserRoleRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if m.GetPageSize() > 1000 {
+			err := ListProjectInvitesRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return SetProjectMemberUserRoleRequestMultiError(errors)
+		return ListProjectInvitesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *SetProjectMemberUserRoleRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
+// ListProjectInvitesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectInvitesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListProjectInvitesRequestMultiError []error
 
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectInvitesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
+// AllErrors returns a list of validation violation errors.
+func (m ListProjectInvitesRequestMultiError) AllErrors() []error { return m }
 
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+// ListProjectInvitesRequestValidationError is the validation error returned by
+// ListProjectInvitesRequest.Validate if the designated constraints aren't met.
+type ListProjectInvitesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+// Field function returns field value.
+func (e ListProjectInvitesRequestValidationError) Field() string { return e.field }
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
-	}
+// Reason function returns reason value.
+func (e ListProjectInvitesRequestValidationError) Reason() string { return e.reason }
 
-	return nil
-}
+// Cause function returns cause value.
+func (e ListProjectInvitesRequestValidationError) Cause() error { return e.cause }
 
-func (m *SetProjectMemberUserRoleRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// SetProjectMemberUserRoleRequestMultiError is an error wrapping multiple
-// validation errors returned by SetProjectMemberUserRoleRequest.ValidateAll()
-// if the designated constraints aren't met.
-type SetProjectMemberUserRoleRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m SetProjectMemberUserRoleRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m SetProjectMemberUserRoleRequestMultiError) AllErrors() []error { return m }
-
-// SetProjectMemberUserRoleRequestValidationError is the validation error
-// returned by SetProjectMemberUserRoleRequest.Validate if the designated
-// constraints aren't met.
-type SetProjectMemberUserRoleRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e SetProjectMemberUserRoleRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e SetProjectMemberUserRoleRequestValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e SetProjectMemberUserRoleRequestValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e SetProjectMemberUserRoleRequestValidationError) Key() bool { return e.key }
+// Key function returns key value.
+func (e ListProjectInvitesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetProjectMemberUserRoleRequestValidationError) ErrorName() string {
-	return "SetProjectMemberUserRoleRequestValidationError"
+func (e ListProjectInvitesRequestValidationError) ErrorName() string {
+	return "ListProjectInvitesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetProjectMemberUserRoleRequestValidationError) Error() string {
+func (e ListProjectInvitesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12158,14 +13362,14 @@ func (e SetProjectMemberUserRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetProjectMemberUserRoleRequest.%s: %s%s",
+		"invalid %sListProjectInvitesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetProjectMemberUserRoleRequestValidationError{}
+var _ error = ListProjectInvitesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12173,46 +13377,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetProjectMemberUserRoleRequestValidationError{}
+} = ListProjectInvitesRequestValidationError{}
 
-// Validate checks the field values on SetProjectMemberUserRoleResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *SetProjectMemberUserRoleResponse) Validate() error {
+// Validate checks the field values on ListProjectInvitesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProjectInvitesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetProjectMemberUserRoleResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// SetProjectMemberUserRoleResponseMultiError, or nil if none found.
-func (m *SetProjectMemberUserRoleResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectInvitesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProjectInvitesResponseMultiError, or nil if none found.
+func (m *ListProjectInvitesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetProjectMemberUserRoleResponse) validate(all bool) error {
+func (m *ListProjectInvitesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetInvites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectInvitesResponseValidationError{
+					field:  fmt.Sprintf("Invites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return SetProjectMemberUserRoleResponseMultiError(errors)
+		return ListProjectInvitesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetProjectMemberUserRoleResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// SetProjectMemberUserRoleResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SetProjectMemberUserRoleResponseMultiError []error
+// ListProjectInvitesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListProjectInvitesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListProjectInvitesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetProjectMemberUserRoleResponseMultiError) Error() string {
+func (m ListProjectInvitesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12221,12 +13459,11 @@ func (m SetProjectMemberUserRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetProjectMemberUserRoleResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectInvitesResponseMultiError) AllErrors() []error { return m }
 
-// SetProjectMemberUserRoleResponseValidationError is the validation error
-// returned by SetProjectMemberUserRoleResponse.Validate if the designated
-// constraints aren't met.
-type SetProjectMemberUserRoleResponseValidationError struct {
+// ListProjectInvitesResponseValidationError is the validation error returned
+// by ListProjectInvitesResponse.Validate if the designated constraints aren't met.
+type ListProjectInvitesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12234,24 +13471,24 @@ type SetProjectMemberUserRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetProjectMemberUserRoleResponseValidationError) Field() string { return e.field }
+func (e ListProjectInvitesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetProjectMemberUserRoleResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectInvitesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetProjectMemberUserRoleResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectInvitesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetProjectMemberUserRoleResponseValidationError) Key() bool { return e.key }
+func (e ListProjectInvitesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetProjectMemberUserRoleResponseValidationError) ErrorName() string {
-	return "SetProjectMemberUserRoleResponseValidationError"
+func (e ListProjectInvitesResponseValidationError) ErrorName() string {
+	return "ListProjectInvitesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetProjectMemberUserRoleResponseValidationError) Error() string {
+func (e ListProjectInvitesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12263,14 +13500,14 @@ func (e SetProjectMemberUserRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetProjectMemberUserRoleResponse.%s: %s%s",
+		"invalid %sListProjectInvitesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetProjectMemberUserRoleResponseValidationError{}
+var _ error = ListProjectInvitesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12278,24 +13515,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetProjectMemberUserRoleResponseValidationError{}
+} = ListProjectInvitesResponseValidationError{}
 
-// Validate checks the field values on CreateUsergroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddProjectMemberUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateUsergroupRequest) Validate() error {
+func (m *AddProjectMemberUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateUsergroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddProjectMemberUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateUsergroupRequestMultiError, or nil if none found.
-func (m *CreateUsergroupRequest) ValidateAll() error {
+// AddProjectMemberUserRequestMultiError, or nil if none found.
+func (m *AddProjectMemberUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateUsergroupRequest) validate(all bool) error {
+func (m *AddProjectMemberUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12303,7 +13540,7 @@ func (m *CreateUsergroupRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := CreateUsergroupRequestValidationError{
+		err := AddProjectMemberUserRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -12313,9 +13550,32 @@ func (m *CreateUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := CreateUsergroupRequestValidationError{
-			field:  "Name",
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := AddProjectMemberUserRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AddProjectMemberUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddProjectMemberUserRequestValidationError{
+			field:  "Role",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -12325,19 +13585,69 @@ func (m *CreateUsergroupRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateUsergroupRequestMultiError(errors)
+		return AddProjectMemberUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateUsergroupRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateUsergroupRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateUsergroupRequestMultiError []error
+func (m *AddProjectMemberUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AddProjectMemberUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AddProjectMemberUserRequestMultiError is an error wrapping multiple
+// validation errors returned by AddProjectMemberUserRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddProjectMemberUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateUsergroupRequestMultiError) Error() string {
+func (m AddProjectMemberUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12346,11 +13656,12 @@ func (m CreateUsergroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateUsergroupRequestMultiError) AllErrors() []error { return m }
+func (m AddProjectMemberUserRequestMultiError) AllErrors() []error { return m }
 
-// CreateUsergroupRequestValidationError is the validation error returned by
-// CreateUsergroupRequest.Validate if the designated constraints aren't met.
-type CreateUsergroupRequestValidationError struct {
+// AddProjectMemberUserRequestValidationError is the validation error returned
+// by AddProjectMemberUserRequest.Validate if the designated constraints
+// aren't met.
+type AddProjectMemberUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12358,24 +13669,24 @@ type CreateUsergroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateUsergroupRequestValidationError) Field() string { return e.field }
+func (e AddProjectMemberUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateUsergroupRequestValidationError) Reason() string { return e.reason }
+func (e AddProjectMemberUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateUsergroupRequestValidationError) Cause() error { return e.cause }
+func (e AddProjectMemberUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateUsergroupRequestValidationError) Key() bool { return e.key }
+func (e AddProjectMemberUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateUsergroupRequestValidationError) ErrorName() string {
-	return "CreateUsergroupRequestValidationError"
+func (e AddProjectMemberUserRequestValidationError) ErrorName() string {
+	return "AddProjectMemberUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateUsergroupRequestValidationError) Error() string {
+func (e AddProjectMemberUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12387,14 +13698,14 @@ func (e CreateUsergroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateUsergroupRequest.%s: %s%s",
+		"invalid %sAddProjectMemberUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateUsergroupRequestValidationError{}
+var _ error = AddProjectMemberUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12402,44 +13713,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateUsergroupRequestValidationError{}
+} = AddProjectMemberUserRequestValidationError{}
 
-// Validate checks the field values on CreateUsergroupResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddProjectMemberUserResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateUsergroupResponse) Validate() error {
+func (m *AddProjectMemberUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateUsergroupResponse with the
+// ValidateAll checks the field values on AddProjectMemberUserResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateUsergroupResponseMultiError, or nil if none found.
-func (m *CreateUsergroupResponse) ValidateAll() error {
+// AddProjectMemberUserResponseMultiError, or nil if none found.
+func (m *AddProjectMemberUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateUsergroupResponse) validate(all bool) error {
+func (m *AddProjectMemberUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PendingSignup
+
 	if len(errors) > 0 {
-		return CreateUsergroupResponseMultiError(errors)
+		return AddProjectMemberUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateUsergroupResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateUsergroupResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateUsergroupResponseMultiError []error
+// AddProjectMemberUserResponseMultiError is an error wrapping multiple
+// validation errors returned by AddProjectMemberUserResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AddProjectMemberUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateUsergroupResponseMultiError) Error() string {
+func (m AddProjectMemberUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12448,11 +13761,12 @@ func (m CreateUsergroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateUsergroupResponseMultiError) AllErrors() []error { return m }
+func (m AddProjectMemberUserResponseMultiError) AllErrors() []error { return m }
 
-// CreateUsergroupResponseValidationError is the validation error returned by
-// CreateUsergroupResponse.Validate if the designated constraints aren't met.
-type CreateUsergroupResponseValidationError struct {
+// AddProjectMemberUserResponseValidationError is the validation error returned
+// by AddProjectMemberUserResponse.Validate if the designated constraints
+// aren't met.
+type AddProjectMemberUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12460,24 +13774,24 @@ type CreateUsergroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateUsergroupResponseValidationError) Field() string { return e.field }
+func (e AddProjectMemberUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateUsergroupResponseValidationError) Reason() string { return e.reason }
+func (e AddProjectMemberUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateUsergroupResponseValidationError) Cause() error { return e.cause }
+func (e AddProjectMemberUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateUsergroupResponseValidationError) Key() bool { return e.key }
+func (e AddProjectMemberUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateUsergroupResponseValidationError) ErrorName() string {
-	return "CreateUsergroupResponseValidationError"
+func (e AddProjectMemberUserResponseValidationError) ErrorName() string {
+	return "AddProjectMemberUserResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateUsergroupResponseValidationError) Error() string {
+func (e AddProjectMemberUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12489,14 +13803,14 @@ func (e CreateUsergroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateUsergroupResponse.%s: %s%s",
+		"invalid %sAddProjectMemberUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateUsergroupResponseValidationError{}
+var _ error = AddProjectMemberUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12504,24 +13818,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateUsergroupResponseValidationError{}
+} = AddProjectMemberUserResponseValidationError{}
 
-// Validate checks the field values on GetUsergroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveProjectMemberUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUsergroupRequest) Validate() error {
+func (m *RemoveProjectMemberUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUsergroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetUsergroupRequestMultiError, or nil if none found.
-func (m *GetUsergroupRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveProjectMemberUserRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberUserRequestMultiError, or nil if none found.
+func (m *RemoveProjectMemberUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUsergroupRequest) validate(all bool) error {
+func (m *RemoveProjectMemberUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12529,7 +13843,7 @@ func (m *GetUsergroupRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := GetUsergroupRequestValidationError{
+		err := RemoveProjectMemberUserRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -12539,9 +13853,9 @@ func (m *GetUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := GetUsergroupRequestValidationError{
-			field:  "Usergroup",
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := RemoveProjectMemberUserRequestValidationError{
+			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -12550,37 +13864,82 @@ func (m *GetUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RemoveProjectMemberUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if m.GetPageSize() > 1000 {
-			err := GetUsergroupRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
+	if len(errors) > 0 {
+		return RemoveProjectMemberUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveProjectMemberUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
 			}
-			errors = append(errors, err)
 		}
+	}
+
+	return nil
+}
 
+func (m *RemoveProjectMemberUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
 	}
+	addr = a.Address
 
-	// no validation rules for PageToken
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
 
-	if len(errors) > 0 {
-		return GetUsergroupRequestMultiError(errors)
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
 	}
 
-	return nil
+	return m._validateHostname(parts[1])
 }
 
-// GetUsergroupRequestMultiError is an error wrapping multiple validation
-// errors returned by GetUsergroupRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetUsergroupRequestMultiError []error
+// RemoveProjectMemberUserRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveProjectMemberUserRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveProjectMemberUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUsergroupRequestMultiError) Error() string {
+func (m RemoveProjectMemberUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12589,11 +13948,12 @@ func (m GetUsergroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUsergroupRequestMultiError) AllErrors() []error { return m }
+func (m RemoveProjectMemberUserRequestMultiError) AllErrors() []error { return m }
 
-// GetUsergroupRequestValidationError is the validation error returned by
-// GetUsergroupRequest.Validate if the designated constraints aren't met.
-type GetUsergroupRequestValidationError struct {
+// RemoveProjectMemberUserRequestValidationError is the validation error
+// returned by RemoveProjectMemberUserRequest.Validate if the designated
+// constraints aren't met.
+type RemoveProjectMemberUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12601,24 +13961,24 @@ type GetUsergroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUsergroupRequestValidationError) Field() string { return e.field }
+func (e RemoveProjectMemberUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUsergroupRequestValidationError) Reason() string { return e.reason }
+func (e RemoveProjectMemberUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUsergroupRequestValidationError) Cause() error { return e.cause }
+func (e RemoveProjectMemberUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUsergroupRequestValidationError) Key() bool { return e.key }
+func (e RemoveProjectMemberUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUsergroupRequestValidationError) ErrorName() string {
-	return "GetUsergroupRequestValidationError"
+func (e RemoveProjectMemberUserRequestValidationError) ErrorName() string {
+	return "RemoveProjectMemberUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUsergroupRequestValidationError) Error() string {
+func (e RemoveProjectMemberUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12630,14 +13990,14 @@ func (e GetUsergroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUsergroupRequest.%s: %s%s",
+		"invalid %sRemoveProjectMemberUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUsergroupRequestValidationError{}
+var _ error = RemoveProjectMemberUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12645,75 +14005,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUsergroupRequestValidationError{}
+} = RemoveProjectMemberUserRequestValidationError{}
 
-// Validate checks the field values on GetUsergroupResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveProjectMemberUserResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUsergroupResponse) Validate() error {
+func (m *RemoveProjectMemberUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUsergroupResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetUsergroupResponseMultiError, or nil if none found.
-func (m *GetUsergroupResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveProjectMemberUserResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberUserResponseMultiError, or nil if none found.
+func (m *RemoveProjectMemberUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUsergroupResponse) validate(all bool) error {
+func (m *RemoveProjectMemberUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUsergroup()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetUsergroupResponseValidationError{
-					field:  "Usergroup",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetUsergroupResponseValidationError{
-					field:  "Usergroup",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetUsergroupResponseValidationError{
-				field:  "Usergroup",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return GetUsergroupResponseMultiError(errors)
+		return RemoveProjectMemberUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUsergroupResponseMultiError is an error wrapping multiple validation
-// errors returned by GetUsergroupResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetUsergroupResponseMultiError []error
+// RemoveProjectMemberUserResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveProjectMemberUserResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveProjectMemberUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUsergroupResponseMultiError) Error() string {
+func (m RemoveProjectMemberUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12722,11 +14051,12 @@ func (m GetUsergroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUsergroupResponseMultiError) AllErrors() []error { return m }
+func (m RemoveProjectMemberUserResponseMultiError) AllErrors() []error { return m }
 
-// GetUsergroupResponseValidationError is the validation error returned by
-// GetUsergroupResponse.Validate if the designated constraints aren't met.
-type GetUsergroupResponseValidationError struct {
+// RemoveProjectMemberUserResponseValidationError is the validation error
+// returned by RemoveProjectMemberUserResponse.Validate if the designated
+// constraints aren't met.
+type RemoveProjectMemberUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12734,24 +14064,24 @@ type GetUsergroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUsergroupResponseValidationError) Field() string { return e.field }
+func (e RemoveProjectMemberUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUsergroupResponseValidationError) Reason() string { return e.reason }
+func (e RemoveProjectMemberUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUsergroupResponseValidationError) Cause() error { return e.cause }
+func (e RemoveProjectMemberUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUsergroupResponseValidationError) Key() bool { return e.key }
+func (e RemoveProjectMemberUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUsergroupResponseValidationError) ErrorName() string {
-	return "GetUsergroupResponseValidationError"
+func (e RemoveProjectMemberUserResponseValidationError) ErrorName() string {
+	return "RemoveProjectMemberUserResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUsergroupResponseValidationError) Error() string {
+func (e RemoveProjectMemberUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12763,14 +14093,14 @@ func (e GetUsergroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUsergroupResponse.%s: %s%s",
+		"invalid %sRemoveProjectMemberUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUsergroupResponseValidationError{}
+var _ error = RemoveProjectMemberUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12778,24 +14108,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUsergroupResponseValidationError{}
+} = RemoveProjectMemberUserResponseValidationError{}
 
-// Validate checks the field values on RenameUsergroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SetProjectMemberUserRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RenameUsergroupRequest) Validate() error {
+func (m *SetProjectMemberUserRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RenameUsergroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RenameUsergroupRequestMultiError, or nil if none found.
-func (m *RenameUsergroupRequest) ValidateAll() error {
+// ValidateAll checks the field values on SetProjectMemberUserRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetProjectMemberUserRoleRequestMultiError, or nil if none found.
+func (m *SetProjectMemberUserRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RenameUsergroupRequest) validate(all bool) error {
+func (m *SetProjectMemberUserRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12803,7 +14133,7 @@ func (m *RenameUsergroupRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RenameUsergroupRequestValidationError{
+		err := SetProjectMemberUserRoleRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -12813,9 +14143,9 @@ func (m *RenameUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := RenameUsergroupRequestValidationError{
-			field:  "Usergroup",
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := SetProjectMemberUserRoleRequestValidationError{
+			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -12824,9 +14154,21 @@ func (m *RenameUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := RenameUsergroupRequestValidationError{
-			field:  "Name",
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = SetProjectMemberUserRoleRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetProjectMemberUserRoleRequestValidationError{
+			field:  "Role",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -12836,19 +14178,69 @@ func (m *RenameUsergroupRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RenameUsergroupRequestMultiError(errors)
+		return SetProjectMemberUserRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RenameUsergroupRequestMultiError is an error wrapping multiple validation
-// errors returned by RenameUsergroupRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RenameUsergroupRequestMultiError []error
+func (m *SetProjectMemberUserRoleRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *SetProjectMemberUserRoleRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// SetProjectMemberUserRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by SetProjectMemberUserRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetProjectMemberUserRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RenameUsergroupRequestMultiError) Error() string {
+func (m SetProjectMemberUserRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12857,11 +14249,12 @@ func (m RenameUsergroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RenameUsergroupRequestMultiError) AllErrors() []error { return m }
+func (m SetProjectMemberUserRoleRequestMultiError) AllErrors() []error { return m }
 
-// RenameUsergroupRequestValidationError is the validation error returned by
-// RenameUsergroupRequest.Validate if the designated constraints aren't met.
-type RenameUsergroupRequestValidationError struct {
+// SetProjectMemberUserRoleRequestValidationError is the validation error
+// returned by SetProjectMemberUserRoleRequest.Validate if the designated
+// constraints aren't met.
+type SetProjectMemberUserRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12869,24 +14262,24 @@ type RenameUsergroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenameUsergroupRequestValidationError) Field() string { return e.field }
+func (e SetProjectMemberUserRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenameUsergroupRequestValidationError) Reason() string { return e.reason }
+func (e SetProjectMemberUserRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenameUsergroupRequestValidationError) Cause() error { return e.cause }
+func (e SetProjectMemberUserRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenameUsergroupRequestValidationError) Key() bool { return e.key }
+func (e SetProjectMemberUserRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenameUsergroupRequestValidationError) ErrorName() string {
-	return "RenameUsergroupRequestValidationError"
+func (e SetProjectMemberUserRoleRequestValidationError) ErrorName() string {
+	return "SetProjectMemberUserRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RenameUsergroupRequestValidationError) Error() string {
+func (e SetProjectMemberUserRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12898,14 +14291,14 @@ func (e RenameUsergroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenameUsergroupRequest.%s: %s%s",
+		"invalid %sSetProjectMemberUserRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenameUsergroupRequestValidationError{}
+var _ error = SetProjectMemberUserRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12913,24 +14306,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenameUsergroupRequestValidationError{}
+} = SetProjectMemberUserRoleRequestValidationError{}
 
-// Validate checks the field values on RenameUsergroupResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RenameUsergroupResponse) Validate() error {
+// Validate checks the field values on SetProjectMemberUserRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetProjectMemberUserRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RenameUsergroupResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RenameUsergroupResponseMultiError, or nil if none found.
-func (m *RenameUsergroupResponse) ValidateAll() error {
+// ValidateAll checks the field values on SetProjectMemberUserRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetProjectMemberUserRoleResponseMultiError, or nil if none found.
+func (m *SetProjectMemberUserRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RenameUsergroupResponse) validate(all bool) error {
+func (m *SetProjectMemberUserRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12938,19 +14332,20 @@ func (m *RenameUsergroupResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return RenameUsergroupResponseMultiError(errors)
+		return SetProjectMemberUserRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RenameUsergroupResponseMultiError is an error wrapping multiple validation
-// errors returned by RenameUsergroupResponse.ValidateAll() if the designated
+// SetProjectMemberUserRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetProjectMemberUserRoleResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RenameUsergroupResponseMultiError []error
+type SetProjectMemberUserRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RenameUsergroupResponseMultiError) Error() string {
+func (m SetProjectMemberUserRoleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12959,11 +14354,12 @@ func (m RenameUsergroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RenameUsergroupResponseMultiError) AllErrors() []error { return m }
+func (m SetProjectMemberUserRoleResponseMultiError) AllErrors() []error { return m }
 
-// RenameUsergroupResponseValidationError is the validation error returned by
-// RenameUsergroupResponse.Validate if the designated constraints aren't met.
-type RenameUsergroupResponseValidationError struct {
+// SetProjectMemberUserRoleResponseValidationError is the validation error
+// returned by SetProjectMemberUserRoleResponse.Validate if the designated
+// constraints aren't met.
+type SetProjectMemberUserRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12971,24 +14367,24 @@ type RenameUsergroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenameUsergroupResponseValidationError) Field() string { return e.field }
+func (e SetProjectMemberUserRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenameUsergroupResponseValidationError) Reason() string { return e.reason }
+func (e SetProjectMemberUserRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenameUsergroupResponseValidationError) Cause() error { return e.cause }
+func (e SetProjectMemberUserRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenameUsergroupResponseValidationError) Key() bool { return e.key }
+func (e SetProjectMemberUserRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenameUsergroupResponseValidationError) ErrorName() string {
-	return "RenameUsergroupResponseValidationError"
+func (e SetProjectMemberUserRoleResponseValidationError) ErrorName() string {
+	return "SetProjectMemberUserRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RenameUsergroupResponseValidationError) Error() string {
+func (e SetProjectMemberUserRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13000,14 +14396,14 @@ func (e RenameUsergroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenameUsergroupResponse.%s: %s%s",
+		"invalid %sSetProjectMemberUserRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenameUsergroupResponseValidationError{}
+var _ error = SetProjectMemberUserRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13015,24 +14411,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenameUsergroupResponseValidationError{}
+} = SetProjectMemberUserRoleResponseValidationError{}
 
-// Validate checks the field values on EditUsergroupRequest with the rules
+// Validate checks the field values on ImportMemberUsersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EditUsergroupRequest) Validate() error {
+func (m *ImportMemberUsersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditUsergroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ImportMemberUsersRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EditUsergroupRequestMultiError, or nil if none found.
-func (m *EditUsergroupRequest) ValidateAll() error {
+// ImportMemberUsersRequestMultiError, or nil if none found.
+func (m *ImportMemberUsersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditUsergroupRequest) validate(all bool) error {
+func (m *ImportMemberUsersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13040,7 +14436,7 @@ func (m *EditUsergroupRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := EditUsergroupRequestValidationError{
+		err := ImportMemberUsersRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -13050,33 +14446,58 @@ func (m *EditUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := EditUsergroupRequestValidationError{
-			field:  "Usergroup",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+	// no validation rules for Project
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportMemberUsersRequestValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportMemberUsersRequestValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportMemberUsersRequestValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
-	// no validation rules for Description
+	// no validation rules for Csv
 
 	if len(errors) > 0 {
-		return EditUsergroupRequestMultiError(errors)
+		return ImportMemberUsersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditUsergroupRequestMultiError is an error wrapping multiple validation
-// errors returned by EditUsergroupRequest.ValidateAll() if the designated
+// ImportMemberUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportMemberUsersRequest.ValidateAll() if the designated
 // constraints aren't met.
-type EditUsergroupRequestMultiError []error
+type ImportMemberUsersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditUsergroupRequestMultiError) Error() string {
+func (m ImportMemberUsersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13085,11 +14506,11 @@ func (m EditUsergroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditUsergroupRequestMultiError) AllErrors() []error { return m }
+func (m ImportMemberUsersRequestMultiError) AllErrors() []error { return m }
 
-// EditUsergroupRequestValidationError is the validation error returned by
-// EditUsergroupRequest.Validate if the designated constraints aren't met.
-type EditUsergroupRequestValidationError struct {
+// ImportMemberUsersRequestValidationError is the validation error returned by
+// ImportMemberUsersRequest.Validate if the designated constraints aren't met.
+type ImportMemberUsersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13097,24 +14518,24 @@ type EditUsergroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditUsergroupRequestValidationError) Field() string { return e.field }
+func (e ImportMemberUsersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditUsergroupRequestValidationError) Reason() string { return e.reason }
+func (e ImportMemberUsersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditUsergroupRequestValidationError) Cause() error { return e.cause }
+func (e ImportMemberUsersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditUsergroupRequestValidationError) Key() bool { return e.key }
+func (e ImportMemberUsersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditUsergroupRequestValidationError) ErrorName() string {
-	return "EditUsergroupRequestValidationError"
+func (e ImportMemberUsersRequestValidationError) ErrorName() string {
+	return "ImportMemberUsersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EditUsergroupRequestValidationError) Error() string {
+func (e ImportMemberUsersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13126,14 +14547,14 @@ func (e EditUsergroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditUsergroupRequest.%s: %s%s",
+		"invalid %sImportMemberUsersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditUsergroupRequestValidationError{}
+var _ error = ImportMemberUsersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13141,44 +14562,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditUsergroupRequestValidationError{}
+} = ImportMemberUsersRequestValidationError{}
 
-// Validate checks the field values on EditUsergroupResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EditUsergroupResponse) Validate() error {
+// Validate checks the field values on ImportMemberUser with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportMemberUser) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditUsergroupResponse with the rules
+// ValidateAll checks the field values on ImportMemberUser with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EditUsergroupResponseMultiError, or nil if none found.
-func (m *EditUsergroupResponse) ValidateAll() error {
+// ImportMemberUserMultiError, or nil if none found.
+func (m *ImportMemberUser) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditUsergroupResponse) validate(all bool) error {
+func (m *ImportMemberUser) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Email
+
+	// no validation rules for Role
+
 	if len(errors) > 0 {
-		return EditUsergroupResponseMultiError(errors)
+		return ImportMemberUserMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditUsergroupResponseMultiError is an error wrapping multiple validation
-// errors returned by EditUsergroupResponse.ValidateAll() if the designated
-// constraints aren't met.
-type EditUsergroupResponseMultiError []error
+// ImportMemberUserMultiError is an error wrapping multiple validation errors
+// returned by ImportMemberUser.ValidateAll() if the designated constraints
+// aren't met.
+type ImportMemberUserMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditUsergroupResponseMultiError) Error() string {
+func (m ImportMemberUserMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13187,11 +14612,11 @@ func (m EditUsergroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditUsergroupResponseMultiError) AllErrors() []error { return m }
+func (m ImportMemberUserMultiError) AllErrors() []error { return m }
 
-// EditUsergroupResponseValidationError is the validation error returned by
-// EditUsergroupResponse.Validate if the designated constraints aren't met.
-type EditUsergroupResponseValidationError struct {
+// ImportMemberUserValidationError is the validation error returned by
+// ImportMemberUser.Validate if the designated constraints aren't met.
+type ImportMemberUserValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13199,24 +14624,22 @@ type EditUsergroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditUsergroupResponseValidationError) Field() string { return e.field }
+func (e ImportMemberUserValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditUsergroupResponseValidationError) Reason() string { return e.reason }
+func (e ImportMemberUserValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditUsergroupResponseValidationError) Cause() error { return e.cause }
+func (e ImportMemberUserValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditUsergroupResponseValidationError) Key() bool { return e.key }
+func (e ImportMemberUserValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditUsergroupResponseValidationError) ErrorName() string {
-	return "EditUsergroupResponseValidationError"
-}
+func (e ImportMemberUserValidationError) ErrorName() string { return "ImportMemberUserValidationError" }
 
 // Error satisfies the builtin error interface
-func (e EditUsergroupResponseValidationError) Error() string {
+func (e ImportMemberUserValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13228,14 +14651,14 @@ func (e EditUsergroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditUsergroupResponse.%s: %s%s",
+		"invalid %sImportMemberUser.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditUsergroupResponseValidationError{}
+var _ error = ImportMemberUserValidationError{}
 
 var _ interface {
 	Field() string
@@ -13243,75 +14666,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditUsergroupResponseValidationError{}
+} = ImportMemberUserValidationError{}
 
-// Validate checks the field values on ListOrganizationMemberUsergroupsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *ListOrganizationMemberUsergroupsRequest) Validate() error {
+// Validate checks the field values on ImportMemberUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportMemberUsersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// ListOrganizationMemberUsergroupsRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// ListOrganizationMemberUsergroupsRequestMultiError, or nil if none found.
-func (m *ListOrganizationMemberUsergroupsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ImportMemberUsersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportMemberUsersResponseMultiError, or nil if none found.
+func (m *ImportMemberUsersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationMemberUsergroupsRequest) validate(all bool) error {
+func (m *ImportMemberUsersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListOrganizationMemberUsergroupsRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Imported
 
-	if m.GetPageSize() != 0 {
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
 
-		if m.GetPageSize() > 1000 {
-			err := ListOrganizationMemberUsergroupsRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportMemberUsersResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportMemberUsersResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportMemberUsersResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
 
 	}
 
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return ListOrganizationMemberUsergroupsRequestMultiError(errors)
+		return ImportMemberUsersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationMemberUsergroupsRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// ListOrganizationMemberUsergroupsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListOrganizationMemberUsergroupsRequestMultiError []error
+// ImportMemberUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportMemberUsersResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ImportMemberUsersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationMemberUsergroupsRequestMultiError) Error() string {
+func (m ImportMemberUsersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13320,12 +14748,11 @@ func (m ListOrganizationMemberUsergroupsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationMemberUsergroupsRequestMultiError) AllErrors() []error { return m }
+func (m ImportMemberUsersResponseMultiError) AllErrors() []error { return m }
 
-// ListOrganizationMemberUsergroupsRequestValidationError is the validation
-// error returned by ListOrganizationMemberUsergroupsRequest.Validate if the
-// designated constraints aren't met.
-type ListOrganizationMemberUsergroupsRequestValidationError struct {
+// ImportMemberUsersResponseValidationError is the validation error returned by
+// ImportMemberUsersResponse.Validate if the designated constraints aren't met.
+type ImportMemberUsersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13333,24 +14760,24 @@ type ListOrganizationMemberUsergroupsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationMemberUsergroupsRequestValidationError) Field() string { return e.field }
+func (e ImportMemberUsersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationMemberUsergroupsRequestValidationError) Reason() string { return e.reason }
+func (e ImportMemberUsersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationMemberUsergroupsRequestValidationError) Cause() error { return e.cause }
+func (e ImportMemberUsersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationMemberUsergroupsRequestValidationError) Key() bool { return e.key }
+func (e ImportMemberUsersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationMemberUsergroupsRequestValidationError) ErrorName() string {
-	return "ListOrganizationMemberUsergroupsRequestValidationError"
+func (e ImportMemberUsersResponseValidationError) ErrorName() string {
+	return "ImportMemberUsersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationMemberUsergroupsRequestValidationError) Error() string {
+func (e ImportMemberUsersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13362,14 +14789,14 @@ func (e ListOrganizationMemberUsergroupsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationMemberUsergroupsRequest.%s: %s%s",
+		"invalid %sImportMemberUsersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationMemberUsergroupsRequestValidationError{}
+var _ error = ImportMemberUsersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13377,83 +14804,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationMemberUsergroupsRequestValidationError{}
+} = ImportMemberUsersResponseValidationError{}
 
-// Validate checks the field values on ListOrganizationMemberUsergroupsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *ListOrganizationMemberUsergroupsResponse) Validate() error {
+// Validate checks the field values on ImportMemberUserResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportMemberUserResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// ListOrganizationMemberUsergroupsResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// ListOrganizationMemberUsergroupsResponseMultiError, or nil if none found.
-func (m *ListOrganizationMemberUsergroupsResponse) ValidateAll() error {
+// ValidateAll checks the field values on ImportMemberUserResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportMemberUserResultMultiError, or nil if none found.
+func (m *ImportMemberUserResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrganizationMemberUsergroupsResponse) validate(all bool) error {
+func (m *ImportMemberUserResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMembers() {
-		_, _ = idx, item
+	// no validation rules for Row
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOrganizationMemberUsergroupsResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOrganizationMemberUsergroupsResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListOrganizationMemberUsergroupsResponseValidationError{
-					field:  fmt.Sprintf("Members[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Email
 
-	}
+	// no validation rules for Role
 
-	// no validation rules for NextPageToken
+	// no validation rules for PendingSignup
+
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return ListOrganizationMemberUsergroupsResponseMultiError(errors)
+		return ImportMemberUserResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrganizationMemberUsergroupsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// ListOrganizationMemberUsergroupsResponse.ValidateAll() if the designated
+// ImportMemberUserResultMultiError is an error wrapping multiple validation
+// errors returned by ImportMemberUserResult.ValidateAll() if the designated
 // constraints aren't met.
-type ListOrganizationMemberUsergroupsResponseMultiError []error
+type ImportMemberUserResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrganizationMemberUsergroupsResponseMultiError) Error() string {
+func (m ImportMemberUserResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13462,12 +14860,11 @@ func (m ListOrganizationMemberUsergroupsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrganizationMemberUsergroupsResponseMultiError) AllErrors() []error { return m }
+func (m ImportMemberUserResultMultiError) AllErrors() []error { return m }
 
-// ListOrganizationMemberUsergroupsResponseValidationError is the validation
-// error returned by ListOrganizationMemberUsergroupsResponse.Validate if the
-// designated constraints aren't met.
-type ListOrganizationMemberUsergroupsResponseValidationError struct {
+// ImportMemberUserResultValidationError is the validation error returned by
+// ImportMemberUserResult.Validate if the designated constraints aren't met.
+type ImportMemberUserResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13475,24 +14872,24 @@ type ListOrganizationMemberUsergroupsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrganizationMemberUsergroupsResponseValidationError) Field() string { return e.field }
+func (e ImportMemberUserResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrganizationMemberUsergroupsResponseValidationError) Reason() string { return e.reason }
+func (e ImportMemberUserResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrganizationMemberUsergroupsResponseValidationError) Cause() error { return e.cause }
+func (e ImportMemberUserResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrganizationMemberUsergroupsResponseValidationError) Key() bool { return e.key }
+func (e ImportMemberUserResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrganizationMemberUsergroupsResponseValidationError) ErrorName() string {
-	return "ListOrganizationMemberUsergroupsResponseValidationError"
+func (e ImportMemberUserResultValidationError) ErrorName() string {
+	return "ImportMemberUserResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrganizationMemberUsergroupsResponseValidationError) Error() string {
+func (e ImportMemberUserResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13504,14 +14901,14 @@ func (e ListOrganizationMemberUsergroupsResponseValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrganizationMemberUsergroupsResponse.%s: %s%s",
+		"invalid %sImportMemberUserResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrganizationMemberUsergroupsResponseValidationError{}
+var _ error = ImportMemberUserResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -13519,25 +14916,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrganizationMemberUsergroupsResponseValidationError{}
+} = ImportMemberUserResultValidationError{}
 
-// Validate checks the field values on ListProjectMemberUsergroupsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ListProjectMemberUsergroupsRequest) Validate() error {
+// Validate checks the field values on CreateUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectMemberUsergroupsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ListProjectMemberUsergroupsRequestMultiError, or nil if none found.
-func (m *ListProjectMemberUsergroupsRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUsergroupRequestMultiError, or nil if none found.
+func (m *CreateUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectMemberUsergroupsRequest) validate(all bool) error {
+func (m *CreateUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13545,7 +14941,7 @@ func (m *ListProjectMemberUsergroupsRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectMemberUsergroupsRequestValidationError{
+		err := CreateUsergroupRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -13555,9 +14951,9 @@ func (m *ListProjectMemberUsergroupsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectMemberUsergroupsRequestValidationError{
-			field:  "Project",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateUsergroupRequestValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -13566,38 +14962,20 @@ func (m *ListProjectMemberUsergroupsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListProjectMemberUsergroupsRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return ListProjectMemberUsergroupsRequestMultiError(errors)
+		return CreateUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectMemberUsergroupsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// ListProjectMemberUsergroupsRequest.ValidateAll() if the designated
+// CreateUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListProjectMemberUsergroupsRequestMultiError []error
+type CreateUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectMemberUsergroupsRequestMultiError) Error() string {
+func (m CreateUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13606,12 +14984,11 @@ func (m ListProjectMemberUsergroupsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectMemberUsergroupsRequestMultiError) AllErrors() []error { return m }
+func (m CreateUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectMemberUsergroupsRequestValidationError is the validation error
-// returned by ListProjectMemberUsergroupsRequest.Validate if the designated
-// constraints aren't met.
-type ListProjectMemberUsergroupsRequestValidationError struct {
+// CreateUsergroupRequestValidationError is the validation error returned by
+// CreateUsergroupRequest.Validate if the designated constraints aren't met.
+type CreateUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13619,24 +14996,24 @@ type ListProjectMemberUsergroupsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectMemberUsergroupsRequestValidationError) Field() string { return e.field }
+func (e CreateUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectMemberUsergroupsRequestValidationError) Reason() string { return e.reason }
+func (e CreateUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectMemberUsergroupsRequestValidationError) Cause() error { return e.cause }
+func (e CreateUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectMemberUsergroupsRequestValidationError) Key() bool { return e.key }
+func (e CreateUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectMemberUsergroupsRequestValidationError) ErrorName() string {
-	return "ListProjectMemberUsergroupsRequestValidationError"
+func (e CreateUsergroupRequestValidationError) ErrorName() string {
+	return "CreateUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectMemberUsergroupsRequestValidationError) Error() string {
+func (e CreateUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13648,14 +15025,14 @@ func (e ListProjectMemberUsergroupsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectMemberUsergroupsRequest.%s: %s%s",
+		"invalid %sCreateUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectMemberUsergroupsRequestValidationError{}
+var _ error = CreateUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13663,82 +15040,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectMemberUsergroupsRequestValidationError{}
+} = CreateUsergroupRequestValidationError{}
 
-// Validate checks the field values on ListProjectMemberUsergroupsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ListProjectMemberUsergroupsResponse) Validate() error {
+// Validate checks the field values on CreateUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectMemberUsergroupsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ListProjectMemberUsergroupsResponseMultiError, or nil if none found.
-func (m *ListProjectMemberUsergroupsResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUsergroupResponseMultiError, or nil if none found.
+func (m *CreateUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectMemberUsergroupsResponse) validate(all bool) error {
+func (m *CreateUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMembers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListProjectMemberUsergroupsResponseValidationError{
-					field:  fmt.Sprintf("Members[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListProjectMemberUsergroupsResponseMultiError(errors)
+		return CreateUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectMemberUsergroupsResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// ListProjectMemberUsergroupsResponse.ValidateAll() if the designated
+// CreateUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateUsergroupResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListProjectMemberUsergroupsResponseMultiError []error
+type CreateUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectMemberUsergroupsResponseMultiError) Error() string {
+func (m CreateUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13747,12 +15086,11 @@ func (m ListProjectMemberUsergroupsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectMemberUsergroupsResponseMultiError) AllErrors() []error { return m }
+func (m CreateUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectMemberUsergroupsResponseValidationError is the validation error
-// returned by ListProjectMemberUsergroupsResponse.Validate if the designated
-// constraints aren't met.
-type ListProjectMemberUsergroupsResponseValidationError struct {
+// CreateUsergroupResponseValidationError is the validation error returned by
+// CreateUsergroupResponse.Validate if the designated constraints aren't met.
+type CreateUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13760,24 +15098,24 @@ type ListProjectMemberUsergroupsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectMemberUsergroupsResponseValidationError) Field() string { return e.field }
+func (e CreateUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectMemberUsergroupsResponseValidationError) Reason() string { return e.reason }
+func (e CreateUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectMemberUsergroupsResponseValidationError) Cause() error { return e.cause }
+func (e CreateUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectMemberUsergroupsResponseValidationError) Key() bool { return e.key }
+func (e CreateUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectMemberUsergroupsResponseValidationError) ErrorName() string {
-	return "ListProjectMemberUsergroupsResponseValidationError"
+func (e CreateUsergroupResponseValidationError) ErrorName() string {
+	return "CreateUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectMemberUsergroupsResponseValidationError) Error() string {
+func (e CreateUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13789,14 +15127,14 @@ func (e ListProjectMemberUsergroupsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectMemberUsergroupsResponse.%s: %s%s",
+		"invalid %sCreateUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectMemberUsergroupsResponseValidationError{}
+var _ error = CreateUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13804,24 +15142,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectMemberUsergroupsResponseValidationError{}
+} = CreateUsergroupResponseValidationError{}
 
-// Validate checks the field values on DeleteUsergroupRequest with the rules
+// Validate checks the field values on GetUsergroupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteUsergroupRequest) Validate() error {
+func (m *GetUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteUsergroupRequest with the rules
+// ValidateAll checks the field values on GetUsergroupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteUsergroupRequestMultiError, or nil if none found.
-func (m *DeleteUsergroupRequest) ValidateAll() error {
+// GetUsergroupRequestMultiError, or nil if none found.
+func (m *GetUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteUsergroupRequest) validate(all bool) error {
+func (m *GetUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13829,7 +15167,7 @@ func (m *DeleteUsergroupRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := DeleteUsergroupRequestValidationError{
+		err := GetUsergroupRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -13840,7 +15178,7 @@ func (m *DeleteUsergroupRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := DeleteUsergroupRequestValidationError{
+		err := GetUsergroupRequestValidationError{
 			field:  "Usergroup",
 			reason: "value length must be at least 1 runes",
 		}
@@ -13850,20 +15188,37 @@ func (m *DeleteUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := GetUsergroupRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return DeleteUsergroupRequestMultiError(errors)
+		return GetUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteUsergroupRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteUsergroupRequest.ValidateAll() if the designated
+// GetUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteUsergroupRequestMultiError []error
+type GetUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteUsergroupRequestMultiError) Error() string {
+func (m GetUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13872,11 +15227,11 @@ func (m DeleteUsergroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteUsergroupRequestMultiError) AllErrors() []error { return m }
+func (m GetUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// DeleteUsergroupRequestValidationError is the validation error returned by
-// DeleteUsergroupRequest.Validate if the designated constraints aren't met.
-type DeleteUsergroupRequestValidationError struct {
+// GetUsergroupRequestValidationError is the validation error returned by
+// GetUsergroupRequest.Validate if the designated constraints aren't met.
+type GetUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13884,24 +15239,24 @@ type DeleteUsergroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteUsergroupRequestValidationError) Field() string { return e.field }
+func (e GetUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteUsergroupRequestValidationError) Reason() string { return e.reason }
+func (e GetUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteUsergroupRequestValidationError) Cause() error { return e.cause }
+func (e GetUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteUsergroupRequestValidationError) Key() bool { return e.key }
+func (e GetUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteUsergroupRequestValidationError) ErrorName() string {
-	return "DeleteUsergroupRequestValidationError"
+func (e GetUsergroupRequestValidationError) ErrorName() string {
+	return "GetUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteUsergroupRequestValidationError) Error() string {
+func (e GetUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13913,14 +15268,14 @@ func (e DeleteUsergroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteUsergroupRequest.%s: %s%s",
+		"invalid %sGetUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteUsergroupRequestValidationError{}
+var _ error = GetUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13928,44 +15283,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteUsergroupRequestValidationError{}
+} = GetUsergroupRequestValidationError{}
 
-// Validate checks the field values on DeleteUsergroupResponse with the rules
+// Validate checks the field values on GetUsergroupResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteUsergroupResponse) Validate() error {
+func (m *GetUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteUsergroupResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteUsergroupResponseMultiError, or nil if none found.
-func (m *DeleteUsergroupResponse) ValidateAll() error {
+// GetUsergroupResponseMultiError, or nil if none found.
+func (m *GetUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteUsergroupResponse) validate(all bool) error {
+func (m *GetUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetUsergroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUsergroupResponseValidationError{
+					field:  "Usergroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUsergroupResponseValidationError{
+				field:  "Usergroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return DeleteUsergroupResponseMultiError(errors)
+		return GetUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteUsergroupResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteUsergroupResponse.ValidateAll() if the designated
+// GetUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUsergroupResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteUsergroupResponseMultiError []error
+type GetUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteUsergroupResponseMultiError) Error() string {
+func (m GetUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13974,11 +15360,11 @@ func (m DeleteUsergroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteUsergroupResponseMultiError) AllErrors() []error { return m }
+func (m GetUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// DeleteUsergroupResponseValidationError is the validation error returned by
-// DeleteUsergroupResponse.Validate if the designated constraints aren't met.
-type DeleteUsergroupResponseValidationError struct {
+// GetUsergroupResponseValidationError is the validation error returned by
+// GetUsergroupResponse.Validate if the designated constraints aren't met.
+type GetUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13986,24 +15372,24 @@ type DeleteUsergroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteUsergroupResponseValidationError) Field() string { return e.field }
+func (e GetUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteUsergroupResponseValidationError) Reason() string { return e.reason }
+func (e GetUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteUsergroupResponseValidationError) Cause() error { return e.cause }
+func (e GetUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteUsergroupResponseValidationError) Key() bool { return e.key }
+func (e GetUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteUsergroupResponseValidationError) ErrorName() string {
-	return "DeleteUsergroupResponseValidationError"
+func (e GetUsergroupResponseValidationError) ErrorName() string {
+	return "GetUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteUsergroupResponseValidationError) Error() string {
+func (e GetUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14015,14 +15401,14 @@ func (e DeleteUsergroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteUsergroupResponse.%s: %s%s",
+		"invalid %sGetUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteUsergroupResponseValidationError{}
+var _ error = GetUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14030,25 +15416,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteUsergroupResponseValidationError{}
+} = GetUsergroupResponseValidationError{}
 
-// Validate checks the field values on AddOrganizationMemberUsergroupRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *AddOrganizationMemberUsergroupRequest) Validate() error {
+// Validate checks the field values on RenameUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddOrganizationMemberUsergroupRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// AddOrganizationMemberUsergroupRequestMultiError, or nil if none found.
-func (m *AddOrganizationMemberUsergroupRequest) ValidateAll() error {
+// ValidateAll checks the field values on RenameUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameUsergroupRequestMultiError, or nil if none found.
+func (m *RenameUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddOrganizationMemberUsergroupRequest) validate(all bool) error {
+func (m *RenameUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14056,7 +15441,7 @@ func (m *AddOrganizationMemberUsergroupRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := AddOrganizationMemberUsergroupRequestValidationError{
+		err := RenameUsergroupRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14067,7 +15452,7 @@ func (m *AddOrganizationMemberUsergroupRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := AddOrganizationMemberUsergroupRequestValidationError{
+		err := RenameUsergroupRequestValidationError{
 			field:  "Usergroup",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14077,9 +15462,9 @@ func (m *AddOrganizationMemberUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := AddOrganizationMemberUsergroupRequestValidationError{
-			field:  "Role",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := RenameUsergroupRequestValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -14089,20 +15474,19 @@ func (m *AddOrganizationMemberUsergroupRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AddOrganizationMemberUsergroupRequestMultiError(errors)
+		return RenameUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddOrganizationMemberUsergroupRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// AddOrganizationMemberUsergroupRequest.ValidateAll() if the designated
+// RenameUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by RenameUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AddOrganizationMemberUsergroupRequestMultiError []error
+type RenameUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddOrganizationMemberUsergroupRequestMultiError) Error() string {
+func (m RenameUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14111,12 +15495,11 @@ func (m AddOrganizationMemberUsergroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddOrganizationMemberUsergroupRequestMultiError) AllErrors() []error { return m }
+func (m RenameUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// AddOrganizationMemberUsergroupRequestValidationError is the validation error
-// returned by AddOrganizationMemberUsergroupRequest.Validate if the
-// designated constraints aren't met.
-type AddOrganizationMemberUsergroupRequestValidationError struct {
+// RenameUsergroupRequestValidationError is the validation error returned by
+// RenameUsergroupRequest.Validate if the designated constraints aren't met.
+type RenameUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14124,24 +15507,24 @@ type AddOrganizationMemberUsergroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddOrganizationMemberUsergroupRequestValidationError) Field() string { return e.field }
+func (e RenameUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddOrganizationMemberUsergroupRequestValidationError) Reason() string { return e.reason }
+func (e RenameUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddOrganizationMemberUsergroupRequestValidationError) Cause() error { return e.cause }
+func (e RenameUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddOrganizationMemberUsergroupRequestValidationError) Key() bool { return e.key }
+func (e RenameUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddOrganizationMemberUsergroupRequestValidationError) ErrorName() string {
-	return "AddOrganizationMemberUsergroupRequestValidationError"
+func (e RenameUsergroupRequestValidationError) ErrorName() string {
+	return "RenameUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddOrganizationMemberUsergroupRequestValidationError) Error() string {
+func (e RenameUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14153,14 +15536,14 @@ func (e AddOrganizationMemberUsergroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddOrganizationMemberUsergroupRequest.%s: %s%s",
+		"invalid %sRenameUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddOrganizationMemberUsergroupRequestValidationError{}
+var _ error = RenameUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14168,26 +15551,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddOrganizationMemberUsergroupRequestValidationError{}
+} = RenameUsergroupRequestValidationError{}
 
-// Validate checks the field values on AddOrganizationMemberUsergroupResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *AddOrganizationMemberUsergroupResponse) Validate() error {
+// Validate checks the field values on RenameUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AddOrganizationMemberUsergroupResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// AddOrganizationMemberUsergroupResponseMultiError, or nil if none found.
-func (m *AddOrganizationMemberUsergroupResponse) ValidateAll() error {
+// ValidateAll checks the field values on RenameUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameUsergroupResponseMultiError, or nil if none found.
+func (m *RenameUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddOrganizationMemberUsergroupResponse) validate(all bool) error {
+func (m *RenameUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14195,20 +15576,19 @@ func (m *AddOrganizationMemberUsergroupResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return AddOrganizationMemberUsergroupResponseMultiError(errors)
+		return RenameUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddOrganizationMemberUsergroupResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// AddOrganizationMemberUsergroupResponse.ValidateAll() if the designated
+// RenameUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by RenameUsergroupResponse.ValidateAll() if the designated
 // constraints aren't met.
-type AddOrganizationMemberUsergroupResponseMultiError []error
+type RenameUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddOrganizationMemberUsergroupResponseMultiError) Error() string {
+func (m RenameUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14217,12 +15597,11 @@ func (m AddOrganizationMemberUsergroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddOrganizationMemberUsergroupResponseMultiError) AllErrors() []error { return m }
+func (m RenameUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// AddOrganizationMemberUsergroupResponseValidationError is the validation
-// error returned by AddOrganizationMemberUsergroupResponse.Validate if the
-// designated constraints aren't met.
-type AddOrganizationMemberUsergroupResponseValidationError struct {
+// RenameUsergroupResponseValidationError is the validation error returned by
+// RenameUsergroupResponse.Validate if the designated constraints aren't met.
+type RenameUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14230,24 +15609,24 @@ type AddOrganizationMemberUsergroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddOrganizationMemberUsergroupResponseValidationError) Field() string { return e.field }
+func (e RenameUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddOrganizationMemberUsergroupResponseValidationError) Reason() string { return e.reason }
+func (e RenameUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddOrganizationMemberUsergroupResponseValidationError) Cause() error { return e.cause }
+func (e RenameUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddOrganizationMemberUsergroupResponseValidationError) Key() bool { return e.key }
+func (e RenameUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddOrganizationMemberUsergroupResponseValidationError) ErrorName() string {
-	return "AddOrganizationMemberUsergroupResponseValidationError"
+func (e RenameUsergroupResponseValidationError) ErrorName() string {
+	return "RenameUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddOrganizationMemberUsergroupResponseValidationError) Error() string {
+func (e RenameUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14259,14 +15638,14 @@ func (e AddOrganizationMemberUsergroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddOrganizationMemberUsergroupResponse.%s: %s%s",
+		"invalid %sRenameUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddOrganizationMemberUsergroupResponseValidationError{}
+var _ error = RenameUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14274,26 +15653,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddOrganizationMemberUsergroupResponseValidationError{}
+} = RenameUsergroupResponseValidationError{}
 
-// Validate checks the field values on
-// SetOrganizationMemberUsergroupRoleRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SetOrganizationMemberUsergroupRoleRequest) Validate() error {
+// Validate checks the field values on EditUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// SetOrganizationMemberUsergroupRoleRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// SetOrganizationMemberUsergroupRoleRequestMultiError, or nil if none found.
-func (m *SetOrganizationMemberUsergroupRoleRequest) ValidateAll() error {
+// ValidateAll checks the field values on EditUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditUsergroupRequestMultiError, or nil if none found.
+func (m *EditUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetOrganizationMemberUsergroupRoleRequest) validate(all bool) error {
+func (m *EditUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14301,7 +15678,7 @@ func (m *SetOrganizationMemberUsergroupRoleRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
+		err := EditUsergroupRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14312,7 +15689,7 @@ func (m *SetOrganizationMemberUsergroupRoleRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
+		err := EditUsergroupRequestValidationError{
 			field:  "Usergroup",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14322,32 +15699,22 @@ func (m *SetOrganizationMemberUsergroupRoleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Description
 
 	if len(errors) > 0 {
-		return SetOrganizationMemberUsergroupRoleRequestMultiError(errors)
+		return EditUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetOrganizationMemberUsergroupRoleRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// SetOrganizationMemberUsergroupRoleRequest.ValidateAll() if the designated
+// EditUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by EditUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SetOrganizationMemberUsergroupRoleRequestMultiError []error
+type EditUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetOrganizationMemberUsergroupRoleRequestMultiError) Error() string {
+func (m EditUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14356,12 +15723,11 @@ func (m SetOrganizationMemberUsergroupRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetOrganizationMemberUsergroupRoleRequestMultiError) AllErrors() []error { return m }
+func (m EditUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// SetOrganizationMemberUsergroupRoleRequestValidationError is the validation
-// error returned by SetOrganizationMemberUsergroupRoleRequest.Validate if the
-// designated constraints aren't met.
-type SetOrganizationMemberUsergroupRoleRequestValidationError struct {
+// EditUsergroupRequestValidationError is the validation error returned by
+// EditUsergroupRequest.Validate if the designated constraints aren't met.
+type EditUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14369,24 +15735,24 @@ type SetOrganizationMemberUsergroupRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Field() string { return e.field }
+func (e EditUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Reason() string { return e.reason }
+func (e EditUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Cause() error { return e.cause }
+func (e EditUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Key() bool { return e.key }
+func (e EditUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetOrganizationMemberUsergroupRoleRequestValidationError) ErrorName() string {
-	return "SetOrganizationMemberUsergroupRoleRequestValidationError"
+func (e EditUsergroupRequestValidationError) ErrorName() string {
+	return "EditUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Error() string {
+func (e EditUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14398,14 +15764,14 @@ func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetOrganizationMemberUsergroupRoleRequest.%s: %s%s",
+		"invalid %sEditUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetOrganizationMemberUsergroupRoleRequestValidationError{}
+var _ error = EditUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14413,26 +15779,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetOrganizationMemberUsergroupRoleRequestValidationError{}
+} = EditUsergroupRequestValidationError{}
 
-// Validate checks the field values on
-// SetOrganizationMemberUsergroupRoleResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SetOrganizationMemberUsergroupRoleResponse) Validate() error {
+// Validate checks the field values on EditUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// SetOrganizationMemberUsergroupRoleResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// SetOrganizationMemberUsergroupRoleResponseMultiError, or nil if none found.
-func (m *SetOrganizationMemberUsergroupRoleResponse) ValidateAll() error {
+// ValidateAll checks the field values on EditUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditUsergroupResponseMultiError, or nil if none found.
+func (m *EditUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetOrganizationMemberUsergroupRoleResponse) validate(all bool) error {
+func (m *EditUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14440,20 +15804,19 @@ func (m *SetOrganizationMemberUsergroupRoleResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return SetOrganizationMemberUsergroupRoleResponseMultiError(errors)
+		return EditUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetOrganizationMemberUsergroupRoleResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// SetOrganizationMemberUsergroupRoleResponse.ValidateAll() if the designated
+// EditUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by EditUsergroupResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SetOrganizationMemberUsergroupRoleResponseMultiError []error
+type EditUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetOrganizationMemberUsergroupRoleResponseMultiError) Error() string {
+func (m EditUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14462,12 +15825,11 @@ func (m SetOrganizationMemberUsergroupRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetOrganizationMemberUsergroupRoleResponseMultiError) AllErrors() []error { return m }
+func (m EditUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// SetOrganizationMemberUsergroupRoleResponseValidationError is the validation
-// error returned by SetOrganizationMemberUsergroupRoleResponse.Validate if
-// the designated constraints aren't met.
-type SetOrganizationMemberUsergroupRoleResponseValidationError struct {
+// EditUsergroupResponseValidationError is the validation error returned by
+// EditUsergroupResponse.Validate if the designated constraints aren't met.
+type EditUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14475,24 +15837,24 @@ type SetOrganizationMemberUsergroupRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Field() string { return e.field }
+func (e EditUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Reason() string { return e.reason }
+func (e EditUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Cause() error { return e.cause }
+func (e EditUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Key() bool { return e.key }
+func (e EditUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetOrganizationMemberUsergroupRoleResponseValidationError) ErrorName() string {
-	return "SetOrganizationMemberUsergroupRoleResponseValidationError"
+func (e EditUsergroupResponseValidationError) ErrorName() string {
+	return "EditUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Error() string {
+func (e EditUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14504,14 +15866,14 @@ func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Error() strin
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetOrganizationMemberUsergroupRoleResponse.%s: %s%s",
+		"invalid %sEditUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetOrganizationMemberUsergroupRoleResponseValidationError{}
+var _ error = EditUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14519,26 +15881,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetOrganizationMemberUsergroupRoleResponseValidationError{}
+} = EditUsergroupResponseValidationError{}
 
-// Validate checks the field values on RemoveOrganizationMemberUsergroupRequest
+// Validate checks the field values on ListOrganizationMemberUsergroupsRequest
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *RemoveOrganizationMemberUsergroupRequest) Validate() error {
+func (m *ListOrganizationMemberUsergroupsRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// RemoveOrganizationMemberUsergroupRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// RemoveOrganizationMemberUsergroupRequestMultiError, or nil if none found.
-func (m *RemoveOrganizationMemberUsergroupRequest) ValidateAll() error {
+// ListOrganizationMemberUsergroupsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// ListOrganizationMemberUsergroupsRequestMultiError, or nil if none found.
+func (m *ListOrganizationMemberUsergroupsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveOrganizationMemberUsergroupRequest) validate(all bool) error {
+func (m *ListOrganizationMemberUsergroupsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14546,7 +15908,7 @@ func (m *RemoveOrganizationMemberUsergroupRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveOrganizationMemberUsergroupRequestValidationError{
+		err := ListOrganizationMemberUsergroupsRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14556,32 +15918,38 @@ func (m *RemoveOrganizationMemberUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := RemoveOrganizationMemberUsergroupRequestValidationError{
-			field:  "Usergroup",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListOrganizationMemberUsergroupsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return RemoveOrganizationMemberUsergroupRequestMultiError(errors)
+		return ListOrganizationMemberUsergroupsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveOrganizationMemberUsergroupRequestMultiError is an error wrapping
+// ListOrganizationMemberUsergroupsRequestMultiError is an error wrapping
 // multiple validation errors returned by
-// RemoveOrganizationMemberUsergroupRequest.ValidateAll() if the designated
+// ListOrganizationMemberUsergroupsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveOrganizationMemberUsergroupRequestMultiError []error
+type ListOrganizationMemberUsergroupsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveOrganizationMemberUsergroupRequestMultiError) Error() string {
+func (m ListOrganizationMemberUsergroupsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14590,12 +15958,12 @@ func (m RemoveOrganizationMemberUsergroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveOrganizationMemberUsergroupRequestMultiError) AllErrors() []error { return m }
+func (m ListOrganizationMemberUsergroupsRequestMultiError) AllErrors() []error { return m }
 
-// RemoveOrganizationMemberUsergroupRequestValidationError is the validation
-// error returned by RemoveOrganizationMemberUsergroupRequest.Validate if the
+// ListOrganizationMemberUsergroupsRequestValidationError is the validation
+// error returned by ListOrganizationMemberUsergroupsRequest.Validate if the
 // designated constraints aren't met.
-type RemoveOrganizationMemberUsergroupRequestValidationError struct {
+type ListOrganizationMemberUsergroupsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14603,24 +15971,24 @@ type RemoveOrganizationMemberUsergroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveOrganizationMemberUsergroupRequestValidationError) Field() string { return e.field }
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveOrganizationMemberUsergroupRequestValidationError) Reason() string { return e.reason }
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveOrganizationMemberUsergroupRequestValidationError) Cause() error { return e.cause }
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveOrganizationMemberUsergroupRequestValidationError) Key() bool { return e.key }
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveOrganizationMemberUsergroupRequestValidationError) ErrorName() string {
-	return "RemoveOrganizationMemberUsergroupRequestValidationError"
+func (e ListOrganizationMemberUsergroupsRequestValidationError) ErrorName() string {
+	return "ListOrganizationMemberUsergroupsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveOrganizationMemberUsergroupRequestValidationError) Error() string {
+func (e ListOrganizationMemberUsergroupsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14632,14 +16000,14 @@ func (e RemoveOrganizationMemberUsergroupRequestValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveOrganizationMemberUsergroupRequest.%s: %s%s",
+		"invalid %sListOrganizationMemberUsergroupsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveOrganizationMemberUsergroupRequestValidationError{}
+var _ error = ListOrganizationMemberUsergroupsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14647,47 +16015,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveOrganizationMemberUsergroupRequestValidationError{}
+} = ListOrganizationMemberUsergroupsRequestValidationError{}
 
-// Validate checks the field values on
-// RemoveOrganizationMemberUsergroupResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RemoveOrganizationMemberUsergroupResponse) Validate() error {
+// Validate checks the field values on ListOrganizationMemberUsergroupsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListOrganizationMemberUsergroupsResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// RemoveOrganizationMemberUsergroupResponse with the rules defined in the
+// ListOrganizationMemberUsergroupsResponse with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
 // a list of violation errors wrapped in
-// RemoveOrganizationMemberUsergroupResponseMultiError, or nil if none found.
-func (m *RemoveOrganizationMemberUsergroupResponse) ValidateAll() error {
+// ListOrganizationMemberUsergroupsResponseMultiError, or nil if none found.
+func (m *ListOrganizationMemberUsergroupsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveOrganizationMemberUsergroupResponse) validate(all bool) error {
+func (m *ListOrganizationMemberUsergroupsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationMemberUsergroupsResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return RemoveOrganizationMemberUsergroupResponseMultiError(errors)
+		return ListOrganizationMemberUsergroupsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveOrganizationMemberUsergroupResponseMultiError is an error wrapping
+// ListOrganizationMemberUsergroupsResponseMultiError is an error wrapping
 // multiple validation errors returned by
-// RemoveOrganizationMemberUsergroupResponse.ValidateAll() if the designated
+// ListOrganizationMemberUsergroupsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveOrganizationMemberUsergroupResponseMultiError []error
+type ListOrganizationMemberUsergroupsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveOrganizationMemberUsergroupResponseMultiError) Error() string {
+func (m ListOrganizationMemberUsergroupsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14696,12 +16100,12 @@ func (m RemoveOrganizationMemberUsergroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveOrganizationMemberUsergroupResponseMultiError) AllErrors() []error { return m }
+func (m ListOrganizationMemberUsergroupsResponseMultiError) AllErrors() []error { return m }
 
-// RemoveOrganizationMemberUsergroupResponseValidationError is the validation
-// error returned by RemoveOrganizationMemberUsergroupResponse.Validate if the
+// ListOrganizationMemberUsergroupsResponseValidationError is the validation
+// error returned by ListOrganizationMemberUsergroupsResponse.Validate if the
 // designated constraints aren't met.
-type RemoveOrganizationMemberUsergroupResponseValidationError struct {
+type ListOrganizationMemberUsergroupsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14709,24 +16113,24 @@ type RemoveOrganizationMemberUsergroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveOrganizationMemberUsergroupResponseValidationError) Field() string { return e.field }
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveOrganizationMemberUsergroupResponseValidationError) Reason() string { return e.reason }
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveOrganizationMemberUsergroupResponseValidationError) Cause() error { return e.cause }
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveOrganizationMemberUsergroupResponseValidationError) Key() bool { return e.key }
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveOrganizationMemberUsergroupResponseValidationError) ErrorName() string {
-	return "RemoveOrganizationMemberUsergroupResponseValidationError"
+func (e ListOrganizationMemberUsergroupsResponseValidationError) ErrorName() string {
+	return "ListOrganizationMemberUsergroupsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveOrganizationMemberUsergroupResponseValidationError) Error() string {
+func (e ListOrganizationMemberUsergroupsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14738,14 +16142,14 @@ func (e RemoveOrganizationMemberUsergroupResponseValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveOrganizationMemberUsergroupResponse.%s: %s%s",
+		"invalid %sListOrganizationMemberUsergroupsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveOrganizationMemberUsergroupResponseValidationError{}
+var _ error = ListOrganizationMemberUsergroupsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14753,25 +16157,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveOrganizationMemberUsergroupResponseValidationError{}
+} = ListOrganizationMemberUsergroupsResponseValidationError{}
 
-// Validate checks the field values on AddProjectMemberUsergroupRequest with
+// Validate checks the field values on ListProjectMemberUsergroupsRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *AddProjectMemberUsergroupRequest) Validate() error {
+func (m *ListProjectMemberUsergroupsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectMemberUsergroupRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AddProjectMemberUsergroupRequestMultiError, or nil if none found.
-func (m *AddProjectMemberUsergroupRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectMemberUsergroupsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectMemberUsergroupsRequestMultiError, or nil if none found.
+func (m *ListProjectMemberUsergroupsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectMemberUsergroupRequest) validate(all bool) error {
+func (m *ListProjectMemberUsergroupsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14779,7 +16183,7 @@ func (m *AddProjectMemberUsergroupRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := AddProjectMemberUsergroupRequestValidationError{
+		err := ListProjectMemberUsergroupsRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14790,7 +16194,7 @@ func (m *AddProjectMemberUsergroupRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := AddProjectMemberUsergroupRequestValidationError{
+		err := ListProjectMemberUsergroupsRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14800,43 +16204,38 @@ func (m *AddProjectMemberUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := AddProjectMemberUsergroupRequestValidationError{
-			field:  "Usergroup",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	if m.GetPageSize() != 0 {
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := AddProjectMemberUsergroupRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if m.GetPageSize() > 1000 {
+			err := ListProjectMemberUsergroupsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return AddProjectMemberUsergroupRequestMultiError(errors)
+		return ListProjectMemberUsergroupsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddProjectMemberUsergroupRequestMultiError is an error wrapping multiple
+// ListProjectMemberUsergroupsRequestMultiError is an error wrapping multiple
 // validation errors returned by
-// AddProjectMemberUsergroupRequest.ValidateAll() if the designated
+// ListProjectMemberUsergroupsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AddProjectMemberUsergroupRequestMultiError []error
+type ListProjectMemberUsergroupsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectMemberUsergroupRequestMultiError) Error() string {
+func (m ListProjectMemberUsergroupsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14845,12 +16244,12 @@ func (m AddProjectMemberUsergroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectMemberUsergroupRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectMemberUsergroupsRequestMultiError) AllErrors() []error { return m }
 
-// AddProjectMemberUsergroupRequestValidationError is the validation error
-// returned by AddProjectMemberUsergroupRequest.Validate if the designated
+// ListProjectMemberUsergroupsRequestValidationError is the validation error
+// returned by ListProjectMemberUsergroupsRequest.Validate if the designated
 // constraints aren't met.
-type AddProjectMemberUsergroupRequestValidationError struct {
+type ListProjectMemberUsergroupsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14858,24 +16257,24 @@ type AddProjectMemberUsergroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectMemberUsergroupRequestValidationError) Field() string { return e.field }
+func (e ListProjectMemberUsergroupsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectMemberUsergroupRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectMemberUsergroupsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectMemberUsergroupRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectMemberUsergroupsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectMemberUsergroupRequestValidationError) Key() bool { return e.key }
+func (e ListProjectMemberUsergroupsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectMemberUsergroupRequestValidationError) ErrorName() string {
-	return "AddProjectMemberUsergroupRequestValidationError"
+func (e ListProjectMemberUsergroupsRequestValidationError) ErrorName() string {
+	return "ListProjectMemberUsergroupsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddProjectMemberUsergroupRequestValidationError) Error() string {
+func (e ListProjectMemberUsergroupsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14887,14 +16286,14 @@ func (e AddProjectMemberUsergroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProjectMemberUsergroupRequest.%s: %s%s",
+		"invalid %sListProjectMemberUsergroupsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddProjectMemberUsergroupRequestValidationError{}
+var _ error = ListProjectMemberUsergroupsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14902,46 +16301,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProjectMemberUsergroupRequestValidationError{}
+} = ListProjectMemberUsergroupsRequestValidationError{}
 
-// Validate checks the field values on AddProjectMemberUsergroupResponse with
+// Validate checks the field values on ListProjectMemberUsergroupsResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *AddProjectMemberUsergroupResponse) Validate() error {
+func (m *ListProjectMemberUsergroupsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProjectMemberUsergroupResponse
+// ValidateAll checks the field values on ListProjectMemberUsergroupsResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// AddProjectMemberUsergroupResponseMultiError, or nil if none found.
-func (m *AddProjectMemberUsergroupResponse) ValidateAll() error {
+// ListProjectMemberUsergroupsResponseMultiError, or nil if none found.
+func (m *ListProjectMemberUsergroupsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProjectMemberUsergroupResponse) validate(all bool) error {
+func (m *ListProjectMemberUsergroupsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectMemberUsergroupsResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return AddProjectMemberUsergroupResponseMultiError(errors)
+		return ListProjectMemberUsergroupsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddProjectMemberUsergroupResponseMultiError is an error wrapping multiple
+// ListProjectMemberUsergroupsResponseMultiError is an error wrapping multiple
 // validation errors returned by
-// AddProjectMemberUsergroupResponse.ValidateAll() if the designated
+// ListProjectMemberUsergroupsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type AddProjectMemberUsergroupResponseMultiError []error
+type ListProjectMemberUsergroupsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProjectMemberUsergroupResponseMultiError) Error() string {
+func (m ListProjectMemberUsergroupsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14950,12 +16385,12 @@ func (m AddProjectMemberUsergroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProjectMemberUsergroupResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectMemberUsergroupsResponseMultiError) AllErrors() []error { return m }
 
-// AddProjectMemberUsergroupResponseValidationError is the validation error
-// returned by AddProjectMemberUsergroupResponse.Validate if the designated
+// ListProjectMemberUsergroupsResponseValidationError is the validation error
+// returned by ListProjectMemberUsergroupsResponse.Validate if the designated
 // constraints aren't met.
-type AddProjectMemberUsergroupResponseValidationError struct {
+type ListProjectMemberUsergroupsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14963,24 +16398,24 @@ type AddProjectMemberUsergroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProjectMemberUsergroupResponseValidationError) Field() string { return e.field }
+func (e ListProjectMemberUsergroupsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProjectMemberUsergroupResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectMemberUsergroupsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProjectMemberUsergroupResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectMemberUsergroupsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProjectMemberUsergroupResponseValidationError) Key() bool { return e.key }
+func (e ListProjectMemberUsergroupsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProjectMemberUsergroupResponseValidationError) ErrorName() string {
-	return "AddProjectMemberUsergroupResponseValidationError"
+func (e ListProjectMemberUsergroupsResponseValidationError) ErrorName() string {
+	return "ListProjectMemberUsergroupsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddProjectMemberUsergroupResponseValidationError) Error() string {
+func (e ListProjectMemberUsergroupsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14992,14 +16427,14 @@ func (e AddProjectMemberUsergroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProjectMemberUsergroupResponse.%s: %s%s",
+		"invalid %sListProjectMemberUsergroupsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddProjectMemberUsergroupResponseValidationError{}
+var _ error = ListProjectMemberUsergroupsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15007,25 +16442,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProjectMemberUsergroupResponseValidationError{}
+} = ListProjectMemberUsergroupsResponseValidationError{}
 
-// Validate checks the field values on SetProjectMemberUsergroupRoleRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *SetProjectMemberUsergroupRoleRequest) Validate() error {
+// Validate checks the field values on DeleteUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetProjectMemberUsergroupRoleRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// SetProjectMemberUsergroupRoleRequestMultiError, or nil if none found.
-func (m *SetProjectMemberUsergroupRoleRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUsergroupRequestMultiError, or nil if none found.
+func (m *DeleteUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetProjectMemberUsergroupRoleRequest) validate(all bool) error {
+func (m *DeleteUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15033,7 +16467,7 @@ func (m *SetProjectMemberUsergroupRoleRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := SetProjectMemberUsergroupRoleRequestValidationError{
+		err := DeleteUsergroupRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -15043,19 +16477,8 @@ func (m *SetProjectMemberUsergroupRoleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := SetProjectMemberUsergroupRoleRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := SetProjectMemberUsergroupRoleRequestValidationError{
+		err := DeleteUsergroupRequestValidationError{
 			field:  "Usergroup",
 			reason: "value length must be at least 1 runes",
 		}
@@ -15065,32 +16488,20 @@ func (m *SetProjectMemberUsergroupRoleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := SetProjectMemberUsergroupRoleRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return SetProjectMemberUsergroupRoleRequestMultiError(errors)
+		return DeleteUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetProjectMemberUsergroupRoleRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// SetProjectMemberUsergroupRoleRequest.ValidateAll() if the designated
+// DeleteUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SetProjectMemberUsergroupRoleRequestMultiError []error
+type DeleteUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetProjectMemberUsergroupRoleRequestMultiError) Error() string {
+func (m DeleteUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15099,12 +16510,113 @@ func (m SetProjectMemberUsergroupRoleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetProjectMemberUsergroupRoleRequestMultiError) AllErrors() []error { return m }
+func (m DeleteUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// SetProjectMemberUsergroupRoleRequestValidationError is the validation error
-// returned by SetProjectMemberUsergroupRoleRequest.Validate if the designated
+// DeleteUsergroupRequestValidationError is the validation error returned by
+// DeleteUsergroupRequest.Validate if the designated constraints aren't met.
+type DeleteUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUsergroupRequestValidationError) ErrorName() string {
+	return "DeleteUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUsergroupRequestValidationError{}
+
+// Validate checks the field values on DeleteUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUsergroupResponseMultiError, or nil if none found.
+func (m *DeleteUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteUsergroupResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SetProjectMemberUsergroupRoleRequestValidationError struct {
+type DeleteUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// DeleteUsergroupResponseValidationError is the validation error returned by
+// DeleteUsergroupResponse.Validate if the designated constraints aren't met.
+type DeleteUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15112,24 +16624,24 @@ type SetProjectMemberUsergroupRoleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetProjectMemberUsergroupRoleRequestValidationError) Field() string { return e.field }
+func (e DeleteUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetProjectMemberUsergroupRoleRequestValidationError) Reason() string { return e.reason }
+func (e DeleteUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetProjectMemberUsergroupRoleRequestValidationError) Cause() error { return e.cause }
+func (e DeleteUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetProjectMemberUsergroupRoleRequestValidationError) Key() bool { return e.key }
+func (e DeleteUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetProjectMemberUsergroupRoleRequestValidationError) ErrorName() string {
-	return "SetProjectMemberUsergroupRoleRequestValidationError"
+func (e DeleteUsergroupResponseValidationError) ErrorName() string {
+	return "DeleteUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetProjectMemberUsergroupRoleRequestValidationError) Error() string {
+func (e DeleteUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15141,14 +16653,14 @@ func (e SetProjectMemberUsergroupRoleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetProjectMemberUsergroupRoleRequest.%s: %s%s",
+		"invalid %sDeleteUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetProjectMemberUsergroupRoleRequestValidationError{}
+var _ error = DeleteUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15156,46 +16668,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetProjectMemberUsergroupRoleRequestValidationError{}
+} = DeleteUsergroupResponseValidationError{}
 
-// Validate checks the field values on SetProjectMemberUsergroupRoleResponse
+// Validate checks the field values on AddOrganizationMemberUsergroupRequest
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *SetProjectMemberUsergroupRoleResponse) Validate() error {
+func (m *AddOrganizationMemberUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetProjectMemberUsergroupRoleResponse
+// ValidateAll checks the field values on AddOrganizationMemberUsergroupRequest
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// SetProjectMemberUsergroupRoleResponseMultiError, or nil if none found.
-func (m *SetProjectMemberUsergroupRoleResponse) ValidateAll() error {
+// AddOrganizationMemberUsergroupRequestMultiError, or nil if none found.
+func (m *AddOrganizationMemberUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetProjectMemberUsergroupRoleResponse) validate(all bool) error {
+func (m *AddOrganizationMemberUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddOrganizationMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := AddOrganizationMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddOrganizationMemberUsergroupRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return SetProjectMemberUsergroupRoleResponseMultiError(errors)
+		return AddOrganizationMemberUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetProjectMemberUsergroupRoleResponseMultiError is an error wrapping
+// AddOrganizationMemberUsergroupRequestMultiError is an error wrapping
 // multiple validation errors returned by
-// SetProjectMemberUsergroupRoleResponse.ValidateAll() if the designated
+// AddOrganizationMemberUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SetProjectMemberUsergroupRoleResponseMultiError []error
+type AddOrganizationMemberUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetProjectMemberUsergroupRoleResponseMultiError) Error() string {
+func (m AddOrganizationMemberUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15204,12 +16749,12 @@ func (m SetProjectMemberUsergroupRoleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetProjectMemberUsergroupRoleResponseMultiError) AllErrors() []error { return m }
+func (m AddOrganizationMemberUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// SetProjectMemberUsergroupRoleResponseValidationError is the validation error
-// returned by SetProjectMemberUsergroupRoleResponse.Validate if the
+// AddOrganizationMemberUsergroupRequestValidationError is the validation error
+// returned by AddOrganizationMemberUsergroupRequest.Validate if the
 // designated constraints aren't met.
-type SetProjectMemberUsergroupRoleResponseValidationError struct {
+type AddOrganizationMemberUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15217,24 +16762,24 @@ type SetProjectMemberUsergroupRoleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetProjectMemberUsergroupRoleResponseValidationError) Field() string { return e.field }
+func (e AddOrganizationMemberUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetProjectMemberUsergroupRoleResponseValidationError) Reason() string { return e.reason }
+func (e AddOrganizationMemberUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetProjectMemberUsergroupRoleResponseValidationError) Cause() error { return e.cause }
+func (e AddOrganizationMemberUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetProjectMemberUsergroupRoleResponseValidationError) Key() bool { return e.key }
+func (e AddOrganizationMemberUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetProjectMemberUsergroupRoleResponseValidationError) ErrorName() string {
-	return "SetProjectMemberUsergroupRoleResponseValidationError"
+func (e AddOrganizationMemberUsergroupRequestValidationError) ErrorName() string {
+	return "AddOrganizationMemberUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetProjectMemberUsergroupRoleResponseValidationError) Error() string {
+func (e AddOrganizationMemberUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15246,14 +16791,14 @@ func (e SetProjectMemberUsergroupRoleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetProjectMemberUsergroupRoleResponse.%s: %s%s",
+		"invalid %sAddOrganizationMemberUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetProjectMemberUsergroupRoleResponseValidationError{}
+var _ error = AddOrganizationMemberUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15261,25 +16806,132 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetProjectMemberUsergroupRoleResponseValidationError{}
+} = AddOrganizationMemberUsergroupRequestValidationError{}
 
-// Validate checks the field values on RemoveProjectMemberUsergroupRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *RemoveProjectMemberUsergroupRequest) Validate() error {
+// Validate checks the field values on AddOrganizationMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AddOrganizationMemberUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectMemberUsergroupRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// RemoveProjectMemberUsergroupRequestMultiError, or nil if none found.
-func (m *RemoveProjectMemberUsergroupRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// AddOrganizationMemberUsergroupResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// AddOrganizationMemberUsergroupResponseMultiError, or nil if none found.
+func (m *AddOrganizationMemberUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectMemberUsergroupRequest) validate(all bool) error {
+func (m *AddOrganizationMemberUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddOrganizationMemberUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddOrganizationMemberUsergroupResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// AddOrganizationMemberUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddOrganizationMemberUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOrganizationMemberUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddOrganizationMemberUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// AddOrganizationMemberUsergroupResponseValidationError is the validation
+// error returned by AddOrganizationMemberUsergroupResponse.Validate if the
+// designated constraints aren't met.
+type AddOrganizationMemberUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddOrganizationMemberUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddOrganizationMemberUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddOrganizationMemberUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddOrganizationMemberUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddOrganizationMemberUsergroupResponseValidationError) ErrorName() string {
+	return "AddOrganizationMemberUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddOrganizationMemberUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddOrganizationMemberUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddOrganizationMemberUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddOrganizationMemberUsergroupResponseValidationError{}
+
+// Validate checks the field values on
+// SetOrganizationMemberUsergroupRoleRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetOrganizationMemberUsergroupRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SetOrganizationMemberUsergroupRoleRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// SetOrganizationMemberUsergroupRoleRequestMultiError, or nil if none found.
+func (m *SetOrganizationMemberUsergroupRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetOrganizationMemberUsergroupRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15287,7 +16939,7 @@ func (m *RemoveProjectMemberUsergroupRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveProjectMemberUsergroupRequestValidationError{
+		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -15297,9 +16949,20 @@ func (m *RemoveProjectMemberUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := RemoveProjectMemberUsergroupRequestValidationError{
-			field:  "Project",
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetOrganizationMemberUsergroupRoleRequestValidationError{
+			field:  "Role",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -15308,32 +16971,5798 @@ func (m *RemoveProjectMemberUsergroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := RemoveProjectMemberUsergroupRequestValidationError{
-			field:  "Usergroup",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return SetOrganizationMemberUsergroupRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetOrganizationMemberUsergroupRoleRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// SetOrganizationMemberUsergroupRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetOrganizationMemberUsergroupRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetOrganizationMemberUsergroupRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetOrganizationMemberUsergroupRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetOrganizationMemberUsergroupRoleRequestValidationError is the validation
+// error returned by SetOrganizationMemberUsergroupRoleRequest.Validate if the
+// designated constraints aren't met.
+type SetOrganizationMemberUsergroupRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) ErrorName() string {
+	return "SetOrganizationMemberUsergroupRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetOrganizationMemberUsergroupRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetOrganizationMemberUsergroupRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetOrganizationMemberUsergroupRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetOrganizationMemberUsergroupRoleRequestValidationError{}
+
+// Validate checks the field values on
+// SetOrganizationMemberUsergroupRoleResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetOrganizationMemberUsergroupRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// SetOrganizationMemberUsergroupRoleResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// SetOrganizationMemberUsergroupRoleResponseMultiError, or nil if none found.
+func (m *SetOrganizationMemberUsergroupRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetOrganizationMemberUsergroupRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetOrganizationMemberUsergroupRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetOrganizationMemberUsergroupRoleResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SetOrganizationMemberUsergroupRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetOrganizationMemberUsergroupRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetOrganizationMemberUsergroupRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetOrganizationMemberUsergroupRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetOrganizationMemberUsergroupRoleResponseValidationError is the validation
+// error returned by SetOrganizationMemberUsergroupRoleResponse.Validate if
+// the designated constraints aren't met.
+type SetOrganizationMemberUsergroupRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) ErrorName() string {
+	return "SetOrganizationMemberUsergroupRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetOrganizationMemberUsergroupRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetOrganizationMemberUsergroupRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetOrganizationMemberUsergroupRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetOrganizationMemberUsergroupRoleResponseValidationError{}
+
+// Validate checks the field values on RemoveOrganizationMemberUsergroupRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveOrganizationMemberUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// RemoveOrganizationMemberUsergroupRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// RemoveOrganizationMemberUsergroupRequestMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveOrganizationMemberUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveOrganizationMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveOrganizationMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveOrganizationMemberUsergroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveOrganizationMemberUsergroupRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveOrganizationMemberUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberUsergroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveOrganizationMemberUsergroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveOrganizationMemberUsergroupRequestMultiError) AllErrors() []error { return m }
+
+// RemoveOrganizationMemberUsergroupRequestValidationError is the validation
+// error returned by RemoveOrganizationMemberUsergroupRequest.Validate if the
+// designated constraints aren't met.
+type RemoveOrganizationMemberUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOrganizationMemberUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOrganizationMemberUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOrganizationMemberUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOrganizationMemberUsergroupRequestValidationError{}
+
+// Validate checks the field values on
+// RemoveOrganizationMemberUsergroupResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RemoveOrganizationMemberUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// RemoveOrganizationMemberUsergroupResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// RemoveOrganizationMemberUsergroupResponseMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveOrganizationMemberUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveOrganizationMemberUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveOrganizationMemberUsergroupResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveOrganizationMemberUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveOrganizationMemberUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveOrganizationMemberUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// RemoveOrganizationMemberUsergroupResponseValidationError is the validation
+// error returned by RemoveOrganizationMemberUsergroupResponse.Validate if the
+// designated constraints aren't met.
+type RemoveOrganizationMemberUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOrganizationMemberUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOrganizationMemberUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOrganizationMemberUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOrganizationMemberUsergroupResponseValidationError{}
+
+// Validate checks the field values on AddProjectMemberUsergroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddProjectMemberUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectMemberUsergroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddProjectMemberUsergroupRequestMultiError, or nil if none found.
+func (m *AddProjectMemberUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectMemberUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddProjectMemberUsergroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProjectMemberUsergroupRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AddProjectMemberUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectMemberUsergroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectMemberUsergroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectMemberUsergroupRequestMultiError) AllErrors() []error { return m }
+
+// AddProjectMemberUsergroupRequestValidationError is the validation error
+// returned by AddProjectMemberUsergroupRequest.Validate if the designated
+// constraints aren't met.
+type AddProjectMemberUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectMemberUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectMemberUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectMemberUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectMemberUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectMemberUsergroupRequestValidationError) ErrorName() string {
+	return "AddProjectMemberUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectMemberUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectMemberUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectMemberUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectMemberUsergroupRequestValidationError{}
+
+// Validate checks the field values on AddProjectMemberUsergroupResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddProjectMemberUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProjectMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AddProjectMemberUsergroupResponseMultiError, or nil if none found.
+func (m *AddProjectMemberUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProjectMemberUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddProjectMemberUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProjectMemberUsergroupResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// AddProjectMemberUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectMemberUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProjectMemberUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProjectMemberUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// AddProjectMemberUsergroupResponseValidationError is the validation error
+// returned by AddProjectMemberUsergroupResponse.Validate if the designated
+// constraints aren't met.
+type AddProjectMemberUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProjectMemberUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProjectMemberUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProjectMemberUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProjectMemberUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProjectMemberUsergroupResponseValidationError) ErrorName() string {
+	return "AddProjectMemberUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProjectMemberUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectMemberUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProjectMemberUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProjectMemberUsergroupResponseValidationError{}
+
+// Validate checks the field values on SetProjectMemberUsergroupRoleRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetProjectMemberUsergroupRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectMemberUsergroupRoleRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetProjectMemberUsergroupRoleRequestMultiError, or nil if none found.
+func (m *SetProjectMemberUsergroupRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectMemberUsergroupRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetProjectMemberUsergroupRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProjectMemberUsergroupRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetProjectMemberUsergroupRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetProjectMemberUsergroupRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectMemberUsergroupRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectMemberUsergroupRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetProjectMemberUsergroupRoleRequestValidationError is the validation error
+// returned by SetProjectMemberUsergroupRoleRequest.Validate if the designated
+// constraints aren't met.
+type SetProjectMemberUsergroupRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectMemberUsergroupRoleRequestValidationError) ErrorName() string {
+	return "SetProjectMemberUsergroupRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectMemberUsergroupRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectMemberUsergroupRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectMemberUsergroupRoleRequestValidationError{}
+
+// Validate checks the field values on SetProjectMemberUsergroupRoleResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetProjectMemberUsergroupRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetProjectMemberUsergroupRoleResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetProjectMemberUsergroupRoleResponseMultiError, or nil if none found.
+func (m *SetProjectMemberUsergroupRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetProjectMemberUsergroupRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetProjectMemberUsergroupRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetProjectMemberUsergroupRoleResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SetProjectMemberUsergroupRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetProjectMemberUsergroupRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectMemberUsergroupRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectMemberUsergroupRoleResponseMultiError) AllErrors() []error { return m }
+
+// SetProjectMemberUsergroupRoleResponseValidationError is the validation error
+// returned by SetProjectMemberUsergroupRoleResponse.Validate if the
+// designated constraints aren't met.
+type SetProjectMemberUsergroupRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) ErrorName() string {
+	return "SetProjectMemberUsergroupRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetProjectMemberUsergroupRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetProjectMemberUsergroupRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetProjectMemberUsergroupRoleResponseValidationError{}
+
+// Validate checks the field values on RemoveProjectMemberUsergroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveProjectMemberUsergroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProjectMemberUsergroupRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberUsergroupRequestMultiError, or nil if none found.
+func (m *RemoveProjectMemberUsergroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProjectMemberUsergroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveProjectMemberUsergroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveProjectMemberUsergroupRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveProjectMemberUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProjectMemberUsergroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProjectMemberUsergroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProjectMemberUsergroupRequestMultiError) AllErrors() []error { return m }
+
+// RemoveProjectMemberUsergroupRequestValidationError is the validation error
+// returned by RemoveProjectMemberUsergroupRequest.Validate if the designated
+// constraints aren't met.
+type RemoveProjectMemberUsergroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProjectMemberUsergroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProjectMemberUsergroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProjectMemberUsergroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProjectMemberUsergroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProjectMemberUsergroupRequestValidationError) ErrorName() string {
+	return "RemoveProjectMemberUsergroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProjectMemberUsergroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProjectMemberUsergroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProjectMemberUsergroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProjectMemberUsergroupRequestValidationError{}
+
+// Validate checks the field values on RemoveProjectMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveProjectMemberUsergroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProjectMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberUsergroupResponseMultiError, or nil if none found.
+func (m *RemoveProjectMemberUsergroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProjectMemberUsergroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveProjectMemberUsergroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveProjectMemberUsergroupResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveProjectMemberUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProjectMemberUsergroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProjectMemberUsergroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProjectMemberUsergroupResponseMultiError) AllErrors() []error { return m }
+
+// RemoveProjectMemberUsergroupResponseValidationError is the validation error
+// returned by RemoveProjectMemberUsergroupResponse.Validate if the designated
+// constraints aren't met.
+type RemoveProjectMemberUsergroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProjectMemberUsergroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProjectMemberUsergroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProjectMemberUsergroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProjectMemberUsergroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProjectMemberUsergroupResponseValidationError) ErrorName() string {
+	return "RemoveProjectMemberUsergroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProjectMemberUsergroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProjectMemberUsergroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProjectMemberUsergroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProjectMemberUsergroupResponseValidationError{}
+
+// Validate checks the field values on AddUsergroupMemberUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddUsergroupMemberUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddUsergroupMemberUserRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddUsergroupMemberUserRequestMultiError, or nil if none found.
+func (m *AddUsergroupMemberUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddUsergroupMemberUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddUsergroupMemberUserRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := AddUsergroupMemberUserRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AddUsergroupMemberUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddUsergroupMemberUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddUsergroupMemberUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AddUsergroupMemberUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AddUsergroupMemberUserRequestMultiError is an error wrapping multiple
+// validation errors returned by AddUsergroupMemberUserRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AddUsergroupMemberUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddUsergroupMemberUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddUsergroupMemberUserRequestMultiError) AllErrors() []error { return m }
+
+// AddUsergroupMemberUserRequestValidationError is the validation error
+// returned by AddUsergroupMemberUserRequest.Validate if the designated
+// constraints aren't met.
+type AddUsergroupMemberUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddUsergroupMemberUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddUsergroupMemberUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddUsergroupMemberUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddUsergroupMemberUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddUsergroupMemberUserRequestValidationError) ErrorName() string {
+	return "AddUsergroupMemberUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddUsergroupMemberUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddUsergroupMemberUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddUsergroupMemberUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddUsergroupMemberUserRequestValidationError{}
+
+// Validate checks the field values on AddUsergroupMemberUserResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddUsergroupMemberUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddUsergroupMemberUserResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddUsergroupMemberUserResponseMultiError, or nil if none found.
+func (m *AddUsergroupMemberUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddUsergroupMemberUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddUsergroupMemberUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddUsergroupMemberUserResponseMultiError is an error wrapping multiple
+// validation errors returned by AddUsergroupMemberUserResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AddUsergroupMemberUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddUsergroupMemberUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddUsergroupMemberUserResponseMultiError) AllErrors() []error { return m }
+
+// AddUsergroupMemberUserResponseValidationError is the validation error
+// returned by AddUsergroupMemberUserResponse.Validate if the designated
+// constraints aren't met.
+type AddUsergroupMemberUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddUsergroupMemberUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddUsergroupMemberUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddUsergroupMemberUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddUsergroupMemberUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddUsergroupMemberUserResponseValidationError) ErrorName() string {
+	return "AddUsergroupMemberUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddUsergroupMemberUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddUsergroupMemberUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddUsergroupMemberUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddUsergroupMemberUserResponseValidationError{}
+
+// Validate checks the field values on ListUsergroupMemberUsersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsergroupMemberUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsergroupMemberUsersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListUsergroupMemberUsersRequestMultiError, or nil if none found.
+func (m *ListUsergroupMemberUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsergroupMemberUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListUsergroupMemberUsersRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := ListUsergroupMemberUsersRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListUsergroupMemberUsersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListUsergroupMemberUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsergroupMemberUsersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListUsergroupMemberUsersRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListUsergroupMemberUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsergroupMemberUsersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsergroupMemberUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListUsergroupMemberUsersRequestValidationError is the validation error
+// returned by ListUsergroupMemberUsersRequest.Validate if the designated
+// constraints aren't met.
+type ListUsergroupMemberUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsergroupMemberUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsergroupMemberUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsergroupMemberUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsergroupMemberUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsergroupMemberUsersRequestValidationError) ErrorName() string {
+	return "ListUsergroupMemberUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsergroupMemberUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsergroupMemberUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsergroupMemberUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsergroupMemberUsersRequestValidationError{}
+
+// Validate checks the field values on ListUsergroupMemberUsersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListUsergroupMemberUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsergroupMemberUsersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListUsergroupMemberUsersResponseMultiError, or nil if none found.
+func (m *ListUsergroupMemberUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsergroupMemberUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsergroupMemberUsersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsergroupMemberUsersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsergroupMemberUsersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListUsergroupMemberUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsergroupMemberUsersResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListUsergroupMemberUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListUsergroupMemberUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsergroupMemberUsersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsergroupMemberUsersResponseMultiError) AllErrors() []error { return m }
+
+// ListUsergroupMemberUsersResponseValidationError is the validation error
+// returned by ListUsergroupMemberUsersResponse.Validate if the designated
+// constraints aren't met.
+type ListUsergroupMemberUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsergroupMemberUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsergroupMemberUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsergroupMemberUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsergroupMemberUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsergroupMemberUsersResponseValidationError) ErrorName() string {
+	return "ListUsergroupMemberUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsergroupMemberUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsergroupMemberUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsergroupMemberUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsergroupMemberUsersResponseValidationError{}
+
+// Validate checks the field values on RemoveUsergroupMemberUserRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveUsergroupMemberUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUsergroupMemberUserRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveUsergroupMemberUserRequestMultiError, or nil if none found.
+func (m *RemoveUsergroupMemberUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUsergroupMemberUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveUsergroupMemberUserRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveUsergroupMemberUserRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RemoveUsergroupMemberUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveUsergroupMemberUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveUsergroupMemberUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RemoveUsergroupMemberUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RemoveUsergroupMemberUserRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveUsergroupMemberUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveUsergroupMemberUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUsergroupMemberUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUsergroupMemberUserRequestMultiError) AllErrors() []error { return m }
+
+// RemoveUsergroupMemberUserRequestValidationError is the validation error
+// returned by RemoveUsergroupMemberUserRequest.Validate if the designated
+// constraints aren't met.
+type RemoveUsergroupMemberUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUsergroupMemberUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUsergroupMemberUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUsergroupMemberUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUsergroupMemberUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUsergroupMemberUserRequestValidationError) ErrorName() string {
+	return "RemoveUsergroupMemberUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUsergroupMemberUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUsergroupMemberUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUsergroupMemberUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUsergroupMemberUserRequestValidationError{}
+
+// Validate checks the field values on RemoveUsergroupMemberUserResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveUsergroupMemberUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUsergroupMemberUserResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveUsergroupMemberUserResponseMultiError, or nil if none found.
+func (m *RemoveUsergroupMemberUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUsergroupMemberUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveUsergroupMemberUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveUsergroupMemberUserResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveUsergroupMemberUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveUsergroupMemberUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUsergroupMemberUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUsergroupMemberUserResponseMultiError) AllErrors() []error { return m }
+
+// RemoveUsergroupMemberUserResponseValidationError is the validation error
+// returned by RemoveUsergroupMemberUserResponse.Validate if the designated
+// constraints aren't met.
+type RemoveUsergroupMemberUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUsergroupMemberUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUsergroupMemberUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUsergroupMemberUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUsergroupMemberUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUsergroupMemberUserResponseValidationError) ErrorName() string {
+	return "RemoveUsergroupMemberUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUsergroupMemberUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUsergroupMemberUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUsergroupMemberUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUsergroupMemberUserResponseValidationError{}
+
+// Validate checks the field values on GetCurrentUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCurrentUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCurrentUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCurrentUserRequestMultiError, or nil if none found.
+func (m *GetCurrentUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCurrentUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCurrentUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCurrentUserRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurrentUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCurrentUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
+
+// GetCurrentUserRequestValidationError is the validation error returned by
+// GetCurrentUserRequest.Validate if the designated constraints aren't met.
+type GetCurrentUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCurrentUserRequestValidationError) ErrorName() string {
+	return "GetCurrentUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCurrentUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCurrentUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCurrentUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCurrentUserRequestValidationError{}
+
+// Validate checks the field values on GetCurrentUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCurrentUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCurrentUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCurrentUserResponseMultiError, or nil if none found.
+func (m *GetCurrentUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCurrentUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCurrentUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCurrentUserResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCurrentUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCurrentUserResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurrentUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCurrentUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
+
+// GetCurrentUserResponseValidationError is the validation error returned by
+// GetCurrentUserResponse.Validate if the designated constraints aren't met.
+type GetCurrentUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCurrentUserResponseValidationError) ErrorName() string {
+	return "GetCurrentUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCurrentUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCurrentUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCurrentUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCurrentUserResponseValidationError{}
+
+// Validate checks the field values on GetUserRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserRequestMultiError,
+// or nil if none found.
+func (m *GetUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = GetUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *GetUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// GetUserRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRequestMultiError) AllErrors() []error { return m }
+
+// GetUserRequestValidationError is the validation error returned by
+// GetUserRequest.Validate if the designated constraints aren't met.
+type GetUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRequestValidationError{}
+
+// Validate checks the field values on GetUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserResponseMultiError, or nil if none found.
+func (m *GetUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserResponseMultiError) AllErrors() []error { return m }
+
+// GetUserResponseValidationError is the validation error returned by
+// GetUserResponse.Validate if the designated constraints aren't met.
+type GetUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserResponseValidationError{}
+
+// Validate checks the field values on UserPreferences with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPreferencesMultiError, or nil if none found.
+func (m *UserPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TimeZone != nil {
+		// no validation rules for TimeZone
+	}
+
+	if m.DefaultOrganization != nil {
+		// no validation rules for DefaultOrganization
+	}
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if len(errors) > 0 {
+		return UserPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPreferencesMultiError is an error wrapping multiple validation errors
+// returned by UserPreferences.ValidateAll() if the designated constraints
+// aren't met.
+type UserPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPreferencesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPreferencesMultiError) AllErrors() []error { return m }
+
+// UserPreferencesValidationError is the validation error returned by
+// UserPreferences.Validate if the designated constraints aren't met.
+type UserPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPreferencesValidationError{}
+
+// Validate checks the field values on UpdateUserPreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserPreferencesRequestMultiError, or nil if none found.
+func (m *UpdateUserPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesRequestValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdateFavoriteProjects
+
+	if len(errors) > 0 {
+		return UpdateUserPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateUserPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserPreferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// UpdateUserPreferencesRequestValidationError is the validation error returned
+// by UpdateUserPreferencesRequest.Validate if the designated constraints
+// aren't met.
+type UpdateUserPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
+	return "UpdateUserPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserPreferencesRequestValidationError{}
+
+// Validate checks the field values on UpdateUserPreferencesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserPreferencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserPreferencesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateUserPreferencesResponseMultiError, or nil if none found.
+func (m *UpdateUserPreferencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserPreferencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserPreferencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateUserPreferencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserPreferencesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
+
+// UpdateUserPreferencesResponseValidationError is the validation error
+// returned by UpdateUserPreferencesResponse.Validate if the designated
+// constraints aren't met.
+type UpdateUserPreferencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
+	return "UpdateUserPreferencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserPreferencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserPreferencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserPreferencesResponseValidationError{}
+
+// Validate checks the field values on StarProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StarProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StarProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StarProjectRequestMultiError, or nil if none found.
+func (m *StarProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StarProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := StarProjectRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := StarProjectRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StarProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StarProjectRequestMultiError is an error wrapping multiple validation errors
+// returned by StarProjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StarProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StarProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StarProjectRequestMultiError) AllErrors() []error { return m }
+
+// StarProjectRequestValidationError is the validation error returned by
+// StarProjectRequest.Validate if the designated constraints aren't met.
+type StarProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StarProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StarProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StarProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StarProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StarProjectRequestValidationError) ErrorName() string {
+	return "StarProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StarProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStarProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StarProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StarProjectRequestValidationError{}
+
+// Validate checks the field values on StarProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StarProjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StarProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StarProjectResponseMultiError, or nil if none found.
+func (m *StarProjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StarProjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StarProjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StarProjectResponseMultiError is an error wrapping multiple validation
+// errors returned by StarProjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StarProjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StarProjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StarProjectResponseMultiError) AllErrors() []error { return m }
+
+// StarProjectResponseValidationError is the validation error returned by
+// StarProjectResponse.Validate if the designated constraints aren't met.
+type StarProjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StarProjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StarProjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StarProjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StarProjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StarProjectResponseValidationError) ErrorName() string {
+	return "StarProjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StarProjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStarProjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StarProjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StarProjectResponseValidationError{}
+
+// Validate checks the field values on UnstarProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnstarProjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnstarProjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnstarProjectRequestMultiError, or nil if none found.
+func (m *UnstarProjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnstarProjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := UnstarProjectRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := UnstarProjectRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnstarProjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnstarProjectRequestMultiError is an error wrapping multiple validation
+// errors returned by UnstarProjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnstarProjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnstarProjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnstarProjectRequestMultiError) AllErrors() []error { return m }
+
+// UnstarProjectRequestValidationError is the validation error returned by
+// UnstarProjectRequest.Validate if the designated constraints aren't met.
+type UnstarProjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnstarProjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnstarProjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnstarProjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnstarProjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnstarProjectRequestValidationError) ErrorName() string {
+	return "UnstarProjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnstarProjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnstarProjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnstarProjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnstarProjectRequestValidationError{}
+
+// Validate checks the field values on UnstarProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnstarProjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnstarProjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnstarProjectResponseMultiError, or nil if none found.
+func (m *UnstarProjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnstarProjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnstarProjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnstarProjectResponseMultiError is an error wrapping multiple validation
+// errors returned by UnstarProjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnstarProjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnstarProjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnstarProjectResponseMultiError) AllErrors() []error { return m }
+
+// UnstarProjectResponseValidationError is the validation error returned by
+// UnstarProjectResponse.Validate if the designated constraints aren't met.
+type UnstarProjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnstarProjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnstarProjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnstarProjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnstarProjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnstarProjectResponseValidationError) ErrorName() string {
+	return "UnstarProjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnstarProjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnstarProjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnstarProjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnstarProjectResponseValidationError{}
+
+// Validate checks the field values on StarDashboardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StarDashboardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StarDashboardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StarDashboardRequestMultiError, or nil if none found.
+func (m *StarDashboardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StarDashboardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := StarDashboardRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := StarDashboardRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ResourceKind
+
+	if utf8.RuneCountInString(m.GetResourceName()) < 1 {
+		err := StarDashboardRequestValidationError{
+			field:  "ResourceName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StarDashboardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StarDashboardRequestMultiError is an error wrapping multiple validation
+// errors returned by StarDashboardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StarDashboardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StarDashboardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StarDashboardRequestMultiError) AllErrors() []error { return m }
+
+// StarDashboardRequestValidationError is the validation error returned by
+// StarDashboardRequest.Validate if the designated constraints aren't met.
+type StarDashboardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StarDashboardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StarDashboardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StarDashboardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StarDashboardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StarDashboardRequestValidationError) ErrorName() string {
+	return "StarDashboardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StarDashboardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStarDashboardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StarDashboardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StarDashboardRequestValidationError{}
+
+// Validate checks the field values on StarDashboardResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StarDashboardResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StarDashboardResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StarDashboardResponseMultiError, or nil if none found.
+func (m *StarDashboardResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StarDashboardResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDashboard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StarDashboardResponseValidationError{
+					field:  "Dashboard",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StarDashboardResponseValidationError{
+					field:  "Dashboard",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDashboard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StarDashboardResponseValidationError{
+				field:  "Dashboard",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StarDashboardResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StarDashboardResponseMultiError is an error wrapping multiple validation
+// errors returned by StarDashboardResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StarDashboardResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StarDashboardResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StarDashboardResponseMultiError) AllErrors() []error { return m }
+
+// StarDashboardResponseValidationError is the validation error returned by
+// StarDashboardResponse.Validate if the designated constraints aren't met.
+type StarDashboardResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StarDashboardResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StarDashboardResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StarDashboardResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StarDashboardResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StarDashboardResponseValidationError) ErrorName() string {
+	return "StarDashboardResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StarDashboardResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStarDashboardResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StarDashboardResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StarDashboardResponseValidationError{}
+
+// Validate checks the field values on UnstarDashboardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnstarDashboardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnstarDashboardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnstarDashboardRequestMultiError, or nil if none found.
+func (m *UnstarDashboardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnstarDashboardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := UnstarDashboardRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := UnstarDashboardRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ResourceKind
+
+	if utf8.RuneCountInString(m.GetResourceName()) < 1 {
+		err := UnstarDashboardRequestValidationError{
+			field:  "ResourceName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnstarDashboardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnstarDashboardRequestMultiError is an error wrapping multiple validation
+// errors returned by UnstarDashboardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnstarDashboardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnstarDashboardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnstarDashboardRequestMultiError) AllErrors() []error { return m }
+
+// UnstarDashboardRequestValidationError is the validation error returned by
+// UnstarDashboardRequest.Validate if the designated constraints aren't met.
+type UnstarDashboardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnstarDashboardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnstarDashboardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnstarDashboardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnstarDashboardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnstarDashboardRequestValidationError) ErrorName() string {
+	return "UnstarDashboardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnstarDashboardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnstarDashboardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnstarDashboardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnstarDashboardRequestValidationError{}
+
+// Validate checks the field values on UnstarDashboardResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnstarDashboardResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnstarDashboardResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnstarDashboardResponseMultiError, or nil if none found.
+func (m *UnstarDashboardResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnstarDashboardResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnstarDashboardResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnstarDashboardResponseMultiError is an error wrapping multiple validation
+// errors returned by UnstarDashboardResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnstarDashboardResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnstarDashboardResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnstarDashboardResponseMultiError) AllErrors() []error { return m }
+
+// UnstarDashboardResponseValidationError is the validation error returned by
+// UnstarDashboardResponse.Validate if the designated constraints aren't met.
+type UnstarDashboardResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnstarDashboardResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnstarDashboardResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnstarDashboardResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnstarDashboardResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnstarDashboardResponseValidationError) ErrorName() string {
+	return "UnstarDashboardResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnstarDashboardResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnstarDashboardResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnstarDashboardResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnstarDashboardResponseValidationError{}
+
+// Validate checks the field values on ListStarredRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStarredRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStarredRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStarredRequestMultiError, or nil if none found.
+func (m *ListStarredRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStarredRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	if len(errors) > 0 {
+		return ListStarredRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStarredRequestMultiError is an error wrapping multiple validation errors
+// returned by ListStarredRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListStarredRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStarredRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStarredRequestMultiError) AllErrors() []error { return m }
+
+// ListStarredRequestValidationError is the validation error returned by
+// ListStarredRequest.Validate if the designated constraints aren't met.
+type ListStarredRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStarredRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStarredRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStarredRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStarredRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStarredRequestValidationError) ErrorName() string {
+	return "ListStarredRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStarredRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStarredRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStarredRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStarredRequestValidationError{}
+
+// Validate checks the field values on ListStarredResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStarredResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStarredResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStarredResponseMultiError, or nil if none found.
+func (m *ListStarredResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStarredResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStarredResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStarredResponseValidationError{
+						field:  fmt.Sprintf("Projects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStarredResponseValidationError{
+					field:  fmt.Sprintf("Projects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDashboards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStarredResponseValidationError{
+						field:  fmt.Sprintf("Dashboards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStarredResponseValidationError{
+						field:  fmt.Sprintf("Dashboards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStarredResponseValidationError{
+					field:  fmt.Sprintf("Dashboards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListStarredResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStarredResponseMultiError is an error wrapping multiple validation
+// errors returned by ListStarredResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListStarredResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStarredResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStarredResponseMultiError) AllErrors() []error { return m }
+
+// ListStarredResponseValidationError is the validation error returned by
+// ListStarredResponse.Validate if the designated constraints aren't met.
+type ListStarredResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStarredResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStarredResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStarredResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStarredResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStarredResponseValidationError) ErrorName() string {
+	return "ListStarredResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStarredResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStarredResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStarredResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStarredResponseValidationError{}
+
+// Validate checks the field values on StarredDashboard with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StarredDashboard) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StarredDashboard with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StarredDashboardMultiError, or nil if none found.
+func (m *StarredDashboard) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StarredDashboard) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for ResourceKind
+
+	// no validation rules for ResourceName
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StarredDashboardValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StarredDashboardValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StarredDashboardValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StarredDashboardMultiError(errors)
+	}
+
+	return nil
+}
+
+// StarredDashboardMultiError is an error wrapping multiple validation errors
+// returned by StarredDashboard.ValidateAll() if the designated constraints
+// aren't met.
+type StarredDashboardMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StarredDashboardMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StarredDashboardMultiError) AllErrors() []error { return m }
+
+// StarredDashboardValidationError is the validation error returned by
+// StarredDashboard.Validate if the designated constraints aren't met.
+type StarredDashboardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StarredDashboardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StarredDashboardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StarredDashboardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StarredDashboardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StarredDashboardValidationError) ErrorName() string { return "StarredDashboardValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StarredDashboardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStarredDashboard.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StarredDashboardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StarredDashboardValidationError{}
+
+// Validate checks the field values on ListNotificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsRequestMultiError, or nil if none found.
+func (m *ListNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UnreadOnly
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListNotificationsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// ListNotificationsRequestValidationError is the validation error returned by
+// ListNotificationsRequest.Validate if the designated constraints aren't met.
+type ListNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsRequestValidationError) ErrorName() string {
+	return "ListNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsRequestValidationError{}
+
+// Validate checks the field values on ListNotificationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsResponseMultiError, or nil if none found.
+func (m *ListNotificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNotificationsResponseValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UnreadCount
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListNotificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListNotificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsResponseMultiError) AllErrors() []error { return m }
+
+// ListNotificationsResponseValidationError is the validation error returned by
+// ListNotificationsResponse.Validate if the designated constraints aren't met.
+type ListNotificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsResponseValidationError) ErrorName() string {
+	return "ListNotificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsResponseValidationError{}
+
+// Validate checks the field values on AckNotificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AckNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AckNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AckNotificationsRequestMultiError, or nil if none found.
+func (m *AckNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AckNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for All
+
+	if len(errors) > 0 {
+		return AckNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AckNotificationsRequestMultiError is an error wrapping multiple validation
+// errors returned by AckNotificationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AckNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AckNotificationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AckNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// AckNotificationsRequestValidationError is the validation error returned by
+// AckNotificationsRequest.Validate if the designated constraints aren't met.
+type AckNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AckNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AckNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AckNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AckNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AckNotificationsRequestValidationError) ErrorName() string {
+	return "AckNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AckNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAckNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AckNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AckNotificationsRequestValidationError{}
+
+// Validate checks the field values on AckNotificationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AckNotificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AckNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AckNotificationsResponseMultiError, or nil if none found.
+func (m *AckNotificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AckNotificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UnreadCount
+
+	if len(errors) > 0 {
+		return AckNotificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AckNotificationsResponseMultiError is an error wrapping multiple validation
+// errors returned by AckNotificationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AckNotificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AckNotificationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AckNotificationsResponseMultiError) AllErrors() []error { return m }
+
+// AckNotificationsResponseValidationError is the validation error returned by
+// AckNotificationsResponse.Validate if the designated constraints aren't met.
+type AckNotificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AckNotificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AckNotificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AckNotificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AckNotificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AckNotificationsResponseValidationError) ErrorName() string {
+	return "AckNotificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AckNotificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAckNotificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AckNotificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AckNotificationsResponseValidationError{}
+
+// Validate checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookmarksRequestMultiError, or nil if none found.
+func (m *ListBookmarksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookmarksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for ResourceKind
+
+	// no validation rules for ResourceName
+
+	if len(errors) > 0 {
+		return ListBookmarksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookmarksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
+
+// ListBookmarksRequestValidationError is the validation error returned by
+// ListBookmarksRequest.Validate if the designated constraints aren't met.
+type ListBookmarksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookmarksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookmarksRequestValidationError) ErrorName() string {
+	return "ListBookmarksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookmarksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookmarksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookmarksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookmarksRequestValidationError{}
+
+// Validate checks the field values on ListBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookmarksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookmarksResponseMultiError, or nil if none found.
+func (m *ListBookmarksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookmarksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBookmarks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBookmarksResponseValidationError{
+					field:  fmt.Sprintf("Bookmarks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBookmarksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookmarksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookmarksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
+
+// ListBookmarksResponseValidationError is the validation error returned by
+// ListBookmarksResponse.Validate if the designated constraints aren't met.
+type ListBookmarksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookmarksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookmarksResponseValidationError) ErrorName() string {
+	return "ListBookmarksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookmarksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookmarksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookmarksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookmarksResponseValidationError{}
+
+// Validate checks the field values on GetBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookmarkRequestMultiError, or nil if none found.
+func (m *GetBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BookmarkId
+
+	if len(errors) > 0 {
+		return GetBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBookmarkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// GetBookmarkRequestValidationError is the validation error returned by
+// GetBookmarkRequest.Validate if the designated constraints aren't met.
+type GetBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBookmarkRequestValidationError) ErrorName() string {
+	return "GetBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBookmarkRequestValidationError{}
+
+// Validate checks the field values on GetBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookmarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookmarkResponseMultiError, or nil if none found.
+func (m *GetBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBookmarkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookmarkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBookmarkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
+
+// GetBookmarkResponseValidationError is the validation error returned by
+// GetBookmarkResponse.Validate if the designated constraints aren't met.
+type GetBookmarkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBookmarkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBookmarkResponseValidationError) ErrorName() string {
+	return "GetBookmarkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBookmarkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBookmarkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBookmarkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBookmarkResponseValidationError{}
+
+// Validate checks the field values on CreateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBookmarkRequestMultiError, or nil if none found.
+func (m *CreateBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for Data
+
+	// no validation rules for ResourceKind
+
+	// no validation rules for ResourceName
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Default
+
+	// no validation rules for Shared
+
+	if len(errors) > 0 {
+		return CreateBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBookmarkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// CreateBookmarkRequestValidationError is the validation error returned by
+// CreateBookmarkRequest.Validate if the designated constraints aren't met.
+type CreateBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBookmarkRequestValidationError) ErrorName() string {
+	return "CreateBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBookmarkRequestValidationError{}
+
+// Validate checks the field values on CreateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBookmarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBookmarkResponseMultiError, or nil if none found.
+func (m *CreateBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBookmarkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBookmarkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBookmarkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
+
+// CreateBookmarkResponseValidationError is the validation error returned by
+// CreateBookmarkResponse.Validate if the designated constraints aren't met.
+type CreateBookmarkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBookmarkResponseValidationError) ErrorName() string {
+	return "CreateBookmarkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBookmarkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBookmarkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBookmarkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBookmarkResponseValidationError{}
+
+// Validate checks the field values on UpdateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookmarkRequestMultiError, or nil if none found.
+func (m *UpdateBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BookmarkId
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for Data
+
+	// no validation rules for Default
+
+	// no validation rules for Shared
+
+	if len(errors) > 0 {
+		return UpdateBookmarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookmarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookmarkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookmarkRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBookmarkRequestValidationError is the validation error returned by
+// UpdateBookmarkRequest.Validate if the designated constraints aren't met.
+type UpdateBookmarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookmarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookmarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookmarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookmarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookmarkRequestValidationError) ErrorName() string {
+	return "UpdateBookmarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookmarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookmarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookmarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookmarkRequestValidationError{}
+
+// Validate checks the field values on UpdateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookmarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookmarkResponseMultiError, or nil if none found.
+func (m *UpdateBookmarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookmarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateBookmarkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookmarkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookmarkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookmarkResponseMultiError) AllErrors() []error { return m }
+
+// UpdateBookmarkResponseValidationError is the validation error returned by
+// UpdateBookmarkResponse.Validate if the designated constraints aren't met.
+type UpdateBookmarkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookmarkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookmarkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookmarkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookmarkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookmarkResponseValidationError) ErrorName() string {
+	return "UpdateBookmarkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookmarkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookmarkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookmarkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookmarkResponseValidationError{}
+
+// Validate checks the field values on RemoveBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveBookmarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveBookmarkRequestMultiError, or nil if none found.
+func (m *RemoveBookmarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveBookmarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for BookmarkId
+
 	if len(errors) > 0 {
-		return RemoveProjectMemberUsergroupRequestMultiError(errors)
+		return RemoveBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveProjectMemberUsergroupRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// RemoveProjectMemberUsergroupRequest.ValidateAll() if the designated
+// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveProjectMemberUsergroupRequestMultiError []error
+type RemoveBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectMemberUsergroupRequestMultiError) Error() string {
+func (m RemoveBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15342,12 +22771,11 @@ func (m RemoveProjectMemberUsergroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectMemberUsergroupRequestMultiError) AllErrors() []error { return m }
+func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// RemoveProjectMemberUsergroupRequestValidationError is the validation error
-// returned by RemoveProjectMemberUsergroupRequest.Validate if the designated
-// constraints aren't met.
-type RemoveProjectMemberUsergroupRequestValidationError struct {
+// RemoveBookmarkRequestValidationError is the validation error returned by
+// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
+type RemoveBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15355,24 +22783,24 @@ type RemoveProjectMemberUsergroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectMemberUsergroupRequestValidationError) Field() string { return e.field }
+func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectMemberUsergroupRequestValidationError) Reason() string { return e.reason }
+func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectMemberUsergroupRequestValidationError) Cause() error { return e.cause }
+func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectMemberUsergroupRequestValidationError) Key() bool { return e.key }
+func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectMemberUsergroupRequestValidationError) ErrorName() string {
-	return "RemoveProjectMemberUsergroupRequestValidationError"
+func (e RemoveBookmarkRequestValidationError) ErrorName() string {
+	return "RemoveBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectMemberUsergroupRequestValidationError) Error() string {
+func (e RemoveBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15384,14 +22812,14 @@ func (e RemoveProjectMemberUsergroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectMemberUsergroupRequest.%s: %s%s",
+		"invalid %sRemoveBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectMemberUsergroupRequestValidationError{}
+var _ error = RemoveBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15399,25 +22827,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectMemberUsergroupRequestValidationError{}
+} = RemoveBookmarkRequestValidationError{}
 
-// Validate checks the field values on RemoveProjectMemberUsergroupResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *RemoveProjectMemberUsergroupResponse) Validate() error {
+// Validate checks the field values on RemoveBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectMemberUsergroupResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// RemoveProjectMemberUsergroupResponseMultiError, or nil if none found.
-func (m *RemoveProjectMemberUsergroupResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveBookmarkResponseMultiError, or nil if none found.
+func (m *RemoveBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectMemberUsergroupResponse) validate(all bool) error {
+func (m *RemoveBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15425,20 +22852,19 @@ func (m *RemoveProjectMemberUsergroupResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return RemoveProjectMemberUsergroupResponseMultiError(errors)
+		return RemoveBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveProjectMemberUsergroupResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// RemoveProjectMemberUsergroupResponse.ValidateAll() if the designated
+// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveProjectMemberUsergroupResponseMultiError []error
+type RemoveBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectMemberUsergroupResponseMultiError) Error() string {
+func (m RemoveBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15447,12 +22873,11 @@ func (m RemoveProjectMemberUsergroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectMemberUsergroupResponseMultiError) AllErrors() []error { return m }
+func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// RemoveProjectMemberUsergroupResponseValidationError is the validation error
-// returned by RemoveProjectMemberUsergroupResponse.Validate if the designated
-// constraints aren't met.
-type RemoveProjectMemberUsergroupResponseValidationError struct {
+// RemoveBookmarkResponseValidationError is the validation error returned by
+// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
+type RemoveBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15460,24 +22885,24 @@ type RemoveProjectMemberUsergroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectMemberUsergroupResponseValidationError) Field() string { return e.field }
+func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectMemberUsergroupResponseValidationError) Reason() string { return e.reason }
+func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectMemberUsergroupResponseValidationError) Cause() error { return e.cause }
+func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectMemberUsergroupResponseValidationError) Key() bool { return e.key }
+func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectMemberUsergroupResponseValidationError) ErrorName() string {
-	return "RemoveProjectMemberUsergroupResponseValidationError"
+func (e RemoveBookmarkResponseValidationError) ErrorName() string {
+	return "RemoveBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectMemberUsergroupResponseValidationError) Error() string {
+func (e RemoveBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15489,14 +22914,14 @@ func (e RemoveProjectMemberUsergroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectMemberUsergroupResponse.%s: %s%s",
+		"invalid %sRemoveBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectMemberUsergroupResponseValidationError{}
+var _ error = RemoveBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15504,44 +22929,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectMemberUsergroupResponseValidationError{}
+} = RemoveBookmarkResponseValidationError{}
 
-// Validate checks the field values on AddUsergroupMemberUserRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SearchUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddUsergroupMemberUserRequest) Validate() error {
+func (m *SearchUsersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddUsergroupMemberUserRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AddUsergroupMemberUserRequestMultiError, or nil if none found.
-func (m *AddUsergroupMemberUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on SearchUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchUsersRequestMultiError, or nil if none found.
+func (m *SearchUsersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddUsergroupMemberUserRequest) validate(all bool) error {
+func (m *SearchUsersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := AddUsergroupMemberUserRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := AddUsergroupMemberUserRequestValidationError{
-			field:  "Usergroup",
+	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
+		err := SearchUsersRequestValidationError{
+			field:  "EmailPattern",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -15550,82 +22964,37 @@ func (m *AddUsergroupMemberUserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = AddUsergroupMemberUserRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return AddUsergroupMemberUserRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *AddUsergroupMemberUserRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+	if m.GetPageSize() != 0 {
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+		if m.GetPageSize() > 1000 {
+			err := SearchUsersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-	}
-
-	return nil
-}
 
-func (m *AddUsergroupMemberUserRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
+	// no validation rules for PageToken
 
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return SearchUsersRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// AddUsergroupMemberUserRequestMultiError is an error wrapping multiple
-// validation errors returned by AddUsergroupMemberUserRequest.ValidateAll()
-// if the designated constraints aren't met.
-type AddUsergroupMemberUserRequestMultiError []error
+// SearchUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchUsersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddUsergroupMemberUserRequestMultiError) Error() string {
+func (m SearchUsersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15634,12 +23003,11 @@ func (m AddUsergroupMemberUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddUsergroupMemberUserRequestMultiError) AllErrors() []error { return m }
+func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
 
-// AddUsergroupMemberUserRequestValidationError is the validation error
-// returned by AddUsergroupMemberUserRequest.Validate if the designated
-// constraints aren't met.
-type AddUsergroupMemberUserRequestValidationError struct {
+// SearchUsersRequestValidationError is the validation error returned by
+// SearchUsersRequest.Validate if the designated constraints aren't met.
+type SearchUsersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15647,24 +23015,24 @@ type AddUsergroupMemberUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddUsergroupMemberUserRequestValidationError) Field() string { return e.field }
+func (e SearchUsersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddUsergroupMemberUserRequestValidationError) Reason() string { return e.reason }
+func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddUsergroupMemberUserRequestValidationError) Cause() error { return e.cause }
+func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddUsergroupMemberUserRequestValidationError) Key() bool { return e.key }
+func (e SearchUsersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddUsergroupMemberUserRequestValidationError) ErrorName() string {
-	return "AddUsergroupMemberUserRequestValidationError"
+func (e SearchUsersRequestValidationError) ErrorName() string {
+	return "SearchUsersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddUsergroupMemberUserRequestValidationError) Error() string {
+func (e SearchUsersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15676,14 +23044,14 @@ func (e AddUsergroupMemberUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddUsergroupMemberUserRequest.%s: %s%s",
+		"invalid %sSearchUsersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddUsergroupMemberUserRequestValidationError{}
+var _ error = SearchUsersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15691,44 +23059,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddUsergroupMemberUserRequestValidationError{}
+} = SearchUsersRequestValidationError{}
 
-// Validate checks the field values on AddUsergroupMemberUserResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SearchUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddUsergroupMemberUserResponse) Validate() error {
+func (m *SearchUsersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddUsergroupMemberUserResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AddUsergroupMemberUserResponseMultiError, or nil if none found.
-func (m *AddUsergroupMemberUserResponse) ValidateAll() error {
+// ValidateAll checks the field values on SearchUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchUsersResponseMultiError, or nil if none found.
+func (m *SearchUsersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddUsergroupMemberUserResponse) validate(all bool) error {
+func (m *SearchUsersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return AddUsergroupMemberUserResponseMultiError(errors)
+		return SearchUsersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddUsergroupMemberUserResponseMultiError is an error wrapping multiple
-// validation errors returned by AddUsergroupMemberUserResponse.ValidateAll()
-// if the designated constraints aren't met.
-type AddUsergroupMemberUserResponseMultiError []error
+// SearchUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchUsersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddUsergroupMemberUserResponseMultiError) Error() string {
+func (m SearchUsersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15737,12 +23141,11 @@ func (m AddUsergroupMemberUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddUsergroupMemberUserResponseMultiError) AllErrors() []error { return m }
+func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
 
-// AddUsergroupMemberUserResponseValidationError is the validation error
-// returned by AddUsergroupMemberUserResponse.Validate if the designated
-// constraints aren't met.
-type AddUsergroupMemberUserResponseValidationError struct {
+// SearchUsersResponseValidationError is the validation error returned by
+// SearchUsersResponse.Validate if the designated constraints aren't met.
+type SearchUsersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15750,24 +23153,24 @@ type AddUsergroupMemberUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddUsergroupMemberUserResponseValidationError) Field() string { return e.field }
+func (e SearchUsersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddUsergroupMemberUserResponseValidationError) Reason() string { return e.reason }
+func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddUsergroupMemberUserResponseValidationError) Cause() error { return e.cause }
+func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddUsergroupMemberUserResponseValidationError) Key() bool { return e.key }
+func (e SearchUsersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddUsergroupMemberUserResponseValidationError) ErrorName() string {
-	return "AddUsergroupMemberUserResponseValidationError"
+func (e SearchUsersResponseValidationError) ErrorName() string {
+	return "SearchUsersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddUsergroupMemberUserResponseValidationError) Error() string {
+func (e SearchUsersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15779,14 +23182,14 @@ func (e AddUsergroupMemberUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddUsergroupMemberUserResponse.%s: %s%s",
+		"invalid %sSearchUsersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddUsergroupMemberUserResponseValidationError{}
+var _ error = SearchUsersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15794,83 +23197,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddUsergroupMemberUserResponseValidationError{}
+} = SearchUsersResponseValidationError{}
 
-// Validate checks the field values on ListUsergroupMemberUsersRequest with the
+// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListUsergroupMemberUsersRequest) Validate() error {
+func (m *RevokeCurrentAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUsergroupMemberUsersRequest with
+// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListUsergroupMemberUsersRequestMultiError, or nil if none found.
-func (m *ListUsergroupMemberUsersRequest) ValidateAll() error {
+// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUsergroupMemberUsersRequest) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListUsergroupMemberUsersRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := ListUsergroupMemberUsersRequestValidationError{
-			field:  "Usergroup",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListUsergroupMemberUsersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return ListUsergroupMemberUsersRequestMultiError(errors)
+		return RevokeCurrentAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListUsergroupMemberUsersRequestMultiError is an error wrapping multiple
-// validation errors returned by ListUsergroupMemberUsersRequest.ValidateAll()
+// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
 // if the designated constraints aren't met.
-type ListUsergroupMemberUsersRequestMultiError []error
+type RevokeCurrentAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUsergroupMemberUsersRequestMultiError) Error() string {
+func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15879,12 +23243,12 @@ func (m ListUsergroupMemberUsersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUsergroupMemberUsersRequestMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// ListUsergroupMemberUsersRequestValidationError is the validation error
-// returned by ListUsergroupMemberUsersRequest.Validate if the designated
+// RevokeCurrentAuthTokenRequestValidationError is the validation error
+// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
 // constraints aren't met.
-type ListUsergroupMemberUsersRequestValidationError struct {
+type RevokeCurrentAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15892,24 +23256,24 @@ type ListUsergroupMemberUsersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUsergroupMemberUsersRequestValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUsergroupMemberUsersRequestValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUsergroupMemberUsersRequestValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUsergroupMemberUsersRequestValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUsergroupMemberUsersRequestValidationError) ErrorName() string {
-	return "ListUsergroupMemberUsersRequestValidationError"
+func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListUsergroupMemberUsersRequestValidationError) Error() string {
+func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15921,14 +23285,14 @@ func (e ListUsergroupMemberUsersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUsergroupMemberUsersRequest.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUsergroupMemberUsersRequestValidationError{}
+var _ error = RevokeCurrentAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15936,82 +23300,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUsergroupMemberUsersRequestValidationError{}
+} = RevokeCurrentAuthTokenRequestValidationError{}
 
-// Validate checks the field values on ListUsergroupMemberUsersResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ListUsergroupMemberUsersResponse) Validate() error {
+// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeCurrentAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUsergroupMemberUsersResponse with
+// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListUsergroupMemberUsersResponseMultiError, or nil if none found.
-func (m *ListUsergroupMemberUsersResponse) ValidateAll() error {
+// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUsergroupMemberUsersResponse) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMembers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListUsergroupMemberUsersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListUsergroupMemberUsersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListUsergroupMemberUsersResponseValidationError{
-					field:  fmt.Sprintf("Members[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
+	// no validation rules for TokenId
 
 	if len(errors) > 0 {
-		return ListUsergroupMemberUsersResponseMultiError(errors)
+		return RevokeCurrentAuthTokenResponseMultiError(errors)
 	}
 
-	return nil
-}
-
-// ListUsergroupMemberUsersResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// ListUsergroupMemberUsersResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListUsergroupMemberUsersResponseMultiError []error
-
+	return nil
+}
+
+// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeCurrentAuthTokenResponseMultiError []error
+
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUsergroupMemberUsersResponseMultiError) Error() string {
+func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16020,12 +23348,12 @@ func (m ListUsergroupMemberUsersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUsergroupMemberUsersResponseMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// ListUsergroupMemberUsersResponseValidationError is the validation error
-// returned by ListUsergroupMemberUsersResponse.Validate if the designated
+// RevokeCurrentAuthTokenResponseValidationError is the validation error
+// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
 // constraints aren't met.
-type ListUsergroupMemberUsersResponseValidationError struct {
+type RevokeCurrentAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16033,24 +23361,24 @@ type ListUsergroupMemberUsersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUsergroupMemberUsersResponseValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUsergroupMemberUsersResponseValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUsergroupMemberUsersResponseValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUsergroupMemberUsersResponseValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUsergroupMemberUsersResponseValidationError) ErrorName() string {
-	return "ListUsergroupMemberUsersResponseValidationError"
+func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListUsergroupMemberUsersResponseValidationError) Error() string {
+func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16062,14 +23390,14 @@ func (e ListUsergroupMemberUsersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUsergroupMemberUsersResponse.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUsergroupMemberUsersResponseValidationError{}
+var _ error = RevokeCurrentAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16077,55 +23405,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUsergroupMemberUsersResponseValidationError{}
+} = RevokeCurrentAuthTokenResponseValidationError{}
 
-// Validate checks the field values on RemoveUsergroupMemberUserRequest with
+// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *RemoveUsergroupMemberUserRequest) Validate() error {
+func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveUsergroupMemberUserRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RemoveUsergroupMemberUserRequestMultiError, or nil if none found.
-func (m *RemoveUsergroupMemberUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveUsergroupMemberUserRequest) validate(all bool) error {
+func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveUsergroupMemberUserRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
-		err := RemoveUsergroupMemberUserRequestValidationError{
-			field:  "Usergroup",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = RemoveUsergroupMemberUserRequestValidationError{
+		err = IssueRepresentativeAuthTokenRequestValidationError{
 			field:  "Email",
 			reason: "value must be a valid email address",
 			cause:  err,
@@ -16136,14 +23442,16 @@ func (m *RemoveUsergroupMemberUserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for TtlMinutes
+
 	if len(errors) > 0 {
-		return RemoveUsergroupMemberUserRequestMultiError(errors)
+		return IssueRepresentativeAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *RemoveUsergroupMemberUserRequest) _validateHostname(host string) error {
+func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
 	s := strings.ToLower(strings.TrimSuffix(host, "."))
 
 	if len(host) > 253 {
@@ -16173,7 +23481,7 @@ func (m *RemoveUsergroupMemberUserRequest) _validateHostname(host string) error
 	return nil
 }
 
-func (m *RemoveUsergroupMemberUserRequest) _validateEmail(addr string) error {
+func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
 	a, err := mail.ParseAddress(addr)
 	if err != nil {
 		return err
@@ -16193,14 +23501,14 @@ func (m *RemoveUsergroupMemberUserRequest) _validateEmail(addr string) error {
 	return m._validateHostname(parts[1])
 }
 
-// RemoveUsergroupMemberUserRequestMultiError is an error wrapping multiple
+// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
 // validation errors returned by
-// RemoveUsergroupMemberUserRequest.ValidateAll() if the designated
+// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveUsergroupMemberUserRequestMultiError []error
+type IssueRepresentativeAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveUsergroupMemberUserRequestMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16209,12 +23517,12 @@ func (m RemoveUsergroupMemberUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveUsergroupMemberUserRequestMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// RemoveUsergroupMemberUserRequestValidationError is the validation error
-// returned by RemoveUsergroupMemberUserRequest.Validate if the designated
+// IssueRepresentativeAuthTokenRequestValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
 // constraints aren't met.
-type RemoveUsergroupMemberUserRequestValidationError struct {
+type IssueRepresentativeAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16222,24 +23530,24 @@ type RemoveUsergroupMemberUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveUsergroupMemberUserRequestValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveUsergroupMemberUserRequestValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveUsergroupMemberUserRequestValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveUsergroupMemberUserRequestValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveUsergroupMemberUserRequestValidationError) ErrorName() string {
-	return "RemoveUsergroupMemberUserRequestValidationError"
+func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveUsergroupMemberUserRequestValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16251,14 +23559,14 @@ func (e RemoveUsergroupMemberUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveUsergroupMemberUserRequest.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveUsergroupMemberUserRequestValidationError{}
+var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16266,46 +23574,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveUsergroupMemberUserRequestValidationError{}
+} = IssueRepresentativeAuthTokenRequestValidationError{}
 
-// Validate checks the field values on RemoveUsergroupMemberUserResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *RemoveUsergroupMemberUserResponse) Validate() error {
+// Validate checks the field values on IssueRepresentativeAuthTokenResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveUsergroupMemberUserResponse
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// RemoveUsergroupMemberUserResponseMultiError, or nil if none found.
-func (m *RemoveUsergroupMemberUserResponse) ValidateAll() error {
+// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveUsergroupMemberUserResponse) validate(all bool) error {
+func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Token
+
 	if len(errors) > 0 {
-		return RemoveUsergroupMemberUserResponseMultiError(errors)
+		return IssueRepresentativeAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveUsergroupMemberUserResponseMultiError is an error wrapping multiple
+// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
 // validation errors returned by
-// RemoveUsergroupMemberUserResponse.ValidateAll() if the designated
+// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveUsergroupMemberUserResponseMultiError []error
+type IssueRepresentativeAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveUsergroupMemberUserResponseMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16314,12 +23624,12 @@ func (m RemoveUsergroupMemberUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveUsergroupMemberUserResponseMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// RemoveUsergroupMemberUserResponseValidationError is the validation error
-// returned by RemoveUsergroupMemberUserResponse.Validate if the designated
+// IssueRepresentativeAuthTokenResponseValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
 // constraints aren't met.
-type RemoveUsergroupMemberUserResponseValidationError struct {
+type IssueRepresentativeAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16327,24 +23637,24 @@ type RemoveUsergroupMemberUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveUsergroupMemberUserResponseValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveUsergroupMemberUserResponseValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveUsergroupMemberUserResponseValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveUsergroupMemberUserResponseValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveUsergroupMemberUserResponseValidationError) ErrorName() string {
-	return "RemoveUsergroupMemberUserResponseValidationError"
+func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveUsergroupMemberUserResponseValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16356,14 +23666,14 @@ func (e RemoveUsergroupMemberUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveUsergroupMemberUserResponse.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveUsergroupMemberUserResponseValidationError{}
+var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16371,44 +23681,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveUsergroupMemberUserResponseValidationError{}
+} = IssueRepresentativeAuthTokenResponseValidationError{}
 
-// Validate checks the field values on GetCurrentUserRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserRequest) Validate() error {
+func (m *RevokeServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCurrentUserRequestMultiError, or nil if none found.
-func (m *GetCurrentUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserRequest) validate(all bool) error {
+func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for TokenId
+
 	if len(errors) > 0 {
-		return GetCurrentUserRequestMultiError(errors)
+		return RevokeServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCurrentUserRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetCurrentUserRequestMultiError []error
+// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserRequestMultiError) Error() string {
+func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16417,11 +23729,12 @@ func (m GetCurrentUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserRequestValidationError is the validation error returned by
-// GetCurrentUserRequest.Validate if the designated constraints aren't met.
-type GetCurrentUserRequestValidationError struct {
+// RevokeServiceAuthTokenRequestValidationError is the validation error
+// returned by RevokeServiceAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16429,24 +23742,24 @@ type GetCurrentUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserRequestValidationError) ErrorName() string {
-	return "GetCurrentUserRequestValidationError"
+func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCurrentUserRequestValidationError) Error() string {
+func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16458,14 +23771,14 @@ func (e GetCurrentUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCurrentUserRequest.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCurrentUserRequestValidationError{}
+var _ error = RevokeServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16473,102 +23786,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCurrentUserRequestValidationError{}
+} = RevokeServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on GetCurrentUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeServiceAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserResponse) Validate() error {
+func (m *RevokeServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCurrentUserResponseMultiError, or nil if none found.
-func (m *GetCurrentUserResponse) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserResponse) validate(all bool) error {
+func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetCurrentUserResponseMultiError(errors)
+		return RevokeServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCurrentUserResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetCurrentUserResponseMultiError []error
+// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserResponseMultiError) Error() string {
+func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16577,11 +23832,12 @@ func (m GetCurrentUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserResponseValidationError is the validation error returned by
-// GetCurrentUserResponse.Validate if the designated constraints aren't met.
-type GetCurrentUserResponseValidationError struct {
+// RevokeServiceAuthTokenResponseValidationError is the validation error
+// returned by RevokeServiceAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16589,186 +23845,24 @@ type GetCurrentUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserResponseValidationError) ErrorName() string {
-	return "GetCurrentUserResponseValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e GetCurrentUserResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sGetCurrentUserResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = GetCurrentUserResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = GetCurrentUserResponseValidationError{}
-
-// Validate checks the field values on GetUserRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetUserRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GetUserRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetUserRequestMultiError,
-// or nil if none found.
-func (m *GetUserRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *GetUserRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = GetUserRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return GetUserRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *GetUserRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
-	}
-
-	return nil
-}
-
-func (m *GetUserRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// GetUserRequestMultiError is an error wrapping multiple validation errors
-// returned by GetUserRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m GetUserRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m GetUserRequestMultiError) AllErrors() []error { return m }
-
-// GetUserRequestValidationError is the validation error returned by
-// GetUserRequest.Validate if the designated constraints aren't met.
-type GetUserRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
+func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenResponseValidationError"
 }
 
-// Field function returns field value.
-func (e GetUserRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e GetUserRequestValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e GetUserRequestValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e GetUserRequestValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
-
 // Error satisfies the builtin error interface
-func (e GetUserRequestValidationError) Error() string {
+func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16780,14 +23874,14 @@ func (e GetUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserRequest.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserRequestValidationError{}
+var _ error = RevokeServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16795,73 +23889,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserRequestValidationError{}
+} = RevokeServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on GetUserResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetUserResponse) Validate() error {
+// Validate checks the field values on IssueServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserResponseMultiError, or nil if none found.
-func (m *GetUserResponse) ValidateAll() error {
+// IssueServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserResponse) validate(all bool) error {
+func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := IssueServiceAuthTokenRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for ServiceName
+
 	if len(errors) > 0 {
-		return GetUserResponseMultiError(errors)
+		return IssueServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserResponseMultiError is an error wrapping multiple validation errors
-// returned by GetUserResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserResponseMultiError []error
+// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type IssueServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserResponseMultiError) Error() string {
+func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16870,11 +23948,12 @@ func (m GetUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserResponseMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// GetUserResponseValidationError is the validation error returned by
-// GetUserResponse.Validate if the designated constraints aren't met.
-type GetUserResponseValidationError struct {
+// IssueServiceAuthTokenRequestValidationError is the validation error returned
+// by IssueServiceAuthTokenRequest.Validate if the designated constraints
+// aren't met.
+type IssueServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16882,22 +23961,24 @@ type GetUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserResponseValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserResponseValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserResponseValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserResponseValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
+func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserResponseValidationError) Error() string {
+func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16909,14 +23990,14 @@ func (e GetUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserResponse.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserResponseValidationError{}
+var _ error = IssueServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16924,48 +24005,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserResponseValidationError{}
+} = IssueServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on UserPreferences with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UserPreferences) Validate() error {
+// Validate checks the field values on IssueServiceAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserPreferences with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserPreferencesMultiError, or nil if none found.
-func (m *UserPreferences) ValidateAll() error {
+// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IssueServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserPreferences) validate(all bool) error {
+func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.TimeZone != nil {
-		// no validation rules for TimeZone
-	}
+	// no validation rules for Token
 
 	if len(errors) > 0 {
-		return UserPreferencesMultiError(errors)
+		return IssueServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserPreferencesMultiError is an error wrapping multiple validation errors
-// returned by UserPreferences.ValidateAll() if the designated constraints
-// aren't met.
-type UserPreferencesMultiError []error
+// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type IssueServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserPreferencesMultiError) Error() string {
+func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16974,11 +24053,12 @@ func (m UserPreferencesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserPreferencesMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// UserPreferencesValidationError is the validation error returned by
-// UserPreferences.Validate if the designated constraints aren't met.
-type UserPreferencesValidationError struct {
+// IssueServiceAuthTokenResponseValidationError is the validation error
+// returned by IssueServiceAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type IssueServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16986,22 +24066,24 @@ type UserPreferencesValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserPreferencesValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserPreferencesValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserPreferencesValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserPreferencesValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
+func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserPreferencesValidationError) Error() string {
+func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17013,14 +24095,14 @@ func (e UserPreferencesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserPreferences.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserPreferencesValidationError{}
+var _ error = IssueServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17028,73 +24110,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserPreferencesValidationError{}
+} = IssueServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesRequest with the
+// Validate checks the field values on ListServiceAuthTokensRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesRequest) Validate() error {
+func (m *ListServiceAuthTokensRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
+// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesRequestMultiError, or nil if none found.
-func (m *UpdateUserPreferencesRequest) ValidateAll() error {
+// ListServiceAuthTokensRequestMultiError, or nil if none found.
+func (m *ListServiceAuthTokensRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserPreferencesRequest) validate(all bool) error {
+func (m *ListServiceAuthTokensRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := ListServiceAuthTokensRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesRequestValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for ServiceName
+
 	if len(errors) > 0 {
-		return UpdateUserPreferencesRequestMultiError(errors)
+		return ListServiceAuthTokensRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
+// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
 // the designated constraints aren't met.
-type UpdateUserPreferencesRequestMultiError []error
+type ListServiceAuthTokensRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesRequestMultiError) Error() string {
+func (m ListServiceAuthTokensRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17103,12 +24169,12 @@ func (m UpdateUserPreferencesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesRequestValidationError is the validation error returned
-// by UpdateUserPreferencesRequest.Validate if the designated constraints
+// ListServiceAuthTokensRequestValidationError is the validation error returned
+// by ListServiceAuthTokensRequest.Validate if the designated constraints
 // aren't met.
-type UpdateUserPreferencesRequestValidationError struct {
+type ListServiceAuthTokensRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17116,24 +24182,24 @@ type UpdateUserPreferencesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
-	return "UpdateUserPreferencesRequestValidationError"
+func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
+	return "ListServiceAuthTokensRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesRequestValidationError) Error() string {
+func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17145,14 +24211,14 @@ func (e UpdateUserPreferencesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
+		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesRequestValidationError{}
+var _ error = ListServiceAuthTokensRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17160,73 +24226,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesRequestValidationError{}
+} = ListServiceAuthTokensRequestValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesResponse with the
+// Validate checks the field values on ListServiceAuthTokensResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesResponse) Validate() error {
+func (m *ListServiceAuthTokensResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserPreferencesResponse with
+// ValidateAll checks the field values on ListServiceAuthTokensResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesResponseMultiError, or nil if none found.
-func (m *UpdateUserPreferencesResponse) ValidateAll() error {
+// ListServiceAuthTokensResponseMultiError, or nil if none found.
+func (m *ListServiceAuthTokensResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserPreferencesResponse) validate(all bool) error {
+func (m *ListServiceAuthTokensResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
+				return ListServiceAuthTokensResponseValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesResponseValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateUserPreferencesResponseMultiError(errors)
+		return ListServiceAuthTokensResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
+// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
 // if the designated constraints aren't met.
-type UpdateUserPreferencesResponseMultiError []error
+type ListServiceAuthTokensResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesResponseMultiError) Error() string {
+func (m ListServiceAuthTokensResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17235,12 +24306,12 @@ func (m UpdateUserPreferencesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesResponseValidationError is the validation error
-// returned by UpdateUserPreferencesResponse.Validate if the designated
+// ListServiceAuthTokensResponseValidationError is the validation error
+// returned by ListServiceAuthTokensResponse.Validate if the designated
 // constraints aren't met.
-type UpdateUserPreferencesResponseValidationError struct {
+type ListServiceAuthTokensResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17248,24 +24319,24 @@ type UpdateUserPreferencesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
-	return "UpdateUserPreferencesResponseValidationError"
+func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
+	return "ListServiceAuthTokensResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesResponseValidationError) Error() string {
+func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17277,14 +24348,14 @@ func (e UpdateUserPreferencesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
+		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesResponseValidationError{}
+var _ error = ListServiceAuthTokensResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17292,50 +24363,99 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesResponseValidationError{}
+} = ListServiceAuthTokensResponseValidationError{}
 
-// Validate checks the field values on ListBookmarksRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on IssueMagicAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksRequest) Validate() error {
+func (m *IssueMagicAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on IssueMagicAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBookmarksRequestMultiError, or nil if none found.
-func (m *ListBookmarksRequest) ValidateAll() error {
+// IssueMagicAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueMagicAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksRequest) validate(all bool) error {
+func (m *IssueMagicAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := IssueMagicAuthTokenRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for ResourceKind
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := IssueMagicAuthTokenRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for ResourceName
+	// no validation rules for TtlMinutes
+
+	// no validation rules for MetricsView
+
+	if all {
+		switch v := interface{}(m.GetMetricsViewFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueMagicAuthTokenRequestValidationError{
+					field:  "MetricsViewFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueMagicAuthTokenRequestValidationError{
+					field:  "MetricsViewFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetricsViewFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueMagicAuthTokenRequestValidationError{
+				field:  "MetricsViewFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListBookmarksRequestMultiError(errors)
+		return IssueMagicAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksRequestMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListBookmarksRequestMultiError []error
+// IssueMagicAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by IssueMagicAuthTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type IssueMagicAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksRequestMultiError) Error() string {
+func (m IssueMagicAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17344,11 +24464,11 @@ func (m ListBookmarksRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
+func (m IssueMagicAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// ListBookmarksRequestValidationError is the validation error returned by
-// ListBookmarksRequest.Validate if the designated constraints aren't met.
-type ListBookmarksRequestValidationError struct {
+// IssueMagicAuthTokenRequestValidationError is the validation error returned
+// by IssueMagicAuthTokenRequest.Validate if the designated constraints aren't met.
+type IssueMagicAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17356,24 +24476,24 @@ type ListBookmarksRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksRequestValidationError) Field() string { return e.field }
+func (e IssueMagicAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
+func (e IssueMagicAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
+func (e IssueMagicAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
+func (e IssueMagicAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksRequestValidationError) ErrorName() string {
-	return "ListBookmarksRequestValidationError"
+func (e IssueMagicAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueMagicAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksRequestValidationError) Error() string {
+func (e IssueMagicAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17385,14 +24505,14 @@ func (e ListBookmarksRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksRequest.%s: %s%s",
+		"invalid %sIssueMagicAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksRequestValidationError{}
+var _ error = IssueMagicAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17400,78 +24520,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksRequestValidationError{}
-
-// Validate checks the field values on ListBookmarksResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on ListBookmarksResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListBookmarksResponseMultiError, or nil if none found.
-func (m *ListBookmarksResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *ListBookmarksResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	for idx, item := range m.GetBookmarks() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListBookmarksResponseValidationError{
-					field:  fmt.Sprintf("Bookmarks[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+} = IssueMagicAuthTokenRequestValidationError{}
 
+// Validate checks the field values on IssueMagicAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueMagicAuthTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueMagicAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueMagicAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueMagicAuthTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueMagicAuthTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for Url
+
 	if len(errors) > 0 {
-		return ListBookmarksResponseMultiError(errors)
+		return IssueMagicAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksResponseMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListBookmarksResponseMultiError []error
+// IssueMagicAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by IssueMagicAuthTokenResponse.ValidateAll() if
+// the designated constraints aren't met.
+type IssueMagicAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksResponseMultiError) Error() string {
+func (m IssueMagicAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17480,11 +24570,12 @@ func (m ListBookmarksResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
+func (m IssueMagicAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// ListBookmarksResponseValidationError is the validation error returned by
-// ListBookmarksResponse.Validate if the designated constraints aren't met.
-type ListBookmarksResponseValidationError struct {
+// IssueMagicAuthTokenResponseValidationError is the validation error returned
+// by IssueMagicAuthTokenResponse.Validate if the designated constraints
+// aren't met.
+type IssueMagicAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17492,24 +24583,24 @@ type ListBookmarksResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksResponseValidationError) Field() string { return e.field }
+func (e IssueMagicAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
+func (e IssueMagicAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
+func (e IssueMagicAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
+func (e IssueMagicAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksResponseValidationError) ErrorName() string {
-	return "ListBookmarksResponseValidationError"
+func (e IssueMagicAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueMagicAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksResponseValidationError) Error() string {
+func (e IssueMagicAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17521,14 +24612,14 @@ func (e ListBookmarksResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksResponse.%s: %s%s",
+		"invalid %sIssueMagicAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksResponseValidationError{}
+var _ error = IssueMagicAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17536,46 +24627,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksResponseValidationError{}
+} = IssueMagicAuthTokenResponseValidationError{}
 
-// Validate checks the field values on GetBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListMagicAuthTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkRequest) Validate() error {
+func (m *ListMagicAuthTokensRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListMagicAuthTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBookmarkRequestMultiError, or nil if none found.
-func (m *GetBookmarkRequest) ValidateAll() error {
+// ListMagicAuthTokensRequestMultiError, or nil if none found.
+func (m *ListMagicAuthTokensRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkRequest) validate(all bool) error {
+func (m *ListMagicAuthTokensRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListMagicAuthTokensRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListMagicAuthTokensRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListMagicAuthTokensRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return GetBookmarkRequestMultiError(errors)
+		return ListMagicAuthTokensRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
-// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetBookmarkRequestMultiError []error
+// ListMagicAuthTokensRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMagicAuthTokensRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListMagicAuthTokensRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkRequestMultiError) Error() string {
+func (m ListMagicAuthTokensRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17584,11 +24712,11 @@ func (m GetBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m ListMagicAuthTokensRequestMultiError) AllErrors() []error { return m }
 
-// GetBookmarkRequestValidationError is the validation error returned by
-// GetBookmarkRequest.Validate if the designated constraints aren't met.
-type GetBookmarkRequestValidationError struct {
+// ListMagicAuthTokensRequestValidationError is the validation error returned
+// by ListMagicAuthTokensRequest.Validate if the designated constraints aren't met.
+type ListMagicAuthTokensRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17596,24 +24724,24 @@ type GetBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkRequestValidationError) Field() string { return e.field }
+func (e ListMagicAuthTokensRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e ListMagicAuthTokensRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e ListMagicAuthTokensRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
+func (e ListMagicAuthTokensRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkRequestValidationError) ErrorName() string {
-	return "GetBookmarkRequestValidationError"
+func (e ListMagicAuthTokensRequestValidationError) ErrorName() string {
+	return "ListMagicAuthTokensRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkRequestValidationError) Error() string {
+func (e ListMagicAuthTokensRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17625,14 +24753,14 @@ func (e GetBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkRequest.%s: %s%s",
+		"invalid %sListMagicAuthTokensRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkRequestValidationError{}
+var _ error = ListMagicAuthTokensRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17640,73 +24768,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkRequestValidationError{}
+} = ListMagicAuthTokensRequestValidationError{}
 
-// Validate checks the field values on GetBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListMagicAuthTokensResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkResponse) Validate() error {
+func (m *ListMagicAuthTokensResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListMagicAuthTokensResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBookmarkResponseMultiError, or nil if none found.
-func (m *GetBookmarkResponse) ValidateAll() error {
+// ListMagicAuthTokensResponseMultiError, or nil if none found.
+func (m *ListMagicAuthTokensResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkResponse) validate(all bool) error {
+func (m *ListMagicAuthTokensResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMagicAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMagicAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
+				return ListMagicAuthTokensResponseValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return GetBookmarkResponseMultiError(errors)
+		return ListMagicAuthTokensResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by GetBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetBookmarkResponseMultiError []error
+// ListMagicAuthTokensResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMagicAuthTokensResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListMagicAuthTokensResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkResponseMultiError) Error() string {
+func (m ListMagicAuthTokensResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17715,11 +24850,12 @@ func (m GetBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m ListMagicAuthTokensResponseMultiError) AllErrors() []error { return m }
 
-// GetBookmarkResponseValidationError is the validation error returned by
-// GetBookmarkResponse.Validate if the designated constraints aren't met.
-type GetBookmarkResponseValidationError struct {
+// ListMagicAuthTokensResponseValidationError is the validation error returned
+// by ListMagicAuthTokensResponse.Validate if the designated constraints
+// aren't met.
+type ListMagicAuthTokensResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17727,24 +24863,24 @@ type GetBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkResponseValidationError) Field() string { return e.field }
+func (e ListMagicAuthTokensResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e ListMagicAuthTokensResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e ListMagicAuthTokensResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
+func (e ListMagicAuthTokensResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkResponseValidationError) ErrorName() string {
-	return "GetBookmarkResponseValidationError"
+func (e ListMagicAuthTokensResponseValidationError) ErrorName() string {
+	return "ListMagicAuthTokensResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkResponseValidationError) Error() string {
+func (e ListMagicAuthTokensResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17756,14 +24892,14 @@ func (e GetBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkResponse.%s: %s%s",
+		"invalid %sListMagicAuthTokensResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkResponseValidationError{}
+var _ error = ListMagicAuthTokensResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17771,60 +24907,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkResponseValidationError{}
+} = ListMagicAuthTokensResponseValidationError{}
 
-// Validate checks the field values on CreateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeMagicAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkRequest) Validate() error {
+func (m *RevokeMagicAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeMagicAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateBookmarkRequestMultiError, or nil if none found.
-func (m *CreateBookmarkRequest) ValidateAll() error {
+// RevokeMagicAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeMagicAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkRequest) validate(all bool) error {
+func (m *RevokeMagicAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DisplayName
-
-	// no validation rules for Description
-
-	// no validation rules for Data
-
-	// no validation rules for ResourceKind
-
-	// no validation rules for ResourceName
-
-	// no validation rules for ProjectId
-
-	// no validation rules for Default
-
-	// no validation rules for Shared
+	// no validation rules for TokenId
 
 	if len(errors) > 0 {
-		return CreateBookmarkRequestMultiError(errors)
+		return RevokeMagicAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateBookmarkRequestMultiError []error
+// RevokeMagicAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeMagicAuthTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeMagicAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkRequestMultiError) Error() string {
+func (m RevokeMagicAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17833,11 +24955,12 @@ func (m CreateBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m RevokeMagicAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkRequestValidationError is the validation error returned by
-// CreateBookmarkRequest.Validate if the designated constraints aren't met.
-type CreateBookmarkRequestValidationError struct {
+// RevokeMagicAuthTokenRequestValidationError is the validation error returned
+// by RevokeMagicAuthTokenRequest.Validate if the designated constraints
+// aren't met.
+type RevokeMagicAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17845,24 +24968,24 @@ type CreateBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
+func (e RevokeMagicAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e RevokeMagicAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e RevokeMagicAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
+func (e RevokeMagicAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkRequestValidationError) ErrorName() string {
-	return "CreateBookmarkRequestValidationError"
+func (e RevokeMagicAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeMagicAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkRequestValidationError) Error() string {
+func (e RevokeMagicAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17874,14 +24997,14 @@ func (e CreateBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkRequest.%s: %s%s",
+		"invalid %sRevokeMagicAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkRequestValidationError{}
+var _ error = RevokeMagicAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17889,73 +25012,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkRequestValidationError{}
+} = RevokeMagicAuthTokenRequestValidationError{}
 
-// Validate checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeMagicAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkResponse) Validate() error {
+func (m *RevokeMagicAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeMagicAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateBookmarkResponseMultiError, or nil if none found.
-func (m *CreateBookmarkResponse) ValidateAll() error {
+// RevokeMagicAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeMagicAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkResponse) validate(all bool) error {
+func (m *RevokeMagicAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return CreateBookmarkResponseMultiError(errors)
+		return RevokeMagicAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateBookmarkResponseMultiError []error
+// RevokeMagicAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeMagicAuthTokenResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeMagicAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkResponseMultiError) Error() string {
+func (m RevokeMagicAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17964,11 +25058,12 @@ func (m CreateBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m RevokeMagicAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkResponseValidationError is the validation error returned by
-// CreateBookmarkResponse.Validate if the designated constraints aren't met.
-type CreateBookmarkResponseValidationError struct {
+// RevokeMagicAuthTokenResponseValidationError is the validation error returned
+// by RevokeMagicAuthTokenResponse.Validate if the designated constraints
+// aren't met.
+type RevokeMagicAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17976,24 +25071,24 @@ type CreateBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
+func (e RevokeMagicAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e RevokeMagicAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e RevokeMagicAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
+func (e RevokeMagicAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkResponseValidationError) ErrorName() string {
-	return "CreateBookmarkResponseValidationError"
+func (e RevokeMagicAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeMagicAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkResponseValidationError) Error() string {
+func (e RevokeMagicAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18005,14 +25100,14 @@ func (e CreateBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkResponse.%s: %s%s",
+		"invalid %sRevokeMagicAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkResponseValidationError{}
+var _ error = RevokeMagicAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18020,56 +25115,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkResponseValidationError{}
+} = RevokeMagicAuthTokenResponseValidationError{}
 
-// Validate checks the field values on UpdateBookmarkRequest with the rules
+// Validate checks the field values on IssueProjectTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookmarkRequest) Validate() error {
+func (m *IssueProjectTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on IssueProjectTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateBookmarkRequestMultiError, or nil if none found.
-func (m *UpdateBookmarkRequest) ValidateAll() error {
+// IssueProjectTokenRequestMultiError, or nil if none found.
+func (m *IssueProjectTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookmarkRequest) validate(all bool) error {
+func (m *IssueProjectTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
-
-	// no validation rules for DisplayName
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := IssueProjectTokenRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Description
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := IssueProjectTokenRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Data
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := IssueProjectTokenRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Default
+	// no validation rules for DisplayName
 
-	// no validation rules for Shared
+	if m.GetTtlMinutes() < 0 {
+		err := IssueProjectTokenRequestValidationError{
+			field:  "TtlMinutes",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return UpdateBookmarkRequestMultiError(errors)
+		return IssueProjectTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateBookmarkRequest.ValidateAll() if the designated
+// IssueProjectTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by IssueProjectTokenRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateBookmarkRequestMultiError []error
+type IssueProjectTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookmarkRequestMultiError) Error() string {
+func (m IssueProjectTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18078,11 +25207,11 @@ func (m UpdateBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m IssueProjectTokenRequestMultiError) AllErrors() []error { return m }
 
-// UpdateBookmarkRequestValidationError is the validation error returned by
-// UpdateBookmarkRequest.Validate if the designated constraints aren't met.
-type UpdateBookmarkRequestValidationError struct {
+// IssueProjectTokenRequestValidationError is the validation error returned by
+// IssueProjectTokenRequest.Validate if the designated constraints aren't met.
+type IssueProjectTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18090,24 +25219,24 @@ type UpdateBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookmarkRequestValidationError) Field() string { return e.field }
+func (e IssueProjectTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e IssueProjectTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e IssueProjectTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookmarkRequestValidationError) Key() bool { return e.key }
+func (e IssueProjectTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookmarkRequestValidationError) ErrorName() string {
-	return "UpdateBookmarkRequestValidationError"
+func (e IssueProjectTokenRequestValidationError) ErrorName() string {
+	return "IssueProjectTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookmarkRequestValidationError) Error() string {
+func (e IssueProjectTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18119,14 +25248,14 @@ func (e UpdateBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookmarkRequest.%s: %s%s",
+		"invalid %sIssueProjectTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookmarkRequestValidationError{}
+var _ error = IssueProjectTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18134,44 +25263,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookmarkRequestValidationError{}
+} = IssueProjectTokenRequestValidationError{}
 
-// Validate checks the field values on UpdateBookmarkResponse with the rules
+// Validate checks the field values on IssueProjectTokenResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookmarkResponse) Validate() error {
+func (m *IssueProjectTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on IssueProjectTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateBookmarkResponseMultiError, or nil if none found.
-func (m *UpdateBookmarkResponse) ValidateAll() error {
+// IssueProjectTokenResponseMultiError, or nil if none found.
+func (m *IssueProjectTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookmarkResponse) validate(all bool) error {
+func (m *IssueProjectTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
+	var errors []error
+
+	// no validation rules for Token
+
+	if all {
+		switch v := interface{}(m.GetProjectToken()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueProjectTokenResponseValidationError{
+					field:  "ProjectToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueProjectTokenResponseValidationError{
+					field:  "ProjectToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProjectToken()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueProjectTokenResponseValidationError{
+				field:  "ProjectToken",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return UpdateBookmarkResponseMultiError(errors)
+		return IssueProjectTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateBookmarkResponseMultiError []error
+// IssueProjectTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by IssueProjectTokenResponse.ValidateAll() if the
+// designated constraints aren't met.
+type IssueProjectTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookmarkResponseMultiError) Error() string {
+func (m IssueProjectTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18180,11 +25340,11 @@ func (m UpdateBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m IssueProjectTokenResponseMultiError) AllErrors() []error { return m }
 
-// UpdateBookmarkResponseValidationError is the validation error returned by
-// UpdateBookmarkResponse.Validate if the designated constraints aren't met.
-type UpdateBookmarkResponseValidationError struct {
+// IssueProjectTokenResponseValidationError is the validation error returned by
+// IssueProjectTokenResponse.Validate if the designated constraints aren't met.
+type IssueProjectTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18192,24 +25352,24 @@ type UpdateBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookmarkResponseValidationError) Field() string { return e.field }
+func (e IssueProjectTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e IssueProjectTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e IssueProjectTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookmarkResponseValidationError) Key() bool { return e.key }
+func (e IssueProjectTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookmarkResponseValidationError) ErrorName() string {
-	return "UpdateBookmarkResponseValidationError"
+func (e IssueProjectTokenResponseValidationError) ErrorName() string {
+	return "IssueProjectTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookmarkResponseValidationError) Error() string {
+func (e IssueProjectTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18221,14 +25381,14 @@ func (e UpdateBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookmarkResponse.%s: %s%s",
+		"invalid %sIssueProjectTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookmarkResponseValidationError{}
+var _ error = IssueProjectTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18236,46 +25396,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookmarkResponseValidationError{}
+} = IssueProjectTokenResponseValidationError{}
 
-// Validate checks the field values on RemoveBookmarkRequest with the rules
+// Validate checks the field values on ListProjectTokensRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkRequest) Validate() error {
+func (m *ListProjectTokensRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListProjectTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkRequestMultiError, or nil if none found.
-func (m *RemoveBookmarkRequest) ValidateAll() error {
+// ListProjectTokensRequestMultiError, or nil if none found.
+func (m *ListProjectTokensRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveBookmarkRequest) validate(all bool) error {
+func (m *ListProjectTokensRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectTokensRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectTokensRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListProjectTokensRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return RemoveBookmarkRequestMultiError(errors)
+		return ListProjectTokensRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
+// ListProjectTokensRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProjectTokensRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveBookmarkRequestMultiError []error
+type ListProjectTokensRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkRequestMultiError) Error() string {
+func (m ListProjectTokensRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18284,11 +25481,11 @@ func (m RemoveBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectTokensRequestMultiError) AllErrors() []error { return m }
 
-// RemoveBookmarkRequestValidationError is the validation error returned by
-// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
-type RemoveBookmarkRequestValidationError struct {
+// ListProjectTokensRequestValidationError is the validation error returned by
+// ListProjectTokensRequest.Validate if the designated constraints aren't met.
+type ListProjectTokensRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18296,24 +25493,24 @@ type RemoveBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
+func (e ListProjectTokensRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectTokensRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectTokensRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
+func (e ListProjectTokensRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveBookmarkRequestValidationError) ErrorName() string {
-	return "RemoveBookmarkRequestValidationError"
+func (e ListProjectTokensRequestValidationError) ErrorName() string {
+	return "ListProjectTokensRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveBookmarkRequestValidationError) Error() string {
+func (e ListProjectTokensRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18325,14 +25522,14 @@ func (e RemoveBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkRequest.%s: %s%s",
+		"invalid %sListProjectTokensRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveBookmarkRequestValidationError{}
+var _ error = ListProjectTokensRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18340,44 +25537,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveBookmarkRequestValidationError{}
+} = ListProjectTokensRequestValidationError{}
 
-// Validate checks the field values on RemoveBookmarkResponse with the rules
+// Validate checks the field values on ListProjectTokensResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkResponse) Validate() error {
+func (m *ListProjectTokensResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListProjectTokensResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkResponseMultiError, or nil if none found.
-func (m *RemoveBookmarkResponse) ValidateAll() error {
+// ListProjectTokensResponseMultiError, or nil if none found.
+func (m *ListProjectTokensResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveBookmarkResponse) validate(all bool) error {
+func (m *ListProjectTokensResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectTokensResponseValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return RemoveBookmarkResponseMultiError(errors)
+		return ListProjectTokensResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RemoveBookmarkResponseMultiError []error
+// ListProjectTokensResponseMultiError is an error wrapping multiple validation
+// errors returned by ListProjectTokensResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListProjectTokensResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkResponseMultiError) Error() string {
+func (m ListProjectTokensResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18386,11 +25619,11 @@ func (m RemoveBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectTokensResponseMultiError) AllErrors() []error { return m }
 
-// RemoveBookmarkResponseValidationError is the validation error returned by
-// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
-type RemoveBookmarkResponseValidationError struct {
+// ListProjectTokensResponseValidationError is the validation error returned by
+// ListProjectTokensResponse.Validate if the designated constraints aren't met.
+type ListProjectTokensResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18398,24 +25631,24 @@ type RemoveBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
+func (e ListProjectTokensResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectTokensResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectTokensResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
+func (e ListProjectTokensResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveBookmarkResponseValidationError) ErrorName() string {
-	return "RemoveBookmarkResponseValidationError"
+func (e ListProjectTokensResponseValidationError) ErrorName() string {
+	return "ListProjectTokensResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveBookmarkResponseValidationError) Error() string {
+func (e ListProjectTokensResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18427,14 +25660,14 @@ func (e RemoveBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkResponse.%s: %s%s",
+		"invalid %sListProjectTokensResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveBookmarkResponseValidationError{}
+var _ error = ListProjectTokensResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18442,33 +25675,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveBookmarkResponseValidationError{}
+} = ListProjectTokensResponseValidationError{}
 
-// Validate checks the field values on SearchUsersRequest with the rules
+// Validate checks the field values on RevokeProjectTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersRequest) Validate() error {
+func (m *RevokeProjectTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeProjectTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SearchUsersRequestMultiError, or nil if none found.
-func (m *SearchUsersRequest) ValidateAll() error {
+// RevokeProjectTokenRequestMultiError, or nil if none found.
+func (m *RevokeProjectTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchUsersRequest) validate(all bool) error {
+func (m *RevokeProjectTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
-		err := SearchUsersRequestValidationError{
-			field:  "EmailPattern",
+	if utf8.RuneCountInString(m.GetTokenId()) < 1 {
+		err := RevokeProjectTokenRequestValidationError{
+			field:  "TokenId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -18477,37 +25710,20 @@ func (m *SearchUsersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := SearchUsersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return SearchUsersRequestMultiError(errors)
+		return RevokeProjectTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersRequestMultiError is an error wrapping multiple validation errors
-// returned by SearchUsersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SearchUsersRequestMultiError []error
+// RevokeProjectTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeProjectTokenRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RevokeProjectTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersRequestMultiError) Error() string {
+func (m RevokeProjectTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18516,11 +25732,11 @@ func (m SearchUsersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
+func (m RevokeProjectTokenRequestMultiError) AllErrors() []error { return m }
 
-// SearchUsersRequestValidationError is the validation error returned by
-// SearchUsersRequest.Validate if the designated constraints aren't met.
-type SearchUsersRequestValidationError struct {
+// RevokeProjectTokenRequestValidationError is the validation error returned by
+// RevokeProjectTokenRequest.Validate if the designated constraints aren't met.
+type RevokeProjectTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18528,24 +25744,24 @@ type SearchUsersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersRequestValidationError) Field() string { return e.field }
+func (e RevokeProjectTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
+func (e RevokeProjectTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
+func (e RevokeProjectTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersRequestValidationError) Key() bool { return e.key }
+func (e RevokeProjectTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersRequestValidationError) ErrorName() string {
-	return "SearchUsersRequestValidationError"
+func (e RevokeProjectTokenRequestValidationError) ErrorName() string {
+	return "RevokeProjectTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersRequestValidationError) Error() string {
+func (e RevokeProjectTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18557,14 +25773,14 @@ func (e SearchUsersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersRequest.%s: %s%s",
+		"invalid %sRevokeProjectTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersRequestValidationError{}
+var _ error = RevokeProjectTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18572,80 +25788,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersRequestValidationError{}
+} = RevokeProjectTokenRequestValidationError{}
 
-// Validate checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeProjectTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersResponse) Validate() error {
+func (m *RevokeProjectTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeProjectTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SearchUsersResponseMultiError, or nil if none found.
-func (m *SearchUsersResponse) ValidateAll() error {
+// RevokeProjectTokenResponseMultiError, or nil if none found.
+func (m *RevokeProjectTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchUsersResponse) validate(all bool) error {
+func (m *RevokeProjectTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SearchUsersResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return SearchUsersResponseMultiError(errors)
+		return RevokeProjectTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersResponseMultiError is an error wrapping multiple validation
-// errors returned by SearchUsersResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SearchUsersResponseMultiError []error
+// RevokeProjectTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeProjectTokenResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeProjectTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersResponseMultiError) Error() string {
+func (m RevokeProjectTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18654,11 +25834,11 @@ func (m SearchUsersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
+func (m RevokeProjectTokenResponseMultiError) AllErrors() []error { return m }
 
-// SearchUsersResponseValidationError is the validation error returned by
-// SearchUsersResponse.Validate if the designated constraints aren't met.
-type SearchUsersResponseValidationError struct {
+// RevokeProjectTokenResponseValidationError is the validation error returned
+// by RevokeProjectTokenResponse.Validate if the designated constraints aren't met.
+type RevokeProjectTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18666,24 +25846,24 @@ type SearchUsersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersResponseValidationError) Field() string { return e.field }
+func (e RevokeProjectTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
+func (e RevokeProjectTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
+func (e RevokeProjectTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersResponseValidationError) Key() bool { return e.key }
+func (e RevokeProjectTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersResponseValidationError) ErrorName() string {
-	return "SearchUsersResponseValidationError"
+func (e RevokeProjectTokenResponseValidationError) ErrorName() string {
+	return "RevokeProjectTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersResponseValidationError) Error() string {
+func (e RevokeProjectTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18695,14 +25875,14 @@ func (e SearchUsersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersResponse.%s: %s%s",
+		"invalid %sRevokeProjectTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersResponseValidationError{}
+var _ error = RevokeProjectTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18710,44 +25890,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersResponseValidationError{}
+} = RevokeProjectTokenResponseValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
+// Validate checks the field values on GetGithubRepoStatusRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenRequest) Validate() error {
+func (m *GetGithubRepoStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGithubRepoStatusRequestMultiError, or nil if none found.
+func (m *GetGithubRepoStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
+func (m *GetGithubRepoStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for GithubUrl
+
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenRequestMultiError(errors)
+		return GetGithubRepoStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeCurrentAuthTokenRequestMultiError []error
+// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubRepoStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
+func (m GetGithubRepoStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18756,12 +25938,11 @@ func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenRequestValidationError is the validation error
-// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
-// constraints aren't met.
-type RevokeCurrentAuthTokenRequestValidationError struct {
+// GetGithubRepoStatusRequestValidationError is the validation error returned
+// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
+type GetGithubRepoStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18769,24 +25950,24 @@ type RevokeCurrentAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenRequestValidationError"
+func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
+	return "GetGithubRepoStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
+func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18798,14 +25979,14 @@ func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
+		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenRequestValidationError{}
+var _ error = GetGithubRepoStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18813,46 +25994,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenRequestValidationError{}
+} = GetGithubRepoStatusRequestValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
+// Validate checks the field values on GetGithubRepoStatusResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenResponse) Validate() error {
+func (m *GetGithubRepoStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGithubRepoStatusResponseMultiError, or nil if none found.
+func (m *GetGithubRepoStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
+func (m *GetGithubRepoStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	// no validation rules for HasAccess
+
+	// no validation rules for GrantAccessUrl
+
+	// no validation rules for DefaultBranch
 
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenResponseMultiError(errors)
+		return GetGithubRepoStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeCurrentAuthTokenResponseMultiError []error
+// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubRepoStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
+func (m GetGithubRepoStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18861,12 +26046,12 @@ func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenResponseValidationError is the validation error
-// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type RevokeCurrentAuthTokenResponseValidationError struct {
+// GetGithubRepoStatusResponseValidationError is the validation error returned
+// by GetGithubRepoStatusResponse.Validate if the designated constraints
+// aren't met.
+type GetGithubRepoStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18874,24 +26059,24 @@ type RevokeCurrentAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenResponseValidationError"
+func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
+	return "GetGithubRepoStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
+func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18903,14 +26088,14 @@ func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
+		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenResponseValidationError{}
+var _ error = GetGithubRepoStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18918,110 +26103,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenResponseValidationError{}
+} = GetGithubRepoStatusResponseValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
+// Validate checks the field values on GetGithubUserStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGithubUserStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetGithubUserStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGithubUserStatusRequestMultiError, or nil if none found.
+func (m *GetGithubUserStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
+func (m *GetGithubUserStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = IssueRepresentativeAuthTokenRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for TtlMinutes
-
 	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+		return GetGithubUserStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenRequestMultiError []error
+// GetGithubUserStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetGithubUserStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubUserStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
+func (m GetGithubUserStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19030,12 +26149,11 @@ func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m GetGithubUserStatusRequestMultiError) AllErrors() []error { return m }
 
-// IssueRepresentativeAuthTokenRequestValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenRequestValidationError struct {
+// GetGithubUserStatusRequestValidationError is the validation error returned
+// by GetGithubUserStatusRequest.Validate if the designated constraints aren't met.
+type GetGithubUserStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19043,24 +26161,24 @@ type IssueRepresentativeAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
+func (e GetGithubUserStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e GetGithubUserStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e GetGithubUserStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e GetGithubUserStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenRequestValidationError"
+func (e GetGithubUserStatusRequestValidationError) ErrorName() string {
+	return "GetGithubUserStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
+func (e GetGithubUserStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19072,14 +26190,14 @@ func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
+		"invalid %sGetGithubUserStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
+var _ error = GetGithubUserStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19087,48 +26205,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenRequestValidationError{}
+} = GetGithubUserStatusRequestValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
+// Validate checks the field values on GetGithubUserStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetGithubUserStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetGithubUserStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGithubUserStatusResponseMultiError, or nil if none found.
+func (m *GetGithubUserStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
+func (m *GetGithubUserStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	// no validation rules for HasAccess
+
+	// no validation rules for GrantAccessUrl
+
+	// no validation rules for AccessToken
+
+	// no validation rules for Account
+
+	// no validation rules for UserInstallationPermission
+
+	// no validation rules for OrganizationInstallationPermissions
 
 	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenResponseMultiError(errors)
+		return GetGithubUserStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenResponseMultiError []error
+// GetGithubUserStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetGithubUserStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubUserStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
+func (m GetGithubUserStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19137,12 +26263,12 @@ func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m GetGithubUserStatusResponseMultiError) AllErrors() []error { return m }
 
-// IssueRepresentativeAuthTokenResponseValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenResponseValidationError struct {
+// GetGithubUserStatusResponseValidationError is the validation error returned
+// by GetGithubUserStatusResponse.Validate if the designated constraints
+// aren't met.
+type GetGithubUserStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19150,24 +26276,24 @@ type IssueRepresentativeAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
+func (e GetGithubUserStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e GetGithubUserStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e GetGithubUserStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e GetGithubUserStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenResponseValidationError"
+func (e GetGithubUserStatusResponseValidationError) ErrorName() string {
+	return "GetGithubUserStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
+func (e GetGithubUserStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19179,14 +26305,14 @@ func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
+		"invalid %sGetGithubUserStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
+var _ error = GetGithubUserStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19194,46 +26320,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenResponseValidationError{}
+} = GetGithubUserStatusResponseValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenRequest with the
+// Validate checks the field values on GetCloneCredentialsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenRequest) Validate() error {
+func (m *GetCloneCredentialsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetCloneCredentialsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCloneCredentialsRequestMultiError, or nil if none found.
+func (m *GetCloneCredentialsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
+func (m *GetCloneCredentialsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := GetCloneCredentialsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := GetCloneCredentialsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenRequestMultiError(errors)
+		return GetCloneCredentialsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeServiceAuthTokenRequestMultiError []error
+// GetCloneCredentialsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCloneCredentialsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetCloneCredentialsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
+func (m GetCloneCredentialsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19242,12 +26388,11 @@ func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m GetCloneCredentialsRequestMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenRequestValidationError is the validation error
-// returned by RevokeServiceAuthTokenRequest.Validate if the designated
-// constraints aren't met.
-type RevokeServiceAuthTokenRequestValidationError struct {
+// GetCloneCredentialsRequestValidationError is the validation error returned
+// by GetCloneCredentialsRequest.Validate if the designated constraints aren't met.
+type GetCloneCredentialsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19255,24 +26400,24 @@ type RevokeServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e GetCloneCredentialsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e GetCloneCredentialsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e GetCloneCredentialsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e GetCloneCredentialsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenRequestValidationError"
+func (e GetCloneCredentialsRequestValidationError) ErrorName() string {
+	return "GetCloneCredentialsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
+func (e GetCloneCredentialsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19284,14 +26429,14 @@ func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sGetCloneCredentialsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenRequestValidationError{}
+var _ error = GetCloneCredentialsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19299,44 +26444,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenRequestValidationError{}
+} = GetCloneCredentialsRequestValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenResponse with the
+// Validate checks the field values on GetCloneCredentialsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenResponse) Validate() error {
+func (m *GetCloneCredentialsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetCloneCredentialsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCloneCredentialsResponseMultiError, or nil if none found.
+func (m *GetCloneCredentialsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
+func (m *GetCloneCredentialsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for GitRepoUrl
+
+	// no validation rules for GitUsername
+
+	// no validation rules for GitPassword
+
+	// no validation rules for GitSubpath
+
+	// no validation rules for GitProdBranch
+
+	// no validation rules for ArchiveDownloadUrl
+
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenResponseMultiError(errors)
+		return GetCloneCredentialsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeServiceAuthTokenResponseMultiError []error
+// GetCloneCredentialsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetCloneCredentialsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetCloneCredentialsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
+func (m GetCloneCredentialsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19345,12 +26502,12 @@ func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m GetCloneCredentialsResponseMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenResponseValidationError is the validation error
-// returned by RevokeServiceAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type RevokeServiceAuthTokenResponseValidationError struct {
+// GetCloneCredentialsResponseValidationError is the validation error returned
+// by GetCloneCredentialsResponse.Validate if the designated constraints
+// aren't met.
+type GetCloneCredentialsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19358,24 +26515,24 @@ type RevokeServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e GetCloneCredentialsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e GetCloneCredentialsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e GetCloneCredentialsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e GetCloneCredentialsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenResponseValidationError"
+func (e GetCloneCredentialsResponseValidationError) ErrorName() string {
+	return "GetCloneCredentialsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
+func (e GetCloneCredentialsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19387,14 +26544,14 @@ func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sGetCloneCredentialsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenResponseValidationError{}
+var _ error = GetCloneCredentialsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19402,33 +26559,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenResponseValidationError{}
+} = GetCloneCredentialsResponseValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenRequest with the
+// Validate checks the field values on CreateWhitelistedDomainRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenRequest) Validate() error {
+func (m *CreateWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
+func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := IssueServiceAuthTokenRequestValidationError{
-			field:  "OrganizationName",
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -19437,22 +26594,42 @@ func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ServiceName
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenRequestMultiError(errors)
+		return CreateWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
-// the designated constraints aren't met.
-type IssueServiceAuthTokenRequestMultiError []error
+// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenRequestMultiError) Error() string {
+func (m CreateWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19461,12 +26638,12 @@ func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenRequestValidationError is the validation error returned
-// by IssueServiceAuthTokenRequest.Validate if the designated constraints
-// aren't met.
-type IssueServiceAuthTokenRequestValidationError struct {
+// CreateWhitelistedDomainRequestValidationError is the validation error
+// returned by CreateWhitelistedDomainRequest.Validate if the designated
+// constraints aren't met.
+type CreateWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19474,24 +26651,24 @@ type IssueServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenRequestValidationError"
+func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenRequestValidationError) Error() string {
+func (e CreateWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19503,14 +26680,14 @@ func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenRequestValidationError{}
+var _ error = CreateWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19518,46 +26695,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenRequestValidationError{}
+} = CreateWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenResponse with the
+// Validate checks the field values on CreateWhitelistedDomainResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenResponse) Validate() error {
+func (m *CreateWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
+// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
+// CreateWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
+func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
-
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenResponseMultiError(errors)
+		return CreateWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
+// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
 // if the designated constraints aren't met.
-type IssueServiceAuthTokenResponseMultiError []error
+type CreateWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenResponseMultiError) Error() string {
+func (m CreateWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19566,12 +26741,12 @@ func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenResponseValidationError is the validation error
-// returned by IssueServiceAuthTokenResponse.Validate if the designated
+// CreateWhitelistedDomainResponseValidationError is the validation error
+// returned by CreateWhitelistedDomainResponse.Validate if the designated
 // constraints aren't met.
-type IssueServiceAuthTokenResponseValidationError struct {
+type CreateWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19579,24 +26754,24 @@ type IssueServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenResponseValidationError"
+func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenResponseValidationError) Error() string {
+func (e CreateWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19608,14 +26783,14 @@ func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenResponseValidationError{}
+var _ error = CreateWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19623,33 +26798,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenResponseValidationError{}
+} = CreateWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on ListServiceAuthTokensRequest with the
+// Validate checks the field values on RemoveWhitelistedDomainRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensRequest) Validate() error {
+func (m *RemoveWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensRequestMultiError, or nil if none found.
-func (m *ListServiceAuthTokensRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServiceAuthTokensRequest) validate(all bool) error {
+func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := ListServiceAuthTokensRequestValidationError{
-			field:  "OrganizationName",
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -19658,22 +26833,31 @@ func (m *ListServiceAuthTokensRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ServiceName
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListServiceAuthTokensRequestMultiError(errors)
+		return RemoveWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListServiceAuthTokensRequestMultiError []error
+// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensRequestMultiError) Error() string {
+func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19682,12 +26866,12 @@ func (m ListServiceAuthTokensRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
+func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensRequestValidationError is the validation error returned
-// by ListServiceAuthTokensRequest.Validate if the designated constraints
-// aren't met.
-type ListServiceAuthTokensRequestValidationError struct {
+// RemoveWhitelistedDomainRequestValidationError is the validation error
+// returned by RemoveWhitelistedDomainRequest.Validate if the designated
+// constraints aren't met.
+type RemoveWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19695,24 +26879,24 @@ type ListServiceAuthTokensRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
+func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
+func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
+func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
+func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
-	return "ListServiceAuthTokensRequestValidationError"
+func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensRequestValidationError) Error() string {
+func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19724,93 +26908,59 @@ func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
+		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensRequestValidationError{}
+var _ error = RemoveWhitelistedDomainRequestValidationError{}
 
 var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ListServiceAuthTokensRequestValidationError{}
-
-// Validate checks the field values on ListServiceAuthTokensResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on ListServiceAuthTokensResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensResponseMultiError, or nil if none found.
-func (m *ListServiceAuthTokensResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *ListServiceAuthTokensResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	for idx, item := range m.GetTokens() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListServiceAuthTokensResponseValidationError{
-					field:  fmt.Sprintf("Tokens[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWhitelistedDomainRequestValidationError{}
+
+// Validate checks the field values on RemoveWhitelistedDomainResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveWhitelistedDomainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return ListServiceAuthTokensResponseMultiError(errors)
+		return RemoveWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
+// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
 // if the designated constraints aren't met.
-type ListServiceAuthTokensResponseMultiError []error
+type RemoveWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensResponseMultiError) Error() string {
+func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19819,12 +26969,12 @@ func (m ListServiceAuthTokensResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
+func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensResponseValidationError is the validation error
-// returned by ListServiceAuthTokensResponse.Validate if the designated
+// RemoveWhitelistedDomainResponseValidationError is the validation error
+// returned by RemoveWhitelistedDomainResponse.Validate if the designated
 // constraints aren't met.
-type ListServiceAuthTokensResponseValidationError struct {
+type RemoveWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19832,24 +26982,24 @@ type ListServiceAuthTokensResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
+func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
+func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
+func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
+func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
-	return "ListServiceAuthTokensResponseValidationError"
+func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensResponseValidationError) Error() string {
+func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19861,14 +27011,14 @@ func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
+		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensResponseValidationError{}
+var _ error = RemoveWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19876,24 +27026,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServiceAuthTokensResponseValidationError{}
+} = RemoveWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on IssueMagicAuthTokenRequest with the
+// Validate checks the field values on ListWhitelistedDomainsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueMagicAuthTokenRequest) Validate() error {
+func (m *ListWhitelistedDomainsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueMagicAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// IssueMagicAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueMagicAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWhitelistedDomainsRequestMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueMagicAuthTokenRequest) validate(all bool) error {
+func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -19901,7 +27051,7 @@ func (m *IssueMagicAuthTokenRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := IssueMagicAuthTokenRequestValidationError{
+		err := ListWhitelistedDomainsRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -19911,64 +27061,20 @@ func (m *IssueMagicAuthTokenRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := IssueMagicAuthTokenRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for TtlMinutes
-
-	// no validation rules for MetricsView
-
-	if all {
-		switch v := interface{}(m.GetMetricsViewFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, IssueMagicAuthTokenRequestValidationError{
-					field:  "MetricsViewFilter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, IssueMagicAuthTokenRequestValidationError{
-					field:  "MetricsViewFilter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetricsViewFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return IssueMagicAuthTokenRequestValidationError{
-				field:  "MetricsViewFilter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return IssueMagicAuthTokenRequestMultiError(errors)
+		return ListWhitelistedDomainsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueMagicAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by IssueMagicAuthTokenRequest.ValidateAll() if
-// the designated constraints aren't met.
-type IssueMagicAuthTokenRequestMultiError []error
+// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListWhitelistedDomainsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueMagicAuthTokenRequestMultiError) Error() string {
+func (m ListWhitelistedDomainsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19977,11 +27083,12 @@ func (m IssueMagicAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueMagicAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
 
-// IssueMagicAuthTokenRequestValidationError is the validation error returned
-// by IssueMagicAuthTokenRequest.Validate if the designated constraints aren't met.
-type IssueMagicAuthTokenRequestValidationError struct {
+// ListWhitelistedDomainsRequestValidationError is the validation error
+// returned by ListWhitelistedDomainsRequest.Validate if the designated
+// constraints aren't met.
+type ListWhitelistedDomainsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19989,24 +27096,24 @@ type IssueMagicAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueMagicAuthTokenRequestValidationError) Field() string { return e.field }
+func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueMagicAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueMagicAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueMagicAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueMagicAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueMagicAuthTokenRequestValidationError"
+func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueMagicAuthTokenRequestValidationError) Error() string {
+func (e ListWhitelistedDomainsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20018,14 +27125,14 @@ func (e IssueMagicAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueMagicAuthTokenRequest.%s: %s%s",
+		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueMagicAuthTokenRequestValidationError{}
+var _ error = ListWhitelistedDomainsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20033,48 +27140,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueMagicAuthTokenRequestValidationError{}
+} = ListWhitelistedDomainsRequestValidationError{}
 
-// Validate checks the field values on IssueMagicAuthTokenResponse with the
+// Validate checks the field values on ListWhitelistedDomainsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueMagicAuthTokenResponse) Validate() error {
+func (m *ListWhitelistedDomainsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueMagicAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// IssueMagicAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueMagicAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWhitelistedDomainsResponseMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueMagicAuthTokenResponse) validate(all bool) error {
+func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	for idx, item := range m.GetDomains() {
+		_, _ = idx, item
 
-	// no validation rules for Url
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWhitelistedDomainsResponseValidationError{
+					field:  fmt.Sprintf("Domains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return IssueMagicAuthTokenResponseMultiError(errors)
+		return ListWhitelistedDomainsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueMagicAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by IssueMagicAuthTokenResponse.ValidateAll() if
-// the designated constraints aren't met.
-type IssueMagicAuthTokenResponseMultiError []error
+// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListWhitelistedDomainsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueMagicAuthTokenResponseMultiError) Error() string {
+func (m ListWhitelistedDomainsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20083,12 +27220,12 @@ func (m IssueMagicAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueMagicAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
 
-// IssueMagicAuthTokenResponseValidationError is the validation error returned
-// by IssueMagicAuthTokenResponse.Validate if the designated constraints
-// aren't met.
-type IssueMagicAuthTokenResponseValidationError struct {
+// ListWhitelistedDomainsResponseValidationError is the validation error
+// returned by ListWhitelistedDomainsResponse.Validate if the designated
+// constraints aren't met.
+type ListWhitelistedDomainsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20096,24 +27233,24 @@ type IssueMagicAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueMagicAuthTokenResponseValidationError) Field() string { return e.field }
+func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueMagicAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueMagicAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueMagicAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueMagicAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueMagicAuthTokenResponseValidationError"
+func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueMagicAuthTokenResponseValidationError) Error() string {
+func (e ListWhitelistedDomainsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20125,14 +27262,14 @@ func (e IssueMagicAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueMagicAuthTokenResponse.%s: %s%s",
+		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueMagicAuthTokenResponseValidationError{}
+var _ error = ListWhitelistedDomainsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -20140,24 +27277,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueMagicAuthTokenResponseValidationError{}
+} = ListWhitelistedDomainsResponseValidationError{}
 
-// Validate checks the field values on ListMagicAuthTokensRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMagicAuthTokensRequest) Validate() error {
+// Validate checks the field values on ListOrganizationNotifiersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListOrganizationNotifiersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMagicAuthTokensRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListMagicAuthTokensRequestMultiError, or nil if none found.
-func (m *ListMagicAuthTokensRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListOrganizationNotifiersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListOrganizationNotifiersRequestMultiError, or nil if none found.
+func (m *ListOrganizationNotifiersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMagicAuthTokensRequest) validate(all bool) error {
+func (m *ListOrganizationNotifiersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -20165,7 +27303,7 @@ func (m *ListMagicAuthTokensRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListMagicAuthTokensRequestValidationError{
+		err := ListOrganizationNotifiersRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -20175,48 +27313,21 @@ func (m *ListMagicAuthTokensRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListMagicAuthTokensRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := ListMagicAuthTokensRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return ListMagicAuthTokensRequestMultiError(errors)
+		return ListOrganizationNotifiersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMagicAuthTokensRequestMultiError is an error wrapping multiple
-// validation errors returned by ListMagicAuthTokensRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListMagicAuthTokensRequestMultiError []error
+// ListOrganizationNotifiersRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListOrganizationNotifiersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListOrganizationNotifiersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMagicAuthTokensRequestMultiError) Error() string {
+func (m ListOrganizationNotifiersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20225,11 +27336,12 @@ func (m ListMagicAuthTokensRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMagicAuthTokensRequestMultiError) AllErrors() []error { return m }
+func (m ListOrganizationNotifiersRequestMultiError) AllErrors() []error { return m }
 
-// ListMagicAuthTokensRequestValidationError is the validation error returned
-// by ListMagicAuthTokensRequest.Validate if the designated constraints aren't met.
-type ListMagicAuthTokensRequestValidationError struct {
+// ListOrganizationNotifiersRequestValidationError is the validation error
+// returned by ListOrganizationNotifiersRequest.Validate if the designated
+// constraints aren't met.
+type ListOrganizationNotifiersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20237,24 +27349,24 @@ type ListMagicAuthTokensRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMagicAuthTokensRequestValidationError) Field() string { return e.field }
+func (e ListOrganizationNotifiersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMagicAuthTokensRequestValidationError) Reason() string { return e.reason }
+func (e ListOrganizationNotifiersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMagicAuthTokensRequestValidationError) Cause() error { return e.cause }
+func (e ListOrganizationNotifiersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMagicAuthTokensRequestValidationError) Key() bool { return e.key }
+func (e ListOrganizationNotifiersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMagicAuthTokensRequestValidationError) ErrorName() string {
-	return "ListMagicAuthTokensRequestValidationError"
+func (e ListOrganizationNotifiersRequestValidationError) ErrorName() string {
+	return "ListOrganizationNotifiersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListMagicAuthTokensRequestValidationError) Error() string {
+func (e ListOrganizationNotifiersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20266,14 +27378,14 @@ func (e ListMagicAuthTokensRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMagicAuthTokensRequest.%s: %s%s",
+		"invalid %sListOrganizationNotifiersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMagicAuthTokensRequestValidationError{}
+var _ error = ListOrganizationNotifiersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20281,47 +27393,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMagicAuthTokensRequestValidationError{}
+} = ListOrganizationNotifiersRequestValidationError{}
 
-// Validate checks the field values on ListMagicAuthTokensResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMagicAuthTokensResponse) Validate() error {
+// Validate checks the field values on ListOrganizationNotifiersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListOrganizationNotifiersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMagicAuthTokensResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListMagicAuthTokensResponseMultiError, or nil if none found.
-func (m *ListMagicAuthTokensResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListOrganizationNotifiersResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListOrganizationNotifiersResponseMultiError, or nil if none found.
+func (m *ListOrganizationNotifiersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMagicAuthTokensResponse) validate(all bool) error {
+func (m *ListOrganizationNotifiersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTokens() {
+	for idx, item := range m.GetNotifiers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListMagicAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
+					errors = append(errors, ListOrganizationNotifiersResponseValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListMagicAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
+					errors = append(errors, ListOrganizationNotifiersResponseValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -20329,8 +27442,8 @@ func (m *ListMagicAuthTokensResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListMagicAuthTokensResponseValidationError{
-					field:  fmt.Sprintf("Tokens[%v]", idx),
+				return ListOrganizationNotifiersResponseValidationError{
+					field:  fmt.Sprintf("Notifiers[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -20339,22 +27452,21 @@ func (m *ListMagicAuthTokensResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListMagicAuthTokensResponseMultiError(errors)
+		return ListOrganizationNotifiersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMagicAuthTokensResponseMultiError is an error wrapping multiple
-// validation errors returned by ListMagicAuthTokensResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListMagicAuthTokensResponseMultiError []error
+// ListOrganizationNotifiersResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListOrganizationNotifiersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListOrganizationNotifiersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMagicAuthTokensResponseMultiError) Error() string {
+func (m ListOrganizationNotifiersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20363,12 +27475,12 @@ func (m ListMagicAuthTokensResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMagicAuthTokensResponseMultiError) AllErrors() []error { return m }
+func (m ListOrganizationNotifiersResponseMultiError) AllErrors() []error { return m }
 
-// ListMagicAuthTokensResponseValidationError is the validation error returned
-// by ListMagicAuthTokensResponse.Validate if the designated constraints
-// aren't met.
-type ListMagicAuthTokensResponseValidationError struct {
+// ListOrganizationNotifiersResponseValidationError is the validation error
+// returned by ListOrganizationNotifiersResponse.Validate if the designated
+// constraints aren't met.
+type ListOrganizationNotifiersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20376,24 +27488,24 @@ type ListMagicAuthTokensResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMagicAuthTokensResponseValidationError) Field() string { return e.field }
+func (e ListOrganizationNotifiersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMagicAuthTokensResponseValidationError) Reason() string { return e.reason }
+func (e ListOrganizationNotifiersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMagicAuthTokensResponseValidationError) Cause() error { return e.cause }
+func (e ListOrganizationNotifiersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMagicAuthTokensResponseValidationError) Key() bool { return e.key }
+func (e ListOrganizationNotifiersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMagicAuthTokensResponseValidationError) ErrorName() string {
-	return "ListMagicAuthTokensResponseValidationError"
+func (e ListOrganizationNotifiersResponseValidationError) ErrorName() string {
+	return "ListOrganizationNotifiersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListMagicAuthTokensResponseValidationError) Error() string {
+func (e ListOrganizationNotifiersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20405,14 +27517,14 @@ func (e ListMagicAuthTokensResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMagicAuthTokensResponse.%s: %s%s",
+		"invalid %sListOrganizationNotifiersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMagicAuthTokensResponseValidationError{}
+var _ error = ListOrganizationNotifiersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -20420,46 +27532,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMagicAuthTokensResponseValidationError{}
+} = ListOrganizationNotifiersResponseValidationError{}
 
-// Validate checks the field values on RevokeMagicAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeMagicAuthTokenRequest) Validate() error {
+// Validate checks the field values on CreateOrganizationNotifierRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateOrganizationNotifierRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeMagicAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RevokeMagicAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeMagicAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateOrganizationNotifierRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateOrganizationNotifierRequestMultiError, or nil if none found.
+func (m *CreateOrganizationNotifierRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeMagicAuthTokenRequest) validate(all bool) error {
+func (m *CreateOrganizationNotifierRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateOrganizationNotifierRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateOrganizationNotifierRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetConnector()) < 1 {
+		err := CreateOrganizationNotifierRequestValidationError{
+			field:  "Connector",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrganizationNotifierRequestValidationError{
+					field:  "Properties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrganizationNotifierRequestValidationError{
+					field:  "Properties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrganizationNotifierRequestValidationError{
+				field:  "Properties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RevokeMagicAuthTokenRequestMultiError(errors)
+		return CreateOrganizationNotifierRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeMagicAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeMagicAuthTokenRequest.ValidateAll() if
-// the designated constraints aren't met.
-type RevokeMagicAuthTokenRequestMultiError []error
+// CreateOrganizationNotifierRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateOrganizationNotifierRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateOrganizationNotifierRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeMagicAuthTokenRequestMultiError) Error() string {
+func (m CreateOrganizationNotifierRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20468,12 +27642,12 @@ func (m RevokeMagicAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeMagicAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m CreateOrganizationNotifierRequestMultiError) AllErrors() []error { return m }
 
-// RevokeMagicAuthTokenRequestValidationError is the validation error returned
-// by RevokeMagicAuthTokenRequest.Validate if the designated constraints
-// aren't met.
-type RevokeMagicAuthTokenRequestValidationError struct {
+// CreateOrganizationNotifierRequestValidationError is the validation error
+// returned by CreateOrganizationNotifierRequest.Validate if the designated
+// constraints aren't met.
+type CreateOrganizationNotifierRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20481,24 +27655,24 @@ type RevokeMagicAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeMagicAuthTokenRequestValidationError) Field() string { return e.field }
+func (e CreateOrganizationNotifierRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeMagicAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e CreateOrganizationNotifierRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeMagicAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e CreateOrganizationNotifierRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeMagicAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e CreateOrganizationNotifierRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeMagicAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeMagicAuthTokenRequestValidationError"
+func (e CreateOrganizationNotifierRequestValidationError) ErrorName() string {
+	return "CreateOrganizationNotifierRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeMagicAuthTokenRequestValidationError) Error() string {
+func (e CreateOrganizationNotifierRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20510,14 +27684,14 @@ func (e RevokeMagicAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeMagicAuthTokenRequest.%s: %s%s",
+		"invalid %sCreateOrganizationNotifierRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeMagicAuthTokenRequestValidationError{}
+var _ error = CreateOrganizationNotifierRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20525,44 +27699,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeMagicAuthTokenRequestValidationError{}
+} = CreateOrganizationNotifierRequestValidationError{}
 
-// Validate checks the field values on RevokeMagicAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeMagicAuthTokenResponse) Validate() error {
+// Validate checks the field values on CreateOrganizationNotifierResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateOrganizationNotifierResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeMagicAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RevokeMagicAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeMagicAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateOrganizationNotifierResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateOrganizationNotifierResponseMultiError, or nil if none found.
+func (m *CreateOrganizationNotifierResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeMagicAuthTokenResponse) validate(all bool) error {
+func (m *CreateOrganizationNotifierResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetNotifier()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrganizationNotifierResponseValidationError{
+					field:  "Notifier",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrganizationNotifierResponseValidationError{
+					field:  "Notifier",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotifier()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrganizationNotifierResponseValidationError{
+				field:  "Notifier",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return RevokeMagicAuthTokenResponseMultiError(errors)
+		return CreateOrganizationNotifierResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeMagicAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeMagicAuthTokenResponse.ValidateAll() if
-// the designated constraints aren't met.
-type RevokeMagicAuthTokenResponseMultiError []error
+// CreateOrganizationNotifierResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateOrganizationNotifierResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateOrganizationNotifierResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeMagicAuthTokenResponseMultiError) Error() string {
+func (m CreateOrganizationNotifierResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20571,12 +27776,12 @@ func (m RevokeMagicAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeMagicAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m CreateOrganizationNotifierResponseMultiError) AllErrors() []error { return m }
 
-// RevokeMagicAuthTokenResponseValidationError is the validation error returned
-// by RevokeMagicAuthTokenResponse.Validate if the designated constraints
-// aren't met.
-type RevokeMagicAuthTokenResponseValidationError struct {
+// CreateOrganizationNotifierResponseValidationError is the validation error
+// returned by CreateOrganizationNotifierResponse.Validate if the designated
+// constraints aren't met.
+type CreateOrganizationNotifierResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20584,24 +27789,24 @@ type RevokeMagicAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeMagicAuthTokenResponseValidationError) Field() string { return e.field }
+func (e CreateOrganizationNotifierResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeMagicAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e CreateOrganizationNotifierResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeMagicAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e CreateOrganizationNotifierResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeMagicAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e CreateOrganizationNotifierResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeMagicAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeMagicAuthTokenResponseValidationError"
+func (e CreateOrganizationNotifierResponseValidationError) ErrorName() string {
+	return "CreateOrganizationNotifierResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeMagicAuthTokenResponseValidationError) Error() string {
+func (e CreateOrganizationNotifierResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20613,14 +27818,14 @@ func (e RevokeMagicAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeMagicAuthTokenResponse.%s: %s%s",
+		"invalid %sCreateOrganizationNotifierResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeMagicAuthTokenResponseValidationError{}
+var _ error = CreateOrganizationNotifierResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -20628,46 +27833,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeMagicAuthTokenResponseValidationError{}
+} = CreateOrganizationNotifierResponseValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusRequest) Validate() error {
+// Validate checks the field values on DeleteOrganizationNotifierRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteOrganizationNotifierRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusRequestMultiError, or nil if none found.
-func (m *GetGithubRepoStatusRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteOrganizationNotifierRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteOrganizationNotifierRequestMultiError, or nil if none found.
+func (m *DeleteOrganizationNotifierRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusRequest) validate(all bool) error {
+func (m *DeleteOrganizationNotifierRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GithubUrl
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := DeleteOrganizationNotifierRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteOrganizationNotifierRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetGithubRepoStatusRequestMultiError(errors)
+		return DeleteOrganizationNotifierRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusRequestMultiError []error
+// DeleteOrganizationNotifierRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteOrganizationNotifierRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteOrganizationNotifierRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusRequestMultiError) Error() string {
+func (m DeleteOrganizationNotifierRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20676,11 +27903,12 @@ func (m GetGithubRepoStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
+func (m DeleteOrganizationNotifierRequestMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusRequestValidationError is the validation error returned
-// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
-type GetGithubRepoStatusRequestValidationError struct {
+// DeleteOrganizationNotifierRequestValidationError is the validation error
+// returned by DeleteOrganizationNotifierRequest.Validate if the designated
+// constraints aren't met.
+type DeleteOrganizationNotifierRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20688,24 +27916,24 @@ type GetGithubRepoStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
+func (e DeleteOrganizationNotifierRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
+func (e DeleteOrganizationNotifierRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
+func (e DeleteOrganizationNotifierRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
+func (e DeleteOrganizationNotifierRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
-	return "GetGithubRepoStatusRequestValidationError"
+func (e DeleteOrganizationNotifierRequestValidationError) ErrorName() string {
+	return "DeleteOrganizationNotifierRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusRequestValidationError) Error() string {
+func (e DeleteOrganizationNotifierRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20717,14 +27945,14 @@ func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
+		"invalid %sDeleteOrganizationNotifierRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusRequestValidationError{}
+var _ error = DeleteOrganizationNotifierRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20732,50 +27960,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusRequestValidationError{}
+} = DeleteOrganizationNotifierRequestValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusResponse) Validate() error {
+// Validate checks the field values on DeleteOrganizationNotifierResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteOrganizationNotifierResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusResponseMultiError, or nil if none found.
-func (m *GetGithubRepoStatusResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeleteOrganizationNotifierResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteOrganizationNotifierResponseMultiError, or nil if none found.
+func (m *DeleteOrganizationNotifierResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusResponse) validate(all bool) error {
+func (m *DeleteOrganizationNotifierResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HasAccess
-
-	// no validation rules for GrantAccessUrl
-
-	// no validation rules for DefaultBranch
-
 	if len(errors) > 0 {
-		return GetGithubRepoStatusResponseMultiError(errors)
+		return DeleteOrganizationNotifierResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusResponseMultiError []error
+// DeleteOrganizationNotifierResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteOrganizationNotifierResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteOrganizationNotifierResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusResponseMultiError) Error() string {
+func (m DeleteOrganizationNotifierResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20784,12 +28008,12 @@ func (m GetGithubRepoStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
+func (m DeleteOrganizationNotifierResponseMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusResponseValidationError is the validation error returned
-// by GetGithubRepoStatusResponse.Validate if the designated constraints
-// aren't met.
-type GetGithubRepoStatusResponseValidationError struct {
+// DeleteOrganizationNotifierResponseValidationError is the validation error
+// returned by DeleteOrganizationNotifierResponse.Validate if the designated
+// constraints aren't met.
+type DeleteOrganizationNotifierResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20797,24 +28021,24 @@ type GetGithubRepoStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
+func (e DeleteOrganizationNotifierResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
+func (e DeleteOrganizationNotifierResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
+func (e DeleteOrganizationNotifierResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
+func (e DeleteOrganizationNotifierResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
-	return "GetGithubRepoStatusResponseValidationError"
+func (e DeleteOrganizationNotifierResponseValidationError) ErrorName() string {
+	return "DeleteOrganizationNotifierResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusResponseValidationError) Error() string {
+func (e DeleteOrganizationNotifierResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20826,14 +28050,14 @@ func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
+		"invalid %sDeleteOrganizationNotifierResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusResponseValidationError{}
+var _ error = DeleteOrganizationNotifierResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -20841,44 +28065,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusResponseValidationError{}
+} = DeleteOrganizationNotifierResponseValidationError{}
 
-// Validate checks the field values on GetGithubUserStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubUserStatusRequest) Validate() error {
+// Validate checks the field values on CreateProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateProjectWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubUserStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubUserStatusRequestMultiError, or nil if none found.
-func (m *GetGithubUserStatusRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateProjectWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *CreateProjectWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubUserStatusRequest) validate(all bool) error {
+func (m *CreateProjectWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetGithubUserStatusRequestMultiError(errors)
+		return CreateProjectWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubUserStatusRequestMultiError is an error wrapping multiple
-// validation errors returned by GetGithubUserStatusRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubUserStatusRequestMultiError []error
+// CreateProjectWhitelistedDomainRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateProjectWhitelistedDomainRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProjectWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubUserStatusRequestMultiError) Error() string {
+func (m CreateProjectWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20887,11 +28157,12 @@ func (m GetGithubUserStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubUserStatusRequestMultiError) AllErrors() []error { return m }
+func (m CreateProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// GetGithubUserStatusRequestValidationError is the validation error returned
-// by GetGithubUserStatusRequest.Validate if the designated constraints aren't met.
-type GetGithubUserStatusRequestValidationError struct {
+// CreateProjectWhitelistedDomainRequestValidationError is the validation error
+// returned by CreateProjectWhitelistedDomainRequest.Validate if the
+// designated constraints aren't met.
+type CreateProjectWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20899,24 +28170,24 @@ type GetGithubUserStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubUserStatusRequestValidationError) Field() string { return e.field }
+func (e CreateProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubUserStatusRequestValidationError) Reason() string { return e.reason }
+func (e CreateProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubUserStatusRequestValidationError) Cause() error { return e.cause }
+func (e CreateProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubUserStatusRequestValidationError) Key() bool { return e.key }
+func (e CreateProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubUserStatusRequestValidationError) ErrorName() string {
-	return "GetGithubUserStatusRequestValidationError"
+func (e CreateProjectWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "CreateProjectWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubUserStatusRequestValidationError) Error() string {
+func (e CreateProjectWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20928,14 +28199,14 @@ func (e GetGithubUserStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubUserStatusRequest.%s: %s%s",
+		"invalid %sCreateProjectWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubUserStatusRequestValidationError{}
+var _ error = CreateProjectWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20943,56 +28214,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubUserStatusRequestValidationError{}
+} = CreateProjectWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on GetGithubUserStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubUserStatusResponse) Validate() error {
+// Validate checks the field values on CreateProjectWhitelistedDomainResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateProjectWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubUserStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetGithubUserStatusResponseMultiError, or nil if none found.
-func (m *GetGithubUserStatusResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// CreateProjectWhitelistedDomainResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CreateProjectWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *CreateProjectWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubUserStatusResponse) validate(all bool) error {
+func (m *CreateProjectWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HasAccess
-
-	// no validation rules for GrantAccessUrl
-
-	// no validation rules for AccessToken
-
-	// no validation rules for Account
-
-	// no validation rules for UserInstallationPermission
-
-	// no validation rules for OrganizationInstallationPermissions
-
 	if len(errors) > 0 {
-		return GetGithubUserStatusResponseMultiError(errors)
+		return CreateProjectWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubUserStatusResponseMultiError is an error wrapping multiple
-// validation errors returned by GetGithubUserStatusResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubUserStatusResponseMultiError []error
+// CreateProjectWhitelistedDomainResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateProjectWhitelistedDomainResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProjectWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubUserStatusResponseMultiError) Error() string {
+func (m CreateProjectWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21001,12 +28263,12 @@ func (m GetGithubUserStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubUserStatusResponseMultiError) AllErrors() []error { return m }
+func (m CreateProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// GetGithubUserStatusResponseValidationError is the validation error returned
-// by GetGithubUserStatusResponse.Validate if the designated constraints
-// aren't met.
-type GetGithubUserStatusResponseValidationError struct {
+// CreateProjectWhitelistedDomainResponseValidationError is the validation
+// error returned by CreateProjectWhitelistedDomainResponse.Validate if the
+// designated constraints aren't met.
+type CreateProjectWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21014,24 +28276,24 @@ type GetGithubUserStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubUserStatusResponseValidationError) Field() string { return e.field }
+func (e CreateProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubUserStatusResponseValidationError) Reason() string { return e.reason }
+func (e CreateProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubUserStatusResponseValidationError) Cause() error { return e.cause }
+func (e CreateProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubUserStatusResponseValidationError) Key() bool { return e.key }
+func (e CreateProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubUserStatusResponseValidationError) ErrorName() string {
-	return "GetGithubUserStatusResponseValidationError"
+func (e CreateProjectWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "CreateProjectWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubUserStatusResponseValidationError) Error() string {
+func (e CreateProjectWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21043,14 +28305,14 @@ func (e GetGithubUserStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubUserStatusResponse.%s: %s%s",
+		"invalid %sCreateProjectWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubUserStatusResponseValidationError{}
+var _ error = CreateProjectWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -21058,24 +28320,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubUserStatusResponseValidationError{}
+} = CreateProjectWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on GetCloneCredentialsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCloneCredentialsRequest) Validate() error {
+// Validate checks the field values on RemoveProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveProjectWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCloneCredentialsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCloneCredentialsRequestMultiError, or nil if none found.
-func (m *GetCloneCredentialsRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveProjectWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *RemoveProjectWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCloneCredentialsRequest) validate(all bool) error {
+func (m *RemoveProjectWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -21083,7 +28346,7 @@ func (m *GetCloneCredentialsRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := GetCloneCredentialsRequestValidationError{
+		err := RemoveProjectWhitelistedDomainRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -21094,7 +28357,7 @@ func (m *GetCloneCredentialsRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := GetCloneCredentialsRequestValidationError{
+		err := RemoveProjectWhitelistedDomainRequestValidationError{
 			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
@@ -21104,20 +28367,32 @@ func (m *GetCloneCredentialsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := RemoveProjectWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetCloneCredentialsRequestMultiError(errors)
+		return RemoveProjectWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCloneCredentialsRequestMultiError is an error wrapping multiple
-// validation errors returned by GetCloneCredentialsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetCloneCredentialsRequestMultiError []error
+// RemoveProjectWhitelistedDomainRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveProjectWhitelistedDomainRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProjectWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCloneCredentialsRequestMultiError) Error() string {
+func (m RemoveProjectWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21126,11 +28401,12 @@ func (m GetCloneCredentialsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCloneCredentialsRequestMultiError) AllErrors() []error { return m }
+func (m RemoveProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// GetCloneCredentialsRequestValidationError is the validation error returned
-// by GetCloneCredentialsRequest.Validate if the designated constraints aren't met.
-type GetCloneCredentialsRequestValidationError struct {
+// RemoveProjectWhitelistedDomainRequestValidationError is the validation error
+// returned by RemoveProjectWhitelistedDomainRequest.Validate if the
+// designated constraints aren't met.
+type RemoveProjectWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21138,24 +28414,24 @@ type GetCloneCredentialsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCloneCredentialsRequestValidationError) Field() string { return e.field }
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCloneCredentialsRequestValidationError) Reason() string { return e.reason }
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCloneCredentialsRequestValidationError) Cause() error { return e.cause }
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCloneCredentialsRequestValidationError) Key() bool { return e.key }
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCloneCredentialsRequestValidationError) ErrorName() string {
-	return "GetCloneCredentialsRequestValidationError"
+func (e RemoveProjectWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "RemoveProjectWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCloneCredentialsRequestValidationError) Error() string {
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21167,14 +28443,14 @@ func (e GetCloneCredentialsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCloneCredentialsRequest.%s: %s%s",
+		"invalid %sRemoveProjectWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCloneCredentialsRequestValidationError{}
+var _ error = RemoveProjectWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -21182,56 +28458,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCloneCredentialsRequestValidationError{}
+} = RemoveProjectWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on GetCloneCredentialsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCloneCredentialsResponse) Validate() error {
+// Validate checks the field values on RemoveProjectWhitelistedDomainResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveProjectWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCloneCredentialsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCloneCredentialsResponseMultiError, or nil if none found.
-func (m *GetCloneCredentialsResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// RemoveProjectWhitelistedDomainResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// RemoveProjectWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *RemoveProjectWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCloneCredentialsResponse) validate(all bool) error {
+func (m *RemoveProjectWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GitRepoUrl
-
-	// no validation rules for GitUsername
-
-	// no validation rules for GitPassword
-
-	// no validation rules for GitSubpath
-
-	// no validation rules for GitProdBranch
-
-	// no validation rules for ArchiveDownloadUrl
-
 	if len(errors) > 0 {
-		return GetCloneCredentialsResponseMultiError(errors)
+		return RemoveProjectWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCloneCredentialsResponseMultiError is an error wrapping multiple
-// validation errors returned by GetCloneCredentialsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetCloneCredentialsResponseMultiError []error
+// RemoveProjectWhitelistedDomainResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveProjectWhitelistedDomainResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProjectWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCloneCredentialsResponseMultiError) Error() string {
+func (m RemoveProjectWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21240,12 +28507,12 @@ func (m GetCloneCredentialsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCloneCredentialsResponseMultiError) AllErrors() []error { return m }
+func (m RemoveProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// GetCloneCredentialsResponseValidationError is the validation error returned
-// by GetCloneCredentialsResponse.Validate if the designated constraints
-// aren't met.
-type GetCloneCredentialsResponseValidationError struct {
+// RemoveProjectWhitelistedDomainResponseValidationError is the validation
+// error returned by RemoveProjectWhitelistedDomainResponse.Validate if the
+// designated constraints aren't met.
+type RemoveProjectWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21253,24 +28520,24 @@ type GetCloneCredentialsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCloneCredentialsResponseValidationError) Field() string { return e.field }
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCloneCredentialsResponseValidationError) Reason() string { return e.reason }
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCloneCredentialsResponseValidationError) Cause() error { return e.cause }
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCloneCredentialsResponseValidationError) Key() bool { return e.key }
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCloneCredentialsResponseValidationError) ErrorName() string {
-	return "GetCloneCredentialsResponseValidationError"
+func (e RemoveProjectWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "RemoveProjectWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCloneCredentialsResponseValidationError) Error() string {
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21282,14 +28549,14 @@ func (e GetCloneCredentialsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCloneCredentialsResponse.%s: %s%s",
+		"invalid %sRemoveProjectWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCloneCredentialsResponseValidationError{}
+var _ error = RemoveProjectWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -21297,24 +28564,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCloneCredentialsResponseValidationError{}
+} = RemoveProjectWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainRequest) Validate() error {
+// Validate checks the field values on ListProjectWhitelistedDomainsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListProjectWhitelistedDomainsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectWhitelistedDomainsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectWhitelistedDomainsRequestMultiError, or nil if none found.
+func (m *ListProjectWhitelistedDomainsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
+func (m *ListProjectWhitelistedDomainsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -21322,7 +28590,7 @@ func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
+		err := ListProjectWhitelistedDomainsRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -21332,20 +28600,9 @@ func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Role",
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectWhitelistedDomainsRequestValidationError{
+			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -21355,19 +28612,20 @@ func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateWhitelistedDomainRequestMultiError(errors)
+		return ListProjectWhitelistedDomainsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
-// if the designated constraints aren't met.
-type CreateWhitelistedDomainRequestMultiError []error
+// ListProjectWhitelistedDomainsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectWhitelistedDomainsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectWhitelistedDomainsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainRequestMultiError) Error() string {
+func (m ListProjectWhitelistedDomainsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21376,12 +28634,12 @@ func (m CreateWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainRequestValidationError is the validation error
-// returned by CreateWhitelistedDomainRequest.Validate if the designated
+// ListProjectWhitelistedDomainsRequestValidationError is the validation error
+// returned by ListProjectWhitelistedDomainsRequest.Validate if the designated
 // constraints aren't met.
-type CreateWhitelistedDomainRequestValidationError struct {
+type ListProjectWhitelistedDomainsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21389,24 +28647,24 @@ type CreateWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e ListProjectWhitelistedDomainsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e ListProjectWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainRequestValidationError"
+func (e ListProjectWhitelistedDomainsRequestValidationError) ErrorName() string {
+	return "ListProjectWhitelistedDomainsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainRequestValidationError) Error() string {
+func (e ListProjectWhitelistedDomainsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21418,14 +28676,14 @@ func (e CreateWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sListProjectWhitelistedDomainsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainRequestValidationError{}
+var _ error = ListProjectWhitelistedDomainsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -21433,44 +28691,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainRequestValidationError{}
+} = ListProjectWhitelistedDomainsRequestValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainResponse) Validate() error {
+// Validate checks the field values on ListProjectWhitelistedDomainsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListProjectWhitelistedDomainsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectWhitelistedDomainsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectWhitelistedDomainsResponseMultiError, or nil if none found.
+func (m *ListProjectWhitelistedDomainsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
+func (m *ListProjectWhitelistedDomainsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetDomains() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectWhitelistedDomainsResponseValidationError{
+					field:  fmt.Sprintf("Domains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return CreateWhitelistedDomainResponseMultiError(errors)
+		return ListProjectWhitelistedDomainsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
-// if the designated constraints aren't met.
-type CreateWhitelistedDomainResponseMultiError []error
+// ListProjectWhitelistedDomainsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// ListProjectWhitelistedDomainsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectWhitelistedDomainsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainResponseMultiError) Error() string {
+func (m ListProjectWhitelistedDomainsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21479,12 +28773,12 @@ func (m CreateWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainResponseValidationError is the validation error
-// returned by CreateWhitelistedDomainResponse.Validate if the designated
-// constraints aren't met.
-type CreateWhitelistedDomainResponseValidationError struct {
+// ListProjectWhitelistedDomainsResponseValidationError is the validation error
+// returned by ListProjectWhitelistedDomainsResponse.Validate if the
+// designated constraints aren't met.
+type ListProjectWhitelistedDomainsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21492,24 +28786,24 @@ type CreateWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e ListProjectWhitelistedDomainsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e ListProjectWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainResponseValidationError"
+func (e ListProjectWhitelistedDomainsResponseValidationError) ErrorName() string {
+	return "ListProjectWhitelistedDomainsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainResponseValidationError) Error() string {
+func (e ListProjectWhitelistedDomainsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21521,14 +28815,14 @@ func (e CreateWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sListProjectWhitelistedDomainsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainResponseValidationError{}
+var _ error = ListProjectWhitelistedDomainsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -21536,24 +28830,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainResponseValidationError{}
+} = ListProjectWhitelistedDomainsResponseValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainRequest) Validate() error {
+// Validate checks the field values on ListProjectPublicMetricsViewsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListProjectPublicMetricsViewsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectPublicMetricsViewsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectPublicMetricsViewsRequestMultiError, or nil if none found.
+func (m *ListProjectPublicMetricsViewsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
+func (m *ListProjectPublicMetricsViewsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -21561,7 +28856,7 @@ func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
+		err := ListProjectPublicMetricsViewsRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -21571,9 +28866,9 @@ func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
-			field:  "Domain",
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectPublicMetricsViewsRequestValidationError{
+			field:  "Project",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -21583,19 +28878,20 @@ func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainRequestMultiError(errors)
+		return ListProjectPublicMetricsViewsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RemoveWhitelistedDomainRequestMultiError []error
+// ListProjectPublicMetricsViewsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectPublicMetricsViewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectPublicMetricsViewsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
+func (m ListProjectPublicMetricsViewsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21604,12 +28900,12 @@ func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectPublicMetricsViewsRequestMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainRequestValidationError is the validation error
-// returned by RemoveWhitelistedDomainRequest.Validate if the designated
+// ListProjectPublicMetricsViewsRequestValidationError is the validation error
+// returned by ListProjectPublicMetricsViewsRequest.Validate if the designated
 // constraints aren't met.
-type RemoveWhitelistedDomainRequestValidationError struct {
+type ListProjectPublicMetricsViewsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21617,24 +28913,24 @@ type RemoveWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e ListProjectPublicMetricsViewsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectPublicMetricsViewsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectPublicMetricsViewsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e ListProjectPublicMetricsViewsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainRequestValidationError"
+func (e ListProjectPublicMetricsViewsRequestValidationError) ErrorName() string {
+	return "ListProjectPublicMetricsViewsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
+func (e ListProjectPublicMetricsViewsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21646,14 +28942,14 @@ func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sListProjectPublicMetricsViewsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainRequestValidationError{}
+var _ error = ListProjectPublicMetricsViewsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -21661,24 +28957,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainRequestValidationError{}
+} = ListProjectPublicMetricsViewsRequestValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainResponse) Validate() error {
+// Validate checks the field values on ListProjectPublicMetricsViewsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListProjectPublicMetricsViewsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectPublicMetricsViewsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectPublicMetricsViewsResponseMultiError, or nil if none found.
+func (m *ListProjectPublicMetricsViewsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
+func (m *ListProjectPublicMetricsViewsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -21686,19 +28983,20 @@ func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainResponseMultiError(errors)
+		return ListProjectPublicMetricsViewsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RemoveWhitelistedDomainResponseMultiError []error
+// ListProjectPublicMetricsViewsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// ListProjectPublicMetricsViewsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectPublicMetricsViewsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
+func (m ListProjectPublicMetricsViewsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21707,12 +29005,12 @@ func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectPublicMetricsViewsResponseMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainResponseValidationError is the validation error
-// returned by RemoveWhitelistedDomainResponse.Validate if the designated
-// constraints aren't met.
-type RemoveWhitelistedDomainResponseValidationError struct {
+// ListProjectPublicMetricsViewsResponseValidationError is the validation error
+// returned by ListProjectPublicMetricsViewsResponse.Validate if the
+// designated constraints aren't met.
+type ListProjectPublicMetricsViewsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21720,24 +29018,24 @@ type RemoveWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e ListProjectPublicMetricsViewsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectPublicMetricsViewsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectPublicMetricsViewsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e ListProjectPublicMetricsViewsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainResponseValidationError"
+func (e ListProjectPublicMetricsViewsResponseValidationError) ErrorName() string {
+	return "ListProjectPublicMetricsViewsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
+func (e ListProjectPublicMetricsViewsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21749,14 +29047,14 @@ func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sListProjectPublicMetricsViewsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainResponseValidationError{}
+var _ error = ListProjectPublicMetricsViewsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -21764,24 +29062,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainResponseValidationError{}
+} = ListProjectPublicMetricsViewsResponseValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsRequest) Validate() error {
+// Validate checks the field values on SetProjectPublicMetricsViewsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetProjectPublicMetricsViewsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsRequestMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
+// ValidateAll checks the field values on SetProjectPublicMetricsViewsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetProjectPublicMetricsViewsRequestMultiError, or nil if none found.
+func (m *SetProjectPublicMetricsViewsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
+func (m *SetProjectPublicMetricsViewsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -21789,7 +29088,7 @@ func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListWhitelistedDomainsRequestValidationError{
+		err := SetProjectPublicMetricsViewsRequestValidationError{
 			field:  "Organization",
 			reason: "value length must be at least 1 runes",
 		}
@@ -21799,20 +29098,74 @@ func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := SetProjectPublicMetricsViewsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMetricsViews()) > 100 {
+		err := SetProjectPublicMetricsViewsRequestValidationError{
+			field:  "MetricsViews",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_SetProjectPublicMetricsViewsRequest_MetricsViews_Unique := make(map[string]struct{}, len(m.GetMetricsViews()))
+
+	for idx, item := range m.GetMetricsViews() {
+		_, _ = idx, item
+
+		if _, exists := _SetProjectPublicMetricsViewsRequest_MetricsViews_Unique[item]; exists {
+			err := SetProjectPublicMetricsViewsRequestValidationError{
+				field:  fmt.Sprintf("MetricsViews[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SetProjectPublicMetricsViewsRequest_MetricsViews_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := SetProjectPublicMetricsViewsRequestValidationError{
+				field:  fmt.Sprintf("MetricsViews[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsRequestMultiError(errors)
+		return SetProjectPublicMetricsViewsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsRequestMultiError []error
+// SetProjectPublicMetricsViewsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetProjectPublicMetricsViewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetProjectPublicMetricsViewsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsRequestMultiError) Error() string {
+func (m SetProjectPublicMetricsViewsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21821,12 +29174,12 @@ func (m ListWhitelistedDomainsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
+func (m SetProjectPublicMetricsViewsRequestMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsRequestValidationError is the validation error
-// returned by ListWhitelistedDomainsRequest.Validate if the designated
+// SetProjectPublicMetricsViewsRequestValidationError is the validation error
+// returned by SetProjectPublicMetricsViewsRequest.Validate if the designated
 // constraints aren't met.
-type ListWhitelistedDomainsRequestValidationError struct {
+type SetProjectPublicMetricsViewsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21834,24 +29187,24 @@ type ListWhitelistedDomainsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
+func (e SetProjectPublicMetricsViewsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
+func (e SetProjectPublicMetricsViewsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
+func (e SetProjectPublicMetricsViewsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
+func (e SetProjectPublicMetricsViewsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsRequestValidationError"
+func (e SetProjectPublicMetricsViewsRequestValidationError) ErrorName() string {
+	return "SetProjectPublicMetricsViewsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsRequestValidationError) Error() string {
+func (e SetProjectPublicMetricsViewsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21863,14 +29216,14 @@ func (e ListWhitelistedDomainsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
+		"invalid %sSetProjectPublicMetricsViewsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsRequestValidationError{}
+var _ error = SetProjectPublicMetricsViewsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -21878,78 +29231,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsRequestValidationError{}
+} = SetProjectPublicMetricsViewsRequestValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsResponse) Validate() error {
+// Validate checks the field values on SetProjectPublicMetricsViewsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetProjectPublicMetricsViewsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsResponseMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
+// ValidateAll checks the field values on SetProjectPublicMetricsViewsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetProjectPublicMetricsViewsResponseMultiError, or nil if none found.
+func (m *SetProjectPublicMetricsViewsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
+func (m *SetProjectPublicMetricsViewsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDomains() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListWhitelistedDomainsResponseValidationError{
-					field:  fmt.Sprintf("Domains[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsResponseMultiError(errors)
+		return SetProjectPublicMetricsViewsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsResponseMultiError []error
+// SetProjectPublicMetricsViewsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetProjectPublicMetricsViewsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetProjectPublicMetricsViewsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsResponseMultiError) Error() string {
+func (m SetProjectPublicMetricsViewsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21958,12 +29279,12 @@ func (m ListWhitelistedDomainsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
+func (m SetProjectPublicMetricsViewsResponseMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsResponseValidationError is the validation error
-// returned by ListWhitelistedDomainsResponse.Validate if the designated
+// SetProjectPublicMetricsViewsResponseValidationError is the validation error
+// returned by SetProjectPublicMetricsViewsResponse.Validate if the designated
 // constraints aren't met.
-type ListWhitelistedDomainsResponseValidationError struct {
+type SetProjectPublicMetricsViewsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21971,24 +29292,24 @@ type ListWhitelistedDomainsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
+func (e SetProjectPublicMetricsViewsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
+func (e SetProjectPublicMetricsViewsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
+func (e SetProjectPublicMetricsViewsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
+func (e SetProjectPublicMetricsViewsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsResponseValidationError"
+func (e SetProjectPublicMetricsViewsResponseValidationError) ErrorName() string {
+	return "SetProjectPublicMetricsViewsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsResponseValidationError) Error() string {
+func (e SetProjectPublicMetricsViewsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -22000,14 +29321,14 @@ func (e ListWhitelistedDomainsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
+		"invalid %sSetProjectPublicMetricsViewsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsResponseValidationError{}
+var _ error = SetProjectPublicMetricsViewsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -22015,90 +29336,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsResponseValidationError{}
+} = SetProjectPublicMetricsViewsResponseValidationError{}
 
-// Validate checks the field values on CreateProjectWhitelistedDomainRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateProjectWhitelistedDomainRequest) Validate() error {
+// Validate checks the field values on GetRepoMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRepoMetaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProjectWhitelistedDomainRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CreateProjectWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *CreateProjectWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetRepoMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRepoMetaRequestMultiError, or nil if none found.
+func (m *GetRepoMetaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectWhitelistedDomainRequest) validate(all bool) error {
+func (m *GetRepoMetaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ProjectId
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Branch
 
 	if len(errors) > 0 {
-		return CreateProjectWhitelistedDomainRequestMultiError(errors)
+		return GetRepoMetaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectWhitelistedDomainRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateProjectWhitelistedDomainRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateProjectWhitelistedDomainRequestMultiError []error
+// GetRepoMetaRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRepoMetaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRepoMetaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectWhitelistedDomainRequestMultiError) Error() string {
+func (m GetRepoMetaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -22107,12 +29386,11 @@ func (m CreateProjectWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m GetRepoMetaRequestMultiError) AllErrors() []error { return m }
 
-// CreateProjectWhitelistedDomainRequestValidationError is the validation error
-// returned by CreateProjectWhitelistedDomainRequest.Validate if the
-// designated constraints aren't met.
-type CreateProjectWhitelistedDomainRequestValidationError struct {
+// GetRepoMetaRequestValidationError is the validation error returned by
+// GetRepoMetaRequest.Validate if the designated constraints aren't met.
+type GetRepoMetaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -22120,24 +29398,24 @@ type CreateProjectWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e GetRepoMetaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e GetRepoMetaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e GetRepoMetaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e GetRepoMetaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "CreateProjectWhitelistedDomainRequestValidationError"
+func (e GetRepoMetaRequestValidationError) ErrorName() string {
+	return "GetRepoMetaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectWhitelistedDomainRequestValidationError) Error() string {
+func (e GetRepoMetaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -22149,14 +29427,14 @@ func (e CreateProjectWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sGetRepoMetaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectWhitelistedDomainRequestValidationError{}
+var _ error = GetRepoMetaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -22164,47 +29442,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectWhitelistedDomainRequestValidationError{}
+} = GetRepoMetaRequestValidationError{}
 
-// Validate checks the field values on CreateProjectWhitelistedDomainResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateProjectWhitelistedDomainResponse) Validate() error {
+// Validate checks the field values on GetRepoMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRepoMetaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CreateProjectWhitelistedDomainResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// CreateProjectWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *CreateProjectWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetRepoMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRepoMetaResponseMultiError, or nil if none found.
+func (m *GetRepoMetaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectWhitelistedDomainResponse) validate(all bool) error {
+func (m *GetRepoMetaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for GitUrl
+
+	if all {
+		switch v := interface{}(m.GetGitUrlExpiresOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRepoMetaResponseValidationError{
+					field:  "GitUrlExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRepoMetaResponseValidationError{
+					field:  "GitUrlExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGitUrlExpiresOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRepoMetaResponseValidationError{
+				field:  "GitUrlExpiresOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GitSubpath
+
+	// no validation rules for ArchiveDownloadUrl
+
+	// no validation rules for GitCommitSha
+
 	if len(errors) > 0 {
-		return CreateProjectWhitelistedDomainResponseMultiError(errors)
+		return GetRepoMetaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectWhitelistedDomainResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateProjectWhitelistedDomainResponse.ValidateAll() if the designated
+// GetRepoMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRepoMetaResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateProjectWhitelistedDomainResponseMultiError []error
+type GetRepoMetaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectWhitelistedDomainResponseMultiError) Error() string {
+func (m GetRepoMetaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -22213,12 +29525,11 @@ func (m CreateProjectWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m GetRepoMetaResponseMultiError) AllErrors() []error { return m }
 
-// CreateProjectWhitelistedDomainResponseValidationError is the validation
-// error returned by CreateProjectWhitelistedDomainResponse.Validate if the
-// designated constraints aren't met.
-type CreateProjectWhitelistedDomainResponseValidationError struct {
+// GetRepoMetaResponseValidationError is the validation error returned by
+// GetRepoMetaResponse.Validate if the designated constraints aren't met.
+type GetRepoMetaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -22226,24 +29537,24 @@ type CreateProjectWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e GetRepoMetaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e GetRepoMetaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e GetRepoMetaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e GetRepoMetaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "CreateProjectWhitelistedDomainResponseValidationError"
+func (e GetRepoMetaResponseValidationError) ErrorName() string {
+	return "GetRepoMetaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectWhitelistedDomainResponseValidationError) Error() string {
+func (e GetRepoMetaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -22255,14 +29566,14 @@ func (e CreateProjectWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sGetRepoMetaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectWhitelistedDomainResponseValidationError{}
+var _ error = GetRepoMetaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -22270,79 +29581,65 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectWhitelistedDomainResponseValidationError{}
+} = GetRepoMetaResponseValidationError{}
 
-// Validate checks the field values on RemoveProjectWhitelistedDomainRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *RemoveProjectWhitelistedDomainRequest) Validate() error {
+// Validate checks the field values on PullVirtualRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PullVirtualRepoRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectWhitelistedDomainRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// RemoveProjectWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *RemoveProjectWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on PullVirtualRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PullVirtualRepoRequestMultiError, or nil if none found.
+func (m *PullVirtualRepoRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectWhitelistedDomainRequest) validate(all bool) error {
+func (m *PullVirtualRepoRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveProjectWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ProjectId
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := RemoveProjectWhitelistedDomainRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Branch
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := RemoveProjectWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 100 {
+			err := PullVirtualRepoRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 100",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return RemoveProjectWhitelistedDomainRequestMultiError(errors)
+		return PullVirtualRepoRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveProjectWhitelistedDomainRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// RemoveProjectWhitelistedDomainRequest.ValidateAll() if the designated
+// PullVirtualRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by PullVirtualRepoRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveProjectWhitelistedDomainRequestMultiError []error
+type PullVirtualRepoRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectWhitelistedDomainRequestMultiError) Error() string {
+func (m PullVirtualRepoRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -22351,12 +29648,11 @@ func (m RemoveProjectWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m PullVirtualRepoRequestMultiError) AllErrors() []error { return m }
 
-// RemoveProjectWhitelistedDomainRequestValidationError is the validation error
-// returned by RemoveProjectWhitelistedDomainRequest.Validate if the
-// designated constraints aren't met.
-type RemoveProjectWhitelistedDomainRequestValidationError struct {
+// PullVirtualRepoRequestValidationError is the validation error returned by
+// PullVirtualRepoRequest.Validate if the designated constraints aren't met.
+type PullVirtualRepoRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -22364,24 +29660,24 @@ type RemoveProjectWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e PullVirtualRepoRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e PullVirtualRepoRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e PullVirtualRepoRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e PullVirtualRepoRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "RemoveProjectWhitelistedDomainRequestValidationError"
+func (e PullVirtualRepoRequestValidationError) ErrorName() string {
+	return "PullVirtualRepoRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Error() string {
+func (e PullVirtualRepoRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -22393,14 +29689,14 @@ func (e RemoveProjectWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sPullVirtualRepoRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectWhitelistedDomainRequestValidationError{}
+var _ error = PullVirtualRepoRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -22408,47 +29704,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectWhitelistedDomainRequestValidationError{}
+} = PullVirtualRepoRequestValidationError{}
 
-// Validate checks the field values on RemoveProjectWhitelistedDomainResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *RemoveProjectWhitelistedDomainResponse) Validate() error {
+// Validate checks the field values on PullVirtualRepoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PullVirtualRepoResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// RemoveProjectWhitelistedDomainResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// RemoveProjectWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *RemoveProjectWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on PullVirtualRepoResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PullVirtualRepoResponseMultiError, or nil if none found.
+func (m *PullVirtualRepoResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectWhitelistedDomainResponse) validate(all bool) error {
+func (m *PullVirtualRepoResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PullVirtualRepoResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PullVirtualRepoResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PullVirtualRepoResponseValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return RemoveProjectWhitelistedDomainResponseMultiError(errors)
+		return PullVirtualRepoResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveProjectWhitelistedDomainResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// RemoveProjectWhitelistedDomainResponse.ValidateAll() if the designated
+// PullVirtualRepoResponseMultiError is an error wrapping multiple validation
+// errors returned by PullVirtualRepoResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveProjectWhitelistedDomainResponseMultiError []error
+type PullVirtualRepoResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectWhitelistedDomainResponseMultiError) Error() string {
+func (m PullVirtualRepoResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -22457,12 +29786,11 @@ func (m RemoveProjectWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m PullVirtualRepoResponseMultiError) AllErrors() []error { return m }
 
-// RemoveProjectWhitelistedDomainResponseValidationError is the validation
-// error returned by RemoveProjectWhitelistedDomainResponse.Validate if the
-// designated constraints aren't met.
-type RemoveProjectWhitelistedDomainResponseValidationError struct {
+// PullVirtualRepoResponseValidationError is the validation error returned by
+// PullVirtualRepoResponse.Validate if the designated constraints aren't met.
+type PullVirtualRepoResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -22470,24 +29798,24 @@ type RemoveProjectWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e PullVirtualRepoResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e PullVirtualRepoResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e PullVirtualRepoResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e PullVirtualRepoResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "RemoveProjectWhitelistedDomainResponseValidationError"
+func (e PullVirtualRepoResponseValidationError) ErrorName() string {
+	return "PullVirtualRepoResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Error() string {
+func (e PullVirtualRepoResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -22499,14 +29827,14 @@ func (e RemoveProjectWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sPullVirtualRepoResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectWhitelistedDomainResponseValidationError{}
+var _ error = PullVirtualRepoResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -22514,68 +29842,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectWhitelistedDomainResponseValidationError{}
+} = PullVirtualRepoResponseValidationError{}
 
-// Validate checks the field values on ListProjectWhitelistedDomainsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *ListProjectWhitelistedDomainsRequest) Validate() error {
+// Validate checks the field values on GetReportMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReportMetaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectWhitelistedDomainsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ListProjectWhitelistedDomainsRequestMultiError, or nil if none found.
-func (m *ListProjectWhitelistedDomainsRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetReportMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReportMetaRequestMultiError, or nil if none found.
+func (m *GetReportMetaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectWhitelistedDomainsRequest) validate(all bool) error {
+func (m *GetReportMetaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectWhitelistedDomainsRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ProjectId
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectWhitelistedDomainsRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for Branch
+
+	// no validation rules for Report
+
+	// no validation rules for Annotations
+
+	if all {
+		switch v := interface{}(m.GetExecutionTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReportMetaRequestValidationError{
+					field:  "ExecutionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReportMetaRequestValidationError{
+					field:  "ExecutionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReportMetaRequestValidationError{
+				field:  "ExecutionTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return ListProjectWhitelistedDomainsRequestMultiError(errors)
+		return GetReportMetaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectWhitelistedDomainsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// ListProjectWhitelistedDomainsRequest.ValidateAll() if the designated
+// GetReportMetaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReportMetaRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListProjectWhitelistedDomainsRequestMultiError []error
+type GetReportMetaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectWhitelistedDomainsRequestMultiError) Error() string {
+func (m GetReportMetaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -22584,12 +29925,11 @@ func (m ListProjectWhitelistedDomainsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
+func (m GetReportMetaRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectWhitelistedDomainsRequestValidationError is the validation error
-// returned by ListProjectWhitelistedDomainsRequest.Validate if the designated
-// constraints aren't met.
-type ListProjectWhitelistedDomainsRequestValidationError struct {
+// GetReportMetaRequestValidationError is the validation error returned by
+// GetReportMetaRequest.Validate if the designated constraints aren't met.
+type GetReportMetaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -22597,24 +29937,24 @@ type ListProjectWhitelistedDomainsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Field() string { return e.field }
+func (e GetReportMetaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
+func (e GetReportMetaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
+func (e GetReportMetaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
+func (e GetReportMetaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectWhitelistedDomainsRequestValidationError) ErrorName() string {
-	return "ListProjectWhitelistedDomainsRequestValidationError"
+func (e GetReportMetaRequestValidationError) ErrorName() string {
+	return "GetReportMetaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectWhitelistedDomainsRequestValidationError) Error() string {
+func (e GetReportMetaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -22626,14 +29966,14 @@ func (e ListProjectWhitelistedDomainsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectWhitelistedDomainsRequest.%s: %s%s",
+		"invalid %sGetReportMetaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectWhitelistedDomainsRequestValidationError{}
+var _ error = GetReportMetaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -22641,80 +29981,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectWhitelistedDomainsRequestValidationError{}
+} = GetReportMetaRequestValidationError{}
 
-// Validate checks the field values on ListProjectWhitelistedDomainsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *ListProjectWhitelistedDomainsResponse) Validate() error {
+// Validate checks the field values on GetReportMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReportMetaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectWhitelistedDomainsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ListProjectWhitelistedDomainsResponseMultiError, or nil if none found.
-func (m *ListProjectWhitelistedDomainsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetReportMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReportMetaResponseMultiError, or nil if none found.
+func (m *GetReportMetaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectWhitelistedDomainsResponse) validate(all bool) error {
+func (m *GetReportMetaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDomains() {
-		_, _ = idx, item
+	// no validation rules for OpenUrl
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListProjectWhitelistedDomainsResponseValidationError{
-					field:  fmt.Sprintf("Domains[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for ExportUrl
 
-	}
+	// no validation rules for EditUrl
 
 	if len(errors) > 0 {
-		return ListProjectWhitelistedDomainsResponseMultiError(errors)
+		return GetReportMetaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectWhitelistedDomainsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// ListProjectWhitelistedDomainsResponse.ValidateAll() if the designated
+// GetReportMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetReportMetaResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListProjectWhitelistedDomainsResponseMultiError []error
+type GetReportMetaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectWhitelistedDomainsResponseMultiError) Error() string {
+func (m GetReportMetaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -22723,12 +30033,11 @@ func (m ListProjectWhitelistedDomainsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
+func (m GetReportMetaResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectWhitelistedDomainsResponseValidationError is the validation error
-// returned by ListProjectWhitelistedDomainsResponse.Validate if the
-// designated constraints aren't met.
-type ListProjectWhitelistedDomainsResponseValidationError struct {
+// GetReportMetaResponseValidationError is the validation error returned by
+// GetReportMetaResponse.Validate if the designated constraints aren't met.
+type GetReportMetaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -22736,24 +30045,24 @@ type ListProjectWhitelistedDomainsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Field() string { return e.field }
+func (e GetReportMetaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
+func (e GetReportMetaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
+func (e GetReportMetaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
+func (e GetReportMetaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectWhitelistedDomainsResponseValidationError) ErrorName() string {
-	return "ListProjectWhitelistedDomainsResponseValidationError"
+func (e GetReportMetaResponseValidationError) ErrorName() string {
+	return "GetReportMetaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectWhitelistedDomainsResponseValidationError) Error() string {
+func (e GetReportMetaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -22765,14 +30074,14 @@ func (e ListProjectWhitelistedDomainsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectWhitelistedDomainsResponse.%s: %s%s",
+		"invalid %sGetReportMetaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectWhitelistedDomainsResponseValidationError{}
+var _ error = GetReportMetaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -22780,24 +30089,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectWhitelistedDomainsResponseValidationError{}
+} = GetReportMetaResponseValidationError{}
 
-// Validate checks the field values on GetRepoMetaRequest with the rules
+// Validate checks the field values on GetAlertMetaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRepoMetaRequest) Validate() error {
+func (m *GetAlertMetaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoMetaRequest with the rules
+// ValidateAll checks the field values on GetAlertMetaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRepoMetaRequestMultiError, or nil if none found.
-func (m *GetRepoMetaRequest) ValidateAll() error {
+// GetAlertMetaRequestMultiError, or nil if none found.
+func (m *GetAlertMetaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoMetaRequest) validate(all bool) error {
+func (m *GetAlertMetaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -22808,20 +30117,53 @@ func (m *GetRepoMetaRequest) validate(all bool) error {
 
 	// no validation rules for Branch
 
+	// no validation rules for Alert
+
+	// no validation rules for Annotations
+
+	switch v := m.QueryFor.(type) {
+	case *GetAlertMetaRequest_QueryForUserId:
+		if v == nil {
+			err := GetAlertMetaRequestValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for QueryForUserId
+	case *GetAlertMetaRequest_QueryForUserEmail:
+		if v == nil {
+			err := GetAlertMetaRequestValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for QueryForUserEmail
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
-		return GetRepoMetaRequestMultiError(errors)
+		return GetAlertMetaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoMetaRequestMultiError is an error wrapping multiple validation errors
-// returned by GetRepoMetaRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetRepoMetaRequestMultiError []error
+// GetAlertMetaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAlertMetaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertMetaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoMetaRequestMultiError) Error() string {
+func (m GetAlertMetaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -22830,11 +30172,11 @@ func (m GetRepoMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoMetaRequestMultiError) AllErrors() []error { return m }
+func (m GetAlertMetaRequestMultiError) AllErrors() []error { return m }
 
-// GetRepoMetaRequestValidationError is the validation error returned by
-// GetRepoMetaRequest.Validate if the designated constraints aren't met.
-type GetRepoMetaRequestValidationError struct {
+// GetAlertMetaRequestValidationError is the validation error returned by
+// GetAlertMetaRequest.Validate if the designated constraints aren't met.
+type GetAlertMetaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -22842,24 +30184,24 @@ type GetRepoMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoMetaRequestValidationError) Field() string { return e.field }
+func (e GetAlertMetaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoMetaRequestValidationError) Reason() string { return e.reason }
+func (e GetAlertMetaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoMetaRequestValidationError) Cause() error { return e.cause }
+func (e GetAlertMetaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoMetaRequestValidationError) Key() bool { return e.key }
+func (e GetAlertMetaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoMetaRequestValidationError) ErrorName() string {
-	return "GetRepoMetaRequestValidationError"
+func (e GetAlertMetaRequestValidationError) ErrorName() string {
+	return "GetAlertMetaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRepoMetaRequestValidationError) Error() string {
+func (e GetAlertMetaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -22871,14 +30213,14 @@ func (e GetRepoMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoMetaRequest.%s: %s%s",
+		"invalid %sGetAlertMetaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoMetaRequestValidationError{}
+var _ error = GetAlertMetaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -22886,79 +30228,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoMetaRequestValidationError{}
+} = GetAlertMetaRequestValidationError{}
 
-// Validate checks the field values on GetRepoMetaResponse with the rules
+// Validate checks the field values on GetAlertMetaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRepoMetaResponse) Validate() error {
+func (m *GetAlertMetaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoMetaResponse with the rules
+// ValidateAll checks the field values on GetAlertMetaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRepoMetaResponseMultiError, or nil if none found.
-func (m *GetRepoMetaResponse) ValidateAll() error {
+// GetAlertMetaResponseMultiError, or nil if none found.
+func (m *GetAlertMetaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoMetaResponse) validate(all bool) error {
+func (m *GetAlertMetaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GitUrl
+	// no validation rules for OpenUrl
+
+	// no validation rules for EditUrl
 
 	if all {
-		switch v := interface{}(m.GetGitUrlExpiresOn()).(type) {
+		switch v := interface{}(m.GetQueryForAttributes()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRepoMetaResponseValidationError{
-					field:  "GitUrlExpiresOn",
+				errors = append(errors, GetAlertMetaResponseValidationError{
+					field:  "QueryForAttributes",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRepoMetaResponseValidationError{
-					field:  "GitUrlExpiresOn",
+				errors = append(errors, GetAlertMetaResponseValidationError{
+					field:  "QueryForAttributes",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetGitUrlExpiresOn()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetRepoMetaResponseValidationError{
-				field:  "GitUrlExpiresOn",
+			return GetAlertMetaResponseValidationError{
+				field:  "QueryForAttributes",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for GitSubpath
-
-	// no validation rules for ArchiveDownloadUrl
-
 	if len(errors) > 0 {
-		return GetRepoMetaResponseMultiError(errors)
+		return GetAlertMetaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetRepoMetaResponse.ValidateAll() if the designated
+// GetAlertMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAlertMetaResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetRepoMetaResponseMultiError []error
+type GetAlertMetaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoMetaResponseMultiError) Error() string {
+func (m GetAlertMetaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -22967,11 +30307,11 @@ func (m GetRepoMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoMetaResponseMultiError) AllErrors() []error { return m }
+func (m GetAlertMetaResponseMultiError) AllErrors() []error { return m }
 
-// GetRepoMetaResponseValidationError is the validation error returned by
-// GetRepoMetaResponse.Validate if the designated constraints aren't met.
-type GetRepoMetaResponseValidationError struct {
+// GetAlertMetaResponseValidationError is the validation error returned by
+// GetAlertMetaResponse.Validate if the designated constraints aren't met.
+type GetAlertMetaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -22979,24 +30319,24 @@ type GetRepoMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoMetaResponseValidationError) Field() string { return e.field }
+func (e GetAlertMetaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoMetaResponseValidationError) Reason() string { return e.reason }
+func (e GetAlertMetaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoMetaResponseValidationError) Cause() error { return e.cause }
+func (e GetAlertMetaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoMetaResponseValidationError) Key() bool { return e.key }
+func (e GetAlertMetaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoMetaResponseValidationError) ErrorName() string {
-	return "GetRepoMetaResponseValidationError"
+func (e GetAlertMetaResponseValidationError) ErrorName() string {
+	return "GetAlertMetaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRepoMetaResponseValidationError) Error() string {
+func (e GetAlertMetaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -23008,14 +30348,14 @@ func (e GetRepoMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoMetaResponse.%s: %s%s",
+		"invalid %sGetAlertMetaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoMetaResponseValidationError{}
+var _ error = GetAlertMetaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -23023,65 +30363,91 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoMetaResponseValidationError{}
+} = GetAlertMetaResponseValidationError{}
 
-// Validate checks the field values on PullVirtualRepoRequest with the rules
+// Validate checks the field values on ReportQueryUsageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PullVirtualRepoRequest) Validate() error {
+func (m *ReportQueryUsageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullVirtualRepoRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReportQueryUsageRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PullVirtualRepoRequestMultiError, or nil if none found.
-func (m *PullVirtualRepoRequest) ValidateAll() error {
+// ReportQueryUsageRequestMultiError, or nil if none found.
+func (m *ReportQueryUsageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullVirtualRepoRequest) validate(all bool) error {
+func (m *ReportQueryUsageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	if utf8.RuneCountInString(m.GetProjectId()) < 1 {
+		err := ReportQueryUsageRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Branch
 
-	if m.GetPageSize() != 0 {
+	for idx, item := range m.GetUsage() {
+		_, _ = idx, item
 
-		if m.GetPageSize() > 100 {
-			err := PullVirtualRepoRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 100",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportQueryUsageRequestValidationError{
+						field:  fmt.Sprintf("Usage[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportQueryUsageRequestValidationError{
+						field:  fmt.Sprintf("Usage[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportQueryUsageRequestValidationError{
+					field:  fmt.Sprintf("Usage[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
 
 	}
 
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return PullVirtualRepoRequestMultiError(errors)
+		return ReportQueryUsageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullVirtualRepoRequestMultiError is an error wrapping multiple validation
-// errors returned by PullVirtualRepoRequest.ValidateAll() if the designated
+// ReportQueryUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportQueryUsageRequest.ValidateAll() if the designated
 // constraints aren't met.
-type PullVirtualRepoRequestMultiError []error
+type ReportQueryUsageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullVirtualRepoRequestMultiError) Error() string {
+func (m ReportQueryUsageRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -23090,11 +30456,11 @@ func (m PullVirtualRepoRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullVirtualRepoRequestMultiError) AllErrors() []error { return m }
+func (m ReportQueryUsageRequestMultiError) AllErrors() []error { return m }
 
-// PullVirtualRepoRequestValidationError is the validation error returned by
-// PullVirtualRepoRequest.Validate if the designated constraints aren't met.
-type PullVirtualRepoRequestValidationError struct {
+// ReportQueryUsageRequestValidationError is the validation error returned by
+// ReportQueryUsageRequest.Validate if the designated constraints aren't met.
+type ReportQueryUsageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -23102,24 +30468,24 @@ type PullVirtualRepoRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullVirtualRepoRequestValidationError) Field() string { return e.field }
+func (e ReportQueryUsageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullVirtualRepoRequestValidationError) Reason() string { return e.reason }
+func (e ReportQueryUsageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullVirtualRepoRequestValidationError) Cause() error { return e.cause }
+func (e ReportQueryUsageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullVirtualRepoRequestValidationError) Key() bool { return e.key }
+func (e ReportQueryUsageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullVirtualRepoRequestValidationError) ErrorName() string {
-	return "PullVirtualRepoRequestValidationError"
+func (e ReportQueryUsageRequestValidationError) ErrorName() string {
+	return "ReportQueryUsageRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PullVirtualRepoRequestValidationError) Error() string {
+func (e ReportQueryUsageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -23131,14 +30497,14 @@ func (e PullVirtualRepoRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullVirtualRepoRequest.%s: %s%s",
+		"invalid %sReportQueryUsageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullVirtualRepoRequestValidationError{}
+var _ error = ReportQueryUsageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -23146,80 +30512,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullVirtualRepoRequestValidationError{}
+} = ReportQueryUsageRequestValidationError{}
 
-// Validate checks the field values on PullVirtualRepoResponse with the rules
+// Validate checks the field values on ReportQueryUsageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PullVirtualRepoResponse) Validate() error {
+func (m *ReportQueryUsageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullVirtualRepoResponse with the
+// ValidateAll checks the field values on ReportQueryUsageResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PullVirtualRepoResponseMultiError, or nil if none found.
-func (m *PullVirtualRepoResponse) ValidateAll() error {
+// ReportQueryUsageResponseMultiError, or nil if none found.
+func (m *ReportQueryUsageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullVirtualRepoResponse) validate(all bool) error {
+func (m *ReportQueryUsageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetFiles() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PullVirtualRepoResponseValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PullVirtualRepoResponseValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PullVirtualRepoResponseValidationError{
-					field:  fmt.Sprintf("Files[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return PullVirtualRepoResponseMultiError(errors)
+		return ReportQueryUsageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullVirtualRepoResponseMultiError is an error wrapping multiple validation
-// errors returned by PullVirtualRepoResponse.ValidateAll() if the designated
+// ReportQueryUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by ReportQueryUsageResponse.ValidateAll() if the designated
 // constraints aren't met.
-type PullVirtualRepoResponseMultiError []error
+type ReportQueryUsageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullVirtualRepoResponseMultiError) Error() string {
+func (m ReportQueryUsageResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -23228,11 +30558,11 @@ func (m PullVirtualRepoResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullVirtualRepoResponseMultiError) AllErrors() []error { return m }
+func (m ReportQueryUsageResponseMultiError) AllErrors() []error { return m }
 
-// PullVirtualRepoResponseValidationError is the validation error returned by
-// PullVirtualRepoResponse.Validate if the designated constraints aren't met.
-type PullVirtualRepoResponseValidationError struct {
+// ReportQueryUsageResponseValidationError is the validation error returned by
+// ReportQueryUsageResponse.Validate if the designated constraints aren't met.
+type ReportQueryUsageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -23240,24 +30570,24 @@ type PullVirtualRepoResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullVirtualRepoResponseValidationError) Field() string { return e.field }
+func (e ReportQueryUsageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullVirtualRepoResponseValidationError) Reason() string { return e.reason }
+func (e ReportQueryUsageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullVirtualRepoResponseValidationError) Cause() error { return e.cause }
+func (e ReportQueryUsageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullVirtualRepoResponseValidationError) Key() bool { return e.key }
+func (e ReportQueryUsageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullVirtualRepoResponseValidationError) ErrorName() string {
-	return "PullVirtualRepoResponseValidationError"
+func (e ReportQueryUsageResponseValidationError) ErrorName() string {
+	return "ReportQueryUsageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PullVirtualRepoResponseValidationError) Error() string {
+func (e ReportQueryUsageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -23269,14 +30599,14 @@ func (e PullVirtualRepoResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullVirtualRepoResponse.%s: %s%s",
+		"invalid %sReportQueryUsageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullVirtualRepoResponseValidationError{}
+var _ error = ReportQueryUsageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -23284,81 +30614,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullVirtualRepoResponseValidationError{}
+} = ReportQueryUsageResponseValidationError{}
 
-// Validate checks the field values on GetReportMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SendRuntimeHeartbeatRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReportMetaRequest) Validate() error {
+func (m *SendRuntimeHeartbeatRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReportMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SendRuntimeHeartbeatRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReportMetaRequestMultiError, or nil if none found.
-func (m *GetReportMetaRequest) ValidateAll() error {
+// SendRuntimeHeartbeatRequestMultiError, or nil if none found.
+func (m *SendRuntimeHeartbeatRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReportMetaRequest) validate(all bool) error {
+func (m *SendRuntimeHeartbeatRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
-
-	// no validation rules for Branch
-
-	// no validation rules for Report
-
-	// no validation rules for Annotations
-
-	if all {
-		switch v := interface{}(m.GetExecutionTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetReportMetaRequestValidationError{
-					field:  "ExecutionTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetReportMetaRequestValidationError{
-					field:  "ExecutionTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetProjectId()) < 1 {
+		err := SendRuntimeHeartbeatRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetReportMetaRequestValidationError{
-				field:  "ExecutionTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for RuntimeVersion
+
 	if len(errors) > 0 {
-		return GetReportMetaRequestMultiError(errors)
+		return SendRuntimeHeartbeatRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReportMetaRequestMultiError is an error wrapping multiple validation
-// errors returned by GetReportMetaRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetReportMetaRequestMultiError []error
+// SendRuntimeHeartbeatRequestMultiError is an error wrapping multiple
+// validation errors returned by SendRuntimeHeartbeatRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SendRuntimeHeartbeatRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReportMetaRequestMultiError) Error() string {
+func (m SendRuntimeHeartbeatRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -23367,11 +30673,12 @@ func (m GetReportMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReportMetaRequestMultiError) AllErrors() []error { return m }
+func (m SendRuntimeHeartbeatRequestMultiError) AllErrors() []error { return m }
 
-// GetReportMetaRequestValidationError is the validation error returned by
-// GetReportMetaRequest.Validate if the designated constraints aren't met.
-type GetReportMetaRequestValidationError struct {
+// SendRuntimeHeartbeatRequestValidationError is the validation error returned
+// by SendRuntimeHeartbeatRequest.Validate if the designated constraints
+// aren't met.
+type SendRuntimeHeartbeatRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -23379,24 +30686,24 @@ type GetReportMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReportMetaRequestValidationError) Field() string { return e.field }
+func (e SendRuntimeHeartbeatRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReportMetaRequestValidationError) Reason() string { return e.reason }
+func (e SendRuntimeHeartbeatRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReportMetaRequestValidationError) Cause() error { return e.cause }
+func (e SendRuntimeHeartbeatRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReportMetaRequestValidationError) Key() bool { return e.key }
+func (e SendRuntimeHeartbeatRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReportMetaRequestValidationError) ErrorName() string {
-	return "GetReportMetaRequestValidationError"
+func (e SendRuntimeHeartbeatRequestValidationError) ErrorName() string {
+	return "SendRuntimeHeartbeatRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReportMetaRequestValidationError) Error() string {
+func (e SendRuntimeHeartbeatRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -23408,14 +30715,14 @@ func (e GetReportMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReportMetaRequest.%s: %s%s",
+		"invalid %sSendRuntimeHeartbeatRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReportMetaRequestValidationError{}
+var _ error = SendRuntimeHeartbeatRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -23423,50 +30730,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReportMetaRequestValidationError{}
+} = SendRuntimeHeartbeatRequestValidationError{}
 
-// Validate checks the field values on GetReportMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SendRuntimeHeartbeatResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReportMetaResponse) Validate() error {
+func (m *SendRuntimeHeartbeatResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReportMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SendRuntimeHeartbeatResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReportMetaResponseMultiError, or nil if none found.
-func (m *GetReportMetaResponse) ValidateAll() error {
+// SendRuntimeHeartbeatResponseMultiError, or nil if none found.
+func (m *SendRuntimeHeartbeatResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReportMetaResponse) validate(all bool) error {
+func (m *SendRuntimeHeartbeatResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OpenUrl
-
-	// no validation rules for ExportUrl
-
-	// no validation rules for EditUrl
-
 	if len(errors) > 0 {
-		return GetReportMetaResponseMultiError(errors)
+		return SendRuntimeHeartbeatResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReportMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetReportMetaResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetReportMetaResponseMultiError []error
+// SendRuntimeHeartbeatResponseMultiError is an error wrapping multiple
+// validation errors returned by SendRuntimeHeartbeatResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SendRuntimeHeartbeatResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReportMetaResponseMultiError) Error() string {
+func (m SendRuntimeHeartbeatResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -23475,11 +30776,12 @@ func (m GetReportMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReportMetaResponseMultiError) AllErrors() []error { return m }
+func (m SendRuntimeHeartbeatResponseMultiError) AllErrors() []error { return m }
 
-// GetReportMetaResponseValidationError is the validation error returned by
-// GetReportMetaResponse.Validate if the designated constraints aren't met.
-type GetReportMetaResponseValidationError struct {
+// SendRuntimeHeartbeatResponseValidationError is the validation error returned
+// by SendRuntimeHeartbeatResponse.Validate if the designated constraints
+// aren't met.
+type SendRuntimeHeartbeatResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -23487,24 +30789,24 @@ type GetReportMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReportMetaResponseValidationError) Field() string { return e.field }
+func (e SendRuntimeHeartbeatResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReportMetaResponseValidationError) Reason() string { return e.reason }
+func (e SendRuntimeHeartbeatResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReportMetaResponseValidationError) Cause() error { return e.cause }
+func (e SendRuntimeHeartbeatResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReportMetaResponseValidationError) Key() bool { return e.key }
+func (e SendRuntimeHeartbeatResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReportMetaResponseValidationError) ErrorName() string {
-	return "GetReportMetaResponseValidationError"
+func (e SendRuntimeHeartbeatResponseValidationError) ErrorName() string {
+	return "SendRuntimeHeartbeatResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReportMetaResponseValidationError) Error() string {
+func (e SendRuntimeHeartbeatResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -23516,14 +30818,14 @@ func (e GetReportMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReportMetaResponse.%s: %s%s",
+		"invalid %sSendRuntimeHeartbeatResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReportMetaResponseValidationError{}
+var _ error = SendRuntimeHeartbeatResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -23531,81 +30833,94 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReportMetaResponseValidationError{}
+} = SendRuntimeHeartbeatResponseValidationError{}
 
-// Validate checks the field values on GetAlertMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SendResourceNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertMetaRequest) Validate() error {
+func (m *SendResourceNotificationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAlertMetaRequestMultiError, or nil if none found.
-func (m *GetAlertMetaRequest) ValidateAll() error {
+// ValidateAll checks the field values on SendResourceNotificationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendResourceNotificationRequestMultiError, or nil if none found.
+func (m *SendResourceNotificationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertMetaRequest) validate(all bool) error {
+func (m *SendResourceNotificationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	if utf8.RuneCountInString(m.GetProjectId()) < 1 {
+		err := SendResourceNotificationRequestValidationError{
+			field:  "ProjectId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Branch
 
-	// no validation rules for Alert
+	if _, ok := _SendResourceNotificationRequest_Type_InLookup[m.GetType()]; !ok {
+		err := SendResourceNotificationRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [NOTIFICATION_TYPE_ALERT_FIRED NOTIFICATION_TYPE_REPORT_READY]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResourceName()) < 1 {
+		err := SendResourceNotificationRequestValidationError{
+			field:  "ResourceName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Annotations
 
-	switch v := m.QueryFor.(type) {
-	case *GetAlertMetaRequest_QueryForUserId:
-		if v == nil {
-			err := GetAlertMetaRequestValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetTitle()) < 1 {
+		err := SendResourceNotificationRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at least 1 runes",
 		}
-		// no validation rules for QueryForUserId
-	case *GetAlertMetaRequest_QueryForUserEmail:
-		if v == nil {
-			err := GetAlertMetaRequestValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+		if !all {
+			return err
 		}
-		// no validation rules for QueryForUserEmail
-	default:
-		_ = v // ensures v is used
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Body
+
 	if len(errors) > 0 {
-		return GetAlertMetaRequestMultiError(errors)
+		return SendResourceNotificationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertMetaRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAlertMetaRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetAlertMetaRequestMultiError []error
+// SendResourceNotificationRequestMultiError is an error wrapping multiple
+// validation errors returned by SendResourceNotificationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SendResourceNotificationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertMetaRequestMultiError) Error() string {
+func (m SendResourceNotificationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -23614,11 +30929,12 @@ func (m GetAlertMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertMetaRequestMultiError) AllErrors() []error { return m }
+func (m SendResourceNotificationRequestMultiError) AllErrors() []error { return m }
 
-// GetAlertMetaRequestValidationError is the validation error returned by
-// GetAlertMetaRequest.Validate if the designated constraints aren't met.
-type GetAlertMetaRequestValidationError struct {
+// SendResourceNotificationRequestValidationError is the validation error
+// returned by SendResourceNotificationRequest.Validate if the designated
+// constraints aren't met.
+type SendResourceNotificationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -23626,24 +30942,24 @@ type GetAlertMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertMetaRequestValidationError) Field() string { return e.field }
+func (e SendResourceNotificationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertMetaRequestValidationError) Reason() string { return e.reason }
+func (e SendResourceNotificationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertMetaRequestValidationError) Cause() error { return e.cause }
+func (e SendResourceNotificationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertMetaRequestValidationError) Key() bool { return e.key }
+func (e SendResourceNotificationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertMetaRequestValidationError) ErrorName() string {
-	return "GetAlertMetaRequestValidationError"
+func (e SendResourceNotificationRequestValidationError) ErrorName() string {
+	return "SendResourceNotificationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertMetaRequestValidationError) Error() string {
+func (e SendResourceNotificationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -23655,14 +30971,14 @@ func (e GetAlertMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertMetaRequest.%s: %s%s",
+		"invalid %sSendResourceNotificationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertMetaRequestValidationError{}
+var _ error = SendResourceNotificationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -23670,77 +30986,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertMetaRequestValidationError{}
+} = SendResourceNotificationRequestValidationError{}
 
-// Validate checks the field values on GetAlertMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertMetaResponse) Validate() error {
+var _SendResourceNotificationRequest_Type_InLookup = map[NotificationType]struct{}{
+	3: {},
+	4: {},
+}
+
+// Validate checks the field values on SendResourceNotificationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SendResourceNotificationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAlertMetaResponseMultiError, or nil if none found.
-func (m *GetAlertMetaResponse) ValidateAll() error {
+// ValidateAll checks the field values on SendResourceNotificationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendResourceNotificationResponseMultiError, or nil if none found.
+func (m *SendResourceNotificationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertMetaResponse) validate(all bool) error {
+func (m *SendResourceNotificationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OpenUrl
-
-	// no validation rules for EditUrl
-
-	if all {
-		switch v := interface{}(m.GetQueryForAttributes()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetAlertMetaResponseValidationError{
-					field:  "QueryForAttributes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetAlertMetaResponseValidationError{
-					field:  "QueryForAttributes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetAlertMetaResponseValidationError{
-				field:  "QueryForAttributes",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for NotifiedUsers
 
 	if len(errors) > 0 {
-		return GetAlertMetaResponseMultiError(errors)
+		return SendResourceNotificationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAlertMetaResponse.ValidateAll() if the designated
+// SendResourceNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SendResourceNotificationResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetAlertMetaResponseMultiError []error
+type SendResourceNotificationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertMetaResponseMultiError) Error() string {
+func (m SendResourceNotificationResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -23749,11 +31041,12 @@ func (m GetAlertMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertMetaResponseMultiError) AllErrors() []error { return m }
+func (m SendResourceNotificationResponseMultiError) AllErrors() []error { return m }
 
-// GetAlertMetaResponseValidationError is the validation error returned by
-// GetAlertMetaResponse.Validate if the designated constraints aren't met.
-type GetAlertMetaResponseValidationError struct {
+// SendResourceNotificationResponseValidationError is the validation error
+// returned by SendResourceNotificationResponse.Validate if the designated
+// constraints aren't met.
+type SendResourceNotificationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -23761,24 +31054,24 @@ type GetAlertMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertMetaResponseValidationError) Field() string { return e.field }
+func (e SendResourceNotificationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertMetaResponseValidationError) Reason() string { return e.reason }
+func (e SendResourceNotificationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertMetaResponseValidationError) Cause() error { return e.cause }
+func (e SendResourceNotificationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertMetaResponseValidationError) Key() bool { return e.key }
+func (e SendResourceNotificationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertMetaResponseValidationError) ErrorName() string {
-	return "GetAlertMetaResponseValidationError"
+func (e SendResourceNotificationResponseValidationError) ErrorName() string {
+	return "SendResourceNotificationResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertMetaResponseValidationError) Error() string {
+func (e SendResourceNotificationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -23790,14 +31083,14 @@ func (e GetAlertMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertMetaResponse.%s: %s%s",
+		"invalid %sSendResourceNotificationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertMetaResponseValidationError{}
+var _ error = SendResourceNotificationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -23805,7 +31098,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertMetaResponseValidationError{}
+} = SendResourceNotificationResponseValidationError{}
 
 // Validate checks the field values on CreateReportRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -27458,6 +34751,39 @@ func (m *Organization) validate(all bool) error {
 
 	// no validation rules for BillingCustomerId
 
+	// no validation rules for ExternalId
+
+	// no validation rules for MaintenanceWindow
+
+	if all {
+		switch v := interface{}(m.GetBranding()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Branding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Branding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBranding()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "Branding",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
@@ -27593,6 +34919,116 @@ var _ interface {
 	ErrorName() string
 } = OrganizationValidationError{}
 
+// Validate checks the field values on OrganizationBranding with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationBranding) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrganizationBranding with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationBrandingMultiError, or nil if none found.
+func (m *OrganizationBranding) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrganizationBranding) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LogoUrl
+
+	// no validation rules for PrimaryColor
+
+	// no validation rules for SupportEmail
+
+	// no validation rules for CustomDomain
+
+	if len(errors) > 0 {
+		return OrganizationBrandingMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationBrandingMultiError is an error wrapping multiple validation
+// errors returned by OrganizationBranding.ValidateAll() if the designated
+// constraints aren't met.
+type OrganizationBrandingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationBrandingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationBrandingMultiError) AllErrors() []error { return m }
+
+// OrganizationBrandingValidationError is the validation error returned by
+// OrganizationBranding.Validate if the designated constraints aren't met.
+type OrganizationBrandingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationBrandingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationBrandingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationBrandingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationBrandingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationBrandingValidationError) ErrorName() string {
+	return "OrganizationBrandingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationBrandingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationBranding.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationBrandingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationBrandingValidationError{}
+
 // Validate checks the field values on Subscription with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -28097,35 +35533,277 @@ func (m *Project) validate(all bool) error {
 
 	// no validation rules for Provisioner
 
-	// no validation rules for GithubUrl
+	// no validation rules for GithubUrl
+
+	// no validation rules for Subpath
+
+	// no validation rules for ProdBranch
+
+	// no validation rules for ArchiveAssetId
+
+	// no validation rules for ProdOlapDriver
+
+	// no validation rules for ProdOlapDsn
+
+	// no validation rules for ProdSlots
+
+	// no validation rules for ProdDeploymentId
+
+	// no validation rules for FrontendUrl
+
+	// no validation rules for ProdTtlSeconds
+
+	// no validation rules for Annotations
+
+	// no validation rules for Labels
+
+	// no validation rules for ProdVersion
+
+	// no validation rules for DeployTrigger
+
+	// no validation rules for DeploySchedule
+
+	// no validation rules for DeployBlueGreen
+
+	// no validation rules for PendingDeploySha
+
+	if all {
+		switch v := interface{}(m.GetPendingDeployOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "PendingDeployOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "PendingDeployOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPendingDeployOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectValidationError{
+				field:  "PendingDeployOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExternalId
+
+	// no validation rules for MaintenanceWindow
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectMultiError is an error wrapping multiple validation errors returned
+// by Project.ValidateAll() if the designated constraints aren't met.
+type ProjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectMultiError) AllErrors() []error { return m }
+
+// ProjectValidationError is the validation error returned by Project.Validate
+// if the designated constraints aren't met.
+type ProjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectValidationError{}
+
+// Validate checks the field values on Job with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Job) Validate() error {
+	return m.validate(false)
+}
 
-	// no validation rules for Subpath
+// ValidateAll checks the field values on Job with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobMultiError, or nil if none found.
+func (m *Job) ValidateAll() error {
+	return m.validate(true)
+}
 
-	// no validation rules for ProdBranch
+func (m *Job) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-	// no validation rules for ArchiveAssetId
+	var errors []error
 
-	// no validation rules for ProdOlapDriver
+	// no validation rules for Id
 
-	// no validation rules for ProdOlapDsn
+	// no validation rules for Kind
 
-	// no validation rules for ProdSlots
+	// no validation rules for Args
 
-	// no validation rules for ProdDeploymentId
+	// no validation rules for Status
 
-	// no validation rules for FrontendUrl
+	// no validation rules for Attempts
 
-	// no validation rules for ProdTtlSeconds
+	// no validation rules for MaxAttempts
 
-	// no validation rules for Annotations
+	// no validation rules for Error
 
-	// no validation rules for ProdVersion
+	if all {
+		switch v := interface{}(m.GetRunAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "RunAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "RunAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRunAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "RunAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
+				errors = append(errors, JobValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -28133,7 +35811,7 @@ func (m *Project) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
+				errors = append(errors, JobValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -28142,7 +35820,7 @@ func (m *Project) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
+			return JobValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -28154,7 +35832,7 @@ func (m *Project) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
+				errors = append(errors, JobValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -28162,7 +35840,7 @@ func (m *Project) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
+				errors = append(errors, JobValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -28171,7 +35849,7 @@ func (m *Project) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
+			return JobValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -28179,19 +35857,48 @@ func (m *Project) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetFinishedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "FinishedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "FinishedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "FinishedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ProjectMultiError(errors)
+		return JobMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectMultiError is an error wrapping multiple validation errors returned
-// by Project.ValidateAll() if the designated constraints aren't met.
-type ProjectMultiError []error
+// JobMultiError is an error wrapping multiple validation errors returned by
+// Job.ValidateAll() if the designated constraints aren't met.
+type JobMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectMultiError) Error() string {
+func (m JobMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -28200,11 +35907,11 @@ func (m ProjectMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectMultiError) AllErrors() []error { return m }
+func (m JobMultiError) AllErrors() []error { return m }
 
-// ProjectValidationError is the validation error returned by Project.Validate
-// if the designated constraints aren't met.
-type ProjectValidationError struct {
+// JobValidationError is the validation error returned by Job.Validate if the
+// designated constraints aren't met.
+type JobValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -28212,22 +35919,22 @@ type ProjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectValidationError) Field() string { return e.field }
+func (e JobValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectValidationError) Reason() string { return e.reason }
+func (e JobValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectValidationError) Cause() error { return e.cause }
+func (e JobValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectValidationError) Key() bool { return e.key }
+func (e JobValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
+func (e JobValidationError) ErrorName() string { return "JobValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProjectValidationError) Error() string {
+func (e JobValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -28239,14 +35946,14 @@ func (e ProjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProject.%s: %s%s",
+		"invalid %sJob.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectValidationError{}
+var _ error = JobValidationError{}
 
 var _ interface {
 	Field() string
@@ -28254,7 +35961,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectValidationError{}
+} = JobValidationError{}
 
 // Validate checks the field values on Deployment with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -28352,6 +36059,8 @@ func (m *Deployment) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for RuntimeConfig
+
 	if len(errors) > 0 {
 		return DeploymentMultiError(errors)
 	}
@@ -28429,6 +36138,141 @@ var _ interface {
 	ErrorName() string
 } = DeploymentValidationError{}
 
+// Validate checks the field values on ProjectHealth with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProjectHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectHealth with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProjectHealthMultiError, or
+// nil if none found.
+func (m *ProjectHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ErroredResources
+
+	// no validation rules for ParseErrors
+
+	if all {
+		switch v := interface{}(m.GetLastReconciledOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectHealthValidationError{
+					field:  "LastReconciledOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectHealthValidationError{
+					field:  "LastReconciledOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastReconciledOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectHealthValidationError{
+				field:  "LastReconciledOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reconciling
+
+	if len(errors) > 0 {
+		return ProjectHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectHealthMultiError is an error wrapping multiple validation errors
+// returned by ProjectHealth.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectHealthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectHealthMultiError) AllErrors() []error { return m }
+
+// ProjectHealthValidationError is the validation error returned by
+// ProjectHealth.Validate if the designated constraints aren't met.
+type ProjectHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectHealthValidationError) ErrorName() string { return "ProjectHealthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProjectHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectHealthValidationError{}
+
 // Validate checks the field values on OrganizationPermissions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -28771,6 +36615,68 @@ func (m *MemberUser) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetLastLoginOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberUserValidationError{
+					field:  "LastLoginOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberUserValidationError{
+					field:  "LastLoginOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastLoginOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberUserValidationError{
+				field:  "LastLoginOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastActiveOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberUserValidationError{
+					field:  "LastActiveOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberUserValidationError{
+					field:  "LastActiveOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastActiveOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberUserValidationError{
+				field:  "LastActiveOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastActiveProject
+
+	// no validation rules for SeatType
+
 	if len(errors) > 0 {
 		return MemberUserMultiError(errors)
 	}
@@ -29059,6 +36965,345 @@ var _ interface {
 	ErrorName() string
 } = WhitelistedDomainValidationError{}
 
+// Validate checks the field values on OrganizationNotifier with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationNotifier) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrganizationNotifier with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationNotifierMultiError, or nil if none found.
+func (m *OrganizationNotifier) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrganizationNotifier) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Connector
+
+	if all {
+		switch v := interface{}(m.GetProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationNotifierValidationError{
+					field:  "Properties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationNotifierValidationError{
+					field:  "Properties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationNotifierValidationError{
+				field:  "Properties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationNotifierValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationNotifierValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationNotifierValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationNotifierValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationNotifierValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationNotifierValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrganizationNotifierMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationNotifierMultiError is an error wrapping multiple validation
+// errors returned by OrganizationNotifier.ValidateAll() if the designated
+// constraints aren't met.
+type OrganizationNotifierMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationNotifierMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationNotifierMultiError) AllErrors() []error { return m }
+
+// OrganizationNotifierValidationError is the validation error returned by
+// OrganizationNotifier.Validate if the designated constraints aren't met.
+type OrganizationNotifierValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationNotifierValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationNotifierValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationNotifierValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationNotifierValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationNotifierValidationError) ErrorName() string {
+	return "OrganizationNotifierValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationNotifierValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationNotifier.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationNotifierValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationNotifierValidationError{}
+
+// Validate checks the field values on Notification with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Notification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Notification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NotificationMultiError, or
+// nil if none found.
+func (m *Notification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Notification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for Title
+
+	// no validation rules for Body
+
+	// no validation rules for Url
+
+	// no validation rules for OrganizationId
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Read
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationMultiError is an error wrapping multiple validation errors
+// returned by Notification.ValidateAll() if the designated constraints aren't met.
+type NotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationMultiError) AllErrors() []error { return m }
+
+// NotificationValidationError is the validation error returned by
+// Notification.Validate if the designated constraints aren't met.
+type NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationValidationError) ErrorName() string { return "NotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationValidationError{}
+
 // Validate checks the field values on Bookmark with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -29650,6 +37895,204 @@ var _ interface {
 	ErrorName() string
 } = MagicAuthTokenValidationError{}
 
+// Validate checks the field values on ProjectToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProjectToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProjectTokenMultiError, or
+// nil if none found.
+func (m *ProjectToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Role
+
+	// no validation rules for DisplayName
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectTokenValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectTokenValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectTokenValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectTokenValidationError{
+					field:  "ExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectTokenValidationError{
+					field:  "ExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectTokenValidationError{
+				field:  "ExpiresOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUsedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectTokenValidationError{
+					field:  "UsedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectTokenValidationError{
+					field:  "UsedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectTokenValidationError{
+				field:  "UsedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedByUserId
+
+	// no validation rules for CreatedByUserEmail
+
+	if len(errors) > 0 {
+		return ProjectTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectTokenMultiError is an error wrapping multiple validation errors
+// returned by ProjectToken.ValidateAll() if the designated constraints aren't met.
+type ProjectTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectTokenMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectTokenMultiError) AllErrors() []error { return m }
+
+// ProjectTokenValidationError is the validation error returned by
+// ProjectToken.Validate if the designated constraints aren't met.
+type ProjectTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectTokenValidationError) ErrorName() string { return "ProjectTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProjectTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectTokenValidationError{}
+
 // Validate checks the field values on VirtualFile with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -30595,3 +39038,168 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MemberUsergroupValidationError{}
+
+// Validate checks the field values on QueryUsage with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueryUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueryUsageMultiError, or
+// nil if none found.
+func (m *QueryUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Subject
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryUsageValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryUsageValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryUsageValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryUsageValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryUsageValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryUsageValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Queries
+
+	// no validation rules for ExecutionTimeMs
+
+	// no validation rules for ScannedBytes
+
+	if len(errors) > 0 {
+		return QueryUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryUsageMultiError is an error wrapping multiple validation errors
+// returned by QueryUsage.ValidateAll() if the designated constraints aren't met.
+type QueryUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryUsageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryUsageMultiError) AllErrors() []error { return m }
+
+// QueryUsageValidationError is the validation error returned by
+// QueryUsage.Validate if the designated constraints aren't met.
+type QueryUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryUsageValidationError) ErrorName() string { return "QueryUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryUsageValidationError{}
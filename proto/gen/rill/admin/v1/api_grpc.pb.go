@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion8
 
 const (
 	AdminService_Ping_FullMethodName                                  = "/rill.admin.v1.AdminService/Ping"
+	AdminService_ListRuntimeVersions_FullMethodName                   = "/rill.admin.v1.AdminService/ListRuntimeVersions"
 	AdminService_ListOrganizations_FullMethodName                     = "/rill.admin.v1.AdminService/ListOrganizations"
 	AdminService_GetOrganization_FullMethodName                       = "/rill.admin.v1.AdminService/GetOrganization"
 	AdminService_CreateOrganization_FullMethodName                    = "/rill.admin.v1.AdminService/CreateOrganization"
@@ -32,12 +33,15 @@ const (
 	AdminService_GetProjectByID_FullMethodName                        = "/rill.admin.v1.AdminService/GetProjectByID"
 	AdminService_SearchProjectNames_FullMethodName                    = "/rill.admin.v1.AdminService/SearchProjectNames"
 	AdminService_GetProjectVariables_FullMethodName                   = "/rill.admin.v1.AdminService/GetProjectVariables"
+	AdminService_GetProjectUsage_FullMethodName                       = "/rill.admin.v1.AdminService/GetProjectUsage"
 	AdminService_CreateProject_FullMethodName                         = "/rill.admin.v1.AdminService/CreateProject"
+	AdminService_CloneProject_FullMethodName                          = "/rill.admin.v1.AdminService/CloneProject"
 	AdminService_DeleteProject_FullMethodName                         = "/rill.admin.v1.AdminService/DeleteProject"
 	AdminService_UpdateProject_FullMethodName                         = "/rill.admin.v1.AdminService/UpdateProject"
 	AdminService_UpdateProjectVariables_FullMethodName                = "/rill.admin.v1.AdminService/UpdateProjectVariables"
 	AdminService_CreateAsset_FullMethodName                           = "/rill.admin.v1.AdminService/CreateAsset"
 	AdminService_TriggerReconcile_FullMethodName                      = "/rill.admin.v1.AdminService/TriggerReconcile"
+	AdminService_ApproveDeployment_FullMethodName                     = "/rill.admin.v1.AdminService/ApproveDeployment"
 	AdminService_TriggerRefreshSources_FullMethodName                 = "/rill.admin.v1.AdminService/TriggerRefreshSources"
 	AdminService_TriggerRedeploy_FullMethodName                       = "/rill.admin.v1.AdminService/TriggerRedeploy"
 	AdminService_ListOrganizationMemberUsers_FullMethodName           = "/rill.admin.v1.AdminService/ListOrganizationMemberUsers"
@@ -51,6 +55,7 @@ const (
 	AdminService_AddProjectMemberUser_FullMethodName                  = "/rill.admin.v1.AdminService/AddProjectMemberUser"
 	AdminService_RemoveProjectMemberUser_FullMethodName               = "/rill.admin.v1.AdminService/RemoveProjectMemberUser"
 	AdminService_SetProjectMemberUserRole_FullMethodName              = "/rill.admin.v1.AdminService/SetProjectMemberUserRole"
+	AdminService_ImportMemberUsers_FullMethodName                     = "/rill.admin.v1.AdminService/ImportMemberUsers"
 	AdminService_CreateUsergroup_FullMethodName                       = "/rill.admin.v1.AdminService/CreateUsergroup"
 	AdminService_GetUsergroup_FullMethodName                          = "/rill.admin.v1.AdminService/GetUsergroup"
 	AdminService_RenameUsergroup_FullMethodName                       = "/rill.admin.v1.AdminService/RenameUsergroup"
@@ -76,6 +81,9 @@ const (
 	AdminService_CreateWhitelistedDomain_FullMethodName               = "/rill.admin.v1.AdminService/CreateWhitelistedDomain"
 	AdminService_RemoveWhitelistedDomain_FullMethodName               = "/rill.admin.v1.AdminService/RemoveWhitelistedDomain"
 	AdminService_ListWhitelistedDomains_FullMethodName                = "/rill.admin.v1.AdminService/ListWhitelistedDomains"
+	AdminService_ListOrganizationNotifiers_FullMethodName             = "/rill.admin.v1.AdminService/ListOrganizationNotifiers"
+	AdminService_CreateOrganizationNotifier_FullMethodName            = "/rill.admin.v1.AdminService/CreateOrganizationNotifier"
+	AdminService_DeleteOrganizationNotifier_FullMethodName            = "/rill.admin.v1.AdminService/DeleteOrganizationNotifier"
 	AdminService_GetUser_FullMethodName                               = "/rill.admin.v1.AdminService/GetUser"
 	AdminService_SearchUsers_FullMethodName                           = "/rill.admin.v1.AdminService/SearchUsers"
 	AdminService_SearchProjectUsers_FullMethodName                    = "/rill.admin.v1.AdminService/SearchProjectUsers"
@@ -88,9 +96,14 @@ const (
 	AdminService_SudoUpdateOrganizationQuotas_FullMethodName          = "/rill.admin.v1.AdminService/SudoUpdateOrganizationQuotas"
 	AdminService_SudoUpdateOrganizationBillingCustomer_FullMethodName = "/rill.admin.v1.AdminService/SudoUpdateOrganizationBillingCustomer"
 	AdminService_SudoUpdateAnnotations_FullMethodName                 = "/rill.admin.v1.AdminService/SudoUpdateAnnotations"
+	AdminService_SudoMigrateDeployment_FullMethodName                 = "/rill.admin.v1.AdminService/SudoMigrateDeployment"
+	AdminService_SudoUpdateRuntimeConfig_FullMethodName               = "/rill.admin.v1.AdminService/SudoUpdateRuntimeConfig"
+	AdminService_ListJobs_FullMethodName                              = "/rill.admin.v1.AdminService/ListJobs"
 	AdminService_CreateProjectWhitelistedDomain_FullMethodName        = "/rill.admin.v1.AdminService/CreateProjectWhitelistedDomain"
 	AdminService_RemoveProjectWhitelistedDomain_FullMethodName        = "/rill.admin.v1.AdminService/RemoveProjectWhitelistedDomain"
 	AdminService_ListProjectWhitelistedDomains_FullMethodName         = "/rill.admin.v1.AdminService/ListProjectWhitelistedDomains"
+	AdminService_ListProjectPublicMetricsViews_FullMethodName         = "/rill.admin.v1.AdminService/ListProjectPublicMetricsViews"
+	AdminService_SetProjectPublicMetricsViews_FullMethodName          = "/rill.admin.v1.AdminService/SetProjectPublicMetricsViews"
 	AdminService_ListServices_FullMethodName                          = "/rill.admin.v1.AdminService/ListServices"
 	AdminService_CreateService_FullMethodName                         = "/rill.admin.v1.AdminService/CreateService"
 	AdminService_UpdateService_FullMethodName                         = "/rill.admin.v1.AdminService/UpdateService"
@@ -101,7 +114,17 @@ const (
 	AdminService_IssueMagicAuthToken_FullMethodName                   = "/rill.admin.v1.AdminService/IssueMagicAuthToken"
 	AdminService_ListMagicAuthTokens_FullMethodName                   = "/rill.admin.v1.AdminService/ListMagicAuthTokens"
 	AdminService_RevokeMagicAuthToken_FullMethodName                  = "/rill.admin.v1.AdminService/RevokeMagicAuthToken"
+	AdminService_IssueProjectToken_FullMethodName                     = "/rill.admin.v1.AdminService/IssueProjectToken"
+	AdminService_ListProjectTokens_FullMethodName                     = "/rill.admin.v1.AdminService/ListProjectTokens"
+	AdminService_RevokeProjectToken_FullMethodName                    = "/rill.admin.v1.AdminService/RevokeProjectToken"
 	AdminService_UpdateUserPreferences_FullMethodName                 = "/rill.admin.v1.AdminService/UpdateUserPreferences"
+	AdminService_StarProject_FullMethodName                           = "/rill.admin.v1.AdminService/StarProject"
+	AdminService_UnstarProject_FullMethodName                         = "/rill.admin.v1.AdminService/UnstarProject"
+	AdminService_StarDashboard_FullMethodName                         = "/rill.admin.v1.AdminService/StarDashboard"
+	AdminService_UnstarDashboard_FullMethodName                       = "/rill.admin.v1.AdminService/UnstarDashboard"
+	AdminService_ListStarred_FullMethodName                           = "/rill.admin.v1.AdminService/ListStarred"
+	AdminService_ListNotifications_FullMethodName                     = "/rill.admin.v1.AdminService/ListNotifications"
+	AdminService_AckNotifications_FullMethodName                      = "/rill.admin.v1.AdminService/AckNotifications"
 	AdminService_ListBookmarks_FullMethodName                         = "/rill.admin.v1.AdminService/ListBookmarks"
 	AdminService_GetBookmark_FullMethodName                           = "/rill.admin.v1.AdminService/GetBookmark"
 	AdminService_CreateBookmark_FullMethodName                        = "/rill.admin.v1.AdminService/CreateBookmark"
@@ -111,6 +134,9 @@ const (
 	AdminService_PullVirtualRepo_FullMethodName                       = "/rill.admin.v1.AdminService/PullVirtualRepo"
 	AdminService_GetReportMeta_FullMethodName                         = "/rill.admin.v1.AdminService/GetReportMeta"
 	AdminService_GetAlertMeta_FullMethodName                          = "/rill.admin.v1.AdminService/GetAlertMeta"
+	AdminService_ReportQueryUsage_FullMethodName                      = "/rill.admin.v1.AdminService/ReportQueryUsage"
+	AdminService_SendRuntimeHeartbeat_FullMethodName                  = "/rill.admin.v1.AdminService/SendRuntimeHeartbeat"
+	AdminService_SendResourceNotification_FullMethodName              = "/rill.admin.v1.AdminService/SendResourceNotification"
 	AdminService_CreateReport_FullMethodName                          = "/rill.admin.v1.AdminService/CreateReport"
 	AdminService_EditReport_FullMethodName                            = "/rill.admin.v1.AdminService/EditReport"
 	AdminService_UnsubscribeReport_FullMethodName                     = "/rill.admin.v1.AdminService/UnsubscribeReport"
@@ -132,6 +158,8 @@ const (
 type AdminServiceClient interface {
 	// Ping returns information about the server
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	// ListRuntimeVersions lists the runtime versions that projects can be pinned to (using prod_version in UpdateProject)
+	ListRuntimeVersions(ctx context.Context, in *ListRuntimeVersionsRequest, opts ...grpc.CallOption) (*ListRuntimeVersionsResponse, error)
 	// ListOrganizations lists all the organizations currently managed by the admin
 	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
 	// GetOrganization returns information about a specific organization
@@ -156,18 +184,26 @@ type AdminServiceClient interface {
 	SearchProjectNames(ctx context.Context, in *SearchProjectNamesRequest, opts ...grpc.CallOption) (*SearchProjectNamesResponse, error)
 	// GetProjectVariables returns project variables. NOTE: Get project API doesn't return variables.
 	GetProjectVariables(ctx context.Context, in *GetProjectVariablesRequest, opts ...grpc.CallOption) (*GetProjectVariablesResponse, error)
+	// GetProjectUsage returns the query usage of a project aggregated by subject (user, service or token)
+	GetProjectUsage(ctx context.Context, in *GetProjectUsageRequest, opts ...grpc.CallOption) (*GetProjectUsageResponse, error)
 	// CreateProject creates a new project
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
+	// CloneProject creates a new project from the files, settings and (optionally) members of an existing project
+	CloneProject(ctx context.Context, in *CloneProjectRequest, opts ...grpc.CallOption) (*CloneProjectResponse, error)
 	// DeleteProject deletes an project
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
 	// UpdateProject updates a project
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
 	// UpdateProjectVariables updates variables for a project. NOTE: Update project API doesn't update variables.
+	// The variables are validated against the project's connectors before they're applied.
+	// Running deployments are only restarted if the variables changed, and the response lists the resources affected by the change.
 	UpdateProjectVariables(ctx context.Context, in *UpdateProjectVariablesRequest, opts ...grpc.CallOption) (*UpdateProjectVariablesResponse, error)
 	// CreateAsset returns a one time signed URL using which any asset can be uploaded.
 	CreateAsset(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*CreateAssetResponse, error)
 	// TriggerReconcile triggers reconcile for the project's prod deployment
 	TriggerReconcile(ctx context.Context, in *TriggerReconcileRequest, opts ...grpc.CallOption) (*TriggerReconcileResponse, error)
+	// ApproveDeployment deploys the latest commit pushed to a project's production branch that is being held back by the project's deploy trigger.
+	ApproveDeployment(ctx context.Context, in *ApproveDeploymentRequest, opts ...grpc.CallOption) (*ApproveDeploymentResponse, error)
 	// TriggerRefreshSources refresh the source for production deployment
 	TriggerRefreshSources(ctx context.Context, in *TriggerRefreshSourcesRequest, opts ...grpc.CallOption) (*TriggerRefreshSourcesResponse, error)
 	// TriggerRedeploy creates a new deployment and teardown the old deployment for production deployment
@@ -194,6 +230,10 @@ type AdminServiceClient interface {
 	RemoveProjectMemberUser(ctx context.Context, in *RemoveProjectMemberUserRequest, opts ...grpc.CallOption) (*RemoveProjectMemberUserResponse, error)
 	// SetProjectMemberUserRole sets the role for the member
 	SetProjectMemberUserRole(ctx context.Context, in *SetProjectMemberUserRoleRequest, opts ...grpc.CallOption) (*SetProjectMemberUserRoleResponse, error)
+	// ImportMemberUsers adds many users to an organization or project in one transaction.
+	// Existing users are added as members and other users are invited.
+	// If any row is invalid, no users are added and the response reports the error for each row.
+	ImportMemberUsers(ctx context.Context, in *ImportMemberUsersRequest, opts ...grpc.CallOption) (*ImportMemberUsersResponse, error)
 	// CreateUsergroup creates a user group in the organization
 	CreateUsergroup(ctx context.Context, in *CreateUsergroupRequest, opts ...grpc.CallOption) (*CreateUsergroupResponse, error)
 	// GetUsergroups returns the user group details
@@ -246,6 +286,12 @@ type AdminServiceClient interface {
 	RemoveWhitelistedDomain(ctx context.Context, in *RemoveWhitelistedDomainRequest, opts ...grpc.CallOption) (*RemoveWhitelistedDomainResponse, error)
 	// ListWhitelistedDomains lists all the whitelisted domains for the organization
 	ListWhitelistedDomains(ctx context.Context, in *ListWhitelistedDomainsRequest, opts ...grpc.CallOption) (*ListWhitelistedDomainsResponse, error)
+	// ListOrganizationNotifiers lists the notifiers that receive notifications about events in the organization
+	ListOrganizationNotifiers(ctx context.Context, in *ListOrganizationNotifiersRequest, opts ...grpc.CallOption) (*ListOrganizationNotifiersResponse, error)
+	// CreateOrganizationNotifier adds a notifier to the organization
+	CreateOrganizationNotifier(ctx context.Context, in *CreateOrganizationNotifierRequest, opts ...grpc.CallOption) (*CreateOrganizationNotifierResponse, error)
+	// DeleteOrganizationNotifier removes a notifier from the organization
+	DeleteOrganizationNotifier(ctx context.Context, in *DeleteOrganizationNotifierRequest, opts ...grpc.CallOption) (*DeleteOrganizationNotifierResponse, error)
 	// GetUser returns user by email
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	// GetUsersByEmail returns users by email
@@ -270,12 +316,25 @@ type AdminServiceClient interface {
 	SudoUpdateOrganizationBillingCustomer(ctx context.Context, in *SudoUpdateOrganizationBillingCustomerRequest, opts ...grpc.CallOption) (*SudoUpdateOrganizationBillingCustomerResponse, error)
 	// SudoUpdateAnnotations endpoint for superusers to update project annotations
 	SudoUpdateAnnotations(ctx context.Context, in *SudoUpdateAnnotationsRequest, opts ...grpc.CallOption) (*SudoUpdateAnnotationsResponse, error)
+	// SudoMigrateDeployment moves a project's production deployment to another runtime host.
+	// It copies the deployment's data and catalog instead of re-ingesting its sources, so the project is only read-only while the copy is made.
+	SudoMigrateDeployment(ctx context.Context, in *SudoMigrateDeploymentRequest, opts ...grpc.CallOption) (*SudoMigrateDeploymentResponse, error)
+	// SudoUpdateRuntimeConfig sets instance config overrides on a project's production deployment, e.g. "rill.metrics.max_execution_timeout_seconds" or "rill.features.<flag>".
+	// The overrides take precedence over the project's variables and are applied without redeploying.
+	SudoUpdateRuntimeConfig(ctx context.Context, in *SudoUpdateRuntimeConfigRequest, opts ...grpc.CallOption) (*SudoUpdateRuntimeConfigResponse, error)
+	// ListJobs lists jobs in the admin job queue, ordered by descending creation time. It's only available to superusers.
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
 	// CreateProjectWhitelistedDomain adds a domain to the project's whitelisted
 	CreateProjectWhitelistedDomain(ctx context.Context, in *CreateProjectWhitelistedDomainRequest, opts ...grpc.CallOption) (*CreateProjectWhitelistedDomainResponse, error)
 	// RemoveProjectWhitelistedDomain removes a domain from the project's whitelisted
 	RemoveProjectWhitelistedDomain(ctx context.Context, in *RemoveProjectWhitelistedDomainRequest, opts ...grpc.CallOption) (*RemoveProjectWhitelistedDomainResponse, error)
 	// ListWhitelistedDomains lists all the whitelisted domains of the project
 	ListProjectWhitelistedDomains(ctx context.Context, in *ListProjectWhitelistedDomainsRequest, opts ...grpc.CallOption) (*ListProjectWhitelistedDomainsResponse, error)
+	// ListProjectPublicMetricsViews lists the metrics views of a project that can be accessed by anyone, even if the project is private.
+	ListProjectPublicMetricsViews(ctx context.Context, in *ListProjectPublicMetricsViewsRequest, opts ...grpc.CallOption) (*ListProjectPublicMetricsViewsResponse, error)
+	// SetProjectPublicMetricsViews replaces the metrics views of a project that can be accessed by anyone.
+	// Anonymous users and users who are not members of the project get access to only those metrics views (and themes).
+	SetProjectPublicMetricsViews(ctx context.Context, in *SetProjectPublicMetricsViewsRequest, opts ...grpc.CallOption) (*SetProjectPublicMetricsViewsResponse, error)
 	// ListService returns all the services per organization
 	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error)
 	// CreateService creates a new service per organization
@@ -296,8 +355,32 @@ type AdminServiceClient interface {
 	ListMagicAuthTokens(ctx context.Context, in *ListMagicAuthTokensRequest, opts ...grpc.CallOption) (*ListMagicAuthTokensResponse, error)
 	// RevokeMagicAuthToken revokes a magic auth token.
 	RevokeMagicAuthToken(ctx context.Context, in *RevokeMagicAuthTokenRequest, opts ...grpc.CallOption) (*RevokeMagicAuthTokenResponse, error)
-	// UpdateUserPreferences updates the preferences for the user
+	// IssueProjectToken creates a long-lived auth token that grants the permissions of a project role in a specific project.
+	// It enables consuming the project's dashboards and APIs from scripts without a user login.
+	IssueProjectToken(ctx context.Context, in *IssueProjectTokenRequest, opts ...grpc.CallOption) (*IssueProjectTokenResponse, error)
+	// ListProjectTokens lists the project tokens for a specific project.
+	ListProjectTokens(ctx context.Context, in *ListProjectTokensRequest, opts ...grpc.CallOption) (*ListProjectTokensResponse, error)
+	// RevokeProjectToken revokes a project token.
+	RevokeProjectToken(ctx context.Context, in *RevokeProjectTokenRequest, opts ...grpc.CallOption) (*RevokeProjectTokenResponse, error)
+	// UpdateUserPreferences updates the preferences for the user.
+	// Only the preferences that are set in the request are updated.
 	UpdateUserPreferences(ctx context.Context, in *UpdateUserPreferencesRequest, opts ...grpc.CallOption) (*UpdateUserPreferencesResponse, error)
+	// StarProject stars a project for the current user.
+	// Starred projects are the same as the favorite projects in UserPreferences.
+	StarProject(ctx context.Context, in *StarProjectRequest, opts ...grpc.CallOption) (*StarProjectResponse, error)
+	// UnstarProject unstars a project for the current user.
+	UnstarProject(ctx context.Context, in *UnstarProjectRequest, opts ...grpc.CallOption) (*UnstarProjectResponse, error)
+	// StarDashboard stars a dashboard in a project's prod deployment for the current user.
+	StarDashboard(ctx context.Context, in *StarDashboardRequest, opts ...grpc.CallOption) (*StarDashboardResponse, error)
+	// UnstarDashboard unstars a dashboard for the current user.
+	UnstarDashboard(ctx context.Context, in *UnstarDashboardRequest, opts ...grpc.CallOption) (*UnstarDashboardResponse, error)
+	// ListStarred lists the projects and dashboards the current user has starred.
+	ListStarred(ctx context.Context, in *ListStarredRequest, opts ...grpc.CallOption) (*ListStarredResponse, error)
+	// ListNotifications lists the notifications in the current user's notification center, newest first.
+	// New notifications are also streamed as server-sent events from /v1/users/current/notifications/stream.
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	// AckNotifications marks notifications in the current user's notification center as read.
+	AckNotifications(ctx context.Context, in *AckNotificationsRequest, opts ...grpc.CallOption) (*AckNotificationsResponse, error)
 	// ListBookmarks lists all the bookmarks for the user and global ones for dashboard
 	ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error)
 	// GetBookmark returns the bookmark for the given user for the given project
@@ -316,6 +399,14 @@ type AdminServiceClient interface {
 	GetReportMeta(ctx context.Context, in *GetReportMetaRequest, opts ...grpc.CallOption) (*GetReportMetaResponse, error)
 	// GetAlertMeta returns metadata for checking an alert. It's currently only called by the alert reconciler in the runtime.
 	GetAlertMeta(ctx context.Context, in *GetAlertMetaRequest, opts ...grpc.CallOption) (*GetAlertMetaResponse, error)
+	// ReportQueryUsage records the cost of queries served by a project's deployment. It's called periodically by the runtime.
+	ReportQueryUsage(ctx context.Context, in *ReportQueryUsageRequest, opts ...grpc.CallOption) (*ReportQueryUsageResponse, error)
+	// SendRuntimeHeartbeat records that the runtime hosting a deployment is alive. It's called periodically by the runtime.
+	// It must be called with the deployment's access token.
+	SendRuntimeHeartbeat(ctx context.Context, in *SendRuntimeHeartbeatRequest, opts ...grpc.CallOption) (*SendRuntimeHeartbeatResponse, error)
+	// SendResourceNotification adds a notification about a resource (such as an alert firing or a report being sent) to the notification centers of its recipients.
+	// It's called by the runtime. Recipients that are not users with access to the project are skipped.
+	SendResourceNotification(ctx context.Context, in *SendResourceNotificationRequest, opts ...grpc.CallOption) (*SendResourceNotificationResponse, error)
 	// CreateReport adds a virtual file for a report, triggers a reconcile, and waits for the report to be added to the runtime catalog
 	CreateReport(ctx context.Context, in *CreateReportRequest, opts ...grpc.CallOption) (*CreateReportResponse, error)
 	// EditReport edits a virtual file for a UI-managed report, triggers a reconcile, and waits for the report to be updated in the runtime
@@ -362,6 +453,16 @@ func (c *adminServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...
 	return out, nil
 }
 
+func (c *adminServiceClient) ListRuntimeVersions(ctx context.Context, in *ListRuntimeVersionsRequest, opts ...grpc.CallOption) (*ListRuntimeVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRuntimeVersionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListRuntimeVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListOrganizationsResponse)
@@ -482,6 +583,16 @@ func (c *adminServiceClient) GetProjectVariables(ctx context.Context, in *GetPro
 	return out, nil
 }
 
+func (c *adminServiceClient) GetProjectUsage(ctx context.Context, in *GetProjectUsageRequest, opts ...grpc.CallOption) (*GetProjectUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectUsageResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetProjectUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateProjectResponse)
@@ -492,6 +603,16 @@ func (c *adminServiceClient) CreateProject(ctx context.Context, in *CreateProjec
 	return out, nil
 }
 
+func (c *adminServiceClient) CloneProject(ctx context.Context, in *CloneProjectRequest, opts ...grpc.CallOption) (*CloneProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneProjectResponse)
+	err := c.cc.Invoke(ctx, AdminService_CloneProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteProjectResponse)
@@ -542,6 +663,16 @@ func (c *adminServiceClient) TriggerReconcile(ctx context.Context, in *TriggerRe
 	return out, nil
 }
 
+func (c *adminServiceClient) ApproveDeployment(ctx context.Context, in *ApproveDeploymentRequest, opts ...grpc.CallOption) (*ApproveDeploymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveDeploymentResponse)
+	err := c.cc.Invoke(ctx, AdminService_ApproveDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) TriggerRefreshSources(ctx context.Context, in *TriggerRefreshSourcesRequest, opts ...grpc.CallOption) (*TriggerRefreshSourcesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TriggerRefreshSourcesResponse)
@@ -672,6 +803,16 @@ func (c *adminServiceClient) SetProjectMemberUserRole(ctx context.Context, in *S
 	return out, nil
 }
 
+func (c *adminServiceClient) ImportMemberUsers(ctx context.Context, in *ImportMemberUsersRequest, opts ...grpc.CallOption) (*ImportMemberUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMemberUsersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ImportMemberUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) CreateUsergroup(ctx context.Context, in *CreateUsergroupRequest, opts ...grpc.CallOption) (*CreateUsergroupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateUsergroupResponse)
@@ -922,6 +1063,36 @@ func (c *adminServiceClient) ListWhitelistedDomains(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *adminServiceClient) ListOrganizationNotifiers(ctx context.Context, in *ListOrganizationNotifiersRequest, opts ...grpc.CallOption) (*ListOrganizationNotifiersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizationNotifiersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListOrganizationNotifiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateOrganizationNotifier(ctx context.Context, in *CreateOrganizationNotifierRequest, opts ...grpc.CallOption) (*CreateOrganizationNotifierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrganizationNotifierResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateOrganizationNotifier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteOrganizationNotifier(ctx context.Context, in *DeleteOrganizationNotifierRequest, opts ...grpc.CallOption) (*DeleteOrganizationNotifierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOrganizationNotifierResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteOrganizationNotifier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserResponse)
@@ -1042,6 +1213,36 @@ func (c *adminServiceClient) SudoUpdateAnnotations(ctx context.Context, in *Sudo
 	return out, nil
 }
 
+func (c *adminServiceClient) SudoMigrateDeployment(ctx context.Context, in *SudoMigrateDeploymentRequest, opts ...grpc.CallOption) (*SudoMigrateDeploymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SudoMigrateDeploymentResponse)
+	err := c.cc.Invoke(ctx, AdminService_SudoMigrateDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SudoUpdateRuntimeConfig(ctx context.Context, in *SudoUpdateRuntimeConfigRequest, opts ...grpc.CallOption) (*SudoUpdateRuntimeConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SudoUpdateRuntimeConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_SudoUpdateRuntimeConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) CreateProjectWhitelistedDomain(ctx context.Context, in *CreateProjectWhitelistedDomainRequest, opts ...grpc.CallOption) (*CreateProjectWhitelistedDomainResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateProjectWhitelistedDomainResponse)
@@ -1072,6 +1273,26 @@ func (c *adminServiceClient) ListProjectWhitelistedDomains(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) ListProjectPublicMetricsViews(ctx context.Context, in *ListProjectPublicMetricsViewsRequest, opts ...grpc.CallOption) (*ListProjectPublicMetricsViewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectPublicMetricsViewsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListProjectPublicMetricsViews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetProjectPublicMetricsViews(ctx context.Context, in *SetProjectPublicMetricsViewsRequest, opts ...grpc.CallOption) (*SetProjectPublicMetricsViewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProjectPublicMetricsViewsResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetProjectPublicMetricsViews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListServicesResponse)
@@ -1172,6 +1393,36 @@ func (c *adminServiceClient) RevokeMagicAuthToken(ctx context.Context, in *Revok
 	return out, nil
 }
 
+func (c *adminServiceClient) IssueProjectToken(ctx context.Context, in *IssueProjectTokenRequest, opts ...grpc.CallOption) (*IssueProjectTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueProjectTokenResponse)
+	err := c.cc.Invoke(ctx, AdminService_IssueProjectToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListProjectTokens(ctx context.Context, in *ListProjectTokensRequest, opts ...grpc.CallOption) (*ListProjectTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectTokensResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListProjectTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeProjectToken(ctx context.Context, in *RevokeProjectTokenRequest, opts ...grpc.CallOption) (*RevokeProjectTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeProjectTokenResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevokeProjectToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) UpdateUserPreferences(ctx context.Context, in *UpdateUserPreferencesRequest, opts ...grpc.CallOption) (*UpdateUserPreferencesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateUserPreferencesResponse)
@@ -1182,6 +1433,76 @@ func (c *adminServiceClient) UpdateUserPreferences(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *adminServiceClient) StarProject(ctx context.Context, in *StarProjectRequest, opts ...grpc.CallOption) (*StarProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StarProjectResponse)
+	err := c.cc.Invoke(ctx, AdminService_StarProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnstarProject(ctx context.Context, in *UnstarProjectRequest, opts ...grpc.CallOption) (*UnstarProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnstarProjectResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnstarProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StarDashboard(ctx context.Context, in *StarDashboardRequest, opts ...grpc.CallOption) (*StarDashboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StarDashboardResponse)
+	err := c.cc.Invoke(ctx, AdminService_StarDashboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnstarDashboard(ctx context.Context, in *UnstarDashboardRequest, opts ...grpc.CallOption) (*UnstarDashboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnstarDashboardResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnstarDashboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListStarred(ctx context.Context, in *ListStarredRequest, opts ...grpc.CallOption) (*ListStarredResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStarredResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListStarred_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AckNotifications(ctx context.Context, in *AckNotificationsRequest, opts ...grpc.CallOption) (*AckNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckNotificationsResponse)
+	err := c.cc.Invoke(ctx, AdminService_AckNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListBookmarks(ctx context.Context, in *ListBookmarksRequest, opts ...grpc.CallOption) (*ListBookmarksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListBookmarksResponse)
@@ -1272,6 +1593,36 @@ func (c *adminServiceClient) GetAlertMeta(ctx context.Context, in *GetAlertMetaR
 	return out, nil
 }
 
+func (c *adminServiceClient) ReportQueryUsage(ctx context.Context, in *ReportQueryUsageRequest, opts ...grpc.CallOption) (*ReportQueryUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportQueryUsageResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReportQueryUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SendRuntimeHeartbeat(ctx context.Context, in *SendRuntimeHeartbeatRequest, opts ...grpc.CallOption) (*SendRuntimeHeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendRuntimeHeartbeatResponse)
+	err := c.cc.Invoke(ctx, AdminService_SendRuntimeHeartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SendResourceNotification(ctx context.Context, in *SendResourceNotificationRequest, opts ...grpc.CallOption) (*SendResourceNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendResourceNotificationResponse)
+	err := c.cc.Invoke(ctx, AdminService_SendResourceNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) CreateReport(ctx context.Context, in *CreateReportRequest, opts ...grpc.CallOption) (*CreateReportResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateReportResponse)
@@ -1408,6 +1759,8 @@ func (c *adminServiceClient) ListPublicBillingPlans(ctx context.Context, in *Lis
 type AdminServiceServer interface {
 	// Ping returns information about the server
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// ListRuntimeVersions lists the runtime versions that projects can be pinned to (using prod_version in UpdateProject)
+	ListRuntimeVersions(context.Context, *ListRuntimeVersionsRequest) (*ListRuntimeVersionsResponse, error)
 	// ListOrganizations lists all the organizations currently managed by the admin
 	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
 	// GetOrganization returns information about a specific organization
@@ -1432,18 +1785,26 @@ type AdminServiceServer interface {
 	SearchProjectNames(context.Context, *SearchProjectNamesRequest) (*SearchProjectNamesResponse, error)
 	// GetProjectVariables returns project variables. NOTE: Get project API doesn't return variables.
 	GetProjectVariables(context.Context, *GetProjectVariablesRequest) (*GetProjectVariablesResponse, error)
+	// GetProjectUsage returns the query usage of a project aggregated by subject (user, service or token)
+	GetProjectUsage(context.Context, *GetProjectUsageRequest) (*GetProjectUsageResponse, error)
 	// CreateProject creates a new project
 	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	// CloneProject creates a new project from the files, settings and (optionally) members of an existing project
+	CloneProject(context.Context, *CloneProjectRequest) (*CloneProjectResponse, error)
 	// DeleteProject deletes an project
 	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
 	// UpdateProject updates a project
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
 	// UpdateProjectVariables updates variables for a project. NOTE: Update project API doesn't update variables.
+	// The variables are validated against the project's connectors before they're applied.
+	// Running deployments are only restarted if the variables changed, and the response lists the resources affected by the change.
 	UpdateProjectVariables(context.Context, *UpdateProjectVariablesRequest) (*UpdateProjectVariablesResponse, error)
 	// CreateAsset returns a one time signed URL using which any asset can be uploaded.
 	CreateAsset(context.Context, *CreateAssetRequest) (*CreateAssetResponse, error)
 	// TriggerReconcile triggers reconcile for the project's prod deployment
 	TriggerReconcile(context.Context, *TriggerReconcileRequest) (*TriggerReconcileResponse, error)
+	// ApproveDeployment deploys the latest commit pushed to a project's production branch that is being held back by the project's deploy trigger.
+	ApproveDeployment(context.Context, *ApproveDeploymentRequest) (*ApproveDeploymentResponse, error)
 	// TriggerRefreshSources refresh the source for production deployment
 	TriggerRefreshSources(context.Context, *TriggerRefreshSourcesRequest) (*TriggerRefreshSourcesResponse, error)
 	// TriggerRedeploy creates a new deployment and teardown the old deployment for production deployment
@@ -1470,6 +1831,10 @@ type AdminServiceServer interface {
 	RemoveProjectMemberUser(context.Context, *RemoveProjectMemberUserRequest) (*RemoveProjectMemberUserResponse, error)
 	// SetProjectMemberUserRole sets the role for the member
 	SetProjectMemberUserRole(context.Context, *SetProjectMemberUserRoleRequest) (*SetProjectMemberUserRoleResponse, error)
+	// ImportMemberUsers adds many users to an organization or project in one transaction.
+	// Existing users are added as members and other users are invited.
+	// If any row is invalid, no users are added and the response reports the error for each row.
+	ImportMemberUsers(context.Context, *ImportMemberUsersRequest) (*ImportMemberUsersResponse, error)
 	// CreateUsergroup creates a user group in the organization
 	CreateUsergroup(context.Context, *CreateUsergroupRequest) (*CreateUsergroupResponse, error)
 	// GetUsergroups returns the user group details
@@ -1522,6 +1887,12 @@ type AdminServiceServer interface {
 	RemoveWhitelistedDomain(context.Context, *RemoveWhitelistedDomainRequest) (*RemoveWhitelistedDomainResponse, error)
 	// ListWhitelistedDomains lists all the whitelisted domains for the organization
 	ListWhitelistedDomains(context.Context, *ListWhitelistedDomainsRequest) (*ListWhitelistedDomainsResponse, error)
+	// ListOrganizationNotifiers lists the notifiers that receive notifications about events in the organization
+	ListOrganizationNotifiers(context.Context, *ListOrganizationNotifiersRequest) (*ListOrganizationNotifiersResponse, error)
+	// CreateOrganizationNotifier adds a notifier to the organization
+	CreateOrganizationNotifier(context.Context, *CreateOrganizationNotifierRequest) (*CreateOrganizationNotifierResponse, error)
+	// DeleteOrganizationNotifier removes a notifier from the organization
+	DeleteOrganizationNotifier(context.Context, *DeleteOrganizationNotifierRequest) (*DeleteOrganizationNotifierResponse, error)
 	// GetUser returns user by email
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	// GetUsersByEmail returns users by email
@@ -1546,12 +1917,25 @@ type AdminServiceServer interface {
 	SudoUpdateOrganizationBillingCustomer(context.Context, *SudoUpdateOrganizationBillingCustomerRequest) (*SudoUpdateOrganizationBillingCustomerResponse, error)
 	// SudoUpdateAnnotations endpoint for superusers to update project annotations
 	SudoUpdateAnnotations(context.Context, *SudoUpdateAnnotationsRequest) (*SudoUpdateAnnotationsResponse, error)
+	// SudoMigrateDeployment moves a project's production deployment to another runtime host.
+	// It copies the deployment's data and catalog instead of re-ingesting its sources, so the project is only read-only while the copy is made.
+	SudoMigrateDeployment(context.Context, *SudoMigrateDeploymentRequest) (*SudoMigrateDeploymentResponse, error)
+	// SudoUpdateRuntimeConfig sets instance config overrides on a project's production deployment, e.g. "rill.metrics.max_execution_timeout_seconds" or "rill.features.<flag>".
+	// The overrides take precedence over the project's variables and are applied without redeploying.
+	SudoUpdateRuntimeConfig(context.Context, *SudoUpdateRuntimeConfigRequest) (*SudoUpdateRuntimeConfigResponse, error)
+	// ListJobs lists jobs in the admin job queue, ordered by descending creation time. It's only available to superusers.
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
 	// CreateProjectWhitelistedDomain adds a domain to the project's whitelisted
 	CreateProjectWhitelistedDomain(context.Context, *CreateProjectWhitelistedDomainRequest) (*CreateProjectWhitelistedDomainResponse, error)
 	// RemoveProjectWhitelistedDomain removes a domain from the project's whitelisted
 	RemoveProjectWhitelistedDomain(context.Context, *RemoveProjectWhitelistedDomainRequest) (*RemoveProjectWhitelistedDomainResponse, error)
 	// ListWhitelistedDomains lists all the whitelisted domains of the project
 	ListProjectWhitelistedDomains(context.Context, *ListProjectWhitelistedDomainsRequest) (*ListProjectWhitelistedDomainsResponse, error)
+	// ListProjectPublicMetricsViews lists the metrics views of a project that can be accessed by anyone, even if the project is private.
+	ListProjectPublicMetricsViews(context.Context, *ListProjectPublicMetricsViewsRequest) (*ListProjectPublicMetricsViewsResponse, error)
+	// SetProjectPublicMetricsViews replaces the metrics views of a project that can be accessed by anyone.
+	// Anonymous users and users who are not members of the project get access to only those metrics views (and themes).
+	SetProjectPublicMetricsViews(context.Context, *SetProjectPublicMetricsViewsRequest) (*SetProjectPublicMetricsViewsResponse, error)
 	// ListService returns all the services per organization
 	ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error)
 	// CreateService creates a new service per organization
@@ -1572,8 +1956,32 @@ type AdminServiceServer interface {
 	ListMagicAuthTokens(context.Context, *ListMagicAuthTokensRequest) (*ListMagicAuthTokensResponse, error)
 	// RevokeMagicAuthToken revokes a magic auth token.
 	RevokeMagicAuthToken(context.Context, *RevokeMagicAuthTokenRequest) (*RevokeMagicAuthTokenResponse, error)
-	// UpdateUserPreferences updates the preferences for the user
+	// IssueProjectToken creates a long-lived auth token that grants the permissions of a project role in a specific project.
+	// It enables consuming the project's dashboards and APIs from scripts without a user login.
+	IssueProjectToken(context.Context, *IssueProjectTokenRequest) (*IssueProjectTokenResponse, error)
+	// ListProjectTokens lists the project tokens for a specific project.
+	ListProjectTokens(context.Context, *ListProjectTokensRequest) (*ListProjectTokensResponse, error)
+	// RevokeProjectToken revokes a project token.
+	RevokeProjectToken(context.Context, *RevokeProjectTokenRequest) (*RevokeProjectTokenResponse, error)
+	// UpdateUserPreferences updates the preferences for the user.
+	// Only the preferences that are set in the request are updated.
 	UpdateUserPreferences(context.Context, *UpdateUserPreferencesRequest) (*UpdateUserPreferencesResponse, error)
+	// StarProject stars a project for the current user.
+	// Starred projects are the same as the favorite projects in UserPreferences.
+	StarProject(context.Context, *StarProjectRequest) (*StarProjectResponse, error)
+	// UnstarProject unstars a project for the current user.
+	UnstarProject(context.Context, *UnstarProjectRequest) (*UnstarProjectResponse, error)
+	// StarDashboard stars a dashboard in a project's prod deployment for the current user.
+	StarDashboard(context.Context, *StarDashboardRequest) (*StarDashboardResponse, error)
+	// UnstarDashboard unstars a dashboard for the current user.
+	UnstarDashboard(context.Context, *UnstarDashboardRequest) (*UnstarDashboardResponse, error)
+	// ListStarred lists the projects and dashboards the current user has starred.
+	ListStarred(context.Context, *ListStarredRequest) (*ListStarredResponse, error)
+	// ListNotifications lists the notifications in the current user's notification center, newest first.
+	// New notifications are also streamed as server-sent events from /v1/users/current/notifications/stream.
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	// AckNotifications marks notifications in the current user's notification center as read.
+	AckNotifications(context.Context, *AckNotificationsRequest) (*AckNotificationsResponse, error)
 	// ListBookmarks lists all the bookmarks for the user and global ones for dashboard
 	ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error)
 	// GetBookmark returns the bookmark for the given user for the given project
@@ -1592,6 +2000,14 @@ type AdminServiceServer interface {
 	GetReportMeta(context.Context, *GetReportMetaRequest) (*GetReportMetaResponse, error)
 	// GetAlertMeta returns metadata for checking an alert. It's currently only called by the alert reconciler in the runtime.
 	GetAlertMeta(context.Context, *GetAlertMetaRequest) (*GetAlertMetaResponse, error)
+	// ReportQueryUsage records the cost of queries served by a project's deployment. It's called periodically by the runtime.
+	ReportQueryUsage(context.Context, *ReportQueryUsageRequest) (*ReportQueryUsageResponse, error)
+	// SendRuntimeHeartbeat records that the runtime hosting a deployment is alive. It's called periodically by the runtime.
+	// It must be called with the deployment's access token.
+	SendRuntimeHeartbeat(context.Context, *SendRuntimeHeartbeatRequest) (*SendRuntimeHeartbeatResponse, error)
+	// SendResourceNotification adds a notification about a resource (such as an alert firing or a report being sent) to the notification centers of its recipients.
+	// It's called by the runtime. Recipients that are not users with access to the project are skipped.
+	SendResourceNotification(context.Context, *SendResourceNotificationRequest) (*SendResourceNotificationResponse, error)
 	// CreateReport adds a virtual file for a report, triggers a reconcile, and waits for the report to be added to the runtime catalog
 	CreateReport(context.Context, *CreateReportRequest) (*CreateReportResponse, error)
 	// EditReport edits a virtual file for a UI-managed report, triggers a reconcile, and waits for the report to be updated in the runtime
@@ -1628,6 +2044,9 @@ type UnimplementedAdminServiceServer struct {
 func (UnimplementedAdminServiceServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
+func (UnimplementedAdminServiceServer) ListRuntimeVersions(context.Context, *ListRuntimeVersionsRequest) (*ListRuntimeVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuntimeVersions not implemented")
+}
 func (UnimplementedAdminServiceServer) ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
 }
@@ -1664,9 +2083,15 @@ func (UnimplementedAdminServiceServer) SearchProjectNames(context.Context, *Sear
 func (UnimplementedAdminServiceServer) GetProjectVariables(context.Context, *GetProjectVariablesRequest) (*GetProjectVariablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProjectVariables not implemented")
 }
+func (UnimplementedAdminServiceServer) GetProjectUsage(context.Context, *GetProjectUsageRequest) (*GetProjectUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectUsage not implemented")
+}
 func (UnimplementedAdminServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
 }
+func (UnimplementedAdminServiceServer) CloneProject(context.Context, *CloneProjectRequest) (*CloneProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneProject not implemented")
+}
 func (UnimplementedAdminServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
 }
@@ -1682,6 +2107,9 @@ func (UnimplementedAdminServiceServer) CreateAsset(context.Context, *CreateAsset
 func (UnimplementedAdminServiceServer) TriggerReconcile(context.Context, *TriggerReconcileRequest) (*TriggerReconcileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerReconcile not implemented")
 }
+func (UnimplementedAdminServiceServer) ApproveDeployment(context.Context, *ApproveDeploymentRequest) (*ApproveDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDeployment not implemented")
+}
 func (UnimplementedAdminServiceServer) TriggerRefreshSources(context.Context, *TriggerRefreshSourcesRequest) (*TriggerRefreshSourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerRefreshSources not implemented")
 }
@@ -1721,6 +2149,9 @@ func (UnimplementedAdminServiceServer) RemoveProjectMemberUser(context.Context,
 func (UnimplementedAdminServiceServer) SetProjectMemberUserRole(context.Context, *SetProjectMemberUserRoleRequest) (*SetProjectMemberUserRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetProjectMemberUserRole not implemented")
 }
+func (UnimplementedAdminServiceServer) ImportMemberUsers(context.Context, *ImportMemberUsersRequest) (*ImportMemberUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMemberUsers not implemented")
+}
 func (UnimplementedAdminServiceServer) CreateUsergroup(context.Context, *CreateUsergroupRequest) (*CreateUsergroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUsergroup not implemented")
 }
@@ -1796,6 +2227,15 @@ func (UnimplementedAdminServiceServer) RemoveWhitelistedDomain(context.Context,
 func (UnimplementedAdminServiceServer) ListWhitelistedDomains(context.Context, *ListWhitelistedDomainsRequest) (*ListWhitelistedDomainsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWhitelistedDomains not implemented")
 }
+func (UnimplementedAdminServiceServer) ListOrganizationNotifiers(context.Context, *ListOrganizationNotifiersRequest) (*ListOrganizationNotifiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationNotifiers not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateOrganizationNotifier(context.Context, *CreateOrganizationNotifierRequest) (*CreateOrganizationNotifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganizationNotifier not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteOrganizationNotifier(context.Context, *DeleteOrganizationNotifierRequest) (*DeleteOrganizationNotifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationNotifier not implemented")
+}
 func (UnimplementedAdminServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
@@ -1832,6 +2272,15 @@ func (UnimplementedAdminServiceServer) SudoUpdateOrganizationBillingCustomer(con
 func (UnimplementedAdminServiceServer) SudoUpdateAnnotations(context.Context, *SudoUpdateAnnotationsRequest) (*SudoUpdateAnnotationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoUpdateAnnotations not implemented")
 }
+func (UnimplementedAdminServiceServer) SudoMigrateDeployment(context.Context, *SudoMigrateDeploymentRequest) (*SudoMigrateDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SudoMigrateDeployment not implemented")
+}
+func (UnimplementedAdminServiceServer) SudoUpdateRuntimeConfig(context.Context, *SudoUpdateRuntimeConfigRequest) (*SudoUpdateRuntimeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SudoUpdateRuntimeConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
 func (UnimplementedAdminServiceServer) CreateProjectWhitelistedDomain(context.Context, *CreateProjectWhitelistedDomainRequest) (*CreateProjectWhitelistedDomainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProjectWhitelistedDomain not implemented")
 }
@@ -1841,6 +2290,12 @@ func (UnimplementedAdminServiceServer) RemoveProjectWhitelistedDomain(context.Co
 func (UnimplementedAdminServiceServer) ListProjectWhitelistedDomains(context.Context, *ListProjectWhitelistedDomainsRequest) (*ListProjectWhitelistedDomainsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjectWhitelistedDomains not implemented")
 }
+func (UnimplementedAdminServiceServer) ListProjectPublicMetricsViews(context.Context, *ListProjectPublicMetricsViewsRequest) (*ListProjectPublicMetricsViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectPublicMetricsViews not implemented")
+}
+func (UnimplementedAdminServiceServer) SetProjectPublicMetricsViews(context.Context, *SetProjectPublicMetricsViewsRequest) (*SetProjectPublicMetricsViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectPublicMetricsViews not implemented")
+}
 func (UnimplementedAdminServiceServer) ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListServices not implemented")
 }
@@ -1871,9 +2326,39 @@ func (UnimplementedAdminServiceServer) ListMagicAuthTokens(context.Context, *Lis
 func (UnimplementedAdminServiceServer) RevokeMagicAuthToken(context.Context, *RevokeMagicAuthTokenRequest) (*RevokeMagicAuthTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeMagicAuthToken not implemented")
 }
+func (UnimplementedAdminServiceServer) IssueProjectToken(context.Context, *IssueProjectTokenRequest) (*IssueProjectTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueProjectToken not implemented")
+}
+func (UnimplementedAdminServiceServer) ListProjectTokens(context.Context, *ListProjectTokensRequest) (*ListProjectTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectTokens not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokeProjectToken(context.Context, *RevokeProjectTokenRequest) (*RevokeProjectTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeProjectToken not implemented")
+}
 func (UnimplementedAdminServiceServer) UpdateUserPreferences(context.Context, *UpdateUserPreferencesRequest) (*UpdateUserPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPreferences not implemented")
 }
+func (UnimplementedAdminServiceServer) StarProject(context.Context, *StarProjectRequest) (*StarProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StarProject not implemented")
+}
+func (UnimplementedAdminServiceServer) UnstarProject(context.Context, *UnstarProjectRequest) (*UnstarProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstarProject not implemented")
+}
+func (UnimplementedAdminServiceServer) StarDashboard(context.Context, *StarDashboardRequest) (*StarDashboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StarDashboard not implemented")
+}
+func (UnimplementedAdminServiceServer) UnstarDashboard(context.Context, *UnstarDashboardRequest) (*UnstarDashboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstarDashboard not implemented")
+}
+func (UnimplementedAdminServiceServer) ListStarred(context.Context, *ListStarredRequest) (*ListStarredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStarred not implemented")
+}
+func (UnimplementedAdminServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedAdminServiceServer) AckNotifications(context.Context, *AckNotificationsRequest) (*AckNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckNotifications not implemented")
+}
 func (UnimplementedAdminServiceServer) ListBookmarks(context.Context, *ListBookmarksRequest) (*ListBookmarksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBookmarks not implemented")
 }
@@ -1901,6 +2386,15 @@ func (UnimplementedAdminServiceServer) GetReportMeta(context.Context, *GetReport
 func (UnimplementedAdminServiceServer) GetAlertMeta(context.Context, *GetAlertMetaRequest) (*GetAlertMetaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAlertMeta not implemented")
 }
+func (UnimplementedAdminServiceServer) ReportQueryUsage(context.Context, *ReportQueryUsageRequest) (*ReportQueryUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportQueryUsage not implemented")
+}
+func (UnimplementedAdminServiceServer) SendRuntimeHeartbeat(context.Context, *SendRuntimeHeartbeatRequest) (*SendRuntimeHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRuntimeHeartbeat not implemented")
+}
+func (UnimplementedAdminServiceServer) SendResourceNotification(context.Context, *SendResourceNotificationRequest) (*SendResourceNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendResourceNotification not implemented")
+}
 func (UnimplementedAdminServiceServer) CreateReport(context.Context, *CreateReportRequest) (*CreateReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateReport not implemented")
 }
@@ -1971,6 +2465,24 @@ func _AdminService_Ping_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListRuntimeVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuntimeVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListRuntimeVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListRuntimeVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListRuntimeVersions(ctx, req.(*ListRuntimeVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrganizationsRequest)
 	if err := dec(in); err != nil {
@@ -2187,6 +2699,24 @@ func _AdminService_GetProjectVariables_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetProjectUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetProjectUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetProjectUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetProjectUsage(ctx, req.(*GetProjectUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateProjectRequest)
 	if err := dec(in); err != nil {
@@ -2205,6 +2735,24 @@ func _AdminService_CreateProject_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_CloneProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CloneProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CloneProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CloneProject(ctx, req.(*CloneProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteProjectRequest)
 	if err := dec(in); err != nil {
@@ -2295,26 +2843,44 @@ func _AdminService_TriggerReconcile_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_TriggerRefreshSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TriggerRefreshSourcesRequest)
+func _AdminService_ApproveDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDeploymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).TriggerRefreshSources(ctx, in)
+		return srv.(AdminServiceServer).ApproveDeployment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_TriggerRefreshSources_FullMethodName,
+		FullMethod: AdminService_ApproveDeployment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).TriggerRefreshSources(ctx, req.(*TriggerRefreshSourcesRequest))
+		return srv.(AdminServiceServer).ApproveDeployment(ctx, req.(*ApproveDeploymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_TriggerRedeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TriggerRedeployRequest)
+func _AdminService_TriggerRefreshSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerRefreshSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TriggerRefreshSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_TriggerRefreshSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TriggerRefreshSources(ctx, req.(*TriggerRefreshSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_TriggerRedeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerRedeployRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2529,6 +3095,24 @@ func _AdminService_SetProjectMemberUserRole_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ImportMemberUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMemberUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ImportMemberUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ImportMemberUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ImportMemberUsers(ctx, req.(*ImportMemberUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_CreateUsergroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUsergroupRequest)
 	if err := dec(in); err != nil {
@@ -2979,6 +3563,60 @@ func _AdminService_ListWhitelistedDomains_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListOrganizationNotifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationNotifiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListOrganizationNotifiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListOrganizationNotifiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListOrganizationNotifiers(ctx, req.(*ListOrganizationNotifiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateOrganizationNotifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationNotifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateOrganizationNotifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateOrganizationNotifier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateOrganizationNotifier(ctx, req.(*CreateOrganizationNotifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteOrganizationNotifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationNotifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteOrganizationNotifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteOrganizationNotifier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteOrganizationNotifier(ctx, req.(*DeleteOrganizationNotifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserRequest)
 	if err := dec(in); err != nil {
@@ -3195,6 +3833,60 @@ func _AdminService_SudoUpdateAnnotations_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_SudoMigrateDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SudoMigrateDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SudoMigrateDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SudoMigrateDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SudoMigrateDeployment(ctx, req.(*SudoMigrateDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SudoUpdateRuntimeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SudoUpdateRuntimeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SudoUpdateRuntimeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SudoUpdateRuntimeConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SudoUpdateRuntimeConfig(ctx, req.(*SudoUpdateRuntimeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_CreateProjectWhitelistedDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateProjectWhitelistedDomainRequest)
 	if err := dec(in); err != nil {
@@ -3249,6 +3941,42 @@ func _AdminService_ListProjectWhitelistedDomains_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListProjectPublicMetricsViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectPublicMetricsViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProjectPublicMetricsViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProjectPublicMetricsViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProjectPublicMetricsViews(ctx, req.(*ListProjectPublicMetricsViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetProjectPublicMetricsViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectPublicMetricsViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetProjectPublicMetricsViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetProjectPublicMetricsViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetProjectPublicMetricsViews(ctx, req.(*SetProjectPublicMetricsViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListServicesRequest)
 	if err := dec(in); err != nil {
@@ -3429,6 +4157,60 @@ func _AdminService_RevokeMagicAuthToken_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_IssueProjectToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueProjectTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).IssueProjectToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_IssueProjectToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).IssueProjectToken(ctx, req.(*IssueProjectTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListProjectTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProjectTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProjectTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProjectTokens(ctx, req.(*ListProjectTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeProjectToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeProjectTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeProjectToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevokeProjectToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeProjectToken(ctx, req.(*RevokeProjectTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_UpdateUserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUserPreferencesRequest)
 	if err := dec(in); err != nil {
@@ -3447,6 +4229,132 @@ func _AdminService_UpdateUserPreferences_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_StarProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StarProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StarProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StarProject(ctx, req.(*StarProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnstarProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnstarProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnstarProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnstarProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnstarProject(ctx, req.(*UnstarProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StarDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarDashboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StarDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StarDashboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StarDashboard(ctx, req.(*StarDashboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnstarDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnstarDashboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnstarDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnstarDashboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnstarDashboard(ctx, req.(*UnstarDashboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListStarred_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStarredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListStarred(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListStarred_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListStarred(ctx, req.(*ListStarredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AckNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AckNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AckNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AckNotifications(ctx, req.(*AckNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBookmarksRequest)
 	if err := dec(in); err != nil {
@@ -3609,6 +4517,60 @@ func _AdminService_GetAlertMeta_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ReportQueryUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportQueryUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReportQueryUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReportQueryUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReportQueryUsage(ctx, req.(*ReportQueryUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SendRuntimeHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRuntimeHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SendRuntimeHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SendRuntimeHeartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SendRuntimeHeartbeat(ctx, req.(*SendRuntimeHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SendResourceNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendResourceNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SendResourceNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SendResourceNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SendResourceNotification(ctx, req.(*SendResourceNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_CreateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateReportRequest)
 	if err := dec(in); err != nil {
@@ -3854,6 +4816,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _AdminService_Ping_Handler,
 		},
+		{
+			MethodName: "ListRuntimeVersions",
+			Handler:    _AdminService_ListRuntimeVersions_Handler,
+		},
 		{
 			MethodName: "ListOrganizations",
 			Handler:    _AdminService_ListOrganizations_Handler,
@@ -3902,10 +4868,18 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProjectVariables",
 			Handler:    _AdminService_GetProjectVariables_Handler,
 		},
+		{
+			MethodName: "GetProjectUsage",
+			Handler:    _AdminService_GetProjectUsage_Handler,
+		},
 		{
 			MethodName: "CreateProject",
 			Handler:    _AdminService_CreateProject_Handler,
 		},
+		{
+			MethodName: "CloneProject",
+			Handler:    _AdminService_CloneProject_Handler,
+		},
 		{
 			MethodName: "DeleteProject",
 			Handler:    _AdminService_DeleteProject_Handler,
@@ -3926,6 +4900,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerReconcile",
 			Handler:    _AdminService_TriggerReconcile_Handler,
 		},
+		{
+			MethodName: "ApproveDeployment",
+			Handler:    _AdminService_ApproveDeployment_Handler,
+		},
 		{
 			MethodName: "TriggerRefreshSources",
 			Handler:    _AdminService_TriggerRefreshSources_Handler,
@@ -3978,6 +4956,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetProjectMemberUserRole",
 			Handler:    _AdminService_SetProjectMemberUserRole_Handler,
 		},
+		{
+			MethodName: "ImportMemberUsers",
+			Handler:    _AdminService_ImportMemberUsers_Handler,
+		},
 		{
 			MethodName: "CreateUsergroup",
 			Handler:    _AdminService_CreateUsergroup_Handler,
@@ -4078,6 +5060,18 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListWhitelistedDomains",
 			Handler:    _AdminService_ListWhitelistedDomains_Handler,
 		},
+		{
+			MethodName: "ListOrganizationNotifiers",
+			Handler:    _AdminService_ListOrganizationNotifiers_Handler,
+		},
+		{
+			MethodName: "CreateOrganizationNotifier",
+			Handler:    _AdminService_CreateOrganizationNotifier_Handler,
+		},
+		{
+			MethodName: "DeleteOrganizationNotifier",
+			Handler:    _AdminService_DeleteOrganizationNotifier_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _AdminService_GetUser_Handler,
@@ -4126,6 +5120,18 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoUpdateAnnotations",
 			Handler:    _AdminService_SudoUpdateAnnotations_Handler,
 		},
+		{
+			MethodName: "SudoMigrateDeployment",
+			Handler:    _AdminService_SudoMigrateDeployment_Handler,
+		},
+		{
+			MethodName: "SudoUpdateRuntimeConfig",
+			Handler:    _AdminService_SudoUpdateRuntimeConfig_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _AdminService_ListJobs_Handler,
+		},
 		{
 			MethodName: "CreateProjectWhitelistedDomain",
 			Handler:    _AdminService_CreateProjectWhitelistedDomain_Handler,
@@ -4138,6 +5144,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListProjectWhitelistedDomains",
 			Handler:    _AdminService_ListProjectWhitelistedDomains_Handler,
 		},
+		{
+			MethodName: "ListProjectPublicMetricsViews",
+			Handler:    _AdminService_ListProjectPublicMetricsViews_Handler,
+		},
+		{
+			MethodName: "SetProjectPublicMetricsViews",
+			Handler:    _AdminService_SetProjectPublicMetricsViews_Handler,
+		},
 		{
 			MethodName: "ListServices",
 			Handler:    _AdminService_ListServices_Handler,
@@ -4178,10 +5192,50 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeMagicAuthToken",
 			Handler:    _AdminService_RevokeMagicAuthToken_Handler,
 		},
+		{
+			MethodName: "IssueProjectToken",
+			Handler:    _AdminService_IssueProjectToken_Handler,
+		},
+		{
+			MethodName: "ListProjectTokens",
+			Handler:    _AdminService_ListProjectTokens_Handler,
+		},
+		{
+			MethodName: "RevokeProjectToken",
+			Handler:    _AdminService_RevokeProjectToken_Handler,
+		},
 		{
 			MethodName: "UpdateUserPreferences",
 			Handler:    _AdminService_UpdateUserPreferences_Handler,
 		},
+		{
+			MethodName: "StarProject",
+			Handler:    _AdminService_StarProject_Handler,
+		},
+		{
+			MethodName: "UnstarProject",
+			Handler:    _AdminService_UnstarProject_Handler,
+		},
+		{
+			MethodName: "StarDashboard",
+			Handler:    _AdminService_StarDashboard_Handler,
+		},
+		{
+			MethodName: "UnstarDashboard",
+			Handler:    _AdminService_UnstarDashboard_Handler,
+		},
+		{
+			MethodName: "ListStarred",
+			Handler:    _AdminService_ListStarred_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _AdminService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "AckNotifications",
+			Handler:    _AdminService_AckNotifications_Handler,
+		},
 		{
 			MethodName: "ListBookmarks",
 			Handler:    _AdminService_ListBookmarks_Handler,
@@ -4218,6 +5272,18 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAlertMeta",
 			Handler:    _AdminService_GetAlertMeta_Handler,
 		},
+		{
+			MethodName: "ReportQueryUsage",
+			Handler:    _AdminService_ReportQueryUsage_Handler,
+		},
+		{
+			MethodName: "SendRuntimeHeartbeat",
+			Handler:    _AdminService_SendRuntimeHeartbeat_Handler,
+		},
+		{
+			MethodName: "SendResourceNotification",
+			Handler:    _AdminService_SendResourceNotification_Handler,
+		},
 		{
 			MethodName: "CreateReport",
 			Handler:    _AdminService_CreateReport_Handler,
@@ -792,6 +792,8 @@ func (m *InstanceHealth) validate(all bool) error {
 
 	// no validation rules for RepoError
 
+	// no validation rules for CatalogError
+
 	if len(errors) > 0 {
 		return InstanceHealthMultiError(errors)
 	}
@@ -1818,6 +1820,10 @@ func (m *CreateInstanceRequest) validate(all bool) error {
 
 	// no validation rules for WatchRepo
 
+	// no validation rules for RestoreInstanceId
+
+	// no validation rules for RestoreBackupId
+
 	if len(errors) > 0 {
 		return CreateInstanceRequestMultiError(errors)
 	}
@@ -2560,30 +2566,30 @@ var _ interface {
 	ErrorName() string
 } = EditInstanceResponseValidationError{}
 
-// Validate checks the field values on ListFilesRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListFilesRequest) Validate() error {
+// Validate checks the field values on ListBackupsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBackupsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListFilesRequest with the rules
+// ValidateAll checks the field values on ListBackupsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListFilesRequestMultiError, or nil if none found.
-func (m *ListFilesRequest) ValidateAll() error {
+// ListBackupsRequestMultiError, or nil if none found.
+func (m *ListBackupsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListFilesRequest) validate(all bool) error {
+func (m *ListBackupsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_ListFilesRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := ListFilesRequestValidationError{
+	if !_ListBackupsRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := ListBackupsRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -2593,22 +2599,20 @@ func (m *ListFilesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Glob
-
 	if len(errors) > 0 {
-		return ListFilesRequestMultiError(errors)
+		return ListBackupsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListFilesRequestMultiError is an error wrapping multiple validation errors
-// returned by ListFilesRequest.ValidateAll() if the designated constraints
+// ListBackupsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListBackupsRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListFilesRequestMultiError []error
+type ListBackupsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListFilesRequestMultiError) Error() string {
+func (m ListBackupsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2617,11 +2621,11 @@ func (m ListFilesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListFilesRequestMultiError) AllErrors() []error { return m }
+func (m ListBackupsRequestMultiError) AllErrors() []error { return m }
 
-// ListFilesRequestValidationError is the validation error returned by
-// ListFilesRequest.Validate if the designated constraints aren't met.
-type ListFilesRequestValidationError struct {
+// ListBackupsRequestValidationError is the validation error returned by
+// ListBackupsRequest.Validate if the designated constraints aren't met.
+type ListBackupsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2629,22 +2633,24 @@ type ListFilesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListFilesRequestValidationError) Field() string { return e.field }
+func (e ListBackupsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListFilesRequestValidationError) Reason() string { return e.reason }
+func (e ListBackupsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListFilesRequestValidationError) Cause() error { return e.cause }
+func (e ListBackupsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListFilesRequestValidationError) Key() bool { return e.key }
+func (e ListBackupsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListFilesRequestValidationError) ErrorName() string { return "ListFilesRequestValidationError" }
+func (e ListBackupsRequestValidationError) ErrorName() string {
+	return "ListBackupsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListFilesRequestValidationError) Error() string {
+func (e ListBackupsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2656,14 +2662,14 @@ func (e ListFilesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListFilesRequest.%s: %s%s",
+		"invalid %sListBackupsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListFilesRequestValidationError{}
+var _ error = ListBackupsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2671,49 +2677,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListFilesRequestValidationError{}
+} = ListBackupsRequestValidationError{}
 
-var _ListFilesRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _ListBackupsRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on ListFilesResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListFilesResponse) Validate() error {
+// Validate checks the field values on ListBackupsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBackupsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListFilesResponse with the rules
+// ValidateAll checks the field values on ListBackupsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListFilesResponseMultiError, or nil if none found.
-func (m *ListFilesResponse) ValidateAll() error {
+// ListBackupsResponseMultiError, or nil if none found.
+func (m *ListBackupsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListFilesResponse) validate(all bool) error {
+func (m *ListBackupsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetFiles() {
+	for idx, item := range m.GetBackups() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListFilesResponseValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
+					errors = append(errors, ListBackupsResponseValidationError{
+						field:  fmt.Sprintf("Backups[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListFilesResponseValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
+					errors = append(errors, ListBackupsResponseValidationError{
+						field:  fmt.Sprintf("Backups[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2721,8 +2727,8 @@ func (m *ListFilesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListFilesResponseValidationError{
-					field:  fmt.Sprintf("Files[%v]", idx),
+				return ListBackupsResponseValidationError{
+					field:  fmt.Sprintf("Backups[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2732,19 +2738,19 @@ func (m *ListFilesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListFilesResponseMultiError(errors)
+		return ListBackupsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListFilesResponseMultiError is an error wrapping multiple validation errors
-// returned by ListFilesResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListFilesResponseMultiError []error
+// ListBackupsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBackupsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBackupsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListFilesResponseMultiError) Error() string {
+func (m ListBackupsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2753,11 +2759,11 @@ func (m ListFilesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListFilesResponseMultiError) AllErrors() []error { return m }
+func (m ListBackupsResponseMultiError) AllErrors() []error { return m }
 
-// ListFilesResponseValidationError is the validation error returned by
-// ListFilesResponse.Validate if the designated constraints aren't met.
-type ListFilesResponseValidationError struct {
+// ListBackupsResponseValidationError is the validation error returned by
+// ListBackupsResponse.Validate if the designated constraints aren't met.
+type ListBackupsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2765,24 +2771,24 @@ type ListFilesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListFilesResponseValidationError) Field() string { return e.field }
+func (e ListBackupsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListFilesResponseValidationError) Reason() string { return e.reason }
+func (e ListBackupsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListFilesResponseValidationError) Cause() error { return e.cause }
+func (e ListBackupsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListFilesResponseValidationError) Key() bool { return e.key }
+func (e ListBackupsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListFilesResponseValidationError) ErrorName() string {
-	return "ListFilesResponseValidationError"
+func (e ListBackupsResponseValidationError) ErrorName() string {
+	return "ListBackupsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListFilesResponseValidationError) Error() string {
+func (e ListBackupsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2794,14 +2800,14 @@ func (e ListFilesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListFilesResponse.%s: %s%s",
+		"invalid %sListBackupsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListFilesResponseValidationError{}
+var _ error = ListBackupsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2809,47 +2815,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListFilesResponseValidationError{}
+} = ListBackupsResponseValidationError{}
 
-// Validate checks the field values on DirEntry with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DirEntry) Validate() error {
+// Validate checks the field values on CreateBackupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBackupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DirEntry with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DirEntryMultiError, or nil
-// if none found.
-func (m *DirEntry) ValidateAll() error {
+// ValidateAll checks the field values on CreateBackupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBackupRequestMultiError, or nil if none found.
+func (m *CreateBackupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DirEntry) validate(all bool) error {
+func (m *CreateBackupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Path
+	if !_CreateBackupRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := CreateBackupRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for IsDir
+	// no validation rules for Snapshot
 
 	if len(errors) > 0 {
-		return DirEntryMultiError(errors)
+		return CreateBackupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DirEntryMultiError is an error wrapping multiple validation errors returned
-// by DirEntry.ValidateAll() if the designated constraints aren't met.
-type DirEntryMultiError []error
+// CreateBackupRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBackupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBackupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DirEntryMultiError) Error() string {
+func (m CreateBackupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2858,11 +2874,11 @@ func (m DirEntryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DirEntryMultiError) AllErrors() []error { return m }
+func (m CreateBackupRequestMultiError) AllErrors() []error { return m }
 
-// DirEntryValidationError is the validation error returned by
-// DirEntry.Validate if the designated constraints aren't met.
-type DirEntryValidationError struct {
+// CreateBackupRequestValidationError is the validation error returned by
+// CreateBackupRequest.Validate if the designated constraints aren't met.
+type CreateBackupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2870,22 +2886,24 @@ type DirEntryValidationError struct {
 }
 
 // Field function returns field value.
-func (e DirEntryValidationError) Field() string { return e.field }
+func (e CreateBackupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DirEntryValidationError) Reason() string { return e.reason }
+func (e CreateBackupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DirEntryValidationError) Cause() error { return e.cause }
+func (e CreateBackupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DirEntryValidationError) Key() bool { return e.key }
+func (e CreateBackupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DirEntryValidationError) ErrorName() string { return "DirEntryValidationError" }
+func (e CreateBackupRequestValidationError) ErrorName() string {
+	return "CreateBackupRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DirEntryValidationError) Error() string {
+func (e CreateBackupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2897,14 +2915,14 @@ func (e DirEntryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDirEntry.%s: %s%s",
+		"invalid %sCreateBackupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DirEntryValidationError{}
+var _ error = CreateBackupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2912,57 +2930,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DirEntryValidationError{}
+} = CreateBackupRequestValidationError{}
 
-// Validate checks the field values on WatchFilesRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WatchFilesRequest) Validate() error {
+var _CreateBackupRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on CreateBackupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBackupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchFilesRequest with the rules
+// ValidateAll checks the field values on CreateBackupResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchFilesRequestMultiError, or nil if none found.
-func (m *WatchFilesRequest) ValidateAll() error {
+// CreateBackupResponseMultiError, or nil if none found.
+func (m *CreateBackupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchFilesRequest) validate(all bool) error {
+func (m *CreateBackupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_WatchFilesRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := WatchFilesRequestValidationError{
-			field:  "InstanceId",
-			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+	if all {
+		switch v := interface{}(m.GetBackup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBackupResponseValidationError{
+					field:  "Backup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBackupResponseValidationError{
+					field:  "Backup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetBackup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBackupResponseValidationError{
+				field:  "Backup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Replay
-
 	if len(errors) > 0 {
-		return WatchFilesRequestMultiError(errors)
+		return CreateBackupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchFilesRequestMultiError is an error wrapping multiple validation errors
-// returned by WatchFilesRequest.ValidateAll() if the designated constraints
-// aren't met.
-type WatchFilesRequestMultiError []error
+// CreateBackupResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateBackupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBackupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchFilesRequestMultiError) Error() string {
+func (m CreateBackupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2971,11 +3007,11 @@ func (m WatchFilesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchFilesRequestMultiError) AllErrors() []error { return m }
+func (m CreateBackupResponseMultiError) AllErrors() []error { return m }
 
-// WatchFilesRequestValidationError is the validation error returned by
-// WatchFilesRequest.Validate if the designated constraints aren't met.
-type WatchFilesRequestValidationError struct {
+// CreateBackupResponseValidationError is the validation error returned by
+// CreateBackupResponse.Validate if the designated constraints aren't met.
+type CreateBackupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2983,24 +3019,24 @@ type WatchFilesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchFilesRequestValidationError) Field() string { return e.field }
+func (e CreateBackupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchFilesRequestValidationError) Reason() string { return e.reason }
+func (e CreateBackupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchFilesRequestValidationError) Cause() error { return e.cause }
+func (e CreateBackupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchFilesRequestValidationError) Key() bool { return e.key }
+func (e CreateBackupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchFilesRequestValidationError) ErrorName() string {
-	return "WatchFilesRequestValidationError"
+func (e CreateBackupResponseValidationError) ErrorName() string {
+	return "CreateBackupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchFilesRequestValidationError) Error() string {
+func (e CreateBackupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3012,14 +3048,14 @@ func (e WatchFilesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchFilesRequest.%s: %s%s",
+		"invalid %sCreateBackupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchFilesRequestValidationError{}
+var _ error = CreateBackupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3027,52 +3063,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchFilesRequestValidationError{}
-
-var _WatchFilesRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+} = CreateBackupResponseValidationError{}
 
-// Validate checks the field values on WatchFilesResponse with the rules
+// Validate checks the field values on RestoreBackupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchFilesResponse) Validate() error {
+func (m *RestoreBackupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchFilesResponse with the rules
+// ValidateAll checks the field values on RestoreBackupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchFilesResponseMultiError, or nil if none found.
-func (m *WatchFilesResponse) ValidateAll() error {
+// RestoreBackupRequestMultiError, or nil if none found.
+func (m *RestoreBackupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchFilesResponse) validate(all bool) error {
+func (m *RestoreBackupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Event
-
-	// no validation rules for Path
+	if !_RestoreBackupRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := RestoreBackupRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for IsDir
+	if utf8.RuneCountInString(m.GetBackupId()) < 1 {
+		err := RestoreBackupRequestValidationError{
+			field:  "BackupId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return WatchFilesResponseMultiError(errors)
+		return RestoreBackupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchFilesResponseMultiError is an error wrapping multiple validation errors
-// returned by WatchFilesResponse.ValidateAll() if the designated constraints
-// aren't met.
-type WatchFilesResponseMultiError []error
+// RestoreBackupRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreBackupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreBackupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchFilesResponseMultiError) Error() string {
+func (m RestoreBackupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3081,11 +3131,11 @@ func (m WatchFilesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchFilesResponseMultiError) AllErrors() []error { return m }
+func (m RestoreBackupRequestMultiError) AllErrors() []error { return m }
 
-// WatchFilesResponseValidationError is the validation error returned by
-// WatchFilesResponse.Validate if the designated constraints aren't met.
-type WatchFilesResponseValidationError struct {
+// RestoreBackupRequestValidationError is the validation error returned by
+// RestoreBackupRequest.Validate if the designated constraints aren't met.
+type RestoreBackupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3093,24 +3143,24 @@ type WatchFilesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchFilesResponseValidationError) Field() string { return e.field }
+func (e RestoreBackupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchFilesResponseValidationError) Reason() string { return e.reason }
+func (e RestoreBackupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchFilesResponseValidationError) Cause() error { return e.cause }
+func (e RestoreBackupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchFilesResponseValidationError) Key() bool { return e.key }
+func (e RestoreBackupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchFilesResponseValidationError) ErrorName() string {
-	return "WatchFilesResponseValidationError"
+func (e RestoreBackupRequestValidationError) ErrorName() string {
+	return "RestoreBackupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchFilesResponseValidationError) Error() string {
+func (e RestoreBackupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3122,14 +3172,14 @@ func (e WatchFilesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchFilesResponse.%s: %s%s",
+		"invalid %sRestoreBackupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchFilesResponseValidationError{}
+var _ error = RestoreBackupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3137,66 +3187,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchFilesResponseValidationError{}
+} = RestoreBackupRequestValidationError{}
 
-// Validate checks the field values on GetFileRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetFileRequest) Validate() error {
+var _RestoreBackupRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on RestoreBackupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreBackupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetFileRequestMultiError,
-// or nil if none found.
-func (m *GetFileRequest) ValidateAll() error {
+// ValidateAll checks the field values on RestoreBackupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreBackupResponseMultiError, or nil if none found.
+func (m *RestoreBackupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFileRequest) validate(all bool) error {
+func (m *RestoreBackupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := GetFileRequestValidationError{
-			field:  "InstanceId",
-			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetPath()) < 1 {
-		err := GetFileRequestValidationError{
-			field:  "Path",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+	if all {
+		switch v := interface{}(m.GetBackup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreBackupResponseValidationError{
+					field:  "Backup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreBackupResponseValidationError{
+					field:  "Backup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBackup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreBackupResponseValidationError{
+				field:  "Backup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetFileRequestMultiError(errors)
+		return RestoreBackupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFileRequestMultiError is an error wrapping multiple validation errors
-// returned by GetFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetFileRequestMultiError []error
+// RestoreBackupResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreBackupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreBackupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFileRequestMultiError) Error() string {
+func (m RestoreBackupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3205,11 +3264,11 @@ func (m GetFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFileRequestMultiError) AllErrors() []error { return m }
+func (m RestoreBackupResponseMultiError) AllErrors() []error { return m }
 
-// GetFileRequestValidationError is the validation error returned by
-// GetFileRequest.Validate if the designated constraints aren't met.
-type GetFileRequestValidationError struct {
+// RestoreBackupResponseValidationError is the validation error returned by
+// RestoreBackupResponse.Validate if the designated constraints aren't met.
+type RestoreBackupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3217,22 +3276,24 @@ type GetFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFileRequestValidationError) Field() string { return e.field }
+func (e RestoreBackupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFileRequestValidationError) Reason() string { return e.reason }
+func (e RestoreBackupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFileRequestValidationError) Cause() error { return e.cause }
+func (e RestoreBackupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFileRequestValidationError) Key() bool { return e.key }
+func (e RestoreBackupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFileRequestValidationError) ErrorName() string { return "GetFileRequestValidationError" }
+func (e RestoreBackupResponseValidationError) ErrorName() string {
+	return "RestoreBackupResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetFileRequestValidationError) Error() string {
+func (e RestoreBackupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3244,14 +3305,14 @@ func (e GetFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFileRequest.%s: %s%s",
+		"invalid %sRestoreBackupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFileRequestValidationError{}
+var _ error = RestoreBackupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3259,77 +3320,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFileRequestValidationError{}
-
-var _GetFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+} = RestoreBackupResponseValidationError{}
 
-// Validate checks the field values on GetFileResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetFileResponse) Validate() error {
+// Validate checks the field values on Backup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Backup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFileResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetFileResponseMultiError, or nil if none found.
-func (m *GetFileResponse) ValidateAll() error {
+// ValidateAll checks the field values on Backup with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BackupMultiError, or nil if none found.
+func (m *Backup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFileResponse) validate(all bool) error {
+func (m *Backup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Blob
+	// no validation rules for Id
 
 	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetFileResponseValidationError{
-					field:  "UpdatedOn",
+				errors = append(errors, BackupValidationError{
+					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetFileResponseValidationError{
-					field:  "UpdatedOn",
+				errors = append(errors, BackupValidationError{
+					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetFileResponseValidationError{
-				field:  "UpdatedOn",
+			return BackupValidationError{
+				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for SizeBytes
+
+	// no validation rules for Snapshot
+
 	if len(errors) > 0 {
-		return GetFileResponseMultiError(errors)
+		return BackupMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFileResponseMultiError is an error wrapping multiple validation errors
-// returned by GetFileResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetFileResponseMultiError []error
+// BackupMultiError is an error wrapping multiple validation errors returned by
+// Backup.ValidateAll() if the designated constraints aren't met.
+type BackupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFileResponseMultiError) Error() string {
+func (m BackupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3338,11 +3399,11 @@ func (m GetFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFileResponseMultiError) AllErrors() []error { return m }
+func (m BackupMultiError) AllErrors() []error { return m }
 
-// GetFileResponseValidationError is the validation error returned by
-// GetFileResponse.Validate if the designated constraints aren't met.
-type GetFileResponseValidationError struct {
+// BackupValidationError is the validation error returned by Backup.Validate if
+// the designated constraints aren't met.
+type BackupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3350,22 +3411,22 @@ type GetFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFileResponseValidationError) Field() string { return e.field }
+func (e BackupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFileResponseValidationError) Reason() string { return e.reason }
+func (e BackupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFileResponseValidationError) Cause() error { return e.cause }
+func (e BackupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFileResponseValidationError) Key() bool { return e.key }
+func (e BackupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFileResponseValidationError) ErrorName() string { return "GetFileResponseValidationError" }
+func (e BackupValidationError) ErrorName() string { return "BackupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetFileResponseValidationError) Error() string {
+func (e BackupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3377,14 +3438,14 @@ func (e GetFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFileResponse.%s: %s%s",
+		"invalid %sBackup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFileResponseValidationError{}
+var _ error = BackupValidationError{}
 
 var _ interface {
 	Field() string
@@ -3392,32 +3453,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFileResponseValidationError{}
+} = BackupValidationError{}
 
-// Validate checks the field values on PutFileRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PutFileRequest) Validate() error {
+// Validate checks the field values on ResumeInstanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeInstanceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PutFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PutFileRequestMultiError,
-// or nil if none found.
-func (m *PutFileRequest) ValidateAll() error {
+// ValidateAll checks the field values on ResumeInstanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeInstanceRequestMultiError, or nil if none found.
+func (m *ResumeInstanceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PutFileRequest) validate(all bool) error {
+func (m *ResumeInstanceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_PutFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := PutFileRequestValidationError{
+	if !_ResumeInstanceRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := ResumeInstanceRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -3427,37 +3488,20 @@ func (m *PutFileRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetPath()) < 1 {
-		err := PutFileRequestValidationError{
-			field:  "Path",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Blob
-
-	// no validation rules for Create
-
-	// no validation rules for CreateOnly
-
 	if len(errors) > 0 {
-		return PutFileRequestMultiError(errors)
+		return ResumeInstanceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PutFileRequestMultiError is an error wrapping multiple validation errors
-// returned by PutFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type PutFileRequestMultiError []error
+// ResumeInstanceRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeInstanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeInstanceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PutFileRequestMultiError) Error() string {
+func (m ResumeInstanceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3466,11 +3510,11 @@ func (m PutFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PutFileRequestMultiError) AllErrors() []error { return m }
+func (m ResumeInstanceRequestMultiError) AllErrors() []error { return m }
 
-// PutFileRequestValidationError is the validation error returned by
-// PutFileRequest.Validate if the designated constraints aren't met.
-type PutFileRequestValidationError struct {
+// ResumeInstanceRequestValidationError is the validation error returned by
+// ResumeInstanceRequest.Validate if the designated constraints aren't met.
+type ResumeInstanceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3478,22 +3522,24 @@ type PutFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PutFileRequestValidationError) Field() string { return e.field }
+func (e ResumeInstanceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PutFileRequestValidationError) Reason() string { return e.reason }
+func (e ResumeInstanceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PutFileRequestValidationError) Cause() error { return e.cause }
+func (e ResumeInstanceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PutFileRequestValidationError) Key() bool { return e.key }
+func (e ResumeInstanceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PutFileRequestValidationError) ErrorName() string { return "PutFileRequestValidationError" }
+func (e ResumeInstanceRequestValidationError) ErrorName() string {
+	return "ResumeInstanceRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PutFileRequestValidationError) Error() string {
+func (e ResumeInstanceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3505,14 +3551,14 @@ func (e PutFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPutFileRequest.%s: %s%s",
+		"invalid %sResumeInstanceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PutFileRequestValidationError{}
+var _ error = ResumeInstanceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3520,48 +3566,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PutFileRequestValidationError{}
+} = ResumeInstanceRequestValidationError{}
 
-var _PutFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _ResumeInstanceRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on PutFileResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PutFileResponse) Validate() error {
+// Validate checks the field values on ResumeInstanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeInstanceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PutFileResponse with the rules
+// ValidateAll checks the field values on ResumeInstanceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PutFileResponseMultiError, or nil if none found.
-func (m *PutFileResponse) ValidateAll() error {
+// ResumeInstanceResponseMultiError, or nil if none found.
+func (m *ResumeInstanceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PutFileResponse) validate(all bool) error {
+func (m *ResumeInstanceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for FilePath
-
 	if len(errors) > 0 {
-		return PutFileResponseMultiError(errors)
+		return ResumeInstanceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PutFileResponseMultiError is an error wrapping multiple validation errors
-// returned by PutFileResponse.ValidateAll() if the designated constraints
-// aren't met.
-type PutFileResponseMultiError []error
+// ResumeInstanceResponseMultiError is an error wrapping multiple validation
+// errors returned by ResumeInstanceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeInstanceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PutFileResponseMultiError) Error() string {
+func (m ResumeInstanceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3570,11 +3614,11 @@ func (m PutFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PutFileResponseMultiError) AllErrors() []error { return m }
+func (m ResumeInstanceResponseMultiError) AllErrors() []error { return m }
 
-// PutFileResponseValidationError is the validation error returned by
-// PutFileResponse.Validate if the designated constraints aren't met.
-type PutFileResponseValidationError struct {
+// ResumeInstanceResponseValidationError is the validation error returned by
+// ResumeInstanceResponse.Validate if the designated constraints aren't met.
+type ResumeInstanceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3582,22 +3626,24 @@ type PutFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PutFileResponseValidationError) Field() string { return e.field }
+func (e ResumeInstanceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PutFileResponseValidationError) Reason() string { return e.reason }
+func (e ResumeInstanceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PutFileResponseValidationError) Cause() error { return e.cause }
+func (e ResumeInstanceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PutFileResponseValidationError) Key() bool { return e.key }
+func (e ResumeInstanceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PutFileResponseValidationError) ErrorName() string { return "PutFileResponseValidationError" }
+func (e ResumeInstanceResponseValidationError) ErrorName() string {
+	return "ResumeInstanceResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PutFileResponseValidationError) Error() string {
+func (e ResumeInstanceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3609,14 +3655,14 @@ func (e PutFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPutFileResponse.%s: %s%s",
+		"invalid %sResumeInstanceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PutFileResponseValidationError{}
+var _ error = ResumeInstanceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3624,32 +3670,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PutFileResponseValidationError{}
+} = ResumeInstanceResponseValidationError{}
 
-// Validate checks the field values on CreateDirectoryRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDirectoryRequest) Validate() error {
+// Validate checks the field values on ListFilesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListFilesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDirectoryRequest with the rules
+// ValidateAll checks the field values on ListFilesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateDirectoryRequestMultiError, or nil if none found.
-func (m *CreateDirectoryRequest) ValidateAll() error {
+// ListFilesRequestMultiError, or nil if none found.
+func (m *ListFilesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDirectoryRequest) validate(all bool) error {
+func (m *ListFilesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CreateDirectoryRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := CreateDirectoryRequestValidationError{
+	if !_ListFilesRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := ListFilesRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -3659,31 +3705,22 @@ func (m *CreateDirectoryRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetPath()) < 1 {
-		err := CreateDirectoryRequestValidationError{
-			field:  "Path",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Glob
 
 	if len(errors) > 0 {
-		return CreateDirectoryRequestMultiError(errors)
+		return ListFilesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDirectoryRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateDirectoryRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateDirectoryRequestMultiError []error
+// ListFilesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListFilesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListFilesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDirectoryRequestMultiError) Error() string {
+func (m ListFilesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3692,11 +3729,11 @@ func (m CreateDirectoryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDirectoryRequestMultiError) AllErrors() []error { return m }
+func (m ListFilesRequestMultiError) AllErrors() []error { return m }
 
-// CreateDirectoryRequestValidationError is the validation error returned by
-// CreateDirectoryRequest.Validate if the designated constraints aren't met.
-type CreateDirectoryRequestValidationError struct {
+// ListFilesRequestValidationError is the validation error returned by
+// ListFilesRequest.Validate if the designated constraints aren't met.
+type ListFilesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3704,24 +3741,22 @@ type CreateDirectoryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDirectoryRequestValidationError) Field() string { return e.field }
+func (e ListFilesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDirectoryRequestValidationError) Reason() string { return e.reason }
+func (e ListFilesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDirectoryRequestValidationError) Cause() error { return e.cause }
+func (e ListFilesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDirectoryRequestValidationError) Key() bool { return e.key }
+func (e ListFilesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDirectoryRequestValidationError) ErrorName() string {
-	return "CreateDirectoryRequestValidationError"
-}
+func (e ListFilesRequestValidationError) ErrorName() string { return "ListFilesRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateDirectoryRequestValidationError) Error() string {
+func (e ListFilesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3733,14 +3768,14 @@ func (e CreateDirectoryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDirectoryRequest.%s: %s%s",
+		"invalid %sListFilesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDirectoryRequestValidationError{}
+var _ error = ListFilesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3748,46 +3783,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDirectoryRequestValidationError{}
+} = ListFilesRequestValidationError{}
 
-var _CreateDirectoryRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _ListFilesRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on CreateDirectoryResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDirectoryResponse) Validate() error {
+// Validate checks the field values on ListFilesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListFilesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDirectoryResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListFilesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateDirectoryResponseMultiError, or nil if none found.
-func (m *CreateDirectoryResponse) ValidateAll() error {
+// ListFilesResponseMultiError, or nil if none found.
+func (m *ListFilesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDirectoryResponse) validate(all bool) error {
+func (m *ListFilesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFilesResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFilesResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFilesResponseValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return CreateDirectoryResponseMultiError(errors)
+		return ListFilesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDirectoryResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateDirectoryResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateDirectoryResponseMultiError []error
+// ListFilesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListFilesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListFilesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDirectoryResponseMultiError) Error() string {
+func (m ListFilesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3796,11 +3865,11 @@ func (m CreateDirectoryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDirectoryResponseMultiError) AllErrors() []error { return m }
+func (m ListFilesResponseMultiError) AllErrors() []error { return m }
 
-// CreateDirectoryResponseValidationError is the validation error returned by
-// CreateDirectoryResponse.Validate if the designated constraints aren't met.
-type CreateDirectoryResponseValidationError struct {
+// ListFilesResponseValidationError is the validation error returned by
+// ListFilesResponse.Validate if the designated constraints aren't met.
+type ListFilesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3808,24 +3877,24 @@ type CreateDirectoryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDirectoryResponseValidationError) Field() string { return e.field }
+func (e ListFilesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDirectoryResponseValidationError) Reason() string { return e.reason }
+func (e ListFilesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDirectoryResponseValidationError) Cause() error { return e.cause }
+func (e ListFilesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDirectoryResponseValidationError) Key() bool { return e.key }
+func (e ListFilesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDirectoryResponseValidationError) ErrorName() string {
-	return "CreateDirectoryResponseValidationError"
+func (e ListFilesResponseValidationError) ErrorName() string {
+	return "ListFilesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDirectoryResponseValidationError) Error() string {
+func (e ListFilesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3837,14 +3906,14 @@ func (e CreateDirectoryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDirectoryResponse.%s: %s%s",
+		"invalid %sListFilesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDirectoryResponseValidationError{}
+var _ error = ListFilesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3852,68 +3921,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDirectoryResponseValidationError{}
+} = ListFilesResponseValidationError{}
 
-// Validate checks the field values on DeleteFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteFileRequest) Validate() error {
+// Validate checks the field values on DirEntry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DirEntry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteFileRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteFileRequestMultiError, or nil if none found.
-func (m *DeleteFileRequest) ValidateAll() error {
+// ValidateAll checks the field values on DirEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DirEntryMultiError, or nil
+// if none found.
+func (m *DirEntry) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteFileRequest) validate(all bool) error {
+func (m *DirEntry) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_DeleteFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := DeleteFileRequestValidationError{
-			field:  "InstanceId",
-			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetPath()) < 1 {
-		err := DeleteFileRequestValidationError{
-			field:  "Path",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Path
 
-	// no validation rules for Force
+	// no validation rules for IsDir
 
 	if len(errors) > 0 {
-		return DeleteFileRequestMultiError(errors)
+		return DirEntryMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteFileRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteFileRequestMultiError []error
+// DirEntryMultiError is an error wrapping multiple validation errors returned
+// by DirEntry.ValidateAll() if the designated constraints aren't met.
+type DirEntryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteFileRequestMultiError) Error() string {
+func (m DirEntryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3922,11 +3970,11 @@ func (m DeleteFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteFileRequestMultiError) AllErrors() []error { return m }
+func (m DirEntryMultiError) AllErrors() []error { return m }
 
-// DeleteFileRequestValidationError is the validation error returned by
-// DeleteFileRequest.Validate if the designated constraints aren't met.
-type DeleteFileRequestValidationError struct {
+// DirEntryValidationError is the validation error returned by
+// DirEntry.Validate if the designated constraints aren't met.
+type DirEntryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3934,24 +3982,22 @@ type DeleteFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteFileRequestValidationError) Field() string { return e.field }
+func (e DirEntryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteFileRequestValidationError) Reason() string { return e.reason }
+func (e DirEntryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteFileRequestValidationError) Cause() error { return e.cause }
+func (e DirEntryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteFileRequestValidationError) Key() bool { return e.key }
+func (e DirEntryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteFileRequestValidationError) ErrorName() string {
-	return "DeleteFileRequestValidationError"
-}
+func (e DirEntryValidationError) ErrorName() string { return "DirEntryValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteFileRequestValidationError) Error() string {
+func (e DirEntryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3963,14 +4009,14 @@ func (e DeleteFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteFileRequest.%s: %s%s",
+		"invalid %sDirEntry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteFileRequestValidationError{}
+var _ error = DirEntryValidationError{}
 
 var _ interface {
 	Field() string
@@ -3978,46 +4024,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteFileRequestValidationError{}
-
-var _DeleteFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+} = DirEntryValidationError{}
 
-// Validate checks the field values on DeleteFileResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteFileResponse) Validate() error {
+// Validate checks the field values on WatchFilesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchFilesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteFileResponse with the rules
+// ValidateAll checks the field values on WatchFilesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteFileResponseMultiError, or nil if none found.
-func (m *DeleteFileResponse) ValidateAll() error {
+// WatchFilesRequestMultiError, or nil if none found.
+func (m *WatchFilesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteFileResponse) validate(all bool) error {
+func (m *WatchFilesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if !_WatchFilesRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := WatchFilesRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Replay
+
 	if len(errors) > 0 {
-		return DeleteFileResponseMultiError(errors)
+		return WatchFilesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteFileResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteFileResponse.ValidateAll() if the designated constraints
+// WatchFilesRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchFilesRequest.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteFileResponseMultiError []error
+type WatchFilesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteFileResponseMultiError) Error() string {
+func (m WatchFilesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4026,11 +4083,11 @@ func (m DeleteFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteFileResponseMultiError) AllErrors() []error { return m }
+func (m WatchFilesRequestMultiError) AllErrors() []error { return m }
 
-// DeleteFileResponseValidationError is the validation error returned by
-// DeleteFileResponse.Validate if the designated constraints aren't met.
-type DeleteFileResponseValidationError struct {
+// WatchFilesRequestValidationError is the validation error returned by
+// WatchFilesRequest.Validate if the designated constraints aren't met.
+type WatchFilesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4038,24 +4095,24 @@ type DeleteFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteFileResponseValidationError) Field() string { return e.field }
+func (e WatchFilesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteFileResponseValidationError) Reason() string { return e.reason }
+func (e WatchFilesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteFileResponseValidationError) Cause() error { return e.cause }
+func (e WatchFilesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteFileResponseValidationError) Key() bool { return e.key }
+func (e WatchFilesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteFileResponseValidationError) ErrorName() string {
-	return "DeleteFileResponseValidationError"
+func (e WatchFilesRequestValidationError) ErrorName() string {
+	return "WatchFilesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteFileResponseValidationError) Error() string {
+func (e WatchFilesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4067,14 +4124,14 @@ func (e DeleteFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteFileResponse.%s: %s%s",
+		"invalid %sWatchFilesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteFileResponseValidationError{}
+var _ error = WatchFilesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4082,77 +4139,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteFileResponseValidationError{}
+} = WatchFilesRequestValidationError{}
 
-// Validate checks the field values on RenameFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *RenameFileRequest) Validate() error {
+var _WatchFilesRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on WatchFilesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchFilesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RenameFileRequest with the rules
+// ValidateAll checks the field values on WatchFilesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RenameFileRequestMultiError, or nil if none found.
-func (m *RenameFileRequest) ValidateAll() error {
+// WatchFilesResponseMultiError, or nil if none found.
+func (m *WatchFilesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RenameFileRequest) validate(all bool) error {
+func (m *WatchFilesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_RenameFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := RenameFileRequestValidationError{
-			field:  "InstanceId",
-			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Event
 
-	if utf8.RuneCountInString(m.GetFromPath()) < 1 {
-		err := RenameFileRequestValidationError{
-			field:  "FromPath",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Path
 
-	if utf8.RuneCountInString(m.GetToPath()) < 1 {
-		err := RenameFileRequestValidationError{
-			field:  "ToPath",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for IsDir
 
 	if len(errors) > 0 {
-		return RenameFileRequestMultiError(errors)
+		return WatchFilesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RenameFileRequestMultiError is an error wrapping multiple validation errors
-// returned by RenameFileRequest.ValidateAll() if the designated constraints
+// WatchFilesResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchFilesResponse.ValidateAll() if the designated constraints
 // aren't met.
-type RenameFileRequestMultiError []error
+type WatchFilesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RenameFileRequestMultiError) Error() string {
+func (m WatchFilesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4161,11 +4193,11 @@ func (m RenameFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RenameFileRequestMultiError) AllErrors() []error { return m }
+func (m WatchFilesResponseMultiError) AllErrors() []error { return m }
 
-// RenameFileRequestValidationError is the validation error returned by
-// RenameFileRequest.Validate if the designated constraints aren't met.
-type RenameFileRequestValidationError struct {
+// WatchFilesResponseValidationError is the validation error returned by
+// WatchFilesResponse.Validate if the designated constraints aren't met.
+type WatchFilesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4173,24 +4205,24 @@ type RenameFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenameFileRequestValidationError) Field() string { return e.field }
+func (e WatchFilesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenameFileRequestValidationError) Reason() string { return e.reason }
+func (e WatchFilesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenameFileRequestValidationError) Cause() error { return e.cause }
+func (e WatchFilesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenameFileRequestValidationError) Key() bool { return e.key }
+func (e WatchFilesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenameFileRequestValidationError) ErrorName() string {
-	return "RenameFileRequestValidationError"
+func (e WatchFilesResponseValidationError) ErrorName() string {
+	return "WatchFilesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RenameFileRequestValidationError) Error() string {
+func (e WatchFilesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4202,14 +4234,14 @@ func (e RenameFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenameFileRequest.%s: %s%s",
+		"invalid %sWatchFilesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenameFileRequestValidationError{}
+var _ error = WatchFilesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4217,46 +4249,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenameFileRequestValidationError{}
-
-var _RenameFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+} = WatchFilesResponseValidationError{}
 
-// Validate checks the field values on RenameFileResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RenameFileResponse) Validate() error {
+// Validate checks the field values on GetFileRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetFileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RenameFileResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RenameFileResponseMultiError, or nil if none found.
-func (m *RenameFileResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetFileRequestMultiError,
+// or nil if none found.
+func (m *GetFileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RenameFileResponse) validate(all bool) error {
+func (m *GetFileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if !_GetFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := GetFileRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := GetFileRequestValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return RenameFileResponseMultiError(errors)
+		return GetFileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RenameFileResponseMultiError is an error wrapping multiple validation errors
-// returned by RenameFileResponse.ValidateAll() if the designated constraints
+// GetFileRequestMultiError is an error wrapping multiple validation errors
+// returned by GetFileRequest.ValidateAll() if the designated constraints
 // aren't met.
-type RenameFileResponseMultiError []error
+type GetFileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RenameFileResponseMultiError) Error() string {
+func (m GetFileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4265,11 +4317,11 @@ func (m RenameFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RenameFileResponseMultiError) AllErrors() []error { return m }
+func (m GetFileRequestMultiError) AllErrors() []error { return m }
 
-// RenameFileResponseValidationError is the validation error returned by
-// RenameFileResponse.Validate if the designated constraints aren't met.
-type RenameFileResponseValidationError struct {
+// GetFileRequestValidationError is the validation error returned by
+// GetFileRequest.Validate if the designated constraints aren't met.
+type GetFileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4277,24 +4329,22 @@ type RenameFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenameFileResponseValidationError) Field() string { return e.field }
+func (e GetFileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenameFileResponseValidationError) Reason() string { return e.reason }
+func (e GetFileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenameFileResponseValidationError) Cause() error { return e.cause }
+func (e GetFileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenameFileResponseValidationError) Key() bool { return e.key }
+func (e GetFileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenameFileResponseValidationError) ErrorName() string {
-	return "RenameFileResponseValidationError"
-}
+func (e GetFileRequestValidationError) ErrorName() string { return "GetFileRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RenameFileResponseValidationError) Error() string {
+func (e GetFileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4306,14 +4356,14 @@ func (e RenameFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenameFileResponse.%s: %s%s",
+		"invalid %sGetFileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenameFileResponseValidationError{}
+var _ error = GetFileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4321,48 +4371,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenameFileResponseValidationError{}
+} = GetFileRequestValidationError{}
 
-// Validate checks the field values on Example with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Example) Validate() error {
+var _GetFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on GetFileResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Example with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ExampleMultiError, or nil if none found.
-func (m *Example) ValidateAll() error {
+// ValidateAll checks the field values on GetFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFileResponseMultiError, or nil if none found.
+func (m *GetFileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Example) validate(all bool) error {
+func (m *GetFileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	// no validation rules for Title
+	// no validation rules for Blob
 
-	// no validation rules for Description
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFileResponseValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFileResponseValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFileResponseValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ExampleMultiError(errors)
+		return GetFileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExampleMultiError is an error wrapping multiple validation errors returned
-// by Example.ValidateAll() if the designated constraints aren't met.
-type ExampleMultiError []error
+// GetFileResponseMultiError is an error wrapping multiple validation errors
+// returned by GetFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetFileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExampleMultiError) Error() string {
+func (m GetFileResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4371,11 +4450,11 @@ func (m ExampleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExampleMultiError) AllErrors() []error { return m }
+func (m GetFileResponseMultiError) AllErrors() []error { return m }
 
-// ExampleValidationError is the validation error returned by Example.Validate
-// if the designated constraints aren't met.
-type ExampleValidationError struct {
+// GetFileResponseValidationError is the validation error returned by
+// GetFileResponse.Validate if the designated constraints aren't met.
+type GetFileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4383,22 +4462,22 @@ type ExampleValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExampleValidationError) Field() string { return e.field }
+func (e GetFileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExampleValidationError) Reason() string { return e.reason }
+func (e GetFileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExampleValidationError) Cause() error { return e.cause }
+func (e GetFileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExampleValidationError) Key() bool { return e.key }
+func (e GetFileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExampleValidationError) ErrorName() string { return "ExampleValidationError" }
+func (e GetFileResponseValidationError) ErrorName() string { return "GetFileResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ExampleValidationError) Error() string {
+func (e GetFileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4410,14 +4489,14 @@ func (e ExampleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExample.%s: %s%s",
+		"invalid %sGetFileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExampleValidationError{}
+var _ error = GetFileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4425,44 +4504,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExampleValidationError{}
+} = GetFileResponseValidationError{}
 
-// Validate checks the field values on ListExamplesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListExamplesRequest) Validate() error {
+// Validate checks the field values on PutFileRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PutFileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListExamplesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListExamplesRequestMultiError, or nil if none found.
-func (m *ListExamplesRequest) ValidateAll() error {
+// ValidateAll checks the field values on PutFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PutFileRequestMultiError,
+// or nil if none found.
+func (m *PutFileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListExamplesRequest) validate(all bool) error {
+func (m *PutFileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if !_PutFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := PutFileRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := PutFileRequestValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Blob
+
+	// no validation rules for Create
+
+	// no validation rules for CreateOnly
+
 	if len(errors) > 0 {
-		return ListExamplesRequestMultiError(errors)
+		return PutFileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListExamplesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListExamplesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListExamplesRequestMultiError []error
+// PutFileRequestMultiError is an error wrapping multiple validation errors
+// returned by PutFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PutFileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListExamplesRequestMultiError) Error() string {
+func (m PutFileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4471,11 +4578,11 @@ func (m ListExamplesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListExamplesRequestMultiError) AllErrors() []error { return m }
+func (m PutFileRequestMultiError) AllErrors() []error { return m }
 
-// ListExamplesRequestValidationError is the validation error returned by
-// ListExamplesRequest.Validate if the designated constraints aren't met.
-type ListExamplesRequestValidationError struct {
+// PutFileRequestValidationError is the validation error returned by
+// PutFileRequest.Validate if the designated constraints aren't met.
+type PutFileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4483,24 +4590,22 @@ type ListExamplesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListExamplesRequestValidationError) Field() string { return e.field }
+func (e PutFileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListExamplesRequestValidationError) Reason() string { return e.reason }
+func (e PutFileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListExamplesRequestValidationError) Cause() error { return e.cause }
+func (e PutFileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListExamplesRequestValidationError) Key() bool { return e.key }
+func (e PutFileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListExamplesRequestValidationError) ErrorName() string {
-	return "ListExamplesRequestValidationError"
-}
+func (e PutFileRequestValidationError) ErrorName() string { return "PutFileRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListExamplesRequestValidationError) Error() string {
+func (e PutFileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4512,14 +4617,14 @@ func (e ListExamplesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListExamplesRequest.%s: %s%s",
+		"invalid %sPutFileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListExamplesRequestValidationError{}
+var _ error = PutFileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4527,78 +4632,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListExamplesRequestValidationError{}
+} = PutFileRequestValidationError{}
 
-// Validate checks the field values on ListExamplesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListExamplesResponse) Validate() error {
+var _PutFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on PutFileResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PutFileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListExamplesResponse with the rules
+// ValidateAll checks the field values on PutFileResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListExamplesResponseMultiError, or nil if none found.
-func (m *ListExamplesResponse) ValidateAll() error {
+// PutFileResponseMultiError, or nil if none found.
+func (m *PutFileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListExamplesResponse) validate(all bool) error {
+func (m *PutFileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetExamples() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListExamplesResponseValidationError{
-						field:  fmt.Sprintf("Examples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListExamplesResponseValidationError{
-						field:  fmt.Sprintf("Examples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListExamplesResponseValidationError{
-					field:  fmt.Sprintf("Examples[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for FilePath
 
 	if len(errors) > 0 {
-		return ListExamplesResponseMultiError(errors)
+		return PutFileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListExamplesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListExamplesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListExamplesResponseMultiError []error
+// PutFileResponseMultiError is an error wrapping multiple validation errors
+// returned by PutFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PutFileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListExamplesResponseMultiError) Error() string {
+func (m PutFileResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4607,11 +4682,11 @@ func (m ListExamplesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListExamplesResponseMultiError) AllErrors() []error { return m }
+func (m PutFileResponseMultiError) AllErrors() []error { return m }
 
-// ListExamplesResponseValidationError is the validation error returned by
-// ListExamplesResponse.Validate if the designated constraints aren't met.
-type ListExamplesResponseValidationError struct {
+// PutFileResponseValidationError is the validation error returned by
+// PutFileResponse.Validate if the designated constraints aren't met.
+type PutFileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4619,24 +4694,22 @@ type ListExamplesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListExamplesResponseValidationError) Field() string { return e.field }
+func (e PutFileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListExamplesResponseValidationError) Reason() string { return e.reason }
+func (e PutFileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListExamplesResponseValidationError) Cause() error { return e.cause }
+func (e PutFileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListExamplesResponseValidationError) Key() bool { return e.key }
+func (e PutFileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListExamplesResponseValidationError) ErrorName() string {
-	return "ListExamplesResponseValidationError"
-}
+func (e PutFileResponseValidationError) ErrorName() string { return "PutFileResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListExamplesResponseValidationError) Error() string {
+func (e PutFileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4648,14 +4721,14 @@ func (e ListExamplesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListExamplesResponse.%s: %s%s",
+		"invalid %sPutFileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListExamplesResponseValidationError{}
+var _ error = PutFileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4663,32 +4736,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListExamplesResponseValidationError{}
+} = PutFileResponseValidationError{}
 
-// Validate checks the field values on UnpackExampleRequest with the rules
+// Validate checks the field values on CreateDirectoryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnpackExampleRequest) Validate() error {
+func (m *CreateDirectoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnpackExampleRequest with the rules
+// ValidateAll checks the field values on CreateDirectoryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnpackExampleRequestMultiError, or nil if none found.
-func (m *UnpackExampleRequest) ValidateAll() error {
+// CreateDirectoryRequestMultiError, or nil if none found.
+func (m *CreateDirectoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnpackExampleRequest) validate(all bool) error {
+func (m *CreateDirectoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_UnpackExampleRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := UnpackExampleRequestValidationError{
+	if !_CreateDirectoryRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := CreateDirectoryRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -4698,9 +4771,9 @@ func (m *UnpackExampleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := UnpackExampleRequestValidationError{
-			field:  "Name",
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := CreateDirectoryRequestValidationError{
+			field:  "Path",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -4709,22 +4782,20 @@ func (m *UnpackExampleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Force
-
 	if len(errors) > 0 {
-		return UnpackExampleRequestMultiError(errors)
+		return CreateDirectoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnpackExampleRequestMultiError is an error wrapping multiple validation
-// errors returned by UnpackExampleRequest.ValidateAll() if the designated
+// CreateDirectoryRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateDirectoryRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UnpackExampleRequestMultiError []error
+type CreateDirectoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnpackExampleRequestMultiError) Error() string {
+func (m CreateDirectoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4733,11 +4804,11 @@ func (m UnpackExampleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnpackExampleRequestMultiError) AllErrors() []error { return m }
+func (m CreateDirectoryRequestMultiError) AllErrors() []error { return m }
 
-// UnpackExampleRequestValidationError is the validation error returned by
-// UnpackExampleRequest.Validate if the designated constraints aren't met.
-type UnpackExampleRequestValidationError struct {
+// CreateDirectoryRequestValidationError is the validation error returned by
+// CreateDirectoryRequest.Validate if the designated constraints aren't met.
+type CreateDirectoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4745,24 +4816,24 @@ type UnpackExampleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnpackExampleRequestValidationError) Field() string { return e.field }
+func (e CreateDirectoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnpackExampleRequestValidationError) Reason() string { return e.reason }
+func (e CreateDirectoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnpackExampleRequestValidationError) Cause() error { return e.cause }
+func (e CreateDirectoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnpackExampleRequestValidationError) Key() bool { return e.key }
+func (e CreateDirectoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnpackExampleRequestValidationError) ErrorName() string {
-	return "UnpackExampleRequestValidationError"
+func (e CreateDirectoryRequestValidationError) ErrorName() string {
+	return "CreateDirectoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnpackExampleRequestValidationError) Error() string {
+func (e CreateDirectoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4774,14 +4845,14 @@ func (e UnpackExampleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnpackExampleRequest.%s: %s%s",
+		"invalid %sCreateDirectoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnpackExampleRequestValidationError{}
+var _ error = CreateDirectoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4789,26 +4860,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnpackExampleRequestValidationError{}
+} = CreateDirectoryRequestValidationError{}
 
-var _UnpackExampleRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _CreateDirectoryRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on UnpackExampleResponse with the rules
+// Validate checks the field values on CreateDirectoryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnpackExampleResponse) Validate() error {
+func (m *CreateDirectoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnpackExampleResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateDirectoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnpackExampleResponseMultiError, or nil if none found.
-func (m *UnpackExampleResponse) ValidateAll() error {
+// CreateDirectoryResponseMultiError, or nil if none found.
+func (m *CreateDirectoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnpackExampleResponse) validate(all bool) error {
+func (m *CreateDirectoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4816,19 +4887,19 @@ func (m *UnpackExampleResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return UnpackExampleResponseMultiError(errors)
+		return CreateDirectoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnpackExampleResponseMultiError is an error wrapping multiple validation
-// errors returned by UnpackExampleResponse.ValidateAll() if the designated
+// CreateDirectoryResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateDirectoryResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UnpackExampleResponseMultiError []error
+type CreateDirectoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnpackExampleResponseMultiError) Error() string {
+func (m CreateDirectoryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4837,11 +4908,11 @@ func (m UnpackExampleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnpackExampleResponseMultiError) AllErrors() []error { return m }
+func (m CreateDirectoryResponseMultiError) AllErrors() []error { return m }
 
-// UnpackExampleResponseValidationError is the validation error returned by
-// UnpackExampleResponse.Validate if the designated constraints aren't met.
-type UnpackExampleResponseValidationError struct {
+// CreateDirectoryResponseValidationError is the validation error returned by
+// CreateDirectoryResponse.Validate if the designated constraints aren't met.
+type CreateDirectoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4849,24 +4920,24 @@ type UnpackExampleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnpackExampleResponseValidationError) Field() string { return e.field }
+func (e CreateDirectoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnpackExampleResponseValidationError) Reason() string { return e.reason }
+func (e CreateDirectoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnpackExampleResponseValidationError) Cause() error { return e.cause }
+func (e CreateDirectoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnpackExampleResponseValidationError) Key() bool { return e.key }
+func (e CreateDirectoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnpackExampleResponseValidationError) ErrorName() string {
-	return "UnpackExampleResponseValidationError"
+func (e CreateDirectoryResponseValidationError) ErrorName() string {
+	return "CreateDirectoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnpackExampleResponseValidationError) Error() string {
+func (e CreateDirectoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4878,14 +4949,14 @@ func (e UnpackExampleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnpackExampleResponse.%s: %s%s",
+		"invalid %sCreateDirectoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnpackExampleResponseValidationError{}
+var _ error = CreateDirectoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4893,32 +4964,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnpackExampleResponseValidationError{}
+} = CreateDirectoryResponseValidationError{}
 
-// Validate checks the field values on UnpackEmptyRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnpackEmptyRequest) Validate() error {
+// Validate checks the field values on DeleteFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnpackEmptyRequest with the rules
+// ValidateAll checks the field values on DeleteFileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnpackEmptyRequestMultiError, or nil if none found.
-func (m *UnpackEmptyRequest) ValidateAll() error {
+// DeleteFileRequestMultiError, or nil if none found.
+func (m *DeleteFileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnpackEmptyRequest) validate(all bool) error {
+func (m *DeleteFileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_UnpackEmptyRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := UnpackEmptyRequestValidationError{
+	if !_DeleteFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := DeleteFileRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -4928,24 +4999,33 @@ func (m *UnpackEmptyRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Title
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := DeleteFileRequestValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Force
 
 	if len(errors) > 0 {
-		return UnpackEmptyRequestMultiError(errors)
+		return DeleteFileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnpackEmptyRequestMultiError is an error wrapping multiple validation errors
-// returned by UnpackEmptyRequest.ValidateAll() if the designated constraints
+// DeleteFileRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteFileRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UnpackEmptyRequestMultiError []error
+type DeleteFileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnpackEmptyRequestMultiError) Error() string {
+func (m DeleteFileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4954,11 +5034,11 @@ func (m UnpackEmptyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnpackEmptyRequestMultiError) AllErrors() []error { return m }
+func (m DeleteFileRequestMultiError) AllErrors() []error { return m }
 
-// UnpackEmptyRequestValidationError is the validation error returned by
-// UnpackEmptyRequest.Validate if the designated constraints aren't met.
-type UnpackEmptyRequestValidationError struct {
+// DeleteFileRequestValidationError is the validation error returned by
+// DeleteFileRequest.Validate if the designated constraints aren't met.
+type DeleteFileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4966,24 +5046,24 @@ type UnpackEmptyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnpackEmptyRequestValidationError) Field() string { return e.field }
+func (e DeleteFileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnpackEmptyRequestValidationError) Reason() string { return e.reason }
+func (e DeleteFileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnpackEmptyRequestValidationError) Cause() error { return e.cause }
+func (e DeleteFileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnpackEmptyRequestValidationError) Key() bool { return e.key }
+func (e DeleteFileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnpackEmptyRequestValidationError) ErrorName() string {
-	return "UnpackEmptyRequestValidationError"
+func (e DeleteFileRequestValidationError) ErrorName() string {
+	return "DeleteFileRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnpackEmptyRequestValidationError) Error() string {
+func (e DeleteFileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4995,14 +5075,14 @@ func (e UnpackEmptyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnpackEmptyRequest.%s: %s%s",
+		"invalid %sDeleteFileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnpackEmptyRequestValidationError{}
+var _ error = DeleteFileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5010,26 +5090,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnpackEmptyRequestValidationError{}
+} = DeleteFileRequestValidationError{}
 
-var _UnpackEmptyRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _DeleteFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on UnpackEmptyResponse with the rules
+// Validate checks the field values on DeleteFileResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnpackEmptyResponse) Validate() error {
+func (m *DeleteFileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnpackEmptyResponse with the rules
+// ValidateAll checks the field values on DeleteFileResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UnpackEmptyResponseMultiError, or nil if none found.
-func (m *UnpackEmptyResponse) ValidateAll() error {
+// DeleteFileResponseMultiError, or nil if none found.
+func (m *DeleteFileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnpackEmptyResponse) validate(all bool) error {
+func (m *DeleteFileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5037,19 +5117,19 @@ func (m *UnpackEmptyResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return UnpackEmptyResponseMultiError(errors)
+		return DeleteFileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnpackEmptyResponseMultiError is an error wrapping multiple validation
-// errors returned by UnpackEmptyResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UnpackEmptyResponseMultiError []error
+// DeleteFileResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteFileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnpackEmptyResponseMultiError) Error() string {
+func (m DeleteFileResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5058,11 +5138,11 @@ func (m UnpackEmptyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnpackEmptyResponseMultiError) AllErrors() []error { return m }
+func (m DeleteFileResponseMultiError) AllErrors() []error { return m }
 
-// UnpackEmptyResponseValidationError is the validation error returned by
-// UnpackEmptyResponse.Validate if the designated constraints aren't met.
-type UnpackEmptyResponseValidationError struct {
+// DeleteFileResponseValidationError is the validation error returned by
+// DeleteFileResponse.Validate if the designated constraints aren't met.
+type DeleteFileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5070,24 +5150,24 @@ type UnpackEmptyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnpackEmptyResponseValidationError) Field() string { return e.field }
+func (e DeleteFileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnpackEmptyResponseValidationError) Reason() string { return e.reason }
+func (e DeleteFileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnpackEmptyResponseValidationError) Cause() error { return e.cause }
+func (e DeleteFileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnpackEmptyResponseValidationError) Key() bool { return e.key }
+func (e DeleteFileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnpackEmptyResponseValidationError) ErrorName() string {
-	return "UnpackEmptyResponseValidationError"
+func (e DeleteFileResponseValidationError) ErrorName() string {
+	return "DeleteFileResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnpackEmptyResponseValidationError) Error() string {
+func (e DeleteFileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5099,14 +5179,14 @@ func (e UnpackEmptyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnpackEmptyResponse.%s: %s%s",
+		"invalid %sDeleteFileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnpackEmptyResponseValidationError{}
+var _ error = DeleteFileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5114,32 +5194,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnpackEmptyResponseValidationError{}
+} = DeleteFileResponseValidationError{}
 
-// Validate checks the field values on GenerateMetricsViewFileRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateMetricsViewFileRequest) Validate() error {
+// Validate checks the field values on RenameFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenameFileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateMetricsViewFileRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GenerateMetricsViewFileRequestMultiError, or nil if none found.
-func (m *GenerateMetricsViewFileRequest) ValidateAll() error {
+// ValidateAll checks the field values on RenameFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameFileRequestMultiError, or nil if none found.
+func (m *RenameFileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateMetricsViewFileRequest) validate(all bool) error {
+func (m *RenameFileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GenerateMetricsViewFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := GenerateMetricsViewFileRequestValidationError{
+	if !_RenameFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := RenameFileRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -5149,32 +5229,42 @@ func (m *GenerateMetricsViewFileRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
-
-	// no validation rules for Table
-
-	// no validation rules for Path
+	if utf8.RuneCountInString(m.GetFromPath()) < 1 {
+		err := RenameFileRequestValidationError{
+			field:  "FromPath",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for UseAi
+	if utf8.RuneCountInString(m.GetToPath()) < 1 {
+		err := RenameFileRequestValidationError{
+			field:  "ToPath",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GenerateMetricsViewFileRequestMultiError(errors)
+		return RenameFileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateMetricsViewFileRequestMultiError is an error wrapping multiple
-// validation errors returned by GenerateMetricsViewFileRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GenerateMetricsViewFileRequestMultiError []error
+// RenameFileRequestMultiError is an error wrapping multiple validation errors
+// returned by RenameFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenameFileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateMetricsViewFileRequestMultiError) Error() string {
+func (m RenameFileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5183,12 +5273,11 @@ func (m GenerateMetricsViewFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateMetricsViewFileRequestMultiError) AllErrors() []error { return m }
+func (m RenameFileRequestMultiError) AllErrors() []error { return m }
 
-// GenerateMetricsViewFileRequestValidationError is the validation error
-// returned by GenerateMetricsViewFileRequest.Validate if the designated
-// constraints aren't met.
-type GenerateMetricsViewFileRequestValidationError struct {
+// RenameFileRequestValidationError is the validation error returned by
+// RenameFileRequest.Validate if the designated constraints aren't met.
+type RenameFileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5196,24 +5285,24 @@ type GenerateMetricsViewFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateMetricsViewFileRequestValidationError) Field() string { return e.field }
+func (e RenameFileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateMetricsViewFileRequestValidationError) Reason() string { return e.reason }
+func (e RenameFileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateMetricsViewFileRequestValidationError) Cause() error { return e.cause }
+func (e RenameFileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateMetricsViewFileRequestValidationError) Key() bool { return e.key }
+func (e RenameFileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateMetricsViewFileRequestValidationError) ErrorName() string {
-	return "GenerateMetricsViewFileRequestValidationError"
+func (e RenameFileRequestValidationError) ErrorName() string {
+	return "RenameFileRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateMetricsViewFileRequestValidationError) Error() string {
+func (e RenameFileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5225,14 +5314,14 @@ func (e GenerateMetricsViewFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateMetricsViewFileRequest.%s: %s%s",
+		"invalid %sRenameFileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateMetricsViewFileRequestValidationError{}
+var _ error = RenameFileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5240,48 +5329,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateMetricsViewFileRequestValidationError{}
+} = RenameFileRequestValidationError{}
 
-var _GenerateMetricsViewFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _RenameFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on GenerateMetricsViewFileResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RenameFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateMetricsViewFileResponse) Validate() error {
+func (m *RenameFileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateMetricsViewFileResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GenerateMetricsViewFileResponseMultiError, or nil if none found.
-func (m *GenerateMetricsViewFileResponse) ValidateAll() error {
+// ValidateAll checks the field values on RenameFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameFileResponseMultiError, or nil if none found.
+func (m *RenameFileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateMetricsViewFileResponse) validate(all bool) error {
+func (m *RenameFileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AiSucceeded
-
 	if len(errors) > 0 {
-		return GenerateMetricsViewFileResponseMultiError(errors)
+		return RenameFileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateMetricsViewFileResponseMultiError is an error wrapping multiple
-// validation errors returned by GenerateMetricsViewFileResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GenerateMetricsViewFileResponseMultiError []error
+// RenameFileResponseMultiError is an error wrapping multiple validation errors
+// returned by RenameFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RenameFileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateMetricsViewFileResponseMultiError) Error() string {
+func (m RenameFileResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5290,12 +5377,11 @@ func (m GenerateMetricsViewFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateMetricsViewFileResponseMultiError) AllErrors() []error { return m }
+func (m RenameFileResponseMultiError) AllErrors() []error { return m }
 
-// GenerateMetricsViewFileResponseValidationError is the validation error
-// returned by GenerateMetricsViewFileResponse.Validate if the designated
-// constraints aren't met.
-type GenerateMetricsViewFileResponseValidationError struct {
+// RenameFileResponseValidationError is the validation error returned by
+// RenameFileResponse.Validate if the designated constraints aren't met.
+type RenameFileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5303,24 +5389,24 @@ type GenerateMetricsViewFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateMetricsViewFileResponseValidationError) Field() string { return e.field }
+func (e RenameFileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateMetricsViewFileResponseValidationError) Reason() string { return e.reason }
+func (e RenameFileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateMetricsViewFileResponseValidationError) Cause() error { return e.cause }
+func (e RenameFileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateMetricsViewFileResponseValidationError) Key() bool { return e.key }
+func (e RenameFileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateMetricsViewFileResponseValidationError) ErrorName() string {
-	return "GenerateMetricsViewFileResponseValidationError"
+func (e RenameFileResponseValidationError) ErrorName() string {
+	return "RenameFileResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateMetricsViewFileResponseValidationError) Error() string {
+func (e RenameFileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5332,14 +5418,14 @@ func (e GenerateMetricsViewFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateMetricsViewFileResponse.%s: %s%s",
+		"invalid %sRenameFileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateMetricsViewFileResponseValidationError{}
+var _ error = RenameFileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5347,63 +5433,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateMetricsViewFileResponseValidationError{}
+} = RenameFileResponseValidationError{}
 
-// Validate checks the field values on GenerateResolverRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateResolverRequest) Validate() error {
+// Validate checks the field values on Example with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Example) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateResolverRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateResolverRequestMultiError, or nil if none found.
-func (m *GenerateResolverRequest) ValidateAll() error {
+// ValidateAll checks the field values on Example with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ExampleMultiError, or nil if none found.
+func (m *Example) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateResolverRequest) validate(all bool) error {
+func (m *Example) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GenerateResolverRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := GenerateResolverRequestValidationError{
-			field:  "InstanceId",
-			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Prompt
-
-	// no validation rules for Connector
+	// no validation rules for Name
 
-	// no validation rules for Table
+	// no validation rules for Title
 
-	// no validation rules for MetricsView
+	// no validation rules for Description
 
 	if len(errors) > 0 {
-		return GenerateResolverRequestMultiError(errors)
+		return ExampleMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateResolverRequestMultiError is an error wrapping multiple validation
-// errors returned by GenerateResolverRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateResolverRequestMultiError []error
+// ExampleMultiError is an error wrapping multiple validation errors returned
+// by Example.ValidateAll() if the designated constraints aren't met.
+type ExampleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateResolverRequestMultiError) Error() string {
+func (m ExampleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5412,11 +5483,11 @@ func (m GenerateResolverRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateResolverRequestMultiError) AllErrors() []error { return m }
+func (m ExampleMultiError) AllErrors() []error { return m }
 
-// GenerateResolverRequestValidationError is the validation error returned by
-// GenerateResolverRequest.Validate if the designated constraints aren't met.
-type GenerateResolverRequestValidationError struct {
+// ExampleValidationError is the validation error returned by Example.Validate
+// if the designated constraints aren't met.
+type ExampleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5424,24 +5495,22 @@ type GenerateResolverRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateResolverRequestValidationError) Field() string { return e.field }
+func (e ExampleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateResolverRequestValidationError) Reason() string { return e.reason }
+func (e ExampleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateResolverRequestValidationError) Cause() error { return e.cause }
+func (e ExampleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateResolverRequestValidationError) Key() bool { return e.key }
+func (e ExampleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateResolverRequestValidationError) ErrorName() string {
-	return "GenerateResolverRequestValidationError"
-}
+func (e ExampleValidationError) ErrorName() string { return "ExampleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateResolverRequestValidationError) Error() string {
+func (e ExampleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5453,14 +5522,14 @@ func (e GenerateResolverRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateResolverRequest.%s: %s%s",
+		"invalid %sExample.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateResolverRequestValidationError{}
+var _ error = ExampleValidationError{}
 
 var _ interface {
 	Field() string
@@ -5468,77 +5537,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateResolverRequestValidationError{}
-
-var _GenerateResolverRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+} = ExampleValidationError{}
 
-// Validate checks the field values on GenerateResolverResponse with the rules
+// Validate checks the field values on ListExamplesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateResolverResponse) Validate() error {
+func (m *ListExamplesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateResolverResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListExamplesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateResolverResponseMultiError, or nil if none found.
-func (m *GenerateResolverResponse) ValidateAll() error {
+// ListExamplesRequestMultiError, or nil if none found.
+func (m *ListExamplesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateResolverResponse) validate(all bool) error {
+func (m *ListExamplesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Resolver
-
-	if all {
-		switch v := interface{}(m.GetResolverProperties()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GenerateResolverResponseValidationError{
-					field:  "ResolverProperties",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GenerateResolverResponseValidationError{
-					field:  "ResolverProperties",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResolverProperties()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GenerateResolverResponseValidationError{
-				field:  "ResolverProperties",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GenerateResolverResponseMultiError(errors)
+		return ListExamplesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateResolverResponseMultiError is an error wrapping multiple validation
-// errors returned by GenerateResolverResponse.ValidateAll() if the designated
+// ListExamplesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListExamplesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GenerateResolverResponseMultiError []error
+type ListExamplesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateResolverResponseMultiError) Error() string {
+func (m ListExamplesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5547,11 +5583,11 @@ func (m GenerateResolverResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateResolverResponseMultiError) AllErrors() []error { return m }
+func (m ListExamplesRequestMultiError) AllErrors() []error { return m }
 
-// GenerateResolverResponseValidationError is the validation error returned by
-// GenerateResolverResponse.Validate if the designated constraints aren't met.
-type GenerateResolverResponseValidationError struct {
+// ListExamplesRequestValidationError is the validation error returned by
+// ListExamplesRequest.Validate if the designated constraints aren't met.
+type ListExamplesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5559,24 +5595,24 @@ type GenerateResolverResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateResolverResponseValidationError) Field() string { return e.field }
+func (e ListExamplesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateResolverResponseValidationError) Reason() string { return e.reason }
+func (e ListExamplesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateResolverResponseValidationError) Cause() error { return e.cause }
+func (e ListExamplesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateResolverResponseValidationError) Key() bool { return e.key }
+func (e ListExamplesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateResolverResponseValidationError) ErrorName() string {
-	return "GenerateResolverResponseValidationError"
+func (e ListExamplesRequestValidationError) ErrorName() string {
+	return "ListExamplesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateResolverResponseValidationError) Error() string {
+func (e ListExamplesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5588,14 +5624,14 @@ func (e GenerateResolverResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateResolverResponse.%s: %s%s",
+		"invalid %sListExamplesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateResolverResponseValidationError{}
+var _ error = ListExamplesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5603,88 +5639,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateResolverResponseValidationError{}
+} = ListExamplesRequestValidationError{}
 
-// Validate checks the field values on GenerateRendererRequest with the rules
+// Validate checks the field values on ListExamplesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateRendererRequest) Validate() error {
+func (m *ListExamplesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateRendererRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListExamplesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateRendererRequestMultiError, or nil if none found.
-func (m *GenerateRendererRequest) ValidateAll() error {
+// ListExamplesResponseMultiError, or nil if none found.
+func (m *ListExamplesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateRendererRequest) validate(all bool) error {
+func (m *ListExamplesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GenerateRendererRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := GenerateRendererRequestValidationError{
-			field:  "InstanceId",
-			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Prompt
-
-	// no validation rules for Resolver
+	for idx, item := range m.GetExamples() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetResolverProperties()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GenerateRendererRequestValidationError{
-					field:  "ResolverProperties",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListExamplesResponseValidationError{
+						field:  fmt.Sprintf("Examples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListExamplesResponseValidationError{
+						field:  fmt.Sprintf("Examples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GenerateRendererRequestValidationError{
-					field:  "ResolverProperties",
+				return ListExamplesResponseValidationError{
+					field:  fmt.Sprintf("Examples[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResolverProperties()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GenerateRendererRequestValidationError{
-				field:  "ResolverProperties",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GenerateRendererRequestMultiError(errors)
+		return ListExamplesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateRendererRequestMultiError is an error wrapping multiple validation
-// errors returned by GenerateRendererRequest.ValidateAll() if the designated
+// ListExamplesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListExamplesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GenerateRendererRequestMultiError []error
+type ListExamplesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateRendererRequestMultiError) Error() string {
+func (m ListExamplesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5693,11 +5719,11 @@ func (m GenerateRendererRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateRendererRequestMultiError) AllErrors() []error { return m }
+func (m ListExamplesResponseMultiError) AllErrors() []error { return m }
 
-// GenerateRendererRequestValidationError is the validation error returned by
-// GenerateRendererRequest.Validate if the designated constraints aren't met.
-type GenerateRendererRequestValidationError struct {
+// ListExamplesResponseValidationError is the validation error returned by
+// ListExamplesResponse.Validate if the designated constraints aren't met.
+type ListExamplesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5705,24 +5731,24 @@ type GenerateRendererRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateRendererRequestValidationError) Field() string { return e.field }
+func (e ListExamplesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateRendererRequestValidationError) Reason() string { return e.reason }
+func (e ListExamplesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateRendererRequestValidationError) Cause() error { return e.cause }
+func (e ListExamplesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateRendererRequestValidationError) Key() bool { return e.key }
+func (e ListExamplesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateRendererRequestValidationError) ErrorName() string {
-	return "GenerateRendererRequestValidationError"
+func (e ListExamplesResponseValidationError) ErrorName() string {
+	return "ListExamplesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateRendererRequestValidationError) Error() string {
+func (e ListExamplesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5734,14 +5760,14 @@ func (e GenerateRendererRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateRendererRequest.%s: %s%s",
+		"invalid %sListExamplesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateRendererRequestValidationError{}
+var _ error = ListExamplesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5749,77 +5775,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateRendererRequestValidationError{}
-
-var _GenerateRendererRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+} = ListExamplesResponseValidationError{}
 
-// Validate checks the field values on GenerateRendererResponse with the rules
+// Validate checks the field values on UnpackExampleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateRendererResponse) Validate() error {
+func (m *UnpackExampleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateRendererResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UnpackExampleRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateRendererResponseMultiError, or nil if none found.
-func (m *GenerateRendererResponse) ValidateAll() error {
+// UnpackExampleRequestMultiError, or nil if none found.
+func (m *UnpackExampleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateRendererResponse) validate(all bool) error {
+func (m *UnpackExampleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Renderer
+	if !_UnpackExampleRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := UnpackExampleRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetRendererProperties()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GenerateRendererResponseValidationError{
-					field:  "RendererProperties",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GenerateRendererResponseValidationError{
-					field:  "RendererProperties",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UnpackExampleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetRendererProperties()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GenerateRendererResponseValidationError{
-				field:  "RendererProperties",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Force
+
 	if len(errors) > 0 {
-		return GenerateRendererResponseMultiError(errors)
+		return UnpackExampleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateRendererResponseMultiError is an error wrapping multiple validation
-// errors returned by GenerateRendererResponse.ValidateAll() if the designated
+// UnpackExampleRequestMultiError is an error wrapping multiple validation
+// errors returned by UnpackExampleRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GenerateRendererResponseMultiError []error
+type UnpackExampleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateRendererResponseMultiError) Error() string {
+func (m UnpackExampleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5828,11 +5845,11 @@ func (m GenerateRendererResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateRendererResponseMultiError) AllErrors() []error { return m }
+func (m UnpackExampleRequestMultiError) AllErrors() []error { return m }
 
-// GenerateRendererResponseValidationError is the validation error returned by
-// GenerateRendererResponse.Validate if the designated constraints aren't met.
-type GenerateRendererResponseValidationError struct {
+// UnpackExampleRequestValidationError is the validation error returned by
+// UnpackExampleRequest.Validate if the designated constraints aren't met.
+type UnpackExampleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5840,24 +5857,24 @@ type GenerateRendererResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateRendererResponseValidationError) Field() string { return e.field }
+func (e UnpackExampleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateRendererResponseValidationError) Reason() string { return e.reason }
+func (e UnpackExampleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateRendererResponseValidationError) Cause() error { return e.cause }
+func (e UnpackExampleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateRendererResponseValidationError) Key() bool { return e.key }
+func (e UnpackExampleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateRendererResponseValidationError) ErrorName() string {
-	return "GenerateRendererResponseValidationError"
+func (e UnpackExampleRequestValidationError) ErrorName() string {
+	return "UnpackExampleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateRendererResponseValidationError) Error() string {
+func (e UnpackExampleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5869,14 +5886,14 @@ func (e GenerateRendererResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateRendererResponse.%s: %s%s",
+		"invalid %sUnpackExampleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateRendererResponseValidationError{}
+var _ error = UnpackExampleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5884,77 +5901,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateRendererResponseValidationError{}
+} = UnpackExampleRequestValidationError{}
 
-// Validate checks the field values on Log with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Log) Validate() error {
+var _UnpackExampleRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on UnpackExampleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpackExampleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Log with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in LogMultiError, or nil if none found.
-func (m *Log) ValidateAll() error {
+// ValidateAll checks the field values on UnpackExampleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpackExampleResponseMultiError, or nil if none found.
+func (m *UnpackExampleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Log) validate(all bool) error {
+func (m *UnpackExampleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Level
-
-	if all {
-		switch v := interface{}(m.GetTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LogValidationError{
-					field:  "Time",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, LogValidationError{
-					field:  "Time",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return LogValidationError{
-				field:  "Time",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Message
-
-	// no validation rules for JsonPayload
-
 	if len(errors) > 0 {
-		return LogMultiError(errors)
+		return UnpackExampleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// LogMultiError is an error wrapping multiple validation errors returned by
-// Log.ValidateAll() if the designated constraints aren't met.
-type LogMultiError []error
+// UnpackExampleResponseMultiError is an error wrapping multiple validation
+// errors returned by UnpackExampleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnpackExampleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LogMultiError) Error() string {
+func (m UnpackExampleResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5963,11 +5949,11 @@ func (m LogMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LogMultiError) AllErrors() []error { return m }
+func (m UnpackExampleResponseMultiError) AllErrors() []error { return m }
 
-// LogValidationError is the validation error returned by Log.Validate if the
-// designated constraints aren't met.
-type LogValidationError struct {
+// UnpackExampleResponseValidationError is the validation error returned by
+// UnpackExampleResponse.Validate if the designated constraints aren't met.
+type UnpackExampleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5975,22 +5961,24 @@ type LogValidationError struct {
 }
 
 // Field function returns field value.
-func (e LogValidationError) Field() string { return e.field }
+func (e UnpackExampleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LogValidationError) Reason() string { return e.reason }
+func (e UnpackExampleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LogValidationError) Cause() error { return e.cause }
+func (e UnpackExampleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LogValidationError) Key() bool { return e.key }
+func (e UnpackExampleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LogValidationError) ErrorName() string { return "LogValidationError" }
+func (e UnpackExampleResponseValidationError) ErrorName() string {
+	return "UnpackExampleResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LogValidationError) Error() string {
+func (e UnpackExampleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6002,14 +5990,14 @@ func (e LogValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLog.%s: %s%s",
+		"invalid %sUnpackExampleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LogValidationError{}
+var _ error = UnpackExampleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6017,38 +6005,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LogValidationError{}
+} = UnpackExampleResponseValidationError{}
 
-// Validate checks the field values on GetLogsRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetLogsRequest) Validate() error {
+// Validate checks the field values on UnpackEmptyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpackEmptyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLogsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetLogsRequestMultiError,
-// or nil if none found.
-func (m *GetLogsRequest) ValidateAll() error {
+// ValidateAll checks the field values on UnpackEmptyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpackEmptyRequestMultiError, or nil if none found.
+func (m *UnpackEmptyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLogsRequest) validate(all bool) error {
+func (m *UnpackEmptyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Ascending
-
-	if m.GetLimit() < -1 {
-		err := GetLogsRequestValidationError{
-			field:  "Limit",
-			reason: "value must be greater than or equal to -1",
+	if !_UnpackEmptyRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := UnpackEmptyRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
 		if !all {
 			return err
@@ -6056,22 +6040,24 @@ func (m *GetLogsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Level
+	// no validation rules for Title
+
+	// no validation rules for Force
 
 	if len(errors) > 0 {
-		return GetLogsRequestMultiError(errors)
+		return UnpackEmptyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetLogsRequestMultiError is an error wrapping multiple validation errors
-// returned by GetLogsRequest.ValidateAll() if the designated constraints
+// UnpackEmptyRequestMultiError is an error wrapping multiple validation errors
+// returned by UnpackEmptyRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetLogsRequestMultiError []error
+type UnpackEmptyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetLogsRequestMultiError) Error() string {
+func (m UnpackEmptyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6080,11 +6066,11 @@ func (m GetLogsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetLogsRequestMultiError) AllErrors() []error { return m }
+func (m UnpackEmptyRequestMultiError) AllErrors() []error { return m }
 
-// GetLogsRequestValidationError is the validation error returned by
-// GetLogsRequest.Validate if the designated constraints aren't met.
-type GetLogsRequestValidationError struct {
+// UnpackEmptyRequestValidationError is the validation error returned by
+// UnpackEmptyRequest.Validate if the designated constraints aren't met.
+type UnpackEmptyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6092,22 +6078,24 @@ type GetLogsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetLogsRequestValidationError) Field() string { return e.field }
+func (e UnpackEmptyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetLogsRequestValidationError) Reason() string { return e.reason }
+func (e UnpackEmptyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetLogsRequestValidationError) Cause() error { return e.cause }
+func (e UnpackEmptyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetLogsRequestValidationError) Key() bool { return e.key }
+func (e UnpackEmptyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetLogsRequestValidationError) ErrorName() string { return "GetLogsRequestValidationError" }
+func (e UnpackEmptyRequestValidationError) ErrorName() string {
+	return "UnpackEmptyRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetLogsRequestValidationError) Error() string {
+func (e UnpackEmptyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6119,14 +6107,14 @@ func (e GetLogsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetLogsRequest.%s: %s%s",
+		"invalid %sUnpackEmptyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetLogsRequestValidationError{}
+var _ error = UnpackEmptyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6134,78 +6122,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetLogsRequestValidationError{}
+} = UnpackEmptyRequestValidationError{}
 
-// Validate checks the field values on GetLogsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetLogsResponse) Validate() error {
+var _UnpackEmptyRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on UnpackEmptyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpackEmptyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLogsResponse with the rules
+// ValidateAll checks the field values on UnpackEmptyResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetLogsResponseMultiError, or nil if none found.
-func (m *GetLogsResponse) ValidateAll() error {
+// UnpackEmptyResponseMultiError, or nil if none found.
+func (m *UnpackEmptyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLogsResponse) validate(all bool) error {
+func (m *UnpackEmptyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetLogs() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetLogsResponseValidationError{
-						field:  fmt.Sprintf("Logs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetLogsResponseValidationError{
-						field:  fmt.Sprintf("Logs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetLogsResponseValidationError{
-					field:  fmt.Sprintf("Logs[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetLogsResponseMultiError(errors)
+		return UnpackEmptyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetLogsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetLogsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetLogsResponseMultiError []error
+// UnpackEmptyResponseMultiError is an error wrapping multiple validation
+// errors returned by UnpackEmptyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnpackEmptyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetLogsResponseMultiError) Error() string {
+func (m UnpackEmptyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6214,11 +6170,11 @@ func (m GetLogsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetLogsResponseMultiError) AllErrors() []error { return m }
+func (m UnpackEmptyResponseMultiError) AllErrors() []error { return m }
 
-// GetLogsResponseValidationError is the validation error returned by
-// GetLogsResponse.Validate if the designated constraints aren't met.
-type GetLogsResponseValidationError struct {
+// UnpackEmptyResponseValidationError is the validation error returned by
+// UnpackEmptyResponse.Validate if the designated constraints aren't met.
+type UnpackEmptyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6226,22 +6182,24 @@ type GetLogsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetLogsResponseValidationError) Field() string { return e.field }
+func (e UnpackEmptyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetLogsResponseValidationError) Reason() string { return e.reason }
+func (e UnpackEmptyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetLogsResponseValidationError) Cause() error { return e.cause }
+func (e UnpackEmptyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetLogsResponseValidationError) Key() bool { return e.key }
+func (e UnpackEmptyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetLogsResponseValidationError) ErrorName() string { return "GetLogsResponseValidationError" }
+func (e UnpackEmptyResponseValidationError) ErrorName() string {
+	return "UnpackEmptyResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetLogsResponseValidationError) Error() string {
+func (e UnpackEmptyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6253,14 +6211,14 @@ func (e GetLogsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetLogsResponse.%s: %s%s",
+		"invalid %sUnpackEmptyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetLogsResponseValidationError{}
+var _ error = UnpackEmptyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6268,38 +6226,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetLogsResponseValidationError{}
+} = UnpackEmptyResponseValidationError{}
 
-// Validate checks the field values on WatchLogsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WatchLogsRequest) Validate() error {
+// Validate checks the field values on GenerateMetricsViewFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateMetricsViewFileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchLogsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WatchLogsRequestMultiError, or nil if none found.
-func (m *WatchLogsRequest) ValidateAll() error {
+// ValidateAll checks the field values on GenerateMetricsViewFileRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateMetricsViewFileRequestMultiError, or nil if none found.
+func (m *GenerateMetricsViewFileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchLogsRequest) validate(all bool) error {
+func (m *GenerateMetricsViewFileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Replay
-
-	if m.GetReplayLimit() < -1 {
-		err := WatchLogsRequestValidationError{
-			field:  "ReplayLimit",
-			reason: "value must be greater than or equal to -1",
+	if !_GenerateMetricsViewFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := GenerateMetricsViewFileRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
 		if !all {
 			return err
@@ -6307,22 +6261,32 @@ func (m *WatchLogsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Level
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	// no validation rules for Table
+
+	// no validation rules for Path
+
+	// no validation rules for UseAi
 
 	if len(errors) > 0 {
-		return WatchLogsRequestMultiError(errors)
+		return GenerateMetricsViewFileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchLogsRequestMultiError is an error wrapping multiple validation errors
-// returned by WatchLogsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type WatchLogsRequestMultiError []error
+// GenerateMetricsViewFileRequestMultiError is an error wrapping multiple
+// validation errors returned by GenerateMetricsViewFileRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GenerateMetricsViewFileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchLogsRequestMultiError) Error() string {
+func (m GenerateMetricsViewFileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6331,11 +6295,12 @@ func (m WatchLogsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchLogsRequestMultiError) AllErrors() []error { return m }
+func (m GenerateMetricsViewFileRequestMultiError) AllErrors() []error { return m }
 
-// WatchLogsRequestValidationError is the validation error returned by
-// WatchLogsRequest.Validate if the designated constraints aren't met.
-type WatchLogsRequestValidationError struct {
+// GenerateMetricsViewFileRequestValidationError is the validation error
+// returned by GenerateMetricsViewFileRequest.Validate if the designated
+// constraints aren't met.
+type GenerateMetricsViewFileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6343,22 +6308,24 @@ type WatchLogsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchLogsRequestValidationError) Field() string { return e.field }
+func (e GenerateMetricsViewFileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchLogsRequestValidationError) Reason() string { return e.reason }
+func (e GenerateMetricsViewFileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchLogsRequestValidationError) Cause() error { return e.cause }
+func (e GenerateMetricsViewFileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchLogsRequestValidationError) Key() bool { return e.key }
+func (e GenerateMetricsViewFileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchLogsRequestValidationError) ErrorName() string { return "WatchLogsRequestValidationError" }
+func (e GenerateMetricsViewFileRequestValidationError) ErrorName() string {
+	return "GenerateMetricsViewFileRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WatchLogsRequestValidationError) Error() string {
+func (e GenerateMetricsViewFileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6370,14 +6337,14 @@ func (e WatchLogsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchLogsRequest.%s: %s%s",
+		"invalid %sGenerateMetricsViewFileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchLogsRequestValidationError{}
+var _ error = GenerateMetricsViewFileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6385,73 +6352,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchLogsRequestValidationError{}
+} = GenerateMetricsViewFileRequestValidationError{}
 
-// Validate checks the field values on WatchLogsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WatchLogsResponse) Validate() error {
+var _GenerateMetricsViewFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on GenerateMetricsViewFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateMetricsViewFileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchLogsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WatchLogsResponseMultiError, or nil if none found.
-func (m *WatchLogsResponse) ValidateAll() error {
+// ValidateAll checks the field values on GenerateMetricsViewFileResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateMetricsViewFileResponseMultiError, or nil if none found.
+func (m *GenerateMetricsViewFileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchLogsResponse) validate(all bool) error {
+func (m *GenerateMetricsViewFileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetLog()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchLogsResponseValidationError{
-					field:  "Log",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchLogsResponseValidationError{
-					field:  "Log",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetLog()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchLogsResponseValidationError{
-				field:  "Log",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for AiSucceeded
 
 	if len(errors) > 0 {
-		return WatchLogsResponseMultiError(errors)
+		return GenerateMetricsViewFileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchLogsResponseMultiError is an error wrapping multiple validation errors
-// returned by WatchLogsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type WatchLogsResponseMultiError []error
+// GenerateMetricsViewFileResponseMultiError is an error wrapping multiple
+// validation errors returned by GenerateMetricsViewFileResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GenerateMetricsViewFileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchLogsResponseMultiError) Error() string {
+func (m GenerateMetricsViewFileResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6460,11 +6402,12 @@ func (m WatchLogsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchLogsResponseMultiError) AllErrors() []error { return m }
+func (m GenerateMetricsViewFileResponseMultiError) AllErrors() []error { return m }
 
-// WatchLogsResponseValidationError is the validation error returned by
-// WatchLogsResponse.Validate if the designated constraints aren't met.
-type WatchLogsResponseValidationError struct {
+// GenerateMetricsViewFileResponseValidationError is the validation error
+// returned by GenerateMetricsViewFileResponse.Validate if the designated
+// constraints aren't met.
+type GenerateMetricsViewFileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6472,24 +6415,24 @@ type WatchLogsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchLogsResponseValidationError) Field() string { return e.field }
+func (e GenerateMetricsViewFileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchLogsResponseValidationError) Reason() string { return e.reason }
+func (e GenerateMetricsViewFileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchLogsResponseValidationError) Cause() error { return e.cause }
+func (e GenerateMetricsViewFileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchLogsResponseValidationError) Key() bool { return e.key }
+func (e GenerateMetricsViewFileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchLogsResponseValidationError) ErrorName() string {
-	return "WatchLogsResponseValidationError"
+func (e GenerateMetricsViewFileResponseValidationError) ErrorName() string {
+	return "GenerateMetricsViewFileResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchLogsResponseValidationError) Error() string {
+func (e GenerateMetricsViewFileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6501,14 +6444,14 @@ func (e WatchLogsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchLogsResponse.%s: %s%s",
+		"invalid %sGenerateMetricsViewFileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchLogsResponseValidationError{}
+var _ error = GenerateMetricsViewFileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6516,50 +6459,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchLogsResponseValidationError{}
+} = GenerateMetricsViewFileResponseValidationError{}
 
-// Validate checks the field values on ListResourcesRequest with the rules
+// Validate checks the field values on GenerateResolverRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListResourcesRequest) Validate() error {
+func (m *GenerateResolverRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListResourcesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GenerateResolverRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListResourcesRequestMultiError, or nil if none found.
-func (m *ListResourcesRequest) ValidateAll() error {
+// GenerateResolverRequestMultiError, or nil if none found.
+func (m *GenerateResolverRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListResourcesRequest) validate(all bool) error {
+func (m *GenerateResolverRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	if !_GenerateResolverRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := GenerateResolverRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Kind
+	// no validation rules for Prompt
 
-	// no validation rules for Path
+	// no validation rules for Connector
+
+	// no validation rules for Table
+
+	// no validation rules for MetricsView
 
 	if len(errors) > 0 {
-		return ListResourcesRequestMultiError(errors)
+		return GenerateResolverRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListResourcesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListResourcesRequest.ValidateAll() if the designated
+// GenerateResolverRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateResolverRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListResourcesRequestMultiError []error
+type GenerateResolverRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListResourcesRequestMultiError) Error() string {
+func (m GenerateResolverRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6568,11 +6524,11 @@ func (m ListResourcesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
+func (m GenerateResolverRequestMultiError) AllErrors() []error { return m }
 
-// ListResourcesRequestValidationError is the validation error returned by
-// ListResourcesRequest.Validate if the designated constraints aren't met.
-type ListResourcesRequestValidationError struct {
+// GenerateResolverRequestValidationError is the validation error returned by
+// GenerateResolverRequest.Validate if the designated constraints aren't met.
+type GenerateResolverRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6580,24 +6536,24 @@ type ListResourcesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListResourcesRequestValidationError) Field() string { return e.field }
+func (e GenerateResolverRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
+func (e GenerateResolverRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
+func (e GenerateResolverRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListResourcesRequestValidationError) Key() bool { return e.key }
+func (e GenerateResolverRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListResourcesRequestValidationError) ErrorName() string {
-	return "ListResourcesRequestValidationError"
+func (e GenerateResolverRequestValidationError) ErrorName() string {
+	return "GenerateResolverRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListResourcesRequestValidationError) Error() string {
+func (e GenerateResolverRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6609,14 +6565,14 @@ func (e ListResourcesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListResourcesRequest.%s: %s%s",
+		"invalid %sGenerateResolverRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListResourcesRequestValidationError{}
+var _ error = GenerateResolverRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6624,78 +6580,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListResourcesRequestValidationError{}
+} = GenerateResolverRequestValidationError{}
 
-// Validate checks the field values on ListResourcesResponse with the rules
+var _GenerateResolverRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on GenerateResolverResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListResourcesResponse) Validate() error {
+func (m *GenerateResolverResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListResourcesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GenerateResolverResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListResourcesResponseMultiError, or nil if none found.
-func (m *ListResourcesResponse) ValidateAll() error {
+// GenerateResolverResponseMultiError, or nil if none found.
+func (m *GenerateResolverResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListResourcesResponse) validate(all bool) error {
+func (m *GenerateResolverResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetResources() {
-		_, _ = idx, item
+	// no validation rules for Resolver
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListResourcesResponseValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListResourcesResponseValidationError{
-						field:  fmt.Sprintf("Resources[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetResolverProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateResolverResponseValidationError{
+					field:  "ResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListResourcesResponseValidationError{
-					field:  fmt.Sprintf("Resources[%v]", idx),
+				errors = append(errors, GenerateResolverResponseValidationError{
+					field:  "ResolverProperties",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResolverProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateResolverResponseValidationError{
+				field:  "ResolverProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListResourcesResponseMultiError(errors)
+		return GenerateResolverResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListResourcesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListResourcesResponse.ValidateAll() if the designated
+// GenerateResolverResponseMultiError is an error wrapping multiple validation
+// errors returned by GenerateResolverResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListResourcesResponseMultiError []error
+type GenerateResolverResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListResourcesResponseMultiError) Error() string {
+func (m GenerateResolverResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6704,11 +6659,11 @@ func (m ListResourcesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListResourcesResponseMultiError) AllErrors() []error { return m }
+func (m GenerateResolverResponseMultiError) AllErrors() []error { return m }
 
-// ListResourcesResponseValidationError is the validation error returned by
-// ListResourcesResponse.Validate if the designated constraints aren't met.
-type ListResourcesResponseValidationError struct {
+// GenerateResolverResponseValidationError is the validation error returned by
+// GenerateResolverResponse.Validate if the designated constraints aren't met.
+type GenerateResolverResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6716,24 +6671,24 @@ type ListResourcesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListResourcesResponseValidationError) Field() string { return e.field }
+func (e GenerateResolverResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListResourcesResponseValidationError) Reason() string { return e.reason }
+func (e GenerateResolverResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListResourcesResponseValidationError) Cause() error { return e.cause }
+func (e GenerateResolverResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListResourcesResponseValidationError) Key() bool { return e.key }
+func (e GenerateResolverResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListResourcesResponseValidationError) ErrorName() string {
-	return "ListResourcesResponseValidationError"
+func (e GenerateResolverResponseValidationError) ErrorName() string {
+	return "GenerateResolverResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListResourcesResponseValidationError) Error() string {
+func (e GenerateResolverResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6745,14 +6700,14 @@ func (e ListResourcesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListResourcesResponse.%s: %s%s",
+		"invalid %sGenerateResolverResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListResourcesResponseValidationError{}
+var _ error = GenerateResolverResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6760,52 +6715,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListResourcesResponseValidationError{}
+} = GenerateResolverResponseValidationError{}
 
-// Validate checks the field values on WatchResourcesRequest with the rules
+// Validate checks the field values on GenerateRendererRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchResourcesRequest) Validate() error {
+func (m *GenerateRendererRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchResourcesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GenerateRendererRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchResourcesRequestMultiError, or nil if none found.
-func (m *WatchResourcesRequest) ValidateAll() error {
+// GenerateRendererRequestMultiError, or nil if none found.
+func (m *GenerateRendererRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchResourcesRequest) validate(all bool) error {
+func (m *GenerateRendererRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	if !_GenerateRendererRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := GenerateRendererRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Kind
+	// no validation rules for Prompt
 
-	// no validation rules for Replay
+	// no validation rules for Resolver
 
-	// no validation rules for Level
+	if all {
+		switch v := interface{}(m.GetResolverProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateRendererRequestValidationError{
+					field:  "ResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateRendererRequestValidationError{
+					field:  "ResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResolverProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateRendererRequestValidationError{
+				field:  "ResolverProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return WatchResourcesRequestMultiError(errors)
+		return GenerateRendererRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchResourcesRequestMultiError is an error wrapping multiple validation
-// errors returned by WatchResourcesRequest.ValidateAll() if the designated
+// GenerateRendererRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateRendererRequest.ValidateAll() if the designated
 // constraints aren't met.
-type WatchResourcesRequestMultiError []error
+type GenerateRendererRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchResourcesRequestMultiError) Error() string {
+func (m GenerateRendererRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6814,11 +6805,11 @@ func (m WatchResourcesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchResourcesRequestMultiError) AllErrors() []error { return m }
+func (m GenerateRendererRequestMultiError) AllErrors() []error { return m }
 
-// WatchResourcesRequestValidationError is the validation error returned by
-// WatchResourcesRequest.Validate if the designated constraints aren't met.
-type WatchResourcesRequestValidationError struct {
+// GenerateRendererRequestValidationError is the validation error returned by
+// GenerateRendererRequest.Validate if the designated constraints aren't met.
+type GenerateRendererRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6826,24 +6817,24 @@ type WatchResourcesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchResourcesRequestValidationError) Field() string { return e.field }
+func (e GenerateRendererRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchResourcesRequestValidationError) Reason() string { return e.reason }
+func (e GenerateRendererRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchResourcesRequestValidationError) Cause() error { return e.cause }
+func (e GenerateRendererRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchResourcesRequestValidationError) Key() bool { return e.key }
+func (e GenerateRendererRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchResourcesRequestValidationError) ErrorName() string {
-	return "WatchResourcesRequestValidationError"
+func (e GenerateRendererRequestValidationError) ErrorName() string {
+	return "GenerateRendererRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchResourcesRequestValidationError) Error() string {
+func (e GenerateRendererRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6855,14 +6846,14 @@ func (e WatchResourcesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchResourcesRequest.%s: %s%s",
+		"invalid %sGenerateRendererRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchResourcesRequestValidationError{}
+var _ error = GenerateRendererRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6870,84 +6861,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchResourcesRequestValidationError{}
+} = GenerateRendererRequestValidationError{}
 
-// Validate checks the field values on WatchResourcesResponse with the rules
+var _GenerateRendererRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on GenerateRendererResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WatchResourcesResponse) Validate() error {
+func (m *GenerateRendererResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchResourcesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GenerateRendererResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WatchResourcesResponseMultiError, or nil if none found.
-func (m *WatchResourcesResponse) ValidateAll() error {
+// GenerateRendererResponseMultiError, or nil if none found.
+func (m *GenerateRendererResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchResourcesResponse) validate(all bool) error {
+func (m *GenerateRendererResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Event
-
-	if all {
-		switch v := interface{}(m.GetName()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchResourcesResponseValidationError{
-					field:  "Name",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchResourcesResponseValidationError{
-					field:  "Name",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchResourcesResponseValidationError{
-				field:  "Name",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Renderer
 
 	if all {
-		switch v := interface{}(m.GetResource()).(type) {
+		switch v := interface{}(m.GetRendererProperties()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchResourcesResponseValidationError{
-					field:  "Resource",
+				errors = append(errors, GenerateRendererResponseValidationError{
+					field:  "RendererProperties",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchResourcesResponseValidationError{
-					field:  "Resource",
+				errors = append(errors, GenerateRendererResponseValidationError{
+					field:  "RendererProperties",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRendererProperties()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return WatchResourcesResponseValidationError{
-				field:  "Resource",
+			return GenerateRendererResponseValidationError{
+				field:  "RendererProperties",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -6955,19 +6919,19 @@ func (m *WatchResourcesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return WatchResourcesResponseMultiError(errors)
+		return GenerateRendererResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchResourcesResponseMultiError is an error wrapping multiple validation
-// errors returned by WatchResourcesResponse.ValidateAll() if the designated
+// GenerateRendererResponseMultiError is an error wrapping multiple validation
+// errors returned by GenerateRendererResponse.ValidateAll() if the designated
 // constraints aren't met.
-type WatchResourcesResponseMultiError []error
+type GenerateRendererResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchResourcesResponseMultiError) Error() string {
+func (m GenerateRendererResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6976,11 +6940,11 @@ func (m WatchResourcesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchResourcesResponseMultiError) AllErrors() []error { return m }
+func (m GenerateRendererResponseMultiError) AllErrors() []error { return m }
 
-// WatchResourcesResponseValidationError is the validation error returned by
-// WatchResourcesResponse.Validate if the designated constraints aren't met.
-type WatchResourcesResponseValidationError struct {
+// GenerateRendererResponseValidationError is the validation error returned by
+// GenerateRendererResponse.Validate if the designated constraints aren't met.
+type GenerateRendererResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6988,24 +6952,24 @@ type WatchResourcesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchResourcesResponseValidationError) Field() string { return e.field }
+func (e GenerateRendererResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchResourcesResponseValidationError) Reason() string { return e.reason }
+func (e GenerateRendererResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchResourcesResponseValidationError) Cause() error { return e.cause }
+func (e GenerateRendererResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchResourcesResponseValidationError) Key() bool { return e.key }
+func (e GenerateRendererResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchResourcesResponseValidationError) ErrorName() string {
-	return "WatchResourcesResponseValidationError"
+func (e GenerateRendererResponseValidationError) ErrorName() string {
+	return "GenerateRendererResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WatchResourcesResponseValidationError) Error() string {
+func (e GenerateRendererResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7017,14 +6981,14 @@ func (e WatchResourcesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchResourcesResponse.%s: %s%s",
+		"invalid %sGenerateRendererResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchResourcesResponseValidationError{}
+var _ error = GenerateRendererResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7032,63 +6996,2662 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchResourcesResponseValidationError{}
+} = GenerateRendererResponseValidationError{}
 
-// Validate checks the field values on GetResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetResourceRequest) Validate() error {
+// Validate checks the field values on Log with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Log) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResourceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetResourceRequestMultiError, or nil if none found.
-func (m *GetResourceRequest) ValidateAll() error {
+// ValidateAll checks the field values on Log with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LogMultiError, or nil if none found.
+func (m *Log) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResourceRequest) validate(all bool) error {
+func (m *Log) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	// no validation rules for Level
 
 	if all {
-		switch v := interface{}(m.GetName()).(type) {
+		switch v := interface{}(m.GetTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetResourceRequestValidationError{
-					field:  "Name",
+				errors = append(errors, LogValidationError{
+					field:  "Time",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetResourceRequestValidationError{
-					field:  "Name",
+				errors = append(errors, LogValidationError{
+					field:  "Time",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetResourceRequestValidationError{
-				field:  "Name",
+			return LogValidationError{
+				field:  "Time",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Message
+
+	// no validation rules for JsonPayload
+
 	if len(errors) > 0 {
-		return GetResourceRequestMultiError(errors)
+		return LogMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogMultiError is an error wrapping multiple validation errors returned by
+// Log.ValidateAll() if the designated constraints aren't met.
+type LogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogMultiError) AllErrors() []error { return m }
+
+// LogValidationError is the validation error returned by Log.Validate if the
+// designated constraints aren't met.
+type LogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogValidationError) ErrorName() string { return "LogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogValidationError{}
+
+// Validate checks the field values on GetLogsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLogsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetLogsRequestMultiError,
+// or nil if none found.
+func (m *GetLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Ascending
+
+	if m.GetLimit() < -1 {
+		err := GetLogsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return GetLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLogsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetLogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLogsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLogsRequestMultiError) AllErrors() []error { return m }
+
+// GetLogsRequestValidationError is the validation error returned by
+// GetLogsRequest.Validate if the designated constraints aren't met.
+type GetLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLogsRequestValidationError) ErrorName() string { return "GetLogsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLogsRequestValidationError{}
+
+// Validate checks the field values on GetLogsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLogsResponseMultiError, or nil if none found.
+func (m *GetLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLogs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetLogsResponseValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetLogsResponseValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetLogsResponseValidationError{
+					field:  fmt.Sprintf("Logs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLogsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetLogsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLogsResponseMultiError) AllErrors() []error { return m }
+
+// GetLogsResponseValidationError is the validation error returned by
+// GetLogsResponse.Validate if the designated constraints aren't met.
+type GetLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLogsResponseValidationError) ErrorName() string { return "GetLogsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLogsResponseValidationError{}
+
+// Validate checks the field values on WatchLogsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchLogsRequestMultiError, or nil if none found.
+func (m *WatchLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Replay
+
+	if m.GetReplayLimit() < -1 {
+		err := WatchLogsRequestValidationError{
+			field:  "ReplayLimit",
+			reason: "value must be greater than or equal to -1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return WatchLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchLogsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchLogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchLogsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchLogsRequestMultiError) AllErrors() []error { return m }
+
+// WatchLogsRequestValidationError is the validation error returned by
+// WatchLogsRequest.Validate if the designated constraints aren't met.
+type WatchLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchLogsRequestValidationError) ErrorName() string { return "WatchLogsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchLogsRequestValidationError{}
+
+// Validate checks the field values on WatchLogsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchLogsResponseMultiError, or nil if none found.
+func (m *WatchLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchLogsResponseValidationError{
+					field:  "Log",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchLogsResponseValidationError{
+					field:  "Log",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchLogsResponseValidationError{
+				field:  "Log",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchLogsResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchLogsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchLogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchLogsResponseMultiError) AllErrors() []error { return m }
+
+// WatchLogsResponseValidationError is the validation error returned by
+// WatchLogsResponse.Validate if the designated constraints aren't met.
+type WatchLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchLogsResponseValidationError) ErrorName() string {
+	return "WatchLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchLogsResponseValidationError{}
+
+// Validate checks the field values on ListSlowQueriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSlowQueriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSlowQueriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSlowQueriesRequestMultiError, or nil if none found.
+func (m *ListSlowQueriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSlowQueriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if m.GetLimit() != 0 {
+
+		if m.GetLimit() > 1000 {
+			err := ListSlowQueriesRequestValidationError{
+				field:  "Limit",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSlowQueriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSlowQueriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSlowQueriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSlowQueriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSlowQueriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSlowQueriesRequestMultiError) AllErrors() []error { return m }
+
+// ListSlowQueriesRequestValidationError is the validation error returned by
+// ListSlowQueriesRequest.Validate if the designated constraints aren't met.
+type ListSlowQueriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSlowQueriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSlowQueriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSlowQueriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSlowQueriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSlowQueriesRequestValidationError) ErrorName() string {
+	return "ListSlowQueriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSlowQueriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSlowQueriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSlowQueriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSlowQueriesRequestValidationError{}
+
+// Validate checks the field values on ListSlowQueriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSlowQueriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSlowQueriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSlowQueriesResponseMultiError, or nil if none found.
+func (m *ListSlowQueriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSlowQueriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetQueries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSlowQueriesResponseValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSlowQueriesResponseValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSlowQueriesResponseValidationError{
+					field:  fmt.Sprintf("Queries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ThresholdMs
+
+	if len(errors) > 0 {
+		return ListSlowQueriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSlowQueriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSlowQueriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSlowQueriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSlowQueriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSlowQueriesResponseMultiError) AllErrors() []error { return m }
+
+// ListSlowQueriesResponseValidationError is the validation error returned by
+// ListSlowQueriesResponse.Validate if the designated constraints aren't met.
+type ListSlowQueriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSlowQueriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSlowQueriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSlowQueriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSlowQueriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSlowQueriesResponseValidationError) ErrorName() string {
+	return "ListSlowQueriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSlowQueriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSlowQueriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSlowQueriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSlowQueriesResponseValidationError{}
+
+// Validate checks the field values on SlowQuery with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SlowQuery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SlowQuery with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SlowQueryMultiError, or nil
+// if none found.
+func (m *SlowQuery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SlowQuery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Fingerprint
+
+	// no validation rules for NormalizedSql
+
+	// no validation rules for Count
+
+	// no validation rules for TotalDurationMs
+
+	// no validation rules for MaxDurationMs
+
+	if all {
+		switch v := interface{}(m.GetFirstSeenOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SlowQueryValidationError{
+					field:  "FirstSeenOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SlowQueryValidationError{
+					field:  "FirstSeenOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFirstSeenOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SlowQueryValidationError{
+				field:  "FirstSeenOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastSeenOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SlowQueryValidationError{
+					field:  "LastSeenOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SlowQueryValidationError{
+					field:  "LastSeenOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeenOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SlowQueryValidationError{
+				field:  "LastSeenOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SlowQueryMultiError(errors)
+	}
+
+	return nil
+}
+
+// SlowQueryMultiError is an error wrapping multiple validation errors returned
+// by SlowQuery.ValidateAll() if the designated constraints aren't met.
+type SlowQueryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SlowQueryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SlowQueryMultiError) AllErrors() []error { return m }
+
+// SlowQueryValidationError is the validation error returned by
+// SlowQuery.Validate if the designated constraints aren't met.
+type SlowQueryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SlowQueryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SlowQueryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SlowQueryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SlowQueryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SlowQueryValidationError) ErrorName() string { return "SlowQueryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SlowQueryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSlowQuery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SlowQueryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SlowQueryValidationError{}
+
+// Validate checks the field values on ListRunningQueriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRunningQueriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRunningQueriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRunningQueriesRequestMultiError, or nil if none found.
+func (m *ListRunningQueriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRunningQueriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if len(errors) > 0 {
+		return ListRunningQueriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRunningQueriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRunningQueriesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListRunningQueriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRunningQueriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRunningQueriesRequestMultiError) AllErrors() []error { return m }
+
+// ListRunningQueriesRequestValidationError is the validation error returned by
+// ListRunningQueriesRequest.Validate if the designated constraints aren't met.
+type ListRunningQueriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRunningQueriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRunningQueriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRunningQueriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRunningQueriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRunningQueriesRequestValidationError) ErrorName() string {
+	return "ListRunningQueriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRunningQueriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRunningQueriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRunningQueriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRunningQueriesRequestValidationError{}
+
+// Validate checks the field values on ListRunningQueriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRunningQueriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRunningQueriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRunningQueriesResponseMultiError, or nil if none found.
+func (m *ListRunningQueriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRunningQueriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetQueries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRunningQueriesResponseValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRunningQueriesResponseValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRunningQueriesResponseValidationError{
+					field:  fmt.Sprintf("Queries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRunningQueriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRunningQueriesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRunningQueriesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRunningQueriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRunningQueriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRunningQueriesResponseMultiError) AllErrors() []error { return m }
+
+// ListRunningQueriesResponseValidationError is the validation error returned
+// by ListRunningQueriesResponse.Validate if the designated constraints aren't met.
+type ListRunningQueriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRunningQueriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRunningQueriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRunningQueriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRunningQueriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRunningQueriesResponseValidationError) ErrorName() string {
+	return "ListRunningQueriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRunningQueriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRunningQueriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRunningQueriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRunningQueriesResponseValidationError{}
+
+// Validate checks the field values on RunningQuery with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RunningQuery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RunningQuery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RunningQueryMultiError, or
+// nil if none found.
+func (m *RunningQuery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RunningQuery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Sql
+
+	if all {
+		switch v := interface{}(m.GetStartedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunningQueryValidationError{
+					field:  "StartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunningQueryValidationError{
+					field:  "StartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunningQueryValidationError{
+				field:  "StartedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ElapsedMs
+
+	// no validation rules for Type
+
+	// no validation rules for Fingerprint
+
+	// no validation rules for Principal
+
+	if len(errors) > 0 {
+		return RunningQueryMultiError(errors)
+	}
+
+	return nil
+}
+
+// RunningQueryMultiError is an error wrapping multiple validation errors
+// returned by RunningQuery.ValidateAll() if the designated constraints aren't met.
+type RunningQueryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RunningQueryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RunningQueryMultiError) AllErrors() []error { return m }
+
+// RunningQueryValidationError is the validation error returned by
+// RunningQuery.Validate if the designated constraints aren't met.
+type RunningQueryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunningQueryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunningQueryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunningQueryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunningQueryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunningQueryValidationError) ErrorName() string { return "RunningQueryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RunningQueryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunningQuery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunningQueryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunningQueryValidationError{}
+
+// Validate checks the field values on CancelQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelQueryRequestMultiError, or nil if none found.
+func (m *CancelQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if utf8.RuneCountInString(m.GetQueryId()) < 1 {
+		err := CancelQueryRequestValidationError{
+			field:  "QueryId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelQueryRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelQueryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CancelQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelQueryRequestMultiError) AllErrors() []error { return m }
+
+// CancelQueryRequestValidationError is the validation error returned by
+// CancelQueryRequest.Validate if the designated constraints aren't met.
+type CancelQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelQueryRequestValidationError) ErrorName() string {
+	return "CancelQueryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelQueryRequestValidationError{}
+
+// Validate checks the field values on CancelQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelQueryResponseMultiError, or nil if none found.
+func (m *CancelQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CancelQueryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelQueryResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelQueryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelQueryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelQueryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelQueryResponseMultiError) AllErrors() []error { return m }
+
+// CancelQueryResponseValidationError is the validation error returned by
+// CancelQueryResponse.Validate if the designated constraints aren't met.
+type CancelQueryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelQueryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelQueryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelQueryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelQueryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelQueryResponseValidationError) ErrorName() string {
+	return "CancelQueryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelQueryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelQueryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelQueryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelQueryResponseValidationError{}
+
+// Validate checks the field values on GetQueryCacheStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetQueryCacheStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQueryCacheStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQueryCacheStatsRequestMultiError, or nil if none found.
+func (m *GetQueryCacheStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQueryCacheStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if len(errors) > 0 {
+		return GetQueryCacheStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQueryCacheStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetQueryCacheStatsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetQueryCacheStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQueryCacheStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQueryCacheStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetQueryCacheStatsRequestValidationError is the validation error returned by
+// GetQueryCacheStatsRequest.Validate if the designated constraints aren't met.
+type GetQueryCacheStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQueryCacheStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQueryCacheStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQueryCacheStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQueryCacheStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQueryCacheStatsRequestValidationError) ErrorName() string {
+	return "GetQueryCacheStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQueryCacheStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQueryCacheStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQueryCacheStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQueryCacheStatsRequestValidationError{}
+
+// Validate checks the field values on GetQueryCacheStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetQueryCacheStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQueryCacheStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQueryCacheStatsResponseMultiError, or nil if none found.
+func (m *GetQueryCacheStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQueryCacheStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Entries
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for DiskEntries
+
+	// no validation rules for DiskSizeBytes
+
+	// no validation rules for Hits
+
+	// no validation rules for Misses
+
+	// no validation rules for Evictions
+
+	if len(errors) > 0 {
+		return GetQueryCacheStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQueryCacheStatsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetQueryCacheStatsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetQueryCacheStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQueryCacheStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQueryCacheStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetQueryCacheStatsResponseValidationError is the validation error returned
+// by GetQueryCacheStatsResponse.Validate if the designated constraints aren't met.
+type GetQueryCacheStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQueryCacheStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQueryCacheStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQueryCacheStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQueryCacheStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQueryCacheStatsResponseValidationError) ErrorName() string {
+	return "GetQueryCacheStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQueryCacheStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQueryCacheStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQueryCacheStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQueryCacheStatsResponseValidationError{}
+
+// Validate checks the field values on PurgeQueryCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeQueryCacheRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeQueryCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeQueryCacheRequestMultiError, or nil if none found.
+func (m *PurgeQueryCacheRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeQueryCacheRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for MetricsView
+
+	if len(errors) > 0 {
+		return PurgeQueryCacheRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeQueryCacheRequestMultiError is an error wrapping multiple validation
+// errors returned by PurgeQueryCacheRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PurgeQueryCacheRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeQueryCacheRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeQueryCacheRequestMultiError) AllErrors() []error { return m }
+
+// PurgeQueryCacheRequestValidationError is the validation error returned by
+// PurgeQueryCacheRequest.Validate if the designated constraints aren't met.
+type PurgeQueryCacheRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeQueryCacheRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeQueryCacheRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeQueryCacheRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeQueryCacheRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeQueryCacheRequestValidationError) ErrorName() string {
+	return "PurgeQueryCacheRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeQueryCacheRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeQueryCacheRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeQueryCacheRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeQueryCacheRequestValidationError{}
+
+// Validate checks the field values on PurgeQueryCacheResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeQueryCacheResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeQueryCacheResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeQueryCacheResponseMultiError, or nil if none found.
+func (m *PurgeQueryCacheResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeQueryCacheResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PurgedEntries
+
+	if len(errors) > 0 {
+		return PurgeQueryCacheResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeQueryCacheResponseMultiError is an error wrapping multiple validation
+// errors returned by PurgeQueryCacheResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PurgeQueryCacheResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeQueryCacheResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeQueryCacheResponseMultiError) AllErrors() []error { return m }
+
+// PurgeQueryCacheResponseValidationError is the validation error returned by
+// PurgeQueryCacheResponse.Validate if the designated constraints aren't met.
+type PurgeQueryCacheResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeQueryCacheResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeQueryCacheResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeQueryCacheResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeQueryCacheResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeQueryCacheResponseValidationError) ErrorName() string {
+	return "PurgeQueryCacheResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeQueryCacheResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeQueryCacheResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeQueryCacheResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeQueryCacheResponseValidationError{}
+
+// Validate checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourcesRequestMultiError, or nil if none found.
+func (m *ListResourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Kind
+
+	// no validation rules for Path
+
+	if len(errors) > 0 {
+		return ListResourcesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResourcesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
+
+// ListResourcesRequestValidationError is the validation error returned by
+// ListResourcesRequest.Validate if the designated constraints aren't met.
+type ListResourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResourcesRequestValidationError) ErrorName() string {
+	return "ListResourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResourcesRequestValidationError{}
+
+// Validate checks the field values on ListResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourcesResponseMultiError, or nil if none found.
+func (m *ListResourcesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResourcesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListResourcesResponseValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListResourcesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResourcesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResourcesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResourcesResponseMultiError) AllErrors() []error { return m }
+
+// ListResourcesResponseValidationError is the validation error returned by
+// ListResourcesResponse.Validate if the designated constraints aren't met.
+type ListResourcesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResourcesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResourcesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResourcesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResourcesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResourcesResponseValidationError) ErrorName() string {
+	return "ListResourcesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResourcesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResourcesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResourcesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResourcesResponseValidationError{}
+
+// Validate checks the field values on WatchResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchResourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchResourcesRequestMultiError, or nil if none found.
+func (m *WatchResourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchResourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Kind
+
+	// no validation rules for Replay
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return WatchResourcesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchResourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchResourcesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchResourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchResourcesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchResourcesRequestMultiError) AllErrors() []error { return m }
+
+// WatchResourcesRequestValidationError is the validation error returned by
+// WatchResourcesRequest.Validate if the designated constraints aren't met.
+type WatchResourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchResourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchResourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchResourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchResourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchResourcesRequestValidationError) ErrorName() string {
+	return "WatchResourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchResourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchResourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchResourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchResourcesRequestValidationError{}
+
+// Validate checks the field values on WatchResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchResourcesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchResourcesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchResourcesResponseMultiError, or nil if none found.
+func (m *WatchResourcesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchResourcesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Event
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchResourcesResponseValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchResourcesResponseValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchResourcesResponseValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchResourcesResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchResourcesResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchResourcesResponseValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchResourcesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchResourcesResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchResourcesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchResourcesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchResourcesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchResourcesResponseMultiError) AllErrors() []error { return m }
+
+// WatchResourcesResponseValidationError is the validation error returned by
+// WatchResourcesResponse.Validate if the designated constraints aren't met.
+type WatchResourcesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchResourcesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchResourcesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchResourcesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchResourcesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchResourcesResponseValidationError) ErrorName() string {
+	return "WatchResourcesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchResourcesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchResourcesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchResourcesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchResourcesResponseValidationError{}
+
+// Validate checks the field values on GetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResourceRequestMultiError, or nil if none found.
+func (m *GetResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetResourceRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetResourceRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetResourceRequestValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetResourceRequestMultiError(errors)
 	}
 
 	return nil
@@ -7097,10 +9660,2710 @@ func (m *GetResourceRequest) validate(all bool) error {
 // GetResourceRequestMultiError is an error wrapping multiple validation errors
 // returned by GetResourceRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetResourceRequestMultiError []error
+type GetResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResourceRequestMultiError) AllErrors() []error { return m }
+
+// GetResourceRequestValidationError is the validation error returned by
+// GetResourceRequest.Validate if the designated constraints aren't met.
+type GetResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResourceRequestValidationError) ErrorName() string {
+	return "GetResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResourceRequestValidationError{}
+
+// Validate checks the field values on GetResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResourceResponseMultiError, or nil if none found.
+func (m *GetResourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetResourceResponseValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetResourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetResourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResourceResponseMultiError) AllErrors() []error { return m }
+
+// GetResourceResponseValidationError is the validation error returned by
+// GetResourceResponse.Validate if the designated constraints aren't met.
+type GetResourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResourceResponseValidationError) ErrorName() string {
+	return "GetResourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResourceResponseValidationError{}
+
+// Validate checks the field values on ListMetricsViewVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMetricsViewVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMetricsViewVersionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListMetricsViewVersionsRequestMultiError, or nil if none found.
+func (m *ListMetricsViewVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMetricsViewVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for MetricsViewName
+
+	if m.GetLimit() != 0 {
+
+		if m.GetLimit() > 100 {
+			err := ListMetricsViewVersionsRequestValidationError{
+				field:  "Limit",
+				reason: "value must be less than or equal to 100",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMetricsViewVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMetricsViewVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMetricsViewVersionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListMetricsViewVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMetricsViewVersionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMetricsViewVersionsRequestMultiError) AllErrors() []error { return m }
+
+// ListMetricsViewVersionsRequestValidationError is the validation error
+// returned by ListMetricsViewVersionsRequest.Validate if the designated
+// constraints aren't met.
+type ListMetricsViewVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMetricsViewVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMetricsViewVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMetricsViewVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMetricsViewVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMetricsViewVersionsRequestValidationError) ErrorName() string {
+	return "ListMetricsViewVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMetricsViewVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMetricsViewVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMetricsViewVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMetricsViewVersionsRequestValidationError{}
+
+// Validate checks the field values on ListMetricsViewVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMetricsViewVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMetricsViewVersionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListMetricsViewVersionsResponseMultiError, or nil if none found.
+func (m *ListMetricsViewVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMetricsViewVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMetricsViewVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMetricsViewVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMetricsViewVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMetricsViewVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMetricsViewVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMetricsViewVersionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListMetricsViewVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMetricsViewVersionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMetricsViewVersionsResponseMultiError) AllErrors() []error { return m }
+
+// ListMetricsViewVersionsResponseValidationError is the validation error
+// returned by ListMetricsViewVersionsResponse.Validate if the designated
+// constraints aren't met.
+type ListMetricsViewVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMetricsViewVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMetricsViewVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMetricsViewVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMetricsViewVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMetricsViewVersionsResponseValidationError) ErrorName() string {
+	return "ListMetricsViewVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMetricsViewVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMetricsViewVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMetricsViewVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMetricsViewVersionsResponseValidationError{}
+
+// Validate checks the field values on MetricsViewVersion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewVersion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewVersionMultiError, or nil if none found.
+func (m *MetricsViewVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRefreshedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewVersionValidationError{
+					field:  "RefreshedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewVersionValidationError{
+					field:  "RefreshedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewVersionValidationError{
+				field:  "RefreshedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RowCount
+
+	// no validation rules for RowCountDelta
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewVersionValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewVersionValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewVersionValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSchemaChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewVersionValidationError{
+						field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewVersionValidationError{
+						field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewVersionValidationError{
+					field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewVersionMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewVersion.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsViewVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewVersionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewVersionMultiError) AllErrors() []error { return m }
+
+// MetricsViewVersionValidationError is the validation error returned by
+// MetricsViewVersion.Validate if the designated constraints aren't met.
+type MetricsViewVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewVersionValidationError) ErrorName() string {
+	return "MetricsViewVersionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewVersionValidationError{}
+
+// Validate checks the field values on GetDataDiffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDataDiffRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDataDiffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDataDiffRequestMultiError, or nil if none found.
+func (m *GetDataDiffRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDataDiffRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if utf8.RuneCountInString(m.GetBaseInstanceId()) < 1 {
+		err := GetDataDiffRequestValidationError{
+			field:  "BaseInstanceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetName()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDataDiffRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDataDiffRequestValidationError{
+					field:  "Name",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDataDiffRequestValidationError{
+				field:  "Name",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Priority
+
+	if len(errors) > 0 {
+		return GetDataDiffRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDataDiffRequestMultiError is an error wrapping multiple validation errors
+// returned by GetDataDiffRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetDataDiffRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDataDiffRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDataDiffRequestMultiError) AllErrors() []error { return m }
+
+// GetDataDiffRequestValidationError is the validation error returned by
+// GetDataDiffRequest.Validate if the designated constraints aren't met.
+type GetDataDiffRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDataDiffRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDataDiffRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDataDiffRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDataDiffRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDataDiffRequestValidationError) ErrorName() string {
+	return "GetDataDiffRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDataDiffRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDataDiffRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDataDiffRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDataDiffRequestValidationError{}
+
+// Validate checks the field values on GetDataDiffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDataDiffResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDataDiffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDataDiffResponseMultiError, or nil if none found.
+func (m *GetDataDiffResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDataDiffResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RowCount
+
+	// no validation rules for BaseRowCount
+
+	for idx, item := range m.GetSchemaChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDataDiffResponseValidationError{
+						field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDataDiffResponseValidationError{
+						field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDataDiffResponseValidationError{
+					field:  fmt.Sprintf("SchemaChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMeasures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDataDiffResponseValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDataDiffResponseValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDataDiffResponseValidationError{
+					field:  fmt.Sprintf("Measures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDataDiffResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDataDiffResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDataDiffResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDataDiffResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDataDiffResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDataDiffResponseMultiError) AllErrors() []error { return m }
+
+// GetDataDiffResponseValidationError is the validation error returned by
+// GetDataDiffResponse.Validate if the designated constraints aren't met.
+type GetDataDiffResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDataDiffResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDataDiffResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDataDiffResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDataDiffResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDataDiffResponseValidationError) ErrorName() string {
+	return "GetDataDiffResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDataDiffResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDataDiffResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDataDiffResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDataDiffResponseValidationError{}
+
+// Validate checks the field values on DataDiffMeasure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataDiffMeasure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataDiffMeasure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataDiffMeasureMultiError, or nil if none found.
+func (m *DataDiffMeasure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataDiffMeasure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if m.Value != nil {
+		// no validation rules for Value
+	}
+
+	if m.BaseValue != nil {
+		// no validation rules for BaseValue
+	}
+
+	if m.Delta != nil {
+		// no validation rules for Delta
+	}
+
+	if len(errors) > 0 {
+		return DataDiffMeasureMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataDiffMeasureMultiError is an error wrapping multiple validation errors
+// returned by DataDiffMeasure.ValidateAll() if the designated constraints
+// aren't met.
+type DataDiffMeasureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataDiffMeasureMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataDiffMeasureMultiError) AllErrors() []error { return m }
+
+// DataDiffMeasureValidationError is the validation error returned by
+// DataDiffMeasure.Validate if the designated constraints aren't met.
+type DataDiffMeasureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataDiffMeasureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataDiffMeasureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataDiffMeasureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataDiffMeasureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataDiffMeasureValidationError) ErrorName() string { return "DataDiffMeasureValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataDiffMeasureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataDiffMeasure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataDiffMeasureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataDiffMeasureValidationError{}
+
+// Validate checks the field values on MetricsViewSchemaChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSchemaChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSchemaChange with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSchemaChangeMultiError, or nil if none found.
+func (m *MetricsViewSchemaChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSchemaChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Column
+
+	if all {
+		switch v := interface{}(m.GetType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSchemaChangeValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSchemaChangeValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSchemaChangeValidationError{
+				field:  "Type",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreviousType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSchemaChangeValidationError{
+					field:  "PreviousType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSchemaChangeValidationError{
+					field:  "PreviousType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreviousType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSchemaChangeValidationError{
+				field:  "PreviousType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewSchemaChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSchemaChangeMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSchemaChange.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSchemaChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSchemaChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSchemaChangeMultiError) AllErrors() []error { return m }
+
+// MetricsViewSchemaChangeValidationError is the validation error returned by
+// MetricsViewSchemaChange.Validate if the designated constraints aren't met.
+type MetricsViewSchemaChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSchemaChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSchemaChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSchemaChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSchemaChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSchemaChangeValidationError) ErrorName() string {
+	return "MetricsViewSchemaChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSchemaChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSchemaChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSchemaChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSchemaChangeValidationError{}
+
+// Validate checks the field values on CreateTriggerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTriggerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTriggerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTriggerRequestMultiError, or nil if none found.
+func (m *CreateTriggerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTriggerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	switch v := m.Trigger.(type) {
+	case *CreateTriggerRequest_PullTriggerSpec:
+		if v == nil {
+			err := CreateTriggerRequestValidationError{
+				field:  "Trigger",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPullTriggerSpec()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  "PullTriggerSpec",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  "PullTriggerSpec",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPullTriggerSpec()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTriggerRequestValidationError{
+					field:  "PullTriggerSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CreateTriggerRequest_RefreshTriggerSpec:
+		if v == nil {
+			err := CreateTriggerRequestValidationError{
+				field:  "Trigger",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRefreshTriggerSpec()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  "RefreshTriggerSpec",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTriggerRequestValidationError{
+						field:  "RefreshTriggerSpec",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRefreshTriggerSpec()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTriggerRequestValidationError{
+					field:  "RefreshTriggerSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return CreateTriggerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTriggerRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTriggerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTriggerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTriggerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTriggerRequestMultiError) AllErrors() []error { return m }
+
+// CreateTriggerRequestValidationError is the validation error returned by
+// CreateTriggerRequest.Validate if the designated constraints aren't met.
+type CreateTriggerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTriggerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTriggerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTriggerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTriggerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTriggerRequestValidationError) ErrorName() string {
+	return "CreateTriggerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTriggerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTriggerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTriggerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTriggerRequestValidationError{}
+
+// Validate checks the field values on CreateTriggerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTriggerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTriggerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTriggerResponseMultiError, or nil if none found.
+func (m *CreateTriggerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTriggerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateTriggerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTriggerResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateTriggerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTriggerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTriggerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTriggerResponseMultiError) AllErrors() []error { return m }
+
+// CreateTriggerResponseValidationError is the validation error returned by
+// CreateTriggerResponse.Validate if the designated constraints aren't met.
+type CreateTriggerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTriggerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTriggerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTriggerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTriggerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTriggerResponseValidationError) ErrorName() string {
+	return "CreateTriggerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTriggerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTriggerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTriggerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTriggerResponseValidationError{}
+
+// Validate checks the field values on ConnectorDriver with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConnectorDriver) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectorDriver with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectorDriverMultiError, or nil if none found.
+func (m *ConnectorDriver) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectorDriver) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetConfigProperties() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectorDriverValidationError{
+						field:  fmt.Sprintf("ConfigProperties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectorDriverValidationError{
+						field:  fmt.Sprintf("ConfigProperties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectorDriverValidationError{
+					field:  fmt.Sprintf("ConfigProperties[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSourceProperties() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConnectorDriverValidationError{
+						field:  fmt.Sprintf("SourceProperties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConnectorDriverValidationError{
+						field:  fmt.Sprintf("SourceProperties[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectorDriverValidationError{
+					field:  fmt.Sprintf("SourceProperties[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for ImplementsRegistry
+
+	// no validation rules for ImplementsCatalog
+
+	// no validation rules for ImplementsRepo
+
+	// no validation rules for ImplementsAdmin
+
+	// no validation rules for ImplementsAi
+
+	// no validation rules for ImplementsSqlStore
+
+	// no validation rules for ImplementsOlap
+
+	// no validation rules for ImplementsObjectStore
+
+	// no validation rules for ImplementsFileStore
+
+	// no validation rules for ImplementsNotifier
+
+	if len(errors) > 0 {
+		return ConnectorDriverMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectorDriverMultiError is an error wrapping multiple validation errors
+// returned by ConnectorDriver.ValidateAll() if the designated constraints
+// aren't met.
+type ConnectorDriverMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectorDriverMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectorDriverMultiError) AllErrors() []error { return m }
+
+// ConnectorDriverValidationError is the validation error returned by
+// ConnectorDriver.Validate if the designated constraints aren't met.
+type ConnectorDriverValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectorDriverValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectorDriverValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectorDriverValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectorDriverValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectorDriverValidationError) ErrorName() string { return "ConnectorDriverValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnectorDriverValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectorDriver.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectorDriverValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectorDriverValidationError{}
+
+// Validate checks the field values on AnalyzedConnector with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AnalyzedConnector) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyzedConnector with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalyzedConnectorMultiError, or nil if none found.
+func (m *AnalyzedConnector) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyzedConnector) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetDriver()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnalyzedConnectorValidationError{
+					field:  "Driver",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnalyzedConnectorValidationError{
+					field:  "Driver",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDriver()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnalyzedConnectorValidationError{
+				field:  "Driver",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Config
+
+	// no validation rules for PresetConfig
+
+	// no validation rules for ProjectConfig
+
+	// no validation rules for EnvConfig
+
+	// no validation rules for HasAnonymousAccess
+
+	for idx, item := range m.GetUsedBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalyzedConnectorValidationError{
+						field:  fmt.Sprintf("UsedBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalyzedConnectorValidationError{
+						field:  fmt.Sprintf("UsedBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalyzedConnectorValidationError{
+					field:  fmt.Sprintf("UsedBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return AnalyzedConnectorMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalyzedConnectorMultiError is an error wrapping multiple validation errors
+// returned by AnalyzedConnector.ValidateAll() if the designated constraints
+// aren't met.
+type AnalyzedConnectorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalyzedConnectorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalyzedConnectorMultiError) AllErrors() []error { return m }
+
+// AnalyzedConnectorValidationError is the validation error returned by
+// AnalyzedConnector.Validate if the designated constraints aren't met.
+type AnalyzedConnectorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalyzedConnectorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalyzedConnectorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalyzedConnectorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalyzedConnectorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalyzedConnectorValidationError) ErrorName() string {
+	return "AnalyzedConnectorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalyzedConnectorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalyzedConnector.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalyzedConnectorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalyzedConnectorValidationError{}
+
+// Validate checks the field values on ListConnectorDriversRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConnectorDriversRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConnectorDriversRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConnectorDriversRequestMultiError, or nil if none found.
+func (m *ListConnectorDriversRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConnectorDriversRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListConnectorDriversRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConnectorDriversRequestMultiError is an error wrapping multiple
+// validation errors returned by ListConnectorDriversRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListConnectorDriversRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConnectorDriversRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConnectorDriversRequestMultiError) AllErrors() []error { return m }
+
+// ListConnectorDriversRequestValidationError is the validation error returned
+// by ListConnectorDriversRequest.Validate if the designated constraints
+// aren't met.
+type ListConnectorDriversRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConnectorDriversRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConnectorDriversRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConnectorDriversRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConnectorDriversRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConnectorDriversRequestValidationError) ErrorName() string {
+	return "ListConnectorDriversRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConnectorDriversRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConnectorDriversRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConnectorDriversRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConnectorDriversRequestValidationError{}
+
+// Validate checks the field values on ListConnectorDriversResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConnectorDriversResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConnectorDriversResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConnectorDriversResponseMultiError, or nil if none found.
+func (m *ListConnectorDriversResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConnectorDriversResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConnectors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListConnectorDriversResponseValidationError{
+						field:  fmt.Sprintf("Connectors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListConnectorDriversResponseValidationError{
+						field:  fmt.Sprintf("Connectors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListConnectorDriversResponseValidationError{
+					field:  fmt.Sprintf("Connectors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListConnectorDriversResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConnectorDriversResponseMultiError is an error wrapping multiple
+// validation errors returned by ListConnectorDriversResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListConnectorDriversResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConnectorDriversResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConnectorDriversResponseMultiError) AllErrors() []error { return m }
+
+// ListConnectorDriversResponseValidationError is the validation error returned
+// by ListConnectorDriversResponse.Validate if the designated constraints
+// aren't met.
+type ListConnectorDriversResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConnectorDriversResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConnectorDriversResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConnectorDriversResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConnectorDriversResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConnectorDriversResponseValidationError) ErrorName() string {
+	return "ListConnectorDriversResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConnectorDriversResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConnectorDriversResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConnectorDriversResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConnectorDriversResponseValidationError{}
+
+// Validate checks the field values on AnalyzeConnectorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnalyzeConnectorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyzeConnectorsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalyzeConnectorsRequestMultiError, or nil if none found.
+func (m *AnalyzeConnectorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyzeConnectorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if len(errors) > 0 {
+		return AnalyzeConnectorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalyzeConnectorsRequestMultiError is an error wrapping multiple validation
+// errors returned by AnalyzeConnectorsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AnalyzeConnectorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalyzeConnectorsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalyzeConnectorsRequestMultiError) AllErrors() []error { return m }
+
+// AnalyzeConnectorsRequestValidationError is the validation error returned by
+// AnalyzeConnectorsRequest.Validate if the designated constraints aren't met.
+type AnalyzeConnectorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalyzeConnectorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalyzeConnectorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalyzeConnectorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalyzeConnectorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalyzeConnectorsRequestValidationError) ErrorName() string {
+	return "AnalyzeConnectorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalyzeConnectorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalyzeConnectorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalyzeConnectorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalyzeConnectorsRequestValidationError{}
+
+// Validate checks the field values on AnalyzeConnectorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AnalyzeConnectorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnalyzeConnectorsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnalyzeConnectorsResponseMultiError, or nil if none found.
+func (m *AnalyzeConnectorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnalyzeConnectorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConnectors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AnalyzeConnectorsResponseValidationError{
+						field:  fmt.Sprintf("Connectors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AnalyzeConnectorsResponseValidationError{
+						field:  fmt.Sprintf("Connectors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AnalyzeConnectorsResponseValidationError{
+					field:  fmt.Sprintf("Connectors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AnalyzeConnectorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnalyzeConnectorsResponseMultiError is an error wrapping multiple validation
+// errors returned by AnalyzeConnectorsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AnalyzeConnectorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnalyzeConnectorsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnalyzeConnectorsResponseMultiError) AllErrors() []error { return m }
+
+// AnalyzeConnectorsResponseValidationError is the validation error returned by
+// AnalyzeConnectorsResponse.Validate if the designated constraints aren't met.
+type AnalyzeConnectorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnalyzeConnectorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnalyzeConnectorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnalyzeConnectorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnalyzeConnectorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnalyzeConnectorsResponseValidationError) ErrorName() string {
+	return "AnalyzeConnectorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnalyzeConnectorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnalyzeConnectorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnalyzeConnectorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnalyzeConnectorsResponseValidationError{}
+
+// Validate checks the field values on ListNotifierConnectorsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotifierConnectorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotifierConnectorsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListNotifierConnectorsRequestMultiError, or nil if none found.
+func (m *ListNotifierConnectorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotifierConnectorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	if len(errors) > 0 {
+		return ListNotifierConnectorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotifierConnectorsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListNotifierConnectorsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListNotifierConnectorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotifierConnectorsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotifierConnectorsRequestMultiError) AllErrors() []error { return m }
+
+// ListNotifierConnectorsRequestValidationError is the validation error
+// returned by ListNotifierConnectorsRequest.Validate if the designated
+// constraints aren't met.
+type ListNotifierConnectorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotifierConnectorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotifierConnectorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotifierConnectorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotifierConnectorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotifierConnectorsRequestValidationError) ErrorName() string {
+	return "ListNotifierConnectorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotifierConnectorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotifierConnectorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotifierConnectorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotifierConnectorsRequestValidationError{}
+
+// Validate checks the field values on ListNotifierConnectorsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotifierConnectorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotifierConnectorsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListNotifierConnectorsResponseMultiError, or nil if none found.
+func (m *ListNotifierConnectorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotifierConnectorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConnectors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNotifierConnectorsResponseValidationError{
+						field:  fmt.Sprintf("Connectors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNotifierConnectorsResponseValidationError{
+						field:  fmt.Sprintf("Connectors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNotifierConnectorsResponseValidationError{
+					field:  fmt.Sprintf("Connectors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListNotifierConnectorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotifierConnectorsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListNotifierConnectorsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListNotifierConnectorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotifierConnectorsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotifierConnectorsResponseMultiError) AllErrors() []error { return m }
+
+// ListNotifierConnectorsResponseValidationError is the validation error
+// returned by ListNotifierConnectorsResponse.Validate if the designated
+// constraints aren't met.
+type ListNotifierConnectorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotifierConnectorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotifierConnectorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotifierConnectorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotifierConnectorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotifierConnectorsResponseValidationError) ErrorName() string {
+	return "ListNotifierConnectorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotifierConnectorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotifierConnectorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotifierConnectorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotifierConnectorsResponseValidationError{}
+
+// Validate checks the field values on IssueDevJWTRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueDevJWTRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueDevJWTRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueDevJWTRequestMultiError, or nil if none found.
+func (m *IssueDevJWTRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueDevJWTRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := IssueDevJWTRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEmail()) < 1 {
+		err := IssueDevJWTRequestValidationError{
+			field:  "Email",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Admin
+
+	if len(errors) > 0 {
+		return IssueDevJWTRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueDevJWTRequestMultiError is an error wrapping multiple validation errors
+// returned by IssueDevJWTRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IssueDevJWTRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResourceRequestMultiError) Error() string {
+func (m IssueDevJWTRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7109,11 +12372,11 @@ func (m GetResourceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResourceRequestMultiError) AllErrors() []error { return m }
+func (m IssueDevJWTRequestMultiError) AllErrors() []error { return m }
 
-// GetResourceRequestValidationError is the validation error returned by
-// GetResourceRequest.Validate if the designated constraints aren't met.
-type GetResourceRequestValidationError struct {
+// IssueDevJWTRequestValidationError is the validation error returned by
+// IssueDevJWTRequest.Validate if the designated constraints aren't met.
+type IssueDevJWTRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7121,24 +12384,128 @@ type GetResourceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResourceRequestValidationError) Field() string { return e.field }
+func (e IssueDevJWTRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResourceRequestValidationError) Reason() string { return e.reason }
+func (e IssueDevJWTRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueDevJWTRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueDevJWTRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueDevJWTRequestValidationError) ErrorName() string {
+	return "IssueDevJWTRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueDevJWTRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueDevJWTRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueDevJWTRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueDevJWTRequestValidationError{}
+
+// Validate checks the field values on IssueDevJWTResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueDevJWTResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueDevJWTResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueDevJWTResponseMultiError, or nil if none found.
+func (m *IssueDevJWTResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueDevJWTResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Jwt
+
+	if len(errors) > 0 {
+		return IssueDevJWTResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueDevJWTResponseMultiError is an error wrapping multiple validation
+// errors returned by IssueDevJWTResponse.ValidateAll() if the designated
+// constraints aren't met.
+type IssueDevJWTResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueDevJWTResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueDevJWTResponseMultiError) AllErrors() []error { return m }
+
+// IssueDevJWTResponseValidationError is the validation error returned by
+// IssueDevJWTResponse.Validate if the designated constraints aren't met.
+type IssueDevJWTResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueDevJWTResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueDevJWTResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResourceRequestValidationError) Cause() error { return e.cause }
+func (e IssueDevJWTResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResourceRequestValidationError) Key() bool { return e.key }
+func (e IssueDevJWTResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResourceRequestValidationError) ErrorName() string {
-	return "GetResourceRequestValidationError"
+func (e IssueDevJWTResponseValidationError) ErrorName() string {
+	return "IssueDevJWTResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetResourceRequestValidationError) Error() string {
+func (e IssueDevJWTResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7150,14 +12517,14 @@ func (e GetResourceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResourceRequest.%s: %s%s",
+		"invalid %sIssueDevJWTResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResourceRequestValidationError{}
+var _ error = IssueDevJWTResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7165,53 +12532,119 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResourceRequestValidationError{}
+} = IssueDevJWTResponseValidationError{}
 
-// Validate checks the field values on GetResourceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetResourceResponse) Validate() error {
+// Validate checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIKey) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResourceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetResourceResponseMultiError, or nil if none found.
-func (m *GetResourceResponse) ValidateAll() error {
+// ValidateAll checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in APIKeyMultiError, or nil if none found.
+func (m *APIKey) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResourceResponse) validate(all bool) error {
+func (m *APIKey) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, APIKeyValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, APIKeyValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return APIKeyValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if all {
-		switch v := interface{}(m.GetResource()).(type) {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetResourceResponseValidationError{
-					field:  "Resource",
+				errors = append(errors, APIKeyValidationError{
+					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetResourceResponseValidationError{
-					field:  "Resource",
+				errors = append(errors, APIKeyValidationError{
+					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetResourceResponseValidationError{
-				field:  "Resource",
+			return APIKeyValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "ExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "ExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "ExpiresOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -7219,19 +12652,18 @@ func (m *GetResourceResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetResourceResponseMultiError(errors)
+		return APIKeyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetResourceResponseMultiError is an error wrapping multiple validation
-// errors returned by GetResourceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetResourceResponseMultiError []error
+// APIKeyMultiError is an error wrapping multiple validation errors returned by
+// APIKey.ValidateAll() if the designated constraints aren't met.
+type APIKeyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResourceResponseMultiError) Error() string {
+func (m APIKeyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7240,11 +12672,11 @@ func (m GetResourceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResourceResponseMultiError) AllErrors() []error { return m }
+func (m APIKeyMultiError) AllErrors() []error { return m }
 
-// GetResourceResponseValidationError is the validation error returned by
-// GetResourceResponse.Validate if the designated constraints aren't met.
-type GetResourceResponseValidationError struct {
+// APIKeyValidationError is the validation error returned by APIKey.Validate if
+// the designated constraints aren't met.
+type APIKeyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7252,24 +12684,22 @@ type GetResourceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResourceResponseValidationError) Field() string { return e.field }
+func (e APIKeyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResourceResponseValidationError) Reason() string { return e.reason }
+func (e APIKeyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResourceResponseValidationError) Cause() error { return e.cause }
+func (e APIKeyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResourceResponseValidationError) Key() bool { return e.key }
+func (e APIKeyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResourceResponseValidationError) ErrorName() string {
-	return "GetResourceResponseValidationError"
-}
+func (e APIKeyValidationError) ErrorName() string { return "APIKeyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetResourceResponseValidationError) Error() string {
+func (e APIKeyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7281,14 +12711,14 @@ func (e GetResourceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResourceResponse.%s: %s%s",
+		"invalid %sAPIKey.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResourceResponseValidationError{}
+var _ error = APIKeyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7296,133 +12726,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResourceResponseValidationError{}
+} = APIKeyValidationError{}
 
-// Validate checks the field values on CreateTriggerRequest with the rules
+// Validate checks the field values on ListAPIKeysRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTriggerRequest) Validate() error {
+func (m *ListAPIKeysRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTriggerRequest with the rules
+// ValidateAll checks the field values on ListAPIKeysRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTriggerRequestMultiError, or nil if none found.
-func (m *CreateTriggerRequest) ValidateAll() error {
+// ListAPIKeysRequestMultiError, or nil if none found.
+func (m *ListAPIKeysRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTriggerRequest) validate(all bool) error {
+func (m *ListAPIKeysRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	switch v := m.Trigger.(type) {
-	case *CreateTriggerRequest_PullTriggerSpec:
-		if v == nil {
-			err := CreateTriggerRequestValidationError{
-				field:  "Trigger",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetPullTriggerSpec()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  "PullTriggerSpec",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  "PullTriggerSpec",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPullTriggerSpec()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateTriggerRequestValidationError{
-					field:  "PullTriggerSpec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *CreateTriggerRequest_RefreshTriggerSpec:
-		if v == nil {
-			err := CreateTriggerRequestValidationError{
-				field:  "Trigger",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if !_ListAPIKeysRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := ListAPIKeysRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
-
-		if all {
-			switch v := interface{}(m.GetRefreshTriggerSpec()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  "RefreshTriggerSpec",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateTriggerRequestValidationError{
-						field:  "RefreshTriggerSpec",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetRefreshTriggerSpec()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateTriggerRequestValidationError{
-					field:  "RefreshTriggerSpec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+		if !all {
+			return err
 		}
-
-	default:
-		_ = v // ensures v is used
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateTriggerRequestMultiError(errors)
+		return ListAPIKeysRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTriggerRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateTriggerRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTriggerRequestMultiError []error
+// ListAPIKeysRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAPIKeysRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAPIKeysRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTriggerRequestMultiError) Error() string {
+func (m ListAPIKeysRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7431,11 +12783,11 @@ func (m CreateTriggerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTriggerRequestMultiError) AllErrors() []error { return m }
+func (m ListAPIKeysRequestMultiError) AllErrors() []error { return m }
 
-// CreateTriggerRequestValidationError is the validation error returned by
-// CreateTriggerRequest.Validate if the designated constraints aren't met.
-type CreateTriggerRequestValidationError struct {
+// ListAPIKeysRequestValidationError is the validation error returned by
+// ListAPIKeysRequest.Validate if the designated constraints aren't met.
+type ListAPIKeysRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7443,24 +12795,24 @@ type CreateTriggerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTriggerRequestValidationError) Field() string { return e.field }
+func (e ListAPIKeysRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTriggerRequestValidationError) Reason() string { return e.reason }
+func (e ListAPIKeysRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTriggerRequestValidationError) Cause() error { return e.cause }
+func (e ListAPIKeysRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTriggerRequestValidationError) Key() bool { return e.key }
+func (e ListAPIKeysRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTriggerRequestValidationError) ErrorName() string {
-	return "CreateTriggerRequestValidationError"
+func (e ListAPIKeysRequestValidationError) ErrorName() string {
+	return "ListAPIKeysRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTriggerRequestValidationError) Error() string {
+func (e ListAPIKeysRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7472,14 +12824,14 @@ func (e CreateTriggerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTriggerRequest.%s: %s%s",
+		"invalid %sListAPIKeysRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTriggerRequestValidationError{}
+var _ error = ListAPIKeysRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7487,44 +12839,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTriggerRequestValidationError{}
+} = ListAPIKeysRequestValidationError{}
 
-// Validate checks the field values on CreateTriggerResponse with the rules
+var _ListAPIKeysRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on ListAPIKeysResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTriggerResponse) Validate() error {
+func (m *ListAPIKeysResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTriggerResponse with the rules
+// ValidateAll checks the field values on ListAPIKeysResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTriggerResponseMultiError, or nil if none found.
-func (m *CreateTriggerResponse) ValidateAll() error {
+// ListAPIKeysResponseMultiError, or nil if none found.
+func (m *ListAPIKeysResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTriggerResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *ListAPIKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAPIKeysResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
 	if len(errors) > 0 {
-		return CreateTriggerResponseMultiError(errors)
+		return ListAPIKeysResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTriggerResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateTriggerResponse.ValidateAll() if the designated
+// ListAPIKeysResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAPIKeysResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateTriggerResponseMultiError []error
+type ListAPIKeysResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTriggerResponseMultiError) Error() string {
+func (m ListAPIKeysResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7533,11 +12921,11 @@ func (m CreateTriggerResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTriggerResponseMultiError) AllErrors() []error { return m }
+func (m ListAPIKeysResponseMultiError) AllErrors() []error { return m }
 
-// CreateTriggerResponseValidationError is the validation error returned by
-// CreateTriggerResponse.Validate if the designated constraints aren't met.
-type CreateTriggerResponseValidationError struct {
+// ListAPIKeysResponseValidationError is the validation error returned by
+// ListAPIKeysResponse.Validate if the designated constraints aren't met.
+type ListAPIKeysResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7545,24 +12933,24 @@ type CreateTriggerResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTriggerResponseValidationError) Field() string { return e.field }
+func (e ListAPIKeysResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTriggerResponseValidationError) Reason() string { return e.reason }
+func (e ListAPIKeysResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTriggerResponseValidationError) Cause() error { return e.cause }
+func (e ListAPIKeysResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTriggerResponseValidationError) Key() bool { return e.key }
+func (e ListAPIKeysResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTriggerResponseValidationError) ErrorName() string {
-	return "CreateTriggerResponseValidationError"
+func (e ListAPIKeysResponseValidationError) ErrorName() string {
+	return "ListAPIKeysResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTriggerResponseValidationError) Error() string {
+func (e ListAPIKeysResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7574,14 +12962,14 @@ func (e CreateTriggerResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTriggerResponse.%s: %s%s",
+		"invalid %sListAPIKeysResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTriggerResponseValidationError{}
+var _ error = ListAPIKeysResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7589,83 +12977,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTriggerResponseValidationError{}
+} = ListAPIKeysResponseValidationError{}
 
-// Validate checks the field values on ConnectorDriver with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ConnectorDriver) Validate() error {
+// Validate checks the field values on CreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConnectorDriver with the rules
+// ValidateAll checks the field values on CreateAPIKeyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ConnectorDriverMultiError, or nil if none found.
-func (m *ConnectorDriver) ValidateAll() error {
+// CreateAPIKeyRequestMultiError, or nil if none found.
+func (m *CreateAPIKeyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConnectorDriver) validate(all bool) error {
+func (m *CreateAPIKeyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	for idx, item := range m.GetConfigProperties() {
-		_, _ = idx, item
+	if !_CreateAPIKeyRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := CreateAPIKeyRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectorDriverValidationError{
-						field:  fmt.Sprintf("ConfigProperties[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectorDriverValidationError{
-						field:  fmt.Sprintf("ConfigProperties[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ConnectorDriverValidationError{
-					field:  fmt.Sprintf("ConfigProperties[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateAPIKeyRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if len(m.GetPermissions()) < 1 {
+		err := CreateAPIKeyRequestValidationError{
+			field:  "Permissions",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetSourceProperties() {
+	for idx, item := range m.GetResources() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConnectorDriverValidationError{
-						field:  fmt.Sprintf("SourceProperties[%v]", idx),
+					errors = append(errors, CreateAPIKeyRequestValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ConnectorDriverValidationError{
-						field:  fmt.Sprintf("SourceProperties[%v]", idx),
+					errors = append(errors, CreateAPIKeyRequestValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -7673,8 +13058,8 @@ func (m *ConnectorDriver) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ConnectorDriverValidationError{
-					field:  fmt.Sprintf("SourceProperties[%v]", idx),
+				return CreateAPIKeyRequestValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -7683,44 +13068,22 @@ func (m *ConnectorDriver) validate(all bool) error {
 
 	}
 
-	// no validation rules for DisplayName
-
-	// no validation rules for Description
-
-	// no validation rules for ImplementsRegistry
-
-	// no validation rules for ImplementsCatalog
-
-	// no validation rules for ImplementsRepo
-
-	// no validation rules for ImplementsAdmin
-
-	// no validation rules for ImplementsAi
-
-	// no validation rules for ImplementsSqlStore
-
-	// no validation rules for ImplementsOlap
-
-	// no validation rules for ImplementsObjectStore
-
-	// no validation rules for ImplementsFileStore
-
-	// no validation rules for ImplementsNotifier
+	// no validation rules for TtlSeconds
 
 	if len(errors) > 0 {
-		return ConnectorDriverMultiError(errors)
+		return CreateAPIKeyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConnectorDriverMultiError is an error wrapping multiple validation errors
-// returned by ConnectorDriver.ValidateAll() if the designated constraints
-// aren't met.
-type ConnectorDriverMultiError []error
+// CreateAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKeyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConnectorDriverMultiError) Error() string {
+func (m CreateAPIKeyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7729,11 +13092,11 @@ func (m ConnectorDriverMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConnectorDriverMultiError) AllErrors() []error { return m }
+func (m CreateAPIKeyRequestMultiError) AllErrors() []error { return m }
 
-// ConnectorDriverValidationError is the validation error returned by
-// ConnectorDriver.Validate if the designated constraints aren't met.
-type ConnectorDriverValidationError struct {
+// CreateAPIKeyRequestValidationError is the validation error returned by
+// CreateAPIKeyRequest.Validate if the designated constraints aren't met.
+type CreateAPIKeyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7741,22 +13104,24 @@ type ConnectorDriverValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConnectorDriverValidationError) Field() string { return e.field }
+func (e CreateAPIKeyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConnectorDriverValidationError) Reason() string { return e.reason }
+func (e CreateAPIKeyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConnectorDriverValidationError) Cause() error { return e.cause }
+func (e CreateAPIKeyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConnectorDriverValidationError) Key() bool { return e.key }
+func (e CreateAPIKeyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConnectorDriverValidationError) ErrorName() string { return "ConnectorDriverValidationError" }
+func (e CreateAPIKeyRequestValidationError) ErrorName() string {
+	return "CreateAPIKeyRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ConnectorDriverValidationError) Error() string {
+func (e CreateAPIKeyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7768,14 +13133,14 @@ func (e ConnectorDriverValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConnectorDriver.%s: %s%s",
+		"invalid %sCreateAPIKeyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConnectorDriverValidationError{}
+var _ error = CreateAPIKeyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7783,121 +13148,201 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConnectorDriverValidationError{}
+} = CreateAPIKeyRequestValidationError{}
 
-// Validate checks the field values on AnalyzedConnector with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AnalyzedConnector) Validate() error {
+var _CreateAPIKeyRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on CreateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AnalyzedConnector with the rules
+// ValidateAll checks the field values on CreateAPIKeyResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AnalyzedConnectorMultiError, or nil if none found.
-func (m *AnalyzedConnector) ValidateAll() error {
+// CreateAPIKeyResponseMultiError, or nil if none found.
+func (m *CreateAPIKeyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AnalyzedConnector) validate(all bool) error {
+func (m *CreateAPIKeyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
 	if all {
-		switch v := interface{}(m.GetDriver()).(type) {
+		switch v := interface{}(m.GetKey()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AnalyzedConnectorValidationError{
-					field:  "Driver",
+				errors = append(errors, CreateAPIKeyResponseValidationError{
+					field:  "Key",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AnalyzedConnectorValidationError{
-					field:  "Driver",
+				errors = append(errors, CreateAPIKeyResponseValidationError{
+					field:  "Key",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDriver()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AnalyzedConnectorValidationError{
-				field:  "Driver",
+			return CreateAPIKeyResponseValidationError{
+				field:  "Key",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Config
+	// no validation rules for Token
 
-	// no validation rules for PresetConfig
+	if len(errors) > 0 {
+		return CreateAPIKeyResponseMultiError(errors)
+	}
 
-	// no validation rules for ProjectConfig
+	return nil
+}
 
-	// no validation rules for EnvConfig
+// CreateAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKeyResponseMultiError []error
 
-	// no validation rules for HasAnonymousAccess
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	for idx, item := range m.GetUsedBy() {
-		_, _ = idx, item
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyResponseValidationError is the validation error returned by
+// CreateAPIKeyResponse.Validate if the designated constraints aren't met.
+type CreateAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyResponseValidationError) ErrorName() string {
+	return "CreateAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyResponseValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKeyRequestMultiError, or nil if none found.
+func (m *RevokeAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AnalyzedConnectorValidationError{
-						field:  fmt.Sprintf("UsedBy[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AnalyzedConnectorValidationError{
-						field:  fmt.Sprintf("UsedBy[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AnalyzedConnectorValidationError{
-					field:  fmt.Sprintf("UsedBy[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	var errors []error
 
+	if !_RevokeAPIKeyRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := RevokeAPIKeyRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for ErrorMessage
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := RevokeAPIKeyRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return AnalyzedConnectorMultiError(errors)
+		return RevokeAPIKeyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AnalyzedConnectorMultiError is an error wrapping multiple validation errors
-// returned by AnalyzedConnector.ValidateAll() if the designated constraints
-// aren't met.
-type AnalyzedConnectorMultiError []error
+// RevokeAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKeyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AnalyzedConnectorMultiError) Error() string {
+func (m RevokeAPIKeyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7906,11 +13351,11 @@ func (m AnalyzedConnectorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AnalyzedConnectorMultiError) AllErrors() []error { return m }
+func (m RevokeAPIKeyRequestMultiError) AllErrors() []error { return m }
 
-// AnalyzedConnectorValidationError is the validation error returned by
-// AnalyzedConnector.Validate if the designated constraints aren't met.
-type AnalyzedConnectorValidationError struct {
+// RevokeAPIKeyRequestValidationError is the validation error returned by
+// RevokeAPIKeyRequest.Validate if the designated constraints aren't met.
+type RevokeAPIKeyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7918,24 +13363,24 @@ type AnalyzedConnectorValidationError struct {
 }
 
 // Field function returns field value.
-func (e AnalyzedConnectorValidationError) Field() string { return e.field }
+func (e RevokeAPIKeyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AnalyzedConnectorValidationError) Reason() string { return e.reason }
+func (e RevokeAPIKeyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AnalyzedConnectorValidationError) Cause() error { return e.cause }
+func (e RevokeAPIKeyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AnalyzedConnectorValidationError) Key() bool { return e.key }
+func (e RevokeAPIKeyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AnalyzedConnectorValidationError) ErrorName() string {
-	return "AnalyzedConnectorValidationError"
+func (e RevokeAPIKeyRequestValidationError) ErrorName() string {
+	return "RevokeAPIKeyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AnalyzedConnectorValidationError) Error() string {
+func (e RevokeAPIKeyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7947,14 +13392,14 @@ func (e AnalyzedConnectorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAnalyzedConnector.%s: %s%s",
+		"invalid %sRevokeAPIKeyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AnalyzedConnectorValidationError{}
+var _ error = RevokeAPIKeyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7962,24 +13407,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AnalyzedConnectorValidationError{}
+} = RevokeAPIKeyRequestValidationError{}
 
-// Validate checks the field values on ListConnectorDriversRequest with the
-// rules defined in the proto definition for this message. If any rules are
+var _RevokeAPIKeyRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on RevokeAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListConnectorDriversRequest) Validate() error {
+func (m *RevokeAPIKeyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListConnectorDriversRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListConnectorDriversRequestMultiError, or nil if none found.
-func (m *ListConnectorDriversRequest) ValidateAll() error {
+// RevokeAPIKeyResponseMultiError, or nil if none found.
+func (m *RevokeAPIKeyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListConnectorDriversRequest) validate(all bool) error {
+func (m *RevokeAPIKeyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7987,19 +13434,19 @@ func (m *ListConnectorDriversRequest) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return ListConnectorDriversRequestMultiError(errors)
+		return RevokeAPIKeyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListConnectorDriversRequestMultiError is an error wrapping multiple
-// validation errors returned by ListConnectorDriversRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListConnectorDriversRequestMultiError []error
+// RevokeAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKeyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListConnectorDriversRequestMultiError) Error() string {
+func (m RevokeAPIKeyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8008,12 +13455,11 @@ func (m ListConnectorDriversRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListConnectorDriversRequestMultiError) AllErrors() []error { return m }
+func (m RevokeAPIKeyResponseMultiError) AllErrors() []error { return m }
 
-// ListConnectorDriversRequestValidationError is the validation error returned
-// by ListConnectorDriversRequest.Validate if the designated constraints
-// aren't met.
-type ListConnectorDriversRequestValidationError struct {
+// RevokeAPIKeyResponseValidationError is the validation error returned by
+// RevokeAPIKeyResponse.Validate if the designated constraints aren't met.
+type RevokeAPIKeyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8021,24 +13467,24 @@ type ListConnectorDriversRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListConnectorDriversRequestValidationError) Field() string { return e.field }
+func (e RevokeAPIKeyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListConnectorDriversRequestValidationError) Reason() string { return e.reason }
+func (e RevokeAPIKeyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListConnectorDriversRequestValidationError) Cause() error { return e.cause }
+func (e RevokeAPIKeyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListConnectorDriversRequestValidationError) Key() bool { return e.key }
+func (e RevokeAPIKeyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListConnectorDriversRequestValidationError) ErrorName() string {
-	return "ListConnectorDriversRequestValidationError"
+func (e RevokeAPIKeyResponseValidationError) ErrorName() string {
+	return "RevokeAPIKeyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListConnectorDriversRequestValidationError) Error() string {
+func (e RevokeAPIKeyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8050,14 +13496,14 @@ func (e ListConnectorDriversRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListConnectorDriversRequest.%s: %s%s",
+		"invalid %sRevokeAPIKeyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListConnectorDriversRequestValidationError{}
+var _ error = RevokeAPIKeyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8065,78 +13511,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListConnectorDriversRequestValidationError{}
+} = RevokeAPIKeyResponseValidationError{}
 
-// Validate checks the field values on ListConnectorDriversResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on FormatExpressionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListConnectorDriversResponse) Validate() error {
+func (m *FormatExpressionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListConnectorDriversResponse with the
+// ValidateAll checks the field values on FormatExpressionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListConnectorDriversResponseMultiError, or nil if none found.
-func (m *ListConnectorDriversResponse) ValidateAll() error {
+// FormatExpressionRequestMultiError, or nil if none found.
+func (m *FormatExpressionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListConnectorDriversResponse) validate(all bool) error {
+func (m *FormatExpressionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConnectors() {
-		_, _ = idx, item
+	if m.GetExpression() == nil {
+		err := FormatExpressionRequestValidationError{
+			field:  "Expression",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListConnectorDriversResponseValidationError{
-						field:  fmt.Sprintf("Connectors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListConnectorDriversResponseValidationError{
-						field:  fmt.Sprintf("Connectors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetExpression()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormatExpressionRequestValidationError{
+					field:  "Expression",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListConnectorDriversResponseValidationError{
-					field:  fmt.Sprintf("Connectors[%v]", idx),
+				errors = append(errors, FormatExpressionRequestValidationError{
+					field:  "Expression",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpression()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormatExpressionRequestValidationError{
+				field:  "Expression",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListConnectorDriversResponseMultiError(errors)
+		return FormatExpressionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListConnectorDriversResponseMultiError is an error wrapping multiple
-// validation errors returned by ListConnectorDriversResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListConnectorDriversResponseMultiError []error
+// FormatExpressionRequestMultiError is an error wrapping multiple validation
+// errors returned by FormatExpressionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FormatExpressionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListConnectorDriversResponseMultiError) Error() string {
+func (m FormatExpressionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8145,12 +13597,11 @@ func (m ListConnectorDriversResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListConnectorDriversResponseMultiError) AllErrors() []error { return m }
+func (m FormatExpressionRequestMultiError) AllErrors() []error { return m }
 
-// ListConnectorDriversResponseValidationError is the validation error returned
-// by ListConnectorDriversResponse.Validate if the designated constraints
-// aren't met.
-type ListConnectorDriversResponseValidationError struct {
+// FormatExpressionRequestValidationError is the validation error returned by
+// FormatExpressionRequest.Validate if the designated constraints aren't met.
+type FormatExpressionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8158,24 +13609,24 @@ type ListConnectorDriversResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListConnectorDriversResponseValidationError) Field() string { return e.field }
+func (e FormatExpressionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListConnectorDriversResponseValidationError) Reason() string { return e.reason }
+func (e FormatExpressionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListConnectorDriversResponseValidationError) Cause() error { return e.cause }
+func (e FormatExpressionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListConnectorDriversResponseValidationError) Key() bool { return e.key }
+func (e FormatExpressionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListConnectorDriversResponseValidationError) ErrorName() string {
-	return "ListConnectorDriversResponseValidationError"
+func (e FormatExpressionRequestValidationError) ErrorName() string {
+	return "FormatExpressionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListConnectorDriversResponseValidationError) Error() string {
+func (e FormatExpressionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8187,14 +13638,14 @@ func (e ListConnectorDriversResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListConnectorDriversResponse.%s: %s%s",
+		"invalid %sFormatExpressionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListConnectorDriversResponseValidationError{}
+var _ error = FormatExpressionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8202,46 +13653,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListConnectorDriversResponseValidationError{}
+} = FormatExpressionRequestValidationError{}
 
-// Validate checks the field values on AnalyzeConnectorsRequest with the rules
+// Validate checks the field values on FormatExpressionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AnalyzeConnectorsRequest) Validate() error {
+func (m *FormatExpressionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AnalyzeConnectorsRequest with the
+// ValidateAll checks the field values on FormatExpressionResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AnalyzeConnectorsRequestMultiError, or nil if none found.
-func (m *AnalyzeConnectorsRequest) ValidateAll() error {
+// FormatExpressionResponseMultiError, or nil if none found.
+func (m *FormatExpressionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AnalyzeConnectorsRequest) validate(all bool) error {
+func (m *FormatExpressionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	// no validation rules for Text
 
 	if len(errors) > 0 {
-		return AnalyzeConnectorsRequestMultiError(errors)
+		return FormatExpressionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AnalyzeConnectorsRequestMultiError is an error wrapping multiple validation
-// errors returned by AnalyzeConnectorsRequest.ValidateAll() if the designated
+// FormatExpressionResponseMultiError is an error wrapping multiple validation
+// errors returned by FormatExpressionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type AnalyzeConnectorsRequestMultiError []error
+type FormatExpressionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AnalyzeConnectorsRequestMultiError) Error() string {
+func (m FormatExpressionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8250,11 +13701,11 @@ func (m AnalyzeConnectorsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AnalyzeConnectorsRequestMultiError) AllErrors() []error { return m }
+func (m FormatExpressionResponseMultiError) AllErrors() []error { return m }
 
-// AnalyzeConnectorsRequestValidationError is the validation error returned by
-// AnalyzeConnectorsRequest.Validate if the designated constraints aren't met.
-type AnalyzeConnectorsRequestValidationError struct {
+// FormatExpressionResponseValidationError is the validation error returned by
+// FormatExpressionResponse.Validate if the designated constraints aren't met.
+type FormatExpressionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8262,24 +13713,24 @@ type AnalyzeConnectorsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AnalyzeConnectorsRequestValidationError) Field() string { return e.field }
+func (e FormatExpressionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AnalyzeConnectorsRequestValidationError) Reason() string { return e.reason }
+func (e FormatExpressionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AnalyzeConnectorsRequestValidationError) Cause() error { return e.cause }
+func (e FormatExpressionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AnalyzeConnectorsRequestValidationError) Key() bool { return e.key }
+func (e FormatExpressionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AnalyzeConnectorsRequestValidationError) ErrorName() string {
-	return "AnalyzeConnectorsRequestValidationError"
+func (e FormatExpressionResponseValidationError) ErrorName() string {
+	return "FormatExpressionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AnalyzeConnectorsRequestValidationError) Error() string {
+func (e FormatExpressionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8291,14 +13742,14 @@ func (e AnalyzeConnectorsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAnalyzeConnectorsRequest.%s: %s%s",
+		"invalid %sFormatExpressionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AnalyzeConnectorsRequestValidationError{}
+var _ error = FormatExpressionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8306,78 +13757,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AnalyzeConnectorsRequestValidationError{}
+} = FormatExpressionResponseValidationError{}
 
-// Validate checks the field values on AnalyzeConnectorsResponse with the rules
+// Validate checks the field values on ParseExpressionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AnalyzeConnectorsResponse) Validate() error {
+func (m *ParseExpressionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AnalyzeConnectorsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ParseExpressionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AnalyzeConnectorsResponseMultiError, or nil if none found.
-func (m *AnalyzeConnectorsResponse) ValidateAll() error {
+// ParseExpressionRequestMultiError, or nil if none found.
+func (m *ParseExpressionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AnalyzeConnectorsResponse) validate(all bool) error {
+func (m *ParseExpressionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConnectors() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AnalyzeConnectorsResponseValidationError{
-						field:  fmt.Sprintf("Connectors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AnalyzeConnectorsResponseValidationError{
-						field:  fmt.Sprintf("Connectors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AnalyzeConnectorsResponseValidationError{
-					field:  fmt.Sprintf("Connectors[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetText()) < 1 {
+		err := ParseExpressionRequestValidationError{
+			field:  "Text",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return AnalyzeConnectorsResponseMultiError(errors)
+		return ParseExpressionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AnalyzeConnectorsResponseMultiError is an error wrapping multiple validation
-// errors returned by AnalyzeConnectorsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type AnalyzeConnectorsResponseMultiError []error
+// ParseExpressionRequestMultiError is an error wrapping multiple validation
+// errors returned by ParseExpressionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ParseExpressionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AnalyzeConnectorsResponseMultiError) Error() string {
+func (m ParseExpressionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8386,11 +13814,11 @@ func (m AnalyzeConnectorsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AnalyzeConnectorsResponseMultiError) AllErrors() []error { return m }
+func (m ParseExpressionRequestMultiError) AllErrors() []error { return m }
 
-// AnalyzeConnectorsResponseValidationError is the validation error returned by
-// AnalyzeConnectorsResponse.Validate if the designated constraints aren't met.
-type AnalyzeConnectorsResponseValidationError struct {
+// ParseExpressionRequestValidationError is the validation error returned by
+// ParseExpressionRequest.Validate if the designated constraints aren't met.
+type ParseExpressionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8398,24 +13826,24 @@ type AnalyzeConnectorsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AnalyzeConnectorsResponseValidationError) Field() string { return e.field }
+func (e ParseExpressionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AnalyzeConnectorsResponseValidationError) Reason() string { return e.reason }
+func (e ParseExpressionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AnalyzeConnectorsResponseValidationError) Cause() error { return e.cause }
+func (e ParseExpressionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AnalyzeConnectorsResponseValidationError) Key() bool { return e.key }
+func (e ParseExpressionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AnalyzeConnectorsResponseValidationError) ErrorName() string {
-	return "AnalyzeConnectorsResponseValidationError"
+func (e ParseExpressionRequestValidationError) ErrorName() string {
+	return "ParseExpressionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AnalyzeConnectorsResponseValidationError) Error() string {
+func (e ParseExpressionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8427,14 +13855,14 @@ func (e AnalyzeConnectorsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAnalyzeConnectorsResponse.%s: %s%s",
+		"invalid %sParseExpressionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AnalyzeConnectorsResponseValidationError{}
+var _ error = ParseExpressionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8442,46 +13870,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AnalyzeConnectorsResponseValidationError{}
+} = ParseExpressionRequestValidationError{}
 
-// Validate checks the field values on ListNotifierConnectorsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ParseExpressionResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListNotifierConnectorsRequest) Validate() error {
+func (m *ParseExpressionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListNotifierConnectorsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListNotifierConnectorsRequestMultiError, or nil if none found.
-func (m *ListNotifierConnectorsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ParseExpressionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ParseExpressionResponseMultiError, or nil if none found.
+func (m *ParseExpressionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListNotifierConnectorsRequest) validate(all bool) error {
+func (m *ParseExpressionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	if all {
+		switch v := interface{}(m.GetExpression()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ParseExpressionResponseValidationError{
+					field:  "Expression",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ParseExpressionResponseValidationError{
+					field:  "Expression",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpression()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ParseExpressionResponseValidationError{
+				field:  "Expression",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListNotifierConnectorsRequestMultiError(errors)
+		return ParseExpressionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListNotifierConnectorsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListNotifierConnectorsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListNotifierConnectorsRequestMultiError []error
+// ParseExpressionResponseMultiError is an error wrapping multiple validation
+// errors returned by ParseExpressionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ParseExpressionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListNotifierConnectorsRequestMultiError) Error() string {
+func (m ParseExpressionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8490,12 +13945,11 @@ func (m ListNotifierConnectorsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListNotifierConnectorsRequestMultiError) AllErrors() []error { return m }
+func (m ParseExpressionResponseMultiError) AllErrors() []error { return m }
 
-// ListNotifierConnectorsRequestValidationError is the validation error
-// returned by ListNotifierConnectorsRequest.Validate if the designated
-// constraints aren't met.
-type ListNotifierConnectorsRequestValidationError struct {
+// ParseExpressionResponseValidationError is the validation error returned by
+// ParseExpressionResponse.Validate if the designated constraints aren't met.
+type ParseExpressionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8503,24 +13957,24 @@ type ListNotifierConnectorsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListNotifierConnectorsRequestValidationError) Field() string { return e.field }
+func (e ParseExpressionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListNotifierConnectorsRequestValidationError) Reason() string { return e.reason }
+func (e ParseExpressionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListNotifierConnectorsRequestValidationError) Cause() error { return e.cause }
+func (e ParseExpressionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListNotifierConnectorsRequestValidationError) Key() bool { return e.key }
+func (e ParseExpressionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListNotifierConnectorsRequestValidationError) ErrorName() string {
-	return "ListNotifierConnectorsRequestValidationError"
+func (e ParseExpressionResponseValidationError) ErrorName() string {
+	return "ParseExpressionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListNotifierConnectorsRequestValidationError) Error() string {
+func (e ParseExpressionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8532,14 +13986,14 @@ func (e ListNotifierConnectorsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListNotifierConnectorsRequest.%s: %s%s",
+		"invalid %sParseExpressionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListNotifierConnectorsRequestValidationError{}
+var _ error = ParseExpressionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8547,78 +14001,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListNotifierConnectorsRequestValidationError{}
+} = ParseExpressionResponseValidationError{}
 
-// Validate checks the field values on ListNotifierConnectorsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListNotifierConnectorsResponse) Validate() error {
+// Validate checks the field values on LintSQLRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LintSQLRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListNotifierConnectorsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListNotifierConnectorsResponseMultiError, or nil if none found.
-func (m *ListNotifierConnectorsResponse) ValidateAll() error {
+// ValidateAll checks the field values on LintSQLRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LintSQLRequestMultiError,
+// or nil if none found.
+func (m *LintSQLRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListNotifierConnectorsResponse) validate(all bool) error {
+func (m *LintSQLRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConnectors() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListNotifierConnectorsResponseValidationError{
-						field:  fmt.Sprintf("Connectors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListNotifierConnectorsResponseValidationError{
-						field:  fmt.Sprintf("Connectors[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListNotifierConnectorsResponseValidationError{
-					field:  fmt.Sprintf("Connectors[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if !_LintSQLRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := LintSQLRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Sql
+
+	// no validation rules for Connector
+
+	// no validation rules for Format
+
 	if len(errors) > 0 {
-		return ListNotifierConnectorsResponseMultiError(errors)
+		return LintSQLRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListNotifierConnectorsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListNotifierConnectorsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListNotifierConnectorsResponseMultiError []error
+// LintSQLRequestMultiError is an error wrapping multiple validation errors
+// returned by LintSQLRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LintSQLRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListNotifierConnectorsResponseMultiError) Error() string {
+func (m LintSQLRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8627,12 +14064,11 @@ func (m ListNotifierConnectorsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListNotifierConnectorsResponseMultiError) AllErrors() []error { return m }
+func (m LintSQLRequestMultiError) AllErrors() []error { return m }
 
-// ListNotifierConnectorsResponseValidationError is the validation error
-// returned by ListNotifierConnectorsResponse.Validate if the designated
-// constraints aren't met.
-type ListNotifierConnectorsResponseValidationError struct {
+// LintSQLRequestValidationError is the validation error returned by
+// LintSQLRequest.Validate if the designated constraints aren't met.
+type LintSQLRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8640,24 +14076,22 @@ type ListNotifierConnectorsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListNotifierConnectorsResponseValidationError) Field() string { return e.field }
+func (e LintSQLRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListNotifierConnectorsResponseValidationError) Reason() string { return e.reason }
+func (e LintSQLRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListNotifierConnectorsResponseValidationError) Cause() error { return e.cause }
+func (e LintSQLRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListNotifierConnectorsResponseValidationError) Key() bool { return e.key }
+func (e LintSQLRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListNotifierConnectorsResponseValidationError) ErrorName() string {
-	return "ListNotifierConnectorsResponseValidationError"
-}
+func (e LintSQLRequestValidationError) ErrorName() string { return "LintSQLRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListNotifierConnectorsResponseValidationError) Error() string {
+func (e LintSQLRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8669,14 +14103,14 @@ func (e ListNotifierConnectorsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListNotifierConnectorsResponse.%s: %s%s",
+		"invalid %sLintSQLRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListNotifierConnectorsResponseValidationError{}
+var _ error = LintSQLRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8684,68 +14118,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListNotifierConnectorsResponseValidationError{}
+} = LintSQLRequestValidationError{}
 
-// Validate checks the field values on IssueDevJWTRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueDevJWTRequest) Validate() error {
+var _LintSQLRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on LintSQLResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LintSQLResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueDevJWTRequest with the rules
+// ValidateAll checks the field values on LintSQLResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IssueDevJWTRequestMultiError, or nil if none found.
-func (m *IssueDevJWTRequest) ValidateAll() error {
+// LintSQLResponseMultiError, or nil if none found.
+func (m *LintSQLResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueDevJWTRequest) validate(all bool) error {
+func (m *LintSQLResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := IssueDevJWTRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetDiagnostics() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetEmail()) < 1 {
-		err := IssueDevJWTRequestValidationError{
-			field:  "Email",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LintSQLResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LintSQLResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LintSQLResponseValidationError{
+					field:  fmt.Sprintf("Diagnostics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
-	// no validation rules for Admin
+	// no validation rules for FormattedSql
+
+	// no validation rules for Dialect
 
 	if len(errors) > 0 {
-		return IssueDevJWTRequestMultiError(errors)
+		return LintSQLResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueDevJWTRequestMultiError is an error wrapping multiple validation errors
-// returned by IssueDevJWTRequest.ValidateAll() if the designated constraints
+// LintSQLResponseMultiError is an error wrapping multiple validation errors
+// returned by LintSQLResponse.ValidateAll() if the designated constraints
 // aren't met.
-type IssueDevJWTRequestMultiError []error
+type LintSQLResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueDevJWTRequestMultiError) Error() string {
+func (m LintSQLResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8754,11 +14204,11 @@ func (m IssueDevJWTRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueDevJWTRequestMultiError) AllErrors() []error { return m }
+func (m LintSQLResponseMultiError) AllErrors() []error { return m }
 
-// IssueDevJWTRequestValidationError is the validation error returned by
-// IssueDevJWTRequest.Validate if the designated constraints aren't met.
-type IssueDevJWTRequestValidationError struct {
+// LintSQLResponseValidationError is the validation error returned by
+// LintSQLResponse.Validate if the designated constraints aren't met.
+type LintSQLResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8766,24 +14216,22 @@ type IssueDevJWTRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueDevJWTRequestValidationError) Field() string { return e.field }
+func (e LintSQLResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueDevJWTRequestValidationError) Reason() string { return e.reason }
+func (e LintSQLResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueDevJWTRequestValidationError) Cause() error { return e.cause }
+func (e LintSQLResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueDevJWTRequestValidationError) Key() bool { return e.key }
+func (e LintSQLResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueDevJWTRequestValidationError) ErrorName() string {
-	return "IssueDevJWTRequestValidationError"
-}
+func (e LintSQLResponseValidationError) ErrorName() string { return "LintSQLResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e IssueDevJWTRequestValidationError) Error() string {
+func (e LintSQLResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8795,14 +14243,14 @@ func (e IssueDevJWTRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueDevJWTRequest.%s: %s%s",
+		"invalid %sLintSQLResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueDevJWTRequestValidationError{}
+var _ error = LintSQLResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8810,46 +14258,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueDevJWTRequestValidationError{}
+} = LintSQLResponseValidationError{}
 
-// Validate checks the field values on IssueDevJWTResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueDevJWTResponse) Validate() error {
+// Validate checks the field values on SQLDiagnostic with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SQLDiagnostic) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueDevJWTResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// IssueDevJWTResponseMultiError, or nil if none found.
-func (m *IssueDevJWTResponse) ValidateAll() error {
+// ValidateAll checks the field values on SQLDiagnostic with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SQLDiagnosticMultiError, or
+// nil if none found.
+func (m *SQLDiagnostic) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueDevJWTResponse) validate(all bool) error {
+func (m *SQLDiagnostic) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Jwt
+	// no validation rules for Rule
+
+	// no validation rules for Severity
+
+	// no validation rules for Message
+
+	// no validation rules for Line
+
+	// no validation rules for Column
 
 	if len(errors) > 0 {
-		return IssueDevJWTResponseMultiError(errors)
+		return SQLDiagnosticMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueDevJWTResponseMultiError is an error wrapping multiple validation
-// errors returned by IssueDevJWTResponse.ValidateAll() if the designated
-// constraints aren't met.
-type IssueDevJWTResponseMultiError []error
+// SQLDiagnosticMultiError is an error wrapping multiple validation errors
+// returned by SQLDiagnostic.ValidateAll() if the designated constraints
+// aren't met.
+type SQLDiagnosticMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueDevJWTResponseMultiError) Error() string {
+func (m SQLDiagnosticMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8858,11 +14314,11 @@ func (m IssueDevJWTResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueDevJWTResponseMultiError) AllErrors() []error { return m }
+func (m SQLDiagnosticMultiError) AllErrors() []error { return m }
 
-// IssueDevJWTResponseValidationError is the validation error returned by
-// IssueDevJWTResponse.Validate if the designated constraints aren't met.
-type IssueDevJWTResponseValidationError struct {
+// SQLDiagnosticValidationError is the validation error returned by
+// SQLDiagnostic.Validate if the designated constraints aren't met.
+type SQLDiagnosticValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8870,24 +14326,22 @@ type IssueDevJWTResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueDevJWTResponseValidationError) Field() string { return e.field }
+func (e SQLDiagnosticValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueDevJWTResponseValidationError) Reason() string { return e.reason }
+func (e SQLDiagnosticValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueDevJWTResponseValidationError) Cause() error { return e.cause }
+func (e SQLDiagnosticValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueDevJWTResponseValidationError) Key() bool { return e.key }
+func (e SQLDiagnosticValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueDevJWTResponseValidationError) ErrorName() string {
-	return "IssueDevJWTResponseValidationError"
-}
+func (e SQLDiagnosticValidationError) ErrorName() string { return "SQLDiagnosticValidationError" }
 
 // Error satisfies the builtin error interface
-func (e IssueDevJWTResponseValidationError) Error() string {
+func (e SQLDiagnosticValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8899,14 +14353,14 @@ func (e IssueDevJWTResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueDevJWTResponse.%s: %s%s",
+		"invalid %sSQLDiagnostic.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueDevJWTResponseValidationError{}
+var _ error = SQLDiagnosticValidationError{}
 
 var _ interface {
 	Field() string
@@ -8914,7 +14368,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueDevJWTResponseValidationError{}
+} = SQLDiagnosticValidationError{}
 
 // Validate checks the field values on ConnectorDriver_Property with the rules
 // defined in the proto definition for this message. If any rules are
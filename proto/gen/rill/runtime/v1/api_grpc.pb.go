@@ -27,6 +27,10 @@ const (
 	RuntimeService_CreateInstance_FullMethodName          = "/rill.runtime.v1.RuntimeService/CreateInstance"
 	RuntimeService_EditInstance_FullMethodName            = "/rill.runtime.v1.RuntimeService/EditInstance"
 	RuntimeService_DeleteInstance_FullMethodName          = "/rill.runtime.v1.RuntimeService/DeleteInstance"
+	RuntimeService_ListBackups_FullMethodName             = "/rill.runtime.v1.RuntimeService/ListBackups"
+	RuntimeService_CreateBackup_FullMethodName            = "/rill.runtime.v1.RuntimeService/CreateBackup"
+	RuntimeService_RestoreBackup_FullMethodName           = "/rill.runtime.v1.RuntimeService/RestoreBackup"
+	RuntimeService_ResumeInstance_FullMethodName          = "/rill.runtime.v1.RuntimeService/ResumeInstance"
 	RuntimeService_ListFiles_FullMethodName               = "/rill.runtime.v1.RuntimeService/ListFiles"
 	RuntimeService_WatchFiles_FullMethodName              = "/rill.runtime.v1.RuntimeService/WatchFiles"
 	RuntimeService_GetFile_FullMethodName                 = "/rill.runtime.v1.RuntimeService/GetFile"
@@ -42,14 +46,27 @@ const (
 	RuntimeService_GenerateRenderer_FullMethodName        = "/rill.runtime.v1.RuntimeService/GenerateRenderer"
 	RuntimeService_GetLogs_FullMethodName                 = "/rill.runtime.v1.RuntimeService/GetLogs"
 	RuntimeService_WatchLogs_FullMethodName               = "/rill.runtime.v1.RuntimeService/WatchLogs"
+	RuntimeService_ListSlowQueries_FullMethodName         = "/rill.runtime.v1.RuntimeService/ListSlowQueries"
+	RuntimeService_ListRunningQueries_FullMethodName      = "/rill.runtime.v1.RuntimeService/ListRunningQueries"
+	RuntimeService_CancelQuery_FullMethodName             = "/rill.runtime.v1.RuntimeService/CancelQuery"
+	RuntimeService_GetQueryCacheStats_FullMethodName      = "/rill.runtime.v1.RuntimeService/GetQueryCacheStats"
+	RuntimeService_PurgeQueryCache_FullMethodName         = "/rill.runtime.v1.RuntimeService/PurgeQueryCache"
 	RuntimeService_ListResources_FullMethodName           = "/rill.runtime.v1.RuntimeService/ListResources"
 	RuntimeService_WatchResources_FullMethodName          = "/rill.runtime.v1.RuntimeService/WatchResources"
 	RuntimeService_GetResource_FullMethodName             = "/rill.runtime.v1.RuntimeService/GetResource"
+	RuntimeService_ListMetricsViewVersions_FullMethodName = "/rill.runtime.v1.RuntimeService/ListMetricsViewVersions"
+	RuntimeService_GetDataDiff_FullMethodName             = "/rill.runtime.v1.RuntimeService/GetDataDiff"
 	RuntimeService_CreateTrigger_FullMethodName           = "/rill.runtime.v1.RuntimeService/CreateTrigger"
 	RuntimeService_ListConnectorDrivers_FullMethodName    = "/rill.runtime.v1.RuntimeService/ListConnectorDrivers"
 	RuntimeService_AnalyzeConnectors_FullMethodName       = "/rill.runtime.v1.RuntimeService/AnalyzeConnectors"
 	RuntimeService_ListNotifierConnectors_FullMethodName  = "/rill.runtime.v1.RuntimeService/ListNotifierConnectors"
 	RuntimeService_IssueDevJWT_FullMethodName             = "/rill.runtime.v1.RuntimeService/IssueDevJWT"
+	RuntimeService_ListAPIKeys_FullMethodName             = "/rill.runtime.v1.RuntimeService/ListAPIKeys"
+	RuntimeService_CreateAPIKey_FullMethodName            = "/rill.runtime.v1.RuntimeService/CreateAPIKey"
+	RuntimeService_RevokeAPIKey_FullMethodName            = "/rill.runtime.v1.RuntimeService/RevokeAPIKey"
+	RuntimeService_FormatExpression_FullMethodName        = "/rill.runtime.v1.RuntimeService/FormatExpression"
+	RuntimeService_ParseExpression_FullMethodName         = "/rill.runtime.v1.RuntimeService/ParseExpression"
+	RuntimeService_LintSQL_FullMethodName                 = "/rill.runtime.v1.RuntimeService/LintSQL"
 )
 
 // RuntimeServiceClient is the client API for RuntimeService service.
@@ -75,6 +92,14 @@ type RuntimeServiceClient interface {
 	EditInstance(ctx context.Context, in *EditInstanceRequest, opts ...grpc.CallOption) (*EditInstanceResponse, error)
 	// DeleteInstance deletes an instance
 	DeleteInstance(ctx context.Context, in *DeleteInstanceRequest, opts ...grpc.CallOption) (*DeleteInstanceResponse, error)
+	// ListBackups lists the available backups of an instance's OLAP data
+	ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error)
+	// CreateBackup backs up the tables of an instance's sources and models to object storage
+	CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*CreateBackupResponse, error)
+	// RestoreBackup recreates the tables contained in a backup in an instance's OLAP connector
+	RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error)
+	// ResumeInstance resumes reconciliation of an instance that was paused by creating a snapshot with CreateBackup
+	ResumeInstance(ctx context.Context, in *ResumeInstanceRequest, opts ...grpc.CallOption) (*ResumeInstanceResponse, error)
 	// ListFiles lists all the files matching a glob in a repo.
 	// The files are sorted by their full path.
 	ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error)
@@ -106,12 +131,29 @@ type RuntimeServiceClient interface {
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
 	// WatchLogs streams new logs emitted from a controller
 	WatchLogs(ctx context.Context, in *WatchLogsRequest, opts ...grpc.CallOption) (RuntimeService_WatchLogsClient, error)
+	// ListSlowQueries returns the slowest query fingerprints recorded in the instance's slow query log
+	ListSlowQueries(ctx context.Context, in *ListSlowQueriesRequest, opts ...grpc.CallOption) (*ListSlowQueriesResponse, error)
+	// ListRunningQueries lists the OLAP queries that are currently running for an instance
+	ListRunningQueries(ctx context.Context, in *ListRunningQueriesRequest, opts ...grpc.CallOption) (*ListRunningQueriesResponse, error)
+	// CancelQuery cancels a running OLAP query
+	CancelQuery(ctx context.Context, in *CancelQueryRequest, opts ...grpc.CallOption) (*CancelQueryResponse, error)
+	// GetQueryCacheStats returns usage stats for the instance's cached query results
+	GetQueryCacheStats(ctx context.Context, in *GetQueryCacheStatsRequest, opts ...grpc.CallOption) (*GetQueryCacheStatsResponse, error)
+	// PurgeQueryCache removes cached query results for an instance or one of its metrics views
+	PurgeQueryCache(ctx context.Context, in *PurgeQueryCacheRequest, opts ...grpc.CallOption) (*PurgeQueryCacheResponse, error)
 	// ListResources lists the resources stored in the catalog
 	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
 	// WatchResources streams updates to catalog resources (including creation and deletion events)
 	WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (RuntimeService_WatchResourcesClient, error)
 	// GetResource looks up a specific catalog resource
 	GetResource(ctx context.Context, in *GetResourceRequest, opts ...grpc.CallOption) (*GetResourceResponse, error)
+	// ListMetricsViewVersions lists the data versions recorded for a metrics view, most recent first.
+	// A new version is recorded each time the source or model underlying the metrics view is refreshed.
+	ListMetricsViewVersions(ctx context.Context, in *ListMetricsViewVersionsRequest, opts ...grpc.CallOption) (*ListMetricsViewVersionsResponse, error)
+	// GetDataDiff compares the output of a model or metrics view in an instance against the same resource in a base instance.
+	// It can be used to review the changes in a preview deployment against prod.
+	// To compare against a previous snapshot, restore the snapshot to a separate instance (see CreateInstanceRequest.restore_backup_id) and use it as the base.
+	GetDataDiff(ctx context.Context, in *GetDataDiffRequest, opts ...grpc.CallOption) (*GetDataDiffResponse, error)
 	// CreateTrigger creates a trigger in the catalog.
 	// Triggers are ephemeral resources that will be cleaned up by the controller.
 	CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*CreateTriggerResponse, error)
@@ -125,6 +167,20 @@ type RuntimeServiceClient interface {
 	ListNotifierConnectors(ctx context.Context, in *ListNotifierConnectorsRequest, opts ...grpc.CallOption) (*ListNotifierConnectorsResponse, error)
 	// IssueDevJWT issues a JWT for mimicking a user in local development.
 	IssueDevJWT(ctx context.Context, in *IssueDevJWTRequest, opts ...grpc.CallOption) (*IssueDevJWTResponse, error)
+	// ListAPIKeys lists the API keys for an instance. The secret part of the keys is never returned.
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// CreateAPIKey creates an API key scoped to an instance.
+	// API keys are native to the runtime and can be used by self-hosted deployments that don't run the admin service.
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// RevokeAPIKey deletes an API key.
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	// FormatExpression converts a filter expression to a compact, human-readable string that can be embedded in links.
+	FormatExpression(ctx context.Context, in *FormatExpressionRequest, opts ...grpc.CallOption) (*FormatExpressionResponse, error)
+	// ParseExpression converts a string produced by FormatExpression back to a filter expression.
+	ParseExpression(ctx context.Context, in *ParseExpressionRequest, opts ...grpc.CallOption) (*ParseExpressionResponse, error)
+	// LintSQL checks SQL for common mistakes (such as SELECT *, unused CTEs and joins without a predicate) and optionally formats it.
+	// The SQL is analyzed for the dialect of the OLAP connector it runs against.
+	LintSQL(ctx context.Context, in *LintSQLRequest, opts ...grpc.CallOption) (*LintSQLResponse, error)
 }
 
 type runtimeServiceClient struct {
@@ -215,6 +271,46 @@ func (c *runtimeServiceClient) DeleteInstance(ctx context.Context, in *DeleteIns
 	return out, nil
 }
 
+func (c *runtimeServiceClient) ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBackupsResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ListBackups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*CreateBackupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBackupResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_CreateBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreBackupResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_RestoreBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ResumeInstance(ctx context.Context, in *ResumeInstanceRequest, opts ...grpc.CallOption) (*ResumeInstanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeInstanceResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ResumeInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListFilesResponse)
@@ -411,6 +507,56 @@ func (x *runtimeServiceWatchLogsClient) Recv() (*WatchLogsResponse, error) {
 	return m, nil
 }
 
+func (c *runtimeServiceClient) ListSlowQueries(ctx context.Context, in *ListSlowQueriesRequest, opts ...grpc.CallOption) (*ListSlowQueriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSlowQueriesResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ListSlowQueries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ListRunningQueries(ctx context.Context, in *ListRunningQueriesRequest, opts ...grpc.CallOption) (*ListRunningQueriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRunningQueriesResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ListRunningQueries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) CancelQuery(ctx context.Context, in *CancelQueryRequest, opts ...grpc.CallOption) (*CancelQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelQueryResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_CancelQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) GetQueryCacheStats(ctx context.Context, in *GetQueryCacheStatsRequest, opts ...grpc.CallOption) (*GetQueryCacheStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueryCacheStatsResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_GetQueryCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) PurgeQueryCache(ctx context.Context, in *PurgeQueryCacheRequest, opts ...grpc.CallOption) (*PurgeQueryCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeQueryCacheResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_PurgeQueryCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListResourcesResponse)
@@ -464,6 +610,26 @@ func (c *runtimeServiceClient) GetResource(ctx context.Context, in *GetResourceR
 	return out, nil
 }
 
+func (c *runtimeServiceClient) ListMetricsViewVersions(ctx context.Context, in *ListMetricsViewVersionsRequest, opts ...grpc.CallOption) (*ListMetricsViewVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMetricsViewVersionsResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ListMetricsViewVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) GetDataDiff(ctx context.Context, in *GetDataDiffRequest, opts ...grpc.CallOption) (*GetDataDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataDiffResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_GetDataDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*CreateTriggerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTriggerResponse)
@@ -514,6 +680,66 @@ func (c *runtimeServiceClient) IssueDevJWT(ctx context.Context, in *IssueDevJWTR
 	return out, nil
 }
 
+func (c *runtimeServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) FormatExpression(ctx context.Context, in *FormatExpressionRequest, opts ...grpc.CallOption) (*FormatExpressionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FormatExpressionResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_FormatExpression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ParseExpression(ctx context.Context, in *ParseExpressionRequest, opts ...grpc.CallOption) (*ParseExpressionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseExpressionResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ParseExpression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) LintSQL(ctx context.Context, in *LintSQLRequest, opts ...grpc.CallOption) (*LintSQLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LintSQLResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_LintSQL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RuntimeServiceServer is the server API for RuntimeService service.
 // All implementations must embed UnimplementedRuntimeServiceServer
 // for forward compatibility
@@ -537,6 +763,14 @@ type RuntimeServiceServer interface {
 	EditInstance(context.Context, *EditInstanceRequest) (*EditInstanceResponse, error)
 	// DeleteInstance deletes an instance
 	DeleteInstance(context.Context, *DeleteInstanceRequest) (*DeleteInstanceResponse, error)
+	// ListBackups lists the available backups of an instance's OLAP data
+	ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error)
+	// CreateBackup backs up the tables of an instance's sources and models to object storage
+	CreateBackup(context.Context, *CreateBackupRequest) (*CreateBackupResponse, error)
+	// RestoreBackup recreates the tables contained in a backup in an instance's OLAP connector
+	RestoreBackup(context.Context, *RestoreBackupRequest) (*RestoreBackupResponse, error)
+	// ResumeInstance resumes reconciliation of an instance that was paused by creating a snapshot with CreateBackup
+	ResumeInstance(context.Context, *ResumeInstanceRequest) (*ResumeInstanceResponse, error)
 	// ListFiles lists all the files matching a glob in a repo.
 	// The files are sorted by their full path.
 	ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error)
@@ -568,12 +802,29 @@ type RuntimeServiceServer interface {
 	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
 	// WatchLogs streams new logs emitted from a controller
 	WatchLogs(*WatchLogsRequest, RuntimeService_WatchLogsServer) error
+	// ListSlowQueries returns the slowest query fingerprints recorded in the instance's slow query log
+	ListSlowQueries(context.Context, *ListSlowQueriesRequest) (*ListSlowQueriesResponse, error)
+	// ListRunningQueries lists the OLAP queries that are currently running for an instance
+	ListRunningQueries(context.Context, *ListRunningQueriesRequest) (*ListRunningQueriesResponse, error)
+	// CancelQuery cancels a running OLAP query
+	CancelQuery(context.Context, *CancelQueryRequest) (*CancelQueryResponse, error)
+	// GetQueryCacheStats returns usage stats for the instance's cached query results
+	GetQueryCacheStats(context.Context, *GetQueryCacheStatsRequest) (*GetQueryCacheStatsResponse, error)
+	// PurgeQueryCache removes cached query results for an instance or one of its metrics views
+	PurgeQueryCache(context.Context, *PurgeQueryCacheRequest) (*PurgeQueryCacheResponse, error)
 	// ListResources lists the resources stored in the catalog
 	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
 	// WatchResources streams updates to catalog resources (including creation and deletion events)
 	WatchResources(*WatchResourcesRequest, RuntimeService_WatchResourcesServer) error
 	// GetResource looks up a specific catalog resource
 	GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error)
+	// ListMetricsViewVersions lists the data versions recorded for a metrics view, most recent first.
+	// A new version is recorded each time the source or model underlying the metrics view is refreshed.
+	ListMetricsViewVersions(context.Context, *ListMetricsViewVersionsRequest) (*ListMetricsViewVersionsResponse, error)
+	// GetDataDiff compares the output of a model or metrics view in an instance against the same resource in a base instance.
+	// It can be used to review the changes in a preview deployment against prod.
+	// To compare against a previous snapshot, restore the snapshot to a separate instance (see CreateInstanceRequest.restore_backup_id) and use it as the base.
+	GetDataDiff(context.Context, *GetDataDiffRequest) (*GetDataDiffResponse, error)
 	// CreateTrigger creates a trigger in the catalog.
 	// Triggers are ephemeral resources that will be cleaned up by the controller.
 	CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error)
@@ -587,6 +838,20 @@ type RuntimeServiceServer interface {
 	ListNotifierConnectors(context.Context, *ListNotifierConnectorsRequest) (*ListNotifierConnectorsResponse, error)
 	// IssueDevJWT issues a JWT for mimicking a user in local development.
 	IssueDevJWT(context.Context, *IssueDevJWTRequest) (*IssueDevJWTResponse, error)
+	// ListAPIKeys lists the API keys for an instance. The secret part of the keys is never returned.
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// CreateAPIKey creates an API key scoped to an instance.
+	// API keys are native to the runtime and can be used by self-hosted deployments that don't run the admin service.
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// RevokeAPIKey deletes an API key.
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	// FormatExpression converts a filter expression to a compact, human-readable string that can be embedded in links.
+	FormatExpression(context.Context, *FormatExpressionRequest) (*FormatExpressionResponse, error)
+	// ParseExpression converts a string produced by FormatExpression back to a filter expression.
+	ParseExpression(context.Context, *ParseExpressionRequest) (*ParseExpressionResponse, error)
+	// LintSQL checks SQL for common mistakes (such as SELECT *, unused CTEs and joins without a predicate) and optionally formats it.
+	// The SQL is analyzed for the dialect of the OLAP connector it runs against.
+	LintSQL(context.Context, *LintSQLRequest) (*LintSQLResponse, error)
 	mustEmbedUnimplementedRuntimeServiceServer()
 }
 
@@ -618,6 +883,18 @@ func (UnimplementedRuntimeServiceServer) EditInstance(context.Context, *EditInst
 func (UnimplementedRuntimeServiceServer) DeleteInstance(context.Context, *DeleteInstanceRequest) (*DeleteInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstance not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (UnimplementedRuntimeServiceServer) CreateBackup(context.Context, *CreateBackupRequest) (*CreateBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBackup not implemented")
+}
+func (UnimplementedRuntimeServiceServer) RestoreBackup(context.Context, *RestoreBackupRequest) (*RestoreBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBackup not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ResumeInstance(context.Context, *ResumeInstanceRequest) (*ResumeInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeInstance not implemented")
+}
 func (UnimplementedRuntimeServiceServer) ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFiles not implemented")
 }
@@ -663,6 +940,21 @@ func (UnimplementedRuntimeServiceServer) GetLogs(context.Context, *GetLogsReques
 func (UnimplementedRuntimeServiceServer) WatchLogs(*WatchLogsRequest, RuntimeService_WatchLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchLogs not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ListSlowQueries(context.Context, *ListSlowQueriesRequest) (*ListSlowQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlowQueries not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ListRunningQueries(context.Context, *ListRunningQueriesRequest) (*ListRunningQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRunningQueries not implemented")
+}
+func (UnimplementedRuntimeServiceServer) CancelQuery(context.Context, *CancelQueryRequest) (*CancelQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQuery not implemented")
+}
+func (UnimplementedRuntimeServiceServer) GetQueryCacheStats(context.Context, *GetQueryCacheStatsRequest) (*GetQueryCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryCacheStats not implemented")
+}
+func (UnimplementedRuntimeServiceServer) PurgeQueryCache(context.Context, *PurgeQueryCacheRequest) (*PurgeQueryCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeQueryCache not implemented")
+}
 func (UnimplementedRuntimeServiceServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
 }
@@ -672,6 +964,12 @@ func (UnimplementedRuntimeServiceServer) WatchResources(*WatchResourcesRequest,
 func (UnimplementedRuntimeServiceServer) GetResource(context.Context, *GetResourceRequest) (*GetResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ListMetricsViewVersions(context.Context, *ListMetricsViewVersionsRequest) (*ListMetricsViewVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetricsViewVersions not implemented")
+}
+func (UnimplementedRuntimeServiceServer) GetDataDiff(context.Context, *GetDataDiffRequest) (*GetDataDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataDiff not implemented")
+}
 func (UnimplementedRuntimeServiceServer) CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTrigger not implemented")
 }
@@ -687,6 +985,24 @@ func (UnimplementedRuntimeServiceServer) ListNotifierConnectors(context.Context,
 func (UnimplementedRuntimeServiceServer) IssueDevJWT(context.Context, *IssueDevJWTRequest) (*IssueDevJWTResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IssueDevJWT not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedRuntimeServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedRuntimeServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedRuntimeServiceServer) FormatExpression(context.Context, *FormatExpressionRequest) (*FormatExpressionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FormatExpression not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ParseExpression(context.Context, *ParseExpressionRequest) (*ParseExpressionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseExpression not implemented")
+}
+func (UnimplementedRuntimeServiceServer) LintSQL(context.Context, *LintSQLRequest) (*LintSQLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LintSQL not implemented")
+}
 func (UnimplementedRuntimeServiceServer) mustEmbedUnimplementedRuntimeServiceServer() {}
 
 // UnsafeRuntimeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -844,6 +1160,78 @@ func _RuntimeService_DeleteInstance_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ListBackups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListBackups(ctx, req.(*ListBackupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_CreateBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).CreateBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_CreateBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).CreateBackup(ctx, req.(*CreateBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_RestoreBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).RestoreBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_RestoreBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).RestoreBackup(ctx, req.(*RestoreBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ResumeInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ResumeInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ResumeInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ResumeInstance(ctx, req.(*ResumeInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFilesRequest)
 	if err := dec(in); err != nil {
@@ -1120,6 +1508,96 @@ func (x *runtimeServiceWatchLogsServer) Send(m *WatchLogsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _RuntimeService_ListSlowQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSlowQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListSlowQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ListSlowQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListSlowQueries(ctx, req.(*ListSlowQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ListRunningQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunningQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListRunningQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ListRunningQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListRunningQueries(ctx, req.(*ListRunningQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_CancelQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).CancelQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_CancelQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).CancelQuery(ctx, req.(*CancelQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_GetQueryCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetQueryCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_GetQueryCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetQueryCacheStats(ctx, req.(*GetQueryCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_PurgeQueryCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeQueryCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).PurgeQueryCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_PurgeQueryCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).PurgeQueryCache(ctx, req.(*PurgeQueryCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListResourcesRequest)
 	if err := dec(in); err != nil {
@@ -1177,6 +1655,42 @@ func _RuntimeService_GetResource_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_ListMetricsViewVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetricsViewVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListMetricsViewVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ListMetricsViewVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListMetricsViewVersions(ctx, req.(*ListMetricsViewVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_GetDataDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetDataDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_GetDataDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetDataDiff(ctx, req.(*GetDataDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_CreateTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTriggerRequest)
 	if err := dec(in); err != nil {
@@ -1267,6 +1781,114 @@ func _RuntimeService_IssueDevJWT_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_FormatExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).FormatExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_FormatExpression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).FormatExpression(ctx, req.(*FormatExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ParseExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ParseExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ParseExpression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ParseExpression(ctx, req.(*ParseExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_LintSQL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LintSQLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).LintSQL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_LintSQL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).LintSQL(ctx, req.(*LintSQLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RuntimeService_ServiceDesc is the grpc.ServiceDesc for RuntimeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1306,6 +1928,22 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteInstance",
 			Handler:    _RuntimeService_DeleteInstance_Handler,
 		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _RuntimeService_ListBackups_Handler,
+		},
+		{
+			MethodName: "CreateBackup",
+			Handler:    _RuntimeService_CreateBackup_Handler,
+		},
+		{
+			MethodName: "RestoreBackup",
+			Handler:    _RuntimeService_RestoreBackup_Handler,
+		},
+		{
+			MethodName: "ResumeInstance",
+			Handler:    _RuntimeService_ResumeInstance_Handler,
+		},
 		{
 			MethodName: "ListFiles",
 			Handler:    _RuntimeService_ListFiles_Handler,
@@ -1358,6 +1996,26 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLogs",
 			Handler:    _RuntimeService_GetLogs_Handler,
 		},
+		{
+			MethodName: "ListSlowQueries",
+			Handler:    _RuntimeService_ListSlowQueries_Handler,
+		},
+		{
+			MethodName: "ListRunningQueries",
+			Handler:    _RuntimeService_ListRunningQueries_Handler,
+		},
+		{
+			MethodName: "CancelQuery",
+			Handler:    _RuntimeService_CancelQuery_Handler,
+		},
+		{
+			MethodName: "GetQueryCacheStats",
+			Handler:    _RuntimeService_GetQueryCacheStats_Handler,
+		},
+		{
+			MethodName: "PurgeQueryCache",
+			Handler:    _RuntimeService_PurgeQueryCache_Handler,
+		},
 		{
 			MethodName: "ListResources",
 			Handler:    _RuntimeService_ListResources_Handler,
@@ -1366,6 +2024,14 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResource",
 			Handler:    _RuntimeService_GetResource_Handler,
 		},
+		{
+			MethodName: "ListMetricsViewVersions",
+			Handler:    _RuntimeService_ListMetricsViewVersions_Handler,
+		},
+		{
+			MethodName: "GetDataDiff",
+			Handler:    _RuntimeService_GetDataDiff_Handler,
+		},
 		{
 			MethodName: "CreateTrigger",
 			Handler:    _RuntimeService_CreateTrigger_Handler,
@@ -1386,6 +2052,30 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IssueDevJWT",
 			Handler:    _RuntimeService_IssueDevJWT_Handler,
 		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _RuntimeService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _RuntimeService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _RuntimeService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "FormatExpression",
+			Handler:    _RuntimeService_FormatExpression_Handler,
+		},
+		{
+			MethodName: "ParseExpression",
+			Handler:    _RuntimeService_ParseExpression_Handler,
+		},
+		{
+			MethodName: "LintSQL",
+			Handler:    _RuntimeService_LintSQL_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -193,6 +193,18 @@ func (m *Expression) validate(all bool) error {
 			}
 		}
 
+	case *Expression_Segment:
+		if v == nil {
+			err := ExpressionValidationError{
+				field:  "Expression",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Segment
 	default:
 		_ = v // ensures v is used
 	}
@@ -341,6 +353,8 @@ func (m *Condition) validate(all bool) error {
 
 	}
 
+	// no validation rules for AccentInsensitive
+
 	if len(errors) > 0 {
 		return ConditionMultiError(errors)
 	}
@@ -500,6 +514,12 @@ func (m *Subquery) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Sort
+
+	// no validation rules for SortDesc
+
+	// no validation rules for Limit
+
 	if len(errors) > 0 {
 		return SubqueryMultiError(errors)
 	}
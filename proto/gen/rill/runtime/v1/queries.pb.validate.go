@@ -695,6 +695,10 @@ func (m *ExportRequest) validate(all bool) error {
 
 	// no validation rules for BakedQuery
 
+	// no validation rules for FilenameTemplate
+
+	// no validation rules for Locale
+
 	if len(errors) > 0 {
 		return ExportRequestMultiError(errors)
 	}
@@ -2893,6 +2897,35 @@ func (m *MetricsViewAggregationRequest) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetPivotColumns()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewAggregationRequestValidationError{
+					field:  "PivotColumns",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewAggregationRequestValidationError{
+					field:  "PivotColumns",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPivotColumns()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewAggregationRequestValidationError{
+				field:  "PivotColumns",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	for idx, item := range m.GetAliases() {
 		_, _ = idx, item
 
@@ -3040,6 +3073,12 @@ func (m *MetricsViewAggregationRequest) validate(all bool) error {
 
 	// no validation rules for Exact
 
+	// no validation rules for ExecutionTimeoutSeconds
+
+	// no validation rules for AllowPartialResults
+
+	// no validation rules for DecimalsAsStrings
+
 	if len(errors) > 0 {
 		return MetricsViewAggregationRequestMultiError(errors)
 	}
@@ -3206,6 +3245,174 @@ func (m *MetricsViewAggregationResponse) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetMeasureErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewAggregationResponseValidationError{
+						field:  fmt.Sprintf("MeasureErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewAggregationResponseValidationError{
+						field:  fmt.Sprintf("MeasureErrors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewAggregationResponseValidationError{
+					field:  fmt.Sprintf("MeasureErrors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPivotHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewAggregationResponseValidationError{
+						field:  fmt.Sprintf("PivotHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewAggregationResponseValidationError{
+						field:  fmt.Sprintf("PivotHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewAggregationResponseValidationError{
+					field:  fmt.Sprintf("PivotHeaders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAnnotations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewAggregationResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewAggregationResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewAggregationResponseValidationError{
+					field:  fmt.Sprintf("Annotations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	// no validation rules for Limit
+
+	// no validation rules for DefaultLimitApplied
+
+	if all {
+		switch v := interface{}(m.GetResolvedTimeRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewAggregationResponseValidationError{
+					field:  "ResolvedTimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewAggregationResponseValidationError{
+					field:  "ResolvedTimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResolvedTimeRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewAggregationResponseValidationError{
+				field:  "ResolvedTimeRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResolvedComparisonTimeRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewAggregationResponseValidationError{
+					field:  "ResolvedComparisonTimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewAggregationResponseValidationError{
+					field:  "ResolvedComparisonTimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResolvedComparisonTimeRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewAggregationResponseValidationError{
+				field:  "ResolvedComparisonTimeRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExecutionTimeoutSeconds
+
 	if len(errors) > 0 {
 		return MetricsViewAggregationResponseMultiError(errors)
 	}
@@ -3287,50 +3494,48 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewAggregationResponseValidationError{}
 
-// Validate checks the field values on MetricsViewAggregationDimension with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewAggregationDimension) Validate() error {
+// Validate checks the field values on MetricsViewAggregationPivotHeader with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *MetricsViewAggregationPivotHeader) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewAggregationDimension with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// MetricsViewAggregationDimensionMultiError, or nil if none found.
-func (m *MetricsViewAggregationDimension) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewAggregationPivotHeader
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// MetricsViewAggregationPivotHeaderMultiError, or nil if none found.
+func (m *MetricsViewAggregationPivotHeader) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewAggregationDimension) validate(all bool) error {
+func (m *MetricsViewAggregationPivotHeader) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	// no validation rules for TimeGrain
-
-	// no validation rules for TimeZone
+	// no validation rules for Column
 
-	// no validation rules for Alias
+	// no validation rules for Measure
 
 	if len(errors) > 0 {
-		return MetricsViewAggregationDimensionMultiError(errors)
+		return MetricsViewAggregationPivotHeaderMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewAggregationDimensionMultiError is an error wrapping multiple
-// validation errors returned by MetricsViewAggregationDimension.ValidateAll()
-// if the designated constraints aren't met.
-type MetricsViewAggregationDimensionMultiError []error
+// MetricsViewAggregationPivotHeaderMultiError is an error wrapping multiple
+// validation errors returned by
+// MetricsViewAggregationPivotHeader.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewAggregationPivotHeaderMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewAggregationDimensionMultiError) Error() string {
+func (m MetricsViewAggregationPivotHeaderMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3339,12 +3544,12 @@ func (m MetricsViewAggregationDimensionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewAggregationDimensionMultiError) AllErrors() []error { return m }
+func (m MetricsViewAggregationPivotHeaderMultiError) AllErrors() []error { return m }
 
-// MetricsViewAggregationDimensionValidationError is the validation error
-// returned by MetricsViewAggregationDimension.Validate if the designated
+// MetricsViewAggregationPivotHeaderValidationError is the validation error
+// returned by MetricsViewAggregationPivotHeader.Validate if the designated
 // constraints aren't met.
-type MetricsViewAggregationDimensionValidationError struct {
+type MetricsViewAggregationPivotHeaderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3352,24 +3557,24 @@ type MetricsViewAggregationDimensionValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewAggregationDimensionValidationError) Field() string { return e.field }
+func (e MetricsViewAggregationPivotHeaderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewAggregationDimensionValidationError) Reason() string { return e.reason }
+func (e MetricsViewAggregationPivotHeaderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewAggregationDimensionValidationError) Cause() error { return e.cause }
+func (e MetricsViewAggregationPivotHeaderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewAggregationDimensionValidationError) Key() bool { return e.key }
+func (e MetricsViewAggregationPivotHeaderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewAggregationDimensionValidationError) ErrorName() string {
-	return "MetricsViewAggregationDimensionValidationError"
+func (e MetricsViewAggregationPivotHeaderValidationError) ErrorName() string {
+	return "MetricsViewAggregationPivotHeaderValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewAggregationDimensionValidationError) Error() string {
+func (e MetricsViewAggregationPivotHeaderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3381,14 +3586,14 @@ func (e MetricsViewAggregationDimensionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewAggregationDimension.%s: %s%s",
+		"invalid %sMetricsViewAggregationPivotHeader.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewAggregationDimensionValidationError{}
+var _ error = MetricsViewAggregationPivotHeaderValidationError{}
 
 var _ interface {
 	Field() string
@@ -3396,60 +3601,279 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewAggregationDimensionValidationError{}
+} = MetricsViewAggregationPivotHeaderValidationError{}
 
-// Validate checks the field values on MetricsViewAggregationMeasure with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewMeasureError with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewAggregationMeasure) Validate() error {
+func (m *MetricsViewMeasureError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewAggregationMeasure with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// MetricsViewAggregationMeasureMultiError, or nil if none found.
-func (m *MetricsViewAggregationMeasure) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewMeasureError with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewMeasureErrorMultiError, or nil if none found.
+func (m *MetricsViewMeasureError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewAggregationMeasure) validate(all bool) error {
+func (m *MetricsViewMeasureError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Measure
 
-	// no validation rules for BuiltinMeasure
+	// no validation rules for Reason
 
-	for idx, item := range m.GetBuiltinMeasureArgs() {
-		_, _ = idx, item
+	// no validation rules for Message
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewAggregationMeasureValidationError{
-						field:  fmt.Sprintf("BuiltinMeasureArgs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewAggregationMeasureValidationError{
-						field:  fmt.Sprintf("BuiltinMeasureArgs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewAggregationMeasureValidationError{
-					field:  fmt.Sprintf("BuiltinMeasureArgs[%v]", idx),
+	if len(errors) > 0 {
+		return MetricsViewMeasureErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewMeasureErrorMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewMeasureError.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewMeasureErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewMeasureErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewMeasureErrorMultiError) AllErrors() []error { return m }
+
+// MetricsViewMeasureErrorValidationError is the validation error returned by
+// MetricsViewMeasureError.Validate if the designated constraints aren't met.
+type MetricsViewMeasureErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewMeasureErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewMeasureErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewMeasureErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewMeasureErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewMeasureErrorValidationError) ErrorName() string {
+	return "MetricsViewMeasureErrorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewMeasureErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewMeasureError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewMeasureErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewMeasureErrorValidationError{}
+
+// Validate checks the field values on MetricsViewAggregationDimension with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewAggregationDimension) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewAggregationDimension with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewAggregationDimensionMultiError, or nil if none found.
+func (m *MetricsViewAggregationDimension) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewAggregationDimension) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for TimeGrain
+
+	// no validation rules for TimeZone
+
+	// no validation rules for Alias
+
+	if len(errors) > 0 {
+		return MetricsViewAggregationDimensionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewAggregationDimensionMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewAggregationDimension.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewAggregationDimensionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewAggregationDimensionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewAggregationDimensionMultiError) AllErrors() []error { return m }
+
+// MetricsViewAggregationDimensionValidationError is the validation error
+// returned by MetricsViewAggregationDimension.Validate if the designated
+// constraints aren't met.
+type MetricsViewAggregationDimensionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewAggregationDimensionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewAggregationDimensionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewAggregationDimensionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewAggregationDimensionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewAggregationDimensionValidationError) ErrorName() string {
+	return "MetricsViewAggregationDimensionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewAggregationDimensionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewAggregationDimension.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewAggregationDimensionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewAggregationDimensionValidationError{}
+
+// Validate checks the field values on MetricsViewAggregationMeasure with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewAggregationMeasure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewAggregationMeasure with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewAggregationMeasureMultiError, or nil if none found.
+func (m *MetricsViewAggregationMeasure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewAggregationMeasure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for BuiltinMeasure
+
+	for idx, item := range m.GetBuiltinMeasureArgs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  fmt.Sprintf("BuiltinMeasureArgs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  fmt.Sprintf("BuiltinMeasureArgs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewAggregationMeasureValidationError{
+					field:  fmt.Sprintf("BuiltinMeasureArgs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3693,29 +4117,70 @@ func (m *MetricsViewAggregationMeasure) validate(all bool) error {
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
-
-	if len(errors) > 0 {
-		return MetricsViewAggregationMeasureMultiError(errors)
-	}
-
-	return nil
-}
-
-// MetricsViewAggregationMeasureMultiError is an error wrapping multiple
-// validation errors returned by MetricsViewAggregationMeasure.ValidateAll()
-// if the designated constraints aren't met.
-type MetricsViewAggregationMeasureMultiError []error
+	case *MetricsViewAggregationMeasure_Derived:
+		if v == nil {
+			err := MetricsViewAggregationMeasureValidationError{
+				field:  "Compute",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewAggregationMeasureMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
+		if all {
+			switch v := interface{}(m.GetDerived()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  "Derived",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  "Derived",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDerived()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewAggregationMeasureValidationError{
+					field:  "Derived",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewAggregationMeasureMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewAggregationMeasureMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewAggregationMeasure.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewAggregationMeasureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewAggregationMeasureMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
 }
 
 // AllErrors returns a list of validation violation errors.
@@ -4347,6 +4812,247 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewAggregationMeasureComputeComparisonRatioValidationError{}
 
+// Validate checks the field values on
+// MetricsViewAggregationMeasureComputeDerived with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewAggregationMeasureComputeDerived) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// MetricsViewAggregationMeasureComputeDerived with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// MetricsViewAggregationMeasureComputeDerivedMultiError, or nil if none found.
+func (m *MetricsViewAggregationMeasureComputeDerived) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewAggregationMeasureComputeDerived) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Expression
+
+	if len(errors) > 0 {
+		return MetricsViewAggregationMeasureComputeDerivedMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewAggregationMeasureComputeDerivedMultiError is an error wrapping
+// multiple validation errors returned by
+// MetricsViewAggregationMeasureComputeDerived.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewAggregationMeasureComputeDerivedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewAggregationMeasureComputeDerivedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewAggregationMeasureComputeDerivedMultiError) AllErrors() []error { return m }
+
+// MetricsViewAggregationMeasureComputeDerivedValidationError is the validation
+// error returned by MetricsViewAggregationMeasureComputeDerived.Validate if
+// the designated constraints aren't met.
+type MetricsViewAggregationMeasureComputeDerivedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewAggregationMeasureComputeDerivedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewAggregationMeasureComputeDerivedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewAggregationMeasureComputeDerivedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewAggregationMeasureComputeDerivedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewAggregationMeasureComputeDerivedValidationError) ErrorName() string {
+	return "MetricsViewAggregationMeasureComputeDerivedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewAggregationMeasureComputeDerivedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewAggregationMeasureComputeDerived.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewAggregationMeasureComputeDerivedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewAggregationMeasureComputeDerivedValidationError{}
+
+// Validate checks the field values on MetricsViewAggregationPivotColumns with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *MetricsViewAggregationPivotColumns) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewAggregationPivotColumns
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// MetricsViewAggregationPivotColumnsMultiError, or nil if none found.
+func (m *MetricsViewAggregationPivotColumns) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewAggregationPivotColumns) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := PivotColumnSort_name[int32(m.GetSort())]; !ok {
+		err := MetricsViewAggregationPivotColumnsValidationError{
+			field:  "Sort",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Measure
+
+	// no validation rules for Desc
+
+	if m.GetLimit() < 0 {
+		err := MetricsViewAggregationPivotColumnsValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OtherLabel
+
+	if len(errors) > 0 {
+		return MetricsViewAggregationPivotColumnsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewAggregationPivotColumnsMultiError is an error wrapping multiple
+// validation errors returned by
+// MetricsViewAggregationPivotColumns.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewAggregationPivotColumnsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewAggregationPivotColumnsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewAggregationPivotColumnsMultiError) AllErrors() []error { return m }
+
+// MetricsViewAggregationPivotColumnsValidationError is the validation error
+// returned by MetricsViewAggregationPivotColumns.Validate if the designated
+// constraints aren't met.
+type MetricsViewAggregationPivotColumnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewAggregationPivotColumnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewAggregationPivotColumnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewAggregationPivotColumnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewAggregationPivotColumnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewAggregationPivotColumnsValidationError) ErrorName() string {
+	return "MetricsViewAggregationPivotColumnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewAggregationPivotColumnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewAggregationPivotColumns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewAggregationPivotColumnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewAggregationPivotColumnsValidationError{}
+
 // Validate checks the field values on MetricsViewAggregationSort with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -4872,29 +5578,63 @@ func (m *MetricsViewToplistResponse) validate(all bool) error {
 
 	}
 
-	if len(errors) > 0 {
-		return MetricsViewToplistResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// MetricsViewToplistResponseMultiError is an error wrapping multiple
-// validation errors returned by MetricsViewToplistResponse.ValidateAll() if
-// the designated constraints aren't met.
-type MetricsViewToplistResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewToplistResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	for idx, item := range m.GetAnnotations() {
+		_, _ = idx, item
 
-// AllErrors returns a list of validation violation errors.
-func (m MetricsViewToplistResponseMultiError) AllErrors() []error { return m }
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewToplistResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewToplistResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewToplistResponseValidationError{
+					field:  fmt.Sprintf("Annotations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewToplistResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewToplistResponseMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewToplistResponse.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewToplistResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewToplistResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewToplistResponseMultiError) AllErrors() []error { return m }
 
 // MetricsViewToplistResponseValidationError is the validation error returned
 // by MetricsViewToplistResponse.Validate if the designated constraints aren't met.
@@ -5311,6 +6051,8 @@ func (m *MetricsViewComparisonRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ExecutionTimeoutSeconds
+
 	if len(errors) > 0 {
 		return MetricsViewComparisonRequestMultiError(errors)
 	}
@@ -5617,6 +6359,8 @@ func (m *TimeRange) validate(all bool) error {
 
 	// no validation rules for TimeZone
 
+	// no validation rules for Expression
+
 	if len(errors) > 0 {
 		return TimeRangeMultiError(errors)
 	}
@@ -6515,6 +7259,35 @@ func (m *MetricsViewTimeSeriesRequest) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetForecast()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
+					field:  "Forecast",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewTimeSeriesRequestValidationError{
+					field:  "Forecast",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetForecast()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewTimeSeriesRequestValidationError{
+				field:  "Forecast",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return MetricsViewTimeSeriesRequestMultiError(errors)
 	}
@@ -6600,6 +7373,136 @@ var _MetricsViewTimeSeriesRequest_TimeGranularity_NotInLookup = map[TimeGrain]st
 	0: {},
 }
 
+// Validate checks the field values on TimeSeriesForecast with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesForecast) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesForecast with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesForecastMultiError, or nil if none found.
+func (m *TimeSeriesForecast) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesForecast) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _TimeSeriesForecast_Method_NotInLookup[m.GetMethod()]; ok {
+		err := TimeSeriesForecastValidationError{
+			field:  "Method",
+			reason: "value must not be in list [METHOD_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPeriods(); val <= 0 || val > 1000 {
+		err := TimeSeriesForecastValidationError{
+			field:  "Periods",
+			reason: "value must be inside range (0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SeasonalPeriod
+
+	if len(errors) > 0 {
+		return TimeSeriesForecastMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesForecastMultiError is an error wrapping multiple validation errors
+// returned by TimeSeriesForecast.ValidateAll() if the designated constraints
+// aren't met.
+type TimeSeriesForecastMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesForecastMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesForecastMultiError) AllErrors() []error { return m }
+
+// TimeSeriesForecastValidationError is the validation error returned by
+// TimeSeriesForecast.Validate if the designated constraints aren't met.
+type TimeSeriesForecastValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesForecastValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesForecastValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesForecastValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesForecastValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesForecastValidationError) ErrorName() string {
+	return "TimeSeriesForecastValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesForecastValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesForecast.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesForecastValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesForecastValidationError{}
+
+var _TimeSeriesForecast_Method_NotInLookup = map[TimeSeriesForecast_Method]struct{}{
+	0: {},
+}
+
 // Validate checks the field values on MetricsViewTimeSeriesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -6690,6 +7593,40 @@ func (m *MetricsViewTimeSeriesResponse) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetAnnotations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewTimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewTimeSeriesResponseValidationError{
+					field:  fmt.Sprintf("Annotations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return MetricsViewTimeSeriesResponseMultiError(errors)
 	}
@@ -7100,6 +8037,40 @@ func (m *MetricsViewTotalsResponse) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetAnnotations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewTotalsResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewTotalsResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewTotalsResponseValidationError{
+					field:  fmt.Sprintf("Annotations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return MetricsViewTotalsResponseMultiError(errors)
 	}
@@ -7563,29 +8534,63 @@ func (m *MetricsViewRowsResponse) validate(all bool) error {
 
 	}
 
-	if len(errors) > 0 {
-		return MetricsViewRowsResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// MetricsViewRowsResponseMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewRowsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type MetricsViewRowsResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewRowsResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m MetricsViewRowsResponseMultiError) AllErrors() []error { return m }
+	for idx, item := range m.GetAnnotations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewRowsResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewRowsResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewRowsResponseValidationError{
+					field:  fmt.Sprintf("Annotations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewRowsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewRowsResponseMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewRowsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewRowsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewRowsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewRowsResponseMultiError) AllErrors() []error { return m }
 
 // MetricsViewRowsResponseValidationError is the validation error returned by
 // MetricsViewRowsResponse.Validate if the designated constraints aren't met.
@@ -8034,6 +9039,125 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewColumnValidationError{}
 
+// Validate checks the field values on MetricsViewColumnAnnotation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewColumnAnnotation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewColumnAnnotation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewColumnAnnotationMultiError, or nil if none found.
+func (m *MetricsViewColumnAnnotation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewColumnAnnotation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Dimension
+
+	// no validation rules for Measure
+
+	// no validation rules for Label
+
+	// no validation rules for Description
+
+	// no validation rules for FormatPreset
+
+	// no validation rules for FormatD3
+
+	// no validation rules for Unit
+
+	if len(errors) > 0 {
+		return MetricsViewColumnAnnotationMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewColumnAnnotationMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewColumnAnnotation.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewColumnAnnotationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewColumnAnnotationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewColumnAnnotationMultiError) AllErrors() []error { return m }
+
+// MetricsViewColumnAnnotationValidationError is the validation error returned
+// by MetricsViewColumnAnnotation.Validate if the designated constraints
+// aren't met.
+type MetricsViewColumnAnnotationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewColumnAnnotationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewColumnAnnotationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewColumnAnnotationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewColumnAnnotationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewColumnAnnotationValidationError) ErrorName() string {
+	return "MetricsViewColumnAnnotationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewColumnAnnotationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewColumnAnnotation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewColumnAnnotationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewColumnAnnotationValidationError{}
+
 // Validate checks the field values on InlineMeasure with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -8325,6 +9449,69 @@ func (m *MetricsViewTimeRangeResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetWatermark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewTimeRangeResponseValidationError{
+					field:  "Watermark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewTimeRangeResponseValidationError{
+					field:  "Watermark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWatermark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewTimeRangeResponseValidationError{
+				field:  "Watermark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTimeZoneSummaries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewTimeRangeResponseValidationError{
+						field:  fmt.Sprintf("TimeZoneSummaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewTimeRangeResponseValidationError{
+						field:  fmt.Sprintf("TimeZoneSummaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewTimeRangeResponseValidationError{
+					field:  fmt.Sprintf("TimeZoneSummaries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return MetricsViewTimeRangeResponseMultiError(errors)
 	}
@@ -8406,57 +9593,84 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewTimeRangeResponseValidationError{}
 
-// Validate checks the field values on MetricsViewSchemaRequest with the rules
+// Validate checks the field values on TimeZoneTimeRangeSummary with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSchemaRequest) Validate() error {
+func (m *TimeZoneTimeRangeSummary) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSchemaRequest with the
+// ValidateAll checks the field values on TimeZoneTimeRangeSummary with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSchemaRequestMultiError, or nil if none found.
-func (m *MetricsViewSchemaRequest) ValidateAll() error {
+// TimeZoneTimeRangeSummaryMultiError, or nil if none found.
+func (m *TimeZoneTimeRangeSummary) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSchemaRequest) validate(all bool) error {
+func (m *TimeZoneTimeRangeSummary) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	// no validation rules for TimeZone
 
-	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
-		err := MetricsViewSchemaRequestValidationError{
-			field:  "MetricsViewName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Watermark
+
+	for idx, item := range m.GetGrains() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeZoneTimeRangeSummaryValidationError{
+						field:  fmt.Sprintf("Grains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeZoneTimeRangeSummaryValidationError{
+						field:  fmt.Sprintf("Grains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeZoneTimeRangeSummaryValidationError{
+					field:  fmt.Sprintf("Grains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	// no validation rules for Priority
+	}
 
 	if len(errors) > 0 {
-		return MetricsViewSchemaRequestMultiError(errors)
+		return TimeZoneTimeRangeSummaryMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSchemaRequestMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewSchemaRequest.ValidateAll() if the designated
+// TimeZoneTimeRangeSummaryMultiError is an error wrapping multiple validation
+// errors returned by TimeZoneTimeRangeSummary.ValidateAll() if the designated
 // constraints aren't met.
-type MetricsViewSchemaRequestMultiError []error
+type TimeZoneTimeRangeSummaryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSchemaRequestMultiError) Error() string {
+func (m TimeZoneTimeRangeSummaryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8465,11 +9679,11 @@ func (m MetricsViewSchemaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSchemaRequestMultiError) AllErrors() []error { return m }
+func (m TimeZoneTimeRangeSummaryMultiError) AllErrors() []error { return m }
 
-// MetricsViewSchemaRequestValidationError is the validation error returned by
-// MetricsViewSchemaRequest.Validate if the designated constraints aren't met.
-type MetricsViewSchemaRequestValidationError struct {
+// TimeZoneTimeRangeSummaryValidationError is the validation error returned by
+// TimeZoneTimeRangeSummary.Validate if the designated constraints aren't met.
+type TimeZoneTimeRangeSummaryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8477,24 +9691,24 @@ type MetricsViewSchemaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSchemaRequestValidationError) Field() string { return e.field }
+func (e TimeZoneTimeRangeSummaryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSchemaRequestValidationError) Reason() string { return e.reason }
+func (e TimeZoneTimeRangeSummaryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSchemaRequestValidationError) Cause() error { return e.cause }
+func (e TimeZoneTimeRangeSummaryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSchemaRequestValidationError) Key() bool { return e.key }
+func (e TimeZoneTimeRangeSummaryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSchemaRequestValidationError) ErrorName() string {
-	return "MetricsViewSchemaRequestValidationError"
+func (e TimeZoneTimeRangeSummaryValidationError) ErrorName() string {
+	return "TimeZoneTimeRangeSummaryValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSchemaRequestValidationError) Error() string {
+func (e TimeZoneTimeRangeSummaryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8506,14 +9720,14 @@ func (e MetricsViewSchemaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSchemaRequest.%s: %s%s",
+		"invalid %sTimeZoneTimeRangeSummary.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSchemaRequestValidationError{}
+var _ error = TimeZoneTimeRangeSummaryValidationError{}
 
 var _ interface {
 	Field() string
@@ -8521,73 +9735,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSchemaRequestValidationError{}
+} = TimeZoneTimeRangeSummaryValidationError{}
 
-// Validate checks the field values on MetricsViewSchemaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewDefaultsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSchemaResponse) Validate() error {
+func (m *MetricsViewDefaultsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSchemaResponse with the
+// ValidateAll checks the field values on MetricsViewDefaultsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSchemaResponseMultiError, or nil if none found.
-func (m *MetricsViewSchemaResponse) ValidateAll() error {
+// MetricsViewDefaultsRequestMultiError, or nil if none found.
+func (m *MetricsViewDefaultsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSchemaResponse) validate(all bool) error {
+func (m *MetricsViewDefaultsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSchema()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewSchemaResponseValidationError{
-					field:  "Schema",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewSchemaResponseValidationError{
-					field:  "Schema",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for InstanceId
+
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewDefaultsRequestValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewSchemaResponseValidationError{
-				field:  "Schema",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for TimeZone
+
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return MetricsViewSchemaResponseMultiError(errors)
+		return MetricsViewDefaultsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSchemaResponseMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewSchemaResponse.ValidateAll() if the
-// designated constraints aren't met.
-type MetricsViewSchemaResponseMultiError []error
+// MetricsViewDefaultsRequestMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewDefaultsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewDefaultsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSchemaResponseMultiError) Error() string {
+func (m MetricsViewDefaultsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8596,11 +9798,11 @@ func (m MetricsViewSchemaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSchemaResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewDefaultsRequestMultiError) AllErrors() []error { return m }
 
-// MetricsViewSchemaResponseValidationError is the validation error returned by
-// MetricsViewSchemaResponse.Validate if the designated constraints aren't met.
-type MetricsViewSchemaResponseValidationError struct {
+// MetricsViewDefaultsRequestValidationError is the validation error returned
+// by MetricsViewDefaultsRequest.Validate if the designated constraints aren't met.
+type MetricsViewDefaultsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8608,24 +9810,24 @@ type MetricsViewSchemaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSchemaResponseValidationError) Field() string { return e.field }
+func (e MetricsViewDefaultsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSchemaResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewDefaultsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSchemaResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewDefaultsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSchemaResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewDefaultsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSchemaResponseValidationError) ErrorName() string {
-	return "MetricsViewSchemaResponseValidationError"
+func (e MetricsViewDefaultsRequestValidationError) ErrorName() string {
+	return "MetricsViewDefaultsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSchemaResponseValidationError) Error() string {
+func (e MetricsViewDefaultsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8637,14 +9839,14 @@ func (e MetricsViewSchemaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSchemaResponse.%s: %s%s",
+		"invalid %sMetricsViewDefaultsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSchemaResponseValidationError{}
+var _ error = MetricsViewDefaultsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8652,97 +9854,119 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSchemaResponseValidationError{}
+} = MetricsViewDefaultsRequestValidationError{}
 
-// Validate checks the field values on MetricsViewSearchRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewDefaultsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSearchRequest) Validate() error {
+func (m *MetricsViewDefaultsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSearchRequest with the
+// ValidateAll checks the field values on MetricsViewDefaultsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSearchRequestMultiError, or nil if none found.
-func (m *MetricsViewSearchRequest) ValidateAll() error {
+// MetricsViewDefaultsResponseMultiError, or nil if none found.
+func (m *MetricsViewDefaultsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSearchRequest) validate(all bool) error {
+func (m *MetricsViewDefaultsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
+	// no validation rules for TimeZone
 
-	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
-		err := MetricsViewSearchRequestValidationError{
-			field:  "MetricsViewName",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for TimeRange
+
+	if all {
+		switch v := interface{}(m.GetTimeStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewDefaultsResponseValidationError{
+					field:  "TimeStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewDefaultsResponseValidationError{
+					field:  "TimeStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetTimeStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewDefaultsResponseValidationError{
+				field:  "TimeStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Search
-
 	if all {
-		switch v := interface{}(m.GetTimeRange()).(type) {
+		switch v := interface{}(m.GetTimeEnd()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "TimeRange",
+				errors = append(errors, MetricsViewDefaultsResponseValidationError{
+					field:  "TimeEnd",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "TimeRange",
+				errors = append(errors, MetricsViewDefaultsResponseValidationError{
+					field:  "TimeEnd",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTimeEnd()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewSearchRequestValidationError{
-				field:  "TimeRange",
+			return MetricsViewDefaultsResponseValidationError{
+				field:  "TimeEnd",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for ComparisonMode
+
+	// no validation rules for ComparisonOffset
+
 	if all {
-		switch v := interface{}(m.GetWhere()).(type) {
+		switch v := interface{}(m.GetComparisonTimeStart()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "Where",
+				errors = append(errors, MetricsViewDefaultsResponseValidationError{
+					field:  "ComparisonTimeStart",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "Where",
+				errors = append(errors, MetricsViewDefaultsResponseValidationError{
+					field:  "ComparisonTimeStart",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetComparisonTimeStart()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewSearchRequestValidationError{
-				field:  "Where",
+			return MetricsViewDefaultsResponseValidationError{
+				field:  "ComparisonTimeStart",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -8750,61 +9974,79 @@ func (m *MetricsViewSearchRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetHaving()).(type) {
+		switch v := interface{}(m.GetComparisonTimeEnd()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "Having",
+				errors = append(errors, MetricsViewDefaultsResponseValidationError{
+					field:  "ComparisonTimeEnd",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewSearchRequestValidationError{
-					field:  "Having",
+				errors = append(errors, MetricsViewDefaultsResponseValidationError{
+					field:  "ComparisonTimeEnd",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetHaving()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetComparisonTimeEnd()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewSearchRequestValidationError{
-				field:  "Having",
+			return MetricsViewDefaultsResponseValidationError{
+				field:  "ComparisonTimeEnd",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetLimit() < 0 {
-		err := MetricsViewSearchRequestValidationError{
-			field:  "Limit",
-			reason: "value must be greater than or equal to 0",
+	// no validation rules for ComparisonDimension
+
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewDefaultsResponseValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewDefaultsResponseValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewDefaultsResponseValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return MetricsViewSearchRequestMultiError(errors)
+		return MetricsViewDefaultsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSearchRequestMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewSearchRequest.ValidateAll() if the designated
-// constraints aren't met.
-type MetricsViewSearchRequestMultiError []error
+// MetricsViewDefaultsResponseMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewDefaultsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewDefaultsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSearchRequestMultiError) Error() string {
+func (m MetricsViewDefaultsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8813,11 +10055,12 @@ func (m MetricsViewSearchRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSearchRequestMultiError) AllErrors() []error { return m }
+func (m MetricsViewDefaultsResponseMultiError) AllErrors() []error { return m }
 
-// MetricsViewSearchRequestValidationError is the validation error returned by
-// MetricsViewSearchRequest.Validate if the designated constraints aren't met.
-type MetricsViewSearchRequestValidationError struct {
+// MetricsViewDefaultsResponseValidationError is the validation error returned
+// by MetricsViewDefaultsResponse.Validate if the designated constraints
+// aren't met.
+type MetricsViewDefaultsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8825,24 +10068,24 @@ type MetricsViewSearchRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSearchRequestValidationError) Field() string { return e.field }
+func (e MetricsViewDefaultsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSearchRequestValidationError) Reason() string { return e.reason }
+func (e MetricsViewDefaultsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSearchRequestValidationError) Cause() error { return e.cause }
+func (e MetricsViewDefaultsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSearchRequestValidationError) Key() bool { return e.key }
+func (e MetricsViewDefaultsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSearchRequestValidationError) ErrorName() string {
-	return "MetricsViewSearchRequestValidationError"
+func (e MetricsViewDefaultsResponseValidationError) ErrorName() string {
+	return "MetricsViewDefaultsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSearchRequestValidationError) Error() string {
+func (e MetricsViewDefaultsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8854,14 +10097,14 @@ func (e MetricsViewSearchRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSearchRequest.%s: %s%s",
+		"invalid %sMetricsViewDefaultsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSearchRequestValidationError{}
+var _ error = MetricsViewDefaultsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8869,78 +10112,119 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSearchRequestValidationError{}
+} = MetricsViewDefaultsResponseValidationError{}
 
-// Validate checks the field values on MetricsViewSearchResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSearchResponse) Validate() error {
+// Validate checks the field values on MetricsViewValidateExpressionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *MetricsViewValidateExpressionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSearchResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MetricsViewSearchResponseMultiError, or nil if none found.
-func (m *MetricsViewSearchResponse) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewValidateExpressionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// MetricsViewValidateExpressionsRequestMultiError, or nil if none found.
+func (m *MetricsViewValidateExpressionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSearchResponse) validate(all bool) error {
+func (m *MetricsViewValidateExpressionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetResults() {
-		_, _ = idx, item
+	// no validation rules for InstanceId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSearchResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSearchResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewValidateExpressionsRequestValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewValidateExpressionsRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MetricsViewSearchResponseValidationError{
-					field:  fmt.Sprintf("Results[%v]", idx),
+				errors = append(errors, MetricsViewValidateExpressionsRequestValidationError{
+					field:  "Where",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewValidateExpressionsRequestValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
+	if all {
+		switch v := interface{}(m.GetHaving()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewValidateExpressionsRequestValidationError{
+					field:  "Having",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewValidateExpressionsRequestValidationError{
+					field:  "Having",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHaving()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewValidateExpressionsRequestValidationError{
+				field:  "Having",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return MetricsViewSearchResponseMultiError(errors)
+		return MetricsViewValidateExpressionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSearchResponseMultiError is an error wrapping multiple validation
-// errors returned by MetricsViewSearchResponse.ValidateAll() if the
-// designated constraints aren't met.
-type MetricsViewSearchResponseMultiError []error
+// MetricsViewValidateExpressionsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// MetricsViewValidateExpressionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewValidateExpressionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSearchResponseMultiError) Error() string {
+func (m MetricsViewValidateExpressionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8949,11 +10233,12 @@ func (m MetricsViewSearchResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSearchResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewValidateExpressionsRequestMultiError) AllErrors() []error { return m }
 
-// MetricsViewSearchResponseValidationError is the validation error returned by
-// MetricsViewSearchResponse.Validate if the designated constraints aren't met.
-type MetricsViewSearchResponseValidationError struct {
+// MetricsViewValidateExpressionsRequestValidationError is the validation error
+// returned by MetricsViewValidateExpressionsRequest.Validate if the
+// designated constraints aren't met.
+type MetricsViewValidateExpressionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8961,24 +10246,24 @@ type MetricsViewSearchResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSearchResponseValidationError) Field() string { return e.field }
+func (e MetricsViewValidateExpressionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSearchResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewValidateExpressionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSearchResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewValidateExpressionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSearchResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewValidateExpressionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSearchResponseValidationError) ErrorName() string {
-	return "MetricsViewSearchResponseValidationError"
+func (e MetricsViewValidateExpressionsRequestValidationError) ErrorName() string {
+	return "MetricsViewValidateExpressionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSearchResponseValidationError) Error() string {
+func (e MetricsViewValidateExpressionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8990,14 +10275,14 @@ func (e MetricsViewSearchResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSearchResponse.%s: %s%s",
+		"invalid %sMetricsViewValidateExpressionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSearchResponseValidationError{}
+var _ error = MetricsViewValidateExpressionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9005,76 +10290,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSearchResponseValidationError{}
+} = MetricsViewValidateExpressionsRequestValidationError{}
 
-// Validate checks the field values on ColumnRollupIntervalRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnRollupIntervalRequest) Validate() error {
+// Validate checks the field values on MetricsViewValidateExpressionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *MetricsViewValidateExpressionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnRollupIntervalRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ColumnRollupIntervalRequestMultiError, or nil if none found.
-func (m *ColumnRollupIntervalRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// MetricsViewValidateExpressionsResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// MetricsViewValidateExpressionsResponseMultiError, or nil if none found.
+func (m *MetricsViewValidateExpressionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnRollupIntervalRequest) validate(all bool) error {
+func (m *MetricsViewValidateExpressionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
+	// no validation rules for Valid
 
-	// no validation rules for DatabaseSchema
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnRollupIntervalRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewValidateExpressionsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewValidateExpressionsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewValidateExpressionsResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnRollupIntervalRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return ColumnRollupIntervalRequestMultiError(errors)
+		return MetricsViewValidateExpressionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnRollupIntervalRequestMultiError is an error wrapping multiple
-// validation errors returned by ColumnRollupIntervalRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ColumnRollupIntervalRequestMultiError []error
+// MetricsViewValidateExpressionsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// MetricsViewValidateExpressionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewValidateExpressionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnRollupIntervalRequestMultiError) Error() string {
+func (m MetricsViewValidateExpressionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9083,12 +10375,12 @@ func (m ColumnRollupIntervalRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnRollupIntervalRequestMultiError) AllErrors() []error { return m }
+func (m MetricsViewValidateExpressionsResponseMultiError) AllErrors() []error { return m }
 
-// ColumnRollupIntervalRequestValidationError is the validation error returned
-// by ColumnRollupIntervalRequest.Validate if the designated constraints
-// aren't met.
-type ColumnRollupIntervalRequestValidationError struct {
+// MetricsViewValidateExpressionsResponseValidationError is the validation
+// error returned by MetricsViewValidateExpressionsResponse.Validate if the
+// designated constraints aren't met.
+type MetricsViewValidateExpressionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9096,24 +10388,24 @@ type ColumnRollupIntervalRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnRollupIntervalRequestValidationError) Field() string { return e.field }
+func (e MetricsViewValidateExpressionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnRollupIntervalRequestValidationError) Reason() string { return e.reason }
+func (e MetricsViewValidateExpressionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnRollupIntervalRequestValidationError) Cause() error { return e.cause }
+func (e MetricsViewValidateExpressionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnRollupIntervalRequestValidationError) Key() bool { return e.key }
+func (e MetricsViewValidateExpressionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnRollupIntervalRequestValidationError) ErrorName() string {
-	return "ColumnRollupIntervalRequestValidationError"
+func (e MetricsViewValidateExpressionsResponseValidationError) ErrorName() string {
+	return "MetricsViewValidateExpressionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnRollupIntervalRequestValidationError) Error() string {
+func (e MetricsViewValidateExpressionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9125,14 +10417,14 @@ func (e ColumnRollupIntervalRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnRollupIntervalRequest.%s: %s%s",
+		"invalid %sMetricsViewValidateExpressionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnRollupIntervalRequestValidationError{}
+var _ error = MetricsViewValidateExpressionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9140,104 +10432,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnRollupIntervalRequestValidationError{}
+} = MetricsViewValidateExpressionsResponseValidationError{}
 
-// Validate checks the field values on ColumnRollupIntervalResponse with the
+// Validate checks the field values on MetricsViewExpressionError with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnRollupIntervalResponse) Validate() error {
+func (m *MetricsViewExpressionError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnRollupIntervalResponse with the
+// ValidateAll checks the field values on MetricsViewExpressionError with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnRollupIntervalResponseMultiError, or nil if none found.
-func (m *ColumnRollupIntervalResponse) ValidateAll() error {
+// MetricsViewExpressionErrorMultiError, or nil if none found.
+func (m *MetricsViewExpressionError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnRollupIntervalResponse) validate(all bool) error {
+func (m *MetricsViewExpressionError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetStart()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnRollupIntervalResponseValidationError{
-					field:  "Start",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnRollupIntervalResponseValidationError{
-					field:  "Start",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnRollupIntervalResponseValidationError{
-				field:  "Start",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Clause
 
-	if all {
-		switch v := interface{}(m.GetEnd()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnRollupIntervalResponseValidationError{
-					field:  "End",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnRollupIntervalResponseValidationError{
-					field:  "End",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnRollupIntervalResponseValidationError{
-				field:  "End",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Path
 
-	// no validation rules for Interval
+	// no validation rules for Name
+
+	// no validation rules for Code
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return ColumnRollupIntervalResponseMultiError(errors)
+		return MetricsViewExpressionErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnRollupIntervalResponseMultiError is an error wrapping multiple
-// validation errors returned by ColumnRollupIntervalResponse.ValidateAll() if
+// MetricsViewExpressionErrorMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewExpressionError.ValidateAll() if
 // the designated constraints aren't met.
-type ColumnRollupIntervalResponseMultiError []error
+type MetricsViewExpressionErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnRollupIntervalResponseMultiError) Error() string {
+func (m MetricsViewExpressionErrorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9246,12 +10488,11 @@ func (m ColumnRollupIntervalResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnRollupIntervalResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewExpressionErrorMultiError) AllErrors() []error { return m }
 
-// ColumnRollupIntervalResponseValidationError is the validation error returned
-// by ColumnRollupIntervalResponse.Validate if the designated constraints
-// aren't met.
-type ColumnRollupIntervalResponseValidationError struct {
+// MetricsViewExpressionErrorValidationError is the validation error returned
+// by MetricsViewExpressionError.Validate if the designated constraints aren't met.
+type MetricsViewExpressionErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9259,24 +10500,24 @@ type ColumnRollupIntervalResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnRollupIntervalResponseValidationError) Field() string { return e.field }
+func (e MetricsViewExpressionErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnRollupIntervalResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewExpressionErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnRollupIntervalResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewExpressionErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnRollupIntervalResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewExpressionErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnRollupIntervalResponseValidationError) ErrorName() string {
-	return "ColumnRollupIntervalResponseValidationError"
+func (e MetricsViewExpressionErrorValidationError) ErrorName() string {
+	return "MetricsViewExpressionErrorValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnRollupIntervalResponseValidationError) Error() string {
+func (e MetricsViewExpressionErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9288,14 +10529,14 @@ func (e ColumnRollupIntervalResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnRollupIntervalResponse.%s: %s%s",
+		"invalid %sMetricsViewExpressionError.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnRollupIntervalResponseValidationError{}
+var _ error = MetricsViewExpressionErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -9303,24 +10544,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnRollupIntervalResponseValidationError{}
+} = MetricsViewExpressionErrorValidationError{}
 
-// Validate checks the field values on ColumnTopKRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTopKRequest) Validate() error {
+// Validate checks the field values on MetricsViewContributionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewContributionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTopKRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ColumnTopKRequestMultiError, or nil if none found.
-func (m *ColumnTopKRequest) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewContributionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewContributionRequestMultiError, or nil if none found.
+func (m *MetricsViewContributionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTopKRequest) validate(all bool) error {
+func (m *MetricsViewContributionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9329,15 +10570,9 @@ func (m *ColumnTopKRequest) validate(all bool) error {
 
 	// no validation rules for InstanceId
 
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
-
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnTopKRequestValidationError{
-			field:  "TableName",
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewContributionRequestValidationError{
+			field:  "MetricsViewName",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -9346,9 +10581,9 @@ func (m *ColumnTopKRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnTopKRequestValidationError{
-			field:  "ColumnName",
+	if utf8.RuneCountInString(m.GetMeasure()) < 1 {
+		err := MetricsViewContributionRequestValidationError{
+			field:  "Measure",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -9357,26 +10592,155 @@ func (m *ColumnTopKRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Agg
+	if utf8.RuneCountInString(m.GetDimension()) < 1 {
+		err := MetricsViewContributionRequestValidationError{
+			field:  "Dimension",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for K
+	if m.GetTimeRange() == nil {
+		err := MetricsViewContributionRequestValidationError{
+			field:  "TimeRange",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewContributionRequestValidationError{
+				field:  "TimeRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetComparisonTimeRange() == nil {
+		err := MetricsViewContributionRequestValidationError{
+			field:  "ComparisonTimeRange",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetComparisonTimeRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "ComparisonTimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "ComparisonTimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComparisonTimeRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewContributionRequestValidationError{
+				field:  "ComparisonTimeRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewContributionRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewContributionRequestValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetLimit() < 0 {
+		err := MetricsViewContributionRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Priority
 
+	// no validation rules for ExecutionTimeoutSeconds
+
 	if len(errors) > 0 {
-		return ColumnTopKRequestMultiError(errors)
+		return MetricsViewContributionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTopKRequestMultiError is an error wrapping multiple validation errors
-// returned by ColumnTopKRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ColumnTopKRequestMultiError []error
+// MetricsViewContributionRequestMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewContributionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewContributionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTopKRequestMultiError) Error() string {
+func (m MetricsViewContributionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9385,11 +10749,12 @@ func (m ColumnTopKRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTopKRequestMultiError) AllErrors() []error { return m }
+func (m MetricsViewContributionRequestMultiError) AllErrors() []error { return m }
 
-// ColumnTopKRequestValidationError is the validation error returned by
-// ColumnTopKRequest.Validate if the designated constraints aren't met.
-type ColumnTopKRequestValidationError struct {
+// MetricsViewContributionRequestValidationError is the validation error
+// returned by MetricsViewContributionRequest.Validate if the designated
+// constraints aren't met.
+type MetricsViewContributionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9397,24 +10762,24 @@ type ColumnTopKRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTopKRequestValidationError) Field() string { return e.field }
+func (e MetricsViewContributionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTopKRequestValidationError) Reason() string { return e.reason }
+func (e MetricsViewContributionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTopKRequestValidationError) Cause() error { return e.cause }
+func (e MetricsViewContributionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTopKRequestValidationError) Key() bool { return e.key }
+func (e MetricsViewContributionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTopKRequestValidationError) ErrorName() string {
-	return "ColumnTopKRequestValidationError"
+func (e MetricsViewContributionRequestValidationError) ErrorName() string {
+	return "MetricsViewContributionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTopKRequestValidationError) Error() string {
+func (e MetricsViewContributionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9426,14 +10791,14 @@ func (e ColumnTopKRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTopKRequest.%s: %s%s",
+		"invalid %sMetricsViewContributionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTopKRequestValidationError{}
+var _ error = MetricsViewContributionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9441,73 +10806,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTopKRequestValidationError{}
+} = MetricsViewContributionRequestValidationError{}
 
-// Validate checks the field values on ColumnTopKResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewContributionResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTopKResponse) Validate() error {
+func (m *MetricsViewContributionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTopKResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ColumnTopKResponseMultiError, or nil if none found.
-func (m *ColumnTopKResponse) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewContributionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MetricsViewContributionResponseMultiError, or nil if none found.
+func (m *MetricsViewContributionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTopKResponse) validate(all bool) error {
+func (m *MetricsViewContributionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCategoricalSummary()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnTopKResponseValidationError{
-					field:  "CategoricalSummary",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for BaseTotal
+
+	// no validation rules for ComparisonTotal
+
+	// no validation rules for Delta
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewContributionResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewContributionResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnTopKResponseValidationError{
-					field:  "CategoricalSummary",
+				return MetricsViewContributionResponseValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCategoricalSummary()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnTopKResponseValidationError{
-				field:  "CategoricalSummary",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for OtherDelta
+
+	// no validation rules for OtherContribution
+
 	if len(errors) > 0 {
-		return ColumnTopKResponseMultiError(errors)
+		return MetricsViewContributionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTopKResponseMultiError is an error wrapping multiple validation errors
-// returned by ColumnTopKResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ColumnTopKResponseMultiError []error
+// MetricsViewContributionResponseMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewContributionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type MetricsViewContributionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTopKResponseMultiError) Error() string {
+func (m MetricsViewContributionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9516,11 +10896,12 @@ func (m ColumnTopKResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTopKResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewContributionResponseMultiError) AllErrors() []error { return m }
 
-// ColumnTopKResponseValidationError is the validation error returned by
-// ColumnTopKResponse.Validate if the designated constraints aren't met.
-type ColumnTopKResponseValidationError struct {
+// MetricsViewContributionResponseValidationError is the validation error
+// returned by MetricsViewContributionResponse.Validate if the designated
+// constraints aren't met.
+type MetricsViewContributionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9528,24 +10909,24 @@ type ColumnTopKResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTopKResponseValidationError) Field() string { return e.field }
+func (e MetricsViewContributionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTopKResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewContributionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTopKResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewContributionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTopKResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewContributionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTopKResponseValidationError) ErrorName() string {
-	return "ColumnTopKResponseValidationError"
+func (e MetricsViewContributionResponseValidationError) ErrorName() string {
+	return "MetricsViewContributionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTopKResponseValidationError) Error() string {
+func (e MetricsViewContributionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9557,14 +10938,14 @@ func (e ColumnTopKResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTopKResponse.%s: %s%s",
+		"invalid %sMetricsViewContributionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTopKResponseValidationError{}
+var _ error = MetricsViewContributionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9572,102 +10953,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTopKResponseValidationError{}
+} = MetricsViewContributionResponseValidationError{}
 
-// Validate checks the field values on CategoricalSummary with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewContributionRow with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CategoricalSummary) Validate() error {
+func (m *MetricsViewContributionRow) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CategoricalSummary with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MetricsViewContributionRow with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CategoricalSummaryMultiError, or nil if none found.
-func (m *CategoricalSummary) ValidateAll() error {
+// MetricsViewContributionRowMultiError, or nil if none found.
+func (m *MetricsViewContributionRow) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CategoricalSummary) validate(all bool) error {
+func (m *MetricsViewContributionRow) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Case.(type) {
-	case *CategoricalSummary_TopK:
-		if v == nil {
-			err := CategoricalSummaryValidationError{
-				field:  "Case",
-				reason: "oneof value cannot be a typed-nil",
+	if all {
+		switch v := interface{}(m.GetDimensionValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewContributionRowValidationError{
+					field:  "DimensionValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewContributionRowValidationError{
+					field:  "DimensionValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			errors = append(errors, err)
 		}
-
-		if all {
-			switch v := interface{}(m.GetTopK()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CategoricalSummaryValidationError{
-						field:  "TopK",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CategoricalSummaryValidationError{
-						field:  "TopK",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetTopK()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CategoricalSummaryValidationError{
-					field:  "TopK",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *CategoricalSummary_Cardinality:
-		if v == nil {
-			err := CategoricalSummaryValidationError{
-				field:  "Case",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetDimensionValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewContributionRowValidationError{
+				field:  "DimensionValue",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for Cardinality
-	default:
-		_ = v // ensures v is used
 	}
 
+	// no validation rules for BaseValue
+
+	// no validation rules for ComparisonValue
+
+	// no validation rules for Delta
+
+	// no validation rules for Contribution
+
 	if len(errors) > 0 {
-		return CategoricalSummaryMultiError(errors)
+		return MetricsViewContributionRowMultiError(errors)
 	}
 
 	return nil
 }
 
-// CategoricalSummaryMultiError is an error wrapping multiple validation errors
-// returned by CategoricalSummary.ValidateAll() if the designated constraints
-// aren't met.
-type CategoricalSummaryMultiError []error
+// MetricsViewContributionRowMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewContributionRow.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewContributionRowMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CategoricalSummaryMultiError) Error() string {
+func (m MetricsViewContributionRowMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9676,11 +11036,11 @@ func (m CategoricalSummaryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CategoricalSummaryMultiError) AllErrors() []error { return m }
+func (m MetricsViewContributionRowMultiError) AllErrors() []error { return m }
 
-// CategoricalSummaryValidationError is the validation error returned by
-// CategoricalSummary.Validate if the designated constraints aren't met.
-type CategoricalSummaryValidationError struct {
+// MetricsViewContributionRowValidationError is the validation error returned
+// by MetricsViewContributionRow.Validate if the designated constraints aren't met.
+type MetricsViewContributionRowValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9688,24 +11048,24 @@ type CategoricalSummaryValidationError struct {
 }
 
 // Field function returns field value.
-func (e CategoricalSummaryValidationError) Field() string { return e.field }
+func (e MetricsViewContributionRowValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CategoricalSummaryValidationError) Reason() string { return e.reason }
+func (e MetricsViewContributionRowValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CategoricalSummaryValidationError) Cause() error { return e.cause }
+func (e MetricsViewContributionRowValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CategoricalSummaryValidationError) Key() bool { return e.key }
+func (e MetricsViewContributionRowValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CategoricalSummaryValidationError) ErrorName() string {
-	return "CategoricalSummaryValidationError"
+func (e MetricsViewContributionRowValidationError) ErrorName() string {
+	return "MetricsViewContributionRowValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CategoricalSummaryValidationError) Error() string {
+func (e MetricsViewContributionRowValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9717,14 +11077,14 @@ func (e CategoricalSummaryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCategoricalSummary.%s: %s%s",
+		"invalid %sMetricsViewContributionRow.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CategoricalSummaryValidationError{}
+var _ error = MetricsViewContributionRowValidationError{}
 
 var _ interface {
 	Field() string
@@ -9732,46 +11092,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CategoricalSummaryValidationError{}
+} = MetricsViewContributionRowValidationError{}
 
-// Validate checks the field values on TopK with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *TopK) Validate() error {
+// Validate checks the field values on MetricsViewFunnelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewFunnelRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TopK with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in TopKMultiError, or nil if none found.
-func (m *TopK) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewFunnelRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewFunnelRequestMultiError, or nil if none found.
+func (m *MetricsViewFunnelRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TopK) validate(all bool) error {
+func (m *MetricsViewFunnelRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetEntries() {
+	// no validation rules for InstanceId
+
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewFunnelRequestValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDimension()) < 1 {
+		err := MetricsViewFunnelRequestValidationError{
+			field:  "Dimension",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSteps()) < 1 {
+		err := MetricsViewFunnelRequestValidationError{
+			field:  "Steps",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSteps() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TopKValidationError{
-						field:  fmt.Sprintf("Entries[%v]", idx),
+					errors = append(errors, MetricsViewFunnelRequestValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TopKValidationError{
-						field:  fmt.Sprintf("Entries[%v]", idx),
+					errors = append(errors, MetricsViewFunnelRequestValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -9779,8 +11175,8 @@ func (m *TopK) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TopKValidationError{
-					field:  fmt.Sprintf("Entries[%v]", idx),
+				return MetricsViewFunnelRequestValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -9789,19 +11185,84 @@ func (m *TopK) validate(all bool) error {
 
 	}
 
+	// no validation rules for ConversionWindow
+
+	if all {
+		switch v := interface{}(m.GetTimeRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewFunnelRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewFunnelRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewFunnelRequestValidationError{
+				field:  "TimeRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewFunnelRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewFunnelRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewFunnelRequestValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Priority
+
+	// no validation rules for ExecutionTimeoutSeconds
+
 	if len(errors) > 0 {
-		return TopKMultiError(errors)
+		return MetricsViewFunnelRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TopKMultiError is an error wrapping multiple validation errors returned by
-// TopK.ValidateAll() if the designated constraints aren't met.
-type TopKMultiError []error
+// MetricsViewFunnelRequestMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewFunnelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewFunnelRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TopKMultiError) Error() string {
+func (m MetricsViewFunnelRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9810,11 +11271,11 @@ func (m TopKMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TopKMultiError) AllErrors() []error { return m }
+func (m MetricsViewFunnelRequestMultiError) AllErrors() []error { return m }
 
-// TopKValidationError is the validation error returned by TopK.Validate if the
-// designated constraints aren't met.
-type TopKValidationError struct {
+// MetricsViewFunnelRequestValidationError is the validation error returned by
+// MetricsViewFunnelRequest.Validate if the designated constraints aren't met.
+type MetricsViewFunnelRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9822,22 +11283,24 @@ type TopKValidationError struct {
 }
 
 // Field function returns field value.
-func (e TopKValidationError) Field() string { return e.field }
+func (e MetricsViewFunnelRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TopKValidationError) Reason() string { return e.reason }
+func (e MetricsViewFunnelRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TopKValidationError) Cause() error { return e.cause }
+func (e MetricsViewFunnelRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TopKValidationError) Key() bool { return e.key }
+func (e MetricsViewFunnelRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TopKValidationError) ErrorName() string { return "TopKValidationError" }
+func (e MetricsViewFunnelRequestValidationError) ErrorName() string {
+	return "MetricsViewFunnelRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TopKValidationError) Error() string {
+func (e MetricsViewFunnelRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9849,14 +11312,14 @@ func (e TopKValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTopK.%s: %s%s",
+		"invalid %sMetricsViewFunnelRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TopKValidationError{}
+var _ error = MetricsViewFunnelRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9864,42 +11327,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TopKValidationError{}
+} = MetricsViewFunnelRequestValidationError{}
 
-// Validate checks the field values on ColumnNullCountRequest with the rules
+// Validate checks the field values on MetricsViewFunnelStep with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnNullCountRequest) Validate() error {
+func (m *MetricsViewFunnelStep) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnNullCountRequest with the rules
+// ValidateAll checks the field values on MetricsViewFunnelStep with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnNullCountRequestMultiError, or nil if none found.
-func (m *ColumnNullCountRequest) ValidateAll() error {
+// MetricsViewFunnelStepMultiError, or nil if none found.
+func (m *MetricsViewFunnelStep) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnNullCountRequest) validate(all bool) error {
+func (m *MetricsViewFunnelStep) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
+	// no validation rules for Name
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnNullCountRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
+	if m.GetWhere() == nil {
+		err := MetricsViewFunnelStepValidationError{
+			field:  "Where",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -9907,33 +11364,49 @@ func (m *ColumnNullCountRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnNullCountRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewFunnelStepValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewFunnelStepValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewFunnelStepValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return ColumnNullCountRequestMultiError(errors)
+		return MetricsViewFunnelStepMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnNullCountRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnNullCountRequest.ValidateAll() if the designated
+// MetricsViewFunnelStepMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewFunnelStep.ValidateAll() if the designated
 // constraints aren't met.
-type ColumnNullCountRequestMultiError []error
+type MetricsViewFunnelStepMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnNullCountRequestMultiError) Error() string {
+func (m MetricsViewFunnelStepMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9942,11 +11415,11 @@ func (m ColumnNullCountRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnNullCountRequestMultiError) AllErrors() []error { return m }
+func (m MetricsViewFunnelStepMultiError) AllErrors() []error { return m }
 
-// ColumnNullCountRequestValidationError is the validation error returned by
-// ColumnNullCountRequest.Validate if the designated constraints aren't met.
-type ColumnNullCountRequestValidationError struct {
+// MetricsViewFunnelStepValidationError is the validation error returned by
+// MetricsViewFunnelStep.Validate if the designated constraints aren't met.
+type MetricsViewFunnelStepValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9954,24 +11427,24 @@ type ColumnNullCountRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnNullCountRequestValidationError) Field() string { return e.field }
+func (e MetricsViewFunnelStepValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnNullCountRequestValidationError) Reason() string { return e.reason }
+func (e MetricsViewFunnelStepValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnNullCountRequestValidationError) Cause() error { return e.cause }
+func (e MetricsViewFunnelStepValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnNullCountRequestValidationError) Key() bool { return e.key }
+func (e MetricsViewFunnelStepValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnNullCountRequestValidationError) ErrorName() string {
-	return "ColumnNullCountRequestValidationError"
+func (e MetricsViewFunnelStepValidationError) ErrorName() string {
+	return "MetricsViewFunnelStepValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnNullCountRequestValidationError) Error() string {
+func (e MetricsViewFunnelStepValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9983,14 +11456,14 @@ func (e ColumnNullCountRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnNullCountRequest.%s: %s%s",
+		"invalid %sMetricsViewFunnelStep.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnNullCountRequestValidationError{}
+var _ error = MetricsViewFunnelStepValidationError{}
 
 var _ interface {
 	Field() string
@@ -9998,46 +11471,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnNullCountRequestValidationError{}
+} = MetricsViewFunnelStepValidationError{}
 
-// Validate checks the field values on ColumnNullCountResponse with the rules
+// Validate checks the field values on MetricsViewFunnelResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnNullCountResponse) Validate() error {
+func (m *MetricsViewFunnelResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnNullCountResponse with the
+// ValidateAll checks the field values on MetricsViewFunnelResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnNullCountResponseMultiError, or nil if none found.
-func (m *ColumnNullCountResponse) ValidateAll() error {
+// MetricsViewFunnelResponseMultiError, or nil if none found.
+func (m *MetricsViewFunnelResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnNullCountResponse) validate(all bool) error {
+func (m *MetricsViewFunnelResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Count
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewFunnelResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewFunnelResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewFunnelResponseValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return ColumnNullCountResponseMultiError(errors)
+		return MetricsViewFunnelResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnNullCountResponseMultiError is an error wrapping multiple validation
-// errors returned by ColumnNullCountResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnNullCountResponseMultiError []error
+// MetricsViewFunnelResponseMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewFunnelResponse.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewFunnelResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnNullCountResponseMultiError) Error() string {
+func (m MetricsViewFunnelResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10046,11 +11551,11 @@ func (m ColumnNullCountResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnNullCountResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewFunnelResponseMultiError) AllErrors() []error { return m }
 
-// ColumnNullCountResponseValidationError is the validation error returned by
-// ColumnNullCountResponse.Validate if the designated constraints aren't met.
-type ColumnNullCountResponseValidationError struct {
+// MetricsViewFunnelResponseValidationError is the validation error returned by
+// MetricsViewFunnelResponse.Validate if the designated constraints aren't met.
+type MetricsViewFunnelResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10058,24 +11563,24 @@ type ColumnNullCountResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnNullCountResponseValidationError) Field() string { return e.field }
+func (e MetricsViewFunnelResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnNullCountResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewFunnelResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnNullCountResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewFunnelResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnNullCountResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewFunnelResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnNullCountResponseValidationError) ErrorName() string {
-	return "ColumnNullCountResponseValidationError"
+func (e MetricsViewFunnelResponseValidationError) ErrorName() string {
+	return "MetricsViewFunnelResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnNullCountResponseValidationError) Error() string {
+func (e MetricsViewFunnelResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10087,14 +11592,14 @@ func (e ColumnNullCountResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnNullCountResponse.%s: %s%s",
+		"invalid %sMetricsViewFunnelResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnNullCountResponseValidationError{}
+var _ error = MetricsViewFunnelResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10102,78 +11607,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnNullCountResponseValidationError{}
+} = MetricsViewFunnelResponseValidationError{}
 
-// Validate checks the field values on ColumnDescriptiveStatisticsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ColumnDescriptiveStatisticsRequest) Validate() error {
+// Validate checks the field values on MetricsViewFunnelStepResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewFunnelStepResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnDescriptiveStatisticsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ColumnDescriptiveStatisticsRequestMultiError, or nil if none found.
-func (m *ColumnDescriptiveStatisticsRequest) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewFunnelStepResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewFunnelStepResultMultiError, or nil if none found.
+func (m *MetricsViewFunnelStepResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnDescriptiveStatisticsRequest) validate(all bool) error {
+func (m *MetricsViewFunnelStepResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
+	// no validation rules for Name
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnDescriptiveStatisticsRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Count
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnDescriptiveStatisticsRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ConversionRate
 
-	// no validation rules for Priority
+	// no validation rules for OverallConversionRate
 
 	if len(errors) > 0 {
-		return ColumnDescriptiveStatisticsRequestMultiError(errors)
+		return MetricsViewFunnelStepResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnDescriptiveStatisticsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// ColumnDescriptiveStatisticsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnDescriptiveStatisticsRequestMultiError []error
+// MetricsViewFunnelStepResultMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewFunnelStepResult.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewFunnelStepResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnDescriptiveStatisticsRequestMultiError) Error() string {
+func (m MetricsViewFunnelStepResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10182,12 +11661,12 @@ func (m ColumnDescriptiveStatisticsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnDescriptiveStatisticsRequestMultiError) AllErrors() []error { return m }
+func (m MetricsViewFunnelStepResultMultiError) AllErrors() []error { return m }
 
-// ColumnDescriptiveStatisticsRequestValidationError is the validation error
-// returned by ColumnDescriptiveStatisticsRequest.Validate if the designated
-// constraints aren't met.
-type ColumnDescriptiveStatisticsRequestValidationError struct {
+// MetricsViewFunnelStepResultValidationError is the validation error returned
+// by MetricsViewFunnelStepResult.Validate if the designated constraints
+// aren't met.
+type MetricsViewFunnelStepResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10195,24 +11674,24 @@ type ColumnDescriptiveStatisticsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnDescriptiveStatisticsRequestValidationError) Field() string { return e.field }
+func (e MetricsViewFunnelStepResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnDescriptiveStatisticsRequestValidationError) Reason() string { return e.reason }
+func (e MetricsViewFunnelStepResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnDescriptiveStatisticsRequestValidationError) Cause() error { return e.cause }
+func (e MetricsViewFunnelStepResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnDescriptiveStatisticsRequestValidationError) Key() bool { return e.key }
+func (e MetricsViewFunnelStepResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnDescriptiveStatisticsRequestValidationError) ErrorName() string {
-	return "ColumnDescriptiveStatisticsRequestValidationError"
+func (e MetricsViewFunnelStepResultValidationError) ErrorName() string {
+	return "MetricsViewFunnelStepResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnDescriptiveStatisticsRequestValidationError) Error() string {
+func (e MetricsViewFunnelStepResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10224,14 +11703,14 @@ func (e ColumnDescriptiveStatisticsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnDescriptiveStatisticsRequest.%s: %s%s",
+		"invalid %sMetricsViewFunnelStepResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnDescriptiveStatisticsRequestValidationError{}
+var _ error = MetricsViewFunnelStepResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -10239,75 +11718,143 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnDescriptiveStatisticsRequestValidationError{}
+} = MetricsViewFunnelStepResultValidationError{}
 
-// Validate checks the field values on ColumnDescriptiveStatisticsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ColumnDescriptiveStatisticsResponse) Validate() error {
+// Validate checks the field values on MetricsViewRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewRetentionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnDescriptiveStatisticsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ColumnDescriptiveStatisticsResponseMultiError, or nil if none found.
-func (m *ColumnDescriptiveStatisticsResponse) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewRetentionRequestMultiError, or nil if none found.
+func (m *MetricsViewRetentionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnDescriptiveStatisticsResponse) validate(all bool) error {
+func (m *MetricsViewRetentionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for InstanceId
+
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewRetentionRequestValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDimension()) < 1 {
+		err := MetricsViewRetentionRequestValidationError{
+			field:  "Dimension",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TimeGrain
+
+	if val := m.GetPeriods(); val < 1 || val > 366 {
+		err := MetricsViewRetentionRequestValidationError{
+			field:  "Periods",
+			reason: "value must be inside range [1, 366]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
-		switch v := interface{}(m.GetNumericSummary()).(type) {
+		switch v := interface{}(m.GetTimeRange()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnDescriptiveStatisticsResponseValidationError{
-					field:  "NumericSummary",
+				errors = append(errors, MetricsViewRetentionRequestValidationError{
+					field:  "TimeRange",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnDescriptiveStatisticsResponseValidationError{
-					field:  "NumericSummary",
+				errors = append(errors, MetricsViewRetentionRequestValidationError{
+					field:  "TimeRange",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ColumnDescriptiveStatisticsResponseValidationError{
-				field:  "NumericSummary",
+			return MetricsViewRetentionRequestValidationError{
+				field:  "TimeRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewRetentionRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewRetentionRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewRetentionRequestValidationError{
+				field:  "Where",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Priority
+
+	// no validation rules for ExecutionTimeoutSeconds
+
 	if len(errors) > 0 {
-		return ColumnDescriptiveStatisticsResponseMultiError(errors)
+		return MetricsViewRetentionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnDescriptiveStatisticsResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// ColumnDescriptiveStatisticsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnDescriptiveStatisticsResponseMultiError []error
+// MetricsViewRetentionRequestMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewRetentionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewRetentionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnDescriptiveStatisticsResponseMultiError) Error() string {
+func (m MetricsViewRetentionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10316,12 +11863,12 @@ func (m ColumnDescriptiveStatisticsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnDescriptiveStatisticsResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewRetentionRequestMultiError) AllErrors() []error { return m }
 
-// ColumnDescriptiveStatisticsResponseValidationError is the validation error
-// returned by ColumnDescriptiveStatisticsResponse.Validate if the designated
-// constraints aren't met.
-type ColumnDescriptiveStatisticsResponseValidationError struct {
+// MetricsViewRetentionRequestValidationError is the validation error returned
+// by MetricsViewRetentionRequest.Validate if the designated constraints
+// aren't met.
+type MetricsViewRetentionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10329,24 +11876,24 @@ type ColumnDescriptiveStatisticsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnDescriptiveStatisticsResponseValidationError) Field() string { return e.field }
+func (e MetricsViewRetentionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnDescriptiveStatisticsResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewRetentionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnDescriptiveStatisticsResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewRetentionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnDescriptiveStatisticsResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewRetentionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnDescriptiveStatisticsResponseValidationError) ErrorName() string {
-	return "ColumnDescriptiveStatisticsResponseValidationError"
+func (e MetricsViewRetentionRequestValidationError) ErrorName() string {
+	return "MetricsViewRetentionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnDescriptiveStatisticsResponseValidationError) Error() string {
+func (e MetricsViewRetentionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10358,14 +11905,14 @@ func (e ColumnDescriptiveStatisticsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnDescriptiveStatisticsResponse.%s: %s%s",
+		"invalid %sMetricsViewRetentionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnDescriptiveStatisticsResponseValidationError{}
+var _ error = MetricsViewRetentionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10373,172 +11920,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnDescriptiveStatisticsResponseValidationError{}
+} = MetricsViewRetentionRequestValidationError{}
 
-// Validate checks the field values on NumericSummary with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *NumericSummary) Validate() error {
+// Validate checks the field values on MetricsViewRetentionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewRetentionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NumericSummary with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NumericSummaryMultiError,
-// or nil if none found.
-func (m *NumericSummary) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewRetentionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewRetentionResponseMultiError, or nil if none found.
+func (m *MetricsViewRetentionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NumericSummary) validate(all bool) error {
+func (m *MetricsViewRetentionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Case.(type) {
-	case *NumericSummary_NumericHistogramBins:
-		if v == nil {
-			err := NumericSummaryValidationError{
-				field:  "Case",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetNumericHistogramBins()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericHistogramBins",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericHistogramBins",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetNumericHistogramBins()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return NumericSummaryValidationError{
-					field:  "NumericHistogramBins",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *NumericSummary_NumericStatistics:
-		if v == nil {
-			err := NumericSummaryValidationError{
-				field:  "Case",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetNumericStatistics()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericStatistics",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericStatistics",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetNumericStatistics()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return NumericSummaryValidationError{
-					field:  "NumericStatistics",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *NumericSummary_NumericOutliers:
-		if v == nil {
-			err := NumericSummaryValidationError{
-				field:  "Case",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	for idx, item := range m.GetCohorts() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetNumericOutliers()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericOutliers",
+					errors = append(errors, MetricsViewRetentionResponseValidationError{
+						field:  fmt.Sprintf("Cohorts[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, NumericSummaryValidationError{
-						field:  "NumericOutliers",
+					errors = append(errors, MetricsViewRetentionResponseValidationError{
+						field:  fmt.Sprintf("Cohorts[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetNumericOutliers()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return NumericSummaryValidationError{
-					field:  "NumericOutliers",
+				return MetricsViewRetentionResponseValidationError{
+					field:  fmt.Sprintf("Cohorts[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return NumericSummaryMultiError(errors)
+		return MetricsViewRetentionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// NumericSummaryMultiError is an error wrapping multiple validation errors
-// returned by NumericSummary.ValidateAll() if the designated constraints
-// aren't met.
-type NumericSummaryMultiError []error
+// MetricsViewRetentionResponseMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewRetentionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewRetentionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NumericSummaryMultiError) Error() string {
+func (m MetricsViewRetentionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10547,11 +12000,12 @@ func (m NumericSummaryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NumericSummaryMultiError) AllErrors() []error { return m }
+func (m MetricsViewRetentionResponseMultiError) AllErrors() []error { return m }
 
-// NumericSummaryValidationError is the validation error returned by
-// NumericSummary.Validate if the designated constraints aren't met.
-type NumericSummaryValidationError struct {
+// MetricsViewRetentionResponseValidationError is the validation error returned
+// by MetricsViewRetentionResponse.Validate if the designated constraints
+// aren't met.
+type MetricsViewRetentionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10559,22 +12013,24 @@ type NumericSummaryValidationError struct {
 }
 
 // Field function returns field value.
-func (e NumericSummaryValidationError) Field() string { return e.field }
+func (e MetricsViewRetentionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NumericSummaryValidationError) Reason() string { return e.reason }
+func (e MetricsViewRetentionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NumericSummaryValidationError) Cause() error { return e.cause }
+func (e MetricsViewRetentionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NumericSummaryValidationError) Key() bool { return e.key }
+func (e MetricsViewRetentionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NumericSummaryValidationError) ErrorName() string { return "NumericSummaryValidationError" }
+func (e MetricsViewRetentionResponseValidationError) ErrorName() string {
+	return "MetricsViewRetentionResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NumericSummaryValidationError) Error() string {
+func (e MetricsViewRetentionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10586,14 +12042,14 @@ func (e NumericSummaryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNumericSummary.%s: %s%s",
+		"invalid %sMetricsViewRetentionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NumericSummaryValidationError{}
+var _ error = MetricsViewRetentionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10601,78 +12057,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NumericSummaryValidationError{}
+} = MetricsViewRetentionResponseValidationError{}
 
-// Validate checks the field values on NumericHistogramBins with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewRetentionCohort with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *NumericHistogramBins) Validate() error {
+func (m *MetricsViewRetentionCohort) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NumericHistogramBins with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MetricsViewRetentionCohort with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// NumericHistogramBinsMultiError, or nil if none found.
-func (m *NumericHistogramBins) ValidateAll() error {
+// MetricsViewRetentionCohortMultiError, or nil if none found.
+func (m *MetricsViewRetentionCohort) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NumericHistogramBins) validate(all bool) error {
+func (m *MetricsViewRetentionCohort) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBins() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, NumericHistogramBinsValidationError{
-						field:  fmt.Sprintf("Bins[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, NumericHistogramBinsValidationError{
-						field:  fmt.Sprintf("Bins[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewRetentionCohortValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return NumericHistogramBinsValidationError{
-					field:  fmt.Sprintf("Bins[%v]", idx),
+				errors = append(errors, MetricsViewRetentionCohortValidationError{
+					field:  "Start",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewRetentionCohortValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
+	// no validation rules for Size
+
 	if len(errors) > 0 {
-		return NumericHistogramBinsMultiError(errors)
+		return MetricsViewRetentionCohortMultiError(errors)
 	}
 
 	return nil
 }
 
-// NumericHistogramBinsMultiError is an error wrapping multiple validation
-// errors returned by NumericHistogramBins.ValidateAll() if the designated
-// constraints aren't met.
-type NumericHistogramBinsMultiError []error
+// MetricsViewRetentionCohortMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewRetentionCohort.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewRetentionCohortMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NumericHistogramBinsMultiError) Error() string {
+func (m MetricsViewRetentionCohortMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10681,11 +12134,11 @@ func (m NumericHistogramBinsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NumericHistogramBinsMultiError) AllErrors() []error { return m }
+func (m MetricsViewRetentionCohortMultiError) AllErrors() []error { return m }
 
-// NumericHistogramBinsValidationError is the validation error returned by
-// NumericHistogramBins.Validate if the designated constraints aren't met.
-type NumericHistogramBinsValidationError struct {
+// MetricsViewRetentionCohortValidationError is the validation error returned
+// by MetricsViewRetentionCohort.Validate if the designated constraints aren't met.
+type MetricsViewRetentionCohortValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10693,24 +12146,24 @@ type NumericHistogramBinsValidationError struct {
 }
 
 // Field function returns field value.
-func (e NumericHistogramBinsValidationError) Field() string { return e.field }
+func (e MetricsViewRetentionCohortValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NumericHistogramBinsValidationError) Reason() string { return e.reason }
+func (e MetricsViewRetentionCohortValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NumericHistogramBinsValidationError) Cause() error { return e.cause }
+func (e MetricsViewRetentionCohortValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NumericHistogramBinsValidationError) Key() bool { return e.key }
+func (e MetricsViewRetentionCohortValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NumericHistogramBinsValidationError) ErrorName() string {
-	return "NumericHistogramBinsValidationError"
+func (e MetricsViewRetentionCohortValidationError) ErrorName() string {
+	return "MetricsViewRetentionCohortValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e NumericHistogramBinsValidationError) Error() string {
+func (e MetricsViewRetentionCohortValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10722,14 +12175,14 @@ func (e NumericHistogramBinsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNumericHistogramBins.%s: %s%s",
+		"invalid %sMetricsViewRetentionCohort.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NumericHistogramBinsValidationError{}
+var _ error = MetricsViewRetentionCohortValidationError{}
 
 var _ interface {
 	Field() string
@@ -10737,58 +12190,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NumericHistogramBinsValidationError{}
+} = MetricsViewRetentionCohortValidationError{}
 
-// Validate checks the field values on NumericStatistics with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *NumericStatistics) Validate() error {
+// Validate checks the field values on MetricsViewSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSchemaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NumericStatistics with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MetricsViewSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// NumericStatisticsMultiError, or nil if none found.
-func (m *NumericStatistics) ValidateAll() error {
+// MetricsViewSchemaRequestMultiError, or nil if none found.
+func (m *MetricsViewSchemaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NumericStatistics) validate(all bool) error {
+func (m *MetricsViewSchemaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Min
-
-	// no validation rules for Max
-
-	// no validation rules for Mean
-
-	// no validation rules for Q25
-
-	// no validation rules for Q50
+	// no validation rules for InstanceId
 
-	// no validation rules for Q75
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewSchemaRequestValidationError{
+			field:  "MetricsViewName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Sd
+	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return NumericStatisticsMultiError(errors)
+		return MetricsViewSchemaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// NumericStatisticsMultiError is an error wrapping multiple validation errors
-// returned by NumericStatistics.ValidateAll() if the designated constraints
-// aren't met.
-type NumericStatisticsMultiError []error
+// MetricsViewSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSchemaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSchemaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NumericStatisticsMultiError) Error() string {
+func (m MetricsViewSchemaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10797,11 +12251,11 @@ func (m NumericStatisticsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NumericStatisticsMultiError) AllErrors() []error { return m }
+func (m MetricsViewSchemaRequestMultiError) AllErrors() []error { return m }
 
-// NumericStatisticsValidationError is the validation error returned by
-// NumericStatistics.Validate if the designated constraints aren't met.
-type NumericStatisticsValidationError struct {
+// MetricsViewSchemaRequestValidationError is the validation error returned by
+// MetricsViewSchemaRequest.Validate if the designated constraints aren't met.
+type MetricsViewSchemaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10809,24 +12263,24 @@ type NumericStatisticsValidationError struct {
 }
 
 // Field function returns field value.
-func (e NumericStatisticsValidationError) Field() string { return e.field }
+func (e MetricsViewSchemaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NumericStatisticsValidationError) Reason() string { return e.reason }
+func (e MetricsViewSchemaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NumericStatisticsValidationError) Cause() error { return e.cause }
+func (e MetricsViewSchemaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NumericStatisticsValidationError) Key() bool { return e.key }
+func (e MetricsViewSchemaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NumericStatisticsValidationError) ErrorName() string {
-	return "NumericStatisticsValidationError"
+func (e MetricsViewSchemaRequestValidationError) ErrorName() string {
+	return "MetricsViewSchemaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e NumericStatisticsValidationError) Error() string {
+func (e MetricsViewSchemaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10838,14 +12292,14 @@ func (e NumericStatisticsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNumericStatistics.%s: %s%s",
+		"invalid %sMetricsViewSchemaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NumericStatisticsValidationError{}
+var _ error = MetricsViewSchemaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10853,47 +12307,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NumericStatisticsValidationError{}
+} = MetricsViewSchemaRequestValidationError{}
 
-// Validate checks the field values on NumericOutliers with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *NumericOutliers) Validate() error {
+// Validate checks the field values on MetricsViewSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSchemaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NumericOutliers with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MetricsViewSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// NumericOutliersMultiError, or nil if none found.
-func (m *NumericOutliers) ValidateAll() error {
+// MetricsViewSchemaResponseMultiError, or nil if none found.
+func (m *MetricsViewSchemaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NumericOutliers) validate(all bool) error {
+func (m *MetricsViewSchemaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetOutliers() {
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSchemaResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSchemaResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSchemaResponseValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TimeDimension
+
+	for idx, item := range m.GetDimensions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, NumericOutliersValidationError{
-						field:  fmt.Sprintf("Outliers[%v]", idx),
+					errors = append(errors, MetricsViewSchemaResponseValidationError{
+						field:  fmt.Sprintf("Dimensions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, NumericOutliersValidationError{
-						field:  fmt.Sprintf("Outliers[%v]", idx),
+					errors = append(errors, MetricsViewSchemaResponseValidationError{
+						field:  fmt.Sprintf("Dimensions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -10901,8 +12386,42 @@ func (m *NumericOutliers) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return NumericOutliersValidationError{
-					field:  fmt.Sprintf("Outliers[%v]", idx),
+				return MetricsViewSchemaResponseValidationError{
+					field:  fmt.Sprintf("Dimensions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMeasures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSchemaResponseValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSchemaResponseValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSchemaResponseValidationError{
+					field:  fmt.Sprintf("Measures[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -10912,19 +12431,19 @@ func (m *NumericOutliers) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return NumericOutliersMultiError(errors)
+		return MetricsViewSchemaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// NumericOutliersMultiError is an error wrapping multiple validation errors
-// returned by NumericOutliers.ValidateAll() if the designated constraints
-// aren't met.
-type NumericOutliersMultiError []error
+// MetricsViewSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSchemaResponse.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewSchemaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NumericOutliersMultiError) Error() string {
+func (m MetricsViewSchemaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10933,11 +12452,11 @@ func (m NumericOutliersMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NumericOutliersMultiError) AllErrors() []error { return m }
+func (m MetricsViewSchemaResponseMultiError) AllErrors() []error { return m }
 
-// NumericOutliersValidationError is the validation error returned by
-// NumericOutliers.Validate if the designated constraints aren't met.
-type NumericOutliersValidationError struct {
+// MetricsViewSchemaResponseValidationError is the validation error returned by
+// MetricsViewSchemaResponse.Validate if the designated constraints aren't met.
+type MetricsViewSchemaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10945,22 +12464,24 @@ type NumericOutliersValidationError struct {
 }
 
 // Field function returns field value.
-func (e NumericOutliersValidationError) Field() string { return e.field }
+func (e MetricsViewSchemaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NumericOutliersValidationError) Reason() string { return e.reason }
+func (e MetricsViewSchemaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NumericOutliersValidationError) Cause() error { return e.cause }
+func (e MetricsViewSchemaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NumericOutliersValidationError) Key() bool { return e.key }
+func (e MetricsViewSchemaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NumericOutliersValidationError) ErrorName() string { return "NumericOutliersValidationError" }
+func (e MetricsViewSchemaResponseValidationError) ErrorName() string {
+	return "MetricsViewSchemaResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NumericOutliersValidationError) Error() string {
+func (e MetricsViewSchemaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10972,14 +12493,14 @@ func (e NumericOutliersValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNumericOutliers.%s: %s%s",
+		"invalid %sMetricsViewSchemaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NumericOutliersValidationError{}
+var _ error = MetricsViewSchemaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10987,76 +12508,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NumericOutliersValidationError{}
+} = MetricsViewSchemaResponseValidationError{}
 
-// Validate checks the field values on ColumnTimeGrainRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewSchemaDimension with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeGrainRequest) Validate() error {
+func (m *MetricsViewSchemaDimension) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeGrainRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MetricsViewSchemaDimension with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeGrainRequestMultiError, or nil if none found.
-func (m *ColumnTimeGrainRequest) ValidateAll() error {
+// MetricsViewSchemaDimensionMultiError, or nil if none found.
+func (m *MetricsViewSchemaDimension) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeGrainRequest) validate(all bool) error {
+func (m *MetricsViewSchemaDimension) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
+	// no validation rules for Name
 
-	// no validation rules for DatabaseSchema
+	// no validation rules for Label
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnTimeGrainRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Description
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnTimeGrainRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSchemaDimensionValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSchemaDimensionValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSchemaDimensionValidationError{
+				field:  "Type",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return ColumnTimeGrainRequestMultiError(errors)
+		return MetricsViewSchemaDimensionMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTimeGrainRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeGrainRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnTimeGrainRequestMultiError []error
+// MetricsViewSchemaDimensionMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewSchemaDimension.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewSchemaDimensionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeGrainRequestMultiError) Error() string {
+func (m MetricsViewSchemaDimensionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11065,11 +12589,11 @@ func (m ColumnTimeGrainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeGrainRequestMultiError) AllErrors() []error { return m }
+func (m MetricsViewSchemaDimensionMultiError) AllErrors() []error { return m }
 
-// ColumnTimeGrainRequestValidationError is the validation error returned by
-// ColumnTimeGrainRequest.Validate if the designated constraints aren't met.
-type ColumnTimeGrainRequestValidationError struct {
+// MetricsViewSchemaDimensionValidationError is the validation error returned
+// by MetricsViewSchemaDimension.Validate if the designated constraints aren't met.
+type MetricsViewSchemaDimensionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11077,24 +12601,24 @@ type ColumnTimeGrainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeGrainRequestValidationError) Field() string { return e.field }
+func (e MetricsViewSchemaDimensionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeGrainRequestValidationError) Reason() string { return e.reason }
+func (e MetricsViewSchemaDimensionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeGrainRequestValidationError) Cause() error { return e.cause }
+func (e MetricsViewSchemaDimensionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeGrainRequestValidationError) Key() bool { return e.key }
+func (e MetricsViewSchemaDimensionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeGrainRequestValidationError) ErrorName() string {
-	return "ColumnTimeGrainRequestValidationError"
+func (e MetricsViewSchemaDimensionValidationError) ErrorName() string {
+	return "MetricsViewSchemaDimensionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeGrainRequestValidationError) Error() string {
+func (e MetricsViewSchemaDimensionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11106,14 +12630,14 @@ func (e ColumnTimeGrainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeGrainRequest.%s: %s%s",
+		"invalid %sMetricsViewSchemaDimension.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeGrainRequestValidationError{}
+var _ error = MetricsViewSchemaDimensionValidationError{}
 
 var _ interface {
 	Field() string
@@ -11121,59 +12645,98 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeGrainRequestValidationError{}
+} = MetricsViewSchemaDimensionValidationError{}
 
-// Validate checks the field values on ColumnTimeGrainResponse with the rules
+// Validate checks the field values on MetricsViewSchemaMeasure with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeGrainResponse) Validate() error {
+func (m *MetricsViewSchemaMeasure) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeGrainResponse with the
+// ValidateAll checks the field values on MetricsViewSchemaMeasure with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeGrainResponseMultiError, or nil if none found.
-func (m *ColumnTimeGrainResponse) ValidateAll() error {
+// MetricsViewSchemaMeasureMultiError, or nil if none found.
+func (m *MetricsViewSchemaMeasure) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeGrainResponse) validate(all bool) error {
+func (m *MetricsViewSchemaMeasure) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TimeGrain
-
-	if len(errors) > 0 {
-		return ColumnTimeGrainResponseMultiError(errors)
-	}
-
-	return nil
-}
+	// no validation rules for Name
 
-// ColumnTimeGrainResponseMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeGrainResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnTimeGrainResponseMultiError []error
+	// no validation rules for Label
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeGrainResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSchemaMeasureValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSchemaMeasureValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSchemaMeasureValidationError{
+				field:  "Type",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FormatPreset
+
+	// no validation rules for FormatD3
+
+	// no validation rules for ValidPercentOfTotal
+
+	if len(errors) > 0 {
+		return MetricsViewSchemaMeasureMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSchemaMeasureMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSchemaMeasure.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSchemaMeasureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSchemaMeasureMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeGrainResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewSchemaMeasureMultiError) AllErrors() []error { return m }
 
-// ColumnTimeGrainResponseValidationError is the validation error returned by
-// ColumnTimeGrainResponse.Validate if the designated constraints aren't met.
-type ColumnTimeGrainResponseValidationError struct {
+// MetricsViewSchemaMeasureValidationError is the validation error returned by
+// MetricsViewSchemaMeasure.Validate if the designated constraints aren't met.
+type MetricsViewSchemaMeasureValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11181,24 +12744,24 @@ type ColumnTimeGrainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeGrainResponseValidationError) Field() string { return e.field }
+func (e MetricsViewSchemaMeasureValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeGrainResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewSchemaMeasureValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeGrainResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewSchemaMeasureValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeGrainResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewSchemaMeasureValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeGrainResponseValidationError) ErrorName() string {
-	return "ColumnTimeGrainResponseValidationError"
+func (e MetricsViewSchemaMeasureValidationError) ErrorName() string {
+	return "MetricsViewSchemaMeasureValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeGrainResponseValidationError) Error() string {
+func (e MetricsViewSchemaMeasureValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11210,14 +12773,14 @@ func (e ColumnTimeGrainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeGrainResponse.%s: %s%s",
+		"invalid %sMetricsViewSchemaMeasure.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeGrainResponseValidationError{}
+var _ error = MetricsViewSchemaMeasureValidationError{}
 
 var _ interface {
 	Field() string
@@ -11225,24 +12788,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeGrainResponseValidationError{}
+} = MetricsViewSchemaMeasureValidationError{}
 
-// Validate checks the field values on ColumnNumericHistogramRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnNumericHistogramRequest) Validate() error {
+func (m *MetricsViewSearchRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnNumericHistogramRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ColumnNumericHistogramRequestMultiError, or nil if none found.
-func (m *ColumnNumericHistogramRequest) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSearchRequestMultiError, or nil if none found.
+func (m *MetricsViewSearchRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnNumericHistogramRequest) validate(all bool) error {
+func (m *MetricsViewSearchRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11251,15 +12814,9 @@ func (m *ColumnNumericHistogramRequest) validate(all bool) error {
 
 	// no validation rules for InstanceId
 
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
-
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnNumericHistogramRequestValidationError{
-			field:  "TableName",
+	if utf8.RuneCountInString(m.GetMetricsViewName()) < 1 {
+		err := MetricsViewSearchRequestValidationError{
+			field:  "MetricsViewName",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -11268,10 +12825,99 @@ func (m *ColumnNumericHistogramRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnNumericHistogramRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for Search
+
+	if all {
+		switch v := interface{}(m.GetTimeRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSearchRequestValidationError{
+				field:  "TimeRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSearchRequestValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHaving()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "Having",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSearchRequestValidationError{
+					field:  "Having",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHaving()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSearchRequestValidationError{
+				field:  "Having",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetLimit() < 0 {
+		err := MetricsViewSearchRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -11279,24 +12925,24 @@ func (m *ColumnNumericHistogramRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for HistogramMethod
-
 	// no validation rules for Priority
 
+	// no validation rules for AccentInsensitive
+
 	if len(errors) > 0 {
-		return ColumnNumericHistogramRequestMultiError(errors)
+		return MetricsViewSearchRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnNumericHistogramRequestMultiError is an error wrapping multiple
-// validation errors returned by ColumnNumericHistogramRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ColumnNumericHistogramRequestMultiError []error
+// MetricsViewSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSearchRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnNumericHistogramRequestMultiError) Error() string {
+func (m MetricsViewSearchRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11305,12 +12951,11 @@ func (m ColumnNumericHistogramRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnNumericHistogramRequestMultiError) AllErrors() []error { return m }
+func (m MetricsViewSearchRequestMultiError) AllErrors() []error { return m }
 
-// ColumnNumericHistogramRequestValidationError is the validation error
-// returned by ColumnNumericHistogramRequest.Validate if the designated
-// constraints aren't met.
-type ColumnNumericHistogramRequestValidationError struct {
+// MetricsViewSearchRequestValidationError is the validation error returned by
+// MetricsViewSearchRequest.Validate if the designated constraints aren't met.
+type MetricsViewSearchRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11318,24 +12963,24 @@ type ColumnNumericHistogramRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnNumericHistogramRequestValidationError) Field() string { return e.field }
+func (e MetricsViewSearchRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnNumericHistogramRequestValidationError) Reason() string { return e.reason }
+func (e MetricsViewSearchRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnNumericHistogramRequestValidationError) Cause() error { return e.cause }
+func (e MetricsViewSearchRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnNumericHistogramRequestValidationError) Key() bool { return e.key }
+func (e MetricsViewSearchRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnNumericHistogramRequestValidationError) ErrorName() string {
-	return "ColumnNumericHistogramRequestValidationError"
+func (e MetricsViewSearchRequestValidationError) ErrorName() string {
+	return "MetricsViewSearchRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnNumericHistogramRequestValidationError) Error() string {
+func (e MetricsViewSearchRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11347,14 +12992,14 @@ func (e ColumnNumericHistogramRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnNumericHistogramRequest.%s: %s%s",
+		"invalid %sMetricsViewSearchRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnNumericHistogramRequestValidationError{}
+var _ error = MetricsViewSearchRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11362,73 +13007,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnNumericHistogramRequestValidationError{}
+} = MetricsViewSearchRequestValidationError{}
 
-// Validate checks the field values on ColumnNumericHistogramResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MetricsViewSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnNumericHistogramResponse) Validate() error {
+func (m *MetricsViewSearchResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnNumericHistogramResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ColumnNumericHistogramResponseMultiError, or nil if none found.
-func (m *ColumnNumericHistogramResponse) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewSearchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSearchResponseMultiError, or nil if none found.
+func (m *MetricsViewSearchResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnNumericHistogramResponse) validate(all bool) error {
+func (m *MetricsViewSearchResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetNumericSummary()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnNumericHistogramResponseValidationError{
-					field:  "NumericSummary",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnNumericHistogramResponseValidationError{
-					field:  "NumericSummary",
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSearchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSearchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSearchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnNumericHistogramResponseValidationError{
-				field:  "NumericSummary",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return ColumnNumericHistogramResponseMultiError(errors)
+		return MetricsViewSearchResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnNumericHistogramResponseMultiError is an error wrapping multiple
-// validation errors returned by ColumnNumericHistogramResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ColumnNumericHistogramResponseMultiError []error
+// MetricsViewSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSearchResponse.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewSearchResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnNumericHistogramResponseMultiError) Error() string {
+func (m MetricsViewSearchResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11437,12 +13087,11 @@ func (m ColumnNumericHistogramResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnNumericHistogramResponseMultiError) AllErrors() []error { return m }
+func (m MetricsViewSearchResponseMultiError) AllErrors() []error { return m }
 
-// ColumnNumericHistogramResponseValidationError is the validation error
-// returned by ColumnNumericHistogramResponse.Validate if the designated
-// constraints aren't met.
-type ColumnNumericHistogramResponseValidationError struct {
+// MetricsViewSearchResponseValidationError is the validation error returned by
+// MetricsViewSearchResponse.Validate if the designated constraints aren't met.
+type MetricsViewSearchResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11450,24 +13099,24 @@ type ColumnNumericHistogramResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnNumericHistogramResponseValidationError) Field() string { return e.field }
+func (e MetricsViewSearchResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnNumericHistogramResponseValidationError) Reason() string { return e.reason }
+func (e MetricsViewSearchResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnNumericHistogramResponseValidationError) Cause() error { return e.cause }
+func (e MetricsViewSearchResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnNumericHistogramResponseValidationError) Key() bool { return e.key }
+func (e MetricsViewSearchResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnNumericHistogramResponseValidationError) ErrorName() string {
-	return "ColumnNumericHistogramResponseValidationError"
+func (e MetricsViewSearchResponseValidationError) ErrorName() string {
+	return "MetricsViewSearchResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnNumericHistogramResponseValidationError) Error() string {
+func (e MetricsViewSearchResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11479,14 +13128,14 @@ func (e ColumnNumericHistogramResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnNumericHistogramResponse.%s: %s%s",
+		"invalid %sMetricsViewSearchResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnNumericHistogramResponseValidationError{}
+var _ error = MetricsViewSearchResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11494,24 +13143,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnNumericHistogramResponseValidationError{}
+} = MetricsViewSearchResponseValidationError{}
 
-// Validate checks the field values on ColumnRugHistogramRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ColumnRollupIntervalRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnRugHistogramRequest) Validate() error {
+func (m *ColumnRollupIntervalRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnRugHistogramRequest with the
+// ValidateAll checks the field values on ColumnRollupIntervalRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnRugHistogramRequestMultiError, or nil if none found.
-func (m *ColumnRugHistogramRequest) ValidateAll() error {
+// ColumnRollupIntervalRequestMultiError, or nil if none found.
+func (m *ColumnRollupIntervalRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnRugHistogramRequest) validate(all bool) error {
+func (m *ColumnRollupIntervalRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11527,7 +13176,7 @@ func (m *ColumnRugHistogramRequest) validate(all bool) error {
 	// no validation rules for DatabaseSchema
 
 	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnRugHistogramRequestValidationError{
+		err := ColumnRollupIntervalRequestValidationError{
 			field:  "TableName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11538,7 +13187,7 @@ func (m *ColumnRugHistogramRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnRugHistogramRequestValidationError{
+		err := ColumnRollupIntervalRequestValidationError{
 			field:  "ColumnName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11551,19 +13200,19 @@ func (m *ColumnRugHistogramRequest) validate(all bool) error {
 	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return ColumnRugHistogramRequestMultiError(errors)
+		return ColumnRollupIntervalRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnRugHistogramRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnRugHistogramRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ColumnRugHistogramRequestMultiError []error
+// ColumnRollupIntervalRequestMultiError is an error wrapping multiple
+// validation errors returned by ColumnRollupIntervalRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ColumnRollupIntervalRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnRugHistogramRequestMultiError) Error() string {
+func (m ColumnRollupIntervalRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11572,11 +13221,12 @@ func (m ColumnRugHistogramRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnRugHistogramRequestMultiError) AllErrors() []error { return m }
+func (m ColumnRollupIntervalRequestMultiError) AllErrors() []error { return m }
 
-// ColumnRugHistogramRequestValidationError is the validation error returned by
-// ColumnRugHistogramRequest.Validate if the designated constraints aren't met.
-type ColumnRugHistogramRequestValidationError struct {
+// ColumnRollupIntervalRequestValidationError is the validation error returned
+// by ColumnRollupIntervalRequest.Validate if the designated constraints
+// aren't met.
+type ColumnRollupIntervalRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11584,24 +13234,24 @@ type ColumnRugHistogramRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnRugHistogramRequestValidationError) Field() string { return e.field }
+func (e ColumnRollupIntervalRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnRugHistogramRequestValidationError) Reason() string { return e.reason }
+func (e ColumnRollupIntervalRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnRugHistogramRequestValidationError) Cause() error { return e.cause }
+func (e ColumnRollupIntervalRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnRugHistogramRequestValidationError) Key() bool { return e.key }
+func (e ColumnRollupIntervalRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnRugHistogramRequestValidationError) ErrorName() string {
-	return "ColumnRugHistogramRequestValidationError"
+func (e ColumnRollupIntervalRequestValidationError) ErrorName() string {
+	return "ColumnRollupIntervalRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnRugHistogramRequestValidationError) Error() string {
+func (e ColumnRollupIntervalRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11613,14 +13263,14 @@ func (e ColumnRugHistogramRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnRugHistogramRequest.%s: %s%s",
+		"invalid %sColumnRollupIntervalRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnRugHistogramRequestValidationError{}
+var _ error = ColumnRollupIntervalRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11628,24 +13278,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnRugHistogramRequestValidationError{}
+} = ColumnRollupIntervalRequestValidationError{}
 
-// Validate checks the field values on ColumnRugHistogramResponse with the
+// Validate checks the field values on ColumnRollupIntervalResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnRugHistogramResponse) Validate() error {
+func (m *ColumnRollupIntervalResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnRugHistogramResponse with the
+// ValidateAll checks the field values on ColumnRollupIntervalResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnRugHistogramResponseMultiError, or nil if none found.
-func (m *ColumnRugHistogramResponse) ValidateAll() error {
+// ColumnRollupIntervalResponseMultiError, or nil if none found.
+func (m *ColumnRollupIntervalResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnRugHistogramResponse) validate(all bool) error {
+func (m *ColumnRollupIntervalResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11653,48 +13303,79 @@ func (m *ColumnRugHistogramResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetNumericSummary()).(type) {
+		switch v := interface{}(m.GetStart()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnRugHistogramResponseValidationError{
-					field:  "NumericSummary",
+				errors = append(errors, ColumnRollupIntervalResponseValidationError{
+					field:  "Start",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnRugHistogramResponseValidationError{
-					field:  "NumericSummary",
+				errors = append(errors, ColumnRollupIntervalResponseValidationError{
+					field:  "Start",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ColumnRugHistogramResponseValidationError{
-				field:  "NumericSummary",
+			return ColumnRollupIntervalResponseValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnRollupIntervalResponseValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnRollupIntervalResponseValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnRollupIntervalResponseValidationError{
+				field:  "End",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Interval
+
 	if len(errors) > 0 {
-		return ColumnRugHistogramResponseMultiError(errors)
+		return ColumnRollupIntervalResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnRugHistogramResponseMultiError is an error wrapping multiple
-// validation errors returned by ColumnRugHistogramResponse.ValidateAll() if
+// ColumnRollupIntervalResponseMultiError is an error wrapping multiple
+// validation errors returned by ColumnRollupIntervalResponse.ValidateAll() if
 // the designated constraints aren't met.
-type ColumnRugHistogramResponseMultiError []error
+type ColumnRollupIntervalResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnRugHistogramResponseMultiError) Error() string {
+func (m ColumnRollupIntervalResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11703,11 +13384,12 @@ func (m ColumnRugHistogramResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnRugHistogramResponseMultiError) AllErrors() []error { return m }
+func (m ColumnRollupIntervalResponseMultiError) AllErrors() []error { return m }
 
-// ColumnRugHistogramResponseValidationError is the validation error returned
-// by ColumnRugHistogramResponse.Validate if the designated constraints aren't met.
-type ColumnRugHistogramResponseValidationError struct {
+// ColumnRollupIntervalResponseValidationError is the validation error returned
+// by ColumnRollupIntervalResponse.Validate if the designated constraints
+// aren't met.
+type ColumnRollupIntervalResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11715,24 +13397,24 @@ type ColumnRugHistogramResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnRugHistogramResponseValidationError) Field() string { return e.field }
+func (e ColumnRollupIntervalResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnRugHistogramResponseValidationError) Reason() string { return e.reason }
+func (e ColumnRollupIntervalResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnRugHistogramResponseValidationError) Cause() error { return e.cause }
+func (e ColumnRollupIntervalResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnRugHistogramResponseValidationError) Key() bool { return e.key }
+func (e ColumnRollupIntervalResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnRugHistogramResponseValidationError) ErrorName() string {
-	return "ColumnRugHistogramResponseValidationError"
+func (e ColumnRollupIntervalResponseValidationError) ErrorName() string {
+	return "ColumnRollupIntervalResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnRugHistogramResponseValidationError) Error() string {
+func (e ColumnRollupIntervalResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11744,14 +13426,14 @@ func (e ColumnRugHistogramResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnRugHistogramResponse.%s: %s%s",
+		"invalid %sColumnRollupIntervalResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnRugHistogramResponseValidationError{}
+var _ error = ColumnRollupIntervalResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11759,24 +13441,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnRugHistogramResponseValidationError{}
+} = ColumnRollupIntervalResponseValidationError{}
 
-// Validate checks the field values on ColumnTimeRangeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeRangeRequest) Validate() error {
+// Validate checks the field values on ColumnTopKRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTopKRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeRangeRequest with the rules
+// ValidateAll checks the field values on ColumnTopKRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeRangeRequestMultiError, or nil if none found.
-func (m *ColumnTimeRangeRequest) ValidateAll() error {
+// ColumnTopKRequestMultiError, or nil if none found.
+func (m *ColumnTopKRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeRangeRequest) validate(all bool) error {
+func (m *ColumnTopKRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11792,7 +13474,7 @@ func (m *ColumnTimeRangeRequest) validate(all bool) error {
 	// no validation rules for DatabaseSchema
 
 	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnTimeRangeRequestValidationError{
+		err := ColumnTopKRequestValidationError{
 			field:  "TableName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11803,7 +13485,7 @@ func (m *ColumnTimeRangeRequest) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnTimeRangeRequestValidationError{
+		err := ColumnTopKRequestValidationError{
 			field:  "ColumnName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11813,22 +13495,26 @@ func (m *ColumnTimeRangeRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Agg
+
+	// no validation rules for K
+
 	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return ColumnTimeRangeRequestMultiError(errors)
+		return ColumnTopKRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTimeRangeRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeRangeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnTimeRangeRequestMultiError []error
+// ColumnTopKRequestMultiError is an error wrapping multiple validation errors
+// returned by ColumnTopKRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnTopKRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeRangeRequestMultiError) Error() string {
+func (m ColumnTopKRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11837,11 +13523,11 @@ func (m ColumnTimeRangeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeRangeRequestMultiError) AllErrors() []error { return m }
+func (m ColumnTopKRequestMultiError) AllErrors() []error { return m }
 
-// ColumnTimeRangeRequestValidationError is the validation error returned by
-// ColumnTimeRangeRequest.Validate if the designated constraints aren't met.
-type ColumnTimeRangeRequestValidationError struct {
+// ColumnTopKRequestValidationError is the validation error returned by
+// ColumnTopKRequest.Validate if the designated constraints aren't met.
+type ColumnTopKRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11849,24 +13535,24 @@ type ColumnTimeRangeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeRangeRequestValidationError) Field() string { return e.field }
+func (e ColumnTopKRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeRangeRequestValidationError) Reason() string { return e.reason }
+func (e ColumnTopKRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeRangeRequestValidationError) Cause() error { return e.cause }
+func (e ColumnTopKRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeRangeRequestValidationError) Key() bool { return e.key }
+func (e ColumnTopKRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeRangeRequestValidationError) ErrorName() string {
-	return "ColumnTimeRangeRequestValidationError"
+func (e ColumnTopKRequestValidationError) ErrorName() string {
+	return "ColumnTopKRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeRangeRequestValidationError) Error() string {
+func (e ColumnTopKRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11878,14 +13564,14 @@ func (e ColumnTimeRangeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeRangeRequest.%s: %s%s",
+		"invalid %sColumnTopKRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeRangeRequestValidationError{}
+var _ error = ColumnTopKRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11893,24 +13579,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeRangeRequestValidationError{}
+} = ColumnTopKRequestValidationError{}
 
-// Validate checks the field values on ColumnTimeRangeResponse with the rules
+// Validate checks the field values on ColumnTopKResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeRangeResponse) Validate() error {
+func (m *ColumnTopKResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeRangeResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ColumnTopKResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeRangeResponseMultiError, or nil if none found.
-func (m *ColumnTimeRangeResponse) ValidateAll() error {
+// ColumnTopKResponseMultiError, or nil if none found.
+func (m *ColumnTopKResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeRangeResponse) validate(all bool) error {
+func (m *ColumnTopKResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11918,28 +13604,28 @@ func (m *ColumnTimeRangeResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetTimeRangeSummary()).(type) {
+		switch v := interface{}(m.GetCategoricalSummary()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnTimeRangeResponseValidationError{
-					field:  "TimeRangeSummary",
+				errors = append(errors, ColumnTopKResponseValidationError{
+					field:  "CategoricalSummary",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnTimeRangeResponseValidationError{
-					field:  "TimeRangeSummary",
+				errors = append(errors, ColumnTopKResponseValidationError{
+					field:  "CategoricalSummary",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimeRangeSummary()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCategoricalSummary()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ColumnTimeRangeResponseValidationError{
-				field:  "TimeRangeSummary",
+			return ColumnTopKResponseValidationError{
+				field:  "CategoricalSummary",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -11947,19 +13633,19 @@ func (m *ColumnTimeRangeResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ColumnTimeRangeResponseMultiError(errors)
+		return ColumnTopKResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTimeRangeResponseMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeRangeResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnTimeRangeResponseMultiError []error
+// ColumnTopKResponseMultiError is an error wrapping multiple validation errors
+// returned by ColumnTopKResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnTopKResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeRangeResponseMultiError) Error() string {
+func (m ColumnTopKResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11968,11 +13654,11 @@ func (m ColumnTimeRangeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeRangeResponseMultiError) AllErrors() []error { return m }
+func (m ColumnTopKResponseMultiError) AllErrors() []error { return m }
 
-// ColumnTimeRangeResponseValidationError is the validation error returned by
-// ColumnTimeRangeResponse.Validate if the designated constraints aren't met.
-type ColumnTimeRangeResponseValidationError struct {
+// ColumnTopKResponseValidationError is the validation error returned by
+// ColumnTopKResponse.Validate if the designated constraints aren't met.
+type ColumnTopKResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11980,24 +13666,24 @@ type ColumnTimeRangeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeRangeResponseValidationError) Field() string { return e.field }
+func (e ColumnTopKResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeRangeResponseValidationError) Reason() string { return e.reason }
+func (e ColumnTopKResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeRangeResponseValidationError) Cause() error { return e.cause }
+func (e ColumnTopKResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeRangeResponseValidationError) Key() bool { return e.key }
+func (e ColumnTopKResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeRangeResponseValidationError) ErrorName() string {
-	return "ColumnTimeRangeResponseValidationError"
+func (e ColumnTopKResponseValidationError) ErrorName() string {
+	return "ColumnTopKResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeRangeResponseValidationError) Error() string {
+func (e ColumnTopKResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12009,14 +13695,14 @@ func (e ColumnTimeRangeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeRangeResponse.%s: %s%s",
+		"invalid %sColumnTopKResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeRangeResponseValidationError{}
+var _ error = ColumnTopKResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12024,131 +13710,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeRangeResponseValidationError{}
+} = ColumnTopKResponseValidationError{}
 
-// Validate checks the field values on TimeRangeSummary with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TimeRangeSummary) Validate() error {
+// Validate checks the field values on CategoricalSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CategoricalSummary) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TimeRangeSummary with the rules
+// ValidateAll checks the field values on CategoricalSummary with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TimeRangeSummaryMultiError, or nil if none found.
-func (m *TimeRangeSummary) ValidateAll() error {
+// CategoricalSummaryMultiError, or nil if none found.
+func (m *CategoricalSummary) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TimeRangeSummary) validate(all bool) error {
+func (m *CategoricalSummary) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetMin()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Min",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Min",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	switch v := m.Case.(type) {
+	case *CategoricalSummary_TopK:
+		if v == nil {
+			err := CategoricalSummaryValidationError{
+				field:  "Case",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		}
-	} else if v, ok := interface{}(m.GetMin()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeRangeSummaryValidationError{
-				field:  "Min",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetMax()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Max",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(m.GetTopK()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CategoricalSummaryValidationError{
+						field:  "TopK",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CategoricalSummaryValidationError{
+						field:  "TopK",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(m.GetTopK()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Max",
+				return CategoricalSummaryValidationError{
+					field:  "TopK",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMax()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeRangeSummaryValidationError{
-				field:  "Max",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetInterval()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Interval",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeRangeSummaryValidationError{
-					field:  "Interval",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	case *CategoricalSummary_Cardinality:
+		if v == nil {
+			err := CategoricalSummaryValidationError{
+				field:  "Case",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		}
-	} else if v, ok := interface{}(m.GetInterval()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeRangeSummaryValidationError{
-				field:  "Interval",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+		// no validation rules for Cardinality
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return TimeRangeSummaryMultiError(errors)
+		return CategoricalSummaryMultiError(errors)
 	}
 
 	return nil
 }
 
-// TimeRangeSummaryMultiError is an error wrapping multiple validation errors
-// returned by TimeRangeSummary.ValidateAll() if the designated constraints
+// CategoricalSummaryMultiError is an error wrapping multiple validation errors
+// returned by CategoricalSummary.ValidateAll() if the designated constraints
 // aren't met.
-type TimeRangeSummaryMultiError []error
+type CategoricalSummaryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TimeRangeSummaryMultiError) Error() string {
+func (m CategoricalSummaryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12157,11 +13814,11 @@ func (m TimeRangeSummaryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TimeRangeSummaryMultiError) AllErrors() []error { return m }
+func (m CategoricalSummaryMultiError) AllErrors() []error { return m }
 
-// TimeRangeSummaryValidationError is the validation error returned by
-// TimeRangeSummary.Validate if the designated constraints aren't met.
-type TimeRangeSummaryValidationError struct {
+// CategoricalSummaryValidationError is the validation error returned by
+// CategoricalSummary.Validate if the designated constraints aren't met.
+type CategoricalSummaryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12169,22 +13826,24 @@ type TimeRangeSummaryValidationError struct {
 }
 
 // Field function returns field value.
-func (e TimeRangeSummaryValidationError) Field() string { return e.field }
+func (e CategoricalSummaryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TimeRangeSummaryValidationError) Reason() string { return e.reason }
+func (e CategoricalSummaryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TimeRangeSummaryValidationError) Cause() error { return e.cause }
+func (e CategoricalSummaryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TimeRangeSummaryValidationError) Key() bool { return e.key }
+func (e CategoricalSummaryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TimeRangeSummaryValidationError) ErrorName() string { return "TimeRangeSummaryValidationError" }
+func (e CategoricalSummaryValidationError) ErrorName() string {
+	return "CategoricalSummaryValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TimeRangeSummaryValidationError) Error() string {
+func (e CategoricalSummaryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12196,14 +13855,14 @@ func (e TimeRangeSummaryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTimeRangeSummary.%s: %s%s",
+		"invalid %sCategoricalSummary.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TimeRangeSummaryValidationError{}
+var _ error = CategoricalSummaryValidationError{}
 
 var _ interface {
 	Field() string
@@ -12211,76 +13870,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TimeRangeSummaryValidationError{}
+} = CategoricalSummaryValidationError{}
 
-// Validate checks the field values on ColumnCardinalityRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnCardinalityRequest) Validate() error {
+// Validate checks the field values on TopK with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *TopK) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnCardinalityRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ColumnCardinalityRequestMultiError, or nil if none found.
-func (m *ColumnCardinalityRequest) ValidateAll() error {
+// ValidateAll checks the field values on TopK with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TopKMultiError, or nil if none found.
+func (m *TopK) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnCardinalityRequest) validate(all bool) error {
+func (m *TopK) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnCardinalityRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TopKValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TopKValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TopKValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
-		err := ColumnCardinalityRequestValidationError{
-			field:  "ColumnName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Priority
-
 	if len(errors) > 0 {
-		return ColumnCardinalityRequestMultiError(errors)
+		return TopKMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnCardinalityRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnCardinalityRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ColumnCardinalityRequestMultiError []error
+// TopKMultiError is an error wrapping multiple validation errors returned by
+// TopK.ValidateAll() if the designated constraints aren't met.
+type TopKMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnCardinalityRequestMultiError) Error() string {
+func (m TopKMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12289,11 +13948,11 @@ func (m ColumnCardinalityRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnCardinalityRequestMultiError) AllErrors() []error { return m }
+func (m TopKMultiError) AllErrors() []error { return m }
 
-// ColumnCardinalityRequestValidationError is the validation error returned by
-// ColumnCardinalityRequest.Validate if the designated constraints aren't met.
-type ColumnCardinalityRequestValidationError struct {
+// TopKValidationError is the validation error returned by TopK.Validate if the
+// designated constraints aren't met.
+type TopKValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12301,24 +13960,22 @@ type ColumnCardinalityRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnCardinalityRequestValidationError) Field() string { return e.field }
+func (e TopKValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnCardinalityRequestValidationError) Reason() string { return e.reason }
+func (e TopKValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnCardinalityRequestValidationError) Cause() error { return e.cause }
+func (e TopKValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnCardinalityRequestValidationError) Key() bool { return e.key }
+func (e TopKValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnCardinalityRequestValidationError) ErrorName() string {
-	return "ColumnCardinalityRequestValidationError"
-}
+func (e TopKValidationError) ErrorName() string { return "TopKValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ColumnCardinalityRequestValidationError) Error() string {
+func (e TopKValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12330,14 +13987,14 @@ func (e ColumnCardinalityRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnCardinalityRequest.%s: %s%s",
+		"invalid %sTopK.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnCardinalityRequestValidationError{}
+var _ error = TopKValidationError{}
 
 var _ interface {
 	Field() string
@@ -12345,73 +14002,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnCardinalityRequestValidationError{}
+} = TopKValidationError{}
 
-// Validate checks the field values on ColumnCardinalityResponse with the rules
+// Validate checks the field values on ColumnNullCountRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnCardinalityResponse) Validate() error {
+func (m *ColumnNullCountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnCardinalityResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ColumnNullCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnCardinalityResponseMultiError, or nil if none found.
-func (m *ColumnCardinalityResponse) ValidateAll() error {
+// ColumnNullCountRequestMultiError, or nil if none found.
+func (m *ColumnNullCountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnCardinalityResponse) validate(all bool) error {
+func (m *ColumnNullCountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCategoricalSummary()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnCardinalityResponseValidationError{
-					field:  "CategoricalSummary",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnCardinalityResponseValidationError{
-					field:  "CategoricalSummary",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnNullCountRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetCategoricalSummary()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnCardinalityResponseValidationError{
-				field:  "CategoricalSummary",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnNullCountRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return ColumnCardinalityResponseMultiError(errors)
+		return ColumnNullCountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnCardinalityResponseMultiError is an error wrapping multiple validation
-// errors returned by ColumnCardinalityResponse.ValidateAll() if the
-// designated constraints aren't met.
-type ColumnCardinalityResponseMultiError []error
+// ColumnNullCountRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnNullCountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnNullCountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnCardinalityResponseMultiError) Error() string {
+func (m ColumnNullCountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12420,11 +14080,11 @@ func (m ColumnCardinalityResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnCardinalityResponseMultiError) AllErrors() []error { return m }
+func (m ColumnNullCountRequestMultiError) AllErrors() []error { return m }
 
-// ColumnCardinalityResponseValidationError is the validation error returned by
-// ColumnCardinalityResponse.Validate if the designated constraints aren't met.
-type ColumnCardinalityResponseValidationError struct {
+// ColumnNullCountRequestValidationError is the validation error returned by
+// ColumnNullCountRequest.Validate if the designated constraints aren't met.
+type ColumnNullCountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12432,24 +14092,24 @@ type ColumnCardinalityResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnCardinalityResponseValidationError) Field() string { return e.field }
+func (e ColumnNullCountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnCardinalityResponseValidationError) Reason() string { return e.reason }
+func (e ColumnNullCountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnCardinalityResponseValidationError) Cause() error { return e.cause }
+func (e ColumnNullCountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnCardinalityResponseValidationError) Key() bool { return e.key }
+func (e ColumnNullCountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnCardinalityResponseValidationError) ErrorName() string {
-	return "ColumnCardinalityResponseValidationError"
+func (e ColumnNullCountRequestValidationError) ErrorName() string {
+	return "ColumnNullCountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnCardinalityResponseValidationError) Error() string {
+func (e ColumnNullCountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12461,14 +14121,14 @@ func (e ColumnCardinalityResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnCardinalityResponse.%s: %s%s",
+		"invalid %sColumnNullCountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnCardinalityResponseValidationError{}
+var _ error = ColumnNullCountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12476,24 +14136,129 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnCardinalityResponseValidationError{}
+} = ColumnNullCountRequestValidationError{}
 
-// Validate checks the field values on ColumnTimeSeriesRequest with the rules
+// Validate checks the field values on ColumnNullCountResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeSeriesRequest) Validate() error {
+func (m *ColumnNullCountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeSeriesRequest with the
+// ValidateAll checks the field values on ColumnNullCountResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeSeriesRequestMultiError, or nil if none found.
-func (m *ColumnTimeSeriesRequest) ValidateAll() error {
+// ColumnNullCountResponseMultiError, or nil if none found.
+func (m *ColumnNullCountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeSeriesRequest) validate(all bool) error {
+func (m *ColumnNullCountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ColumnNullCountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnNullCountResponseMultiError is an error wrapping multiple validation
+// errors returned by ColumnNullCountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnNullCountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnNullCountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnNullCountResponseMultiError) AllErrors() []error { return m }
+
+// ColumnNullCountResponseValidationError is the validation error returned by
+// ColumnNullCountResponse.Validate if the designated constraints aren't met.
+type ColumnNullCountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnNullCountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnNullCountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnNullCountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnNullCountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnNullCountResponseValidationError) ErrorName() string {
+	return "ColumnNullCountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnNullCountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnNullCountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnNullCountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnNullCountResponseValidationError{}
+
+// Validate checks the field values on ColumnDescriptiveStatisticsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ColumnDescriptiveStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnDescriptiveStatisticsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ColumnDescriptiveStatisticsRequestMultiError, or nil if none found.
+func (m *ColumnDescriptiveStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnDescriptiveStatisticsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12509,7 +14274,7 @@ func (m *ColumnTimeSeriesRequest) validate(all bool) error {
 	// no validation rules for DatabaseSchema
 
 	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := ColumnTimeSeriesRequestValidationError{
+		err := ColumnDescriptiveStatisticsRequestValidationError{
 			field:  "TableName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -12519,34 +14284,2945 @@ func (m *ColumnTimeSeriesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetMeasures()) < 1 {
-		err := ColumnTimeSeriesRequestValidationError{
-			field:  "Measures",
-			reason: "value must contain at least 1 item(s)",
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnDescriptiveStatisticsRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
 		}
-		errors = append(errors, err)
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Priority
+
+	if len(errors) > 0 {
+		return ColumnDescriptiveStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnDescriptiveStatisticsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ColumnDescriptiveStatisticsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnDescriptiveStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnDescriptiveStatisticsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnDescriptiveStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// ColumnDescriptiveStatisticsRequestValidationError is the validation error
+// returned by ColumnDescriptiveStatisticsRequest.Validate if the designated
+// constraints aren't met.
+type ColumnDescriptiveStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnDescriptiveStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnDescriptiveStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnDescriptiveStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnDescriptiveStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnDescriptiveStatisticsRequestValidationError) ErrorName() string {
+	return "ColumnDescriptiveStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnDescriptiveStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnDescriptiveStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnDescriptiveStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnDescriptiveStatisticsRequestValidationError{}
+
+// Validate checks the field values on ColumnDescriptiveStatisticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ColumnDescriptiveStatisticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnDescriptiveStatisticsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ColumnDescriptiveStatisticsResponseMultiError, or nil if none found.
+func (m *ColumnDescriptiveStatisticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnDescriptiveStatisticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNumericSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnDescriptiveStatisticsResponseValidationError{
+					field:  "NumericSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnDescriptiveStatisticsResponseValidationError{
+					field:  "NumericSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnDescriptiveStatisticsResponseValidationError{
+				field:  "NumericSummary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ColumnDescriptiveStatisticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnDescriptiveStatisticsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ColumnDescriptiveStatisticsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnDescriptiveStatisticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnDescriptiveStatisticsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnDescriptiveStatisticsResponseMultiError) AllErrors() []error { return m }
+
+// ColumnDescriptiveStatisticsResponseValidationError is the validation error
+// returned by ColumnDescriptiveStatisticsResponse.Validate if the designated
+// constraints aren't met.
+type ColumnDescriptiveStatisticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnDescriptiveStatisticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnDescriptiveStatisticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnDescriptiveStatisticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnDescriptiveStatisticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnDescriptiveStatisticsResponseValidationError) ErrorName() string {
+	return "ColumnDescriptiveStatisticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnDescriptiveStatisticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnDescriptiveStatisticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnDescriptiveStatisticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnDescriptiveStatisticsResponseValidationError{}
+
+// Validate checks the field values on NumericSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NumericSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NumericSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NumericSummaryMultiError,
+// or nil if none found.
+func (m *NumericSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NumericSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Case.(type) {
+	case *NumericSummary_NumericHistogramBins:
+		if v == nil {
+			err := NumericSummaryValidationError{
+				field:  "Case",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNumericHistogramBins()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericHistogramBins",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericHistogramBins",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNumericHistogramBins()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NumericSummaryValidationError{
+					field:  "NumericHistogramBins",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *NumericSummary_NumericStatistics:
+		if v == nil {
+			err := NumericSummaryValidationError{
+				field:  "Case",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNumericStatistics()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericStatistics",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericStatistics",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNumericStatistics()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NumericSummaryValidationError{
+					field:  "NumericStatistics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *NumericSummary_NumericOutliers:
+		if v == nil {
+			err := NumericSummaryValidationError{
+				field:  "Case",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNumericOutliers()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericOutliers",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NumericSummaryValidationError{
+						field:  "NumericOutliers",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNumericOutliers()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NumericSummaryValidationError{
+					field:  "NumericOutliers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return NumericSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// NumericSummaryMultiError is an error wrapping multiple validation errors
+// returned by NumericSummary.ValidateAll() if the designated constraints
+// aren't met.
+type NumericSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NumericSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NumericSummaryMultiError) AllErrors() []error { return m }
+
+// NumericSummaryValidationError is the validation error returned by
+// NumericSummary.Validate if the designated constraints aren't met.
+type NumericSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NumericSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NumericSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NumericSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NumericSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NumericSummaryValidationError) ErrorName() string { return "NumericSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NumericSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNumericSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NumericSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NumericSummaryValidationError{}
+
+// Validate checks the field values on NumericHistogramBins with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NumericHistogramBins) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NumericHistogramBins with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NumericHistogramBinsMultiError, or nil if none found.
+func (m *NumericHistogramBins) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NumericHistogramBins) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBins() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NumericHistogramBinsValidationError{
+						field:  fmt.Sprintf("Bins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NumericHistogramBinsValidationError{
+						field:  fmt.Sprintf("Bins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NumericHistogramBinsValidationError{
+					field:  fmt.Sprintf("Bins[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return NumericHistogramBinsMultiError(errors)
+	}
+
+	return nil
+}
+
+// NumericHistogramBinsMultiError is an error wrapping multiple validation
+// errors returned by NumericHistogramBins.ValidateAll() if the designated
+// constraints aren't met.
+type NumericHistogramBinsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NumericHistogramBinsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NumericHistogramBinsMultiError) AllErrors() []error { return m }
+
+// NumericHistogramBinsValidationError is the validation error returned by
+// NumericHistogramBins.Validate if the designated constraints aren't met.
+type NumericHistogramBinsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NumericHistogramBinsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NumericHistogramBinsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NumericHistogramBinsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NumericHistogramBinsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NumericHistogramBinsValidationError) ErrorName() string {
+	return "NumericHistogramBinsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NumericHistogramBinsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNumericHistogramBins.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NumericHistogramBinsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NumericHistogramBinsValidationError{}
+
+// Validate checks the field values on NumericStatistics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NumericStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NumericStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NumericStatisticsMultiError, or nil if none found.
+func (m *NumericStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NumericStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Mean
+
+	// no validation rules for Q25
+
+	// no validation rules for Q50
+
+	// no validation rules for Q75
+
+	// no validation rules for Sd
+
+	if len(errors) > 0 {
+		return NumericStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// NumericStatisticsMultiError is an error wrapping multiple validation errors
+// returned by NumericStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type NumericStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NumericStatisticsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NumericStatisticsMultiError) AllErrors() []error { return m }
+
+// NumericStatisticsValidationError is the validation error returned by
+// NumericStatistics.Validate if the designated constraints aren't met.
+type NumericStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NumericStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NumericStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NumericStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NumericStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NumericStatisticsValidationError) ErrorName() string {
+	return "NumericStatisticsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NumericStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNumericStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NumericStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NumericStatisticsValidationError{}
+
+// Validate checks the field values on NumericOutliers with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NumericOutliers) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NumericOutliers with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NumericOutliersMultiError, or nil if none found.
+func (m *NumericOutliers) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NumericOutliers) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOutliers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NumericOutliersValidationError{
+						field:  fmt.Sprintf("Outliers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NumericOutliersValidationError{
+						field:  fmt.Sprintf("Outliers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NumericOutliersValidationError{
+					field:  fmt.Sprintf("Outliers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return NumericOutliersMultiError(errors)
+	}
+
+	return nil
+}
+
+// NumericOutliersMultiError is an error wrapping multiple validation errors
+// returned by NumericOutliers.ValidateAll() if the designated constraints
+// aren't met.
+type NumericOutliersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NumericOutliersMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NumericOutliersMultiError) AllErrors() []error { return m }
+
+// NumericOutliersValidationError is the validation error returned by
+// NumericOutliers.Validate if the designated constraints aren't met.
+type NumericOutliersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NumericOutliersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NumericOutliersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NumericOutliersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NumericOutliersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NumericOutliersValidationError) ErrorName() string { return "NumericOutliersValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NumericOutliersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNumericOutliers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NumericOutliersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NumericOutliersValidationError{}
+
+// Validate checks the field values on ColumnTimeGrainRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTimeGrainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnTimeGrainRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnTimeGrainRequestMultiError, or nil if none found.
+func (m *ColumnTimeGrainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnTimeGrainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnTimeGrainRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnTimeGrainRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Priority
+
+	if len(errors) > 0 {
+		return ColumnTimeGrainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnTimeGrainRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeGrainRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnTimeGrainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnTimeGrainRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnTimeGrainRequestMultiError) AllErrors() []error { return m }
+
+// ColumnTimeGrainRequestValidationError is the validation error returned by
+// ColumnTimeGrainRequest.Validate if the designated constraints aren't met.
+type ColumnTimeGrainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnTimeGrainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnTimeGrainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnTimeGrainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnTimeGrainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnTimeGrainRequestValidationError) ErrorName() string {
+	return "ColumnTimeGrainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnTimeGrainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnTimeGrainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnTimeGrainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnTimeGrainRequestValidationError{}
+
+// Validate checks the field values on ColumnTimeGrainResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTimeGrainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnTimeGrainResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnTimeGrainResponseMultiError, or nil if none found.
+func (m *ColumnTimeGrainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnTimeGrainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TimeGrain
+
+	if len(errors) > 0 {
+		return ColumnTimeGrainResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnTimeGrainResponseMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeGrainResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnTimeGrainResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnTimeGrainResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnTimeGrainResponseMultiError) AllErrors() []error { return m }
+
+// ColumnTimeGrainResponseValidationError is the validation error returned by
+// ColumnTimeGrainResponse.Validate if the designated constraints aren't met.
+type ColumnTimeGrainResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnTimeGrainResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnTimeGrainResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnTimeGrainResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnTimeGrainResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnTimeGrainResponseValidationError) ErrorName() string {
+	return "ColumnTimeGrainResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnTimeGrainResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnTimeGrainResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnTimeGrainResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnTimeGrainResponseValidationError{}
+
+// Validate checks the field values on ColumnNumericHistogramRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnNumericHistogramRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnNumericHistogramRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ColumnNumericHistogramRequestMultiError, or nil if none found.
+func (m *ColumnNumericHistogramRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnNumericHistogramRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnNumericHistogramRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnNumericHistogramRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for HistogramMethod
+
+	// no validation rules for Priority
+
+	if len(errors) > 0 {
+		return ColumnNumericHistogramRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnNumericHistogramRequestMultiError is an error wrapping multiple
+// validation errors returned by ColumnNumericHistogramRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ColumnNumericHistogramRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnNumericHistogramRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnNumericHistogramRequestMultiError) AllErrors() []error { return m }
+
+// ColumnNumericHistogramRequestValidationError is the validation error
+// returned by ColumnNumericHistogramRequest.Validate if the designated
+// constraints aren't met.
+type ColumnNumericHistogramRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnNumericHistogramRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnNumericHistogramRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnNumericHistogramRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnNumericHistogramRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnNumericHistogramRequestValidationError) ErrorName() string {
+	return "ColumnNumericHistogramRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnNumericHistogramRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnNumericHistogramRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnNumericHistogramRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnNumericHistogramRequestValidationError{}
+
+// Validate checks the field values on ColumnNumericHistogramResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnNumericHistogramResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnNumericHistogramResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ColumnNumericHistogramResponseMultiError, or nil if none found.
+func (m *ColumnNumericHistogramResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnNumericHistogramResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNumericSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnNumericHistogramResponseValidationError{
+					field:  "NumericSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnNumericHistogramResponseValidationError{
+					field:  "NumericSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnNumericHistogramResponseValidationError{
+				field:  "NumericSummary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ColumnNumericHistogramResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnNumericHistogramResponseMultiError is an error wrapping multiple
+// validation errors returned by ColumnNumericHistogramResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ColumnNumericHistogramResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnNumericHistogramResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnNumericHistogramResponseMultiError) AllErrors() []error { return m }
+
+// ColumnNumericHistogramResponseValidationError is the validation error
+// returned by ColumnNumericHistogramResponse.Validate if the designated
+// constraints aren't met.
+type ColumnNumericHistogramResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnNumericHistogramResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnNumericHistogramResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnNumericHistogramResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnNumericHistogramResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnNumericHistogramResponseValidationError) ErrorName() string {
+	return "ColumnNumericHistogramResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnNumericHistogramResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnNumericHistogramResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnNumericHistogramResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnNumericHistogramResponseValidationError{}
+
+// Validate checks the field values on ColumnRugHistogramRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnRugHistogramRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnRugHistogramRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnRugHistogramRequestMultiError, or nil if none found.
+func (m *ColumnRugHistogramRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnRugHistogramRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnRugHistogramRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnRugHistogramRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Priority
+
+	if len(errors) > 0 {
+		return ColumnRugHistogramRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnRugHistogramRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnRugHistogramRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ColumnRugHistogramRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnRugHistogramRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnRugHistogramRequestMultiError) AllErrors() []error { return m }
+
+// ColumnRugHistogramRequestValidationError is the validation error returned by
+// ColumnRugHistogramRequest.Validate if the designated constraints aren't met.
+type ColumnRugHistogramRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnRugHistogramRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnRugHistogramRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnRugHistogramRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnRugHistogramRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnRugHistogramRequestValidationError) ErrorName() string {
+	return "ColumnRugHistogramRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnRugHistogramRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnRugHistogramRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnRugHistogramRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnRugHistogramRequestValidationError{}
+
+// Validate checks the field values on ColumnRugHistogramResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnRugHistogramResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnRugHistogramResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnRugHistogramResponseMultiError, or nil if none found.
+func (m *ColumnRugHistogramResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnRugHistogramResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNumericSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnRugHistogramResponseValidationError{
+					field:  "NumericSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnRugHistogramResponseValidationError{
+					field:  "NumericSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNumericSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnRugHistogramResponseValidationError{
+				field:  "NumericSummary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ColumnRugHistogramResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnRugHistogramResponseMultiError is an error wrapping multiple
+// validation errors returned by ColumnRugHistogramResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ColumnRugHistogramResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnRugHistogramResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnRugHistogramResponseMultiError) AllErrors() []error { return m }
+
+// ColumnRugHistogramResponseValidationError is the validation error returned
+// by ColumnRugHistogramResponse.Validate if the designated constraints aren't met.
+type ColumnRugHistogramResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnRugHistogramResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnRugHistogramResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnRugHistogramResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnRugHistogramResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnRugHistogramResponseValidationError) ErrorName() string {
+	return "ColumnRugHistogramResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnRugHistogramResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnRugHistogramResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnRugHistogramResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnRugHistogramResponseValidationError{}
+
+// Validate checks the field values on ColumnTimeRangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTimeRangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnTimeRangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnTimeRangeRequestMultiError, or nil if none found.
+func (m *ColumnTimeRangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnTimeRangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnTimeRangeRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnTimeRangeRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Priority
+
+	if len(errors) > 0 {
+		return ColumnTimeRangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnTimeRangeRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeRangeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnTimeRangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnTimeRangeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnTimeRangeRequestMultiError) AllErrors() []error { return m }
+
+// ColumnTimeRangeRequestValidationError is the validation error returned by
+// ColumnTimeRangeRequest.Validate if the designated constraints aren't met.
+type ColumnTimeRangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnTimeRangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnTimeRangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnTimeRangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnTimeRangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnTimeRangeRequestValidationError) ErrorName() string {
+	return "ColumnTimeRangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnTimeRangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnTimeRangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnTimeRangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnTimeRangeRequestValidationError{}
+
+// Validate checks the field values on ColumnTimeRangeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTimeRangeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnTimeRangeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnTimeRangeResponseMultiError, or nil if none found.
+func (m *ColumnTimeRangeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnTimeRangeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTimeRangeSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnTimeRangeResponseValidationError{
+					field:  "TimeRangeSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnTimeRangeResponseValidationError{
+					field:  "TimeRangeSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeRangeSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnTimeRangeResponseValidationError{
+				field:  "TimeRangeSummary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ColumnTimeRangeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnTimeRangeResponseMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeRangeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnTimeRangeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnTimeRangeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnTimeRangeResponseMultiError) AllErrors() []error { return m }
+
+// ColumnTimeRangeResponseValidationError is the validation error returned by
+// ColumnTimeRangeResponse.Validate if the designated constraints aren't met.
+type ColumnTimeRangeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnTimeRangeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnTimeRangeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnTimeRangeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnTimeRangeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnTimeRangeResponseValidationError) ErrorName() string {
+	return "ColumnTimeRangeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnTimeRangeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnTimeRangeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnTimeRangeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnTimeRangeResponseValidationError{}
+
+// Validate checks the field values on TimeRangeSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TimeRangeSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeRangeSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeRangeSummaryMultiError, or nil if none found.
+func (m *TimeRangeSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeRangeSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Min",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Min",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeRangeSummaryValidationError{
+				field:  "Min",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMax()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Max",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Max",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMax()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeRangeSummaryValidationError{
+				field:  "Max",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Interval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeRangeSummaryValidationError{
+					field:  "Interval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeRangeSummaryValidationError{
+				field:  "Interval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TimeRangeSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeRangeSummaryMultiError is an error wrapping multiple validation errors
+// returned by TimeRangeSummary.ValidateAll() if the designated constraints
+// aren't met.
+type TimeRangeSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeRangeSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeRangeSummaryMultiError) AllErrors() []error { return m }
+
+// TimeRangeSummaryValidationError is the validation error returned by
+// TimeRangeSummary.Validate if the designated constraints aren't met.
+type TimeRangeSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeRangeSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeRangeSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeRangeSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeRangeSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeRangeSummaryValidationError) ErrorName() string { return "TimeRangeSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeRangeSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeRangeSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeRangeSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeRangeSummaryValidationError{}
+
+// Validate checks the field values on ColumnCardinalityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnCardinalityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnCardinalityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnCardinalityRequestMultiError, or nil if none found.
+func (m *ColumnCardinalityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnCardinalityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnCardinalityRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetColumnName()) < 1 {
+		err := ColumnCardinalityRequestValidationError{
+			field:  "ColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Priority
+
+	if len(errors) > 0 {
+		return ColumnCardinalityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnCardinalityRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnCardinalityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnCardinalityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnCardinalityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnCardinalityRequestMultiError) AllErrors() []error { return m }
+
+// ColumnCardinalityRequestValidationError is the validation error returned by
+// ColumnCardinalityRequest.Validate if the designated constraints aren't met.
+type ColumnCardinalityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnCardinalityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnCardinalityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnCardinalityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnCardinalityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnCardinalityRequestValidationError) ErrorName() string {
+	return "ColumnCardinalityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnCardinalityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnCardinalityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnCardinalityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnCardinalityRequestValidationError{}
+
+// Validate checks the field values on ColumnCardinalityResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnCardinalityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnCardinalityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnCardinalityResponseMultiError, or nil if none found.
+func (m *ColumnCardinalityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnCardinalityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategoricalSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnCardinalityResponseValidationError{
+					field:  "CategoricalSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnCardinalityResponseValidationError{
+					field:  "CategoricalSummary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategoricalSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnCardinalityResponseValidationError{
+				field:  "CategoricalSummary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ColumnCardinalityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnCardinalityResponseMultiError is an error wrapping multiple validation
+// errors returned by ColumnCardinalityResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ColumnCardinalityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnCardinalityResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnCardinalityResponseMultiError) AllErrors() []error { return m }
+
+// ColumnCardinalityResponseValidationError is the validation error returned by
+// ColumnCardinalityResponse.Validate if the designated constraints aren't met.
+type ColumnCardinalityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnCardinalityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnCardinalityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnCardinalityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnCardinalityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnCardinalityResponseValidationError) ErrorName() string {
+	return "ColumnCardinalityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnCardinalityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnCardinalityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnCardinalityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnCardinalityResponseValidationError{}
+
+// Validate checks the field values on ColumnTimeSeriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTimeSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnTimeSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnTimeSeriesRequestMultiError, or nil if none found.
+func (m *ColumnTimeSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnTimeSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := ColumnTimeSeriesRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMeasures()) < 1 {
+		err := ColumnTimeSeriesRequestValidationError{
+			field:  "Measures",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMeasures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ColumnTimeSeriesRequestValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ColumnTimeSeriesRequestValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ColumnTimeSeriesRequestValidationError{
+					field:  fmt.Sprintf("Measures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetTimestampColumnName()) < 1 {
+		err := ColumnTimeSeriesRequestValidationError{
+			field:  "TimestampColumnName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnTimeSeriesRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnTimeSeriesRequestValidationError{
+					field:  "TimeRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnTimeSeriesRequestValidationError{
+				field:  "TimeRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPixels() < 0 {
+		err := ColumnTimeSeriesRequestValidationError{
+			field:  "Pixels",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSampleSize() < 0 {
+		err := ColumnTimeSeriesRequestValidationError{
+			field:  "SampleSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Priority
+
+	// no validation rules for TimeZone
+
+	if len(errors) > 0 {
+		return ColumnTimeSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnTimeSeriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeSeriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnTimeSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnTimeSeriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnTimeSeriesRequestMultiError) AllErrors() []error { return m }
+
+// ColumnTimeSeriesRequestValidationError is the validation error returned by
+// ColumnTimeSeriesRequest.Validate if the designated constraints aren't met.
+type ColumnTimeSeriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnTimeSeriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnTimeSeriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnTimeSeriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnTimeSeriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnTimeSeriesRequestValidationError) ErrorName() string {
+	return "ColumnTimeSeriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnTimeSeriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnTimeSeriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnTimeSeriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnTimeSeriesRequestValidationError{}
+
+// Validate checks the field values on ColumnTimeSeriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ColumnTimeSeriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnTimeSeriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ColumnTimeSeriesResponseMultiError, or nil if none found.
+func (m *ColumnTimeSeriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnTimeSeriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRollup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnTimeSeriesResponseValidationError{
+					field:  "Rollup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnTimeSeriesResponseValidationError{
+					field:  "Rollup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRollup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnTimeSeriesResponseValidationError{
+				field:  "Rollup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ColumnTimeSeriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnTimeSeriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ColumnTimeSeriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ColumnTimeSeriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnTimeSeriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnTimeSeriesResponseMultiError) AllErrors() []error { return m }
+
+// ColumnTimeSeriesResponseValidationError is the validation error returned by
+// ColumnTimeSeriesResponse.Validate if the designated constraints aren't met.
+type ColumnTimeSeriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnTimeSeriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnTimeSeriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnTimeSeriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnTimeSeriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnTimeSeriesResponseValidationError) ErrorName() string {
+	return "ColumnTimeSeriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ColumnTimeSeriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnTimeSeriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnTimeSeriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnTimeSeriesResponseValidationError{}
+
+// Validate checks the field values on TimeSeriesTimeRange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesTimeRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesTimeRange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesTimeRangeMultiError, or nil if none found.
+func (m *TimeSeriesTimeRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesTimeRange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeSeriesTimeRangeValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeSeriesTimeRangeValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeSeriesTimeRangeValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeSeriesTimeRangeValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeSeriesTimeRangeValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeSeriesTimeRangeValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := TimeGrain_name[int32(m.GetInterval())]; !ok {
+		err := TimeSeriesTimeRangeValidationError{
+			field:  "Interval",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TimeSeriesTimeRangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesTimeRangeMultiError is an error wrapping multiple validation
+// errors returned by TimeSeriesTimeRange.ValidateAll() if the designated
+// constraints aren't met.
+type TimeSeriesTimeRangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesTimeRangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesTimeRangeMultiError) AllErrors() []error { return m }
+
+// TimeSeriesTimeRangeValidationError is the validation error returned by
+// TimeSeriesTimeRange.Validate if the designated constraints aren't met.
+type TimeSeriesTimeRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesTimeRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesTimeRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesTimeRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesTimeRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesTimeRangeValidationError) ErrorName() string {
+	return "TimeSeriesTimeRangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesTimeRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesTimeRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesTimeRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesTimeRangeValidationError{}
+
+// Validate checks the field values on TimeSeriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesResponseMultiError, or nil if none found.
+func (m *TimeSeriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeSeriesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	for idx, item := range m.GetMeasures() {
+	for idx, item := range m.GetSpark() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ColumnTimeSeriesRequestValidationError{
-						field:  fmt.Sprintf("Measures[%v]", idx),
+					errors = append(errors, TimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Spark[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ColumnTimeSeriesRequestValidationError{
-						field:  fmt.Sprintf("Measures[%v]", idx),
+					errors = append(errors, TimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Spark[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -12554,8 +17230,8 @@ func (m *ColumnTimeSeriesRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ColumnTimeSeriesRequestValidationError{
-					field:  fmt.Sprintf("Measures[%v]", idx),
+				return TimeSeriesResponseValidationError{
+					field:  fmt.Sprintf("Spark[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -12564,61 +17240,284 @@ func (m *ColumnTimeSeriesRequest) validate(all bool) error {
 
 	}
 
-	if utf8.RuneCountInString(m.GetTimestampColumnName()) < 1 {
-		err := ColumnTimeSeriesRequestValidationError{
-			field:  "TimestampColumnName",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for SampleSize
+
+	if len(errors) > 0 {
+		return TimeSeriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesResponseMultiError is an error wrapping multiple validation errors
+// returned by TimeSeriesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TimeSeriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesResponseMultiError) AllErrors() []error { return m }
+
+// TimeSeriesResponseValidationError is the validation error returned by
+// TimeSeriesResponse.Validate if the designated constraints aren't met.
+type TimeSeriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesResponseValidationError) ErrorName() string {
+	return "TimeSeriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesResponseValidationError{}
+
+// Validate checks the field values on TimeSeriesValue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesValueMultiError, or nil if none found.
+func (m *TimeSeriesValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeSeriesValueValidationError{
+					field:  "Ts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeSeriesValueValidationError{
+					field:  "Ts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeSeriesValueValidationError{
+				field:  "Ts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for Bin
+
 	if all {
-		switch v := interface{}(m.GetTimeRange()).(type) {
+		switch v := interface{}(m.GetRecords()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnTimeSeriesRequestValidationError{
-					field:  "TimeRange",
+				errors = append(errors, TimeSeriesValueValidationError{
+					field:  "Records",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnTimeSeriesRequestValidationError{
-					field:  "TimeRange",
+				errors = append(errors, TimeSeriesValueValidationError{
+					field:  "Records",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimeRange()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRecords()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ColumnTimeSeriesRequestValidationError{
-				field:  "TimeRange",
+			return TimeSeriesValueValidationError{
+				field:  "Records",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetPixels() < 0 {
-		err := ColumnTimeSeriesRequestValidationError{
-			field:  "Pixels",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	// no validation rules for Forecast
+
+	if len(errors) > 0 {
+		return TimeSeriesValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesValueMultiError is an error wrapping multiple validation errors
+// returned by TimeSeriesValue.ValidateAll() if the designated constraints
+// aren't met.
+type TimeSeriesValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesValueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesValueMultiError) AllErrors() []error { return m }
+
+// TimeSeriesValueValidationError is the validation error returned by
+// TimeSeriesValue.Validate if the designated constraints aren't met.
+type TimeSeriesValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesValueValidationError) ErrorName() string { return "TimeSeriesValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesValueValidationError{}
+
+// Validate checks the field values on TableCardinalityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TableCardinalityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TableCardinalityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TableCardinalityRequestMultiError, or nil if none found.
+func (m *TableCardinalityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TableCardinalityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if m.GetSampleSize() < 0 {
-		err := ColumnTimeSeriesRequestValidationError{
-			field:  "SampleSize",
-			reason: "value must be greater than or equal to 0",
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := TableCardinalityRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -12628,22 +17527,20 @@ func (m *ColumnTimeSeriesRequest) validate(all bool) error {
 
 	// no validation rules for Priority
 
-	// no validation rules for TimeZone
-
 	if len(errors) > 0 {
-		return ColumnTimeSeriesRequestMultiError(errors)
+		return TableCardinalityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTimeSeriesRequestMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeSeriesRequest.ValidateAll() if the designated
+// TableCardinalityRequestMultiError is an error wrapping multiple validation
+// errors returned by TableCardinalityRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ColumnTimeSeriesRequestMultiError []error
+type TableCardinalityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeSeriesRequestMultiError) Error() string {
+func (m TableCardinalityRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12652,11 +17549,11 @@ func (m ColumnTimeSeriesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeSeriesRequestMultiError) AllErrors() []error { return m }
+func (m TableCardinalityRequestMultiError) AllErrors() []error { return m }
 
-// ColumnTimeSeriesRequestValidationError is the validation error returned by
-// ColumnTimeSeriesRequest.Validate if the designated constraints aren't met.
-type ColumnTimeSeriesRequestValidationError struct {
+// TableCardinalityRequestValidationError is the validation error returned by
+// TableCardinalityRequest.Validate if the designated constraints aren't met.
+type TableCardinalityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12664,24 +17561,24 @@ type ColumnTimeSeriesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeSeriesRequestValidationError) Field() string { return e.field }
+func (e TableCardinalityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeSeriesRequestValidationError) Reason() string { return e.reason }
+func (e TableCardinalityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeSeriesRequestValidationError) Cause() error { return e.cause }
+func (e TableCardinalityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeSeriesRequestValidationError) Key() bool { return e.key }
+func (e TableCardinalityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeSeriesRequestValidationError) ErrorName() string {
-	return "ColumnTimeSeriesRequestValidationError"
+func (e TableCardinalityRequestValidationError) ErrorName() string {
+	return "TableCardinalityRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeSeriesRequestValidationError) Error() string {
+func (e TableCardinalityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12693,14 +17590,14 @@ func (e ColumnTimeSeriesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeSeriesRequest.%s: %s%s",
+		"invalid %sTableCardinalityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeSeriesRequestValidationError{}
+var _ error = TableCardinalityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12708,73 +17605,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeSeriesRequestValidationError{}
+} = TableCardinalityRequestValidationError{}
 
-// Validate checks the field values on ColumnTimeSeriesResponse with the rules
+// Validate checks the field values on TableCardinalityResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ColumnTimeSeriesResponse) Validate() error {
+func (m *TableCardinalityResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ColumnTimeSeriesResponse with the
+// ValidateAll checks the field values on TableCardinalityResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ColumnTimeSeriesResponseMultiError, or nil if none found.
-func (m *ColumnTimeSeriesResponse) ValidateAll() error {
+// TableCardinalityResponseMultiError, or nil if none found.
+func (m *TableCardinalityResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ColumnTimeSeriesResponse) validate(all bool) error {
+func (m *TableCardinalityResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRollup()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ColumnTimeSeriesResponseValidationError{
-					field:  "Rollup",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ColumnTimeSeriesResponseValidationError{
-					field:  "Rollup",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRollup()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ColumnTimeSeriesResponseValidationError{
-				field:  "Rollup",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Cardinality
 
 	if len(errors) > 0 {
-		return ColumnTimeSeriesResponseMultiError(errors)
+		return TableCardinalityResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ColumnTimeSeriesResponseMultiError is an error wrapping multiple validation
-// errors returned by ColumnTimeSeriesResponse.ValidateAll() if the designated
+// TableCardinalityResponseMultiError is an error wrapping multiple validation
+// errors returned by TableCardinalityResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ColumnTimeSeriesResponseMultiError []error
+type TableCardinalityResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ColumnTimeSeriesResponseMultiError) Error() string {
+func (m TableCardinalityResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12783,11 +17653,11 @@ func (m ColumnTimeSeriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ColumnTimeSeriesResponseMultiError) AllErrors() []error { return m }
+func (m TableCardinalityResponseMultiError) AllErrors() []error { return m }
 
-// ColumnTimeSeriesResponseValidationError is the validation error returned by
-// ColumnTimeSeriesResponse.Validate if the designated constraints aren't met.
-type ColumnTimeSeriesResponseValidationError struct {
+// TableCardinalityResponseValidationError is the validation error returned by
+// TableCardinalityResponse.Validate if the designated constraints aren't met.
+type TableCardinalityResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12795,24 +17665,24 @@ type ColumnTimeSeriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ColumnTimeSeriesResponseValidationError) Field() string { return e.field }
+func (e TableCardinalityResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ColumnTimeSeriesResponseValidationError) Reason() string { return e.reason }
+func (e TableCardinalityResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ColumnTimeSeriesResponseValidationError) Cause() error { return e.cause }
+func (e TableCardinalityResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ColumnTimeSeriesResponseValidationError) Key() bool { return e.key }
+func (e TableCardinalityResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ColumnTimeSeriesResponseValidationError) ErrorName() string {
-	return "ColumnTimeSeriesResponseValidationError"
+func (e TableCardinalityResponseValidationError) ErrorName() string {
+	return "TableCardinalityResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ColumnTimeSeriesResponseValidationError) Error() string {
+func (e TableCardinalityResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12824,14 +17694,14 @@ func (e ColumnTimeSeriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sColumnTimeSeriesResponse.%s: %s%s",
+		"invalid %sTableCardinalityResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ColumnTimeSeriesResponseValidationError{}
+var _ error = TableCardinalityResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12839,92 +17709,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ColumnTimeSeriesResponseValidationError{}
+} = TableCardinalityResponseValidationError{}
 
-// Validate checks the field values on TimeSeriesTimeRange with the rules
+// Validate checks the field values on TableColumnsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TimeSeriesTimeRange) Validate() error {
+func (m *TableColumnsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TimeSeriesTimeRange with the rules
+// ValidateAll checks the field values on TableColumnsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TimeSeriesTimeRangeMultiError, or nil if none found.
-func (m *TimeSeriesTimeRange) ValidateAll() error {
+// TableColumnsRequestMultiError, or nil if none found.
+func (m *TableColumnsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TimeSeriesTimeRange) validate(all bool) error {
+func (m *TableColumnsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetStart()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeSeriesTimeRangeValidationError{
-					field:  "Start",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeSeriesTimeRangeValidationError{
-					field:  "Start",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeSeriesTimeRangeValidationError{
-				field:  "Start",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for InstanceId
 
-	if all {
-		switch v := interface{}(m.GetEnd()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeSeriesTimeRangeValidationError{
-					field:  "End",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeSeriesTimeRangeValidationError{
-					field:  "End",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeSeriesTimeRangeValidationError{
-				field:  "End",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Connector
 
-	if _, ok := TimeGrain_name[int32(m.GetInterval())]; !ok {
-		err := TimeSeriesTimeRangeValidationError{
-			field:  "Interval",
-			reason: "value must be one of the defined enum values",
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := TableColumnsRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -12932,20 +17752,22 @@ func (m *TimeSeriesTimeRange) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return TimeSeriesTimeRangeMultiError(errors)
+		return TableColumnsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TimeSeriesTimeRangeMultiError is an error wrapping multiple validation
-// errors returned by TimeSeriesTimeRange.ValidateAll() if the designated
+// TableColumnsRequestMultiError is an error wrapping multiple validation
+// errors returned by TableColumnsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TimeSeriesTimeRangeMultiError []error
+type TableColumnsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TimeSeriesTimeRangeMultiError) Error() string {
+func (m TableColumnsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12954,11 +17776,11 @@ func (m TimeSeriesTimeRangeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TimeSeriesTimeRangeMultiError) AllErrors() []error { return m }
-
-// TimeSeriesTimeRangeValidationError is the validation error returned by
-// TimeSeriesTimeRange.Validate if the designated constraints aren't met.
-type TimeSeriesTimeRangeValidationError struct {
+func (m TableColumnsRequestMultiError) AllErrors() []error { return m }
+
+// TableColumnsRequestValidationError is the validation error returned by
+// TableColumnsRequest.Validate if the designated constraints aren't met.
+type TableColumnsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12966,24 +17788,24 @@ type TimeSeriesTimeRangeValidationError struct {
 }
 
 // Field function returns field value.
-func (e TimeSeriesTimeRangeValidationError) Field() string { return e.field }
+func (e TableColumnsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TimeSeriesTimeRangeValidationError) Reason() string { return e.reason }
+func (e TableColumnsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TimeSeriesTimeRangeValidationError) Cause() error { return e.cause }
+func (e TableColumnsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TimeSeriesTimeRangeValidationError) Key() bool { return e.key }
+func (e TableColumnsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TimeSeriesTimeRangeValidationError) ErrorName() string {
-	return "TimeSeriesTimeRangeValidationError"
+func (e TableColumnsRequestValidationError) ErrorName() string {
+	return "TableColumnsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TimeSeriesTimeRangeValidationError) Error() string {
+func (e TableColumnsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12995,14 +17817,14 @@ func (e TimeSeriesTimeRangeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTimeSeriesTimeRange.%s: %s%s",
+		"invalid %sTableColumnsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TimeSeriesTimeRangeValidationError{}
+var _ error = TableColumnsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13010,47 +17832,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TimeSeriesTimeRangeValidationError{}
+} = TableColumnsRequestValidationError{}
 
-// Validate checks the field values on TimeSeriesResponse with the rules
+// Validate checks the field values on TableColumnsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TimeSeriesResponse) Validate() error {
+func (m *TableColumnsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TimeSeriesResponse with the rules
+// ValidateAll checks the field values on TableColumnsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TimeSeriesResponseMultiError, or nil if none found.
-func (m *TimeSeriesResponse) ValidateAll() error {
+// TableColumnsResponseMultiError, or nil if none found.
+func (m *TableColumnsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TimeSeriesResponse) validate(all bool) error {
+func (m *TableColumnsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetResults() {
+	for idx, item := range m.GetProfileColumns() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
+					errors = append(errors, TableColumnsResponseValidationError{
+						field:  fmt.Sprintf("ProfileColumns[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
+					errors = append(errors, TableColumnsResponseValidationError{
+						field:  fmt.Sprintf("ProfileColumns[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -13058,8 +17880,8 @@ func (m *TimeSeriesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TimeSeriesResponseValidationError{
-					field:  fmt.Sprintf("Results[%v]", idx),
+				return TableColumnsResponseValidationError{
+					field:  fmt.Sprintf("ProfileColumns[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -13068,56 +17890,130 @@ func (m *TimeSeriesResponse) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetSpark() {
-		_, _ = idx, item
+	// no validation rules for UnsupportedColumns
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Spark[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TimeSeriesResponseValidationError{
-						field:  fmt.Sprintf("Spark[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TimeSeriesResponseValidationError{
-					field:  fmt.Sprintf("Spark[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if len(errors) > 0 {
+		return TableColumnsResponseMultiError(errors)
+	}
+
+	return nil
+}
 
+// TableColumnsResponseMultiError is an error wrapping multiple validation
+// errors returned by TableColumnsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TableColumnsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TableColumnsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for SampleSize
+// AllErrors returns a list of validation violation errors.
+func (m TableColumnsResponseMultiError) AllErrors() []error { return m }
+
+// TableColumnsResponseValidationError is the validation error returned by
+// TableColumnsResponse.Validate if the designated constraints aren't met.
+type TableColumnsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TableColumnsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TableColumnsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TableColumnsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TableColumnsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TableColumnsResponseValidationError) ErrorName() string {
+	return "TableColumnsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TableColumnsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTableColumnsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TableColumnsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TableColumnsResponseValidationError{}
+
+// Validate checks the field values on ProfileColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProfileColumn) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileColumn with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProfileColumnMultiError, or
+// nil if none found.
+func (m *ProfileColumn) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileColumn) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for LargestStringLength
 
 	if len(errors) > 0 {
-		return TimeSeriesResponseMultiError(errors)
+		return ProfileColumnMultiError(errors)
 	}
 
 	return nil
 }
 
-// TimeSeriesResponseMultiError is an error wrapping multiple validation errors
-// returned by TimeSeriesResponse.ValidateAll() if the designated constraints
+// ProfileColumnMultiError is an error wrapping multiple validation errors
+// returned by ProfileColumn.ValidateAll() if the designated constraints
 // aren't met.
-type TimeSeriesResponseMultiError []error
+type ProfileColumnMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TimeSeriesResponseMultiError) Error() string {
+func (m ProfileColumnMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13126,11 +18022,11 @@ func (m TimeSeriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TimeSeriesResponseMultiError) AllErrors() []error { return m }
+func (m ProfileColumnMultiError) AllErrors() []error { return m }
 
-// TimeSeriesResponseValidationError is the validation error returned by
-// TimeSeriesResponse.Validate if the designated constraints aren't met.
-type TimeSeriesResponseValidationError struct {
+// ProfileColumnValidationError is the validation error returned by
+// ProfileColumn.Validate if the designated constraints aren't met.
+type ProfileColumnValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13138,24 +18034,22 @@ type TimeSeriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TimeSeriesResponseValidationError) Field() string { return e.field }
+func (e ProfileColumnValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TimeSeriesResponseValidationError) Reason() string { return e.reason }
+func (e ProfileColumnValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TimeSeriesResponseValidationError) Cause() error { return e.cause }
+func (e ProfileColumnValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TimeSeriesResponseValidationError) Key() bool { return e.key }
+func (e ProfileColumnValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TimeSeriesResponseValidationError) ErrorName() string {
-	return "TimeSeriesResponseValidationError"
-}
+func (e ProfileColumnValidationError) ErrorName() string { return "ProfileColumnValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TimeSeriesResponseValidationError) Error() string {
+func (e ProfileColumnValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13167,14 +18061,14 @@ func (e TimeSeriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTimeSeriesResponse.%s: %s%s",
+		"invalid %sProfileColumn.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TimeSeriesResponseValidationError{}
+var _ error = ProfileColumnValidationError{}
 
 var _ interface {
 	Field() string
@@ -13182,104 +18076,87 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TimeSeriesResponseValidationError{}
+} = ProfileColumnValidationError{}
 
-// Validate checks the field values on TimeSeriesValue with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TimeSeriesValue) Validate() error {
+// Validate checks the field values on TableColumnsProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TableColumnsProfileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TimeSeriesValue with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TableColumnsProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TimeSeriesValueMultiError, or nil if none found.
-func (m *TimeSeriesValue) ValidateAll() error {
+// TableColumnsProfileRequestMultiError, or nil if none found.
+func (m *TableColumnsProfileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TimeSeriesValue) validate(all bool) error {
+func (m *TableColumnsProfileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTs()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeSeriesValueValidationError{
-					field:  "Ts",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeSeriesValueValidationError{
-					field:  "Ts",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for InstanceId
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := TableColumnsProfileRequestValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetTs()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeSeriesValueValidationError{
-				field:  "Ts",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for Bin
+	if val := m.GetK(); val < 0 || val > 100 {
+		err := TableColumnsProfileRequestValidationError{
+			field:  "K",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetRecords()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TimeSeriesValueValidationError{
-					field:  "Records",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TimeSeriesValueValidationError{
-					field:  "Records",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if val := m.GetHistogramBins(); val < 0 || val > 100 {
+		err := TableColumnsProfileRequestValidationError{
+			field:  "HistogramBins",
+			reason: "value must be inside range [0, 100]",
 		}
-	} else if v, ok := interface{}(m.GetRecords()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TimeSeriesValueValidationError{
-				field:  "Records",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
-		return TimeSeriesValueMultiError(errors)
+		return TableColumnsProfileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TimeSeriesValueMultiError is an error wrapping multiple validation errors
-// returned by TimeSeriesValue.ValidateAll() if the designated constraints
-// aren't met.
-type TimeSeriesValueMultiError []error
+// TableColumnsProfileRequestMultiError is an error wrapping multiple
+// validation errors returned by TableColumnsProfileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type TableColumnsProfileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TimeSeriesValueMultiError) Error() string {
+func (m TableColumnsProfileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13288,11 +18165,11 @@ func (m TimeSeriesValueMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TimeSeriesValueMultiError) AllErrors() []error { return m }
+func (m TableColumnsProfileRequestMultiError) AllErrors() []error { return m }
 
-// TimeSeriesValueValidationError is the validation error returned by
-// TimeSeriesValue.Validate if the designated constraints aren't met.
-type TimeSeriesValueValidationError struct {
+// TableColumnsProfileRequestValidationError is the validation error returned
+// by TableColumnsProfileRequest.Validate if the designated constraints aren't met.
+type TableColumnsProfileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13300,22 +18177,24 @@ type TimeSeriesValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e TimeSeriesValueValidationError) Field() string { return e.field }
+func (e TableColumnsProfileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TimeSeriesValueValidationError) Reason() string { return e.reason }
+func (e TableColumnsProfileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TimeSeriesValueValidationError) Cause() error { return e.cause }
+func (e TableColumnsProfileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TimeSeriesValueValidationError) Key() bool { return e.key }
+func (e TableColumnsProfileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TimeSeriesValueValidationError) ErrorName() string { return "TimeSeriesValueValidationError" }
+func (e TableColumnsProfileRequestValidationError) ErrorName() string {
+	return "TableColumnsProfileRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TimeSeriesValueValidationError) Error() string {
+func (e TableColumnsProfileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13327,14 +18206,14 @@ func (e TimeSeriesValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTimeSeriesValue.%s: %s%s",
+		"invalid %sTableColumnsProfileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TimeSeriesValueValidationError{}
+var _ error = TableColumnsProfileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13342,65 +18221,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TimeSeriesValueValidationError{}
+} = TableColumnsProfileRequestValidationError{}
 
-// Validate checks the field values on TableCardinalityRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on TableColumnsProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TableCardinalityRequest) Validate() error {
+func (m *TableColumnsProfileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TableCardinalityRequest with the
+// ValidateAll checks the field values on TableColumnsProfileResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TableCardinalityRequestMultiError, or nil if none found.
-func (m *TableCardinalityRequest) ValidateAll() error {
+// TableColumnsProfileResponseMultiError, or nil if none found.
+func (m *TableColumnsProfileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TableCardinalityRequest) validate(all bool) error {
+func (m *TableColumnsProfileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for InstanceId
-
-	// no validation rules for Connector
-
-	// no validation rules for Database
+	// no validation rules for RowCount
 
-	// no validation rules for DatabaseSchema
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := TableCardinalityRequestValidationError{
-			field:  "TableName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TableColumnsProfileResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TableColumnsProfileResponseValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TableColumnsProfileResponseValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	// no validation rules for Priority
+	}
 
 	if len(errors) > 0 {
-		return TableCardinalityRequestMultiError(errors)
+		return TableColumnsProfileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TableCardinalityRequestMultiError is an error wrapping multiple validation
-// errors returned by TableCardinalityRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TableCardinalityRequestMultiError []error
+// TableColumnsProfileResponseMultiError is an error wrapping multiple
+// validation errors returned by TableColumnsProfileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type TableColumnsProfileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TableCardinalityRequestMultiError) Error() string {
+func (m TableColumnsProfileResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13409,11 +18303,12 @@ func (m TableCardinalityRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TableCardinalityRequestMultiError) AllErrors() []error { return m }
+func (m TableColumnsProfileResponseMultiError) AllErrors() []error { return m }
 
-// TableCardinalityRequestValidationError is the validation error returned by
-// TableCardinalityRequest.Validate if the designated constraints aren't met.
-type TableCardinalityRequestValidationError struct {
+// TableColumnsProfileResponseValidationError is the validation error returned
+// by TableColumnsProfileResponse.Validate if the designated constraints
+// aren't met.
+type TableColumnsProfileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13421,24 +18316,24 @@ type TableCardinalityRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TableCardinalityRequestValidationError) Field() string { return e.field }
+func (e TableColumnsProfileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TableCardinalityRequestValidationError) Reason() string { return e.reason }
+func (e TableColumnsProfileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TableCardinalityRequestValidationError) Cause() error { return e.cause }
+func (e TableColumnsProfileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TableCardinalityRequestValidationError) Key() bool { return e.key }
+func (e TableColumnsProfileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TableCardinalityRequestValidationError) ErrorName() string {
-	return "TableCardinalityRequestValidationError"
+func (e TableColumnsProfileResponseValidationError) ErrorName() string {
+	return "TableColumnsProfileResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TableCardinalityRequestValidationError) Error() string {
+func (e TableColumnsProfileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13450,14 +18345,14 @@ func (e TableCardinalityRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTableCardinalityRequest.%s: %s%s",
+		"invalid %sTableColumnsProfileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TableCardinalityRequestValidationError{}
+var _ error = TableColumnsProfileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13465,46 +18360,197 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TableCardinalityRequestValidationError{}
+} = TableColumnsProfileResponseValidationError{}
 
-// Validate checks the field values on TableCardinalityResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TableCardinalityResponse) Validate() error {
+// Validate checks the field values on ColumnProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ColumnProfile) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TableCardinalityResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TableCardinalityResponseMultiError, or nil if none found.
-func (m *TableCardinalityResponse) ValidateAll() error {
+// ValidateAll checks the field values on ColumnProfile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ColumnProfileMultiError, or
+// nil if none found.
+func (m *ColumnProfile) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TableCardinalityResponse) validate(all bool) error {
+func (m *ColumnProfile) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for Cardinality
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnProfileValidationError{
+				field:  "Type",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NullCount
+
+	// no validation rules for NullRatio
+
+	// no validation rules for Cardinality
+
+	if all {
+		switch v := interface{}(m.GetMin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "Min",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "Min",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnProfileValidationError{
+				field:  "Min",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMax()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "Max",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "Max",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMax()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnProfileValidationError{
+				field:  "Max",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHistogram()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "Histogram",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "Histogram",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHistogram()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnProfileValidationError{
+				field:  "Histogram",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTopK()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "TopK",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ColumnProfileValidationError{
+					field:  "TopK",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTopK()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ColumnProfileValidationError{
+				field:  "TopK",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return TableCardinalityResponseMultiError(errors)
+		return ColumnProfileMultiError(errors)
 	}
 
 	return nil
 }
 
-// TableCardinalityResponseMultiError is an error wrapping multiple validation
-// errors returned by TableCardinalityResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TableCardinalityResponseMultiError []error
+// ColumnProfileMultiError is an error wrapping multiple validation errors
+// returned by ColumnProfile.ValidateAll() if the designated constraints
+// aren't met.
+type ColumnProfileMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TableCardinalityResponseMultiError) Error() string {
+func (m ColumnProfileMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13513,11 +18559,11 @@ func (m TableCardinalityResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TableCardinalityResponseMultiError) AllErrors() []error { return m }
+func (m ColumnProfileMultiError) AllErrors() []error { return m }
 
-// TableCardinalityResponseValidationError is the validation error returned by
-// TableCardinalityResponse.Validate if the designated constraints aren't met.
-type TableCardinalityResponseValidationError struct {
+// ColumnProfileValidationError is the validation error returned by
+// ColumnProfile.Validate if the designated constraints aren't met.
+type ColumnProfileValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13525,24 +18571,22 @@ type TableCardinalityResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TableCardinalityResponseValidationError) Field() string { return e.field }
+func (e ColumnProfileValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TableCardinalityResponseValidationError) Reason() string { return e.reason }
+func (e ColumnProfileValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TableCardinalityResponseValidationError) Cause() error { return e.cause }
+func (e ColumnProfileValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TableCardinalityResponseValidationError) Key() bool { return e.key }
+func (e ColumnProfileValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TableCardinalityResponseValidationError) ErrorName() string {
-	return "TableCardinalityResponseValidationError"
-}
+func (e ColumnProfileValidationError) ErrorName() string { return "ColumnProfileValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TableCardinalityResponseValidationError) Error() string {
+func (e ColumnProfileValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13554,14 +18598,14 @@ func (e TableCardinalityResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTableCardinalityResponse.%s: %s%s",
+		"invalid %sColumnProfile.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TableCardinalityResponseValidationError{}
+var _ error = ColumnProfileValidationError{}
 
 var _ interface {
 	Field() string
@@ -13569,24 +18613,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TableCardinalityResponseValidationError{}
+} = ColumnProfileValidationError{}
 
-// Validate checks the field values on TableColumnsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TableColumnsRequest) Validate() error {
+// Validate checks the field values on TableRowsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TableRowsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TableColumnsRequest with the rules
+// ValidateAll checks the field values on TableRowsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TableColumnsRequestMultiError, or nil if none found.
-func (m *TableColumnsRequest) ValidateAll() error {
+// TableRowsRequestMultiError, or nil if none found.
+func (m *TableRowsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TableColumnsRequest) validate(all bool) error {
+func (m *TableRowsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13602,7 +18646,7 @@ func (m *TableColumnsRequest) validate(all bool) error {
 	// no validation rules for DatabaseSchema
 
 	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := TableColumnsRequestValidationError{
+		err := TableRowsRequestValidationError{
 			field:  "TableName",
 			reason: "value length must be at least 1 runes",
 		}
@@ -13612,22 +18656,33 @@ func (m *TableColumnsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetLimit() < 0 {
+		err := TableRowsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return TableColumnsRequestMultiError(errors)
+		return TableRowsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TableColumnsRequestMultiError is an error wrapping multiple validation
-// errors returned by TableColumnsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TableColumnsRequestMultiError []error
+// TableRowsRequestMultiError is an error wrapping multiple validation errors
+// returned by TableRowsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TableRowsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TableColumnsRequestMultiError) Error() string {
+func (m TableRowsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13636,11 +18691,11 @@ func (m TableColumnsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TableColumnsRequestMultiError) AllErrors() []error { return m }
+func (m TableRowsRequestMultiError) AllErrors() []error { return m }
 
-// TableColumnsRequestValidationError is the validation error returned by
-// TableColumnsRequest.Validate if the designated constraints aren't met.
-type TableColumnsRequestValidationError struct {
+// TableRowsRequestValidationError is the validation error returned by
+// TableRowsRequest.Validate if the designated constraints aren't met.
+type TableRowsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13648,24 +18703,22 @@ type TableColumnsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TableColumnsRequestValidationError) Field() string { return e.field }
+func (e TableRowsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TableColumnsRequestValidationError) Reason() string { return e.reason }
+func (e TableRowsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TableColumnsRequestValidationError) Cause() error { return e.cause }
+func (e TableRowsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TableColumnsRequestValidationError) Key() bool { return e.key }
+func (e TableRowsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TableColumnsRequestValidationError) ErrorName() string {
-	return "TableColumnsRequestValidationError"
-}
+func (e TableRowsRequestValidationError) ErrorName() string { return "TableRowsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TableColumnsRequestValidationError) Error() string {
+func (e TableRowsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13677,14 +18730,14 @@ func (e TableColumnsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTableColumnsRequest.%s: %s%s",
+		"invalid %sTableRowsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TableColumnsRequestValidationError{}
+var _ error = TableRowsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13692,47 +18745,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TableColumnsRequestValidationError{}
+} = TableRowsRequestValidationError{}
 
-// Validate checks the field values on TableColumnsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TableColumnsResponse) Validate() error {
+// Validate checks the field values on TableRowsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TableRowsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TableColumnsResponse with the rules
+// ValidateAll checks the field values on TableRowsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TableColumnsResponseMultiError, or nil if none found.
-func (m *TableColumnsResponse) ValidateAll() error {
+// TableRowsResponseMultiError, or nil if none found.
+func (m *TableRowsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TableColumnsResponse) validate(all bool) error {
+func (m *TableRowsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetProfileColumns() {
+	for idx, item := range m.GetData() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TableColumnsResponseValidationError{
-						field:  fmt.Sprintf("ProfileColumns[%v]", idx),
+					errors = append(errors, TableRowsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TableColumnsResponseValidationError{
-						field:  fmt.Sprintf("ProfileColumns[%v]", idx),
+					errors = append(errors, TableRowsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -13740,140 +18793,30 @@ func (m *TableColumnsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TableColumnsResponseValidationError{
-					field:  fmt.Sprintf("ProfileColumns[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for UnsupportedColumns
-
-	if len(errors) > 0 {
-		return TableColumnsResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// TableColumnsResponseMultiError is an error wrapping multiple validation
-// errors returned by TableColumnsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TableColumnsResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m TableColumnsResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m TableColumnsResponseMultiError) AllErrors() []error { return m }
-
-// TableColumnsResponseValidationError is the validation error returned by
-// TableColumnsResponse.Validate if the designated constraints aren't met.
-type TableColumnsResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e TableColumnsResponseValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e TableColumnsResponseValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e TableColumnsResponseValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e TableColumnsResponseValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e TableColumnsResponseValidationError) ErrorName() string {
-	return "TableColumnsResponseValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e TableColumnsResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sTableColumnsResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = TableColumnsResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = TableColumnsResponseValidationError{}
-
-// Validate checks the field values on ProfileColumn with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ProfileColumn) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on ProfileColumn with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ProfileColumnMultiError, or
-// nil if none found.
-func (m *ProfileColumn) ValidateAll() error {
-	return m.validate(true)
-}
+				return TableRowsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-func (m *ProfileColumn) validate(all bool) error {
-	if m == nil {
-		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for Name
-
-	// no validation rules for Type
-
-	// no validation rules for LargestStringLength
-
 	if len(errors) > 0 {
-		return ProfileColumnMultiError(errors)
+		return TableRowsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProfileColumnMultiError is an error wrapping multiple validation errors
-// returned by ProfileColumn.ValidateAll() if the designated constraints
+// TableRowsResponseMultiError is an error wrapping multiple validation errors
+// returned by TableRowsResponse.ValidateAll() if the designated constraints
 // aren't met.
-type ProfileColumnMultiError []error
+type TableRowsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProfileColumnMultiError) Error() string {
+func (m TableRowsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13882,11 +18825,11 @@ func (m ProfileColumnMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProfileColumnMultiError) AllErrors() []error { return m }
+func (m TableRowsResponseMultiError) AllErrors() []error { return m }
 
-// ProfileColumnValidationError is the validation error returned by
-// ProfileColumn.Validate if the designated constraints aren't met.
-type ProfileColumnValidationError struct {
+// TableRowsResponseValidationError is the validation error returned by
+// TableRowsResponse.Validate if the designated constraints aren't met.
+type TableRowsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13894,22 +18837,24 @@ type ProfileColumnValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProfileColumnValidationError) Field() string { return e.field }
+func (e TableRowsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProfileColumnValidationError) Reason() string { return e.reason }
+func (e TableRowsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProfileColumnValidationError) Cause() error { return e.cause }
+func (e TableRowsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProfileColumnValidationError) Key() bool { return e.key }
+func (e TableRowsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProfileColumnValidationError) ErrorName() string { return "ProfileColumnValidationError" }
+func (e TableRowsResponseValidationError) ErrorName() string {
+	return "TableRowsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ProfileColumnValidationError) Error() string {
+func (e TableRowsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13921,14 +18866,14 @@ func (e ProfileColumnValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProfileColumn.%s: %s%s",
+		"invalid %sTableRowsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProfileColumnValidationError{}
+var _ error = TableRowsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13936,24 +18881,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProfileColumnValidationError{}
+} = TableRowsResponseValidationError{}
 
-// Validate checks the field values on TableRowsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TableRowsRequest) Validate() error {
+// Validate checks the field values on ModelChangesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModelChangesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TableRowsRequest with the rules
+// ValidateAll checks the field values on ModelChangesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TableRowsRequestMultiError, or nil if none found.
-func (m *TableRowsRequest) ValidateAll() error {
+// ModelChangesRequestMultiError, or nil if none found.
+func (m *ModelChangesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TableRowsRequest) validate(all bool) error {
+func (m *ModelChangesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13962,15 +18907,9 @@ func (m *TableRowsRequest) validate(all bool) error {
 
 	// no validation rules for InstanceId
 
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
-
-	if utf8.RuneCountInString(m.GetTableName()) < 1 {
-		err := TableRowsRequestValidationError{
-			field:  "TableName",
+	if utf8.RuneCountInString(m.GetModelName()) < 1 {
+		err := ModelChangesRequestValidationError{
+			field:  "ModelName",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -13979,9 +18918,9 @@ func (m *TableRowsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetLimit() < 0 {
-		err := TableRowsRequestValidationError{
-			field:  "Limit",
+	if m.GetSinceVersion() < 0 {
+		err := ModelChangesRequestValidationError{
+			field:  "SinceVersion",
 			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
@@ -13990,22 +18929,39 @@ func (m *TableRowsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 10000 {
+			err := ModelChangesRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 10000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
 	// no validation rules for Priority
 
 	if len(errors) > 0 {
-		return TableRowsRequestMultiError(errors)
+		return ModelChangesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TableRowsRequestMultiError is an error wrapping multiple validation errors
-// returned by TableRowsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TableRowsRequestMultiError []error
+// ModelChangesRequestMultiError is an error wrapping multiple validation
+// errors returned by ModelChangesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ModelChangesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TableRowsRequestMultiError) Error() string {
+func (m ModelChangesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14014,11 +18970,11 @@ func (m TableRowsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TableRowsRequestMultiError) AllErrors() []error { return m }
+func (m ModelChangesRequestMultiError) AllErrors() []error { return m }
 
-// TableRowsRequestValidationError is the validation error returned by
-// TableRowsRequest.Validate if the designated constraints aren't met.
-type TableRowsRequestValidationError struct {
+// ModelChangesRequestValidationError is the validation error returned by
+// ModelChangesRequest.Validate if the designated constraints aren't met.
+type ModelChangesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14026,22 +18982,24 @@ type TableRowsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TableRowsRequestValidationError) Field() string { return e.field }
+func (e ModelChangesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TableRowsRequestValidationError) Reason() string { return e.reason }
+func (e ModelChangesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TableRowsRequestValidationError) Cause() error { return e.cause }
+func (e ModelChangesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TableRowsRequestValidationError) Key() bool { return e.key }
+func (e ModelChangesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TableRowsRequestValidationError) ErrorName() string { return "TableRowsRequestValidationError" }
+func (e ModelChangesRequestValidationError) ErrorName() string {
+	return "ModelChangesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TableRowsRequestValidationError) Error() string {
+func (e ModelChangesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14053,14 +19011,14 @@ func (e TableRowsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTableRowsRequest.%s: %s%s",
+		"invalid %sModelChangesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TableRowsRequestValidationError{}
+var _ error = ModelChangesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14068,30 +19026,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TableRowsRequestValidationError{}
+} = ModelChangesRequestValidationError{}
 
-// Validate checks the field values on TableRowsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TableRowsResponse) Validate() error {
+// Validate checks the field values on ModelChangesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModelChangesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TableRowsResponse with the rules
+// ValidateAll checks the field values on ModelChangesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TableRowsResponseMultiError, or nil if none found.
-func (m *TableRowsResponse) ValidateAll() error {
+// ModelChangesResponseMultiError, or nil if none found.
+func (m *ModelChangesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TableRowsResponse) validate(all bool) error {
+func (m *ModelChangesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelChangesResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelChangesResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelChangesResponseValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	for idx, item := range m.GetData() {
 		_, _ = idx, item
 
@@ -14099,7 +19088,7 @@ func (m *TableRowsResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TableRowsResponseValidationError{
+					errors = append(errors, ModelChangesResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -14107,7 +19096,7 @@ func (m *TableRowsResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TableRowsResponseValidationError{
+					errors = append(errors, ModelChangesResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -14116,7 +19105,7 @@ func (m *TableRowsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TableRowsResponseValidationError{
+				return ModelChangesResponseValidationError{
 					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14126,20 +19115,24 @@ func (m *TableRowsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for RemovedRows
+
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return TableRowsResponseMultiError(errors)
+		return ModelChangesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TableRowsResponseMultiError is an error wrapping multiple validation errors
-// returned by TableRowsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type TableRowsResponseMultiError []error
+// ModelChangesResponseMultiError is an error wrapping multiple validation
+// errors returned by ModelChangesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ModelChangesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TableRowsResponseMultiError) Error() string {
+func (m ModelChangesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14148,11 +19141,11 @@ func (m TableRowsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TableRowsResponseMultiError) AllErrors() []error { return m }
+func (m ModelChangesResponseMultiError) AllErrors() []error { return m }
 
-// TableRowsResponseValidationError is the validation error returned by
-// TableRowsResponse.Validate if the designated constraints aren't met.
-type TableRowsResponseValidationError struct {
+// ModelChangesResponseValidationError is the validation error returned by
+// ModelChangesResponse.Validate if the designated constraints aren't met.
+type ModelChangesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14160,24 +19153,24 @@ type TableRowsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TableRowsResponseValidationError) Field() string { return e.field }
+func (e ModelChangesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TableRowsResponseValidationError) Reason() string { return e.reason }
+func (e ModelChangesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TableRowsResponseValidationError) Cause() error { return e.cause }
+func (e ModelChangesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TableRowsResponseValidationError) Key() bool { return e.key }
+func (e ModelChangesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TableRowsResponseValidationError) ErrorName() string {
-	return "TableRowsResponseValidationError"
+func (e ModelChangesResponseValidationError) ErrorName() string {
+	return "ModelChangesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TableRowsResponseValidationError) Error() string {
+func (e ModelChangesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14189,14 +19182,14 @@ func (e TableRowsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTableRowsResponse.%s: %s%s",
+		"invalid %sModelChangesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TableRowsResponseValidationError{}
+var _ error = ModelChangesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14204,7 +19197,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TableRowsResponseValidationError{}
+} = ModelChangesResponseValidationError{}
 
 // Validate checks the field values on MetricsViewFilter_Cond with the rules
 // defined in the proto definition for this message. If any rules are
@@ -14344,6 +19337,117 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewFilter_CondValidationError{}
 
+// Validate checks the field values on TimeZoneTimeRangeSummary_Grain with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TimeZoneTimeRangeSummary_Grain) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeZoneTimeRangeSummary_Grain with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// TimeZoneTimeRangeSummary_GrainMultiError, or nil if none found.
+func (m *TimeZoneTimeRangeSummary_Grain) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeZoneTimeRangeSummary_Grain) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Grain
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Watermark
+
+	if len(errors) > 0 {
+		return TimeZoneTimeRangeSummary_GrainMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeZoneTimeRangeSummary_GrainMultiError is an error wrapping multiple
+// validation errors returned by TimeZoneTimeRangeSummary_Grain.ValidateAll()
+// if the designated constraints aren't met.
+type TimeZoneTimeRangeSummary_GrainMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeZoneTimeRangeSummary_GrainMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeZoneTimeRangeSummary_GrainMultiError) AllErrors() []error { return m }
+
+// TimeZoneTimeRangeSummary_GrainValidationError is the validation error
+// returned by TimeZoneTimeRangeSummary_Grain.Validate if the designated
+// constraints aren't met.
+type TimeZoneTimeRangeSummary_GrainValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeZoneTimeRangeSummary_GrainValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeZoneTimeRangeSummary_GrainValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeZoneTimeRangeSummary_GrainValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeZoneTimeRangeSummary_GrainValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeZoneTimeRangeSummary_GrainValidationError) ErrorName() string {
+	return "TimeZoneTimeRangeSummary_GrainValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TimeZoneTimeRangeSummary_GrainValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeZoneTimeRangeSummary_Grain.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeZoneTimeRangeSummary_GrainValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeZoneTimeRangeSummary_GrainValidationError{}
+
 // Validate checks the field values on MetricsViewSearchResponse_SearchResult
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
@@ -19,31 +19,38 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	QueryService_Query_FullMethodName                       = "/rill.runtime.v1.QueryService/Query"
-	QueryService_QueryBatch_FullMethodName                  = "/rill.runtime.v1.QueryService/QueryBatch"
-	QueryService_Export_FullMethodName                      = "/rill.runtime.v1.QueryService/Export"
-	QueryService_MetricsViewAggregation_FullMethodName      = "/rill.runtime.v1.QueryService/MetricsViewAggregation"
-	QueryService_MetricsViewToplist_FullMethodName          = "/rill.runtime.v1.QueryService/MetricsViewToplist"
-	QueryService_MetricsViewComparison_FullMethodName       = "/rill.runtime.v1.QueryService/MetricsViewComparison"
-	QueryService_MetricsViewTimeSeries_FullMethodName       = "/rill.runtime.v1.QueryService/MetricsViewTimeSeries"
-	QueryService_MetricsViewTotals_FullMethodName           = "/rill.runtime.v1.QueryService/MetricsViewTotals"
-	QueryService_MetricsViewRows_FullMethodName             = "/rill.runtime.v1.QueryService/MetricsViewRows"
-	QueryService_MetricsViewTimeRange_FullMethodName        = "/rill.runtime.v1.QueryService/MetricsViewTimeRange"
-	QueryService_MetricsViewSchema_FullMethodName           = "/rill.runtime.v1.QueryService/MetricsViewSchema"
-	QueryService_MetricsViewSearch_FullMethodName           = "/rill.runtime.v1.QueryService/MetricsViewSearch"
-	QueryService_ColumnRollupInterval_FullMethodName        = "/rill.runtime.v1.QueryService/ColumnRollupInterval"
-	QueryService_ColumnTopK_FullMethodName                  = "/rill.runtime.v1.QueryService/ColumnTopK"
-	QueryService_ColumnNullCount_FullMethodName             = "/rill.runtime.v1.QueryService/ColumnNullCount"
-	QueryService_ColumnDescriptiveStatistics_FullMethodName = "/rill.runtime.v1.QueryService/ColumnDescriptiveStatistics"
-	QueryService_ColumnTimeGrain_FullMethodName             = "/rill.runtime.v1.QueryService/ColumnTimeGrain"
-	QueryService_ColumnNumericHistogram_FullMethodName      = "/rill.runtime.v1.QueryService/ColumnNumericHistogram"
-	QueryService_ColumnRugHistogram_FullMethodName          = "/rill.runtime.v1.QueryService/ColumnRugHistogram"
-	QueryService_ColumnTimeRange_FullMethodName             = "/rill.runtime.v1.QueryService/ColumnTimeRange"
-	QueryService_ColumnCardinality_FullMethodName           = "/rill.runtime.v1.QueryService/ColumnCardinality"
-	QueryService_ColumnTimeSeries_FullMethodName            = "/rill.runtime.v1.QueryService/ColumnTimeSeries"
-	QueryService_TableCardinality_FullMethodName            = "/rill.runtime.v1.QueryService/TableCardinality"
-	QueryService_TableColumns_FullMethodName                = "/rill.runtime.v1.QueryService/TableColumns"
-	QueryService_TableRows_FullMethodName                   = "/rill.runtime.v1.QueryService/TableRows"
+	QueryService_Query_FullMethodName                          = "/rill.runtime.v1.QueryService/Query"
+	QueryService_QueryBatch_FullMethodName                     = "/rill.runtime.v1.QueryService/QueryBatch"
+	QueryService_Export_FullMethodName                         = "/rill.runtime.v1.QueryService/Export"
+	QueryService_MetricsViewAggregation_FullMethodName         = "/rill.runtime.v1.QueryService/MetricsViewAggregation"
+	QueryService_MetricsViewToplist_FullMethodName             = "/rill.runtime.v1.QueryService/MetricsViewToplist"
+	QueryService_MetricsViewComparison_FullMethodName          = "/rill.runtime.v1.QueryService/MetricsViewComparison"
+	QueryService_MetricsViewTimeSeries_FullMethodName          = "/rill.runtime.v1.QueryService/MetricsViewTimeSeries"
+	QueryService_MetricsViewTotals_FullMethodName              = "/rill.runtime.v1.QueryService/MetricsViewTotals"
+	QueryService_MetricsViewRows_FullMethodName                = "/rill.runtime.v1.QueryService/MetricsViewRows"
+	QueryService_MetricsViewTimeRange_FullMethodName           = "/rill.runtime.v1.QueryService/MetricsViewTimeRange"
+	QueryService_MetricsViewDefaults_FullMethodName            = "/rill.runtime.v1.QueryService/MetricsViewDefaults"
+	QueryService_MetricsViewSchema_FullMethodName              = "/rill.runtime.v1.QueryService/MetricsViewSchema"
+	QueryService_MetricsViewSearch_FullMethodName              = "/rill.runtime.v1.QueryService/MetricsViewSearch"
+	QueryService_MetricsViewValidateExpressions_FullMethodName = "/rill.runtime.v1.QueryService/MetricsViewValidateExpressions"
+	QueryService_MetricsViewContribution_FullMethodName        = "/rill.runtime.v1.QueryService/MetricsViewContribution"
+	QueryService_MetricsViewFunnel_FullMethodName              = "/rill.runtime.v1.QueryService/MetricsViewFunnel"
+	QueryService_MetricsViewRetention_FullMethodName           = "/rill.runtime.v1.QueryService/MetricsViewRetention"
+	QueryService_ColumnRollupInterval_FullMethodName           = "/rill.runtime.v1.QueryService/ColumnRollupInterval"
+	QueryService_ColumnTopK_FullMethodName                     = "/rill.runtime.v1.QueryService/ColumnTopK"
+	QueryService_ColumnNullCount_FullMethodName                = "/rill.runtime.v1.QueryService/ColumnNullCount"
+	QueryService_ColumnDescriptiveStatistics_FullMethodName    = "/rill.runtime.v1.QueryService/ColumnDescriptiveStatistics"
+	QueryService_ColumnTimeGrain_FullMethodName                = "/rill.runtime.v1.QueryService/ColumnTimeGrain"
+	QueryService_ColumnNumericHistogram_FullMethodName         = "/rill.runtime.v1.QueryService/ColumnNumericHistogram"
+	QueryService_ColumnRugHistogram_FullMethodName             = "/rill.runtime.v1.QueryService/ColumnRugHistogram"
+	QueryService_ColumnTimeRange_FullMethodName                = "/rill.runtime.v1.QueryService/ColumnTimeRange"
+	QueryService_ColumnCardinality_FullMethodName              = "/rill.runtime.v1.QueryService/ColumnCardinality"
+	QueryService_ColumnTimeSeries_FullMethodName               = "/rill.runtime.v1.QueryService/ColumnTimeSeries"
+	QueryService_TableCardinality_FullMethodName               = "/rill.runtime.v1.QueryService/TableCardinality"
+	QueryService_TableColumns_FullMethodName                   = "/rill.runtime.v1.QueryService/TableColumns"
+	QueryService_TableColumnsProfile_FullMethodName            = "/rill.runtime.v1.QueryService/TableColumnsProfile"
+	QueryService_TableRows_FullMethodName                      = "/rill.runtime.v1.QueryService/TableRows"
+	QueryService_ModelChanges_FullMethodName                   = "/rill.runtime.v1.QueryService/ModelChanges"
 )
 
 // QueryServiceClient is the client API for QueryService service.
@@ -103,10 +110,27 @@ type QueryServiceClient interface {
 	MetricsViewRows(ctx context.Context, in *MetricsViewRowsRequest, opts ...grpc.CallOption) (*MetricsViewRowsResponse, error)
 	// MetricsViewTimeRange Get the time range summaries (min, max) for time column in a metrics view
 	MetricsViewTimeRange(ctx context.Context, in *MetricsViewTimeRangeRequest, opts ...grpc.CallOption) (*MetricsViewTimeRangeResponse, error)
-	// MetricsViewSchema Get the data types of measures and dimensions
+	// MetricsViewDefaults resolves the default dashboard state of a metrics view (time range, comparison, filter and selected fields) against its current data.
+	// It enables all clients (web, embeds and API) to share the same defaults.
+	MetricsViewDefaults(ctx context.Context, in *MetricsViewDefaultsRequest, opts ...grpc.CallOption) (*MetricsViewDefaultsResponse, error)
+	// MetricsViewSchema Get the dimensions and measures of a metrics view with their data types, labels and formats.
+	// Fields the caller doesn't have access to under the metrics view's security policy are omitted.
 	MetricsViewSchema(ctx context.Context, in *MetricsViewSchemaRequest, opts ...grpc.CallOption) (*MetricsViewSchemaResponse, error)
 	// MetricsViewSearch Get the data types of measures and dimensions
 	MetricsViewSearch(ctx context.Context, in *MetricsViewSearchRequest, opts ...grpc.CallOption) (*MetricsViewSearchResponse, error)
+	// MetricsViewValidateExpressions validates where and having expressions against a metrics view without running a query with them.
+	// It returns all the problems it finds, such as unknown names, type mismatches and operators not supported by the OLAP connector.
+	MetricsViewValidateExpressions(ctx context.Context, in *MetricsViewValidateExpressionsRequest, opts ...grpc.CallOption) (*MetricsViewValidateExpressionsResponse, error)
+	// MetricsViewContribution decomposes the change in a measure between two time ranges into the contributions of a dimension's values.
+	// It answers questions like "which countries explain the drop in revenue since last week?".
+	// The decomposition is computed from a single grouped query, so it's only exact for additive measures such as sums and counts.
+	MetricsViewContribution(ctx context.Context, in *MetricsViewContributionRequest, opts ...grpc.CallOption) (*MetricsViewContributionResponse, error)
+	// MetricsViewFunnel counts the values of a dimension, such as a user or session ID, that complete an ordered sequence of steps.
+	// Each step is a filter, and a value completes a step if it matches the step's filter after the previous step and within the conversion window.
+	MetricsViewFunnel(ctx context.Context, in *MetricsViewFunnelRequest, opts ...grpc.CallOption) (*MetricsViewFunnelResponse, error)
+	// MetricsViewRetention groups the values of a dimension, such as a user ID, into cohorts by the time period they were first seen,
+	// and counts how many of each cohort's values are active in each of the following periods.
+	MetricsViewRetention(ctx context.Context, in *MetricsViewRetentionRequest, opts ...grpc.CallOption) (*MetricsViewRetentionResponse, error)
 	// ColumnRollupInterval returns the minimum time granularity (as well as the time range) for a specified timestamp column
 	ColumnRollupInterval(ctx context.Context, in *ColumnRollupIntervalRequest, opts ...grpc.CallOption) (*ColumnRollupIntervalResponse, error)
 	// Get TopK elements from a table for a column given an agg function
@@ -132,8 +156,13 @@ type QueryServiceClient interface {
 	TableCardinality(ctx context.Context, in *TableCardinalityRequest, opts ...grpc.CallOption) (*TableCardinalityResponse, error)
 	// TableColumns returns column profiles
 	TableColumns(ctx context.Context, in *TableColumnsRequest, opts ...grpc.CallOption) (*TableColumnsResponse, error)
+	// TableColumnsProfile returns null counts, cardinality, min/max, histograms and top-K values for a set of columns in a single call
+	TableColumnsProfile(ctx context.Context, in *TableColumnsProfileRequest, opts ...grpc.CallOption) (*TableColumnsProfileResponse, error)
 	// TableRows returns table rows
 	TableRows(ctx context.Context, in *TableRowsRequest, opts ...grpc.CallOption) (*TableRowsResponse, error)
+	// ModelChanges returns the rows added to a model since a change feed version.
+	// It enables downstream consumers to sync deltas instead of doing full exports. The model must have change_feed enabled.
+	ModelChanges(ctx context.Context, in *ModelChangesRequest, opts ...grpc.CallOption) (*ModelChangesResponse, error)
 }
 
 type queryServiceClient struct {
@@ -267,6 +296,16 @@ func (c *queryServiceClient) MetricsViewTimeRange(ctx context.Context, in *Metri
 	return out, nil
 }
 
+func (c *queryServiceClient) MetricsViewDefaults(ctx context.Context, in *MetricsViewDefaultsRequest, opts ...grpc.CallOption) (*MetricsViewDefaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MetricsViewDefaultsResponse)
+	err := c.cc.Invoke(ctx, QueryService_MetricsViewDefaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) MetricsViewSchema(ctx context.Context, in *MetricsViewSchemaRequest, opts ...grpc.CallOption) (*MetricsViewSchemaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MetricsViewSchemaResponse)
@@ -287,6 +326,46 @@ func (c *queryServiceClient) MetricsViewSearch(ctx context.Context, in *MetricsV
 	return out, nil
 }
 
+func (c *queryServiceClient) MetricsViewValidateExpressions(ctx context.Context, in *MetricsViewValidateExpressionsRequest, opts ...grpc.CallOption) (*MetricsViewValidateExpressionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MetricsViewValidateExpressionsResponse)
+	err := c.cc.Invoke(ctx, QueryService_MetricsViewValidateExpressions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) MetricsViewContribution(ctx context.Context, in *MetricsViewContributionRequest, opts ...grpc.CallOption) (*MetricsViewContributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MetricsViewContributionResponse)
+	err := c.cc.Invoke(ctx, QueryService_MetricsViewContribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) MetricsViewFunnel(ctx context.Context, in *MetricsViewFunnelRequest, opts ...grpc.CallOption) (*MetricsViewFunnelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MetricsViewFunnelResponse)
+	err := c.cc.Invoke(ctx, QueryService_MetricsViewFunnel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) MetricsViewRetention(ctx context.Context, in *MetricsViewRetentionRequest, opts ...grpc.CallOption) (*MetricsViewRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MetricsViewRetentionResponse)
+	err := c.cc.Invoke(ctx, QueryService_MetricsViewRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) ColumnRollupInterval(ctx context.Context, in *ColumnRollupIntervalRequest, opts ...grpc.CallOption) (*ColumnRollupIntervalResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ColumnRollupIntervalResponse)
@@ -407,6 +486,16 @@ func (c *queryServiceClient) TableColumns(ctx context.Context, in *TableColumnsR
 	return out, nil
 }
 
+func (c *queryServiceClient) TableColumnsProfile(ctx context.Context, in *TableColumnsProfileRequest, opts ...grpc.CallOption) (*TableColumnsProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableColumnsProfileResponse)
+	err := c.cc.Invoke(ctx, QueryService_TableColumnsProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryServiceClient) TableRows(ctx context.Context, in *TableRowsRequest, opts ...grpc.CallOption) (*TableRowsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TableRowsResponse)
@@ -417,6 +506,16 @@ func (c *queryServiceClient) TableRows(ctx context.Context, in *TableRowsRequest
 	return out, nil
 }
 
+func (c *queryServiceClient) ModelChanges(ctx context.Context, in *ModelChangesRequest, opts ...grpc.CallOption) (*ModelChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModelChangesResponse)
+	err := c.cc.Invoke(ctx, QueryService_ModelChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 // All implementations must embed UnimplementedQueryServiceServer
 // for forward compatibility
@@ -474,10 +573,27 @@ type QueryServiceServer interface {
 	MetricsViewRows(context.Context, *MetricsViewRowsRequest) (*MetricsViewRowsResponse, error)
 	// MetricsViewTimeRange Get the time range summaries (min, max) for time column in a metrics view
 	MetricsViewTimeRange(context.Context, *MetricsViewTimeRangeRequest) (*MetricsViewTimeRangeResponse, error)
-	// MetricsViewSchema Get the data types of measures and dimensions
+	// MetricsViewDefaults resolves the default dashboard state of a metrics view (time range, comparison, filter and selected fields) against its current data.
+	// It enables all clients (web, embeds and API) to share the same defaults.
+	MetricsViewDefaults(context.Context, *MetricsViewDefaultsRequest) (*MetricsViewDefaultsResponse, error)
+	// MetricsViewSchema Get the dimensions and measures of a metrics view with their data types, labels and formats.
+	// Fields the caller doesn't have access to under the metrics view's security policy are omitted.
 	MetricsViewSchema(context.Context, *MetricsViewSchemaRequest) (*MetricsViewSchemaResponse, error)
 	// MetricsViewSearch Get the data types of measures and dimensions
 	MetricsViewSearch(context.Context, *MetricsViewSearchRequest) (*MetricsViewSearchResponse, error)
+	// MetricsViewValidateExpressions validates where and having expressions against a metrics view without running a query with them.
+	// It returns all the problems it finds, such as unknown names, type mismatches and operators not supported by the OLAP connector.
+	MetricsViewValidateExpressions(context.Context, *MetricsViewValidateExpressionsRequest) (*MetricsViewValidateExpressionsResponse, error)
+	// MetricsViewContribution decomposes the change in a measure between two time ranges into the contributions of a dimension's values.
+	// It answers questions like "which countries explain the drop in revenue since last week?".
+	// The decomposition is computed from a single grouped query, so it's only exact for additive measures such as sums and counts.
+	MetricsViewContribution(context.Context, *MetricsViewContributionRequest) (*MetricsViewContributionResponse, error)
+	// MetricsViewFunnel counts the values of a dimension, such as a user or session ID, that complete an ordered sequence of steps.
+	// Each step is a filter, and a value completes a step if it matches the step's filter after the previous step and within the conversion window.
+	MetricsViewFunnel(context.Context, *MetricsViewFunnelRequest) (*MetricsViewFunnelResponse, error)
+	// MetricsViewRetention groups the values of a dimension, such as a user ID, into cohorts by the time period they were first seen,
+	// and counts how many of each cohort's values are active in each of the following periods.
+	MetricsViewRetention(context.Context, *MetricsViewRetentionRequest) (*MetricsViewRetentionResponse, error)
 	// ColumnRollupInterval returns the minimum time granularity (as well as the time range) for a specified timestamp column
 	ColumnRollupInterval(context.Context, *ColumnRollupIntervalRequest) (*ColumnRollupIntervalResponse, error)
 	// Get TopK elements from a table for a column given an agg function
@@ -503,8 +619,13 @@ type QueryServiceServer interface {
 	TableCardinality(context.Context, *TableCardinalityRequest) (*TableCardinalityResponse, error)
 	// TableColumns returns column profiles
 	TableColumns(context.Context, *TableColumnsRequest) (*TableColumnsResponse, error)
+	// TableColumnsProfile returns null counts, cardinality, min/max, histograms and top-K values for a set of columns in a single call
+	TableColumnsProfile(context.Context, *TableColumnsProfileRequest) (*TableColumnsProfileResponse, error)
 	// TableRows returns table rows
 	TableRows(context.Context, *TableRowsRequest) (*TableRowsResponse, error)
+	// ModelChanges returns the rows added to a model since a change feed version.
+	// It enables downstream consumers to sync deltas instead of doing full exports. The model must have change_feed enabled.
+	ModelChanges(context.Context, *ModelChangesRequest) (*ModelChangesResponse, error)
 	mustEmbedUnimplementedQueryServiceServer()
 }
 
@@ -542,12 +663,27 @@ func (UnimplementedQueryServiceServer) MetricsViewRows(context.Context, *Metrics
 func (UnimplementedQueryServiceServer) MetricsViewTimeRange(context.Context, *MetricsViewTimeRangeRequest) (*MetricsViewTimeRangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewTimeRange not implemented")
 }
+func (UnimplementedQueryServiceServer) MetricsViewDefaults(context.Context, *MetricsViewDefaultsRequest) (*MetricsViewDefaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewDefaults not implemented")
+}
 func (UnimplementedQueryServiceServer) MetricsViewSchema(context.Context, *MetricsViewSchemaRequest) (*MetricsViewSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewSchema not implemented")
 }
 func (UnimplementedQueryServiceServer) MetricsViewSearch(context.Context, *MetricsViewSearchRequest) (*MetricsViewSearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewSearch not implemented")
 }
+func (UnimplementedQueryServiceServer) MetricsViewValidateExpressions(context.Context, *MetricsViewValidateExpressionsRequest) (*MetricsViewValidateExpressionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewValidateExpressions not implemented")
+}
+func (UnimplementedQueryServiceServer) MetricsViewContribution(context.Context, *MetricsViewContributionRequest) (*MetricsViewContributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewContribution not implemented")
+}
+func (UnimplementedQueryServiceServer) MetricsViewFunnel(context.Context, *MetricsViewFunnelRequest) (*MetricsViewFunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewFunnel not implemented")
+}
+func (UnimplementedQueryServiceServer) MetricsViewRetention(context.Context, *MetricsViewRetentionRequest) (*MetricsViewRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewRetention not implemented")
+}
 func (UnimplementedQueryServiceServer) ColumnRollupInterval(context.Context, *ColumnRollupIntervalRequest) (*ColumnRollupIntervalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ColumnRollupInterval not implemented")
 }
@@ -584,9 +720,15 @@ func (UnimplementedQueryServiceServer) TableCardinality(context.Context, *TableC
 func (UnimplementedQueryServiceServer) TableColumns(context.Context, *TableColumnsRequest) (*TableColumnsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TableColumns not implemented")
 }
+func (UnimplementedQueryServiceServer) TableColumnsProfile(context.Context, *TableColumnsProfileRequest) (*TableColumnsProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TableColumnsProfile not implemented")
+}
 func (UnimplementedQueryServiceServer) TableRows(context.Context, *TableRowsRequest) (*TableRowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TableRows not implemented")
 }
+func (UnimplementedQueryServiceServer) ModelChanges(context.Context, *ModelChangesRequest) (*ModelChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModelChanges not implemented")
+}
 func (UnimplementedQueryServiceServer) mustEmbedUnimplementedQueryServiceServer() {}
 
 // UnsafeQueryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -783,6 +925,24 @@ func _QueryService_MetricsViewTimeRange_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_MetricsViewDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsViewDefaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).MetricsViewDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_MetricsViewDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).MetricsViewDefaults(ctx, req.(*MetricsViewDefaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_MetricsViewSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MetricsViewSchemaRequest)
 	if err := dec(in); err != nil {
@@ -819,6 +979,78 @@ func _QueryService_MetricsViewSearch_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_MetricsViewValidateExpressions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsViewValidateExpressionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).MetricsViewValidateExpressions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_MetricsViewValidateExpressions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).MetricsViewValidateExpressions(ctx, req.(*MetricsViewValidateExpressionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_MetricsViewContribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsViewContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).MetricsViewContribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_MetricsViewContribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).MetricsViewContribution(ctx, req.(*MetricsViewContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_MetricsViewFunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsViewFunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).MetricsViewFunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_MetricsViewFunnel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).MetricsViewFunnel(ctx, req.(*MetricsViewFunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_MetricsViewRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsViewRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).MetricsViewRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_MetricsViewRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).MetricsViewRetention(ctx, req.(*MetricsViewRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_ColumnRollupInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ColumnRollupIntervalRequest)
 	if err := dec(in); err != nil {
@@ -1035,6 +1267,24 @@ func _QueryService_TableColumns_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_TableColumnsProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TableColumnsProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).TableColumnsProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_TableColumnsProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).TableColumnsProfile(ctx, req.(*TableColumnsProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryService_TableRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TableRowsRequest)
 	if err := dec(in); err != nil {
@@ -1053,6 +1303,24 @@ func _QueryService_TableRows_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_ModelChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModelChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ModelChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_ModelChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ModelChanges(ctx, req.(*ModelChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QueryService_ServiceDesc is the grpc.ServiceDesc for QueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1096,6 +1364,10 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MetricsViewTimeRange",
 			Handler:    _QueryService_MetricsViewTimeRange_Handler,
 		},
+		{
+			MethodName: "MetricsViewDefaults",
+			Handler:    _QueryService_MetricsViewDefaults_Handler,
+		},
 		{
 			MethodName: "MetricsViewSchema",
 			Handler:    _QueryService_MetricsViewSchema_Handler,
@@ -1104,6 +1376,22 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MetricsViewSearch",
 			Handler:    _QueryService_MetricsViewSearch_Handler,
 		},
+		{
+			MethodName: "MetricsViewValidateExpressions",
+			Handler:    _QueryService_MetricsViewValidateExpressions_Handler,
+		},
+		{
+			MethodName: "MetricsViewContribution",
+			Handler:    _QueryService_MetricsViewContribution_Handler,
+		},
+		{
+			MethodName: "MetricsViewFunnel",
+			Handler:    _QueryService_MetricsViewFunnel_Handler,
+		},
+		{
+			MethodName: "MetricsViewRetention",
+			Handler:    _QueryService_MetricsViewRetention_Handler,
+		},
 		{
 			MethodName: "ColumnRollupInterval",
 			Handler:    _QueryService_ColumnRollupInterval_Handler,
@@ -1152,10 +1440,18 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TableColumns",
 			Handler:    _QueryService_TableColumns_Handler,
 		},
+		{
+			MethodName: "TableColumnsProfile",
+			Handler:    _QueryService_TableColumnsProfile_Handler,
+		},
 		{
 			MethodName: "TableRows",
 			Handler:    _QueryService_TableRows_Handler,
 		},
+		{
+			MethodName: "ModelChanges",
+			Handler:    _QueryService_ModelChanges_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -702,6 +702,47 @@ func (m *Resource) validate(all bool) error {
 			}
 		}
 
+	case *Resource_Segment:
+		if v == nil {
+			err := ResourceValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSegment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Segment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceValidationError{
+						field:  "Segment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSegment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceValidationError{
+					field:  "Segment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -2441,6 +2482,44 @@ func (m *ModelSpec) validate(all bool) error {
 
 	// no validation rules for Trigger
 
+	// no validation rules for ChangeFeed
+
+	for idx, item := range m.GetTests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelSpecValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelSpecValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelSpecValidationError{
+					field:  fmt.Sprintf("Tests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TestsBlocking
+
 	if len(errors) > 0 {
 		return ModelSpecMultiError(errors)
 	}
@@ -2666,6 +2745,42 @@ func (m *ModelState) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ChangeFeedVersion
+
+	for idx, item := range m.GetTestResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("TestResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("TestResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelStateValidationError{
+					field:  fmt.Sprintf("TestResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ModelStateMultiError(errors)
 	}
@@ -3089,6 +3204,109 @@ func (m *MetricsViewSpec) validate(all bool) error {
 
 	// no validation rules for DefaultTheme
 
+	// no validation rules for Final
+
+	// no validation rules for TimeAnchor
+
+	if all {
+		switch v := interface{}(m.GetDefaultFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSpecValidationError{
+					field:  "DefaultFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSpecValidationError{
+					field:  "DefaultFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefaultFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSpecValidationError{
+				field:  "DefaultFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("Tests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TestsBlocking
+
+	for idx, item := range m.GetLookupJoins() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("LookupJoins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("LookupJoins[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("LookupJoins[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return MetricsViewSpecMultiError(errors)
 	}
@@ -3167,91 +3385,335 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewSpecValidationError{}
 
-// Validate checks the field values on SecurityRule with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on DataTest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *SecurityRule) Validate() error {
+func (m *DataTest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecurityRule with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SecurityRuleMultiError, or
-// nil if none found.
-func (m *SecurityRule) ValidateAll() error {
+// ValidateAll checks the field values on DataTest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DataTestMultiError, or nil
+// if none found.
+func (m *DataTest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecurityRule) validate(all bool) error {
+func (m *DataTest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Rule.(type) {
-	case *SecurityRule_Access:
-		if v == nil {
-			err := SecurityRuleValidationError{
-				field:  "Rule",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Name
 
-		if all {
-			switch v := interface{}(m.GetAccess()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "Access",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "Access",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for NotNull
+
+	// no validation rules for Unique
+
+	if all {
+		switch v := interface{}(m.GetAcceptedValues()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataTestValidationError{
+					field:  "AcceptedValues",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetAccess()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return SecurityRuleValidationError{
-					field:  "Access",
+				errors = append(errors, DataTestValidationError{
+					field:  "AcceptedValues",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *SecurityRule_FieldAccess:
-		if v == nil {
-			err := SecurityRuleValidationError{
-				field:  "Rule",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetAcceptedValues()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataTestValidationError{
+				field:  "AcceptedValues",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetFieldAccess()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "FieldAccess",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+	// no validation rules for Sql
+
+	if len(errors) > 0 {
+		return DataTestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataTestMultiError is an error wrapping multiple validation errors returned
+// by DataTest.ValidateAll() if the designated constraints aren't met.
+type DataTestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataTestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataTestMultiError) AllErrors() []error { return m }
+
+// DataTestValidationError is the validation error returned by
+// DataTest.Validate if the designated constraints aren't met.
+type DataTestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataTestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataTestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataTestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataTestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataTestValidationError) ErrorName() string { return "DataTestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataTestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataTest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataTestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataTestValidationError{}
+
+// Validate checks the field values on DataTestResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DataTestResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataTestResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DataTestResultMultiError,
+// or nil if none found.
+func (m *DataTestResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataTestResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Passed
+
+	// no validation rules for FailedRows
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return DataTestResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataTestResultMultiError is an error wrapping multiple validation errors
+// returned by DataTestResult.ValidateAll() if the designated constraints
+// aren't met.
+type DataTestResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataTestResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataTestResultMultiError) AllErrors() []error { return m }
+
+// DataTestResultValidationError is the validation error returned by
+// DataTestResult.Validate if the designated constraints aren't met.
+type DataTestResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataTestResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataTestResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataTestResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataTestResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataTestResultValidationError) ErrorName() string { return "DataTestResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataTestResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataTestResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataTestResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataTestResultValidationError{}
+
+// Validate checks the field values on SecurityRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecurityRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecurityRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecurityRuleMultiError, or
+// nil if none found.
+func (m *SecurityRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecurityRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Rule.(type) {
+	case *SecurityRule_Access:
+		if v == nil {
+			err := SecurityRuleValidationError{
+				field:  "Rule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAccess()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "Access",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "Access",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccess()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecurityRuleValidationError{
+					field:  "Access",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SecurityRule_FieldAccess:
+		if v == nil {
+			err := SecurityRuleValidationError{
+				field:  "Rule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFieldAccess()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "FieldAccess",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
@@ -3796,6 +4258,40 @@ func (m *MetricsViewState) validate(all bool) error {
 
 	// no validation rules for Streaming
 
+	for idx, item := range m.GetTestResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewStateValidationError{
+						field:  fmt.Sprintf("TestResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewStateValidationError{
+						field:  fmt.Sprintf("TestResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewStateValidationError{
+					field:  fmt.Sprintf("TestResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return MetricsViewStateMultiError(errors)
 	}
@@ -4460,6 +4956,8 @@ func (m *ReportSpec) validate(all bool) error {
 
 	// no validation rules for ExportFormat
 
+	// no validation rules for ExportFilenameTemplate
+
 	for idx, item := range m.GetNotifiers() {
 		_, _ = idx, item
 
@@ -5236,7 +5734,36 @@ func (m *AlertSpec) validate(all bool) error {
 
 	// no validation rules for Annotations
 
-	switch v := m.QueryFor.(type) {
+	if all {
+		switch v := interface{}(m.GetAnomaly()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "Anomaly",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "Anomaly",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnomaly()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertSpecValidationError{
+				field:  "Anomaly",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.QueryFor.(type) {
 	case *AlertSpec_QueryForUserId:
 		if v == nil {
 			err := AlertSpecValidationError{
@@ -5383,6 +5910,148 @@ var _ interface {
 	ErrorName() string
 } = AlertSpecValidationError{}
 
+// Validate checks the field values on AlertAnomaly with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AlertAnomaly) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlertAnomaly with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AlertAnomalyMultiError, or
+// nil if none found.
+func (m *AlertAnomaly) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlertAnomaly) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MetricsView
+
+	// no validation rules for Measure
+
+	// no validation rules for TimeGrain
+
+	// no validation rules for Window
+
+	// no validation rules for Method
+
+	// no validation rules for Threshold
+
+	if all {
+		switch v := interface{}(m.GetWhere()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertAnomalyValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertAnomalyValidationError{
+					field:  "Where",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWhere()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertAnomalyValidationError{
+				field:  "Where",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TimeZone
+
+	if len(errors) > 0 {
+		return AlertAnomalyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertAnomalyMultiError is an error wrapping multiple validation errors
+// returned by AlertAnomaly.ValidateAll() if the designated constraints aren't met.
+type AlertAnomalyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertAnomalyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertAnomalyMultiError) AllErrors() []error { return m }
+
+// AlertAnomalyValidationError is the validation error returned by
+// AlertAnomaly.Validate if the designated constraints aren't met.
+type AlertAnomalyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertAnomalyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertAnomalyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertAnomalyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertAnomalyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertAnomalyValidationError) ErrorName() string { return "AlertAnomalyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertAnomalyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlertAnomaly.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertAnomalyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertAnomalyValidationError{}
+
 // Validate checks the field values on Notifier with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -10082,56 +10751,98 @@ var _ interface {
 	ErrorName() string
 } = ConnectorV2ValidationError{}
 
-// Validate checks the field values on MetricsViewSpec_DimensionV2 with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSpec_DimensionV2) Validate() error {
+// Validate checks the field values on Segment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Segment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSpec_DimensionV2 with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MetricsViewSpec_DimensionV2MultiError, or nil if none found.
-func (m *MetricsViewSpec_DimensionV2) ValidateAll() error {
+// ValidateAll checks the field values on Segment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SegmentMultiError, or nil if none found.
+func (m *Segment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSpec_DimensionV2) validate(all bool) error {
+func (m *Segment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	// no validation rules for Column
-
-	// no validation rules for Expression
-
-	// no validation rules for Label
-
-	// no validation rules for Description
-
-	// no validation rules for Unnest
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SegmentValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SegmentValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SegmentValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Uri
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SegmentValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SegmentValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SegmentValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return MetricsViewSpec_DimensionV2MultiError(errors)
+		return SegmentMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSpec_DimensionV2MultiError is an error wrapping multiple
-// validation errors returned by MetricsViewSpec_DimensionV2.ValidateAll() if
-// the designated constraints aren't met.
-type MetricsViewSpec_DimensionV2MultiError []error
+// SegmentMultiError is an error wrapping multiple validation errors returned
+// by Segment.ValidateAll() if the designated constraints aren't met.
+type SegmentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSpec_DimensionV2MultiError) Error() string {
+func (m SegmentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10140,12 +10851,11 @@ func (m MetricsViewSpec_DimensionV2MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSpec_DimensionV2MultiError) AllErrors() []error { return m }
+func (m SegmentMultiError) AllErrors() []error { return m }
 
-// MetricsViewSpec_DimensionV2ValidationError is the validation error returned
-// by MetricsViewSpec_DimensionV2.Validate if the designated constraints
-// aren't met.
-type MetricsViewSpec_DimensionV2ValidationError struct {
+// SegmentValidationError is the validation error returned by Segment.Validate
+// if the designated constraints aren't met.
+type SegmentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10153,24 +10863,22 @@ type MetricsViewSpec_DimensionV2ValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSpec_DimensionV2ValidationError) Field() string { return e.field }
+func (e SegmentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSpec_DimensionV2ValidationError) Reason() string { return e.reason }
+func (e SegmentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSpec_DimensionV2ValidationError) Cause() error { return e.cause }
+func (e SegmentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSpec_DimensionV2ValidationError) Key() bool { return e.key }
+func (e SegmentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSpec_DimensionV2ValidationError) ErrorName() string {
-	return "MetricsViewSpec_DimensionV2ValidationError"
-}
+func (e SegmentValidationError) ErrorName() string { return "SegmentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSpec_DimensionV2ValidationError) Error() string {
+func (e SegmentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10182,14 +10890,14 @@ func (e MetricsViewSpec_DimensionV2ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSpec_DimensionV2.%s: %s%s",
+		"invalid %sSegment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSpec_DimensionV2ValidationError{}
+var _ error = SegmentValidationError{}
 
 var _ interface {
 	Field() string
@@ -10197,46 +10905,433 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSpec_DimensionV2ValidationError{}
+} = SegmentValidationError{}
 
-// Validate checks the field values on MetricsViewSpec_DimensionSelector with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *MetricsViewSpec_DimensionSelector) Validate() error {
+// Validate checks the field values on SegmentSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SegmentSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSpec_DimensionSelector
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// MetricsViewSpec_DimensionSelectorMultiError, or nil if none found.
-func (m *MetricsViewSpec_DimensionSelector) ValidateAll() error {
+// ValidateAll checks the field values on SegmentSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SegmentSpecMultiError, or
+// nil if none found.
+func (m *SegmentSpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSpec_DimensionSelector) validate(all bool) error {
+func (m *SegmentSpec) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	// no validation rules for TimeGrain
-
-	// no validation rules for Desc
+	// no validation rules for MetricsView
 
-	if len(errors) > 0 {
-		return MetricsViewSpec_DimensionSelectorMultiError(errors)
-	}
+	// no validation rules for DisplayName
 
-	return nil
-}
+	// no validation rules for Description
 
-// MetricsViewSpec_DimensionSelectorMultiError is an error wrapping multiple
-// validation errors returned by
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SegmentSpecValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SegmentSpecValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SegmentSpecValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SegmentSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// SegmentSpecMultiError is an error wrapping multiple validation errors
+// returned by SegmentSpec.ValidateAll() if the designated constraints aren't met.
+type SegmentSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SegmentSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SegmentSpecMultiError) AllErrors() []error { return m }
+
+// SegmentSpecValidationError is the validation error returned by
+// SegmentSpec.Validate if the designated constraints aren't met.
+type SegmentSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SegmentSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SegmentSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SegmentSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SegmentSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SegmentSpecValidationError) ErrorName() string { return "SegmentSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SegmentSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSegmentSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SegmentSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SegmentSpecValidationError{}
+
+// Validate checks the field values on SegmentState with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SegmentState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SegmentState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SegmentStateMultiError, or
+// nil if none found.
+func (m *SegmentState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SegmentState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetValidSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SegmentStateValidationError{
+					field:  "ValidSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SegmentStateValidationError{
+					field:  "ValidSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SegmentStateValidationError{
+				field:  "ValidSpec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SegmentStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// SegmentStateMultiError is an error wrapping multiple validation errors
+// returned by SegmentState.ValidateAll() if the designated constraints aren't met.
+type SegmentStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SegmentStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SegmentStateMultiError) AllErrors() []error { return m }
+
+// SegmentStateValidationError is the validation error returned by
+// SegmentState.Validate if the designated constraints aren't met.
+type SegmentStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SegmentStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SegmentStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SegmentStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SegmentStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SegmentStateValidationError) ErrorName() string { return "SegmentStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SegmentStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSegmentState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SegmentStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SegmentStateValidationError{}
+
+// Validate checks the field values on MetricsViewSpec_DimensionV2 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_DimensionV2) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_DimensionV2 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_DimensionV2MultiError, or nil if none found.
+func (m *MetricsViewSpec_DimensionV2) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_DimensionV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Column
+
+	// no validation rules for Expression
+
+	// no validation rules for Label
+
+	// no validation rules for Description
+
+	// no validation rules for Unnest
+
+	// no validation rules for Uri
+
+	// no validation rules for LookupTable
+
+	// no validation rules for LookupKeyColumn
+
+	// no validation rules for LookupValueColumn
+
+	// no validation rules for LookupDefaultExpression
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_DimensionV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_DimensionV2MultiError is an error wrapping multiple
+// validation errors returned by MetricsViewSpec_DimensionV2.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewSpec_DimensionV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_DimensionV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_DimensionV2MultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_DimensionV2ValidationError is the validation error returned
+// by MetricsViewSpec_DimensionV2.Validate if the designated constraints
+// aren't met.
+type MetricsViewSpec_DimensionV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_DimensionV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_DimensionV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_DimensionV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_DimensionV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_DimensionV2ValidationError) ErrorName() string {
+	return "MetricsViewSpec_DimensionV2ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_DimensionV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_DimensionV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_DimensionV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_DimensionV2ValidationError{}
+
+// Validate checks the field values on MetricsViewSpec_DimensionSelector with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *MetricsViewSpec_DimensionSelector) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_DimensionSelector
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_DimensionSelectorMultiError, or nil if none found.
+func (m *MetricsViewSpec_DimensionSelector) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_DimensionSelector) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for TimeGrain
+
+	// no validation rules for Desc
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_DimensionSelectorMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_DimensionSelectorMultiError is an error wrapping multiple
+// validation errors returned by
 // MetricsViewSpec_DimensionSelector.ValidateAll() if the designated
 // constraints aren't met.
 type MetricsViewSpec_DimensionSelectorMultiError []error
@@ -10584,6 +11679,8 @@ func (m *MetricsViewSpec_MeasureV2) validate(all bool) error {
 
 	// no validation rules for FormatD3
 
+	// no validation rules for Unit
+
 	// no validation rules for ValidPercentOfTotal
 
 	if len(errors) > 0 {
@@ -10916,3 +12013,217 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MetricsViewSpec_AvailableTimeRangeValidationError{}
+
+// Validate checks the field values on MetricsViewSpec_LookupJoin with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_LookupJoin) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_LookupJoin with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_LookupJoinMultiError, or nil if none found.
+func (m *MetricsViewSpec_LookupJoin) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_LookupJoin) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Lookup
+
+	// no validation rules for Alias
+
+	// no validation rules for KeyExpression
+
+	// no validation rules for Inner
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_LookupJoinMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_LookupJoinMultiError is an error wrapping multiple
+// validation errors returned by MetricsViewSpec_LookupJoin.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsViewSpec_LookupJoinMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_LookupJoinMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_LookupJoinMultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_LookupJoinValidationError is the validation error returned
+// by MetricsViewSpec_LookupJoin.Validate if the designated constraints aren't met.
+type MetricsViewSpec_LookupJoinValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_LookupJoinValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_LookupJoinValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_LookupJoinValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_LookupJoinValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_LookupJoinValidationError) ErrorName() string {
+	return "MetricsViewSpec_LookupJoinValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_LookupJoinValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_LookupJoin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_LookupJoinValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_LookupJoinValidationError{}
+
+// Validate checks the field values on DataTest_AcceptedValues with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataTest_AcceptedValues) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataTest_AcceptedValues with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataTest_AcceptedValuesMultiError, or nil if none found.
+func (m *DataTest_AcceptedValues) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataTest_AcceptedValues) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return DataTest_AcceptedValuesMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataTest_AcceptedValuesMultiError is an error wrapping multiple validation
+// errors returned by DataTest_AcceptedValues.ValidateAll() if the designated
+// constraints aren't met.
+type DataTest_AcceptedValuesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataTest_AcceptedValuesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataTest_AcceptedValuesMultiError) AllErrors() []error { return m }
+
+// DataTest_AcceptedValuesValidationError is the validation error returned by
+// DataTest_AcceptedValues.Validate if the designated constraints aren't met.
+type DataTest_AcceptedValuesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataTest_AcceptedValuesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataTest_AcceptedValuesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataTest_AcceptedValuesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataTest_AcceptedValuesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataTest_AcceptedValuesValidationError) ErrorName() string {
+	return "DataTest_AcceptedValuesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataTest_AcceptedValuesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataTest_AcceptedValues.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataTest_AcceptedValuesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataTest_AcceptedValuesValidationError{}
@@ -147,6 +147,35 @@ func (m *Type) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetDecimalType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TypeValidationError{
+					field:  "DecimalType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TypeValidationError{
+					field:  "DecimalType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecimalType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TypeValidationError{
+				field:  "DecimalType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return TypeMultiError(errors)
 	}
@@ -513,6 +542,109 @@ var _ interface {
 	ErrorName() string
 } = MapTypeValidationError{}
 
+// Validate checks the field values on DecimalType with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DecimalType) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DecimalType with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DecimalTypeMultiError, or
+// nil if none found.
+func (m *DecimalType) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DecimalType) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Precision
+
+	// no validation rules for Scale
+
+	if len(errors) > 0 {
+		return DecimalTypeMultiError(errors)
+	}
+
+	return nil
+}
+
+// DecimalTypeMultiError is an error wrapping multiple validation errors
+// returned by DecimalType.ValidateAll() if the designated constraints aren't met.
+type DecimalTypeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DecimalTypeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DecimalTypeMultiError) AllErrors() []error { return m }
+
+// DecimalTypeValidationError is the validation error returned by
+// DecimalType.Validate if the designated constraints aren't met.
+type DecimalTypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecimalTypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecimalTypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecimalTypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecimalTypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecimalTypeValidationError) ErrorName() string { return "DecimalTypeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DecimalTypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecimalType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecimalTypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecimalTypeValidationError{}
+
 // Validate checks the field values on StructType_Field with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
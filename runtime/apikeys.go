@@ -0,0 +1,33 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// APIKeys returns the API keys created for an instance.
+func (r *Runtime) APIKeys(ctx context.Context, instanceID string) ([]*drivers.APIKey, error) {
+	return r.registryCache.store.FindAPIKeys(ctx, instanceID)
+}
+
+// APIKey looks up an API key by ID. It returns drivers.ErrNotFound if the key doesn't exist.
+func (r *Runtime) APIKey(ctx context.Context, id string) (*drivers.APIKey, error) {
+	return r.registryCache.store.FindAPIKey(ctx, id)
+}
+
+// CreateAPIKey stores a new API key for an instance.
+// The caller is responsible for generating the key and setting its secret hash.
+func (r *Runtime) CreateAPIKey(ctx context.Context, key *drivers.APIKey) error {
+	// Check the instance exists
+	_, err := r.registryCache.get(key.InstanceID)
+	if err != nil {
+		return err
+	}
+	return r.registryCache.store.InsertAPIKey(ctx, key)
+}
+
+// RevokeAPIKey deletes an API key. Requests authenticated with the key will be rejected immediately after it returns.
+func (r *Runtime) RevokeAPIKey(ctx context.Context, id string) error {
+	return r.registryCache.store.DeleteAPIKey(ctx, id)
+}
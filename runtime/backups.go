@@ -0,0 +1,572 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+
+	// Register blob drivers for the backup URL
+	_ "gocloud.dev/blob/fileblob"
+	_ "gocloud.dev/blob/gcsblob"
+	_ "gocloud.dev/blob/s3blob"
+)
+
+// ErrBackupsNotConfigured is returned when backups are requested without Options.BackupURL being set.
+var ErrBackupsNotConfigured = errors.New("backups are not configured")
+
+// errBackupsUnsupported is returned when backing up an instance with an OLAP connector other than DuckDB.
+var errBackupsUnsupported = errors.New("backups are only supported for DuckDB")
+
+// backupManifestFile is the name of the file describing a backup. It's written last, so backups without it are incomplete.
+const backupManifestFile = "manifest.json"
+
+// backupCatalogFile is the name of the file containing the catalog resources of a snapshot.
+const backupCatalogFile = "catalog.json"
+
+// Backup describes a backup of the tables in an instance's DuckDB OLAP.
+type Backup struct {
+	ID        string        `json:"id"`
+	CreatedOn time.Time     `json:"created_on"`
+	Tables    []BackupTable `json:"tables"`
+	// Catalog is the file containing the instance's catalog resources. It's only set for snapshots (see SnapshotInstance).
+	Catalog string `json:"catalog,omitempty"`
+}
+
+// BackupTable describes a table stored in a backup as a Parquet file.
+type BackupTable struct {
+	Name      string `json:"name"`
+	File      string `json:"file"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+// SizeBytes returns the total size of the backup's files.
+func (b *Backup) SizeBytes() int64 {
+	var n int64
+	for _, t := range b.Tables {
+		n += t.SizeBytes
+	}
+	return n
+}
+
+// BackupInstance exports the tables of the instance's sources and models to Parquet files in the backup bucket.
+// Only instances with a DuckDB OLAP connector can be backed up.
+// After a successful backup, backups beyond Options.BackupRetention are deleted.
+func (r *Runtime) BackupInstance(ctx context.Context, instanceID string) (*Backup, error) {
+	return r.backupInstance(ctx, instanceID, false)
+}
+
+// SnapshotInstance pauses the instance's controller and creates a backup that also contains the instance's catalog.
+// The controller stays paused after the snapshot has been created, so the instance keeps serving queries but doesn't change
+// until ResumeInstance is called or the instance is deleted.
+// Snapshots are used to move an instance to another runtime without re-ingesting its data (see CreateInstanceFromSnapshot).
+func (r *Runtime) SnapshotInstance(ctx context.Context, instanceID string) (*Backup, error) {
+	ctrl, err := r.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctrl.Pause(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause controller: %w", err)
+	}
+
+	b, err := r.backupInstance(ctx, instanceID, true)
+	if err != nil {
+		ctrl.Resume()
+		return nil, err
+	}
+
+	r.logger.Info("created instance snapshot", zap.String("instance_id", instanceID), zap.String("backup_id", b.ID), zap.Int("tables", len(b.Tables)))
+	return b, nil
+}
+
+// ResumeInstance resumes reconciliation of an instance paused by SnapshotInstance.
+func (r *Runtime) ResumeInstance(ctx context.Context, instanceID string) error {
+	ctrl, err := r.Controller(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	ctrl.Resume()
+	return nil
+}
+
+// CreateInstanceFromSnapshot creates a new instance and populates its OLAP tables and catalog from a snapshot of another instance before starting its controller.
+// The snapshot is looked up in the backups of sourceInstanceID, so the runtime that created it must use the same Options.BackupURL.
+// It returns when the instance has been restored. If the restore fails, the instance is still created, but its data will be re-ingested.
+func (r *Runtime) CreateInstanceFromSnapshot(ctx context.Context, inst *drivers.Instance, sourceInstanceID, backupID string) error {
+	bucket, err := r.openBackupBucket(ctx, sourceInstanceID)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	b, err := readBackup(ctx, bucket, backupID)
+	if err != nil {
+		return err
+	}
+	if b.Catalog == "" {
+		return fmt.Errorf("backup %q is not a snapshot", backupID)
+	}
+
+	done := make(chan error, 1)
+	err = r.registryCache.create(ctx, inst, func(ctx context.Context) error {
+		err := r.restoreSnapshot(ctx, inst.ID, bucket, b)
+		done <- err
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("failed to restore snapshot: %w", err)
+		}
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	r.logger.Info("created instance from snapshot", zap.String("instance_id", inst.ID), zap.String("source_instance_id", sourceInstanceID), zap.String("backup_id", b.ID))
+	return nil
+}
+
+func (r *Runtime) backupInstance(ctx context.Context, instanceID string, withCatalog bool) (*Backup, error) {
+	bucket, err := r.openBackupBucket(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	olap, release, err := r.OLAP(ctx, instanceID, "")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if olap.Dialect() != drivers.DialectDuckDB {
+		return nil, errBackupsUnsupported
+	}
+
+	tables, err := r.backupTableNames(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpDir := r.TempDir(instanceID, "backup")
+	if err := os.MkdirAll(tmpDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	now := time.Now().UTC()
+	b := &Backup{
+		ID:        now.Format("20060102T150405Z"),
+		CreatedOn: now,
+	}
+	for i, name := range tables {
+		file := fmt.Sprintf("%d.parquet", i)
+		localPath := filepath.Join(tmpDir, file)
+
+		err := olap.Exec(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("COPY (SELECT * FROM %s) TO %s (FORMAT PARQUET)", olap.Dialect().EscapeIdentifier(name), olap.Dialect().EscapeStringValue(localPath)),
+			Priority:    -1,
+			LongRunning: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to export table %q: %w", name, err)
+		}
+
+		size, err := uploadFile(ctx, bucket, path.Join(b.ID, file), localPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload table %q: %w", name, err)
+		}
+		_ = os.Remove(localPath)
+
+		b.Tables = append(b.Tables, BackupTable{Name: name, File: file, SizeBytes: size})
+	}
+
+	if withCatalog {
+		err := r.backupCatalog(ctx, instanceID, bucket, b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to back up catalog: %w", err)
+		}
+	}
+
+	manifest, err := json.Marshal(b)
+	if err != nil {
+		return nil, err
+	}
+	err = bucket.WriteAll(ctx, path.Join(b.ID, backupManifestFile), manifest, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.pruneBackups(ctx, bucket); err != nil {
+		r.logger.Warn("failed to prune backups", zap.String("instance_id", instanceID), zap.Error(err))
+	}
+
+	return b, nil
+}
+
+// ListBackups returns the complete backups of an instance ordered from newest to oldest.
+func (r *Runtime) ListBackups(ctx context.Context, instanceID string) ([]*Backup, error) {
+	bucket, err := r.openBackupBucket(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	return listBackups(ctx, bucket)
+}
+
+// RestoreInstance recreates the tables contained in a backup in the instance's OLAP connector.
+// Existing tables with the same names are replaced. Other tables are left untouched.
+func (r *Runtime) RestoreInstance(ctx context.Context, instanceID, backupID string) (*Backup, error) {
+	bucket, err := r.openBackupBucket(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	b, err := readBackup(ctx, bucket, backupID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.restoreTables(ctx, instanceID, bucket, b)
+	if err != nil {
+		return nil, err
+	}
+
+	r.logger.Info("restored instance from backup", zap.String("instance_id", instanceID), zap.String("backup_id", b.ID), zap.Int("tables", len(b.Tables)))
+	return b, nil
+}
+
+// restoreTables recreates the tables contained in a backup in the instance's default OLAP connector.
+func (r *Runtime) restoreTables(ctx context.Context, instanceID string, bucket *blob.Bucket, b *Backup) error {
+	olap, release, err := r.OLAP(ctx, instanceID, "")
+	if err != nil {
+		return err
+	}
+	defer release()
+	if olap.Dialect() != drivers.DialectDuckDB {
+		return errBackupsUnsupported
+	}
+
+	tmpDir := r.TempDir(instanceID, "restore")
+	if err := os.MkdirAll(tmpDir, os.ModePerm); err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	for _, t := range b.Tables {
+		localPath := filepath.Join(tmpDir, t.File)
+		err := downloadFile(ctx, bucket, path.Join(b.ID, t.File), localPath)
+		if err != nil {
+			return fmt.Errorf("failed to download table %q: %w", t.Name, err)
+		}
+
+		sql := fmt.Sprintf("SELECT * FROM read_parquet(%s)", olap.Dialect().EscapeStringValue(localPath))
+		err = olap.CreateTableAsSelect(ctx, t.Name, false, sql, nil)
+		if err != nil {
+			return fmt.Errorf("failed to restore table %q: %w", t.Name, err)
+		}
+		_ = os.Remove(localPath)
+	}
+
+	return nil
+}
+
+// restoreSnapshot restores the tables and catalog of a snapshot into a new instance.
+// It must be called before the instance's controller is started.
+// The tables are restored first, so a failure leaves an empty catalog that'll trigger a full re-ingestion.
+func (r *Runtime) restoreSnapshot(ctx context.Context, instanceID string, bucket *blob.Bucket, b *Backup) error {
+	err := r.restoreTables(ctx, instanceID, bucket, b)
+	if err != nil {
+		return err
+	}
+
+	data, err := bucket.ReadAll(ctx, path.Join(b.ID, b.Catalog))
+	if err != nil {
+		return fmt.Errorf("failed to download catalog: %w", err)
+	}
+	var resources []drivers.Resource
+	if err := json.Unmarshal(data, &resources); err != nil {
+		return fmt.Errorf("invalid catalog file: %w", err)
+	}
+
+	catalog, release, err := r.Catalog(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	v, err := catalog.NextControllerVersion(ctx)
+	if err != nil {
+		return err
+	}
+	for _, res := range resources {
+		err := catalog.CreateResource(ctx, v, res)
+		if err != nil {
+			return fmt.Errorf("failed to restore resource %s/%s: %w", res.Kind, res.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// backupCatalog uploads the instance's catalog resources to the backup and sets b.Catalog.
+func (r *Runtime) backupCatalog(ctx context.Context, instanceID string, bucket *blob.Bucket, b *Backup) error {
+	catalog, release, err := r.Catalog(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	resources, err := catalog.FindResources(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(resources)
+	if err != nil {
+		return err
+	}
+
+	err = bucket.WriteAll(ctx, path.Join(b.ID, backupCatalogFile), data, nil)
+	if err != nil {
+		return err
+	}
+	b.Catalog = backupCatalogFile
+	return nil
+}
+
+// runBackupScheduler backs up all DuckDB-backed instances every Options.BackupInterval until ctx is cancelled.
+func (r *Runtime) runBackupScheduler(ctx context.Context) {
+	if r.opts.BackupURL == "" || r.opts.BackupInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(r.opts.BackupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		insts, err := r.registryCache.list()
+		if err != nil {
+			r.logger.Error("backup: failed to list instances", zap.Error(err))
+			continue
+		}
+		for _, inst := range insts {
+			start := time.Now()
+			b, err := r.BackupInstance(ctx, inst.ID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if errors.Is(err, errBackupsUnsupported) {
+					continue
+				}
+				r.logger.Error("backup: failed", zap.String("instance_id", inst.ID), zap.Error(err))
+				continue
+			}
+			r.logger.Info("backup: completed", zap.String("instance_id", inst.ID), zap.String("backup_id", b.ID), zap.Int64("size_bytes", b.SizeBytes()), zap.Duration("duration", time.Since(start)))
+		}
+	}
+}
+
+// backupTableNames returns the names of the tables created by the instance's sources and models in its default OLAP connector.
+// Models materialized as views are skipped since they don't contain data.
+func (r *Runtime) backupTableNames(ctx context.Context, instanceID string) ([]string, error) {
+	inst, err := r.Instance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	olapConnector := inst.ResolveOLAPConnector()
+
+	ctrl, err := r.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	srcs, err := ctrl.List(ctx, ResourceKindSource, "", false)
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range srcs {
+		state := res.GetSource().State
+		if state.Table != "" && state.Connector == olapConnector {
+			names = append(names, state.Table)
+		}
+	}
+
+	mdls, err := ctrl.List(ctx, ResourceKindModel, "", false)
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range mdls {
+		state := res.GetModel().State
+		if state.ResultTable == "" || state.ResultConnector != olapConnector {
+			continue
+		}
+		if view, ok := state.ResultProperties.AsMap()["view"].(bool); ok && view {
+			continue
+		}
+		names = append(names, state.ResultTable)
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
+
+// openBackupBucket opens the backup bucket scoped to the instance's backups.
+func (r *Runtime) openBackupBucket(ctx context.Context, instanceID string) (*blob.Bucket, error) {
+	if r.opts.BackupURL == "" {
+		return nil, ErrBackupsNotConfigured
+	}
+
+	bucket, err := blob.OpenBucket(ctx, r.opts.BackupURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open backup bucket: %w", err)
+	}
+	return blob.PrefixedBucket(bucket, instanceID+"/"), nil
+}
+
+// pruneBackups deletes the oldest backups beyond Options.BackupRetention.
+func (r *Runtime) pruneBackups(ctx context.Context, bucket *blob.Bucket) error {
+	if r.opts.BackupRetention <= 0 {
+		return nil
+	}
+
+	backups, err := listBackups(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if len(backups) <= r.opts.BackupRetention {
+		return nil
+	}
+
+	for _, b := range backups[r.opts.BackupRetention:] {
+		// Delete the manifest first, so a partially deleted backup is not listed
+		keys := []string{path.Join(b.ID, backupManifestFile)}
+		for _, t := range b.Tables {
+			keys = append(keys, path.Join(b.ID, t.File))
+		}
+		if b.Catalog != "" {
+			keys = append(keys, path.Join(b.ID, b.Catalog))
+		}
+		for _, key := range keys {
+			err := bucket.Delete(ctx, key)
+			if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func listBackups(ctx context.Context, bucket *blob.Bucket) ([]*Backup, error) {
+	var res []*Backup
+	it := bucket.List(&blob.ListOptions{Delimiter: "/"})
+	for {
+		obj, err := it.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !obj.IsDir {
+			continue
+		}
+
+		b, err := readBackup(ctx, bucket, strings.TrimSuffix(obj.Key, "/"))
+		if err != nil {
+			if errors.Is(err, drivers.ErrNotFound) {
+				// Incomplete backup
+				continue
+			}
+			return nil, err
+		}
+		res = append(res, b)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].CreatedOn.After(res[j].CreatedOn)
+	})
+	return res, nil
+}
+
+func readBackup(ctx context.Context, bucket *blob.Bucket, backupID string) (*Backup, error) {
+	if backupID == "" || strings.Contains(backupID, "/") {
+		return nil, fmt.Errorf("invalid backup ID %q", backupID)
+	}
+
+	data, err := bucket.ReadAll(ctx, path.Join(backupID, backupManifestFile))
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, drivers.ErrNotFound
+		}
+		return nil, err
+	}
+
+	b := &Backup{}
+	if err := json.Unmarshal(data, b); err != nil {
+		return nil, fmt.Errorf("invalid backup manifest: %w", err)
+	}
+	return b, nil
+}
+
+func uploadFile(ctx context.Context, bucket *blob.Bucket, key, localPath string) (int64, error) {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	w, err := bucket.NewWriter(ctx, key, nil)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(w, f)
+	if err != nil {
+		_ = w.Close()
+		return 0, err
+	}
+	return n, w.Close()
+}
+
+func downloadFile(ctx context.Context, bucket *blob.Bucket, key, localPath string) error {
+	rd, err := bucket.NewReader(ctx, key, nil)
+	if err != nil {
+		return err
+	}
+	defer rd.Close()
+
+	f, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, rd)
+	return err
+}
@@ -0,0 +1,59 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/memblob"
+)
+
+func TestBackupRetention(t *testing.T) {
+	ctx := context.Background()
+	bucket := blob.PrefixedBucket(memblob.OpenBucket(nil), "inst/")
+	defer bucket.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		createdOn := start.Add(time.Duration(i) * time.Hour)
+		b := &Backup{
+			ID:        createdOn.Format("20060102T150405Z"),
+			CreatedOn: createdOn,
+			Tables:    []BackupTable{{Name: "foo", File: "0.parquet", SizeBytes: 3}},
+		}
+		require.NoError(t, bucket.WriteAll(ctx, path.Join(b.ID, "0.parquet"), []byte("foo"), nil))
+		data, err := json.Marshal(b)
+		require.NoError(t, err)
+		require.NoError(t, bucket.WriteAll(ctx, path.Join(b.ID, backupManifestFile), data, nil))
+	}
+
+	// A backup without a manifest is incomplete and should not be listed
+	require.NoError(t, bucket.WriteAll(ctx, "20240102T000000Z/0.parquet", []byte("bar"), nil))
+
+	backups, err := listBackups(ctx, bucket)
+	require.NoError(t, err)
+	require.Len(t, backups, 4)
+	require.Equal(t, "20240101T030000Z", backups[0].ID)
+	require.Equal(t, int64(3), backups[0].SizeBytes())
+
+	r := &Runtime{opts: &Options{BackupRetention: 2}, logger: zap.NewNop()}
+	require.NoError(t, r.pruneBackups(ctx, bucket))
+
+	backups, err = listBackups(ctx, bucket)
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	require.Equal(t, "20240101T030000Z", backups[0].ID)
+	require.Equal(t, "20240101T020000Z", backups[1].ID)
+
+	ok, err := bucket.Exists(ctx, "20240101T000000Z/0.parquet")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = readBackup(ctx, bucket, "../other")
+	require.Error(t, err)
+}
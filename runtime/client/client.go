@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/url"
 
@@ -21,6 +22,12 @@ type Client struct {
 
 // New creates a new Client and opens a connection. You must call Close() when done with the client.
 func New(runtimeHost, bearerToken string) (*Client, error) {
+	return NewWithTLS(runtimeHost, bearerToken, nil)
+}
+
+// NewWithTLS is similar to New, but uses the provided TLS config for non-HTTP hosts instead of the host's root certs.
+// It's used to present a client certificate to runtimes that have mutual TLS enabled.
+func NewWithTLS(runtimeHost, bearerToken string, tlsConfig *tls.Config) (*Client, error) {
 	uri, err := url.Parse(runtimeHost)
 	if err != nil {
 		return nil, err
@@ -33,7 +40,11 @@ func New(runtimeHost, bearerToken string) (*Client, error) {
 	if uri.Scheme == "http" {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	} else {
-		opts = append(opts, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, ""))) // NOTE: Defaults to host's root certs
+		if tlsConfig != nil {
+			opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		} else {
+			opts = append(opts, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, ""))) // NOTE: Defaults to host's root certs
+		}
 		// There must be a port. Default to TLS port.
 		if uri.Port() == "" {
 			uri.Host = fmt.Sprintf("%s:443", uri.Host)
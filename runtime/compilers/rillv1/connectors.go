@@ -183,7 +183,8 @@ func (a *connectorAnalyzer) analyzeResourceNotifiers(r *Resource, notifiers []*r
 	for _, n := range notifiers {
 		anonAccess := false
 		var decodeErr error
-		if n.Connector == "slack" {
+		switch n.Connector {
+		case "slack":
 			// Slack notifier can be used anonymously if no users and no channels are specified (only webhooks)
 			props, err := slack.DecodeProps(n.Properties.AsMap())
 			decodeErr = err
@@ -192,6 +193,9 @@ func (a *connectorAnalyzer) analyzeResourceNotifiers(r *Resource, notifiers []*r
 					anonAccess = true
 				}
 			}
+		case "teams", "webhook":
+			// Teams and webhook notifiers only post to the URLs in their properties (a webhook secret is optional)
+			anonAccess = true
 		}
 		a.trackConnector(n.Connector, r, anonAccess)
 		if decodeErr != nil {
@@ -10,6 +10,9 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/teams"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
+	"github.com/rilldata/rill/runtime/pkg/expressionpb"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -36,11 +39,12 @@ type AlertYAML struct {
 			Attributes map[string]any `yaml:"attributes"`
 		} `yaml:"for"`
 	} `yaml:"query"`
-	OnRecover     *bool  `yaml:"on_recover"`
-	OnFail        *bool  `yaml:"on_fail"`
-	OnError       *bool  `yaml:"on_error"`
-	Renotify      *bool  `yaml:"renotify"`
-	RenotifyAfter string `yaml:"renotify_after"`
+	Anomaly       *AlertAnomalyYAML `yaml:"anomaly"`
+	OnRecover     *bool             `yaml:"on_recover"`
+	OnFail        *bool             `yaml:"on_fail"`
+	OnError       *bool             `yaml:"on_error"`
+	Renotify      *bool             `yaml:"renotify"`
+	RenotifyAfter string            `yaml:"renotify_after"`
 	Notify        struct {
 		Email struct {
 			Recipients []string `yaml:"recipients"`
@@ -50,6 +54,12 @@ type AlertYAML struct {
 			Channels []string `yaml:"channels"`
 			Webhooks []string `yaml:"webhooks"`
 		} `yaml:"slack"`
+		Teams struct {
+			Webhooks []string `yaml:"webhooks"`
+		} `yaml:"teams"`
+		Webhook struct {
+			URLs []string `yaml:"urls"`
+		} `yaml:"webhook"`
 	} `yaml:"notify"`
 	Annotations map[string]string `yaml:"annotations"`
 	// Backwards compatibility
@@ -63,6 +73,19 @@ type AlertYAML struct {
 	} `yaml:"email"`
 }
 
+// AlertAnomalyYAML is the raw structure of the anomaly detection options of an alert.
+// The alert fails when the latest complete time period of the measure deviates from its trailing window by more than the threshold.
+type AlertAnomalyYAML struct {
+	MetricsView string  `yaml:"metrics_view"`
+	Measure     string  `yaml:"measure"`
+	TimeGrain   string  `yaml:"time_grain"`
+	Window      uint    `yaml:"window"`
+	Method      string  `yaml:"method"` // options: "zscore", "percent_deviation"
+	Threshold   float64 `yaml:"threshold"`
+	Where       string  `yaml:"where"`
+	TimeZone    string  `yaml:"time_zone"`
+}
+
 // parseAlert parses an alert definition and adds the resulting resource to p.Resources.
 func (p *Parser) parseAlert(node *Node) error {
 	// Parse YAML
@@ -116,13 +139,28 @@ func (p *Parser) parseAlert(node *Node) error {
 		}
 	}
 
+	// Anomaly detection (replaces the query)
+	var anomaly *runtimev1.AlertAnomaly
+	if tmp.Anomaly != nil {
+		if tmp.Query.Name != "" {
+			return errors.New(`cannot set both "query" and "anomaly"`)
+		}
+		anomaly, err = parseAlertAnomaly(tmp.Anomaly)
+		if err != nil {
+			return err
+		}
+		node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindMetricsView, Name: anomaly.MetricsView})
+	}
+
 	// Query name
-	if tmp.Query.Name == "" {
+	if tmp.Query.Name == "" && anomaly == nil {
 		return fmt.Errorf(`invalid value %q for property "query.name"`, tmp.Query.Name)
 	}
 
 	// Query args
-	if tmp.Query.ArgsJSON != "" {
+	if anomaly != nil {
+		// Not applicable
+	} else if tmp.Query.ArgsJSON != "" {
 		// Validate JSON
 		if !json.Valid([]byte(tmp.Query.ArgsJSON)) {
 			return errors.New(`failed to parse "query.args_json" as JSON`)
@@ -135,7 +173,7 @@ func (p *Parser) parseAlert(node *Node) error {
 		}
 		tmp.Query.ArgsJSON = string(data)
 	}
-	if tmp.Query.ArgsJSON == "" {
+	if tmp.Query.ArgsJSON == "" && anomaly == nil {
 		return errors.New(`missing query args (must set either "query.args" or "query.args_json")`)
 	}
 
@@ -227,6 +265,7 @@ func (p *Parser) parseAlert(node *Node) error {
 	}
 	r.AlertSpec.QueryName = tmp.Query.Name
 	r.AlertSpec.QueryArgsJson = tmp.Query.ArgsJSON
+	r.AlertSpec.Anomaly = anomaly
 
 	// Note: have already validated that at most one of the cases match
 	if queryForUserID != "" {
@@ -311,9 +350,102 @@ func (p *Parser) parseAlert(node *Node) error {
 				Properties: props,
 			})
 		}
+		// Teams settings
+		if len(tmp.Notify.Teams.Webhooks) > 0 {
+			props, err := structpb.NewStruct(teams.EncodeProps(tmp.Notify.Teams.Webhooks))
+			if err != nil {
+				return err
+			}
+			r.AlertSpec.Notifiers = append(r.AlertSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "teams",
+				Properties: props,
+			})
+		}
+		// Webhook settings
+		if len(tmp.Notify.Webhook.URLs) > 0 {
+			props, err := structpb.NewStruct(webhook.EncodeProps(tmp.Notify.Webhook.URLs))
+			if err != nil {
+				return err
+			}
+			r.AlertSpec.Notifiers = append(r.AlertSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "webhook",
+				Properties: props,
+			})
+		}
 	}
 
 	r.AlertSpec.Annotations = tmp.Annotations
 
 	return nil
 }
+
+// parseAlertAnomaly validates and converts the properties of an anomaly alert.
+func parseAlertAnomaly(tmp *AlertAnomalyYAML) (*runtimev1.AlertAnomaly, error) {
+	if tmp.MetricsView == "" {
+		return nil, errors.New(`missing required property "anomaly.metrics_view"`)
+	}
+	if tmp.Measure == "" {
+		return nil, errors.New(`missing required property "anomaly.measure"`)
+	}
+
+	grain, err := parseTimeGrain(tmp.TimeGrain)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid value %q for property "anomaly.time_grain"`, tmp.TimeGrain)
+	}
+	if grain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED || grain == runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND {
+		return nil, fmt.Errorf(`invalid value %q for property "anomaly.time_grain"`, tmp.TimeGrain)
+	}
+
+	window := tmp.Window
+	if window == 0 {
+		window = 7
+	} else if window < 2 {
+		return nil, errors.New(`property "anomaly.window" must be at least 2`)
+	}
+
+	threshold := tmp.Threshold
+	var m runtimev1.AlertAnomaly_Method
+	switch strings.ToLower(tmp.Method) {
+	case "", "zscore", "z_score":
+		m = runtimev1.AlertAnomaly_METHOD_Z_SCORE
+		if threshold == 0 {
+			threshold = 3
+		}
+	case "percent_deviation":
+		m = runtimev1.AlertAnomaly_METHOD_PERCENT_DEVIATION
+		if threshold == 0 {
+			threshold = 20
+		}
+	default:
+		return nil, fmt.Errorf(`invalid value %q for property "anomaly.method"`, tmp.Method)
+	}
+	if threshold < 0 {
+		return nil, errors.New(`property "anomaly.threshold" must be positive`)
+	}
+
+	var whereExpr *runtimev1.Expression
+	if tmp.Where != "" {
+		whereExpr, err = expressionpb.Parse(tmp.Where)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid "anomaly.where": %w`, err)
+		}
+	}
+
+	if tmp.TimeZone != "" {
+		_, err := time.LoadLocation(tmp.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid value %q for property "anomaly.time_zone"`, tmp.TimeZone)
+		}
+	}
+
+	return &runtimev1.AlertAnomaly{
+		MetricsView: tmp.MetricsView,
+		Measure:     tmp.Measure,
+		TimeGrain:   grain,
+		Window:      uint32(window),
+		Method:      m,
+		Threshold:   threshold,
+		Where:       whereExpr,
+		TimeZone:    tmp.TimeZone,
+	}, nil
+}
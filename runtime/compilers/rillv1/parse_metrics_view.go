@@ -1,12 +1,17 @@
 package rillv1
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path"
+	"slices"
 	"strings"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/pkg/duration"
+	"github.com/rilldata/rill/runtime/pkg/expressionpb"
 	"gopkg.in/yaml.v3"
 
 	// Load IANA time zone data
@@ -25,12 +30,15 @@ type MetricsViewYAML struct {
 	Table              string           `yaml:"table"`
 	TimeDimension      string           `yaml:"timeseries"`
 	Watermark          string           `yaml:"watermark"`
+	TimeAnchor         string           `yaml:"time_anchor"`
+	Final              bool             `yaml:"final"`
 	SmallestTimeGrain  string           `yaml:"smallest_time_grain"`
 	DefaultTimeRange   string           `yaml:"default_time_range"`
 	AvailableTimeZones []string         `yaml:"available_time_zones"`
 	FirstDayOfWeek     uint32           `yaml:"first_day_of_week"`
 	FirstMonthOfYear   uint32           `yaml:"first_month_of_year"`
 	DefaultTheme       string           `yaml:"default_theme"`
+	Imports            []string         `yaml:"imports"` // Paths of metrics libraries or metrics views to import dimensions and measures from
 	Dimensions         []*struct {
 		Name        string
 		Label       string
@@ -41,6 +49,12 @@ type MetricsViewYAML struct {
 		Ignore      bool `yaml:"ignore"`
 		Unnest      bool
 		URI         string
+		Lookup      *struct {
+			Table             string `yaml:"table"`
+			KeyColumn         string `yaml:"key_column"`
+			ValueColumn       string `yaml:"value_column"`
+			DefaultExpression string `yaml:"default_expression"`
+		}
 	}
 	DefaultDimensions []string `yaml:"default_dimensions"`
 	Measures          []*struct {
@@ -54,6 +68,7 @@ type MetricsViewYAML struct {
 		Description         string
 		FormatPreset        string `yaml:"format_preset"`
 		FormatD3            string `yaml:"format_d3"`
+		Unit                string `yaml:"unit"`
 		Ignore              bool   `yaml:"ignore"`
 		ValidPercentOfTotal bool   `yaml:"valid_percent_of_total"`
 	}
@@ -63,7 +78,17 @@ type MetricsViewYAML struct {
 		Mode      string `yaml:"mode"`
 		Dimension string `yaml:"dimension"`
 	} `yaml:"default_comparison"`
+	DefaultFilter       string               `yaml:"default_filter"`
 	AvailableTimeRanges []AvailableTimeRange `yaml:"available_time_ranges"`
+	Tests               []*DataTestYAML      `yaml:"tests"`
+	TestsBlocking       bool                 `yaml:"tests_blocking"`
+	UnionTables         []string             `yaml:"union_tables"`
+	LookupJoins         []*struct {
+		Lookup string `yaml:"lookup"`
+		Alias  string `yaml:"alias"`
+		Key    string `yaml:"key"`
+		Type   string `yaml:"type"`
+	} `yaml:"lookup_joins"`
 }
 
 type AvailableTimeRange struct {
@@ -536,13 +561,19 @@ var comparisonModesMap = map[string]runtimev1.MetricsViewSpec_ComparisonMode{
 
 var validComparisonModes = []string{"none", "time", "dimension"}
 
+var timeAnchorsMap = map[string]runtimev1.MetricsViewSpec_TimeAnchor{
+	"":          runtimev1.MetricsViewSpec_TIME_ANCHOR_UNSPECIFIED,
+	"watermark": runtimev1.MetricsViewSpec_TIME_ANCHOR_WATERMARK,
+	"now":       runtimev1.MetricsViewSpec_TIME_ANCHOR_NOW,
+}
+
 const (
 	nameIsMeasure   uint8 = 1
 	nameIsDimension uint8 = 2
 )
 
 // parseMetricsView parses a metrics view (dashboard) definition and adds the resulting resource to p.Resources.
-func (p *Parser) parseMetricsView(node *Node) error {
+func (p *Parser) parseMetricsView(ctx context.Context, node *Node) error {
 	// Parse YAML
 	tmp := &MetricsViewYAML{}
 	err := p.decodeNodeYAML(node, true, tmp)
@@ -550,6 +581,15 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		return err
 	}
 
+	// Merge in dimensions and measures from imported files
+	var importedPaths []string
+	if len(tmp.Imports) > 0 {
+		importedPaths, err = p.parseMetricsViewImports(ctx, node, tmp)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Backwards compatibility
 	if tmp.DisplayName != "" && tmp.Title == "" {
 		tmp.Title = tmp.DisplayName
@@ -567,6 +607,45 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		return fmt.Errorf(`must set a value for either the "model" field or the "table" field`)
 	}
 
+	for _, t := range tmp.UnionTables {
+		if t == "" {
+			return fmt.Errorf(`invalid "union_tables": table names must not be empty`)
+		}
+	}
+
+	var lookupJoins []*runtimev1.MetricsViewSpec_LookupJoin
+	joinAliases := make(map[string]bool)
+	for i, j := range tmp.LookupJoins {
+		if j == nil || j.Lookup == "" {
+			return fmt.Errorf(`invalid "lookup_joins" at index %d: "lookup" is required`, i)
+		}
+		if j.Key == "" {
+			return fmt.Errorf(`invalid "lookup_joins" at index %d: "key" is required`, i)
+		}
+		var inner bool
+		switch strings.ToLower(j.Type) {
+		case "", "left":
+		case "inner":
+			inner = true
+		default:
+			return fmt.Errorf(`invalid "lookup_joins" at index %d: invalid type %q (expected "left" or "inner")`, i, j.Type)
+		}
+		alias := j.Alias
+		if alias == "" {
+			alias = j.Lookup
+		}
+		if joinAliases[strings.ToLower(alias)] {
+			return fmt.Errorf(`invalid "lookup_joins" at index %d: found duplicate alias %q`, i, alias)
+		}
+		joinAliases[strings.ToLower(alias)] = true
+		lookupJoins = append(lookupJoins, &runtimev1.MetricsViewSpec_LookupJoin{
+			Lookup:        j.Lookup,
+			Alias:         j.Alias,
+			KeyExpression: j.Key,
+			Inner:         inner,
+		})
+	}
+
 	smallestTimeGrain, err := parseTimeGrain(tmp.SmallestTimeGrain)
 	if err != nil {
 		return fmt.Errorf(`invalid "smallest_time_grain": %w`, err)
@@ -612,6 +691,15 @@ func (p *Parser) parseMetricsView(node *Node) error {
 			return fmt.Errorf("exactly one of column or expression should be set for dimension: %q", dim.Name)
 		}
 
+		if dim.Lookup != nil {
+			if dim.Lookup.Table == "" || dim.Lookup.KeyColumn == "" || dim.Lookup.ValueColumn == "" {
+				return fmt.Errorf(`"lookup" for dimension %q must set "table", "key_column" and "value_column"`, dim.Name)
+			}
+			if dim.Unnest {
+				return fmt.Errorf(`"lookup" and "unnest" cannot be used together for dimension %q`, dim.Name)
+			}
+		}
+
 		lower := strings.ToLower(dim.Name)
 		if _, ok := names[lower]; ok {
 			return fmt.Errorf("found duplicate dimension or measure name %q", dim.Name)
@@ -755,6 +843,7 @@ func (p *Parser) parseMetricsView(node *Node) error {
 			Description:         measure.Description,
 			FormatPreset:        measure.FormatPreset,
 			FormatD3:            measure.FormatD3,
+			Unit:                measure.Unit,
 			ValidPercentOfTotal: measure.ValidPercentOfTotal,
 		})
 	}
@@ -777,6 +866,11 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		}
 	}
 
+	tmp.TimeAnchor = strings.ToLower(tmp.TimeAnchor)
+	if _, ok := timeAnchorsMap[tmp.TimeAnchor]; !ok {
+		return fmt.Errorf(`invalid time_anchor %q (must be "watermark" or "now")`, tmp.TimeAnchor)
+	}
+
 	tmp.DefaultComparison.Mode = strings.ToLower(tmp.DefaultComparison.Mode)
 	if _, ok := comparisonModesMap[tmp.DefaultComparison.Mode]; !ok {
 		return fmt.Errorf("invalid mode: %q. allowed values: %s", tmp.DefaultComparison.Mode, strings.Join(validComparisonModes, ","))
@@ -787,6 +881,18 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		}
 	}
 
+	var defaultFilter *runtimev1.Expression
+	if tmp.DefaultFilter != "" {
+		defaultFilter, err = expressionpb.Parse(tmp.DefaultFilter)
+		if err != nil {
+			return fmt.Errorf(`invalid "default_filter": %w`, err)
+		}
+		err = validateFilterNames(defaultFilter, names, false)
+		if err != nil {
+			return fmt.Errorf(`invalid "default_filter": %w`, err)
+		}
+	}
+
 	if tmp.AvailableTimeRanges != nil {
 		for _, r := range tmp.AvailableTimeRanges {
 			err := validateISO8601(r.Range, false, false)
@@ -815,7 +921,17 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		return err
 	}
 
+	tests, err := parseDataTestsYAML(tmp.Tests)
+	if err != nil {
+		return err
+	}
+
 	node.Refs = append(node.Refs, ResourceName{Name: table})
+	for _, dim := range tmp.Dimensions {
+		if dim != nil && !dim.Ignore && dim.Lookup != nil {
+			node.Refs = append(node.Refs, ResourceName{Name: dim.Lookup.Table})
+		}
+	}
 	if tmp.DefaultTheme != "" {
 		node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindTheme, Name: tmp.DefaultTheme})
 	}
@@ -826,6 +942,7 @@ func (p *Parser) parseMetricsView(node *Node) error {
 	}
 	// NOTE: After calling insertResource, an error must not be returned. Any validation should be done before calling it.
 	spec := r.MetricsViewSpec
+	p.trackImportedPaths(r, importedPaths)
 
 	spec.Connector = node.Connector
 	spec.Database = tmp.Database
@@ -835,6 +952,10 @@ func (p *Parser) parseMetricsView(node *Node) error {
 	spec.Description = tmp.Description
 	spec.TimeDimension = tmp.TimeDimension
 	spec.WatermarkExpression = tmp.Watermark
+	spec.TimeAnchor = timeAnchorsMap[tmp.TimeAnchor]
+	spec.Final = tmp.Final
+	spec.UnionTables = tmp.UnionTables
+	spec.LookupJoins = lookupJoins
 	spec.SmallestTimeGrain = smallestTimeGrain
 	spec.DefaultTimeRange = tmp.DefaultTimeRange
 	spec.AvailableTimeZones = tmp.AvailableTimeZones
@@ -847,7 +968,7 @@ func (p *Parser) parseMetricsView(node *Node) error {
 			continue
 		}
 
-		spec.Dimensions = append(spec.Dimensions, &runtimev1.MetricsViewSpec_DimensionV2{
+		d := &runtimev1.MetricsViewSpec_DimensionV2{
 			Name:        dim.Name,
 			Column:      dim.Column,
 			Expression:  dim.Expression,
@@ -855,7 +976,14 @@ func (p *Parser) parseMetricsView(node *Node) error {
 			Description: dim.Description,
 			Unnest:      dim.Unnest,
 			Uri:         dim.URI,
-		})
+		}
+		if dim.Lookup != nil {
+			d.LookupTable = dim.Lookup.Table
+			d.LookupKeyColumn = dim.Lookup.KeyColumn
+			d.LookupValueColumn = dim.Lookup.ValueColumn
+			d.LookupDefaultExpression = dim.Lookup.DefaultExpression
+		}
+		spec.Dimensions = append(spec.Dimensions, d)
 	}
 	spec.DefaultDimensions = tmp.DefaultDimensions
 
@@ -885,8 +1013,63 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		}
 	}
 
+	spec.DefaultFilter = defaultFilter
+
 	spec.SecurityRules = securityRules
 
+	spec.Tests = tests
+	spec.TestsBlocking = tmp.TestsBlocking
+
+	return nil
+}
+
+// validateFilterNames validates that the identifiers in a filter expression reference dimensions (or measures if allowMeasures is true).
+// Identifiers in the HAVING clause of a subquery may reference measures.
+func validateFilterNames(e *runtimev1.Expression, names map[string]uint8, allowMeasures bool) error {
+	if e == nil {
+		return nil
+	}
+
+	if ident := e.GetIdent(); ident != "" {
+		typ, ok := names[strings.ToLower(ident)]
+		if !ok || (typ != nameIsDimension && !(allowMeasures && typ == nameIsMeasure)) {
+			if allowMeasures {
+				return fmt.Errorf("dimension or measure %q not found", ident)
+			}
+			return fmt.Errorf("dimension %q not found", ident)
+		}
+	}
+
+	if c := e.GetCond(); c != nil {
+		for _, e := range c.Exprs {
+			if err := validateFilterNames(e, names, allowMeasures); err != nil {
+				return err
+			}
+		}
+	}
+
+	if sq := e.GetSubquery(); sq != nil {
+		if typ, ok := names[strings.ToLower(sq.Dimension)]; !ok || typ != nameIsDimension {
+			return fmt.Errorf("dimension %q not found", sq.Dimension)
+		}
+		for _, m := range sq.Measures {
+			if typ, ok := names[strings.ToLower(m)]; !ok || typ != nameIsMeasure {
+				return fmt.Errorf("measure %q not found", m)
+			}
+		}
+		if err := validateFilterNames(sq.Where, names, false); err != nil {
+			return err
+		}
+		if err := validateFilterNames(sq.Having, names, true); err != nil {
+			return err
+		}
+		if sq.Sort != "" && !strings.EqualFold(sq.Sort, sq.Dimension) {
+			if typ, ok := names[strings.ToLower(sq.Sort)]; !ok || typ != nameIsMeasure {
+				return fmt.Errorf("sort field %q not found", sq.Sort)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -1005,3 +1188,192 @@ func parseNamesYAML(n yaml.Node) (names []string, all bool, err error) {
 	}
 	return
 }
+
+// metricsLibraryType is the "type:" of YAML files that contain dimensions and measures for metrics views to import.
+// Metrics libraries are not resources themselves.
+const metricsLibraryType = "metrics_library"
+
+// metricsViewImportYAML is the raw structure of a file imported by a metrics view.
+// It may be a metrics library or another metrics view, in which case its other fields are ignored.
+type metricsViewImportYAML struct {
+	Type       string      `yaml:"type"`
+	Imports    []string    `yaml:"imports"`
+	Dimensions []yaml.Node `yaml:"dimensions"`
+	Measures   []yaml.Node `yaml:"measures"`
+}
+
+// parseMetricsViewImports resolves the imports of a metrics view and replaces tmp's dimensions and measures with the merged result.
+// Imported dimensions and measures come first, in the order of the imports.
+// A dimension or measure in the metrics view with the same name as an imported one overrides the properties it sets (use "ignore: true" to drop it).
+// Imported files may themselves import other files. It returns the paths of all the imported files.
+func (p *Parser) parseMetricsViewImports(ctx context.Context, node *Node, tmp *MetricsViewYAML) ([]string, error) {
+	// Decode the metrics view's own dimensions and measures as YAML nodes, so they can be merged with the imported ones
+	raw := &metricsViewImportYAML{}
+	err := p.decodeNodeYAML(node, false, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var importedPaths []string
+	dims, measures, err := p.resolveMetricsViewImports(ctx, raw, []string{node.YAMLPath}, &importedPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp.Dimensions = nil
+	err = (&yaml.Node{Kind: yaml.SequenceNode, Content: dims}).Decode(&tmp.Dimensions)
+	if err != nil {
+		return nil, externalError{err: fmt.Errorf("invalid imported dimensions: %w", newYAMLError(err))}
+	}
+	tmp.Measures = nil
+	err = (&yaml.Node{Kind: yaml.SequenceNode, Content: measures}).Decode(&tmp.Measures)
+	if err != nil {
+		return nil, externalError{err: fmt.Errorf("invalid imported measures: %w", newYAMLError(err))}
+	}
+
+	return importedPaths, nil
+}
+
+// resolveMetricsViewImports returns the dimensions and measures of raw merged with those of the files it imports.
+// The stack contains the paths of the files currently being resolved and is used to detect import cycles.
+// The paths of all imported files are appended to importedPaths.
+func (p *Parser) resolveMetricsViewImports(ctx context.Context, raw *metricsViewImportYAML, stack []string, importedPaths *[]string) ([]*yaml.Node, []*yaml.Node, error) {
+	var dims, measures []*yaml.Node
+	for _, imp := range raw.Imports {
+		// Import paths are relative to the project root
+		impPath := path.Clean(normalizePath(imp))
+		if slices.Contains(stack, impPath) {
+			return nil, nil, externalError{err: fmt.Errorf("import cycle detected: %s -> %s", strings.Join(stack, " -> "), impPath)}
+		}
+		if !pathIsYAML(impPath) {
+			return nil, nil, fmt.Errorf("invalid import %q: must be a YAML file", imp)
+		}
+		if !slices.Contains(*importedPaths, impPath) {
+			*importedPaths = append(*importedPaths, impPath)
+		}
+
+		// Load and decode the imported file.
+		// Errors are external because they may be resolved by changes to the imported file.
+		// (Using Stat to check existence because the error returned by Get may not be an fs.ErrNotExist.)
+		_, err := p.Repo.Stat(ctx, impPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, nil, externalError{err: fmt.Errorf("imported file %q not found", impPath)}
+			}
+			return nil, nil, err
+		}
+		data, err := p.Repo.Get(ctx, impPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(data) > maxFileSize {
+			return nil, nil, externalError{err: fmt.Errorf("imported file %q exceeds max size of %d bytes", impPath, maxFileSize)}
+		}
+		impRaw := &metricsViewImportYAML{}
+		err = yaml.Unmarshal([]byte(data), impRaw)
+		if err != nil {
+			return nil, nil, externalError{err: fmt.Errorf("invalid imported file %q: %w", impPath, newYAMLError(err))}
+		}
+		// Metrics views in the "dashboards" directory don't need to set a type
+		isMetricsView := impRaw.Type == "" && strings.HasPrefix(impPath, "/dashboards")
+		if kind, err := ParseResourceKind(impRaw.Type); err == nil && kind == ResourceKindMetricsView {
+			isMetricsView = true
+		}
+		if !isMetricsView && impRaw.Type != metricsLibraryType {
+			return nil, nil, externalError{err: fmt.Errorf("imported file %q must be a metrics view or have type %q", impPath, metricsLibraryType)}
+		}
+
+		impDims, impMeasures, err := p.resolveMetricsViewImports(ctx, impRaw, append(stack, impPath), importedPaths)
+		if err != nil {
+			return nil, nil, err
+		}
+		dims = mergeMetricsViewFields(dims, derefYAMLNodes(impDims), metricsViewDimensionName)
+		measures = mergeMetricsViewFields(measures, derefYAMLNodes(impMeasures), metricsViewMeasureName)
+	}
+
+	dims = mergeMetricsViewFields(dims, raw.Dimensions, metricsViewDimensionName)
+	measures = mergeMetricsViewFields(measures, raw.Measures, metricsViewMeasureName)
+	return dims, measures, nil
+}
+
+// mergeMetricsViewFields merges a list of dimension or measure YAML nodes into another.
+// Overrides with the same name as a base field are merged into it, and other overrides are appended.
+func mergeMetricsViewFields(base []*yaml.Node, overrides []yaml.Node, nameFn func(*yaml.Node) string) []*yaml.Node {
+	res := slices.Clone(base)
+	for i := range overrides {
+		o := &overrides[i]
+		name := nameFn(o)
+		idx := -1
+		if name != "" {
+			idx = slices.IndexFunc(res, func(n *yaml.Node) bool { return strings.EqualFold(nameFn(n), name) })
+		}
+		if idx < 0 {
+			res = append(res, o)
+			continue
+		}
+		res[idx] = mergeYAMLMappings(res[idx], o)
+	}
+	return res
+}
+
+// mergeYAMLMappings returns a mapping node with the keys of base and override, where the values in override take precedence.
+// Nested values are not merged. If either node is not a mapping, it returns override.
+func mergeYAMLMappings(base, override *yaml.Node) *yaml.Node {
+	if base.Kind != yaml.MappingNode || override.Kind != yaml.MappingNode {
+		return override
+	}
+	res := &yaml.Node{Kind: yaml.MappingNode, Tag: base.Tag, Content: slices.Clone(base.Content)}
+	for i := 0; i+1 < len(override.Content); i += 2 {
+		k, v := override.Content[i], override.Content[i+1]
+		found := false
+		for j := 0; j+1 < len(res.Content); j += 2 {
+			if res.Content[j].Value == k.Value {
+				res.Content[j+1] = v
+				found = true
+				break
+			}
+		}
+		if !found {
+			res.Content = append(res.Content, k, v)
+		}
+	}
+	return res
+}
+
+// derefYAMLNodes converts a slice of YAML node pointers to a slice of YAML nodes.
+func derefYAMLNodes(ns []*yaml.Node) []yaml.Node {
+	res := make([]yaml.Node, len(ns))
+	for i, n := range ns {
+		res[i] = *n
+	}
+	return res
+}
+
+// metricsViewDimensionName returns the name of a dimension YAML node.
+// Like in parseMetricsView, the name falls back to the column for backwards compatibility.
+func metricsViewDimensionName(n *yaml.Node) string {
+	for _, key := range []string{"name", "column", "property"} {
+		if v := yamlMappingValue(n, key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// metricsViewMeasureName returns the name of a measure YAML node.
+func metricsViewMeasureName(n *yaml.Node) string {
+	return yamlMappingValue(n, "name")
+}
+
+// yamlMappingValue returns the value of a scalar key in a mapping node, or an empty string if it's not found.
+func yamlMappingValue(n *yaml.Node, key string) string {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return ""
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key && n.Content[i+1].Kind == yaml.ScalarNode {
+			return n.Content[i+1].Value
+		}
+	}
+	return ""
+}
@@ -17,6 +17,9 @@ type ModelYAML struct {
 	Refresh         *ScheduleYAML                           `yaml:"refresh"`
 	Timeout         string                                  `yaml:"timeout"`
 	Incremental     bool                                    `yaml:"incremental"`
+	ChangeFeed      bool                                    `yaml:"change_feed"`
+	Tests           []*DataTestYAML                         `yaml:"tests"`
+	TestsBlocking   bool                                    `yaml:"tests_blocking"`
 	State           *DataYAML                               `yaml:"state"`
 	InputProperties map[string]any                          `yaml:",inline" mapstructure:",remain"`
 	Output          struct {
@@ -113,6 +116,20 @@ func (p *Parser) parseModel(node *Node) error {
 		}
 	}
 
+	// Validate change feed (it relies on DuckDB's row hashing)
+	if tmp.ChangeFeed {
+		driver, _, _ := p.driverForConnector(outputConnector)
+		if driver != "duckdb" {
+			return errors.New(`"change_feed" is only supported for models that output to DuckDB`)
+		}
+	}
+
+	// Parse tests
+	tests, err := parseDataTestsYAML(tmp.Tests)
+	if err != nil {
+		return err
+	}
+
 	// Insert the model
 	r, err := p.insertResource(ResourceKindModel, node.Name, node.Paths, node.Refs...)
 	if err != nil {
@@ -139,9 +156,86 @@ func (p *Parser) parseModel(node *Node) error {
 	r.ModelSpec.OutputConnector = outputConnector
 	r.ModelSpec.OutputProperties = outputPropsPB
 
+	r.ModelSpec.ChangeFeed = tmp.ChangeFeed
+
+	r.ModelSpec.Tests = tests
+	r.ModelSpec.TestsBlocking = tmp.TestsBlocking
+
 	return nil
 }
 
+// DataTestYAML is the raw structure of a test (assertion) on the data of a model or metrics view.
+type DataTestYAML struct {
+	Name           string `yaml:"name"`
+	NotNull        string `yaml:"not_null"`
+	Unique         string `yaml:"unique"`
+	AcceptedValues *struct {
+		Column string   `yaml:"column"`
+		Values []string `yaml:"values"`
+	} `yaml:"accepted_values"`
+	SQL string `yaml:"sql"`
+}
+
+// parseDataTestsYAML validates tests and converts them to protos.
+// Tests without a name are named after their assertion and column (e.g. "not_null_id").
+func parseDataTestsYAML(tests []*DataTestYAML) ([]*runtimev1.DataTest, error) {
+	var res []*runtimev1.DataTest
+	names := make(map[string]bool, len(tests))
+	for i, t := range tests {
+		if t == nil {
+			continue
+		}
+
+		pb := &runtimev1.DataTest{Name: t.Name}
+		n := 0
+		if t.NotNull != "" {
+			n++
+			pb.NotNull = t.NotNull
+			if pb.Name == "" {
+				pb.Name = "not_null_" + t.NotNull
+			}
+		}
+		if t.Unique != "" {
+			n++
+			pb.Unique = t.Unique
+			if pb.Name == "" {
+				pb.Name = "unique_" + t.Unique
+			}
+		}
+		if t.AcceptedValues != nil {
+			n++
+			if t.AcceptedValues.Column == "" || len(t.AcceptedValues.Values) == 0 {
+				return nil, fmt.Errorf(`invalid test at index %d: "accepted_values" must specify a "column" and "values"`, i)
+			}
+			pb.AcceptedValues = &runtimev1.DataTest_AcceptedValues{
+				Column: t.AcceptedValues.Column,
+				Values: t.AcceptedValues.Values,
+			}
+			if pb.Name == "" {
+				pb.Name = "accepted_values_" + t.AcceptedValues.Column
+			}
+		}
+		if sql := strings.TrimSpace(t.SQL); sql != "" {
+			n++
+			pb.Sql = sql
+			if pb.Name == "" {
+				return nil, fmt.Errorf(`invalid test at index %d: tests with "sql" must have a "name"`, i)
+			}
+		}
+		if n != 1 {
+			return nil, fmt.Errorf(`invalid test at index %d: must specify exactly one of "not_null", "unique", "accepted_values" or "sql"`, i)
+		}
+
+		if names[pb.Name] {
+			return nil, fmt.Errorf("found duplicate test name %q", pb.Name)
+		}
+		names[pb.Name] = true
+
+		res = append(res, pb)
+	}
+	return res, nil
+}
+
 // inferSQLRefs attempts to infer table references from the node's SQL.
 // The provided node must have a non-empty SQL field.
 func (p *Parser) inferSQLRefs(node *Node) ([]ResourceName, error) {
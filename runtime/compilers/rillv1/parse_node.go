@@ -1,6 +1,8 @@
 package rillv1
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -30,14 +32,14 @@ type Node struct {
 }
 
 // parseNode multiplexes to the appropriate parse function based on the node kind.
-func (p *Parser) parseNode(node *Node) error {
+func (p *Parser) parseNode(ctx context.Context, node *Node) error {
 	switch node.Kind {
 	case ResourceKindSource:
 		return p.parseSource(node)
 	case ResourceKindModel:
 		return p.parseModel(node)
 	case ResourceKindMetricsView:
-		return p.parseMetricsView(node)
+		return p.parseMetricsView(ctx, node)
 	case ResourceKindMigration:
 		return p.parseMigration(node)
 	case ResourceKindReport:
@@ -54,6 +56,8 @@ func (p *Parser) parseNode(node *Node) error {
 		return p.parseAPI(node)
 	case ResourceKindConnector:
 		return p.parseConnector(node)
+	case ResourceKindSegment:
+		return p.parseSegment(node)
 	default:
 		panic(fmt.Errorf("unexpected resource type: %s", node.Kind.String()))
 	}
@@ -87,6 +91,7 @@ type commonYAML struct {
 
 // parseStem parses a pair of YAML and SQL files with the same path stem (e.g. "/path/to/file.yaml" for "/path/to/file.sql").
 // Note that either of the YAML or SQL files may be empty (the paths arg will only contain non-nil paths).
+// It returns a nil Node for files that don't define a resource (metrics libraries).
 func (p *Parser) parseStem(paths []string, ymlPath, yml, sqlPath, sql string) (*Node, error) {
 	// The rest of the function builds a Node from YAML and SQL info
 	res := &Node{Paths: paths}
@@ -109,21 +114,19 @@ func (p *Parser) parseStem(paths []string, ymlPath, yml, sqlPath, sql string) (*
 		}
 	}
 
+	// Metrics libraries only contain definitions for metrics views to import (see parseMetricsViewImports)
+	if cfg != nil && cfg.Type != nil && strings.EqualFold(*cfg.Type, metricsLibraryType) {
+		return nil, nil
+	}
+
 	// Handle YAML config
 	templatingEnabled := true
 	if cfg != nil {
 		// Handle "dev:" and "prod:" shorthands (copy to to cfg.Env)
-		if !cfg.Dev.IsZero() {
-			if cfg.Env == nil {
-				cfg.Env = make(map[string]yaml.Node)
-			}
-			cfg.Env["dev"] = cfg.Dev
-		}
-		if !cfg.Prod.IsZero() {
-			if cfg.Env == nil {
-				cfg.Env = make(map[string]yaml.Node)
-			}
-			cfg.Env["prod"] = cfg.Prod
+		var err error
+		cfg.Env, err = applyEnvShorthands(cfg.Env, cfg.Dev, cfg.Prod)
+		if err != nil {
+			return nil, pathError{path: ymlPath, err: err}
 		}
 
 		// Set environment-specific override
@@ -149,7 +152,6 @@ func (p *Parser) parseStem(paths []string, ymlPath, yml, sqlPath, sql string) (*
 		}
 
 		// Parse refs provided in YAML
-		var err error
 		res.Refs, err = parseYAMLRefs(cfg.Refs)
 		if err != nil {
 			return nil, pathError{path: ymlPath, err: newYAMLError(err)}
@@ -340,15 +342,45 @@ func (p *Parser) decodeNodeYAML(node *Node, knownFields bool, dst any) error {
 
 	// Apply environment-specific overrides
 	if node.YAMLOverride != nil {
-		err := node.YAMLOverride.Decode(dst)
+		var err error
+		if knownFields {
+			// Re-encoding the override since KnownFields can only be set on a decoder
+			var data []byte
+			data, err = yaml.Marshal(node.YAMLOverride)
+			if err == nil {
+				dec := yaml.NewDecoder(bytes.NewReader(data))
+				dec.KnownFields(true)
+				err = dec.Decode(dst)
+			}
+		} else {
+			err = node.YAMLOverride.Decode(dst)
+		}
 		if err != nil {
-			return pathError{path: node.YAMLPath, err: newYAMLError(err)}
+			return pathError{path: node.YAMLPath, err: fmt.Errorf("invalid override for environment %q: %w", p.Environment, newYAMLError(err))}
 		}
 	}
 
 	return nil
 }
 
+// applyEnvShorthands adds the "dev:" and "prod:" shorthands to the environment-specific overrides under "env:".
+// It returns an error if an environment is overridden using both a shorthand and "env:".
+func applyEnvShorthands(env map[string]yaml.Node, dev, prod yaml.Node) (map[string]yaml.Node, error) {
+	for name, n := range map[string]yaml.Node{"dev": dev, "prod": prod} {
+		if n.IsZero() {
+			continue
+		}
+		if _, ok := env[name]; ok {
+			return nil, fmt.Errorf(`cannot set both "%s" and "env.%s"`, name, name)
+		}
+		if env == nil {
+			env = make(map[string]yaml.Node)
+		}
+		env[name] = n
+	}
+	return env, nil
+}
+
 // parseYAMLRefs parses a list of YAML nodes into a list of ResourceNames.
 // It's used to parse the "refs" field in baseConfig.
 func parseYAMLRefs(refs []yaml.Node) ([]ResourceName, error) {
@@ -10,6 +10,9 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/teams"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
+	"github.com/rilldata/rill/runtime/pkg/exportfilename"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -32,8 +35,9 @@ type ReportYAML struct {
 		ArgsJSON string         `yaml:"args_json"`
 	} `yaml:"query"`
 	Export struct {
-		Format string `yaml:"format"`
-		Limit  uint   `yaml:"limit"`
+		Format   string `yaml:"format"`
+		Limit    uint   `yaml:"limit"`
+		Filename string `yaml:"filename"`
 	} `yaml:"export"`
 	Email struct {
 		Recipients []string `yaml:"recipients"`
@@ -47,6 +51,12 @@ type ReportYAML struct {
 			Channels []string `yaml:"channels"`
 			Webhooks []string `yaml:"webhooks"`
 		} `yaml:"slack"`
+		Teams struct {
+			Webhooks []string `yaml:"webhooks"`
+		} `yaml:"teams"`
+		Webhook struct {
+			URLs []string `yaml:"urls"`
+		} `yaml:"webhook"`
 	} `yaml:"notify"`
 	Annotations map[string]string `yaml:"annotations"`
 }
@@ -136,6 +146,11 @@ func (p *Parser) parseReport(node *Node) error {
 		return fmt.Errorf(`missing required property "export.format"`)
 	}
 
+	// Validate export filename template
+	if err := exportfilename.Validate(tmp.Export.Filename); err != nil {
+		return fmt.Errorf(`invalid property "export.filename": %w`, err)
+	}
+
 	if len(tmp.Email.Recipients) > 0 && len(tmp.Notify.Email.Recipients) > 0 {
 		return errors.New(`cannot set both "email.recipients" and "notify.email.recipients"`)
 	}
@@ -153,7 +168,8 @@ func (p *Parser) parseReport(node *Node) error {
 		}
 	} else {
 		if len(tmp.Notify.Email.Recipients) == 0 && len(tmp.Notify.Slack.Channels) == 0 &&
-			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 {
+			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 &&
+			len(tmp.Notify.Teams.Webhooks) == 0 && len(tmp.Notify.Webhook.URLs) == 0 {
 			return fmt.Errorf(`missing notification recipients`)
 		}
 		for _, email := range tmp.Notify.Email.Recipients {
@@ -192,6 +208,7 @@ func (p *Parser) parseReport(node *Node) error {
 	r.ReportSpec.QueryArgsJson = tmp.Query.ArgsJSON
 	r.ReportSpec.ExportLimit = uint64(tmp.Export.Limit)
 	r.ReportSpec.ExportFormat = exportFormat
+	r.ReportSpec.ExportFilenameTemplate = tmp.Export.Filename
 
 	if isLegacySyntax {
 		// Backwards compatibility
@@ -233,6 +250,28 @@ func (p *Parser) parseReport(node *Node) error {
 				Properties: props,
 			})
 		}
+		// Teams settings
+		if len(tmp.Notify.Teams.Webhooks) > 0 {
+			props, err := structpb.NewStruct(teams.EncodeProps(tmp.Notify.Teams.Webhooks))
+			if err != nil {
+				return err
+			}
+			r.ReportSpec.Notifiers = append(r.ReportSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "teams",
+				Properties: props,
+			})
+		}
+		// Webhook settings
+		if len(tmp.Notify.Webhook.URLs) > 0 {
+			props, err := structpb.NewStruct(webhook.EncodeProps(tmp.Notify.Webhook.URLs))
+			if err != nil {
+				return err
+			}
+			r.ReportSpec.Notifiers = append(r.ReportSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "webhook",
+				Properties: props,
+			})
+		}
 	}
 
 	r.ReportSpec.Annotations = tmp.Annotations
@@ -99,17 +99,9 @@ func (p *Parser) parseRillYAML(ctx context.Context, path string) error {
 	}
 
 	// Handle "dev:" and "prod:" shorthands (copy to to tmp.Env)
-	if !tmp.Dev.IsZero() {
-		if tmp.Env == nil {
-			tmp.Env = make(map[string]yaml.Node)
-		}
-		tmp.Env["dev"] = tmp.Dev
-	}
-	if !tmp.Prod.IsZero() {
-		if tmp.Env == nil {
-			tmp.Env = make(map[string]yaml.Node)
-		}
-		tmp.Env["prod"] = tmp.Prod
+	tmp.Env, err = applyEnvShorthands(tmp.Env, tmp.Dev, tmp.Prod)
+	if err != nil {
+		return err
 	}
 
 	// Apply environment-specific overrides
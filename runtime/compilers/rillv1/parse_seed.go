@@ -0,0 +1,49 @@
+package rillv1
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// parseSeed parses a seed file and adds a source that loads it into the default OLAP connector.
+// Seeds are CSV or Parquet files in the "seeds" directory. The source is named after the file (without extension).
+func (p *Parser) parseSeed(ctx context.Context, path string) error {
+	stat, err := p.Repo.Stat(ctx, path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// This is a dirty parse where a file disappeared during parsing.
+			return nil
+		}
+		return err
+	}
+
+	name := pathStem(path[strings.LastIndexByte(path, '/')+1:])
+
+	// The modified time is included in the properties to reload the seed when the file changes.
+	props, err := structpb.NewStruct(map[string]any{
+		"path":            strings.TrimPrefix(path, "/"),
+		"seed_updated_on": stat.LastUpdated.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return err
+	}
+
+	r, err := p.insertResource(ResourceKindSource, name, []string{path})
+	if err != nil {
+		p.addParseError(path, err, false)
+		return nil
+	}
+	// NOTE: After calling insertResource, an error must not be returned. Any validation should be done before calling it.
+
+	r.SourceSpec.SourceConnector = "local_file"
+	r.SourceSpec.SinkConnector = p.defaultOLAPConnector()
+	r.SourceSpec.Properties = props
+	r.SourceSpec.RefreshSchedule = &runtimev1.Schedule{RefUpdate: true}
+
+	return nil
+}
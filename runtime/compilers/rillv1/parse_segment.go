@@ -0,0 +1,67 @@
+package rillv1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rilldata/rill/runtime/pkg/expressionpb"
+)
+
+// SegmentYAML is the raw structure of a Segment resource defined in YAML (does not include common fields).
+// A segment is a named filter on a metrics view that queries can reference by name.
+type SegmentYAML struct {
+	commonYAML  `yaml:",inline"` // Not accessed here, only setting it so we can use KnownFields for YAML parsing
+	MetricsView string           `yaml:"metrics_view"`
+	DisplayName string           `yaml:"display_name"`
+	Description string           `yaml:"description"`
+	Filter      string           `yaml:"filter"`
+}
+
+// parseSegment parses a segment definition and adds the resulting resource to p.Resources.
+func (p *Parser) parseSegment(node *Node) error {
+	// Parse YAML
+	tmp := &SegmentYAML{}
+	err := p.decodeNodeYAML(node, true, tmp)
+	if err != nil {
+		return err
+	}
+
+	// Validate SQL or connector isn't set
+	if node.SQL != "" {
+		return errors.New("segments cannot have SQL")
+	}
+	if !node.ConnectorInferred && node.Connector != "" {
+		return errors.New("segments cannot have a connector")
+	}
+
+	if tmp.MetricsView == "" {
+		return errors.New(`property "metrics_view" is required`)
+	}
+	if tmp.Filter == "" {
+		return errors.New(`property "filter" is required`)
+	}
+
+	// The names in the filter are validated against the metrics view by the reconciler, since the metrics view may not have been parsed yet.
+	filter, err := expressionpb.Parse(tmp.Filter)
+	if err != nil {
+		return fmt.Errorf(`invalid "filter": %w`, err)
+	}
+	if filter.GetCond() == nil {
+		return errors.New(`invalid "filter": must be a condition`)
+	}
+
+	node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindMetricsView, Name: tmp.MetricsView})
+
+	r, err := p.insertResource(ResourceKindSegment, node.Name, node.Paths, node.Refs...)
+	if err != nil {
+		return err
+	}
+	// NOTE: After calling insertResource, an error must not be returned. Any validation should be done before calling it.
+
+	r.SegmentSpec.MetricsView = tmp.MetricsView
+	r.SegmentSpec.DisplayName = tmp.DisplayName
+	r.SegmentSpec.Description = tmp.Description
+	r.SegmentSpec.Filter = filter
+
+	return nil
+}
@@ -30,6 +30,9 @@ type Resource struct {
 	Paths   []string
 	Refs    []ResourceName // Derived from rawRefs after parsing (can't contain ResourceKindUnspecified). Always sorted.
 	rawRefs []ResourceName // Populated during parsing (may contain ResourceKindUnspecified)
+	// importedPaths are files that the resource imports definitions from (e.g. shared metrics view dimensions and measures).
+	// Unlike Paths, they don't belong to the resource, but changes to them require reparsing it.
+	importedPaths []string
 
 	// Only one of these will be non-nil
 	SourceSpec      *runtimev1.SourceSpec
@@ -43,6 +46,7 @@ type Resource struct {
 	DashboardSpec   *runtimev1.DashboardSpec
 	APISpec         *runtimev1.APISpec
 	ConnectorSpec   *runtimev1.ConnectorSpec
+	SegmentSpec     *runtimev1.SegmentSpec
 }
 
 // ResourceName is a unique identifier for a resource
@@ -78,6 +82,7 @@ const (
 	ResourceKindDashboard
 	ResourceKindAPI
 	ResourceKindConnector
+	ResourceKindSegment
 )
 
 // ParseResourceKind maps a string to a ResourceKind.
@@ -108,6 +113,8 @@ func ParseResourceKind(kind string) (ResourceKind, error) {
 		return ResourceKindAPI, nil
 	case "connector":
 		return ResourceKindConnector, nil
+	case "segment":
+		return ResourceKindSegment, nil
 	default:
 		return ResourceKindUnspecified, fmt.Errorf("invalid resource type %q", kind)
 	}
@@ -139,6 +146,8 @@ func (k ResourceKind) String() string {
 		return "API"
 	case ResourceKindConnector:
 		return "Connector"
+	case ResourceKindSegment:
+		return "Segment"
 	default:
 		panic(fmt.Sprintf("unexpected resource type: %d", k))
 	}
@@ -173,6 +182,7 @@ type Parser struct {
 	// Internal state
 	resourcesForPath           map[string][]*Resource // Reverse index of Resource.Paths
 	resourcesForUnspecifiedRef map[string][]*Resource // Reverse index of Resource.rawRefs where kind=ResourceKindUnspecified
+	resourcesForImportedPath   map[string][]*Resource // Reverse index of Resource.importedPaths
 	insertedResources          []*Resource
 	updatedResources           []*Resource
 	deletedResources           []*Resource
@@ -261,7 +271,7 @@ func (p *Parser) Reparse(ctx context.Context, paths []string) (*Diff, error) {
 // IsSkippable returns true if the path will be skipped by Reparse.
 // It's useful for callers to avoid triggering a reparse when they know the path is not relevant.
 func (p *Parser) IsSkippable(path string) bool {
-	return !pathIsYAML(path) && !pathIsSQL(path) && !pathIsDotEnv(path)
+	return !pathIsYAML(path) && !pathIsSQL(path) && !pathIsDotEnv(path) && !pathIsSeed(path)
 }
 
 // TrackedPathsInDir returns the paths under the given directory that the parser currently has cached results for.
@@ -289,6 +299,7 @@ func (p *Parser) reload(ctx context.Context) error {
 	p.Errors = nil
 	p.resourcesForPath = make(map[string][]*Resource)
 	p.resourcesForUnspecifiedRef = make(map[string][]*Resource)
+	p.resourcesForImportedPath = make(map[string][]*Resource)
 	p.insertedResources = nil
 	p.updatedResources = nil
 	p.deletedResources = nil
@@ -298,6 +309,11 @@ func (p *Parser) reload(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("could not list project files: %w", err)
 	}
+	seeds, err := p.Repo.ListRecursive(ctx, "seeds/**/*.{csv,parquet}", true)
+	if err != nil {
+		return fmt.Errorf("could not list seed files: %w", err)
+	}
+	files = append(files, seeds...)
 
 	// Build paths slice
 	paths := make([]string, 0, len(files))
@@ -364,7 +380,8 @@ func (p *Parser) reparseExceptRillYAML(ctx context.Context, paths []string) (*Di
 		isSQL := pathIsSQL(path)
 		isYAML := pathIsYAML(path)
 		isDotEnv := pathIsDotEnv(path)
-		if !isSQL && !isYAML && !isDotEnv {
+		isSeed := pathIsSeed(path)
+		if !isSQL && !isYAML && !isDotEnv && !isSeed {
 			continue
 		}
 
@@ -417,6 +434,13 @@ func (p *Parser) reparseExceptRillYAML(ctx context.Context, paths []string) (*Di
 			checkPaths = append(checkPaths, resource.Paths...)
 		}
 
+		// Remove all resources that import this path, and reparse them
+		rs = slices.Clone(p.resourcesForImportedPath[path])
+		for _, resource := range rs {
+			p.deleteResource(resource)
+			checkPaths = append(checkPaths, resource.Paths...)
+		}
+
 		// Remove all parse errors related to this path
 		// (We can't mutate p.Errors while iterating over it, hence the nested loop here.)
 		for {
@@ -547,12 +571,19 @@ func (p *Parser) parsePaths(ctx context.Context, paths []string) error {
 			}
 			i++
 			continue
+		} else if pathIsSeed(path) {
+			err := p.parseSeed(ctx, path)
+			if err != nil {
+				return err
+			}
+			i++
+			continue
 		}
 
 		// Identify the range of paths with the same stem as paths[i]
 		j := i + 1
 		pathStemI := pathStem(paths[i])
-		for j < len(paths) && pathStemI == pathStem(paths[j]) {
+		for j < len(paths) && pathStemI == pathStem(paths[j]) && !pathIsSeed(paths[j]) {
 			j++
 		}
 
@@ -652,9 +683,10 @@ func (p *Parser) parseStemPaths(ctx context.Context, paths []string) error {
 	}
 
 	// Parse the SQL/YAML file pair to a Node, then parse the Node to p.Resources.
+	// (The node is nil for files that don't define a resource.)
 	node, err := p.parseStem(paths, yamlPath, yaml, sqlPath, sql)
-	if err == nil {
-		err = p.parseNode(node)
+	if err == nil && node != nil {
+		err = p.parseNode(ctx, node)
 	}
 
 	// Spread error across the node's paths (YAML and/or SQL files)
@@ -811,6 +843,8 @@ func (p *Parser) insertResource(kind ResourceKind, name string, paths []string,
 		r.APISpec = &runtimev1.APISpec{}
 	case ResourceKindConnector:
 		r.ConnectorSpec = &runtimev1.ConnectorSpec{}
+	case ResourceKindSegment:
+		r.SegmentSpec = &runtimev1.SegmentSpec{}
 	default:
 		panic(fmt.Errorf("unexpected resource type: %s", kind.String()))
 	}
@@ -835,6 +869,14 @@ func (p *Parser) insertResource(kind ResourceKind, name string, paths []string,
 	return r, nil
 }
 
+// trackImportedPaths records that a resource imports definitions from the given paths.
+func (p *Parser) trackImportedPaths(r *Resource, paths []string) {
+	r.importedPaths = paths
+	for _, path := range paths {
+		p.resourcesForImportedPath[path] = append(p.resourcesForImportedPath[path], r)
+	}
+}
+
 // deleteResource removes a resource from p.Resources as well as all internal indexes.
 func (p *Parser) deleteResource(r *Resource) {
 	// Remove from p.Resources
@@ -870,6 +912,20 @@ func (p *Parser) deleteResource(r *Resource) {
 		}
 	}
 
+	// Remove from p.resourcesForImportedPath
+	for _, path := range r.importedPaths {
+		rs := p.resourcesForImportedPath[path]
+		idx := slices.Index(rs, r)
+		if idx < 0 {
+			panic(fmt.Errorf("resource %q not found in resourcesForImportedPath", r.Name))
+		}
+		if len(rs) == 1 {
+			delete(p.resourcesForImportedPath, path)
+		} else {
+			p.resourcesForImportedPath[path] = slices.Delete(rs, idx, idx+1)
+		}
+	}
+
 	// Remove pointers indexed in resourcesForUnspecifiedRef
 	for _, ref := range r.rawRefs {
 		if ref.Kind != ResourceKindUnspecified {
@@ -990,6 +1046,11 @@ func pathIsDotEnv(path string) bool {
 	return path == "/.env"
 }
 
+// pathIsSeed returns true if the path is a seed file (a CSV or Parquet file in the seeds directory)
+func pathIsSeed(path string) bool {
+	return strings.HasPrefix(path, "/seeds/") && (strings.HasSuffix(path, ".csv") || strings.HasSuffix(path, ".parquet"))
+}
+
 // normalizePath normalizes a user-provided path to the format returned from ListRecursive.
 // TODO: Change this once ListRecursive returns paths without leading slash.
 func normalizePath(path string) string {
@@ -12,6 +12,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/expressionpb"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -982,6 +983,216 @@ SELECT * FROM t2
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestModelChangeFeed(t *testing.T) {
+	ctx := context.Background()
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		// Model with a change feed
+		`models/m1.yaml`: `
+type: model
+connector: s3
+path: s3://bucket/path.parquet
+output:
+  connector: duckdb
+change_feed: true
+`,
+		// Change feeds are not supported for non-DuckDB outputs
+		`models/m2.yaml`: `
+type: model
+connector: s3
+path: s3://bucket/path.parquet
+output:
+  connector: s3
+change_feed: true
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/models/m1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				InputConnector:  "s3",
+				InputProperties: must(structpb.NewStruct(map[string]any{"path": "s3://bucket/path.parquet"})),
+				OutputConnector: "duckdb",
+				ChangeFeed:      true,
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `"change_feed" is only supported for models that output to DuckDB`,
+			FilePath: "/models/m2.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestDataTests(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`models/m1.yaml`: `
+type: model
+connector: s3
+path: s3://bucket/path.parquet
+output:
+  connector: duckdb
+tests_blocking: true
+tests:
+  - not_null: id
+  - unique: id
+  - name: valid_country
+    accepted_values:
+      column: country
+      values: [US, DK]
+  - name: positive_revenue
+    sql: SELECT * FROM m1 WHERE revenue < 0
+`,
+		`models/m2.yaml`: `
+type: model
+connector: s3
+path: s3://bucket/path.parquet
+tests:
+  - not_null: id
+    unique: id
+`,
+		`models/m3.yaml`: `
+type: model
+connector: s3
+path: s3://bucket/path.parquet
+tests:
+  - sql: SELECT 1
+`,
+		`dashboards/d1.yaml`: `
+table: t1
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: b
+    expression: count(*)
+tests:
+  - not_null: a
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+
+	require.Len(t, p.Errors, 2)
+	require.Equal(t, "/models/m2.yaml", p.Errors[0].FilePath)
+	require.Contains(t, p.Errors[0].Message, "must specify exactly one of")
+	require.Equal(t, "/models/m3.yaml", p.Errors[1].FilePath)
+	require.Contains(t, p.Errors[1].Message, `must have a "name"`)
+
+	m1 := p.Resources[ResourceName{Kind: ResourceKindModel, Name: "m1"}.Normalized()]
+	require.NotNil(t, m1)
+	require.True(t, m1.ModelSpec.TestsBlocking)
+	require.Equal(t, []*runtimev1.DataTest{
+		{Name: "not_null_id", NotNull: "id"},
+		{Name: "unique_id", Unique: "id"},
+		{Name: "valid_country", AcceptedValues: &runtimev1.DataTest_AcceptedValues{Column: "country", Values: []string{"US", "DK"}}},
+		{Name: "positive_revenue", Sql: "SELECT * FROM m1 WHERE revenue < 0"},
+	}, m1.ModelSpec.Tests)
+	// Tests must not leak into the input properties
+	require.Equal(t, map[string]any{"path": "s3://bucket/path.parquet"}, m1.ModelSpec.InputProperties.AsMap())
+
+	d1 := p.Resources[ResourceName{Kind: ResourceKindMetricsView, Name: "d1"}.Normalized()]
+	require.NotNil(t, d1)
+	require.False(t, d1.MetricsViewSpec.TestsBlocking)
+	require.Equal(t, []*runtimev1.DataTest{{Name: "not_null_a", NotNull: "a"}}, d1.MetricsViewSpec.Tests)
+}
+
+func TestSeeds(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`:                   ``,
+		`seeds/countries.csv`:         "code,name\nDK,Denmark\n",
+		`seeds/nested/events.parquet`: ``,
+		`seeds/readme.txt`:            ``,
+		`data/other.csv`:              ``,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	require.Empty(t, p.Errors)
+	require.Len(t, p.Resources, 2)
+
+	r := p.Resources[ResourceName{Kind: ResourceKindSource, Name: "countries"}.Normalized()]
+	require.NotNil(t, r)
+	require.Equal(t, []string{"/seeds/countries.csv"}, r.Paths)
+	require.Equal(t, "local_file", r.SourceSpec.SourceConnector)
+	require.Equal(t, "duckdb", r.SourceSpec.SinkConnector)
+	require.Equal(t, "seeds/countries.csv", r.SourceSpec.Properties.Fields["path"].GetStringValue())
+	require.NotEmpty(t, r.SourceSpec.Properties.Fields["seed_updated_on"].GetStringValue())
+
+	r = p.Resources[ResourceName{Kind: ResourceKindSource, Name: "events"}.Normalized()]
+	require.NotNil(t, r)
+	require.Equal(t, "seeds/nested/events.parquet", r.SourceSpec.Properties.Fields["path"].GetStringValue())
+
+	// Seeds collide with other sources of the same name
+	putRepo(t, repo, map[string]string{
+		`sources/countries.yaml`: "connector: s3\npath: s3://bucket/countries.csv\n",
+	})
+	diff, err := p.Reparse(ctx, []string{"/sources/countries.yaml"})
+	require.NoError(t, err)
+	require.Empty(t, diff.Added)
+	require.Len(t, p.Errors, 1)
+	require.Contains(t, p.Errors[0].Message, "name collision")
+
+	// Deleting a seed deletes its source
+	deleteRepo(t, repo, `sources/countries.yaml`, `seeds/nested/events.parquet`)
+	diff, err = p.Reparse(ctx, []string{"/sources/countries.yaml", "/seeds/nested/events.parquet"})
+	require.NoError(t, err)
+	require.Equal(t, []ResourceName{{Kind: ResourceKindSource, Name: "events"}}, diff.Deleted)
+	require.Empty(t, p.Errors)
+	require.Len(t, p.Resources, 1)
+}
+
+func TestSeedNameCollisions(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`:              ``,
+		`seeds/countries.csv`:    "code,name\nDK,Denmark\n",
+		`sources/countries.yaml`: "connector: s3\npath: s3://bucket/countries.csv\n",
+		`seeds/cities.csv`:       "code,name\nCPH,Copenhagen\n",
+		`models/cities.sql`:      `SELECT 1`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	require.Len(t, p.Errors, 2)
+
+	// A seed and a source with the same name (seeds are parsed first, so the error is attached to the source)
+	perr := findParseError(p.Errors, "/sources/countries.yaml")
+	require.NotNil(t, perr)
+	require.Equal(t, `name collision: another resource of type "Source" is also named "countries"`, perr.Message)
+
+	// A seed and a model with the same name (the error is attached to the model)
+	perr = findParseError(p.Errors, "/models/cities.sql")
+	require.NotNil(t, perr)
+	require.Equal(t, `model name collides with source "cities"`, perr.Message)
+	require.NotNil(t, p.Resources[ResourceName{Kind: ResourceKindSource, Name: "cities"}.Normalized()])
+	require.Nil(t, p.Resources[ResourceName{Kind: ResourceKindModel, Name: "cities"}.Normalized()])
+}
+
+func findParseError(errs []*runtimev1.ParseError, path string) *runtimev1.ParseError {
+	for _, err := range errs {
+		if err.FilePath == path {
+			return err
+		}
+	}
+	return nil
+}
+
 func TestProjectDashboardDefaults(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1134,6 +1345,92 @@ env:
 	requireResourcesAndErrors(t, p, []*Resource{s1Test}, nil)
 }
 
+func TestEnvironmentShorthands(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		// source s1 reads a sample of the data in dev
+		`sources/s1.yaml`: `
+connector: s3
+path: s3://bucket/*.parquet
+dev:
+  path: s3://bucket/2024-01-01.parquet
+prod:
+  refresh:
+    cron: "0 * * * *"
+`,
+		// source s2 sets dev overrides twice
+		`sources/s2.yaml`: `
+connector: s3
+path: s3://bucket/*.parquet
+dev:
+  path: a
+env:
+  dev:
+    path: b
+`,
+		// metrics view d1 has a typo in its prod overrides
+		`dashboards/d1.yaml`: `
+table: t1
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: b
+    expression: count(*)
+prod:
+  measuers:
+    - name: c
+      expression: sum(c)
+`,
+	})
+
+	s1 := func(path string, cron string) *Resource {
+		return &Resource{
+			Name:  ResourceName{Kind: ResourceKindSource, Name: "s1"},
+			Paths: []string{"/sources/s1.yaml"},
+			SourceSpec: &runtimev1.SourceSpec{
+				SourceConnector: "s3",
+				SinkConnector:   "duckdb",
+				Properties:      must(structpb.NewStruct(map[string]any{"path": path})),
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true, Cron: cron},
+			},
+		}
+	}
+	d1 := &Resource{
+		Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+		Paths: []string{"/dashboards/d1.yaml"},
+		MetricsViewSpec: &runtimev1.MetricsViewSpec{
+			Connector: "duckdb",
+			Table:     "t1",
+			Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+				{Name: "a", Column: "a"},
+			},
+			Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+				{Name: "b", Expression: "count(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			},
+		},
+	}
+	s2Err := &runtimev1.ParseError{
+		Message:  `cannot set both "dev" and "env.dev"`,
+		FilePath: "/sources/s2.yaml",
+	}
+
+	p, err := Parse(ctx, repo, "", "dev", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{s1("s3://bucket/2024-01-01.parquet", ""), d1}, []*runtimev1.ParseError{s2Err})
+
+	p, err = Parse(ctx, repo, "", "prod", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{s1("s3://bucket/*.parquet", "0 * * * *")}, []*runtimev1.ParseError{
+		s2Err,
+		{
+			Message:  `invalid override for environment "prod"`,
+			FilePath: "/dashboards/d1.yaml",
+		},
+	})
+}
+
 func TestMetricsViewSecurity(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1269,6 +1566,7 @@ query:
 export:
   format: csv
   limit: 10000
+  filename: "{metrics_view}_{time_range}_{date}"
 
 
 notify:
@@ -1280,6 +1578,12 @@ notify:
       - reports
     users:
       - user_2@example.com
+  teams:
+    webhooks:
+      - https://example.webhook.office.com/webhookb2/123
+  webhook:
+    urls:
+      - https://example.com/hooks/rill
 
 annotations:
   foo: bar
@@ -1321,13 +1625,16 @@ annotations:
 					Cron:      "0 * * * *",
 					TimeZone:  "America/Los_Angeles",
 				},
-				QueryName:     "MetricsViewToplist",
-				QueryArgsJson: `{"metrics_view":"mv1"}`,
-				ExportFormat:  runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
-				ExportLimit:   10000,
+				QueryName:              "MetricsViewToplist",
+				QueryArgsJson:          `{"metrics_view":"mv1"}`,
+				ExportFormat:           runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
+				ExportLimit:            10000,
+				ExportFilenameTemplate: "{metrics_view}_{time_range}_{date}",
 				Notifiers: []*runtimev1.Notifier{
 					{Connector: "email", Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"user_1@example.com"}}))},
 					{Connector: "slack", Properties: must(structpb.NewStruct(map[string]any{"users": []any{"user_2@example.com"}, "channels": []any{"reports"}, "webhooks": []any{}}))},
+					{Connector: "teams", Properties: must(structpb.NewStruct(map[string]any{"webhooks": []any{"https://example.webhook.office.com/webhookb2/123"}}))},
+					{Connector: "webhook", Properties: must(structpb.NewStruct(map[string]any{"urls": []any{"https://example.com/hooks/rill"}}))},
 				},
 				Annotations:          map[string]string{"foo": "bar"},
 				WatermarkInherit:     true,
@@ -1428,6 +1735,88 @@ annotations:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestAnomalyAlert(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`alerts/a1.yaml`: `
+type: alert
+watermark: inherit
+anomaly:
+  metrics_view: mv1
+  measure: revenue
+  time_grain: day
+  window: 14
+  method: percent_deviation
+  threshold: 25
+  where: country = 'DK'
+  time_zone: Europe/Copenhagen
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+`,
+		`alerts/a2.yaml`: `
+type: alert
+anomaly:
+  metrics_view: mv1
+  measure: revenue
+  time_grain: day
+query:
+  name: MetricsViewToplist
+  args:
+    metrics_view: mv1
+`,
+		`alerts/a3.yaml`: `
+type: alert
+anomaly:
+  metrics_view: mv1
+  measure: revenue
+  time_grain: day
+  method: median
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a1"},
+			Paths: []string{"/alerts/a1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+			AlertSpec: &runtimev1.AlertSpec{
+				RefreshSchedule:  &runtimev1.Schedule{RefUpdate: true},
+				WatermarkInherit: true,
+				Anomaly: &runtimev1.AlertAnomaly{
+					MetricsView: "mv1",
+					Measure:     "revenue",
+					TimeGrain:   runtimev1.TimeGrain_TIME_GRAIN_DAY,
+					Window:      14,
+					Method:      runtimev1.AlertAnomaly_METHOD_PERCENT_DEVIATION,
+					Threshold:   25,
+					Where:       expressionpb.Eq("country", "DK"),
+					TimeZone:    "Europe/Copenhagen",
+				},
+				NotifyOnFail: true,
+				Notifiers:    []*runtimev1.Notifier{{Connector: "email", Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"benjamin@example.com"}}))}},
+			},
+		},
+	}
+
+	errs := []*runtimev1.ParseError{
+		{
+			Message:  `cannot set both "query" and "anomaly"`,
+			FilePath: "/alerts/a2.yaml",
+		},
+		{
+			Message:  `invalid value "median" for property "anomaly.method"`,
+			FilePath: "/alerts/a3.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errs)
+}
+
 func TestMetricsViewAvoidSelfCyclicRef(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1681,6 +2070,55 @@ metrics_sql: select * from m1
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestSegment(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`segments/emea_enterprise.yaml`: `
+type: segment
+metrics_view: mv1
+display_name: EMEA enterprise customers
+description: Enterprise customers in Europe, the Middle East and Africa
+filter: region = 'EMEA' AND tier IN ('enterprise', 'strategic')
+`,
+		`segments/no_mv.yaml`: `
+type: segment
+filter: region = 'EMEA'
+`,
+		`segments/bad_filter.yaml`: `
+type: segment
+metrics_view: mv1
+filter: region IN ('EMEA'
+`,
+		`segments/not_cond.yaml`: `
+type: segment
+metrics_view: mv1
+filter: region
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+
+	require.Len(t, p.Errors, 3)
+	require.Equal(t, "/segments/bad_filter.yaml", p.Errors[0].FilePath)
+	require.Contains(t, p.Errors[0].Message, `invalid "filter"`)
+	require.Equal(t, "/segments/no_mv.yaml", p.Errors[1].FilePath)
+	require.Equal(t, `property "metrics_view" is required`, p.Errors[1].Message)
+	require.Equal(t, "/segments/not_cond.yaml", p.Errors[2].FilePath)
+	require.Equal(t, `invalid "filter": must be a condition`, p.Errors[2].Message)
+
+	r := p.Resources[ResourceName{Kind: ResourceKindSegment, Name: "emea_enterprise"}.Normalized()]
+	require.NotNil(t, r)
+	require.Equal(t, []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}}, r.Refs)
+	require.Equal(t, "mv1", r.SegmentSpec.MetricsView)
+	require.Equal(t, "EMEA enterprise customers", r.SegmentSpec.DisplayName)
+	require.Equal(t, "Enterprise customers in Europe, the Middle East and Africa", r.SegmentSpec.Description)
+	f, err := expressionpb.Format(r.SegmentSpec.Filter)
+	require.NoError(t, err)
+	require.Equal(t, "(region = 'EMEA') AND (tier IN ('enterprise', 'strategic'))", f)
+}
+
 func TestKindBackwardsCompatibility(t *testing.T) {
 	files := map[string]string{
 		// rill.yaml
@@ -1927,3 +2365,370 @@ func deleteRepo(t testing.TB, repo drivers.RepoStore, files ...string) {
 func asPtr[T any](val T) *T {
 	return &val
 }
+
+func TestMetricsViewTimeAnchor(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+table: t1
+time_anchor: now
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: b
+    expression: count(*)
+`,
+		`dashboards/d2.yaml`: `
+table: t2
+time_anchor: yesterday
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: b
+    expression: count(*)
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Connector:  "duckdb",
+				Table:      "t1",
+				TimeAnchor: runtimev1.MetricsViewSpec_TIME_ANCHOR_NOW,
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "a", Column: "a"},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "b", Expression: "count(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+				},
+			},
+		},
+	}
+	errors := []*runtimev1.ParseError{
+		{Message: `invalid time_anchor "yesterday"`, FilePath: "/dashboards/d2.yaml"},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestMetricsViewDefaultFilter(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+table: t1
+default_filter: country IN ('US', 'CA') AND domain IN (SELECT domain HAVING b > 10)
+dimensions:
+  - name: country
+    column: country
+  - name: domain
+    column: domain
+measures:
+  - name: b
+    expression: count(*)
+`,
+		`dashboards/d2.yaml`: `
+table: t2
+default_filter: b > 10
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: b
+    expression: count(*)
+`,
+		`dashboards/d3.yaml`: `
+table: t3
+default_filter: a IN ('x'
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: b
+    expression: count(*)
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+
+	require.Len(t, p.Errors, 2)
+	require.Equal(t, "/dashboards/d2.yaml", p.Errors[0].FilePath)
+	require.Equal(t, `invalid "default_filter": dimension "b" not found`, p.Errors[0].Message)
+	require.Equal(t, "/dashboards/d3.yaml", p.Errors[1].FilePath)
+	require.Contains(t, p.Errors[1].Message, `invalid "default_filter"`)
+
+	r := p.Resources[ResourceName{Kind: ResourceKindMetricsView, Name: "d1"}.Normalized()]
+	require.NotNil(t, r)
+	f, err := expressionpb.Format(r.MetricsViewSpec.DefaultFilter)
+	require.NoError(t, err)
+	require.Equal(t, "(country IN ('US', 'CA')) AND (domain IN (SELECT domain HAVING b > 10))", f)
+}
+
+func TestMetricsViewDimensionLookup(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+table: t1
+dimensions:
+  - name: a
+    column: a_id
+    lookup:
+      table: a_names
+      key_column: id
+      value_column: name
+      default_expression: "'Unknown'"
+measures:
+  - name: b
+    expression: count(*)
+`,
+		`dashboards/d2.yaml`: `
+table: t2
+dimensions:
+  - name: a
+    column: a_id
+    lookup:
+      table: a_names
+measures:
+  - name: b
+    expression: count(*)
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Connector: "duckdb",
+				Table:     "t1",
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "a", Column: "a_id", LookupTable: "a_names", LookupKeyColumn: "id", LookupValueColumn: "name", LookupDefaultExpression: "'Unknown'"},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "b", Expression: "count(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+				},
+			},
+		},
+	}
+	errors := []*runtimev1.ParseError{
+		{Message: `"lookup" for dimension "a" must set "table", "key_column" and "value_column"`, FilePath: "/dashboards/d2.yaml"},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestMetricsViewUnionAndLookupJoins(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+table: events_2024
+union_tables:
+  - events_2023
+lookup_joins:
+  - lookup: country_names
+    alias: countries
+    key: country_code
+  - lookup: devices
+    key: device_id
+    type: inner
+dimensions:
+  - name: country
+    expression: countries.v
+measures:
+  - name: b
+    expression: count(*)
+`,
+		`dashboards/d2.yaml`: `
+table: t2
+lookup_joins:
+  - lookup: country_names
+    key: country_code
+    type: right
+measures:
+  - name: b
+    expression: count(*)
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Connector:   "duckdb",
+				Table:       "events_2024",
+				UnionTables: []string{"events_2023"},
+				LookupJoins: []*runtimev1.MetricsViewSpec_LookupJoin{
+					{Lookup: "country_names", Alias: "countries", KeyExpression: "country_code"},
+					{Lookup: "devices", KeyExpression: "device_id", Inner: true},
+				},
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "country", Expression: "countries.v"},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "b", Expression: "count(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+				},
+			},
+		},
+	}
+	errors := []*runtimev1.ParseError{
+		{Message: `invalid "lookup_joins" at index 0: invalid type "right"`, FilePath: "/dashboards/d2.yaml"},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestMetricsViewImports(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`metrics/shared/common.yaml`: `
+type: metrics_library
+dimensions:
+  - name: country
+    column: country
+  - column: domain
+measures:
+  - name: impressions
+    expression: count(*)
+  - name: revenue
+    label: Revenue
+    expression: sum(revenue)
+    format_preset: currency_usd
+`,
+		`metrics/shared/extra.yaml`: `
+type: metrics_library
+imports:
+  - metrics/shared/common.yaml
+measures:
+  - name: avg_revenue
+    expression: avg(revenue)
+`,
+		`dashboards/customer_a.yaml`: `
+table: customer_a
+imports:
+  - /metrics/shared/extra.yaml
+dimensions:
+  - name: domain
+    label: Site
+measures:
+  - name: revenue
+    expression: sum(revenue_eur)
+    format_preset: currency_eur
+  - name: impressions
+    ignore: true
+  - name: clicks
+    expression: sum(clicks)
+`,
+		`dashboards/customer_b.yaml`: `
+table: customer_b
+imports:
+  - dashboards/customer_a.yaml
+`,
+		`dashboards/cycle.yaml`: `
+table: cycle
+imports:
+  - metrics/cycle.yaml
+`,
+		`metrics/cycle.yaml`: `
+type: metrics_library
+imports:
+  - dashboards/cycle.yaml
+`,
+	})
+
+	mvA := &Resource{
+		Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "customer_a"},
+		Paths: []string{"/dashboards/customer_a.yaml"},
+		MetricsViewSpec: &runtimev1.MetricsViewSpec{
+			Connector: "duckdb",
+			Table:     "customer_a",
+			Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+				{Name: "country", Column: "country"},
+				{Name: "domain", Label: "Site", Column: "domain"},
+			},
+			Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+				{Name: "revenue", Label: "Revenue", Expression: "sum(revenue_eur)", FormatPreset: "currency_eur", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+				{Name: "avg_revenue", Expression: "avg(revenue)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+				{Name: "clicks", Expression: "sum(clicks)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			},
+		},
+	}
+	mvB := &Resource{
+		Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "customer_b"},
+		Paths: []string{"/dashboards/customer_b.yaml"},
+		MetricsViewSpec: &runtimev1.MetricsViewSpec{
+			Connector:  "duckdb",
+			Table:      "customer_b",
+			Dimensions: mvA.MetricsViewSpec.Dimensions,
+			Measures:   mvA.MetricsViewSpec.Measures,
+		},
+	}
+	errs := []*runtimev1.ParseError{
+		{Message: "import cycle detected: /dashboards/cycle.yaml -> /metrics/cycle.yaml -> /dashboards/cycle.yaml", FilePath: "/dashboards/cycle.yaml"},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, []*Resource{mvA, mvB}, errs)
+
+	// Changing a library reparses the metrics views that import it (directly or transitively)
+	putRepo(t, repo, map[string]string{
+		`metrics/shared/common.yaml`: `
+type: metrics_library
+dimensions:
+  - name: country
+    column: country_code
+  - column: domain
+measures:
+  - name: revenue
+    expression: sum(revenue)
+`,
+	})
+	diff, err := p.Reparse(ctx, []string{"/metrics/shared/common.yaml"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []ResourceName{mvA.Name, mvB.Name}, diff.Modified)
+	mvA.MetricsViewSpec.Dimensions[0].Column = "country_code"
+	mvA.MetricsViewSpec.Measures[0].Label = ""
+	requireResourcesAndErrors(t, p, []*Resource{mvA, mvB}, errs)
+
+	// Deleting an imported file
+	deleteRepo(t, repo, "/metrics/shared/extra.yaml")
+	diff, err = p.Reparse(ctx, []string{"/metrics/shared/extra.yaml"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []ResourceName{mvA.Name, mvB.Name}, diff.Deleted)
+	errs = append(errs,
+		&runtimev1.ParseError{Message: `imported file "/metrics/shared/extra.yaml" not found`, FilePath: "/dashboards/customer_a.yaml"},
+		&runtimev1.ParseError{Message: `imported file "/metrics/shared/extra.yaml" not found`, FilePath: "/dashboards/customer_b.yaml"},
+	)
+	requireResourcesAndErrors(t, p, nil, errs)
+
+	// Restoring it fixes the metrics views (the errors are external, so they're rechecked on every reparse)
+	putRepo(t, repo, map[string]string{
+		`metrics/shared/extra.yaml`: `
+type: metrics_library
+imports:
+  - metrics/shared/common.yaml
+`,
+	})
+	diff, err = p.Reparse(ctx, []string{"/metrics/shared/extra.yaml"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []ResourceName{mvA.Name, mvB.Name}, diff.Added)
+	mvA.MetricsViewSpec.Measures = slices.Delete(mvA.MetricsViewSpec.Measures, 1, 2)
+	mvB.MetricsViewSpec.Measures = mvA.MetricsViewSpec.Measures
+	requireResourcesAndErrors(t, p, []*Resource{mvA, mvB}, errs[:1])
+}
@@ -92,6 +92,8 @@ type Controller struct {
 	invocations map[string]*invocation
 	// completed receives invocations that have finished running.
 	completed chan *invocation
+	// paused is true while scheduling of new reconciles is suspended (see Pause).
+	paused bool
 }
 
 // NewController creates a new Controller
@@ -179,12 +181,17 @@ func (c *Controller) Run(ctx context.Context) error {
 		select {
 		case <-c.queueUpdatedCh: // There are resources we should schedule
 			c.mu.Lock()
-			err := c.processQueue()
-			if err != nil {
-				loopErr = err
-				stop = true
+			if c.paused {
+				// Keep the queue until the controller is resumed
+				c.queueUpdated = false
 			} else {
-				resetTimelineTimer()
+				err := c.processQueue()
+				if err != nil {
+					loopErr = err
+					stop = true
+				} else {
+					resetTimelineTimer()
+				}
 			}
 			c.checkIdleWaits()
 			c.mu.Unlock()
@@ -370,6 +377,51 @@ func (c *Controller) WaitUntilIdle(ctx context.Context, ignoreHidden bool) error
 	return ctx.Err()
 }
 
+// Pause suspends scheduling of new reconciles until Resume is called. Queries against the instance are not affected.
+// It waits for running reconciles to finish and flushes the catalog before returning,
+// so the persisted catalog matches the data in the instance's connectors while paused.
+// Resources enqueued while paused are scheduled when the controller is resumed.
+func (c *Controller) Pause(ctx context.Context) error {
+	if err := c.checkRunning(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.paused = true
+	c.mu.Unlock()
+
+	// While paused, the controller is idle when no reconcilers are running
+	err := c.WaitUntilIdle(ctx, false)
+	if err == nil {
+		err = c.Flush(ctx)
+	}
+	if err != nil {
+		c.Resume()
+		return err
+	}
+	return nil
+}
+
+// Resume resumes scheduling of reconciles after a call to Pause.
+func (c *Controller) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.paused {
+		return
+	}
+	c.paused = false
+	if len(c.queue) > 0 {
+		c.setQueueUpdated()
+	}
+}
+
+// Paused returns true if the controller has been paused with Pause.
+func (c *Controller) Paused() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.paused
+}
+
 // Get returns a resource by name.
 // Soft-deleted resources (i.e. resources where DeletedOn != nil) are not returned.
 func (c *Controller) Get(ctx context.Context, name *runtimev1.ResourceName, clone bool) (*runtimev1.Resource, error) {
@@ -835,7 +887,8 @@ func (c *Controller) checkIdleWaits() {
 	// But we need to do some other extra checks to handle ignoreHidden.
 
 	// The queue is processed rapidly, so we don't check ignoreHidden against it.
-	if len(c.queue) != 0 {
+	// When paused, the queue is not processed, so we ignore it.
+	if len(c.queue) != 0 && !c.paused {
 		return // Not idle
 	}
 
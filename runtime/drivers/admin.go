@@ -8,6 +8,9 @@ import (
 type AdminService interface {
 	GetReportMetadata(ctx context.Context, reportName string, annotations map[string]string, executionTime time.Time) (*ReportMetadata, error)
 	GetAlertMetadata(ctx context.Context, alertName string, annotations map[string]string, queryForUserID, queryForUserEmail string) (*AlertMetadata, error)
+	ReportQueryUsage(ctx context.Context, usage []*QueryUsage) error
+	SendHeartbeat(ctx context.Context, version string) error
+	SendResourceNotification(ctx context.Context, n *ResourceNotification) error
 }
 
 type ReportMetadata struct {
@@ -21,3 +24,31 @@ type AlertMetadata struct {
 	EditURL            string
 	QueryForAttributes map[string]any
 }
+
+// QueryUsage is the aggregated cost of the queries executed on behalf of a subject (usually a user or token ID) in a time window.
+type QueryUsage struct {
+	Subject       string
+	StartTime     time.Time
+	EndTime       time.Time
+	Queries       int64
+	ExecutionTime time.Duration
+	ScannedBytes  int64
+}
+
+// ResourceNotificationType is the type of a ResourceNotification.
+type ResourceNotificationType int
+
+const (
+	ResourceNotificationTypeAlertFired ResourceNotificationType = iota
+	ResourceNotificationTypeReportReady
+)
+
+// ResourceNotification is an in-app notification about a resource for the users among its recipients.
+type ResourceNotification struct {
+	Type            ResourceNotificationType
+	ResourceName    string
+	Annotations     map[string]string
+	Title           string
+	Body            string
+	RecipientEmails []string
+}
@@ -125,6 +125,8 @@ type Handle struct {
 	// These will not be set if downloadURL is set
 	gitURL          string
 	gitURLExpiresOn time.Time
+	// gitCommit pins the repo to a specific commit on the branch (if set).
+	gitCommit string
 
 	// downloadURL is set when using one-time uploads
 	downloadURL string
@@ -379,7 +381,8 @@ func (h *Handle) cloneOrPullInner(ctx context.Context) (err error) {
 // checkHandshake checks and possibly renews the repo details handshake with the admin server.
 // Unsafe for concurrent use.
 func (h *Handle) checkHandshake(ctx context.Context) error {
-	if h.gitURLExpiresOn.After(time.Now()) {
+	// When pinned to a commit, we always renew the handshake since the pinned commit may have changed.
+	if h.gitURLExpiresOn.After(time.Now()) && h.gitCommit == "" {
 		return nil
 	}
 	meta, err := h.admin.GetRepoMeta(ctx, &adminv1.GetRepoMetaRequest{
@@ -414,6 +417,7 @@ func (h *Handle) checkHandshake(ctx context.Context) error {
 	}
 
 	h.gitURL = meta.GitUrl
+	h.gitCommit = meta.GitCommitSha
 	if meta.GitUrlExpiresOn != nil {
 		h.gitURLExpiresOn = meta.GitUrlExpiresOn.AsTime()
 	} else {
@@ -432,12 +436,16 @@ func (h *Handle) cloneGit() error {
 		_ = os.RemoveAll(h.repoPath)
 	}
 
-	_, err = git.PlainClone(h.repoPath, false, &git.CloneOptions{
+	repo, err := git.PlainClone(h.repoPath, false, &git.CloneOptions{
 		URL:           h.gitURL,
 		ReferenceName: plumbing.NewBranchReferenceName(h.config.Branch),
 		SingleBranch:  true,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+
+	return h.checkoutPinnedCommit(repo)
 }
 
 // pullUnsafeGit pulls changes from the Git repo. It must run after a successful call to cloneUnsafeGit.
@@ -460,7 +468,7 @@ func (h *Handle) pullGit() error {
 		Force:         true,
 	})
 	if errors.Is(err, git.NoErrAlreadyUpToDate) {
-		return nil
+		return h.checkoutPinnedCommit(repo)
 	} else if errors.Is(err, git.ErrNonFastForwardUpdate) {
 		head, err := repo.Head()
 		if err != nil {
@@ -488,7 +496,51 @@ func (h *Handle) pullGit() error {
 		return err
 	}
 
-	return nil
+	return h.checkoutPinnedCommit(repo)
+}
+
+// checkoutPinnedCommit hard resets the worktree to h.gitCommit if the repo is pinned to a commit.
+// If the repo is not pinned, it ensures the worktree is at the tip of the remote branch (in case it was previously pinned to an older commit).
+// Unsafe for concurrent use.
+func (h *Handle) checkoutPinnedCommit(repo *git.Repository) error {
+	head, err := repo.Head()
+	if err != nil {
+		return err
+	}
+
+	var target plumbing.Hash
+	if h.gitCommit != "" {
+		target = plumbing.NewHash(h.gitCommit)
+		_, err = repo.CommitObject(target)
+		if err != nil {
+			return fmt.Errorf("pinned commit %q not found on branch %q: %w", h.gitCommit, h.config.Branch, err)
+		}
+	} else {
+		branch, err := repo.Branch(head.Name().Short())
+		if err != nil {
+			return err
+		}
+
+		rev, err := repo.ResolveRevision(plumbing.Revision(fmt.Sprintf("remotes/%s/%s", branch.Remote, head.Name().Short())))
+		if err != nil {
+			return err
+		}
+		target = *rev
+	}
+
+	if head.Hash() == target {
+		return nil
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	return wt.Reset(&git.ResetOptions{
+		Commit: target,
+		Mode:   git.HardReset,
+	})
 }
 
 // pullUnsafeVirtual syncs changes from the admin server's virtual repository.
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -58,3 +59,56 @@ func (h *Handle) GetAlertMetadata(ctx context.Context, alertName string, annotat
 
 	return meta, nil
 }
+
+func (h *Handle) ReportQueryUsage(ctx context.Context, usage []*drivers.QueryUsage) error {
+	pbs := make([]*adminv1.QueryUsage, len(usage))
+	for i, u := range usage {
+		pbs[i] = &adminv1.QueryUsage{
+			Subject:         u.Subject,
+			StartTime:       timestamppb.New(u.StartTime),
+			EndTime:         timestamppb.New(u.EndTime),
+			Queries:         u.Queries,
+			ExecutionTimeMs: u.ExecutionTime.Milliseconds(),
+			ScannedBytes:    u.ScannedBytes,
+		}
+	}
+
+	_, err := h.admin.ReportQueryUsage(ctx, &adminv1.ReportQueryUsageRequest{
+		ProjectId: h.config.ProjectID,
+		Branch:    h.config.Branch,
+		Usage:     pbs,
+	})
+	return err
+}
+
+func (h *Handle) SendHeartbeat(ctx context.Context, version string) error {
+	_, err := h.admin.SendRuntimeHeartbeat(ctx, &adminv1.SendRuntimeHeartbeatRequest{
+		ProjectId:      h.config.ProjectID,
+		RuntimeVersion: version,
+	})
+	return err
+}
+
+func (h *Handle) SendResourceNotification(ctx context.Context, n *drivers.ResourceNotification) error {
+	var typ adminv1.NotificationType
+	switch n.Type {
+	case drivers.ResourceNotificationTypeAlertFired:
+		typ = adminv1.NotificationType_NOTIFICATION_TYPE_ALERT_FIRED
+	case drivers.ResourceNotificationTypeReportReady:
+		typ = adminv1.NotificationType_NOTIFICATION_TYPE_REPORT_READY
+	default:
+		return fmt.Errorf("unknown resource notification type %d", n.Type)
+	}
+
+	_, err := h.admin.SendResourceNotification(ctx, &adminv1.SendResourceNotificationRequest{
+		ProjectId:       h.config.ProjectID,
+		Branch:          h.config.Branch,
+		Type:            typ,
+		ResourceName:    n.ResourceName,
+		Annotations:     n.Annotations,
+		Title:           n.Title,
+		Body:            n.Body,
+		RecipientEmails: n.RecipientEmails,
+	})
+	return err
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"cloud.google.com/go/bigquery"
+	"github.com/jmoiron/sqlx"
 	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
@@ -30,6 +32,20 @@ var spec = drivers.Spec{
 			Type: drivers.FilePropertyType,
 			Hint: "Enter path of file to load from.",
 		},
+		{
+			Key:         "project_id",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Project ID",
+			Description: "Google project ID to run queries in when used as an OLAP connector.",
+			Placeholder: "my-project",
+		},
+		{
+			Key:         "dataset",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Dataset",
+			Description: "Default dataset for unqualified table names when used as an OLAP connector.",
+			Placeholder: "my_dataset",
+		},
 	},
 	SourceProperties: []*drivers.PropertySpec{
 		{
@@ -59,6 +75,7 @@ var spec = drivers.Spec{
 		},
 	},
 	ImplementsSQLStore: true,
+	ImplementsOLAP:     true,
 }
 
 type driver struct{}
@@ -67,6 +84,15 @@ type configProperties struct {
 	SecretJSON      string `mapstructure:"google_application_credentials"`
 	AllowHostAccess bool   `mapstructure:"allow_host_access"`
 	TempDir         string `mapstructure:"temp_dir"`
+	// ProjectID is the project that OLAP queries run in. If empty, it's detected from the credentials.
+	ProjectID string `mapstructure:"project_id"`
+	// Dataset is the default dataset for unqualified table names in OLAP queries.
+	Dataset string `mapstructure:"dataset"`
+	// Location is the location of the datasets, e.g. "US". If empty, BigQuery infers it.
+	Location string `mapstructure:"location"`
+	// MaxBytesBilled fails OLAP queries that would bill more than the limit. Disabled if zero.
+	MaxBytesBilled int64 `mapstructure:"max_bytes_billed"`
+	LogQueries     bool  `mapstructure:"log_queries"`
 }
 
 func (d driver) Open(instanceID string, config map[string]any, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
@@ -103,6 +129,11 @@ func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any
 type Connection struct {
 	config *configProperties
 	logger *zap.Logger
+
+	// olapDB and olapClient serve OLAP queries. They're created lazily by acquireOLAP.
+	olapMu     sync.Mutex
+	olapDB     *sqlx.DB
+	olapClient *bigquery.Client
 }
 
 var _ drivers.Handle = &Connection{}
@@ -116,7 +147,7 @@ func (c *Connection) Ping(ctx context.Context) error {
 
 // Driver implements drivers.Connection.
 func (c *Connection) Driver() string {
-	return "gcs"
+	return "bigquery"
 }
 
 // Config implements drivers.Connection.
@@ -128,7 +159,7 @@ func (c *Connection) Config() map[string]any {
 
 // Close implements drivers.Connection.
 func (c *Connection) Close() error {
-	return nil
+	return c.closeOLAP()
 }
 
 // Registry implements drivers.Connection.
@@ -158,7 +189,7 @@ func (c *Connection) AsAI(instanceID string) (drivers.AIService, bool) {
 
 // OLAP implements drivers.Connection.
 func (c *Connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
-	return nil, false
+	return c, true
 }
 
 // Migrate implements drivers.Connection.
@@ -0,0 +1,423 @@
+package bigquery
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/bigquery/sqldriver"
+	"github.com/rilldata/rill/runtime/pkg/querycost"
+	"github.com/rilldata/rill/runtime/pkg/queryerr"
+	"github.com/rilldata/rill/runtime/pkg/slowquery"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/api/iterator"
+)
+
+// ErrBytesBilledExceeded is returned by dry runs of queries that are estimated to process more bytes than the configured max_bytes_billed.
+var ErrBytesBilledExceeded = queryerr.New(queryerr.ReasonBytesLimitExceeded, "bigquery: query would exceed max_bytes_billed")
+
+var _ drivers.OLAPStore = &Connection{}
+
+// AddTableColumn implements drivers.OLAPStore.
+func (c *Connection) AddTableColumn(ctx context.Context, tableName, columnName, typ string) error {
+	return fmt.Errorf("bigquery: data transformation not yet supported")
+}
+
+// AlterTableColumn implements drivers.OLAPStore.
+func (c *Connection) AlterTableColumn(ctx context.Context, tableName, columnName, newType string) error {
+	return fmt.Errorf("bigquery: data transformation not yet supported")
+}
+
+// CreateTableAsSelect implements drivers.OLAPStore.
+func (c *Connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string, tableOpts map[string]any) error {
+	return fmt.Errorf("bigquery: data transformation not yet supported")
+}
+
+// DropTable implements drivers.OLAPStore.
+func (c *Connection) DropTable(ctx context.Context, name string, view bool) error {
+	return fmt.Errorf("bigquery: data transformation not yet supported")
+}
+
+// InsertTableAsSelect implements drivers.OLAPStore.
+func (c *Connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
+	return fmt.Errorf("bigquery: data transformation not yet supported")
+}
+
+// RenameTable implements drivers.OLAPStore.
+func (c *Connection) RenameTable(ctx context.Context, name, newName string, view bool) error {
+	return fmt.Errorf("bigquery: data transformation not yet supported")
+}
+
+// Dialect implements drivers.OLAPStore.
+func (c *Connection) Dialect() drivers.Dialect {
+	return drivers.DialectBigQuery
+}
+
+// WithConnection implements drivers.OLAPStore.
+func (c *Connection) WithConnection(ctx context.Context, priority int, longRunning, tx bool, fn drivers.WithConnectionFunc) error {
+	return fmt.Errorf("bigquery: WithConnection not supported")
+}
+
+// EstimateSize implements drivers.OLAPStore.
+func (c *Connection) EstimateSize() (int64, bool) {
+	return 0, false
+}
+
+// Exec implements drivers.OLAPStore.
+func (c *Connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
+	res, err := c.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if stmt.DryRun {
+		return nil
+	}
+	return res.Close()
+}
+
+// Execute implements drivers.OLAPStore.
+// Dry runs validate the query and estimate the bytes it will process without running it (see EstimateBytesProcessed).
+func (c *Connection) Execute(ctx context.Context, stmt *drivers.Statement) (res *drivers.Result, outErr error) {
+	// Log query if enabled (usually disabled)
+	if c.config.LogQueries {
+		c.logger.Info("bigquery query", zap.String("sql", stmt.Query), zap.Any("args", stmt.Args))
+	}
+
+	ctx, span := drivers.StartQuerySpan(ctx, drivers.DialectBigQuery, "", stmt)
+	defer func() { drivers.EndQuerySpan(span, outErr) }()
+
+	if stmt.DryRun {
+		n, err := c.EstimateBytesProcessed(ctx, stmt.Query, stmt.Args)
+		if err != nil {
+			return nil, err
+		}
+		span.SetAttributes(attribute.Int64("db.bytes_processed_estimate", n))
+		if c.config.MaxBytesBilled > 0 && n > c.config.MaxBytesBilled {
+			return nil, fmt.Errorf("%w: estimated %d bytes, limit is %d", ErrBytesBilledExceeded, n, c.config.MaxBytesBilled)
+		}
+		return nil, nil
+	}
+
+	db, _, err := c.acquireOLAP(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cancelFunc context.CancelFunc
+	if stmt.ExecutionTimeout != 0 {
+		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
+	}
+
+	start := time.Now()
+	rows, err := db.QueryxContext(ctx, stmt.Query, stmt.Args...)
+	if err != nil {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil, err
+	}
+	elapsed := time.Since(start)
+	querycost.RecordQuery(ctx, elapsed)
+	slowquery.Observe(ctx, stmt.Query, elapsed)
+
+	schema, err := rowsToSchema(rows)
+	if err != nil {
+		rows.Close()
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil, err
+	}
+
+	r := &drivers.Result{Rows: rows, Schema: schema}
+	r.SetCleanupFunc(func() error {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil
+	})
+
+	return r, nil
+}
+
+// EstimateBytesProcessed runs the query as a dry run and returns the number of bytes BigQuery estimates it will process.
+// BigQuery bills on-demand queries by bytes processed, so it's a proxy for the cost of the query.
+func (c *Connection) EstimateBytesProcessed(ctx context.Context, query string, args []any) (int64, error) {
+	_, client, err := c.acquireOLAP(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	q := sqldriver.NewQuery(client, c.queryOptions(), query, args)
+	q.DryRun = true
+	job, err := q.Run(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	status := job.LastStatus()
+	if err := status.Err(); err != nil {
+		return 0, err
+	}
+	if status.Statistics == nil {
+		return 0, nil
+	}
+	return status.Statistics.TotalBytesProcessed, nil
+}
+
+// acquireOLAP returns the database/sql handle and client used to serve OLAP queries.
+// They're created on first use since creating the client requires resolving credentials.
+func (c *Connection) acquireOLAP(ctx context.Context) (*sqlx.DB, *bigquery.Client, error) {
+	c.olapMu.Lock()
+	defer c.olapMu.Unlock()
+
+	if c.olapDB != nil {
+		return c.olapDB, c.olapClient, nil
+	}
+
+	opts, err := c.clientOption(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectID := c.config.ProjectID
+	if projectID == "" {
+		projectID = bigquery.DetectProjectID
+	}
+	client, err := createClient(ctx, projectID, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.config.Location != "" {
+		client.Location = c.config.Location
+	}
+
+	c.olapClient = client
+	c.olapDB = sqlx.NewDb(sql.OpenDB(sqldriver.NewConnector(client, c.queryOptions())), "bigquery")
+	return c.olapDB, c.olapClient, nil
+}
+
+func (c *Connection) queryOptions() sqldriver.QueryOptions {
+	return sqldriver.QueryOptions{
+		DefaultProjectID: c.config.ProjectID,
+		DefaultDatasetID: c.config.Dataset,
+		Location:         c.config.Location,
+		MaxBytesBilled:   c.config.MaxBytesBilled,
+	}
+}
+
+// closeOLAP closes the handles created by acquireOLAP (if any).
+func (c *Connection) closeOLAP() error {
+	c.olapMu.Lock()
+	defer c.olapMu.Unlock()
+
+	if c.olapDB == nil {
+		return nil
+	}
+	err1 := c.olapDB.Close()
+	err2 := c.olapClient.Close()
+	c.olapDB = nil
+	c.olapClient = nil
+	return errors.Join(err1, err2)
+}
+
+type informationSchema struct {
+	c *Connection
+}
+
+// InformationSchema implements drivers.OLAPStore.
+func (c *Connection) InformationSchema() drivers.InformationSchema {
+	return informationSchema{c: c}
+}
+
+// All lists the tables in the configured dataset. If no dataset is configured, it lists the tables in all datasets of the project.
+func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
+	_, client, err := i.c.acquireOLAP(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var datasets []string
+	if i.c.config.Dataset != "" {
+		datasets = []string{i.c.config.Dataset}
+	} else {
+		it := client.Datasets(ctx)
+		for {
+			ds, err := it.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			datasets = append(datasets, ds.DatasetID)
+		}
+	}
+
+	var names [][2]string
+	for _, ds := range datasets {
+		it := client.Dataset(ds).Tables(ctx)
+		for {
+			t, err := it.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, [2]string{ds, t.TableID})
+		}
+	}
+
+	// Fetch table schemas in parallel
+	tables := make([]*drivers.Table, len(names))
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(5)
+	for idx, name := range names {
+		idx, name := idx, name
+		g.Go(func() error {
+			t, err := i.Lookup(ctx, client.Project(), name[0], name[1])
+			if err != nil {
+				return err
+			}
+			tables[idx] = t
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+// Lookup returns a table's schema. The db is the project and the schema is the dataset, which default to the configured values.
+func (i informationSchema) Lookup(ctx context.Context, db, schema, name string) (*drivers.Table, error) {
+	_, client, err := i.c.acquireOLAP(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if db == "" {
+		db = client.Project()
+	}
+	if schema == "" {
+		schema = i.c.config.Dataset
+	}
+	if schema == "" {
+		return nil, fmt.Errorf("bigquery: a dataset is required to look up table %q", name)
+	}
+
+	md, err := client.DatasetInProject(db, schema).Table(name).Metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unsupportedCols := make(map[string]string)
+	var fields []*runtimev1.StructType_Field
+	for _, f := range md.Schema {
+		t := fieldSchemaToPB(f)
+		if t.Code == runtimev1.Type_CODE_UNSPECIFIED {
+			unsupportedCols[f.Name] = sqldriver.DatabaseTypeName(f)
+			continue
+		}
+		fields = append(fields, &runtimev1.StructType_Field{Name: f.Name, Type: t})
+	}
+
+	return &drivers.Table{
+		Database:                db,
+		DatabaseSchema:          schema,
+		IsDefaultDatabase:       db == client.Project(),
+		IsDefaultDatabaseSchema: schema == i.c.config.Dataset,
+		Name:                    name,
+		View:                    md.Type == bigquery.ViewTable || md.Type == bigquery.MaterializedView,
+		Schema:                  &runtimev1.StructType{Fields: fields},
+		UnsupportedCols:         unsupportedCols,
+	}, nil
+}
+
+func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	cts, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*runtimev1.StructType_Field, len(cts))
+	for i, ct := range cts {
+		fields[i] = &runtimev1.StructType_Field{
+			Name: ct.Name(),
+			Type: databaseTypeToPB(ct.DatabaseTypeName()),
+		}
+	}
+
+	return &runtimev1.StructType{Fields: fields}, nil
+}
+
+// databaseTypeToPB converts a type name returned by sqldriver.DatabaseTypeName to a runtimev1.Type.
+// Nested record fields are not available from the type name, so records are reported as JSON.
+func databaseTypeToPB(dbt string) *runtimev1.Type {
+	if len(dbt) > 7 && dbt[:6] == "ARRAY<" {
+		return &runtimev1.Type{
+			Code:             runtimev1.Type_CODE_ARRAY,
+			ArrayElementType: databaseTypeToPB(dbt[6 : len(dbt)-1]),
+			Nullable:         true,
+		}
+	}
+	t := fieldSchemaToPB(&bigquery.FieldSchema{Type: bigquery.FieldType(dbt)})
+	if t.Code == runtimev1.Type_CODE_STRUCT || t.Code == runtimev1.Type_CODE_UNSPECIFIED {
+		t.Code = runtimev1.Type_CODE_JSON
+	}
+	return t
+}
+
+func fieldSchemaToPB(f *bigquery.FieldSchema) *runtimev1.Type {
+	t := &runtimev1.Type{Nullable: !f.Required}
+	if f.Repeated {
+		elem := *f
+		elem.Repeated = false
+		elem.Required = true
+		t.Code = runtimev1.Type_CODE_ARRAY
+		t.ArrayElementType = fieldSchemaToPB(&elem)
+		return t
+	}
+
+	switch f.Type {
+	case bigquery.StringFieldType, bigquery.GeographyFieldType, bigquery.TimeFieldType, bigquery.IntervalFieldType:
+		t.Code = runtimev1.Type_CODE_STRING
+	case bigquery.BytesFieldType:
+		t.Code = runtimev1.Type_CODE_BYTES
+	case bigquery.IntegerFieldType:
+		t.Code = runtimev1.Type_CODE_INT64
+	case bigquery.FloatFieldType, bigquery.NumericFieldType, bigquery.BigNumericFieldType:
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case bigquery.BooleanFieldType:
+		t.Code = runtimev1.Type_CODE_BOOL
+	case bigquery.TimestampFieldType, bigquery.DateTimeFieldType:
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case bigquery.DateFieldType:
+		t.Code = runtimev1.Type_CODE_DATE
+	case bigquery.JSONFieldType:
+		t.Code = runtimev1.Type_CODE_JSON
+	case bigquery.RecordFieldType:
+		t.Code = runtimev1.Type_CODE_STRUCT
+		t.StructType = &runtimev1.StructType{}
+		for _, sf := range f.Schema {
+			t.StructType.Fields = append(t.StructType.Fields, &runtimev1.StructType_Field{Name: sf.Name, Type: fieldSchemaToPB(sf)})
+		}
+	default:
+		t.Code = runtimev1.Type_CODE_UNSPECIFIED
+	}
+	return t
+}
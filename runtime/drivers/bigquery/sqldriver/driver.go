@@ -0,0 +1,272 @@
+package sqldriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"google.golang.org/api/iterator"
+)
+
+// QueryOptions configures the BigQuery jobs created for queries.
+type QueryOptions struct {
+	// DefaultProjectID and DefaultDatasetID are used to resolve unqualified table names.
+	DefaultProjectID string
+	DefaultDatasetID string
+	// Location is the location of the datasets, e.g. "US". If empty, BigQuery infers it.
+	Location string
+	// MaxBytesBilled fails queries that would bill more bytes than the limit. Disabled if zero.
+	MaxBytesBilled int64
+}
+
+// NewConnector returns a database/sql connector that runs queries as jobs using the provided client.
+// Queries use positional "?" parameters. The connector doesn't take ownership of the client.
+// Use it with sql.OpenDB.
+func NewConnector(client *bigquery.Client, opts QueryOptions) driver.Connector {
+	return &connector{client: client, opts: opts}
+}
+
+type connector struct {
+	client *bigquery.Client
+	opts   QueryOptions
+}
+
+var _ driver.Connector = &connector{}
+
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	return &conn{client: c.client, opts: c.opts}, nil
+}
+
+func (c *connector) Driver() driver.Driver {
+	return bigqueryDriver{}
+}
+
+type bigqueryDriver struct{}
+
+func (bigqueryDriver) Open(name string) (driver.Conn, error) {
+	return nil, errors.New("bigquery: use NewConnector instead of a DSN")
+}
+
+type conn struct {
+	client *bigquery.Client
+	opts   QueryOptions
+}
+
+var (
+	_ driver.QueryerContext = &conn{}
+	_ driver.ExecerContext  = &conn{}
+)
+
+// NewQuery creates a BigQuery query with the connector's options and the given positional args.
+func NewQuery(client *bigquery.Client, opts QueryOptions, query string, args []any) *bigquery.Query {
+	q := client.Query(query)
+	q.DefaultProjectID = opts.DefaultProjectID
+	q.DefaultDatasetID = opts.DefaultDatasetID
+	q.Location = opts.Location
+	q.MaxBytesBilled = opts.MaxBytesBilled
+	for _, arg := range args {
+		q.Parameters = append(q.Parameters, bigquery.QueryParameter{Value: arg})
+	}
+	return q
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	job, err := NewQuery(c.client, c.opts, query, namedValuesToArgs(args)).Run(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cancel the job if the context is cancelled before the results have been read
+	stop := context.AfterFunc(ctx, func() {
+		tctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = job.Cancel(tctx)
+	})
+
+	it, err := job.Read(ctx)
+	if err != nil {
+		stop()
+		return nil, err
+	}
+
+	// The schema is only available after the first page has been fetched, so we prefetch the first row.
+	r := &rows{it: it, stop: stop}
+	err = it.Next(&r.next)
+	if err != nil && !errors.Is(err, iterator.Done) {
+		stop()
+		return nil, err
+	}
+	r.done = errors.Is(err, iterator.Done)
+	r.schema = it.Schema
+	return r, nil
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	job, err := NewQuery(c.client, c.opts, query, namedValuesToArgs(args)).Run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status, err := job.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := status.Err(); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("bigquery: prepared statements are not supported")
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("bigquery: transactions are not supported")
+}
+
+// CheckNamedValue accepts all values, since they are converted to query parameters by the BigQuery client.
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	return nil
+}
+
+type rows struct {
+	it     *bigquery.RowIterator
+	schema bigquery.Schema
+	next   []bigquery.Value
+	done   bool
+	stop   func() bool
+}
+
+var (
+	_ driver.Rows                           = &rows{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &rows{}
+	_ driver.RowsColumnTypeScanType         = &rows{}
+)
+
+func (r *rows) Columns() []string {
+	cols := make([]string, len(r.schema))
+	for i, f := range r.schema {
+		cols[i] = f.Name
+	}
+	return cols
+}
+
+func (r *rows) Close() error {
+	r.done = true
+	r.stop()
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+
+	for i, v := range r.next {
+		dest[i] = convertValue(v, r.schema[i])
+	}
+
+	r.next = nil
+	err := r.it.Next(&r.next)
+	if errors.Is(err, iterator.Done) {
+		r.done = true
+		return nil
+	}
+	return err
+}
+
+func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
+	return DatabaseTypeName(r.schema[index])
+}
+
+func (r *rows) ColumnTypeScanType(index int) reflect.Type {
+	f := r.schema[index]
+	if f.Repeated {
+		return reflect.TypeOf([]any{})
+	}
+	switch f.Type {
+	case bigquery.IntegerFieldType:
+		return reflect.TypeOf(int64(0))
+	case bigquery.FloatFieldType, bigquery.NumericFieldType, bigquery.BigNumericFieldType:
+		return reflect.TypeOf(float64(0))
+	case bigquery.BooleanFieldType:
+		return reflect.TypeOf(true)
+	case bigquery.TimestampFieldType, bigquery.DateFieldType, bigquery.DateTimeFieldType:
+		return reflect.TypeOf(time.Time{})
+	case bigquery.RecordFieldType:
+		return reflect.TypeOf(map[string]any{})
+	default:
+		return reflect.TypeOf("")
+	}
+}
+
+// DatabaseTypeName returns the type name of a field, e.g. "INTEGER" or "ARRAY<STRING>" for repeated fields.
+func DatabaseTypeName(f *bigquery.FieldSchema) string {
+	if f.Repeated {
+		return fmt.Sprintf("ARRAY<%s>", f.Type)
+	}
+	return string(f.Type)
+}
+
+// convertValue converts a value returned by the BigQuery client to a type supported by database/sql.
+// Civil dates and datetimes become time.Time in UTC, numerics become float64, and repeated and record fields become slices and maps.
+func convertValue(v bigquery.Value, f *bigquery.FieldSchema) driver.Value {
+	if v == nil {
+		return nil
+	}
+
+	if f.Repeated {
+		vs, ok := v.([]bigquery.Value)
+		if !ok {
+			return v
+		}
+		elem := *f
+		elem.Repeated = false
+		res := make([]any, len(vs))
+		for i, ev := range vs {
+			res[i] = convertValue(ev, &elem)
+		}
+		return res
+	}
+
+	switch v := v.(type) {
+	case civil.Date:
+		return v.In(time.UTC)
+	case civil.DateTime:
+		return v.In(time.UTC)
+	case civil.Time:
+		return v.String()
+	case *big.Rat:
+		f, _ := v.Float64()
+		return f
+	case []bigquery.Value:
+		res := make(map[string]any, len(v))
+		for i, fv := range v {
+			if i < len(f.Schema) {
+				res[f.Schema[i].Name] = convertValue(fv, f.Schema[i])
+			}
+		}
+		return res
+	default:
+		return v
+	}
+}
+
+func namedValuesToArgs(nvs []driver.NamedValue) []any {
+	args := make([]any, len(nvs))
+	for i, nv := range nvs {
+		args[i] = nv.Value
+	}
+	return args
+}
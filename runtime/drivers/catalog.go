@@ -3,6 +3,7 @@ package drivers
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 // Constants representing the kinds of catalog objects.
@@ -36,6 +37,10 @@ type CatalogStore interface {
 	UpdateResource(ctx context.Context, v int64, r Resource) error
 	DeleteResource(ctx context.Context, v int64, k, n string) error
 	DeleteResources(ctx context.Context) error
+
+	FindMetricsViewVersions(ctx context.Context, metricsView string, limit int) ([]*MetricsViewVersion, error)
+	InsertMetricsViewVersion(ctx context.Context, v *MetricsViewVersion, retain int) error
+	DeleteMetricsViewVersions(ctx context.Context, metricsView string) error
 }
 
 // Resource is an entry in a catalog store
@@ -44,3 +49,14 @@ type Resource struct {
 	Name string
 	Data []byte
 }
+
+// MetricsViewVersion is an entry in the history of refreshes of the data underlying a metrics view.
+// FindMetricsViewVersions returns versions ordered by RefreshedOn, newest first.
+// InsertMetricsViewVersion only retains the newest versions for each metrics view, up to the given limit.
+type MetricsViewVersion struct {
+	MetricsView string
+	RefreshedOn time.Time
+	RowCount    int64
+	Schema      []byte // Serialized runtimev1.StructType of the metrics view's table
+	CreatedOn   time.Time
+}
@@ -1,9 +1,62 @@
 package drivers_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
 )
 
-func testCatalog(t *testing.T, catalog drivers.CatalogStore) {}
+func testCatalog(t *testing.T, catalog drivers.CatalogStore) {
+	ctx := context.Background()
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	vs, err := catalog.FindMetricsViewVersions(ctx, "mv", 10)
+	require.NoError(t, err)
+	require.Len(t, vs, 0)
+
+	for i := 0; i < 5; i++ {
+		err := catalog.InsertMetricsViewVersion(ctx, &drivers.MetricsViewVersion{
+			MetricsView: "mv",
+			RefreshedOn: t0.Add(time.Duration(i) * time.Hour),
+			RowCount:    int64(i * 10),
+			Schema:      []byte{byte(i)},
+		}, 3)
+		require.NoError(t, err)
+	}
+	err = catalog.InsertMetricsViewVersion(ctx, &drivers.MetricsViewVersion{
+		MetricsView: "other",
+		RefreshedOn: t0,
+		Schema:      []byte{},
+	}, 3)
+	require.NoError(t, err)
+
+	// Only the three most recent versions are retained, and lookups are case insensitive
+	vs, err = catalog.FindMetricsViewVersions(ctx, "MV", 10)
+	require.NoError(t, err)
+	require.Len(t, vs, 3)
+	require.Equal(t, int64(40), vs[0].RowCount)
+	require.True(t, t0.Add(4*time.Hour).Equal(vs[0].RefreshedOn))
+	require.Equal(t, []byte{4}, vs[0].Schema)
+	require.False(t, vs[0].CreatedOn.IsZero())
+	require.Equal(t, int64(20), vs[2].RowCount)
+
+	vs, err = catalog.FindMetricsViewVersions(ctx, "mv", 1)
+	require.NoError(t, err)
+	require.Len(t, vs, 1)
+	require.Equal(t, int64(40), vs[0].RowCount)
+
+	err = catalog.DeleteMetricsViewVersions(ctx, "mv")
+	require.NoError(t, err)
+	vs, err = catalog.FindMetricsViewVersions(ctx, "mv", 10)
+	require.NoError(t, err)
+	require.Len(t, vs, 0)
+	vs, err = catalog.FindMetricsViewVersions(ctx, "other", 10)
+	require.NoError(t, err)
+	require.Len(t, vs, 1)
+
+	err = catalog.DeleteMetricsViewVersions(ctx, "other")
+	require.NoError(t, err)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/priorityqueue"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 	"golang.org/x/sync/semaphore"
 )
@@ -132,6 +133,17 @@ type configProperties struct {
 	LogQueries bool `mapstructure:"log_queries"`
 	// SettingsOverride override the default settings used in queries. One use case is to disable settings and set `readonly = 1` when using read-only user.
 	SettingsOverride string `mapstructure:"settings_override"`
+	// MaxExecutionTime sets the max_execution_time setting (in seconds) for queries. Not applied if SettingsOverride is set.
+	MaxExecutionTime int `mapstructure:"max_execution_time"`
+	// MaxThreads sets the max_threads setting for queries. Not applied if SettingsOverride is set.
+	MaxThreads int `mapstructure:"max_threads"`
+	// QuerySettings is a comma separated list of extra settings appended to the default settings, e.g. "max_memory_usage = 10000000000".
+	// Not applied if SettingsOverride is set.
+	QuerySettings string `mapstructure:"query_settings"`
+	// Cluster is the name of the cluster to use for DDL statements. If set, tables are created ON CLUSTER as local tables fronted by a Distributed table.
+	Cluster string `mapstructure:"cluster"`
+	// PoolConfig configures the connection pool. If not set, the pool allows maxOpenConnections connections.
+	drivers.PoolConfig `mapstructure:",squash"`
 }
 
 // Open connects to Clickhouse using std API.
@@ -142,10 +154,13 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 	}
 
 	conf := &configProperties{}
-	err := mapstructure.WeakDecode(config, conf)
+	err := drivers.DecodeConfig(config, conf)
 	if err != nil {
 		return nil, err
 	}
+	if err := conf.PoolConfig.Validate(); err != nil {
+		return nil, err
+	}
 
 	// build clickhouse options
 	var opts *clickhouse.Options
@@ -187,7 +202,7 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 	db := sqlx.NewDb(clickhouse.OpenDB(opts), "clickhouse")
 	// very roughly approximating num queries required for a typical page load
 	// TODO: copied from druid reevaluate
-	db.SetMaxOpenConns(maxOpenConnections)
+	conf.PoolConfig.Apply(db.DB, maxOpenConnections)
 
 	err = db.Ping()
 	if err != nil {
@@ -210,13 +225,26 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 		return nil, fmt.Errorf("clickhouse version must be 22.7 or higher")
 	}
 
+	// See note in connection struct
+	olapSemSize := conf.PoolConfig.MaxOpenConns(maxOpenConnections) - 1
+	if olapSemSize < 1 {
+		olapSemSize = 1
+	}
+
+	registration, err := drivers.RegisterPoolMetrics(db.DB, "clickhouse", instanceID)
+	if err != nil {
+		return nil, err
+	}
+
 	conn := &connection{
-		db:      db,
-		config:  conf,
-		logger:  logger,
-		metaSem: semaphore.NewWeighted(1),
-		olapSem: priorityqueue.NewSemaphore(maxOpenConnections - 1),
-		opts:    opts,
+		db:           db,
+		config:       conf,
+		logger:       logger,
+		instanceID:   instanceID,
+		metaSem:      semaphore.NewWeighted(1),
+		olapSem:      priorityqueue.NewSemaphore(olapSemSize),
+		opts:         opts,
+		registration: registration,
 	}
 	return conn, nil
 }
@@ -252,6 +280,8 @@ type connection struct {
 
 	// options used to open clickhouse connections
 	opts *clickhouse.Options
+	// registration of pool metrics, which should be unregistered on close
+	registration metric.Registration
 }
 
 // Ping implements drivers.Handle.
@@ -273,6 +303,7 @@ func (c *connection) Config() map[string]any {
 
 // Close implements drivers.Connection.
 func (c *connection) Close() error {
+	_ = c.registration.Unregister()
 	return c.db.Close()
 }
 
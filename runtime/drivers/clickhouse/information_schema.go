@@ -3,6 +3,7 @@ package clickhouse
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -53,9 +54,31 @@ func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
 		return nil, err
 	}
 
+	if i.c.config.Cluster != "" {
+		tables = hideLocalTables(tables)
+	}
+
 	return tables, nil
 }
 
+// hideLocalTables removes the local tables behind Distributed tables created on a cluster. Queries should only target the Distributed tables.
+func hideLocalTables(tables []*drivers.Table) []*drivers.Table {
+	names := make(map[[2]string]bool, len(tables))
+	for _, t := range tables {
+		names[[2]string{t.DatabaseSchema, t.Name}] = true
+	}
+
+	res := tables[:0]
+	for _, t := range tables {
+		distributed, ok := strings.CutSuffix(t.Name, localTableSuffix)
+		if ok && names[[2]string{t.DatabaseSchema, distributed}] {
+			continue
+		}
+		res = append(res, t)
+	}
+	return res
+}
+
 func (i informationSchema) Lookup(ctx context.Context, db, schema, name string) (*drivers.Table, error) {
 	conn, release, err := i.c.acquireMetaConn(ctx)
 	if err != nil {
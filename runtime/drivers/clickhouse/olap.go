@@ -4,14 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/jmoiron/sqlx"
 	"github.com/mitchellh/mapstructure"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/querycost"
+	"github.com/rilldata/rill/runtime/pkg/runningquery"
+	"github.com/rilldata/rill/runtime/pkg/slowquery"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -75,6 +80,9 @@ func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
 		return err
 	}
 
+	// Track the query while it's running, so it can be listed and cancelled
+	ctx, _, done := runningquery.Start(ctx, stmt.Query)
+
 	// TODO: should we use timeout to acquire connection as well ?
 	var cancelFunc context.CancelFunc
 	if stmt.ExecutionTimeout != 0 {
@@ -84,10 +92,11 @@ func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
 		if cancelFunc != nil {
 			cancelFunc()
 		}
+		done()
 		_ = release()
 	}()
 	_, err = conn.ExecContext(ctx, stmt.Query, stmt.Args...)
-	return err
+	return runningquery.Err(ctx, err)
 }
 
 func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res *drivers.Result, outErr error) {
@@ -96,6 +105,9 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 		c.logger.Info("clickhouse query", zap.String("sql", stmt.Query), zap.Any("args", stmt.Args))
 	}
 
+	ctx, span := drivers.StartQuerySpan(ctx, drivers.DialectClickHouse, c.instanceID, stmt)
+	defer func() { drivers.EndQuerySpan(span, outErr) }()
+
 	// We use the meta conn for dry run queries
 	if stmt.DryRun {
 		conn, release, err := c.acquireMetaConn(ctx)
@@ -108,13 +120,10 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 		return nil, err
 	}
 
-	if c.config.SettingsOverride != "" {
-		stmt.Query += "\n SETTINGS " + c.config.SettingsOverride
-	} else {
-		stmt.Query += "\n SETTINGS cast_keep_nullable = 1, join_use_nulls = 1, session_timezone = 'UTC'"
-		if c.config.EnableCache {
-			stmt.Query += ", use_query_cache = 1"
-		}
+	stmt.Query += "\n SETTINGS " + c.querySettings()
+	if tag := drivers.QueryTagFromContext(ctx); tag != "" {
+		// The log_comment setting is recorded in system.query_log. Tags are sanitized, so they can be safely quoted.
+		stmt.Query += fmt.Sprintf(", log_comment = '%s'", tag)
 	}
 
 	// Gather metrics only for actual queries
@@ -139,6 +148,8 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 		if acquired {
 			// Only track query latency when not cancelled in queue
 			queryLatencyHistogram.Record(ctx, totalLatency-queueLatency, metric.WithAttributeSet(attrSet))
+			querycost.RecordQuery(ctx, time.Since(acquiredTime))
+			slowquery.Observe(ctx, stmt.Query, time.Since(acquiredTime))
 		}
 
 		if c.activity != nil {
@@ -161,25 +172,37 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 	// NOTE: We can't just "defer release()" because release() will block until rows.Close() is called.
 	// We must be careful to make sure release() is called on all code paths.
 
+	// Track the query while it's running, so it can be listed and cancelled
+	ctx, _, done := runningquery.Start(ctx, stmt.Query)
+
 	var cancelFunc context.CancelFunc
 	if stmt.ExecutionTimeout != 0 {
 		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
 	}
 
-	rows, err := conn.QueryxContext(ctx, stmt.Query, stmt.Args...)
+	// Report bytes read by the query as progress packets are received
+	queryCtx := clickhouse.Context(ctx, clickhouse.WithProgress(func(p *clickhouse.Progress) {
+		querycost.AddScannedBytes(ctx, int64(p.Bytes))
+	}))
+
+	rows, err := conn.QueryxContext(queryCtx, stmt.Query, stmt.Args...)
 	if err != nil {
+		err = runningquery.Err(ctx, err)
 		if cancelFunc != nil {
 			cancelFunc()
 		}
+		done()
 		_ = release()
 		return nil, err
 	}
 
 	schema, err := rowsToSchema(rows)
 	if err != nil {
+		err = runningquery.Err(ctx, err)
 		if cancelFunc != nil {
 			cancelFunc()
 		}
+		done()
 		_ = rows.Close()
 		_ = release()
 		return nil, err
@@ -190,12 +213,35 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 		if cancelFunc != nil {
 			cancelFunc()
 		}
+		done()
 		return release()
 	})
 
 	return res, nil
 }
 
+// querySettings returns the settings to use for OLAP queries.
+func (c *connection) querySettings() string {
+	if c.config.SettingsOverride != "" {
+		return c.config.SettingsOverride
+	}
+
+	settings := []string{"cast_keep_nullable = 1", "join_use_nulls = 1", "session_timezone = 'UTC'"}
+	if c.config.EnableCache {
+		settings = append(settings, "use_query_cache = 1")
+	}
+	if c.config.MaxExecutionTime > 0 {
+		settings = append(settings, fmt.Sprintf("max_execution_time = %d", c.config.MaxExecutionTime))
+	}
+	if c.config.MaxThreads > 0 {
+		settings = append(settings, fmt.Sprintf("max_threads = %d", c.config.MaxThreads))
+	}
+	if c.config.QuerySettings != "" {
+		settings = append(settings, c.config.QuerySettings)
+	}
+	return strings.Join(settings, ", ")
+}
+
 // AddTableColumn implements drivers.OLAPStore.
 func (c *connection) AddTableColumn(ctx context.Context, tableName, columnName, typ string) error {
 	return fmt.Errorf("clickhouse: data transformation not yet supported")
@@ -210,7 +256,7 @@ func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName
 func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string, tableOpts map[string]any) error {
 	if view {
 		return c.Exec(ctx, &drivers.Statement{
-			Query:    fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", safeSQLName(name), sql),
+			Query:    fmt.Sprintf("CREATE OR REPLACE VIEW %s%s AS %s", safeSQLName(name), c.onCluster(), sql),
 			Priority: 100,
 		})
 	}
@@ -220,9 +266,38 @@ func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view
 		return fmt.Errorf("failed to parse output properties: %w", err)
 	}
 
+	if c.config.Cluster == "" {
+		return c.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("CREATE OR REPLACE TABLE %s%s AS %s", safeSQLName(name), tableClauses(outputProps), sql),
+			Priority: 100,
+		})
+	}
+
+	// On a cluster, the data is stored in a local table on each shard and queried through a Distributed table.
+	// We create an empty local table with the query's schema and then insert into the Distributed table, which spreads the rows across shards.
+	err := c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("CREATE OR REPLACE TABLE %s%s%s AS SELECT * FROM (%s) LIMIT 0", safeSQLName(localTableName(name)), c.onCluster(), tableClauses(outputProps), sql),
+		Priority: 100,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = c.createDistributedTable(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return c.Exec(ctx, &drivers.Statement{
+		Query:       fmt.Sprintf("INSERT INTO %s %s", safeSQLName(name), sql),
+		Priority:    100,
+		LongRunning: true,
+	})
+}
+
+// tableClauses returns the column, engine and other table clauses for a CREATE TABLE statement.
+func tableClauses(outputProps *ModelOutputProperties) string {
 	var create strings.Builder
-	create.WriteString("CREATE OR REPLACE TABLE ")
-	create.WriteString(safeSQLName(name))
 
 	// see if there is any column override
 	if outputProps.Columns != "" {
@@ -279,15 +354,33 @@ func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view
 		create.WriteString(outputProps.Settings)
 	}
 
-	// write sql query
-	create.WriteString(" AS ")
-	create.WriteString(sql)
+	return create.String()
+}
+
+// createDistributedTable creates (or replaces) a Distributed table that fronts the local tables of name on all shards of the cluster.
+func (c *connection) createDistributedTable(ctx context.Context, name string) error {
+	local := localTableName(name)
 	return c.Exec(ctx, &drivers.Statement{
-		Query:    create.String(),
+		Query: fmt.Sprintf(
+			"CREATE OR REPLACE TABLE %s%s AS %s ENGINE = Distributed(%s, currentDatabase(), %s, rand())",
+			safeSQLName(name),
+			c.onCluster(),
+			safeSQLName(local),
+			safeSQLName(c.config.Cluster),
+			safeSQLName(local),
+		),
 		Priority: 100,
 	})
 }
 
+// onCluster returns an ON CLUSTER clause for DDL statements if a cluster is configured.
+func (c *connection) onCluster() string {
+	if c.config.Cluster == "" {
+		return ""
+	}
+	return " ON CLUSTER " + safeSQLName(c.config.Cluster)
+}
+
 // InsertTableAsSelect implements drivers.OLAPStore.
 func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
 	if !inPlace {
@@ -313,21 +406,49 @@ func (c *connection) DropTable(ctx context.Context, name string, view bool) erro
 	} else {
 		typ = "TABLE"
 	}
+	err := c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("DROP %s %s%s", typ, safeSQLName(name), c.onCluster()),
+		Priority: 100,
+	})
+	if err != nil || view || c.config.Cluster == "" {
+		return err
+	}
+
+	// Also drop the local tables behind the Distributed table
 	return c.Exec(ctx, &drivers.Statement{
-		Query:    fmt.Sprintf("DROP %s %s", typ, safeSQLName(name)),
+		Query:    fmt.Sprintf("DROP TABLE IF EXISTS %s%s", safeSQLName(localTableName(name)), c.onCluster()),
 		Priority: 100,
 	})
 }
 
 // RenameTable implements drivers.OLAPStore.
 func (c *connection) RenameTable(ctx context.Context, name, newName string, view bool) error {
-	if !view {
+	if !view && c.config.Cluster == "" {
 		return c.Exec(ctx, &drivers.Statement{
 			Query:    fmt.Sprintf("RENAME TABLE %s TO %s", safeSQLName(name), safeSQLName(newName)),
 			Priority: 100,
 		})
 	}
 
+	if !view {
+		// The Distributed table references the local table by name, so we rename the local table and recreate the Distributed table in front of it.
+		err := c.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("RENAME TABLE %s TO %s%s", safeSQLName(localTableName(name)), safeSQLName(localTableName(newName)), c.onCluster()),
+			Priority: 100,
+		})
+		if err != nil {
+			return err
+		}
+		err = c.createDistributedTable(ctx, newName)
+		if err != nil {
+			return err
+		}
+		return c.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("DROP TABLE %s%s", safeSQLName(name), c.onCluster()),
+			Priority: 100,
+		})
+	}
+
 	// clickhouse does not support renaming views so we capture the OLD view DDL and use it to create new view
 	res, err := c.Execute(ctx, &drivers.Statement{
 		Query:    fmt.Sprintf("SHOW CREATE VIEW %s", safeSQLName(name)),
@@ -347,7 +468,7 @@ func (c *connection) RenameTable(ctx context.Context, name, newName string, view
 	res.Close()
 
 	// create new view
-	sql = strings.Replace(sql, name, safeSQLName(newName), 1)
+	sql = strings.Replace(sql, name, safeSQLName(newName)+c.onCluster(), 1)
 	err = c.Exec(ctx, &drivers.Statement{
 		Query:    sql,
 		Priority: 100,
@@ -358,7 +479,7 @@ func (c *connection) RenameTable(ctx context.Context, name, newName string, view
 
 	// drop old view
 	err = c.Exec(context.Background(), &drivers.Statement{
-		Query:    fmt.Sprintf("DROP VIEW %s", safeSQLName(name)),
+		Query:    fmt.Sprintf("DROP VIEW %s%s", safeSQLName(name), c.onCluster()),
 		Priority: 100,
 	})
 	if err != nil {
@@ -408,16 +529,24 @@ func (c *connection) acquireOLAPConn(ctx context.Context, priority int) (*sqlx.C
 		return conn, func() error { return nil }, nil
 	}
 
-	// Acquire semaphore
-	err := c.olapSem.Acquire(ctx, priority)
-	if err != nil {
-		return nil, nil, err
-	}
+	// Acquire semaphore and conn.
+	// The pool acquire timeout also covers waiting on the semaphore since that's where queries queue up.
+	var releaseConn func() error
+	conn, err := drivers.AcquireConn(ctx, c.config.PoolConfig, "clickhouse", func(ctx context.Context) (*sqlx.Conn, error) {
+		err := c.olapSem.Acquire(ctx, priority)
+		if err != nil {
+			return nil, err
+		}
 
-	// Get new conn
-	conn, releaseConn, err := c.acquireConn(ctx)
+		var conn *sqlx.Conn
+		conn, releaseConn, err = c.acquireConn(ctx)
+		if err != nil {
+			c.olapSem.Release()
+			return nil, err
+		}
+		return conn, nil
+	})
 	if err != nil {
-		c.olapSem.Release()
 		return nil, nil, err
 	}
 
@@ -433,7 +562,7 @@ func (c *connection) acquireOLAPConn(ctx context.Context, priority int) (*sqlx.C
 
 // acquireConn returns a DuckDB connection. It should only be used internally in acquireMetaConn and acquireOLAPConn.
 func (c *connection) acquireConn(ctx context.Context) (*sqlx.Conn, func() error, error) {
-	conn, err := c.db.Connx(ctx)
+	conn, err := drivers.AcquireConn(ctx, c.config.PoolConfig, "clickhouse", c.db.Connx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -479,25 +608,27 @@ func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
 
 // databaseTypeToPB converts Clickhouse types to Rill's generic schema type.
 // Refer the list of types here: https://clickhouse.com/docs/en/sql-reference/data-types
-// NOTE: Doesn't handle aggregation function types, nested data structures, tuples, geo types, special data types.
+// NOTE: Doesn't handle aggregation function types, geo types, special data types.
 func databaseTypeToPB(dbt string, nullable bool) (*runtimev1.Type, error) {
-	dbt = strings.ToUpper(dbt)
+	// We only uppercase the type names, not the args, to preserve the case of field names in tuples.
+	dbt = strings.TrimSpace(dbt)
+	upper := strings.ToUpper(dbt)
 
 	// For nullable the datatype is Nullable(X)
-	if strings.HasPrefix(dbt, "NULLABLE(") {
+	if strings.HasPrefix(upper, "NULLABLE(") {
 		dbt = dbt[9 : len(dbt)-1]
 		return databaseTypeToPB(dbt, true)
 	}
 
 	// For LowCardinality the datatype is LowCardinality(X)
-	if strings.HasPrefix(dbt, "LOWCARDINALITY(") {
+	if strings.HasPrefix(upper, "LOWCARDINALITY(") {
 		dbt = dbt[15 : len(dbt)-1]
 		return databaseTypeToPB(dbt, nullable)
 	}
 
 	match := true
 	t := &runtimev1.Type{Nullable: nullable}
-	switch dbt {
+	switch upper {
 	case "BOOL":
 		t.Code = runtimev1.Type_CODE_BOOL
 	case "INT8":
@@ -564,7 +695,7 @@ func databaseTypeToPB(dbt string, nullable bool) (*runtimev1.Type, error) {
 		return nil, errUnsupportedType
 	}
 
-	switch base {
+	switch strings.ToUpper(base) {
 	case "DATETIME":
 		t.Code = runtimev1.Type_CODE_TIMESTAMP
 	case "DATETIME64":
@@ -572,36 +703,42 @@ func databaseTypeToPB(dbt string, nullable bool) (*runtimev1.Type, error) {
 	// Example: "DECIMAL(10,20)", "DECIMAL(10)"
 	case "DECIMAL":
 		t.Code = runtimev1.Type_CODE_DECIMAL
+		t.DecimalType = parseDecimalArgs(args)
+	// Example: "DECIMAL32(2)", where the arg is the scale
 	case "DECIMAL32":
 		t.Code = runtimev1.Type_CODE_DECIMAL
+		t.DecimalType = parseDecimalArgs("9," + args)
 	case "DECIMAL64":
 		t.Code = runtimev1.Type_CODE_DECIMAL
+		t.DecimalType = parseDecimalArgs("18," + args)
 	case "DECIMAL128":
 		t.Code = runtimev1.Type_CODE_DECIMAL
+		t.DecimalType = parseDecimalArgs("38," + args)
 	case "DECIMAL256":
 		t.Code = runtimev1.Type_CODE_DECIMAL
+		t.DecimalType = parseDecimalArgs("76," + args)
 	case "FIXEDSTRING":
 		t.Code = runtimev1.Type_CODE_STRING
 	case "ARRAY":
 		t.Code = runtimev1.Type_CODE_ARRAY
 		var err error
-		t.ArrayElementType, err = databaseTypeToPB(dbt[6:len(dbt)-1], true)
+		t.ArrayElementType, err = databaseTypeToPB(args, true)
 		if err != nil {
 			return nil, err
 		}
-	// Example: "MAP(VARCHAR, INT)"
+	// Example: "MAP(STRING, MAP(STRING, UINT64))"
 	case "MAP":
-		fieldStrs := strings.Split(args, ",")
+		fieldStrs := splitTypeArgs(args)
 		if len(fieldStrs) != 2 {
 			return nil, errUnsupportedType
 		}
 
-		keyType, err := databaseTypeToPB(strings.TrimSpace(fieldStrs[0]), true)
+		keyType, err := databaseTypeToPB(fieldStrs[0], true)
 		if err != nil {
 			return nil, err
 		}
 
-		valType, err := databaseTypeToPB(strings.TrimSpace(fieldStrs[1]), true)
+		valType, err := databaseTypeToPB(fieldStrs[1], true)
 		if err != nil {
 			return nil, err
 		}
@@ -611,6 +748,22 @@ func databaseTypeToPB(dbt string, nullable bool) (*runtimev1.Type, error) {
 			KeyType:   keyType,
 			ValueType: valType,
 		}
+	// Example: "TUPLE(min UINT64, max UINT64)" or "TUPLE(UINT64, STRING)"
+	case "TUPLE":
+		st, err := tupleArgsToPB(args)
+		if err != nil {
+			return nil, err
+		}
+		t.Code = runtimev1.Type_CODE_STRUCT
+		t.StructType = st
+	// A nested data structure is an array of named tuples, e.g. "NESTED(name STRING, value UINT64)"
+	case "NESTED":
+		st, err := tupleArgsToPB(args)
+		if err != nil {
+			return nil, err
+		}
+		t.Code = runtimev1.Type_CODE_ARRAY
+		t.ArrayElementType = &runtimev1.Type{Code: runtimev1.Type_CODE_STRUCT, StructType: st, Nullable: true}
 	case "ENUM", "ENUM8", "ENUM16":
 		// Representing enums as strings
 		t.Code = runtimev1.Type_CODE_STRING
@@ -621,6 +774,24 @@ func databaseTypeToPB(dbt string, nullable bool) (*runtimev1.Type, error) {
 	return t, nil
 }
 
+// parseDecimalArgs parses the precision and scale args of a DECIMAL type, e.g. "10,2".
+// It returns nil if the args can't be parsed.
+func parseDecimalArgs(args string) *runtimev1.DecimalType {
+	p, s, _ := strings.Cut(args, ",")
+	precision, err := strconv.ParseUint(strings.TrimSpace(p), 10, 32)
+	if err != nil {
+		return nil
+	}
+	var scale uint64
+	if s != "" {
+		scale, err = strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+		if err != nil {
+			return nil
+		}
+	}
+	return &runtimev1.DecimalType{Precision: uint32(precision), Scale: uint32(scale)}
+}
+
 // Splits a type with args in parentheses, for example:
 //
 //	`Nullable(UInt64)` -> (`Nullable`, `UInt64`, true)
@@ -637,4 +808,71 @@ func splitBaseAndArgs(s string) (string, string, bool) {
 	return base, rest, true
 }
 
+// tupleArgsToPB converts the args of a Tuple type to a struct type.
+// Unnamed elements are named by their 1-based position, matching ClickHouse's tupleElement function.
+func tupleArgsToPB(args string) (*runtimev1.StructType, error) {
+	elems := splitTypeArgs(args)
+	fields := make([]*runtimev1.StructType_Field, len(elems))
+	for i, e := range elems {
+		name, typ := splitTupleElement(e)
+		if name == "" {
+			name = strconv.Itoa(i + 1)
+		}
+		ft, err := databaseTypeToPB(typ, true)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = &runtimev1.StructType_Field{Name: name, Type: ft}
+	}
+	return &runtimev1.StructType{Fields: fields}, nil
+}
+
+// splitTupleElement splits an element of a Tuple type into its name and type.
+// Elements are either a type (e.g. "UInt64") or a name followed by a type (e.g. "count UInt64" or "`my count` UInt64").
+func splitTupleElement(s string) (string, string) {
+	if strings.HasPrefix(s, "`") {
+		if end := strings.IndexByte(s[1:], '`'); end >= 0 {
+			return s[1 : end+1], strings.TrimSpace(s[end+2:])
+		}
+	}
+	sp := strings.IndexByte(s, ' ')
+	if sp < 0 {
+		return "", s
+	}
+	// A space inside parentheses belongs to the args of an unnamed element's type, e.g. "Decimal(10, 2)".
+	if p := strings.IndexByte(s, '('); p >= 0 && p < sp {
+		return "", s
+	}
+	return s[:sp], strings.TrimSpace(s[sp+1:])
+}
+
+// splitTypeArgs splits the args of a complex type on top-level commas.
+// Example: "String, Map(String, UInt64)" -> ["String", "Map(String, UInt64)"].
+func splitTypeArgs(args string) []string {
+	var res []string
+	var quote byte
+	depth, start := 0, 0
+	for i := 0; i < len(args); i++ {
+		c := args[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			res = append(res, strings.TrimSpace(args[start:i]))
+			start = i + 1
+		}
+	}
+	return append(res, strings.TrimSpace(args[start:]))
+}
+
 var errUnsupportedType = errors.New("encountered unsupported clickhouse type")
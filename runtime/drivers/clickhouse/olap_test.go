@@ -0,0 +1,73 @@
+package clickhouse
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerySettings(t *testing.T) {
+	c := &connection{config: &configProperties{}}
+	require.Equal(t, "cast_keep_nullable = 1, join_use_nulls = 1, session_timezone = 'UTC'", c.querySettings())
+
+	c.config = &configProperties{EnableCache: true, MaxExecutionTime: 30, MaxThreads: 4, QuerySettings: "max_memory_usage = 1000"}
+	require.Equal(t, "cast_keep_nullable = 1, join_use_nulls = 1, session_timezone = 'UTC', use_query_cache = 1, max_execution_time = 30, max_threads = 4, max_memory_usage = 1000", c.querySettings())
+
+	c.config.SettingsOverride = "readonly = 1"
+	require.Equal(t, "readonly = 1", c.querySettings())
+}
+
+func TestHideLocalTables(t *testing.T) {
+	tables := []*drivers.Table{
+		{DatabaseSchema: "default", Name: "events"},
+		{DatabaseSchema: "default", Name: "events_local"},
+		{DatabaseSchema: "default", Name: "orphan_local"},
+		{DatabaseSchema: "other", Name: "events_local"},
+	}
+
+	var names []string
+	for _, t := range hideLocalTables(tables) {
+		names = append(names, t.DatabaseSchema+"."+t.Name)
+	}
+	require.Equal(t, []string{"default.events", "default.orphan_local", "other.events_local"}, names)
+}
+
+func TestDatabaseTypeToPBNested(t *testing.T) {
+	u64 := &runtimev1.Type{Code: runtimev1.Type_CODE_UINT64, Nullable: true}
+	str := &runtimev1.Type{Code: runtimev1.Type_CODE_STRING, Nullable: true}
+
+	tt, err := databaseTypeToPB("Map(String, Map(LowCardinality(String), Nullable(UInt64)))", false)
+	require.NoError(t, err)
+	require.Equal(t, runtimev1.Type_CODE_MAP, tt.Code)
+	require.Equal(t, runtimev1.Type_CODE_STRING, tt.MapType.KeyType.Code)
+	require.Equal(t, runtimev1.Type_CODE_MAP, tt.MapType.ValueType.Code)
+	require.Equal(t, u64.Code, tt.MapType.ValueType.MapType.ValueType.Code)
+
+	tt, err = databaseTypeToPB("Tuple(minValue UInt64, `max value` Decimal(10, 2), tags Array(String))", false)
+	require.NoError(t, err)
+	require.Equal(t, runtimev1.Type_CODE_STRUCT, tt.Code)
+	require.Len(t, tt.StructType.Fields, 3)
+	require.Equal(t, "minValue", tt.StructType.Fields[0].Name)
+	require.Equal(t, u64, tt.StructType.Fields[0].Type)
+	require.Equal(t, "max value", tt.StructType.Fields[1].Name)
+	require.Equal(t, runtimev1.Type_CODE_DECIMAL, tt.StructType.Fields[1].Type.Code)
+	require.Equal(t, uint32(2), tt.StructType.Fields[1].Type.DecimalType.Scale)
+	require.Equal(t, "tags", tt.StructType.Fields[2].Name)
+	require.Equal(t, str, tt.StructType.Fields[2].Type.ArrayElementType)
+
+	tt, err = databaseTypeToPB("Tuple(UInt64, Enum8('a, b' = 1, 'c' = 2))", true)
+	require.NoError(t, err)
+	require.True(t, tt.Nullable)
+	require.Len(t, tt.StructType.Fields, 2)
+	require.Equal(t, "1", tt.StructType.Fields[0].Name)
+	require.Equal(t, "2", tt.StructType.Fields[1].Name)
+	require.Equal(t, runtimev1.Type_CODE_STRING, tt.StructType.Fields[1].Type.Code)
+
+	tt, err = databaseTypeToPB("Nested(name String, value UInt64)", false)
+	require.NoError(t, err)
+	require.Equal(t, runtimev1.Type_CODE_ARRAY, tt.Code)
+	require.Equal(t, runtimev1.Type_CODE_STRUCT, tt.ArrayElementType.Code)
+	require.Equal(t, "value", tt.ArrayElementType.StructType.Fields[1].Name)
+}
@@ -11,3 +11,10 @@ func safeSQLName(name string) string {
 	}
 	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
 }
+
+// localTableName returns the name of the local table that stores the data of a Distributed table on each shard.
+func localTableName(name string) string {
+	return name + localTableSuffix
+}
+
+const localTableSuffix = "_local"
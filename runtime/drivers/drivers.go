@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/queryerr"
 	"go.uber.org/zap"
 )
 
@@ -18,6 +19,9 @@ var ErrNotImplemented = errors.New("driver: not implemented")
 // ErrStorageLimitExceeded indicates the driver's storage limit was exceeded.
 var ErrStorageLimitExceeded = fmt.Errorf("connectors: exceeds storage limit")
 
+// ErrMemoryLimitExceeded indicates a query was aborted because it exceeded the driver's memory limit.
+var ErrMemoryLimitExceeded = queryerr.New(queryerr.ReasonMemoryLimitExceeded, "driver: query exceeded the memory limit")
+
 // ErrNotNotifier indicates the driver cannot be used as a Notifier.
 var ErrNotNotifier = errors.New("driver: not a notifier")
 
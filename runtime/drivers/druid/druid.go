@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
 	// Load Druid database/sql driver
@@ -113,6 +115,9 @@ var spec = drivers.Spec{
 	ImplementsOLAP: true,
 }
 
+// maxOpenConnections is the default size of the connection pool
+const maxOpenConnections = 20
+
 type driver struct{}
 
 var _ drivers.Driver = &driver{}
@@ -128,6 +133,13 @@ type configProperties struct {
 	SSL bool `mapstructure:"ssl"`
 	// LogQueries controls whether to log the raw SQL passed to OLAP.Execute.
 	LogQueries bool `mapstructure:"log_queries"`
+	// AsyncQueries enables Druid's async statements API for long running queries, such as exports.
+	// It requires the multi-stage query engine with durable storage enabled on the Druid cluster.
+	AsyncQueries bool `mapstructure:"async_queries"`
+	// AsyncPollInterval is the interval between status checks of async queries. Defaults to 1s.
+	AsyncPollInterval time.Duration `mapstructure:"async_poll_interval"`
+	// PoolConfig configures the connection pool. If not set, the pool allows maxOpenConnections connections.
+	drivers.PoolConfig `mapstructure:",squash"`
 }
 
 // Opens a connection to Apache Druid using HTTP API.
@@ -138,10 +150,13 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 	}
 
 	conf := &configProperties{}
-	err := mapstructure.WeakDecode(config, conf)
+	err := drivers.DecodeConfig(config, conf)
 	if err != nil {
 		return nil, err
 	}
+	if err := conf.PoolConfig.Validate(); err != nil {
+		return nil, err
+	}
 
 	dsn, err := dnsFromConfig(conf)
 	if err != nil {
@@ -154,17 +169,23 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 	}
 
 	// very roughly approximating num queries required for a typical page load
-	db.SetMaxOpenConns(20)
+	conf.PoolConfig.Apply(db.DB, maxOpenConnections)
 
 	err = db.Ping()
 	if err != nil {
 		return nil, fmt.Errorf("druid: %w", err)
 	}
 
+	registration, err := drivers.RegisterPoolMetrics(db.DB, "druid", instanceID)
+	if err != nil {
+		return nil, err
+	}
+
 	conn := &connection{
-		db:     db,
-		config: conf,
-		logger: logger,
+		db:           db,
+		config:       conf,
+		logger:       logger,
+		registration: registration,
 	}
 	return conn, nil
 }
@@ -185,6 +206,8 @@ type connection struct {
 	db     *sqlx.DB
 	config *configProperties
 	logger *zap.Logger
+	// registration of pool metrics, which should be unregistered on close
+	registration metric.Registration
 }
 
 // Ping implements drivers.Handle.
@@ -206,6 +229,7 @@ func (c *connection) Config() map[string]any {
 
 // Close implements drivers.Connection.
 func (c *connection) Close() error {
+	_ = c.registration.Unregister()
 	return c.db.Close()
 }
 
@@ -294,8 +318,13 @@ func (c *connection) AcquireLongRunning(ctx context.Context) (func(), error) {
 }
 
 func GetDSN(config map[string]string) (string, error) {
+	cfg := make(map[string]any, len(config))
+	for k, v := range config {
+		cfg[k] = v
+	}
+
 	conf := &configProperties{}
-	err := mapstructure.WeakDecode(config, conf)
+	err := drivers.DecodeConfig(cfg, conf)
 	if err != nil {
 		return "", err
 	}
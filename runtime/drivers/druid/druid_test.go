@@ -10,6 +10,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
@@ -120,6 +121,7 @@ func TestDruid(t *testing.T) {
 	t.Run("schema lookup", func(t *testing.T) { testSchemaLookup(t, olap) })
 	// Add new tests here
 	t.Run("time floor", func(t *testing.T) { testTimeFloor(t, olap) })
+	t.Run("date trunc parity", func(t *testing.T) { testDateTruncParity(t, olap) })
 
 	require.NoError(t, conn.Close())
 }
@@ -177,6 +179,57 @@ func testTimeFloor(t *testing.T, olap drivers.OLAPStore) {
 	require.Equal(t, 9, count)
 }
 
+// testDateTruncParity checks that time grain truncation in Druid matches DuckDB for non-UTC time zones and custom week/year starts, including around DST changes.
+func testDateTruncParity(t *testing.T, olap drivers.OLAPStore) {
+	duck, err := drivers.Open("duckdb", "default", map[string]any{"dsn": ":memory:"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer duck.Close()
+	duckOLAP, ok := duck.AsOLAP("")
+	require.True(t, ok)
+
+	timestamps := []string{
+		"2022-03-13T06:30:00Z", // Just before DST starts in New York
+		"2022-03-27T00:30:00Z", // Just before DST starts in Berlin
+		"2022-04-01T03:30:00Z", // Start of Q2 in New York, still Q1 in UTC
+		"2022-11-06T05:30:00Z", // During the repeated hour when DST ends in New York
+		"2022-12-31T23:30:00Z", // New year in Berlin, not in UTC
+	}
+	grains := []runtimev1.TimeGrain{runtimev1.TimeGrain_TIME_GRAIN_DAY, runtimev1.TimeGrain_TIME_GRAIN_WEEK, runtimev1.TimeGrain_TIME_GRAIN_MONTH, runtimev1.TimeGrain_TIME_GRAIN_QUARTER, runtimev1.TimeGrain_TIME_GRAIN_YEAR}
+	zones := []string{"America/New_York", "Europe/Berlin", "Asia/Kathmandu"}
+	starts := []struct{ firstDayOfWeek, firstMonthOfYear int }{{1, 1}, {7, 4}}
+
+	for _, ts := range timestamps {
+		for _, grain := range grains {
+			for _, tz := range zones {
+				for _, start := range starts {
+					druidExpr, err := drivers.DialectDruid.DateTruncExpr(&runtimev1.MetricsViewSpec_DimensionV2{Expression: fmt.Sprintf("TIME_PARSE('%s')", ts)}, grain, tz, start.firstDayOfWeek, start.firstMonthOfYear)
+					require.NoError(t, err)
+					duckExpr, err := drivers.DialectDuckDB.DateTruncExpr(&runtimev1.MetricsViewSpec_DimensionV2{Expression: fmt.Sprintf("TIMESTAMPTZ '%s'", ts)}, grain, tz, start.firstDayOfWeek, start.firstMonthOfYear)
+					require.NoError(t, err)
+
+					rows, err := olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT " + druidExpr})
+					require.NoError(t, err)
+					var druidRes string
+					require.True(t, rows.Next())
+					require.NoError(t, rows.Scan(&druidRes))
+					require.NoError(t, rows.Close())
+					druidTime, err := time.Parse(time.RFC3339, druidRes)
+					require.NoError(t, err)
+
+					rows, err = duckOLAP.Execute(context.Background(), &drivers.Statement{Query: "SELECT " + duckExpr})
+					require.NoError(t, err)
+					var duckTime time.Time
+					require.True(t, rows.Next())
+					require.NoError(t, rows.Scan(&duckTime))
+					require.NoError(t, rows.Close())
+
+					require.True(t, druidTime.Equal(duckTime), "%s truncated to %s in %s (week start %d, year start %d): druid=%s duckdb=%s", ts, grain, tz, start.firstDayOfWeek, start.firstMonthOfYear, druidTime, duckTime)
+				}
+			}
+		}
+	}
+}
+
 func testSchemaAll(t *testing.T, olap drivers.OLAPStore) {
 	tables, err := olap.InformationSchema().All(context.Background())
 	require.NoError(t, err)
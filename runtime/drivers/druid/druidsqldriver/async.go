@@ -0,0 +1,275 @@
+package druidsqldriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// defaultAsyncPollInterval is the interval between status checks of async queries if none is configured.
+const defaultAsyncPollInterval = time.Second
+
+type asyncCtxKey struct{}
+
+// WithAsync returns a context that makes SQL queries run with it use Druid's async statements API.
+// Async queries are executed by the multi-stage query engine and their results are written to durable storage,
+// so they are not subject to the HTTP timeouts of the synchronous SQL API.
+// The driver polls for the query's status every pollInterval and then pages through the results.
+func WithAsync(ctx context.Context, pollInterval time.Duration) context.Context {
+	if pollInterval <= 0 {
+		pollInterval = defaultAsyncPollInterval
+	}
+	return context.WithValue(ctx, asyncCtxKey{}, pollInterval)
+}
+
+func asyncPollInterval(ctx context.Context) (time.Duration, bool) {
+	d, ok := ctx.Value(asyncCtxKey{}).(time.Duration)
+	return d, ok
+}
+
+type asyncRequest struct {
+	Query        string           `json:"query"`
+	Parameters   []DruidParameter `json:"parameters"`
+	ResultFormat string           `json:"resultFormat"`
+	Context      map[string]any   `json:"context"`
+}
+
+// asyncStatus is the status of a query submitted to the statements API.
+// See https://druid.apache.org/docs/latest/api-reference/sql-api#query-from-deep-storage.
+type asyncStatus struct {
+	QueryID string `json:"queryId"`
+	State   string `json:"state"`
+	Schema  []struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"schema"`
+	Result *struct {
+		NumTotalRows int64 `json:"numTotalRows"`
+		Pages        []struct {
+			ID      int   `json:"id"`
+			NumRows int64 `json:"numRows"`
+		} `json:"pages"`
+	} `json:"result"`
+	ErrorDetails *struct {
+		Error        string `json:"error"`
+		ErrorCode    string `json:"errorCode"`
+		ErrorMessage string `json:"errorMessage"`
+	} `json:"errorDetails"`
+}
+
+// queryAsync submits the query to the statements API, waits for it to complete and returns rows that page through its results.
+func (c *sqlConnection) queryAsync(ctx context.Context, query string, args []driver.NamedValue, pollInterval time.Duration) (driver.Rows, error) {
+	dr := newDruidRequest(ctx, query, args)
+	queryCtx := map[string]any{
+		"executionMode":     "ASYNC",
+		"selectDestination": "durableStorage",
+		"sqlQueryId":        dr.Context.SQLQueryID,
+	}
+	if dr.Context.QueryTag != "" {
+		queryCtx[queryTagContextKey] = dr.Context.QueryTag
+	}
+	b, err := json.Marshal(&asyncRequest{
+		Query:        dr.Query,
+		Parameters:   dr.Parameters,
+		ResultFormat: "array",
+		Context:      queryCtx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := strings.TrimSuffix(c.dsn, "/") + "/statements"
+
+	var status asyncStatus
+	err = c.doJSON(ctx, http.MethodPost, endpoint, bytes.NewReader(b), &status)
+	if err != nil {
+		return nil, err
+	}
+	statusURL := endpoint + "/" + status.QueryID
+
+	// Cancel the query if the context is cancelled or polling fails before it completes
+	cancelQuery := func() {
+		tctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = c.doJSON(tctx, http.MethodDelete, statusURL, http.NoBody, nil)
+	}
+	stop := context.AfterFunc(ctx, cancelQuery)
+
+	for status.State == "ACCEPTED" || status.State == "RUNNING" {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+
+		status = asyncStatus{}
+		err = c.doJSON(ctx, http.MethodGet, statusURL, http.NoBody, &status)
+		if err != nil {
+			if stop() {
+				go cancelQuery()
+			}
+			return nil, err
+		}
+	}
+	stop()
+
+	if status.State != "SUCCESS" {
+		if status.ErrorDetails != nil {
+			return nil, fmt.Errorf("druid async query %s: %s: %s", strings.ToLower(status.State), status.ErrorDetails.ErrorCode, status.ErrorDetails.ErrorMessage)
+		}
+		return nil, fmt.Errorf("druid async query ended in state %q", status.State)
+	}
+
+	rows := &asyncRows{
+		conn:       c,
+		ctx:        ctx,
+		resultsURL: statusURL + "/results",
+	}
+	for _, col := range status.Schema {
+		rows.columns = append(rows.columns, col.Name)
+		rows.types = append(rows.types, col.Type)
+		rows.transformers = append(rows.transformers, transformer(col.Type))
+	}
+	if status.Result != nil {
+		for _, p := range status.Result.Pages {
+			rows.pages = append(rows.pages, p.ID)
+		}
+	}
+	return rows, nil
+}
+
+// doJSON sends a request to the statements API and decodes the JSON response into res (if not nil).
+func (c *sqlConnection) doJSON(ctx context.Context, method, url string, body io.Reader, res any) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var ne nativeError
+		data, _ := io.ReadAll(resp.Body)
+		if json.Unmarshal(data, &ne) == nil && ne.ErrorMessage != "" {
+			return fmt.Errorf("druid async query failed: %s: %s", ne.Error, ne.ErrorMessage)
+		}
+		return fmt.Errorf("unexpected status code: %d, status: %s", resp.StatusCode, resp.Status)
+	}
+
+	if res == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
+// asyncRows streams the results of a completed async query one page at a time.
+// The pages are only fetched from durable storage when the previous page has been consumed.
+type asyncRows struct {
+	conn         *sqlConnection
+	ctx          context.Context
+	resultsURL   string
+	columns      []string
+	types        []string
+	transformers []func(any) (any, error)
+	pages        []int
+	body         io.ReadCloser
+	dec          *json.Decoder
+}
+
+var (
+	_ driver.Rows                           = &asyncRows{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &asyncRows{}
+)
+
+func (ar *asyncRows) Columns() []string {
+	return ar.columns
+}
+
+func (ar *asyncRows) Close() error {
+	if ar.body == nil {
+		return nil
+	}
+	err := ar.body.Close()
+	ar.body = nil
+	ar.dec = nil
+	return err
+}
+
+func (ar *asyncRows) Next(dest []driver.Value) error {
+	for {
+		if ar.dec == nil {
+			if len(ar.pages) == 0 {
+				return io.EOF
+			}
+			if err := ar.openPage(ar.pages[0]); err != nil {
+				return err
+			}
+			ar.pages = ar.pages[1:]
+		}
+
+		if !ar.dec.More() {
+			if err := ar.Close(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var vals []any
+		if err := ar.dec.Decode(&vals); err != nil {
+			return err
+		}
+		for i, v := range vals {
+			v, err := ar.transformers[i](v)
+			if err != nil {
+				return err
+			}
+			dest[i] = v
+		}
+		return nil
+	}
+}
+
+// openPage starts streaming a page of results. The results are a JSON array of rows, so it consumes the opening bracket.
+func (ar *asyncRows) openPage(page int) error {
+	req, err := http.NewRequestWithContext(ar.ctx, http.MethodGet, fmt.Sprintf("%s?page=%d&resultFormat=array", ar.resultsURL, page), http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := ar.conn.client.Do(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return fmt.Errorf("failed to fetch page %d of druid async query results: %s", page, resp.Status)
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	if _, err := dec.Token(); err != nil {
+		resp.Body.Close()
+		return err
+	}
+	ar.body = resp.Body
+	ar.dec = dec
+	return nil
+}
+
+func (ar *asyncRows) ColumnTypeScanType(index int) reflect.Type {
+	return scanType(ar.types[index])
+}
+
+func (ar *asyncRows) ColumnTypeDatabaseTypeName(index int) string {
+	return ar.types[index]
+}
@@ -0,0 +1,93 @@
+package druidsqldriver
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncQuery(t *testing.T) {
+	var polls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /druid/v2/sql/statements", func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "SELECT pub, COUNT(*) FROM ad_bids GROUP BY 1", req["query"])
+		require.Equal(t, "ASYNC", req["context"].(map[string]any)["executionMode"])
+		_, _ = w.Write([]byte(`{"queryId":"q1","state":"ACCEPTED"}`))
+	})
+	mux.HandleFunc("GET /druid/v2/sql/statements/q1", func(w http.ResponseWriter, r *http.Request) {
+		if polls.Add(1) < 2 {
+			_, _ = w.Write([]byte(`{"queryId":"q1","state":"RUNNING"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"queryId":"q1",
+			"state":"SUCCESS",
+			"schema":[{"name":"pub","type":"VARCHAR"},{"name":"n","type":"BIGINT"}],
+			"result":{"numTotalRows":3,"pages":[{"id":0,"numRows":2},{"id":1,"numRows":1}]}
+		}`))
+	})
+	mux.HandleFunc("GET /druid/v2/sql/statements/q1/results", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "0":
+			_, _ = w.Write([]byte(`[["Google",10],["Yahoo",4]]`))
+		case "1":
+			_, _ = w.Write([]byte(`[[null,1]]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	db, err := sql.Open("druid", srv.URL+"/druid/v2/sql")
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := WithAsync(context.Background(), time.Millisecond)
+	rows, err := db.QueryContext(ctx, "SELECT pub, COUNT(*) FROM ad_bids GROUP BY 1")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	require.NoError(t, err)
+	require.Equal(t, []string{"pub", "n"}, cols)
+
+	var pubs []sql.NullString
+	var counts []int64
+	for rows.Next() {
+		var pub sql.NullString
+		var n int64
+		require.NoError(t, rows.Scan(&pub, &n))
+		pubs = append(pubs, pub)
+		counts = append(counts, n)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []int64{10, 4, 1}, counts)
+	require.False(t, pubs[2].Valid)
+	require.Equal(t, int32(2), polls.Load())
+}
+
+func TestAsyncQueryFailed(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /druid/v2/sql/statements", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"queryId":"q2","state":"FAILED","errorDetails":{"error":"druidException","errorCode":"TooManyWarnings","errorMessage":"Too many warnings"}}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	db, err := sql.Open("druid", srv.URL+"/druid/v2/sql")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.QueryContext(WithAsync(context.Background(), 0), "SELECT 1")
+	require.ErrorContains(t, err, "Too many warnings")
+}
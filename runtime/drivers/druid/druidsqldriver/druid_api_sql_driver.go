@@ -41,7 +41,14 @@ type sqlConnection struct {
 var _ driver.QueryerContext = &sqlConnection{}
 
 func (c *sqlConnection) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
-	dr := newDruidRequest(query, args)
+	if IsNativeQuery(query) {
+		return c.queryNative(ctx, query, args)
+	}
+	if pollInterval, ok := asyncPollInterval(ctx); ok {
+		return c.queryAsync(ctx, query, args, pollInterval)
+	}
+
+	dr := newDruidRequest(ctx, query, args)
 	b, err := json.Marshal(dr)
 	if err != nil {
 		return nil, err
@@ -105,114 +112,7 @@ func (c *sqlConnection) QueryContext(ctx context.Context, query string, args []d
 
 		transformers := make([]func(any) (any, error), len(columns))
 		for i, c := range types {
-			transformers[i] = identityTransformer
-			switch c {
-			case "TINYINT":
-				transformers[i] = func(v any) (any, error) {
-					switch v := v.(type) {
-					case float64:
-						return int8(v), nil
-					default:
-						return v, nil
-					}
-				}
-			case "SMALLINT":
-				transformers[i] = func(v any) (any, error) {
-					switch v := v.(type) {
-					case float64:
-						return int16(v), nil
-					default:
-						return v, nil
-					}
-				}
-			case "INTEGER":
-				transformers[i] = func(v any) (any, error) {
-					switch v := v.(type) {
-					case float64:
-						return int32(v), nil
-					default:
-						return v, nil
-					}
-				}
-			case "BIGINT":
-				transformers[i] = func(v any) (any, error) {
-					switch v := v.(type) {
-					case float64:
-						return int64(v), nil
-					default:
-						return v, nil
-					}
-				}
-			case "FLOAT":
-				transformers[i] = func(v any) (any, error) {
-					switch v := v.(type) {
-					case float64:
-						return float32(v), nil
-					case string:
-						return strconv.ParseFloat(v, 32)
-					default:
-						return v, nil
-					}
-				}
-			case "DOUBLE":
-				transformers[i] = func(v any) (any, error) {
-					switch v := v.(type) {
-					case string:
-						return strconv.ParseFloat(v, 64)
-					default:
-						return v, nil
-					}
-				}
-			case "REAL":
-				transformers[i] = func(v any) (any, error) {
-					switch v := v.(type) {
-					case string:
-						return strconv.ParseFloat(v, 64)
-					default:
-						return v, nil
-					}
-				}
-			case "DECIMAL":
-				transformers[i] = func(v any) (any, error) {
-					switch v := v.(type) {
-					case string:
-						return strconv.ParseFloat(v, 64)
-					default:
-						return v, nil
-					}
-				}
-			case "TIMESTAMP":
-				transformers[i] = func(v any) (any, error) {
-					switch v := v.(type) {
-					case string:
-						t, err := time.Parse(time.RFC3339, v)
-						if err != nil {
-							return nil, err
-						}
-						return t, nil
-					default:
-						return v, nil
-					}
-				}
-			case "ARRAY":
-				transformers[i] = func(v any) (any, error) {
-					var l []any
-					err := json.Unmarshal([]byte(v.(string)), &l)
-					if err != nil {
-						return nil, err
-					}
-					return l, nil
-				}
-			case "OTHER":
-				transformers[i] = func(v any) (any, error) {
-					var l map[string]any
-					err := json.Unmarshal([]byte(v.(string)), &l)
-					if err != nil {
-						return nil, err
-					}
-					return l, nil
-				}
-			}
+			transformers[i] = transformer(c)
 		}
 
 		druidRows := &druidRows{
@@ -271,7 +171,12 @@ func (dr *druidRows) Next(dest []driver.Value) error {
 }
 
 func (dr *druidRows) ColumnTypeScanType(index int) reflect.Type {
-	switch dr.types[index] {
+	return scanType(dr.types[index])
+}
+
+// scanType returns the Go type that values of a Druid SQL type are scanned into.
+func scanType(typ string) reflect.Type {
+	switch typ {
 	case "BOOLEAN":
 		return reflect.TypeOf(true)
 	case "TINYINT":
@@ -338,6 +243,23 @@ func (s *stmt) NumInput() int {
 
 type DruidQueryContext struct {
 	SQLQueryID string `json:"sqlQueryId"`
+	QueryTag   string `json:"rillQueryTag,omitempty"`
+}
+
+// queryTagContextKey is the Druid query context key that WithQueryTag sets.
+// Druid includes the query context in its request logs, which makes it possible to attribute load to a tag.
+const queryTagContextKey = "rillQueryTag"
+
+type queryTagCtxKey struct{}
+
+// WithQueryTag returns a context that adds the tag to the Druid query context of queries run with it.
+func WithQueryTag(ctx context.Context, tag string) context.Context {
+	return context.WithValue(ctx, queryTagCtxKey{}, tag)
+}
+
+func queryTag(ctx context.Context) string {
+	tag, _ := ctx.Value(queryTagCtxKey{}).(string)
+	return tag
 }
 
 type DruidParameter struct {
@@ -354,7 +276,7 @@ type DruidRequest struct {
 	Context        DruidQueryContext `json:"context"`
 }
 
-func newDruidRequest(query string, args []driver.NamedValue) *DruidRequest {
+func newDruidRequest(ctx context.Context, query string, args []driver.NamedValue) *DruidRequest {
 	parameters := make([]DruidParameter, len(args))
 	for i, arg := range args {
 		parameters[i] = DruidParameter{
@@ -370,6 +292,7 @@ func newDruidRequest(query string, args []driver.NamedValue) *DruidRequest {
 		Parameters:     parameters,
 		Context: DruidQueryContext{
 			SQLQueryID: uuid.New().String(),
+			QueryTag:   queryTag(ctx),
 		},
 	}
 }
@@ -382,6 +305,118 @@ func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
 	return nil, fmt.Errorf("unsupported")
 }
 
+// transformer returns a function that converts values of a Druid SQL type decoded from JSON to their Go representation.
+func transformer(typ string) func(any) (any, error) {
+	switch typ {
+	case "TINYINT":
+		return func(v any) (any, error) {
+			switch v := v.(type) {
+			case float64:
+				return int8(v), nil
+			default:
+				return v, nil
+			}
+		}
+	case "SMALLINT":
+		return func(v any) (any, error) {
+			switch v := v.(type) {
+			case float64:
+				return int16(v), nil
+			default:
+				return v, nil
+			}
+		}
+	case "INTEGER":
+		return func(v any) (any, error) {
+			switch v := v.(type) {
+			case float64:
+				return int32(v), nil
+			default:
+				return v, nil
+			}
+		}
+	case "BIGINT":
+		return func(v any) (any, error) {
+			switch v := v.(type) {
+			case float64:
+				return int64(v), nil
+			default:
+				return v, nil
+			}
+		}
+	case "FLOAT":
+		return func(v any) (any, error) {
+			switch v := v.(type) {
+			case float64:
+				return float32(v), nil
+			case string:
+				return strconv.ParseFloat(v, 32)
+			default:
+				return v, nil
+			}
+		}
+	case "DOUBLE":
+		return func(v any) (any, error) {
+			switch v := v.(type) {
+			case string:
+				return strconv.ParseFloat(v, 64)
+			default:
+				return v, nil
+			}
+		}
+	case "REAL":
+		return func(v any) (any, error) {
+			switch v := v.(type) {
+			case string:
+				return strconv.ParseFloat(v, 64)
+			default:
+				return v, nil
+			}
+		}
+	case "DECIMAL":
+		return func(v any) (any, error) {
+			switch v := v.(type) {
+			case string:
+				return strconv.ParseFloat(v, 64)
+			default:
+				return v, nil
+			}
+		}
+	case "TIMESTAMP":
+		return func(v any) (any, error) {
+			switch v := v.(type) {
+			case string:
+				t, err := time.Parse(time.RFC3339, v)
+				if err != nil {
+					return nil, err
+				}
+				return t, nil
+			default:
+				return v, nil
+			}
+		}
+	case "ARRAY":
+		return func(v any) (any, error) {
+			var l []any
+			err := json.Unmarshal([]byte(v.(string)), &l)
+			if err != nil {
+				return nil, err
+			}
+			return l, nil
+		}
+	case "OTHER":
+		return func(v any) (any, error) {
+			var l map[string]any
+			err := json.Unmarshal([]byte(v.(string)), &l)
+			if err != nil {
+				return nil, err
+			}
+			return l, nil
+		}
+	}
+	return identityTransformer
+}
+
 func identityTransformer(v any) (any, error) {
 	return v, nil
 }
@@ -0,0 +1,285 @@
+package druidsqldriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NativeTimeColumn is the name of the column that holds the result timestamp of native timeseries queries.
+const NativeTimeColumn = "__time"
+
+// nativeIntermediatePrefix marks aggregations that are only used as inputs to post-aggregations (e.g. sketches).
+// They are not returned as columns.
+const nativeIntermediatePrefix = "__"
+
+var sqlEndpointRegex = regexp.MustCompile(`/v2/sql/?$`)
+
+// IsNativeQuery returns true if the query is a native Druid query (a JSON object) instead of SQL.
+func IsNativeQuery(query string) bool {
+	return strings.HasPrefix(strings.TrimSpace(query), "{")
+}
+
+// nativeQuery is the subset of a native Druid query needed to derive the result columns.
+type nativeQuery struct {
+	QueryType        string            `json:"queryType"`
+	Dimension        json.RawMessage   `json:"dimension"`
+	Aggregations     []nativeAggregate `json:"aggregations"`
+	PostAggregations []nativeAggregate `json:"postAggregations"`
+}
+
+type nativeAggregate struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type nativeError struct {
+	Error        string `json:"error"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// queryNative runs a native JSON query against the native query endpoint and flattens the results into rows.
+// Only topN and timeseries queries are supported since their result columns can be derived from the query.
+func (c *sqlConnection) queryNative(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if len(args) > 0 {
+		return nil, fmt.Errorf("native queries don't support arguments")
+	}
+
+	var nq nativeQuery
+	if err := json.Unmarshal([]byte(query), &nq); err != nil {
+		return nil, fmt.Errorf("invalid native query: %w", err)
+	}
+	columns, types, err := nq.columns()
+	if err != nil {
+		return nil, err
+	}
+
+	// Set a query ID so the query can be cancelled
+	var req map[string]any
+	if err := json.Unmarshal([]byte(query), &req); err != nil {
+		return nil, fmt.Errorf("invalid native query: %w", err)
+	}
+	queryCtx, _ := req["context"].(map[string]any)
+	if queryCtx == nil {
+		queryCtx = map[string]any{}
+	}
+	queryID := uuid.New().String()
+	queryCtx["queryId"] = queryID
+	if tag := queryTag(ctx); tag != "" {
+		queryCtx[queryTagContextKey] = tag
+	}
+	req["context"] = queryCtx
+
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := sqlEndpointRegex.ReplaceAllString(c.dsn, "/v2")
+	context.AfterFunc(ctx, func() {
+		tctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		r, err := http.NewRequestWithContext(tctx, http.MethodDelete, endpoint+"/"+queryID, http.NoBody)
+		if err != nil {
+			return
+		}
+
+		resp, err := c.client.Do(r)
+		if err != nil {
+			return
+		}
+		resp.Body.Close()
+	})
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Add("Content-Type", "application/json")
+
+	resp, err := c.client.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		var ne nativeError
+		if json.Unmarshal(body, &ne) == nil && (ne.ErrorMessage != "" || ne.Error != "") {
+			return nil, fmt.Errorf("druid native query failed: %s: %s", ne.Error, ne.ErrorMessage)
+		}
+		return nil, fmt.Errorf("unexpected status code: %d, status: %s", resp.StatusCode, resp.Status)
+	}
+
+	var results []struct {
+		Timestamp string          `json:"timestamp"`
+		Result    json.RawMessage `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+
+	var events []map[string]any
+	var timestamps []string
+	for _, res := range results {
+		switch nq.QueryType {
+		case "topN":
+			var rs []map[string]any
+			if err := json.Unmarshal(res.Result, &rs); err != nil {
+				return nil, err
+			}
+			events = append(events, rs...)
+		case "timeseries":
+			var r map[string]any
+			if err := json.Unmarshal(res.Result, &r); err != nil {
+				return nil, err
+			}
+			events = append(events, r)
+			timestamps = append(timestamps, res.Timestamp)
+		}
+	}
+
+	rows := make([][]driver.Value, len(events))
+	for i, ev := range events {
+		row := make([]driver.Value, len(columns))
+		for j, col := range columns {
+			if nq.QueryType == "timeseries" && col == NativeTimeColumn {
+				t, err := time.Parse(time.RFC3339, timestamps[i])
+				if err != nil {
+					return nil, err
+				}
+				row[j] = t
+				continue
+			}
+			v := ev[col]
+			if f, ok := v.(float64); ok && types[j] == "BIGINT" {
+				v = int64(f)
+			}
+			row[j] = v
+		}
+		rows[i] = row
+	}
+
+	return &nativeRows{
+		columns: columns,
+		types:   types,
+		rows:    rows,
+	}, nil
+}
+
+// columns returns the names and Druid SQL types of the result columns of the native query.
+// Columns are returned in the order: dimension or time, aggregations, post-aggregations.
+func (q *nativeQuery) columns() ([]string, []string, error) {
+	var columns, types []string
+	switch q.QueryType {
+	case "topN":
+		name, err := q.dimensionName()
+		if err != nil {
+			return nil, nil, err
+		}
+		columns = append(columns, name)
+		types = append(types, "VARCHAR")
+	case "timeseries":
+		columns = append(columns, NativeTimeColumn)
+		types = append(types, "TIMESTAMP")
+	default:
+		return nil, nil, fmt.Errorf("unsupported native query type %q", q.QueryType)
+	}
+
+	for _, agg := range q.Aggregations {
+		if strings.HasPrefix(agg.Name, nativeIntermediatePrefix) {
+			continue
+		}
+		columns = append(columns, agg.Name)
+		types = append(types, aggregatorType(agg.Type))
+	}
+	for _, agg := range q.PostAggregations {
+		if strings.HasPrefix(agg.Name, nativeIntermediatePrefix) {
+			continue
+		}
+		columns = append(columns, agg.Name)
+		types = append(types, "DOUBLE")
+	}
+
+	return columns, types, nil
+}
+
+// dimensionName returns the output name of the query's dimension, which is either a plain string or a dimension spec.
+func (q *nativeQuery) dimensionName() (string, error) {
+	var name string
+	if err := json.Unmarshal(q.Dimension, &name); err == nil {
+		return name, nil
+	}
+
+	var spec struct {
+		Dimension  string `json:"dimension"`
+		OutputName string `json:"outputName"`
+	}
+	if err := json.Unmarshal(q.Dimension, &spec); err != nil {
+		return "", fmt.Errorf("invalid native query dimension: %w", err)
+	}
+	if spec.OutputName != "" {
+		return spec.OutputName, nil
+	}
+	return spec.Dimension, nil
+}
+
+// aggregatorType returns the Druid SQL type of a native aggregator's output.
+func aggregatorType(typ string) string {
+	switch typ {
+	case "count", "longSum", "longMin", "longMax", "longFirst", "longLast":
+		return "BIGINT"
+	default:
+		return "DOUBLE"
+	}
+}
+
+// nativeRows holds the flattened, fully buffered results of a native query.
+// Native topN and timeseries results are small, so there's no need to stream them.
+type nativeRows struct {
+	columns []string
+	types   []string
+	rows    [][]driver.Value
+	pos     int
+}
+
+var (
+	_ driver.Rows                           = &nativeRows{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &nativeRows{}
+)
+
+func (nr *nativeRows) Columns() []string {
+	return nr.columns
+}
+
+func (nr *nativeRows) Close() error {
+	return nil
+}
+
+func (nr *nativeRows) Next(dest []driver.Value) error {
+	if nr.pos >= len(nr.rows) {
+		return io.EOF
+	}
+	copy(dest, nr.rows[nr.pos])
+	nr.pos++
+	return nil
+}
+
+func (nr *nativeRows) ColumnTypeScanType(index int) reflect.Type {
+	return scanType(nr.types[index])
+}
+
+func (nr *nativeRows) ColumnTypeDatabaseTypeName(index int) string {
+	return nr.types[index]
+}
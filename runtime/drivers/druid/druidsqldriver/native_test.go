@@ -0,0 +1,123 @@
+package druidsqldriver
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNativeTopN(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/druid/v2", r.URL.Path)
+
+		var req map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.NotEmpty(t, req["context"].(map[string]any)["queryId"])
+
+		_, _ = w.Write([]byte(`[{"timestamp":"2024-01-01T00:00:00.000Z","result":[
+			{"publisher":"Google","total":10,"__p50":"AgMIGo","p50":1.5},
+			{"publisher":null,"total":4,"__p50":"AgMIGo","p50":0.5}
+		]}]`))
+	}))
+	defer srv.Close()
+
+	db, err := sql.Open("druid", srv.URL+"/druid/v2/sql")
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows, err := db.Query(`{
+		"queryType": "topN",
+		"dataSource": "ad_bids",
+		"dimension": {"type": "default", "dimension": "pub", "outputName": "publisher"},
+		"metric": "total",
+		"threshold": 2,
+		"intervals": ["1000-01-01/3000-01-01"],
+		"aggregations": [
+			{"type": "longSum", "name": "total", "fieldName": "bids"},
+			{"type": "quantilesDoublesSketch", "name": "__p50", "fieldName": "price"}
+		],
+		"postAggregations": [
+			{"type": "quantilesDoublesSketchToQuantile", "name": "p50", "field": {"type": "fieldAccess", "fieldName": "__p50"}, "fraction": 0.5}
+		]
+	}`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	require.NoError(t, err)
+	require.Equal(t, []string{"publisher", "total", "p50"}, cols)
+
+	types, err := rows.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, "VARCHAR", types[0].DatabaseTypeName())
+	require.Equal(t, "BIGINT", types[1].DatabaseTypeName())
+	require.Equal(t, "DOUBLE", types[2].DatabaseTypeName())
+
+	var pubs []sql.NullString
+	var totals []int64
+	for rows.Next() {
+		var pub sql.NullString
+		var total int64
+		var p50 float64
+		require.NoError(t, rows.Scan(&pub, &total, &p50))
+		pubs = append(pubs, pub)
+		totals = append(totals, total)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []sql.NullString{{String: "Google", Valid: true}, {}}, pubs)
+	require.Equal(t, []int64{10, 4}, totals)
+}
+
+func TestNativeTimeseries(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"timestamp":"2024-01-01T00:00:00.000Z","result":{"n":3}},
+			{"timestamp":"2024-01-02T00:00:00.000Z","result":{"n":5}}
+		]`))
+	}))
+	defer srv.Close()
+
+	db, err := sql.Open("druid", srv.URL+"/druid/v2/sql")
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows, err := db.Query(`{"queryType":"timeseries","dataSource":"ad_bids","granularity":"day","intervals":["1000-01-01/3000-01-01"],"aggregations":[{"type":"count","name":"n"}]}`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var ts []time.Time
+	var ns []int64
+	for rows.Next() {
+		var t0 time.Time
+		var n int64
+		require.NoError(t, rows.Scan(&t0, &n))
+		ts = append(ts, t0)
+		ns = append(ns, n)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []int64{3, 5}, ns)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), ts[1].UTC())
+}
+
+func TestNativeError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"Unknown exception","errorMessage":"Unknown datasource"}`))
+	}))
+	defer srv.Close()
+
+	db, err := sql.Open("druid", srv.URL+"/druid/v2/sql")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Query(`{"queryType":"timeseries","dataSource":"missing","intervals":["1000-01-01/3000-01-01"]}`)
+	require.ErrorContains(t, err, "Unknown datasource")
+
+	_, err = db.Query(`{"queryType":"scan","dataSource":"missing"}`)
+	require.ErrorContains(t, err, `unsupported native query type "scan"`)
+}
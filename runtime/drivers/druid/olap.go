@@ -10,6 +10,10 @@ import (
 	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/druid/druidsqldriver"
+	"github.com/rilldata/rill/runtime/pkg/querycost"
+	"github.com/rilldata/rill/runtime/pkg/runningquery"
+	"github.com/rilldata/rill/runtime/pkg/slowquery"
 	"go.uber.org/zap"
 )
 
@@ -69,12 +73,15 @@ func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
 	return res.Close()
 }
 
-func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*drivers.Result, error) {
+func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res *drivers.Result, outErr error) {
 	// Log query if enabled (usually disabled)
 	if c.config.LogQueries {
 		c.logger.Info("druid query", zap.String("sql", stmt.Query), zap.Any("args", stmt.Args))
 	}
 
+	ctx, span := drivers.StartQuerySpan(ctx, drivers.DialectDruid, "", stmt)
+	defer func() { drivers.EndQuerySpan(span, outErr) }()
+
 	if stmt.DryRun {
 		rows, err := c.db.QueryxContext(ctx, "EXPLAIN PLAN FOR "+stmt.Query, stmt.Args...)
 		if err != nil {
@@ -89,38 +96,70 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*dri
 		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
 	}
 
+	// Forward the query tag to Druid's query context
+	if tag := drivers.QueryTagFromContext(ctx); tag != "" {
+		ctx = druidsqldriver.WithQueryTag(ctx, tag)
+	}
+
+	// Long running queries use the async API, which stores results in durable storage instead of streaming them over a single HTTP request.
+	if stmt.LongRunning && c.config.AsyncQueries && !druidsqldriver.IsNativeQuery(stmt.Query) {
+		ctx = druidsqldriver.WithAsync(ctx, c.config.AsyncPollInterval)
+	}
+
+	conn, err := drivers.AcquireConn(ctx, c.config.PoolConfig, "druid", c.db.Connx)
+	if err != nil {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil, err
+	}
+
+	// Track the query while it's running, so it can be listed and cancelled
+	ctx, _, done := runningquery.Start(ctx, stmt.Query)
+
 	var rows *sqlx.Rows
-	var err error
 
+	start := time.Now()
 	re := retrier.New(retrier.ExponentialBackoff(numRetries, retryWait), retryErrClassifier{})
 	err = re.RunCtx(ctx, func(ctx2 context.Context) error {
-		rows, err = c.db.QueryxContext(ctx2, stmt.Query, stmt.Args...)
+		rows, err = conn.QueryxContext(ctx2, stmt.Query, stmt.Args...)
 		return err
 	})
 	if err != nil {
+		err = runningquery.Err(ctx, err)
+		_ = conn.Close()
 		if cancelFunc != nil {
 			cancelFunc()
 		}
+		done()
 		return nil, err
 	}
 
+	elapsed := time.Since(start)
+	querycost.RecordQuery(ctx, elapsed)
+	slowquery.Observe(ctx, stmt.Query, elapsed)
+
 	schema, err := rowsToSchema(rows)
 	if err != nil {
 		rows.Close()
+		_ = conn.Close()
 		if cancelFunc != nil {
 			cancelFunc()
 		}
+		done()
 
 		return nil, err
 	}
 
 	r := &drivers.Result{Rows: rows, Schema: schema}
 	r.SetCleanupFunc(func() error {
+		err := conn.Close()
 		if cancelFunc != nil {
 			cancelFunc()
 		}
+		done()
 
-		return nil
+		return err
 	})
 
 	return r, nil
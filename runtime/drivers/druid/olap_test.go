@@ -0,0 +1,67 @@
+package druid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/runningquery"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestExecuteRunningQueries(t *testing.T) {
+	// Serves "SELECT 1" immediately and blocks any other query until the request is cancelled
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		var req struct {
+			Query string `json:"query"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req.Query != "SELECT 1" {
+			<-r.Context().Done()
+			return
+		}
+		_, _ = w.Write([]byte("[\"x\"]\n[\"INTEGER\"]\n[1]\n"))
+	}))
+	defer srv.Close()
+
+	handle, err := driver{}.Open("default", map[string]any{"dsn": srv.URL + "/druid/v2/sql"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer handle.Close()
+	olap, ok := handle.AsOLAP("default")
+	require.True(t, ok)
+
+	reg := runningquery.New()
+	ctx := runningquery.WithRegistry(context.Background(), reg, runningquery.Source{InstanceID: "default"})
+	res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT 1"})
+	require.NoError(t, err)
+	qs := reg.List("default")
+	require.Len(t, qs, 1)
+	require.Equal(t, "SELECT 1", qs[0].SQL)
+	require.NoError(t, res.Close())
+	require.Empty(t, reg.List("default"))
+
+	// Cancel a slow query while it's running
+	go func() {
+		for {
+			qs := reg.List("default")
+			if len(qs) > 0 {
+				reg.Cancel("default", qs[0].ID)
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	err = olap.Exec(ctx, &drivers.Statement{Query: "SELECT COUNT(*) FROM slow"})
+	require.ErrorIs(t, err, runningquery.ErrCancelled)
+	require.Empty(t, reg.List("default"))
+}
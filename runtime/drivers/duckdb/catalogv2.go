@@ -188,3 +188,90 @@ func (c *connection) DeleteResources(ctx context.Context) error {
 
 	return nil
 }
+
+func (c *connection) FindMetricsViewVersions(ctx context.Context, metricsView string, limit int) ([]*drivers.MetricsViewVersion, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	rows, err := conn.QueryxContext(
+		ctx,
+		"SELECT metrics_view, refreshed_on, row_count, schema, created_on FROM rill.metrics_view_versions WHERE lower(metrics_view)=lower(?) ORDER BY refreshed_on DESC LIMIT ?",
+		metricsView,
+		limit,
+	)
+	if err != nil {
+		return nil, c.checkErr(err)
+	}
+	defer rows.Close()
+
+	var res []*drivers.MetricsViewVersion
+	for rows.Next() {
+		v := &drivers.MetricsViewVersion{}
+		err := rows.Scan(&v.MetricsView, &v.RefreshedOn, &v.RowCount, &v.Schema, &v.CreatedOn)
+		if err != nil {
+			return nil, c.checkErr(err)
+		}
+		res = append(res, v)
+	}
+
+	if rows.Err() != nil {
+		return nil, c.checkErr(rows.Err())
+	}
+
+	return res, nil
+}
+
+func (c *connection) InsertMetricsViewVersion(ctx context.Context, v *drivers.MetricsViewVersion, retain int) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	_, err = conn.ExecContext(
+		ctx,
+		"INSERT INTO rill.metrics_view_versions(metrics_view, refreshed_on, row_count, schema, created_on) VALUES (?, ?, ?, ?, ?)",
+		v.MetricsView,
+		v.RefreshedOn,
+		v.RowCount,
+		v.Schema,
+		time.Now(),
+	)
+	if err != nil {
+		return c.checkErr(err)
+	}
+
+	// Delete versions older than the retained ones. The subquery returns NULL (deleting nothing) if there are fewer versions than retained.
+	_, err = conn.ExecContext(
+		ctx,
+		`DELETE FROM rill.metrics_view_versions WHERE lower(metrics_view)=lower(?) AND refreshed_on < (
+			SELECT refreshed_on FROM rill.metrics_view_versions WHERE lower(metrics_view)=lower(?) ORDER BY refreshed_on DESC LIMIT 1 OFFSET ?
+		)`,
+		v.MetricsView,
+		v.MetricsView,
+		retain-1,
+	)
+	if err != nil {
+		return c.checkErr(err)
+	}
+
+	return nil
+}
+
+func (c *connection) DeleteMetricsViewVersions(ctx context.Context, metricsView string) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	_, err = conn.ExecContext(ctx, "DELETE FROM rill.metrics_view_versions WHERE lower(metrics_view)=lower(?)", metricsView)
+	if err != nil {
+		return c.checkErr(err)
+	}
+
+	return nil
+}
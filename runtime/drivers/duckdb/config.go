@@ -41,6 +41,11 @@ type config struct {
 	StorageLimitBytes int64 `mapstructure:"storage_limit_bytes"`
 	// MaxMemoryOverride sets a hard override for the "max_memory" DuckDB setting
 	MaxMemoryGBOverride int `mapstructure:"max_memory_gb_override"`
+	// QueryMemoryLimitGB is the estimated amount of memory a single interactive query may use before it's cancelled. Disabled if zero.
+	// Since DuckDB doesn't report memory usage per query, the usage is estimated from the growth in total memory usage while the query is running.
+	QueryMemoryLimitGB int `mapstructure:"query_memory_limit_gb"`
+	// TempDirectory is the directory DuckDB spills to when a query doesn't fit in memory. Defaults to a "tmp" directory next to the database file.
+	TempDirectory string `mapstructure:"temp_directory"`
 	// ThreadsOverride sets a hard override for the "threads" DuckDB setting. Set to -1 for unlimited threads.
 	ThreadsOverride int `mapstructure:"threads_override"`
 	// BootQueries is queries to run on boot. Use ; to separate multiple queries. Common use case is to provide project specific memory and threads ratios.
@@ -49,6 +54,11 @@ type config struct {
 	DBFilePath string `mapstructure:"-"`
 	// DBStoragePath is the path where the database files are stored. It is inferred from the DSN (can't be provided by user).
 	DBStoragePath string `mapstructure:"-"`
+	// ReadReplicas is the number of read-only copies of the database to route metrics queries to. The copies are refreshed after writes.
+	// Replicas are only supported for file-backed databases.
+	ReadReplicas int `mapstructure:"read_replicas"`
+	// ReplicasPath is the directory where read replicas are stored. It is inferred from the DSN (can't be provided by user).
+	ReplicasPath string `mapstructure:"-"`
 	// LogQueries controls whether to log the raw SQL passed to OLAP.Execute. (Internal queries will not be logged.)
 	LogQueries bool `mapstructure:"log_queries"`
 }
@@ -87,6 +97,15 @@ func newConfig(cfgMap map[string]any) (*config, error) {
 		// Infer DBFilePath
 		cfg.DBFilePath = uri.Path
 		cfg.DBStoragePath = filepath.Dir(cfg.DBFilePath)
+
+		// Store replicas next to (not in) the storage path since it's scanned for external tables on open
+		if cfg.ReadReplicas > 0 && cfg.DBFilePath != "" {
+			storagePath, err := filepath.Abs(cfg.DBStoragePath)
+			if err != nil {
+				return nil, fmt.Errorf("could not resolve storage path: %w", err)
+			}
+			cfg.ReplicasPath = storagePath + "_replicas"
+		}
 	}
 
 	// Set memory limit
@@ -98,6 +117,12 @@ func newConfig(cfgMap map[string]any) (*config, error) {
 		qry.Add("max_memory", fmt.Sprintf("%dGB", maxMemory))
 	}
 
+	// Set spill directory.
+	// It's applied with a boot query instead of the DSN so the path doesn't need to be URL encoded.
+	if cfg.TempDirectory == "" && cfg.DBStoragePath != "" {
+		cfg.TempDirectory = filepath.Join(cfg.DBStoragePath, "tmp")
+	}
+
 	// Set threads limit
 	var threads int
 	if cfg.ThreadsOverride != 0 {
@@ -145,6 +170,14 @@ func newConfig(cfgMap map[string]any) (*config, error) {
 	return cfg, nil
 }
 
+// spillBootQueries returns queries that configure where DuckDB spills to disk when a query exceeds max_memory.
+func (c *config) spillBootQueries() []string {
+	if c.TempDirectory == "" {
+		return nil
+	}
+	return []string{fmt.Sprintf("SET temp_directory=%s", safeSQLString(c.TempDirectory))}
+}
+
 func generateDSN(path, encodedQuery string) string {
 	if encodedQuery == "" {
 		return path
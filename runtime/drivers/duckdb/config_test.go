@@ -2,9 +2,11 @@ package duckdb
 
 import (
 	"context"
+	"errors"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/rilldata/rill/runtime/drivers"
@@ -124,3 +126,44 @@ func TestOverrides(t *testing.T) {
 
 	require.Equal(t, "1.8 GiB", mem)
 }
+
+func TestSpillConfig(t *testing.T) {
+	cfg, err := newConfig(map[string]any{"data_dir": "path/to"})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("path/to", "tmp"), cfg.TempDirectory)
+	require.Equal(t, []string{"SET temp_directory='path/to/tmp'"}, cfg.spillBootQueries())
+
+	cfg, err = newConfig(map[string]any{"dsn": ":memory:"})
+	require.NoError(t, err)
+	require.Empty(t, cfg.spillBootQueries())
+
+	cfg, err = newConfig(map[string]any{"data_dir": "path/to", "temp_directory": "/spill"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"SET temp_directory='/spill'"}, cfg.spillBootQueries())
+}
+
+func TestMemoryLimitExceeded(t *testing.T) {
+	cfgMap := map[string]any{"data_dir": t.TempDir(), "boot_queries": "SET max_memory='1MB'"}
+	handle, err := Driver{}.Open("default", cfgMap, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer handle.Close()
+
+	olap, ok := handle.AsOLAP("")
+	require.True(t, ok)
+
+	_, err = olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT list(range) FROM range(10000000)"})
+	require.ErrorIs(t, err, drivers.ErrMemoryLimitExceeded)
+}
+
+func TestCheckErrMemoryLimitExceeded(t *testing.T) {
+	c := &connection{config: &config{}, dbCond: sync.NewCond(&sync.Mutex{}), logger: zap.NewNop()}
+
+	err := c.checkErr(errors.New("Out of Memory Error: failed to allocate data of size 16.0 MiB (1.0 MiB/1.0 MiB used)"))
+	require.ErrorIs(t, err, drivers.ErrMemoryLimitExceeded)
+
+	err = c.checkErr(errors.New("Binder Error: Out of Memory Error: failed to allocate data of size 16.0 MiB"))
+	require.ErrorIs(t, err, drivers.ErrMemoryLimitExceeded)
+
+	err = c.checkErr(errors.New("Binder Error: column not found"))
+	require.NotErrorIs(t, err, drivers.ErrMemoryLimitExceeded)
+}
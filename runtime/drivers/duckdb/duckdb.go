@@ -27,6 +27,7 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/duckdbsql"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/pkg/priorityqueue"
+	"github.com/rilldata/rill/runtime/pkg/runningquery"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
@@ -141,6 +142,7 @@ func (d Driver) Open(instanceID string, cfgMap map[string]any, ac *activity.Clie
 		driverConfig:   cfgMap,
 		driverName:     d.name,
 		connTimes:      make(map[int]time.Time),
+		runningQueries: make(map[*runningquery.Query]*queryMemory),
 		ctx:            ctx,
 		cancel:         cancel,
 	}
@@ -184,10 +186,35 @@ func (d Driver) Open(instanceID string, cfgMap map[string]any, ac *activity.Clie
 		return nil, err
 	}
 
+	if cfg.ReadReplicas > 0 {
+		if cfg.ReplicasPath == "" {
+			return nil, fmt.Errorf("read replicas are only supported for file-backed databases")
+		}
+		c.replicas, err = newReplicaSet(c)
+		if err != nil {
+			return nil, err
+		}
+		go c.replicas.run(ctx)
+	}
+
+	// Drop temporary tables left behind by a previous process (e.g. if it crashed while ingesting a source).
+	// We skip MotherDuck since its databases may be shared with other processes.
+	if d.name != "motherduck" {
+		c.cleanupTempTables(ctx, 0)
+	}
+
 	go c.periodicallyEmitStats(time.Minute)
 
 	go c.periodicallyCheckConnDurations(time.Minute)
 
+	if cfg.QueryMemoryLimitGB > 0 {
+		go c.periodicallyCheckQueryMemory(queryMemoryCheckInterval)
+	}
+
+	if d.name != "motherduck" {
+		go c.periodicallyCleanupTempTables(time.Hour)
+	}
+
 	return c, nil
 }
 
@@ -196,6 +223,9 @@ func (d Driver) Drop(cfgMap map[string]any, logger *zap.Logger) error {
 	if err != nil {
 		return err
 	}
+	if cfg.ReplicasPath != "" {
+		_ = os.RemoveAll(cfg.ReplicasPath)
+	}
 	if cfg.DBStoragePath != "" {
 		return os.RemoveAll(cfg.DBStoragePath)
 	}
@@ -307,6 +337,14 @@ type connection struct {
 	cancel context.CancelFunc
 	// registration should be unregistered on close
 	registration metric.Registration
+	// replicas serves read queries from read-only copies of the database (nil if not configured)
+	replicas *replicaSet
+	// tempTablesSeen tracks when temporary tables without a known creation time were first seen by cleanupTempTables
+	tempTablesMu   sync.Mutex
+	tempTablesSeen map[string]time.Time
+	// runningQueries tracks the memory usage of running interactive queries when config.QueryMemoryLimitGB is set
+	runningQueriesMu sync.Mutex
+	runningQueries   map[*runningquery.Query]*queryMemory
 }
 
 var _ drivers.OLAPStore = &connection{}
@@ -333,9 +371,33 @@ func (c *connection) Config() map[string]any {
 func (c *connection) Close() error {
 	c.cancel()
 	_ = c.registration.Unregister()
+	if c.replicas != nil {
+		c.replicas.close()
+	}
+	c.checkpoint()
 	return c.db.Close()
 }
 
+// checkpointTimeout bounds the time spent checkpointing when a connection is closed.
+const checkpointTimeout = 10 * time.Second
+
+// checkpoint merges the WAL into the database file before the handle is closed.
+// DuckDB also checkpoints on close, but doing it explicitly bounds the time it takes and surfaces failures,
+// so a shutdown that gets killed midway is less likely to leave an unmerged WAL behind.
+func (c *connection) checkpoint() {
+	if c.db == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), checkpointTimeout)
+	defer cancel()
+
+	_, err := c.db.ExecContext(ctx, "CHECKPOINT")
+	if err != nil {
+		c.logger.Warn("duckdb: checkpoint on close failed", zap.Error(err))
+	}
+}
+
 // AsRegistry Registry implements drivers.Connection.
 func (c *connection) AsRegistry() (drivers.RegistryStore, bool) {
 	return nil, false
@@ -393,7 +455,8 @@ func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecu
 			if err := mapstructure.WeakDecode(opts.OutputProperties, outputProps); err != nil {
 				return nil, false
 			}
-			if supportsExportFormat(outputProps.Format) {
+			// DuckDB's COPY only writes CSV files for the default locale, so localized CSV exports fall back to the file connector's executor.
+			if supportsExportFormat(outputProps.Format) && (outputProps.Format != drivers.FileFormatCSV || outputProps.Locale == "") {
 				return &selfToFileExecutor{c, opts}, true
 			}
 		}
@@ -449,52 +512,7 @@ func (c *connection) reopenDB() error {
 		c.db = nil
 	}
 
-	// Queries to run when a new DuckDB connection is opened.
-	var bootQueries []string
-
-	// Add custom boot queries before any other (e.g. to override the extensions repository)
-	if c.config.BootQueries != "" {
-		bootQueries = append(bootQueries, c.config.BootQueries)
-	}
-
-	// Add required boot queries
-	bootQueries = append(bootQueries,
-		"INSTALL 'json'",
-		"LOAD 'json'",
-		"INSTALL 'icu'",
-		"LOAD 'icu'",
-		"INSTALL 'parquet'",
-		"LOAD 'parquet'",
-		"INSTALL 'httpfs'",
-		"LOAD 'httpfs'",
-		"INSTALL 'sqlite'",
-		"LOAD 'sqlite'",
-		"SET max_expression_depth TO 250",
-		"SET timezone='UTC'",
-		"SET old_implicit_casting = true", // Implicit Cast to VARCHAR
-	)
-
-	// We want to set preserve_insertion_order=false in hosted environments only (where source data is never viewed directly). Setting it reduces batch data ingestion time by ~40%.
-	// Hack: Using AllowHostAccess as a proxy indicator for a hosted environment.
-	if !c.config.AllowHostAccess {
-		bootQueries = append(bootQueries, "SET preserve_insertion_order TO false")
-	}
-
-	// DuckDB extensions need to be loaded separately on each connection, but the built-in connection pool in database/sql doesn't enable that.
-	// So we use go-duckdb's custom connector to pass a callback that it invokes for each new connection.
-	connector, err := duckdb.NewConnector(c.config.DSN, func(execer driver.ExecerContext) error {
-		for _, qry := range bootQueries {
-			_, err := execer.ExecContext(context.Background(), qry, nil)
-			if err != nil && strings.Contains(err.Error(), "Failed to download extension") {
-				// Retry using another mirror. Based on: https://github.com/duckdb/duckdb/issues/9378
-				_, err = execer.ExecContext(context.Background(), qry+" FROM 'http://nightly-extensions.duckdb.org'", nil)
-			}
-			if err != nil {
-				return err
-			}
-		}
-		return nil
-	})
+	connector, err := newConnector(c.config.DSN, c.bootQueries())
 	if err != nil {
 		// Check for using incompatible database files
 		if strings.Contains(err.Error(), "Trying to read a database file with version number") {
@@ -584,6 +602,61 @@ func (c *connection) reopenDB() error {
 	return nil
 }
 
+// bootQueries returns the queries to run when a new DuckDB connection is opened.
+func (c *connection) bootQueries() []string {
+	// Spill settings go first so custom boot queries can override them.
+	bootQueries := c.config.spillBootQueries()
+
+	// Add custom boot queries before any other (e.g. to override the extensions repository)
+	if c.config.BootQueries != "" {
+		bootQueries = append(bootQueries, c.config.BootQueries)
+	}
+
+	// Add required boot queries
+	bootQueries = append(bootQueries,
+		"INSTALL 'json'",
+		"LOAD 'json'",
+		"INSTALL 'icu'",
+		"LOAD 'icu'",
+		"INSTALL 'parquet'",
+		"LOAD 'parquet'",
+		"INSTALL 'httpfs'",
+		"LOAD 'httpfs'",
+		"INSTALL 'sqlite'",
+		"LOAD 'sqlite'",
+		"SET max_expression_depth TO 250",
+		"SET timezone='UTC'",
+		"SET old_implicit_casting = true", // Implicit Cast to VARCHAR
+	)
+
+	// We want to set preserve_insertion_order=false in hosted environments only (where source data is never viewed directly). Setting it reduces batch data ingestion time by ~40%.
+	// Hack: Using AllowHostAccess as a proxy indicator for a hosted environment.
+	if !c.config.AllowHostAccess {
+		bootQueries = append(bootQueries, "SET preserve_insertion_order TO false")
+	}
+
+	return bootQueries
+}
+
+// newConnector creates a DuckDB connector that runs the boot queries on each new connection.
+func newConnector(dsn string, bootQueries []string) (*duckdb.Connector, error) {
+	// DuckDB extensions need to be loaded separately on each connection, but the built-in connection pool in database/sql doesn't enable that.
+	// So we use go-duckdb's custom connector to pass a callback that it invokes for each new connection.
+	return duckdb.NewConnector(dsn, func(execer driver.ExecerContext) error {
+		for _, qry := range bootQueries {
+			_, err := execer.ExecContext(context.Background(), qry, nil)
+			if err != nil && strings.Contains(err.Error(), "Failed to download extension") {
+				// Retry using another mirror. Based on: https://github.com/duckdb/duckdb/issues/9378
+				_, err = execer.ExecContext(context.Background(), qry+" FROM 'http://nightly-extensions.duckdb.org'", nil)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // acquireMetaConn gets a connection from the pool for "meta" queries like catalog and information schema (i.e. fast queries).
 // It returns a function that puts the connection back in the pool (if applicable).
 func (c *connection) acquireMetaConn(ctx context.Context) (*sqlx.Conn, func() error, error) {
@@ -756,10 +829,28 @@ func (c *connection) checkErr(err error) error {
 			c.dbReopen = true
 			c.logger.Error("encountered internal DuckDB error - scheduling reopen of DuckDB", zap.Error(err))
 		}
+
+		// DuckDB aborts queries that exceed max_memory and can't be spilled to disk with an "Out of Memory Error".
+		// It may be wrapped in another error (e.g. "Binder Error: ... Out of Memory Error"), so we match anywhere in the message.
+		// We surface a clearer error that callers can identify.
+		if strings.Contains(err.Error(), "Out of Memory Error") {
+			return fmt.Errorf("%w of %s: %s", drivers.ErrMemoryLimitExceeded, c.memoryLimit(), err.Error())
+		}
 	}
 	return err
 }
 
+// memoryLimit describes the memory limit configured for the database.
+func (c *connection) memoryLimit() string {
+	if c.config.MaxMemoryGBOverride > 0 {
+		return fmt.Sprintf("%dGB", c.config.MaxMemoryGBOverride)
+	}
+	if c.config.MemoryLimitGB > 0 {
+		return fmt.Sprintf("%dGB", c.config.MemoryLimitGB)
+	}
+	return "the default size"
+}
+
 // Periodically collects stats using pragma_database_size() and emits as activity events
 // nolint
 func (c *connection) periodicallyEmitStats(d time.Duration) {
@@ -3,6 +3,7 @@ package duckdb
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -246,6 +247,7 @@ func databaseTypeToPB(dbt string, nullable bool) (*runtimev1.Type, error) {
 	// Example: "DECIMAL(10,20)"
 	case "DECIMAL":
 		t.Code = runtimev1.Type_CODE_DECIMAL
+		t.DecimalType = parseDecimalArgs(args)
 	// Example: `STRUCT("a" INT, "b" INT)`
 	case "STRUCT":
 		t.Code = runtimev1.Type_CODE_STRUCT
@@ -303,6 +305,24 @@ func databaseTypeToPB(dbt string, nullable bool) (*runtimev1.Type, error) {
 	return t, nil
 }
 
+// parseDecimalArgs parses the precision and scale args of a DECIMAL type, e.g. "10,2".
+// It returns nil if the args can't be parsed.
+func parseDecimalArgs(args string) *runtimev1.DecimalType {
+	p, s, _ := strings.Cut(args, ",")
+	precision, err := strconv.ParseUint(strings.TrimSpace(p), 10, 32)
+	if err != nil {
+		return nil
+	}
+	var scale uint64
+	if s != "" {
+		scale, err = strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+		if err != nil {
+			return nil
+		}
+	}
+	return &runtimev1.DecimalType{Precision: uint32(precision), Scale: uint32(scale)}
+}
+
 // Splits a type with args in parentheses, for example:
 //
 //	`STRUCT("a" INT, "b" INT)` -> (`STRUCT`, `"a" INT, "b" INT`, true)
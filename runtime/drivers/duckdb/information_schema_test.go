@@ -64,7 +64,7 @@ func TestDatabaseTypeToPB(t *testing.T) {
 	}{
 		{
 			input:  "DECIMAL(10,20)",
-			output: &runtimev1.Type{Code: runtimev1.Type_CODE_DECIMAL, Nullable: true},
+			output: &runtimev1.Type{Code: runtimev1.Type_CODE_DECIMAL, Nullable: true, DecimalType: &runtimev1.DecimalType{Precision: 10, Scale: 20}},
 		},
 		{
 			input: `STRUCT(foo HUGEINT, "bar" STRUCT(a INTEGER, b MAP(INTEGER, BOOLEAN)), baz VARCHAR[])`,
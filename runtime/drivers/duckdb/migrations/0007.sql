@@ -0,0 +1,7 @@
+CREATE TABLE rill.metrics_view_versions (
+	metrics_view TEXT NOT NULL,
+	refreshed_on TIMESTAMPTZ NOT NULL,
+	row_count BIGINT NOT NULL,
+	schema BLOB NOT NULL,
+	created_on TIMESTAMPTZ NOT NULL
+);
@@ -18,6 +18,9 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/querycost"
+	"github.com/rilldata/rill/runtime/pkg/runningquery"
+	"github.com/rilldata/rill/runtime/pkg/slowquery"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -75,6 +78,9 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 		c.logger.Info("duckdb query", zap.String("sql", stmt.Query), zap.Any("args", stmt.Args))
 	}
 
+	ctx, span := drivers.StartQuerySpan(ctx, drivers.DialectDuckDB, c.instanceID, stmt)
+	defer func() { drivers.EndQuerySpan(span, outErr) }()
+
 	// We use the meta conn for dry run queries
 	if stmt.DryRun {
 		conn, release, err := c.acquireMetaConn(ctx)
@@ -118,6 +124,8 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 		if acquired {
 			// Only track query latency when not cancelled in queue
 			queryLatencyHistogram.Record(ctx, totalLatency-queueLatency, metric.WithAttributeSet(attrSet))
+			querycost.RecordQuery(ctx, time.Since(acquiredTime))
+			slowquery.Observe(ctx, stmt.Query, time.Since(acquiredTime))
 		}
 
 		if c.activity != nil {
@@ -130,7 +138,7 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 	}()
 
 	// Acquire connection
-	conn, release, err := c.acquireOLAPConn(ctx, stmt.Priority, stmt.LongRunning, false)
+	conn, release, err := c.acquireReadConn(ctx, stmt)
 	acquiredTime = time.Now()
 	if err != nil {
 		return nil, err
@@ -140,16 +148,22 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 	// NOTE: We can't just "defer release()" because release() will block until rows.Close() is called.
 	// We must be careful to make sure release() is called on all code paths.
 
+	// Track the query while it's running, so it can be listed and cancelled
+	ctx, done := c.startQuery(ctx, stmt)
+
 	var cancelFunc context.CancelFunc
 	if stmt.ExecutionTimeout != 0 {
 		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
 	}
 
-	rows, err := conn.QueryxContext(ctx, stmt.Query, stmt.Args...)
+	// The query tag is prepended as a comment, which makes it visible in DuckDB's profiling output
+	rows, err := conn.QueryxContext(ctx, drivers.QueryTagComment(ctx)+stmt.Query, stmt.Args...)
 	if err != nil {
+		err = runningquery.Err(ctx, err)
 		if cancelFunc != nil {
 			cancelFunc()
 		}
+		done()
 
 		// err must be checked before release
 		err = c.checkErr(err)
@@ -159,9 +173,11 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 
 	schema, err := RowsToSchema(rows)
 	if err != nil {
+		err = runningquery.Err(ctx, err)
 		if cancelFunc != nil {
 			cancelFunc()
 		}
+		done()
 
 		// err must be checked before release
 		err = c.checkErr(err)
@@ -175,6 +191,7 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 		if cancelFunc != nil {
 			cancelFunc()
 		}
+		done()
 
 		return release()
 	})
@@ -212,6 +229,7 @@ func (c *connection) EstimateSize() (int64, bool) {
 
 // AddTableColumn implements drivers.OLAPStore.
 func (c *connection) AddTableColumn(ctx context.Context, tableName, columnName, typ string) error {
+	defer c.replicas.markStale()
 	c.logger.Debug("add table column", zap.String("tableName", tableName), zap.String("columnName", columnName), zap.String("typ", typ))
 	if !c.config.ExtTableStorage {
 		return c.Exec(ctx, &drivers.Statement{
@@ -242,6 +260,7 @@ func (c *connection) AddTableColumn(ctx context.Context, tableName, columnName,
 
 // AlterTableColumn implements drivers.OLAPStore.
 func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName, newType string) error {
+	defer c.replicas.markStale()
 	c.logger.Debug("alter table column", zap.String("tableName", tableName), zap.String("columnName", columnName), zap.String("newType", newType))
 	if !c.config.ExtTableStorage {
 		return c.Exec(ctx, &drivers.Statement{
@@ -274,6 +293,7 @@ func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName
 // CreateTableAsSelect implements drivers.OLAPStore.
 // We add a \n at the end of the any user query to ensure any comment at the end of model doesn't make the query incomplete.
 func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string, tableOpts map[string]any) error {
+	defer c.replicas.markStale()
 	c.logger.Debug("create table", zap.String("name", name), zap.Bool("view", view))
 	if view {
 		return c.Exec(ctx, &drivers.Statement{
@@ -357,6 +377,7 @@ func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view
 
 // InsertTableAsSelect implements drivers.OLAPStore.
 func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
+	defer c.replicas.markStale()
 	c.logger.Debug("insert table", zap.String("name", name), zap.Bool("byName", byName), zap.String("strategy", string(strategy)), zap.Strings("uniqueKey", uniqueKey))
 
 	if !c.config.ExtTableStorage {
@@ -449,6 +470,7 @@ func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string,
 
 // DropTable implements drivers.OLAPStore.
 func (c *connection) DropTable(ctx context.Context, name string, view bool) error {
+	defer c.replicas.markStale()
 	c.logger.Debug("drop table", zap.String("name", name), zap.Bool("view", view))
 	if !c.config.ExtTableStorage {
 		var typ string
@@ -514,6 +536,7 @@ func (c *connection) DropTable(ctx context.Context, name string, view bool) erro
 // `DETACH foo__1`
 // `rm foo/1.db`
 func (c *connection) RenameTable(ctx context.Context, oldName, newName string, view bool) error {
+	defer c.replicas.markStale()
 	c.logger.Debug("rename table", zap.String("from", oldName), zap.String("to", newName), zap.Bool("view", view), zap.Bool("ext", c.config.ExtTableStorage))
 	if strings.EqualFold(oldName, newName) {
 		return fmt.Errorf("rename: old and new name are same case insensitive strings")
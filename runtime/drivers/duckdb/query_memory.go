@@ -0,0 +1,127 @@
+package duckdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/runningquery"
+	"go.uber.org/zap"
+)
+
+// queryMemoryCheckInterval is how often the memory usage of running queries is checked when a per-query memory limit is configured.
+const queryMemoryCheckInterval = time.Second
+
+// queryMemory tracks the estimated memory usage of a running query.
+type queryMemory struct {
+	query *runningquery.Query
+	// baseline is DuckDB's total memory usage when the query was first observed by the memory check. It's -1 until then.
+	baseline int64
+}
+
+// startQuery registers a query that is about to be executed in the running queries registry.
+// If a per-query memory limit is configured, interactive (i.e. not long-running) queries are also subject to the memory check.
+// The returned context must be used to execute the query, and the returned function must be called when the query has finished.
+func (c *connection) startQuery(ctx context.Context, stmt *drivers.Statement) (context.Context, func()) {
+	ctx, q, done := runningquery.Start(ctx, stmt.Query)
+	if c.config.QueryMemoryLimitGB <= 0 || stmt.LongRunning {
+		return ctx, done
+	}
+
+	c.runningQueriesMu.Lock()
+	c.runningQueries[q] = &queryMemory{query: q, baseline: -1}
+	c.runningQueriesMu.Unlock()
+
+	return ctx, func() {
+		c.runningQueriesMu.Lock()
+		delete(c.runningQueries, q)
+		c.runningQueriesMu.Unlock()
+		done()
+	}
+}
+
+// periodicallyCheckQueryMemory periodically samples DuckDB's memory usage while interactive queries are running and enforces the per-query memory limit.
+func (c *connection) periodicallyCheckQueryMemory(d time.Duration) {
+	ticker := time.NewTicker(d)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.runningQueriesMu.Lock()
+			n := len(c.runningQueries)
+			c.runningQueriesMu.Unlock()
+			if n == 0 {
+				continue
+			}
+
+			usage, err := c.memoryUsage(c.ctx, d)
+			if err != nil {
+				if c.ctx.Err() == nil {
+					c.logger.Warn("duckdb: failed to check memory usage", zap.Error(err))
+				}
+				continue
+			}
+			c.enforceQueryMemoryLimit(usage)
+		}
+	}
+}
+
+// memoryUsage returns the number of bytes of memory currently used by DuckDB.
+// It uses the meta connection, which stays available while OLAP queries occupy the rest of the pool.
+func (c *connection) memoryUsage(ctx context.Context, timeout time.Duration) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = release() }()
+
+	var usage int64
+	err = conn.QueryRowxContext(ctx, "SELECT COALESCE(SUM(memory_usage_bytes), 0)::BIGINT FROM duckdb_memory()").Scan(&usage)
+	if err != nil {
+		return 0, err
+	}
+	return usage, nil
+}
+
+// enforceQueryMemoryLimit cancels the interactive query with the highest estimated memory usage if the estimate exceeds the per-query memory limit.
+//
+// DuckDB only reports memory usage for the database as a whole, so the usage of a query is estimated as the growth in total usage since the query started,
+// split evenly between the queries that are currently running. At most one query is cancelled per check to give the memory of the cancelled query a chance to be released.
+func (c *connection) enforceQueryMemoryLimit(usage int64) {
+	limit := int64(c.config.QueryMemoryLimitGB) * 1000 * 1000 * 1000
+
+	c.runningQueriesMu.Lock()
+	var worst *queryMemory
+	var worstEstimate int64
+	n := int64(len(c.runningQueries))
+	for _, m := range c.runningQueries {
+		if m.baseline < 0 {
+			m.baseline = usage
+			continue
+		}
+		estimate := (usage - m.baseline) / n
+		if estimate > limit && estimate > worstEstimate {
+			worst, worstEstimate = m, estimate
+		}
+	}
+	if worst != nil {
+		delete(c.runningQueries, worst.query)
+	}
+	c.runningQueriesMu.Unlock()
+
+	if worst != nil {
+		c.logger.Warn("duckdb: cancelling query that exceeded the per-query memory limit",
+			zap.String("query_id", worst.query.ID),
+			zap.Int64("estimated_memory_bytes", worstEstimate),
+			zap.Int("limit_gb", c.config.QueryMemoryLimitGB),
+			zap.Duration("elapsed", time.Since(worst.query.StartedOn)),
+		)
+		worst.query.Cancel(fmt.Errorf("%w: the estimated memory usage of the query exceeded the per-query limit of %dGB", drivers.ErrMemoryLimitExceeded, c.config.QueryMemoryLimitGB))
+	}
+}
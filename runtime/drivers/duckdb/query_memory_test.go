@@ -0,0 +1,80 @@
+package duckdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/runningquery"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestEnforceQueryMemoryLimit(t *testing.T) {
+	handle, err := Driver{}.Open("default", map[string]any{"dsn": ":memory:", "query_memory_limit_gb": 1}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	c := handle.(*connection)
+	defer c.Close()
+
+	ctx1, done1 := c.startQuery(context.Background(), &drivers.Statement{Query: "SELECT 1"})
+	defer done1()
+	ctx2, done2 := c.startQuery(context.Background(), &drivers.Statement{Query: "SELECT 2"})
+	defer done2()
+	ctx3, done3 := c.startQuery(context.Background(), &drivers.Statement{Query: "SELECT 3", LongRunning: true})
+	defer done3()
+
+	// The first check records baselines
+	c.enforceQueryMemoryLimit(1e9)
+	require.NoError(t, ctx1.Err())
+	require.NoError(t, ctx2.Err())
+
+	// Growth split between two queries is within the limit
+	c.enforceQueryMemoryLimit(2.5e9)
+	require.NoError(t, ctx1.Err())
+	require.NoError(t, ctx2.Err())
+
+	// Growth split between two queries exceeds the limit, so one of them is cancelled
+	c.enforceQueryMemoryLimit(3.5e9)
+	cancelled := ctx1
+	if ctx1.Err() == nil {
+		cancelled = ctx2
+	}
+	require.Error(t, cancelled.Err())
+	require.ErrorIs(t, runningquery.Err(cancelled, cancelled.Err()), drivers.ErrMemoryLimitExceeded)
+	require.Len(t, c.runningQueries, 1)
+
+	// Long-running queries are not subject to the limit
+	require.NoError(t, ctx3.Err())
+}
+
+func TestExecuteRunningQueries(t *testing.T) {
+	handle, err := Driver{}.Open("default", map[string]any{"dsn": ":memory:"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	c := handle.(*connection)
+	defer c.Close()
+
+	reg := runningquery.New()
+	ctx := runningquery.WithRegistry(context.Background(), reg, runningquery.Source{InstanceID: "default"})
+	res, err := c.Execute(ctx, &drivers.Statement{Query: "SELECT 1"})
+	require.NoError(t, err)
+	require.Len(t, reg.List("default"), 1)
+	require.NoError(t, res.Close())
+	require.Empty(t, reg.List("default"))
+
+	// Cancel a slow query while it's running
+	go func() {
+		for {
+			qs := reg.List("default")
+			if len(qs) > 0 {
+				reg.Cancel("default", qs[0].ID)
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	err = c.Exec(ctx, &drivers.Statement{Query: "SELECT COUNT(*) FROM range(1000000000000)"})
+	require.ErrorIs(t, err, runningquery.ErrCancelled)
+	require.Empty(t, reg.List("default"))
+}
@@ -0,0 +1,282 @@
+package duckdb
+
+import (
+	"context"
+	dbsql "database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/XSAM/otelsql"
+	"github.com/jmoiron/sqlx"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+// replicaSyncDelay is how long to wait after a write before refreshing the read replicas.
+// It batches the many writes of a reconcile into a single refresh.
+const replicaSyncDelay = 5 * time.Second
+
+// replicaSnapshotDB is the name the snapshot database is attached as on the primary while it's being built.
+const replicaSnapshotDB = "__rill_replica_snapshot"
+
+// replicaSet maintains read-only copies of the primary database and routes read queries across them.
+// The copies are rebuilt from a snapshot of the primary after writes, so they may briefly serve stale data.
+// Since each replica is a separate DuckDB database, reads served by replicas don't contend with ingestion on the primary.
+type replicaSet struct {
+	c     *connection
+	stale chan struct{}
+
+	mu       sync.RWMutex
+	replicas []*replica
+	next     atomic.Uint64
+}
+
+// replica is a read-only DuckDB database opened on a copy of a snapshot.
+type replica struct {
+	db   *sqlx.DB
+	dir  string // Directory containing the replica's generation of files
+	refs sync.WaitGroup
+}
+
+func newReplicaSet(c *connection) (*replicaSet, error) {
+	// Clear replicas left behind by a previous process
+	err := os.RemoveAll(c.config.ReplicasPath)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &replicaSet{
+		c:     c,
+		stale: make(chan struct{}, 1),
+	}
+	s.markStale() // Trigger initial sync
+	return s, nil
+}
+
+// markStale schedules a refresh of the replicas. It is safe to call on a nil replicaSet.
+func (s *replicaSet) markStale() {
+	if s == nil {
+		return
+	}
+	select {
+	case s.stale <- struct{}{}:
+	default:
+	}
+}
+
+// acquire returns a connection to the next replica in round-robin order.
+// It returns a nil connection if the replicas have not been synced yet.
+func (s *replicaSet) acquire(ctx context.Context) (*sqlx.Conn, func() error, error) {
+	s.mu.RLock()
+	if len(s.replicas) == 0 {
+		s.mu.RUnlock()
+		return nil, nil, nil
+	}
+	r := s.replicas[s.next.Add(1)%uint64(len(s.replicas))]
+	r.refs.Add(1)
+	s.mu.RUnlock()
+
+	conn, err := r.db.Connx(ctx)
+	if err != nil {
+		r.refs.Done()
+		return nil, nil, err
+	}
+
+	release := func() error {
+		err := conn.Close()
+		r.refs.Done()
+		return err
+	}
+	return conn, release, nil
+}
+
+// run refreshes the replicas when they have been marked stale until ctx is cancelled.
+func (s *replicaSet) run(ctx context.Context) {
+	for {
+		select {
+		case <-s.stale:
+		case <-ctx.Done():
+			return
+		}
+
+		select {
+		case <-time.After(replicaSyncDelay):
+		case <-ctx.Done():
+			return
+		}
+
+		// Writes made during the delay are included in this sync
+		select {
+		case <-s.stale:
+		default:
+		}
+
+		start := time.Now()
+		err := s.sync(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			s.c.logger.Error("duckdb: failed to refresh read replicas", zap.Error(err))
+			continue
+		}
+		s.c.logger.Debug("duckdb: refreshed read replicas", zap.Int("replicas", s.c.config.ReadReplicas), zap.Duration("duration", time.Since(start)))
+	}
+}
+
+// sync snapshots the primary database and swaps the current replicas for new ones opened on copies of the snapshot.
+func (s *replicaSet) sync(ctx context.Context) error {
+	dir := filepath.Join(s.c.config.ReplicasPath, fmt.Sprint(time.Now().UnixMilli()))
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	snapshotFile := filepath.Join(dir, "snapshot.db")
+	err = s.c.WithConnection(ctx, -1, true, false, func(ctx, ensuredCtx context.Context, conn *dbsql.Conn) error {
+		return s.snapshot(ctx, ensuredCtx, conn, snapshotFile)
+	})
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return err
+	}
+
+	replicas := make([]*replica, 0, s.c.config.ReadReplicas)
+	for i := 0; i < s.c.config.ReadReplicas; i++ {
+		file := filepath.Join(dir, fmt.Sprintf("%d.db", i))
+		if i == s.c.config.ReadReplicas-1 {
+			err = os.Rename(snapshotFile, file)
+		} else {
+			err = copyFile(snapshotFile, file)
+		}
+		if err == nil {
+			var r *replica
+			r, err = s.openReplica(dir, file)
+			if err == nil {
+				replicas = append(replicas, r)
+			}
+		}
+		if err != nil {
+			closeReplicas(replicas, false)
+			_ = os.RemoveAll(dir)
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	old := s.replicas
+	s.replicas = replicas
+	s.mu.Unlock()
+
+	// Close the old replicas when their in-flight queries have finished
+	go closeReplicas(old, true)
+	return nil
+}
+
+// snapshot materializes the tables and views of the primary database into a new database file.
+// Materializing views also copies the data of tables in external table storage, which are exposed as views.
+func (s *replicaSet) snapshot(ctx, ensuredCtx context.Context, conn *dbsql.Conn, file string) error {
+	_, err := conn.ExecContext(ctx, fmt.Sprintf("ATTACH %s AS %s", safeSQLString(file), safeSQLName(replicaSnapshotDB)))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = conn.ExecContext(ensuredCtx, fmt.Sprintf("DETACH %s", safeSQLName(replicaSnapshotDB)))
+	}()
+
+	rows, err := conn.QueryContext(ctx, "SELECT table_name FROM information_schema.tables WHERE table_catalog = current_database() AND table_schema = current_schema()")
+	if err != nil {
+		return err
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		names = append(names, name)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		_, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE TABLE %s.%s AS SELECT * FROM %s", safeSQLName(replicaSnapshotDB), safeSQLName(name), safeSQLName(name)))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// Broken views shouldn't prevent the other tables from being replicated
+			s.c.logger.Warn("duckdb: failed to replicate table", zap.String("table", name), zap.Error(err))
+		}
+	}
+
+	// Flush the snapshot to disk before it's copied
+	_, err = conn.ExecContext(ctx, fmt.Sprintf("CHECKPOINT %s", safeSQLName(replicaSnapshotDB)))
+	return err
+}
+
+// openReplica opens a read-only DuckDB database on file.
+// It uses the same settings as the primary, except for the spill directory.
+func (s *replicaSet) openReplica(dir, file string) (*replica, error) {
+	qry := url.Values{}
+	if i := strings.Index(s.c.config.DSN, "?"); i >= 0 {
+		var err error
+		qry, err = url.ParseQuery(s.c.config.DSN[i+1:])
+		if err != nil {
+			return nil, err
+		}
+	}
+	qry.Set("access_mode", "READ_ONLY")
+
+	bootQueries := s.c.bootQueries()
+	bootQueries = append(bootQueries, fmt.Sprintf("SET temp_directory=%s", safeSQLString(filepath.Join(dir, "tmp"))))
+
+	connector, err := newConnector(generateDSN(file, qry.Encode()), bootQueries)
+	if err != nil {
+		return nil, err
+	}
+
+	db := sqlx.NewDb(otelsql.OpenDB(connector), "duckdb")
+	db.SetMaxOpenConns(s.c.config.PoolSize)
+	return &replica{db: db, dir: dir}, nil
+}
+
+// close closes the current replicas.
+func (s *replicaSet) close() {
+	s.mu.Lock()
+	old := s.replicas
+	s.replicas = nil
+	s.mu.Unlock()
+	closeReplicas(old, false)
+}
+
+// closeReplicas waits for in-flight queries on the replicas to finish and then closes them.
+// If removeFiles is true, it also removes the directories containing their files.
+func closeReplicas(replicas []*replica, removeFiles bool) {
+	for _, r := range replicas {
+		r.refs.Wait()
+		_ = r.db.Close()
+		if removeFiles {
+			_ = os.RemoveAll(r.dir)
+		}
+	}
+}
+
+// acquireReadConn acquires a connection for a read query, serving it from a read replica if allowed and available.
+func (c *connection) acquireReadConn(ctx context.Context, stmt *drivers.Statement) (*sqlx.Conn, func() error, error) {
+	if stmt.AllowReplica && c.replicas != nil && connFromContext(ctx) == nil {
+		conn, release, err := c.replicas.acquire(ctx)
+		if err != nil || conn != nil {
+			return conn, release, err
+		}
+	}
+	return c.acquireOLAPConn(ctx, stmt.Priority, stmt.LongRunning, false)
+}
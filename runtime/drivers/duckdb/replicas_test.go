@@ -0,0 +1,56 @@
+package duckdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	activity "github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReadReplicas(t *testing.T) {
+	ctx := context.Background()
+	handle, err := Driver{}.Open("default", map[string]any{"data_dir": t.TempDir(), "read_replicas": 2, "external_table_storage": true}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer handle.Close()
+	c := handle.(*connection)
+
+	olap, ok := handle.AsOLAP("")
+	require.True(t, ok)
+
+	err = olap.CreateTableAsSelect(ctx, "foo", false, "SELECT range AS id FROM range(10)", nil)
+	require.NoError(t, err)
+	err = olap.CreateTableAsSelect(ctx, "bar", true, "SELECT * FROM foo WHERE id < 5", nil)
+	require.NoError(t, err)
+
+	// Falls back to the primary before the replicas have been synced
+	requireCount(t, olap, &drivers.Statement{Query: "SELECT count(*) FROM bar", AllowReplica: true}, 5)
+
+	require.NoError(t, c.replicas.sync(ctx))
+	requireCount(t, olap, &drivers.Statement{Query: "SELECT count(*) FROM foo", AllowReplica: true}, 10)
+	requireCount(t, olap, &drivers.Statement{Query: "SELECT count(*) FROM bar", AllowReplica: true}, 5)
+
+	// Replicas are read-only
+	err = olap.Exec(ctx, &drivers.Statement{Query: "CREATE TABLE baz AS SELECT 1", AllowReplica: true})
+	require.Error(t, err)
+
+	// Writes are visible on replicas after the next sync
+	err = olap.InsertTableAsSelect(ctx, "foo", "SELECT 10 AS id", false, true, drivers.IncrementalStrategyAppend, nil)
+	require.NoError(t, err)
+	requireCount(t, olap, &drivers.Statement{Query: "SELECT count(*) FROM foo", AllowReplica: true}, 10)
+	requireCount(t, olap, &drivers.Statement{Query: "SELECT count(*) FROM foo"}, 11)
+	require.NoError(t, c.replicas.sync(ctx))
+	requireCount(t, olap, &drivers.Statement{Query: "SELECT count(*) FROM foo", AllowReplica: true}, 11)
+}
+
+func requireCount(t *testing.T, olap drivers.OLAPStore, stmt *drivers.Statement, expected int) {
+	res, err := olap.Execute(context.Background(), stmt)
+	require.NoError(t, err)
+	defer res.Close()
+	require.True(t, res.Next())
+	var n int
+	require.NoError(t, res.Scan(&n))
+	require.Equal(t, expected, n)
+}
@@ -0,0 +1,191 @@
+package duckdb
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// tempTablePrefix is the name prefix Rill uses for temporary tables (e.g. staging tables for sources and models).
+	// Such tables are normally dropped or renamed by the code that creates them, but they leak if the runtime crashes mid-operation.
+	tempTablePrefix = "__rill_tmp_"
+	// tempRenameTablePrefix is the prefix for tables that are mid-way through a case-only rename.
+	// They may hold the only copy of a model's data, so they are never cleaned up automatically.
+	tempRenameTablePrefix = "__rill_tmp_rename_"
+	// orphanedTempTableTTL is how long a temporary table must have existed before the periodic cleanup considers it orphaned.
+	orphanedTempTableTTL = 24 * time.Hour
+)
+
+// tempTable is a temporary table found by listTempTables.
+type tempTable struct {
+	name    string
+	view    bool
+	created time.Time // Zero if unknown
+}
+
+// periodicallyCleanupTempTables periodically drops temporary tables that are older than orphanedTempTableTTL.
+func (c *connection) periodicallyCleanupTempTables(d time.Duration) {
+	ticker := time.NewTicker(d)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.cleanupTempTables(c.ctx, orphanedTempTableTTL)
+		}
+	}
+}
+
+// cleanupTempTables drops temporary tables that have existed for longer than ttl.
+// When the creation time of a table is unknown, its age is measured from when this connection first saw it.
+// A ttl of 0 drops all temporary tables, which is safe when no other operations are running (i.e. when opening the connection).
+func (c *connection) cleanupTempTables(ctx context.Context, ttl time.Duration) {
+	tables, err := c.listTempTables(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.logger.Warn("duckdb: failed to list temporary tables", zap.Error(err))
+		}
+		return
+	}
+
+	now := time.Now()
+	c.tempTablesMu.Lock()
+	seen := make(map[string]time.Time, len(tables))
+	for _, t := range tables {
+		if t.created.IsZero() {
+			t.created = now
+			if s, ok := c.tempTablesSeen[t.name]; ok {
+				t.created = s
+			}
+		}
+		seen[t.name] = t.created
+	}
+	c.tempTablesSeen = seen
+	c.tempTablesMu.Unlock()
+
+	for _, t := range tables {
+		created := seen[t.name]
+		if ttl > 0 && now.Sub(created) < ttl {
+			continue
+		}
+
+		err := c.DropTable(ctx, t.name, t.view)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.logger.Warn("duckdb: failed to drop orphaned temporary table", zap.String("table", t.name), zap.Error(err))
+			continue
+		}
+
+		// DropTable only removes the storage directory of tables with a version, so we remove directories left behind before the version was written here.
+		if c.config.ExtTableStorage {
+			_ = os.RemoveAll(filepath.Join(c.config.DBStoragePath, t.name))
+		}
+
+		c.tempTablesMu.Lock()
+		delete(c.tempTablesSeen, t.name)
+		c.tempTablesMu.Unlock()
+		c.logger.Info("duckdb: dropped orphaned temporary table", zap.String("table", t.name), zap.Duration("age", now.Sub(created)))
+	}
+}
+
+// listTempTables lists the temporary tables in the database.
+// With external table storage, it also includes table directories that were left behind without a view.
+func (c *connection) listTempTables(ctx context.Context) ([]*tempTable, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.QueryxContext(ctx, `
+		SELECT table_name, table_type = 'VIEW'
+		FROM information_schema.tables
+		WHERE table_catalog = current_database() AND table_schema = 'main' AND starts_with(table_name, ?)
+	`, tempTablePrefix)
+	if err != nil {
+		_ = release()
+		return nil, c.checkErr(err)
+	}
+
+	var res []*tempTable
+	names := make(map[string]bool)
+	for rows.Next() {
+		t := &tempTable{}
+		if err := rows.Scan(&t.name, &t.view); err != nil {
+			_ = rows.Close()
+			_ = release()
+			return nil, err
+		}
+		names[t.name] = true
+		if !strings.HasPrefix(t.name, tempRenameTablePrefix) {
+			res = append(res, t)
+		}
+	}
+	err = rows.Err()
+	_ = rows.Close()
+	_ = release()
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.config.ExtTableStorage {
+		return res, nil
+	}
+
+	// Externally stored tables are views over an attached database, so we use the version (the creation time in Unix milliseconds) to determine their age.
+	for _, t := range res {
+		if !t.view {
+			continue
+		}
+		version, exist, err := c.tableVersion(t.name)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			continue
+		}
+		t.view = false
+		t.created = versionTime(version)
+	}
+
+	entries, err := os.ReadDir(c.config.DBStoragePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return res, nil
+		}
+		return nil, err
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if !e.IsDir() || names[name] || !strings.HasPrefix(name, tempTablePrefix) || strings.HasPrefix(name, tempRenameTablePrefix) {
+			continue
+		}
+		t := &tempTable{name: name}
+		if version, exist, err := c.tableVersion(name); err == nil && exist {
+			t.created = versionTime(version)
+		}
+		res = append(res, t)
+	}
+
+	return res, nil
+}
+
+// versionTime parses a table version created by CreateTableAsSelect into a time.
+// It returns the zero time if the version is not a timestamp.
+func versionTime(version string) time.Time {
+	ms, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
@@ -0,0 +1,63 @@
+package duckdb
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func Test_connection_cleanupTempTables(t *testing.T) {
+	for _, ext := range []bool{false, true} {
+		temp := t.TempDir()
+		ctx := context.Background()
+
+		dbPath := filepath.Join(temp, "view.db")
+		handle, err := Driver{}.Open("default", map[string]any{"path": dbPath, "external_table_storage": ext}, activity.NewNoopClient(), zap.NewNop())
+		require.NoError(t, err)
+		c := handle.(*connection)
+		require.NoError(t, c.Migrate(ctx))
+
+		require.NoError(t, c.CreateTableAsSelect(ctx, "foo", false, "SELECT 1 AS id", nil))
+		require.NoError(t, c.CreateTableAsSelect(ctx, "__rill_tmp_model_foo", false, "SELECT 1 AS id", nil))
+		require.NoError(t, c.CreateTableAsSelect(ctx, "__rill_tmp_src_bar", true, "SELECT 1 AS id", nil))
+		require.NoError(t, c.CreateTableAsSelect(ctx, "__rill_tmp_rename_TABLE_baz", false, "SELECT 1 AS id", nil))
+
+		// Recently created tables are kept
+		c.cleanupTempTables(ctx, time.Hour)
+		require.ElementsMatch(t, []string{"foo", "__rill_tmp_model_foo", "__rill_tmp_src_bar", "__rill_tmp_rename_TABLE_baz"}, tableNames(t, c))
+
+		// Reopening drops orphaned temporary tables, except for tables that are mid-way through a rename
+		require.NoError(t, c.Close())
+		handle, err = Driver{}.Open("default", map[string]any{"path": dbPath, "external_table_storage": ext}, activity.NewNoopClient(), zap.NewNop())
+		require.NoError(t, err)
+		c = handle.(*connection)
+		require.ElementsMatch(t, []string{"foo", "__rill_tmp_rename_TABLE_baz"}, tableNames(t, c))
+		if ext {
+			_, err = os.Stat(filepath.Join(c.config.DBStoragePath, "__rill_tmp_model_foo"))
+			require.True(t, os.IsNotExist(err))
+		}
+		require.NoError(t, c.Close())
+	}
+}
+
+func tableNames(t *testing.T, c *connection) []string {
+	res, err := c.Execute(context.Background(), &drivers.Statement{Query: "SELECT table_name FROM information_schema.tables WHERE table_schema = 'main' AND table_catalog = current_database() AND table_name NOT LIKE 'rill_%'"})
+	require.NoError(t, err)
+	defer res.Close()
+
+	var names []string
+	for res.Next() {
+		var name string
+		require.NoError(t, res.Scan(&name))
+		names = append(names, name)
+	}
+	require.NoError(t, res.Err())
+	return names
+}
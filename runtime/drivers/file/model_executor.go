@@ -4,12 +4,18 @@ import (
 	"fmt"
 
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/locale"
 )
 
 type ModelOutputProperties struct {
 	Path               string             `mapstructure:"path"`
 	Format             drivers.FileFormat `mapstructure:"format"`
 	FileSizeLimitBytes int64              `mapstructure:"file_size_limit_bytes"`
+	// Headers optionally replaces the header row of XLSX files with multiple header rows.
+	// It maps column names to the header cells to write for the column, one per row.
+	Headers map[string][]string `mapstructure:"headers"`
+	// Locale optionally sets a BCP 47 language tag that controls the decimal separator and delimiter of CSV files.
+	Locale string `mapstructure:"locale"`
 }
 
 func (p *ModelOutputProperties) Validate() error {
@@ -21,6 +27,9 @@ func (p *ModelOutputProperties) Validate() error {
 	} else if !p.Format.Valid() {
 		return fmt.Errorf("invalid property 'format': %q", p.Format)
 	}
+	if err := locale.Validate(p.Locale); err != nil {
+		return fmt.Errorf("invalid property 'locale': %w", err)
+	}
 	return nil
 }
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/apache/arrow/go/v14/arrow"
 	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/decimal128"
 	"github.com/apache/arrow/go/v14/arrow/memory"
 	"github.com/apache/arrow/go/v14/parquet/pqarrow"
 	"github.com/c2h5oh/datasize"
@@ -19,6 +20,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/jsonval"
+	"github.com/rilldata/rill/runtime/pkg/locale"
 	"github.com/xuri/excelize/v2"
 )
 
@@ -55,10 +57,12 @@ func (e *olapToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 	}
 
 	// Execute the SQL
+	// Exports may scan a lot of data, so they're marked long running (which, for example, makes Druid use its async query API).
 	res, err := e.olap.Execute(ctx, &drivers.Statement{
-		Query:    inputProps.SQL,
-		Args:     inputProps.Args,
-		Priority: e.opts.Priority,
+		Query:       inputProps.SQL,
+		Args:        inputProps.Args,
+		Priority:    e.opts.Priority,
+		LongRunning: true,
 	})
 	if err != nil {
 		return nil, err
@@ -79,11 +83,15 @@ func (e *olapToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 	case drivers.FileFormatParquet:
 		err = writeParquet(res, fw)
 	case drivers.FileFormatCSV:
-		err = writeCSV(res, fw)
+		var loc *locale.Locale
+		loc, err = locale.Parse(outputProps.Locale)
+		if err == nil {
+			err = writeCSV(res, fw, loc)
+		}
 	case drivers.FileFormatJSON:
 		return nil, errors.New("json file output not currently supported")
 	case drivers.FileFormatXLSX:
-		err = writeXLSX(res, fw)
+		err = writeXLSX(res, fw, outputProps.Headers)
 	default:
 		return nil, fmt.Errorf("unsupported output format %q", outputProps.Format)
 	}
@@ -110,8 +118,9 @@ func (e *olapToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 	}, nil
 }
 
-func writeCSV(res *drivers.Result, fw io.Writer) error {
+func writeCSV(res *drivers.Result, fw io.Writer, loc *locale.Locale) error {
 	w := csv.NewWriter(fw)
+	w.Comma = loc.CSVDelimiter()
 
 	strs := make([]string, len(res.Schema.Fields))
 	for i, f := range res.Schema.Fields {
@@ -148,6 +157,9 @@ func writeCSV(res *drivers.Result, fw io.Writer) error {
 					return fmt.Errorf("failed to marshal JSON value: %w", err)
 				}
 				s = string(tmp)
+				if isNumber(v) {
+					s = loc.FormatNumber(s)
+				}
 			}
 
 			strs[i] = s
@@ -166,7 +178,17 @@ func writeCSV(res *drivers.Result, fw io.Writer) error {
 	return nil
 }
 
-func writeXLSX(res *drivers.Result, fw io.Writer) error {
+// isNumber returns true if v is a numeric value returned by jsonval.ToValue.
+func isNumber(v any) bool {
+	switch v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func writeXLSX(res *drivers.Result, fw io.Writer, headers map[string][]string) error {
 	xf := excelize.NewFile()
 	defer func() { _ = xf.Close() }()
 
@@ -175,20 +197,37 @@ func writeXLSX(res *drivers.Result, fw io.Writer) error {
 		return err
 	}
 
-	row := make([]any, len(res.Schema.Fields))
+	names := make([]string, len(res.Schema.Fields))
 	for i, f := range res.Schema.Fields {
-		row[i] = f.Name
+		names[i] = f.Name
 	}
-	if err := sw.SetRow("A1", row, excelize.RowOpts{Height: 45, Hidden: false}); err != nil {
-		return err
+	headerRows, merges := xlsxHeaders(names, headers)
+	for i, hr := range headerRows {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return err
+		}
+		opts := excelize.RowOpts{Height: 45, Hidden: false}
+		if len(headerRows) > 1 {
+			opts.Height = 20
+		}
+		if err := sw.SetRow(cell, hr, opts); err != nil {
+			return err
+		}
+	}
+	for _, m := range merges {
+		if err := sw.MergeCell(m[0], m[1]); err != nil {
+			return err
+		}
 	}
 
+	row := make([]any, len(res.Schema.Fields))
 	vals := make([]any, len(res.Schema.Fields))
 	for i := range vals {
 		vals[i] = new(any)
 	}
 
-	idx := 2 // 1-based, and +1 for headers
+	idx := len(headerRows) + 1 // 1-based, and +N for headers
 	for res.Next() {
 		err := res.Scan(vals...)
 		if err != nil {
@@ -202,9 +241,15 @@ func writeXLSX(res *drivers.Result, fw io.Writer) error {
 				return fmt.Errorf("failed to convert to JSON value: %w", err)
 			}
 
-			switch res.(type) {
+			switch v := res.(type) {
 			case nil:
 				res = ""
+			case json.Number:
+				// Excel stores numbers as floats, so exact decimals can't be preserved
+				res, err = v.Float64()
+				if err != nil {
+					return fmt.Errorf("failed to convert decimal value: %w", err)
+				}
 			case []any, map[string]any:
 				res, err = json.Marshal(res)
 				if err != nil {
@@ -240,6 +285,91 @@ func writeXLSX(res *drivers.Result, fw io.Writer) error {
 	return nil
 }
 
+// xlsxHeaders returns the header rows for an XLSX file with the given columns, and the ranges of header cells to merge.
+// If headers is empty, there's a single header row with the column names.
+// Otherwise, columns in headers get their header cells in consecutive rows.
+// Adjacent cells in a row are merged if they're equal and the cells above them were merged, which renders nested headers (like for a pivot).
+// Columns not in headers get their name in a single cell that spans all the header rows.
+func xlsxHeaders(names []string, headers map[string][]string) ([][]any, [][2]string) {
+	depth := 1
+	for _, n := range names {
+		if len(headers[n]) > depth {
+			depth = len(headers[n])
+		}
+	}
+
+	// cells[c][r] is the header cell for column c in row r (empty if it's merged into the cell above)
+	cells := make([][]string, len(names))
+	spans := make([]int, len(names)) // The number of rows covered by each column's header cells
+	for c, n := range names {
+		hs, ok := headers[n]
+		if !ok || len(hs) == 0 {
+			hs = []string{n}
+		}
+		cells[c] = make([]string, depth)
+		copy(cells[c], hs)
+		spans[c] = len(hs)
+	}
+
+	rows := make([][]any, depth)
+	for r := range rows {
+		rows[r] = make([]any, len(names))
+		for c := range names {
+			if r < spans[c] {
+				rows[r][c] = cells[c][r]
+			}
+		}
+	}
+
+	var merges [][2]string
+	cellName := func(c, r int) string {
+		// The coordinates are always valid, so it can't fail
+		name, _ := excelize.CoordinatesToCellName(c+1, r+1)
+		return name
+	}
+
+	// Merge the last cell of each column down to the bottom header row
+	for c := range names {
+		if spans[c] < depth {
+			merges = append(merges, [2]string{cellName(c, spans[c]-1), cellName(c, depth-1)})
+		}
+	}
+
+	// Merge equal adjacent cells in each row, as long as the cells above are in the same merged group
+	if depth > 1 {
+		for r := 0; r < depth; r++ {
+			for start := 0; start < len(names); {
+				end := start
+				for end+1 < len(names) && sameHeaderPrefix(cells, spans, start, end+1, r) {
+					end++
+				}
+				if end > start {
+					merges = append(merges, [2]string{cellName(start, r), cellName(end, r)})
+				}
+				start = end + 1
+			}
+		}
+	}
+
+	return rows, merges
+}
+
+// sameHeaderPrefix returns true if columns a and b have the same header cells in rows 0 through r.
+// Cells that are merged down to the bottom header row are never merged sideways.
+func sameHeaderPrefix(cells [][]string, spans []int, a, b, r int) bool {
+	for _, c := range []int{a, b} {
+		if r >= spans[c] || (spans[c] < len(cells[c]) && r == spans[c]-1) {
+			return false
+		}
+	}
+	for i := 0; i <= r; i++ {
+		if cells[a][i] != cells[b][i] {
+			return false
+		}
+	}
+	return true
+}
+
 func writeParquet(res *drivers.Result, fw io.Writer) error {
 	fields := make([]arrow.Field, 0, len(res.Schema.Fields))
 	for _, f := range res.Schema.Fields {
@@ -259,7 +389,12 @@ func writeParquet(res *drivers.Result, fw io.Writer) error {
 		case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
 			arrowField.Type = arrow.PrimitiveTypes.Float64
 		case runtimev1.Type_CODE_DECIMAL:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
+			// Decimals are written as exact decimals if they fit in 128 bits, and otherwise as strings
+			if d := f.Type.DecimalType; d != nil && d.Precision > 0 && d.Precision <= 38 {
+				arrowField.Type = &arrow.Decimal128Type{Precision: int32(d.Precision), Scale: int32(d.Scale)}
+			} else {
+				arrowField.Type = arrow.BinaryTypes.String
+			}
 		case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
 			arrowField.Type = arrow.FixedWidthTypes.Timestamp_us
 		case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
@@ -319,8 +454,22 @@ func writeParquet(res *drivers.Result, fw io.Writer) error {
 				v, _ := v.(float64)
 				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
 			case runtimev1.Type_CODE_DECIMAL:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
+				if v == nil {
+					recordBuilder.Field(i).AppendNull()
+					continue
+				}
+				s := fmt.Sprint(v)
+				switch b := recordBuilder.Field(i).(type) {
+				case *array.Decimal128Builder:
+					dt := b.Type().(*arrow.Decimal128Type)
+					n, err := decimal128.FromString(s, dt.Precision, dt.Scale)
+					if err != nil {
+						return fmt.Errorf("failed to convert decimal value %q: %w", s, err)
+					}
+					b.Append(n)
+				case *array.StringBuilder:
+					b.Append(s)
+				}
 			case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
 				v, _ := v.(time.Time)
 				tmp, err := arrow.TimestampFromTime(v, arrow.Microsecond)
@@ -0,0 +1,33 @@
+package file
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXLSXHeaders(t *testing.T) {
+	// Without headers, the column names are written in a single row
+	rows, merges := xlsxHeaders([]string{"a", "b"}, nil)
+	require.Equal(t, [][]any{{"a", "b"}}, rows)
+	require.Empty(t, merges)
+
+	// Pivot headers are nested, and the other columns span all the header rows
+	names := []string{"pub", "2022_Google_bids", "2022_Google_price", "2022_Yahoo_bids", "2023_Google_bids"}
+	rows, merges = xlsxHeaders(names, map[string][]string{
+		"2022_Google_bids":  {"2022", "Google", "bids"},
+		"2022_Google_price": {"2022", "Google", "price"},
+		"2022_Yahoo_bids":   {"2022", "Yahoo", "bids"},
+		"2023_Google_bids":  {"2023", "Google", "bids"},
+	})
+	require.Equal(t, [][]any{
+		{"pub", "2022", "2022", "2022", "2023"},
+		{nil, "Google", "Google", "Yahoo", "Google"},
+		{nil, "bids", "price", "bids", "bids"},
+	}, rows)
+	require.ElementsMatch(t, [][2]string{
+		{"A1", "A3"},
+		{"B1", "D1"},
+		{"B2", "C2"},
+	}, merges)
+}
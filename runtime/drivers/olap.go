@@ -51,6 +51,8 @@ type Statement struct {
 	Priority         int
 	LongRunning      bool
 	ExecutionTimeout time.Duration
+	// AllowReplica indicates the query only reads data and may be served by a read replica, which can lag slightly behind recent writes.
+	AllowReplica bool
 }
 
 // Result wraps the results of query.
@@ -173,6 +175,7 @@ const (
 	DialectDruid
 	DialectClickHouse
 	DialectPinot
+	DialectBigQuery
 )
 
 func (d Dialect) String() string {
@@ -187,6 +190,8 @@ func (d Dialect) String() string {
 		return "clickhouse"
 	case DialectPinot:
 		return "pinot"
+	case DialectBigQuery:
+		return "bigquery"
 	default:
 		panic("not implemented")
 	}
@@ -201,13 +206,23 @@ func (d Dialect) EscapeIdentifier(ident string) string {
 	if ident == "" {
 		return ident
 	}
+	if d == DialectBigQuery {
+		// BigQuery quotes identifiers with backticks and uses backslash escapes
+		return fmt.Sprintf("`%s`", bigQueryEscaper.Replace(ident))
+	}
 	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(ident, "\"", "\"\""))
 }
 
 func (d Dialect) EscapeStringValue(s string) string {
+	if d == DialectBigQuery {
+		return fmt.Sprintf("'%s'", bigQueryEscaper.Replace(s))
+	}
 	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
 }
 
+// bigQueryEscaper escapes characters in BigQuery quoted identifiers and string literals.
+var bigQueryEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`", "'", `\'`)
+
 func (d Dialect) ConvertToDateTruncSpecifier(grain runtimev1.TimeGrain) string {
 	var str string
 	switch grain {
@@ -238,7 +253,72 @@ func (d Dialect) ConvertToDateTruncSpecifier(grain runtimev1.TimeGrain) string {
 }
 
 func (d Dialect) SupportsILike() bool {
-	return d != DialectDruid && d != DialectPinot
+	return d != DialectDruid && d != DialectPinot && d != DialectBigQuery
+}
+
+// StripAccentsExpr returns an expression that removes accents (diacritics) from the given string expression, ie "Café" becomes "Cafe".
+// It returns false if the dialect doesn't support removing accents.
+func (d Dialect) StripAccentsExpr(expr string) (string, bool) {
+	switch d {
+	case DialectDuckDB:
+		return fmt.Sprintf("strip_accents(%s)", expr), true
+	case DialectClickHouse:
+		// Decompose accented characters and remove the resulting combining marks.
+		return fmt.Sprintf(`replaceRegexpAll(normalizeUTF8NFD(%s), '\p{Mn}', '')`, expr), true
+	case DialectBigQuery:
+		return fmt.Sprintf(`REGEXP_REPLACE(NORMALIZE(%s, NFD), r'\pM', '')`, expr), true
+	default:
+		return "", false
+	}
+}
+
+// IPAddressExpr returns an expression that converts the given expression to a comparable IP address type.
+// It returns false if the dialect doesn't support IP addresses.
+func (d Dialect) IPAddressExpr(expr string) (string, bool) {
+	switch d {
+	case DialectDuckDB:
+		// Requires the inet extension, which DuckDB autoloads.
+		return fmt.Sprintf("CAST(%s AS INET)", expr), true
+	case DialectClickHouse:
+		// IPv4 addresses are mapped to IPv6, so both families can be compared.
+		return fmt.Sprintf("toIPv6(toString(%s))", expr), true
+	default:
+		return "", false
+	}
+}
+
+// RegexpMatchExpr returns a boolean expression that is true if expr contains a match of the regular expression pattern.
+// It returns false if the dialect doesn't support regular expressions.
+func (d Dialect) RegexpMatchExpr(expr, pattern string) (string, bool) {
+	switch d {
+	case DialectDuckDB:
+		return fmt.Sprintf("regexp_matches(%s, %s)", expr, pattern), true
+	case DialectDruid, DialectPinot:
+		return fmt.Sprintf("REGEXP_LIKE(%s, %s)", expr, pattern), true
+	case DialectClickHouse:
+		return fmt.Sprintf("match(%s, %s)", expr, pattern), true
+	case DialectBigQuery:
+		return fmt.Sprintf("REGEXP_CONTAINS(%s, %s)", expr, pattern), true
+	default:
+		return "", false
+	}
+}
+
+// EpochMillisExpr returns an expression that converts the given timestamp expression to milliseconds since the Unix epoch.
+// It returns false if the dialect doesn't support the conversion.
+func (d Dialect) EpochMillisExpr(expr string) (string, bool) {
+	switch d {
+	case DialectDuckDB:
+		return fmt.Sprintf("epoch_ms(%s)", expr), true
+	case DialectDruid:
+		return fmt.Sprintf("TIMESTAMP_TO_MILLIS(%s)", expr), true
+	case DialectClickHouse:
+		return fmt.Sprintf("toUnixTimestamp64Milli(toDateTime64(%s, 3))", expr), true
+	case DialectBigQuery:
+		return fmt.Sprintf("UNIX_MILLIS(%s)", expr), true
+	default:
+		return "", false
+	}
 }
 
 // EscapeTable returns an esacped fully qualified table name
@@ -256,6 +336,48 @@ func (d Dialect) EscapeTable(db, schema, table string) string {
 	return sb.String()
 }
 
+// EscapeMetricsViewTable returns the escaped table reference of a metrics view, including table modifiers, unions and joins configured on the metrics view.
+func (d Dialect) EscapeMetricsViewTable(mv *runtimev1.MetricsViewSpec) string {
+	tbl := d.EscapeTable(mv.Database, mv.DatabaseSchema, mv.Table)
+	if mv.Final && d == DialectClickHouse {
+		tbl += " FINAL"
+	}
+	if d != DialectDruid {
+		return tbl
+	}
+
+	// Druid appends datasources with the APPEND table function, which takes the datasource names as string literals.
+	if len(mv.UnionTables) > 0 {
+		names := make([]string, 0, len(mv.UnionTables)+1)
+		names = append(names, d.EscapeStringValue(mv.Table))
+		for _, t := range mv.UnionTables {
+			names = append(names, d.EscapeStringValue(t))
+		}
+		tbl = fmt.Sprintf("TABLE(APPEND(%s))", strings.Join(names, ", "))
+	}
+
+	// Druid lookups are in the "lookup" schema and have the columns "k" and "v".
+	for _, j := range mv.LookupJoins {
+		alias := j.Alias
+		if alias == "" {
+			alias = j.Lookup
+		}
+		typ := "LEFT"
+		if j.Inner {
+			typ = "INNER"
+		}
+		tbl += fmt.Sprintf(" %s JOIN %s AS %s ON (%s) = %s.%s",
+			typ,
+			d.EscapeTable("", "lookup", j.Lookup),
+			d.EscapeIdentifier(alias),
+			j.KeyExpression,
+			d.EscapeIdentifier(alias),
+			d.EscapeIdentifier("k"),
+		)
+	}
+	return tbl
+}
+
 func (d Dialect) DimensionSelect(db, dbSchema, table string, dim *runtimev1.MetricsViewSpec_DimensionV2) (dimSelect, unnestClause string) {
 	colName := d.EscapeIdentifier(dim.Name)
 	if !dim.Unnest || d == DialectDruid {
@@ -265,6 +387,10 @@ func (d Dialect) DimensionSelect(db, dbSchema, table string, dim *runtimev1.Metr
 	unnestColName := d.EscapeIdentifier(tempName(fmt.Sprintf("%s_%s_", "unnested", dim.Name)))
 	unnestTableName := tempName("tbl")
 	sel := fmt.Sprintf(`%s as %s`, unnestColName, colName)
+	if d == DialectBigQuery {
+		// BigQuery doesn't support LATERAL or column aliases for UNNEST, the alias refers to the element directly
+		return sel, fmt.Sprintf(`, UNNEST(%s) AS %s`, d.MetricsViewDimensionExpression(dim), unnestColName)
+	}
 	if dim.Expression == "" {
 		// select "unnested_colName" as "colName" ... FROM "mv_table", LATERAL UNNEST("mv_table"."colName") tbl_name("unnested_colName") ...
 		return sel, fmt.Sprintf(`, LATERAL UNNEST(%s.%s) %s(%s)`, d.EscapeTable(db, dbSchema, table), colName, unnestTableName, unnestColName)
@@ -281,6 +407,9 @@ func (d Dialect) DimensionSelectPair(db, dbSchema, table string, dim *runtimev1.
 
 	unnestColName := d.EscapeIdentifier(tempName(fmt.Sprintf("%s_%s_", "unnested", dim.Name)))
 	unnestTableName := tempName("tbl")
+	if d == DialectBigQuery {
+		return unnestColName, colName, fmt.Sprintf(`, UNNEST(%s) AS %s`, d.MetricsViewDimensionExpression(dim), unnestColName)
+	}
 	if dim.Expression == "" {
 		// select "unnested_colName" as "colName" ... FROM "mv_table", LATERAL UNNEST("mv_table"."colName") tbl_name("unnested_colName") ...
 		return unnestColName, colName, fmt.Sprintf(`, LATERAL UNNEST(%s.%s) %s(%s)`, d.EscapeTable(db, dbSchema, table), colName, unnestTableName, unnestColName)
@@ -294,6 +423,12 @@ func (d Dialect) LateralUnnest(expr, tableAlias, colName string) (tbl string, au
 		return "", true, nil
 	}
 
+	if d == DialectBigQuery {
+		// BigQuery has no LATERAL keyword or column aliases for UNNEST.
+		// Unnesting into single-field structs makes the elements addressable as <tableAlias>.<colName> like in the other dialects.
+		return fmt.Sprintf("UNNEST(ARRAY(SELECT AS STRUCT v AS %s FROM UNNEST(%s) AS v)) AS %s", d.EscapeIdentifier(colName), expr, tableAlias), false, nil
+	}
+
 	return fmt.Sprintf(`LATERAL UNNEST(%s) %s(%s)`, expr, tableAlias, d.EscapeIdentifier(colName)), false, nil
 }
 
@@ -313,17 +448,49 @@ func (d Dialect) SafeDivideExpression(numExpr, denExpr string) string {
 	switch d {
 	case DialectDruid:
 		return fmt.Sprintf("SAFE_DIVIDE(%s, CAST(%s AS DOUBLE))", numExpr, denExpr)
+	case DialectBigQuery:
+		return fmt.Sprintf("SAFE_DIVIDE(%s, CAST(%s AS FLOAT64))", numExpr, denExpr)
 	default:
 		return fmt.Sprintf("(%s)/CAST(%s AS DOUBLE)", numExpr, denExpr)
 	}
 }
 
+// ArgMaxExpression returns an aggregate expression that evaluates to valueExpr in the row where byExpr is largest.
+// If isMax is false, it uses the row where byExpr is smallest.
+func (d Dialect) ArgMaxExpression(valueExpr, byExpr string, isMax bool) (string, error) {
+	switch d {
+	case DialectDuckDB:
+		if isMax {
+			return fmt.Sprintf("ARG_MAX(%s, %s)", valueExpr, byExpr), nil
+		}
+		return fmt.Sprintf("ARG_MIN(%s, %s)", valueExpr, byExpr), nil
+	case DialectClickHouse:
+		if isMax {
+			return fmt.Sprintf("argMax(%s, %s)", valueExpr, byExpr), nil
+		}
+		return fmt.Sprintf("argMin(%s, %s)", valueExpr, byExpr), nil
+	case DialectBigQuery:
+		if isMax {
+			return fmt.Sprintf("ANY_VALUE(%s HAVING MAX %s)", valueExpr, byExpr), nil
+		}
+		return fmt.Sprintf("ANY_VALUE(%s HAVING MIN %s)", valueExpr, byExpr), nil
+	case DialectDruid:
+		// Druid only supports this when byExpr is a timestamp
+		if isMax {
+			return fmt.Sprintf("LATEST_BY(%s, %s)", valueExpr, byExpr), nil
+		}
+		return fmt.Sprintf("EARLIEST_BY(%s, %s)", valueExpr, byExpr), nil
+	default:
+		return "", fmt.Errorf("arg max is not supported for dialect %q", d.String())
+	}
+}
+
 func (d Dialect) OrderByExpression(name string, desc bool) string {
 	res := d.EscapeIdentifier(name)
 	if desc {
 		res += " DESC"
 	}
-	if d == DialectDuckDB {
+	if d == DialectDuckDB || d == DialectBigQuery {
 		res += " NULLS LAST"
 	}
 	return res
@@ -414,7 +581,8 @@ func (d Dialect) DateTruncExpr(dim *runtimev1.MetricsViewSpec_DimensionV2, grain
 		if shift == 0 {
 			return fmt.Sprintf("time_floor(%s, '%s', null, '%s')", expr, specifier, tz), nil
 		}
-		return fmt.Sprintf("time_shift(time_floor(time_shift(%s, '%s', %d), '%s', null, '%s'), '%s', -%d)", expr, shiftPeriod, shift, specifier, tz, shiftPeriod, shift), nil
+		// The shifts must also be applied in the time zone. Shifting by days or months in UTC drifts by an hour across DST changes.
+		return fmt.Sprintf("time_shift(time_floor(time_shift(%s, '%s', %d, '%s'), '%s', null, '%s'), '%s', -%d, '%s')", expr, shiftPeriod, shift, tz, specifier, tz, shiftPeriod, shift, tz), nil
 	case DialectClickHouse:
 		var shift string
 		if grain == runtimev1.TimeGrain_TIME_GRAIN_WEEK && firstDayOfWeek > 1 {
@@ -437,6 +605,36 @@ func (d Dialect) DateTruncExpr(dim *runtimev1.MetricsViewSpec_DimensionV2, grain
 			return fmt.Sprintf("toTimezone(date_trunc('%s', toTimezone(%s::TIMESTAMP, '%s'))::TIMESTAMP, '%s')", specifier, expr, tz, tz), nil
 		}
 		return fmt.Sprintf("toTimezone(date_trunc('%s', toTimezone(%s::TIMESTAMP, '%s') + INTERVAL %s)::TIMESTAMP - INTERVAL %s, '%s')", specifier, expr, tz, shift, shift, tz), nil
+	case DialectBigQuery:
+		// BigQuery can only truncate in a time zone for grains of a day or larger (smaller grains are the same in all whole-hour time zones).
+		var tzArg string
+		switch grain {
+		case runtimev1.TimeGrain_TIME_GRAIN_DAY, runtimev1.TimeGrain_TIME_GRAIN_WEEK, runtimev1.TimeGrain_TIME_GRAIN_MONTH, runtimev1.TimeGrain_TIME_GRAIN_QUARTER, runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+			if tz != "" {
+				tzArg = fmt.Sprintf(", '%s'", tz)
+			}
+		}
+
+		// Weeks can start on any day natively.
+		if grain == runtimev1.TimeGrain_TIME_GRAIN_WEEK {
+			day := time.Weekday(1) // Monday
+			if firstDayOfWeek > 1 {
+				day = time.Weekday(firstDayOfWeek % 7)
+			}
+			specifier = fmt.Sprintf("WEEK(%s)", strings.ToUpper(day.String()))
+		}
+
+		// Years starting on another month are shifted in civil time, since TIMESTAMP_ADD doesn't support months.
+		if grain == runtimev1.TimeGrain_TIME_GRAIN_YEAR && firstMonthOfYear > 1 {
+			shift := 13 - firstMonthOfYear
+			loc := tz
+			if loc == "" {
+				loc = "UTC"
+			}
+			return fmt.Sprintf("TIMESTAMP(DATETIME_SUB(DATETIME_TRUNC(DATETIME_ADD(DATETIME(%s, '%s'), INTERVAL %d MONTH), YEAR), INTERVAL %d MONTH), '%s')", expr, loc, shift, shift, loc), nil
+		}
+
+		return fmt.Sprintf("TIMESTAMP_TRUNC(%s, %s%s)", expr, specifier, tzArg), nil
 	case DialectPinot:
 		// TODO: Handle tz instead of ignoring it.
 		// TODO: Handle firstDayOfWeek and firstMonthOfYear. NOTE: We currently error when configuring these for Pinot in runtime/validate.go.
@@ -3,6 +3,7 @@ package drivers_test
 import (
 	"testing"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/stretchr/testify/require"
 )
@@ -10,3 +11,74 @@ import (
 func testOLAP(t *testing.T, olap drivers.OLAPStore) {
 	require.True(t, true)
 }
+
+func TestBigQueryDialect(t *testing.T) {
+	d := drivers.DialectBigQuery
+	require.Equal(t, "`my\\`col`", d.EscapeIdentifier("my`col"))
+	require.Equal(t, "`proj`.`ds`.`tbl`", d.EscapeTable("proj", "ds", "tbl"))
+	require.Equal(t, `'it\'s'`, d.EscapeStringValue("it's"))
+
+	dim := &runtimev1.MetricsViewSpec_DimensionV2{Column: "ts"}
+	cases := []struct {
+		grain          runtimev1.TimeGrain
+		tz             string
+		firstDayOfWeek int
+		firstMonth     int
+		want           string
+	}{
+		{runtimev1.TimeGrain_TIME_GRAIN_HOUR, "Asia/Kolkata", 1, 1, "TIMESTAMP_TRUNC(`ts`, HOUR)"},
+		{runtimev1.TimeGrain_TIME_GRAIN_DAY, "Asia/Kolkata", 1, 1, "TIMESTAMP_TRUNC(`ts`, DAY, 'Asia/Kolkata')"},
+		{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "", 1, 1, "TIMESTAMP_TRUNC(`ts`, WEEK(MONDAY))"},
+		{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "UTC", 7, 1, "TIMESTAMP_TRUNC(`ts`, WEEK(SUNDAY))"},
+		{runtimev1.TimeGrain_TIME_GRAIN_YEAR, "", 1, 4, "TIMESTAMP(DATETIME_SUB(DATETIME_TRUNC(DATETIME_ADD(DATETIME(`ts`, 'UTC'), INTERVAL 9 MONTH), YEAR), INTERVAL 9 MONTH), 'UTC')"},
+	}
+	for _, tc := range cases {
+		got, err := d.DateTruncExpr(dim, tc.grain, tc.tz, tc.firstDayOfWeek, tc.firstMonth)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, got)
+	}
+}
+
+func TestDruidDateTruncExpr(t *testing.T) {
+	d := drivers.DialectDruid
+	dim := &runtimev1.MetricsViewSpec_DimensionV2{Column: "ts"}
+	cases := []struct {
+		grain          runtimev1.TimeGrain
+		tz             string
+		firstDayOfWeek int
+		firstMonth     int
+		want           string
+	}{
+		{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "", 1, 1, `date_trunc('WEEK', "ts")`},
+		{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "", 7, 1, `time_shift(date_trunc('WEEK', time_shift("ts", 'P1D', 1)), 'P1D', -1)`},
+		{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "America/New_York", 1, 1, `time_floor("ts", 'P1W', null, 'America/New_York')`},
+		{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "America/New_York", 7, 1, `time_shift(time_floor(time_shift("ts", 'P1D', 1, 'America/New_York'), 'P1W', null, 'America/New_York'), 'P1D', -1, 'America/New_York')`},
+		{runtimev1.TimeGrain_TIME_GRAIN_QUARTER, "Asia/Kathmandu", 1, 1, `time_floor("ts", 'P3M', null, 'Asia/Kathmandu')`},
+		{runtimev1.TimeGrain_TIME_GRAIN_YEAR, "Europe/Berlin", 1, 4, `time_shift(time_floor(time_shift("ts", 'P1M', 9, 'Europe/Berlin'), 'P1Y', null, 'Europe/Berlin'), 'P1M', -9, 'Europe/Berlin')`},
+	}
+	for _, tc := range cases {
+		got, err := d.DateTruncExpr(dim, tc.grain, tc.tz, tc.firstDayOfWeek, tc.firstMonth)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, got)
+	}
+}
+
+func TestDruidEscapeMetricsViewTable(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table:       "events_2024",
+		UnionTables: []string{"events_2023", "o'brien"},
+		LookupJoins: []*runtimev1.MetricsViewSpec_LookupJoin{
+			{Lookup: "country_names", Alias: "countries", KeyExpression: "country_code"},
+			{Lookup: "devices", KeyExpression: "lower(device)", Inner: true},
+		},
+	}
+	require.Equal(t,
+		`TABLE(APPEND('events_2024', 'events_2023', 'o''brien'))`+
+			` LEFT JOIN "lookup"."country_names" AS "countries" ON (country_code) = "countries"."k"`+
+			` INNER JOIN "lookup"."devices" AS "devices" ON (lower(device)) = "devices"."k"`,
+		drivers.DialectDruid.EscapeMetricsViewTable(mv),
+	)
+
+	// Other dialects ignore the Druid-specific options
+	require.Equal(t, `"events_2024"`, drivers.DialectDuckDB.EscapeMetricsViewTable(mv))
+}
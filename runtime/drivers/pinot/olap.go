@@ -5,10 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/querycost"
+	"github.com/rilldata/rill/runtime/pkg/runningquery"
+	"github.com/rilldata/rill/runtime/pkg/slowquery"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -67,7 +71,10 @@ func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
 	return res.Close()
 }
 
-func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*drivers.Result, error) {
+func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res *drivers.Result, outErr error) {
+	ctx, span := drivers.StartQuerySpan(ctx, drivers.DialectPinot, "", stmt)
+	defer func() { drivers.EndQuerySpan(span, outErr) }()
+
 	if stmt.DryRun {
 		rows, err := c.db.QueryxContext(ctx, "EXPLAIN PLAN FOR "+stmt.Query, stmt.Args...)
 		if err != nil {
@@ -82,7 +89,7 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*dri
 		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
 	}
 
-	rows, err := c.db.QueryxContext(ctx, stmt.Query, stmt.Args...)
+	conn, err := drivers.AcquireConn(ctx, c.pool, "pinot", c.db.Connx)
 	if err != nil {
 		if cancelFunc != nil {
 			cancelFunc()
@@ -90,21 +97,43 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*dri
 		return nil, err
 	}
 
+	// Track the query while it's running, so it can be listed and cancelled
+	ctx, _, done := runningquery.Start(ctx, stmt.Query)
+
+	start := time.Now()
+	rows, err := conn.QueryxContext(ctx, stmt.Query, stmt.Args...)
+	if err != nil {
+		err = runningquery.Err(ctx, err)
+		_ = conn.Close()
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		done()
+		return nil, err
+	}
+	elapsed := time.Since(start)
+	querycost.RecordQuery(ctx, elapsed)
+	slowquery.Observe(ctx, stmt.Query, elapsed)
+
 	schema, err := rowsToSchema(rows)
 	if err != nil {
 		rows.Close()
+		_ = conn.Close()
 		if cancelFunc != nil {
 			cancelFunc()
 		}
+		done()
 		return nil, err
 	}
 
 	r := &drivers.Result{Rows: rows, Schema: schema}
 	r.SetCleanupFunc(func() error {
+		err := conn.Close()
 		if cancelFunc != nil {
 			cancelFunc()
 		}
-		return nil
+		done()
+		return err
 	})
 
 	return r, nil
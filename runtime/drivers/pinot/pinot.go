@@ -6,10 +6,10 @@ import (
 	"net/url"
 
 	"github.com/jmoiron/sqlx"
-	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/drivers/pinot/sqldriver"
 	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 )
 
@@ -111,6 +111,9 @@ var spec = drivers.Spec{
 	ImplementsOLAP: true,
 }
 
+// maxOpenConnections is the default size of the connection pool
+const maxOpenConnections = 20
+
 type driver struct{}
 
 type configProperties struct {
@@ -124,6 +127,8 @@ type configProperties struct {
 	SSL bool `mapstructure:"ssl"`
 	// LogQueries controls whether to log the raw SQL passed to OLAP.Execute.
 	LogQueries bool `mapstructure:"log_queries"`
+	// PoolConfig configures the connection pool. If not set, the pool allows maxOpenConnections connections.
+	drivers.PoolConfig `mapstructure:",squash"`
 }
 
 // Open a connection to Apache Pinot using HTTP API.
@@ -133,10 +138,13 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 	}
 
 	conf := &configProperties{}
-	err := mapstructure.WeakDecode(config, conf)
+	err := drivers.DecodeConfig(config, conf)
 	if err != nil {
 		return nil, err
 	}
+	if err := conf.PoolConfig.Validate(); err != nil {
+		return nil, err
+	}
 
 	var dsn string
 	if conf.DSN != "" {
@@ -174,7 +182,7 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 	}
 
 	// very roughly approximating num queries required for a typical page load
-	db.SetMaxOpenConns(20)
+	conf.PoolConfig.Apply(db.DB, maxOpenConnections)
 
 	err = db.Ping()
 	if err != nil {
@@ -186,11 +194,18 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 		return nil, err
 	}
 
+	registration, err := drivers.RegisterPoolMetrics(db.DB, "pinot", instanceID)
+	if err != nil {
+		return nil, err
+	}
+
 	conn := &connection{
-		db:      db,
-		config:  config,
-		baseURL: controller,
-		headers: headers,
+		db:           db,
+		config:       config,
+		pool:         conf.PoolConfig,
+		baseURL:      controller,
+		headers:      headers,
+		registration: registration,
 	}
 	return conn, nil
 }
@@ -210,8 +225,11 @@ func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any
 type connection struct {
 	db      *sqlx.DB
 	config  map[string]any
+	pool    drivers.PoolConfig
 	baseURL string
 	headers map[string]string
+	// registration of pool metrics, which should be unregistered on close
+	registration metric.Registration
 }
 
 // Ping implements drivers.Handle.
@@ -231,6 +249,7 @@ func (c *connection) Config() map[string]any {
 
 // Close implements drivers.Connection.
 func (c *connection) Close() error {
+	_ = c.registration.Unregister()
 	return c.db.Close()
 }
 
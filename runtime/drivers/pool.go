@@ -0,0 +1,119 @@
+package drivers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	poolMaxOpen           = observability.Must(meter.Int64ObservableGauge("pool.max_open"))
+	poolOpen              = observability.Must(meter.Int64ObservableGauge("pool.open"))
+	poolInUse             = observability.Must(meter.Int64ObservableGauge("pool.in_use"))
+	poolIdle              = observability.Must(meter.Int64ObservableGauge("pool.idle"))
+	poolSaturation        = observability.Must(meter.Float64ObservableGauge("pool.saturation"))
+	poolWaitCount         = observability.Must(meter.Int64ObservableCounter("pool.wait_count"))
+	poolWaitDuration      = observability.Must(meter.Int64ObservableCounter("pool.wait_duration", metric.WithUnit("ms")))
+	poolAcquireTimeouts   = observability.Must(meter.Int64Counter("pool.acquire_timeouts"))
+	errPoolAcquireTimeout = errors.New("timed out waiting for a connection from the pool")
+)
+
+// PoolConfig configures the connection pool of an OLAP driver backed by database/sql.
+// Drivers embed it in their config struct with `mapstructure:",squash"` so the options can be set in the connector config.
+type PoolConfig struct {
+	// PoolMaxOpenConns is the maximum number of open connections. If zero, the driver's default is used.
+	PoolMaxOpenConns int `mapstructure:"pool_max_open_conns"`
+	// PoolMaxIdleConns is the maximum number of idle connections. If zero, database/sql's default of 2 is used.
+	PoolMaxIdleConns int `mapstructure:"pool_max_idle_conns"`
+	// PoolConnMaxLifetime is the maximum amount of time a connection may be reused. If zero, connections are reused forever.
+	PoolConnMaxLifetime time.Duration `mapstructure:"pool_conn_max_lifetime"`
+	// PoolAcquireTimeout is the maximum amount of time a query waits for a connection. If zero, it waits until the query's context is done.
+	PoolAcquireTimeout time.Duration `mapstructure:"pool_acquire_timeout"`
+}
+
+// DecodeConfig decodes a connector config into a driver's config struct.
+// Unlike mapstructure.WeakDecode, it also parses durations like "30s".
+func DecodeConfig(config map[string]any, out any) error {
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           out,
+	})
+	if err != nil {
+		return err
+	}
+	return dec.Decode(config)
+}
+
+// MaxOpenConns returns the configured maximum number of open connections, or def if not configured.
+func (c PoolConfig) MaxOpenConns(def int) int {
+	if c.PoolMaxOpenConns > 0 {
+		return c.PoolMaxOpenConns
+	}
+	return def
+}
+
+// Apply configures db's connection pool. The max open connections default to defaultMaxOpen.
+func (c PoolConfig) Apply(db *sql.DB, defaultMaxOpen int) {
+	db.SetMaxOpenConns(c.MaxOpenConns(defaultMaxOpen))
+	if c.PoolMaxIdleConns > 0 {
+		db.SetMaxIdleConns(c.PoolMaxIdleConns)
+	}
+	if c.PoolConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(c.PoolConnMaxLifetime)
+	}
+}
+
+// Validate returns an error if the pool options are invalid.
+func (c PoolConfig) Validate() error {
+	if c.PoolMaxOpenConns < 0 || c.PoolMaxIdleConns < 0 || c.PoolConnMaxLifetime < 0 || c.PoolAcquireTimeout < 0 {
+		return fmt.Errorf("pool options can't be negative")
+	}
+	if c.PoolMaxOpenConns > 0 && c.PoolMaxIdleConns > c.PoolMaxOpenConns {
+		return fmt.Errorf("pool_max_idle_conns can't exceed pool_max_open_conns")
+	}
+	return nil
+}
+
+// AcquireConn gets a connection with acquire (usually (*sqlx.DB).Connx), waiting at most cfg.PoolAcquireTimeout.
+// The timeout only applies to waiting for a connection from the pool, not to queries run on it afterwards.
+func AcquireConn[T any](ctx context.Context, cfg PoolConfig, driver string, acquire func(context.Context) (T, error)) (T, error) {
+	if cfg.PoolAcquireTimeout <= 0 {
+		return acquire(ctx)
+	}
+
+	acquireCtx, cancel := context.WithTimeoutCause(ctx, cfg.PoolAcquireTimeout, errPoolAcquireTimeout)
+	defer cancel()
+	conn, err := acquire(acquireCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(context.Cause(acquireCtx), errPoolAcquireTimeout) {
+		poolAcquireTimeouts.Add(ctx, 1, metric.WithAttributes(attribute.String("driver", driver)))
+		return conn, fmt.Errorf("%s: %w (waited %s)", driver, errPoolAcquireTimeout, cfg.PoolAcquireTimeout)
+	}
+	return conn, err
+}
+
+// RegisterPoolMetrics registers gauges that report the saturation of db's connection pool.
+// The returned registration must be unregistered when db is closed.
+func RegisterPoolMetrics(db *sql.DB, driver, instanceID string) (metric.Registration, error) {
+	attrs := metric.WithAttributes(attribute.String("driver", driver), attribute.String("instance_id", instanceID))
+	return meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		stats := db.Stats()
+		o.ObserveInt64(poolMaxOpen, int64(stats.MaxOpenConnections), attrs)
+		o.ObserveInt64(poolOpen, int64(stats.OpenConnections), attrs)
+		o.ObserveInt64(poolInUse, int64(stats.InUse), attrs)
+		o.ObserveInt64(poolIdle, int64(stats.Idle), attrs)
+		if stats.MaxOpenConnections > 0 {
+			o.ObserveFloat64(poolSaturation, float64(stats.InUse)/float64(stats.MaxOpenConnections), attrs)
+		}
+		o.ObserveInt64(poolWaitCount, stats.WaitCount, attrs)
+		o.ObserveInt64(poolWaitDuration, stats.WaitDuration.Milliseconds(), attrs)
+		return nil
+	}, poolMaxOpen, poolOpen, poolInUse, poolIdle, poolSaturation, poolWaitCount, poolWaitDuration)
+}
@@ -0,0 +1,61 @@
+package drivers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodePoolConfig(t *testing.T) {
+	type config struct {
+		DSN                string `mapstructure:"dsn"`
+		drivers.PoolConfig `mapstructure:",squash"`
+	}
+
+	cfg := &config{}
+	err := drivers.DecodeConfig(map[string]any{
+		"dsn":                    "http://localhost",
+		"pool_max_open_conns":    "40",
+		"pool_max_idle_conns":    10,
+		"pool_conn_max_lifetime": "5m",
+		"pool_acquire_timeout":   "2s",
+	}, cfg)
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost", cfg.DSN)
+	require.Equal(t, 40, cfg.MaxOpenConns(20))
+	require.Equal(t, 10, cfg.PoolMaxIdleConns)
+	require.Equal(t, 5*time.Minute, cfg.PoolConnMaxLifetime)
+	require.Equal(t, 2*time.Second, cfg.PoolAcquireTimeout)
+	require.NoError(t, cfg.Validate())
+
+	cfg = &config{}
+	require.NoError(t, drivers.DecodeConfig(map[string]any{"pool_max_open_conns": 2, "pool_max_idle_conns": 4}, cfg))
+	require.Error(t, cfg.Validate())
+	require.Equal(t, 20, (drivers.PoolConfig{}).MaxOpenConns(20))
+}
+
+func TestAcquireConnTimeout(t *testing.T) {
+	ctx := context.Background()
+	blocking := func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+
+	cfg := drivers.PoolConfig{PoolAcquireTimeout: 10 * time.Millisecond}
+	_, err := drivers.AcquireConn(ctx, cfg, "test", blocking)
+	require.ErrorContains(t, err, "timed out waiting for a connection")
+
+	// The caller's own cancellation is returned as is
+	cfg = drivers.PoolConfig{PoolAcquireTimeout: time.Minute}
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = drivers.AcquireConn(cctx, cfg, "test", blocking)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	n, err := drivers.AcquireConn(ctx, cfg, "test", func(ctx context.Context) (int, error) { return 1, nil })
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
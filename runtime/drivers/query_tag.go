@@ -0,0 +1,55 @@
+package drivers
+
+import (
+	"context"
+	"strings"
+)
+
+// maxQueryTagLen is the maximum length of a query tag. Longer tags are truncated.
+const maxQueryTagLen = 128
+
+type queryTagCtxKey struct{}
+
+// WithQueryTag returns a context that attributes OLAP queries run with it to the given tag.
+// Tags are supplied by clients to identify the UI component that issued a query (e.g. "dashboard:ad_bids/widget:leaderboard").
+// OLAP drivers forward the tag to the engine where possible, so engine-side monitoring can attribute load to it.
+// The tag is sanitized to alphanumerics and the characters "_-.:/=", so drivers can embed it in SQL comments or settings without escaping.
+func WithQueryTag(ctx context.Context, tag string) context.Context {
+	tag = sanitizeQueryTag(tag)
+	if tag == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, queryTagCtxKey{}, tag)
+}
+
+// QueryTagFromContext returns the tag set with WithQueryTag, or an empty string if no tag was set.
+func QueryTagFromContext(ctx context.Context) string {
+	tag, _ := ctx.Value(queryTagCtxKey{}).(string)
+	return tag
+}
+
+// QueryTagComment returns a SQL comment containing the context's query tag that can be prepended to a query.
+// It returns an empty string if no tag was set.
+func QueryTagComment(ctx context.Context) string {
+	tag := QueryTagFromContext(ctx)
+	if tag == "" {
+		return ""
+	}
+	return "/* rill_query_tag: " + tag + " */\n"
+}
+
+func sanitizeQueryTag(tag string) string {
+	var b strings.Builder
+	for _, r := range tag {
+		if b.Len() >= maxQueryTagLen {
+			break
+		}
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case strings.ContainsRune("_-.:/=", r):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
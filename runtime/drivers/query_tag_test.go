@@ -0,0 +1,32 @@
+package drivers_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryTag(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, "", drivers.QueryTagFromContext(ctx))
+	require.Equal(t, "", drivers.QueryTagComment(ctx))
+
+	ctx = drivers.WithQueryTag(context.Background(), "dashboard:ad_bids/widget:leaderboard")
+	require.Equal(t, "dashboard:ad_bids/widget:leaderboard", drivers.QueryTagFromContext(ctx))
+	require.Equal(t, "/* rill_query_tag: dashboard:ad_bids/widget:leaderboard */\n", drivers.QueryTagComment(ctx))
+
+	// Characters that could escape a comment or string literal are dropped
+	ctx = drivers.WithQueryTag(context.Background(), "x */ DROP TABLE foo; --'")
+	require.Equal(t, "x/DROPTABLEfoo--", drivers.QueryTagFromContext(ctx))
+
+	// Tags are truncated
+	ctx = drivers.WithQueryTag(context.Background(), strings.Repeat("a", 1000))
+	require.Len(t, drivers.QueryTagFromContext(ctx), 128)
+
+	// Empty tags are ignored
+	ctx = drivers.WithQueryTag(context.Background(), "'*'")
+	require.Equal(t, "", drivers.QueryTagFromContext(ctx))
+}
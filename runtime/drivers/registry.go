@@ -3,6 +3,8 @@ package drivers
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/c2h5oh/datasize"
@@ -17,6 +19,10 @@ type RegistryStore interface {
 	CreateInstance(ctx context.Context, instance *Instance) error
 	DeleteInstance(ctx context.Context, id string) error
 	EditInstance(ctx context.Context, instance *Instance) error
+	FindAPIKeys(ctx context.Context, instanceID string) ([]*APIKey, error)
+	FindAPIKey(ctx context.Context, id string) (*APIKey, error)
+	InsertAPIKey(ctx context.Context, key *APIKey) error
+	DeleteAPIKey(ctx context.Context, id string) error
 }
 
 // Instance represents a single data project, meaning one OLAP connection, one repo connection,
@@ -67,6 +73,27 @@ type Instance struct {
 	IgnoreInitialInvalidProjectError bool `db:"-"`
 }
 
+// APIKey is a runtime-managed credential that grants a fixed set of permissions on a single instance.
+// Only a hash of the key's secret is stored.
+type APIKey struct {
+	// Identifier (also encoded in the key string)
+	ID string
+	// InstanceID is the instance the key grants access to
+	InstanceID string
+	// Name is a human-readable label for the key
+	Name string
+	// SecretHash is a hash of the key's secret
+	SecretHash []byte `db:"secret_hash"`
+	// Permissions are names of the instance-level permissions granted by the key
+	Permissions []string
+	// Resources optionally restricts access to the listed resources (all resources if empty)
+	Resources []*runtimev1.ResourceName
+	// CreatedOn is when the key was created
+	CreatedOn time.Time `db:"created_on"`
+	// ExpiresOn is when the key expires (optional)
+	ExpiresOn *time.Time `db:"expires_on"`
+}
+
 // InstanceConfig contains dynamic configuration for an instance.
 // It is configured by parsing instance variables prefixed with "rill.".
 // For example, a variable "rill.stage_changes=true" would set the StageChanges field to true.
@@ -89,9 +116,20 @@ type InstanceConfig struct {
 	// Enabling it reduces the performance of Druid toplist queries.
 	// See runtime/metricsview/executor_rewrite_druid_exactify.go for more details.
 	MetricsExactifyDruidTopN bool `mapstructure:"rill.metrics.exactify_druid_topn"`
+	// MetricsDruidNativeTopN indicates whether to issue eligible Druid toplist queries as native topN queries instead of SQL.
+	// See runtime/metricsview/executor_druid_native.go for the criteria.
+	MetricsDruidNativeTopN bool `mapstructure:"rill.metrics.druid_native_topn"`
+	// MetricsMaxExecutionTimeoutSeconds caps the execution timeout that metrics queries can request. If set to 0, there is no cap.
+	MetricsMaxExecutionTimeoutSeconds uint32 `mapstructure:"rill.metrics.max_execution_timeout_seconds"`
 	// AlertStreamingRefDefaultRefreshCron sets a default cron expression for refreshing alerts with streaming refs.
 	// Namely, this is used to check alerts against external tables (e.g. in Druid) where new data may be added at any time (i.e. is considered "streaming").
 	AlertsDefaultStreamingRefreshCron string `mapstructure:"rill.alerts.default_streaming_refresh_cron"`
+	// DeployAllowSecrets disables the check that fails production deploys of project files containing credentials.
+	DeployAllowSecrets bool `mapstructure:"rill.deploy.allow_secrets"`
+	// RefreshMaintenanceWindow restricts scheduled refreshes of sources and models to a recurring window, e.g. "Mon-Fri 22:00-06:00 America/New_York".
+	// Scheduled refreshes that fall outside the window are deferred to the start of the next window. Refreshes caused by changes or manual triggers are not affected.
+	// See runtime/pkg/maintenancewindow for the format.
+	RefreshMaintenanceWindow string `mapstructure:"rill.refresh.maintenance_window"`
 }
 
 // ResolveOLAPConnector resolves the OLAP connector to default to for the instance.
@@ -118,6 +156,32 @@ func (i *Instance) ResolveVariables() map[string]string {
 	return r
 }
 
+// ResolveFeatureFlags returns the instance's feature flags.
+// A variable named "rill.features.<flag>" overrides the flag configured in rill.yaml, which allows setting flags for a deployment without changing its project files.
+func (i *Instance) ResolveFeatureFlags() (map[string]bool, error) {
+	res := make(map[string]bool, len(i.FeatureFlags))
+	for k, v := range i.FeatureFlags {
+		res[k] = v
+	}
+
+	for k, v := range i.ResolveVariables() {
+		name, ok := strings.CutPrefix(k, featureFlagVariablePrefix)
+		if !ok {
+			continue
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for feature flag variable %q: %w", v, k, err)
+		}
+		res[name] = b
+	}
+
+	return res, nil
+}
+
+// featureFlagVariablePrefix is the prefix of variables that override feature flags.
+const featureFlagVariablePrefix = "rill.features."
+
 // Config resolves the current dynamic config properties for the instance.
 // See InstanceConfig for details.
 func (i *Instance) Config() (InstanceConfig, error) {
@@ -130,7 +194,10 @@ func (i *Instance) Config() (InstanceConfig, error) {
 		ModelMaterializeDelaySeconds:      0,
 		MetricsApproximateComparisons:     true,
 		MetricsExactifyDruidTopN:          false,
+		MetricsDruidNativeTopN:            false,
+		MetricsMaxExecutionTimeoutSeconds: 900,            // 15 minutes
 		AlertsDefaultStreamingRefreshCron: "*/10 * * * *", // Every 10 minutes
+		DeployAllowSecrets:                false,
 	}
 
 	// Resolve variables
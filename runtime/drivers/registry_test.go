@@ -63,6 +63,41 @@ func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 	require.NoError(t, err)
 	require.Equal(t, 2, len(insts))
 
+	key := &drivers.APIKey{
+		InstanceID:  inst.ID,
+		Name:        "ci",
+		SecretHash:  []byte{0x01, 0x02},
+		Permissions: []string{"read_metrics"},
+		Resources:   []*runtimev1.ResourceName{{Kind: "rill.runtime.v1.MetricsView", Name: "dashboard"}},
+	}
+	err = reg.InsertAPIKey(ctx, key)
+	require.NoError(t, err)
+	_, err = uuid.Parse(key.ID)
+	require.NoError(t, err)
+	require.Greater(t, time.Minute, time.Since(key.CreatedOn))
+
+	resKey, err := reg.FindAPIKey(ctx, key.ID)
+	require.NoError(t, err)
+	require.Equal(t, inst.ID, resKey.InstanceID)
+	require.Equal(t, key.SecretHash, resKey.SecretHash)
+	require.Equal(t, key.Permissions, resKey.Permissions)
+	require.Len(t, resKey.Resources, 1)
+	require.Equal(t, key.Resources[0].Kind, resKey.Resources[0].Kind)
+	require.Equal(t, key.Resources[0].Name, resKey.Resources[0].Name)
+	require.Nil(t, resKey.ExpiresOn)
+
+	keys, err := reg.FindAPIKeys(ctx, inst.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+
+	err = reg.DeleteAPIKey(ctx, key.ID)
+	require.NoError(t, err)
+	_, err = reg.FindAPIKey(ctx, key.ID)
+	require.EqualError(t, err, drivers.ErrNotFound.Error())
+
+	err = reg.InsertAPIKey(ctx, &drivers.APIKey{InstanceID: inst.ID, Name: "other", SecretHash: []byte{0x03}})
+	require.NoError(t, err)
+
 	err = reg.DeleteInstance(ctx, inst.ID)
 	require.NoError(t, err)
 
@@ -72,4 +107,8 @@ func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 	insts, err = reg.FindInstances(ctx)
 	require.NoError(t, err)
 	require.Equal(t, 1, len(insts))
+
+	keys, err = reg.FindAPIKeys(ctx, inst.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(keys))
 }
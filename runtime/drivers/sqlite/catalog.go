@@ -140,3 +140,76 @@ func (c *catalogStore) DeleteResources(ctx context.Context) error {
 
 	return nil
 }
+
+func (c *catalogStore) FindMetricsViewVersions(ctx context.Context, metricsView string, limit int) ([]*drivers.MetricsViewVersion, error) {
+	rows, err := c.db.QueryxContext(
+		ctx,
+		"SELECT metrics_view, refreshed_on, row_count, schema, created_on FROM metrics_view_versions WHERE instance_id=? AND lower(metrics_view)=lower(?) ORDER BY refreshed_on DESC LIMIT ?",
+		c.instanceID,
+		metricsView,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*drivers.MetricsViewVersion
+	for rows.Next() {
+		v := &drivers.MetricsViewVersion{}
+		err := rows.Scan(&v.MetricsView, &v.RefreshedOn, &v.RowCount, &v.Schema, &v.CreatedOn)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return res, nil
+}
+
+func (c *catalogStore) InsertMetricsViewVersion(ctx context.Context, v *drivers.MetricsViewVersion, retain int) error {
+	_, err := c.db.ExecContext(
+		ctx,
+		"INSERT INTO metrics_view_versions(instance_id, metrics_view, refreshed_on, row_count, schema, created_on) VALUES (?, ?, ?, ?, ?, ?)",
+		c.instanceID,
+		v.MetricsView,
+		v.RefreshedOn,
+		v.RowCount,
+		v.Schema,
+		time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Delete versions older than the retained ones. The subquery returns NULL (deleting nothing) if there are fewer versions than retained.
+	_, err = c.db.ExecContext(
+		ctx,
+		`DELETE FROM metrics_view_versions WHERE instance_id=? AND lower(metrics_view)=lower(?) AND refreshed_on < (
+			SELECT refreshed_on FROM metrics_view_versions WHERE instance_id=? AND lower(metrics_view)=lower(?) ORDER BY refreshed_on DESC LIMIT 1 OFFSET ?
+		)`,
+		c.instanceID,
+		v.MetricsView,
+		c.instanceID,
+		v.MetricsView,
+		retain-1,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *catalogStore) DeleteMetricsViewVersions(ctx context.Context, metricsView string) error {
+	_, err := c.db.ExecContext(ctx, "DELETE FROM metrics_view_versions WHERE instance_id=? AND lower(metrics_view)=lower(?)", c.instanceID, metricsView)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+CREATE TABLE api_keys (
+	id TEXT PRIMARY KEY,
+	instance_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	secret_hash BLOB NOT NULL,
+	permissions TEXT NOT NULL DEFAULT '[]',
+	resources TEXT NOT NULL DEFAULT '[]',
+	created_on TIMESTAMP NOT NULL,
+	expires_on TIMESTAMP
+);
+CREATE INDEX api_keys_instance_id_idx ON api_keys (instance_id);
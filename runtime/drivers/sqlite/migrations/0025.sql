@@ -0,0 +1,9 @@
+CREATE TABLE metrics_view_versions (
+	instance_id TEXT NOT NULL,
+	metrics_view TEXT NOT NULL,
+	refreshed_on TIMESTAMP NOT NULL,
+	row_count INTEGER NOT NULL,
+	schema BLOB NOT NULL,
+	created_on TIMESTAMP NOT NULL
+);
+CREATE INDEX metrics_view_versions_idx ON metrics_view_versions (instance_id, lower(metrics_view), refreshed_on);
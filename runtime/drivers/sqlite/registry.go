@@ -334,6 +334,121 @@ func (c *connection) DeleteInstance(_ context.Context, id string) error {
 	ctx := context.Background()
 
 	_, err := c.db.ExecContext(ctx, "DELETE FROM instances WHERE id=$1", id)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, "DELETE FROM api_keys WHERE instance_id=$1", id)
+	return err
+}
+
+// FindAPIKeys implements drivers.RegistryStore.
+func (c *connection) FindAPIKeys(ctx context.Context, instanceID string) ([]*drivers.APIKey, error) {
+	return c.findAPIKeys(ctx, "WHERE instance_id = $1", instanceID)
+}
+
+// FindAPIKey implements drivers.RegistryStore.
+func (c *connection) FindAPIKey(ctx context.Context, id string) (*drivers.APIKey, error) {
+	ks, err := c.findAPIKeys(ctx, "WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(ks) == 0 {
+		return nil, drivers.ErrNotFound
+	}
+	return ks[0], nil
+}
+
+func (c *connection) findAPIKeys(_ context.Context, whereClause string, args ...any) ([]*drivers.APIKey, error) {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	sql := fmt.Sprintf(`
+		SELECT id, instance_id, name, secret_hash, permissions, resources, created_on, expires_on
+		FROM api_keys %s ORDER BY created_on, id
+	`, whereClause)
+
+	rows, err := c.db.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*drivers.APIKey
+	for rows.Next() {
+		var permissions, resources []byte
+		k := &drivers.APIKey{}
+		err := rows.Scan(&k.ID, &k.InstanceID, &k.Name, &k.SecretHash, &permissions, &resources, &k.CreatedOn, &k.ExpiresOn)
+		if err != nil {
+			return nil, err
+		}
+
+		k.Permissions, err = arrayFromJSON[string](permissions)
+		if err != nil {
+			return nil, err
+		}
+
+		k.Resources, err = arrayFromJSON[*runtimev1.ResourceName](resources)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, k)
+	}
+
+	return res, rows.Err()
+}
+
+// InsertAPIKey implements drivers.RegistryStore.
+func (c *connection) InsertAPIKey(_ context.Context, key *drivers.APIKey) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	if key.ID == "" {
+		key.ID = uuid.NewString()
+	}
+
+	permissions, err := arrayToJSON(key.Permissions)
+	if err != nil {
+		return err
+	}
+
+	resources, err := arrayToJSON(key.Resources)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err = c.db.ExecContext(
+		ctx,
+		`
+		INSERT INTO api_keys(id, instance_id, name, secret_hash, permissions, resources, created_on, expires_on)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`,
+		key.ID,
+		key.InstanceID,
+		key.Name,
+		key.SecretHash,
+		permissions,
+		resources,
+		now,
+		key.ExpiresOn,
+	)
+	if err != nil {
+		return err
+	}
+
+	// We assign manually instead of using RETURNING because it doesn't work for timestamps in SQLite
+	key.CreatedOn = now
+	return nil
+}
+
+// DeleteAPIKey implements drivers.RegistryStore.
+func (c *connection) DeleteAPIKey(_ context.Context, id string) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	_, err := c.db.ExecContext(ctx, "DELETE FROM api_keys WHERE id=$1", id)
 	return err
 }
 
@@ -0,0 +1,208 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+)
+
+// webhookTimeout is the timeout for posting a message to a Teams webhook.
+const webhookTimeout = 30 * time.Second
+
+type notifier struct {
+	props *NotifierProperties
+}
+
+type NotifierProperties struct {
+	Webhooks []string `mapstructure:"webhooks"`
+}
+
+func newNotifier(propsMap map[string]any) (*notifier, error) {
+	props, err := DecodeProps(propsMap)
+	if err != nil {
+		return nil, err
+	}
+	return &notifier{props: props}, nil
+}
+
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	msg := &Message{
+		Title: s.Title,
+		Text:  fmt.Sprintf("Your %s report for **%s** is ready.", s.DownloadFormat, s.ReportTime.Format(time.RFC1123)),
+		Links: []Link{
+			{Name: "Download", URL: s.DownloadLink},
+			{Name: "Open in browser", URL: s.OpenLink},
+			{Name: "Edit or unsubscribe", URL: s.EditLink},
+		},
+	}
+	return n.send(msg)
+}
+
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	executionTime := s.ExecutionTime.Format(time.RFC1123)
+	msg := &Message{
+		Title: fmt.Sprintf("%s (%s)", s.Title, executionTime),
+		Links: []Link{
+			{Name: "Open in browser", URL: s.OpenLink},
+			{Name: "Edit or unsubscribe", URL: s.EditLink},
+		},
+	}
+
+	switch s.Status {
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_PASS:
+		if s.IsRecover {
+			msg.Title = fmt.Sprintf("Recovered: %s", msg.Title)
+			msg.Text = fmt.Sprintf("The alert has recovered on **%s** from a previous failure.", executionTime)
+		} else {
+			msg.Text = fmt.Sprintf("The alert has passed on **%s**.", executionTime)
+		}
+		msg.Color = ColorGood
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL:
+		msg.Text = fmt.Sprintf("The alert was triggered on **%s**.", executionTime)
+		msg.Facts = s.FailRow
+		msg.Color = ColorAttention
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
+		msg.Text = fmt.Sprintf("The alert failed to evaluate on **%s**. It failed with the following error message: _%s_.", executionTime, s.ExecutionError)
+		msg.Color = ColorAttention
+		msg.Links = msg.Links[1:] // The open link is not useful when the alert could not be evaluated
+	default:
+		return fmt.Errorf("unknown assertion status: %v", s.Status)
+	}
+
+	return n.send(msg)
+}
+
+func (n *notifier) send(msg *Message) error {
+	for _, webhook := range n.props.Webhooks {
+		err := PostWebhook(context.Background(), webhook, msg)
+		if err != nil {
+			return fmt.Errorf("teams webhook error: %w", err)
+		}
+	}
+	return nil
+}
+
+// Theme colors for a Message.
+const (
+	ColorGood      = "2EB67D"
+	ColorAttention = "E01E5A"
+)
+
+// Message is a message posted to a Teams incoming webhook.
+type Message struct {
+	Title string
+	// Text is the body of the message. It supports a subset of Markdown.
+	Text string
+	// Color is an optional hex-encoded theme color (without a leading "#").
+	Color string
+	// Facts are rendered as a list of name/value pairs below the text.
+	Facts map[string]any
+	// Links are rendered as buttons. Links with an empty URL are omitted.
+	Links []Link
+}
+
+// Link is a button in a Message.
+type Link struct {
+	Name string
+	URL  string
+}
+
+// PostWebhook posts a message to a Teams incoming webhook URL.
+// The message is sent as a legacy actionable message card, which is the format accepted by Teams webhooks.
+func PostWebhook(ctx context.Context, url string, msg *Message) error {
+	body, err := json.Marshal(msg.card())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", res.StatusCode, string(msg))
+	}
+	return nil
+}
+
+// card converts the message to a message card payload.
+func (m *Message) card() map[string]any {
+	card := map[string]any{
+		"@type":    "MessageCard",
+		"@context": "https://schema.org/extensions",
+		"summary":  m.Title,
+		"title":    m.Title,
+		"text":     m.Text,
+	}
+	if m.Color != "" {
+		card["themeColor"] = m.Color
+	}
+
+	if len(m.Facts) > 0 {
+		keys := make([]string, 0, len(m.Facts))
+		for k := range m.Facts {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		facts := make([]map[string]any, len(keys))
+		for i, k := range keys {
+			facts[i] = map[string]any{"name": k, "value": fmt.Sprintf("%v", m.Facts[k])}
+		}
+		card["sections"] = []map[string]any{{"facts": facts}}
+	}
+
+	var actions []map[string]any
+	for _, l := range m.Links {
+		if l.URL == "" {
+			continue
+		}
+		actions = append(actions, map[string]any{
+			"@type":   "OpenUri",
+			"name":    l.Name,
+			"targets": []map[string]any{{"os": "default", "uri": l.URL}},
+		})
+	}
+	if len(actions) > 0 {
+		card["potentialAction"] = actions
+	}
+
+	return card
+}
+
+func EncodeProps(webhooks []string) map[string]any {
+	return map[string]any{
+		"webhooks": pbutil.ToSliceAny(webhooks),
+	}
+}
+
+func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
+	props := &NotifierProperties{}
+	err := mapstructure.WeakDecode(propsMap, props)
+	if err != nil {
+		return nil, err
+	}
+	return props, nil
+}
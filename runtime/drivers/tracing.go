@@ -0,0 +1,42 @@
+package drivers
+
+import (
+	"context"
+
+	"github.com/rilldata/rill/runtime/pkg/sqlfingerprint"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/rilldata/rill/runtime/drivers")
+
+// StartQuerySpan starts a trace span for executing a statement against an OLAP store.
+// The span carries a fingerprint of the SQL instead of the SQL itself to avoid exporting data embedded in literals.
+// The instanceID may be empty for drivers that don't track which instance they serve.
+// Callers must end the span with EndQuerySpan.
+func StartQuerySpan(ctx context.Context, dialect Dialect, instanceID string, stmt *Statement) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("db.system", dialect.String()),
+		attribute.String("db.statement.fingerprint", sqlfingerprint.Fingerprint(stmt.Query)),
+		attribute.Int("priority", stmt.Priority),
+		attribute.Bool("dry_run", stmt.DryRun),
+	}
+	if instanceID != "" {
+		attrs = append(attrs, attribute.String("instance_id", instanceID))
+	}
+	if tag := QueryTagFromContext(ctx); tag != "" {
+		attrs = append(attrs, attribute.String("query_tag", tag))
+	}
+	return tracer.Start(ctx, "OLAP.Execute", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// EndQuerySpan ends a span started with StartQuerySpan, recording err if it is non-nil.
+func EndQuerySpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
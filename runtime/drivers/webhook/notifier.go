@@ -0,0 +1,163 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+)
+
+// SignatureHeader is the HTTP header that holds the signature of a payload when a secret is configured.
+// Its value has the format "sha256=<hex-encoded HMAC-SHA256 of the request body>".
+const SignatureHeader = "X-Rill-Signature"
+
+// postTimeout is the timeout for posting a payload to a URL.
+const postTimeout = 30 * time.Second
+
+type notifier struct {
+	secret string
+	props  *NotifierProperties
+}
+
+type NotifierProperties struct {
+	URLs []string `mapstructure:"urls"`
+}
+
+func newNotifier(secret string, propsMap map[string]any) (*notifier, error) {
+	props, err := DecodeProps(propsMap)
+	if err != nil {
+		return nil, err
+	}
+	return &notifier{secret: secret, props: props}, nil
+}
+
+// Payload types.
+const (
+	PayloadTypeAlertStatus     = "alert_status"
+	PayloadTypeScheduledReport = "scheduled_report"
+)
+
+// AlertStatusPayload is the JSON payload posted for alert notifications.
+type AlertStatusPayload struct {
+	Type           string         `json:"type"`
+	Title          string         `json:"title"`
+	ExecutionTime  time.Time      `json:"execution_time"`
+	Status         string         `json:"status"`
+	IsRecover      bool           `json:"is_recover"`
+	FailRow        map[string]any `json:"fail_row,omitempty"`
+	ExecutionError string         `json:"execution_error,omitempty"`
+	OpenLink       string         `json:"open_link,omitempty"`
+	EditLink       string         `json:"edit_link,omitempty"`
+}
+
+// ScheduledReportPayload is the JSON payload posted for scheduled reports.
+type ScheduledReportPayload struct {
+	Type           string    `json:"type"`
+	Title          string    `json:"title"`
+	ReportTime     time.Time `json:"report_time"`
+	DownloadFormat string    `json:"download_format"`
+	OpenLink       string    `json:"open_link,omitempty"`
+	DownloadLink   string    `json:"download_link,omitempty"`
+	EditLink       string    `json:"edit_link,omitempty"`
+}
+
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	return n.send(&AlertStatusPayload{
+		Type:           PayloadTypeAlertStatus,
+		Title:          s.Title,
+		ExecutionTime:  s.ExecutionTime,
+		Status:         s.Status.String(),
+		IsRecover:      s.IsRecover,
+		FailRow:        s.FailRow,
+		ExecutionError: s.ExecutionError,
+		OpenLink:       s.OpenLink,
+		EditLink:       s.EditLink,
+	})
+}
+
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	return n.send(&ScheduledReportPayload{
+		Type:           PayloadTypeScheduledReport,
+		Title:          s.Title,
+		ReportTime:     s.ReportTime,
+		DownloadFormat: s.DownloadFormat,
+		OpenLink:       s.OpenLink,
+		DownloadLink:   s.DownloadLink,
+		EditLink:       s.EditLink,
+	})
+}
+
+func (n *notifier) send(payload any) error {
+	for _, u := range n.props.URLs {
+		err := Post(context.Background(), u, n.secret, payload)
+		if err != nil {
+			return fmt.Errorf("webhook error: %w", err)
+		}
+	}
+	return nil
+}
+
+// Post sends the JSON serialization of payload to url in a POST request.
+// If secret is not empty, the body is signed and the signature is sent in the SignatureHeader.
+func Post(ctx context.Context, url, secret string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, postTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", res.StatusCode, string(msg))
+	}
+	return nil
+}
+
+// Sign returns the value of the SignatureHeader for a request body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func EncodeProps(urls []string) map[string]any {
+	return map[string]any{
+		"urls": pbutil.ToSliceAny(urls),
+	}
+}
+
+func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
+	props := &NotifierProperties{}
+	err := mapstructure.WeakDecode(propsMap, props)
+	if err != nil {
+		return nil, err
+	}
+	return props, nil
+}
@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNotifier(t *testing.T) {
+	var body []byte
+	var signature string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+		signature = r.Header.Get(SignatureHeader)
+	}))
+	defer srv.Close()
+
+	h, err := driver{}.Open("default", map[string]any{"secret": "s3cret"}, nil, zap.NewNop())
+	require.NoError(t, err)
+	n, err := h.AsNotifier(EncodeProps([]string{srv.URL}))
+	require.NoError(t, err)
+
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		Title:         "My alert",
+		ExecutionTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"country": "US"},
+		OpenLink:      "https://example.com/open",
+	})
+	require.NoError(t, err)
+
+	payload := &AlertStatusPayload{}
+	require.NoError(t, json.Unmarshal(body, payload))
+	require.Equal(t, PayloadTypeAlertStatus, payload.Type)
+	require.Equal(t, "My alert", payload.Title)
+	require.Equal(t, "ASSERTION_STATUS_FAIL", payload.Status)
+	require.Equal(t, map[string]any{"country": "US"}, payload.FailRow)
+	require.Equal(t, Sign("s3cret", body), signature)
+}
+
+func TestNotifierErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier("", EncodeProps([]string{srv.URL}))
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{Title: "My report"})
+	require.ErrorContains(t, err, "unexpected status code 400")
+}
@@ -0,0 +1,43 @@
+package runtime
+
+import (
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/exportfilename"
+	"github.com/rilldata/rill/runtime/pkg/locale"
+)
+
+// Filename returns the filename for an export (without the file extension).
+// It renders opts.FilenameTemplate if set, and otherwise (or if the template renders to an empty string) returns defaultName.
+func (o *ExportOptions) Filename(defaultName string, vars *exportfilename.Vars) (string, error) {
+	if o.FilenameTemplate == "" {
+		return defaultName, nil
+	}
+
+	if vars == nil {
+		vars = &exportfilename.Vars{}
+	}
+	vars.Now = time.Now()
+	vars.User = o.FilenameUser
+
+	res, err := exportfilename.Render(o.FilenameTemplate, vars)
+	if err != nil {
+		return "", err
+	}
+	if res == "" {
+		return defaultName, nil
+	}
+	return res, nil
+}
+
+// ParsedLocale returns the locale for an export. It returns the default locale if opts.Locale is not set.
+func (o *ExportOptions) ParsedLocale() (*locale.Locale, error) {
+	return locale.Parse(o.Locale)
+}
+
+// CanCopyCSV returns true if a CSV export can be written directly by the OLAP engine (e.g. with DuckDB's COPY),
+// which only produces CSV files for the default locale.
+func (o *ExportOptions) CanCopyCSV() bool {
+	loc, err := o.ParsedLocale()
+	return err == nil && loc.IsDefault()
+}
@@ -18,6 +18,7 @@ type InstanceHealth struct {
 	Controller error
 	Repo       error
 	OLAP       error
+	Catalog    error
 }
 
 func (h *InstanceHealth) To() *runtimev1.InstanceHealth {
@@ -34,6 +35,9 @@ func (h *InstanceHealth) To() *runtimev1.InstanceHealth {
 	if h.OLAP != nil {
 		r.OlapError = h.OLAP.Error()
 	}
+	if h.Catalog != nil {
+		r.CatalogError = h.Catalog.Error()
+	}
 	return r
 }
 
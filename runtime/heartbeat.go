@@ -0,0 +1,56 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+// heartbeatInterval is the interval at which the runtime reports its liveness to the admin service.
+// It should be well below the heartbeat timeout configured on the admin service.
+const heartbeatInterval = time.Minute
+
+// runHeartbeater sends a heartbeat for each instance every heartbeatInterval until ctx is cancelled.
+func (r *Runtime) runHeartbeater(ctx context.Context) {
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		insts, err := r.registryCache.list()
+		if err != nil {
+			r.logger.Error("heartbeat: failed to list instances", zap.Error(err))
+			continue
+		}
+		for _, inst := range insts {
+			err := r.sendHeartbeat(ctx, inst.ID)
+			if err == nil {
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			if errors.Is(err, ErrAdminNotConfigured) || errors.Is(err, drivers.ErrNotFound) {
+				// Heartbeats are only sent for instances managed by an admin service
+				continue
+			}
+			r.logger.Warn("heartbeat: failed to send", zap.String("instance_id", inst.ID), zap.Error(err))
+		}
+	}
+}
+
+func (r *Runtime) sendHeartbeat(ctx context.Context, instanceID string) error {
+	admin, release, err := r.Admin(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return admin.SendHeartbeat(ctx, r.opts.Version)
+}
@@ -9,6 +9,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/queryerr"
 )
 
 // AST is the abstract syntax tree for a metrics SQL query.
@@ -21,6 +22,7 @@ type AST struct {
 	underlyingWhere *ExprNode
 	dimFields       []FieldNode
 	unnests         []string
+	lookups         []*lookupJoin
 	nextIdentifier  int
 
 	// Contextual info for building the AST
@@ -140,7 +142,7 @@ func NewAST(mv *runtimev1.MetricsViewSpec, sec *runtime.ResolvedSecurity, qry *Q
 		f := FieldNode{
 			Name:  dim.Name,
 			Label: dim.Label,
-			Expr:  ast.dialect.MetricsViewDimensionExpression(dim),
+			Expr:  ast.dimensionExpression(dim),
 		}
 
 		if dim.Unnest {
@@ -161,7 +163,7 @@ func NewAST(mv *runtimev1.MetricsViewSpec, sec *runtime.ResolvedSecurity, qry *Q
 	}
 
 	// Build underlying SELECT
-	tbl := ast.dialect.EscapeTable(mv.Database, mv.DatabaseSchema, mv.Table)
+	tbl := ast.dialect.EscapeMetricsViewTable(mv)
 	where, err := ast.buildUnderlyingWhere()
 	if err != nil {
 		return nil, err
@@ -320,6 +322,58 @@ func (a *AST) resolveMeasure(qm Measure, visible bool) (*runtimev1.MetricsViewSp
 		}, nil
 	}
 
+	if qm.Compute.Aggregate != nil {
+		if !qm.Compute.Aggregate.Function.Valid() {
+			return nil, fmt.Errorf("invalid aggregate function %q", qm.Compute.Aggregate.Function)
+		}
+
+		dim, err := a.lookupDimension(qm.Compute.Aggregate.Dimension, visible)
+		if err != nil {
+			return nil, err
+		}
+
+		fn := strings.ToUpper(string(qm.Compute.Aggregate.Function))
+		return &runtimev1.MetricsViewSpec_MeasureV2{
+			Name:       qm.Name,
+			Expression: fmt.Sprintf("%s(%s)", fn, a.dimensionExpression(dim)),
+			Type:       runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE,
+			Label:      fmt.Sprintf("%s of %s", strings.ToUpper(fn[:1])+strings.ToLower(fn[1:]), dimensionLabel(dim)),
+		}, nil
+	}
+
+	if qm.Compute.ArgMax != nil || qm.Compute.ArgMin != nil {
+		arg, isMax := qm.Compute.ArgMax, true
+		if arg == nil {
+			arg, isMax = qm.Compute.ArgMin, false
+		}
+
+		dim, err := a.lookupDimension(arg.Dimension, visible)
+		if err != nil {
+			return nil, err
+		}
+		by, err := a.lookupDimension(arg.By, visible)
+		if err != nil {
+			return nil, err
+		}
+
+		expr, err := a.dialect.ArgMaxExpression(a.dimensionExpression(dim), a.dimensionExpression(by), isMax)
+		if err != nil {
+			return nil, err
+		}
+
+		label := fmt.Sprintf("%s at max %s", dimensionLabel(dim), dimensionLabel(by))
+		if !isMax {
+			label = fmt.Sprintf("%s at min %s", dimensionLabel(dim), dimensionLabel(by))
+		}
+
+		return &runtimev1.MetricsViewSpec_MeasureV2{
+			Name:       qm.Name,
+			Expression: expr,
+			Type:       runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE,
+			Label:      label,
+		}, nil
+	}
+
 	if qm.Compute.ComparisonValue != nil {
 		m, err := a.lookupMeasure(qm.Compute.ComparisonValue.Measure, visible)
 		if err != nil {
@@ -369,6 +423,30 @@ func (a *AST) resolveMeasure(qm Measure, visible bool) (*runtimev1.MetricsViewSp
 		}, nil
 	}
 
+	if qm.Compute.Derived != nil {
+		expr, err := parseDerivedMeasureExpression(qm.Compute.Derived.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derived measure expression: %w", err)
+		}
+
+		// Check the referenced measures exist and are accessible under the security policy
+		refs := expr.measures()
+		for _, name := range refs {
+			_, err := a.lookupMeasure(name, visible)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return &runtimev1.MetricsViewSpec_MeasureV2{
+			Name:               qm.Name,
+			Expression:         expr.sql(a.dialect),
+			Type:               runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED,
+			ReferencedMeasures: refs,
+			Label:              strings.TrimSpace(qm.Compute.Derived.Expression),
+		}, nil
+	}
+
 	return nil, errors.New("unhandled compute operation")
 }
 
@@ -398,7 +476,7 @@ func (a *AST) lookupDimension(name string, visible bool) (*runtimev1.MetricsView
 		}
 	}
 
-	return nil, fmt.Errorf("dimension %q not found", name)
+	return nil, queryerr.Errorf(queryerr.ReasonDimensionNotFound, "dimension %q not found", name)
 }
 
 // lookupMeasure finds a measure spec in the metrics view.
@@ -416,7 +494,15 @@ func (a *AST) lookupMeasure(name string, visible bool) (*runtimev1.MetricsViewSp
 		}
 	}
 
-	return nil, fmt.Errorf("measure %q not found", name)
+	return nil, queryerr.Errorf(queryerr.ReasonMeasureNotFound, "measure %q not found", name)
+}
+
+// dimensionLabel returns the label of a dimension, falling back to its name.
+func dimensionLabel(dim *runtimev1.MetricsViewSpec_DimensionV2) string {
+	if dim.Label != "" {
+		return dim.Label
+	}
+	return dim.Name
 }
 
 // checkNameForComputedField checks that the name for a computed field does not collide with an existing dimension or measure name.
@@ -954,6 +1040,83 @@ func (a *AST) findFieldForDimension(n *SelectNode, dim *runtimev1.MetricsViewSpe
 }
 
 // generateIdentifier generates a unique table identifier for use in the AST.
+// dimensionExpression returns the SQL expression for a metrics view dimension.
+// If the dimension has a lookup table, the expression evaluates to the looked up value, and a join against the lookup table is added to the underlying table (see applyLookupJoins).
+func (a *AST) dimensionExpression(dim *runtimev1.MetricsViewSpec_DimensionV2) string {
+	expr := a.dialect.MetricsViewDimensionExpression(dim)
+	if dim.LookupTable == "" {
+		return expr
+	}
+
+	var lj *lookupJoin
+	for _, l := range a.lookups {
+		if l.dim.Name == dim.Name {
+			lj = l
+			break
+		}
+	}
+	if lj == nil {
+		lj = &lookupJoin{dim: dim, alias: a.generateIdentifier()}
+		a.lookups = append(a.lookups, lj)
+	}
+
+	defaultExpr := expr
+	if dim.LookupDefaultExpression != "" {
+		defaultExpr = dim.LookupDefaultExpression
+	}
+
+	// We check a match flag instead of using COALESCE because some OLAPs (notably ClickHouse) return default values instead of NULLs for unmatched rows in a LEFT JOIN.
+	return fmt.Sprintf("(CASE WHEN %s = 1 THEN %s ELSE %s END)", a.dialect.EscapeIdentifier(lj.matchColumn()), a.dialect.EscapeIdentifier(lj.valueColumn()), defaultExpr)
+}
+
+// applyLookupJoins rewrites the underlying table to join the lookup tables of the dimensions referenced in the query.
+// It must be called after the AST has been fully built, since dimensions may be referenced at any point during the build.
+func (a *AST) applyLookupJoins() {
+	if len(a.lookups) == 0 || a.underlyingTable == nil {
+		return
+	}
+
+	b := &strings.Builder{}
+	b.WriteString("(SELECT * FROM ")
+	b.WriteString(a.dialect.EscapeMetricsViewTable(a.metricsView))
+	for _, lj := range a.lookups {
+		// The lookup table's columns are aliased to unique names, so unqualified column references in the dimension's expression resolve against the metrics view's table.
+		fmt.Fprintf(b, " LEFT JOIN (SELECT %s AS %s, %s AS %s, 1 AS %s FROM %s) %s ON (%s) = %s.%s",
+			a.dialect.EscapeIdentifier(lj.dim.LookupKeyColumn),
+			a.dialect.EscapeIdentifier(lj.keyColumn()),
+			a.dialect.EscapeIdentifier(lj.dim.LookupValueColumn),
+			a.dialect.EscapeIdentifier(lj.valueColumn()),
+			a.dialect.EscapeIdentifier(lj.matchColumn()),
+			a.dialect.EscapeTable(a.metricsView.Database, a.metricsView.DatabaseSchema, lj.dim.LookupTable),
+			a.dialect.EscapeIdentifier(lj.alias),
+			a.dialect.MetricsViewDimensionExpression(lj.dim),
+			a.dialect.EscapeIdentifier(lj.alias),
+			a.dialect.EscapeIdentifier(lj.keyColumn()),
+		)
+	}
+	b.WriteString(")")
+
+	*a.underlyingTable = b.String()
+}
+
+// lookupJoin represents a join against the lookup table of a dimension.
+type lookupJoin struct {
+	dim   *runtimev1.MetricsViewSpec_DimensionV2
+	alias string
+}
+
+func (l *lookupJoin) keyColumn() string {
+	return fmt.Sprintf("__lookup_%s_key", l.alias)
+}
+
+func (l *lookupJoin) valueColumn() string {
+	return fmt.Sprintf("__lookup_%s_value", l.alias)
+}
+
+func (l *lookupJoin) matchColumn() string {
+	return fmt.Sprintf("__lookup_%s_match", l.alias)
+}
+
 func (a *AST) generateIdentifier() string {
 	tmp := fmt.Sprintf("t%d", a.nextIdentifier)
 	a.nextIdentifier++
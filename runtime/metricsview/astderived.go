@@ -0,0 +1,230 @@
+package metricsview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// maxDerivedMeasureExpressionLength caps the length of an ad-hoc derived measure expression.
+// It's a simple guard against deeply nested expressions, which would otherwise recurse without bound in the parser.
+const maxDerivedMeasureExpressionLength = 1000
+
+// derivedMeasureExpr is a parsed arithmetic expression over a metrics view's measures, such as "revenue / impressions".
+// Exactly one of the following is set: op (with left and right, or only right for negation), name or number.
+type derivedMeasureExpr struct {
+	op     byte // One of '+', '-', '*', '/', or 'n' for negation.
+	left   *derivedMeasureExpr
+	right  *derivedMeasureExpr
+	name   string
+	number string
+}
+
+// parseDerivedMeasureExpression parses an ad-hoc derived measure expression.
+// It supports measure names (bare or double-quoted), numeric literals, the +, -, * and / operators, and parentheses.
+func parseDerivedMeasureExpression(s string) (*derivedMeasureExpr, error) {
+	if len(s) > maxDerivedMeasureExpressionLength {
+		return nil, fmt.Errorf("expression is longer than %d characters", maxDerivedMeasureExpressionLength)
+	}
+	toks, err := tokenizeDerivedMeasureExpression(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &derivedMeasureParser{toks: toks}
+	e, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if p.idx < len(p.toks) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.toks[p.idx].text, p.toks[p.idx].pos)
+	}
+	return e, nil
+}
+
+// measures returns the names of the measures referenced in the expression (without duplicates).
+func (e *derivedMeasureExpr) measures() []string {
+	var res []string
+	seen := make(map[string]bool)
+	var walk func(e *derivedMeasureExpr)
+	walk = func(e *derivedMeasureExpr) {
+		if e == nil {
+			return
+		}
+		if e.name != "" && !seen[e.name] {
+			seen[e.name] = true
+			res = append(res, e.name)
+		}
+		walk(e.left)
+		walk(e.right)
+	}
+	walk(e)
+	return res
+}
+
+// sql renders the expression as SQL. Measure names are rendered as identifiers, so the referenced measures must be in scope.
+// Division uses the dialect's safe divide, so dividing by zero doesn't fail the query.
+func (e *derivedMeasureExpr) sql(dialect drivers.Dialect) string {
+	switch {
+	case e.name != "":
+		return dialect.EscapeIdentifier(e.name)
+	case e.number != "":
+		return e.number
+	case e.op == 'n':
+		return fmt.Sprintf("(-%s)", e.right.sql(dialect))
+	case e.op == '/':
+		return fmt.Sprintf("(%s)", dialect.SafeDivideExpression(e.left.sql(dialect), e.right.sql(dialect)))
+	default:
+		return fmt.Sprintf("(%s %c %s)", e.left.sql(dialect), e.op, e.right.sql(dialect))
+	}
+}
+
+type derivedMeasureToken struct {
+	text  string // For identifiers, the unescaped name.
+	ident bool
+	pos   int
+}
+
+func tokenizeDerivedMeasureExpression(s string) ([]derivedMeasureToken, error) {
+	var toks []derivedMeasureToken
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.IndexByte("+-*/()", c) >= 0:
+			toks = append(toks, derivedMeasureToken{text: string(c), pos: i})
+			i++
+		case c >= '0' && c <= '9' || c == '.':
+			start := i
+			for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+				i++
+			}
+			text := s[start:i]
+			if strings.Count(text, ".") > 1 || text == "." {
+				return nil, fmt.Errorf("invalid number %q at position %d", text, start)
+			}
+			toks = append(toks, derivedMeasureToken{text: text, pos: start})
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			start := i
+			for i < len(s) && (s[i] == '_' || s[i] >= 'a' && s[i] <= 'z' || s[i] >= 'A' && s[i] <= 'Z' || s[i] >= '0' && s[i] <= '9') {
+				i++
+			}
+			toks = append(toks, derivedMeasureToken{text: s[start:i], ident: true, pos: start})
+		case c == '"':
+			start := i
+			var b strings.Builder
+			i++
+			for {
+				if i >= len(s) {
+					return nil, fmt.Errorf("unterminated identifier at position %d", start)
+				}
+				if s[i] == '"' {
+					if i+1 < len(s) && s[i+1] == '"' {
+						b.WriteByte('"')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				b.WriteByte(s[i])
+				i++
+			}
+			toks = append(toks, derivedMeasureToken{text: b.String(), ident: true, pos: start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	return toks, nil
+}
+
+type derivedMeasureParser struct {
+	toks []derivedMeasureToken
+	idx  int
+}
+
+// accept consumes the next token if it's the given symbol.
+func (p *derivedMeasureParser) accept(sym string) bool {
+	if p.idx < len(p.toks) && !p.toks[p.idx].ident && p.toks[p.idx].text == sym {
+		p.idx++
+		return true
+	}
+	return false
+}
+
+func (p *derivedMeasureParser) parseSum() (*derivedMeasureExpr, error) {
+	return p.parseBinary("+-", p.parseProduct)
+}
+
+func (p *derivedMeasureParser) parseProduct() (*derivedMeasureExpr, error) {
+	return p.parseBinary("*/", p.parseFactor)
+}
+
+// parseBinary parses a left-associative sequence of operands separated by any of the given operators.
+func (p *derivedMeasureParser) parseBinary(ops string, next func() (*derivedMeasureExpr, error)) (*derivedMeasureExpr, error) {
+	left, err := next()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var op byte
+		for i := 0; i < len(ops); i++ {
+			if p.accept(ops[i : i+1]) {
+				op = ops[i]
+				break
+			}
+		}
+		if op == 0 {
+			return left, nil
+		}
+		right, err := next()
+		if err != nil {
+			return nil, err
+		}
+		left = &derivedMeasureExpr{op: op, left: left, right: right}
+	}
+}
+
+func (p *derivedMeasureParser) parseFactor() (*derivedMeasureExpr, error) {
+	if p.idx >= len(p.toks) {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	t := p.toks[p.idx]
+	switch {
+	case t.ident:
+		p.idx++
+		return &derivedMeasureExpr{name: t.text}, nil
+	case t.text[0] >= '0' && t.text[0] <= '9' || t.text[0] == '.':
+		p.idx++
+		return &derivedMeasureExpr{number: t.text}, nil
+	case p.accept("-"):
+		e, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return &derivedMeasureExpr{op: 'n', right: e}, nil
+	case p.accept("("):
+		e, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("expected %q at position %d", ")", p.pos())
+		}
+		return e, nil
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+}
+
+// pos returns the position of the next token, or the end of the input.
+func (p *derivedMeasureParser) pos() int {
+	if p.idx < len(p.toks) {
+		return p.toks[p.idx].pos
+	}
+	if len(p.toks) == 0 {
+		return 0
+	}
+	last := p.toks[len(p.toks)-1]
+	return last.pos + len(last.text)
+}
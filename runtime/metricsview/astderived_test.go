@@ -0,0 +1,59 @@
+package metricsview
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerivedMeasureExpression(t *testing.T) {
+	tt := []struct {
+		expr     string
+		measures []string
+		duckdb   string
+		druid    string
+	}{
+		{
+			expr:     "revenue / impressions * 100",
+			measures: []string{"revenue", "impressions"},
+			duckdb:   `((("revenue")/CAST("impressions" AS DOUBLE)) * 100)`,
+			druid:    `((SAFE_DIVIDE("revenue", CAST("impressions" AS DOUBLE))) * 100)`,
+		},
+		{
+			expr:     `-(a - "b c") + a * 0.5`,
+			measures: []string{"a", "b c"},
+			duckdb:   `((-("a" - "b c")) + ("a" * 0.5))`,
+		},
+		{
+			expr:     `"say ""hi"""`,
+			measures: []string{`say "hi"`},
+			duckdb:   `"say ""hi"""`,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.expr, func(t *testing.T) {
+			e, err := parseDerivedMeasureExpression(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.measures, e.measures())
+			require.Equal(t, tc.duckdb, e.sql(drivers.DialectDuckDB))
+			if tc.druid != "" {
+				require.Equal(t, tc.druid, e.sql(drivers.DialectDruid))
+			}
+		})
+	}
+
+	errs := map[string]string{
+		"":          "unexpected end of expression",
+		"revenue +": "unexpected end of expression",
+		"(a":        `expected ")" at position 2`,
+		"a $ b":     `unexpected character '$' at position 2`,
+		"a b":       `unexpected "b" at position 2`,
+		"1.2.3":     `invalid number "1.2.3" at position 0`,
+		`"a`:        "unterminated identifier at position 0",
+	}
+	for expr, msg := range errs {
+		_, err := parseDerivedMeasureExpression(expr)
+		require.ErrorContains(t, err, msg, expr)
+	}
+}
@@ -52,6 +52,9 @@ func (b *sqlExprBuilder) writeExpression(e *Expression) error {
 	if e.Condition != nil {
 		return b.writeCondition(e.Condition)
 	}
+	if e.Segment != "" {
+		return fmt.Errorf("segment %q was not resolved", e.Segment)
+	}
 	return errors.New("invalid expression")
 }
 
@@ -82,14 +85,14 @@ func (b *sqlExprBuilder) writeSubquery(sub *Subquery) error {
 	inner := &Query{
 		MetricsView:         outer.MetricsView,
 		Dimensions:          []Dimension{sub.Dimension},
-		Measures:            sub.Measures,
+		Measures:            subqueryMeasures(sub),
 		PivotOn:             nil,
-		Sort:                nil,
+		Sort:                sub.Sort,
 		TimeRange:           outer.TimeRange,
 		ComparisonTimeRange: outer.ComparisonTimeRange,
 		Where:               sub.Where,
 		Having:              sub.Having,
-		Limit:               nil,
+		Limit:               sub.Limit,
 		Offset:              nil,
 		TimeZone:            outer.TimeZone,
 		Label:               false,
@@ -115,6 +118,28 @@ func (b *sqlExprBuilder) writeSubquery(sub *Subquery) error {
 	return nil
 }
 
+// subqueryMeasures returns the subquery's measures with any measures referenced only in its sort appended.
+// This enables "top N by measure" subqueries without repeating the measure in the subquery's measures.
+func subqueryMeasures(sub *Subquery) []Measure {
+	res := sub.Measures
+	for _, s := range sub.Sort {
+		if s.Name == sub.Dimension.Name {
+			continue
+		}
+		found := false
+		for _, m := range res {
+			if m.Name == s.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			res = append(res[:len(res):len(res)], Measure{Name: s.Name})
+		}
+	}
+	return res
+}
+
 func (b *sqlExprBuilder) writeCondition(cond *Condition) error {
 	switch cond.Operator {
 	case OperatorOr:
@@ -125,7 +150,7 @@ func (b *sqlExprBuilder) writeCondition(cond *Condition) error {
 		if !cond.Operator.Valid() {
 			return fmt.Errorf("invalid expression operator %q", cond.Operator)
 		}
-		return b.writeBinaryCondition(cond.Expressions, cond.Operator)
+		return b.writeBinaryCondition(cond.Expressions, cond.Operator, cond.AccentInsensitive)
 	}
 }
 
@@ -151,7 +176,7 @@ func (b *sqlExprBuilder) writeJoinedExpressions(exprs []*Expression, joiner stri
 	return nil
 }
 
-func (b *sqlExprBuilder) writeBinaryCondition(exprs []*Expression, op Operator) error {
+func (b *sqlExprBuilder) writeBinaryCondition(exprs []*Expression, op Operator, accentInsensitive bool) error {
 	// Backwards compatibility: For IN and NIN, the right hand side may be a flattened list of values, not a single list.
 	if op == OperatorIn || op == OperatorNin {
 		if len(exprs) == 2 {
@@ -172,6 +197,11 @@ func (b *sqlExprBuilder) writeBinaryCondition(exprs []*Expression, op Operator)
 		}
 	}
 
+	// The bounds of IP_BETWEEN may be passed as separate expressions.
+	if op == OperatorIPBetween && len(exprs) == 3 && exprs[1] != nil && exprs[2] != nil {
+		exprs = []*Expression{exprs[0], {Value: []any{exprs[1].Value, exprs[2].Value}}}
+	}
+
 	if len(exprs) != 2 {
 		return fmt.Errorf("binary condition must have exactly 2 expressions")
 	}
@@ -206,7 +236,7 @@ func (b *sqlExprBuilder) writeBinaryCondition(exprs []*Expression, op Operator)
 
 		// If not unnested, write the expression as-is
 		if !unnest {
-			return b.writeBinaryConditionInner(nil, right, leftExpr, op)
+			return b.writeBinaryConditionInner(nil, right, leftExpr, op, accentInsensitive)
 		}
 
 		// Generate unnest join
@@ -217,7 +247,7 @@ func (b *sqlExprBuilder) writeBinaryCondition(exprs []*Expression, op Operator)
 		}
 		if !ok {
 			// Means the DB automatically unnests, so we can treat it as a normal value
-			return b.writeBinaryConditionInner(nil, right, leftExpr, op)
+			return b.writeBinaryConditionInner(nil, right, leftExpr, op, accentInsensitive)
 		}
 		unnestColAlias := b.ast.sqlForMember(unnestTableAlias, left.Name)
 
@@ -233,6 +263,9 @@ func (b *sqlExprBuilder) writeBinaryCondition(exprs []*Expression, op Operator)
 		case OperatorNilike:
 			op = OperatorIlike
 			not = true
+		case OperatorIPNinCIDR:
+			op = OperatorIPInCIDR
+			not = true
 		}
 
 		// Output: [NOT] EXISTS (SELECT 1 FROM <unnestFrom> WHERE <unnestColAlias> <operator> <right>)
@@ -242,7 +275,7 @@ func (b *sqlExprBuilder) writeBinaryCondition(exprs []*Expression, op Operator)
 		b.writeString("EXISTS (SELECT 1 FROM ")
 		b.writeString(unnestFrom)
 		b.writeString(" WHERE ")
-		err = b.writeBinaryConditionInner(nil, right, unnestColAlias, op)
+		err = b.writeBinaryConditionInner(nil, right, unnestColAlias, op, accentInsensitive)
 		if err != nil {
 			return err
 		}
@@ -251,10 +284,10 @@ func (b *sqlExprBuilder) writeBinaryCondition(exprs []*Expression, op Operator)
 	}
 
 	// Handle netiher side is a name
-	return b.writeBinaryConditionInner(left, right, "", op)
+	return b.writeBinaryConditionInner(left, right, "", op, accentInsensitive)
 }
 
-func (b *sqlExprBuilder) writeBinaryConditionInner(left, right *Expression, leftOverride string, op Operator) error {
+func (b *sqlExprBuilder) writeBinaryConditionInner(left, right *Expression, leftOverride string, op Operator, accentInsensitive bool) error {
 	var joiner string
 	switch op {
 	case OperatorEq:
@@ -270,13 +303,23 @@ func (b *sqlExprBuilder) writeBinaryConditionInner(left, right *Expression, left
 	case OperatorGte:
 		joiner = " >= "
 	case OperatorIlike:
+		if accentInsensitive {
+			return b.writeUnaccentedILikeCondition(left, right, leftOverride, false)
+		}
 		return b.writeILikeCondition(left, right, leftOverride, false)
 	case OperatorNilike:
+		if accentInsensitive {
+			return b.writeUnaccentedILikeCondition(left, right, leftOverride, true)
+		}
 		return b.writeILikeCondition(left, right, leftOverride, true)
 	case OperatorIn:
 		return b.writeInCondition(left, right, leftOverride, false)
 	case OperatorNin:
 		return b.writeInCondition(left, right, leftOverride, true)
+	case OperatorIPInCIDR, OperatorIPNinCIDR, OperatorIPBetween:
+		return b.writeIPCondition(left, right, leftOverride, op)
+	case OperatorRegex:
+		return b.writeRegexCondition(left, right, leftOverride)
 	default:
 		return fmt.Errorf("invalid binary condition operator %q", op)
 	}
@@ -374,6 +417,159 @@ func (b *sqlExprBuilder) writeILikeCondition(left, right *Expression, leftOverri
 	return nil
 }
 
+func (b *sqlExprBuilder) writeIPCondition(left, right *Expression, leftOverride string, op Operator) error {
+	var start, end string
+	var err error
+	if op == OperatorIPBetween {
+		start, end, err = IPRangeBounds(right.Value)
+	} else {
+		cidr, ok := right.Value.(string)
+		if !ok {
+			return fmt.Errorf("the right value must be a CIDR string for an %q condition", op)
+		}
+		start, end, err = CIDRBounds(cidr)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Render the left side separately so it can be wrapped in the dialect's IP conversion.
+	leftExpr, leftArgs, err := b.renderOperand(left, leftOverride)
+	if err != nil {
+		return err
+	}
+
+	ipExpr, ok := b.ast.dialect.IPAddressExpr(leftExpr)
+	if !ok {
+		return fmt.Errorf("the %q operator is not supported for %s", op, b.ast.dialect.String())
+	}
+	startExpr, _ := b.ast.dialect.IPAddressExpr("?")
+	endExpr, _ := b.ast.dialect.IPAddressExpr("?")
+
+	// Output: [(NOT] <ip(left)> BETWEEN <ip(start)> AND <ip(end)> [OR <left> IS NULL)]
+	// Like for NOT ILIKE, NULL values are included by the negated condition.
+	not := op == OperatorIPNinCIDR
+	if not {
+		b.writeString("(NOT ")
+	}
+	b.writeByte('(')
+	b.writeString(ipExpr)
+	b.writeString(" BETWEEN ")
+	b.writeString(startExpr)
+	b.writeString(" AND ")
+	b.writeString(endExpr)
+	b.writeByte(')')
+	b.args = append(b.args, leftArgs...)
+	b.args = append(b.args, start, end)
+	if not {
+		b.writeString(" OR ")
+		b.writeParenthesizedString(leftExpr)
+		b.writeString(" IS NULL)")
+		b.args = append(b.args, leftArgs...)
+	}
+	return nil
+}
+
+func (b *sqlExprBuilder) writeRegexCondition(left, right *Expression, leftOverride string) error {
+	// The pattern must be a literal so it can be validated before it reaches the database.
+	if right.Name != "" || right.Condition != nil || right.Subquery != nil {
+		return fmt.Errorf("the right side of a %q condition must be a string value", OperatorRegex)
+	}
+	pattern, ok := right.Value.(string)
+	if !ok {
+		return fmt.Errorf("the right side of a %q condition must be a string value", OperatorRegex)
+	}
+	err := ValidateRegex(pattern)
+	if err != nil {
+		return err
+	}
+
+	leftExpr, leftArgs, err := b.renderOperand(left, leftOverride)
+	if err != nil {
+		return err
+	}
+
+	expr, ok := b.ast.dialect.RegexpMatchExpr(leftExpr, "?")
+	if !ok {
+		return fmt.Errorf("the %q operator is not supported for %s", OperatorRegex, b.ast.dialect.String())
+	}
+	b.writeString(expr)
+	b.args = append(b.args, leftArgs...)
+	b.args = append(b.args, pattern)
+	return nil
+}
+
+func (b *sqlExprBuilder) writeUnaccentedILikeCondition(left, right *Expression, leftOverride string, not bool) error {
+	leftExpr, leftArgs, err := b.renderOperand(left, leftOverride)
+	if err != nil {
+		return err
+	}
+	rightExpr, rightArgs, err := b.renderOperand(right, "")
+	if err != nil {
+		return err
+	}
+
+	strippedLeft, ok := b.ast.dialect.StripAccentsExpr(leftExpr)
+	if !ok {
+		return fmt.Errorf("accent-insensitive matching is not supported for %s", b.ast.dialect.String())
+	}
+	strippedRight, _ := b.ast.dialect.StripAccentsExpr(rightExpr)
+
+	// Output: [(]<strip(left)> [NOT] ILIKE <strip(right)> [OR <left> IS NULL)]
+	if not {
+		b.writeByte('(')
+	}
+	if b.ast.dialect.SupportsILike() {
+		b.writeString(strippedLeft)
+		if not {
+			b.writeString(" NOT ILIKE ")
+		} else {
+			b.writeString(" ILIKE ")
+		}
+		b.writeString(strippedRight)
+	} else {
+		b.writeString("LOWER(" + strippedLeft + ")")
+		if not {
+			b.writeString(" NOT LIKE ")
+		} else {
+			b.writeString(" LIKE ")
+		}
+		b.writeString("LOWER(" + strippedRight + ")")
+	}
+	b.args = append(b.args, leftArgs...)
+	b.args = append(b.args, rightArgs...)
+
+	// As for NOT ILIKE, explicitly include NULL values.
+	if not {
+		b.writeString(" OR ")
+		b.writeParenthesizedString(leftExpr)
+		b.writeString(" IS NULL)")
+		b.args = append(b.args, leftArgs...)
+	}
+
+	return nil
+}
+
+// renderOperand returns the SQL for an operand of a condition without writing it, so it can be wrapped in a function call.
+// If override is not empty, it is returned instead.
+func (b *sqlExprBuilder) renderOperand(e *Expression, override string) (string, []any, error) {
+	if override != "" {
+		return override, nil, nil
+	}
+	ob := &sqlExprBuilder{
+		ast:          b.ast,
+		node:         b.node,
+		pseudoHaving: b.pseudoHaving,
+		visible:      b.visible,
+		out:          &strings.Builder{},
+	}
+	err := ob.writeExpression(e)
+	if err != nil {
+		return "", nil, err
+	}
+	return ob.out.String(), ob.args, nil
+}
+
 func (b *sqlExprBuilder) writeInCondition(left, right *Expression, leftOverride string, not bool) error {
 	if right.Value != nil {
 		vals, ok := right.Value.([]any)
@@ -547,7 +743,7 @@ func (b *sqlExprBuilder) sqlForName(name string) (expr string, unnest bool, err
 		}
 
 		// Note: If dim.Unnest is true, we need to unnest it inside of the generated expression (because it's not part of the dimFields and therefore not unnested with a LATERAL JOIN).
-		return b.ast.dialect.MetricsViewDimensionExpression(dim), dim.Unnest, nil
+		return b.ast.dimensionExpression(dim), dim.Unnest, nil
 	}
 
 	// Since node is not nil, we're in the context of a wrapped SELECT.
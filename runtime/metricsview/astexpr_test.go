@@ -0,0 +1,66 @@
+package metricsview
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccentInsensitiveLikeSQL(t *testing.T) {
+	ast := &AST{
+		dialect:   drivers.DialectDuckDB,
+		dimFields: []FieldNode{{Name: "city", Expr: `"city"`}},
+	}
+
+	sql, args, err := ast.sqlForExpression(&Expression{Condition: &Condition{
+		Operator:          OperatorIlike,
+		Expressions:       []*Expression{{Name: "city"}, {Value: "%sao%"}},
+		AccentInsensitive: true,
+	}}, nil, false, false)
+	require.NoError(t, err)
+	require.Equal(t, `strip_accents("city") ILIKE strip_accents(?)`, sql)
+	require.Equal(t, []any{"%sao%"}, args)
+
+	sql, _, err = ast.sqlForExpression(&Expression{Condition: &Condition{
+		Operator:          OperatorNilike,
+		Expressions:       []*Expression{{Name: "city"}, {Value: "%sao%"}},
+		AccentInsensitive: true,
+	}}, nil, false, false)
+	require.NoError(t, err)
+	require.Equal(t, `(strip_accents("city") NOT ILIKE strip_accents(?) OR ("city") IS NULL)`, sql)
+
+	// Without the flag, the condition is unchanged
+	sql, _, err = ast.sqlForExpression(&Expression{Condition: &Condition{
+		Operator:    OperatorIlike,
+		Expressions: []*Expression{{Name: "city"}, {Value: "%sao%"}},
+	}}, nil, false, false)
+	require.NoError(t, err)
+	require.NotContains(t, sql, "strip_accents")
+
+	ast.dialect = drivers.DialectDruid
+	_, _, err = ast.sqlForExpression(&Expression{Condition: &Condition{
+		Operator:          OperatorIlike,
+		Expressions:       []*Expression{{Name: "city"}, {Value: "%sao%"}},
+		AccentInsensitive: true,
+	}}, nil, false, false)
+	require.ErrorContains(t, err, "not supported for druid")
+}
+
+func TestSubqueryMeasures(t *testing.T) {
+	// Sorting by a measure that isn't selected adds it to the subquery
+	sub := &Subquery{
+		Dimension: Dimension{Name: "campaign"},
+		Sort:      []Sort{{Name: "revenue", Desc: true}},
+	}
+	require.Equal(t, []Measure{{Name: "revenue"}}, subqueryMeasures(sub))
+
+	// Sorting by the dimension or a selected measure doesn't change the measures
+	sub = &Subquery{
+		Dimension: Dimension{Name: "campaign"},
+		Measures:  []Measure{{Name: "revenue"}},
+		Sort:      []Sort{{Name: "campaign"}, {Name: "revenue", Desc: true}},
+	}
+	require.Equal(t, []Measure{{Name: "revenue"}}, subqueryMeasures(sub))
+	require.Len(t, sub.Measures, 1)
+}
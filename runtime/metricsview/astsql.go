@@ -8,6 +8,8 @@ import (
 // SQL builds a SQL query from the AST.
 // It returns the query and query arguments to be passed to the database driver.
 func (a *AST) SQL() (string, []any, error) {
+	a.applyLookupJoins()
+
 	b := &sqlBuilder{
 		ast: a,
 		out: &strings.Builder{},
@@ -9,8 +9,16 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/queryerr"
+	"github.com/rilldata/rill/runtime/pkg/sqlfingerprint"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/rilldata/rill/runtime/metricsview")
+
 const (
 	defaultInteractiveTimeout = time.Minute * 3
 	defaultExportTimeout      = time.Minute * 5
@@ -79,6 +87,12 @@ func (e *Executor) Watermark(ctx context.Context) (time.Time, error) {
 	return e.loadWatermark(ctx, nil)
 }
 
+// ResolveTimeRange resolves a time range in the given time zone, ensuring only its Start and End properties are populated.
+// Relative time ranges are resolved against the metrics view's time anchor (the watermark unless configured otherwise).
+func (e *Executor) ResolveTimeRange(ctx context.Context, tr *TimeRange, tz *time.Location, executionTime *time.Time) error {
+	return e.resolveTimeRange(ctx, tr, tz, executionTime)
+}
+
 // Schema returns a schema for the metrics view's dimensions and measures.
 func (e *Executor) Schema(ctx context.Context) (*runtimev1.StructType, error) {
 	if !e.security.CanAccess() {
@@ -145,9 +159,10 @@ func (e *Executor) Schema(ctx context.Context) (*runtimev1.StructType, error) {
 		Args:             args,
 		Priority:         e.priority,
 		ExecutionTimeout: defaultInteractiveTimeout,
+		AllowReplica:     true,
 	})
 	if err != nil {
-		return nil, err
+		return nil, queryerr.Wrap(queryerr.ReasonOLAPError, err)
 	}
 	defer res.Close()
 
@@ -156,58 +171,117 @@ func (e *Executor) Schema(ctx context.Context) (*runtimev1.StructType, error) {
 
 // Query executes the provided query against the metrics view.
 func (e *Executor) Query(ctx context.Context, qry *Query, executionTime *time.Time) (*drivers.Result, bool, error) {
+	res, cache, _, err := e.query(ctx, qry, executionTime)
+	return res, cache, err
+}
+
+// QueryWithPivotHeaders is like Query, but for pivot queries it also returns a header for each pivoted column in the result.
+// The headers break the pivoted column names down into the values of each pivoted dimension, which lets clients render nested headers.
+// For queries that don't pivot, the headers are nil.
+func (e *Executor) QueryWithPivotHeaders(ctx context.Context, qry *Query, executionTime *time.Time) (*drivers.Result, []PivotHeader, error) {
+	res, _, headers, err := e.query(ctx, qry, executionTime)
+	return res, headers, err
+}
+
+func (e *Executor) query(ctx context.Context, qry *Query, executionTime *time.Time) (_ *drivers.Result, _ bool, _ []PivotHeader, outErr error) {
+	ctx, span := tracer.Start(ctx, "Executor.Query", trace.WithAttributes(
+		attribute.String("instance_id", e.instanceID),
+		attribute.String("table", e.metricsView.Table),
+	))
+	defer func() {
+		if outErr != nil {
+			span.RecordError(outErr)
+			span.SetStatus(codes.Error, outErr.Error())
+		}
+		span.End()
+	}()
+
 	if !e.security.CanAccess() {
-		return nil, false, runtime.ErrForbidden
+		return nil, false, nil, runtime.ErrForbidden
+	}
+
+	if err := e.rewriteQuerySegments(ctx, qry); err != nil {
+		return nil, false, nil, err
 	}
 
 	rowsCap, err := e.rewriteQueryEnforceCaps(qry)
 	if err != nil {
-		return nil, false, err
+		return nil, false, nil, err
 	}
 
 	pivotAST, pivoting, err := e.rewriteQueryForPivot(qry)
 	if err != nil {
-		return nil, false, err
+		return nil, false, nil, err
 	}
 
+	timeout := e.executionTimeout(qry, defaultInteractiveTimeout)
+
 	if err := e.rewriteQueryTimeRanges(ctx, qry, executionTime); err != nil {
-		return nil, false, err
+		return nil, false, nil, err
 	}
 
 	if err := e.rewriteQueryDruidExactify(ctx, qry); err != nil {
-		return nil, false, err
+		return nil, false, nil, err
+	}
+
+	// Eligible Druid queries are sent as native queries, which bypass the AST.
+	if !pivoting {
+		native, ok, err := e.druidNativeQuery(qry)
+		if err != nil {
+			return nil, false, nil, err
+		}
+		if ok {
+			span.SetAttributes(attribute.Bool("druid_native", true))
+			res, err := e.olap.Execute(ctx, &drivers.Statement{
+				Query:            native,
+				Priority:         e.priority,
+				ExecutionTimeout: timeout,
+			})
+			if err != nil {
+				return nil, false, nil, queryerr.Wrap(queryerr.ReasonOLAPError, err)
+			}
+			if rowsCap > 0 {
+				res.SetCap(rowsCap)
+			}
+			return res, false, nil, nil
+		}
 	}
 
 	ast, err := NewAST(e.metricsView, e.security, qry, e.olap.Dialect())
 	if err != nil {
-		return nil, false, err
+		return nil, false, nil, err
 	}
 
 	e.rewriteApproxComparisons(ast)
 
 	if err := e.rewriteLimitsIntoSubqueries(ast); err != nil {
-		return nil, false, err
+		return nil, false, nil, err
 	}
 
 	if err := e.rewriteDruidGroups(ast); err != nil {
-		return nil, false, err
+		return nil, false, nil, err
 	}
 
+	e.rewriteStableSort(ast)
+
 	var res *drivers.Result
+	var headers []PivotHeader
 	if !pivoting {
 		sql, args, err := ast.SQL()
 		if err != nil {
-			return nil, false, err
+			return nil, false, nil, err
 		}
+		span.SetAttributes(attribute.String("db.statement.fingerprint", sqlfingerprint.Fingerprint(sql)))
 
 		res, err = e.olap.Execute(ctx, &drivers.Statement{
 			Query:            sql,
 			Args:             args,
 			Priority:         e.priority,
-			ExecutionTimeout: defaultInteractiveTimeout,
+			ExecutionTimeout: timeout,
+			AllowReplica:     true,
 		})
 		if err != nil {
-			return nil, false, err
+			return nil, false, nil, queryerr.Wrap(queryerr.ReasonOLAPError, err)
 		}
 	} else {
 		// Since pivots are mainly used for exports, we just do an inefficient shim that runs a pivoted export to a temporary Parquet file, and then reads the file into a *drivers.Result using DuckDB.
@@ -221,33 +295,34 @@ func (e *Executor) Query(ctx context.Context, qry *Query, executionTime *time.Ti
 		} else {
 			handle, release, err := e.rt.AcquireHandle(ctx, e.instanceID, "duckdb")
 			if err != nil {
-				return nil, false, fmt.Errorf("failed to acquire DuckDB for serving pivot: %w", err)
+				return nil, false, nil, fmt.Errorf("failed to acquire DuckDB for serving pivot: %w", err)
 			}
 
 			var ok bool
 			duck, ok = handle.AsOLAP(e.instanceID)
 			if !ok {
 				release()
-				return nil, false, fmt.Errorf(`connector "duckdb" is not an OLAP store`)
+				return nil, false, nil, fmt.Errorf(`connector "duckdb" is not an OLAP store`)
 			}
 			releaseDuck = release
 		}
 
 		// Execute the pivot export
-		path, err := e.executePivotExport(ctx, ast, pivotAST, "parquet")
+		var path string
+		path, headers, err = e.executePivotExport(ctx, ast, pivotAST, "parquet", e.executionTimeout(qry, defaultPivotExportTimeout))
 		if err != nil {
-			return nil, false, err
+			return nil, false, nil, err
 		}
 
 		// Use DuckDB to read the Parquet file into a *drivers.Result
 		res, err = duck.Execute(ctx, &drivers.Statement{
 			Query:            fmt.Sprintf("SELECT * FROM '%s'", path),
 			Priority:         e.priority,
-			ExecutionTimeout: defaultInteractiveTimeout,
+			ExecutionTimeout: timeout,
 		})
 		if err != nil {
 			_ = os.Remove(path)
-			return nil, false, err
+			return nil, false, nil, err
 		}
 		res.SetCleanupFunc(func() error {
 			if releaseDuck != nil {
@@ -265,7 +340,7 @@ func (e *Executor) Query(ctx context.Context, qry *Query, executionTime *time.Ti
 	// TODO: Get from OLAP instead of hardcoding
 	cache := e.olap.Dialect() == drivers.DialectDuckDB
 
-	return res, cache, nil
+	return res, cache, headers, nil
 }
 
 // Export executes and exports the provided query against the metrics view.
@@ -275,6 +350,10 @@ func (e *Executor) Export(ctx context.Context, qry *Query, executionTime *time.T
 		return "", runtime.ErrForbidden
 	}
 
+	if err := e.rewriteQuerySegments(ctx, qry); err != nil {
+		return "", err
+	}
+
 	pivotAST, pivoting, err := e.rewriteQueryForPivot(qry)
 	if err != nil {
 		return "", err
@@ -303,8 +382,11 @@ func (e *Executor) Export(ctx context.Context, qry *Query, executionTime *time.T
 		return "", err
 	}
 
+	e.rewriteStableSort(ast)
+
 	if pivoting {
-		return e.executePivotExport(ctx, ast, pivotAST, format)
+		path, _, err := e.executePivotExport(ctx, ast, pivotAST, format, e.executionTimeout(qry, defaultPivotExportTimeout))
+		return path, err
 	}
 
 	sql, args, err := ast.SQL()
@@ -312,8 +394,39 @@ func (e *Executor) Export(ctx context.Context, qry *Query, executionTime *time.T
 		return "", err
 	}
 
+	var outputProps map[string]any
+	if qry.Locale != "" {
+		outputProps = map[string]any{"locale": qry.Locale}
+	}
+
 	return e.executeExport(ctx, format, e.metricsView.Connector, map[string]any{
 		"sql":  sql,
 		"args": args,
-	})
+	}, outputProps, e.executionTimeout(qry, defaultExportTimeout))
+}
+
+// ExecutionTimeout returns the timeout that Query applies when executing the query.
+func (e *Executor) ExecutionTimeout(qry *Query) time.Duration {
+	return e.executionTimeout(qry, defaultInteractiveTimeout)
+}
+
+// RowsCap returns the max number of rows that Query can return, or 0 if it's not capped.
+func (e *Executor) RowsCap() int64 {
+	return e.instanceCfg.InteractiveSQLRowLimit
+}
+
+// executionTimeout returns the timeout to apply when executing the query.
+// It uses the timeout requested in the query if set, else the provided default, and clamps it to the instance's configured max.
+func (e *Executor) executionTimeout(qry *Query, def time.Duration) time.Duration {
+	timeout := def
+	if qry.ExecutionTimeoutSeconds > 0 {
+		timeout = time.Duration(qry.ExecutionTimeoutSeconds) * time.Second
+	}
+	if e.instanceCfg.MetricsMaxExecutionTimeoutSeconds > 0 {
+		maxTimeout := time.Duration(e.instanceCfg.MetricsMaxExecutionTimeoutSeconds) * time.Second
+		if timeout > maxTimeout {
+			timeout = maxTimeout
+		}
+	}
+	return timeout
 }
@@ -0,0 +1,208 @@
+package metricsview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
+)
+
+// AnomalyMethod is a method for scoring how much a value deviates from a trailing window of values.
+type AnomalyMethod string
+
+const (
+	// AnomalyMethodZScore scores a value by its number of standard deviations from the trailing mean.
+	AnomalyMethodZScore AnomalyMethod = "zscore"
+	// AnomalyMethodPercentDeviation scores a value by its percent deviation from the trailing mean.
+	AnomalyMethodPercentDeviation AnomalyMethod = "percent_deviation"
+)
+
+func (m AnomalyMethod) Valid() bool {
+	switch m {
+	case AnomalyMethodZScore, AnomalyMethodPercentDeviation:
+		return true
+	}
+	return false
+}
+
+// AnomalyQuery compares the value of a measure in the latest complete time period against the values of the preceding periods.
+type AnomalyQuery struct {
+	MetricsView string        `mapstructure:"metrics_view"`
+	Measure     string        `mapstructure:"measure"`
+	TimeGrain   TimeGrain     `mapstructure:"time_grain"`
+	Window      int           `mapstructure:"window"`
+	Method      AnomalyMethod `mapstructure:"method"`
+	Where       *Expression   `mapstructure:"where"`
+	TimeZone    string        `mapstructure:"time_zone"`
+}
+
+// AnomalyResult is the result of an AnomalyQuery.
+type AnomalyResult struct {
+	// Time is the start of the latest complete time period.
+	Time time.Time
+	// Value is the measure's value in the latest complete time period.
+	Value float64
+	// Mean and StdDev are the (population) mean and standard deviation of the measure over the trailing window.
+	Mean   float64
+	StdDev float64
+	// Periods is the number of periods in the trailing window that had a value.
+	Periods int
+	// Score is the z-score or percent deviation of Value. It is nil if it's undefined (e.g. because the standard deviation is zero).
+	Score *float64
+}
+
+// Anomaly scores the latest complete time period of a measure against its trailing window.
+// The periods are determined relative to the metrics view's time anchor (or executionTime if provided).
+// It returns false if the measure has no value in the latest complete period.
+func (e *Executor) Anomaly(ctx context.Context, qry *AnomalyQuery, executionTime *time.Time) (*AnomalyResult, bool, error) {
+	if !e.security.CanAccess() {
+		return nil, false, runtime.ErrForbidden
+	}
+
+	if e.metricsView.TimeDimension == "" {
+		return nil, false, errors.New("metrics view does not have a time dimension")
+	}
+	if qry.TimeGrain == TimeGrainUnspecified || qry.TimeGrain == TimeGrainMillisecond || !qry.TimeGrain.Valid() {
+		return nil, false, fmt.Errorf("invalid time grain %q", qry.TimeGrain)
+	}
+	if qry.Window < 2 {
+		return nil, false, errors.New("the window must span at least two periods")
+	}
+	if !qry.Method.Valid() {
+		return nil, false, fmt.Errorf("invalid anomaly method %q", qry.Method)
+	}
+
+	tz := time.UTC
+	if qry.TimeZone != "" {
+		var err error
+		tz, err = time.LoadLocation(qry.TimeZone)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid time zone %q: %w", qry.TimeZone, err)
+		}
+	}
+
+	// Query the measure for the latest complete period and the trailing window.
+	// Rounding the end of the time range to the grain excludes the current (incomplete) period.
+	dur, err := grainDuration(qry.TimeGrain, qry.Window+1)
+	if err != nil {
+		return nil, false, err
+	}
+	limit := int64(qry.Window + 1)
+	timeDim := e.metricsView.TimeDimension
+	inner := &Query{
+		MetricsView: qry.MetricsView,
+		Dimensions: []Dimension{{
+			Name: timeDim,
+			Compute: &DimensionCompute{TimeFloor: &DimensionComputeTimeFloor{
+				Dimension: timeDim,
+				Grain:     qry.TimeGrain,
+			}},
+		}},
+		Measures: []Measure{{Name: qry.Measure}},
+		Sort:     []Sort{{Name: timeDim, Desc: true}},
+		TimeRange: &TimeRange{
+			IsoDuration:  dur,
+			RoundToGrain: qry.TimeGrain,
+		},
+		Where:    qry.Where,
+		Limit:    &limit,
+		TimeZone: qry.TimeZone,
+	}
+	err = e.rewriteQuerySegments(ctx, inner)
+	if err != nil {
+		return nil, false, err
+	}
+	err = e.rewriteQueryTimeRanges(ctx, inner, executionTime)
+	if err != nil {
+		return nil, false, err
+	}
+	fdow := int(e.metricsView.FirstDayOfWeek)
+	if fdow > 7 || fdow <= 0 {
+		fdow = 1
+	}
+	fmoy := int(e.metricsView.FirstMonthOfYear)
+	if fmoy > 12 || fmoy <= 0 {
+		fmoy = 1
+	}
+	latest := timeutil.TruncateTime(inner.TimeRange.End.Add(-time.Millisecond), qry.TimeGrain.ToTimeutil(), tz, fdow, fmoy)
+
+	ast, err := NewAST(e.metricsView, e.security, inner, e.olap.Dialect())
+	if err != nil {
+		return nil, false, err
+	}
+	innerSQL, args, err := ast.SQL()
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Compute the statistics and score in SQL, so only a single row is returned from the OLAP.
+	d := e.olap.Dialect()
+	var scoreExpr string
+	switch qry.Method {
+	case AnomalyMethodZScore:
+		scoreExpr = d.SafeDivideExpression("v - mean", "NULLIF(stddev, 0)")
+	case AnomalyMethodPercentDeviation:
+		scoreExpr = fmt.Sprintf("100 * %s", d.SafeDivideExpression("v - mean", "NULLIF(ABS(mean), 0)"))
+	}
+	sql := fmt.Sprintf(`
+		SELECT t, v, mean, stddev, n, %s AS score FROM (
+			SELECT
+				MAX(CASE WHEN rn = 1 THEN t END) AS t,
+				MAX(CASE WHEN rn = 1 THEN v END) AS v,
+				AVG(CASE WHEN rn > 1 THEN v END) AS mean,
+				STDDEV_POP(CASE WHEN rn > 1 THEN v END) AS stddev,
+				COUNT(CASE WHEN rn > 1 THEN v END) AS n
+			FROM (
+				SELECT %s AS t, CAST(%s AS DOUBLE) AS v, ROW_NUMBER() OVER (ORDER BY %s DESC) AS rn FROM (%s) s
+			) r
+		) a
+	`, scoreExpr, d.EscapeIdentifier(timeDim), d.EscapeIdentifier(qry.Measure), d.EscapeIdentifier(timeDim), innerSQL)
+
+	res, err := e.olap.Execute(ctx, &drivers.Statement{
+		Query:            sql,
+		Args:             args,
+		Priority:         e.priority,
+		ExecutionTimeout: defaultInteractiveTimeout,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	defer res.Close()
+
+	if !res.Next() {
+		if res.Err() != nil {
+			return nil, false, res.Err()
+		}
+		return nil, false, nil
+	}
+	var t *time.Time
+	var v, mean, stddev, score *float64
+	var n int64
+	err = res.Scan(&t, &v, &mean, &stddev, &n, &score)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The latest row may be an earlier period if the latest period has no data.
+	if t == nil || v == nil || t.Before(latest) {
+		return nil, false, nil
+	}
+
+	r := &AnomalyResult{
+		Time:    *t,
+		Value:   *v,
+		Periods: int(n),
+		Score:   score,
+	}
+	if mean != nil {
+		r.Mean = *mean
+	}
+	if stddev != nil {
+		r.StdDev = *stddev
+	}
+	return r, true, nil
+}
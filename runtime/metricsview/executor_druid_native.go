@@ -0,0 +1,341 @@
+package metricsview
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// druidNativeMaxThreshold is the largest limit that is served with a native topN query.
+// It matches Druid's default threshold for planning SQL queries as topN.
+const druidNativeMaxThreshold = 1000
+
+// Druid's representation of the min and max timestamps, used for unbounded intervals.
+const (
+	druidMinTime = "-146136543-09-08T08:23:32.096Z"
+	druidMaxTime = "146140482-04-24T15:36:27.903Z"
+)
+
+// druidAggregationRegex matches the measure expressions that can be translated to native aggregators.
+var druidAggregationRegex = regexp.MustCompile(`(?i)^\s*(sum|min|max|count|approx_quantile_ds)\s*\(\s*(\*|"[^"]+"|[a-z_][a-z0-9_]*)\s*(?:,\s*([0-9]*\.?[0-9]+)\s*)?\)\s*$`)
+
+// druidNativeQuery returns a native Druid query (as JSON) for the query if it's enabled and the query is eligible.
+// Druid SQL can't express some query shapes efficiently, notably topN queries with approximate quantiles.
+// The native topN query returns the same columns as the SQL query would, so callers can use the result interchangeably.
+// It returns false if the query should be executed as SQL.
+func (e *Executor) druidNativeQuery(qry *Query) (string, bool, error) {
+	if !e.instanceCfg.MetricsDruidNativeTopN || e.olap.Dialect() != drivers.DialectDruid {
+		return "", false, nil
+	}
+
+	// Unions and lookup joins are only applied in the SQL path.
+	if len(e.metricsView.UnionTables) > 0 || len(e.metricsView.LookupJoins) > 0 {
+		return "", false, nil
+	}
+
+	// Security policies are only applied in the SQL path.
+	if e.security.RowFilter() != "" || e.security.QueryFilter() != nil {
+		return "", false, nil
+	}
+	for _, d := range qry.Dimensions {
+		if !e.security.CanAccessField(d.Name) {
+			return "", false, nil
+		}
+	}
+	for _, m := range qry.Measures {
+		if !e.security.CanAccessField(m.Name) {
+			return "", false, nil
+		}
+	}
+
+	nq, ok := buildDruidNativeTopN(e.metricsView, qry)
+	if !ok {
+		return "", false, nil
+	}
+
+	res, err := json.Marshal(nq)
+	if err != nil {
+		return "", false, fmt.Errorf("druid native: failed to serialize query: %w", err)
+	}
+	return string(res), true, nil
+}
+
+// buildDruidNativeTopN builds a native topN query for the query. It returns false if the query is not eligible.
+// A query is eligible if it has a single categorical dimension, one sort field, a limit of at most druidNativeMaxThreshold, no offset,
+// and only measures and filters that map directly to native aggregators and filters.
+func buildDruidNativeTopN(mv *runtimev1.MetricsViewSpec, qry *Query) (map[string]any, bool) {
+	if len(qry.Dimensions) != 1 || len(qry.Measures) == 0 || len(qry.Sort) != 1 || len(qry.PivotOn) > 0 {
+		return nil, false
+	}
+	if qry.Limit == nil || *qry.Limit <= 0 || *qry.Limit > druidNativeMaxThreshold || (qry.Offset != nil && *qry.Offset != 0) {
+		return nil, false
+	}
+	if qry.Spine != nil || qry.Having != nil || qry.ComparisonTimeRange != nil || qry.Label {
+		return nil, false
+	}
+
+	qd := qry.Dimensions[0]
+	if qd.Compute != nil {
+		return nil, false
+	}
+	col, ok := druidNativeColumn(mv, qd.Name)
+	if !ok {
+		return nil, false
+	}
+
+	// Native topN returns aggregations before post-aggregations, so quantiles (which are post-aggregations) must be the last measures to preserve the column order.
+	var aggs, postAggs []map[string]any
+	for _, qm := range qry.Measures {
+		if qm.Compute != nil {
+			return nil, false
+		}
+		agg, postAgg, ok := druidNativeAggregation(mv, qm.Name)
+		if !ok {
+			return nil, false
+		}
+		if postAgg == nil && len(postAggs) > 0 {
+			return nil, false
+		}
+		aggs = append(aggs, agg)
+		if postAgg != nil {
+			postAggs = append(postAggs, postAgg)
+		}
+	}
+
+	// A topN can be ordered by a measure or by the dimension
+	var metric map[string]any
+	sort := qry.Sort[0]
+	if sort.Name == qd.Name {
+		metric = map[string]any{"type": "dimension", "ordering": "lexicographic"}
+		if sort.Desc {
+			metric = map[string]any{"type": "inverted", "metric": metric}
+		}
+	} else {
+		found := false
+		for _, qm := range qry.Measures {
+			if qm.Name == sort.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+		metric = map[string]any{"type": "numeric", "metric": sort.Name}
+		if !sort.Desc {
+			metric = map[string]any{"type": "inverted", "metric": metric}
+		}
+	}
+
+	// Time ranges are expressed as intervals, which always apply to the primary time column.
+	interval := druidMinTime + "/" + druidMaxTime
+	if qry.TimeRange != nil && !qry.TimeRange.IsZero() && mv.TimeDimension != "" {
+		if mv.TimeDimension != "__time" {
+			return nil, false
+		}
+		start, end := druidMinTime, druidMaxTime
+		if !qry.TimeRange.Start.IsZero() {
+			start = qry.TimeRange.Start.UTC().Format(time.RFC3339Nano)
+		}
+		if !qry.TimeRange.End.IsZero() {
+			end = qry.TimeRange.End.UTC().Format(time.RFC3339Nano)
+		}
+		interval = start + "/" + end
+	}
+
+	nq := map[string]any{
+		"queryType":   "topN",
+		"dataSource":  mv.Table,
+		"granularity": "all",
+		"dimension": map[string]any{
+			"type":       "default",
+			"dimension":  col,
+			"outputName": qd.Name,
+		},
+		"metric":       metric,
+		"threshold":    *qry.Limit,
+		"intervals":    []string{interval},
+		"aggregations": aggs,
+	}
+	if len(postAggs) > 0 {
+		nq["postAggregations"] = postAggs
+	}
+
+	if qry.Where != nil {
+		filter, ok := druidNativeFilter(mv, qry.Where)
+		if !ok {
+			return nil, false
+		}
+		nq["filter"] = filter
+	}
+
+	return nq, true
+}
+
+// druidNativeColumn returns the column of a categorical dimension that's backed by a plain column.
+func druidNativeColumn(mv *runtimev1.MetricsViewSpec, name string) (string, bool) {
+	if name == mv.TimeDimension {
+		return "", false
+	}
+	for _, d := range mv.Dimensions {
+		if d.Name != name {
+			continue
+		}
+		if d.Expression != "" || d.Unnest || d.LookupTable != "" {
+			return "", false
+		}
+		if d.Column != "" {
+			return d.Column, true
+		}
+		return d.Name, true
+	}
+	return "", false
+}
+
+// druidNativeAggregation translates a simple measure to a native aggregator.
+// Approximate quantiles translate to a sketch aggregator and a post-aggregator that extracts the quantile from the sketch.
+func druidNativeAggregation(mv *runtimev1.MetricsViewSpec, name string) (map[string]any, map[string]any, bool) {
+	var m *runtimev1.MetricsViewSpec_MeasureV2
+	for _, mm := range mv.Measures {
+		if mm.Name == name {
+			m = mm
+			break
+		}
+	}
+	if m == nil || m.Window != nil || len(m.RequiredDimensions) > 0 || len(m.ReferencedMeasures) > 0 {
+		return nil, nil, false
+	}
+	if m.Type != runtimev1.MetricsViewSpec_MEASURE_TYPE_UNSPECIFIED && m.Type != runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE {
+		return nil, nil, false
+	}
+
+	match := druidAggregationRegex.FindStringSubmatch(m.Expression)
+	if match == nil {
+		return nil, nil, false
+	}
+	fn, field, fraction := strings.ToLower(match[1]), strings.Trim(match[2], `"`), match[3]
+
+	// Only COUNT(*) is supported for counts, since COUNT(col) excludes nulls.
+	if fn == "count" {
+		if field != "*" || fraction != "" {
+			return nil, nil, false
+		}
+		return map[string]any{"type": "count", "name": name}, nil, true
+	}
+	if field == "*" {
+		return nil, nil, false
+	}
+
+	if fn == "approx_quantile_ds" {
+		f, err := strconv.ParseFloat(fraction, 64)
+		if err != nil || f < 0 || f > 1 {
+			return nil, nil, false
+		}
+		sketch := "__" + name
+		agg := map[string]any{"type": "quantilesDoublesSketch", "name": sketch, "fieldName": field}
+		postAgg := map[string]any{
+			"type":     "quantilesDoublesSketchToQuantile",
+			"name":     name,
+			"field":    map[string]any{"type": "fieldAccess", "fieldName": sketch},
+			"fraction": f,
+		}
+		return agg, postAgg, true
+	}
+
+	if fraction != "" {
+		return nil, nil, false
+	}
+	var typ string
+	switch fn {
+	case "sum":
+		typ = "doubleSum"
+	case "min":
+		typ = "doubleMin"
+	case "max":
+		typ = "doubleMax"
+	}
+	return map[string]any{"type": typ, "name": name, "fieldName": field}, nil, true
+}
+
+// druidNativeFilter translates a filter expression to a native filter.
+// Only comparisons of dimensions against literal values combined with AND/OR are supported.
+func druidNativeFilter(mv *runtimev1.MetricsViewSpec, e *Expression) (map[string]any, bool) {
+	if e == nil || e.Condition == nil {
+		return nil, false
+	}
+	cond := e.Condition
+
+	switch cond.Operator {
+	case OperatorAnd, OperatorOr:
+		var fields []map[string]any
+		for _, sub := range cond.Expressions {
+			f, ok := druidNativeFilter(mv, sub)
+			if !ok {
+				return nil, false
+			}
+			fields = append(fields, f)
+		}
+		return map[string]any{"type": string(cond.Operator), "fields": fields}, true
+	case OperatorEq, OperatorNeq, OperatorIn, OperatorNin:
+	default:
+		return nil, false
+	}
+
+	if len(cond.Expressions) != 2 || cond.Expressions[0].Name == "" || cond.Expressions[1].Name != "" || cond.Expressions[1].Condition != nil || cond.Expressions[1].Subquery != nil {
+		return nil, false
+	}
+	col, ok := druidNativeColumn(mv, cond.Expressions[0].Name)
+	if !ok {
+		return nil, false
+	}
+	val := cond.Expressions[1].Value
+
+	var filter map[string]any
+	if cond.Operator == OperatorEq || cond.Operator == OperatorNeq {
+		s, ok := druidNativeValue(val)
+		if !ok {
+			return nil, false
+		}
+		filter = map[string]any{"type": "selector", "dimension": col, "value": s}
+	} else {
+		arr, ok := val.([]any)
+		if !ok {
+			return nil, false
+		}
+		vals := make([]any, len(arr))
+		for i, v := range arr {
+			vals[i], ok = druidNativeValue(v)
+			if !ok {
+				return nil, false
+			}
+		}
+		filter = map[string]any{"type": "in", "dimension": col, "values": vals}
+	}
+
+	if cond.Operator == OperatorNeq || cond.Operator == OperatorNin {
+		filter = map[string]any{"type": "not", "field": filter}
+	}
+	return filter, true
+}
+
+// druidNativeValue converts a literal to the string representation native filters match against. Nil matches nulls.
+func druidNativeValue(v any) (any, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, true
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int, int32, int64, float32, float64:
+		return fmt.Sprint(v), true
+	default:
+		return nil, false
+	}
+}
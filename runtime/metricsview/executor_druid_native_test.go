@@ -0,0 +1,108 @@
+package metricsview
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDruidNativeTopN(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table:         "ad_bids",
+		TimeDimension: "__time",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "publisher", Column: "pub"},
+			{Name: "domain"},
+			{Name: "tld", Expression: "regexp_extract(domain, '\\.(\\w+)$')"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "records", Expression: "COUNT(*)"},
+			{Name: "bids", Expression: `sum("bid_count")`, Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			{Name: "p95_price", Expression: "APPROX_QUANTILE_DS(price, 0.95)"},
+			{Name: "avg_price", Expression: "AVG(price)"},
+			{Name: "bids_per_record", Expression: "bids/records", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, ReferencedMeasures: []string{"bids", "records"}},
+		},
+	}
+
+	limit := func(n int64) *int64 { return &n }
+
+	t.Run("eligible", func(t *testing.T) {
+		qry := &Query{
+			Dimensions: []Dimension{{Name: "publisher"}},
+			Measures:   []Measure{{Name: "records"}, {Name: "bids"}, {Name: "p95_price"}},
+			Sort:       []Sort{{Name: "bids", Desc: true}},
+			TimeRange:  &TimeRange{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			Where: &Expression{Condition: &Condition{Operator: OperatorAnd, Expressions: []*Expression{
+				{Condition: &Condition{Operator: OperatorIn, Expressions: []*Expression{{Name: "domain"}, {Value: []any{"a.com", "b.com"}}}}},
+				{Condition: &Condition{Operator: OperatorNeq, Expressions: []*Expression{{Name: "publisher"}, {Value: nil}}}},
+			}}},
+			Limit: limit(10),
+		}
+
+		nq, ok := buildDruidNativeTopN(mv, qry)
+		require.True(t, ok)
+
+		got, err := json.Marshal(nq)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"queryType": "topN",
+			"dataSource": "ad_bids",
+			"granularity": "all",
+			"dimension": {"type": "default", "dimension": "pub", "outputName": "publisher"},
+			"metric": {"type": "numeric", "metric": "bids"},
+			"threshold": 10,
+			"intervals": ["2024-01-01T00:00:00Z/146140482-04-24T15:36:27.903Z"],
+			"aggregations": [
+				{"type": "count", "name": "records"},
+				{"type": "doubleSum", "name": "bids", "fieldName": "bid_count"},
+				{"type": "quantilesDoublesSketch", "name": "__p95_price", "fieldName": "price"}
+			],
+			"postAggregations": [
+				{"type": "quantilesDoublesSketchToQuantile", "name": "p95_price", "field": {"type": "fieldAccess", "fieldName": "__p95_price"}, "fraction": 0.95}
+			],
+			"filter": {"type": "and", "fields": [
+				{"type": "in", "dimension": "domain", "values": ["a.com", "b.com"]},
+				{"type": "not", "field": {"type": "selector", "dimension": "pub", "value": null}}
+			]}
+		}`, string(got))
+	})
+
+	t.Run("sort by dimension", func(t *testing.T) {
+		qry := &Query{
+			Dimensions: []Dimension{{Name: "domain"}},
+			Measures:   []Measure{{Name: "records"}},
+			Sort:       []Sort{{Name: "domain", Desc: true}},
+			Limit:      limit(5),
+		}
+
+		nq, ok := buildDruidNativeTopN(mv, qry)
+		require.True(t, ok)
+		require.Equal(t, map[string]any{"type": "inverted", "metric": map[string]any{"type": "dimension", "ordering": "lexicographic"}}, nq["metric"])
+		require.Equal(t, []string{druidMinTime + "/" + druidMaxTime}, nq["intervals"])
+	})
+
+	ineligible := map[string]*Query{
+		"no limit":             {Dimensions: []Dimension{{Name: "domain"}}, Measures: []Measure{{Name: "records"}}, Sort: []Sort{{Name: "records"}}},
+		"limit above max":      {Dimensions: []Dimension{{Name: "domain"}}, Measures: []Measure{{Name: "records"}}, Sort: []Sort{{Name: "records"}}, Limit: limit(5000)},
+		"offset":               {Dimensions: []Dimension{{Name: "domain"}}, Measures: []Measure{{Name: "records"}}, Sort: []Sort{{Name: "records"}}, Limit: limit(5), Offset: limit(5)},
+		"two dimensions":       {Dimensions: []Dimension{{Name: "domain"}, {Name: "publisher"}}, Measures: []Measure{{Name: "records"}}, Sort: []Sort{{Name: "records"}}, Limit: limit(5)},
+		"time dimension":       {Dimensions: []Dimension{{Name: "__time"}}, Measures: []Measure{{Name: "records"}}, Sort: []Sort{{Name: "records"}}, Limit: limit(5)},
+		"expression dimension": {Dimensions: []Dimension{{Name: "tld"}}, Measures: []Measure{{Name: "records"}}, Sort: []Sort{{Name: "records"}}, Limit: limit(5)},
+		"unsupported measure":  {Dimensions: []Dimension{{Name: "domain"}}, Measures: []Measure{{Name: "avg_price"}}, Sort: []Sort{{Name: "avg_price"}}, Limit: limit(5)},
+		"derived measure":      {Dimensions: []Dimension{{Name: "domain"}}, Measures: []Measure{{Name: "bids_per_record"}}, Sort: []Sort{{Name: "bids_per_record"}}, Limit: limit(5)},
+		"quantile not last":    {Dimensions: []Dimension{{Name: "domain"}}, Measures: []Measure{{Name: "p95_price"}, {Name: "records"}}, Sort: []Sort{{Name: "records"}}, Limit: limit(5)},
+		"sort by other field":  {Dimensions: []Dimension{{Name: "domain"}}, Measures: []Measure{{Name: "records"}}, Sort: []Sort{{Name: "bids"}}, Limit: limit(5)},
+		"having":               {Dimensions: []Dimension{{Name: "domain"}}, Measures: []Measure{{Name: "records"}}, Sort: []Sort{{Name: "records"}}, Limit: limit(5), Having: &Expression{Condition: &Condition{Operator: OperatorGt, Expressions: []*Expression{{Name: "records"}, {Value: 10}}}}},
+		"unsupported filter":   {Dimensions: []Dimension{{Name: "domain"}}, Measures: []Measure{{Name: "records"}}, Sort: []Sort{{Name: "records"}}, Limit: limit(5), Where: &Expression{Condition: &Condition{Operator: OperatorIlike, Expressions: []*Expression{{Name: "domain"}, {Value: "%a%"}}}}},
+		"comparison":           {Dimensions: []Dimension{{Name: "domain"}}, Measures: []Measure{{Name: "records"}}, Sort: []Sort{{Name: "records"}}, Limit: limit(5), ComparisonTimeRange: &TimeRange{IsoDuration: "P1D"}},
+	}
+	for name, qry := range ineligible {
+		t.Run(name, func(t *testing.T) {
+			_, ok := buildDruidNativeTopN(mv, qry)
+			require.False(t, ok)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/rilldata/rill/runtime/drivers"
 )
@@ -15,8 +16,10 @@ import (
 // executeExport works by simulating a model that outputs to a file.
 // This means it creates a ModelExecutor with the provided input connector and props as input,
 // and with the "file" driver as the output connector targeting a temporary output path.
-func (e *Executor) executeExport(ctx context.Context, format drivers.FileFormat, inputConnector string, inputProps map[string]any) (string, error) {
-	ctx, cancel := context.WithTimeout(ctx, defaultExportTimeout)
+// The export is cancelled if it doesn't complete within the given timeout.
+// The optional outputProps are passed to the "file" driver in addition to the path and format (for example, "headers" for XLSX files).
+func (e *Executor) executeExport(ctx context.Context, format drivers.FileFormat, inputConnector string, inputProps, outputProps map[string]any, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	name, err := randomString("export-", 16)
@@ -39,6 +42,15 @@ func (e *Executor) executeExport(ctx context.Context, format drivers.FileFormat,
 	}
 	defer or()
 
+	outputProperties := map[string]any{
+		"path":                  path,
+		"format":                format,
+		"file_size_limit_bytes": e.instanceCfg.DownloadLimitBytes,
+	}
+	for k, v := range outputProps {
+		outputProperties[k] = v
+	}
+
 	opts := &drivers.ModelExecutorOptions{
 		Env: &drivers.ModelEnv{
 			AllowHostAccess: e.rt.AllowHostAccess(),
@@ -46,18 +58,14 @@ func (e *Executor) executeExport(ctx context.Context, format drivers.FileFormat,
 				return e.rt.AcquireHandle(ctx, e.instanceID, name)
 			},
 		},
-		ModelName:       "metrics_export", // This isn't a real model; just setting for nicer log messages
-		InputHandle:     ic,
-		InputConnector:  inputConnector,
-		InputProperties: inputProps,
-		OutputHandle:    oc,
-		OutputConnector: "file",
-		OutputProperties: map[string]any{
-			"path":                  path,
-			"format":                format,
-			"file_size_limit_bytes": e.instanceCfg.DownloadLimitBytes,
-		},
-		Priority: e.priority,
+		ModelName:        "metrics_export", // This isn't a real model; just setting for nicer log messages
+		InputHandle:      ic,
+		InputConnector:   inputConnector,
+		InputProperties:  inputProps,
+		OutputHandle:     oc,
+		OutputConnector:  "file",
+		OutputProperties: outputProperties,
+		Priority:         e.priority,
 	}
 
 	me, ok := ic.AsModelExecutor(e.instanceID, opts)
@@ -0,0 +1,272 @@
+package metricsview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/duration"
+)
+
+// maxFunnelSteps caps the number of steps in a funnel, since each step adds a level of nesting to the generated SQL.
+const maxFunnelSteps = 20
+
+// FunnelQuery counts the values of a dimension, such as a user or session ID, that complete an ordered sequence of steps.
+// A value completes a step if it has a row matching the step's filter after its row for the previous step,
+// and within the conversion window from its first row for the first step.
+type FunnelQuery struct {
+	MetricsView string `mapstructure:"metrics_view"`
+	// Dimension identifies the values that move through the funnel, e.g. a user or session ID.
+	Dimension string       `mapstructure:"dimension"`
+	Steps     []FunnelStep `mapstructure:"steps"`
+	// ConversionWindow is an ISO 8601 duration that bounds the time from the first step to the last step.
+	// Calendar units are approximated, i.e. a month is 30 days and a year is 365 days. If empty, the window is unbounded.
+	ConversionWindow        string      `mapstructure:"conversion_window"`
+	TimeRange               *TimeRange  `mapstructure:"time_range"`
+	Where                   *Expression `mapstructure:"where"`
+	TimeZone                string      `mapstructure:"time_zone"`
+	ExecutionTimeoutSeconds uint32      `mapstructure:"execution_timeout_seconds"`
+}
+
+// FunnelStep is a step in a FunnelQuery.
+type FunnelStep struct {
+	Name  string      `mapstructure:"name"`
+	Where *Expression `mapstructure:"where"`
+}
+
+// FunnelStepResult is the result for a step of a FunnelQuery.
+type FunnelStepResult struct {
+	Name string
+	// Count is the number of dimension values that completed the step and all the steps before it.
+	Count int64
+	// ConversionRate is Count divided by the previous step's count. It's 1 for the first step and 0 if the previous step's count is 0.
+	ConversionRate float64
+	// OverallConversionRate is Count divided by the first step's count. It's 0 if the first step's count is 0.
+	OverallConversionRate float64
+}
+
+// Funnel computes the number of dimension values that complete each step of a funnel in a single query.
+// On Druid, which has limited support for window functions, it only considers the first row for each step,
+// so it may undercount values that repeat steps out of order.
+func (e *Executor) Funnel(ctx context.Context, qry *FunnelQuery, executionTime *time.Time) ([]*FunnelStepResult, error) {
+	if !e.security.CanAccess() {
+		return nil, runtime.ErrForbidden
+	}
+
+	if e.metricsView.TimeDimension == "" {
+		return nil, errors.New("metrics view does not have a time dimension")
+	}
+	if qry.Dimension == "" {
+		return nil, errors.New("a funnel requires a dimension")
+	}
+	if len(qry.Steps) == 0 {
+		return nil, errors.New("a funnel requires at least one step")
+	}
+	if len(qry.Steps) > maxFunnelSteps {
+		return nil, fmt.Errorf("a funnel can have at most %d steps", maxFunnelSteps)
+	}
+	for i, s := range qry.Steps {
+		if s.Where == nil {
+			return nil, fmt.Errorf("step %d does not have a filter", i+1)
+		}
+	}
+
+	var windowMillis int64
+	if qry.ConversionWindow != "" {
+		d, err := duration.ParseISO8601(qry.ConversionWindow)
+		if err != nil {
+			return nil, fmt.Errorf("invalid conversion window %q: %w", qry.ConversionWindow, err)
+		}
+		native, ok := d.EstimateNative()
+		if !ok || native <= 0 {
+			return nil, fmt.Errorf("invalid conversion window %q", qry.ConversionWindow)
+		}
+		windowMillis = native.Milliseconds()
+	}
+
+	// We build a regular query grouped by the dimension to resolve the segments and time range,
+	// and to get an AST that we can use to compile the step filters against the underlying table.
+	inner := &Query{
+		MetricsView:             qry.MetricsView,
+		Dimensions:              []Dimension{{Name: qry.Dimension}},
+		TimeRange:               qry.TimeRange,
+		Where:                   qry.Where,
+		TimeZone:                qry.TimeZone,
+		ExecutionTimeoutSeconds: qry.ExecutionTimeoutSeconds,
+	}
+	err := e.rewriteQuerySegments(ctx, inner)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range qry.Steps {
+		err = e.rewriteSegments(ctx, qry.MetricsView, s.Where, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = e.rewriteQueryTimeRanges(ctx, inner, executionTime)
+	if err != nil {
+		return nil, err
+	}
+
+	ast, err := NewAST(e.metricsView, e.security, inner, e.olap.Dialect())
+	if err != nil {
+		return nil, err
+	}
+	sql, args, err := ast.sqlForFunnel(qry.Steps, windowMillis)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := e.olap.Execute(ctx, &drivers.Statement{
+		Query:            sql,
+		Args:             args,
+		Priority:         e.priority,
+		ExecutionTimeout: e.executionTimeout(inner, defaultInteractiveTimeout),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	counts := make([]*int64, len(qry.Steps))
+	dest := make([]any, len(qry.Steps))
+	for i := range counts {
+		dest[i] = &counts[i]
+	}
+	if res.Next() {
+		err = res.Scan(dest...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	steps := make([]*FunnelStepResult, len(qry.Steps))
+	for i, s := range qry.Steps {
+		r := &FunnelStepResult{Name: s.Name}
+		if counts[i] != nil {
+			r.Count = *counts[i]
+		}
+		if i == 0 {
+			r.ConversionRate = 1
+			if r.Count > 0 {
+				r.OverallConversionRate = 1
+			}
+		} else {
+			if prev := steps[i-1].Count; prev > 0 {
+				r.ConversionRate = float64(r.Count) / float64(prev)
+			}
+			if first := steps[0].Count; first > 0 {
+				r.OverallConversionRate = float64(r.Count) / float64(first)
+			}
+		}
+		steps[i] = r
+	}
+	return steps, nil
+}
+
+// sqlForFunnel builds a query that returns a single row with the number of values of the AST's dimension that complete each step.
+// The AST must be for a query with the dimension as its only dimension and without measures.
+func (a *AST) sqlForFunnel(steps []FunnelStep, windowMillis int64) (string, []any, error) {
+	if len(a.dimFields) != 1 || len(a.unnests) > 0 {
+		return "", nil, fmt.Errorf("funnels can't be computed over dimension %q", a.query.Dimensions[0].Name)
+	}
+	id := a.dimFields[0].Expr
+
+	timeDim, err := a.lookupDimension(a.metricsView.TimeDimension, false)
+	if err != nil {
+		return "", nil, err
+	}
+	t, ok := a.dialect.EpochMillisExpr(a.dimensionExpression(timeDim))
+	if !ok {
+		return "", nil, fmt.Errorf("funnels are not supported for the %s dialect", a.dialect.String())
+	}
+
+	// Compile the step filters and restrict the underlying rows to those that match at least one step
+	stepExprs := make([]string, len(steps))
+	stepArgs := make([][]any, len(steps))
+	var anyStep []string
+	var anyArgs []any
+	for i, s := range steps {
+		expr, args, err := a.sqlForExpression(s.Where, nil, false, true)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to compile the filter of step %d: %w", i+1, err)
+		}
+		stepExprs[i] = expr
+		stepArgs[i] = args
+		anyStep = append(anyStep, fmt.Sprintf("(%s)", expr))
+		anyArgs = append(anyArgs, args...)
+	}
+
+	where := a.Root.Where
+	if a.Root.TimeWhere != nil {
+		where = where.and(a.Root.TimeWhere.Expr, a.Root.TimeWhere.Args)
+	}
+	where = where.and(strings.Join(anyStep, " OR "), anyArgs)
+
+	// Must be called after all dimension expressions have been resolved
+	a.applyLookupJoins()
+
+	var args []any
+	b := &strings.Builder{}
+	if a.dialect == drivers.DialectDruid {
+		// Compute each value's first row for each step in a single GROUP BY, then check the order and window of the steps.
+		fmt.Fprintf(b, "SELECT (%s) AS id", id)
+		for i := range steps {
+			fmt.Fprintf(b, ", MIN(CASE WHEN (%s) THEN %s END) AS t%d", stepExprs[i], t, i+1)
+			args = append(args, stepArgs[i]...)
+		}
+		fmt.Fprintf(b, " FROM %s WHERE %s GROUP BY (%s)", *a.underlyingTable, where.Expr, id)
+		args = append(args, where.Args...)
+
+		var reached string
+		counts := make([]string, len(steps))
+		for i := range steps {
+			if i == 0 {
+				reached = "t1 IS NOT NULL"
+			} else {
+				reached = fmt.Sprintf("%s AND t%d >= t%d", reached, i+1, i)
+				if windowMillis > 0 {
+					reached = fmt.Sprintf("%s AND t%d <= t1 + %d", reached, i+1, windowMillis)
+				}
+			}
+			counts[i] = fmt.Sprintf("SUM(CASE WHEN %s THEN 1 ELSE 0 END) AS c%d", reached, i+1)
+		}
+
+		return fmt.Sprintf("SELECT %s FROM (%s) f", strings.Join(counts, ", "), b.String()), args, nil
+	}
+
+	// Flag each row with the steps it matches
+	fmt.Fprintf(b, "SELECT (%s) AS id, %s AS t", id, t)
+	for i := range steps {
+		fmt.Fprintf(b, ", CASE WHEN (%s) THEN 1 ELSE 0 END AS s%d", stepExprs[i], i+1)
+		args = append(args, stepArgs[i]...)
+	}
+	fmt.Fprintf(b, " FROM %s WHERE %s", *a.underlyingTable, where.Expr)
+	args = append(args, where.Args...)
+
+	// For each step, find the time of each value's first matching row after the previous step and within the conversion window.
+	// Each step depends on the previous one, so each step adds a window function in a new level of nesting.
+	sql := b.String()
+	counts := make([]string, len(steps))
+	for i := range steps {
+		cond := fmt.Sprintf("s%d = 1", i+1)
+		if i > 0 {
+			cond = fmt.Sprintf("%s AND t >= t%d", cond, i)
+			if windowMillis > 0 {
+				cond = fmt.Sprintf("%s AND t <= t1 + %d", cond, windowMillis)
+			}
+		}
+		sql = fmt.Sprintf("SELECT *, MIN(CASE WHEN %s THEN t END) OVER (PARTITION BY id) AS t%d FROM (%s) f%d", cond, i+1, sql, i+1)
+		counts[i] = fmt.Sprintf("COUNT(DISTINCT CASE WHEN t%d IS NOT NULL THEN id END) AS c%d", i+1, i+1)
+	}
+
+	return fmt.Sprintf("SELECT %s FROM (%s) f", strings.Join(counts, ", "), sql), args, nil
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"crypto/rand"
 	"database/sql"
+	"database/sql/driver"
 	"encoding/hex"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/locale"
 	"go.uber.org/zap"
 )
 
@@ -57,6 +60,21 @@ func (e *Executor) rewriteQueryForPivot(qry *Query) (*pivotAST, bool, error) {
 		}
 	}
 
+	// Validate and normalize the pivot column options
+	cols, err := normalizePivotColumns(qry)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Resolve the locale for labelling pivoted time dimensions
+	var loc *locale.Locale
+	if qry.Locale != "" {
+		loc, err = locale.Parse(qry.Locale)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
 	// Determine dialect for the PIVOT (in practice, this currently always becomes DuckDB because it's the only OLAP that supports pivoting)
 	dialect := e.olap.Dialect()
 	if !dialect.CanPivot() {
@@ -67,12 +85,14 @@ func (e *Executor) rewriteQueryForPivot(qry *Query) (*pivotAST, bool, error) {
 	ast := &pivotAST{
 		keep:    nil, // Populated below
 		on:      qry.PivotOn,
+		columns: cols,
 		using:   nil, // Populated below
 		orderBy: nil, // Populated below
 		limit:   qry.Limit,
 		offset:  qry.Offset,
 		label:   qry.Label,
 		dialect: dialect,
+		locale:  loc,
 	}
 	for _, d := range qry.Dimensions {
 		var found bool
@@ -84,6 +104,11 @@ func (e *Executor) rewriteQueryForPivot(qry *Query) (*pivotAST, bool, error) {
 		}
 		if !found {
 			ast.keep = append(ast.keep, d.Name)
+		} else if d.Compute != nil && d.Compute.TimeFloor != nil {
+			if ast.timeGrains == nil {
+				ast.timeGrains = make(map[string]TimeGrain)
+			}
+			ast.timeGrains[d.Name] = d.Compute.TimeFloor.Grain
 		}
 	}
 	for _, m := range qry.Measures {
@@ -92,9 +117,14 @@ func (e *Executor) rewriteQueryForPivot(qry *Query) (*pivotAST, bool, error) {
 	for _, f := range qry.Sort {
 		ast.orderBy = append(ast.orderBy, OrderFieldNode(f))
 	}
+	if len(ast.orderBy) > 0 || ast.offset != nil {
+		// The non-pivoted dimensions identify a row of the pivoted output, so they make the order deterministic.
+		ast.orderBy = appendTieBreakers(ast.orderBy, ast.keep)
+	}
 
 	// Remove parameters from the underlying query that are now handled in the pivot AST
 	qry.PivotOn = nil
+	qry.PivotColumns = nil
 	qry.Sort = nil
 	qry.Limit = nil
 	qry.Offset = nil
@@ -104,37 +134,20 @@ func (e *Executor) rewriteQueryForPivot(qry *Query) (*pivotAST, bool, error) {
 }
 
 // executePivotExport executes a PIVOT query prepared using rewriteQueryForPivot, and exports the result to a file in the given format.
-func (e *Executor) executePivotExport(ctx context.Context, ast *AST, pivot *pivotAST, format drivers.FileFormat) (string, error) {
-	ctx, cancel := context.WithTimeout(ctx, defaultPivotExportTimeout)
+// It also returns a header for each pivoted column. For XLSX exports, the headers are written as merged header rows.
+func (e *Executor) executePivotExport(ctx context.Context, ast *AST, pivot *pivotAST, format drivers.FileFormat, timeout time.Duration) (string, []PivotHeader, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	// Build underlying SQL
-	underlyingSQL, args, err := ast.SQL()
-	if err != nil {
-		return "", err
-	}
-
-	// If the metrics view's connector doesn't support pivoting, we export the underlying (non-pivoted) data to a Parquet file, and handover to DuckDB to do the pivot.
+	// If the metrics view's connector doesn't support pivoting, the underlying (non-pivoted) data is exported to Parquet files and handed over to DuckDB to do the pivot (see pivotStage).
 	pivotConnector := e.metricsView.Connector
 	if !e.olap.Dialect().CanPivot() {
-		// Export non-pivoted data to a temporary Parquet file
-		path, err := e.executeExport(ctx, "parquet", e.metricsView.Connector, map[string]any{
-			"sql":  underlyingSQL,
-			"args": args,
-		})
-		if err != nil {
-			return "", fmt.Errorf("failed to execute pre-pivot export: %w", err)
-		}
-		defer os.Remove(path)
-
 		// Hard-code DuckDB as the connector that executes the pivot
 		pivotConnector = "duckdb"
-		underlyingSQL = fmt.Sprintf("SELECT * FROM '%s'", path)
-		args = nil
 
 		// Check for consistency with rewriteQueryForPivot
 		if pivot.dialect != drivers.DialectDuckDB {
-			return "", fmt.Errorf("cannot execute pivot: the pivot AST fell back to dialect %q, not DuckDB", pivot.dialect.String())
+			return "", nil, fmt.Errorf("cannot execute pivot: the pivot AST fell back to dialect %q, not DuckDB", pivot.dialect.String())
 		}
 	}
 
@@ -142,36 +155,35 @@ func (e *Executor) executePivotExport(ctx context.Context, ast *AST, pivot *pivo
 	// So we stage the underlying data in a temporary table and run the PIVOT against that table instead.
 	olap, release, err := e.rt.OLAP(ctx, e.instanceID, pivotConnector)
 	if err != nil {
-		return "", fmt.Errorf("failed to acquire OLAP for serving pivot: %w", err)
+		return "", nil, fmt.Errorf("failed to acquire OLAP for serving pivot: %w", err)
 	}
 	defer release()
 	var path string
+	var headers []PivotHeader
 	err = olap.WithConnection(ctx, e.priority, false, false, func(wrappedCtx context.Context, ensuredCtx context.Context, conn *sql.Conn) error {
-		// Stage the underlying data in a temporary table
-		alias, err := randomString("t", 8)
-		if err != nil {
-			return fmt.Errorf("failed to generate random alias: %w", err)
+		stage := &pivotStage{
+			executor:   e,
+			olap:       olap,
+			conn:       conn,
+			ctx:        wrappedCtx,
+			ensuredCtx: ensuredCtx,
+			timeout:    timeout,
 		}
-		err = olap.Exec(wrappedCtx, &drivers.Statement{
-			Query: fmt.Sprintf("CREATE TEMPORARY TABLE %s AS (%s)", alias, underlyingSQL),
-			Args:  args,
-		})
+		defer stage.cleanup()
+
+		// Stage the underlying data in a temporary table
+		alias, err := stage.stageAST(ast)
 		if err != nil {
 			return fmt.Errorf("failed to stage underlying data for pivot: %w", err)
 		}
 
-		// Defer cleanup of the temporary table
-		defer func() {
-			err = olap.Exec(ensuredCtx, &drivers.Statement{
-				Query: fmt.Sprintf("DROP TABLE %s", alias),
-			})
+		// Resolve the order and number of pivoted columns
+		if pivot.columns != nil {
+			alias, err = e.resolvePivotColumns(stage, ast, pivot, alias)
 			if err != nil {
-				l, err2 := e.rt.InstanceLogger(ctx, e.instanceID)
-				if err2 == nil {
-					l.Error("duckdb: failed to cleanup temporary table for pivot export", zap.Error(err))
-				}
+				return fmt.Errorf("failed to resolve pivot columns: %w", err)
 			}
-		}()
+		}
 
 		// Build the PIVOT query
 		pivotSQL, err := pivot.SQL(ast, alias)
@@ -179,26 +191,294 @@ func (e *Executor) executePivotExport(ctx context.Context, ast *AST, pivot *pivo
 			return err
 		}
 
+		// Resolve the headers of the pivoted columns
+		headers, err = pivot.headers(stage, ast, alias)
+		if err != nil {
+			return fmt.Errorf("failed to resolve pivot headers: %w", err)
+		}
+
 		// Execute the pivot export
+		outputProps := map[string]any{}
+		if pivot.locale != nil {
+			outputProps["locale"] = pivot.locale.Tag
+		}
+		if format == drivers.FileFormatXLSX && len(pivot.on) > 0 {
+			outputProps["headers"] = pivot.headerRows(headers)
+		}
 		path, err = e.executeExport(wrappedCtx, format, pivotConnector, map[string]any{
 			"sql": pivotSQL,
-		})
+		}, outputProps, timeout)
 		if err != nil {
 			return fmt.Errorf("failed to execute pivot export: %w", err)
 		}
 
 		return nil
 	})
+	if err != nil {
+		return "", nil, err
+	}
+	return path, headers, nil
+}
+
+// resolvePivotColumns resolves the values to pivot on for each of the pivot's dimensions based on pivot.columns, and populates pivot.in.
+// If the number of values for a dimension exceeds the limit, the remaining values are rolled up into pivot.columns.OtherLabel.
+// The rollup is computed by the metrics view's OLAP with the capped dimensions removed, so measures are re-aggregated correctly (not summed across values).
+// It returns the alias of the table to pivot, which is a new temporary table if a rollup was needed.
+func (e *Executor) resolvePivotColumns(stage *pivotStage, ast *AST, pivot *pivotAST, alias string) (string, error) {
+	cols := pivot.columns
+	d := pivot.dialect
+
+	pivot.in = make(map[string][]string, len(pivot.on))
+	var capped []string
+	topValues := make(map[string][]any)
+	for _, dim := range pivot.on {
+		var src, orderBy string
+		switch cols.Sort {
+		case PivotColumnSortValue:
+			src = fmt.Sprintf("(SELECT DISTINCT %s FROM %s)", d.EscapeIdentifier(dim), alias)
+			orderBy = d.OrderByExpression(dim, cols.Desc)
+		case PivotColumnSortAlphabetical:
+			src = fmt.Sprintf("(SELECT DISTINCT %s FROM %s)", d.EscapeIdentifier(dim), alias)
+			orderBy = fmt.Sprintf("CAST(%s AS VARCHAR)", d.EscapeIdentifier(dim))
+			if cols.Desc {
+				orderBy += " DESC"
+			}
+		case PivotColumnSortMeasure:
+			// The underlying data is broken down by the non-pivoted dimensions, so we compute the measure's total for each value separately.
+			qry := *ast.query
+			qry.Dimensions = nil
+			for _, qd := range ast.query.Dimensions {
+				if qd.Name == dim {
+					qry.Dimensions = append(qry.Dimensions, qd)
+				}
+			}
+			qry.Measures = nil
+			for _, qm := range ast.query.Measures {
+				if qm.Name == cols.Measure {
+					qry.Measures = append(qry.Measures, qm)
+				}
+			}
+			qry.Spine = nil
+			qry.Having = nil
+
+			totalsAST, err := e.newPivotUnderlyingAST(&qry)
+			if err != nil {
+				return "", err
+			}
+			src, err = stage.stageAST(totalsAST)
+			if err != nil {
+				return "", err
+			}
+			orderBy = fmt.Sprintf("%s, %s", d.OrderByExpression(cols.Measure, cols.Desc), d.OrderByExpression(dim, false))
+		default:
+			return "", fmt.Errorf("unexpected pivot column sort %q", cols.Sort)
+		}
+
+		qry := fmt.Sprintf("SELECT %s, CAST(%s AS VARCHAR) FROM %s WHERE %s IS NOT NULL ORDER BY %s", d.EscapeIdentifier(dim), d.EscapeIdentifier(dim), src, d.EscapeIdentifier(dim), orderBy)
+		if cols.Limit != nil {
+			qry += fmt.Sprintf(" LIMIT %d", *cols.Limit+1)
+		}
+
+		res, err := stage.olap.Execute(stage.ctx, &drivers.Statement{Query: qry, Priority: e.priority})
+		if err != nil {
+			return "", err
+		}
+		var values []any
+		var texts []string
+		for res.Next() {
+			var v any
+			var t string
+			if err := res.Scan(&v, &t); err != nil {
+				res.Close()
+				return "", err
+			}
+			values = append(values, v)
+			texts = append(texts, t)
+		}
+		err = res.Err()
+		res.Close()
+		if err != nil {
+			return "", err
+		}
+
+		if cols.Limit != nil && int64(len(values)) > *cols.Limit {
+			values = values[:*cols.Limit]
+			texts = append(texts[:*cols.Limit], cols.OtherLabel)
+			capped = append(capped, dim)
+			topValues[dim] = values
+		}
+		pivot.in[dim] = texts
+	}
+
+	if len(capped) == 0 {
+		return alias, nil
+	}
+
+	// The capped dimensions become strings in the pivoted table, so they can hold the rollup label.
+	castCapped := func(exclude map[string]bool) string {
+		var exprs []string
+		for _, dim := range capped {
+			if !exclude[dim] {
+				exprs = append(exprs, fmt.Sprintf("CAST(%s AS VARCHAR) AS %s", d.EscapeIdentifier(dim), d.EscapeIdentifier(dim)))
+			}
+		}
+		if len(exprs) == 0 {
+			return "*"
+		}
+		return fmt.Sprintf("* REPLACE (%s)", strings.Join(exprs, ", "))
+	}
+
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "SELECT %s FROM %s", castCapped(nil), alias)
+
+	// Stage a rollup for each combination of capped dimensions.
+	for mask := 1; mask < 1<<len(capped); mask++ {
+		rolled := make(map[string]bool)
+		qry := *ast.query
+		qry.Dimensions = nil
+		for i, dim := range capped {
+			if mask&(1<<i) != 0 {
+				rolled[dim] = true
+				qry.Where = andExpressions(qry.Where, notInExpression(dim, topValues[dim]))
+				if qry.Spine != nil && qry.Spine.Where != nil {
+					spine := *qry.Spine
+					spine.Where = &WhereSpine{Expression: andExpressions(spine.Where.Expression, notInExpression(dim, topValues[dim]))}
+					qry.Spine = &spine
+				}
+			}
+		}
+		for _, qd := range ast.query.Dimensions {
+			if !rolled[qd.Name] {
+				qry.Dimensions = append(qry.Dimensions, qd)
+			}
+		}
+
+		rollupAST, err := e.newPivotUnderlyingAST(&qry)
+		if err != nil {
+			return "", err
+		}
+		rollupAlias, err := stage.stageAST(rollupAST)
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(b, " UNION ALL BY NAME SELECT %s", castCapped(rolled))
+		for _, dim := range capped {
+			if rolled[dim] {
+				fmt.Fprintf(b, ", %s AS %s", d.EscapeStringValue(cols.OtherLabel), d.EscapeIdentifier(dim))
+			}
+		}
+		fmt.Fprintf(b, " FROM %s", rollupAlias)
+	}
+
+	return stage.stageSQL(b.String(), nil)
+}
+
+// newPivotUnderlyingAST builds an AST for an auxiliary underlying query used to resolve pivot columns.
+func (e *Executor) newPivotUnderlyingAST(qry *Query) (*AST, error) {
+	ast, err := NewAST(e.metricsView, e.security, qry, e.olap.Dialect())
+	if err != nil {
+		return nil, err
+	}
+
+	e.rewriteApproxComparisons(ast)
+
+	if err := e.rewriteDruidGroups(ast); err != nil {
+		return nil, err
+	}
+
+	return ast, nil
+}
+
+// pivotTempTablePrefix is the name prefix of tables staged for pivots.
+// It uses the common prefix for Rill's temporary tables so that OLAP drivers can garbage collect them if they outlive the query.
+const pivotTempTablePrefix = "__rill_tmp_pivot_"
+
+// pivotStage stages data in temporary tables on the connection that executes a pivot.
+type pivotStage struct {
+	executor   *Executor
+	olap       drivers.OLAPStore
+	conn       *sql.Conn
+	ctx        context.Context
+	ensuredCtx context.Context
+	timeout    time.Duration
+	tables     []string
+}
+
+// stageAST stages the result of an underlying query in a temporary table and returns its alias.
+func (s *pivotStage) stageAST(ast *AST) (string, error) {
+	underlyingSQL, args, err := ast.SQL()
 	if err != nil {
 		return "", err
 	}
-	return path, nil
+
+	// If the metrics view's connector doesn't support pivoting, we export the underlying data to a temporary Parquet file that DuckDB can read.
+	e := s.executor
+	if !e.olap.Dialect().CanPivot() {
+		path, err := e.executeExport(s.ctx, "parquet", e.metricsView.Connector, map[string]any{
+			"sql":  underlyingSQL,
+			"args": args,
+		}, nil, s.timeout)
+		if err != nil {
+			return "", fmt.Errorf("failed to execute pre-pivot export: %w", err)
+		}
+		defer os.Remove(path)
+
+		underlyingSQL = fmt.Sprintf("SELECT * FROM '%s'", path)
+		args = nil
+	}
+
+	return s.stageSQL(underlyingSQL, args)
+}
+
+// stageSQL stages the result of a query against the pivot connector in a temporary table and returns its alias.
+func (s *pivotStage) stageSQL(query string, args []any) (string, error) {
+	alias, err := randomString(pivotTempTablePrefix, 8)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate random alias: %w", err)
+	}
+
+	err = s.olap.Exec(s.ctx, &drivers.Statement{
+		Query: fmt.Sprintf("CREATE TEMPORARY TABLE %s AS (%s)", alias, query),
+		Args:  args,
+	})
+	if err != nil {
+		return "", err
+	}
+	s.tables = append(s.tables, alias)
+
+	return alias, nil
+}
+
+// cleanup drops the staged temporary tables.
+// If a table can't be dropped, the connection is discarded from the pool so that its temporary tables are released when it closes instead of leaking into later queries.
+func (s *pivotStage) cleanup() {
+	var failed bool
+	for _, alias := range s.tables {
+		err := s.olap.Exec(s.ensuredCtx, &drivers.Statement{
+			Query: fmt.Sprintf("DROP TABLE IF EXISTS %s", alias),
+		})
+		if err != nil {
+			failed = true
+			l, err2 := s.executor.rt.InstanceLogger(s.ensuredCtx, s.executor.instanceID)
+			if err2 == nil {
+				l.Error("duckdb: failed to cleanup temporary table for pivot export", zap.String("table", alias), zap.Error(err))
+			}
+		}
+	}
+
+	if failed && s.conn != nil {
+		// Returning driver.ErrBadConn from Raw makes database/sql close the connection instead of returning it to the pool.
+		_ = s.conn.Raw(func(any) error { return driver.ErrBadConn })
+	}
 }
 
 // pivotAST represents config for generating a PIVOT query.
 type pivotAST struct {
 	keep    []string
 	on      []string
+	columns *PivotColumns       // Optional options for ordering and capping the pivoted values
+	in      map[string][]string // Resolved values (as text) to pivot on for each dimension in on; populated by resolvePivotColumns
 	using   []string
 	orderBy []OrderFieldNode
 	limit   *int64
@@ -206,6 +486,9 @@ type pivotAST struct {
 
 	label   bool
 	dialect drivers.Dialect
+
+	locale     *locale.Locale       // Optional locale for the labels of pivoted time dimensions in file headers
+	timeGrains map[string]TimeGrain // Grains of the pivoted dimensions that are time floors
 }
 
 // SQL generates a query that outputs a pivoted table based on the pivot config and data in the underlying query.
@@ -261,6 +544,18 @@ func (a *pivotAST) SQL(underlyingAST *AST, underlyingAlias string) (string, erro
 			b.WriteString(", ")
 		}
 		b.WriteString(a.dialect.EscapeIdentifier(fn))
+
+		// The order of the values in the IN list determines the order of the pivoted columns.
+		if vals := a.in[fn]; len(vals) > 0 {
+			b.WriteString(" IN (")
+			for j, v := range vals {
+				if j > 0 {
+					b.WriteString(", ")
+				}
+				b.WriteString(a.dialect.EscapeStringValue(v))
+			}
+			b.WriteString(")")
+		}
 	}
 
 	if len(a.using) > 0 {
@@ -313,6 +608,116 @@ func (a *pivotAST) SQL(underlyingAST *AST, underlyingAlias string) (string, erro
 	return b.String(), nil
 }
 
+// PivotHeader describes a column produced by a pivot.
+type PivotHeader struct {
+	// Column is the name of the column in the result.
+	Column string
+	// Values are the values of the pivoted dimensions for the column, in the order of Query.PivotOn.
+	Values []string
+	// Measure is the name (or label, for labelled queries) of the measure in the column.
+	Measure string
+}
+
+// headers returns a header for each column produced by the pivot, in the order the columns are produced.
+// The table must be the one the pivot runs against. Like the PIVOT, it uses the resolved values in a.in if present.
+func (a *pivotAST) headers(stage *pivotStage, underlyingAST *AST, table string) ([]PivotHeader, error) {
+	// The PIVOT produces a column for every combination of the non-null values of the pivoted dimensions.
+	values := make([][]string, len(a.on))
+	for i, dim := range a.on {
+		if vals, ok := a.in[dim]; ok {
+			values[i] = vals
+			continue
+		}
+
+		qry := fmt.Sprintf("SELECT DISTINCT CAST(%s AS VARCHAR) AS v FROM %s WHERE %s IS NOT NULL ORDER BY v", a.dialect.EscapeIdentifier(dim), table, a.dialect.EscapeIdentifier(dim))
+		res, err := stage.olap.Execute(stage.ctx, &drivers.Statement{Query: qry, Priority: stage.executor.priority})
+		if err != nil {
+			return nil, err
+		}
+		for res.Next() {
+			var v string
+			if err := res.Scan(&v); err != nil {
+				res.Close()
+				return nil, err
+			}
+			values[i] = append(values[i], v)
+		}
+		err = res.Err()
+		res.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var measures []string
+	for _, fn := range a.using {
+		f, ok := findField(fn, underlyingAST.Root.MeasureFields)
+		if !ok {
+			return nil, fmt.Errorf("pivot using measure %q not found in underlying query", fn)
+		}
+		if a.label && f.Label != "" {
+			measures = append(measures, f.Label)
+		} else {
+			measures = append(measures, f.Name)
+		}
+	}
+
+	var res []PivotHeader
+	var walk func(prefix []string)
+	walk = func(prefix []string) {
+		if len(prefix) < len(values) {
+			for _, v := range values[len(prefix)] {
+				walk(append(prefix[:len(prefix):len(prefix)], v))
+			}
+			return
+		}
+
+		name := strings.Join(prefix, "_")
+		if len(measures) == 0 {
+			res = append(res, PivotHeader{Column: name, Values: prefix})
+			return
+		}
+		for _, m := range measures {
+			res = append(res, PivotHeader{Column: name + "_" + m, Values: prefix, Measure: m})
+		}
+	}
+	walk(nil)
+
+	return res, nil
+}
+
+// headerRows maps pivoted column names to the header rows to write for them in a file export.
+// Values of pivoted time floor dimensions are written as localized labels, e.g. "März 2024" for a month.
+func (a *pivotAST) headerRows(headers []PivotHeader) map[string][]string {
+	res := make(map[string][]string, len(headers))
+	for _, h := range headers {
+		rows := make([]string, 0, len(h.Values)+1)
+		for i, v := range h.Values {
+			if grain, ok := a.timeGrains[a.on[i]]; ok && a.locale != nil {
+				v = timeLabel(a.locale, v, grain)
+			}
+			rows = append(rows, v)
+		}
+		if h.Measure != "" {
+			rows = append(rows, h.Measure)
+		}
+		res[h.Column] = rows
+	}
+	return res
+}
+
+// timeLabel formats a time value cast to text by the OLAP engine as a localized label for the given grain.
+// It returns the value unchanged if it can't be parsed as a time.
+func timeLabel(loc *locale.Locale, v string, grain TimeGrain) string {
+	for _, layout := range []string{"2006-01-02 15:04:05-07", "2006-01-02 15:04:05", time.RFC3339Nano, "2006-01-02"} {
+		t, err := time.Parse(layout, v)
+		if err == nil {
+			return loc.TimeLabel(t, string(grain))
+		}
+	}
+	return v
+}
+
 func randomString(prefix string, n int) (string, error) {
 	b := make([]byte, n)
 	_, err := rand.Read(b)
@@ -330,3 +735,91 @@ func findField(n string, fs []FieldNode) (FieldNode, bool) {
 	}
 	return FieldNode{}, false
 }
+
+// normalizePivotColumns validates qry.PivotColumns and returns a copy with defaults applied.
+// It returns nil if no pivot column options were provided.
+func normalizePivotColumns(qry *Query) (*PivotColumns, error) {
+	if qry.PivotColumns == nil {
+		return nil, nil
+	}
+	cols := *qry.PivotColumns
+
+	if !cols.Sort.Valid() {
+		return nil, fmt.Errorf("invalid pivot column sort %q", cols.Sort)
+	}
+
+	if cols.Limit != nil {
+		if *cols.Limit < 1 {
+			return nil, fmt.Errorf("pivot column limit must be greater than zero")
+		}
+
+		// The rollup of the remaining values filters on the dimension, which is not possible for computed dimensions
+		for _, d := range qry.Dimensions {
+			if d.Compute == nil {
+				continue
+			}
+			for _, f := range qry.PivotOn {
+				if f == d.Name {
+					return nil, fmt.Errorf("pivot column limit is not supported for computed dimension %q", d.Name)
+				}
+			}
+		}
+
+		if cols.Sort == PivotColumnSortUnspecified {
+			cols.Sort = PivotColumnSortMeasure
+			cols.Desc = true
+		}
+	}
+
+	if cols.Sort == PivotColumnSortUnspecified {
+		// Nothing to resolve
+		return nil, nil
+	}
+
+	if cols.Sort == PivotColumnSortMeasure {
+		if cols.Measure == "" {
+			if len(qry.Measures) == 0 {
+				return nil, fmt.Errorf("cannot sort pivot columns by measure: the query has no measures")
+			}
+			cols.Measure = qry.Measures[0].Name
+		}
+
+		var found bool
+		for _, m := range qry.Measures {
+			if m.Name == cols.Measure {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("pivot column sort measure %q not found in measures", cols.Measure)
+		}
+	} else if cols.Measure != "" {
+		return nil, fmt.Errorf("a pivot column sort measure can only be set when sorting by measure")
+	}
+
+	if cols.OtherLabel == "" {
+		cols.OtherLabel = "Other"
+	}
+
+	return &cols, nil
+}
+
+// notInExpression returns an expression that matches rows where the dimension is not one of the given values.
+func notInExpression(dim string, values []any) *Expression {
+	return &Expression{Condition: &Condition{
+		Operator:    OperatorNin,
+		Expressions: []*Expression{{Name: dim}, {Value: values}},
+	}}
+}
+
+// andExpressions returns the AND of two expressions, either of which may be nil.
+func andExpressions(a, b *Expression) *Expression {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	return &Expression{Condition: &Condition{Operator: OperatorAnd, Expressions: []*Expression{a, b}}}
+}
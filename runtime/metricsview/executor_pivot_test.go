@@ -0,0 +1,84 @@
+package metricsview
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePivotColumns(t *testing.T) {
+	limit := int64(3)
+	zero := int64(0)
+	qry := func(cols *PivotColumns) *Query {
+		return &Query{
+			Dimensions:   []Dimension{{Name: "country"}, {Name: "device"}, {Name: "day", Compute: &DimensionCompute{TimeFloor: &DimensionComputeTimeFloor{Dimension: "ts", Grain: TimeGrainDay}}}},
+			Measures:     []Measure{{Name: "revenue"}, {Name: "users"}},
+			PivotOn:      []string{"device"},
+			PivotColumns: cols,
+		}
+	}
+
+	// No options
+	cols, err := normalizePivotColumns(qry(nil))
+	require.NoError(t, err)
+	require.Nil(t, cols)
+	cols, err = normalizePivotColumns(qry(&PivotColumns{Desc: true}))
+	require.NoError(t, err)
+	require.Nil(t, cols)
+
+	// Limit defaults to the top values by the first measure
+	cols, err = normalizePivotColumns(qry(&PivotColumns{Limit: &limit}))
+	require.NoError(t, err)
+	require.Equal(t, &PivotColumns{Sort: PivotColumnSortMeasure, Measure: "revenue", Desc: true, Limit: &limit, OtherLabel: "Other"}, cols)
+
+	cols, err = normalizePivotColumns(qry(&PivotColumns{Sort: PivotColumnSortAlphabetical, OtherLabel: "Rest"}))
+	require.NoError(t, err)
+	require.Equal(t, &PivotColumns{Sort: PivotColumnSortAlphabetical, OtherLabel: "Rest"}, cols)
+
+	_, err = normalizePivotColumns(qry(&PivotColumns{Sort: PivotColumnSortMeasure, Measure: "users"}))
+	require.NoError(t, err)
+
+	// Invalid options
+	_, err = normalizePivotColumns(qry(&PivotColumns{Sort: "random"}))
+	require.Error(t, err)
+	_, err = normalizePivotColumns(qry(&PivotColumns{Limit: &zero}))
+	require.Error(t, err)
+	_, err = normalizePivotColumns(qry(&PivotColumns{Sort: PivotColumnSortMeasure, Measure: "unknown"}))
+	require.Error(t, err)
+	_, err = normalizePivotColumns(qry(&PivotColumns{Sort: PivotColumnSortValue, Measure: "users"}))
+	require.Error(t, err)
+
+	q := qry(&PivotColumns{Limit: &limit})
+	q.PivotOn = []string{"day"}
+	_, err = normalizePivotColumns(q)
+	require.ErrorContains(t, err, "computed dimension")
+}
+
+func TestPivotColumnSortFromProto(t *testing.T) {
+	s, err := PivotColumnSortFromProto(runtimev1.PivotColumnSort_PIVOT_COLUMN_SORT_MEASURE)
+	require.NoError(t, err)
+	require.Equal(t, PivotColumnSortMeasure, s)
+
+	_, err = PivotColumnSortFromProto(runtimev1.PivotColumnSort(100))
+	require.Error(t, err)
+}
+
+func TestPivotSQLColumns(t *testing.T) {
+	underlying := &AST{Root: &SelectNode{
+		DimFields:     []FieldNode{{Name: "country"}, {Name: "device"}},
+		MeasureFields: []FieldNode{{Name: "revenue"}},
+	}}
+	pivot := &pivotAST{
+		keep:    []string{"country"},
+		on:      []string{"device"},
+		in:      map[string][]string{"device": {"mobile", "desktop", "Other"}},
+		using:   []string{"revenue"},
+		dialect: drivers.DialectDuckDB,
+	}
+
+	sql, err := pivot.SQL(underlying, "t")
+	require.NoError(t, err)
+	require.Equal(t, `PIVOT t ON "device" IN ('mobile', 'desktop', 'Other') USING ANY_VALUE("revenue") AS "revenue"`, sql)
+}
@@ -0,0 +1,266 @@
+package metricsview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/duration"
+)
+
+// maxRetentionPeriods caps the number of periods tracked for each cohort in a RetentionQuery.
+const maxRetentionPeriods = 366
+
+// RetentionQuery groups the values of a dimension, such as a user ID, into cohorts by the time period they were first seen,
+// and counts how many of each cohort's values are active in each of the following periods.
+type RetentionQuery struct {
+	MetricsView string `mapstructure:"metrics_view"`
+	// Dimension identifies the values to track, e.g. a user ID.
+	Dimension string `mapstructure:"dimension"`
+	// TimeGrain is the length of the cohort and activity periods.
+	TimeGrain TimeGrain `mapstructure:"time_grain"`
+	// Periods is the number of periods after the cohort's period to track.
+	Periods int `mapstructure:"periods"`
+	// TimeRange selects the cohorts whose period starts in the range.
+	// Values are assigned to cohorts based on all their activity, including activity before the time range.
+	TimeRange               *TimeRange  `mapstructure:"time_range"`
+	Where                   *Expression `mapstructure:"where"`
+	TimeZone                string      `mapstructure:"time_zone"`
+	ExecutionTimeoutSeconds uint32      `mapstructure:"execution_timeout_seconds"`
+}
+
+// RetentionCohort is a row in the cohort matrix returned for a RetentionQuery.
+type RetentionCohort struct {
+	// Start is the start of the cohort's period.
+	Start time.Time
+	// Size is the number of dimension values first seen in the cohort's period.
+	Size int64
+	// Counts contains the number of the cohort's values that were active in the cohort's period (Counts[0], which equals Size) and each of the following periods.
+	// It's truncated to exclude periods that start after the latest period with data.
+	Counts []int64
+	// Rates contains Counts divided by Size.
+	Rates []float64
+}
+
+// Retention computes a cohort retention matrix in a single query.
+// On Druid, which has limited support for window functions, it uses a join instead, which is subject to Druid's limits on subquery results.
+func (e *Executor) Retention(ctx context.Context, qry *RetentionQuery, executionTime *time.Time) ([]*RetentionCohort, error) {
+	if !e.security.CanAccess() {
+		return nil, runtime.ErrForbidden
+	}
+
+	if e.metricsView.TimeDimension == "" {
+		return nil, errors.New("metrics view does not have a time dimension")
+	}
+	if qry.Dimension == "" {
+		return nil, errors.New("a retention query requires a dimension")
+	}
+	if qry.TimeGrain == TimeGrainUnspecified || qry.TimeGrain == TimeGrainMillisecond || !qry.TimeGrain.Valid() {
+		return nil, fmt.Errorf("invalid time grain %q", qry.TimeGrain)
+	}
+	if qry.Periods < 1 || qry.Periods > maxRetentionPeriods {
+		return nil, fmt.Errorf("the number of periods must be between 1 and %d", maxRetentionPeriods)
+	}
+
+	tz := time.UTC
+	if qry.TimeZone != "" {
+		var err error
+		tz, err = time.LoadLocation(qry.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", qry.TimeZone, err)
+		}
+	}
+
+	// Query the distinct pairs of dimension values and periods with activity.
+	timeDim := e.metricsView.TimeDimension
+	inner := &Query{
+		MetricsView: qry.MetricsView,
+		Dimensions: []Dimension{
+			{Name: qry.Dimension},
+			{
+				Name: timeDim,
+				Compute: &DimensionCompute{TimeFloor: &DimensionComputeTimeFloor{
+					Dimension: timeDim,
+					Grain:     qry.TimeGrain,
+				}},
+			},
+		},
+		TimeRange:               qry.TimeRange,
+		Where:                   qry.Where,
+		TimeZone:                qry.TimeZone,
+		ExecutionTimeoutSeconds: qry.ExecutionTimeoutSeconds,
+	}
+	err := e.rewriteQuerySegments(ctx, inner)
+	if err != nil {
+		return nil, err
+	}
+	err = e.rewriteQueryTimeRanges(ctx, inner, executionTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// The time range selects cohorts, but the activity must include all history (to find when values were first seen) and the tracked periods after the range.
+	var start, end time.Time
+	if inner.TimeRange != nil {
+		start, end = inner.TimeRange.Start, inner.TimeRange.End
+		inner.TimeRange = nil
+	}
+	if !end.IsZero() {
+		d, err := grainDuration(qry.TimeGrain, qry.Periods)
+		if err != nil {
+			return nil, err
+		}
+		sd, err := duration.ParseISO8601(d)
+		if err != nil {
+			return nil, err
+		}
+		inner.TimeRange = &TimeRange{End: sd.Add(end.In(tz))}
+	}
+
+	ast, err := NewAST(e.metricsView, e.security, inner, e.olap.Dialect())
+	if err != nil {
+		return nil, err
+	}
+	innerSQL, args, err := ast.SQL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Find each value's cohort and count the values in each pair of cohort and period.
+	d := e.olap.Dialect()
+	activity := fmt.Sprintf("SELECT %s AS id, %s AS period FROM (%s) r WHERE %s IS NOT NULL", d.EscapeIdentifier(qry.Dimension), d.EscapeIdentifier(timeDim), innerSQL, d.EscapeIdentifier(qry.Dimension))
+	var sql, cohort, period string
+	if d == drivers.DialectDruid {
+		cohort, period = "c.cohort", "a.period"
+		sql = fmt.Sprintf("SELECT c.cohort, a.period, COUNT(*) AS n FROM (%s) a INNER JOIN (SELECT id, MIN(period) AS cohort FROM (%s) b GROUP BY id) c ON a.id = c.id", activity, activity)
+		args = append(args, args...)
+	} else {
+		cohort, period = "cohort", "period"
+		sql = fmt.Sprintf("SELECT cohort, period, COUNT(*) AS n FROM (SELECT period, MIN(period) OVER (PARTITION BY id) AS cohort FROM (%s) a) c", activity)
+	}
+	var where []string
+	if !start.IsZero() {
+		where = append(where, fmt.Sprintf("%s >= ?", cohort))
+		args = append(args, start)
+	}
+	if !end.IsZero() {
+		where = append(where, fmt.Sprintf("%s < ?", cohort))
+		args = append(args, end)
+	}
+	if len(where) > 0 {
+		sql += " WHERE " + strings.Join(where, " AND ")
+	}
+	sql += fmt.Sprintf(" GROUP BY %s, %s", cohort, period)
+
+	res, err := e.olap.Execute(ctx, &drivers.Statement{
+		Query:            sql,
+		Args:             args,
+		Priority:         e.priority,
+		ExecutionTimeout: e.executionTimeout(inner, defaultInteractiveTimeout),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var cells []retentionCell
+	var latest time.Time
+	for res.Next() {
+		var c retentionCell
+		err = res.Scan(&c.cohort, &c.period, &c.n)
+		if err != nil {
+			return nil, err
+		}
+		if c.period.After(latest) {
+			latest = c.period
+		}
+		cells = append(cells, c)
+	}
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return retentionMatrix(cells, qry.TimeGrain, qry.Periods, tz, latest)
+}
+
+// retentionCell is the number of values in a cohort that were active in a period.
+type retentionCell struct {
+	cohort, period time.Time
+	n              int64
+}
+
+// retentionMatrix arranges the cells into cohorts ordered by time, tracking the given number of periods after each cohort's period.
+// The periods of each cohort are truncated to exclude periods that start after latest.
+func retentionMatrix(cells []retentionCell, grain TimeGrain, periods int, tz *time.Location, latest time.Time) ([]*RetentionCohort, error) {
+	// Durations for adding k periods to the start of a cohort
+	durs := make([]duration.Duration, periods+1)
+	for k := 1; k <= periods; k++ {
+		d, err := grainDuration(grain, k)
+		if err != nil {
+			return nil, err
+		}
+		durs[k], err = duration.ParseISO8601(d)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	type cohortState struct {
+		res     *RetentionCohort
+		offsets map[int64]int // Maps the start of a period (in unix ms) to its number of periods after the cohort's period
+	}
+	cohorts := make(map[int64]*cohortState)
+	for _, c := range cells {
+		cs, ok := cohorts[c.cohort.UnixMilli()]
+		if !ok {
+			cs = &cohortState{
+				res:     &RetentionCohort{Start: c.cohort},
+				offsets: make(map[int64]int, periods+1),
+			}
+			for k := 0; k <= periods; k++ {
+				t := c.cohort
+				if k > 0 {
+					t = durs[k].Add(c.cohort.In(tz))
+				}
+				if t.After(latest) {
+					break
+				}
+				cs.offsets[t.UnixMilli()] = k
+			}
+			cs.res.Counts = make([]int64, len(cs.offsets))
+			cohorts[c.cohort.UnixMilli()] = cs
+		}
+
+		k, ok := cs.offsets[c.period.UnixMilli()]
+		if !ok {
+			// Beyond the tracked periods
+			continue
+		}
+		cs.res.Counts[k] = c.n
+	}
+
+	res := make([]*RetentionCohort, 0, len(cohorts))
+	for _, cs := range cohorts {
+		c := cs.res
+		if len(c.Counts) > 0 {
+			c.Size = c.Counts[0]
+		}
+		c.Rates = make([]float64, len(c.Counts))
+		for i, n := range c.Counts {
+			if c.Size > 0 {
+				c.Rates[i] = float64(n) / float64(c.Size)
+			}
+		}
+		res = append(res, c)
+	}
+	slices.SortFunc(res, func(a, b *RetentionCohort) int {
+		return a.Start.Compare(b.Start)
+	})
+
+	return res, nil
+}
@@ -0,0 +1,112 @@
+package metricsview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// rewriteQuerySegments replaces the segment references in the query's expressions with the segments' filters.
+// Segments filter on dimensions, so they can't be used in HAVING clauses.
+func (e *Executor) rewriteQuerySegments(ctx context.Context, qry *Query) error {
+	if err := e.rewriteSegments(ctx, qry.MetricsView, qry.Where, false); err != nil {
+		return err
+	}
+	return e.rewriteSegments(ctx, qry.MetricsView, qry.Having, true)
+}
+
+// rewriteSegments recursively replaces segment references in the expression with the segments' filters.
+func (e *Executor) rewriteSegments(ctx context.Context, mvName string, expr *Expression, having bool) error {
+	if expr == nil {
+		return nil
+	}
+
+	if expr.Segment != "" {
+		if having {
+			return fmt.Errorf("segment %q can't be used in a HAVING clause", expr.Segment)
+		}
+		filter, err := e.resolveSegment(ctx, mvName, expr.Segment)
+		if err != nil {
+			return err
+		}
+		*expr = *filter
+		return nil
+	}
+
+	if expr.Condition != nil {
+		for _, ex := range expr.Condition.Expressions {
+			if err := e.rewriteSegments(ctx, mvName, ex, having); err != nil {
+				return err
+			}
+		}
+	}
+
+	if expr.Subquery != nil {
+		if err := e.rewriteSegments(ctx, mvName, expr.Subquery.Where, false); err != nil {
+			return err
+		}
+		if err := e.rewriteSegments(ctx, mvName, expr.Subquery.Having, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveSegment returns the filter of a segment defined for the given metrics view.
+// It uses the segment's last valid spec, so a broken edit to a segment doesn't break the queries that use it.
+func (e *Executor) resolveSegment(ctx context.Context, mvName, name string) (*Expression, error) {
+	ctrl, err := e.rt.Controller(ctx, e.instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindSegment, Name: name}, false)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, fmt.Errorf("segment %q not found", name)
+		}
+		return nil, err
+	}
+
+	spec := res.GetSegment().State.ValidSpec
+	if spec == nil {
+		return nil, fmt.Errorf("segment %q is invalid", name)
+	}
+	if !strings.EqualFold(spec.MetricsView, mvName) {
+		return nil, fmt.Errorf("segment %q is defined for metrics view %q, not %q", name, spec.MetricsView, mvName)
+	}
+
+	return NewExpressionFromProto(spec.Filter), nil
+}
+
+// Segments returns the names of the segments referenced in the query's expressions.
+func (q *Query) Segments() []string {
+	var names []string
+	var walk func(e *Expression)
+	walk = func(e *Expression) {
+		if e == nil {
+			return
+		}
+		if e.Segment != "" {
+			names = append(names, e.Segment)
+		}
+		if e.Condition != nil {
+			for _, ex := range e.Condition.Expressions {
+				walk(ex)
+			}
+		}
+		if e.Subquery != nil {
+			walk(e.Subquery.Where)
+			walk(e.Subquery.Having)
+		}
+	}
+	walk(q.Where)
+	walk(q.Having)
+	return names
+}
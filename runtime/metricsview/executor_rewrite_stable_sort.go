@@ -0,0 +1,35 @@
+package metricsview
+
+// rewriteStableSort adds the output dimensions as tie-breakers to the outermost ORDER BY clause.
+// Since the dimensions form the grouping key, this gives every row a unique position,
+// which ensures that pages fetched with LIMIT and OFFSET never contain duplicate or missing rows.
+// It is a no-op for queries that are neither sorted nor paginated.
+func (e *Executor) rewriteStableSort(ast *AST) {
+	n := ast.Root
+	if len(n.OrderBy) == 0 && n.Offset == nil {
+		return
+	}
+
+	names := make([]string, len(n.DimFields))
+	for i, f := range n.DimFields {
+		names[i] = f.Name
+	}
+	n.OrderBy = appendTieBreakers(n.OrderBy, names)
+}
+
+// appendTieBreakers appends the given fields to the ORDER BY clause if they are not already in it.
+func appendTieBreakers(orderBy []OrderFieldNode, names []string) []OrderFieldNode {
+	for _, name := range names {
+		var found bool
+		for _, o := range orderBy {
+			if o.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			orderBy = append(orderBy, OrderFieldNode{Name: name})
+		}
+	}
+	return orderBy
+}
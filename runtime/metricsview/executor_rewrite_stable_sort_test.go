@@ -0,0 +1,20 @@
+package metricsview
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendTieBreakers(t *testing.T) {
+	orderBy := []OrderFieldNode{{Name: "total", Desc: true}, {Name: "country", Desc: true}}
+	res := appendTieBreakers(orderBy, []string{"country", "city", "day"})
+	require.Equal(t, []OrderFieldNode{
+		{Name: "total", Desc: true},
+		{Name: "country", Desc: true},
+		{Name: "city"},
+		{Name: "day"},
+	}, res)
+
+	require.Equal(t, []OrderFieldNode{{Name: "a"}}, appendTieBreakers(nil, []string{"a"}))
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/duration"
 	"github.com/rilldata/rill/runtime/pkg/timeutil"
@@ -41,8 +42,19 @@ func (e *Executor) resolveTimeRange(ctx context.Context, tr *TimeRange, tz *time
 		return nil
 	}
 
+	if tr.Expression != "" {
+		if !tr.Start.IsZero() || !tr.End.IsZero() || tr.IsoDuration != "" || tr.IsoOffset != "" || tr.RoundToGrain != TimeGrainUnspecified {
+			return errors.New(`"expression" cannot be combined with other time range properties`)
+		}
+		parsed, err := ParseTimeRangeExpression(tr.Expression)
+		if err != nil {
+			return err
+		}
+		*tr = *parsed
+	}
+
 	if tr.Start.IsZero() && tr.End.IsZero() {
-		t, err := e.loadWatermark(ctx, executionTime)
+		t, err := e.loadTimeAnchor(ctx, executionTime)
 		if err != nil {
 			return err
 		}
@@ -118,6 +130,18 @@ func (e *Executor) resolveTimeRange(ctx context.Context, tr *TimeRange, tz *time
 	return nil
 }
 
+// loadTimeAnchor returns the time that relative time ranges are resolved against.
+// It's the watermark unless the metrics view is configured to anchor to the current time.
+func (e *Executor) loadTimeAnchor(ctx context.Context, executionTime *time.Time) (time.Time, error) {
+	if executionTime != nil {
+		return *executionTime, nil
+	}
+	if e.metricsView.TimeAnchor == runtimev1.MetricsViewSpec_TIME_ANCHOR_NOW {
+		return time.Now(), nil
+	}
+	return e.loadWatermark(ctx, executionTime)
+}
+
 // resolveWatermark resolves the metric view's watermark expression.
 // If the resolved time is zero, it defaults to the current time.
 func (e *Executor) loadWatermark(ctx context.Context, executionTime *time.Time) (time.Time, error) {
@@ -140,12 +164,13 @@ func (e *Executor) loadWatermark(ctx context.Context, executionTime *time.Time)
 		return time.Time{}, errors.New("cannot determine time anchor for relative time range")
 	}
 
-	sql := fmt.Sprintf("SELECT %s FROM %s", expr, dialect.EscapeTable(e.metricsView.Database, e.metricsView.DatabaseSchema, e.metricsView.Table))
+	sql := fmt.Sprintf("SELECT %s FROM %s", expr, dialect.EscapeMetricsViewTable(e.metricsView))
 
 	res, err := e.olap.Execute(ctx, &drivers.Statement{
 		Query:            sql,
 		Priority:         e.priority,
 		ExecutionTimeout: defaultInteractiveTimeout,
+		AllowReplica:     true,
 	})
 	if err != nil {
 		return time.Time{}, err
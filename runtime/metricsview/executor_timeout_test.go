@@ -0,0 +1,28 @@
+package metricsview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionTimeout(t *testing.T) {
+	e := &Executor{instanceCfg: drivers.InstanceConfig{MetricsMaxExecutionTimeoutSeconds: 600}}
+
+	// Defaults are used when the query doesn't request a timeout
+	require.Equal(t, defaultInteractiveTimeout, e.executionTimeout(&Query{}, defaultInteractiveTimeout))
+	require.Equal(t, defaultExportTimeout, e.executionTimeout(&Query{}, defaultExportTimeout))
+
+	// Requested timeouts override the default in either direction
+	require.Equal(t, 10*time.Second, e.executionTimeout(&Query{ExecutionTimeoutSeconds: 10}, defaultInteractiveTimeout))
+	require.Equal(t, 8*time.Minute, e.executionTimeout(&Query{ExecutionTimeoutSeconds: 480}, defaultExportTimeout))
+
+	// Requested timeouts are clamped to the instance max
+	require.Equal(t, 10*time.Minute, e.executionTimeout(&Query{ExecutionTimeoutSeconds: 3600}, defaultExportTimeout))
+
+	// No clamping when the instance max is disabled
+	e.instanceCfg.MetricsMaxExecutionTimeoutSeconds = 0
+	require.Equal(t, time.Hour, e.executionTimeout(&Query{ExecutionTimeoutSeconds: 3600}, defaultExportTimeout))
+}
@@ -0,0 +1,445 @@
+package metricsview
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// ExpressionErrorCode is a machine-readable reason for an ExpressionError.
+type ExpressionErrorCode string
+
+const (
+	// ExpressionErrorCodeInvalid means the expression is malformed, e.g. a condition with the wrong number of arguments.
+	ExpressionErrorCodeInvalid ExpressionErrorCode = "INVALID_EXPRESSION"
+	// ExpressionErrorCodeUnknownName means the expression references a name that is not a dimension or measure the caller can access.
+	ExpressionErrorCodeUnknownName ExpressionErrorCode = "UNKNOWN_NAME"
+	// ExpressionErrorCodeMeasureNotAllowed means a WHERE expression references a measure.
+	ExpressionErrorCodeMeasureNotAllowed ExpressionErrorCode = "MEASURE_NOT_ALLOWED"
+	// ExpressionErrorCodeTypeMismatch means a value or operator is not compatible with the type of the dimension or measure it's applied to.
+	ExpressionErrorCodeTypeMismatch ExpressionErrorCode = "TYPE_MISMATCH"
+	// ExpressionErrorCodeUnsupportedOperator means the operator is not supported by the metrics view's OLAP connector.
+	ExpressionErrorCodeUnsupportedOperator ExpressionErrorCode = "UNSUPPORTED_OPERATOR"
+	// ExpressionErrorCodeInvalidValue means a value is invalid for its operator, e.g. a malformed CIDR block or regular expression.
+	ExpressionErrorCodeInvalidValue ExpressionErrorCode = "INVALID_VALUE"
+)
+
+// ExpressionError describes a problem found by ValidateExpressions.
+type ExpressionError struct {
+	// Clause is the clause that contains the problem, i.e. "where" or "having".
+	Clause string
+	// Path locates the problematic expression in the clause's expression tree, e.g. "cond.exprs[1]". It's empty for the clause's root expression.
+	Path string
+	// Name is the dimension or measure name that the problem relates to, if any.
+	Name    string
+	Code    ExpressionErrorCode
+	Message string
+}
+
+// ValidateExpressions validates WHERE and HAVING expressions against the metrics view without running a query with them.
+// Unlike query execution, it doesn't stop at the first problem, but returns all the problems it finds.
+// The HAVING expression may reference any of the metrics view's dimensions and measures, as well as the names in havingNames (such as aliases or computed measures), which are not type checked.
+// The WHERE expression may reference segments defined for the metrics view with the given name; their filters are validated too.
+func (e *Executor) ValidateExpressions(ctx context.Context, metricsView string, where, having *Expression, havingNames []string) ([]*ExpressionError, error) {
+	// The schema only contains the fields that the security policy allows access to
+	schema, err := e.Schema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v := newExpressionValidator(e.metricsView, schema, e.olap.Dialect(), havingNames)
+	v.segments = func(name string) (*Expression, error) {
+		return e.resolveSegment(ctx, metricsView, name)
+	}
+	v.validateRoot(where, "where", false)
+	v.validateRoot(having, "having", true)
+	return v.errs, nil
+}
+
+// expressionValidator accumulates the problems found in expressions.
+type expressionValidator struct {
+	dialect     drivers.Dialect
+	types       map[string]*runtimev1.Type
+	measures    map[string]bool
+	havingNames map[string]bool
+	segments    func(name string) (*Expression, error) // Resolves a segment's filter. If nil, segments are reported as unknown.
+	errs        []*ExpressionError
+}
+
+func newExpressionValidator(mv *runtimev1.MetricsViewSpec, schema *runtimev1.StructType, dialect drivers.Dialect, havingNames []string) *expressionValidator {
+	v := &expressionValidator{
+		dialect:     dialect,
+		types:       make(map[string]*runtimev1.Type, len(schema.Fields)),
+		measures:    make(map[string]bool, len(mv.Measures)),
+		havingNames: make(map[string]bool, len(havingNames)),
+	}
+	for _, f := range schema.Fields {
+		v.types[f.Name] = f.Type
+	}
+	for _, m := range mv.Measures {
+		v.measures[m.Name] = true
+	}
+	for _, n := range havingNames {
+		v.havingNames[n] = true
+	}
+	return v
+}
+
+func (v *expressionValidator) addError(clause, path, name string, code ExpressionErrorCode, msg string, args ...any) {
+	v.errs = append(v.errs, &ExpressionError{
+		Clause:  clause,
+		Path:    path,
+		Name:    name,
+		Code:    code,
+		Message: fmt.Sprintf(msg, args...),
+	})
+}
+
+// validateRoot validates the root expression of a clause, which must be a condition if set.
+func (v *expressionValidator) validateRoot(e *Expression, clause string, having bool) {
+	if e == nil {
+		return
+	}
+	v.validateBoolean(e, clause, "", having)
+}
+
+// validateBoolean validates an expression that must evaluate to a boolean, i.e. a condition.
+func (v *expressionValidator) validateBoolean(e *Expression, clause, path string, having bool) {
+	if e != nil && e.Segment != "" {
+		v.validateSegment(e.Segment, clause, joinExpressionPath(path, "segment"), having)
+		return
+	}
+	if e == nil || e.Condition == nil {
+		v.addError(clause, path, "", ExpressionErrorCodeInvalid, "expected a condition")
+		return
+	}
+	v.validateCondition(e.Condition, clause, joinExpressionPath(path, "cond"), having)
+}
+
+// validateSegment validates a segment reference, which must be in a WHERE clause and resolve to a valid filter.
+func (v *expressionValidator) validateSegment(name, clause, path string, having bool) {
+	if having {
+		v.addError(clause, path, name, ExpressionErrorCodeInvalid, "segment %q can't be used in a HAVING clause", name)
+		return
+	}
+	if v.segments == nil {
+		v.addError(clause, path, name, ExpressionErrorCodeUnknownName, "segment %q not found", name)
+		return
+	}
+	filter, err := v.segments(name)
+	if err != nil {
+		v.addError(clause, path, name, ExpressionErrorCodeUnknownName, "%s", err.Error())
+		return
+	}
+	v.validateBoolean(filter, clause, path, false)
+}
+
+func (v *expressionValidator) validateCondition(c *Condition, clause, path string, having bool) {
+	switch c.Operator {
+	case OperatorAnd, OperatorOr:
+		for i, e := range c.Expressions {
+			v.validateBoolean(e, clause, fmt.Sprintf("%s.exprs[%d]", path, i), having)
+		}
+		return
+	case OperatorUnspecified:
+		v.addError(clause, path, "", ExpressionErrorCodeInvalid, "condition has no operator")
+		return
+	}
+	if !c.Operator.Valid() {
+		v.addError(clause, path, "", ExpressionErrorCodeInvalid, "invalid operator %q", c.Operator)
+		return
+	}
+
+	exprs, ok := binaryConditionExpressions(c.Operator, c.Expressions)
+	if !ok {
+		v.addError(clause, path, "", ExpressionErrorCodeInvalid, "the %q operator must have exactly 2 arguments", c.Operator)
+		return
+	}
+	left, right := exprs[0], exprs[1]
+	leftPath := path + ".exprs[0]"
+	rightPath := path + ".exprs[1]"
+
+	// Check the operator is supported by the OLAP connector
+	switch c.Operator {
+	case OperatorRegex:
+		if _, ok := v.dialect.RegexpMatchExpr("x", "?"); !ok {
+			v.addError(clause, path, "", ExpressionErrorCodeUnsupportedOperator, "the %q operator is not supported for %s", c.Operator, v.dialect.String())
+		}
+	case OperatorIPInCIDR, OperatorIPNinCIDR, OperatorIPBetween:
+		if _, ok := v.dialect.IPAddressExpr("x"); !ok {
+			v.addError(clause, path, "", ExpressionErrorCodeUnsupportedOperator, "the %q operator is not supported for %s", c.Operator, v.dialect.String())
+		}
+	case OperatorIlike, OperatorNilike:
+		if c.AccentInsensitive {
+			if _, ok := v.dialect.StripAccentsExpr("x"); !ok {
+				v.addError(clause, path, "", ExpressionErrorCodeUnsupportedOperator, "accent-insensitive matching is not supported for %s", v.dialect.String())
+			}
+		}
+	}
+
+	// Validate the left side, which is usually a dimension or measure
+	var name string
+	var typ *runtimev1.Type
+	switch {
+	case left == nil:
+		v.addError(clause, leftPath, "", ExpressionErrorCodeInvalid, "left expression is nil")
+		return
+	case left.Name != "":
+		name = left.Name
+		typ = v.validateName(left.Name, clause, leftPath, having)
+	case left.Condition != nil:
+		v.validateCondition(left.Condition, clause, joinExpressionPath(leftPath, "cond"), having)
+	case left.Subquery != nil:
+		v.addError(clause, leftPath, "", ExpressionErrorCodeInvalid, "a subquery is only allowed on the right side of an %q or %q condition", OperatorIn, OperatorNin)
+	case left.Segment != "":
+		v.addError(clause, leftPath, left.Segment, ExpressionErrorCodeInvalid, "a segment can't be an argument of the %q operator", c.Operator)
+	}
+
+	// Validate the right side
+	if right == nil {
+		v.addError(clause, rightPath, "", ExpressionErrorCodeInvalid, "right expression is nil")
+		return
+	}
+	if right.Name != "" {
+		v.validateName(right.Name, clause, rightPath, having)
+		return
+	}
+	if right.Segment != "" {
+		v.addError(clause, rightPath, right.Segment, ExpressionErrorCodeInvalid, "a segment can't be an argument of the %q operator", c.Operator)
+		return
+	}
+	if right.Condition != nil {
+		v.validateCondition(right.Condition, clause, joinExpressionPath(rightPath, "cond"), having)
+		return
+	}
+	if right.Subquery != nil {
+		if c.Operator != OperatorIn && c.Operator != OperatorNin {
+			v.addError(clause, rightPath, "", ExpressionErrorCodeInvalid, "a subquery is only allowed on the right side of an %q or %q condition", OperatorIn, OperatorNin)
+			return
+		}
+		v.validateSubquery(right.Subquery, clause, joinExpressionPath(rightPath, "subquery"))
+		return
+	}
+	v.validateValue(c.Operator, name, typ, right.Value, clause, rightPath)
+}
+
+// validateValue validates the literal value on the right side of a binary condition against the operator and the type of the left side.
+// The type is nil if the left side is not a dimension or measure with a known type.
+func (v *expressionValidator) validateValue(op Operator, name string, typ *runtimev1.Type, val any, clause, path string) {
+	switch op {
+	case OperatorIn, OperatorNin:
+		vals, ok := val.([]any)
+		if !ok {
+			v.addError(clause, path, name, ExpressionErrorCodeInvalidValue, "the right value must be a list of values for an %q condition", op)
+			return
+		}
+		for i, val := range vals {
+			if !valueMatchesType(typ, val) {
+				v.addError(clause, fmt.Sprintf("%s.val[%d]", path, i), name, ExpressionErrorCodeTypeMismatch, "value %v is not compatible with the type of %q", val, name)
+			}
+		}
+	case OperatorIlike, OperatorNilike:
+		if !typeIsString(typ) {
+			v.addError(clause, path, name, ExpressionErrorCodeTypeMismatch, "the %q operator can only be applied to text, but %q is not text", op, name)
+		}
+		if _, ok := val.(string); !ok {
+			v.addError(clause, path, name, ExpressionErrorCodeInvalidValue, "the right value must be a string pattern for an %q condition", op)
+		}
+	case OperatorRegex:
+		if !typeIsString(typ) {
+			v.addError(clause, path, name, ExpressionErrorCodeTypeMismatch, "the %q operator can only be applied to text, but %q is not text", op, name)
+		}
+		pattern, ok := val.(string)
+		if !ok {
+			v.addError(clause, path, name, ExpressionErrorCodeInvalidValue, "the right side of a %q condition must be a string value", op)
+			return
+		}
+		if err := ValidateRegex(pattern); err != nil {
+			v.addError(clause, path, name, ExpressionErrorCodeInvalidValue, "%s", err.Error())
+		}
+	case OperatorIPInCIDR, OperatorIPNinCIDR:
+		if !typeIsString(typ) {
+			v.addError(clause, path, name, ExpressionErrorCodeTypeMismatch, "the %q operator can only be applied to text, but %q is not text", op, name)
+		}
+		cidr, ok := val.(string)
+		if !ok {
+			v.addError(clause, path, name, ExpressionErrorCodeInvalidValue, "the right value must be a CIDR string for an %q condition", op)
+			return
+		}
+		if _, _, err := CIDRBounds(cidr); err != nil {
+			v.addError(clause, path, name, ExpressionErrorCodeInvalidValue, "%s", err.Error())
+		}
+	case OperatorIPBetween:
+		if !typeIsString(typ) {
+			v.addError(clause, path, name, ExpressionErrorCodeTypeMismatch, "the %q operator can only be applied to text, but %q is not text", op, name)
+		}
+		if _, _, err := IPRangeBounds(val); err != nil {
+			v.addError(clause, path, name, ExpressionErrorCodeInvalidValue, "%s", err.Error())
+		}
+	case OperatorLt, OperatorLte, OperatorGt, OperatorGte:
+		if typ != nil && typ.Code == runtimev1.Type_CODE_BOOL {
+			v.addError(clause, path, name, ExpressionErrorCodeTypeMismatch, "the %q operator can't be applied to %q because it's a boolean", op, name)
+			return
+		}
+		if val == nil {
+			v.addError(clause, path, name, ExpressionErrorCodeInvalidValue, "the right value of an %q condition can't be null", op)
+			return
+		}
+		if !valueMatchesType(typ, val) {
+			v.addError(clause, path, name, ExpressionErrorCodeTypeMismatch, "value %v is not compatible with the type of %q", val, name)
+		}
+	default: // OperatorEq, OperatorNeq
+		if !valueMatchesType(typ, val) {
+			v.addError(clause, path, name, ExpressionErrorCodeTypeMismatch, "value %v is not compatible with the type of %q", val, name)
+		}
+	}
+}
+
+// validateSubquery validates a subquery on the right side of an IN or NIN condition.
+func (v *expressionValidator) validateSubquery(sq *Subquery, clause, path string) {
+	if sq.Dimension.Name == "" {
+		v.addError(clause, path, "", ExpressionErrorCodeInvalid, "subquery must have a dimension")
+	} else {
+		v.validateName(sq.Dimension.Name, clause, path, false)
+	}
+	for _, m := range sq.Measures {
+		if _, ok := v.types[m.Name]; !ok || !v.measures[m.Name] {
+			v.addError(clause, path, m.Name, ExpressionErrorCodeUnknownName, "%q is not a measure of the metrics view", m.Name)
+		}
+	}
+	if sq.Where != nil {
+		v.validateBoolean(sq.Where, clause, joinExpressionPath(path, "where"), false)
+	}
+	if sq.Having != nil {
+		v.validateBoolean(sq.Having, clause, joinExpressionPath(path, "having"), true)
+	}
+}
+
+// validateName validates a dimension or measure reference and returns its type.
+// It returns nil if the name is invalid or its type is unknown.
+func (v *expressionValidator) validateName(name, clause, path string, having bool) *runtimev1.Type {
+	if having && v.havingNames[name] {
+		return nil
+	}
+
+	typ, ok := v.types[name]
+	if !ok {
+		if having {
+			v.addError(clause, path, name, ExpressionErrorCodeUnknownName, "%q is not a dimension or measure of the metrics view", name)
+		} else {
+			v.addError(clause, path, name, ExpressionErrorCodeUnknownName, "%q is not a dimension of the metrics view", name)
+		}
+		return nil
+	}
+
+	if !having && v.measures[name] {
+		v.addError(clause, path, name, ExpressionErrorCodeMeasureNotAllowed, "measure %q can't be used in a where clause (use a having clause instead)", name)
+		return nil
+	}
+
+	return typ
+}
+
+// binaryConditionExpressions returns the left and right expressions of a binary condition.
+// Like the SQL builder, it accepts the backwards compatible forms where the values of IN, NIN and IP_BETWEEN are passed as separate expressions.
+// It returns false if the condition doesn't have exactly two arguments.
+func binaryConditionExpressions(op Operator, exprs []*Expression) ([]*Expression, bool) {
+	if op == OperatorIn || op == OperatorNin {
+		if len(exprs) == 2 && exprs[1] != nil {
+			rhs := exprs[1]
+			typ := reflect.TypeOf(rhs.Value)
+			isListVal := typ != nil && typ.Kind() == reflect.Slice
+			if rhs.Name == "" && !isListVal && rhs.Condition == nil && rhs.Subquery == nil {
+				return []*Expression{exprs[0], {Value: []any{rhs.Value}}}, true
+			}
+		}
+		if len(exprs) > 2 {
+			vals := make([]any, 0, len(exprs)-1)
+			for _, e := range exprs[1:] {
+				if e == nil {
+					return nil, false
+				}
+				vals = append(vals, e.Value)
+			}
+			return []*Expression{exprs[0], {Value: vals}}, true
+		}
+	}
+
+	if op == OperatorIPBetween && len(exprs) == 3 && exprs[1] != nil && exprs[2] != nil {
+		return []*Expression{exprs[0], {Value: []any{exprs[1].Value, exprs[2].Value}}}, true
+	}
+
+	return exprs, len(exprs) == 2
+}
+
+// valueMatchesType returns true if the value can be compared to a dimension or measure of the given type.
+// Values that can be cast by the OLAP engine, such as numeric strings for numeric types, are allowed.
+// It returns true if the type is unknown.
+func valueMatchesType(typ *runtimev1.Type, val any) bool {
+	if typ == nil || val == nil {
+		return true
+	}
+
+	switch typ.Code {
+	case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64, runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256,
+		runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64, runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256,
+		runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64, runtimev1.Type_CODE_DECIMAL:
+		switch val := val.(type) {
+		case float64, float32, int, int32, int64:
+			return true
+		case string:
+			_, err := strconv.ParseFloat(val, 64)
+			return err == nil
+		default:
+			return false
+		}
+	case runtimev1.Type_CODE_BOOL:
+		switch val := val.(type) {
+		case bool:
+			return true
+		case string:
+			_, err := strconv.ParseBool(val)
+			return err == nil
+		default:
+			return false
+		}
+	case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE:
+		s, ok := val.(string)
+		if !ok {
+			return false
+		}
+		for _, layout := range []string{time.RFC3339Nano, time.DateTime, time.DateOnly} {
+			if _, err := time.Parse(layout, s); err == nil {
+				return true
+			}
+		}
+		return false
+	case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_UUID:
+		switch val.(type) {
+		case []any, map[string]any:
+			return false
+		default:
+			return true
+		}
+	default:
+		return true
+	}
+}
+
+// typeIsString returns true if the type is textual or unknown.
+func typeIsString(typ *runtimev1.Type) bool {
+	return typ == nil || typ.Code == runtimev1.Type_CODE_STRING || typ.Code == runtimev1.Type_CODE_UUID
+}
+
+// joinExpressionPath appends a field to an expression path.
+func joinExpressionPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
@@ -0,0 +1,106 @@
+package metricsview
+
+import (
+	"fmt"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateExpressions(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{{Name: "country"}, {Name: "ip"}, {Name: "clicks_bucket"}, {Name: "secret"}},
+		Measures:   []*runtimev1.MetricsViewSpec_MeasureV2{{Name: "revenue"}},
+	}
+	// The schema doesn't contain "secret", e.g. because of a security policy
+	schema := &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+		{Name: "country", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+		{Name: "ip", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+		{Name: "clicks_bucket", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}},
+		{Name: "revenue", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64}},
+	}}
+
+	cond := func(op Operator, exprs ...*Expression) *Expression {
+		return &Expression{Condition: &Condition{Operator: op, Expressions: exprs}}
+	}
+	validate := func(dialect drivers.Dialect, where, having *Expression) []*ExpressionError {
+		v := newExpressionValidator(mv, schema, dialect, []string{"revenue__delta_abs"})
+		v.validateRoot(where, "where", false)
+		v.validateRoot(having, "having", true)
+		return v.errs
+	}
+
+	// Valid expressions
+	errs := validate(drivers.DialectDuckDB,
+		cond(OperatorAnd,
+			cond(OperatorIn, &Expression{Name: "country"}, &Expression{Value: []any{"US", "DK"}}),
+			cond(OperatorGte, &Expression{Name: "clicks_bucket"}, &Expression{Value: "10"}),
+			cond(OperatorIPInCIDR, &Expression{Name: "ip"}, &Expression{Value: "10.0.0.0/8"}),
+		),
+		cond(OperatorOr,
+			cond(OperatorGt, &Expression{Name: "revenue"}, &Expression{Value: 100.0}),
+			cond(OperatorLt, &Expression{Name: "revenue__delta_abs"}, &Expression{Value: 0.0}),
+		),
+	)
+	require.Empty(t, errs)
+
+	// Unknown names, measures in WHERE and type mismatches
+	errs = validate(drivers.DialectDuckDB,
+		cond(OperatorAnd,
+			cond(OperatorEq, &Expression{Name: "secret"}, &Expression{Value: "x"}),
+			cond(OperatorGt, &Expression{Name: "revenue"}, &Expression{Value: 100.0}),
+			cond(OperatorIn, &Expression{Name: "clicks_bucket"}, &Expression{Value: []any{1.0, "many"}}),
+			cond(OperatorIlike, &Expression{Name: "clicks_bucket"}, &Expression{Value: "%1%"}),
+		),
+		cond(OperatorGt, &Expression{Name: "nope"}, &Expression{Value: 1.0}),
+	)
+	require.Len(t, errs, 5)
+	require.Equal(t, &ExpressionError{Clause: "where", Path: "cond.exprs[0].cond.exprs[0]", Name: "secret", Code: ExpressionErrorCodeUnknownName, Message: `"secret" is not a dimension of the metrics view`}, errs[0])
+	require.Equal(t, ExpressionErrorCodeMeasureNotAllowed, errs[1].Code)
+	require.Equal(t, "cond.exprs[1].cond.exprs[0]", errs[1].Path)
+	require.Equal(t, ExpressionErrorCodeTypeMismatch, errs[2].Code)
+	require.Equal(t, "cond.exprs[2].cond.exprs[1].val[1]", errs[2].Path)
+	require.Equal(t, ExpressionErrorCodeTypeMismatch, errs[3].Code)
+	require.Equal(t, "having", errs[4].Clause)
+	require.Equal(t, ExpressionErrorCodeUnknownName, errs[4].Code)
+
+	// Operators not supported by the dialect and invalid values
+	errs = validate(drivers.DialectDruid,
+		cond(OperatorAnd,
+			cond(OperatorIPInCIDR, &Expression{Name: "ip"}, &Expression{Value: "10.0.0.0/99"}),
+			cond(OperatorRegex, &Expression{Name: "country"}, &Expression{Value: "(a+)+"}),
+			cond(OperatorEq, &Expression{Name: "country"}),
+		),
+		nil,
+	)
+	require.Len(t, errs, 4)
+	require.Equal(t, ExpressionErrorCodeUnsupportedOperator, errs[0].Code)
+	require.Equal(t, ExpressionErrorCodeInvalidValue, errs[1].Code)
+	require.Equal(t, ExpressionErrorCodeInvalidValue, errs[2].Code)
+	require.Equal(t, ExpressionErrorCodeInvalid, errs[3].Code)
+	require.Equal(t, "cond.exprs[2].cond", errs[3].Path)
+
+	// The root must be a condition
+	errs = validate(drivers.DialectDuckDB, &Expression{Name: "country"}, nil)
+	require.Len(t, errs, 1)
+	require.Equal(t, ExpressionErrorCodeInvalid, errs[0].Code)
+
+	// Segments are resolved and their filters validated, but they can't be used in HAVING
+	v := newExpressionValidator(mv, schema, drivers.DialectDuckDB, nil)
+	v.segments = func(name string) (*Expression, error) {
+		if name == "emea" {
+			return cond(OperatorAnd, cond(OperatorEq, &Expression{Name: "country"}, &Expression{Value: "DK"}), cond(OperatorEq, &Expression{Name: "secret"}, &Expression{Value: "x"})), nil
+		}
+		return nil, fmt.Errorf("segment %q not found", name)
+	}
+	v.validateRoot(cond(OperatorOr, &Expression{Segment: "emea"}, &Expression{Segment: "apac"}), "where", false)
+	v.validateRoot(&Expression{Segment: "emea"}, "having", true)
+	require.Len(t, v.errs, 3)
+	require.Equal(t, "cond.exprs[0].segment.cond.exprs[1].cond.exprs[0]", v.errs[0].Path)
+	require.Equal(t, ExpressionErrorCodeUnknownName, v.errs[0].Code)
+	require.Equal(t, &ExpressionError{Clause: "where", Path: "cond.exprs[1].segment", Name: "apac", Code: ExpressionErrorCodeUnknownName, Message: `segment "apac" not found`}, v.errs[1])
+	require.Equal(t, "having", v.errs[2].Clause)
+	require.Equal(t, ExpressionErrorCodeInvalid, v.errs[2].Code)
+}
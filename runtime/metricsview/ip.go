@@ -0,0 +1,55 @@
+package metricsview
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// CIDRBounds returns the first and last IP address in a CIDR block, such as "10.0.0.0/8".
+// A single IP address is treated as a block that contains only that address.
+func CIDRBounds(cidr string) (string, string, error) {
+	if addr, err := netip.ParseAddr(cidr); err == nil {
+		return addr.String(), addr.String(), nil
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid CIDR %q", cidr)
+	}
+	prefix = prefix.Masked()
+
+	first := prefix.Addr()
+	last := first.AsSlice()
+	for i := prefix.Bits(); i < len(last)*8; i++ {
+		last[i/8] |= 1 << (7 - i%8)
+	}
+	lastAddr, _ := netip.AddrFromSlice(last)
+
+	return first.String(), lastAddr.String(), nil
+}
+
+// IPRangeBounds validates the bounds of an IP_BETWEEN condition, which must be a list of two IP addresses of the same family.
+func IPRangeBounds(v any) (string, string, error) {
+	vals, ok := v.([]any)
+	if !ok || len(vals) != 2 {
+		return "", "", fmt.Errorf("the right value must be a list of two IP addresses for an %q condition", OperatorIPBetween)
+	}
+
+	var bounds [2]netip.Addr
+	for i, v := range vals {
+		s, ok := v.(string)
+		if !ok {
+			return "", "", fmt.Errorf("invalid IP address %v", v)
+		}
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid IP address %q", s)
+		}
+		bounds[i] = addr
+	}
+	if bounds[0].Is4() != bounds[1].Is4() {
+		return "", "", fmt.Errorf("the IP addresses %q and %q are not of the same family", bounds[0], bounds[1])
+	}
+
+	return bounds[0].String(), bounds[1].String(), nil
+}
@@ -0,0 +1,84 @@
+package metricsview
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCIDRBounds(t *testing.T) {
+	cases := []struct {
+		cidr  string
+		start string
+		end   string
+	}{
+		{"10.0.0.0/8", "10.0.0.0", "10.255.255.255"},
+		{"192.168.1.77/26", "192.168.1.64", "192.168.1.127"},
+		{"192.168.1.1", "192.168.1.1", "192.168.1.1"},
+		{"0.0.0.0/0", "0.0.0.0", "255.255.255.255"},
+		{"2001:db8::/32", "2001:db8::", "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff"},
+	}
+	for _, tc := range cases {
+		start, end, err := CIDRBounds(tc.cidr)
+		require.NoError(t, err, tc.cidr)
+		require.Equal(t, tc.start, start, tc.cidr)
+		require.Equal(t, tc.end, end, tc.cidr)
+	}
+
+	_, _, err := CIDRBounds("10.0.0.0/33")
+	require.Error(t, err)
+}
+
+func TestIPRangeBounds(t *testing.T) {
+	start, end, err := IPRangeBounds([]any{"10.0.0.1", "10.0.0.9"})
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1", start)
+	require.Equal(t, "10.0.0.9", end)
+
+	_, _, err = IPRangeBounds([]any{"10.0.0.1"})
+	require.Error(t, err)
+	_, _, err = IPRangeBounds([]any{"10.0.0.1", "::1"})
+	require.ErrorContains(t, err, "same family")
+	_, _, err = IPRangeBounds([]any{"10.0.0.1", "foo"})
+	require.ErrorContains(t, err, "invalid IP address")
+}
+
+func TestIPConditionSQL(t *testing.T) {
+	ast := &AST{
+		dialect:   drivers.DialectDuckDB,
+		dimFields: []FieldNode{{Name: "ip", Expr: `"client_ip"`}},
+	}
+
+	sql, args, err := ast.sqlForExpression(&Expression{Condition: &Condition{
+		Operator:    OperatorIPInCIDR,
+		Expressions: []*Expression{{Name: "ip"}, {Value: "10.0.0.0/8"}},
+	}}, nil, false, false)
+	require.NoError(t, err)
+	require.Equal(t, `(CAST("client_ip" AS INET) BETWEEN CAST(? AS INET) AND CAST(? AS INET))`, sql)
+	require.Equal(t, []any{"10.0.0.0", "10.255.255.255"}, args)
+
+	sql, args, err = ast.sqlForExpression(&Expression{Condition: &Condition{
+		Operator:    OperatorIPNinCIDR,
+		Expressions: []*Expression{{Name: "ip"}, {Value: "192.168.0.0/16"}},
+	}}, nil, false, false)
+	require.NoError(t, err)
+	require.Equal(t, `(NOT (CAST("client_ip" AS INET) BETWEEN CAST(? AS INET) AND CAST(? AS INET)) OR ("client_ip") IS NULL)`, sql)
+	require.Equal(t, []any{"192.168.0.0", "192.168.255.255"}, args)
+
+	// The bounds can be passed as separate expressions
+	sql, args, err = ast.sqlForExpression(&Expression{Condition: &Condition{
+		Operator:    OperatorIPBetween,
+		Expressions: []*Expression{{Name: "ip"}, {Value: "10.0.0.1"}, {Value: "10.0.0.9"}},
+	}}, nil, false, false)
+	require.NoError(t, err)
+	require.Equal(t, `(CAST("client_ip" AS INET) BETWEEN CAST(? AS INET) AND CAST(? AS INET))`, sql)
+	require.Equal(t, []any{"10.0.0.1", "10.0.0.9"}, args)
+
+	ast.dialect = drivers.DialectDruid
+	_, _, err = ast.sqlForExpression(&Expression{Condition: &Condition{
+		Operator:    OperatorIPInCIDR,
+		Expressions: []*Expression{{Name: "ip"}, {Value: "10.0.0.0/8"}},
+	}}, nil, false, false)
+	require.ErrorContains(t, err, "not supported for druid")
+}
@@ -9,20 +9,27 @@ import (
 )
 
 type Query struct {
-	MetricsView         string      `mapstructure:"metrics_view"`
-	Dimensions          []Dimension `mapstructure:"dimensions"`
-	Measures            []Measure   `mapstructure:"measures"`
-	PivotOn             []string    `mapstructure:"pivot_on"`
-	Spine               *Spine      `mapstructure:"spine"`
-	Sort                []Sort      `mapstructure:"sort"`
-	TimeRange           *TimeRange  `mapstructure:"time_range"`
-	ComparisonTimeRange *TimeRange  `mapstructure:"comparison_time_range"`
-	Where               *Expression `mapstructure:"where"`
-	Having              *Expression `mapstructure:"having"`
-	Limit               *int64      `mapstructure:"limit"`
-	Offset              *int64      `mapstructure:"offset"`
-	TimeZone            string      `mapstructure:"time_zone"`
-	Label               bool        `mapstructure:"label"`
+	MetricsView         string        `mapstructure:"metrics_view"`
+	Dimensions          []Dimension   `mapstructure:"dimensions"`
+	Measures            []Measure     `mapstructure:"measures"`
+	PivotOn             []string      `mapstructure:"pivot_on"`
+	PivotColumns        *PivotColumns `mapstructure:"pivot_columns"`
+	Spine               *Spine        `mapstructure:"spine"`
+	Sort                []Sort        `mapstructure:"sort"`
+	TimeRange           *TimeRange    `mapstructure:"time_range"`
+	ComparisonTimeRange *TimeRange    `mapstructure:"comparison_time_range"`
+	Where               *Expression   `mapstructure:"where"`
+	Having              *Expression   `mapstructure:"having"`
+	Limit               *int64        `mapstructure:"limit"`
+	Offset              *int64        `mapstructure:"offset"`
+	TimeZone            string        `mapstructure:"time_zone"`
+	Label               bool          `mapstructure:"label"`
+	// Locale is a BCP 47 language tag that controls locale-sensitive formatting in exports,
+	// namely the decimal separator of CSV files and month/day names in the headers of pivoted time dimensions.
+	Locale string `mapstructure:"locale"`
+	// ExecutionTimeoutSeconds overrides the default timeout for executing the query.
+	// It is capped by the instance's max execution timeout (see drivers.InstanceConfig).
+	ExecutionTimeoutSeconds uint32 `mapstructure:"execution_timeout_seconds"`
 }
 
 type Dimension struct {
@@ -47,9 +54,13 @@ type Measure struct {
 type MeasureCompute struct {
 	Count           bool                           `mapstructure:"count"`
 	CountDistinct   *MeasureComputeCountDistinct   `mapstructure:"count_distinct"`
+	Aggregate       *MeasureComputeAggregate       `mapstructure:"aggregate"`
+	ArgMax          *MeasureComputeArg             `mapstructure:"arg_max"`
+	ArgMin          *MeasureComputeArg             `mapstructure:"arg_min"`
 	ComparisonValue *MeasureComputeComparisonValue `mapstructure:"comparison_value"`
 	ComparisonDelta *MeasureComputeComparisonDelta `mapstructure:"comparison_delta"`
 	ComparisonRatio *MeasureComputeComparisonRatio `mapstructure:"comparison_ratio"`
+	Derived         *MeasureComputeDerived         `mapstructure:"derived"`
 }
 
 func (m *MeasureCompute) Validate() error {
@@ -60,6 +71,15 @@ func (m *MeasureCompute) Validate() error {
 	if m.CountDistinct != nil {
 		n++
 	}
+	if m.Aggregate != nil {
+		n++
+	}
+	if m.ArgMax != nil {
+		n++
+	}
+	if m.ArgMin != nil {
+		n++
+	}
 	if m.ComparisonValue != nil {
 		n++
 	}
@@ -69,6 +89,9 @@ func (m *MeasureCompute) Validate() error {
 	if m.ComparisonRatio != nil {
 		n++
 	}
+	if m.Derived != nil {
+		n++
+	}
 	if n == 0 {
 		return fmt.Errorf(`must specify a compute operation`)
 	}
@@ -82,6 +105,36 @@ type MeasureComputeCountDistinct struct {
 	Dimension string `mapstructure:"dimension"`
 }
 
+// MeasureComputeAggregate applies a simple aggregation function to a dimension.
+type MeasureComputeAggregate struct {
+	Function  AggregateFunction `mapstructure:"function"`
+	Dimension string            `mapstructure:"dimension"`
+}
+
+// MeasureComputeArg selects the value of a dimension in the row where another dimension is largest (for ArgMax) or smallest (for ArgMin).
+// When By is the time dimension, it computes the last or first value of the dimension.
+type MeasureComputeArg struct {
+	Dimension string `mapstructure:"dimension"`
+	By        string `mapstructure:"by"`
+}
+
+type AggregateFunction string
+
+const (
+	AggregateFunctionMin AggregateFunction = "min"
+	AggregateFunctionMax AggregateFunction = "max"
+	AggregateFunctionSum AggregateFunction = "sum"
+	AggregateFunctionAvg AggregateFunction = "avg"
+)
+
+func (f AggregateFunction) Valid() bool {
+	switch f {
+	case AggregateFunctionMin, AggregateFunctionMax, AggregateFunctionSum, AggregateFunctionAvg:
+		return true
+	}
+	return false
+}
+
 type MeasureComputeComparisonValue struct {
 	Measure string `mapstructure:"measure"`
 }
@@ -94,6 +147,59 @@ type MeasureComputeComparisonRatio struct {
 	Measure string `mapstructure:"measure"`
 }
 
+// MeasureComputeDerived computes an arithmetic expression over the metrics view's measures, such as "revenue / impressions".
+// The expression supports measure names, numeric literals, the +, -, * and / operators, and parentheses.
+type MeasureComputeDerived struct {
+	Expression string `mapstructure:"expression"`
+}
+
+// PivotColumns configures the order and number of the columns produced by a pivot.
+// The options apply to each dimension in PivotOn separately.
+type PivotColumns struct {
+	// Sort orders the pivoted values. If not set, the columns follow the engine's order, unless Limit is set, in which case it defaults to descending by measure total.
+	Sort PivotColumnSort `mapstructure:"sort"`
+	// Measure is the measure to sort by when Sort is PivotColumnSortMeasure. Defaults to the first measure in the query.
+	Measure string `mapstructure:"measure"`
+	Desc    bool   `mapstructure:"desc"`
+	// Limit caps the number of pivoted values. The remaining values are rolled up into a single column named OtherLabel.
+	Limit *int64 `mapstructure:"limit"`
+	// OtherLabel is the value of the rollup column. Defaults to "Other".
+	OtherLabel string `mapstructure:"other_label"`
+}
+
+// PivotColumnSort is a strategy for ordering pivoted columns.
+type PivotColumnSort string
+
+const (
+	PivotColumnSortUnspecified  PivotColumnSort = ""
+	PivotColumnSortValue        PivotColumnSort = "value"
+	PivotColumnSortAlphabetical PivotColumnSort = "alphabetical"
+	PivotColumnSortMeasure      PivotColumnSort = "measure"
+)
+
+func (s PivotColumnSort) Valid() bool {
+	switch s {
+	case PivotColumnSortUnspecified, PivotColumnSortValue, PivotColumnSortAlphabetical, PivotColumnSortMeasure:
+		return true
+	}
+	return false
+}
+
+func PivotColumnSortFromProto(s runtimev1.PivotColumnSort) (PivotColumnSort, error) {
+	switch s {
+	case runtimev1.PivotColumnSort_PIVOT_COLUMN_SORT_UNSPECIFIED:
+		return PivotColumnSortUnspecified, nil
+	case runtimev1.PivotColumnSort_PIVOT_COLUMN_SORT_VALUE:
+		return PivotColumnSortValue, nil
+	case runtimev1.PivotColumnSort_PIVOT_COLUMN_SORT_ALPHABETICAL:
+		return PivotColumnSortAlphabetical, nil
+	case runtimev1.PivotColumnSort_PIVOT_COLUMN_SORT_MEASURE:
+		return PivotColumnSortMeasure, nil
+	default:
+		return "", fmt.Errorf("invalid pivot column sort %q", s)
+	}
+}
+
 type Spine struct {
 	Where     *WhereSpine `mapstructure:"where"`
 	TimeRange *TimeSpine  `mapstructure:"time"`
@@ -120,10 +226,13 @@ type TimeRange struct {
 	IsoDuration  string    `mapstructure:"iso_duration"`
 	IsoOffset    string    `mapstructure:"iso_offset"`
 	RoundToGrain TimeGrain `mapstructure:"round_to_grain"`
+	// Expression is a shorthand for a relative time range, like "P7D", "rill-WTD" or "last 4 quarters" (see ParseTimeRangeExpression).
+	// It can't be combined with the other fields.
+	Expression string `mapstructure:"expression"`
 }
 
 func (tr *TimeRange) IsZero() bool {
-	return tr.Start.IsZero() && tr.End.IsZero() && tr.IsoDuration == "" && tr.IsoOffset == "" && tr.RoundToGrain == TimeGrainUnspecified
+	return tr.Start.IsZero() && tr.End.IsZero() && tr.IsoDuration == "" && tr.IsoOffset == "" && tr.RoundToGrain == TimeGrainUnspecified && tr.Expression == ""
 }
 
 type Expression struct {
@@ -131,11 +240,15 @@ type Expression struct {
 	Value     any        `mapstructure:"val"`
 	Condition *Condition `mapstructure:"cond"`
 	Subquery  *Subquery  `mapstructure:"subquery"`
+	// Segment references a segment resource by name. The executor replaces it with the segment's filter before building the query.
+	Segment string `mapstructure:"segment"`
 }
 
 type Condition struct {
 	Operator    Operator      `mapstructure:"op"`
 	Expressions []*Expression `mapstructure:"exprs"`
+	// AccentInsensitive makes ilike and nilike conditions ignore accents in addition to case.
+	AccentInsensitive bool `mapstructure:"accent_insensitive"`
 }
 
 type Subquery struct {
@@ -143,6 +256,10 @@ type Subquery struct {
 	Measures  []Measure   `mapstructure:"measures"`
 	Where     *Expression `mapstructure:"where"`
 	Having    *Expression `mapstructure:"having"`
+	// Sort and Limit restrict the subquery to the leading dimension values, ie "top 10 by revenue".
+	// Sorting by a measure not in Measures implicitly adds it to the subquery.
+	Sort  []Sort `mapstructure:"sort"`
+	Limit *int64 `mapstructure:"limit"`
 }
 
 type Operator string
@@ -161,11 +278,16 @@ const (
 	OperatorNilike      Operator = "nilike"
 	OperatorOr          Operator = "or"
 	OperatorAnd         Operator = "and"
+	OperatorIPInCIDR    Operator = "ip_in_cidr"
+	OperatorIPNinCIDR   Operator = "ip_nin_cidr"
+	OperatorIPBetween   Operator = "ip_between"
+	OperatorRegex       Operator = "regex"
 )
 
 func (o Operator) Valid() bool {
 	switch o {
-	case OperatorEq, OperatorNeq, OperatorLt, OperatorLte, OperatorGt, OperatorGte, OperatorIn, OperatorNin, OperatorIlike, OperatorNilike, OperatorOr, OperatorAnd:
+	case OperatorEq, OperatorNeq, OperatorLt, OperatorLte, OperatorGt, OperatorGte, OperatorIn, OperatorNin, OperatorIlike, OperatorNilike, OperatorOr, OperatorAnd,
+		OperatorIPInCIDR, OperatorIPNinCIDR, OperatorIPBetween, OperatorRegex:
 		return true
 	}
 	return false
@@ -37,6 +37,9 @@ func (b exprStrBuilder) writeExpression(e *Expression) error {
 	if e.Condition != nil {
 		return b.writeCondition(e.Condition)
 	}
+	if e.Segment != "" {
+		return b.writeSegment(e.Segment)
+	}
 	return errors.New("invalid expression")
 }
 
@@ -66,6 +69,15 @@ func (b exprStrBuilder) writeSubquery(_ *Subquery) error {
 	return err
 }
 
+func (b exprStrBuilder) writeSegment(name string) error {
+	b.writeString("SEGMENT(")
+	if err := b.writeName(name); err != nil {
+		return err
+	}
+	b.writeString(")")
+	return nil
+}
+
 func (b exprStrBuilder) writeCondition(cond *Condition) error {
 	switch cond.Operator {
 	case OperatorOr:
@@ -119,6 +131,11 @@ func (b exprStrBuilder) writeBinaryCondition(exprs []*Expression, op Operator) e
 		}
 	}
 
+	// The bounds of IP_BETWEEN may be passed as separate expressions.
+	if op == OperatorIPBetween && len(exprs) == 3 && exprs[1] != nil && exprs[2] != nil {
+		exprs = []*Expression{exprs[0], {Value: []any{exprs[1].Value, exprs[2].Value}}}
+	}
+
 	if len(exprs) != 2 {
 		return fmt.Errorf("binary condition must have exactly 2 expressions")
 	}
@@ -159,6 +176,14 @@ func (b exprStrBuilder) writeBinaryCondition(exprs []*Expression, op Operator) e
 		b.writeString(" ILIKE ")
 	case OperatorNilike:
 		b.writeString(" NOT ILIKE ")
+	case OperatorIPInCIDR:
+		b.writeString(" IN CIDR ")
+	case OperatorIPNinCIDR:
+		b.writeString(" NOT IN CIDR ")
+	case OperatorIPBetween:
+		b.writeString(" IP BETWEEN ")
+	case OperatorRegex:
+		b.writeString(" REGEXP ")
 	default:
 		return fmt.Errorf("invalid binary condition operator %q", op)
 	}
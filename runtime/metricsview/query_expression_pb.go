@@ -43,6 +43,14 @@ func NewExpressionFromProto(expr *runtimev1.Expression) *Expression {
 			op = OperatorIlike
 		case runtimev1.Operation_OPERATION_NLIKE:
 			op = OperatorNilike
+		case runtimev1.Operation_OPERATION_IP_IN_CIDR:
+			op = OperatorIPInCIDR
+		case runtimev1.Operation_OPERATION_IP_NIN_CIDR:
+			op = OperatorIPNinCIDR
+		case runtimev1.Operation_OPERATION_IP_BETWEEN:
+			op = OperatorIPBetween
+		case runtimev1.Operation_OPERATION_REGEX:
+			op = OperatorRegex
 		}
 
 		exprs := make([]*Expression, 0, len(e.Cond.Exprs))
@@ -51,8 +59,9 @@ func NewExpressionFromProto(expr *runtimev1.Expression) *Expression {
 		}
 
 		res.Condition = &Condition{
-			Operator:    op,
-			Expressions: exprs,
+			Operator:          op,
+			Expressions:       exprs,
+			AccentInsensitive: e.Cond.AccentInsensitive,
 		}
 	case *runtimev1.Expression_Subquery:
 		measures := make([]Measure, 0, len(e.Subquery.Measures))
@@ -66,6 +75,15 @@ func NewExpressionFromProto(expr *runtimev1.Expression) *Expression {
 			Where:     NewExpressionFromProto(e.Subquery.Where),
 			Having:    NewExpressionFromProto(e.Subquery.Having),
 		}
+		if e.Subquery.Sort != "" {
+			res.Subquery.Sort = []Sort{{Name: e.Subquery.Sort, Desc: e.Subquery.SortDesc}}
+		}
+		if e.Subquery.Limit != 0 {
+			limit := e.Subquery.Limit
+			res.Subquery.Limit = &limit
+		}
+	case *runtimev1.Expression_Segment:
+		res.Segment = e.Segment
 	}
 
 	return res
@@ -0,0 +1,94 @@
+package metricsview
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rilldata/rill/runtime/pkg/duration"
+)
+
+// lastNGrainsPattern matches time range expressions like "last 4 quarters" or "last week".
+var lastNGrainsPattern = regexp.MustCompile(`^last\s+(?:(\d+)\s+)?([a-z]+?)s?$`)
+
+// previousPeriodGrains maps the Rill extensions for previous complete periods (see duration.ParseISO8601) to time grains.
+var previousPeriodGrains = map[string]TimeGrain{
+	"rill-PD": TimeGrainDay,
+	"rill-PW": TimeGrainWeek,
+	"rill-PM": TimeGrainMonth,
+	"rill-PQ": TimeGrainQuarter,
+	"rill-PY": TimeGrainYear,
+}
+
+// ParseTimeRangeExpression parses a shorthand time range expression into the relative fields of a TimeRange (IsoDuration, IsoOffset and RoundToGrain).
+// Relative time ranges are resolved against the metrics view's watermark, so the caller doesn't have to compute timestamps.
+// The supported formats are:
+//   - An ISO 8601 duration, e.g. "P7D" for the 7 days up to the watermark.
+//   - A Rill period-to-date extension, e.g. "rill-WTD" for the current week up to the watermark.
+//   - A Rill previous period extension, e.g. "rill-PM" for the previous complete month.
+//   - "last <n> <grain>s", e.g. "last 4 quarters" for the 4 complete quarters before the watermark's quarter. The count defaults to 1.
+func ParseTimeRangeExpression(expr string) (*TimeRange, error) {
+	s := strings.TrimSpace(expr)
+	if s == "" {
+		return nil, fmt.Errorf("empty time range expression")
+	}
+
+	if g, ok := previousPeriodGrains[s]; ok {
+		d, err := grainDuration(g, 1)
+		if err != nil {
+			return nil, err
+		}
+		return &TimeRange{IsoDuration: d, RoundToGrain: g}, nil
+	}
+
+	if m := lastNGrainsPattern.FindStringSubmatch(strings.ToLower(s)); m != nil {
+		n := 1
+		if m[1] != "" {
+			var err error
+			n, err = strconv.Atoi(m[1])
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid count in time range expression %q", expr)
+			}
+		}
+		g := TimeGrain(m[2])
+		if !g.Valid() || g == TimeGrainUnspecified || g == TimeGrainMillisecond {
+			return nil, fmt.Errorf("invalid time grain %q in time range expression %q", m[2], expr)
+		}
+		d, err := grainDuration(g, n)
+		if err != nil {
+			return nil, err
+		}
+		return &TimeRange{IsoDuration: d, RoundToGrain: g}, nil
+	}
+
+	// Fall back to an ISO 8601 duration or a Rill extension that is directly supported as a duration
+	if _, err := duration.ParseISO8601(s); err != nil {
+		return nil, fmt.Errorf("invalid time range expression %q: must be an ISO 8601 duration, a rill-* period or \"last <n> <grain>s\"", expr)
+	}
+	return &TimeRange{IsoDuration: s}, nil
+}
+
+// grainDuration returns an ISO 8601 duration of n times the grain.
+func grainDuration(g TimeGrain, n int) (string, error) {
+	switch g {
+	case TimeGrainSecond:
+		return fmt.Sprintf("PT%dS", n), nil
+	case TimeGrainMinute:
+		return fmt.Sprintf("PT%dM", n), nil
+	case TimeGrainHour:
+		return fmt.Sprintf("PT%dH", n), nil
+	case TimeGrainDay:
+		return fmt.Sprintf("P%dD", n), nil
+	case TimeGrainWeek:
+		return fmt.Sprintf("P%dW", n), nil
+	case TimeGrainMonth:
+		return fmt.Sprintf("P%dM", n), nil
+	case TimeGrainQuarter:
+		return fmt.Sprintf("P%dM", 3*n), nil
+	case TimeGrainYear:
+		return fmt.Sprintf("P%dY", n), nil
+	default:
+		return "", fmt.Errorf("unsupported time grain %q", g)
+	}
+}
@@ -0,0 +1,39 @@
+package metricsview
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeRangeExpression(t *testing.T) {
+	tests := []struct {
+		expr    string
+		want    *TimeRange
+		wantErr bool
+	}{
+		{expr: "P7D", want: &TimeRange{IsoDuration: "P7D"}},
+		{expr: "PT6H", want: &TimeRange{IsoDuration: "PT6H"}},
+		{expr: "rill-WTD", want: &TimeRange{IsoDuration: "rill-WTD"}},
+		{expr: "rill-PM", want: &TimeRange{IsoDuration: "P1M", RoundToGrain: TimeGrainMonth}},
+		{expr: "last 4 quarters", want: &TimeRange{IsoDuration: "P12M", RoundToGrain: TimeGrainQuarter}},
+		{expr: "Last 2 Weeks", want: &TimeRange{IsoDuration: "P2W", RoundToGrain: TimeGrainWeek}},
+		{expr: "last day", want: &TimeRange{IsoDuration: "P1D", RoundToGrain: TimeGrainDay}},
+		{expr: "last 3 hours", want: &TimeRange{IsoDuration: "PT3H", RoundToGrain: TimeGrainHour}},
+		{expr: "last 0 days", wantErr: true},
+		{expr: "last 2 fortnights", wantErr: true},
+		{expr: "yesterday", wantErr: true},
+		{expr: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := ParseTimeRangeExpression(tt.expr)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
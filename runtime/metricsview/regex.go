@@ -0,0 +1,57 @@
+package metricsview
+
+import (
+	"fmt"
+	"regexp/syntax"
+)
+
+const (
+	// maxRegexLength is the maximum length of a regular expression pattern in a filter.
+	maxRegexLength = 1000
+	// maxRegexInstructions is the maximum size of the compiled program for a regular expression in a filter.
+	// It bounds patterns that are short but expand to large automatons, such as nested counted repetitions.
+	maxRegexInstructions = 10000
+)
+
+// ValidateRegex checks that a regular expression used in a filter is valid RE2 syntax and cheap enough to evaluate.
+// Besides limiting its size, it rejects nested unbounded repetitions (such as "(a+)+"),
+// which cause catastrophic backtracking in OLAP engines that don't use a linear-time regex engine (such as Druid).
+func ValidateRegex(pattern string) error {
+	if len(pattern) > maxRegexLength {
+		return fmt.Errorf("regular expression is too long (max %d characters)", maxRegexLength)
+	}
+
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return fmt.Errorf("invalid regular expression %q: %w", pattern, err)
+	}
+
+	if hasNestedRepetition(re, false) {
+		return fmt.Errorf("regular expression %q is too complex: nested repetitions are not allowed", pattern)
+	}
+
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return fmt.Errorf("invalid regular expression %q: %w", pattern, err)
+	}
+	if len(prog.Inst) > maxRegexInstructions {
+		return fmt.Errorf("regular expression %q is too complex", pattern)
+	}
+
+	return nil
+}
+
+// hasNestedRepetition returns true if re contains an unbounded repetition inside another repetition that can match more than once.
+func hasNestedRepetition(re *syntax.Regexp, inRepeat bool) bool {
+	unbounded := re.Op == syntax.OpStar || re.Op == syntax.OpPlus || (re.Op == syntax.OpRepeat && re.Max == -1)
+	repeat := unbounded || (re.Op == syntax.OpRepeat && re.Max > 1)
+	if unbounded && inRepeat {
+		return true
+	}
+	for _, sub := range re.Sub {
+		if hasNestedRepetition(sub, inRepeat || repeat) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package metricsview
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRegex(t *testing.T) {
+	require.NoError(t, ValidateRegex(`^foo.*bar$`))
+	require.NoError(t, ValidateRegex(`(?i)(google|bing)\.com`))
+	require.NoError(t, ValidateRegex(`(ab?c)+`))
+
+	require.ErrorContains(t, ValidateRegex(`(foo`), "invalid regular expression")
+	require.ErrorContains(t, ValidateRegex(`(a+)+$`), "nested repetitions")
+	require.ErrorContains(t, ValidateRegex(`(x*y){2,}`), "nested repetitions")
+	require.ErrorContains(t, ValidateRegex(strings.Repeat("a", maxRegexLength+1)), "too long")
+	require.ErrorContains(t, ValidateRegex(strings.Repeat(`[a-z]{1000}`, 11)), "too complex")
+}
+
+func TestRegexConditionSQL(t *testing.T) {
+	ast := &AST{
+		dialect:   drivers.DialectDuckDB,
+		dimFields: []FieldNode{{Name: "domain", Expr: `"domain"`}},
+	}
+	cond := &Expression{Condition: &Condition{
+		Operator:    OperatorRegex,
+		Expressions: []*Expression{{Name: "domain"}, {Value: `\.com$`}},
+	}}
+
+	sql, args, err := ast.sqlForExpression(cond, nil, false, false)
+	require.NoError(t, err)
+	require.Equal(t, `regexp_matches("domain", ?)`, sql)
+	require.Equal(t, []any{`\.com$`}, args)
+
+	ast.dialect = drivers.DialectDruid
+	sql, _, err = ast.sqlForExpression(cond, nil, false, false)
+	require.NoError(t, err)
+	require.Equal(t, `REGEXP_LIKE("domain", ?)`, sql)
+
+	_, _, err = ast.sqlForExpression(&Expression{Condition: &Condition{
+		Operator:    OperatorRegex,
+		Expressions: []*Expression{{Name: "domain"}, {Value: `(.*)*`}},
+	}}, nil, false, false)
+	require.ErrorContains(t, err, "too complex")
+
+	_, _, err = ast.sqlForExpression(&Expression{Condition: &Condition{
+		Operator:    OperatorRegex,
+		Expressions: []*Expression{{Name: "domain"}, {Name: "domain"}},
+	}}, nil, false, false)
+	require.ErrorContains(t, err, "must be a string value")
+}
@@ -0,0 +1,89 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ErrMalformed is used when attempting to parse an invalid API key string.
+var ErrMalformed = errors.New("malformed API key")
+
+// Prefix is prepended to every runtime API key.
+// It makes it cheap to tell API keys apart from JWTs in an authorization header.
+const Prefix = "rill_rtk_"
+
+// Key is a parsed runtime API key with a UUID ID and 24-byte secret.
+// Example string representation: rill_rtk_2Dws32dc2FxTThgCQjHerGM1rx9pJLCPQh5QbWjUiwpkZNkCCRrlrK.
+type Key struct {
+	ID     uuid.UUID
+	Secret [24]byte
+}
+
+// NewRandom generates a securely random API key.
+func NewRandom() *Key {
+	k := Key{ID: uuid.New()}
+	if _, err := rand.Read(k.Secret[:]); err != nil {
+		panic(err)
+	}
+	return &k
+}
+
+// IsAPIKey returns true if s looks like a runtime API key (it does not validate it).
+func IsAPIKey(s string) bool {
+	return strings.HasPrefix(s, Prefix)
+}
+
+// FromString re-creates a key from its string representation (acquired by calling String()).
+func FromString(s string) (*Key, error) {
+	if !IsAPIKey(s) {
+		return nil, ErrMalformed
+	}
+
+	var i big.Int
+	_, ok := i.SetString(s[len(Prefix):], 62)
+	if !ok || i.Sign() < 0 || i.BitLen() > 40*8 {
+		return nil, ErrMalformed
+	}
+
+	payload := make([]byte, 40)
+	i.FillBytes(payload)
+
+	var id [16]byte
+	copy(id[:], payload[0:16])
+
+	var secret [24]byte
+	copy(secret[:], payload[16:40])
+
+	return &Key{
+		ID:     uuid.UUID(id),
+		Secret: secret,
+	}, nil
+}
+
+// String canonically encodes the key as a string.
+func (k *Key) String() string {
+	payload := make([]byte, 40)
+	copy(payload[0:16], k.ID[:])
+	copy(payload[16:40], k.Secret[:])
+	var i big.Int
+	i.SetBytes(payload)
+	return fmt.Sprintf("%s%s", Prefix, i.Text(62))
+}
+
+// SecretHash returns a SHA256 hash of the key secret.
+func (k *Key) SecretHash() []byte {
+	hashed := sha256.Sum256(k.Secret[:])
+	return hashed[:]
+}
+
+// Verify checks the key's secret against a hash previously returned by SecretHash.
+func (k *Key) Verify(secretHash []byte) bool {
+	return subtle.ConstantTimeCompare(k.SecretHash(), secretHash) == 1
+}
@@ -0,0 +1,50 @@
+package apikey
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReciprocity(t *testing.T) {
+	k := NewRandom()
+	require.True(t, k.ID != uuid.UUID{})
+	require.True(t, k.Secret != [24]byte{})
+	require.Len(t, k.SecretHash(), 32)
+
+	str := k.String()
+	require.True(t, IsAPIKey(str))
+
+	parsed, err := FromString(str)
+	require.NoError(t, err)
+	require.Equal(t, k.ID, parsed.ID)
+	require.Equal(t, k.Secret, parsed.Secret)
+	require.True(t, parsed.Verify(k.SecretHash()))
+	require.False(t, NewRandom().Verify(k.SecretHash()))
+}
+
+func TestLeadingZeros(t *testing.T) {
+	k := &Key{}
+	k.Secret[23] = 1
+
+	parsed, err := FromString(k.String())
+	require.NoError(t, err)
+	require.Equal(t, k.ID, parsed.ID)
+	require.Equal(t, k.Secret, parsed.Secret)
+}
+
+func TestValidity(t *testing.T) {
+	invalid := []string{
+		"rill_usr_2Dws32dc2FxTThgCQjHerGM1rx9pJLCPQh5QbWjUiwpkZNkCCRrlrK",
+		"rill_rtk_2Dws32dc2FxTThgCQjHerGM1rx9pJLCPQh5QbWjUiwpkZNkCCRrlrKZZZZ",
+		"rill_rtk_not-base62",
+		"rill_rtk_",
+		"eyJhbGciOiJSUzI1NiJ9.e30.c2ln",
+		"",
+	}
+	for _, tt := range invalid {
+		_, err := FromString(tt)
+		require.Equal(t, ErrMalformed, err, tt)
+	}
+}
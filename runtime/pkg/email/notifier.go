@@ -0,0 +1,51 @@
+package email
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+)
+
+// AsNotifier returns a drivers.Notifier that sends emails to the recipients in the properties of an "email" notifier.
+func (c *Client) AsNotifier(properties map[string]any) drivers.Notifier {
+	return &notifier{
+		client:     c,
+		recipients: pbutil.ToSliceString(properties["recipients"]),
+	}
+}
+
+type notifier struct {
+	client     *Client
+	recipients []string
+}
+
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	for _, recipient := range n.recipients {
+		msg := *s
+		msg.ToEmail = recipient
+		err := n.client.SendAlertStatus(&msg)
+		if err != nil {
+			return fmt.Errorf("failed to send email to %q: %w", recipient, err)
+		}
+	}
+	return nil
+}
+
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	for _, recipient := range n.recipients {
+		err := n.client.SendScheduledReport(&ScheduledReport{
+			ToEmail:        recipient,
+			Title:          s.Title,
+			ReportTime:     s.ReportTime,
+			DownloadFormat: s.DownloadFormat,
+			OpenLink:       s.OpenLink,
+			DownloadLink:   s.DownloadLink,
+			EditLink:       s.EditLink,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to send email to %q: %w", recipient, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Package exportfilename implements templates for the filenames of exported files.
+// Templates are plain text with placeholders in curly braces, e.g. "{metrics_view}_{time_range}_{date}".
+package exportfilename
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Vars are the values available to the placeholders of a template.
+type Vars struct {
+	// MetricsView is the name of the exported metrics view (or table). Used for {metrics_view}.
+	MetricsView string
+	// TimeStart and TimeEnd are the bounds of the exported time range. Either may be zero. Used for {time_range}.
+	TimeStart time.Time
+	TimeEnd   time.Time
+	// TimeRangeISO is an ISO 8601 duration describing the time range when it doesn't have explicit bounds. Used for {time_range}.
+	TimeRangeISO string
+	// Now is the time of the export. Used for {date}.
+	Now time.Time
+	// User is the email of the user requesting the export. Used for {user}.
+	User string
+}
+
+// placeholders are the supported placeholders.
+var placeholders = map[string]bool{
+	"metrics_view": true,
+	"time_range":   true,
+	"date":         true,
+	"user":         true,
+}
+
+// dateLayout is the layout used to format dates.
+const dateLayout = "20060102"
+
+// Validate returns an error if tmpl is not a valid template.
+func Validate(tmpl string) error {
+	_, err := render(tmpl, func(string) string { return "" })
+	return err
+}
+
+// Render renders a template.
+// Characters that are unsafe in filenames are replaced with underscores, and the result may be empty.
+func Render(tmpl string, vars *Vars) (string, error) {
+	res, err := render(tmpl, func(placeholder string) string {
+		switch placeholder {
+		case "metrics_view":
+			return vars.MetricsView
+		case "time_range":
+			return formatTimeRange(vars)
+		case "date":
+			return vars.Now.UTC().Format(dateLayout)
+		case "user":
+			return vars.User
+		default:
+			return ""
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	return sanitize(res), nil
+}
+
+// render replaces the placeholders in tmpl with the values returned by fn.
+func render(tmpl string, fn func(placeholder string) string) (string, error) {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(tmpl, '{')
+		if start < 0 {
+			start = len(tmpl)
+		}
+		if strings.IndexByte(tmpl[:start], '}') >= 0 {
+			return "", fmt.Errorf("invalid filename template: unexpected '}'")
+		}
+		b.WriteString(tmpl[:start])
+		if start == len(tmpl) {
+			return b.String(), nil
+		}
+
+		end := strings.IndexByte(tmpl[start:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("invalid filename template: unclosed '{'")
+		}
+		end += start
+
+		placeholder := tmpl[start+1 : end]
+		if !placeholders[placeholder] {
+			return "", fmt.Errorf("invalid filename template: unknown placeholder %q", "{"+placeholder+"}")
+		}
+
+		b.WriteString(fn(placeholder))
+		tmpl = tmpl[end+1:]
+	}
+}
+
+// formatTimeRange formats the time range of an export.
+func formatTimeRange(vars *Vars) string {
+	start := !vars.TimeStart.IsZero()
+	end := !vars.TimeEnd.IsZero()
+	switch {
+	case start && end:
+		return vars.TimeStart.UTC().Format(dateLayout) + "-" + vars.TimeEnd.UTC().Format(dateLayout)
+	case start:
+		return "from_" + vars.TimeStart.UTC().Format(dateLayout)
+	case end:
+		return "until_" + vars.TimeEnd.UTC().Format(dateLayout)
+	case vars.TimeRangeISO != "":
+		return vars.TimeRangeISO
+	default:
+		return "all_time"
+	}
+}
+
+// sanitize replaces characters that are not safe to use in filenames or in a Content-Disposition header.
+func sanitize(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
+			return '_'
+		}
+		if r < 0x20 || r == 0x7f {
+			return '_'
+		}
+		return r
+	}, s)
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,45 @@
+package exportfilename
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	now := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		tmpl string
+		vars *Vars
+		want string
+	}{
+		{"report", &Vars{}, "report"},
+		{"{metrics_view}_{time_range}_{date}", &Vars{MetricsView: "ad_bids", TimeStart: start, TimeEnd: end, Now: now}, "ad_bids_20240101-20240201_20240315"},
+		{"{metrics_view}_{time_range}", &Vars{MetricsView: "ad_bids", TimeStart: start}, "ad_bids_from_20240101"},
+		{"{metrics_view}_{time_range}", &Vars{MetricsView: "ad_bids", TimeEnd: end}, "ad_bids_until_20240201"},
+		{"{metrics_view}_{time_range}", &Vars{MetricsView: "ad_bids", TimeRangeISO: "P7D"}, "ad_bids_P7D"},
+		{"{metrics_view}_{time_range}", &Vars{MetricsView: "ad_bids"}, "ad_bids_all_time"},
+		{"{user} export", &Vars{User: "jane@example.com"}, "jane@example.com export"},
+		{"{metrics_view}", &Vars{MetricsView: `a/b:"c"`}, "a_b__c_"},
+		{" {user} ", &Vars{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tmpl, func(t *testing.T) {
+			got, err := Render(tt.tmpl, tt.vars)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(""))
+	require.NoError(t, Validate("{metrics_view}_{time_range}_{date}_{user}"))
+	require.ErrorContains(t, Validate("{metrics_view"), "unclosed")
+	require.ErrorContains(t, Validate("metrics_view}"), "unexpected")
+	require.ErrorContains(t, Validate("{dashboard}"), `unknown placeholder "{dashboard}"`)
+}
@@ -25,6 +25,14 @@ func Value(val *structpb.Value) *runtimev1.Expression {
 	}
 }
 
+func Segment(name string) *runtimev1.Expression {
+	return &runtimev1.Expression{
+		Expression: &runtimev1.Expression_Segment{
+			Segment: name,
+		},
+	}
+}
+
 func String(str string) *runtimev1.Expression {
 	return &runtimev1.Expression{
 		Expression: &runtimev1.Expression_Val{
@@ -0,0 +1,612 @@
+package expressionpb
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The text format is a compact, SQL-like representation of an expression that is safe to embed in URLs (after percent-encoding).
+// For example:
+//
+//	country IN ('US', 'CA') AND (revenue > 100 OR domain NOT LIKE '%google%')
+//	publisher IN (SELECT publisher WHERE country = 'US' HAVING impressions > 1000)
+//	campaign IN (SELECT campaign ORDER BY revenue DESC LIMIT 10)
+//	SEGMENT(emea_enterprise) AND revenue > 100
+//
+// Identifiers are bare words or double-quoted (with "" as an escape), strings are single-quoted (with '' as an escape),
+// and keywords are case-insensitive. A subquery selects a dimension followed by zero or more measures,
+// and may be restricted to the leading dimension values with ORDER BY and LIMIT.
+// SEGMENT(name) references a segment resource; SEGMENT is not a keyword, so it can still be used as an identifier.
+
+var bareIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var keywords = map[string]bool{
+	"AND":    true,
+	"OR":     true,
+	"NOT":    true,
+	"IN":     true,
+	"LIKE":   true,
+	"SELECT": true,
+	"WHERE":  true,
+	"HAVING": true,
+	"ORDER":  true,
+	"BY":     true,
+	"ASC":    true,
+	"DESC":   true,
+	"LIMIT":  true,
+	"TRUE":   true,
+	"FALSE":  true,
+	"NULL":   true,
+}
+
+var binaryOperators = map[runtimev1.Operation]string{
+	runtimev1.Operation_OPERATION_EQ:    "=",
+	runtimev1.Operation_OPERATION_NEQ:   "!=",
+	runtimev1.Operation_OPERATION_LT:    "<",
+	runtimev1.Operation_OPERATION_LTE:   "<=",
+	runtimev1.Operation_OPERATION_GT:    ">",
+	runtimev1.Operation_OPERATION_GTE:   ">=",
+	runtimev1.Operation_OPERATION_LIKE:  "LIKE",
+	runtimev1.Operation_OPERATION_NLIKE: "NOT LIKE",
+}
+
+// Format serializes an expression to the text format. Use Parse to convert it back.
+func Format(e *runtimev1.Expression) (string, error) {
+	b := &strings.Builder{}
+	if err := writeExpression(b, e); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func writeExpression(b *strings.Builder, e *runtimev1.Expression) error {
+	if e == nil {
+		return fmt.Errorf("empty expression")
+	}
+
+	switch v := e.Expression.(type) {
+	case *runtimev1.Expression_Ident:
+		b.WriteString(formatIdentifier(v.Ident))
+		return nil
+	case *runtimev1.Expression_Val:
+		return writeValue(b, v.Val)
+	case *runtimev1.Expression_Cond:
+		return writeCondition(b, v.Cond)
+	case *runtimev1.Expression_Subquery:
+		return writeSubquery(b, v.Subquery)
+	case *runtimev1.Expression_Segment:
+		b.WriteString("SEGMENT(")
+		b.WriteString(formatIdentifier(v.Segment))
+		b.WriteString(")")
+		return nil
+	default:
+		return fmt.Errorf("empty expression")
+	}
+}
+
+func writeCondition(b *strings.Builder, c *runtimev1.Condition) error {
+	switch c.Op {
+	case runtimev1.Operation_OPERATION_AND, runtimev1.Operation_OPERATION_OR:
+		if len(c.Exprs) == 0 {
+			return fmt.Errorf("condition %s has no operands", c.Op)
+		}
+		sep := " AND "
+		if c.Op == runtimev1.Operation_OPERATION_OR {
+			sep = " OR "
+		}
+		for i, e := range c.Exprs {
+			if i > 0 {
+				b.WriteString(sep)
+			}
+			if err := writeOperand(b, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case runtimev1.Operation_OPERATION_IN, runtimev1.Operation_OPERATION_NIN:
+		if len(c.Exprs) == 0 {
+			return fmt.Errorf("condition %s has no operands", c.Op)
+		}
+		if err := writeOperand(b, c.Exprs[0]); err != nil {
+			return err
+		}
+		if c.Op == runtimev1.Operation_OPERATION_IN {
+			b.WriteString(" IN (")
+		} else {
+			b.WriteString(" NOT IN (")
+		}
+		for i, e := range c.Exprs[1:] {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			if err := writeOperand(b, e); err != nil {
+				return err
+			}
+		}
+		b.WriteString(")")
+		return nil
+	}
+
+	op, ok := binaryOperators[c.Op]
+	if !ok {
+		return fmt.Errorf("unsupported operation %s", c.Op)
+	}
+	if len(c.Exprs) != 2 {
+		return fmt.Errorf("operation %s expects 2 operands, got %d", c.Op, len(c.Exprs))
+	}
+	if err := writeOperand(b, c.Exprs[0]); err != nil {
+		return err
+	}
+	b.WriteString(" ")
+	b.WriteString(op)
+	b.WriteString(" ")
+	return writeOperand(b, c.Exprs[1])
+}
+
+// writeOperand writes an operand of a condition, wrapping nested conditions in parentheses.
+func writeOperand(b *strings.Builder, e *runtimev1.Expression) error {
+	if e.GetCond() == nil {
+		return writeExpression(b, e)
+	}
+	b.WriteString("(")
+	if err := writeExpression(b, e); err != nil {
+		return err
+	}
+	b.WriteString(")")
+	return nil
+}
+
+func writeSubquery(b *strings.Builder, s *runtimev1.Subquery) error {
+	if s.Dimension == "" {
+		return fmt.Errorf("subquery must have a dimension")
+	}
+	b.WriteString("SELECT ")
+	b.WriteString(formatIdentifier(s.Dimension))
+	for _, m := range s.Measures {
+		b.WriteString(", ")
+		b.WriteString(formatIdentifier(m))
+	}
+	if s.Where != nil {
+		b.WriteString(" WHERE ")
+		if err := writeExpression(b, s.Where); err != nil {
+			return err
+		}
+	}
+	if s.Having != nil {
+		b.WriteString(" HAVING ")
+		if err := writeExpression(b, s.Having); err != nil {
+			return err
+		}
+	}
+	if s.Sort != "" {
+		b.WriteString(" ORDER BY ")
+		b.WriteString(formatIdentifier(s.Sort))
+		if s.SortDesc {
+			b.WriteString(" DESC")
+		}
+	}
+	if s.Limit != 0 {
+		b.WriteString(" LIMIT ")
+		b.WriteString(strconv.FormatInt(s.Limit, 10))
+	}
+	return nil
+}
+
+func writeValue(b *strings.Builder, v *structpb.Value) error {
+	switch v := v.GetKind().(type) {
+	case nil, *structpb.Value_NullValue:
+		b.WriteString("NULL")
+	case *structpb.Value_BoolValue:
+		if v.BoolValue {
+			b.WriteString("TRUE")
+		} else {
+			b.WriteString("FALSE")
+		}
+	case *structpb.Value_NumberValue:
+		if math.IsNaN(v.NumberValue) || math.IsInf(v.NumberValue, 0) {
+			return fmt.Errorf("unsupported number %v", v.NumberValue)
+		}
+		b.WriteString(strconv.FormatFloat(v.NumberValue, 'g', -1, 64))
+	case *structpb.Value_StringValue:
+		b.WriteString("'")
+		b.WriteString(strings.ReplaceAll(v.StringValue, "'", "''"))
+		b.WriteString("'")
+	default:
+		return fmt.Errorf("unsupported value type %T", v)
+	}
+	return nil
+}
+
+func formatIdentifier(name string) string {
+	if bareIdentifierRegexp.MatchString(name) && !keywords[strings.ToUpper(name)] {
+		return name
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// Parse parses an expression in the text format produced by Format.
+func Parse(s string) (*runtimev1.Expression, error) {
+	toks, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &textParser{toks: toks}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.peek().text, p.peek().pos)
+	}
+	return e, nil
+}
+
+type tokenKind int
+
+const (
+	tokenIdent tokenKind = iota
+	tokenKeyword
+	tokenString
+	tokenNumber
+	tokenSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string // For identifiers and strings, the unescaped value. For keywords, the upper-case keyword.
+	pos  int
+}
+
+func tokenize(s string) ([]token, error) {
+	var toks []token
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"':
+			start := i
+			var sb strings.Builder
+			i++
+			closed := false
+			for i < len(s) {
+				if s[i] == c {
+					if i+1 < len(s) && s[i+1] == c {
+						sb.WriteByte(c)
+						i += 2
+						continue
+					}
+					i++
+					closed = true
+					break
+				}
+				sb.WriteByte(s[i])
+				i++
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated quote at position %d", start)
+			}
+			kind := tokenString
+			if c == '"' {
+				kind = tokenIdent
+			}
+			toks = append(toks, token{kind: kind, text: sb.String(), pos: start})
+		case c == '(' || c == ')' || c == ',' || c == '=':
+			toks = append(toks, token{kind: tokenSymbol, text: string(c), pos: i})
+			i++
+		case c == '!' || c == '<' || c == '>':
+			if i+1 < len(s) && s[i+1] == '=' {
+				toks = append(toks, token{kind: tokenSymbol, text: s[i : i+2], pos: i})
+				i += 2
+			} else if c == '!' {
+				return nil, fmt.Errorf("unexpected '!' at position %d", i)
+			} else if c == '<' && i+1 < len(s) && s[i+1] == '>' {
+				toks = append(toks, token{kind: tokenSymbol, text: "!=", pos: i})
+				i += 2
+			} else {
+				toks = append(toks, token{kind: tokenSymbol, text: string(c), pos: i})
+				i++
+			}
+		case c == '-' || c == '.' || (c >= '0' && c <= '9'):
+			start := i
+			i++
+			for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.' || s[i] == 'e' || s[i] == 'E' || ((s[i] == '-' || s[i] == '+') && (s[i-1] == 'e' || s[i-1] == 'E'))) {
+				i++
+			}
+			toks = append(toks, token{kind: tokenNumber, text: s[start:i], pos: start})
+		case c == '_' || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z'):
+			start := i
+			for i < len(s) && (s[i] == '_' || (s[i] >= 'A' && s[i] <= 'Z') || (s[i] >= 'a' && s[i] <= 'z') || (s[i] >= '0' && s[i] <= '9')) {
+				i++
+			}
+			word := s[start:i]
+			if keywords[strings.ToUpper(word)] {
+				toks = append(toks, token{kind: tokenKeyword, text: strings.ToUpper(word), pos: start})
+			} else {
+				toks = append(toks, token{kind: tokenIdent, text: word, pos: start})
+			}
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	return toks, nil
+}
+
+type textParser struct {
+	toks []token
+	idx  int
+}
+
+func (p *textParser) done() bool {
+	return p.idx >= len(p.toks)
+}
+
+func (p *textParser) peek() token {
+	if p.done() {
+		return token{kind: tokenSymbol, text: "", pos: -1}
+	}
+	return p.toks[p.idx]
+}
+
+// accept consumes the next token if it's the given keyword or symbol.
+func (p *textParser) accept(text string) bool {
+	t := p.peek()
+	if (t.kind == tokenKeyword || t.kind == tokenSymbol) && t.text == text && !p.done() {
+		p.idx++
+		return true
+	}
+	return false
+}
+
+func (p *textParser) expect(text string) error {
+	if !p.accept(text) {
+		if p.done() {
+			return fmt.Errorf("expected %q at end of input", text)
+		}
+		return fmt.Errorf("expected %q at position %d", text, p.peek().pos)
+	}
+	return nil
+}
+
+func (p *textParser) parseOr() (*runtimev1.Expression, error) {
+	return p.parseJunction("OR", runtimev1.Operation_OPERATION_OR, p.parseAnd)
+}
+
+func (p *textParser) parseAnd() (*runtimev1.Expression, error) {
+	return p.parseJunction("AND", runtimev1.Operation_OPERATION_AND, p.parseComparison)
+}
+
+func (p *textParser) parseJunction(keyword string, op runtimev1.Operation, next func() (*runtimev1.Expression, error)) (*runtimev1.Expression, error) {
+	e, err := next()
+	if err != nil {
+		return nil, err
+	}
+	exprs := []*runtimev1.Expression{e}
+	for p.accept(keyword) {
+		e, err := next()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return condition(op, exprs...), nil
+}
+
+func (p *textParser) parseComparison() (*runtimev1.Expression, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.accept("NOT") {
+		if p.accept("IN") {
+			return p.parseInList(runtimev1.Operation_OPERATION_NIN, left)
+		}
+		if p.accept("LIKE") {
+			right, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			return condition(runtimev1.Operation_OPERATION_NLIKE, left, right), nil
+		}
+		return nil, fmt.Errorf("expected IN or LIKE after NOT at position %d", p.peek().pos)
+	}
+	if p.accept("IN") {
+		return p.parseInList(runtimev1.Operation_OPERATION_IN, left)
+	}
+	if p.accept("LIKE") {
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return condition(runtimev1.Operation_OPERATION_LIKE, left, right), nil
+	}
+
+	for op, sym := range binaryOperators {
+		if op == runtimev1.Operation_OPERATION_LIKE || op == runtimev1.Operation_OPERATION_NLIKE {
+			continue
+		}
+		if p.accept(sym) {
+			right, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			return condition(op, left, right), nil
+		}
+	}
+
+	return left, nil
+}
+
+func (p *textParser) parseInList(op runtimev1.Operation, left *runtimev1.Expression) (*runtimev1.Expression, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	exprs := []*runtimev1.Expression{left}
+
+	// Handle an empty list
+	if p.accept(")") {
+		return condition(op, exprs...), nil
+	}
+
+	if p.accept("SELECT") {
+		sq, err := p.parseSubquery()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, sq)
+	} else {
+		for {
+			e, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			exprs = append(exprs, e)
+			if !p.accept(",") {
+				break
+			}
+		}
+	}
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return condition(op, exprs...), nil
+}
+
+func (p *textParser) parseSubquery() (*runtimev1.Expression, error) {
+	sq := &runtimev1.Subquery{}
+	dim, err := p.parseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	sq.Dimension = dim
+	for p.accept(",") {
+		m, err := p.parseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		sq.Measures = append(sq.Measures, m)
+	}
+	if p.accept("WHERE") {
+		sq.Where, err = p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.accept("HAVING") {
+		sq.Having, err = p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.accept("ORDER") {
+		if err := p.expect("BY"); err != nil {
+			return nil, err
+		}
+		sq.Sort, err = p.parseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		if p.accept("DESC") {
+			sq.SortDesc = true
+		} else {
+			p.accept("ASC")
+		}
+	}
+	if p.accept("LIMIT") {
+		t := p.peek()
+		if p.done() || t.kind != tokenNumber {
+			return nil, fmt.Errorf("expected limit at position %d", t.pos)
+		}
+		p.idx++
+		sq.Limit, err = strconv.ParseInt(t.text, 10, 64)
+		if err != nil || sq.Limit <= 0 {
+			return nil, fmt.Errorf("invalid limit %q at position %d", t.text, t.pos)
+		}
+	}
+	return &runtimev1.Expression{Expression: &runtimev1.Expression_Subquery{Subquery: sq}}, nil
+}
+
+func (p *textParser) parseIdentifier() (string, error) {
+	t := p.peek()
+	if p.done() || t.kind != tokenIdent {
+		return "", fmt.Errorf("expected identifier at position %d", t.pos)
+	}
+	p.idx++
+	return t.text, nil
+}
+
+func (p *textParser) parseOperand() (*runtimev1.Expression, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of input")
+	}
+	t := p.peek()
+	switch t.kind {
+	case tokenIdent:
+		p.idx++
+		if strings.EqualFold(t.text, "SEGMENT") && p.accept("(") {
+			name, err := p.parseIdentifier()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return Segment(name), nil
+		}
+		return Identifier(t.text), nil
+	case tokenString:
+		p.idx++
+		return String(t.text), nil
+	case tokenNumber:
+		p.idx++
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", t.text, t.pos)
+		}
+		return Number(n), nil
+	case tokenKeyword:
+		switch t.text {
+		case "TRUE", "FALSE":
+			p.idx++
+			return Value(structpb.NewBoolValue(t.text == "TRUE")), nil
+		case "NULL":
+			p.idx++
+			return Value(structpb.NewNullValue()), nil
+		}
+	case tokenSymbol:
+		if p.accept("(") {
+			e, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return e, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+}
+
+func condition(op runtimev1.Operation, exprs ...*runtimev1.Expression) *runtimev1.Expression {
+	return &runtimev1.Expression{
+		Expression: &runtimev1.Expression_Cond{
+			Cond: &runtimev1.Condition{
+				Op:    op,
+				Exprs: exprs,
+			},
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package expressionpb_test
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/expressionpb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestFormatAndParse(t *testing.T) {
+	tests := []struct {
+		expr *runtimev1.Expression
+		text string
+	}{
+		{
+			expr: expressionpb.Eq("country", "US"),
+			text: `country = 'US'`,
+		},
+		{
+			expr: expressionpb.AndAll(
+				expressionpb.In(expressionpb.Identifier("country"), []*runtimev1.Expression{expressionpb.String("US"), expressionpb.String("it's")}),
+				expressionpb.OrAll(
+					expressionpb.Gt("revenue", 100.5),
+					expressionpb.NotLike(expressionpb.Identifier("domain"), expressionpb.String("%google%")),
+				),
+			),
+			text: `(country IN ('US', 'it''s')) AND ((revenue > 100.5) OR (domain NOT LIKE '%google%'))`,
+		},
+		{
+			expr: expressionpb.NotIn(expressionpb.Identifier("Order Status"), []*runtimev1.Expression{expressionpb.Value(structpb.NewNullValue()), expressionpb.Value(structpb.NewBoolValue(true)), expressionpb.Number(-3e21)}),
+			text: `"Order Status" NOT IN (NULL, TRUE, -3e+21)`,
+		},
+		{
+			expr: expressionpb.In(expressionpb.Identifier("select"), nil),
+			text: `"select" IN ()`,
+		},
+		{
+			expr: expressionpb.In(expressionpb.Identifier("publisher"), []*runtimev1.Expression{{
+				Expression: &runtimev1.Expression_Subquery{Subquery: &runtimev1.Subquery{
+					Dimension: "publisher",
+					Measures:  []string{"impressions"},
+					Where:     expressionpb.Eq("country", "US"),
+					Having:    expressionpb.Gt("impressions", 1000),
+				}},
+			}}),
+			text: `publisher IN (SELECT publisher, impressions WHERE country = 'US' HAVING impressions > 1000)`,
+		},
+		{
+			expr: expressionpb.In(expressionpb.Identifier("campaign"), []*runtimev1.Expression{{
+				Expression: &runtimev1.Expression_Subquery{Subquery: &runtimev1.Subquery{
+					Dimension: "campaign",
+					Sort:      "revenue",
+					SortDesc:  true,
+					Limit:     10,
+				}},
+			}}),
+			text: `campaign IN (SELECT campaign ORDER BY revenue DESC LIMIT 10)`,
+		},
+		{
+			expr: expressionpb.AndAll(expressionpb.Segment("emea_enterprise"), expressionpb.Eq("segment", "smb")),
+			text: `SEGMENT(emea_enterprise) AND (segment = 'smb')`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			text, err := expressionpb.Format(tt.expr)
+			require.NoError(t, err)
+			require.Equal(t, tt.text, text)
+
+			expr, err := expressionpb.Parse(text)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.expr, expr), "parsed expression doesn't match")
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	// Parentheses are optional, AND binds tighter than OR, and keywords are case-insensitive
+	expr, err := expressionpb.Parse(`a = 1 or b <> 'x' and c like 'y%'`)
+	require.NoError(t, err)
+	text, err := expressionpb.Format(expr)
+	require.NoError(t, err)
+	require.Equal(t, `(a = 1) OR ((b != 'x') AND (c LIKE 'y%'))`, text)
+
+	for _, s := range []string{``, `a =`, `a IN ('x'`, `a = 'x`, `a = 1 b`, `a ! 1`, `a NOT = 1`, `a IN (SELECT a LIMIT x)`, `a IN (SELECT a ORDER revenue)`} {
+		_, err := expressionpb.Parse(s)
+		require.Error(t, err, s)
+	}
+}
@@ -0,0 +1,173 @@
+// Package forecast projects time series into the future using simple statistical models.
+// The series are expected to be evenly spaced. Missing values are represented by NaN.
+package forecast
+
+import (
+	"math"
+)
+
+// smoothingGrid are the candidate values for the smoothing parameters of the exponential smoothing models.
+// The parameters are fitted by picking the combination that minimizes the one-step-ahead squared error.
+var smoothingGrid = []float64{0.1, 0.3, 0.5, 0.7, 0.9}
+
+// Linear fits a least squares line to the non-missing values and extrapolates it n periods past the end of the series.
+// It returns nil if there are fewer than two non-missing values.
+func Linear(values []float64, n int) []float64 {
+	var count, sumX, sumY, sumXX, sumXY float64
+	for i, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		x := float64(i)
+		count++
+		sumX += x
+		sumY += v
+		sumXX += x * x
+		sumXY += x * v
+	}
+	if count < 2 {
+		return nil
+	}
+
+	denom := count*sumXX - sumX*sumX
+	if denom == 0 {
+		return nil
+	}
+	slope := (count*sumXY - sumX*sumY) / denom
+	intercept := (sumY - slope*sumX) / count
+
+	res := make([]float64, n)
+	for i := range res {
+		res[i] = intercept + slope*float64(len(values)+i)
+	}
+	return res
+}
+
+// HoltWinters fits an additive Holt-Winters model with the given season length and extrapolates it n periods past the end of the series.
+// If season is less than 2 or the series doesn't span two full seasons, it fits Holt's linear trend model (without seasonality) instead.
+// Leading missing values are ignored and other missing values are imputed with the model's one-step-ahead prediction.
+// It returns nil if there are fewer than two values after the leading missing values.
+func HoltWinters(values []float64, season, n int) []float64 {
+	for len(values) > 0 && math.IsNaN(values[0]) {
+		values = values[1:]
+	}
+	if len(values) < 2 {
+		return nil
+	}
+
+	if season < 2 || len(values) < 2*season || hasNaN(values[:2*season]) {
+		season = 0
+	}
+
+	var best *model
+	bestSSE := math.Inf(1)
+	gammas := smoothingGrid
+	if season == 0 {
+		gammas = []float64{0}
+	}
+	for _, alpha := range smoothingGrid {
+		for _, beta := range smoothingGrid {
+			for _, gamma := range gammas {
+				m, start := newModel(values, season, alpha, beta, gamma)
+				sse := m.fit(values[start:])
+				if sse < bestSSE {
+					best, bestSSE = m, sse
+				}
+			}
+		}
+	}
+	if best == nil {
+		return nil
+	}
+
+	res := make([]float64, n)
+	for i := range res {
+		res[i] = best.predict(i + 1)
+	}
+	return res
+}
+
+// model is the state of an additive Holt-Winters model.
+type model struct {
+	alpha, beta, gamma float64
+	level, trend       float64
+	seasonals          []float64 // nil if there's no seasonality
+	t                  int       // Number of observations processed
+}
+
+// newModel initializes a model from the start of the series.
+// It returns the index of the first value that has not been used for initialization.
+// With seasonality, the level and trend are initialized from the means of the first two seasons (and the first season is consumed).
+// Without seasonality, they are initialized from the first two values (and the first value is consumed).
+func newModel(values []float64, season int, alpha, beta, gamma float64) (*model, int) {
+	m := &model{alpha: alpha, beta: beta, gamma: gamma}
+	if season == 0 {
+		m.level = values[0]
+		m.trend = values[1] - values[0]
+		m.t = 1
+		return m, 1
+	}
+
+	var first, second float64
+	for i := 0; i < season; i++ {
+		first += values[i]
+		second += values[season+i]
+	}
+	first /= float64(season)
+	second /= float64(season)
+
+	// The season means are centered in the middle of the seasons, so we detrend relative to the middle of the first season.
+	m.trend = (second - first) / float64(season)
+	mid := float64(season-1) / 2
+	m.level = first + m.trend*mid
+	m.seasonals = make([]float64, season)
+	for i := 0; i < season; i++ {
+		m.seasonals[i] = values[i] - (first + m.trend*(float64(i)-mid))
+	}
+	m.t = season
+	return m, season
+}
+
+// fit updates the model with the values and returns the sum of squared one-step-ahead errors.
+func (m *model) fit(values []float64) float64 {
+	var sse float64
+	for _, v := range values {
+		pred := m.predict(1)
+		if math.IsNaN(v) {
+			v = pred
+		}
+		sse += (v - pred) * (v - pred)
+
+		prevLevel := m.level
+		s := m.seasonal(0)
+		m.level = m.alpha*(v-s) + (1-m.alpha)*(m.level+m.trend)
+		m.trend = m.beta*(m.level-prevLevel) + (1-m.beta)*m.trend
+		if m.seasonals != nil {
+			m.seasonals[m.t%len(m.seasonals)] = m.gamma*(v-m.level) + (1-m.gamma)*s
+		}
+		m.t++
+	}
+	return sse
+}
+
+// predict returns the prediction h periods after the last processed observation.
+func (m *model) predict(h int) float64 {
+	return m.level + float64(h)*m.trend + m.seasonal(h-1)
+}
+
+// seasonal returns the seasonal component h periods after the last processed observation.
+func (m *model) seasonal(h int) float64 {
+	if m.seasonals == nil {
+		return 0
+	}
+	return m.seasonals[(m.t+h)%len(m.seasonals)]
+}
+
+func hasNaN(values []float64) bool {
+	for _, v := range values {
+		if math.IsNaN(v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package forecast
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinear(t *testing.T) {
+	nan := math.NaN()
+
+	res := Linear([]float64{1, 3, nan, 7, 9}, 3)
+	require.InDeltaSlice(t, []float64{11, 13, 15}, res, 1e-9)
+
+	require.Nil(t, Linear([]float64{nan, 1, nan}, 3))
+	require.Nil(t, Linear(nil, 3))
+}
+
+func TestHoltWinters(t *testing.T) {
+	nan := math.NaN()
+
+	// A perfect trend is extrapolated exactly
+	res := HoltWinters([]float64{nan, 10, 12, 14, 16, 18, 20}, 0, 2)
+	require.InDeltaSlice(t, []float64{22, 24}, res, 1e-9)
+
+	// A seasonal pattern on top of a trend is continued
+	var values []float64
+	pattern := []float64{5, -5, 0, 10}
+	for i := 0; i < 16; i++ {
+		values = append(values, float64(100+2*i)+pattern[i%4])
+	}
+	res = HoltWinters(values, 4, 4)
+	var expected []float64
+	for i := 16; i < 20; i++ {
+		expected = append(expected, float64(100+2*i)+pattern[i%4])
+	}
+	require.InDeltaSlice(t, expected, res, 1e-6)
+
+	// Missing values in the middle of the series are imputed
+	values[9] = nan
+	res = HoltWinters(values, 4, 4)
+	require.InDeltaSlice(t, expected, res, 1)
+
+	// Falls back to no seasonality if the series is shorter than two seasons
+	res = HoltWinters([]float64{1, 2, 3, 4, 5}, 4, 1)
+	require.InDeltaSlice(t, []float64{6}, res, 1e-9)
+
+	require.Nil(t, HoltWinters([]float64{nan, 1}, 0, 1))
+}
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 )
 
+const grpcShutdownTimeout = 15 * time.Second
+
 // ServeGRPC serves a GRPC server and performs a graceful shutdown if/when ctx is cancelled.
 func ServeGRPC(ctx context.Context, server *grpc.Server, port int) error {
 	// Calling net.Listen("tcp", ...) will succeed if the port is blocked on IPv4 but not on IPv6.
@@ -34,7 +37,18 @@ func ServeGRPC(ctx context.Context, server *grpc.Server, port int) error {
 
 	<-cctx.Done()
 	if serveErr == nil {
-		server.GracefulStop()
+		// GracefulStop waits for all RPCs to finish, which may never happen for long-lived streams.
+		// So after a timeout, we forcefully close the remaining connections.
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(grpcShutdownTimeout):
+			server.Stop()
+		}
 	}
 
 	return serveErr
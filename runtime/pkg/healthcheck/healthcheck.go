@@ -0,0 +1,86 @@
+// Package healthcheck implements structured liveness and readiness responses for load balancers and k8s probes.
+package healthcheck
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+// Status of a dependency check or of a report as a whole.
+type Status string
+
+const (
+	StatusOK Status = "ok"
+	// StatusDegraded means a non-critical check failed. The service can still serve some traffic.
+	StatusDegraded Status = "degraded"
+	// StatusError means a critical check failed. The service should not receive traffic.
+	StatusError Status = "error"
+)
+
+// CheckResult is the result of a single dependency check.
+type CheckResult struct {
+	Status Status `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report collects the results of dependency checks. It is safe for concurrent use.
+type Report struct {
+	mu     sync.Mutex
+	Status Status                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// NewReport creates an empty report with status StatusOK.
+func NewReport() *Report {
+	return &Report{
+		Status: StatusOK,
+		Checks: make(map[string]CheckResult),
+	}
+}
+
+// Add records the result of a dependency check.
+// A failing critical check sets the report's status to StatusError, and a failing non-critical check sets it to StatusDegraded (unless it's already StatusError).
+func (r *Report) Add(name string, err error, critical bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err == nil {
+		r.Checks[name] = CheckResult{Status: StatusOK}
+		return
+	}
+
+	res := CheckResult{Status: StatusDegraded, Error: err.Error()}
+	if critical {
+		res.Status = StatusError
+	}
+	r.Checks[name] = res
+
+	if res.Status == StatusError || r.Status == StatusOK {
+		r.Status = res.Status
+	}
+}
+
+// WriteHTTP serializes the report to w. It responds with status 503 if the report's status is StatusError, and 200 otherwise.
+func (r *Report) WriteHTTP(w http.ResponseWriter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	code := http.StatusOK
+	if r.Status == StatusError {
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(r)
+}
+
+// LivenessHandler returns a handler that always reports StatusOK.
+// It only verifies that the process is able to serve HTTP requests.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		NewReport().WriteHTTP(w)
+	})
+}
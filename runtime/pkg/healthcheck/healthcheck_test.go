@@ -0,0 +1,47 @@
+package healthcheck
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport(t *testing.T) {
+	r := NewReport()
+	r.Add("db", nil, true)
+	require.Equal(t, StatusOK, r.Status)
+
+	r.Add("olap", errors.New("connection refused"), false)
+	require.Equal(t, StatusDegraded, r.Status)
+
+	r.Add("metastore", errors.New("timeout"), true)
+	require.Equal(t, StatusError, r.Status)
+
+	r.Add("repo", errors.New("not found"), false)
+	require.Equal(t, StatusError, r.Status)
+
+	w := httptest.NewRecorder()
+	r.WriteHTTP(w)
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	var res struct {
+		Status Status                 `json:"status"`
+		Checks map[string]CheckResult `json:"checks"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	require.Equal(t, StatusError, res.Status)
+	require.Len(t, res.Checks, 4)
+	require.Equal(t, CheckResult{Status: StatusDegraded, Error: "connection refused"}, res.Checks["olap"])
+	require.Equal(t, CheckResult{Status: StatusOK}, res.Checks["db"])
+}
+
+func TestLivenessHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	LivenessHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", http.NoBody))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"status":"ok","checks":{}}`, w.Body.String())
+}
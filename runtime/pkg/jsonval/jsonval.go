@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/marcboeker/go-duckdb"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
 )
 
 // ToValue converts a value scanned from a database/sql driver to a Go type that can be marshaled to JSON.
@@ -73,17 +74,14 @@ func ToValue(v any, t *runtimev1.Type) (any, error) {
 		// This is what we should do when frontend supports it:
 		// return v.String(), nil
 	case *big.Int:
+		if v == nil {
+			return nil, nil
+		}
 		// Evil cast to float until frontend can deal with bigs:
 		v2, _ := new(big.Float).SetInt(v).Float64()
 		return v2, nil
 		// This is what we should do when frontend supports it:
 		// return v.String(), nil
-	case duckdb.Decimal:
-		// Evil cast to float until frontend can deal with bigs:
-		denom := big.NewInt(10)
-		denom.Exp(denom, big.NewInt(int64(v.Scale)), nil)
-		v2, _ := new(big.Rat).SetFrac(v.Value, denom).Float64()
-		return v2, nil
 	case map[string]any:
 		var t2 *runtimev1.StructType
 		if t != nil {
@@ -91,6 +89,10 @@ func ToValue(v any, t *runtimev1.Type) (any, error) {
 		}
 		return toMap(v, t2)
 	case []any:
+		if t != nil && t.StructType != nil {
+			// Some drivers return structs as a list of values in field order (e.g. ClickHouse for unnamed tuples).
+			return toMapPositional(v, t.StructType)
+		}
 		return toSlice(v, t)
 	case map[any]any:
 		var t2 *runtimev1.MapType
@@ -185,6 +187,14 @@ func ToValue(v any, t *runtimev1.Type) (any, error) {
 		return nil, nil
 	default:
 	}
+	// Drivers may return typed nil pointers for NULL values nested in complex types (e.g. a ClickHouse Map(String, Nullable(UInt64))).
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil, nil
+	}
+	if s, ok := pbutil.DecimalString(v, t); ok {
+		// A json.Number marshals as a JSON number without losing precision.
+		return json.Number(s), nil
+	}
 	if t != nil && t.ArrayElementType != nil {
 		return toSliceUnknown(v, t)
 	}
@@ -216,6 +226,23 @@ func toMap(v map[string]any, t *runtimev1.StructType) (map[string]any, error) {
 	return v, nil
 }
 
+// toMapPositional converts a list of field values to a map.
+// The values must be in the same order as the fields of t.
+func toMapPositional(v []any, t *runtimev1.StructType) (map[string]any, error) {
+	if len(v) != len(t.Fields) {
+		return nil, fmt.Errorf("received %d values for struct with %d fields", len(v), len(t.Fields))
+	}
+	x := make(map[string]any, len(v))
+	for i, f := range t.Fields {
+		var err error
+		x[f.Name], err = ToValue(v[i], f.Type)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return x, nil
+}
+
 // toMapCoerceKeys converts a map with non-string keys to a map[string]any.
 // It attempts to coerce the keys to JSON strings. Providing t as a type hint is optional.
 func toMapCoerceKeys(v map[any]any, t *runtimev1.MapType) (map[string]any, error) {
@@ -0,0 +1,168 @@
+// Package locale implements the locale-sensitive formatting applied to query results in exports,
+// namely decimal separators for numbers and month/day names for time labels.
+package locale
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Locale formats numbers and dates for a language.
+type Locale struct {
+	// Tag is the normalized language tag, e.g. "de" or "pt-BR".
+	Tag string
+	// DecimalSeparator separates the integer and fractional parts of numbers.
+	DecimalSeparator byte
+	months           [12]string
+	days             [7]string
+}
+
+// Default is the locale used when no locale is specified.
+var Default = &Locale{Tag: "en", DecimalSeparator: '.', months: languages["en"].months, days: languages["en"].days}
+
+type language struct {
+	decimalSeparator byte
+	months           [12]string
+	days             [7]string // Starting with Sunday to match time.Weekday
+}
+
+var languages = map[string]language{
+	"en": {'.',
+		[12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		[7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	},
+	"de": {',',
+		[12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		[7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	},
+	"fr": {',',
+		[12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		[7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	},
+	"es": {',',
+		[12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		[7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	},
+	"it": {',',
+		[12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		[7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+	},
+	"nl": {',',
+		[12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		[7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+	},
+	"pt": {',',
+		[12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		[7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+	},
+	"sv": {',',
+		[12]string{"januari", "februari", "mars", "april", "maj", "juni", "juli", "augusti", "september", "oktober", "november", "december"},
+		[7]string{"söndag", "måndag", "tisdag", "onsdag", "torsdag", "fredag", "lördag"},
+	},
+	"da": {',',
+		[12]string{"januar", "februar", "marts", "april", "maj", "juni", "juli", "august", "september", "oktober", "november", "december"},
+		[7]string{"søndag", "mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag"},
+	},
+	"nb": {',',
+		[12]string{"januar", "februar", "mars", "april", "mai", "juni", "juli", "august", "september", "oktober", "november", "desember"},
+		[7]string{"søndag", "mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag"},
+	},
+	"fi": {',',
+		[12]string{"tammikuu", "helmikuu", "maaliskuu", "huhtikuu", "toukokuu", "kesäkuu", "heinäkuu", "elokuu", "syyskuu", "lokakuu", "marraskuu", "joulukuu"},
+		[7]string{"sunnuntai", "maanantai", "tiistai", "keskiviikko", "torstai", "perjantai", "lauantai"},
+	},
+	"pl": {',',
+		[12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+		[7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+	},
+}
+
+// Validate returns an error if tag is not empty and not a supported locale.
+func Validate(tag string) error {
+	_, err := Parse(tag)
+	return err
+}
+
+// Parse resolves a BCP 47 language tag like "de", "de-DE" or "pt_BR" to a locale.
+// Only the language subtag is used for formatting. An empty tag returns the default locale.
+func Parse(tag string) (*Locale, error) {
+	if tag == "" {
+		return Default, nil
+	}
+
+	parts := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("invalid locale %q", tag)
+	}
+	base := strings.ToLower(parts[0])
+	if base == "no" || base == "nn" {
+		base = "nb"
+	}
+	lang, ok := languages[base]
+	if !ok {
+		return nil, fmt.Errorf("unsupported locale %q", tag)
+	}
+
+	norm := base
+	if len(parts) > 1 {
+		norm += "-" + strings.ToUpper(parts[1])
+	}
+
+	return &Locale{
+		Tag:              norm,
+		DecimalSeparator: lang.decimalSeparator,
+		months:           lang.months,
+		days:             lang.days,
+	}, nil
+}
+
+// IsDefault returns true if the locale formats numbers the same way as the default locale.
+func (l *Locale) IsDefault() bool {
+	return l == nil || l.DecimalSeparator == '.'
+}
+
+// CSVDelimiter returns the field delimiter to use for CSV files.
+// Locales that use a comma as the decimal separator conventionally use a semicolon as the delimiter.
+func (l *Locale) CSVDelimiter() rune {
+	if l.IsDefault() {
+		return ','
+	}
+	return ';'
+}
+
+// FormatNumber rewrites a number formatted with a period as the decimal separator (as by strconv) to use the locale's decimal separator.
+func (l *Locale) FormatNumber(s string) string {
+	if l.IsDefault() {
+		return s
+	}
+	return strings.Replace(s, ".", string(l.DecimalSeparator), 1)
+}
+
+// MonthName returns the name of a month.
+func (l *Locale) MonthName(m time.Month) string {
+	return l.months[m-1]
+}
+
+// DayName returns the name of a day of the week.
+func (l *Locale) DayName(d time.Weekday) string {
+	return l.days[d]
+}
+
+// TimeLabel formats a time for display as a time dimension value truncated to the given grain.
+// Months and years are labelled by name ("März 2024"), and finer grains by date ("Montag 4 März 2024").
+// The grain is one of "year", "quarter", "month", "week", "day" or finer; unknown grains are formatted as dates.
+func (l *Locale) TimeLabel(t time.Time, grain string) string {
+	switch grain {
+	case "year":
+		return fmt.Sprintf("%d", t.Year())
+	case "quarter":
+		return fmt.Sprintf("Q%d %d", (int(t.Month())-1)/3+1, t.Year())
+	case "month":
+		return fmt.Sprintf("%s %d", l.MonthName(t.Month()), t.Year())
+	case "week", "day":
+		return fmt.Sprintf("%s %d %s %d", l.DayName(t.Weekday()), t.Day(), l.MonthName(t.Month()), t.Year())
+	default:
+		return fmt.Sprintf("%s %d %s %d %s", l.DayName(t.Weekday()), t.Day(), l.MonthName(t.Month()), t.Year(), t.Format("15:04:05"))
+	}
+}
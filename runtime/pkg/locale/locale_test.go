@@ -0,0 +1,50 @@
+package locale
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	l, err := Parse("")
+	require.NoError(t, err)
+	require.Same(t, Default, l)
+
+	l, err = Parse("de_de")
+	require.NoError(t, err)
+	require.Equal(t, "de-DE", l.Tag)
+	require.Equal(t, byte(','), l.DecimalSeparator)
+
+	l, err = Parse("no")
+	require.NoError(t, err)
+	require.Equal(t, "nb", l.Tag)
+
+	_, err = Parse("xx-YY")
+	require.Error(t, err)
+	require.Error(t, Validate("-"))
+}
+
+func TestFormatting(t *testing.T) {
+	en, err := Parse("en-US")
+	require.NoError(t, err)
+	fr, err := Parse("fr")
+	require.NoError(t, err)
+
+	require.Equal(t, ',', en.CSVDelimiter())
+	require.Equal(t, ';', fr.CSVDelimiter())
+	require.Equal(t, "1234.5", en.FormatNumber("1234.5"))
+	require.Equal(t, "1234,5", fr.FormatNumber("1234.5"))
+	require.Equal(t, "-1,5e+21", fr.FormatNumber("-1.5e+21"))
+
+	var nilLocale *Locale
+	require.Equal(t, ',', nilLocale.CSVDelimiter())
+	require.Equal(t, "1.5", nilLocale.FormatNumber("1.5"))
+
+	ts := time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, "mars 2024", fr.TimeLabel(ts, "month"))
+	require.Equal(t, "lundi 4 mars 2024", fr.TimeLabel(ts, "day"))
+	require.Equal(t, "Monday 4 March 2024 00:00:00", en.TimeLabel(ts, "hour"))
+	require.Equal(t, "Q1 2024", en.TimeLabel(ts, "quarter"))
+}
@@ -0,0 +1,204 @@
+// Package maintenancewindow parses and evaluates recurring maintenance windows like "Mon-Fri 22:00-06:00 America/New_York".
+// Maintenance windows are used to restrict heavy work, such as scheduled refreshes and runtime upgrades, to times outside business hours.
+package maintenancewindow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Window is a recurring daily time range that is active on a subset of the days of the week.
+//
+// The textual format is "[DAYS] START-END [TIMEZONE]", where:
+//   - DAYS is an optional comma-separated list of days or day ranges, e.g. "Mon-Fri" or "Sat,Sun" (defaults to every day).
+//     A window that crosses midnight is considered to belong to the day it starts on.
+//   - START and END are times of day formatted as "HH:MM". If END is before START, the window crosses midnight. END may be "24:00".
+//   - TIMEZONE is an optional IANA time zone name (defaults to UTC).
+type Window struct {
+	days     [7]bool
+	start    time.Duration
+	end      time.Duration
+	location *time.Location
+}
+
+var dayNames = [7]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// Parse parses a maintenance window. See Window for the format.
+func Parse(s string) (*Window, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 || len(fields) > 3 {
+		return nil, fmt.Errorf("invalid maintenance window %q: expected format \"[DAYS] HH:MM-HH:MM [TIMEZONE]\"", s)
+	}
+
+	w := &Window{location: time.UTC}
+
+	// The days are optional, so the first field is either days or the time range.
+	if strings.Contains(fields[0], ":") {
+		for i := range w.days {
+			w.days[i] = true
+		}
+	} else {
+		err := w.parseDays(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window %q: %w", s, err)
+		}
+		fields = fields[1:]
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("invalid maintenance window %q: missing time range", s)
+		}
+	}
+
+	err := w.parseTimes(fields[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance window %q: %w", s, err)
+	}
+
+	if len(fields) > 1 {
+		loc, err := time.LoadLocation(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window %q: %w", s, err)
+		}
+		w.location = loc
+	}
+
+	return w, nil
+}
+
+// Validate returns an error if s is not empty and not a valid maintenance window.
+func Validate(s string) error {
+	if s == "" {
+		return nil
+	}
+	_, err := Parse(s)
+	return err
+}
+
+// Contains returns true if t is inside the window.
+func (w *Window) Contains(t time.Time) bool {
+	t = t.In(w.location)
+	// Check the occurrence starting on the same day and, for windows that cross midnight, the previous day.
+	for offset := -1; offset <= 0; offset++ {
+		day := t.AddDate(0, 0, offset)
+		if !w.days[day.Weekday()] {
+			continue
+		}
+		start, end := w.occurrence(day)
+		if !t.Before(start) && t.Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
+// Next returns the earliest time at or after t that is inside the window.
+func (w *Window) Next(t time.Time) time.Time {
+	if w.Contains(t) {
+		return t
+	}
+	local := t.In(w.location)
+	for offset := 0; offset <= 7; offset++ {
+		day := local.AddDate(0, 0, offset)
+		if !w.days[day.Weekday()] {
+			continue
+		}
+		start, _ := w.occurrence(day)
+		if start.After(t) {
+			return start
+		}
+	}
+	// Unreachable since a parsed window is active on at least one day.
+	return t
+}
+
+// occurrence returns the start and end of the window's occurrence that starts on the given day.
+func (w *Window) occurrence(day time.Time) (time.Time, time.Time) {
+	y, m, d := day.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, w.location).Add(w.start)
+	end := time.Date(y, m, d, 0, 0, 0, 0, w.location).Add(w.end)
+	if w.end <= w.start {
+		end = time.Date(y, m, d+1, 0, 0, 0, 0, w.location).Add(w.end)
+	}
+	return start, end
+}
+
+func (w *Window) parseDays(s string) error {
+	for _, part := range strings.Split(s, ",") {
+		from, to, isRange := strings.Cut(part, "-")
+		i, err := parseDay(from)
+		if err != nil {
+			return err
+		}
+		j := i
+		if isRange {
+			j, err = parseDay(to)
+			if err != nil {
+				return err
+			}
+		}
+		// Ranges may wrap around the end of the week, e.g. "Fri-Mon".
+		for {
+			w.days[i] = true
+			if i == j {
+				break
+			}
+			i = (i + 1) % 7
+		}
+	}
+	return nil
+}
+
+func parseDay(s string) (int, error) {
+	s = strings.ToLower(s)
+	if len(s) >= 3 {
+		for i, name := range dayNames {
+			if strings.HasPrefix(s, name) {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("invalid day %q", s)
+}
+
+func (w *Window) parseTimes(s string) error {
+	from, to, ok := strings.Cut(s, "-")
+	if !ok {
+		return fmt.Errorf("invalid time range %q", s)
+	}
+	var err error
+	w.start, err = parseTimeOfDay(from)
+	if err != nil {
+		return err
+	}
+	w.end, err = parseTimeOfDay(to)
+	if err != nil {
+		return err
+	}
+	if w.start == w.end {
+		return fmt.Errorf("time range %q is empty", s)
+	}
+	if w.start == 24*time.Hour {
+		return fmt.Errorf("time range %q can't start at 24:00", s)
+	}
+	return nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	hh, mm, ok := strings.Cut(s, ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	h, err := strconv.Atoi(hh)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	m, err := strconv.Atoi(mm)
+	if err != nil || len(mm) != 2 {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	if h < 0 || m < 0 || m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
@@ -0,0 +1,70 @@
+package maintenancewindow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	valid := []string{
+		"22:00-06:00",
+		"00:00-24:00",
+		"Mon-Fri 22:00-06:00",
+		"sat,sun 00:00-23:59 Europe/Copenhagen",
+		"Fri-Mon 01:30-04:00 UTC",
+	}
+	for _, s := range valid {
+		_, err := Parse(s)
+		require.NoError(t, err, s)
+	}
+
+	invalid := []string{
+		"",
+		"22:00",
+		"Mon-Fri",
+		"Foo 22:00-06:00",
+		"25:00-06:00",
+		"22:00-06:0",
+		"06:00-06:00",
+		"24:00-06:00",
+		"22:00-06:00 Mars/Olympus",
+		"Mon 22:00-06:00 UTC extra",
+	}
+	for _, s := range invalid {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+
+	require.NoError(t, Validate(""))
+}
+
+func TestContainsAndNext(t *testing.T) {
+	// Weeknights from 22:00 to 06:00 UTC. 2024-03-04 is a Monday.
+	w, err := Parse("Mon-Fri 22:00-06:00")
+	require.NoError(t, err)
+
+	monNoon := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+	monNight := time.Date(2024, 3, 4, 23, 0, 0, 0, time.UTC)
+	tueEarly := time.Date(2024, 3, 5, 5, 59, 0, 0, time.UTC)
+	satEarly := time.Date(2024, 3, 9, 3, 0, 0, 0, time.UTC)
+	satNight := time.Date(2024, 3, 9, 23, 0, 0, 0, time.UTC)
+
+	require.False(t, w.Contains(monNoon))
+	require.True(t, w.Contains(monNight))
+	require.True(t, w.Contains(tueEarly))
+	require.True(t, w.Contains(satEarly)) // The window that started Friday night
+	require.False(t, w.Contains(satNight))
+
+	require.Equal(t, monNight, w.Next(monNight))
+	require.Equal(t, time.Date(2024, 3, 4, 22, 0, 0, 0, time.UTC), w.Next(monNoon))
+	require.Equal(t, time.Date(2024, 3, 11, 22, 0, 0, 0, time.UTC), w.Next(satNight))
+
+	// Time zones are applied
+	w, err = Parse("02:00-04:00 America/New_York")
+	require.NoError(t, err)
+	require.True(t, w.Contains(time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC)))
+	require.False(t, w.Contains(time.Date(2024, 3, 4, 2, 0, 0, 0, time.UTC)))
+	require.Equal(t, time.Date(2024, 3, 5, 7, 0, 0, 0, time.UTC), w.Next(time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)).UTC())
+}
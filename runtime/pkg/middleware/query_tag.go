@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"net/textproto"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/rilldata/rill/runtime/drivers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// QueryTagHeader is the header (or gRPC metadata key) that clients use to tag the queries issued by a request.
+// See drivers.WithQueryTag for details.
+const QueryTagHeader = "X-Rill-Query-Tag"
+
+// QueryTagHeaderMatcher forwards QueryTagHeader from HTTP requests proxied by the REST gateway to gRPC metadata.
+// Other headers are handled by fallback, which would usually be gateway.DefaultHeaderMatcher.
+func QueryTagHeaderMatcher(fallback func(string) (string, bool)) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		if textproto.CanonicalMIMEHeaderKey(key) == QueryTagHeader {
+			return QueryTagHeader, true
+		}
+		return fallback(key)
+	}
+}
+
+// QueryTagStreamServerInterceptor sets the query tag from the request metadata on the request context.
+func QueryTagStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		tagged := withQueryTagFromMetadata(ctx)
+		if tagged == ctx {
+			return handler(srv, ss)
+		}
+
+		wss := grpc_middleware.WrapServerStream(ss)
+		wss.WrappedContext = tagged
+		return handler(srv, wss)
+	}
+}
+
+// QueryTagUnaryServerInterceptor sets the query tag from the request metadata on the request context.
+func QueryTagUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withQueryTagFromMetadata(ctx), req)
+	}
+}
+
+func withQueryTagFromMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	// Metadata keys are lowercased
+	vals := md.Get(QueryTagHeader)
+	if len(vals) == 0 {
+		return ctx
+	}
+	return drivers.WithQueryTag(ctx, vals[0])
+}
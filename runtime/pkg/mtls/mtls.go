@@ -0,0 +1,155 @@
+// Package mtls provides TLS configs for mutual TLS between the admin service and runtime hosts.
+// Certificates are loaded from disk and re-loaded when the files change, so they can be rotated without restarts.
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// reloadCheckInterval is the minimum interval between checks for changes to the certificate files.
+const reloadCheckInterval = 10 * time.Second
+
+// Reloader holds a certificate, its private key and a CA bundle loaded from disk.
+// It checks the files for changes at most every reloadCheckInterval when a new connection is established.
+// If a reload fails (for example because a rotation is in progress), the previously loaded files continue to be used.
+type Reloader struct {
+	certPath string
+	keyPath  string
+	caPath   string
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+	modTimes  [3]time.Time
+	checkedOn time.Time
+	now       func() time.Time
+}
+
+// NewReloader loads the certificate, key and CA bundle at the given paths.
+// The CA bundle is used to verify the peer's certificate.
+func NewReloader(certPath, keyPath, caPath string) (*Reloader, error) {
+	if certPath == "" || keyPath == "" || caPath == "" {
+		return nil, errors.New("mtls: certificate, key and CA paths must all be set")
+	}
+
+	r := &Reloader{
+		certPath: certPath,
+		keyPath:  keyPath,
+		caPath:   caPath,
+		now:      time.Now,
+	}
+
+	modTimes, err := r.statFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.load(modTimes)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// ServerConfig returns a TLS config for servers. Client certificates are verified against the CA bundle.
+func (r *Reloader) ServerConfig(clientAuth tls.ClientAuthType) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.maybeReload()
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				ClientCAs:    r.pool,
+				ClientAuth:   clientAuth,
+			}, nil
+		},
+	}
+}
+
+// ClientConfig returns a TLS config for clients. The server certificate is verified against the CA bundle.
+// Since the CA bundle is captured when ClientConfig is called, clients should call it for each new connection.
+func (r *Reloader) ClientConfig() *tls.Config {
+	r.maybeReload()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    r.pool,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			r.maybeReload()
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			return r.cert, nil
+		},
+	}
+}
+
+// maybeReload reloads the files if they have changed since they were last loaded.
+func (r *Reloader) maybeReload() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if now.Sub(r.checkedOn) < reloadCheckInterval {
+		return
+	}
+	r.checkedOn = now
+
+	modTimes, err := r.statFiles()
+	if err != nil || modTimes == r.modTimes {
+		return
+	}
+
+	// Errors are ignored to keep serving the previous certificate until the new files are valid.
+	_ = r.loadLocked(modTimes)
+}
+
+func (r *Reloader) load(modTimes [3]time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checkedOn = r.now()
+	return r.loadLocked(modTimes)
+}
+
+func (r *Reloader) loadLocked(modTimes [3]time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return fmt.Errorf("mtls: failed to load key pair: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(r.caPath)
+	if err != nil {
+		return fmt.Errorf("mtls: failed to read CA bundle: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return fmt.Errorf("mtls: no certificates found in CA bundle %q", r.caPath)
+	}
+
+	r.cert = &cert
+	r.pool = pool
+	r.modTimes = modTimes
+	return nil
+}
+
+func (r *Reloader) statFiles() ([3]time.Time, error) {
+	var res [3]time.Time
+	for i, p := range []string{r.certPath, r.keyPath, r.caPath} {
+		info, err := os.Stat(p)
+		if err != nil {
+			return res, fmt.Errorf("mtls: %w", err)
+		}
+		res[i] = info.ModTime()
+	}
+	return res, nil
+}
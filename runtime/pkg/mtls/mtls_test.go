@@ -0,0 +1,173 @@
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandshake(t *testing.T) {
+	dir := t.TempDir()
+	ca, caKey := newCA(t)
+	writeCA(t, dir, "ca.pem", ca)
+	writeLeaf(t, dir, "server", ca, caKey, 1)
+	writeLeaf(t, dir, "client", ca, caKey, 2)
+
+	srv, err := NewReloader(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server-key.pem"), filepath.Join(dir, "ca.pem"))
+	require.NoError(t, err)
+	cli, err := NewReloader(filepath.Join(dir, "client.pem"), filepath.Join(dir, "client-key.pem"), filepath.Join(dir, "ca.pem"))
+	require.NoError(t, err)
+
+	serial, err := handshake(srv.ServerConfig(tls.RequireAndVerifyClientCert), cli.ClientConfig())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), serial)
+
+	// A client without a certificate is rejected
+	noCert := cli.ClientConfig()
+	noCert.GetClientCertificate = nil
+	_, err = handshake(srv.ServerConfig(tls.RequireAndVerifyClientCert), noCert)
+	require.Error(t, err)
+
+	// A client with a certificate from another CA is rejected
+	otherDir := t.TempDir()
+	otherCA, otherKey := newCA(t)
+	writeCA(t, otherDir, "ca.pem", otherCA)
+	writeLeaf(t, otherDir, "client", otherCA, otherKey, 3)
+	other, err := NewReloader(filepath.Join(otherDir, "client.pem"), filepath.Join(otherDir, "client-key.pem"), filepath.Join(dir, "ca.pem"))
+	require.NoError(t, err)
+	_, err = handshake(srv.ServerConfig(tls.RequireAndVerifyClientCert), other.ClientConfig())
+	require.Error(t, err)
+}
+
+func TestRotation(t *testing.T) {
+	dir := t.TempDir()
+	ca, caKey := newCA(t)
+	writeCA(t, dir, "ca.pem", ca)
+	writeLeaf(t, dir, "server", ca, caKey, 1)
+	writeLeaf(t, dir, "client", ca, caKey, 2)
+
+	now := time.Now()
+	srv, err := NewReloader(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server-key.pem"), filepath.Join(dir, "ca.pem"))
+	require.NoError(t, err)
+	srv.now = func() time.Time { return now }
+	cli, err := NewReloader(filepath.Join(dir, "client.pem"), filepath.Join(dir, "client-key.pem"), filepath.Join(dir, "ca.pem"))
+	require.NoError(t, err)
+
+	// Rotate the server certificate
+	writeLeaf(t, dir, "server", ca, caKey, 10)
+	future := now.Add(time.Minute)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "server.pem"), future, future))
+
+	// Not picked up before the check interval has passed
+	serial, err := handshake(srv.ServerConfig(tls.RequireAndVerifyClientCert), cli.ClientConfig())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), serial)
+
+	// Picked up after the check interval
+	now = now.Add(reloadCheckInterval + time.Second)
+	serial, err = handshake(srv.ServerConfig(tls.RequireAndVerifyClientCert), cli.ClientConfig())
+	require.NoError(t, err)
+	require.Equal(t, int64(10), serial)
+
+	// An invalid file doesn't replace the current certificate
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "server.pem"), []byte("invalid"), 0o600))
+	future = future.Add(time.Minute)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "server.pem"), future, future))
+	now = now.Add(reloadCheckInterval + time.Second)
+	serial, err = handshake(srv.ServerConfig(tls.RequireAndVerifyClientCert), cli.ClientConfig())
+	require.NoError(t, err)
+	require.Equal(t, int64(10), serial)
+}
+
+// handshake performs a TLS handshake over a loopback connection and returns the serial number of the server's certificate.
+func handshake(serverConf, clientConf *tls.Config) (int64, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer lis.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			errCh <- err
+			return
+		}
+		defer conn.Close()
+		errCh <- tls.Server(conn, serverConf).Handshake()
+	}()
+
+	clientConf.ServerName = "localhost"
+	c, err := tls.Dial("tcp", lis.Addr().String(), clientConf)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	// With TLS 1.3, the server may reject the client certificate after the client considers the handshake complete.
+	err = <-errCh
+	if err != nil {
+		return 0, err
+	}
+	return c.ConnectionState().PeerCertificates[0].SerialNumber.Int64(), nil
+}
+
+func newCA(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(100),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func writeCA(t *testing.T, dir, name string, ca *x509.Certificate) {
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0o600))
+}
+
+func writeLeaf(t *testing.T, dir, name string, ca *x509.Certificate, caKey *ecdsa.PrivateKey, serial int64) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".pem"), certPEM, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+"-key.pem"), keyPEM, 0o600))
+}
@@ -275,6 +275,11 @@ func (rw *wrappedResponseWriter) Status() int {
 	return rw.status
 }
 
+// Unwrap returns the underlying response writer. It enables http.ResponseController to flush streaming responses.
+func (rw *wrappedResponseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 func (rw *wrappedResponseWriter) WriteHeader(code int) {
 	if rw.wroteHeader {
 		return
@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
@@ -115,6 +116,9 @@ func Start(ctx context.Context, logger *zap.Logger, opts *Options) (ShutdownFunc
 	// Set global tracer provider
 	if tracerProvider != nil {
 		otel.SetTracerProvider(tracerProvider)
+
+		// Propagate trace context in outgoing gRPC and HTTP requests, so traces span the admin and runtime services
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
 	}
 
 	// Collect metrics from the Go runtime (polls every 15s by default)
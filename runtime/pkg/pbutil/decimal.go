@@ -0,0 +1,100 @@
+package pbutil
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/marcboeker/go-duckdb"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// maxDecimalScale is the scale used to format a decimal that has no exact decimal representation and no known scale.
+// It matches the largest scale supported by BigQuery's BIGNUMERIC type.
+const maxDecimalScale = 38
+
+// DecimalString formats a decimal value scanned from a database/sql driver as an exact decimal string (e.g. "123.4500").
+// It returns false if v is not a decimal value.
+// Values of types that are not decimal-specific (like fmt.Stringer implementations) are only handled if t is a decimal type.
+func DecimalString(v any, t *runtimev1.Type) (string, bool) {
+	switch v := v.(type) {
+	case duckdb.Decimal:
+		return decimalString(v.Value, int(v.Scale)), true
+	case *duckdb.Decimal:
+		if v == nil {
+			return "", false
+		}
+		return decimalString(v.Value, int(v.Scale)), true
+	case *big.Rat:
+		if v == nil {
+			return "", false
+		}
+		return ratString(v, t), true
+	case big.Rat:
+		return ratString(&v, t), true
+	}
+
+	if t == nil || t.Code != runtimev1.Type_CODE_DECIMAL {
+		return "", false
+	}
+
+	// Decimal types from other drivers (e.g. the shopspring/decimal type returned by ClickHouse) format exactly with String().
+	if v, ok := v.(fmt.Stringer); ok {
+		return v.String(), true
+	}
+	return "", false
+}
+
+// StringifyDecimals replaces the values of decimal fields in s with exact decimal strings.
+// The row must be the map that s was converted from with ToStruct.
+// It enables clients to opt out of the lossy conversion of decimals to float64 numbers.
+func StringifyDecimals(s *structpb.Struct, row map[string]any, t *runtimev1.StructType) {
+	for _, f := range t.Fields {
+		if f.Type == nil || f.Type.Code != runtimev1.Type_CODE_DECIMAL {
+			continue
+		}
+		if str, ok := DecimalString(row[f.Name], f.Type); ok {
+			s.Fields[f.Name] = structpb.NewStringValue(str)
+		}
+	}
+}
+
+// decimalString formats an unscaled value with the given scale, i.e. value * 10^-scale.
+func decimalString(value *big.Int, scale int) string {
+	if value == nil {
+		value = new(big.Int)
+	}
+	denom := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	return new(big.Rat).SetFrac(value, denom).FloatString(scale)
+}
+
+// ratString formats a rational number as a decimal string.
+// It uses the scale of t if known, and otherwise the smallest scale that represents r exactly (up to maxDecimalScale).
+func ratString(r *big.Rat, t *runtimev1.Type) string {
+	if t != nil && t.DecimalType != nil {
+		return r.FloatString(int(t.DecimalType.Scale))
+	}
+	if r.IsInt() {
+		return r.Num().String()
+	}
+
+	// A fraction has an exact decimal representation if its denominator only has the prime factors 2 and 5.
+	// The number of digits needed is the larger of the two exponents.
+	d := new(big.Int).Set(r.Denom())
+	two, five := big.NewInt(2), big.NewInt(5)
+	var twos, fives int
+	m := new(big.Int)
+	for m.Mod(d, two).Sign() == 0 {
+		d.Quo(d, two)
+		twos++
+	}
+	for m.Mod(d, five).Sign() == 0 {
+		d.Quo(d, five)
+		fives++
+	}
+	scale := max(twos, fives)
+	if d.Cmp(big.NewInt(1)) != 0 || scale > maxDecimalScale {
+		scale = maxDecimalScale
+	}
+	return r.FloatString(scale)
+}
@@ -0,0 +1,67 @@
+package pbutil
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/marcboeker/go-duckdb"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type testDecimal string
+
+func (d testDecimal) String() string { return string(d) }
+
+func TestDecimalString(t *testing.T) {
+	decimal := &runtimev1.Type{Code: runtimev1.Type_CODE_DECIMAL}
+	decimalScale3 := &runtimev1.Type{Code: runtimev1.Type_CODE_DECIMAL, DecimalType: &runtimev1.DecimalType{Precision: 38, Scale: 3}}
+	bigValue, _ := new(big.Int).SetString("123456789012345678901234567890123456", 10)
+
+	cases := []struct {
+		Input    any
+		Type     *runtimev1.Type
+		Expected string
+		OK       bool
+	}{
+		{Input: duckdb.Decimal{Width: 10, Scale: 2, Value: big.NewInt(12345)}, Expected: "123.45", OK: true},
+		{Input: duckdb.Decimal{Width: 10, Scale: 2, Value: big.NewInt(-5)}, Expected: "-0.05", OK: true},
+		{Input: duckdb.Decimal{Width: 38, Scale: 4, Value: bigValue}, Expected: "12345678901234567890123456789012.3456", OK: true},
+		{Input: big.NewRat(1, 8), Expected: "0.125", OK: true},
+		{Input: big.NewRat(1, 8), Type: decimalScale3, Expected: "0.125", OK: true},
+		{Input: big.NewRat(10, 1), Expected: "10", OK: true},
+		{Input: big.NewRat(1, 3), Expected: "0.33333333333333333333333333333333333333", OK: true},
+		{Input: testDecimal("1.10"), Type: decimal, Expected: "1.10", OK: true},
+		{Input: testDecimal("1.10"), Type: nil, OK: false},
+		{Input: 1.5, Type: decimal, OK: false},
+	}
+	for _, tt := range cases {
+		actual, ok := DecimalString(tt.Input, tt.Type)
+		require.Equal(t, tt.OK, ok)
+		require.Equal(t, tt.Expected, actual)
+	}
+}
+
+func TestStringifyDecimals(t *testing.T) {
+	schema := &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+		{Name: "a", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_DECIMAL, DecimalType: &runtimev1.DecimalType{Precision: 38, Scale: 2}}},
+		{Name: "b", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64}},
+		{Name: "c", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_DECIMAL}},
+	}}
+	bigValue, _ := new(big.Int).SetString("1234567890123456789", 10)
+	row := map[string]any{
+		"a": duckdb.Decimal{Width: 38, Scale: 2, Value: bigValue},
+		"b": 1.5,
+		"c": nil,
+	}
+
+	s, err := ToStruct(row, schema)
+	require.NoError(t, err)
+	require.Equal(t, 12345678901234567.89, s.Fields["a"].GetNumberValue())
+
+	StringifyDecimals(s, row, schema)
+	require.Equal(t, "12345678901234567.89", s.Fields["a"].GetStringValue())
+	require.Equal(t, 1.5, s.Fields["b"].GetNumberValue())
+	require.Equal(t, structpb.NewNullValue().Kind, s.Fields["c"].Kind)
+}
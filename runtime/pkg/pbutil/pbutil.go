@@ -34,6 +34,14 @@ func ToValue(v any, t *runtimev1.Type) (*structpb.Value, error) {
 		}
 		return structpb.NewStructValue(v2), nil
 	case []any:
+		if t != nil && t.StructType != nil {
+			// Some drivers return structs as a list of values in field order (e.g. ClickHouse for unnamed tuples).
+			v2, err := ToStructPositional(v, t.StructType)
+			if err != nil {
+				return nil, err
+			}
+			return structpb.NewStructValue(v2), nil
+		}
 		v2, err := ToListValue(v, t)
 		if err != nil {
 			return nil, err
@@ -77,6 +85,9 @@ func ToValue(v any, t *runtimev1.Type) (*structpb.Value, error) {
 		// s := v.String()
 		// return structpb.NewStringValue(s), nil
 	case *big.Int:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		// Evil cast to float until frontend can deal with bigs:
 		v2, _ := new(big.Float).SetInt(v).Float64()
 		return structpb.NewNumberValue(v2), nil
@@ -121,30 +132,69 @@ func ToValue(v any, t *runtimev1.Type) (*structpb.Value, error) {
 		return structpb.NewStringValue(v.String()), nil
 	// pointers to base types
 	case *bool:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewBoolValue(*v), nil
 	case *int:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewNumberValue(float64(*v)), nil
 	case *int32:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewNumberValue(float64(*v)), nil
 	case *int64:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewNumberValue(float64(*v)), nil
 	case *uint:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewNumberValue(float64(*v)), nil
 	case *uint32:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewNumberValue(float64(*v)), nil
 	case *uint64:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewNumberValue(float64(*v)), nil
 	case *string:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return ToValue(*v, nil)
 	case *int8:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewNumberValue(float64(*v)), nil
 	case *int16:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewNumberValue(float64(*v)), nil
 	case *uint8:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewNumberValue(float64(*v)), nil
 	case *uint16:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewNumberValue(float64(*v)), nil
 	case *time.Time:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		if t != nil && t.Code == runtimev1.Type_CODE_DATE {
 			s := v.In(time.UTC).Format(time.DateOnly)
 			return structpb.NewStringValue(s), nil
@@ -152,6 +202,9 @@ func ToValue(v any, t *runtimev1.Type) (*structpb.Value, error) {
 		s := v.In(time.UTC).Format(time.RFC3339Nano)
 		return structpb.NewStringValue(s), nil
 	case *float32:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		// Turning NaNs and Infs into nulls until frontend can deal with them as strings
 		// (They don't have a native JSON representation)
 		if math.IsNaN(float64(*v)) || math.IsInf(float64(*v), 0) {
@@ -159,6 +212,9 @@ func ToValue(v any, t *runtimev1.Type) (*structpb.Value, error) {
 		}
 		return structpb.NewNumberValue(float64(*v)), nil
 	case *float64:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		// Turning NaNs and Infs into nulls until frontend can deal with them as strings
 		// (They don't have a native JSON representation)
 		if math.IsNaN(*v) || math.IsInf(*v, 0) {
@@ -166,9 +222,16 @@ func ToValue(v any, t *runtimev1.Type) (*structpb.Value, error) {
 		}
 		return structpb.NewNumberValue(*v), nil
 	case *net.IP:
+		if v == nil {
+			return structpb.NewNullValue(), nil
+		}
 		return structpb.NewStringValue(v.String()), nil
 	default:
 	}
+	// Drivers may return typed nil pointers for NULL values nested in complex types (e.g. a ClickHouse Map(String, Nullable(UInt64))).
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return structpb.NewNullValue(), nil
+	}
 	if t != nil && t.ArrayElementType != nil {
 		v2, err := ToListValueUnknown(v, t)
 		if err != nil {
@@ -183,6 +246,15 @@ func ToValue(v any, t *runtimev1.Type) (*structpb.Value, error) {
 		}
 		return structpb.NewStructValue(v2), nil
 	}
+	if s, ok := DecimalString(v, t); ok {
+		// Evil cast to float until frontend can deal with decimals (clients can opt in to exact values with StringifyDecimals):
+		v2, _, err := big.ParseFloat(s, 10, 53, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		f, _ := v2.Float64()
+		return structpb.NewNumberValue(f), nil
+	}
 	// Default handling for basic types (ints, string, etc.)
 	return structpb.NewValue(v)
 }
@@ -212,6 +284,23 @@ func ToStruct(v map[string]any, t *runtimev1.StructType) (*structpb.Struct, erro
 	return x, nil
 }
 
+// ToStructPositional converts a list of field values to a google.protobuf.Struct.
+// The values must be in the same order as the fields of t.
+func ToStructPositional(v []any, t *runtimev1.StructType) (*structpb.Struct, error) {
+	if len(v) != len(t.Fields) {
+		return nil, fmt.Errorf("received %d values for struct with %d fields", len(v), len(t.Fields))
+	}
+	x := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(v))}
+	for i, f := range t.Fields {
+		var err error
+		x.Fields[f.Name], err = ToValue(v[i], f.Type)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return x, nil
+}
+
 // ToStructCoerceKeys converts a map with non-string keys to a google.protobuf.Struct.
 // It attempts to coerce the keys to JSON strings. Providing t as a type hint is optional.
 func ToStructCoerceKeys(v map[any]any, t *runtimev1.MapType) (*structpb.Struct, error) {
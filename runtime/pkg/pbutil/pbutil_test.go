@@ -78,6 +78,51 @@ func Test_ToListValueUnknown(t *testing.T) {
 	}
 }
 
+func TestToValueNested(t *testing.T) {
+	structType := &runtimev1.Type{Code: runtimev1.Type_CODE_STRUCT, StructType: &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+		{Name: "min", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_UINT64}},
+		{Name: "counts", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_MAP, MapType: &runtimev1.MapType{
+			KeyType:   &runtimev1.Type{Code: runtimev1.Type_CODE_INT32},
+			ValueType: &runtimev1.Type{Code: runtimev1.Type_CODE_UINT64, Nullable: true},
+		}}},
+	}}}
+	var null *uint64
+	one := uint64(1)
+
+	cases := []struct {
+		Input    any
+		Type     *runtimev1.Type
+		Expected any
+	}{
+		// Struct as a map with a nested map that has non-string keys and null values
+		{
+			Input:    map[string]any{"min": uint64(2), "counts": map[int32]*uint64{1: &one, 2: null}},
+			Type:     structType,
+			Expected: map[string]any{"min": 2, "counts": map[string]any{"1": 1, "2": nil}},
+		},
+		// Struct as a list of values in field order
+		{
+			Input:    []any{uint64(2), map[int32]*uint64{3: &one}},
+			Type:     structType,
+			Expected: map[string]any{"min": 2, "counts": map[string]any{"3": 1}},
+		},
+		// Typed nil pointers
+		{Input: null, Type: nil, Expected: nil},
+		{Input: (*[]int)(nil), Type: &runtimev1.Type{Code: runtimev1.Type_CODE_ARRAY, ArrayElementType: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}}, Expected: nil},
+	}
+	for i, tt := range cases {
+		expected, err := structpb.NewValue(tt.Expected)
+		require.NoError(t, err)
+
+		actual, err := ToValue(tt.Input, tt.Type)
+		require.NoError(t, err, "case %d", i)
+		require.True(t, proto.Equal(expected, actual), "case %d, expected: %v, actual: %v", i, expected, actual)
+	}
+
+	_, err := ToValue([]any{uint64(2)}, structType)
+	require.Error(t, err)
+}
+
 func structpbList(v []interface{}) *structpb.ListValue {
 	list, err := structpb.NewList(v)
 	if err != nil {
@@ -0,0 +1,70 @@
+// Package querycost tracks the cost of the OLAP queries executed while serving a request.
+// A Tracker is attached to the request context, and OLAP drivers report the queries they execute to it.
+package querycost
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Stats summarizes the cost of one or more queries.
+type Stats struct {
+	Queries       int64
+	ExecutionTime time.Duration
+	ScannedBytes  int64
+}
+
+// Add returns the sum of s and other.
+func (s Stats) Add(other Stats) Stats {
+	return Stats{
+		Queries:       s.Queries + other.Queries,
+		ExecutionTime: s.ExecutionTime + other.ExecutionTime,
+		ScannedBytes:  s.ScannedBytes + other.ScannedBytes,
+	}
+}
+
+// Tracker accumulates Stats for queries. It is safe for concurrent use.
+type Tracker struct {
+	mu    sync.Mutex
+	stats Stats
+}
+
+// Stats returns the stats accumulated so far.
+func (t *Tracker) Stats() Stats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stats
+}
+
+type trackerContextKey struct{}
+
+// WithTracker returns a copy of ctx with a new Tracker attached.
+func WithTracker(ctx context.Context) (context.Context, *Tracker) {
+	t := &Tracker{}
+	return context.WithValue(ctx, trackerContextKey{}, t), t
+}
+
+// RecordQuery records the execution of a query. It is a no-op if ctx doesn't have a Tracker.
+func RecordQuery(ctx context.Context, executionTime time.Duration) {
+	t, ok := ctx.Value(trackerContextKey{}).(*Tracker)
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	t.stats.Queries++
+	t.stats.ExecutionTime += executionTime
+	t.mu.Unlock()
+}
+
+// AddScannedBytes records bytes scanned by a query. It is a no-op if ctx doesn't have a Tracker.
+// Drivers that can't report scanned bytes don't call it.
+func AddScannedBytes(ctx context.Context, n int64) {
+	t, ok := ctx.Value(trackerContextKey{}).(*Tracker)
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	t.stats.ScannedBytes += n
+	t.mu.Unlock()
+}
@@ -0,0 +1,32 @@
+package querycost
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	// No-op without a tracker
+	RecordQuery(context.Background(), time.Second)
+	AddScannedBytes(context.Background(), 100)
+
+	ctx, tracker := WithTracker(context.Background())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			RecordQuery(ctx, time.Millisecond)
+			AddScannedBytes(ctx, 10)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, Stats{Queries: 10, ExecutionTime: 10 * time.Millisecond, ScannedBytes: 100}, tracker.Stats())
+	require.Equal(t, Stats{Queries: 20, ExecutionTime: 20 * time.Millisecond, ScannedBytes: 200}, tracker.Stats().Add(tracker.Stats()))
+}
@@ -0,0 +1,127 @@
+// Package queryerr provides a typed error model for runtime queries.
+// Errors are tagged with a machine-readable reason, which is returned to clients as an ErrorInfo detail on the gRPC status.
+package queryerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain is the ErrorInfo domain of errors returned by the runtime.
+const Domain = "rilldata.com"
+
+// Reason is a machine-readable reason code for a query error.
+type Reason string
+
+const (
+	ReasonQueryTimeout         Reason = "QUERY_TIMEOUT"
+	ReasonQueryCanceled        Reason = "QUERY_CANCELED"
+	ReasonInvalidQuery         Reason = "INVALID_QUERY"
+	ReasonMetricsViewNotFound  Reason = "METRICS_VIEW_NOT_FOUND"
+	ReasonMetricsViewInvalid   Reason = "METRICS_VIEW_INVALID"
+	ReasonMeasureNotFound      Reason = "MEASURE_NOT_FOUND"
+	ReasonDimensionNotFound    Reason = "DIMENSION_NOT_FOUND"
+	ReasonSecurityDenied       Reason = "SECURITY_DENIED"
+	ReasonMemoryLimitExceeded  Reason = "MEMORY_LIMIT_EXCEEDED"
+	ReasonOLAPError            Reason = "OLAP_ERROR"
+	ReasonUnsupportedConnector Reason = "UNSUPPORTED_CONNECTOR"
+	ReasonBytesLimitExceeded   Reason = "BYTES_LIMIT_EXCEEDED"
+)
+
+// Code returns the gRPC status code for the reason.
+func (r Reason) Code() codes.Code {
+	switch r {
+	case ReasonQueryTimeout:
+		return codes.DeadlineExceeded
+	case ReasonQueryCanceled:
+		return codes.Canceled
+	case ReasonInvalidQuery, ReasonMetricsViewNotFound, ReasonMetricsViewInvalid, ReasonMeasureNotFound, ReasonDimensionNotFound:
+		return codes.InvalidArgument
+	case ReasonSecurityDenied:
+		// Security denials have always been returned as Unauthenticated, and clients depend on it.
+		return codes.Unauthenticated
+	case ReasonMemoryLimitExceeded, ReasonBytesLimitExceeded:
+		return codes.ResourceExhausted
+	case ReasonUnsupportedConnector:
+		return codes.Unimplemented
+	default:
+		return codes.Unknown
+	}
+}
+
+// Error is an error tagged with a Reason.
+type Error struct {
+	Reason Reason
+	Err    error
+}
+
+// New returns an error with the given reason and message.
+func New(reason Reason, msg string) error {
+	return &Error{Reason: reason, Err: errors.New(msg)}
+}
+
+// Errorf returns an error with the given reason and a formatted message. It supports %w.
+func Errorf(reason Reason, format string, args ...any) error {
+	return &Error{Reason: reason, Err: fmt.Errorf(format, args...)}
+}
+
+// Wrap tags err with the reason. It returns nil if err is nil, and err unchanged if it already has a reason (including context errors).
+func Wrap(reason Reason, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := ReasonOf(err); ok {
+		return err
+	}
+	return &Error{Reason: reason, Err: err}
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus implements the interface used by the grpc/status package to convert errors to statuses.
+func (e *Error) GRPCStatus() *status.Status {
+	return Status(e)
+}
+
+// ReasonOf returns the reason of err. Untagged context errors are classified as timeouts and cancellations.
+// It returns false if the error doesn't have a known reason.
+func ReasonOf(err error) (Reason, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Reason, true
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ReasonQueryTimeout, true
+	}
+	if errors.Is(err, context.Canceled) {
+		return ReasonQueryCanceled, true
+	}
+	return "", false
+}
+
+// Status converts err to a gRPC status with an ErrorInfo detail carrying its reason.
+// The status message is the full error message. If err doesn't have a known reason, it returns nil.
+func Status(err error) *status.Status {
+	reason, ok := ReasonOf(err)
+	if !ok {
+		return nil
+	}
+
+	s := status.New(reason.Code(), err.Error())
+	sd, derr := s.WithDetails(&errdetails.ErrorInfo{Reason: string(reason), Domain: Domain})
+	if derr != nil {
+		return s
+	}
+	return sd
+}
@@ -0,0 +1,71 @@
+package queryerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReasonOf(t *testing.T) {
+	tests := []struct {
+		err    error
+		reason Reason
+		ok     bool
+	}{
+		{Errorf(ReasonMeasureNotFound, "measure %q not found", "foo"), ReasonMeasureNotFound, true},
+		{fmt.Errorf("wrapped: %w", New(ReasonSecurityDenied, "action not allowed")), ReasonSecurityDenied, true},
+		{fmt.Errorf("query failed: %w", context.DeadlineExceeded), ReasonQueryTimeout, true},
+		{context.Canceled, ReasonQueryCanceled, true},
+		{errors.New("boom"), "", false},
+		{nil, "", false},
+	}
+	for _, tt := range tests {
+		reason, ok := ReasonOf(tt.err)
+		require.Equal(t, tt.ok, ok, tt.err)
+		require.Equal(t, tt.reason, reason, tt.err)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	require.Nil(t, Wrap(ReasonOLAPError, nil))
+
+	err := Wrap(ReasonOLAPError, errors.New("syntax error"))
+	reason, _ := ReasonOf(err)
+	require.Equal(t, ReasonOLAPError, reason)
+	require.Equal(t, "syntax error", err.Error())
+
+	// Errors that already have a reason are not re-tagged
+	err = Wrap(ReasonOLAPError, New(ReasonMemoryLimitExceeded, "oom"))
+	reason, _ = ReasonOf(err)
+	require.Equal(t, ReasonMemoryLimitExceeded, reason)
+
+	err = Wrap(ReasonOLAPError, context.DeadlineExceeded)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	reason, _ = ReasonOf(err)
+	require.Equal(t, ReasonQueryTimeout, reason)
+}
+
+func TestStatus(t *testing.T) {
+	require.Nil(t, Status(errors.New("boom")))
+
+	err := fmt.Errorf("resolve failed: %w", Errorf(ReasonDimensionNotFound, "dimension %q not found", "foo"))
+	s := Status(err)
+	require.Equal(t, codes.InvalidArgument, s.Code())
+	require.Equal(t, `resolve failed: dimension "foo" not found`, s.Message())
+	require.Len(t, s.Details(), 1)
+	info, ok := s.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, string(ReasonDimensionNotFound), info.Reason)
+	require.Equal(t, Domain, info.Domain)
+
+	// The status package picks up the reason from the error itself
+	s, ok = status.FromError(New(ReasonBytesLimitExceeded, "too many bytes"))
+	require.True(t, ok)
+	require.Equal(t, codes.ResourceExhausted, s.Code())
+}
@@ -0,0 +1,376 @@
+// Package resultcache implements a byte-budgeted LRU cache for query results.
+//
+// Entries belong to an instance and are evicted in least recently used order when either the total memory budget or the instance's memory budget is exceeded.
+// Entries can be tagged (e.g. with the resources they depend on), which enables purging all entries that depend on a resource.
+// Large entries that provide a Codec can be stored on disk instead of in memory, which lets big results be cached without exhausting the memory budget.
+package resultcache
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Options configure a Cache.
+type Options struct {
+	// MaxBytes is the total memory budget for entries stored in memory.
+	MaxBytes int64
+	// MaxBytesPerInstance is the memory budget for each instance. It is not enforced if zero.
+	MaxBytesPerInstance int64
+	// DiskDir is a directory that large entries are stored in. Storing entries on disk is disabled if it is empty.
+	// The cache assumes exclusive ownership of the directory and clears it when created and closed.
+	DiskDir string
+	// DiskThresholdBytes is the size at which entries that provide a Codec are stored on disk instead of in memory.
+	DiskThresholdBytes int64
+	// MaxDiskBytes is the total budget for entries stored on disk.
+	MaxDiskBytes int64
+}
+
+// Codec serializes values so they can be stored on disk.
+type Codec interface {
+	Encode(v any) ([]byte, error)
+	Decode(data []byte) (any, error)
+}
+
+// Entry is a value to add to the cache.
+type Entry struct {
+	InstanceID string
+	Key        string
+	// Tags are used to purge the entry with PurgeTag.
+	Tags []string
+	// Value is the cached value. It is returned as-is for entries stored in memory.
+	Value any
+	// Size is the estimated size of the value in bytes.
+	Size int64
+	// Codec is optional. If set, the entry is stored on disk if it exceeds Options.DiskThresholdBytes.
+	Codec Codec
+}
+
+// Stats contains usage stats for the cache or an instance.
+type Stats struct {
+	Entries     int64
+	Bytes       int64
+	DiskEntries int64
+	DiskBytes   int64
+	Hits        int64
+	Misses      int64
+	Evictions   int64
+}
+
+// Cache is a byte-budgeted LRU cache. It is safe for concurrent use.
+type Cache struct {
+	opts      Options
+	mu        sync.Mutex
+	entries   map[string]*entry
+	memLRU    *list.List // Entries stored in memory; the front is the most recently used
+	diskLRU   *list.List // Entries stored on disk; the front is the most recently used
+	instances map[string]*instance
+	stats     Stats
+}
+
+type instance struct {
+	entries map[string]*entry
+	memLRU  *list.List
+	stats   Stats
+}
+
+type entry struct {
+	instanceID string
+	key        string
+	tags       []string
+	value      any
+	size       int64
+	path       string // Set if the entry is stored on disk
+	codec      Codec
+	elem       *list.Element // Element in Cache.memLRU or Cache.diskLRU
+	instElem   *list.Element // Element in instance.memLRU (nil for entries on disk)
+}
+
+// New creates a new Cache.
+func New(opts Options) (*Cache, error) {
+	if opts.MaxBytes <= 0 {
+		return nil, fmt.Errorf("resultcache: invalid memory budget %d", opts.MaxBytes)
+	}
+	if opts.DiskDir != "" {
+		if err := resetDir(opts.DiskDir); err != nil {
+			return nil, fmt.Errorf("resultcache: failed to prepare disk directory: %w", err)
+		}
+	}
+	return &Cache{
+		opts:      opts,
+		entries:   make(map[string]*entry),
+		memLRU:    list.New(),
+		diskLRU:   list.New(),
+		instances: make(map[string]*instance),
+	}, nil
+}
+
+// Get returns the value for a key.
+func (c *Cache) Get(instanceID, key string) (any, bool) {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if !ok || e.instanceID != instanceID {
+		c.recordMiss(instanceID)
+		c.mu.Unlock()
+		return nil, false
+	}
+	c.touch(e)
+	if e.path == "" {
+		c.recordHit(instanceID)
+		c.mu.Unlock()
+		return e.value, true
+	}
+	path, codec := e.path, e.codec
+	c.mu.Unlock()
+
+	// Read entries stored on disk without holding the lock.
+	// If the entry is evicted concurrently, the read fails and is treated as a miss.
+	v, err := readFile(path, codec)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		if e2, ok := c.entries[key]; ok && e2 == e {
+			c.remove(e)
+		}
+		c.recordMiss(instanceID)
+		return nil, false
+	}
+	c.recordHit(instanceID)
+	return v, true
+}
+
+// Set adds an entry to the cache, replacing any existing entry with the same key.
+// Entries that exceed the memory budget (and can't be stored on disk) are not cached.
+func (c *Cache) Set(ent Entry) {
+	// Store large entries on disk if possible. The file is written before taking the lock.
+	var path string
+	size := ent.Size
+	if c.opts.DiskDir != "" && ent.Codec != nil && ent.Size >= c.opts.DiskThresholdBytes && ent.Size <= c.opts.MaxDiskBytes {
+		p, n, err := writeFile(c.opts.DiskDir, ent.Value, ent.Codec)
+		if err == nil && n <= c.opts.MaxDiskBytes {
+			path, size = p, n
+		} else if err == nil {
+			_ = os.Remove(p)
+		}
+	}
+
+	if path == "" && (size > c.opts.MaxBytes || (c.opts.MaxBytesPerInstance > 0 && size > c.opts.MaxBytesPerInstance)) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if old, ok := c.entries[ent.Key]; ok {
+		c.remove(old)
+	}
+
+	e := &entry{
+		instanceID: ent.InstanceID,
+		key:        ent.Key,
+		tags:       ent.Tags,
+		size:       size,
+		path:       path,
+		codec:      ent.Codec,
+	}
+	inst := c.instance(ent.InstanceID)
+	c.entries[e.key] = e
+	inst.entries[e.key] = e
+
+	if path != "" {
+		e.elem = c.diskLRU.PushFront(e)
+		c.stats.DiskEntries++
+		c.stats.DiskBytes += size
+		inst.stats.DiskEntries++
+		inst.stats.DiskBytes += size
+		for c.stats.DiskBytes > c.opts.MaxDiskBytes {
+			c.evict(c.diskLRU.Back().Value.(*entry))
+		}
+		return
+	}
+
+	e.value = ent.Value
+	e.elem = c.memLRU.PushFront(e)
+	e.instElem = inst.memLRU.PushFront(e)
+	c.stats.Entries++
+	c.stats.Bytes += size
+	inst.stats.Entries++
+	inst.stats.Bytes += size
+	if c.opts.MaxBytesPerInstance > 0 {
+		for inst.stats.Bytes > c.opts.MaxBytesPerInstance {
+			c.evict(inst.memLRU.Back().Value.(*entry))
+		}
+	}
+	for c.stats.Bytes > c.opts.MaxBytes {
+		c.evict(c.memLRU.Back().Value.(*entry))
+	}
+}
+
+// PurgeInstance removes all entries for an instance and resets its stats. It returns the number of entries removed.
+func (c *Cache) PurgeInstance(instanceID string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	inst, ok := c.instances[instanceID]
+	if !ok {
+		return 0
+	}
+	n := len(inst.entries)
+	for _, e := range inst.entries {
+		c.remove(e)
+	}
+	delete(c.instances, instanceID)
+	return n
+}
+
+// PurgeTag removes the instance's entries that have the tag. It returns the number of entries removed.
+func (c *Cache) PurgeTag(instanceID, tag string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	inst, ok := c.instances[instanceID]
+	if !ok {
+		return 0
+	}
+	n := 0
+	for _, e := range inst.entries {
+		for _, t := range e.tags {
+			if t == tag {
+				c.remove(e)
+				n++
+				break
+			}
+		}
+	}
+	return n
+}
+
+// Stats returns stats for the whole cache.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// InstanceStats returns stats for an instance.
+func (c *Cache) InstanceStats(instanceID string) Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if inst, ok := c.instances[instanceID]; ok {
+		return inst.stats
+	}
+	return Stats{}
+}
+
+// Close removes all entries, including entries stored on disk.
+func (c *Cache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*entry)
+	c.memLRU.Init()
+	c.diskLRU.Init()
+	c.instances = make(map[string]*instance)
+	if c.opts.DiskDir != "" {
+		return os.RemoveAll(c.opts.DiskDir)
+	}
+	return nil
+}
+
+func (c *Cache) instance(instanceID string) *instance {
+	inst, ok := c.instances[instanceID]
+	if !ok {
+		inst = &instance{
+			entries: make(map[string]*entry),
+			memLRU:  list.New(),
+		}
+		c.instances[instanceID] = inst
+	}
+	return inst
+}
+
+func (c *Cache) touch(e *entry) {
+	if e.path != "" {
+		c.diskLRU.MoveToFront(e.elem)
+		return
+	}
+	c.memLRU.MoveToFront(e.elem)
+	c.instances[e.instanceID].memLRU.MoveToFront(e.instElem)
+}
+
+func (c *Cache) evict(e *entry) {
+	c.remove(e)
+	c.stats.Evictions++
+	if inst, ok := c.instances[e.instanceID]; ok {
+		inst.stats.Evictions++
+	}
+}
+
+func (c *Cache) remove(e *entry) {
+	delete(c.entries, e.key)
+	inst := c.instances[e.instanceID]
+	delete(inst.entries, e.key)
+
+	if e.path != "" {
+		c.diskLRU.Remove(e.elem)
+		c.stats.DiskEntries--
+		c.stats.DiskBytes -= e.size
+		inst.stats.DiskEntries--
+		inst.stats.DiskBytes -= e.size
+		_ = os.Remove(e.path)
+		return
+	}
+
+	c.memLRU.Remove(e.elem)
+	inst.memLRU.Remove(e.instElem)
+	c.stats.Entries--
+	c.stats.Bytes -= e.size
+	inst.stats.Entries--
+	inst.stats.Bytes -= e.size
+}
+
+func (c *Cache) recordHit(instanceID string) {
+	c.stats.Hits++
+	c.instance(instanceID).stats.Hits++
+}
+
+func (c *Cache) recordMiss(instanceID string) {
+	c.stats.Misses++
+	c.instance(instanceID).stats.Misses++
+}
+
+func resetDir(dir string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	return os.MkdirAll(dir, os.ModePerm)
+}
+
+func writeFile(dir string, v any, codec Codec) (string, int64, error) {
+	data, err := codec.Encode(v)
+	if err != nil {
+		return "", 0, err
+	}
+	f, err := os.CreateTemp(dir, "entry-*")
+	if err != nil {
+		return "", 0, err
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", 0, err
+	}
+	return f.Name(), int64(len(data)), nil
+}
+
+func readFile(path string, codec Codec) (any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return codec.Decode(data)
+}
@@ -0,0 +1,125 @@
+package resultcache
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRU(t *testing.T) {
+	c, err := New(Options{MaxBytes: 100})
+	require.NoError(t, err)
+
+	c.Set(Entry{InstanceID: "i1", Key: "a", Value: "a", Size: 40})
+	c.Set(Entry{InstanceID: "i1", Key: "b", Value: "b", Size: 40})
+
+	// Touch "a", so "b" is evicted when "c" is added
+	_, ok := c.Get("i1", "a")
+	require.True(t, ok)
+	c.Set(Entry{InstanceID: "i2", Key: "c", Value: "c", Size: 40})
+
+	_, ok = c.Get("i1", "b")
+	require.False(t, ok)
+	v, ok := c.Get("i1", "a")
+	require.True(t, ok)
+	require.Equal(t, "a", v)
+
+	require.Equal(t, Stats{Entries: 2, Bytes: 80, Hits: 2, Misses: 1, Evictions: 1}, c.Stats())
+	require.Equal(t, Stats{Entries: 1, Bytes: 40, Hits: 2, Misses: 1, Evictions: 1}, c.InstanceStats("i1"))
+	require.Equal(t, Stats{Entries: 1, Bytes: 40}, c.InstanceStats("i2"))
+
+	// Entries larger than the budget are not cached
+	c.Set(Entry{InstanceID: "i1", Key: "d", Value: "d", Size: 101})
+	_, ok = c.Get("i1", "d")
+	require.False(t, ok)
+	require.Equal(t, int64(80), c.Stats().Bytes)
+
+	// Replacing an entry updates its size
+	c.Set(Entry{InstanceID: "i1", Key: "a", Value: "a2", Size: 10})
+	require.Equal(t, int64(50), c.Stats().Bytes)
+}
+
+func TestInstanceBudget(t *testing.T) {
+	c, err := New(Options{MaxBytes: 100, MaxBytesPerInstance: 50})
+	require.NoError(t, err)
+
+	c.Set(Entry{InstanceID: "i1", Key: "a", Value: "a", Size: 30})
+	c.Set(Entry{InstanceID: "i2", Key: "b", Value: "b", Size: 30})
+	c.Set(Entry{InstanceID: "i1", Key: "c", Value: "c", Size: 30})
+
+	// Only i1 exceeded its budget, so only its oldest entry is evicted
+	_, ok := c.Get("i1", "a")
+	require.False(t, ok)
+	_, ok = c.Get("i2", "b")
+	require.True(t, ok)
+	_, ok = c.Get("i1", "c")
+	require.True(t, ok)
+	require.Equal(t, int64(1), c.InstanceStats("i1").Evictions)
+	require.Equal(t, int64(0), c.InstanceStats("i2").Evictions)
+}
+
+func TestPurge(t *testing.T) {
+	c, err := New(Options{MaxBytes: 100})
+	require.NoError(t, err)
+
+	c.Set(Entry{InstanceID: "i1", Key: "a", Tags: []string{"mv1"}, Value: "a", Size: 10})
+	c.Set(Entry{InstanceID: "i1", Key: "b", Tags: []string{"mv1", "mv2"}, Value: "b", Size: 10})
+	c.Set(Entry{InstanceID: "i1", Key: "c", Tags: []string{"mv2"}, Value: "c", Size: 10})
+	c.Set(Entry{InstanceID: "i2", Key: "d", Tags: []string{"mv1"}, Value: "d", Size: 10})
+
+	require.Equal(t, 2, c.PurgeTag("i1", "mv1"))
+	_, ok := c.Get("i1", "c")
+	require.True(t, ok)
+	_, ok = c.Get("i2", "d")
+	require.True(t, ok)
+
+	require.Equal(t, 1, c.PurgeInstance("i1"))
+	require.Equal(t, Stats{}, c.InstanceStats("i1"))
+	require.Equal(t, int64(1), c.Stats().Entries)
+	require.Equal(t, int64(0), c.Stats().Evictions)
+}
+
+type bytesCodec struct{}
+
+func (bytesCodec) Encode(v any) ([]byte, error) {
+	return v.([]byte), nil
+}
+
+func (bytesCodec) Decode(data []byte) (any, error) {
+	return data, nil
+}
+
+func TestDisk(t *testing.T) {
+	dir := t.TempDir()
+	c, err := New(Options{MaxBytes: 10, DiskDir: dir, DiskThresholdBytes: 5, MaxDiskBytes: 20})
+	require.NoError(t, err)
+
+	// Large entries with a codec are stored on disk
+	c.Set(Entry{InstanceID: "i1", Key: "a", Value: []byte("aaaaaaaaaa"), Size: 10, Codec: bytesCodec{}})
+	c.Set(Entry{InstanceID: "i1", Key: "b", Value: []byte("bbbbbbbbbb"), Size: 10, Codec: bytesCodec{}})
+	c.Set(Entry{InstanceID: "i1", Key: "c", Value: []byte("cc"), Size: 2, Codec: bytesCodec{}})
+	require.Equal(t, Stats{Entries: 1, Bytes: 2, DiskEntries: 2, DiskBytes: 20}, c.Stats())
+
+	v, ok := c.Get("i1", "a")
+	require.True(t, ok)
+	require.Equal(t, []byte("aaaaaaaaaa"), v)
+
+	// Exceeding the disk budget evicts the least recently used entry on disk
+	c.Set(Entry{InstanceID: "i1", Key: "d", Value: []byte("dddddddddd"), Size: 10, Codec: bytesCodec{}})
+	_, ok = c.Get("i1", "b")
+	require.False(t, ok)
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	// Large entries without a codec must fit in memory
+	c.Set(Entry{InstanceID: "i1", Key: "e", Value: "eeeeeeeeeee", Size: 11})
+	_, ok = c.Get("i1", "e")
+	require.False(t, ok)
+
+	require.Equal(t, 3, c.PurgeInstance("i1"))
+	require.NoError(t, c.Close())
+	_, err = os.Stat(dir)
+	require.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,144 @@
+// Package runningquery keeps track of the OLAP queries that are currently executing, so they can be listed and cancelled.
+package runningquery
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/runtime/pkg/queryerr"
+)
+
+// ErrCancelled is the cancellation cause of queries cancelled with Registry.Cancel.
+var ErrCancelled = queryerr.New(queryerr.ReasonQueryCanceled, "query was cancelled by an administrator")
+
+// Source describes the request that queries are executed on behalf of.
+type Source struct {
+	InstanceID string
+	// Type identifies the kind of request, e.g. the name of the API that issued the query.
+	Type string
+	// Principal identifies the user or service that made the request.
+	Principal string
+}
+
+// Query is a running query.
+type Query struct {
+	Source
+	ID        string
+	SQL       string
+	StartedOn time.Time
+	cancel    context.CancelCauseFunc
+}
+
+// Cancel cancels the query's context with the given cause.
+func (q *Query) Cancel(cause error) {
+	q.cancel(cause)
+}
+
+// Registry tracks running queries. It is safe for concurrent use.
+type Registry struct {
+	mu      sync.Mutex
+	queries map[string]*Query
+}
+
+// New creates an empty Registry.
+func New() *Registry {
+	return &Registry{queries: make(map[string]*Query)}
+}
+
+// List returns the queries currently running for an instance, ordered by start time.
+func (r *Registry) List(instanceID string) []*Query {
+	r.mu.Lock()
+	var res []*Query
+	for _, q := range r.queries {
+		if q.InstanceID == instanceID {
+			res = append(res, q)
+		}
+	}
+	r.mu.Unlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].StartedOn.Equal(res[j].StartedOn) {
+			return res[i].StartedOn.Before(res[j].StartedOn)
+		}
+		return res[i].ID < res[j].ID
+	})
+	return res
+}
+
+// Cancel cancels a running query with ErrCancelled. It returns false if the instance doesn't have a running query with the ID.
+func (r *Registry) Cancel(instanceID, id string) bool {
+	r.mu.Lock()
+	q, ok := r.queries[id]
+	r.mu.Unlock()
+	if !ok || q.InstanceID != instanceID {
+		return false
+	}
+	q.Cancel(ErrCancelled)
+	return true
+}
+
+func (r *Registry) add(q *Query) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queries[q.ID] = q
+}
+
+func (r *Registry) remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.queries, id)
+}
+
+type registryContextKey struct{}
+
+type registryContextValue struct {
+	registry *Registry
+	source   Source
+}
+
+// WithRegistry returns a copy of ctx that tracks queries executed on behalf of the source in the registry.
+func WithRegistry(ctx context.Context, r *Registry, src Source) context.Context {
+	return context.WithValue(ctx, registryContextKey{}, registryContextValue{registry: r, source: src})
+}
+
+// Start marks the start of a query. It's called by OLAP drivers before executing a query.
+// It returns a context that is cancelled if the query is cancelled, and a function that must be called when the query has finished.
+// If ctx doesn't have a registry (see WithRegistry), the query isn't listed anywhere, but it can still be cancelled through the returned Query.
+func Start(ctx context.Context, sql string) (context.Context, *Query, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	q := &Query{
+		ID:        uuid.NewString(),
+		SQL:       sql,
+		StartedOn: time.Now(),
+		cancel:    cancel,
+	}
+
+	v, ok := ctx.Value(registryContextKey{}).(registryContextValue)
+	if !ok {
+		return ctx, q, func() { cancel(nil) }
+	}
+
+	q.Source = v.source
+	v.registry.add(q)
+	return ctx, q, func() {
+		v.registry.remove(q.ID)
+		cancel(nil)
+	}
+}
+
+// Err returns the cause passed to Query.Cancel if the query was cancelled that way, and err unchanged otherwise.
+// It's used by OLAP drivers to surface a meaningful error instead of context.Canceled for cancelled queries.
+func Err(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	cause := context.Cause(ctx)
+	if cause == nil || errors.Is(cause, context.Canceled) || errors.Is(cause, context.DeadlineExceeded) {
+		return err
+	}
+	return cause
+}
@@ -0,0 +1,61 @@
+package runningquery
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	r := New()
+
+	ctx1, q1, done1 := Start(WithRegistry(context.Background(), r, Source{InstanceID: "i1", Type: "MetricsViewAggregation", Principal: "u1"}), "SELECT 1")
+	_, q2, done2 := Start(WithRegistry(context.Background(), r, Source{InstanceID: "i1"}), "SELECT 2")
+	_, _, done3 := Start(WithRegistry(context.Background(), r, Source{InstanceID: "i2"}), "SELECT 3")
+	defer done2()
+	defer done3()
+
+	list := r.List("i1")
+	require.Len(t, list, 2)
+	require.Equal(t, q1.ID, list[0].ID)
+	require.Equal(t, "SELECT 1", list[0].SQL)
+	require.Equal(t, "MetricsViewAggregation", list[0].Type)
+	require.Equal(t, "u1", list[0].Principal)
+	require.Equal(t, q2.ID, list[1].ID)
+	require.Len(t, r.List("i2"), 1)
+
+	// Can't cancel another instance's query
+	require.False(t, r.Cancel("i2", q1.ID))
+	require.NoError(t, ctx1.Err())
+
+	require.True(t, r.Cancel("i1", q1.ID))
+	require.ErrorIs(t, ctx1.Err(), context.Canceled)
+	require.ErrorIs(t, Err(ctx1, ctx1.Err()), ErrCancelled)
+
+	done1()
+	require.Len(t, r.List("i1"), 1)
+	require.False(t, r.Cancel("i1", q1.ID))
+}
+
+func TestStartWithoutRegistry(t *testing.T) {
+	ctx, q, done := Start(context.Background(), "SELECT 1")
+	defer done()
+
+	cause := errors.New("custom cause")
+	q.Cancel(cause)
+	require.ErrorIs(t, ctx.Err(), context.Canceled)
+	require.Equal(t, cause, Err(ctx, ctx.Err()))
+}
+
+func TestErr(t *testing.T) {
+	err := errors.New("query failed")
+	ctx, _, done := Start(context.Background(), "SELECT 1")
+	require.Equal(t, err, Err(ctx, err))
+
+	// Finishing the query doesn't turn errors into cancellations
+	done()
+	require.Equal(t, err, Err(ctx, err))
+	require.NoError(t, Err(ctx, nil))
+}
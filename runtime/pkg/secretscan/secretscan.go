@@ -0,0 +1,89 @@
+// Package secretscan detects credentials committed to project files.
+// It is used to refuse deploys of projects that contain secrets that should have been passed as variables instead.
+package secretscan
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Finding is a possible credential found in a file.
+type Finding struct {
+	// Line is the 1-indexed line number of the finding.
+	Line int
+	// Rule is the ID of the rule that matched.
+	Rule string
+	// Description is a human-readable description of the finding. It does not include the matched secret.
+	Description string
+}
+
+type rule struct {
+	id          string
+	description string
+	re          *regexp.Regexp
+	// group is the index of the regex group that captures the secret. If 0, the full match is used.
+	group int
+	// allow optionally filters out matches that are not secrets (e.g. templated values).
+	allow func(secret string) bool
+}
+
+var rules = []rule{
+	{
+		id:          "aws_access_key_id",
+		description: "AWS access key ID",
+		re:          regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+	},
+	{
+		id:          "aws_secret_access_key",
+		description: "AWS secret access key",
+		re:          regexp.MustCompile(`(?i)aws_?secret_?(?:access_?)?key["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})\b`),
+		group:       1,
+	},
+	{
+		id:          "dsn_password",
+		description: "password in connection string",
+		re:          regexp.MustCompile(`\b[a-zA-Z][a-zA-Z0-9+.\-]*://[^\s:/@'"]+:([^\s@/'"]+)@`),
+		group:       1,
+		allow:       isPlaceholder,
+	},
+	{
+		id:          "private_key",
+		description: "private key",
+		re:          regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )*PRIVATE KEY-----`),
+	},
+}
+
+// Scan returns possible credentials found in the given file contents.
+func Scan(content string) []Finding {
+	var res []Finding
+	for i, line := range strings.Split(content, "\n") {
+		for _, r := range rules {
+			for _, m := range r.re.FindAllStringSubmatch(line, -1) {
+				if r.allow != nil && r.allow(m[r.group]) {
+					continue
+				}
+				res = append(res, Finding{
+					Line:        i + 1,
+					Rule:        r.id,
+					Description: r.description,
+				})
+			}
+		}
+	}
+	return res
+}
+
+// isPlaceholder returns true if a password in a connection string is templated or an obvious placeholder.
+func isPlaceholder(s string) bool {
+	if strings.Contains(s, "{{") || strings.HasPrefix(s, "$") || strings.HasPrefix(s, "<") {
+		return true
+	}
+	if strings.Trim(s, "*x.") == "" {
+		return true
+	}
+	switch strings.ToLower(s) {
+	case "password", "pass", "pwd", "secret":
+		return true
+	}
+	return false
+}
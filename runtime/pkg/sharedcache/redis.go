@@ -0,0 +1,102 @@
+package sharedcache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisOptions configure a Redis cache.
+type RedisOptions struct {
+	// Prefix is prepended to all keys. It can be used to share a Redis database with other applications.
+	Prefix string
+	// TTL is the time values are kept. Keys embed the version of the resources they depend on, so outdated values are never read and can just be left to expire.
+	TTL time.Duration
+	// LockTTL is the time a lock is held if the replica holding it stops refreshing it (e.g. because it died).
+	LockTTL time.Duration
+}
+
+// Redis is a Cache backed by Redis.
+type Redis struct {
+	client *redis.Client
+	opts   RedisOptions
+}
+
+var _ Cache = (*Redis)(nil)
+
+// unlockScript deletes a lock only if it is still held by the caller, which guards against deleting a lock that expired and was taken by another replica.
+var unlockScript = redis.NewScript(`if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) else return 0 end`)
+
+// refreshScript extends a lock only if it is still held by the caller.
+var refreshScript = redis.NewScript(`if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("PEXPIRE", KEYS[1], ARGV[2]) else return 0 end`)
+
+// NewRedis creates a Cache that stores values in Redis.
+func NewRedis(client *redis.Client, opts RedisOptions) *Redis {
+	if opts.TTL == 0 {
+		opts.TTL = 24 * time.Hour
+	}
+	if opts.LockTTL == 0 {
+		opts.LockTTL = 30 * time.Second
+	}
+	return &Redis{client: client, opts: opts}
+}
+
+// Get implements Cache.
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	val, err := r.client.Get(ctx, r.opts.Prefix+key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return val, true, nil
+}
+
+// Set implements Cache.
+func (r *Redis) Set(ctx context.Context, key string, val []byte) error {
+	return r.client.Set(ctx, r.opts.Prefix+key, val, r.opts.TTL).Err()
+}
+
+// TryLock implements Cache.
+// The lock expires after LockTTL, but is refreshed in the background until it is released.
+func (r *Redis) TryLock(ctx context.Context, key string) (func(), bool, error) {
+	lockKey := r.opts.Prefix + "lock:" + key
+	token := uuid.NewString()
+	ok, err := r.client.SetNX(ctx, lockKey, token, r.opts.LockTTL).Result()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	// Refresh the lock until it's released
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(r.opts.LockTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				_ = refreshScript.Run(context.Background(), r.client, []string{lockKey}, token, r.opts.LockTTL.Milliseconds()).Err()
+			}
+		}
+	}()
+
+	unlock := func() {
+		close(stop)
+		<-done
+		_ = unlockScript.Run(context.Background(), r.client, []string{lockKey}, token).Err()
+	}
+	return unlock, true, nil
+}
+
+// Close implements Cache. It closes the Redis client.
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
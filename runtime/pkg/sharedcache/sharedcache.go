@@ -0,0 +1,81 @@
+// Package sharedcache implements a cache that is shared between runtime replicas.
+// It lets replicas serving the same instance reuse each other's query results, and ensures that at most one replica at a time computes a result for a key.
+package sharedcache
+
+import (
+	"context"
+	"time"
+)
+
+// defaultPollInterval is the interval at which Load checks for a result being computed by another replica.
+const defaultPollInterval = 100 * time.Millisecond
+
+// Cache is a byte cache that is shared between replicas.
+type Cache interface {
+	// Get returns the value for key.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set sets the value for key.
+	Set(ctx context.Context, key string, val []byte) error
+	// TryLock attempts to take an exclusive lock for key across all replicas.
+	// It returns false if another replica holds the lock. The lock must be released by calling the returned func.
+	// Implementations should release the lock automatically if the process holding it dies.
+	TryLock(ctx context.Context, key string) (func(), bool, error)
+	// Close releases resources held by the cache.
+	Close() error
+}
+
+// LoadFunc computes a value. If it returns false, the value is returned to the caller of Load but not cached.
+type LoadFunc func(ctx context.Context) ([]byte, bool, error)
+
+// Load returns the value for key from the cache. If it isn't cached, it calls fn and caches its result.
+// It guarantees that fn is called by at most one replica at a time for the same key; other replicas wait for the value to become available.
+// If the cache fails, Load falls back to calling fn without caching, since the cache is an optimization.
+func Load(ctx context.Context, c Cache, key string, fn LoadFunc) ([]byte, error) {
+	for {
+		val, ok, err := c.Get(ctx, key)
+		if err != nil {
+			return load(ctx, fn)
+		}
+		if ok {
+			return val, nil
+		}
+
+		unlock, ok, err := c.TryLock(ctx, key)
+		if err != nil {
+			return load(ctx, fn)
+		}
+		if ok {
+			return loadLocked(ctx, c, key, unlock, fn)
+		}
+
+		// Another replica is computing the value. Wait for it to finish (or die, in which case the lock expires).
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(defaultPollInterval):
+		}
+	}
+}
+
+func loadLocked(ctx context.Context, c Cache, key string, unlock func(), fn LoadFunc) ([]byte, error) {
+	defer unlock()
+
+	// The replica that previously held the lock may have cached the value before we took the lock.
+	if val, ok, err := c.Get(ctx, key); err == nil && ok {
+		return val, nil
+	}
+
+	val, cache, err := fn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cache {
+		_ = c.Set(ctx, key, val)
+	}
+	return val, nil
+}
+
+func load(ctx context.Context, fn LoadFunc) ([]byte, error) {
+	val, _, err := fn(ctx)
+	return val, err
+}
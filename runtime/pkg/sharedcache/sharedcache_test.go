@@ -0,0 +1,80 @@
+package sharedcache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	// Simulate several replicas sharing the same Redis
+	replicas := make([]*Redis, 3)
+	for i := range replicas {
+		replicas[i] = NewRedis(redis.NewClient(&redis.Options{Addr: mr.Addr()}), RedisOptions{Prefix: "test:"})
+		defer replicas[i].Close()
+	}
+
+	var calls atomic.Int64
+	fn := func(ctx context.Context) ([]byte, bool, error) {
+		calls.Add(1)
+		time.Sleep(200 * time.Millisecond)
+		return []byte("result"), true, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 9; i++ {
+		wg.Add(1)
+		go func(c Cache) {
+			defer wg.Done()
+			val, err := Load(context.Background(), c, "key", fn)
+			require.NoError(t, err)
+			require.Equal(t, []byte("result"), val)
+		}(replicas[i%len(replicas)])
+	}
+	wg.Wait()
+	require.Equal(t, int64(1), calls.Load())
+
+	// The lock is released
+	require.False(t, mr.Exists("test:lock:key"))
+
+	// Values that shouldn't be cached are returned, but not stored
+	val, err := Load(context.Background(), replicas[0], "other", func(ctx context.Context) ([]byte, bool, error) {
+		return []byte("uncached"), false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("uncached"), val)
+	require.False(t, mr.Exists("test:other"))
+
+	// Errors are not cached
+	_, err = Load(context.Background(), replicas[0], "failing", func(ctx context.Context) ([]byte, bool, error) {
+		return nil, false, errors.New("failed")
+	})
+	require.Error(t, err)
+	require.False(t, mr.Exists("test:failing"))
+}
+
+func TestLoadUnavailable(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	c := NewRedis(redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1}), RedisOptions{})
+	defer c.Close()
+	mr.Close()
+
+	// Load falls back to computing the value if Redis is unavailable
+	val, err := Load(context.Background(), c, "key", func(ctx context.Context) ([]byte, bool, error) {
+		return []byte("result"), true, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("result"), val)
+}
@@ -0,0 +1,163 @@
+// Package slowquery keeps an in-memory log of OLAP queries that exceed a duration threshold.
+// Queries are aggregated by SQL fingerprint (see package sqlfingerprint), so the log stays small even for dashboards that issue many similar queries.
+package slowquery
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/sqlfingerprint"
+)
+
+// maxEntriesPerInstance bounds the number of fingerprints tracked per instance.
+// When exceeded, the least recently seen fingerprint is evicted.
+const maxEntriesPerInstance = 1000
+
+// Entry contains stats for slow queries with the same fingerprint.
+type Entry struct {
+	Fingerprint   string
+	NormalizedSQL string
+	Count         int64
+	TotalDuration time.Duration
+	MaxDuration   time.Duration
+	FirstSeenOn   time.Time
+	LastSeenOn    time.Time
+}
+
+// Log records slow queries per instance. It is safe for concurrent use.
+type Log struct {
+	threshold time.Duration
+	mu        sync.Mutex
+	instances map[string]map[string]*Entry
+}
+
+// New creates a Log that records queries that take at least threshold to execute.
+// If threshold is zero or negative, the log is disabled and doesn't record anything.
+func New(threshold time.Duration) *Log {
+	return &Log{
+		threshold: threshold,
+		instances: make(map[string]map[string]*Entry),
+	}
+}
+
+// Enabled returns true if the log records slow queries.
+func (l *Log) Enabled() bool {
+	return l.threshold > 0
+}
+
+// Threshold returns the duration at which queries are considered slow. It returns zero if the log is disabled.
+func (l *Log) Threshold() time.Duration {
+	if !l.Enabled() {
+		return 0
+	}
+	return l.threshold
+}
+
+// Record records a query if its duration exceeds the threshold.
+func (l *Log) Record(instanceID, sql string, d time.Duration) {
+	if !l.Enabled() || d < l.threshold {
+		return
+	}
+
+	normalized := sqlfingerprint.Normalize(sql)
+	fingerprint := sqlfingerprint.Fingerprint(sql)
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entries, ok := l.instances[instanceID]
+	if !ok {
+		entries = make(map[string]*Entry)
+		l.instances[instanceID] = entries
+	}
+
+	e, ok := entries[fingerprint]
+	if !ok {
+		if len(entries) >= maxEntriesPerInstance {
+			evictOldest(entries)
+		}
+		e = &Entry{
+			Fingerprint:   fingerprint,
+			NormalizedSQL: normalized,
+			FirstSeenOn:   now,
+		}
+		entries[fingerprint] = e
+	}
+
+	e.Count++
+	e.TotalDuration += d
+	if d > e.MaxDuration {
+		e.MaxDuration = d
+	}
+	e.LastSeenOn = now
+}
+
+// Top returns the n fingerprints with the highest total duration for an instance.
+// If n is zero or negative, all fingerprints are returned.
+func (l *Log) Top(instanceID string, n int) []Entry {
+	l.mu.Lock()
+	res := make([]Entry, 0, len(l.instances[instanceID]))
+	for _, e := range l.instances[instanceID] {
+		res = append(res, *e)
+	}
+	l.mu.Unlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].TotalDuration != res[j].TotalDuration {
+			return res[i].TotalDuration > res[j].TotalDuration
+		}
+		return res[i].Fingerprint < res[j].Fingerprint
+	})
+
+	if n > 0 && len(res) > n {
+		res = res[:n]
+	}
+	return res
+}
+
+// Reset removes all recorded queries for an instance.
+func (l *Log) Reset(instanceID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.instances, instanceID)
+}
+
+func evictOldest(entries map[string]*Entry) {
+	var oldest *Entry
+	for _, e := range entries {
+		if oldest == nil || e.LastSeenOn.Before(oldest.LastSeenOn) {
+			oldest = e
+		}
+	}
+	if oldest != nil {
+		delete(entries, oldest.Fingerprint)
+	}
+}
+
+type logContextKey struct{}
+
+type logContextValue struct {
+	log        *Log
+	instanceID string
+}
+
+// WithLog returns a copy of ctx that records slow queries for the instance to the log.
+func WithLog(ctx context.Context, l *Log, instanceID string) context.Context {
+	if !l.Enabled() {
+		return ctx
+	}
+	return context.WithValue(ctx, logContextKey{}, logContextValue{log: l, instanceID: instanceID})
+}
+
+// Observe records a query to the log attached to ctx with WithLog. It is a no-op if ctx doesn't have a log.
+// It's called by OLAP drivers after executing a query.
+func Observe(ctx context.Context, sql string, d time.Duration) {
+	v, ok := ctx.Value(logContextKey{}).(logContextValue)
+	if !ok {
+		return
+	}
+	v.log.Record(v.instanceID, sql, d)
+}
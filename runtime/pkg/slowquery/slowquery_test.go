@@ -0,0 +1,51 @@
+package slowquery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog(t *testing.T) {
+	l := New(time.Second)
+
+	l.Record("i1", "SELECT * FROM t WHERE id = 1", 2*time.Second)
+	l.Record("i1", "SELECT * FROM t WHERE id = 2", 4*time.Second)
+	l.Record("i1", "SELECT count(*) FROM t", 3*time.Second)
+	l.Record("i1", "SELECT 1", 500*time.Millisecond) // Below threshold
+	l.Record("i2", "SELECT count(*) FROM t", time.Minute)
+
+	top := l.Top("i1", 0)
+	require.Len(t, top, 2)
+	require.Equal(t, "select * from t where id = ?", top[0].NormalizedSQL)
+	require.Equal(t, int64(2), top[0].Count)
+	require.Equal(t, 6*time.Second, top[0].TotalDuration)
+	require.Equal(t, 4*time.Second, top[0].MaxDuration)
+	require.Equal(t, "select count(*) from t", top[1].NormalizedSQL)
+
+	require.Len(t, l.Top("i1", 1), 1)
+	require.Len(t, l.Top("i2", 10), 1)
+
+	l.Reset("i1")
+	require.Empty(t, l.Top("i1", 0))
+	require.Len(t, l.Top("i2", 0), 1)
+}
+
+func TestObserve(t *testing.T) {
+	l := New(time.Second)
+
+	// No-op without a log
+	Observe(context.Background(), "SELECT 1", time.Hour)
+
+	ctx := WithLog(context.Background(), l, "i1")
+	Observe(ctx, "SELECT 1", time.Hour)
+	require.Len(t, l.Top("i1", 0), 1)
+
+	// Disabled log
+	disabled := New(0)
+	ctx = WithLog(context.Background(), disabled, "i1")
+	Observe(ctx, "SELECT 1", time.Hour)
+	require.Empty(t, disabled.Top("i1", 0))
+}
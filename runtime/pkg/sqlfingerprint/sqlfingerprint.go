@@ -0,0 +1,122 @@
+// Package sqlfingerprint normalizes SQL queries so that queries that only differ in literal values map to the same fingerprint.
+// Fingerprints are safe to export to logs and traces since they don't contain the data embedded in a query's literals.
+package sqlfingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// listRegexp matches parenthesized lists of placeholders, such as the values of an IN clause.
+var listRegexp = regexp.MustCompile(`\(\?(?:, ?\?)*\)`)
+
+// Normalize returns a normalized version of the SQL query.
+// It replaces string and numeric literals and positional arguments with "?", collapses lists of literals into a single "?",
+// removes comments, collapses whitespace, and lowercases everything except quoted identifiers.
+// It doesn't parse the SQL, so it works (approximately) for all dialects.
+func Normalize(sql string) string {
+	var b strings.Builder
+	b.Grow(len(sql))
+
+	rs := []rune(sql)
+	n := len(rs)
+	space := false
+	for i := 0; i < n; i++ {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			space = true
+			continue
+		case r == '-' && i+1 < n && rs[i+1] == '-':
+			// Line comment
+			for i < n && rs[i] != '\n' {
+				i++
+			}
+			space = true
+			continue
+		case r == '/' && i+1 < n && rs[i+1] == '*':
+			// Block comment
+			i += 2
+			for i < n && !(rs[i] == '*' && i+1 < n && rs[i+1] == '/') {
+				i++
+			}
+			i++
+			space = true
+			continue
+		}
+
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+
+		switch {
+		case r == '\'':
+			// String literal ('' is an escaped quote)
+			i++
+			for i < n {
+				if rs[i] == '\'' {
+					if i+1 < n && rs[i+1] == '\'' {
+						i++
+					} else {
+						break
+					}
+				} else if rs[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			b.WriteByte('?')
+		case r == '"' || r == '`':
+			// Quoted identifier (kept as-is)
+			j := i + 1
+			for j < n && rs[j] != r {
+				j++
+			}
+			if j == n {
+				j--
+			}
+			b.WriteString(string(rs[i : j+1]))
+			i = j
+		case r == '$' && i+1 < n && unicode.IsDigit(rs[i+1]):
+			// Positional argument
+			for i+1 < n && unicode.IsDigit(rs[i+1]) {
+				i++
+			}
+			b.WriteByte('?')
+		case unicode.IsDigit(r) && !isIdentRune(prevRune(rs, i)):
+			// Numeric literal
+			for i+1 < n && (isIdentRune(rs[i+1]) || rs[i+1] == '.' || ((rs[i+1] == '-' || rs[i+1] == '+') && (rs[i] == 'e' || rs[i] == 'E'))) {
+				i++
+			}
+			b.WriteByte('?')
+		case r == ';' && strings.TrimSpace(string(rs[i+1:])) == "":
+			// Trailing semicolon
+			i = n
+		default:
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	return listRegexp.ReplaceAllString(b.String(), "(?)")
+}
+
+// Fingerprint returns a short hash of the normalized SQL query.
+func Fingerprint(sql string) string {
+	sum := sha256.Sum256([]byte(Normalize(sql)))
+	return hex.EncodeToString(sum[:8])
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func prevRune(rs []rune, i int) rune {
+	if i == 0 {
+		return ' '
+	}
+	return rs[i-1]
+}
@@ -0,0 +1,35 @@
+package sqlfingerprint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		sql  string
+		want string
+	}{
+		{"SELECT 1", "select ?"},
+		{"SELECT  a,\n\tb FROM t WHERE x = 'it''s' AND y > 10.5e-3;", "select a, b from t where x = ? and y > ?"},
+		{`SELECT "Col 1", t2.c FROM "My Table" t2 -- comment`, `select "Col 1", t2.c from "My Table" t2`},
+		{"SELECT /* hint */ * FROM t WHERE id IN (1, 2, 3) AND s IN ('a','b')", "select * from t where id in (?) and s in (?)"},
+		{"SELECT * FROM t WHERE a = $1 AND b = ? LIMIT 100", "select * from t where a = ? and b = ? limit ?"},
+		{"SELECT col1 FROM tbl_2", "select col1 from tbl_2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			require.Equal(t, tt.want, Normalize(tt.sql))
+		})
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	a := Fingerprint("SELECT * FROM t WHERE id = 1")
+	b := Fingerprint("select *\nfrom t where id = 42")
+	c := Fingerprint("SELECT * FROM t WHERE name = 'x'")
+	require.Len(t, a, 16)
+	require.Equal(t, a, b)
+	require.NotEqual(t, a, c)
+}
@@ -0,0 +1,283 @@
+package sqlparse
+
+import (
+	"strings"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// formatIndent is the string used for one level of indentation by Format.
+const formatIndent = "  "
+
+// keywords are upper-cased by Format.
+// It only contains keywords that are reserved in all supported dialects, since identifiers are case sensitive in some dialects (e.g. ClickHouse).
+var keywords = map[string]bool{
+	"ALL": true, "AND": true, "AS": true, "ASC": true, "BETWEEN": true, "BY": true, "CASE": true, "CAST": true,
+	"CROSS": true, "DESC": true, "DISTINCT": true, "ELSE": true, "END": true, "EXCEPT": true, "EXISTS": true,
+	"FALSE": true, "FROM": true, "GROUP": true, "HAVING": true, "ILIKE": true, "IN": true, "INNER": true,
+	"INTERSECT": true, "INTERVAL": true, "IS": true, "JOIN": true, "LATERAL": true, "LIKE": true, "LIMIT": true,
+	"NATURAL": true, "NOT": true, "NULL": true, "OFFSET": true, "ON": true, "OR": true, "ORDER": true,
+	"OUTER": true, "OVER": true, "PARTITION": true, "QUALIFY": true, "RECURSIVE": true, "SELECT": true,
+	"THEN": true, "TRUE": true, "UNION": true, "USING": true, "WHEN": true, "WHERE": true, "WITH": true,
+}
+
+// contextualKeywords are upper-cased by Format only when followed by one of the given keywords.
+// For example, "left" is a function in some dialects, but "left join" is a keyword.
+var contextualKeywords = map[string][]string{
+	"LEFT":  {"JOIN", "OUTER", "SEMI", "ANTI", "ANY", "ALL", "ARRAY"},
+	"RIGHT": {"JOIN", "OUTER", "SEMI", "ANTI", "ANY", "ALL"},
+	"FULL":  {"JOIN", "OUTER"},
+	"NULLS": {"FIRST", "LAST"},
+}
+
+// spacedBeforeParen are keywords that are followed by a space before an opening parenthesis.
+// Other words are assumed to be function names, which are not followed by a space.
+var spacedBeforeParen = map[string]bool{
+	"AND": true, "AS": true, "BY": true, "ELSE": true, "EXISTS": true, "FROM": true, "IN": true, "JOIN": true,
+	"LATERAL": true, "NOT": true, "ON": true, "OR": true, "OVER": true, "SELECT": true, "THEN": true,
+	"USING": true, "WHEN": true, "WHERE": true, "WITH": true, "UNION": true, "ALL": true, "INTERSECT": true,
+	"EXCEPT": true, "HAVING": true, "QUALIFY": true, "DISTINCT": true, "VALUES": true,
+}
+
+// clauseKeywords start a new line when they occur in a query.
+var clauseKeywords = map[string]bool{
+	"WITH": true, "SELECT": true, "FROM": true, "WHERE": true, "GROUP": true, "HAVING": true, "QUALIFY": true,
+	"WINDOW": true, "ORDER": true, "LIMIT": true, "OFFSET": true, "UNION": true, "INTERSECT": true, "EXCEPT": true,
+}
+
+// Format formats a SQL string in a consistent style: keywords are upper-cased, each clause starts on a new line,
+// the selected expressions are listed on separate lines, and subqueries are indented.
+// Comments and templating tags are preserved. It returns an error if the SQL can't be tokenized.
+func Format(sql string, dialect drivers.Dialect) (string, error) {
+	toks, err := tokenize(sql, dialect)
+	if err != nil {
+		return "", err
+	}
+
+	f := &formatter{toks: toks, newline: -1}
+	f.stack = []formatFrame{{query: true}}
+	for i := range toks {
+		f.write(i)
+	}
+	return strings.TrimSpace(f.sb.String()), nil
+}
+
+// formatter holds the state for a call to Format.
+type formatter struct {
+	toks  []token
+	sb    strings.Builder
+	stack []formatFrame
+	// newline is the indentation level of the line that the next token should start, or -1 if it should continue the current line.
+	newline int
+	// indent is the indentation level of the current line.
+	indent int
+	// blankLine is true if an empty line should be written before the next token.
+	blankLine bool
+}
+
+// formatFrame tracks a level of parenthesis nesting.
+type formatFrame struct {
+	// query is true for the top level and for subqueries, where clauses start on new lines.
+	query  bool
+	indent int
+	clause string
+}
+
+// next returns the first non-comment token after index i, or an empty token.
+func (f *formatter) next(i int) token {
+	for j := i + 1; j < len(f.toks); j++ {
+		if f.toks[j].kind != tokenComment {
+			return f.toks[j]
+		}
+	}
+	return token{kind: tokenComment}
+}
+
+// prev returns the first non-comment token before index i, or an empty token.
+func (f *formatter) prev(i int) token {
+	for j := i - 1; j >= 0; j-- {
+		if f.toks[j].kind != tokenComment {
+			return f.toks[j]
+		}
+	}
+	return token{kind: tokenComment}
+}
+
+func (f *formatter) write(i int) {
+	t := f.toks[i]
+	frame := &f.stack[len(f.stack)-1]
+	text := t.text
+
+	if t.kind == tokenWord {
+		up := t.upper()
+		if keywords[up] || (contextualKeywords[up] != nil && f.followedBy(i, contextualKeywords[up])) {
+			text = up
+		}
+		if frame.query && f.startsClause(i) {
+			frame.clause = up
+			f.newline = frame.indent
+		}
+	}
+
+	switch {
+	case t.is(")"):
+		if frame.query && len(f.stack) > 1 {
+			f.newline = f.stack[len(f.stack)-2].indent
+		}
+		if len(f.stack) > 1 {
+			f.stack = f.stack[:len(f.stack)-1]
+		}
+	case t.is(";"):
+		f.newline = -1
+	}
+
+	f.writeSeparator(i)
+	f.sb.WriteString(text)
+
+	// Determine where the next token goes
+	frame = &f.stack[len(f.stack)-1]
+	switch {
+	case t.kind == tokenComment && strings.HasPrefix(t.text, "--"):
+		if f.newline < 0 {
+			f.newline = f.indent
+		}
+	case t.is("("):
+		next := f.next(i)
+		if next.is("SELECT") || next.is("WITH") {
+			f.stack = append(f.stack, formatFrame{query: true, indent: frame.indent + 1})
+			f.newline = frame.indent + 1
+		} else {
+			f.stack = append(f.stack, formatFrame{indent: frame.indent})
+		}
+	case t.is(";"):
+		f.stack = f.stack[:1]
+		f.stack[0] = formatFrame{query: true}
+		f.newline = 0
+		f.blankLine = true
+	case t.is(","):
+		switch frame.clause {
+		case "SELECT":
+			f.newline = frame.indent + 1
+		case "WITH":
+			f.newline = frame.indent
+		}
+	case frame.clause == "SELECT" && (t.is("SELECT") || t.is("DISTINCT") || t.is("ALL")) && frame.query:
+		next := f.next(i)
+		if !next.is("DISTINCT") && !next.is("ALL") && !next.is("ON") {
+			f.newline = frame.indent + 1
+		}
+	}
+}
+
+// writeSeparator writes the whitespace before the token at index i.
+func (f *formatter) writeSeparator(i int) {
+	if i == 0 {
+		f.newline = -1
+		return
+	}
+	if f.newline >= 0 {
+		f.sb.WriteString("\n")
+		if f.blankLine {
+			f.sb.WriteString("\n")
+			f.blankLine = false
+		}
+		f.sb.WriteString(strings.Repeat(formatIndent, f.newline))
+		f.indent = f.newline
+		f.newline = -1
+		return
+	}
+	if f.needsSpace(i) {
+		f.sb.WriteString(" ")
+	}
+}
+
+// needsSpace returns true if there should be a space between the token at index i and the previous token.
+func (f *formatter) needsSpace(i int) bool {
+	t := f.toks[i]
+	prev := f.toks[i-1]
+
+	// Preserve the original spacing around templating tags, which may be part of an identifier.
+	if t.kind == tokenTemplate || prev.kind == tokenTemplate {
+		return t.spaceBefore
+	}
+	if t.kind == tokenComment || prev.kind == tokenComment {
+		return true
+	}
+
+	switch {
+	case t.is(",") || t.is(")") || t.is(".") || t.is("::") || t.is(";") || t.is("]"):
+		return false
+	case prev.is("(") || prev.is(".") || prev.is("::") || prev.is("["):
+		return false
+	case t.is("["):
+		return !(prev.kind == tokenWord || prev.kind == tokenQuotedIdent || prev.is(")") || prev.is("]"))
+	case t.is("("):
+		if prev.kind == tokenWord {
+			return spacedBeforeParen[prev.upper()]
+		}
+		return !(prev.kind == tokenQuotedIdent || prev.is(")") || prev.is("]"))
+	case (prev.is("-") || prev.is("+")) && f.isUnary(i-1):
+		return false
+	}
+	return true
+}
+
+// isUnary returns true if the +/- operator at index i is a unary operator.
+func (f *formatter) isUnary(i int) bool {
+	prev := f.prev(i)
+	switch prev.kind {
+	case tokenNumber, tokenString, tokenQuotedIdent, tokenTemplate:
+		return false
+	case tokenWord:
+		return keywords[prev.upper()] && !prev.is("NULL") && !prev.is("TRUE") && !prev.is("FALSE") && !prev.is("END")
+	case tokenOperator:
+		return !prev.is(")") && !prev.is("]")
+	default:
+		return true
+	}
+}
+
+// followedBy returns true if the token after index i is one of the given words.
+func (f *formatter) followedBy(i int, words []string) bool {
+	next := f.next(i)
+	for _, w := range words {
+		if next.is(w) {
+			return true
+		}
+	}
+	return false
+}
+
+// startsClause returns true if the word at index i starts a clause of a query.
+func (f *formatter) startsClause(i int) bool {
+	t := f.toks[i]
+	prev := f.prev(i)
+	up := t.upper()
+	switch {
+	case up == "GROUP" || up == "ORDER":
+		return f.next(i).is("BY")
+	case up == "FROM":
+		// Ignore "IS DISTINCT FROM"
+		return !prev.is("DISTINCT")
+	case up == "EXCEPT":
+		// Ignore "SELECT * EXCEPT (...)"
+		return !prev.is("*")
+	case clauseKeywords[up]:
+		return true
+	case up == "JOIN" || joinModifiers[up]:
+		// The first of a sequence of join keywords starts the clause
+		if joinModifiers[prev.upper()] && (prev.upper() != "ALL" || !f.prev(i-1).is("UNION")) {
+			return false
+		}
+		for j := i; j < len(f.toks); j++ {
+			tj := f.toks[j]
+			if tj.is("JOIN") {
+				return true
+			}
+			if tj.kind != tokenComment && !joinModifiers[tj.upper()] {
+				return false
+			}
+		}
+	}
+	return false
+}
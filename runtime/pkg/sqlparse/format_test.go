@@ -0,0 +1,119 @@
+package sqlparse
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		title   string
+		sql     string
+		dialect drivers.Dialect
+		want    string
+	}{
+		{
+			title: "simple",
+			sql:   "select a,b as c, count(*) from t where a>1 and b<>'x' group by 1,2 order by 3 desc limit 10",
+			want: `SELECT
+  a,
+  b AS c,
+  count(*)
+FROM t
+WHERE a > 1 AND b <> 'x'
+GROUP BY 1, 2
+ORDER BY 3 DESC
+LIMIT 10`,
+		},
+		{
+			title: "ctes and subqueries",
+			sql:   "with a as (select x from t), b as (select x from (select x from a) where x::int > -1) select distinct x from b left join a using (x)",
+			want: `WITH a AS (
+  SELECT
+    x
+  FROM t
+),
+b AS (
+  SELECT
+    x
+  FROM (
+    SELECT
+      x
+    FROM a
+  )
+  WHERE x::int > -1
+)
+SELECT DISTINCT
+  x
+FROM b
+LEFT JOIN a USING (x)`,
+		},
+		{
+			title: "window and case",
+			sql:   "select case when a is null then 0 else a end as a, sum(b) over (partition by c order by d) from t union all select 1, 2",
+			want: `SELECT
+  CASE WHEN a IS NULL THEN 0 ELSE a END AS a,
+  sum(b) OVER (PARTITION BY c ORDER BY d)
+FROM t
+UNION ALL
+SELECT
+  1,
+  2`,
+		},
+		{
+			title: "comments and templates",
+			sql:   "-- @materialize: true\nselect a -- the a\nfrom {{ ref \"t\" }} where b = '{{ .env.x }}' and c_{{ .env.y }} = 1",
+			want: `-- @materialize: true
+SELECT
+  a -- the a
+FROM {{ ref "t" }}
+WHERE b = '{{ .env.x }}' AND c_{{ .env.y }} = 1`,
+		},
+		{
+			title: "identifiers are not upper-cased",
+			sql:   `select "Date", left(name, 2), date from t`,
+			want: `SELECT
+  "Date",
+  left(name, 2),
+  date
+FROM t`,
+		},
+		{
+			title:   "backslash escapes in clickhouse",
+			sql:     `select 'it\'s' from t`,
+			dialect: drivers.DialectClickHouse,
+			want: `SELECT
+  'it\'s'
+FROM t`,
+		},
+		{
+			title: "multiple statements",
+			sql:   "select 1; select 2;",
+			want: `SELECT
+  1;
+
+SELECT
+  2;`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			got, err := Format(tt.sql, tt.dialect)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+
+			// Formatting is idempotent
+			again, err := Format(got, tt.dialect)
+			require.NoError(t, err)
+			require.Equal(t, got, again)
+		})
+	}
+}
+
+func TestFormatError(t *testing.T) {
+	_, err := Format("select 'x", drivers.DialectDuckDB)
+	require.ErrorContains(t, err, "unterminated string literal")
+}
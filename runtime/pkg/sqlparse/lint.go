@@ -0,0 +1,364 @@
+package sqlparse
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// Lint rules.
+const (
+	RuleSyntax            = "syntax"
+	RuleSelectStar        = "select_star"
+	RuleUnusedCTE         = "unused_cte"
+	RuleCrossJoin         = "cross_join"
+	RuleIdentifierQuoting = "identifier_quoting"
+)
+
+// Severity of a lint diagnostic.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+}
+
+// Diagnostic is a problem found by Lint.
+type Diagnostic struct {
+	Rule     string
+	Severity Severity
+	Message  string
+	// Line and Column are the 1-based position of the problem in the SQL string. The column is counted in characters.
+	Line   int
+	Column int
+}
+
+// Lint checks a SQL string for common mistakes in the given dialect.
+// It is a best-effort, token-based analysis that doesn't require the SQL to be fully valid, so it can be used while the SQL is being edited.
+// It flags:
+//   - SELECT * (and t.*), which makes the output schema depend on upstream schema changes,
+//   - CTEs that are never referenced,
+//   - joins without a predicate, i.e. JOINs without ON or USING, comma-separated tables without a WHERE clause and explicit CROSS JOINs,
+//   - identifiers quoted with backticks in dialects that only support double quotes.
+//
+// The diagnostics are ordered by position.
+func Lint(sql string, dialect drivers.Dialect) []*Diagnostic {
+	toks, err := tokenize(sql, dialect)
+	if err != nil {
+		var serr *syntaxError
+		if errors.As(err, &serr) {
+			return []*Diagnostic{{Rule: RuleSyntax, Severity: SeverityError, Message: serr.msg, Line: serr.line, Column: serr.col}}
+		}
+		return []*Diagnostic{{Rule: RuleSyntax, Severity: SeverityError, Message: err.Error(), Line: 1, Column: 1}}
+	}
+
+	// Comments don't affect the analysis
+	n := 0
+	for _, t := range toks {
+		if t.kind != tokenComment {
+			toks[n] = t
+			n++
+		}
+	}
+	toks = toks[:n]
+
+	l := &linter{toks: toks, dialect: dialect}
+	if l.matchParens() {
+		l.lintLevel(0, len(toks))
+		l.lintCTEs()
+	}
+	l.lintQuoting()
+
+	sort.SliceStable(l.diags, func(i, j int) bool {
+		a, b := l.diags[i], l.diags[j]
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return l.diags
+}
+
+// linter holds the state for a call to Lint.
+type linter struct {
+	toks    []token
+	dialect drivers.Dialect
+	// match contains the index of the matching parenthesis for parenthesis tokens.
+	match map[int]int
+	diags []*Diagnostic
+}
+
+func (l *linter) report(t token, rule string, severity Severity, format string, args ...any) {
+	l.diags = append(l.diags, &Diagnostic{
+		Rule:     rule,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+		Line:     t.line,
+		Column:   t.col,
+	})
+}
+
+// matchParens populates l.match. It reports a syntax error and returns false if the parentheses are unbalanced.
+func (l *linter) matchParens() bool {
+	l.match = make(map[int]int)
+	var stack []int
+	for i, t := range l.toks {
+		switch {
+		case t.is("("):
+			stack = append(stack, i)
+		case t.is(")"):
+			if len(stack) == 0 {
+				l.report(t, RuleSyntax, SeverityError, "unexpected closing parenthesis")
+				return false
+			}
+			j := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			l.match[i] = j
+			l.match[j] = i
+		}
+	}
+	if len(stack) > 0 {
+		l.report(l.toks[stack[len(stack)-1]], RuleSyntax, SeverityError, "unclosed parenthesis")
+		return false
+	}
+	return true
+}
+
+// tok returns the token at index i, or an empty token if i is out of bounds.
+func (l *linter) tok(i int) token {
+	if i < 0 || i >= len(l.toks) {
+		return token{kind: tokenComment}
+	}
+	return l.toks[i]
+}
+
+// selectState tracks the clauses of a SELECT statement at one level of parenthesis nesting.
+type selectState struct {
+	clause string
+	// commaJoin is the index of the first comma separating tables in the FROM clause, or -1.
+	commaJoin int
+	hasWhere  bool
+	// pendingJoin is the index of a JOIN that hasn't been followed by ON or USING yet, or -1.
+	pendingJoin int
+}
+
+// lintLevel lints the tokens in [start, end) and recurses into parenthesized expressions.
+func (l *linter) lintLevel(start, end int) {
+	s := &selectState{commaJoin: -1, pendingJoin: -1}
+	for i := start; i < end; i++ {
+		t := l.toks[i]
+		switch {
+		case t.is("("):
+			l.lintLevel(i+1, l.match[i])
+			i = l.match[i]
+		case t.is(";"):
+			l.flush(s)
+		case t.is("*"):
+			prev := l.tok(i - 1)
+			if s.clause == "SELECT" && (prev.is("SELECT") || prev.is("DISTINCT") || prev.is("ALL") || prev.is(",") || prev.is(".")) {
+				l.report(t, RuleSelectStar, SeverityWarning, "avoid SELECT *: the output columns change when the upstream schema changes, so list the columns explicitly")
+			}
+		case t.is(","):
+			if s.clause == "FROM" || s.clause == "JOIN" {
+				l.finishJoin(s)
+				s.clause = "FROM"
+				next := l.tok(i + 1)
+				if s.commaJoin < 0 && !next.is("LATERAL") && !next.is("UNNEST") {
+					s.commaJoin = i
+				}
+			}
+		case t.kind == tokenWord:
+			switch t.upper() {
+			case "SELECT":
+				l.flush(s)
+				s.clause = "SELECT"
+			case "FROM":
+				// Ignore "IS DISTINCT FROM"
+				if !l.tok(i - 1).is("DISTINCT") {
+					s.clause = "FROM"
+				}
+			case "WHERE":
+				l.finishJoin(s)
+				s.hasWhere = true
+				s.clause = "WHERE"
+			case "GROUP", "HAVING", "QUALIFY", "ORDER", "LIMIT", "OFFSET", "WINDOW":
+				l.finishJoin(s)
+				s.clause = t.upper()
+			case "UNION", "INTERSECT", "EXCEPT":
+				// Ignore "SELECT * EXCEPT (...)"
+				if !l.tok(i - 1).is("*") {
+					l.flush(s)
+				}
+			case "ON", "USING":
+				if s.clause == "JOIN" {
+					s.pendingJoin = -1
+				}
+			case "JOIN":
+				l.finishJoin(s)
+				l.lintJoin(s, i)
+				s.clause = "JOIN"
+			}
+		}
+	}
+	l.flush(s)
+}
+
+// joinModifiers are keywords that can precede JOIN.
+var joinModifiers = map[string]bool{
+	"INNER": true, "LEFT": true, "RIGHT": true, "FULL": true, "OUTER": true, "CROSS": true, "NATURAL": true,
+	"ASOF": true, "POSITIONAL": true, "SEMI": true, "ANTI": true, "ANY": true, "ALL": true, "GLOBAL": true,
+	"ARRAY": true, "PASTE": true,
+}
+
+// lintJoin checks the JOIN at index i.
+func (l *linter) lintJoin(s *selectState, i int) {
+	var cross, noPredicate bool
+	for j := i - 1; j >= 0 && joinModifiers[l.tok(j).upper()]; j-- {
+		switch l.tok(j).upper() {
+		case "CROSS":
+			cross = true
+		case "NATURAL", "POSITIONAL", "ARRAY", "PASTE":
+			noPredicate = true
+		}
+	}
+
+	next := l.tok(i + 1)
+	if next.is("LATERAL") || next.is("UNNEST") || noPredicate {
+		return
+	}
+	if cross {
+		l.report(l.tok(i), RuleCrossJoin, SeverityInfo, "CROSS JOIN returns every combination of rows from both sides, which can be very large")
+		return
+	}
+	s.pendingJoin = i
+}
+
+// finishJoin reports the pending JOIN of s if it didn't have a predicate.
+func (l *linter) finishJoin(s *selectState) {
+	if s.pendingJoin >= 0 {
+		l.report(l.toks[s.pendingJoin], RuleCrossJoin, SeverityWarning, "JOIN without an ON or USING condition returns every combination of rows from both sides")
+		s.pendingJoin = -1
+	}
+}
+
+// flush reports problems that can only be detected at the end of a SELECT statement and resets s.
+func (l *linter) flush(s *selectState) {
+	l.finishJoin(s)
+	if s.commaJoin >= 0 && !s.hasWhere {
+		l.report(l.toks[s.commaJoin], RuleCrossJoin, SeverityWarning, "tables separated by a comma without a WHERE clause return every combination of rows; use a JOIN with an ON condition")
+	}
+	*s = selectState{commaJoin: -1, pendingJoin: -1}
+}
+
+// cte is a common table expression found by lintCTEs.
+type cte struct {
+	name string
+	// idx is the index of the token with the CTE's name.
+	idx int
+	// start and end are the indexes of the parentheses around the CTE's query.
+	start, end int
+}
+
+// lintCTEs reports CTEs that are never referenced.
+func (l *linter) lintCTEs() {
+	var ctes []cte
+	for i, t := range l.toks {
+		if t.is("WITH") {
+			ctes = append(ctes, l.parseCTEs(i)...)
+		}
+	}
+
+	for _, c := range ctes {
+		used := false
+		for i, t := range l.toks {
+			if i == c.idx || (i > c.start && i < c.end) {
+				continue
+			}
+			if name, ok := t.ident(); ok && strings.EqualFold(name, c.name) {
+				used = true
+				break
+			}
+			// Templating tags may reference the CTE, for example in a conditional block.
+			if t.kind == tokenTemplate && strings.Contains(strings.ToLower(t.text), strings.ToLower(c.name)) {
+				used = true
+				break
+			}
+		}
+		if !used {
+			l.report(l.toks[c.idx], RuleUnusedCTE, SeverityWarning, "CTE %q is never used", c.name)
+		}
+	}
+}
+
+// parseCTEs parses the CTE definitions after the WITH keyword at index i.
+// It returns nil if the WITH keyword doesn't start a list of CTEs (e.g. "WITH TIES" or a ClickHouse "WITH <expr> AS <alias>").
+func (l *linter) parseCTEs(i int) []cte {
+	var res []cte
+	j := i + 1
+	if l.tok(j).is("RECURSIVE") {
+		j++
+	}
+	for {
+		name, ok := l.tok(j).ident()
+		if !ok {
+			return res
+		}
+		c := cte{name: name, idx: j}
+		j++
+		if l.tok(j).is("(") {
+			j = l.match[j] + 1
+		}
+		if !l.tok(j).is("AS") {
+			return res
+		}
+		j++
+		if l.tok(j).is("NOT") {
+			j++
+		}
+		if l.tok(j).is("MATERIALIZED") {
+			j++
+		}
+		if !l.tok(j).is("(") {
+			return res
+		}
+		c.start = j
+		c.end = l.match[j]
+		res = append(res, c)
+		j = c.end + 1
+		if !l.tok(j).is(",") {
+			return res
+		}
+		j++
+	}
+}
+
+// lintQuoting reports identifiers quoted with backticks in dialects that only support double quotes.
+func (l *linter) lintQuoting() {
+	switch l.dialect {
+	case drivers.DialectDuckDB, drivers.DialectDruid, drivers.DialectPinot:
+	default:
+		return
+	}
+	for _, t := range l.toks {
+		if t.kind == tokenQuotedIdent && t.text[0] == '`' {
+			l.report(t, RuleIdentifierQuoting, SeverityError, "%s doesn't support backtick-quoted identifiers; use double quotes", l.dialect.String())
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package sqlparse
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		title   string
+		sql     string
+		dialect drivers.Dialect
+		want    []Diagnostic
+	}{
+		{
+			title: "clean",
+			sql:   "SELECT a, count(*) AS n FROM t JOIN u ON t.id = u.id WHERE a > 1 GROUP BY a",
+		},
+		{
+			title: "select star",
+			sql:   "select *\nfrom t",
+			want:  []Diagnostic{{Rule: RuleSelectStar, Severity: SeverityWarning, Line: 1, Column: 8}},
+		},
+		{
+			title: "qualified select star in subquery",
+			sql:   "SELECT x FROM (SELECT t.* FROM t)",
+			want:  []Diagnostic{{Rule: RuleSelectStar, Severity: SeverityWarning, Line: 1, Column: 25}},
+		},
+		{
+			title: "star in function and expression",
+			sql:   "SELECT count(*), a * b FROM t",
+		},
+		{
+			title: "unused cte",
+			sql:   "WITH a AS (SELECT 1 AS x), b AS (SELECT x FROM a) SELECT x FROM a",
+			want:  []Diagnostic{{Rule: RuleUnusedCTE, Severity: SeverityWarning, Line: 1, Column: 28}},
+		},
+		{
+			title: "recursive cte that only references itself",
+			sql:   "WITH RECURSIVE r AS (SELECT 1 AS n UNION ALL SELECT n + 1 FROM r) SELECT 1 AS n",
+			want:  []Diagnostic{{Rule: RuleUnusedCTE, Severity: SeverityWarning, Line: 1, Column: 16}},
+		},
+		{
+			title: "cte referenced in template",
+			sql:   "WITH a AS (SELECT 1 AS x) SELECT x FROM {{ if dev }}a{{ else }}b{{ end }}",
+		},
+		{
+			title: "comma join without where",
+			sql:   "SELECT a FROM t, u",
+			want:  []Diagnostic{{Rule: RuleCrossJoin, Severity: SeverityWarning, Line: 1, Column: 16}},
+		},
+		{
+			title: "comma join with where",
+			sql:   "SELECT a FROM t, u WHERE t.id = u.id",
+		},
+		{
+			title: "comma join with unnest",
+			sql:   "SELECT a FROM t, UNNEST(t.arr) AS a",
+		},
+		{
+			title: "join without condition",
+			sql:   "SELECT a FROM t LEFT JOIN u WHERE a > 1",
+			want:  []Diagnostic{{Rule: RuleCrossJoin, Severity: SeverityWarning, Line: 1, Column: 22}},
+		},
+		{
+			title: "join with using",
+			sql:   "SELECT a FROM t JOIN u USING (id) JOIN v ON v.id = u.id",
+		},
+		{
+			title: "explicit cross join",
+			sql:   "SELECT a FROM t CROSS JOIN u",
+			want:  []Diagnostic{{Rule: RuleCrossJoin, Severity: SeverityInfo, Line: 1, Column: 23}},
+		},
+		{
+			title: "natural join",
+			sql:   "SELECT a FROM t NATURAL JOIN u",
+		},
+		{
+			title: "except columns",
+			sql:   "SELECT * EXCEPT (b) FROM t, u WHERE t.id = u.id",
+			want:  []Diagnostic{{Rule: RuleSelectStar, Severity: SeverityWarning, Line: 1, Column: 8}},
+		},
+		{
+			title:   "backticks in duckdb",
+			sql:     "SELECT `a` FROM t",
+			dialect: drivers.DialectDuckDB,
+			want:    []Diagnostic{{Rule: RuleIdentifierQuoting, Severity: SeverityError, Line: 1, Column: 8}},
+		},
+		{
+			title:   "backticks in clickhouse",
+			sql:     "SELECT `a` FROM t",
+			dialect: drivers.DialectClickHouse,
+		},
+		{
+			title: "comments are ignored",
+			sql:   "-- SELECT * FROM t, u\nSELECT a /* , * */ FROM t",
+		},
+		{
+			title: "unterminated string",
+			sql:   "SELECT a FROM t\nWHERE b = 'x",
+			want:  []Diagnostic{{Rule: RuleSyntax, Severity: SeverityError, Line: 2, Column: 11}},
+		},
+		{
+			title: "unbalanced parentheses",
+			sql:   "SELECT (a FROM t",
+			want:  []Diagnostic{{Rule: RuleSyntax, Severity: SeverityError, Line: 1, Column: 8}},
+		},
+		{
+			title: "multiple statements",
+			sql:   "SELECT a FROM t, u; SELECT b FROM v, w WHERE v.id = w.id",
+			want:  []Diagnostic{{Rule: RuleCrossJoin, Severity: SeverityWarning, Line: 1, Column: 16}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			diags := Lint(tt.sql, tt.dialect)
+			var got []Diagnostic
+			for _, d := range diags {
+				require.NotEmpty(t, d.Message)
+				got = append(got, Diagnostic{Rule: d.Rule, Severity: d.Severity, Line: d.Line, Column: d.Column})
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
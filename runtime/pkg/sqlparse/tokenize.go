@@ -0,0 +1,225 @@
+package sqlparse
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// tokenKind enumerates the kinds of tokens produced by tokenize.
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenQuotedIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenComment
+	tokenTemplate
+)
+
+// token is a lexical token in a SQL string.
+type token struct {
+	kind tokenKind
+	text string
+	// line and col are the 1-based position of the token in the SQL string. The column is counted in characters.
+	line int
+	col  int
+	// spaceBefore is true if the token was preceded by whitespace.
+	spaceBefore bool
+}
+
+// upper returns the upper-cased text of a word token, or an empty string for other tokens.
+func (t token) upper() string {
+	if t.kind != tokenWord {
+		return ""
+	}
+	return strings.ToUpper(t.text)
+}
+
+// is returns true if the token is the given operator or (case-insensitively) the given word.
+func (t token) is(s string) bool {
+	switch t.kind {
+	case tokenOperator:
+		return t.text == s
+	case tokenWord:
+		return strings.EqualFold(t.text, s)
+	default:
+		return false
+	}
+}
+
+// ident returns the name of an identifier token with quotes removed.
+func (t token) ident() (string, bool) {
+	switch t.kind {
+	case tokenWord:
+		return t.text, true
+	case tokenQuotedIdent:
+		q := t.text[:1]
+		return strings.ReplaceAll(t.text[1:len(t.text)-1], q+q, q), true
+	default:
+		return "", false
+	}
+}
+
+// syntaxError is returned by tokenize for malformed SQL.
+type syntaxError struct {
+	msg  string
+	line int
+	col  int
+}
+
+func (e *syntaxError) Error() string {
+	return fmt.Sprintf("%s (line %d, column %d)", e.msg, e.line, e.col)
+}
+
+// multiCharOperators are operators that consist of more than one character, longest first.
+var multiCharOperators = []string{"->>", "::", "<=", ">=", "<>", "!=", "==", "||", "->", "=>", "<<", ">>"}
+
+// tokenize splits a SQL string into tokens.
+// It handles the quoting rules of the dialect and treats Rill templating tags ("{{ ... }}" and "{% ... %}") as opaque tokens.
+func tokenize(sql string, dialect drivers.Dialect) ([]token, error) {
+	// ClickHouse and BigQuery support backslash escapes in string literals.
+	backslashEscapes := dialect == drivers.DialectClickHouse || dialect == drivers.DialectBigQuery
+
+	var toks []token
+	line, col := 1, 1
+	space := false
+	i := 0
+
+	// advance moves i to end and updates the line and column.
+	advance := func(end int) {
+		for _, r := range sql[i:end] {
+			if r == '\n' {
+				line++
+				col = 1
+			} else {
+				col++
+			}
+		}
+		i = end
+	}
+	emit := func(kind tokenKind, end int) {
+		toks = append(toks, token{kind: kind, text: sql[i:end], line: line, col: col, spaceBefore: space})
+		space = false
+		advance(end)
+	}
+	errorf := func(format string, args ...any) error {
+		return &syntaxError{msg: fmt.Sprintf(format, args...), line: line, col: col}
+	}
+
+	for i < len(sql) {
+		r, size := utf8.DecodeRuneInString(sql[i:])
+		rest := sql[i:]
+		switch {
+		case unicode.IsSpace(r):
+			space = true
+			advance(i + size)
+		case strings.HasPrefix(rest, "--"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			emit(tokenComment, i+len(strings.TrimRight(rest[:end], " \t\r")))
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				return nil, errorf("unterminated block comment")
+			}
+			emit(tokenComment, i+end+4)
+		case strings.HasPrefix(rest, "{{"), strings.HasPrefix(rest, "{%"):
+			closer := "}}"
+			if rest[1] == '%' {
+				closer = "%}"
+			}
+			end := strings.Index(rest[2:], closer)
+			if end < 0 {
+				return nil, errorf("unterminated template tag")
+			}
+			emit(tokenTemplate, i+end+4)
+		case r == '\'':
+			end, ok := scanQuoted(rest, '\'', backslashEscapes)
+			if !ok {
+				return nil, errorf("unterminated string literal")
+			}
+			emit(tokenString, i+end)
+		case r == '"', r == '`':
+			end, ok := scanQuoted(rest, byte(r), false)
+			if !ok {
+				return nil, errorf("unterminated quoted identifier")
+			}
+			emit(tokenQuotedIdent, i+end)
+		case isDigit(r), r == '.' && len(rest) > 1 && isDigit(rune(rest[1])):
+			emit(tokenNumber, i+scanNumber(rest))
+		case r == '_' || unicode.IsLetter(r):
+			end := len(rest)
+			for j, c := range rest {
+				if c != '_' && c != '$' && !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+					end = j
+					break
+				}
+			}
+			emit(tokenWord, i+end)
+		default:
+			end := size
+			for _, op := range multiCharOperators {
+				if strings.HasPrefix(rest, op) {
+					end = len(op)
+					break
+				}
+			}
+			emit(tokenOperator, i+end)
+		}
+	}
+
+	return toks, nil
+}
+
+// scanQuoted returns the length of the quoted string at the start of s.
+// The quote character can be escaped by doubling it, or with a backslash if backslashEscapes is true.
+func scanQuoted(s string, quote byte, backslashEscapes bool) (int, bool) {
+	for j := 1; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			if backslashEscapes {
+				j++
+			}
+		case quote:
+			if j+1 < len(s) && s[j+1] == quote {
+				j++
+				continue
+			}
+			return j + 1, true
+		}
+	}
+	return 0, false
+}
+
+// scanNumber returns the length of the numeric literal at the start of s.
+func scanNumber(s string) int {
+	j := 0
+	for j < len(s) && (isDigit(rune(s[j])) || s[j] == '.' || s[j] == '_') {
+		j++
+	}
+	if j < len(s) && (s[j] == 'e' || s[j] == 'E') {
+		k := j + 1
+		if k < len(s) && (s[k] == '+' || s[k] == '-') {
+			k++
+		}
+		if k < len(s) && isDigit(rune(s[k])) {
+			j = k
+			for j < len(s) && isDigit(rune(s[j])) {
+				j++
+			}
+		}
+	}
+	return j
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -18,16 +18,21 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/exportfilename"
 	"github.com/rilldata/rill/runtime/pkg/expressionpb"
+	"github.com/rilldata/rill/runtime/pkg/locale"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"github.com/rilldata/rill/runtime/pkg/queryerr"
 	"github.com/xuri/excelize/v2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-var ErrForbidden = errors.New("action not allowed")
+var ErrForbidden = queryerr.New(queryerr.ReasonSecurityDenied, "action not allowed")
 
 // resolveMVAndSecurityFromAttributes resolves the metrics view and security policy from the attributes
 func resolveMVAndSecurityFromAttributes(ctx context.Context, rt *runtime.Runtime, instanceID, metricsViewName string, claims *runtime.SecurityClaims) (*runtimev1.MetricsViewSpec, *runtime.ResolvedSecurity, error) {
@@ -57,18 +62,69 @@ func lookupMetricsView(ctx context.Context, rt *runtime.Runtime, instanceID, nam
 
 	res, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: name}, false)
 	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, nil, queryerr.Errorf(queryerr.ReasonMetricsViewNotFound, "metrics view %q not found", name)
+		}
 		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	mv := res.GetMetricsView()
 	spec := mv.State.ValidSpec
 	if spec == nil {
-		return nil, nil, status.Errorf(codes.InvalidArgument, "metrics view %q is invalid", name)
+		return nil, nil, queryerr.Errorf(queryerr.ReasonMetricsViewInvalid, "metrics view %q is invalid", name)
 	}
 
 	return res, spec, nil
 }
 
+// metricsViewQueryDeps returns the deps of a query against a metrics view, including the segments referenced in its expressions.
+// Tracking the segments ensures cached results are invalidated when a segment's filter changes.
+func metricsViewQueryDeps(metricsViewName string, exprs ...*runtimev1.Expression) []*runtimev1.ResourceName {
+	deps := []*runtimev1.ResourceName{
+		{Kind: runtime.ResourceKindMetricsView, Name: metricsViewName},
+	}
+	var walk func(e *runtimev1.Expression)
+	walk = func(e *runtimev1.Expression) {
+		if e == nil {
+			return
+		}
+		if s := e.GetSegment(); s != "" {
+			deps = append(deps, &runtimev1.ResourceName{Kind: runtime.ResourceKindSegment, Name: s})
+		}
+		for _, e := range e.GetCond().GetExprs() {
+			walk(e)
+		}
+		if sq := e.GetSubquery(); sq != nil {
+			walk(sq.Where)
+			walk(sq.Having)
+		}
+	}
+	for _, e := range exprs {
+		walk(e)
+	}
+	return deps
+}
+
+// metricsViewTimeRangeFromProto converts a time range from its proto representation.
+func metricsViewTimeRangeFromProto(tr *runtimev1.TimeRange) *metricsview.TimeRange {
+	if tr == nil {
+		return nil
+	}
+	res := &metricsview.TimeRange{
+		IsoDuration:  tr.IsoDuration,
+		IsoOffset:    tr.IsoOffset,
+		RoundToGrain: metricsview.TimeGrainFromProto(tr.RoundToGrain),
+		Expression:   tr.Expression,
+	}
+	if tr.Start != nil {
+		res.Start = tr.Start.AsTime()
+	}
+	if tr.End != nil {
+		res.End = tr.End.AsTime()
+	}
+	return res
+}
+
 func metricsQuery(ctx context.Context, olap drivers.OLAPStore, priority int, sql string, args []any) ([]*runtimev1.MetricsViewColumn, []*structpb.Struct, error) {
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            sql,
@@ -90,6 +146,11 @@ func metricsQuery(ctx context.Context, olap drivers.OLAPStore, priority int, sql
 }
 
 func rowsToData(rows *drivers.Result) ([]*structpb.Struct, error) {
+	return rowsToDataWithDecimals(rows, false)
+}
+
+// rowsToDataWithDecimals is similar to rowsToData, but optionally encodes decimal values as exact decimal strings instead of numbers.
+func rowsToDataWithDecimals(rows *drivers.Result, decimalsAsStrings bool) ([]*structpb.Struct, error) {
 	var data []*structpb.Struct
 	for rows.Next() {
 		rowMap := make(map[string]any)
@@ -102,6 +163,9 @@ func rowsToData(rows *drivers.Result) ([]*structpb.Struct, error) {
 		if err != nil {
 			return nil, err
 		}
+		if decimalsAsStrings && rows.Schema != nil {
+			pbutil.StringifyDecimals(rowStruct, rowMap, rows.Schema)
+		}
 
 		data = append(data, rowStruct)
 	}
@@ -126,6 +190,93 @@ func structTypeToMetricsViewColumn(v *runtimev1.StructType) []*runtimev1.Metrics
 	return res
 }
 
+// metricsViewColumnSource identifies the dimension or measure that a result column was computed from.
+type metricsViewColumnSource struct {
+	dimension string
+	measure   string
+	// ratio is true if the column is a comparison ratio of the measure.
+	ratio bool
+}
+
+// metricsViewColumnAnnotations returns annotations with metadata from the metrics view for the given result columns.
+// Columns are looked up in sources, or else matched to a dimension or measure with the same name. Columns that match neither are skipped.
+func metricsViewColumnAnnotations(mv *runtimev1.MetricsViewSpec, columns []string, sources map[string]metricsViewColumnSource) []*runtimev1.MetricsViewColumnAnnotation {
+	dims := make(map[string]*runtimev1.MetricsViewSpec_DimensionV2, len(mv.Dimensions))
+	for _, d := range mv.Dimensions {
+		dims[d.Name] = d
+	}
+	measures := make(map[string]*runtimev1.MetricsViewSpec_MeasureV2, len(mv.Measures))
+	for _, m := range mv.Measures {
+		measures[m.Name] = m
+	}
+
+	var res []*runtimev1.MetricsViewColumnAnnotation
+	for _, col := range columns {
+		src, ok := sources[col]
+		if !ok {
+			src = metricsViewColumnSource{dimension: col, measure: col}
+		}
+
+		if src.dimension != "" {
+			if d, ok := dims[src.dimension]; ok {
+				res = append(res, &runtimev1.MetricsViewColumnAnnotation{
+					Name:        col,
+					Dimension:   d.Name,
+					Label:       d.Label,
+					Description: d.Description,
+				})
+				continue
+			}
+			if src.dimension == mv.TimeDimension {
+				res = append(res, &runtimev1.MetricsViewColumnAnnotation{
+					Name:      col,
+					Dimension: mv.TimeDimension,
+				})
+				continue
+			}
+		}
+
+		if src.measure != "" {
+			if m, ok := measures[src.measure]; ok {
+				a := &runtimev1.MetricsViewColumnAnnotation{
+					Name:         col,
+					Measure:      m.Name,
+					Label:        m.Label,
+					Description:  m.Description,
+					FormatPreset: m.FormatPreset,
+					FormatD3:     m.FormatD3,
+					Unit:         m.Unit,
+				}
+				if src.ratio {
+					a.FormatPreset = "percentage"
+					a.FormatD3 = ""
+					a.Unit = ""
+				}
+				res = append(res, a)
+			}
+		}
+	}
+	return res
+}
+
+// structTypeNames returns the field names of a struct type.
+func structTypeNames(v *runtimev1.StructType) []string {
+	res := make([]string, len(v.Fields))
+	for i, f := range v.Fields {
+		res[i] = f.Name
+	}
+	return res
+}
+
+// metricsViewColumnNames returns the names of the given columns.
+func metricsViewColumnNames(meta []*runtimev1.MetricsViewColumn) []string {
+	res := make([]string, len(meta))
+	for i, c := range meta {
+		res[i] = c.Name
+	}
+	return res
+}
+
 type ExpressionBuilder struct {
 	mv       *runtimev1.MetricsViewSpec
 	aliases  []*runtimev1.MetricsViewComparisonMeasureAlias
@@ -217,6 +368,9 @@ func (builder *ExpressionBuilder) buildExpression(expr *runtimev1.Expression) (s
 
 	case *runtimev1.Expression_Cond:
 		return builder.buildConditionExpression(e.Cond)
+
+	case *runtimev1.Expression_Segment:
+		return "", nil, fmt.Errorf("segments are not supported in this query")
 	}
 
 	return "", nil, nil
@@ -230,6 +384,12 @@ func (builder *ExpressionBuilder) buildConditionExpression(cond *runtimev1.Condi
 	case runtimev1.Operation_OPERATION_IN, runtimev1.Operation_OPERATION_NIN:
 		return builder.buildInExpression(cond)
 
+	case runtimev1.Operation_OPERATION_IP_IN_CIDR, runtimev1.Operation_OPERATION_IP_NIN_CIDR, runtimev1.Operation_OPERATION_IP_BETWEEN:
+		return builder.buildIPExpression(cond)
+
+	case runtimev1.Operation_OPERATION_REGEX:
+		return builder.buildRegexExpression(cond)
+
 	case runtimev1.Operation_OPERATION_AND:
 		return builder.buildAndOrExpressions(cond, " AND ")
 
@@ -276,10 +436,26 @@ func (builder *ExpressionBuilder) buildLikeExpression(cond *runtimev1.Condition)
 	// identify if immediate identifier has unnest
 	unnest := builder.identifierIsUnnest(cond.Exprs[0])
 
+	// Wraps an expression to remove accents if the condition is accent-insensitive
+	unaccent := func(expr string) string { return expr }
+	if cond.AccentInsensitive {
+		if _, ok := builder.dialect.StripAccentsExpr(""); !ok {
+			return "", nil, fmt.Errorf("accent-insensitive matching is not supported for %s", builder.dialect.String())
+		}
+		unaccent = func(expr string) string {
+			res, _ := builder.dialect.StripAccentsExpr(expr)
+			return res
+		}
+	}
+
 	var clause string
 	// Build [NOT] len(list_filter("dim", x -> x ILIKE ?)) > 0
 	if unnest && builder.dialect != drivers.DialectDruid && builder.dialect != drivers.DialectPinot {
-		clause = fmt.Sprintf("%s len(list_filter((%s), x -> x ILIKE %s)) > 0", notKeyword, leftExpr, rightExpr)
+		clause = fmt.Sprintf("%s len(list_filter((%s), x -> %s ILIKE %s)) > 0", notKeyword, leftExpr, unaccent("x"), unaccent(rightExpr))
+	} else if cond.AccentInsensitive && builder.dialect.SupportsILike() {
+		clause = fmt.Sprintf("%s %s ILIKE %s", unaccent("("+leftExpr+")"), notKeyword, unaccent(rightExpr))
+	} else if cond.AccentInsensitive {
+		clause = fmt.Sprintf("LOWER(%s) %s LIKE LOWER(%s)", unaccent("("+leftExpr+")"), notKeyword, unaccent(rightExpr))
 	} else {
 		if builder.dialect == drivers.DialectDruid || builder.dialect == drivers.DialectPinot {
 			// Druid and Pinot does not support ILIKE
@@ -298,6 +474,95 @@ func (builder *ExpressionBuilder) buildLikeExpression(cond *runtimev1.Condition)
 	return clause, args, nil
 }
 
+func (builder *ExpressionBuilder) buildIPExpression(cond *runtimev1.Condition) (string, []any, error) {
+	if len(cond.Exprs) < 2 {
+		return "", nil, fmt.Errorf("ip expression should have at least 2 sub expressions")
+	}
+
+	var vals []any
+	for _, e := range cond.Exprs[1:] {
+		v, ok := e.Expression.(*runtimev1.Expression_Val)
+		if !ok {
+			return "", nil, fmt.Errorf("ip expression only supports values on the right side")
+		}
+		val, err := pbutil.FromValue(v.Val)
+		if err != nil {
+			return "", nil, err
+		}
+		vals = append(vals, val)
+	}
+
+	var start, end string
+	var err error
+	if cond.Op == runtimev1.Operation_OPERATION_IP_BETWEEN {
+		start, end, err = metricsview.IPRangeBounds(vals)
+	} else {
+		cidr, ok := vals[0].(string)
+		if !ok || len(vals) != 1 {
+			return "", nil, fmt.Errorf("ip_in_cidr/ip_nin_cidr expression should have a single CIDR string on the right side")
+		}
+		start, end, err = metricsview.CIDRBounds(cidr)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	leftExpr, args, err := builder.buildExpression(cond.Exprs[0])
+	if err != nil {
+		return "", nil, err
+	}
+	ipExpr, ok := builder.dialect.IPAddressExpr(leftExpr)
+	if !ok {
+		return "", nil, fmt.Errorf("ip expressions are not supported for %s", builder.dialect.String())
+	}
+	boundExpr, _ := builder.dialect.IPAddressExpr("?")
+	clause := fmt.Sprintf("(%s BETWEEN %s AND %s)", ipExpr, boundExpr, boundExpr)
+	args = append(args, start, end)
+
+	// Include NULL values when excluding a CIDR block, consistent with NOT LIKE.
+	if cond.Op == runtimev1.Operation_OPERATION_IP_NIN_CIDR {
+		clause = fmt.Sprintf("(NOT %s OR (%s) IS NULL)", clause, leftExpr)
+	}
+
+	return clause, args, nil
+}
+
+func (builder *ExpressionBuilder) buildRegexExpression(cond *runtimev1.Condition) (string, []any, error) {
+	if len(cond.Exprs) != 2 {
+		return "", nil, fmt.Errorf("regex expression should have exactly 2 sub expressions")
+	}
+
+	v, ok := cond.Exprs[1].Expression.(*runtimev1.Expression_Val)
+	if !ok {
+		return "", nil, fmt.Errorf("regex expression only supports a string value on the right side")
+	}
+	pattern, ok := v.Val.GetKind().(*structpb.Value_StringValue)
+	if !ok {
+		return "", nil, fmt.Errorf("regex expression only supports a string value on the right side")
+	}
+	err := metricsview.ValidateRegex(pattern.StringValue)
+	if err != nil {
+		return "", nil, err
+	}
+
+	leftExpr, args, err := builder.buildExpression(cond.Exprs[0])
+	if err != nil {
+		return "", nil, err
+	}
+	clause, ok := builder.dialect.RegexpMatchExpr(leftExpr, "?")
+	if !ok {
+		return "", nil, fmt.Errorf("regex expressions are not supported for %s", builder.dialect.String())
+	}
+	// Build len(list_filter("dim", x -> regexp_matches(x, ?))) > 0 for unnested dimensions
+	if builder.identifierIsUnnest(cond.Exprs[0]) && builder.dialect != drivers.DialectDruid && builder.dialect != drivers.DialectPinot {
+		match, _ := builder.dialect.RegexpMatchExpr("x", "?")
+		clause = fmt.Sprintf("len(list_filter((%s), x -> %s)) > 0", leftExpr, match)
+	}
+	args = append(args, pattern.StringValue)
+
+	return clause, args, nil
+}
+
 func (builder *ExpressionBuilder) buildInExpression(cond *runtimev1.Condition) (string, []any, error) {
 	if len(cond.Exprs) <= 1 {
 		return "", nil, fmt.Errorf("in/not in expression should have at least 2 sub expressions")
@@ -407,6 +672,52 @@ func conditionExpressionOperation(oprn runtimev1.Operation) string {
 	panic(fmt.Sprintf("unknown condition operation: %v", oprn))
 }
 
+// builtinMeasureCompute converts a builtin measure to a computed measure in the metricsview package.
+// It returns nil if the measure is not a builtin measure.
+func builtinMeasureCompute(m *runtimev1.MetricsViewAggregationMeasure) (*metricsview.MeasureCompute, error) {
+	nargs := 0
+	switch m.BuiltinMeasure {
+	case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED:
+		return nil, nil
+	case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT:
+		return &metricsview.MeasureCompute{Count: true}, nil
+	case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_ARGMAX, runtimev1.BuiltinMeasure_BUILTIN_MEASURE_ARGMIN:
+		nargs = 2
+	default:
+		nargs = 1
+	}
+
+	if len(m.BuiltinMeasureArgs) != nargs {
+		return nil, fmt.Errorf("builtin measure %s expects %d argument(s), got %d", m.BuiltinMeasure, nargs, len(m.BuiltinMeasureArgs))
+	}
+	args := make([]string, nargs)
+	for i, arg := range m.BuiltinMeasureArgs {
+		args[i] = arg.GetStringValue()
+		if args[i] == "" {
+			return nil, fmt.Errorf("builtin measure %s expects dimension names as arguments", m.BuiltinMeasure)
+		}
+	}
+
+	switch m.BuiltinMeasure {
+	case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT_DISTINCT:
+		return &metricsview.MeasureCompute{CountDistinct: &metricsview.MeasureComputeCountDistinct{Dimension: args[0]}}, nil
+	case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_MIN:
+		return &metricsview.MeasureCompute{Aggregate: &metricsview.MeasureComputeAggregate{Function: metricsview.AggregateFunctionMin, Dimension: args[0]}}, nil
+	case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_MAX:
+		return &metricsview.MeasureCompute{Aggregate: &metricsview.MeasureComputeAggregate{Function: metricsview.AggregateFunctionMax, Dimension: args[0]}}, nil
+	case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_SUM:
+		return &metricsview.MeasureCompute{Aggregate: &metricsview.MeasureComputeAggregate{Function: metricsview.AggregateFunctionSum, Dimension: args[0]}}, nil
+	case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_AVG:
+		return &metricsview.MeasureCompute{Aggregate: &metricsview.MeasureComputeAggregate{Function: metricsview.AggregateFunctionAvg, Dimension: args[0]}}, nil
+	case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_ARGMAX:
+		return &metricsview.MeasureCompute{ArgMax: &metricsview.MeasureComputeArg{Dimension: args[0], By: args[1]}}, nil
+	case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_ARGMIN:
+		return &metricsview.MeasureCompute{ArgMin: &metricsview.MeasureComputeArg{Dimension: args[0], By: args[1]}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported builtin measure %s", m.BuiltinMeasure)
+	}
+}
+
 func convertFilterToExpression(filter *runtimev1.MetricsViewFilter) *runtimev1.Expression {
 	var exprs []*runtimev1.Expression
 
@@ -491,7 +802,7 @@ func convertDimensionFilterToExpression(cond *runtimev1.MetricsViewFilter_Cond,
 
 func convertToString(pbvalue *structpb.Value) (string, error) {
 	switch pbvalue.GetKind().(type) {
-	case *structpb.Value_StructValue:
+	case *structpb.Value_StructValue, *structpb.Value_ListValue:
 		bts, err := protojson.Marshal(pbvalue)
 		if err != nil {
 			return "", err
@@ -507,7 +818,7 @@ func convertToString(pbvalue *structpb.Value) (string, error) {
 
 func convertToXLSXValue(pbvalue *structpb.Value) (interface{}, error) {
 	switch pbvalue.GetKind().(type) {
-	case *structpb.Value_StructValue:
+	case *structpb.Value_StructValue, *structpb.Value_ListValue:
 		bts, err := protojson.Marshal(pbvalue)
 		if err != nil {
 			return "", err
@@ -521,8 +832,10 @@ func convertToXLSXValue(pbvalue *structpb.Value) (interface{}, error) {
 	}
 }
 
-func WriteCSV(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer) error {
+// WriteCSV writes the data as CSV. The locale controls the decimal separator of numbers and the delimiter; it may be nil for the default locale.
+func WriteCSV(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer, loc *locale.Locale) error {
 	w := csv.NewWriter(writer)
+	w.Comma = loc.CSVDelimiter()
 
 	record := make([]string, 0, len(meta))
 	for _, field := range meta {
@@ -540,6 +853,9 @@ func WriteCSV(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writ
 			if err != nil {
 				return err
 			}
+			if _, ok := pbvalue.GetKind().(*structpb.Value_NumberValue); ok {
+				str = loc.FormatNumber(str)
+			}
 
 			record = append(record, str)
 		}
@@ -763,6 +1079,28 @@ func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOpti
 	return err
 }
 
+// exportFilenameVars returns the values for the placeholders of an export filename template.
+// Explicit start and end bounds take precedence over the bounds of tr.
+func exportFilenameVars(metricsView string, tr *runtimev1.TimeRange, start, end *timestamppb.Timestamp) *exportfilename.Vars {
+	vars := &exportfilename.Vars{MetricsView: metricsView}
+	if tr != nil {
+		if start == nil {
+			start = tr.Start
+		}
+		if end == nil {
+			end = tr.End
+		}
+		vars.TimeRangeISO = tr.IsoDuration
+	}
+	if start != nil {
+		vars.TimeStart = start.AsTime()
+	}
+	if end != nil {
+		vars.TimeEnd = end.AsTime()
+	}
+	return vars
+}
+
 func (q *MetricsViewRows) generateFilename(mv *runtimev1.MetricsViewSpec) string {
 	filename := strings.ReplaceAll(mv.Table, `"`, `_`)
 	if q.TimeStart != nil || q.TimeEnd != nil || q.Where != nil {
@@ -12,6 +12,9 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/queryerr"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type MetricsViewAggregation struct {
@@ -28,12 +31,21 @@ type MetricsViewAggregation struct {
 	Limit               *int64                                         `json:"limit,omitempty"`
 	Offset              int64                                          `json:"offset,omitempty"`
 	PivotOn             []string                                       `json:"pivot_on,omitempty"`
+	PivotColumns        *runtimev1.MetricsViewAggregationPivotColumns  `json:"pivot_columns,omitempty"`
 	SecurityClaims      *runtime.SecurityClaims                        `json:"security_claims,omitempty"`
 	Aliases             []*runtimev1.MetricsViewComparisonMeasureAlias `json:"aliases,omitempty"`
 	Exact               bool                                           `json:"exact,omitempty"`
+	AllowPartialResults bool                                           `json:"allow_partial_results,omitempty"`
+	DecimalsAsStrings   bool                                           `json:"decimals_as_strings,omitempty"`
+
+	// ExecutionTimeoutSeconds overrides the default query timeout.
+	ExecutionTimeoutSeconds uint32 `json:"execution_timeout_seconds,omitempty"`
 
 	Result    *runtimev1.MetricsViewAggregationResponse `json:"-"`
 	Exporting bool                                      `json:"-"` // Deprecated: Remove when tests call Export directly
+
+	// defaultLimitApplied is set when the query is rewritten if the server picked the limit because Limit was 0.
+	defaultLimitApplied bool
 }
 
 var _ runtime.Query = &MetricsViewAggregation{}
@@ -47,9 +59,7 @@ func (q *MetricsViewAggregation) Key() string {
 }
 
 func (q *MetricsViewAggregation) Deps() []*runtimev1.ResourceName {
-	return []*runtimev1.ResourceName{
-		{Kind: runtime.ResourceKindMetricsView, Name: q.MetricsViewName},
-	}
+	return metricsViewQueryDeps(q.MetricsViewName, q.Where, q.Having)
 }
 
 func (q *MetricsViewAggregation) MarshalResult() *runtime.QueryResult {
@@ -85,24 +95,213 @@ func (q *MetricsViewAggregation) Resolve(ctx context.Context, rt *runtime.Runtim
 	}
 	defer e.Close()
 
-	res, _, err := e.Query(ctx, qry, nil)
+	probed := probeTruncation(qry, e.RowsCap())
+
+	res, headers, err := e.QueryWithPivotHeaders(ctx, qry, nil)
 	if err != nil {
+		if q.AllowPartialResults {
+			return q.resolvePartial(ctx, mv, e, err)
+		}
 		return err
 	}
 	defer res.Close()
 
-	data, err := rowsToData(res)
+	data, err := rowsToDataWithDecimals(res, q.DecimalsAsStrings)
 	if err != nil {
 		return err
 	}
+	data, truncated := q.truncateData(data, probed)
 
 	q.Result = &runtimev1.MetricsViewAggregationResponse{
 		Schema: res.Schema,
 		Data:   data,
 	}
+	q.setResultHints(e, qry, truncated)
+	sources := q.columnSources()
+	for _, h := range headers {
+		q.Result.PivotHeaders = append(q.Result.PivotHeaders, &runtimev1.MetricsViewAggregationPivotHeader{
+			Column:  h.Column,
+			Values:  h.Values,
+			Measure: h.Measure,
+		})
+		src, ok := sources[h.Measure]
+		if !ok {
+			src = metricsViewColumnSource{measure: h.Measure}
+		}
+		sources[h.Column] = src
+	}
+	q.Result.Annotations = metricsViewColumnAnnotations(mv, structTypeNames(res.Schema), sources)
+	return nil
+}
+
+// columnSources maps the names of the result columns to the dimensions and measures they are computed from.
+// Columns not in the map have the same name as their dimension or measure.
+func (q *MetricsViewAggregation) columnSources() map[string]metricsViewColumnSource {
+	res := make(map[string]metricsViewColumnSource)
+	for _, d := range q.Dimensions {
+		if d.Alias != "" {
+			res[d.Alias] = metricsViewColumnSource{dimension: d.Name}
+		}
+	}
+	for _, m := range q.Measures {
+		if m.BuiltinMeasure != runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED {
+			// Builtin measures are not defined in the metrics view
+			res[m.Name] = metricsViewColumnSource{}
+			continue
+		}
+		switch c := m.Compute.(type) {
+		case *runtimev1.MetricsViewAggregationMeasure_Count, *runtimev1.MetricsViewAggregationMeasure_CountDistinct, *runtimev1.MetricsViewAggregationMeasure_Derived:
+			res[m.Name] = metricsViewColumnSource{}
+		case *runtimev1.MetricsViewAggregationMeasure_ComparisonValue:
+			res[m.Name] = metricsViewColumnSource{measure: c.ComparisonValue.Measure}
+		case *runtimev1.MetricsViewAggregationMeasure_ComparisonDelta:
+			res[m.Name] = metricsViewColumnSource{measure: c.ComparisonDelta.Measure}
+		case *runtimev1.MetricsViewAggregationMeasure_ComparisonRatio:
+			res[m.Name] = metricsViewColumnSource{measure: c.ComparisonRatio.Measure, ratio: true}
+		}
+	}
+	return res
+}
+
+// resolvePartial is called when the full query failed and partial results are allowed.
+// It probes each measure in isolation to find the measures that cause the failure,
+// and then re-runs the query without them, reporting them in the result's MeasureErrors.
+// If the failure can't be attributed to individual measures, it returns queryErr.
+func (q *MetricsViewAggregation) resolvePartial(ctx context.Context, mv *runtimev1.MetricsViewSpec, e *metricsview.Executor, queryErr error) error {
+	// Only errors returned by the OLAP can be caused by a measure's expression
+	if reason, _ := queryerr.ReasonOf(queryErr); reason != queryerr.ReasonOLAPError {
+		return queryErr
+	}
+
+	// Executor.Query mutates the query, so we rewrite a fresh query for every execution.
+	qry, err := q.rewriteToMetricsViewQuery(q.Exporting)
+	if err != nil {
+		return err
+	}
+	if len(qry.Measures) < 2 || len(qry.PivotOn) > 0 {
+		return queryErr
+	}
+
+	var measureErrs []*runtimev1.MetricsViewMeasureError
+	keep := make(map[string]bool)
+	for _, m := range qry.Measures {
+		probe, err := q.rewriteToMetricsViewQuery(q.Exporting)
+		if err != nil {
+			return err
+		}
+		retainMeasures(probe, map[string]bool{m.Name: true})
+		// The HAVING clause may reference other measures
+		probe.Having = nil
+
+		res, _, err := e.Query(ctx, probe, nil)
+		if err == nil {
+			res.Close()
+			keep[m.Name] = true
+			continue
+		}
+
+		reason, _ := queryerr.ReasonOf(err)
+		if reason != queryerr.ReasonOLAPError {
+			// For example a timeout or cancellation, which isn't specific to the measure
+			return err
+		}
+		measureErrs = append(measureErrs, &runtimev1.MetricsViewMeasureError{
+			Measure: m.Name,
+			Reason:  string(reason),
+			Message: err.Error(),
+		})
+	}
+	if len(measureErrs) == 0 || len(keep) == 0 {
+		return queryErr
+	}
+
+	qry, err = q.rewriteToMetricsViewQuery(q.Exporting)
+	if err != nil {
+		return err
+	}
+	retainMeasures(qry, keep)
+	probed := probeTruncation(qry, e.RowsCap())
+
+	res, _, err := e.Query(ctx, qry, nil)
+	if err != nil {
+		return queryErr
+	}
+	defer res.Close()
+
+	data, err := rowsToDataWithDecimals(res, q.DecimalsAsStrings)
+	if err != nil {
+		return err
+	}
+	data, truncated := q.truncateData(data, probed)
+
+	q.Result = &runtimev1.MetricsViewAggregationResponse{
+		Schema:        res.Schema,
+		Data:          data,
+		MeasureErrors: measureErrs,
+		Annotations:   metricsViewColumnAnnotations(mv, structTypeNames(res.Schema), q.columnSources()),
+	}
+	q.setResultHints(e, qry, truncated)
 	return nil
 }
 
+// probeTruncation increases the query's limit by one, so the result reveals whether the limit truncated it (see truncateData).
+// It returns false if the query has no limit, or if the increased limit would exceed the rows cap.
+func probeTruncation(qry *metricsview.Query, rowsCap int64) bool {
+	if qry.Limit == nil {
+		return false
+	}
+	limit := *qry.Limit + 1
+	if rowsCap > 0 && limit > rowsCap {
+		return false
+	}
+	qry.Limit = &limit
+	return true
+}
+
+// truncateData removes the extra row fetched by probeTruncation and reports whether the limit truncated the result.
+// If the limit couldn't be probed, a result with exactly as many rows as the limit is assumed to be truncated.
+func (q *MetricsViewAggregation) truncateData(data []*structpb.Struct, probed bool) ([]*structpb.Struct, bool) {
+	if q.Limit == nil {
+		return data, false
+	}
+	limit := int(*q.Limit)
+	if probed {
+		if len(data) > limit {
+			return data[:limit], true
+		}
+		return data, false
+	}
+	return data, len(data) == limit
+}
+
+// setResultHints sets the fields of the result that describe how the query was executed.
+// It must be called after the query has been executed, since the executor resolves the query's time ranges in-place.
+func (q *MetricsViewAggregation) setResultHints(e *metricsview.Executor, qry *metricsview.Query, truncated bool) {
+	q.Result.Truncated = truncated
+	if q.Limit != nil {
+		q.Result.Limit = *q.Limit
+	}
+	q.Result.DefaultLimitApplied = q.defaultLimitApplied
+	q.Result.ResolvedTimeRange = resolvedTimeRangeToProto(qry.TimeRange)
+	q.Result.ResolvedComparisonTimeRange = resolvedTimeRangeToProto(qry.ComparisonTimeRange)
+	q.Result.ExecutionTimeoutSeconds = uint32(e.ExecutionTimeout(qry).Seconds())
+}
+
+// resolvedTimeRangeToProto converts a time range resolved by the executor to a proto time range with only start and end set.
+func resolvedTimeRangeToProto(tr *metricsview.TimeRange) *runtimev1.TimeRange {
+	if tr == nil || (tr.Start.IsZero() && tr.End.IsZero()) {
+		return nil
+	}
+	res := &runtimev1.TimeRange{}
+	if !tr.Start.IsZero() {
+		res.Start = timestamppb.New(tr.Start)
+	}
+	if !tr.End.IsZero() {
+		res.End = timestamppb.New(tr.End)
+	}
+	return res
+}
+
 func (q *MetricsViewAggregation) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
 	filename := strings.ReplaceAll(q.MetricsViewName, `"`, `_`)
 	if !isTimeRangeNil(q.TimeRange) || q.Where != nil || q.Having != nil {
@@ -139,12 +338,19 @@ func (q *MetricsViewAggregation) Export(ctx context.Context, rt *runtime.Runtime
 		return fmt.Errorf("unsupported format: %s", opts.Format.String())
 	}
 
+	qry.Locale = opts.Locale
+
 	path, err := e.Export(ctx, qry, nil, format)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = os.Remove(path) }()
 
+	filename, err = opts.Filename(filename, exportFilenameVars(q.MetricsViewName, q.TimeRange, nil, nil))
+	if err != nil {
+		return err
+	}
+
 	err = opts.PreWriteHook(filename)
 	if err != nil {
 		return err
@@ -165,7 +371,7 @@ func (q *MetricsViewAggregation) Export(ctx context.Context, rt *runtime.Runtime
 }
 
 func (q *MetricsViewAggregation) rewriteToMetricsViewQuery(export bool) (*metricsview.Query, error) {
-	qry := &metricsview.Query{MetricsView: q.MetricsViewName}
+	qry := &metricsview.Query{MetricsView: q.MetricsViewName, ExecutionTimeoutSeconds: q.ExecutionTimeoutSeconds}
 
 	for _, d := range q.Dimensions {
 		res := metricsview.Dimension{Name: d.Name}
@@ -189,15 +395,11 @@ func (q *MetricsViewAggregation) rewriteToMetricsViewQuery(export bool) (*metric
 	var measureFilter *runtimev1.Expression
 
 	for _, m := range q.Measures {
-		res := metricsview.Measure{Name: m.Name}
-		switch m.BuiltinMeasure {
-		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT:
-			res.Compute = &metricsview.MeasureCompute{Count: true}
-		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT_DISTINCT:
-			res.Compute = &metricsview.MeasureCompute{CountDistinct: &metricsview.MeasureComputeCountDistinct{
-				Dimension: m.BuiltinMeasureArgs[0].GetStringValue(),
-			}}
+		compute, err := builtinMeasureCompute(m)
+		if err != nil {
+			return nil, err
 		}
+		res := metricsview.Measure{Name: m.Name, Compute: compute}
 
 		if m.Filter != nil {
 			if len(q.Measures) > 1 {
@@ -220,6 +422,10 @@ func (q *MetricsViewAggregation) rewriteToMetricsViewQuery(export bool) (*metric
 				res.Compute = &metricsview.MeasureCompute{ComparisonRatio: &metricsview.MeasureComputeComparisonRatio{
 					Measure: c.ComparisonRatio.Measure,
 				}}
+			case *runtimev1.MetricsViewAggregationMeasure_Derived:
+				res.Compute = &metricsview.MeasureCompute{Derived: &metricsview.MeasureComputeDerived{
+					Expression: c.Derived.Expression,
+				}}
 			}
 		}
 
@@ -246,6 +452,7 @@ func (q *MetricsViewAggregation) rewriteToMetricsViewQuery(export bool) (*metric
 		res.IsoDuration = q.TimeRange.IsoDuration
 		res.IsoOffset = q.TimeRange.IsoOffset
 		res.RoundToGrain = metricsview.TimeGrainFromProto(q.TimeRange.RoundToGrain)
+		res.Expression = q.TimeRange.Expression
 		qry.TimeRange = res
 	}
 
@@ -260,6 +467,7 @@ func (q *MetricsViewAggregation) rewriteToMetricsViewQuery(export bool) (*metric
 		res.IsoDuration = q.ComparisonTimeRange.IsoDuration
 		res.IsoOffset = q.ComparisonTimeRange.IsoOffset
 		res.RoundToGrain = metricsview.TimeGrainFromProto(q.ComparisonTimeRange.RoundToGrain)
+		res.Expression = q.ComparisonTimeRange.Expression
 		qry.ComparisonTimeRange = res
 	}
 
@@ -301,6 +509,7 @@ func (q *MetricsViewAggregation) rewriteToMetricsViewQuery(export bool) (*metric
 		if *q.Limit == 0 {
 			tmp := int64(100)
 			q.Limit = &tmp
+			q.defaultLimitApplied = true
 		}
 		qry.Limit = q.Limit
 	}
@@ -313,7 +522,46 @@ func (q *MetricsViewAggregation) rewriteToMetricsViewQuery(export bool) (*metric
 		qry.PivotOn = q.PivotOn
 	}
 
+	if q.PivotColumns != nil {
+		sort, err := metricsview.PivotColumnSortFromProto(q.PivotColumns.Sort)
+		if err != nil {
+			return nil, err
+		}
+		qry.PivotColumns = &metricsview.PivotColumns{
+			Sort:       sort,
+			Measure:    q.PivotColumns.Measure,
+			Desc:       q.PivotColumns.Desc,
+			OtherLabel: q.PivotColumns.OtherLabel,
+		}
+		if q.PivotColumns.Limit > 0 {
+			qry.PivotColumns.Limit = &q.PivotColumns.Limit
+		}
+	}
+
 	qry.Label = export
 
 	return qry, nil
 }
+
+// retainMeasures removes the measures that are not in keep from the query, along with any sort fields that reference them.
+func retainMeasures(qry *metricsview.Query, keep map[string]bool) {
+	measures := make([]metricsview.Measure, 0, len(keep))
+	for _, m := range qry.Measures {
+		if keep[m.Name] {
+			measures = append(measures, m)
+		}
+	}
+	qry.Measures = measures
+
+	dims := make(map[string]bool, len(qry.Dimensions))
+	for _, d := range qry.Dimensions {
+		dims[d.Name] = true
+	}
+	sort := make([]metricsview.Sort, 0, len(qry.Sort))
+	for _, s := range qry.Sort {
+		if dims[s.Name] || keep[s.Name] {
+			sort = append(sort, s)
+		}
+	}
+	qry.Sort = sort
+}
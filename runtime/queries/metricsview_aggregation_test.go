@@ -421,6 +421,181 @@ func TestMetricsViewsAggregation_pivot(t *testing.T) {
 	require.Equal(t, "Yahoo", fieldsToString(rows[i], "pub"))
 }
 
+func TestMetricsViewsAggregation_pivot_headers(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{
+				Name: "dom",
+			},
+			{
+				Name: "pub",
+			},
+			{
+				Name:      "timestamp",
+				TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+			},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{
+				Name: "measure_0",
+			},
+			{
+				Name: "measure_1",
+			},
+		},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "dom",
+			},
+		},
+		PivotOn: []string{"timestamp", "pub"},
+		PivotColumns: &runtimev1.MetricsViewAggregationPivotColumns{
+			Sort: runtimev1.PivotColumnSort_PIVOT_COLUMN_SORT_ALPHABETICAL,
+		},
+		SecurityClaims: testClaims(),
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result)
+
+	// Every pivoted column has a header, in the order of the schema
+	headers := q.Result.PivotHeaders
+	require.Equal(t, len(q.Result.Schema.Fields)-1, len(headers))
+	require.Equal(t, "dom", q.Result.Schema.Fields[0].Name)
+	for i, h := range headers {
+		require.Equal(t, q.Result.Schema.Fields[i+1].Name, h.Column)
+	}
+
+	require.Equal(t, "2022-01-01 00:00:00_Facebook_measure_0", headers[0].Column)
+	require.Equal(t, []string{"2022-01-01 00:00:00", "Facebook"}, headers[0].Values)
+	require.Equal(t, "measure_0", headers[0].Measure)
+	require.Equal(t, "2022-01-01 00:00:00_Facebook_measure_1", headers[1].Column)
+	require.Equal(t, []string{"2022-01-01 00:00:00", "Facebook"}, headers[1].Values)
+	require.Equal(t, "measure_1", headers[1].Measure)
+	require.Equal(t, []string{"2022-01-01 00:00:00", "Google"}, headers[2].Values)
+}
+
+func TestMetricsViewsAggregation_pivot_columns_sort(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{
+				Name: "pub",
+			},
+			{
+				Name:      "timestamp",
+				TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+			},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{
+				Name: "measure_1",
+			},
+		},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "pub",
+			},
+		},
+		PivotOn: []string{"timestamp"},
+		PivotColumns: &runtimev1.MetricsViewAggregationPivotColumns{
+			Sort: runtimev1.PivotColumnSort_PIVOT_COLUMN_SORT_VALUE,
+			Desc: true,
+		},
+		SecurityClaims: testClaims(),
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result)
+
+	require.Equal(t, 4, len(q.Result.Schema.Fields))
+	require.Equal(t, "pub", q.Result.Schema.Fields[0].Name)
+	require.Equal(t, "2022-03-01 00:00:00_measure_1", q.Result.Schema.Fields[1].Name)
+	require.Equal(t, "2022-02-01 00:00:00_measure_1", q.Result.Schema.Fields[2].Name)
+	require.Equal(t, "2022-01-01 00:00:00_measure_1", q.Result.Schema.Fields[3].Name)
+	require.Equal(t, 5, len(q.Result.Data))
+}
+
+func TestMetricsViewsAggregation_pivot_columns_limit(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{
+				Name: "dom",
+			},
+			{
+				Name: "pub",
+			},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{
+				Name: "measure_0",
+			},
+		},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "dom",
+			},
+		},
+		PivotOn: []string{"pub"},
+		PivotColumns: &runtimev1.MetricsViewAggregationPivotColumns{
+			Limit:      2,
+			OtherLabel: "Rest",
+		},
+		SecurityClaims: testClaims(),
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result)
+
+	// The two publishers with the most bids are pivoted, and the rest are rolled up
+	require.Equal(t, 4, len(q.Result.Schema.Fields))
+	require.Equal(t, "dom", q.Result.Schema.Fields[0].Name)
+	require.Equal(t, "Facebook_measure_0", q.Result.Schema.Fields[1].Name)
+	require.Equal(t, "Google_measure_0", q.Result.Schema.Fields[2].Name)
+	require.Equal(t, "Rest_measure_0", q.Result.Schema.Fields[3].Name)
+
+	// The rollup includes all remaining bids, so the columns add up to the domain's total
+	totals := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{
+				Name: "dom",
+			},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{
+				Name: "measure_0",
+			},
+		},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "dom",
+			},
+		},
+		SecurityClaims: testClaims(),
+	}
+	err = totals.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Equal(t, len(totals.Result.Data), len(q.Result.Data))
+	for i, row := range q.Result.Data {
+		require.Equal(t, totals.Result.Data[i].Fields["dom"].GetStringValue(), row.Fields["dom"].GetStringValue())
+
+		var sum float64
+		for _, f := range q.Result.Schema.Fields[1:] {
+			sum += row.Fields[f.Name].GetNumberValue()
+		}
+		require.Equal(t, totals.Result.Data[i].Fields["measure_0"].GetNumberValue(), sum)
+	}
+}
+
 func TestMetricsViewsAggregation_pivot_export_labels_2_time_columns_limit_exceeded_error(t *testing.T) {
 	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
 
@@ -4425,3 +4600,173 @@ func fieldsToString(row *structpb.Struct, args ...string) string {
 	}
 	return strings.Join(s, ",")
 }
+
+func TestMetricsViewsAggregation_annotations(t *testing.T) {
+	rt, instanceID := testruntime.NewProject().
+		Seed("orders", "country,amount\nDK,10\nDK,5\nUS,20\n").
+		Model("orders_model", "SELECT * FROM orders").
+		MetricsView("orders_mv", testruntime.MetricsView{
+			Model:      "orders_model",
+			Dimensions: []testruntime.Dimension{{Name: "country", Label: "Country", Column: "country"}},
+			Measures: []testruntime.Measure{
+				{Name: "revenue", Label: "Revenue", Expression: "SUM(amount)", FormatPreset: "currency_usd", Unit: "USD"},
+			},
+		}).
+		Build(t)
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "orders_mv",
+		Dimensions:      []*runtimev1.MetricsViewAggregationDimension{{Name: "country"}},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{Name: "revenue"},
+			{Name: "rows", BuiltinMeasure: runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT},
+		},
+		Sort:           []*runtimev1.MetricsViewAggregationSort{{Name: "country"}},
+		SecurityClaims: testClaims(),
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Len(t, q.Result.Data, 2)
+
+	annotations := q.Result.Annotations
+	require.Len(t, annotations, 2)
+	require.Equal(t, "country", annotations[0].Name)
+	require.Equal(t, "country", annotations[0].Dimension)
+	require.Equal(t, "Country", annotations[0].Label)
+	require.Equal(t, "revenue", annotations[1].Name)
+	require.Equal(t, "revenue", annotations[1].Measure)
+	require.Equal(t, "Revenue", annotations[1].Label)
+	require.Equal(t, "currency_usd", annotations[1].FormatPreset)
+	require.Equal(t, "USD", annotations[1].Unit)
+}
+
+func TestMetricsViewsAggregation_result_hints(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	limit := int64(2)
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions:      []*runtimev1.MetricsViewAggregationDimension{{Name: "pub"}},
+		Measures:        []*runtimev1.MetricsViewAggregationMeasure{{Name: "measure_1"}},
+		Sort:            []*runtimev1.MetricsViewAggregationSort{{Name: "pub"}},
+		TimeRange: &runtimev1.TimeRange{
+			End:         timestamppb.New(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)),
+			IsoDuration: "P1M",
+		},
+		Limit:                   &limit,
+		ExecutionTimeoutSeconds: 30,
+		SecurityClaims:          testClaims(),
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Len(t, q.Result.Data, 2)
+	require.True(t, q.Result.Truncated)
+	require.Equal(t, int64(2), q.Result.Limit)
+	require.False(t, q.Result.DefaultLimitApplied)
+	require.Equal(t, time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC), q.Result.ResolvedTimeRange.Start.AsTime())
+	require.Equal(t, time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), q.Result.ResolvedTimeRange.End.AsTime())
+	require.Nil(t, q.Result.ResolvedComparisonTimeRange)
+	require.Equal(t, uint32(30), q.Result.ExecutionTimeoutSeconds)
+
+	// A limit of 0 applies the default limit, which doesn't truncate the few publishers
+	limit = 0
+	q = &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions:      []*runtimev1.MetricsViewAggregationDimension{{Name: "pub"}},
+		Measures:        []*runtimev1.MetricsViewAggregationMeasure{{Name: "measure_1"}},
+		Sort:            []*runtimev1.MetricsViewAggregationSort{{Name: "pub"}},
+		Limit:           &limit,
+		SecurityClaims:  testClaims(),
+	}
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Len(t, q.Result.Data, 5)
+	require.False(t, q.Result.Truncated)
+	require.Equal(t, int64(100), q.Result.Limit)
+	require.True(t, q.Result.DefaultLimitApplied)
+	require.Nil(t, q.Result.ResolvedTimeRange)
+}
+
+func TestMetricsViewAggregation_KeyIncludesTimeout(t *testing.T) {
+	// Queries with the same key share an execution, so queries with different timeouts must have different keys
+	q1 := &queries.MetricsViewAggregation{MetricsViewName: "ad_bids_metrics", ExecutionTimeoutSeconds: 10}
+	q2 := &queries.MetricsViewAggregation{MetricsViewName: "ad_bids_metrics", ExecutionTimeoutSeconds: 600}
+	require.NotEqual(t, q1.Key(), q2.Key())
+}
+
+func TestMetricsViewAggregation_PaginateTies(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"models/cities.sql": `
+SELECT * FROM (VALUES
+	('DK', 'Copenhagen', 'mobile'),
+	('DK', 'Aarhus', 'desktop'),
+	('SE', 'Stockholm', 'mobile'),
+	('SE', 'Gothenburg', 'mobile'),
+	('NO', 'Oslo', 'desktop'),
+	('NO', 'Bergen', 'mobile'),
+	('US', 'New York', 'desktop')
+) t(country, city, device)
+`,
+			"dashboards/cities_metrics.yaml": `
+model: cities
+dimensions:
+- column: country
+- column: city
+- column: device
+measures:
+- name: count
+  expression: count(*)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	want := []string{
+		"DK,Copenhagen", "DK,Aarhus", "SE,Stockholm", "SE,Gothenburg", "NO,Oslo", "NO,Bergen", "US,New York",
+	}
+
+	// Fetches all pages of two rows each and returns the union of the pages
+	paginate := func(t *testing.T, q queries.MetricsViewAggregation) []string {
+		var res []string
+		limit := int64(2)
+		for offset := int64(0); offset < int64(len(want))+2; offset += limit {
+			q := q
+			q.Limit = &limit
+			q.Offset = offset
+			q.SecurityClaims = testClaims()
+			err := q.Resolve(context.Background(), rt, instanceID, 0)
+			require.NoError(t, err)
+			for _, row := range q.Result.Data {
+				res = append(res, fieldsToString(row, "country", "city"))
+			}
+		}
+		return res
+	}
+
+	t.Run("plain", func(t *testing.T) {
+		// Every row has the same count, so the sort alone doesn't determine the order
+		res := paginate(t, queries.MetricsViewAggregation{
+			MetricsViewName: "cities_metrics",
+			Dimensions:      []*runtimev1.MetricsViewAggregationDimension{{Name: "country"}, {Name: "city"}},
+			Measures:        []*runtimev1.MetricsViewAggregationMeasure{{Name: "count"}},
+			Sort:            []*runtimev1.MetricsViewAggregationSort{{Name: "count", Desc: true}},
+		})
+		require.Len(t, res, len(want))
+		require.ElementsMatch(t, want, res)
+	})
+
+	t.Run("pivot", func(t *testing.T) {
+		// Several cities share a country, so sorting on the country alone doesn't determine the order
+		res := paginate(t, queries.MetricsViewAggregation{
+			MetricsViewName: "cities_metrics",
+			Dimensions:      []*runtimev1.MetricsViewAggregationDimension{{Name: "country"}, {Name: "city"}, {Name: "device"}},
+			Measures:        []*runtimev1.MetricsViewAggregationMeasure{{Name: "count"}},
+			Sort:            []*runtimev1.MetricsViewAggregationSort{{Name: "country"}},
+			PivotOn:         []string{"device"},
+		})
+		require.Len(t, res, len(want))
+		require.ElementsMatch(t, want, res)
+	})
+}
@@ -35,6 +35,9 @@ type MetricsViewComparison struct {
 	Exact               bool                                           `json:"exact"`
 	SecurityClaims      *runtime.SecurityClaims                        `json:"security_claims,omitempty"`
 
+	// ExecutionTimeoutSeconds overrides the default query timeout.
+	ExecutionTimeoutSeconds uint32 `json:"execution_timeout_seconds,omitempty"`
+
 	Result       *runtimev1.MetricsViewComparisonResponse `json:"-"`
 	measuresMeta map[string]metricsViewMeasureMeta        `json:"-"`
 }
@@ -56,9 +59,7 @@ func (q *MetricsViewComparison) Key() string {
 }
 
 func (q *MetricsViewComparison) Deps() []*runtimev1.ResourceName {
-	return []*runtimev1.ResourceName{
-		{Kind: runtime.ResourceKindMetricsView, Name: q.MetricsViewName},
-	}
+	return metricsViewQueryDeps(q.MetricsViewName, q.Where, q.Having)
 }
 
 func (q *MetricsViewComparison) MarshalResult() *runtime.QueryResult {
@@ -203,13 +204,19 @@ func (q *MetricsViewComparison) Export(ctx context.Context, rt *runtime.Runtime,
 		return fmt.Errorf("unsupported format: %s", opts.Format.String())
 	}
 
+	qry.Locale = opts.Locale
+
 	path, err := e.Export(ctx, qry, nil, format)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = os.Remove(path) }()
 
-	filename := q.generateFilename()
+	filename, err := opts.Filename(q.generateFilename(), exportFilenameVars(q.MetricsViewName, q.TimeRange, nil, nil))
+	if err != nil {
+		return err
+	}
+
 	err = opts.PreWriteHook(filename)
 	if err != nil {
 		return err
@@ -239,22 +246,18 @@ func (q *MetricsViewComparison) generateFilename() string {
 }
 
 func (q *MetricsViewComparison) rewriteToMetricsViewQuery(export bool) (*metricsview.Query, error) {
-	qry := &metricsview.Query{MetricsView: q.MetricsViewName}
+	qry := &metricsview.Query{MetricsView: q.MetricsViewName, ExecutionTimeoutSeconds: q.ExecutionTimeoutSeconds}
 
 	qry.Dimensions = append(qry.Dimensions, metricsview.Dimension{Name: q.DimensionName})
 
 	var measureFilter *runtimev1.Expression
 
 	for _, m := range q.Measures {
-		res := metricsview.Measure{Name: m.Name}
-		switch m.BuiltinMeasure {
-		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT:
-			res.Compute = &metricsview.MeasureCompute{Count: true}
-		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_COUNT_DISTINCT:
-			res.Compute = &metricsview.MeasureCompute{CountDistinct: &metricsview.MeasureComputeCountDistinct{
-				Dimension: m.BuiltinMeasureArgs[0].GetStringValue(),
-			}}
+		compute, err := builtinMeasureCompute(m)
+		if err != nil {
+			return nil, err
 		}
+		res := metricsview.Measure{Name: m.Name, Compute: compute}
 
 		if m.Filter != nil {
 			if len(q.Measures) > 1 {
@@ -277,6 +280,10 @@ func (q *MetricsViewComparison) rewriteToMetricsViewQuery(export bool) (*metrics
 				res.Compute = &metricsview.MeasureCompute{ComparisonRatio: &metricsview.MeasureComputeComparisonRatio{
 					Measure: c.ComparisonRatio.Measure,
 				}}
+			case *runtimev1.MetricsViewAggregationMeasure_Derived:
+				res.Compute = &metricsview.MeasureCompute{Derived: &metricsview.MeasureComputeDerived{
+					Expression: c.Derived.Expression,
+				}}
 			}
 		}
 
@@ -319,6 +326,7 @@ func (q *MetricsViewComparison) rewriteToMetricsViewQuery(export bool) (*metrics
 		res.IsoDuration = q.TimeRange.IsoDuration
 		res.IsoOffset = q.TimeRange.IsoOffset
 		res.RoundToGrain = metricsview.TimeGrainFromProto(q.TimeRange.RoundToGrain)
+		res.Expression = q.TimeRange.Expression
 		qry.TimeRange = res
 		qry.TimeZone = q.TimeRange.TimeZone
 	}
@@ -334,6 +342,7 @@ func (q *MetricsViewComparison) rewriteToMetricsViewQuery(export bool) (*metrics
 		res.IsoDuration = q.ComparisonTimeRange.IsoDuration
 		res.IsoOffset = q.ComparisonTimeRange.IsoOffset
 		res.RoundToGrain = metricsview.TimeGrainFromProto(q.ComparisonTimeRange.RoundToGrain)
+		res.Expression = q.ComparisonTimeRange.Expression
 		qry.ComparisonTimeRange = res
 	}
 
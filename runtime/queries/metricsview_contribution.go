@@ -0,0 +1,171 @@
+package queries
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
+)
+
+// MetricsViewContribution decomposes the change in a measure between two time ranges into the contributions of a dimension's values.
+// It runs a single comparison query grouped by the dimension and derives the contributions from its rows,
+// so the contributions are only exact for additive measures.
+type MetricsViewContribution struct {
+	MetricsViewName     string                  `json:"metrics_view_name,omitempty"`
+	MeasureName         string                  `json:"measure_name,omitempty"`
+	DimensionName       string                  `json:"dimension_name,omitempty"`
+	TimeRange           *runtimev1.TimeRange    `json:"time_range,omitempty"`
+	ComparisonTimeRange *runtimev1.TimeRange    `json:"comparison_time_range,omitempty"`
+	Where               *runtimev1.Expression   `json:"where,omitempty"`
+	Limit               int64                   `json:"limit,omitempty"`
+	SecurityClaims      *runtime.SecurityClaims `json:"security_claims,omitempty"`
+
+	// ExecutionTimeoutSeconds overrides the default query timeout.
+	ExecutionTimeoutSeconds uint32 `json:"execution_timeout_seconds,omitempty"`
+
+	Result *runtimev1.MetricsViewContributionResponse `json:"-"`
+}
+
+var _ runtime.Query = &MetricsViewContribution{}
+
+func (q *MetricsViewContribution) Key() string {
+	r, err := json.Marshal(q)
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("MetricsViewContribution:%s", string(r))
+}
+
+func (q *MetricsViewContribution) Deps() []*runtimev1.ResourceName {
+	return metricsViewQueryDeps(q.MetricsViewName, q.Where)
+}
+
+func (q *MetricsViewContribution) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
+}
+
+func (q *MetricsViewContribution) UnmarshalResult(v any) error {
+	res, ok := v.(*runtimev1.MetricsViewContributionResponse)
+	if !ok {
+		return fmt.Errorf("MetricsViewContribution: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *MetricsViewContribution) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	if q.TimeRange == nil || q.ComparisonTimeRange == nil {
+		return errors.New("both a time range and a comparison time range are required")
+	}
+
+	mv, security, err := resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, q.MetricsViewName, q.SecurityClaims)
+	if err != nil {
+		return err
+	}
+
+	e, err := metricsview.NewExecutor(ctx, rt, instanceID, mv, security, priority)
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+
+	res, _, err := e.Query(ctx, q.rewriteToMetricsViewQuery(), nil)
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+
+	data, err := rowsToData(res)
+	if err != nil {
+		return err
+	}
+
+	rows := make([]*runtimev1.MetricsViewContributionRow, 0, len(data))
+	for _, d := range data {
+		// The measure is NULL for dimension values that are only present in one of the periods, which we treat as zero.
+		base := d.Fields[q.MeasureName].GetNumberValue()
+		comp := d.Fields[q.comparisonAlias()].GetNumberValue()
+		rows = append(rows, &runtimev1.MetricsViewContributionRow{
+			DimensionValue:  d.Fields[q.DimensionName],
+			BaseValue:       base,
+			ComparisonValue: comp,
+			Delta:           base - comp,
+		})
+	}
+
+	q.Result = contributionsFromRows(rows, q.Limit)
+	return nil
+}
+
+func (q *MetricsViewContribution) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	return ErrExportNotSupported
+}
+
+// comparisonAlias is the name of the measure's comparison value in the metrics view query.
+func (q *MetricsViewContribution) comparisonAlias() string {
+	return q.MeasureName + "__previous"
+}
+
+// rewriteToMetricsViewQuery builds a comparison query grouped by the dimension.
+// It doesn't sort or limit, since the contributions depend on all the dimension's values.
+func (q *MetricsViewContribution) rewriteToMetricsViewQuery() *metricsview.Query {
+	qry := &metricsview.Query{
+		MetricsView: q.MetricsViewName,
+		Dimensions:  []metricsview.Dimension{{Name: q.DimensionName}},
+		Measures: []metricsview.Measure{
+			{Name: q.MeasureName},
+			{Name: q.comparisonAlias(), Compute: &metricsview.MeasureCompute{ComparisonValue: &metricsview.MeasureComputeComparisonValue{Measure: q.MeasureName}}},
+		},
+		TimeRange:               metricsViewTimeRangeFromProto(q.TimeRange),
+		ComparisonTimeRange:     metricsViewTimeRangeFromProto(q.ComparisonTimeRange),
+		TimeZone:                q.TimeRange.TimeZone,
+		ExecutionTimeoutSeconds: q.ExecutionTimeoutSeconds,
+	}
+	if q.Where != nil {
+		qry.Where = metricsview.NewExpressionFromProto(q.Where)
+	}
+	return qry
+}
+
+// contributionsFromRows computes the totals and each row's share of the overall change.
+// It orders the rows by the absolute size of their change and sums the rows beyond the limit into the "other" fields.
+func contributionsFromRows(rows []*runtimev1.MetricsViewContributionRow, limit int64) *runtimev1.MetricsViewContributionResponse {
+	res := &runtimev1.MetricsViewContributionResponse{}
+	for _, r := range rows {
+		res.BaseTotal += r.BaseValue
+		res.ComparisonTotal += r.ComparisonValue
+	}
+	res.Delta = res.BaseTotal - res.ComparisonTotal
+
+	for _, r := range rows {
+		if res.Delta != 0 {
+			r.Contribution = r.Delta / res.Delta
+		}
+	}
+
+	slices.SortStableFunc(rows, func(a, b *runtimev1.MetricsViewContributionRow) int {
+		return cmp.Compare(math.Abs(b.Delta), math.Abs(a.Delta))
+	})
+
+	if limit > 0 && int64(len(rows)) > limit {
+		for _, r := range rows[limit:] {
+			res.OtherDelta += r.Delta
+			res.OtherContribution += r.Contribution
+		}
+		rows = rows[:limit]
+	}
+	res.Rows = rows
+
+	return res
+}
@@ -0,0 +1,48 @@
+package queries
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestMetricsViewContributionFromRows(t *testing.T) {
+	row := func(dim string, base, comp float64) *runtimev1.MetricsViewContributionRow {
+		return &runtimev1.MetricsViewContributionRow{
+			DimensionValue:  structpb.NewStringValue(dim),
+			BaseValue:       base,
+			ComparisonValue: comp,
+			Delta:           base - comp,
+		}
+	}
+
+	rows := []*runtimev1.MetricsViewContributionRow{
+		row("US", 100, 150),
+		row("DK", 30, 20),
+		row("SE", 10, 10),
+		row("NO", 0, 30),
+	}
+
+	res := contributionsFromRows(rows, 2)
+	require.Equal(t, 140.0, res.BaseTotal)
+	require.Equal(t, 210.0, res.ComparisonTotal)
+	require.Equal(t, -70.0, res.Delta)
+
+	require.Len(t, res.Rows, 2)
+	require.Equal(t, "US", res.Rows[0].DimensionValue.GetStringValue())
+	require.InDelta(t, 50.0/70.0, res.Rows[0].Contribution, 1e-9)
+	require.Equal(t, "NO", res.Rows[1].DimensionValue.GetStringValue())
+	require.InDelta(t, 30.0/70.0, res.Rows[1].Contribution, 1e-9)
+
+	// DK and SE are summed into other
+	require.Equal(t, 10.0, res.OtherDelta)
+	require.InDelta(t, -10.0/70.0, res.OtherContribution, 1e-9)
+
+	// No overall change
+	res = contributionsFromRows([]*runtimev1.MetricsViewContributionRow{row("US", 10, 20), row("DK", 20, 10)}, 0)
+	require.Equal(t, 0.0, res.Delta)
+	require.Len(t, res.Rows, 2)
+	require.Equal(t, 0.0, res.Rows[0].Contribution)
+}
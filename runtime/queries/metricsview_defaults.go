@@ -0,0 +1,181 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// previousPeriodOffset is the comparison offset that compares a time range to the equally long period right before it.
+const previousPeriodOffset = "rill-PP"
+
+// MetricsViewDefaults resolves the default dashboard state of a metrics view against its current data.
+type MetricsViewDefaults struct {
+	MetricsViewName string                  `json:"metrics_view_name,omitempty"`
+	TimeZone        string                  `json:"time_zone,omitempty"`
+	SecurityClaims  *runtime.SecurityClaims `json:"security_claims,omitempty"`
+
+	Result *runtimev1.MetricsViewDefaultsResponse `json:"-"`
+}
+
+var _ runtime.Query = &MetricsViewDefaults{}
+
+func (q *MetricsViewDefaults) Key() string {
+	r, err := json.Marshal(q)
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("MetricsViewDefaults:%s", string(r))
+}
+
+func (q *MetricsViewDefaults) Deps() []*runtimev1.ResourceName {
+	return []*runtimev1.ResourceName{
+		{Kind: runtime.ResourceKindMetricsView, Name: q.MetricsViewName},
+	}
+}
+
+func (q *MetricsViewDefaults) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
+}
+
+func (q *MetricsViewDefaults) UnmarshalResult(v any) error {
+	res, ok := v.(*runtimev1.MetricsViewDefaultsResponse)
+	if !ok {
+		return fmt.Errorf("MetricsViewDefaults: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *MetricsViewDefaults) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	mv, sec, err := resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, q.MetricsViewName, q.SecurityClaims)
+	if err != nil {
+		return err
+	}
+
+	tzName := q.TimeZone
+	if tzName == "" && len(mv.AvailableTimeZones) > 0 {
+		tzName = mv.AvailableTimeZones[0]
+	}
+	tz := time.UTC
+	if tzName != "" {
+		tz, err = time.LoadLocation(tzName)
+		if err != nil {
+			return fmt.Errorf("invalid timezone '%s': %w", tzName, err)
+		}
+	}
+
+	res := &runtimev1.MetricsViewDefaultsResponse{
+		TimeZone:       tz.String(),
+		ComparisonMode: mv.DefaultComparisonMode,
+		Where:          mv.DefaultFilter,
+	}
+
+	// Only return the default fields that the caller has access to. If no defaults are configured, all fields are selected.
+	for _, d := range mv.Dimensions {
+		if sec.CanAccessField(d.Name) && (len(mv.DefaultDimensions) == 0 || containsFold(mv.DefaultDimensions, d.Name)) {
+			res.Dimensions = append(res.Dimensions, d.Name)
+		}
+	}
+	for _, m := range mv.Measures {
+		if sec.CanAccessField(m.Name) && (len(mv.DefaultMeasures) == 0 || containsFold(mv.DefaultMeasures, m.Name)) {
+			res.Measures = append(res.Measures, m.Name)
+		}
+	}
+
+	if mv.DefaultComparisonMode == runtimev1.MetricsViewSpec_COMPARISON_MODE_DIMENSION {
+		res.ComparisonDimension = mv.DefaultComparisonDimension
+		if res.ComparisonDimension == "" || !sec.CanAccessField(res.ComparisonDimension) {
+			res.ComparisonDimension = ""
+			if len(res.Dimensions) > 0 {
+				res.ComparisonDimension = res.Dimensions[0]
+			}
+		}
+	}
+
+	q.Result = res
+
+	if mv.TimeDimension == "" || mv.DefaultTimeRange == "" {
+		return nil
+	}
+	res.TimeRange = mv.DefaultTimeRange
+
+	e, err := metricsview.NewExecutor(ctx, rt, instanceID, mv, sec, priority)
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+
+	tr, err := metricsview.ParseTimeRangeExpression(mv.DefaultTimeRange)
+	if err != nil {
+		return fmt.Errorf("invalid default time range: %w", err)
+	}
+	err = e.ResolveTimeRange(ctx, tr, tz, nil)
+	if err != nil {
+		return err
+	}
+	res.TimeStart = timestamppb.New(tr.Start)
+	res.TimeEnd = timestamppb.New(tr.End)
+
+	if mv.DefaultComparisonMode != runtimev1.MetricsViewSpec_COMPARISON_MODE_TIME {
+		return nil
+	}
+
+	ctr, offset := defaultComparisonTimeRange(mv, tr)
+	res.ComparisonOffset = offset
+	err = e.ResolveTimeRange(ctx, ctr, tz, nil)
+	if err != nil {
+		return fmt.Errorf("failed to resolve comparison time range: %w", err)
+	}
+	res.ComparisonTimeStart = timestamppb.New(ctr.Start)
+	res.ComparisonTimeEnd = timestamppb.New(ctr.End)
+
+	return nil
+}
+
+func (q *MetricsViewDefaults) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	return ErrExportNotSupported
+}
+
+// defaultComparisonTimeRange returns the comparison time range for the resolved default time range tr, and the offset it's based on.
+// It uses the first comparison offset configured for the default time range in the metrics view's available time ranges,
+// and otherwise compares to the previous period.
+func defaultComparisonTimeRange(mv *runtimev1.MetricsViewSpec, tr *metricsview.TimeRange) (*metricsview.TimeRange, string) {
+	for _, r := range mv.AvailableTimeRanges {
+		if r.Range != mv.DefaultTimeRange || len(r.ComparisonOffsets) == 0 {
+			continue
+		}
+		o := r.ComparisonOffsets[0]
+		if o.Offset == previousPeriodOffset {
+			break
+		}
+		if o.Range != "" {
+			return &metricsview.TimeRange{End: tr.End, IsoDuration: o.Range, IsoOffset: o.Offset}, o.Offset
+		}
+		return &metricsview.TimeRange{Start: tr.Start, End: tr.End, IsoOffset: o.Offset}, o.Offset
+	}
+
+	d := tr.End.Sub(tr.Start)
+	return &metricsview.TimeRange{Start: tr.Start.Add(-d), End: tr.Start}, previousPeriodOffset
+}
+
+// containsFold returns true if vals contains s under case-insensitive comparison.
+func containsFold(vals []string, s string) bool {
+	for _, v := range vals {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
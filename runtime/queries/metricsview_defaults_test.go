@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultComparisonTimeRange(t *testing.T) {
+	start := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	tr := &metricsview.TimeRange{Start: start, End: end}
+
+	// Defaults to the previous period
+	mv := &runtimev1.MetricsViewSpec{DefaultTimeRange: "P7D"}
+	ctr, offset := defaultComparisonTimeRange(mv, tr)
+	require.Equal(t, previousPeriodOffset, offset)
+	require.Equal(t, &metricsview.TimeRange{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: start}, ctr)
+
+	// Uses the first comparison offset of the matching available time range
+	mv.AvailableTimeRanges = []*runtimev1.MetricsViewSpec_AvailableTimeRange{
+		{Range: "P14D", ComparisonOffsets: []*runtimev1.MetricsViewSpec_AvailableComparisonOffset{{Offset: "P1D"}}},
+		{Range: "P7D", ComparisonOffsets: []*runtimev1.MetricsViewSpec_AvailableComparisonOffset{{Offset: "P1Y"}, {Offset: "P1M"}}},
+	}
+	ctr, offset = defaultComparisonTimeRange(mv, tr)
+	require.Equal(t, "P1Y", offset)
+	require.Equal(t, &metricsview.TimeRange{Start: start, End: end, IsoOffset: "P1Y"}, ctr)
+
+	// Uses the range override of the comparison offset
+	mv.AvailableTimeRanges[1].ComparisonOffsets[0].Range = "P14D"
+	ctr, offset = defaultComparisonTimeRange(mv, tr)
+	require.Equal(t, "P1Y", offset)
+	require.Equal(t, &metricsview.TimeRange{End: end, IsoDuration: "P14D", IsoOffset: "P1Y"}, ctr)
+}
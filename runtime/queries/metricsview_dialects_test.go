@@ -0,0 +1,333 @@
+package queries_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/expressionpb"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const fixtureMetricsView = `
+type: metrics_view
+table: fixture
+timeseries: __time
+dimensions:
+  - name: publisher
+    column: publisher
+  - name: domain
+    column: domain
+measures:
+  - name: bids
+    expression: COUNT(*)
+  - name: total_bid_price
+    expression: SUM(bid_price)
+  - name: max_bid_price
+    expression: MAX(bid_price)
+  - name: impressions
+    expression: SUM(impressions)
+`
+
+// dialectCase is a query that TestMetricsViewDialects runs against every OLAP target.
+// The resolve function runs the query and returns its result as rows, which are compared across targets.
+type dialectCase struct {
+	name    string
+	resolve func(ctx context.Context, rt *runtime.Runtime, instanceID string) ([]*structpb.Struct, error)
+}
+
+// TestMetricsViewDialects runs the same metrics view queries against every available OLAP engine and asserts they return the same results as DuckDB.
+// See testruntime.OLAPTargets for the engines that are tested.
+func TestMetricsViewDialects(t *testing.T) {
+	limit := int64(100)
+	topLimit := int64(3)
+	aggregations := []struct {
+		name string
+		qry  *queries.MetricsViewAggregation
+	}{
+		{
+			name: "sort_asc_with_nulls",
+			qry: &queries.MetricsViewAggregation{
+				Dimensions: []*runtimev1.MetricsViewAggregationDimension{{Name: "publisher"}},
+				Measures:   []*runtimev1.MetricsViewAggregationMeasure{{Name: "bids"}, {Name: "total_bid_price"}},
+				Sort:       []*runtimev1.MetricsViewAggregationSort{{Name: "publisher"}},
+			},
+		},
+		{
+			name: "sort_desc_with_nulls",
+			qry: &queries.MetricsViewAggregation{
+				Dimensions: []*runtimev1.MetricsViewAggregationDimension{{Name: "publisher"}},
+				Measures:   []*runtimev1.MetricsViewAggregationMeasure{{Name: "impressions"}},
+				Sort:       []*runtimev1.MetricsViewAggregationSort{{Name: "publisher", Desc: true}},
+			},
+		},
+		{
+			name: "sort_by_measure_with_limit",
+			qry: &queries.MetricsViewAggregation{
+				Dimensions: []*runtimev1.MetricsViewAggregationDimension{{Name: "domain"}},
+				Measures:   []*runtimev1.MetricsViewAggregationMeasure{{Name: "max_bid_price"}},
+				Sort:       []*runtimev1.MetricsViewAggregationSort{{Name: "max_bid_price", Desc: true}, {Name: "domain"}},
+				Limit:      &topLimit,
+			},
+		},
+		{
+			name: "month_grain",
+			qry: &queries.MetricsViewAggregation{
+				Dimensions: []*runtimev1.MetricsViewAggregationDimension{{Name: "__time", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH}},
+				Measures:   []*runtimev1.MetricsViewAggregationMeasure{{Name: "bids"}, {Name: "impressions"}},
+				Sort:       []*runtimev1.MetricsViewAggregationSort{{Name: "__time"}},
+			},
+		},
+		{
+			name: "day_grain_with_time_zone",
+			qry: &queries.MetricsViewAggregation{
+				Dimensions: []*runtimev1.MetricsViewAggregationDimension{{Name: "__time", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY, TimeZone: "America/New_York"}},
+				Measures:   []*runtimev1.MetricsViewAggregationMeasure{{Name: "bids"}},
+				Sort:       []*runtimev1.MetricsViewAggregationSort{{Name: "__time"}},
+			},
+		},
+		{
+			name: "filter_and_time_range",
+			qry: &queries.MetricsViewAggregation{
+				Dimensions: []*runtimev1.MetricsViewAggregationDimension{{Name: "publisher"}},
+				Measures:   []*runtimev1.MetricsViewAggregationMeasure{{Name: "total_bid_price"}},
+				Sort:       []*runtimev1.MetricsViewAggregationSort{{Name: "publisher"}},
+				Where:      expressionpb.IdentIn("domain", expressionpb.String("google.com"), expressionpb.String("yahoo.com"), expressionpb.String("msn.com")),
+				TimeRange: &runtimev1.TimeRange{
+					Start: timestampOf(t, "2024-01-01T00:00:00Z"),
+					End:   timestampOf(t, "2024-03-01T00:00:00Z"),
+				},
+			},
+		},
+		{
+			name: "having",
+			qry: &queries.MetricsViewAggregation{
+				Dimensions: []*runtimev1.MetricsViewAggregationDimension{{Name: "publisher"}},
+				Measures:   []*runtimev1.MetricsViewAggregationMeasure{{Name: "bids"}},
+				Sort:       []*runtimev1.MetricsViewAggregationSort{{Name: "publisher"}},
+				Having:     expressionpb.Gt("bids", 1),
+			},
+		},
+		{
+			name: "totals",
+			qry: &queries.MetricsViewAggregation{
+				Measures: []*runtimev1.MetricsViewAggregationMeasure{{Name: "bids"}, {Name: "total_bid_price"}, {Name: "max_bid_price"}, {Name: "impressions"}},
+			},
+		},
+	}
+
+	var cases []dialectCase
+	for _, a := range aggregations {
+		cases = append(cases, dialectCase{
+			name: "aggregation/" + a.name,
+			resolve: func(ctx context.Context, rt *runtime.Runtime, instanceID string) ([]*structpb.Struct, error) {
+				qry := *a.qry
+				qry.MetricsViewName = "fixture_metrics"
+				qry.SecurityClaims = testClaims()
+				if qry.Limit == nil {
+					qry.Limit = &limit
+				}
+				if err := qry.Resolve(ctx, rt, instanceID, 0); err != nil {
+					return nil, err
+				}
+				return qry.Result.Data, nil
+			},
+		})
+	}
+
+	cases = append(cases,
+		dialectCase{
+			name: "toplist",
+			resolve: func(ctx context.Context, rt *runtime.Runtime, instanceID string) ([]*structpb.Struct, error) {
+				q := &queries.MetricsViewToplist{
+					MetricsViewName: "fixture_metrics",
+					DimensionName:   "publisher",
+					MeasureNames:    []string{"bids", "total_bid_price"},
+					Sort:            []*runtimev1.MetricsViewSort{{Name: "bids"}, {Name: "publisher", Ascending: true}},
+					Limit:           &topLimit,
+					SecurityClaims:  testClaims(),
+				}
+				if err := q.Resolve(ctx, rt, instanceID, 0); err != nil {
+					return nil, err
+				}
+				return q.Result.Data, nil
+			},
+		},
+		dialectCase{
+			name: "comparison_toplist",
+			resolve: func(ctx context.Context, rt *runtime.Runtime, instanceID string) ([]*structpb.Struct, error) {
+				q := &queries.MetricsViewComparison{
+					MetricsViewName: "fixture_metrics",
+					DimensionName:   "domain",
+					Measures:        []*runtimev1.MetricsViewAggregationMeasure{{Name: "bids"}, {Name: "total_bid_price"}},
+					TimeRange: &runtimev1.TimeRange{
+						Start: timestampOf(t, "2024-01-01T00:00:00Z"),
+						End:   timestampOf(t, "2024-02-01T00:00:00Z"),
+					},
+					ComparisonTimeRange: &runtimev1.TimeRange{
+						Start: timestampOf(t, "2024-02-01T00:00:00Z"),
+						End:   timestampOf(t, "2024-03-01T00:00:00Z"),
+					},
+					Sort: []*runtimev1.MetricsViewComparisonSort{
+						{Name: "total_bid_price", SortType: runtimev1.MetricsViewComparisonMeasureType_METRICS_VIEW_COMPARISON_MEASURE_TYPE_ABS_DELTA, Desc: true},
+						{Name: "domain", SortType: runtimev1.MetricsViewComparisonMeasureType_METRICS_VIEW_COMPARISON_MEASURE_TYPE_BASE_VALUE},
+					},
+					Limit:          10,
+					SecurityClaims: testClaims(),
+				}
+				if err := q.Resolve(ctx, rt, instanceID, 0); err != nil {
+					return nil, err
+				}
+				return protoRows(t, q.Result.Rows), nil
+			},
+		},
+		dialectCase{
+			name: "timeseries",
+			resolve: func(ctx context.Context, rt *runtime.Runtime, instanceID string) ([]*structpb.Struct, error) {
+				q := &queries.MetricsViewTimeSeries{
+					MetricsViewName: "fixture_metrics",
+					MeasureNames:    []string{"bids", "impressions"},
+					TimeStart:       timestampOf(t, "2024-01-01T00:00:00Z"),
+					TimeEnd:         timestampOf(t, "2024-03-01T00:00:00Z"),
+					TimeGranularity: runtimev1.TimeGrain_TIME_GRAIN_WEEK,
+					SecurityClaims:  testClaims(),
+				}
+				if err := q.Resolve(ctx, rt, instanceID, 0); err != nil {
+					return nil, err
+				}
+				return protoRows(t, q.Result.Data), nil
+			},
+		},
+		dialectCase{
+			name: "totals",
+			resolve: func(ctx context.Context, rt *runtime.Runtime, instanceID string) ([]*structpb.Struct, error) {
+				q := &queries.MetricsViewTotals{
+					MetricsViewName: "fixture_metrics",
+					MeasureNames:    []string{"bids", "total_bid_price", "max_bid_price", "impressions"},
+					TimeStart:       timestampOf(t, "2024-01-01T00:00:00Z"),
+					TimeEnd:         timestampOf(t, "2024-03-01T00:00:00Z"),
+					Where:           expressionpb.IdentIn("domain", expressionpb.String("google.com"), expressionpb.String("msn.com")),
+					SecurityClaims:  testClaims(),
+				}
+				if err := q.Resolve(ctx, rt, instanceID, 0); err != nil {
+					return nil, err
+				}
+				return []*structpb.Struct{q.Result.Data}, nil
+			},
+		},
+		dialectCase{
+			name: "rows",
+			resolve: func(ctx context.Context, rt *runtime.Runtime, instanceID string) ([]*structpb.Struct, error) {
+				ctrl, err := rt.Controller(ctx, instanceID)
+				if err != nil {
+					return nil, err
+				}
+				res, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "fixture_metrics"}, false)
+				if err != nil {
+					return nil, err
+				}
+				security, err := rt.ResolveSecurity(instanceID, testClaims(), res)
+				if err != nil {
+					return nil, err
+				}
+
+				q := &queries.MetricsViewRows{
+					MetricsViewName:    "fixture_metrics",
+					MetricsView:        res.GetMetricsView().State.ValidSpec,
+					ResolvedMVSecurity: security,
+					TimeGranularity:    runtimev1.TimeGrain_TIME_GRAIN_DAY,
+					Sort:               []*runtimev1.MetricsViewSort{{Name: "__time", Ascending: true}},
+					Limit:              &limit,
+				}
+				if err := q.Resolve(ctx, rt, instanceID, 0); err != nil {
+					return nil, err
+				}
+				return q.Result.Data, nil
+			},
+		},
+	)
+
+	// Collect results per target
+	targets := testruntime.OLAPTargets(t)
+	results := make([]map[string][]map[string]any, len(targets))
+	for i, target := range targets {
+		rt, instanceID := testruntime.NewInstanceWithFixture(t, target, map[string]string{"fixture_metrics.yaml": fixtureMetricsView})
+
+		results[i] = make(map[string][]map[string]any)
+		for _, tc := range cases {
+			rows, err := tc.resolve(context.Background(), rt, instanceID)
+			require.NoError(t, err, "%s: %s", target.Driver, tc.name)
+			results[i][tc.name] = normalizeDialectRows(rows)
+		}
+	}
+
+	// Compare every target against DuckDB
+	for i := 1; i < len(targets); i++ {
+		for _, tc := range cases {
+			t.Run(targets[i].Driver+"/"+tc.name, func(t *testing.T) {
+				require.Equal(t, results[0][tc.name], results[i][tc.name])
+			})
+		}
+	}
+
+	// Sanity check the DuckDB results for NULL ordering, which is where dialects most often drift
+	require.Nil(t, results[0]["aggregation/sort_asc_with_nulls"][4]["publisher"])
+	require.Nil(t, results[0]["aggregation/sort_desc_with_nulls"][4]["publisher"])
+}
+
+// protoRows converts proto messages to rows using their JSON representation.
+func protoRows[T proto.Message](t *testing.T, msgs []T) []*structpb.Struct {
+	res := make([]*structpb.Struct, len(msgs))
+	for i, msg := range msgs {
+		data, err := protojson.Marshal(msg)
+		require.NoError(t, err)
+		res[i] = &structpb.Struct{}
+		require.NoError(t, protojson.Unmarshal(data, res[i]))
+	}
+	return res
+}
+
+// normalizeDialectRows converts rows to plain maps and normalizes representational differences that are not semantic, such as float precision and timestamp formatting.
+func normalizeDialectRows(rows []*structpb.Struct) []map[string]any {
+	res := make([]map[string]any, len(rows))
+	for i, row := range rows {
+		res[i] = normalizeDialectValue(row.AsMap()).(map[string]any)
+	}
+	return res
+}
+
+// normalizeDialectValue normalizes a value in a row for normalizeDialectRows. Nested maps and lists are normalized recursively.
+func normalizeDialectValue(v any) any {
+	switch v := v.(type) {
+	case float64:
+		return math.Round(v*1e6) / 1e6
+	case string:
+		if ts, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return ts.UTC().Format(time.RFC3339)
+		}
+	case map[string]any:
+		for k, vv := range v {
+			v[k] = normalizeDialectValue(vv)
+		}
+	case []any:
+		for i, vv := range v {
+			v[i] = normalizeDialectValue(vv)
+		}
+	}
+	return v
+}
+
+func timestampOf(t *testing.T, s string) *timestamppb.Timestamp {
+	ts, err := time.Parse(time.RFC3339, s)
+	require.NoError(t, err)
+	return timestamppb.New(ts)
+}
@@ -0,0 +1,111 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
+)
+
+type MetricsViewFunnel struct {
+	MetricsViewName  string                             `json:"metrics_view_name,omitempty"`
+	DimensionName    string                             `json:"dimension_name,omitempty"`
+	Steps            []*runtimev1.MetricsViewFunnelStep `json:"steps,omitempty"`
+	ConversionWindow string                             `json:"conversion_window,omitempty"`
+	TimeRange        *runtimev1.TimeRange               `json:"time_range,omitempty"`
+	Where            *runtimev1.Expression              `json:"where,omitempty"`
+	SecurityClaims   *runtime.SecurityClaims            `json:"security_claims,omitempty"`
+
+	// ExecutionTimeoutSeconds overrides the default query timeout.
+	ExecutionTimeoutSeconds uint32 `json:"execution_timeout_seconds,omitempty"`
+
+	Result *runtimev1.MetricsViewFunnelResponse `json:"-"`
+}
+
+var _ runtime.Query = &MetricsViewFunnel{}
+
+func (q *MetricsViewFunnel) Key() string {
+	r, err := json.Marshal(q)
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("MetricsViewFunnel:%s", string(r))
+}
+
+func (q *MetricsViewFunnel) Deps() []*runtimev1.ResourceName {
+	exprs := []*runtimev1.Expression{q.Where}
+	for _, s := range q.Steps {
+		exprs = append(exprs, s.Where)
+	}
+	return metricsViewQueryDeps(q.MetricsViewName, exprs...)
+}
+
+func (q *MetricsViewFunnel) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
+}
+
+func (q *MetricsViewFunnel) UnmarshalResult(v any) error {
+	res, ok := v.(*runtimev1.MetricsViewFunnelResponse)
+	if !ok {
+		return fmt.Errorf("MetricsViewFunnel: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *MetricsViewFunnel) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	mv, security, err := resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, q.MetricsViewName, q.SecurityClaims)
+	if err != nil {
+		return err
+	}
+
+	e, err := metricsview.NewExecutor(ctx, rt, instanceID, mv, security, priority)
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+
+	qry := &metricsview.FunnelQuery{
+		MetricsView:             q.MetricsViewName,
+		Dimension:               q.DimensionName,
+		ConversionWindow:        q.ConversionWindow,
+		TimeRange:               metricsViewTimeRangeFromProto(q.TimeRange),
+		Where:                   metricsview.NewExpressionFromProto(q.Where),
+		TimeZone:                q.TimeRange.GetTimeZone(),
+		ExecutionTimeoutSeconds: q.ExecutionTimeoutSeconds,
+	}
+	for _, s := range q.Steps {
+		qry.Steps = append(qry.Steps, metricsview.FunnelStep{
+			Name:  s.Name,
+			Where: metricsview.NewExpressionFromProto(s.Where),
+		})
+	}
+
+	steps, err := e.Funnel(ctx, qry, nil)
+	if err != nil {
+		return err
+	}
+
+	q.Result = &runtimev1.MetricsViewFunnelResponse{}
+	for _, s := range steps {
+		q.Result.Steps = append(q.Result.Steps, &runtimev1.MetricsViewFunnelStepResult{
+			Name:                  s.Name,
+			Count:                 s.Count,
+			ConversionRate:        s.ConversionRate,
+			OverallConversionRate: s.OverallConversionRate,
+		})
+	}
+
+	return nil
+}
+
+func (q *MetricsViewFunnel) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	return ErrExportNotSupported
+}
@@ -0,0 +1,69 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/expressionpb"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsViewFunnel(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			`rill.yaml`: ``,
+			// u1 completes all steps, u2 adds to cart after the conversion window, u3 adds to cart before viewing and u4 buys before adding to cart.
+			`models/events.sql`: `
+SELECT * FROM (VALUES
+	('u1', 'view', TIMESTAMP '2024-01-01 00:00:00'), ('u1', 'cart', TIMESTAMP '2024-01-01 01:00:00'), ('u1', 'buy', TIMESTAMP '2024-01-01 02:00:00'),
+	('u2', 'view', TIMESTAMP '2024-01-01 00:00:00'), ('u2', 'cart', TIMESTAMP '2024-01-03 01:00:00'),
+	('u3', 'cart', TIMESTAMP '2024-01-01 00:00:00'), ('u3', 'view', TIMESTAMP '2024-01-01 01:00:00'),
+	('u4', 'view', TIMESTAMP '2024-01-01 00:00:00'), ('u4', 'buy', TIMESTAMP '2024-01-01 01:00:00'), ('u4', 'cart', TIMESTAMP '2024-01-01 02:00:00')
+) t(user_id, event, ts)
+`,
+			`metrics/events.yaml`: `
+type: metrics_view
+model: events
+timeseries: ts
+dimensions:
+- column: user_id
+- column: event
+measures:
+- name: count
+  expression: count(*)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	step := func(name string) *runtimev1.MetricsViewFunnelStep {
+		return &runtimev1.MetricsViewFunnelStep{Name: name, Where: expressionpb.Eq("event", name)}
+	}
+	q := &queries.MetricsViewFunnel{
+		MetricsViewName:  "events",
+		DimensionName:    "user_id",
+		Steps:            []*runtimev1.MetricsViewFunnelStep{step("view"), step("cart"), step("buy")},
+		ConversionWindow: "P1D",
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+
+	require.Len(t, q.Result.Steps, 3)
+	require.Equal(t, "view", q.Result.Steps[0].Name)
+	require.Equal(t, int64(4), q.Result.Steps[0].Count)
+	require.Equal(t, 1.0, q.Result.Steps[0].ConversionRate)
+	require.Equal(t, int64(2), q.Result.Steps[1].Count)
+	require.Equal(t, 0.5, q.Result.Steps[1].ConversionRate)
+	require.Equal(t, int64(1), q.Result.Steps[2].Count)
+	require.Equal(t, 0.5, q.Result.Steps[2].ConversionRate)
+	require.Equal(t, 0.25, q.Result.Steps[2].OverallConversionRate)
+
+	// Without a conversion window, u2 converts to the second step
+	q.ConversionWindow = ""
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), q.Result.Steps[1].Count)
+}
@@ -0,0 +1,101 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type MetricsViewRetention struct {
+	MetricsViewName string                  `json:"metrics_view_name,omitempty"`
+	DimensionName   string                  `json:"dimension_name,omitempty"`
+	TimeGrain       runtimev1.TimeGrain     `json:"time_grain,omitempty"`
+	Periods         int                     `json:"periods,omitempty"`
+	TimeRange       *runtimev1.TimeRange    `json:"time_range,omitempty"`
+	Where           *runtimev1.Expression   `json:"where,omitempty"`
+	SecurityClaims  *runtime.SecurityClaims `json:"security_claims,omitempty"`
+
+	// ExecutionTimeoutSeconds overrides the default query timeout.
+	ExecutionTimeoutSeconds uint32 `json:"execution_timeout_seconds,omitempty"`
+
+	Result *runtimev1.MetricsViewRetentionResponse `json:"-"`
+}
+
+var _ runtime.Query = &MetricsViewRetention{}
+
+func (q *MetricsViewRetention) Key() string {
+	r, err := json.Marshal(q)
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("MetricsViewRetention:%s", string(r))
+}
+
+func (q *MetricsViewRetention) Deps() []*runtimev1.ResourceName {
+	return metricsViewQueryDeps(q.MetricsViewName, q.Where)
+}
+
+func (q *MetricsViewRetention) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
+}
+
+func (q *MetricsViewRetention) UnmarshalResult(v any) error {
+	res, ok := v.(*runtimev1.MetricsViewRetentionResponse)
+	if !ok {
+		return fmt.Errorf("MetricsViewRetention: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *MetricsViewRetention) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	mv, security, err := resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, q.MetricsViewName, q.SecurityClaims)
+	if err != nil {
+		return err
+	}
+
+	e, err := metricsview.NewExecutor(ctx, rt, instanceID, mv, security, priority)
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+
+	cohorts, err := e.Retention(ctx, &metricsview.RetentionQuery{
+		MetricsView:             q.MetricsViewName,
+		Dimension:               q.DimensionName,
+		TimeGrain:               metricsview.TimeGrainFromProto(q.TimeGrain),
+		Periods:                 q.Periods,
+		TimeRange:               metricsViewTimeRangeFromProto(q.TimeRange),
+		Where:                   metricsview.NewExpressionFromProto(q.Where),
+		TimeZone:                q.TimeRange.GetTimeZone(),
+		ExecutionTimeoutSeconds: q.ExecutionTimeoutSeconds,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	q.Result = &runtimev1.MetricsViewRetentionResponse{}
+	for _, c := range cohorts {
+		q.Result.Cohorts = append(q.Result.Cohorts, &runtimev1.MetricsViewRetentionCohort{
+			Start:  timestamppb.New(c.Start),
+			Size:   c.Size,
+			Counts: c.Counts,
+			Rates:  c.Rates,
+		})
+	}
+
+	return nil
+}
+
+func (q *MetricsViewRetention) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	return ErrExportNotSupported
+}
@@ -0,0 +1,65 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMetricsViewRetention(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			`rill.yaml`: ``,
+			// u0 is first seen before the time range, so it's not in a cohort even though it's active in the time range.
+			`models/events.sql`: `
+SELECT * FROM (VALUES
+	('u0', TIMESTAMP '2023-12-31 10:00:00'), ('u0', TIMESTAMP '2024-01-02 10:00:00'),
+	('u1', TIMESTAMP '2024-01-01 10:00:00'), ('u1', TIMESTAMP '2024-01-02 10:00:00'), ('u1', TIMESTAMP '2024-01-04 10:00:00'),
+	('u2', TIMESTAMP '2024-01-01 10:00:00'), ('u2', TIMESTAMP '2024-01-03 10:00:00'),
+	('u3', TIMESTAMP '2024-01-02 10:00:00'), ('u3', TIMESTAMP '2024-01-03 10:00:00')
+) t(user_id, ts)
+`,
+			`metrics/events.yaml`: `
+type: metrics_view
+model: events
+timeseries: ts
+dimensions:
+- column: user_id
+measures:
+- name: count
+  expression: count(*)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, instanceID, 3, 0, 0)
+
+	q := &queries.MetricsViewRetention{
+		MetricsViewName: "events",
+		DimensionName:   "user_id",
+		TimeGrain:       runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		Periods:         3,
+		TimeRange: &runtimev1.TimeRange{
+			Start: timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			End:   timestamppb.New(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+
+	require.Len(t, q.Result.Cohorts, 2)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), q.Result.Cohorts[0].Start.AsTime())
+	require.Equal(t, int64(2), q.Result.Cohorts[0].Size)
+	require.Equal(t, []int64{2, 1, 1, 1}, q.Result.Cohorts[0].Counts)
+	require.Equal(t, []float64{1, 0.5, 0.5, 0.5}, q.Result.Cohorts[0].Rates)
+
+	// The last period of the second cohort starts after the latest data, so it's omitted
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), q.Result.Cohorts[1].Start.AsTime())
+	require.Equal(t, int64(1), q.Result.Cohorts[1].Size)
+	require.Equal(t, []int64{1, 1, 0}, q.Result.Cohorts[1].Counts)
+}
@@ -104,8 +104,9 @@ func (q *MetricsViewRows) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 	}
 
 	q.Result = &runtimev1.MetricsViewRowsResponse{
-		Meta: meta,
-		Data: data,
+		Meta:        meta,
+		Data:        data,
+		Annotations: metricsViewColumnAnnotations(q.MetricsView, metricsViewColumnNames(meta), nil),
 	}
 
 	return nil
@@ -120,7 +121,7 @@ func (q *MetricsViewRows) Export(ctx context.Context, rt *runtime.Runtime, insta
 
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
+		if (opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV && opts.CanCopyCSV()) || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
 			if q.MetricsView.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
 				return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 			}
@@ -143,7 +144,11 @@ func (q *MetricsViewRows) Export(ctx context.Context, rt *runtime.Runtime, insta
 				return err
 			}
 
-			filename := q.generateFilename(q.MetricsView)
+			filename, err := opts.Filename(q.generateFilename(q.MetricsView), exportFilenameVars(q.MetricsViewName, nil, q.TimeStart, q.TimeEnd))
+			if err != nil {
+				return err
+			}
+
 			if err := DuckDBCopyExport(ctx, w, opts, sql, args, filename, olap, opts.Format); err != nil {
 				return err
 			}
@@ -170,7 +175,12 @@ func (q *MetricsViewRows) generalExport(ctx context.Context, rt *runtime.Runtime
 	}
 
 	if opts.PreWriteHook != nil {
-		err = opts.PreWriteHook(q.generateFilename(mv))
+		filename, err := opts.Filename(q.generateFilename(mv), exportFilenameVars(q.MetricsViewName, nil, q.TimeStart, q.TimeEnd))
+		if err != nil {
+			return err
+		}
+
+		err = opts.PreWriteHook(filename)
 		if err != nil {
 			return err
 		}
@@ -180,7 +190,11 @@ func (q *MetricsViewRows) generalExport(ctx context.Context, rt *runtime.Runtime
 	case runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
 		return fmt.Errorf("unspecified format")
 	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
-		return WriteCSV(q.Result.Meta, q.Result.Data, w)
+		loc, err := opts.ParsedLocale()
+		if err != nil {
+			return err
+		}
+		return WriteCSV(q.Result.Meta, q.Result.Data, w, loc)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
 		return WriteXLSX(q.Result.Meta, q.Result.Data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
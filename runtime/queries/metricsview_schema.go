@@ -69,7 +69,41 @@ func (q *MetricsViewSchema) Resolve(ctx context.Context, rt *runtime.Runtime, in
 	}
 
 	q.Result = &runtimev1.MetricsViewSchemaResponse{
-		Schema: schema,
+		Schema:        schema,
+		TimeDimension: mv.TimeDimension,
+	}
+
+	// The schema only contains the fields that the security policy allows access to
+	types := make(map[string]*runtimev1.Type, len(schema.Fields))
+	for _, f := range schema.Fields {
+		types[f.Name] = f.Type
+	}
+	for _, d := range mv.Dimensions {
+		t, ok := types[d.Name]
+		if !ok {
+			continue
+		}
+		q.Result.Dimensions = append(q.Result.Dimensions, &runtimev1.MetricsViewSchemaDimension{
+			Name:        d.Name,
+			Label:       d.Label,
+			Description: d.Description,
+			Type:        t,
+		})
+	}
+	for _, m := range mv.Measures {
+		t, ok := types[m.Name]
+		if !ok {
+			continue
+		}
+		q.Result.Measures = append(q.Result.Measures, &runtimev1.MetricsViewSchemaMeasure{
+			Name:                m.Name,
+			Label:               m.Label,
+			Description:         m.Description,
+			Type:                t,
+			FormatPreset:        m.FormatPreset,
+			FormatD3:            m.FormatD3,
+			ValidPercentOfTotal: m.ValidPercentOfTotal,
+		})
 	}
 
 	return nil
@@ -0,0 +1,46 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsViewSchemaSecurity(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	measureNames := func(res *runtimev1.MetricsViewSchemaResponse) []string {
+		var names []string
+		for _, m := range res.Measures {
+			names = append(names, m.Name)
+		}
+		return names
+	}
+
+	// The policy hides "total volume" from users outside of msn.com
+	q := &queries.MetricsViewSchema{
+		MetricsViewName: "ad_bids_mini_metrics_with_policy",
+		SecurityClaims:  &runtime.SecurityClaims{UserAttributes: map[string]any{"domain": "yahoo.com"}},
+	}
+	require.NoError(t, q.Resolve(context.Background(), rt, instanceID, 0))
+	require.Equal(t, "timestamp", q.Result.TimeDimension)
+	require.Len(t, q.Result.Dimensions, 2)
+	require.Equal(t, "publisher", q.Result.Dimensions[0].Name)
+	require.Equal(t, "Publisher", q.Result.Dimensions[0].Label)
+	require.Equal(t, runtimev1.Type_CODE_STRING, q.Result.Dimensions[0].Type.Code)
+	require.Equal(t, []string{"bid's number", "total impressions", `total click"s`}, measureNames(q.Result))
+	require.Equal(t, "Number of bids", q.Result.Measures[0].Label)
+	require.Equal(t, runtimev1.Type_CODE_INT64, q.Result.Measures[0].Type.Code)
+
+	q = &queries.MetricsViewSchema{
+		MetricsViewName: "ad_bids_mini_metrics_with_policy",
+		SecurityClaims:  &runtime.SecurityClaims{UserAttributes: map[string]any{"domain": "msn.com"}},
+	}
+	require.NoError(t, q.Resolve(context.Background(), rt, instanceID, 0))
+	require.Equal(t, []string{"bid's number", "total volume", "total impressions", `total click"s`}, measureNames(q.Result))
+}
@@ -28,6 +28,8 @@ type MetricsViewSearch struct {
 	Priority        int32                   `json:"priority,omitempty"`
 	Limit           *int64                  `json:"limit,omitempty"`
 	SecurityClaims  *runtime.SecurityClaims `json:"security_claims,omitempty"`
+	// AccentInsensitive makes the search ignore accents in addition to case.
+	AccentInsensitive bool `json:"accent_insensitive,omitempty"`
 
 	Result *runtimev1.MetricsViewSearchResponse
 }
@@ -99,7 +101,8 @@ func (q *MetricsViewSearch) Resolve(ctx context.Context, rt *runtime.Runtime, in
 		}
 	}
 
-	if olap.Dialect() == drivers.DialectDruid {
+	// The native search query doesn't support unions and lookup joins
+	if olap.Dialect() == drivers.DialectDruid && len(mv.UnionTables) == 0 && len(mv.LookupJoins) == 0 && !q.AccentInsensitive {
 		ok, err := q.executeSearchInDruid(ctx, rt, olap, instanceID, mv.Table, sec)
 		if err != nil || ok {
 			return err
@@ -270,7 +273,9 @@ func (q *MetricsViewSearch) buildSearchQuerySQL(mv *runtimev1.MetricsViewSpec, d
 			mv:      mv,
 			dialect: dialect,
 		}
-		clause, clauseArgs, err := filterBuilder.buildExpression(expressionpb.Like(expressionpb.Identifier(dimName), expressionpb.String(fmt.Sprintf("%%%s%%", q.Search))))
+		like := expressionpb.Like(expressionpb.Identifier(dimName), expressionpb.String(fmt.Sprintf("%%%s%%", q.Search)))
+		like.GetCond().AccentInsensitive = q.AccentInsensitive
+		clause, clauseArgs, err := filterBuilder.buildExpression(like)
 		if err != nil {
 			return "", nil, err
 		}
@@ -283,7 +288,7 @@ func (q *MetricsViewSearch) buildSearchQuerySQL(mv *runtimev1.MetricsViewSpec, d
 			`SELECT %s as "value", '%s' as dimension from %s %s WHERE 1=1 %s %s GROUP BY 1`,
 			expr,
 			dimName,
-			mv.Table,
+			escapeMetricsViewTable(dialect, mv),
 			unnest,
 			baseWhereClause,
 			clause,
@@ -27,7 +27,7 @@ func Test_writeCSV_emptystring(t *testing.T) {
 
 	var buf bytes.Buffer
 
-	err := WriteCSV(meta, data, &buf)
+	err := WriteCSV(meta, data, &buf, nil)
 	require.NoError(t, err)
 	require.Equal(t, "col\n\n", buf.String())
 }
@@ -48,7 +48,7 @@ func Test_writeCSV_number(t *testing.T) {
 
 	var buf bytes.Buffer
 
-	err := WriteCSV(meta, data, &buf)
+	err := WriteCSV(meta, data, &buf, nil)
 	require.NoError(t, err)
 	require.Equal(t, "col\n2.5\n", buf.String())
 }
@@ -69,7 +69,7 @@ func Test_writeCSV_null(t *testing.T) {
 
 	var buf bytes.Buffer
 
-	err := WriteCSV(meta, data, &buf)
+	err := WriteCSV(meta, data, &buf, nil)
 	require.NoError(t, err)
 	require.Equal(t, "col\n\n", buf.String())
 }
@@ -90,7 +90,7 @@ func Test_writeCSV_bool(t *testing.T) {
 
 	var buf bytes.Buffer
 
-	err := WriteCSV(meta, data, &buf)
+	err := WriteCSV(meta, data, &buf, nil)
 	require.NoError(t, err)
 	require.Equal(t, "col\ntrue\n", buf.String())
 }
@@ -116,7 +116,7 @@ func Test_writeCSV_struct(t *testing.T) {
 
 	var buf bytes.Buffer
 
-	err := WriteCSV(meta, data, &buf)
+	err := WriteCSV(meta, data, &buf, nil)
 	require.NoError(t, err)
 	require.Equal(t, "col\n\"{\"\"a\"\":2.5}\"\n", buf.String())
 }
@@ -144,9 +144,9 @@ func Test_writeCSV_list(t *testing.T) {
 	)
 
 	var buf bytes.Buffer
-	err := WriteCSV(meta, data, &buf)
+	err := WriteCSV(meta, data, &buf, nil)
 	require.NoError(t, err)
-	require.Equal(t, "col\n[2.5 true]\n", buf.String())
+	require.Equal(t, "col\n\"[2.5,true]\"\n", buf.String())
 }
 
 func Test_writeCSV_quotes(t *testing.T) {
@@ -170,7 +170,7 @@ func Test_writeCSV_quotes(t *testing.T) {
 
 	var buf bytes.Buffer
 
-	err := WriteCSV(meta, data, &buf)
+	err := WriteCSV(meta, data, &buf, nil)
 	require.NoError(t, err)
 
 	expected := fmt.Sprintf(
@@ -425,7 +425,7 @@ func Test_writeXLSX_list(t *testing.T) {
 
 	v, err := file.GetCellValue("Sheet1", "A2")
 	require.NoError(t, err)
-	require.Equal(t, "[2.5 true]", v)
+	require.Equal(t, "[2.5,true]", v)
 }
 
 func Test_writeXLSX_quotes(t *testing.T) {
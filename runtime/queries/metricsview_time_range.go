@@ -11,6 +11,8 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -19,6 +21,8 @@ type MetricsViewTimeRange struct {
 	MetricsViewName    string                     `json:"name"`
 	MetricsView        *runtimev1.MetricsViewSpec `json:"-"`
 	ResolvedMVSecurity *runtime.ResolvedSecurity  `json:"security"`
+	TimeZones          []string                   `json:"time_zones,omitempty"`
+	TimeGrains         []runtimev1.TimeGrain      `json:"time_grains,omitempty"`
 
 	Result *runtimev1.MetricsViewTimeRangeResponse `json:"_"`
 }
@@ -71,18 +75,55 @@ func (q *MetricsViewTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime,
 	}
 	defer release()
 
+	// Validate the time zones before running any queries
+	tzs := make([]*time.Location, len(q.TimeZones))
+	for i, name := range q.TimeZones {
+		tzs[i], err = time.LoadLocation(name)
+		if err != nil {
+			return fmt.Errorf("invalid timezone '%s': %w", name, err)
+		}
+	}
+
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		return q.resolveDuckDB(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectDuckDB, q.MetricsView), policyFilter, priority)
+		err = q.resolveDuckDB(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectDuckDB, q.MetricsView), policyFilter, priority)
 	case drivers.DialectDruid:
-		return q.resolveDruid(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectDruid, q.MetricsView), policyFilter, priority)
+		err = q.resolveDruid(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectDruid, q.MetricsView), policyFilter, priority)
 	case drivers.DialectClickHouse:
-		return q.resolveClickHouseAndPinot(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectClickHouse, q.MetricsView), policyFilter, priority)
+		err = q.resolveClickHouseAndPinot(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectClickHouse, q.MetricsView), policyFilter, priority)
 	case drivers.DialectPinot:
-		return q.resolveClickHouseAndPinot(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectPinot, q.MetricsView), policyFilter, priority)
+		err = q.resolveClickHouseAndPinot(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectPinot, q.MetricsView), policyFilter, priority)
+	case drivers.DialectBigQuery:
+		err = q.resolveClickHouseAndPinot(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectBigQuery, q.MetricsView), policyFilter, priority)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
+	if err != nil {
+		return err
+	}
+
+	if len(tzs) == 0 {
+		return nil
+	}
+
+	e, err := metricsview.NewExecutor(ctx, rt, instanceID, q.MetricsView, q.ResolvedMVSecurity, priority)
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+
+	watermark, err := e.Watermark(ctx)
+	if err != nil {
+		return err
+	}
+	q.Result.Watermark = timestamppb.New(watermark)
+
+	for _, tz := range tzs {
+		s := timeZoneTimeRangeSummary(q.Result.TimeRangeSummary, watermark, tz, q.TimeGrains, int(q.MetricsView.FirstDayOfWeek), int(q.MetricsView.FirstMonthOfYear))
+		q.Result.TimeZoneSummaries = append(q.Result.TimeZoneSummaries, s)
+	}
+
+	return nil
 }
 
 func (q *MetricsViewTimeRange) resolveDuckDB(ctx context.Context, olap drivers.OLAPStore, timeDim, escapedTableName, filter string, priority int) error {
@@ -239,11 +280,14 @@ func (q *MetricsViewTimeRange) resolveClickHouseAndPinot(ctx context.Context, ol
 		filter = fmt.Sprintf(" WHERE %s", filter)
 	}
 
+	d := olap.Dialect()
 	rangeSQL := fmt.Sprintf(
-		"SELECT min(%[1]s) AS \"min\", max(%[1]s) AS \"max\" FROM %[2]s %[3]s",
-		safeName(timeDim),
+		"SELECT min(%[1]s) AS %[4]s, max(%[1]s) AS %[5]s FROM %[2]s %[3]s",
+		d.EscapeIdentifier(timeDim),
 		escapedTableName,
 		filter,
+		d.EscapeIdentifier("min"),
+		d.EscapeIdentifier("max"),
 	)
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
@@ -295,6 +339,63 @@ func (q *MetricsViewTimeRange) resolveClickHouseAndPinot(ctx context.Context, ol
 	return errors.New("no rows returned")
 }
 
+// timeZoneTimeRangeSummary converts a time range summary and watermark to the time zone tz, and aligns them to each of the grains.
+func timeZoneTimeRangeSummary(summary *runtimev1.TimeRangeSummary, watermark time.Time, tz *time.Location, grains []runtimev1.TimeGrain, firstDay, firstMonth int) *runtimev1.TimeZoneTimeRangeSummary {
+	if firstDay > 7 || firstDay <= 0 {
+		firstDay = 1
+	}
+	if firstMonth > 12 || firstMonth <= 0 {
+		firstMonth = 1
+	}
+
+	var minTime, maxTime time.Time
+	if summary.Min != nil {
+		minTime = summary.Min.AsTime()
+	}
+	if summary.Max != nil {
+		maxTime = summary.Max.AsTime()
+	}
+
+	res := &runtimev1.TimeZoneTimeRangeSummary{
+		TimeZone:  tz.String(),
+		Min:       formatInTimeZone(minTime, tz),
+		Max:       formatInTimeZone(maxTime, tz),
+		Watermark: formatInTimeZone(watermark, tz),
+	}
+
+	for _, g := range grains {
+		tg := convTimeGrain(g)
+		if tg == timeutil.TimeGrainUnspecified {
+			continue
+		}
+
+		grain := &runtimev1.TimeZoneTimeRangeSummary_Grain{Grain: g}
+		if !minTime.IsZero() {
+			grain.Min = formatInTimeZone(timeutil.TruncateTime(minTime, tg, tz, firstDay, firstMonth), tz)
+		}
+		if !maxTime.IsZero() {
+			end := timeutil.TruncateTime(maxTime, tg, tz, firstDay, firstMonth)
+			end = timeGrainToDuration(g).Add(end.In(tz))
+			grain.Max = formatInTimeZone(end, tz)
+		}
+		if !watermark.IsZero() {
+			grain.Watermark = formatInTimeZone(timeutil.TruncateTime(watermark, tg, tz, firstDay, firstMonth), tz)
+		}
+		res.Grains = append(res.Grains, grain)
+	}
+
+	return res
+}
+
+// formatInTimeZone formats t as an ISO 8601 timestamp with the UTC offset of tz.
+// It returns an empty string for the zero time.
+func formatInTimeZone(t time.Time, tz *time.Location) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(tz).Format(time.RFC3339Nano)
+}
+
 func (q *MetricsViewTimeRange) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
 	return ErrExportNotSupported
 }
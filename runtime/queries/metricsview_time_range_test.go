@@ -0,0 +1,55 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTimeZoneTimeRangeSummary(t *testing.T) {
+	summary := &runtimev1.TimeRangeSummary{
+		Min: timestamppb.New(time.Date(2024, 3, 1, 3, 30, 0, 0, time.UTC)),
+		Max: timestamppb.New(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)),
+	}
+	watermark := time.Date(2024, 3, 10, 10, 0, 0, 0, time.UTC)
+	grains := []runtimev1.TimeGrain{runtimev1.TimeGrain_TIME_GRAIN_HOUR, runtimev1.TimeGrain_TIME_GRAIN_DAY, runtimev1.TimeGrain_TIME_GRAIN_WEEK}
+
+	// The summary crosses the DST change in New York on 2024-03-10
+	tz, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	res := timeZoneTimeRangeSummary(summary, watermark, tz, grains, 1, 1)
+	require.Equal(t, "America/New_York", res.TimeZone)
+	require.Equal(t, "2024-02-29T22:30:00-05:00", res.Min)
+	require.Equal(t, "2024-03-10T08:00:00-04:00", res.Max)
+	require.Equal(t, "2024-03-10T06:00:00-04:00", res.Watermark)
+	require.Len(t, res.Grains, 3)
+
+	require.Equal(t, runtimev1.TimeGrain_TIME_GRAIN_HOUR, res.Grains[0].Grain)
+	require.Equal(t, "2024-02-29T22:00:00-05:00", res.Grains[0].Min)
+	require.Equal(t, "2024-03-10T09:00:00-04:00", res.Grains[0].Max)
+	require.Equal(t, "2024-03-10T06:00:00-04:00", res.Grains[0].Watermark)
+
+	require.Equal(t, runtimev1.TimeGrain_TIME_GRAIN_DAY, res.Grains[1].Grain)
+	require.Equal(t, "2024-02-29T00:00:00-05:00", res.Grains[1].Min)
+	require.Equal(t, "2024-03-11T00:00:00-04:00", res.Grains[1].Max)
+	require.Equal(t, "2024-03-10T00:00:00-05:00", res.Grains[1].Watermark)
+
+	require.Equal(t, runtimev1.TimeGrain_TIME_GRAIN_WEEK, res.Grains[2].Grain)
+	require.Equal(t, "2024-02-26T00:00:00-05:00", res.Grains[2].Min)
+	require.Equal(t, "2024-03-11T00:00:00-04:00", res.Grains[2].Max)
+	require.Equal(t, "2024-03-04T00:00:00-05:00", res.Grains[2].Watermark)
+
+	// Summaries of empty tables have no min and max
+	tz, err = time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+	res = timeZoneTimeRangeSummary(&runtimev1.TimeRangeSummary{}, watermark, tz, grains[1:2], 1, 1)
+	require.Empty(t, res.Min)
+	require.Empty(t, res.Max)
+	require.Equal(t, "2024-03-10T15:30:00+05:30", res.Watermark)
+	require.Empty(t, res.Grains[0].Min)
+	require.Empty(t, res.Grains[0].Max)
+	require.Equal(t, "2024-03-10T00:00:00+05:30", res.Grains[0].Watermark)
+}
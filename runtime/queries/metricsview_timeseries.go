@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"slices"
 	"strings"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/metricsview"
 	"github.com/rilldata/rill/runtime/pkg/duration"
+	"github.com/rilldata/rill/runtime/pkg/forecast"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/rilldata/rill/runtime/pkg/timeutil"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -21,19 +23,20 @@ import (
 )
 
 type MetricsViewTimeSeries struct {
-	MetricsViewName string                       `json:"metrics_view_name,omitempty"`
-	MeasureNames    []string                     `json:"measure_names,omitempty"`
-	TimeStart       *timestamppb.Timestamp       `json:"time_start,omitempty"`
-	TimeEnd         *timestamppb.Timestamp       `json:"time_end,omitempty"`
-	Limit           int64                        `json:"limit,omitempty"`
-	Offset          int64                        `json:"offset,omitempty"`
-	Sort            []*runtimev1.MetricsViewSort `json:"sort,omitempty"`
-	Where           *runtimev1.Expression        `json:"where,omitempty"`
-	Filter          *runtimev1.MetricsViewFilter `json:"filter,omitempty"` // backwards compatibility
-	Having          *runtimev1.Expression        `json:"having,omitempty"`
-	TimeGranularity runtimev1.TimeGrain          `json:"time_granularity,omitempty"`
-	TimeZone        string                       `json:"time_zone,omitempty"`
-	SecurityClaims  *runtime.SecurityClaims      `json:"security_claims,omitempty"`
+	MetricsViewName string                        `json:"metrics_view_name,omitempty"`
+	MeasureNames    []string                      `json:"measure_names,omitempty"`
+	TimeStart       *timestamppb.Timestamp        `json:"time_start,omitempty"`
+	TimeEnd         *timestamppb.Timestamp        `json:"time_end,omitempty"`
+	Limit           int64                         `json:"limit,omitempty"`
+	Offset          int64                         `json:"offset,omitempty"`
+	Sort            []*runtimev1.MetricsViewSort  `json:"sort,omitempty"`
+	Where           *runtimev1.Expression         `json:"where,omitempty"`
+	Filter          *runtimev1.MetricsViewFilter  `json:"filter,omitempty"` // backwards compatibility
+	Having          *runtimev1.Expression         `json:"having,omitempty"`
+	TimeGranularity runtimev1.TimeGrain           `json:"time_granularity,omitempty"`
+	TimeZone        string                        `json:"time_zone,omitempty"`
+	SecurityClaims  *runtime.SecurityClaims       `json:"security_claims,omitempty"`
+	Forecast        *runtimev1.TimeSeriesForecast `json:"forecast,omitempty"`
 
 	Result *runtimev1.MetricsViewTimeSeriesResponse `json:"-"`
 }
@@ -49,9 +52,7 @@ func (q *MetricsViewTimeSeries) Key() string {
 }
 
 func (q *MetricsViewTimeSeries) Deps() []*runtimev1.ResourceName {
-	return []*runtimev1.ResourceName{
-		{Kind: runtime.ResourceKindMetricsView, Name: q.MetricsViewName},
-	}
+	return metricsViewQueryDeps(q.MetricsViewName, q.Where, q.Having)
 }
 
 func (q *MetricsViewTimeSeries) MarshalResult() *runtime.QueryResult {
@@ -80,6 +81,10 @@ func (q *MetricsViewTimeSeries) Resolve(ctx context.Context, rt *runtime.Runtime
 		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
+	if q.Forecast != nil && (len(q.Sort) > 0 || q.Limit != 0 || q.Offset != 0) {
+		return fmt.Errorf("forecasts are not supported for sorted or paginated time series")
+	}
+
 	qry, err := q.rewriteToMetricsViewQuery(mv.TimeDimension)
 	if err != nil {
 		return fmt.Errorf("error rewriting to metrics query: %w", err)
@@ -119,18 +124,35 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 	mv := r.GetMetricsView().Spec
 
 	if opts.PreWriteHook != nil {
-		err = opts.PreWriteHook(q.generateFilename())
+		filename, err := opts.Filename(q.generateFilename(), exportFilenameVars(q.MetricsViewName, nil, q.TimeStart, q.TimeEnd))
 		if err != nil {
 			return err
 		}
+
+		err = opts.PreWriteHook(filename)
+		if err != nil {
+			return err
+		}
+	}
+
+	loc, err := opts.ParsedLocale()
+	if err != nil {
+		return err
 	}
 
+	// When a locale is requested, times are written as localized labels for the time grain (e.g. "März 2024")
+	grain := strings.ToLower(strings.TrimPrefix(q.TimeGranularity.String(), "TIME_GRAIN_"))
+
 	tmp := make([]*structpb.Struct, 0, len(q.Result.Data))
 	meta := append([]*runtimev1.MetricsViewColumn{{
 		Name: mv.TimeDimension,
 	}}, q.Result.Meta...)
 	for _, dt := range q.Result.Data {
-		dt.Records.Fields[mv.TimeDimension] = structpb.NewStringValue(dt.Ts.AsTime().Format(time.RFC3339Nano))
+		ts := dt.Ts.AsTime().Format(time.RFC3339Nano)
+		if opts.Locale != "" {
+			ts = loc.TimeLabel(dt.Ts.AsTime(), grain)
+		}
+		dt.Records.Fields[mv.TimeDimension] = structpb.NewStringValue(ts)
 		tmp = append(tmp, dt.Records)
 	}
 
@@ -138,7 +160,7 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 	case runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
 		return fmt.Errorf("unspecified format")
 	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
-		return WriteCSV(meta, tmp, w)
+		return WriteCSV(meta, tmp, w, loc)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
 		return WriteXLSX(meta, tmp, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
@@ -237,11 +259,16 @@ func (q *MetricsViewTimeSeries) populateResult(rows *drivers.Result, tsAlias str
 		}
 	}
 
+	if q.Forecast != nil {
+		data = appendForecast(data, schema, q.Forecast, dur, tz)
+	}
+
 	meta := structTypeToMetricsViewColumn(rows.Schema)
 
 	q.Result = &runtimev1.MetricsViewTimeSeriesResponse{
-		Meta: meta,
-		Data: data,
+		Meta:        meta,
+		Data:        data,
+		Annotations: metricsViewColumnAnnotations(mv, metricsViewColumnNames(meta), nil),
 	}
 
 	return nil
@@ -274,6 +301,64 @@ func addNulls(data []*runtimev1.TimeSeriesValue, nullRecords *structpb.Struct, s
 	return data
 }
 
+// appendForecast projects each measure in data into the future and appends the projected buckets flagged as forecasts.
+// Null values are treated as missing. Measures that don't have numeric values or enough data to fit the model are projected as null.
+func appendForecast(data []*runtimev1.TimeSeriesValue, schema *runtimev1.StructType, opts *runtimev1.TimeSeriesForecast, d duration.Duration, tz *time.Location) []*runtimev1.TimeSeriesValue {
+	if len(data) == 0 {
+		return data
+	}
+
+	n := int(opts.Periods)
+	projected := make(map[string][]float64, len(schema.Fields))
+	for _, f := range schema.Fields {
+		values := make([]float64, len(data))
+		numeric := true
+		for i, v := range data {
+			switch x := v.Records.Fields[f.Name].GetKind().(type) {
+			case *structpb.Value_NumberValue:
+				values[i] = x.NumberValue
+			case *structpb.Value_NullValue, nil:
+				values[i] = math.NaN()
+			default:
+				numeric = false
+			}
+			if !numeric {
+				break
+			}
+		}
+		if !numeric {
+			continue
+		}
+
+		switch opts.Method {
+		case runtimev1.TimeSeriesForecast_METHOD_LINEAR:
+			projected[f.Name] = forecast.Linear(values, n)
+		case runtimev1.TimeSeriesForecast_METHOD_HOLT_WINTERS:
+			projected[f.Name] = forecast.HoltWinters(values, int(opts.SeasonalPeriod), n)
+		}
+	}
+
+	t := data[len(data)-1].Ts.AsTime()
+	for i := 0; i < n; i++ {
+		t = addTo(t, d, tz)
+		records := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(schema.Fields))}
+		for _, f := range schema.Fields {
+			vals := projected[f.Name]
+			if vals == nil || math.IsNaN(vals[i]) || math.IsInf(vals[i], 0) {
+				records.Fields[f.Name] = structpb.NewNullValue()
+				continue
+			}
+			records.Fields[f.Name] = structpb.NewNumberValue(vals[i])
+		}
+		data = append(data, &runtimev1.TimeSeriesValue{
+			Ts:       timestamppb.New(t),
+			Records:  records,
+			Forecast: true,
+		})
+	}
+	return data
+}
+
 func addTo(t time.Time, d duration.Duration, tz *time.Location) time.Time {
 	sd := d.(duration.StandardDuration)
 	if sd.Hour > 0 || sd.Minute > 0 || sd.Second > 0 {
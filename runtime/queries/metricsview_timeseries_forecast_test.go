@@ -0,0 +1,54 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAppendForecast(t *testing.T) {
+	schema := &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+		{Name: "total", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64}},
+		{Name: "label", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+	}}
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var data []*runtimev1.TimeSeriesValue
+	for i, v := range []any{10.0, 20.0, nil, 40.0} {
+		total := structpb.NewNullValue()
+		if v != nil {
+			total = structpb.NewNumberValue(v.(float64))
+		}
+		data = append(data, &runtimev1.TimeSeriesValue{
+			Ts: timestamppb.New(start.AddDate(0, 0, i)),
+			Records: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"total": total,
+				"label": structpb.NewStringValue("x"),
+			}},
+		})
+	}
+
+	opts := &runtimev1.TimeSeriesForecast{Method: runtimev1.TimeSeriesForecast_METHOD_LINEAR, Periods: 2}
+	res := appendForecast(data, schema, opts, timeGrainToDuration(runtimev1.TimeGrain_TIME_GRAIN_DAY), time.UTC)
+	require.Len(t, res, 6)
+	for _, v := range res[:4] {
+		require.False(t, v.Forecast)
+	}
+
+	require.True(t, res[4].Forecast)
+	require.Equal(t, start.AddDate(0, 0, 4), res[4].Ts.AsTime())
+	require.InDelta(t, 50.0, res[4].Records.Fields["total"].GetNumberValue(), 1e-9)
+	_, isNull := res[4].Records.Fields["label"].Kind.(*structpb.Value_NullValue)
+	require.True(t, isNull)
+
+	require.True(t, res[5].Forecast)
+	require.Equal(t, start.AddDate(0, 0, 5), res[5].Ts.AsTime())
+	require.InDelta(t, 60.0, res[5].Records.Fields["total"].GetNumberValue(), 1e-9)
+
+	// No data to project
+	require.Empty(t, appendForecast(nil, schema, opts, timeGrainToDuration(runtimev1.TimeGrain_TIME_GRAIN_DAY), time.UTC))
+}
@@ -43,9 +43,7 @@ func (q *MetricsViewToplist) Key() string {
 }
 
 func (q *MetricsViewToplist) Deps() []*runtimev1.ResourceName {
-	return []*runtimev1.ResourceName{
-		{Kind: runtime.ResourceKindMetricsView, Name: q.MetricsViewName},
-	}
+	return metricsViewQueryDeps(q.MetricsViewName, q.Where, q.Having)
 }
 
 func (q *MetricsViewToplist) MarshalResult() *runtime.QueryResult {
@@ -93,8 +91,9 @@ func (q *MetricsViewToplist) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	}
 
 	q.Result = &runtimev1.MetricsViewToplistResponse{
-		Meta: structTypeToMetricsViewColumn(res.Schema),
-		Data: data,
+		Meta:        structTypeToMetricsViewColumn(res.Schema),
+		Data:        data,
+		Annotations: metricsViewColumnAnnotations(mv, structTypeNames(res.Schema), nil),
 	}
 	return nil
 }
@@ -128,13 +127,19 @@ func (q *MetricsViewToplist) Export(ctx context.Context, rt *runtime.Runtime, in
 		return fmt.Errorf("unsupported format: %s", opts.Format.String())
 	}
 
+	qry.Locale = opts.Locale
+
 	path, err := e.Export(ctx, qry, nil, format)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = os.Remove(path) }()
 
-	filename := q.generateFilename()
+	filename, err := opts.Filename(q.generateFilename(), exportFilenameVars(q.MetricsViewName, nil, q.TimeStart, q.TimeEnd))
+	if err != nil {
+		return err
+	}
+
 	err = opts.PreWriteHook(filename)
 	if err != nil {
 		return err
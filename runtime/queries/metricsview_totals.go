@@ -35,9 +35,7 @@ func (q *MetricsViewTotals) Key() string {
 }
 
 func (q *MetricsViewTotals) Deps() []*runtimev1.ResourceName {
-	return []*runtimev1.ResourceName{
-		{Kind: runtime.ResourceKindMetricsView, Name: q.MetricsViewName},
-	}
+	return metricsViewQueryDeps(q.MetricsViewName, q.Where)
 }
 
 func (q *MetricsViewTotals) MarshalResult() *runtime.QueryResult {
@@ -89,8 +87,9 @@ func (q *MetricsViewTotals) Resolve(ctx context.Context, rt *runtime.Runtime, in
 	}
 
 	q.Result = &runtimev1.MetricsViewTotalsResponse{
-		Meta: structTypeToMetricsViewColumn(res.Schema),
-		Data: data[0],
+		Meta:        structTypeToMetricsViewColumn(res.Schema),
+		Data:        data[0],
+		Annotations: metricsViewColumnAnnotations(mv, structTypeNames(res.Schema), nil),
 	}
 
 	return nil
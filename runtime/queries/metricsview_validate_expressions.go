@@ -0,0 +1,89 @@
+package queries
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
+)
+
+type MetricsViewValidateExpressions struct {
+	MetricsViewName string                  `json:"metrics_view_name,omitempty"`
+	Where           *runtimev1.Expression   `json:"where,omitempty"`
+	Having          *runtimev1.Expression   `json:"having,omitempty"`
+	HavingNames     []string                `json:"having_names,omitempty"`
+	SecurityClaims  *runtime.SecurityClaims `json:"security_claims,omitempty"`
+
+	Result *runtimev1.MetricsViewValidateExpressionsResponse `json:"-"`
+}
+
+var _ runtime.Query = &MetricsViewValidateExpressions{}
+
+func (q *MetricsViewValidateExpressions) Key() string {
+	r, err := json.Marshal(q)
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("MetricsViewValidateExpressions:%s", string(r))
+}
+
+func (q *MetricsViewValidateExpressions) Deps() []*runtimev1.ResourceName {
+	return metricsViewQueryDeps(q.MetricsViewName, q.Where, q.Having)
+}
+
+func (q *MetricsViewValidateExpressions) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
+}
+
+func (q *MetricsViewValidateExpressions) UnmarshalResult(v any) error {
+	res, ok := v.(*runtimev1.MetricsViewValidateExpressionsResponse)
+	if !ok {
+		return fmt.Errorf("MetricsViewValidateExpressions: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *MetricsViewValidateExpressions) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	mv, sec, err := resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, q.MetricsViewName, q.SecurityClaims)
+	if err != nil {
+		return err
+	}
+
+	e, err := metricsview.NewExecutor(ctx, rt, instanceID, mv, sec, priority)
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+
+	errs, err := e.ValidateExpressions(ctx, q.MetricsViewName, metricsview.NewExpressionFromProto(q.Where), metricsview.NewExpressionFromProto(q.Having), q.HavingNames)
+	if err != nil {
+		return err
+	}
+
+	q.Result = &runtimev1.MetricsViewValidateExpressionsResponse{
+		Valid: len(errs) == 0,
+	}
+	for _, err := range errs {
+		q.Result.Errors = append(q.Result.Errors, &runtimev1.MetricsViewExpressionError{
+			Clause:  err.Clause,
+			Path:    err.Path,
+			Name:    err.Name,
+			Code:    string(err.Code),
+			Message: err.Message,
+		})
+	}
+
+	return nil
+}
+
+func (q *MetricsViewValidateExpressions) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	return nil
+}
@@ -0,0 +1,192 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ModelChangeFeedRetention is the number of versions for which removed rows are retained in a model's change feed.
+// Consumers that fall further behind must re-sync from scratch.
+const ModelChangeFeedRetention = 1000
+
+// ErrModelChangesExpired is returned by ModelChanges when the requested version is older than the change feed's retention.
+var ErrModelChangesExpired = errors.New("the requested version is no longer retained in the change feed, a full re-sync is required")
+
+// ModelChangeFeedTable returns the name of the table that tracks the row-level changes of a model.
+func ModelChangeFeedTable(model string) string {
+	return "__rill_changes_" + model
+}
+
+// ModelChanges returns the rows added to a model and the number of rows removed from it between two change feed versions.
+// A row that was changed is reported as a removed row and an added row.
+type ModelChanges struct {
+	ModelName    string              `json:"model_name,omitempty"`
+	SinceVersion int64               `json:"since_version,omitempty"`
+	ToVersion    int64               `json:"to_version,omitempty"` // Defaults to the model's current version if 0
+	Limit        int                 `json:"limit,omitempty"`
+	Offset       int                 `json:"offset,omitempty"`
+	Result       *ModelChangesResult `json:"-"`
+}
+
+type ModelChangesResult struct {
+	Version     int64
+	Schema      *runtimev1.StructType
+	Data        []*structpb.Struct
+	RemovedRows int64
+	HasMore     bool
+}
+
+var _ runtime.Query = &ModelChanges{}
+
+func (q *ModelChanges) Key() string {
+	return fmt.Sprintf("ModelChanges:%s:%d:%d:%d:%d", q.ModelName, q.SinceVersion, q.ToVersion, q.Limit, q.Offset)
+}
+
+func (q *ModelChanges) Deps() []*runtimev1.ResourceName {
+	return []*runtimev1.ResourceName{
+		{Kind: runtime.ResourceKindModel, Name: q.ModelName},
+	}
+}
+
+func (q *ModelChanges) MarshalResult() *runtime.QueryResult {
+	var size int64
+	if q.Result != nil && len(q.Result.Data) > 0 {
+		// approx
+		size = sizeProtoMessage(q.Result.Data[0]) * int64(len(q.Result.Data))
+	}
+
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: size,
+	}
+}
+
+func (q *ModelChanges) UnmarshalResult(v any) error {
+	res, ok := v.(*ModelChangesResult)
+	if !ok {
+		return fmt.Errorf("ModelChanges: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *ModelChanges) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	ctrl, err := rt.Controller(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	rs, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: q.ModelName}, false)
+	if err != nil {
+		return err
+	}
+	mdl := rs.GetModel()
+	if !mdl.Spec.ChangeFeed {
+		return fmt.Errorf("model %q does not have a change feed", q.ModelName)
+	}
+	if mdl.State.ResultTable == "" || mdl.State.ChangeFeedVersion == 0 {
+		return fmt.Errorf("model %q has not been built successfully", q.ModelName)
+	}
+
+	version := mdl.State.ChangeFeedVersion
+	if q.ToVersion != 0 {
+		if q.ToVersion > version {
+			return fmt.Errorf("version %d is newer than the current version %d", q.ToVersion, version)
+		}
+		version = q.ToVersion
+	}
+	if q.SinceVersion < 0 || q.SinceVersion > version {
+		return fmt.Errorf("invalid since version %d (the current version is %d)", q.SinceVersion, version)
+	}
+	if q.SinceVersion > 0 && q.SinceVersion <= mdl.State.ChangeFeedVersion-ModelChangeFeedRetention {
+		return ErrModelChangesExpired
+	}
+
+	olap, release, err := rt.OLAP(ctx, instanceID, mdl.State.ResultConnector)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if olap.Dialect() != drivers.DialectDuckDB {
+		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
+
+	d := olap.Dialect()
+	changes := d.EscapeIdentifier(ModelChangeFeedTable(q.ModelName))
+
+	// Count the rows that were present at the since version and have since been removed
+	var removed int64
+	if q.SinceVersion > 0 {
+		res, err := olap.Execute(ctx, &drivers.Statement{
+			Query:            fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE added_version <= ? AND removed_version > ? AND removed_version <= ?", changes),
+			Args:             []any{q.SinceVersion, q.SinceVersion, version},
+			Priority:         priority,
+			ExecutionTimeout: defaultExecutionTimeout,
+		})
+		if err != nil {
+			return err
+		}
+		for res.Next() {
+			if err := res.Scan(&removed); err != nil {
+				_ = res.Close()
+				return err
+			}
+		}
+		err = res.Err()
+		_ = res.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Fetch the rows that were added after the since version and are still present.
+	// One extra row is requested to determine if there are more rows.
+	sql := fmt.Sprintf(
+		`SELECT m.* EXCLUDE (__rill_row_hash) FROM (SELECT t.*, hash(t) AS __rill_row_hash FROM %s t) m JOIN %s c ON m.__rill_row_hash = c.row_hash WHERE c.removed_version IS NULL AND c.added_version > ? AND c.added_version <= ? ORDER BY c.added_version, c.row_hash LIMIT %d OFFSET %d`,
+		d.EscapeIdentifier(mdl.State.ResultTable),
+		changes,
+		q.Limit+1,
+		q.Offset,
+	)
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            sql,
+		Args:             []any{q.SinceVersion, version},
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+
+	data, err := rowsToData(res)
+	if err != nil {
+		return err
+	}
+
+	hasMore := len(data) > q.Limit
+	if hasMore {
+		data = data[:q.Limit]
+	}
+
+	q.Result = &ModelChangesResult{
+		Version:     version,
+		Schema:      res.Schema,
+		Data:        data,
+		RemovedRows: removed,
+		HasMore:     hasMore,
+	}
+	return nil
+}
+
+func (q *ModelChanges) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	return ErrExportNotSupported
+}
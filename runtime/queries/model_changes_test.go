@@ -0,0 +1,70 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelChanges(t *testing.T) {
+	rt, instanceID := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, instanceID, map[string]string{
+		"/models/foo.yaml": `
+type: model
+sql: SELECT * FROM (VALUES (1, 'a'), (2, 'b')) t(id, name)
+change_feed: true
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, instanceID)
+	testruntime.RequireReconcileState(t, rt, instanceID, 2, 0, 0)
+
+	// An initial sync returns all rows
+	q := &queries.ModelChanges{ModelName: "foo", Limit: 10}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), q.Result.Version)
+	require.Len(t, q.Result.Data, 2)
+	require.Equal(t, int64(0), q.Result.RemovedRows)
+	require.False(t, q.Result.HasMore)
+	require.Len(t, q.Result.Schema.Fields, 2)
+
+	// Remove one row, change one row and add one row
+	testruntime.PutFiles(t, rt, instanceID, map[string]string{
+		"/models/foo.yaml": `
+type: model
+sql: SELECT * FROM (VALUES (2, 'b2'), (3, 'c')) t(id, name)
+change_feed: true
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, instanceID)
+	testruntime.RequireReconcileState(t, rt, instanceID, 2, 0, 0)
+
+	q = &queries.ModelChanges{ModelName: "foo", SinceVersion: 1, Limit: 1}
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), q.Result.Version)
+	require.Len(t, q.Result.Data, 1)
+	require.Equal(t, int64(2), q.Result.RemovedRows)
+	require.True(t, q.Result.HasMore)
+
+	q = &queries.ModelChanges{ModelName: "foo", SinceVersion: 1, ToVersion: 2, Limit: 1, Offset: 1}
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Len(t, q.Result.Data, 1)
+	require.False(t, q.Result.HasMore)
+
+	// No changes since the current version
+	q = &queries.ModelChanges{ModelName: "foo", SinceVersion: 2, Limit: 10}
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Empty(t, q.Result.Data)
+	require.Equal(t, int64(0), q.Result.RemovedRows)
+
+	// Versions in the future are rejected
+	q = &queries.ModelChanges{ModelName: "foo", SinceVersion: 3, Limit: 10}
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.Error(t, err)
+}
@@ -37,6 +37,7 @@ func ProtoToQuery(q *runtimev1.Query, claims *runtime.SecurityClaims) (runtime.Q
 			Filter:              req.Filter,
 			Offset:              req.Offset,
 			PivotOn:             req.PivotOn,
+			PivotColumns:        req.PivotColumns,
 			SecurityClaims:      claims,
 		}, nil
 	case *runtimev1.Query_MetricsViewComparisonRequest:
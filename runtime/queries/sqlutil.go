@@ -88,5 +88,5 @@ func addInterval(t time.Time, timeGrain runtimev1.TimeGrain) time.Time {
 }
 
 func escapeMetricsViewTable(d drivers.Dialect, mv *runtimev1.MetricsViewSpec) string {
-	return d.EscapeTable(mv.Database, mv.DatabaseSchema, mv.Table)
+	return d.EscapeMetricsViewTable(mv)
 }
@@ -0,0 +1,351 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+)
+
+// TableColumnsProfile profiles multiple columns of a table at once.
+// It computes the statistics of all the columns in one query, and the histograms and top-K values of all the columns in a second query,
+// which avoids a round trip per column and statistic.
+type TableColumnsProfile struct {
+	Connector      string
+	Database       string
+	DatabaseSchema string
+	TableName      string
+	ColumnNames    []string // Profiles all columns if empty
+	K              int
+	HistogramBins  int
+	Result         *runtimev1.TableColumnsProfileResponse
+}
+
+var _ runtime.Query = &TableColumnsProfile{}
+
+func (q *TableColumnsProfile) Key() string {
+	return fmt.Sprintf("TableColumnsProfile:%s:%q:%d:%d", q.TableName, q.ColumnNames, q.K, q.HistogramBins)
+}
+
+func (q *TableColumnsProfile) Deps() []*runtimev1.ResourceName {
+	return []*runtimev1.ResourceName{
+		{Kind: runtime.ResourceKindSource, Name: q.TableName},
+		{Kind: runtime.ResourceKindModel, Name: q.TableName},
+	}
+}
+
+func (q *TableColumnsProfile) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
+}
+
+func (q *TableColumnsProfile) UnmarshalResult(v any) error {
+	res, ok := v.(*runtimev1.TableColumnsProfileResponse)
+	if !ok {
+		return fmt.Errorf("TableColumnsProfile: mismatched unmarshal input")
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *TableColumnsProfile) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	olap, release, err := rt.OLAP(ctx, instanceID, q.Connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if olap.Dialect() != drivers.DialectDuckDB {
+		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
+	}
+
+	tbl, err := olap.InformationSchema().Lookup(ctx, q.Database, q.DatabaseSchema, q.TableName)
+	if err != nil {
+		return err
+	}
+
+	// Resolve the columns to profile
+	var fields []*runtimev1.StructType_Field
+	if len(q.ColumnNames) == 0 {
+		fields = tbl.Schema.Fields
+	} else {
+		for _, name := range q.ColumnNames {
+			var found *runtimev1.StructType_Field
+			for _, f := range tbl.Schema.Fields {
+				if f.Name == name {
+					found = f
+					break
+				}
+			}
+			if found == nil {
+				return fmt.Errorf("column %q not found in table %q", name, q.TableName)
+			}
+			fields = append(fields, found)
+		}
+	}
+
+	from := olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName)
+	cols := make([]*profiledColumn, len(fields))
+	for i, f := range fields {
+		cols[i] = &profiledColumn{
+			field:   f,
+			expr:    safeName(f.Name),
+			simple:  !isComplexType(f.Type),
+			numeric: isNumericType(f.Type),
+			profile: &runtimev1.ColumnProfile{Name: f.Name, Type: f.Type},
+		}
+	}
+
+	res := &runtimev1.TableColumnsProfileResponse{}
+	err = q.resolveStatistics(ctx, olap, priority, from, cols, res)
+	if err != nil {
+		return err
+	}
+
+	err = q.resolveDistributions(ctx, olap, priority, from, cols)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range cols {
+		res.Columns = append(res.Columns, c.profile)
+	}
+	q.Result = res
+	return nil
+}
+
+func (q *TableColumnsProfile) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	return ErrExportNotSupported
+}
+
+// resolveStatistics computes the row count and the null count, cardinality and min/max of every column.
+func (q *TableColumnsProfile) resolveStatistics(ctx context.Context, olap drivers.OLAPStore, priority int, from string, cols []*profiledColumn, res *runtimev1.TableColumnsProfileResponse) error {
+	exprs := []string{"count(*)"}
+	dest := []any{&res.RowCount}
+	for _, c := range cols {
+		exprs = append(exprs, fmt.Sprintf("count(*) FILTER (WHERE %s IS NULL)", c.expr))
+		dest = append(dest, &c.profile.NullCount)
+		if c.simple {
+			exprs = append(exprs, fmt.Sprintf("approx_count_distinct(%s)", c.expr), fmt.Sprintf("min(%s)", c.expr), fmt.Sprintf("max(%s)", c.expr))
+			dest = append(dest, &c.profile.Cardinality, &c.min, &c.max)
+		}
+		if c.numeric {
+			exprs = append(exprs, fmt.Sprintf("min(%s)::DOUBLE", c.expr), fmt.Sprintf("max(%s)::DOUBLE", c.expr))
+			dest = append(dest, &c.minFloat, &c.maxFloat)
+		}
+	}
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            fmt.Sprintf("SELECT %s FROM %s", strings.Join(exprs, ", "), from),
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err = rows.Scan(dest...)
+		if err != nil {
+			return err
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	for _, c := range cols {
+		if res.RowCount > 0 {
+			c.profile.NullRatio = c.profile.NullCount / res.RowCount
+		}
+		if c.min != nil {
+			c.profile.Min, err = pbutil.ToValue(c.min, c.field.Type)
+			if err != nil {
+				return err
+			}
+		}
+		if c.max != nil {
+			c.profile.Max, err = pbutil.ToValue(c.max, c.field.Type)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveDistributions computes the top-K values and histogram of every column.
+// It must be called after resolveStatistics since the histogram bins are derived from the min/max of the columns.
+func (q *TableColumnsProfile) resolveDistributions(ctx context.Context, olap drivers.OLAPStore, priority int, from string, cols []*profiledColumn) error {
+	var exprs []string
+	var dest []any
+	for _, c := range cols {
+		if c.simple && q.K > 0 {
+			exprs = append(exprs, fmt.Sprintf(
+				"(SELECT list({'value': v, 'count': n} ORDER BY n DESC, v ASC) FROM (SELECT %s AS v, count(*) AS n FROM %s GROUP BY 1 ORDER BY 2 DESC, 1 ASC LIMIT %d))",
+				c.expr, from, q.K,
+			))
+			c.topK = new(any)
+			dest = append(dest, c.topK)
+		}
+
+		if c.numeric && q.HistogramBins > 0 && c.minFloat != nil && c.maxFloat != nil && !math.IsInf(*c.minFloat, 0) && !math.IsInf(*c.maxFloat, 0) {
+			c.bins = q.HistogramBins
+			c.width = (*c.maxFloat - *c.minFloat) / float64(c.bins)
+			bucketExpr := "0"
+			if c.width > 0 {
+				bucketExpr = fmt.Sprintf("LEAST(GREATEST(FLOOR((%s::DOUBLE - %s) / %s), 0), %d)", c.expr, doubleLiteral(*c.minFloat), doubleLiteral(c.width), c.bins-1)
+			} else {
+				c.bins = 1
+			}
+			exprs = append(exprs, fmt.Sprintf(
+				"(SELECT list({'bucket': b, 'count': n} ORDER BY b) FROM (SELECT %s::INTEGER AS b, count(*) AS n FROM %s WHERE %s IS NOT NULL GROUP BY 1))",
+				bucketExpr, from, c.expr,
+			))
+			c.histogram = new(any)
+			dest = append(dest, c.histogram)
+		}
+	}
+	if len(exprs) == 0 {
+		return nil
+	}
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            "SELECT " + strings.Join(exprs, ", "),
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err = rows.Scan(dest...)
+		if err != nil {
+			return err
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	for _, c := range cols {
+		if c.topK != nil {
+			c.profile.TopK = &runtimev1.TopK{}
+			entries, _ := (*c.topK).([]any)
+			for _, e := range entries {
+				m, ok := e.(map[string]any)
+				if !ok {
+					return fmt.Errorf("unexpected top-K entry type %T", e)
+				}
+				v, err := pbutil.ToValue(m["value"], c.field.Type)
+				if err != nil {
+					return err
+				}
+				n, err := profileCount(m["count"])
+				if err != nil {
+					return err
+				}
+				c.profile.TopK.Entries = append(c.profile.TopK.Entries, &runtimev1.TopK_Entry{Value: v, Count: n})
+			}
+		}
+
+		if c.histogram != nil {
+			// Return all the bins, including empty ones
+			hist := &runtimev1.NumericHistogramBins{Bins: make([]*runtimev1.NumericHistogramBins_Bin, c.bins)}
+			for i := range hist.Bins {
+				low := *c.minFloat + float64(i)*c.width
+				high := low + c.width
+				hist.Bins[i] = &runtimev1.NumericHistogramBins_Bin{
+					Bucket:   int32(i),
+					Low:      low,
+					Midpoint: (low + high) / 2,
+					High:     high,
+				}
+			}
+			entries, _ := (*c.histogram).([]any)
+			for _, e := range entries {
+				m, ok := e.(map[string]any)
+				if !ok {
+					return fmt.Errorf("unexpected histogram entry type %T", e)
+				}
+				b, ok := m["bucket"].(int32)
+				if !ok || b < 0 || int(b) >= c.bins {
+					continue
+				}
+				n, err := profileCount(m["count"])
+				if err != nil {
+					return err
+				}
+				hist.Bins[b].Count = n
+			}
+			c.profile.Histogram = hist
+		}
+	}
+
+	return nil
+}
+
+// profiledColumn holds intermediate state for a column profiled by TableColumnsProfile.
+type profiledColumn struct {
+	field     *runtimev1.StructType_Field
+	expr      string
+	simple    bool // False for nested types, which are only profiled for nulls
+	numeric   bool
+	profile   *runtimev1.ColumnProfile
+	min       any
+	max       any
+	minFloat  *float64
+	maxFloat  *float64
+	topK      *any
+	histogram *any
+	bins      int
+	width     float64
+}
+
+func isComplexType(t *runtimev1.Type) bool {
+	switch t.Code {
+	case runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON:
+		return true
+	}
+	return false
+}
+
+func isNumericType(t *runtimev1.Type) bool {
+	switch t.Code {
+	case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64, runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256,
+		runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64, runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256,
+		runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64, runtimev1.Type_CODE_DECIMAL:
+		return true
+	}
+	return false
+}
+
+func profileCount(v any) (float64, error) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("unexpected count type %T", v)
+	}
+}
+
+func doubleLiteral(f float64) string {
+	return fmt.Sprintf("(%s)::DOUBLE", strconv.FormatFloat(f, 'g', -1, 64))
+}
@@ -9,6 +9,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/exportfilename"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -64,7 +65,7 @@ func (q *TableHead) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectPinot {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectPinot && olap.Dialect() != drivers.DialectBigQuery {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -102,8 +103,12 @@ func (q *TableHead) Export(ctx context.Context, rt *runtime.Runtime, instanceID
 
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
-			filename := q.TableName
+		if (opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV && opts.CanCopyCSV()) || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
+			filename, err := opts.Filename(q.TableName, &exportfilename.Vars{MetricsView: q.TableName})
+			if err != nil {
+				return err
+			}
+
 			sql, err := q.buildTableHeadSQL(ctx, olap)
 			if err != nil {
 				return err
@@ -121,7 +126,7 @@ func (q *TableHead) Export(ctx context.Context, rt *runtime.Runtime, instanceID
 		if err := q.generalExport(ctx, rt, instanceID, w, opts); err != nil {
 			return err
 		}
-	case drivers.DialectClickHouse:
+	case drivers.DialectClickHouse, drivers.DialectBigQuery:
 		if err := q.generalExport(ctx, rt, instanceID, w, opts); err != nil {
 			return err
 		}
@@ -139,7 +144,12 @@ func (q *TableHead) generalExport(ctx context.Context, rt *runtime.Runtime, inst
 	}
 
 	if opts.PreWriteHook != nil {
-		err = opts.PreWriteHook(q.TableName)
+		filename, err := opts.Filename(q.TableName, &exportfilename.Vars{MetricsView: q.TableName})
+		if err != nil {
+			return err
+		}
+
+		err = opts.PreWriteHook(filename)
 		if err != nil {
 			return err
 		}
@@ -151,7 +161,11 @@ func (q *TableHead) generalExport(ctx context.Context, rt *runtime.Runtime, inst
 	case runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
 		return fmt.Errorf("unspecified format")
 	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
-		return WriteCSV(meta, q.Result, w)
+		loc, err := opts.ParsedLocale()
+		if err != nil {
+			return err
+		}
+		return WriteCSV(meta, q.Result, w, loc)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
 		return WriteXLSX(meta, q.Result, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
@@ -188,7 +202,7 @@ func supportedColumns(ctx context.Context, olap drivers.OLAPStore, db, schema, t
 	}
 	var columns []string
 	for _, field := range tbl.Schema.Fields {
-		columns = append(columns, safeName(field.Name))
+		columns = append(columns, olap.Dialect().EscapeIdentifier(field.Name))
 	}
 	return columns, nil
 }
@@ -72,6 +72,10 @@ func timeGrainToDuration(tg runtimev1.TimeGrain) duration.Duration {
 // }
 
 func ResolveTimeRange(tr *runtimev1.TimeRange, mv *runtimev1.MetricsViewSpec) (time.Time, time.Time, error) {
+	if tr.Expression != "" {
+		return time.Time{}, time.Time{}, fmt.Errorf("time_range.expression is not supported for this query")
+	}
+
 	tz := time.UTC
 
 	if tr.TimeZone != "" {
@@ -7,23 +7,21 @@ import (
 	"strings"
 	"time"
 
-	"github.com/dgraph-io/ristretto"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
-	"github.com/rilldata/rill/runtime/pkg/singleflight"
+	"github.com/rilldata/rill/runtime/pkg/resultcache"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
 	meter                        = otel.Meter("github.com/rilldata/rill/runtime")
-	queryCacheHitsCounter        = observability.Must(meter.Int64ObservableCounter("query_cache.hits"))
-	queryCacheMissesCounter      = observability.Must(meter.Int64ObservableCounter("query_cache.misses"))
-	queryCacheItemCountGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.items"))
-	queryCacheSizeBytesGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.size", metric.WithUnit("bytes")))
 	queryCacheEntrySizeHistogram = observability.Must(meter.Int64Histogram("query_cache.entry_size", metric.WithUnit("bytes")))
+	queryDeduplicatedCounter     = observability.Must(meter.Int64Counter("query.deduplicated"))
 )
 
 type QueryResult struct {
@@ -32,13 +30,20 @@ type QueryResult struct {
 }
 
 type ExportOptions struct {
-	Format       runtimev1.ExportFormat
-	Priority     int
+	Format   runtimev1.ExportFormat
+	Priority int
+	// FilenameTemplate optionally overrides the filename passed to PreWriteHook (see package exportfilename for the syntax).
+	FilenameTemplate string
+	// FilenameUser is the value of the {user} placeholder in FilenameTemplate.
+	FilenameUser string
+	// Locale optionally sets the locale for formatting numbers and dates in the export (see package locale).
+	Locale       string
 	PreWriteHook func(filename string) error
 }
 
 type Query interface {
-	// Key should return a cache key that uniquely identifies the query
+	// Key should return a cache key that uniquely identifies the query.
+	// Concurrent calls with the same key share a single execution, so it must also include options that affect the execution without changing the result (such as timeouts).
 	Key() string
 	// Deps should return the resource names that the query targets.
 	// It's used to invalidate cached queries when the underlying data changes.
@@ -55,8 +60,16 @@ type Query interface {
 	Export(ctx context.Context, rt *Runtime, instanceID string, w io.Writer, opts *ExportOptions) error
 }
 
-func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, priority int) error {
+func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, priority int) (outErr error) {
 	qk := query.Key()
+
+	ctx, span := tracer.Start(ctx, "Runtime.Query", trace.WithAttributes(
+		attribute.String("instance_id", instanceID),
+		attribute.String("query", queryName(query)),
+		attribute.String("query_key", qk),
+	))
+	defer func() { endSpan(span, outErr) }()
+
 	// If key is empty, skip caching
 	if qk == "" {
 		return query.Resolve(ctx, r, instanceID, priority)
@@ -69,6 +82,8 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	}
 	deps := query.Deps()
 	depKeys := make([]string, 0, len(deps))
+	depTags := make([]string, 0, len(deps))
+	var metricsViews []string
 	olapConnector := ""
 	for _, dep := range deps {
 		// Get the dependency resource
@@ -81,6 +96,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 		// Infer OLAP connector for common resource types used in a query
 		if mv := res.GetMetricsView(); mv != nil {
 			olapConnector = mv.Spec.Connector
+			metricsViews = append(metricsViews, res.Meta.Name.Name)
 		} else if mdl := res.GetModel(); mdl != nil {
 			olapConnector = mdl.Spec.OutputConnector
 		} else if src := res.GetSource(); src != nil {
@@ -91,11 +107,20 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 		// Using StateUpdatedOn instead of StateVersion because the state version is reset when the resource is deleted and recreated.
 		key := fmt.Sprintf("%s:%s:%d:%d", res.Meta.Name.Kind, res.Meta.Name.Name, res.Meta.StateUpdatedOn.Seconds, res.Meta.StateUpdatedOn.Nanos/int32(time.Millisecond))
 		depKeys = append(depKeys, key)
+		depTags = append(depTags, queryCacheTag(res.Meta.Name))
 	}
 
+	// Build cache key
+	depKey := strings.Join(depKeys, ";")
+	key := queryCacheKey{
+		instanceID:    instanceID,
+		queryKey:      qk,
+		dependencyKey: depKey,
+	}.String()
+
 	// If there were no known dependencies, skip caching
 	if len(depKeys) == 0 {
-		return query.Resolve(ctx, r, instanceID, priority)
+		return r.resolveDeduplicated(ctx, key, query, instanceID, priority)
 	}
 
 	// Skip caching if the OLAP connector is not DuckDB.
@@ -107,19 +132,16 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	dialect := olap.Dialect()
 	release()
 	if dialect != drivers.DialectDuckDB {
-		return query.Resolve(ctx, r, instanceID, priority)
+		return r.resolveDeduplicated(ctx, key, query, instanceID, priority)
 	}
 
-	// Build cache key
-	depKey := strings.Join(depKeys, ";")
-	key := queryCacheKey{
-		instanceID:    instanceID,
-		queryKey:      qk,
-		dependencyKey: depKey,
-	}.String()
+	// Track the query so it can be replayed to warm the cache after the metrics views it targets are refreshed
+	for _, mv := range metricsViews {
+		r.queryWarmer.track(instanceID, mv, query, priority)
+	}
 
 	// Try to get from cache
-	if val, ok := r.queryCache.cache.Get(key); ok {
+	if val, ok := r.queryCache.cache.Get(instanceID, key); ok {
 		observability.AddRequestAttributes(ctx, attribute.Bool("query.cache_hit", true))
 		return query.UnmarshalResult(val)
 	}
@@ -129,20 +151,32 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	owner := false
 	val, err := r.queryCache.singleflight.Do(ctx, key, func(ctx context.Context) (any, error) {
 		// Try cache again
-		if val, ok := r.queryCache.cache.Get(key); ok {
+		if val, ok := r.queryCache.cache.Get(instanceID, key); ok {
 			return val, nil
 		}
 
-		// Load
-		err := query.Resolve(ctx, r, instanceID, priority)
+		// Load from the shared cache or resolve the query
+		res, err := r.queryCache.loadShared(ctx, key, queryResultCodec{}, func(ctx context.Context) (*loadedResult, error) {
+			err := query.Resolve(ctx, r, instanceID, priority)
+			if err != nil {
+				return nil, err
+			}
+			res := query.MarshalResult()
+			return &loadedResult{Value: res.Value, Size: res.Bytes, Cache: true}, nil
+		})
 		if err != nil {
 			return nil, err
 		}
 
-		owner = true
-		res := query.MarshalResult()
-		r.queryCache.cache.Set(key, res.Value, res.Bytes)
-		queryCacheEntrySizeHistogram.Record(ctx, res.Bytes, metric.WithAttributes(attribute.String("query", queryName(query))))
+		owner = res.Resolved
+		r.queryCache.cache.Set(resultcache.Entry{
+			InstanceID: instanceID,
+			Key:        key,
+			Tags:       depTags,
+			Value:      res.Value,
+			Size:       res.Size,
+		})
+		queryCacheEntrySizeHistogram.Record(ctx, res.Size, metric.WithAttributes(attribute.String("query", queryName(query))))
 		return res.Value, nil
 	})
 	if err != nil {
@@ -155,57 +189,47 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	return nil
 }
 
-type queryCacheKey struct {
-	instanceID    string
-	queryKey      string
-	dependencyKey string
-}
-
-func (k queryCacheKey) String() string {
-	return fmt.Sprintf("inst:%s deps:%s qry:%s", k.instanceID, k.dependencyKey, k.queryKey)
-}
-
-type queryCache struct {
-	cache        *ristretto.Cache
-	singleflight *singleflight.Group[string, any]
-	metrics      metric.Registration
-}
-
-func newQueryCache(sizeInBytes int64) *queryCache {
-	if sizeInBytes <= 100 {
-		panic(fmt.Sprintf("invalid cache size should be greater than 100: %v", sizeInBytes))
-	}
-	cache, err := ristretto.NewCache(&ristretto.Config{
-		// Use 5% of cache memory for storing counters. Each counter takes roughly 3 bytes.
-		// Recommended value is 10x the number of items in cache when full.
-		// Tune this again based on metrics.
-		NumCounters: int64(float64(sizeInBytes) * 0.05 / 3),
-		MaxCost:     int64(float64(sizeInBytes) * 0.95),
-		BufferItems: 64,
-		Metrics:     true,
+// resolveDeduplicated resolves a query that can't be cached.
+// Concurrent calls for the same key share one execution, and the result is fanned out to all callers.
+func (r *Runtime) resolveDeduplicated(ctx context.Context, key string, query Query, instanceID string, priority int) error {
+	owner := false
+	val, err := r.queryCache.singleflight.Do(ctx, "dedupe:"+key, func(ctx context.Context) (any, error) {
+		err := query.Resolve(ctx, r, instanceID, priority)
+		if err != nil {
+			return nil, err
+		}
+		owner = true
+		return query.MarshalResult().Value, nil
 	})
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	metrics := observability.Must(meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
-		observer.ObserveInt64(queryCacheHitsCounter, int64(cache.Metrics.Hits()))
-		observer.ObserveInt64(queryCacheMissesCounter, int64(cache.Metrics.Misses()))
-		observer.ObserveInt64(queryCacheItemCountGauge, int64(cache.Metrics.KeysAdded()-cache.Metrics.KeysEvicted()))
-		observer.ObserveInt64(queryCacheSizeBytesGauge, int64(cache.Metrics.CostAdded()-cache.Metrics.CostEvicted()))
+	if owner {
 		return nil
-	}, queryCacheHitsCounter, queryCacheMissesCounter, queryCacheItemCountGauge, queryCacheSizeBytesGauge))
+	}
+	observability.AddRequestAttributes(ctx, attribute.Bool("query.deduplicated", true))
+	queryDeduplicatedCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("query", queryName(query))))
+	return query.UnmarshalResult(val)
+}
 
-	return &queryCache{
-		cache:        cache,
-		singleflight: &singleflight.Group[string, any]{},
-		metrics:      metrics,
+// endSpan ends a span, recording err on it if it is non-nil.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 	}
+	span.End()
 }
 
-func (c *queryCache) close() error {
-	c.cache.Close()
-	return c.metrics.Unregister()
+type queryCacheKey struct {
+	instanceID    string
+	queryKey      string
+	dependencyKey string
+}
+
+func (k queryCacheKey) String() string {
+	return fmt.Sprintf("inst:%s deps:%s qry:%s", k.instanceID, k.dependencyKey, k.queryKey)
 }
 
 func queryName(q Query) string {
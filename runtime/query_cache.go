@@ -0,0 +1,228 @@
+package runtime
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/resultcache"
+	"github.com/rilldata/rill/runtime/pkg/sharedcache"
+	"github.com/rilldata/rill/runtime/pkg/singleflight"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var (
+	queryCacheHitsCounter      = observability.Must(meter.Int64ObservableCounter("query_cache.hits"))
+	queryCacheMissesCounter    = observability.Must(meter.Int64ObservableCounter("query_cache.misses"))
+	queryCacheEvictionsCounter = observability.Must(meter.Int64ObservableCounter("query_cache.evictions"))
+	queryCacheItemCountGauge   = observability.Must(meter.Int64ObservableGauge("query_cache.items"))
+	queryCacheSizeBytesGauge   = observability.Must(meter.Int64ObservableGauge("query_cache.size", metric.WithUnit("bytes")))
+	queryCacheDiskItemsGauge   = observability.Must(meter.Int64ObservableGauge("query_cache.disk_items"))
+	queryCacheDiskBytesGauge   = observability.Must(meter.Int64ObservableGauge("query_cache.disk_size", metric.WithUnit("bytes")))
+)
+
+// PurgeQueryCache removes cached query results for the instance.
+// If metricsView is not empty, only results of queries that target the metrics view are removed.
+// It returns the number of removed results.
+func (r *Runtime) PurgeQueryCache(instanceID, metricsView string) int {
+	if metricsView == "" {
+		return r.queryCache.cache.PurgeInstance(instanceID)
+	}
+	return r.queryCache.cache.PurgeTag(instanceID, queryCacheTag(&runtimev1.ResourceName{Kind: ResourceKindMetricsView, Name: metricsView}))
+}
+
+// QueryCacheStats returns usage stats for the instance's cached query results.
+// Hits, misses and evictions are counted since the runtime started or the instance's cache was last purged.
+func (r *Runtime) QueryCacheStats(instanceID string) resultcache.Stats {
+	return r.queryCache.cache.InstanceStats(instanceID)
+}
+
+type queryCache struct {
+	cache        *resultcache.Cache
+	shared       sharedcache.Cache
+	singleflight *singleflight.Group[string, any]
+	metrics      metric.Registration
+}
+
+// loadedResult is a query result returned by queryCache.loadShared.
+type loadedResult struct {
+	Value any
+	// Size is the estimated size of the value in bytes.
+	Size int64
+	// Cache is false if the result must not be cached.
+	Cache bool
+	// Resolved is true if the result was resolved by this replica, and false if it was loaded from the shared cache.
+	Resolved bool
+}
+
+// loadShared returns the result for key from the shared cache. If no replica has cached the result, it calls fn to resolve it.
+// The codec serializes results for the shared cache. Results that the codec can't serialize are not shared.
+// If the shared cache is not configured, it just calls fn.
+func (c *queryCache) loadShared(ctx context.Context, key string, codec resultcache.Codec, fn func(ctx context.Context) (*loadedResult, error)) (*loadedResult, error) {
+	if c.shared == nil {
+		res, err := fn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res.Resolved = true
+		return res, nil
+	}
+
+	var res *loadedResult
+	data, err := sharedcache.Load(ctx, c.shared, sharedQueryCacheKey(key), func(ctx context.Context) ([]byte, bool, error) {
+		var err error
+		res, err = fn(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		res.Resolved = true
+		if !res.Cache {
+			return nil, false, nil
+		}
+		data, err := codec.Encode(res.Value)
+		if err != nil {
+			// Not all results can be shared
+			return nil, false, nil
+		}
+		return data, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res != nil {
+		return res, nil
+	}
+
+	v, err := codec.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return &loadedResult{Value: v, Size: int64(len(data)), Cache: true}, nil
+}
+
+func newQueryCache(opts *Options) *queryCache {
+	if opts.QueryCacheSizeBytes <= 100 {
+		panic(fmt.Sprintf("invalid cache size should be greater than 100: %v", opts.QueryCacheSizeBytes))
+	}
+
+	cacheOpts := resultcache.Options{
+		MaxBytes:            opts.QueryCacheSizeBytes,
+		MaxBytesPerInstance: opts.QueryCacheInstanceSizeBytes,
+	}
+	if opts.DataDir != "" && opts.QueryCacheDiskSizeBytes > 0 {
+		cacheOpts.DiskDir = filepath.Join(opts.DataDir, ".query_cache")
+		cacheOpts.DiskThresholdBytes = opts.QueryCacheDiskThresholdBytes
+		cacheOpts.MaxDiskBytes = opts.QueryCacheDiskSizeBytes
+	}
+	cache, err := resultcache.New(cacheOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	metrics := observability.Must(meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		stats := cache.Stats()
+		observer.ObserveInt64(queryCacheHitsCounter, stats.Hits)
+		observer.ObserveInt64(queryCacheMissesCounter, stats.Misses)
+		observer.ObserveInt64(queryCacheEvictionsCounter, stats.Evictions)
+		observer.ObserveInt64(queryCacheItemCountGauge, stats.Entries)
+		observer.ObserveInt64(queryCacheSizeBytesGauge, stats.Bytes)
+		observer.ObserveInt64(queryCacheDiskItemsGauge, stats.DiskEntries)
+		observer.ObserveInt64(queryCacheDiskBytesGauge, stats.DiskBytes)
+		return nil
+	}, queryCacheHitsCounter, queryCacheMissesCounter, queryCacheEvictionsCounter, queryCacheItemCountGauge, queryCacheSizeBytesGauge, queryCacheDiskItemsGauge, queryCacheDiskBytesGauge))
+
+	return &queryCache{
+		cache:        cache,
+		shared:       opts.SharedQueryCache,
+		singleflight: &singleflight.Group[string, any]{},
+		metrics:      metrics,
+	}
+}
+
+func (c *queryCache) close() error {
+	err := c.cache.Close()
+	if c.shared != nil {
+		err = errors.Join(err, c.shared.Close())
+	}
+	return errors.Join(err, c.metrics.Unregister())
+}
+
+// sharedQueryCacheKey returns the key of a query result in the shared cache.
+// Local cache keys contain the instance ID, the query's Key() and the versions of the resources it depends on, so results are never shared across versions.
+// They are hashed since they can be large.
+func sharedQueryCacheKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return "query:" + hex.EncodeToString(sum[:])
+}
+
+// queryCacheTag returns the tag used to purge cached results that depend on a resource.
+func queryCacheTag(n *runtimev1.ResourceName) string {
+	return fmt.Sprintf("%s/%s", n.Kind, strings.ToLower(n.Name))
+}
+
+// queryResultCodec serializes results of legacy queries (see Query).
+// Only results that are protobuf messages can be serialized.
+type queryResultCodec struct{}
+
+func (queryResultCodec) Encode(v any) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot serialize query result of type %T", v)
+	}
+	a, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(a)
+}
+
+func (queryResultCodec) Decode(data []byte) (any, error) {
+	a := &anypb.Any{}
+	if err := proto.Unmarshal(data, a); err != nil {
+		return nil, err
+	}
+	return a.UnmarshalNew()
+}
+
+// resolveResultCodec serializes a ResolveResult, so large results can be cached on disk.
+// The encoding is the length-prefixed schema followed by the data.
+type resolveResultCodec struct{}
+
+func (resolveResultCodec) Encode(v any) ([]byte, error) {
+	res, ok := v.(ResolveResult)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value of type %T", v)
+	}
+	schema, err := proto.Marshal(res.Schema)
+	if err != nil {
+		return nil, err
+	}
+	buf := binary.AppendUvarint(nil, uint64(len(schema)))
+	buf = append(buf, schema...)
+	buf = append(buf, res.Data...)
+	return buf, nil
+}
+
+func (resolveResultCodec) Decode(data []byte) (any, error) {
+	n, k := binary.Uvarint(data)
+	if k <= 0 || uint64(len(data)-k) < n {
+		return nil, errors.New("invalid cached result")
+	}
+	schema := &runtimev1.StructType{}
+	if err := proto.Unmarshal(data[k:k+int(n)], schema); err != nil {
+		return nil, err
+	}
+	return ResolveResult{
+		Data:   data[k+int(n):],
+		Schema: schema,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package runtime
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestQueryCacheCodecs(t *testing.T) {
+	schema := &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{{Name: "a", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}}}}
+
+	data, err := resolveResultCodec{}.Encode(ResolveResult{Data: []byte(`[{"a":1}]`), Schema: schema})
+	require.NoError(t, err)
+	v, err := resolveResultCodec{}.Decode(data)
+	require.NoError(t, err)
+	res := v.(ResolveResult)
+	require.Equal(t, `[{"a":1}]`, string(res.Data))
+	require.True(t, proto.Equal(schema, res.Schema))
+
+	data, err = queryResultCodec{}.Encode(schema)
+	require.NoError(t, err)
+	v, err = queryResultCodec{}.Decode(data)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(schema, v.(*runtimev1.StructType)))
+
+	// Only protobuf messages can be shared
+	_, err = queryResultCodec{}.Encode([]string{"a"})
+	require.Error(t, err)
+}
+
+func TestQueryWarmerTop(t *testing.T) {
+	w := newQueryWarmer(2)
+	for i, key := range []string{"a", "b", "b", "c", "c", "c"} {
+		w.track("inst", "mv", &warmingTestQuery{key: key}, i)
+	}
+	w.track("inst", "other", &warmingTestQuery{key: "d"}, 0)
+
+	top := w.top(queryWarmerKey{instanceID: "inst", metricsView: "mv"})
+	require.Len(t, top, 2)
+	require.Equal(t, "c", top[0].query.Key())
+	require.Equal(t, 3, top[0].count)
+	require.Equal(t, "b", top[1].query.Key())
+
+	// The returned queries are copies, so resolving them doesn't affect the tracked queries
+	top[0].query.(*warmingTestQuery).result = "resolved"
+	require.Empty(t, w.top(queryWarmerKey{instanceID: "inst", metricsView: "mv"})[0].query.(*warmingTestQuery).result)
+
+	w.reset("inst")
+	require.Empty(t, w.top(queryWarmerKey{instanceID: "inst", metricsView: "mv"}))
+	require.Empty(t, w.top(queryWarmerKey{instanceID: "inst", metricsView: "other"}))
+}
+
+type warmingTestQuery struct {
+	key    string
+	result string
+}
+
+func (q *warmingTestQuery) Key() string                                          { return q.key }
+func (q *warmingTestQuery) Deps() []*runtimev1.ResourceName                      { return nil }
+func (q *warmingTestQuery) MarshalResult() *QueryResult                          { return &QueryResult{Value: q.result} }
+func (q *warmingTestQuery) UnmarshalResult(v any) error                          { q.result = v.(string); return nil }
+func (q *warmingTestQuery) Resolve(context.Context, *Runtime, string, int) error { return nil }
+func (q *warmingTestQuery) Export(context.Context, *Runtime, string, io.Writer, *ExportOptions) error {
+	return nil
+}
@@ -0,0 +1,79 @@
+package runtime_test
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryDeduplication(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+
+	var calls atomic.Int64
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			q := &slowQuery{calls: &calls}
+			err := rt.Query(context.Background(), id, q, 1)
+			require.NoError(t, err)
+			require.Equal(t, "result", q.result)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	// The query has no dependencies, so it is not cached, but the concurrent calls shared one execution
+	require.Equal(t, int64(1), calls.Load())
+
+	q := &slowQuery{calls: &calls}
+	require.NoError(t, rt.Query(context.Background(), id, q, 1))
+	require.Equal(t, int64(2), calls.Load())
+}
+
+// slowQuery is a query without dependencies that takes a while to resolve.
+type slowQuery struct {
+	calls  *atomic.Int64
+	result string
+}
+
+var _ runtime.Query = &slowQuery{}
+
+func (q *slowQuery) Key() string {
+	return "slow_query"
+}
+
+func (q *slowQuery) Deps() []*runtimev1.ResourceName {
+	return nil
+}
+
+func (q *slowQuery) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{Value: q.result, Bytes: int64(len(q.result))}
+}
+
+func (q *slowQuery) UnmarshalResult(v any) error {
+	q.result = v.(string)
+	return nil
+}
+
+func (q *slowQuery) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	q.calls.Add(1)
+	time.Sleep(200 * time.Millisecond)
+	q.result = "result"
+	return nil
+}
+
+func (q *slowQuery) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
+	return nil
+}
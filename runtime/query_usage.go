@@ -0,0 +1,127 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/querycost"
+	"go.uber.org/zap"
+)
+
+// queryUsageFlushInterval is the interval at which aggregated query usage is reported to the admin service.
+const queryUsageFlushInterval = time.Minute
+
+// queryUsageKey identifies a subject's usage on an instance.
+type queryUsageKey struct {
+	instanceID string
+	subject    string
+}
+
+// queryUsage aggregates query costs per instance and subject between flushes.
+type queryUsage struct {
+	mu      sync.Mutex
+	start   time.Time
+	entries map[queryUsageKey]querycost.Stats
+}
+
+func newQueryUsage() *queryUsage {
+	return &queryUsage{
+		start:   time.Now(),
+		entries: make(map[queryUsageKey]querycost.Stats),
+	}
+}
+
+// RecordQueryUsage adds the cost of queries executed on behalf of a subject to the instance's usage.
+// The usage is periodically reported to the admin service (if the instance has one).
+func (r *Runtime) RecordQueryUsage(instanceID, subject string, stats querycost.Stats) {
+	if stats.Queries == 0 {
+		return
+	}
+
+	u := r.queryUsage
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	k := queryUsageKey{instanceID: instanceID, subject: subject}
+	u.entries[k] = u.entries[k].Add(stats)
+}
+
+// runQueryUsageFlusher flushes query usage every queryUsageFlushInterval until ctx is cancelled.
+func (r *Runtime) runQueryUsageFlusher(ctx context.Context) {
+	ticker := time.NewTicker(queryUsageFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.flushQueryUsage(ctx)
+		case <-ctx.Done():
+			// Do a final flush on a fresh ctx to not lose the usage for the last interval
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			r.flushQueryUsage(flushCtx)
+			cancel()
+			return
+		}
+	}
+}
+
+// flushQueryUsage reports the aggregated query usage to the admin service of each instance.
+// If reporting for an instance fails, its usage is kept and retried on the next flush.
+func (r *Runtime) flushQueryUsage(ctx context.Context) {
+	u := r.queryUsage
+	u.mu.Lock()
+	entries := u.entries
+	start := u.start
+	end := time.Now()
+	u.entries = make(map[queryUsageKey]querycost.Stats)
+	u.start = end
+	u.mu.Unlock()
+
+	byInstance := make(map[string][]*drivers.QueryUsage)
+	for k, stats := range entries {
+		byInstance[k.instanceID] = append(byInstance[k.instanceID], &drivers.QueryUsage{
+			Subject:       k.subject,
+			StartTime:     start,
+			EndTime:       end,
+			Queries:       stats.Queries,
+			ExecutionTime: stats.ExecutionTime,
+			ScannedBytes:  stats.ScannedBytes,
+		})
+	}
+
+	for instanceID, usage := range byInstance {
+		err := r.reportQueryUsage(ctx, instanceID, usage)
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, ErrAdminNotConfigured) || errors.Is(err, drivers.ErrNotFound) {
+			// Usage is only tracked for instances managed by an admin service
+			continue
+		}
+
+		r.logger.Warn("failed to report query usage", zap.String("instance_id", instanceID), zap.Error(err))
+
+		// Merge back for the next flush
+		u.mu.Lock()
+		for _, qu := range usage {
+			k := queryUsageKey{instanceID: instanceID, subject: qu.Subject}
+			u.entries[k] = u.entries[k].Add(querycost.Stats{
+				Queries:       qu.Queries,
+				ExecutionTime: qu.ExecutionTime,
+				ScannedBytes:  qu.ScannedBytes,
+			})
+		}
+		u.start = start
+		u.mu.Unlock()
+	}
+}
+
+func (r *Runtime) reportQueryUsage(ctx context.Context, instanceID string, usage []*drivers.QueryUsage) error {
+	admin, release, err := r.Admin(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return admin.ReportQueryUsage(ctx, usage)
+}
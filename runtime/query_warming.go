@@ -0,0 +1,222 @@
+package runtime
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+const (
+	// queryWarmingMaxTracked bounds the number of distinct queries tracked per metrics view.
+	queryWarmingMaxTracked = 200
+	// queryWarmingWindow is the time window in which queries must have been seen to be replayed.
+	queryWarmingWindow = 24 * time.Hour
+	// queryWarmingTimeout is the max time spent replaying a single query.
+	queryWarmingTimeout = time.Minute
+	// queryWarmingQueueSize bounds the number of pending warming requests. Requests are dropped when it's full.
+	queryWarmingQueueSize = 100
+)
+
+var queryCacheWarmedCounter = observability.Must(meter.Int64Counter("query_cache.warmed"))
+
+// queryWarmer tracks the most frequent recent queries against each metrics view, and replays them to warm the query cache after the metrics view is refreshed.
+type queryWarmer struct {
+	limit    int
+	mu       sync.Mutex
+	tracked  map[queryWarmerKey]map[string]*trackedQuery // Keyed by query.Key()
+	pending  map[queryWarmerKey]bool
+	requests chan queryWarmerKey
+}
+
+type queryWarmerKey struct {
+	instanceID  string
+	metricsView string
+}
+
+type trackedQuery struct {
+	query    Query
+	priority int
+	count    int
+	lastSeen time.Time
+}
+
+func newQueryWarmer(limit int) *queryWarmer {
+	return &queryWarmer{
+		limit:    limit,
+		tracked:  make(map[queryWarmerKey]map[string]*trackedQuery),
+		pending:  make(map[queryWarmerKey]bool),
+		requests: make(chan queryWarmerKey, queryWarmingQueueSize),
+	}
+}
+
+// enabled returns true if cache warming is enabled (see Options.QueryCacheWarmingLimit).
+func (w *queryWarmer) enabled() bool {
+	return w.limit > 0
+}
+
+// track records a query against a metrics view. It must be called before the query is resolved.
+func (w *queryWarmer) track(instanceID, metricsView string, query Query, priority int) {
+	if !w.enabled() {
+		return
+	}
+
+	k := queryWarmerKey{instanceID: instanceID, metricsView: metricsView}
+	qk := query.Key()
+	now := time.Now()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	queries, ok := w.tracked[k]
+	if !ok {
+		queries = make(map[string]*trackedQuery)
+		w.tracked[k] = queries
+	}
+
+	if t, ok := queries[qk]; ok {
+		t.count++
+		t.lastSeen = now
+		return
+	}
+
+	// Queries are replayed after the request that issued them has finished, so we need our own copy
+	clone, ok := cloneQuery(query)
+	if !ok {
+		return
+	}
+
+	if len(queries) >= queryWarmingMaxTracked {
+		evictLeastFrequentQuery(queries)
+	}
+	queries[qk] = &trackedQuery{query: clone, priority: priority, count: 1, lastSeen: now}
+}
+
+// top returns copies of the most frequent queries against the metrics view that were seen recently.
+func (w *queryWarmer) top(k queryWarmerKey) []*trackedQuery {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	queries := w.tracked[k]
+	res := make([]*trackedQuery, 0, len(queries))
+	for qk, t := range queries {
+		if time.Since(t.lastSeen) > queryWarmingWindow {
+			delete(queries, qk)
+			continue
+		}
+		clone, ok := cloneQuery(t.query)
+		if !ok {
+			continue
+		}
+		res = append(res, &trackedQuery{query: clone, priority: t.priority, count: t.count, lastSeen: t.lastSeen})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].count != res[j].count {
+			return res[i].count > res[j].count
+		}
+		return res[i].lastSeen.After(res[j].lastSeen)
+	})
+	if len(res) > w.limit {
+		res = res[:w.limit]
+	}
+	return res
+}
+
+// reset forgets the queries tracked for an instance.
+func (w *queryWarmer) reset(instanceID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for k := range w.tracked {
+		if k.instanceID == instanceID {
+			delete(w.tracked, k)
+		}
+	}
+}
+
+// WarmQueryCache schedules a replay of the most frequent recent queries against a metrics view.
+// It should be called after the metrics view or its underlying data has been refreshed, so the first dashboard load after a refresh hits the cache.
+// It returns immediately; the queries are replayed in the background.
+// It is a no-op if cache warming is disabled (see Options.QueryCacheWarmingLimit).
+func (r *Runtime) WarmQueryCache(instanceID, metricsView string) {
+	w := r.queryWarmer
+	if !w.enabled() {
+		return
+	}
+
+	k := queryWarmerKey{instanceID: instanceID, metricsView: metricsView}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.tracked[k]; !ok || w.pending[k] {
+		return
+	}
+	select {
+	case w.requests <- k:
+		w.pending[k] = true
+	default:
+		// Warming is best-effort, so drop the request if the queue is full
+	}
+}
+
+// runQueryCacheWarmer processes requests scheduled with WarmQueryCache until ctx is cancelled.
+func (r *Runtime) runQueryCacheWarmer(ctx context.Context) {
+	w := r.queryWarmer
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case k := <-w.requests:
+			w.mu.Lock()
+			delete(w.pending, k)
+			w.mu.Unlock()
+			r.warmQueryCache(ctx, k)
+		}
+	}
+}
+
+func (r *Runtime) warmQueryCache(ctx context.Context, k queryWarmerKey) {
+	queries := r.queryWarmer.top(k)
+	for _, t := range queries {
+		if ctx.Err() != nil {
+			return
+		}
+
+		qctx, cancel := context.WithTimeout(ctx, queryWarmingTimeout)
+		err := r.Query(qctx, k.instanceID, t.query, t.priority)
+		cancel()
+		if err != nil {
+			r.logger.Debug("query cache warming failed", zap.String("instance_id", k.instanceID), zap.String("metrics_view", k.metricsView), zap.String("query", queryName(t.query)), zap.Error(err))
+			continue
+		}
+		queryCacheWarmedCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("query", queryName(t.query))))
+	}
+}
+
+// cloneQuery returns a shallow copy of a query that is a pointer to a struct.
+func cloneQuery(q Query) (Query, bool) {
+	v := reflect.ValueOf(q)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	c := reflect.New(v.Elem().Type())
+	c.Elem().Set(v.Elem())
+	res, ok := c.Interface().(Query)
+	return res, ok
+}
+
+func evictLeastFrequentQuery(queries map[string]*trackedQuery) {
+	var evictKey string
+	var evict *trackedQuery
+	for qk, t := range queries {
+		if evict == nil || t.count < evict.count || (t.count == evict.count && t.lastSeen.Before(evict.lastSeen)) {
+			evictKey, evict = qk, t
+		}
+	}
+	delete(queries, evictKey)
+}
@@ -7,12 +7,14 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"net/url"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/metricsview"
 	"github.com/rilldata/rill/runtime/pkg/duration"
 	"github.com/rilldata/rill/runtime/pkg/formatter"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
@@ -20,6 +22,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -261,6 +264,17 @@ func (r *AlertReconciler) executionSpecHash(spec *runtimev1.AlertSpec, refs []*r
 		return "", err
 	}
 
+	if spec.Anomaly != nil {
+		data, err := proto.Marshal(spec.Anomaly)
+		if err != nil {
+			return "", err
+		}
+		_, err = hash.Write(data)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	_, err = hash.Write([]byte(spec.GetQueryForUserId()))
 	if err != nil {
 		return "", err
@@ -542,6 +556,10 @@ func (r *AlertReconciler) executeSingleWrapped(ctx context.Context, self *runtim
 	// Log
 	r.C.Logger.Info("Checking alert", zap.String("name", self.Meta.Name.Name), zap.Time("execution_time", executionTime))
 
+	if a.Spec.Anomaly != nil {
+		return r.checkAnomaly(ctx, self, a, adminMeta, executionTime)
+	}
+
 	// Build query proto
 	qpb, err := queries.ProtoFromJSON(a.Spec.QueryName, a.Spec.QueryArgsJson, &executionTime)
 	if err != nil {
@@ -596,6 +614,83 @@ func (r *AlertReconciler) executeSingleWrapped(ctx context.Context, self *runtim
 	return &runtimev1.AssertionResult{Status: runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, FailRow: failRow}, nil
 }
 
+// checkAnomaly scores the latest complete time period of the alert's anomaly measure and fails if the score exceeds the threshold.
+func (r *AlertReconciler) checkAnomaly(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert, adminMeta *drivers.AlertMetadata, executionTime time.Time) (*runtimev1.AssertionResult, error) {
+	spec := a.Spec.Anomaly
+
+	mv, err := r.C.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: spec.MetricsView}, false)
+	if err != nil {
+		return nil, err
+	}
+	mvSpec := mv.GetMetricsView().State.ValidSpec
+	if mvSpec == nil {
+		return nil, fmt.Errorf("metrics view %q is invalid", spec.MetricsView)
+	}
+
+	// Evaluate query attributes
+	var queryForAttrs map[string]any
+	if adminMeta != nil {
+		queryForAttrs = adminMeta.QueryForAttributes
+	}
+	if a.Spec.GetQueryForAttributes() != nil { // Explicit attributes take precedence
+		queryForAttrs = a.Spec.GetQueryForAttributes().AsMap()
+	}
+	sec, err := r.C.Runtime.ResolveSecurity(r.C.InstanceID, &runtime.SecurityClaims{UserAttributes: queryForAttrs}, mv)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := metricsview.NewExecutor(ctx, r.C.Runtime, r.C.InstanceID, mvSpec, sec, alertQueryPriority)
+	if err != nil {
+		return nil, err
+	}
+	defer e.Close()
+
+	qry := &metricsview.AnomalyQuery{
+		MetricsView: spec.MetricsView,
+		Measure:     spec.Measure,
+		TimeGrain:   metricsview.TimeGrainFromProto(spec.TimeGrain),
+		Window:      int(spec.Window),
+		Where:       metricsview.NewExpressionFromProto(spec.Where),
+		TimeZone:    spec.TimeZone,
+	}
+	switch spec.Method {
+	case runtimev1.AlertAnomaly_METHOD_Z_SCORE:
+		qry.Method = metricsview.AnomalyMethodZScore
+	case runtimev1.AlertAnomaly_METHOD_PERCENT_DEVIATION:
+		qry.Method = metricsview.AnomalyMethodPercentDeviation
+	}
+
+	res, ok, err := e.Anomaly(ctx, qry, &executionTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for anomalies: %w", err)
+	}
+	if !ok || res.Score == nil || math.Abs(*res.Score) < spec.Threshold {
+		r.C.Logger.Info("Alert passed", zap.String("name", self.Meta.Name.Name), zap.Time("execution_time", executionTime))
+		return &runtimev1.AssertionResult{Status: runtimev1.AssertionStatus_ASSERTION_STATUS_PASS}, nil
+	}
+
+	r.C.Logger.Info("Alert failed", zap.String("name", self.Meta.Name.Name), zap.Time("execution_time", executionTime))
+
+	// Return the scored period as the fail row
+	measureLabel, f := getMeasureLabelAndFormatter(spec.Measure, mvSpec.Measures, r.C.Logger)
+	scoreLabel := "Z-score"
+	if spec.Method == runtimev1.AlertAnomaly_METHOD_PERCENT_DEVIATION {
+		scoreLabel = "Deviation (%)"
+	}
+	failRow, err := structpb.NewStruct(map[string]any{
+		"Period":                      res.Time.Format(time.RFC3339),
+		measureLabel:                  formatValue(f, res.Value, r.C.Logger),
+		measureLabel + " (mean)":      formatValue(f, res.Mean, r.C.Logger),
+		measureLabel + " (std. dev.)": formatValue(f, res.StdDev, r.C.Logger),
+		scoreLabel:                    math.Round(*res.Score*100) / 100,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert fail row to proto: %w", err)
+	}
+	return &runtimev1.AssertionResult{Status: runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, FailRow: failRow}, nil
+}
+
 // popCurrentExecution moves the current execution into the execution history and sends notifications if the execution matched the notification criteria.
 // At a certain limit, it trims old executions from the history to prevent it from growing unboundedly.
 func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert, adminMeta *drivers.AlertMetadata) error {
@@ -723,53 +818,49 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 		}
 
 		for _, notifier := range a.Spec.Notifiers {
-			switch notifier.Connector {
-			// TODO: transform email client to notifier
-			case "email":
-				recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-				for _, recipient := range recipients {
-					msg.ToEmail = recipient
-					err := r.C.Runtime.Email.SendAlertStatus(msg)
-					if err != nil {
-						notificationErr = fmt.Errorf("failed to send email to %q: %w", recipient, err)
-						break
-					}
+			err := func() (outErr error) {
+				n, release, err := acquireNotifier(ctx, r.C, notifier)
+				if err != nil {
+					return err
 				}
-			default:
-				err := func() (outErr error) {
-					conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
-					if err != nil {
-						return err
-					}
-					defer release()
-					n, err := conn.AsNotifier(notifier.Properties.AsMap())
-					if err != nil {
-						return err
+				defer release()
+				start := time.Now()
+				defer func() {
+					totalLatency := time.Since(start).Milliseconds()
+
+					if r.C.Activity != nil {
+						r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
+							attribute.Bool("failed", outErr != nil),
+							attribute.String("connector", notifier.Connector),
+							attribute.String("notification_type", "alert_status"),
+						)
 					}
-					start := time.Now()
-					defer func() {
-						totalLatency := time.Since(start).Milliseconds()
-
-						if r.C.Activity != nil {
-							r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
-								attribute.Bool("failed", outErr != nil),
-								attribute.String("connector", notifier.Connector),
-								attribute.String("notification_type", "alert_status"),
-							)
-						}
-					}()
-					err = n.SendAlertStatus(msg)
-					if err != nil {
-						notificationErr = fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
-					}
-					return nil
 				}()
+				err = n.SendAlertStatus(msg)
 				if err != nil {
-					return err
+					notificationErr = fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
 				}
+				return nil
+			}()
+			if err != nil {
+				return err
 			}
 		}
 		sentNotifications = true
+
+		if !msg.IsRecover {
+			body := "The alert's condition was met."
+			if msg.Status == runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR {
+				body = fmt.Sprintf("The alert failed to run: %s", msg.ExecutionError)
+			}
+			sendResourceNotification(ctx, r.C, a.Spec.Notifiers, &drivers.ResourceNotification{
+				Type:         drivers.ResourceNotificationTypeAlertFired,
+				ResourceName: self.Meta.Name.Name,
+				Annotations:  a.Spec.Annotations,
+				Title:        a.Spec.Title,
+				Body:         body,
+			})
+		}
 	}
 
 	// If sending notifications failed, add the error as an execution error.
@@ -1,6 +1,7 @@
 package reconcilers_test
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"testing"
@@ -325,6 +326,71 @@ SELECT '2024-01-04T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
 	require.Contains(t, emails[0].Body, "Denmark")
 }
 
+func TestAnomalyAlert(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.sql": `
+SELECT TIMESTAMP '2024-01-01' + to_days(d::INTEGER) AS __time, 'Denmark' AS country FROM range(7) t(d), range(10) u(i)
+UNION ALL
+SELECT TIMESTAMP '2024-01-06' AS __time, 'Denmark' AS country FROM range(2)
+UNION ALL
+SELECT TIMESTAMP '2024-01-08' AS __time, 'Denmark' AS country FROM range(50)
+UNION ALL
+SELECT TIMESTAMP '2024-01-09' AS __time, 'Denmark' AS country
+`,
+		"/dashboards/mv1.yaml": `
+title: mv1
+model: bar
+timeseries: __time
+dimensions:
+- column: country
+measures:
+- name: events
+  expression: count(*)
+`,
+		"/alerts/a1.yaml": `
+type: alert
+title: Anomaly Alert
+watermark: inherit
+anomaly:
+  metrics_view: mv1
+  measure: events
+  time_grain: day
+  window: 7
+  where: country = 'Denmark'
+notify:
+  email:
+    recipients:
+      - somebody@example.com
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	r, err := ctrl.Get(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: "a1"}, false)
+	require.NoError(t, err)
+	a := r.GetAlert()
+	require.Equal(t, []*runtimev1.ResourceName{{Kind: runtime.ResourceKindMetricsView, Name: "mv1"}}, r.Meta.Refs)
+	require.Equal(t, runtimev1.AlertAnomaly_METHOD_Z_SCORE, a.Spec.Anomaly.Method)
+	require.Equal(t, float64(3), a.Spec.Anomaly.Threshold)
+
+	// The latest complete day (2024-01-08) has 50 events, far above the trailing mean of ~10
+	require.Len(t, a.State.ExecutionHistory, 1)
+	res := a.State.ExecutionHistory[0].Result
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, res.Status)
+	row := res.FailRow.AsMap()
+	require.Equal(t, "2024-01-08T00:00:00Z", row["Period"])
+	require.Equal(t, "50", row["events"])
+	require.Equal(t, 56.75, row["Z-score"])
+
+	// Check that the alert was sent
+	emails := rt.Email.Sender.(*email.TestSender).Emails
+	require.Len(t, emails, 1)
+	require.Contains(t, emails[0].Body, "Z-score")
+}
+
 func newMetricsView(name, table, timeDim string, measures, dimensions []string) (*runtimev1.MetricsViewV2, *runtimev1.Resource) {
 	metrics := &runtimev1.MetricsViewV2{
 		Spec: &runtimev1.MetricsViewSpec{
@@ -0,0 +1,107 @@
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// dataTestTimeout is the maximum execution time for a single data test.
+const dataTestTimeout = 5 * time.Minute
+
+// runDataTests runs data tests against a table and returns a result for each test.
+// Failures of individual tests are reported in the results. Use dataTestsError to get an error describing them.
+func runDataTests(ctx context.Context, c *runtime.Controller, connector, db, schema, table string, tests []*runtimev1.DataTest) ([]*runtimev1.DataTestResult, error) {
+	olap, release, err := c.AcquireOLAP(ctx, connector)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	d := olap.Dialect()
+	tbl := d.EscapeTable(db, schema, table)
+
+	results := make([]*runtimev1.DataTestResult, 0, len(tests))
+	for _, t := range tests {
+		res := &runtimev1.DataTestResult{Name: t.Name}
+		n, err := runDataTest(ctx, olap, dataTestSQL(d, tbl, t))
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			res.Error = err.Error()
+		} else {
+			res.FailedRows = n
+			res.Passed = n == 0
+		}
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+// runDataTest counts the rows returned by a data test query.
+func runDataTest(ctx context.Context, olap drivers.OLAPStore, sql string) (int64, error) {
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            fmt.Sprintf("SELECT COUNT(*) FROM (%s) t", sql),
+		Priority:         100,
+		ExecutionTimeout: dataTestTimeout,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var n int64
+	for rows.Next() {
+		if err := rows.Scan(&n); err != nil {
+			return 0, err
+		}
+	}
+	return n, rows.Err()
+}
+
+// dataTestSQL returns a query that selects the rows in tbl that violate the test.
+// The tbl must already be escaped.
+func dataTestSQL(d drivers.Dialect, tbl string, t *runtimev1.DataTest) string {
+	switch {
+	case t.NotNull != "":
+		return fmt.Sprintf("SELECT * FROM %s WHERE %s IS NULL", tbl, d.EscapeIdentifier(t.NotNull))
+	case t.Unique != "":
+		col := d.EscapeIdentifier(t.Unique)
+		return fmt.Sprintf("SELECT %s FROM %s WHERE %s IS NOT NULL GROUP BY %s HAVING COUNT(*) > 1", col, tbl, col, col)
+	case t.AcceptedValues != nil:
+		vals := make([]string, len(t.AcceptedValues.Values))
+		for i, v := range t.AcceptedValues.Values {
+			vals[i] = d.EscapeStringValue(v)
+		}
+		col := d.EscapeIdentifier(t.AcceptedValues.Column)
+		return fmt.Sprintf("SELECT * FROM %s WHERE %s IS NOT NULL AND %s NOT IN (%s)", tbl, col, col, strings.Join(vals, ", "))
+	default:
+		return t.Sql
+	}
+}
+
+// dataTestsError returns an error listing the failed tests, or nil if all tests passed.
+func dataTestsError(results []*runtimev1.DataTestResult) error {
+	var failed []string
+	for _, r := range results {
+		if r.Passed {
+			continue
+		}
+		if r.Error != "" {
+			failed = append(failed, fmt.Sprintf("%s (error: %s)", r.Name, r.Error))
+		} else {
+			failed = append(failed, fmt.Sprintf("%s (%d failing rows)", r.Name, r.FailedRows))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d tests failed: %s", len(failed), len(results), strings.Join(failed, ", "))
+}
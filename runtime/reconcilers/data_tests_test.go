@@ -0,0 +1,57 @@
+package reconcilers_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelDataTests(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/m1.yaml": `
+type: model
+sql: SELECT * FROM (VALUES (1, 'US'), (2, 'DK'), (2, NULL)) t(id, country)
+tests:
+  - not_null: id
+  - unique: id
+  - accepted_values:
+      column: country
+      values: [US, DK]
+`,
+		"/models/m2.yaml": `
+type: model
+sql: SELECT 1 AS id
+tests_blocking: true
+tests:
+  - name: no_ones
+    sql: SELECT * FROM m2 WHERE id = 1
+`,
+		"/models/m3.sql": `SELECT * FROM m2`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+
+	// m2 fails because of its blocking test, which in turn fails m3
+	testruntime.RequireReconcileState(t, rt, id, 4, 2, 0)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+
+	m1, err := ctrl.Get(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "m1"}, false)
+	require.NoError(t, err)
+	require.Empty(t, m1.Meta.ReconcileError)
+	require.Equal(t, []*runtimev1.DataTestResult{
+		{Name: "not_null_id", Passed: true},
+		{Name: "unique_id", FailedRows: 1},
+		{Name: "accepted_values_country", Passed: true},
+	}, m1.GetModel().State.TestResults)
+
+	m2, err := ctrl.Get(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "m2"}, false)
+	require.NoError(t, err)
+	require.Contains(t, m2.Meta.ReconcileError, "1 of 1 tests failed: no_ones (1 failing rows)")
+	require.Equal(t, "m2", m2.GetModel().State.ResultTable)
+}
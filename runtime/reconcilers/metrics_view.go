@@ -4,11 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// metricsViewVersionsRetained is the number of data versions retained per metrics view in the catalog.
+const metricsViewVersionsRetained = 100
+
 func init() {
 	runtime.RegisterReconcilerInitializer(runtime.ResourceKindMetricsView, newMetricsViewReconciler)
 }
@@ -62,6 +70,10 @@ func (r *MetricsViewReconciler) Reconcile(ctx context.Context, n *runtimev1.Reso
 
 	// Exit early for deletion
 	if self.Meta.DeletedOn != nil {
+		err := r.deleteVersions(ctx, self.Meta.Name.Name)
+		if err != nil {
+			r.C.Logger.Warn("failed to delete metrics view versions", zap.String("name", n.Name), zap.Error(err))
+		}
 		return runtime.ReconcileResult{}
 	}
 
@@ -100,11 +112,149 @@ func (r *MetricsViewReconciler) Reconcile(ctx context.Context, n *runtimev1.Reso
 		mv.State.Streaming = !hasInternalRef
 	}
 
+	// Run the metrics view's tests against its table.
+	// Failing tests are only reported in the state, unless the metrics view is configured to block on them.
+	var testErr error
+	mv.State.TestResults = nil
+	if validateErr == nil && len(mv.Spec.Tests) > 0 {
+		results, err := runDataTests(ctx, r.C, mv.Spec.Connector, mv.Spec.Database, mv.Spec.DatabaseSchema, mv.Spec.Table, mv.Spec.Tests)
+		if err != nil {
+			testErr = fmt.Errorf("failed to run tests: %w", err)
+		} else {
+			mv.State.TestResults = results
+			testErr = dataTestsError(results)
+		}
+		if testErr != nil {
+			r.C.Logger.Warn("metrics view tests failed", zap.String("name", n.Name), zap.Error(testErr))
+			if !mv.Spec.TestsBlocking {
+				testErr = nil
+			}
+		}
+	}
+
 	// Update state. Even if the validation result is unchanged, we always update the state to ensure the state version is incremented.
 	err = r.C.UpdateState(ctx, self.Meta.Name, self)
 	if err != nil {
 		return runtime.ReconcileResult{Err: err}
 	}
 
-	return runtime.ReconcileResult{Err: validateErr}
+	// Record a new data version if the underlying source or model has been refreshed.
+	// Failing to record a version does not affect the metrics view, so we only log the error.
+	if validateErr == nil && !mv.State.Streaming {
+		err := r.recordVersion(ctx, self)
+		if err != nil && ctx.Err() == nil {
+			r.C.Logger.Warn("failed to record metrics view version", zap.String("name", n.Name), zap.Error(err))
+		}
+	}
+
+	// The state update invalidates cached query results, so replay the most frequent recent queries in the background.
+	// This avoids a slow first dashboard load after the underlying data is refreshed.
+	if validateErr == nil {
+		r.C.Runtime.WarmQueryCache(r.C.InstanceID, self.Meta.Name.Name)
+	}
+
+	return runtime.ReconcileResult{Err: errors.Join(validateErr, testErr)}
+}
+
+// recordVersion inserts a new version in the catalog for the metrics view's table if its source or model refs have been refreshed since the last recorded version.
+func (r *MetricsViewReconciler) recordVersion(ctx context.Context, self *runtimev1.Resource) error {
+	var refreshedOn time.Time
+	for _, ref := range self.Meta.Refs {
+		if ref.Kind != runtime.ResourceKindSource && ref.Kind != runtime.ResourceKindModel {
+			continue
+		}
+		res, err := r.C.Get(ctx, ref, false)
+		if err != nil {
+			if errors.Is(err, drivers.ErrResourceNotFound) {
+				continue
+			}
+			return err
+		}
+
+		var ts *timestamppb.Timestamp
+		switch ref.Kind {
+		case runtime.ResourceKindSource:
+			ts = res.GetSource().GetState().GetRefreshedOn()
+		case runtime.ResourceKindModel:
+			ts = res.GetModel().GetState().GetRefreshedOn()
+		}
+		if ts != nil && ts.AsTime().After(refreshedOn) {
+			refreshedOn = ts.AsTime()
+		}
+	}
+	if refreshedOn.IsZero() {
+		return nil
+	}
+
+	catalog, release, err := r.C.Runtime.Catalog(ctx, r.C.InstanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	name := self.Meta.Name.Name
+	prev, err := catalog.FindMetricsViewVersions(ctx, name, 1)
+	if err != nil {
+		return err
+	}
+	if len(prev) > 0 && !refreshedOn.After(prev[0].RefreshedOn) {
+		return nil
+	}
+
+	spec := self.GetMetricsView().State.ValidSpec
+	olap, releaseOLAP, err := r.C.AcquireOLAP(ctx, spec.Connector)
+	if err != nil {
+		return err
+	}
+	defer releaseOLAP()
+
+	tbl, err := olap.InformationSchema().Lookup(ctx, spec.Database, spec.DatabaseSchema, spec.Table)
+	if err != nil {
+		return err
+	}
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            fmt.Sprintf("SELECT count(*) FROM %s", olap.Dialect().EscapeTable(spec.Database, spec.DatabaseSchema, spec.Table)),
+		Priority:         -1,
+		ExecutionTimeout: time.Minute,
+	})
+	if err != nil {
+		return err
+	}
+	var rowCount int64
+	if rows.Next() {
+		err = rows.Scan(&rowCount)
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+	}
+	err = rows.Err()
+	_ = rows.Close()
+	if err != nil {
+		return err
+	}
+
+	schema, err := proto.Marshal(tbl.Schema)
+	if err != nil {
+		return err
+	}
+
+	return catalog.InsertMetricsViewVersion(ctx, &drivers.MetricsViewVersion{
+		MetricsView: name,
+		RefreshedOn: refreshedOn,
+		RowCount:    rowCount,
+		Schema:      schema,
+	}, metricsViewVersionsRetained)
+}
+
+// deleteVersions deletes the recorded data versions of a metrics view.
+func (r *MetricsViewReconciler) deleteVersions(ctx context.Context, name string) error {
+	catalog, release, err := r.C.Runtime.Catalog(ctx, r.C.InstanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return catalog.DeleteMetricsViewVersions(ctx, name)
 }
@@ -108,6 +108,12 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	// Handle deletion
 	if self.Meta.DeletedOn != nil {
 		if prevManager != nil {
+			if model.Spec.ChangeFeed || model.State.ChangeFeedVersion != 0 {
+				err = r.dropChangeFeed(ctx, prevResult.Connector, self.Meta.Name.Name)
+				if err != nil {
+					r.C.Logger.Warn("failed to drop model change feed", zap.String("model", n.Name), zap.Error(err))
+				}
+			}
 			err = prevManager.Delete(ctx, prevResult)
 			return runtime.ReconcileResult{Err: err}
 		}
@@ -125,6 +131,13 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 				r.C.Logger.Warn("failed to rename model", zap.String("model", n.Name), zap.String("renamed_from", self.Meta.RenamedFrom.Name), zap.Error(err))
 			}
 
+			if model.Spec.ChangeFeed || model.State.ChangeFeedVersion != 0 {
+				err = r.renameChangeFeed(ctx, prevResult.Connector, self.Meta.RenamedFrom.Name, self.Meta.Name.Name)
+				if err != nil {
+					r.C.Logger.Warn("failed to rename model change feed", zap.String("model", n.Name), zap.String("renamed_from", self.Meta.RenamedFrom.Name), zap.Error(err))
+				}
+			}
+
 			// Note: Not exiting early. We may need to retrigger the model in some cases. We also need to set the correct retrigger time.
 		}
 	}
@@ -172,6 +185,10 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
 		}
+		refreshOn, err = deferToRefreshWindow(ctx, r.C, refreshOn)
+		if err != nil {
+			return runtime.ReconcileResult{Err: err}
+		}
 	}
 
 	// Check if the output still exists (might have been corrupted/lost somehow)
@@ -289,6 +306,28 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		newIncrementalState, newIncrementalStateSchema, execErr = r.resolveIncrementalState(ctx, model)
 	}
 
+	// Run the model's tests against the new output.
+	// Failing tests are only reported in the state, unless the model is configured to block on them.
+	var testErr error
+	if execErr == nil {
+		model.State.TestResults = nil
+		if len(model.Spec.Tests) > 0 {
+			results, err := runDataTests(ctx, r.C, execRes.Connector, "", "", execRes.Table, model.Spec.Tests)
+			if err != nil {
+				testErr = fmt.Errorf("failed to run tests: %w", err)
+			} else {
+				model.State.TestResults = results
+				testErr = dataTestsError(results)
+			}
+			if testErr != nil {
+				r.C.Logger.Warn("model tests failed", zap.String("model", n.Name), zap.Error(testErr))
+				if !model.Spec.TestsBlocking {
+					testErr = nil
+				}
+			}
+		}
+	}
+
 	// If the build succeeded, update the model's state accodingly
 	if execErr == nil {
 		model.State.ExecutorConnector = executorConnector
@@ -297,6 +336,24 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		model.State.RefreshedOn = timestamppb.Now()
 		model.State.IncrementalState = newIncrementalState
 		model.State.IncrementalStateSchema = newIncrementalStateSchema
+
+		// Record the changes in the change feed (if enabled).
+		// Failures are not fatal since the next successful update will capture all changes since the last recorded version.
+		if model.Spec.ChangeFeed {
+			v, err := r.updateChangeFeed(ctx, self, execRes)
+			if err != nil {
+				r.C.Logger.Warn("failed to update model change feed", zap.String("model", n.Name), zap.Error(err))
+			} else {
+				model.State.ChangeFeedVersion = v
+			}
+		} else if model.State.ChangeFeedVersion != 0 {
+			err := r.dropChangeFeed(ctx, execRes.Connector, self.Meta.Name.Name)
+			if err != nil {
+				r.C.Logger.Warn("failed to drop model change feed", zap.String("model", n.Name), zap.Error(err))
+			}
+			model.State.ChangeFeedVersion = 0
+		}
+
 		err := r.updateStateWithResult(ctx, self, execRes)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
@@ -331,9 +388,13 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	if err != nil {
 		return runtime.ReconcileResult{Err: errors.Join(err, execErr)}
 	}
+	refreshOn, err = deferToRefreshWindow(ctx, r.C, refreshOn)
+	if err != nil {
+		return runtime.ReconcileResult{Err: errors.Join(err, execErr)}
+	}
 
-	// Note: If the build failed, this is where we return the error.
-	return runtime.ReconcileResult{Err: execErr, Retrigger: refreshOn}
+	// Note: If the build failed (or a blocking test failed), this is where we return the error.
+	return runtime.ReconcileResult{Err: errors.Join(execErr, testErr), Retrigger: refreshOn}
 }
 
 // executionSpecHash computes a hash of those model properties that impact execution.
@@ -525,6 +586,8 @@ func (r *ModelReconciler) updateStateClear(ctx context.Context, self *runtimev1.
 	mdl.State.RefreshedOn = nil
 	mdl.State.IncrementalState = nil
 	mdl.State.IncrementalStateSchema = nil
+	mdl.State.TestResults = nil
+	mdl.State.ChangeFeedVersion = 0
 
 	return r.C.UpdateState(ctx, self.Meta.Name, self)
 }
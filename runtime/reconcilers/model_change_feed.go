@@ -0,0 +1,119 @@
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/queries"
+)
+
+// updateChangeFeed records the rows added and removed by the latest execution of a model in its change feed table.
+// The change feed tracks the hash of every distinct row in the model along with the version in which it was added and removed (if it has been removed).
+// It returns the new change feed version.
+func (r *ModelReconciler) updateChangeFeed(ctx context.Context, self *runtimev1.Resource, res *drivers.ModelResult) (int64, error) {
+	olap, release, err := r.C.AcquireOLAP(ctx, res.Connector)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	if olap.Dialect() != drivers.DialectDuckDB {
+		return 0, fmt.Errorf("change feed is not supported for dialect %q", olap.Dialect())
+	}
+
+	d := olap.Dialect()
+	tbl := d.EscapeIdentifier(res.Table)
+	changes := d.EscapeIdentifier(queries.ModelChangeFeedTable(self.Meta.Name.Name))
+
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (row_hash UBIGINT NOT NULL, added_version BIGINT NOT NULL, removed_version BIGINT)", changes),
+		Priority: 100,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// The next version is derived from the change feed itself (instead of the model's state), so versions stay monotonic even if the state is reset.
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT COALESCE(MAX(GREATEST(added_version, COALESCE(removed_version, 0))), 0) + 1 FROM %s", changes),
+		Priority: 100,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var version int64
+	for rows.Next() {
+		if err := rows.Scan(&version); err != nil {
+			_ = rows.Close()
+			return 0, err
+		}
+	}
+	err = rows.Err()
+	_ = rows.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	stmts := []string{
+		// Mark rows that are no longer present as removed
+		fmt.Sprintf("UPDATE %s SET removed_version = %d WHERE removed_version IS NULL AND row_hash NOT IN (SELECT hash(t) FROM %s t)", changes, version, tbl),
+		// Track rows that are new
+		fmt.Sprintf("INSERT INTO %s SELECT DISTINCT hash(t), %d, NULL FROM %s t WHERE hash(t) NOT IN (SELECT row_hash FROM %s WHERE removed_version IS NULL)", changes, version, tbl, changes),
+		// Prune removed rows that are too old to be of interest to consumers
+		fmt.Sprintf("DELETE FROM %s WHERE removed_version IS NOT NULL AND removed_version <= %d", changes, version-queries.ModelChangeFeedRetention),
+	}
+	for _, stmt := range stmts {
+		err = olap.Exec(ctx, &drivers.Statement{Query: stmt, Priority: 100})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return version, nil
+}
+
+// dropChangeFeed drops a model's change feed table (if it exists).
+func (r *ModelReconciler) dropChangeFeed(ctx context.Context, connector, model string) error {
+	olap, release, err := r.C.AcquireOLAP(ctx, connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if olap.Dialect() != drivers.DialectDuckDB {
+		return nil
+	}
+
+	return olap.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("DROP TABLE IF EXISTS %s", olap.Dialect().EscapeIdentifier(queries.ModelChangeFeedTable(model))),
+		Priority: 100,
+	})
+}
+
+// renameChangeFeed renames a model's change feed table (if it exists).
+func (r *ModelReconciler) renameChangeFeed(ctx context.Context, connector, from, to string) error {
+	olap, release, err := r.C.AcquireOLAP(ctx, connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if olap.Dialect() != drivers.DialectDuckDB {
+		return nil
+	}
+
+	d := olap.Dialect()
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("DROP TABLE IF EXISTS %s", d.EscapeIdentifier(queries.ModelChangeFeedTable(to))),
+		Priority: 100,
+	})
+	if err != nil {
+		return err
+	}
+	return olap.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("ALTER TABLE IF EXISTS %s RENAME TO %s", d.EscapeIdentifier(queries.ModelChangeFeedTable(from)), d.EscapeIdentifier(queries.ModelChangeFeedTable(to))),
+		Priority: 100,
+	})
+}
@@ -12,6 +12,7 @@ import (
 	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/arrayutil"
+	"github.com/rilldata/rill/runtime/pkg/secretscan"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
@@ -243,9 +244,24 @@ func (r *ProjectParserReconciler) Reconcile(ctx context.Context, n *runtimev1.Re
 
 // reconcileParser reconciles a parser's output with the current resources in the catalog.
 func (r *ProjectParserReconciler) reconcileParser(ctx context.Context, inst *drivers.Instance, self *runtimev1.Resource, parser *compilerv1.Parser, diff *compilerv1.Diff, changedPaths []string) error {
+	// Before accepting the project files for a deploy, check they don't contain committed credentials.
+	// This only runs on full parses since production deployments don't watch for changes.
+	parseErrors := parser.Errors
+	var secretErrors []*runtimev1.ParseError
+	if diff == nil {
+		var err error
+		secretErrors, err = r.scanSecrets(ctx, inst, parser)
+		if err != nil {
+			return err
+		}
+		if len(secretErrors) > 0 {
+			parseErrors = append(slices.Clone(parser.Errors), secretErrors...)
+		}
+	}
+
 	// Update parse errors
 	pp := self.GetProjectParser()
-	pp.State.ParseErrors = parser.Errors
+	pp.State.ParseErrors = parseErrors
 	err := r.C.UpdateState(ctx, self.Meta.Name, self)
 	if err != nil {
 		return err
@@ -259,7 +275,7 @@ func (r *ProjectParserReconciler) reconcileParser(ctx context.Context, inst *dri
 		// (The UpdateState calls above do not mutate `self`, which is a cloned object, so the starting StateVersion is preserved here. Also quite hacky.)
 		skipRillYAMLErr := inst.IgnoreInitialInvalidProjectError && self.Meta.StateVersion == 1
 
-		for _, e := range parser.Errors {
+		for _, e := range parseErrors {
 			if skipRillYAMLErr && e.FilePath == "/rill.yaml" {
 				continue
 			}
@@ -277,7 +293,7 @@ func (r *ProjectParserReconciler) reconcileParser(ctx context.Context, inst *dri
 
 	// Set an error without returning to mark if there are parse errors (if not, force error to nil in case there previously were parse errors)
 	var parseErrsErr error
-	if len(parser.Errors) > 0 {
+	if len(parseErrors) > 0 {
 		parseErrsErr = ErrParserHasParseErrors
 	}
 	err = r.C.UpdateError(ctx, self.Meta.Name, parseErrsErr)
@@ -290,6 +306,11 @@ func (r *ProjectParserReconciler) reconcileParser(ctx context.Context, inst *dri
 		return parseErrsErr
 	}
 
+	// If the project files contain credentials, don't accept the deploy (existing resources are left unchanged)
+	if len(secretErrors) > 0 {
+		return parseErrsErr
+	}
+
 	// not setting restartController=true when diff is actually nil prevents infinite restarts
 	updateConfig := diff == nil || diff.ModifiedDotEnv || diff.Reloaded
 	if updateConfig {
@@ -323,6 +344,50 @@ func (r *ProjectParserReconciler) reconcileParser(ctx context.Context, inst *dri
 	return parseErrsErr // Keep the parseErrsErr in this case
 }
 
+// scanSecrets scans the project files for committed credentials and returns a parse error for each finding.
+// It only scans production instances, and can be disabled with the rill.deploy.allow_secrets instance config.
+func (r *ProjectParserReconciler) scanSecrets(ctx context.Context, inst *drivers.Instance, parser *compilerv1.Parser) ([]*runtimev1.ParseError, error) {
+	if inst.Environment != "prod" {
+		return nil, nil
+	}
+
+	cfg, err := inst.Config()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.DeployAllowSecrets {
+		return nil, nil
+	}
+
+	// NOTE: Not scanning .env files since they are the intended place for credentials (and not committed to Git).
+	files, err := parser.Repo.ListRecursive(ctx, "**/*.{sql,yaml,yml}", true)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*runtimev1.ParseError
+	for _, f := range files {
+		data, err := parser.Repo.Get(ctx, f.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, finding := range secretscan.Scan(data) {
+			res = append(res, &runtimev1.ParseError{
+				Message:       fmt.Sprintf("possible %s committed to project file (pass it as a variable instead, or set %q to deploy anyway)", finding.Description, "rill.deploy.allow_secrets"),
+				FilePath:      f.Path,
+				StartLocation: &runtimev1.CharLocation{Line: uint32(finding.Line)},
+			})
+		}
+	}
+
+	if len(res) > 0 {
+		r.C.Logger.Warn("Refusing to deploy project files containing credentials", zap.Int("findings", len(res)))
+	}
+
+	return res, nil
+}
+
 // reconcileProjectConfig updates instance config derived from rill.yaml and .env
 func (r *ProjectParserReconciler) reconcileProjectConfig(ctx context.Context, parser *compilerv1.Parser, restartController bool) error {
 	return r.C.Runtime.UpdateInstanceWithRillYAML(ctx, r.C.InstanceID, parser, restartController)
@@ -540,6 +605,10 @@ func (r *ProjectParserReconciler) putParserResourceDef(ctx context.Context, inst
 		if existing == nil || !equalConnectorSpec(existing.GetConnector().Spec, def.ConnectorSpec) {
 			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Connector{Connector: &runtimev1.ConnectorV2{Spec: def.ConnectorSpec}}}
 		}
+	case compilerv1.ResourceKindSegment:
+		if existing == nil || !equalSegmentSpec(existing.GetSegment().Spec, def.SegmentSpec) {
+			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Segment{Segment: &runtimev1.Segment{Spec: def.SegmentSpec}}}
+		}
 	default:
 		panic(fmt.Errorf("unknown resource type %q", def.Name.Kind))
 	}
@@ -723,3 +792,7 @@ func equalAPISpec(a, b *runtimev1.APISpec) bool {
 func equalConnectorSpec(a, b *runtimev1.ConnectorSpec) bool {
 	return proto.Equal(a, b)
 }
+
+func equalSegmentSpec(a, b *runtimev1.SegmentSpec) bool {
+	return proto.Equal(a, b)
+}
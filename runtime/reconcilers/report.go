@@ -12,8 +12,6 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/duration"
-	"github.com/rilldata/rill/runtime/pkg/email"
-	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/server"
 	"go.opentelemetry.io/otel/attribute"
@@ -420,73 +418,59 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 	if rep.Spec.ExportLimit > 0 {
 		exportURLQry.Set("limit", strconv.Itoa(int(rep.Spec.ExportLimit)))
 	}
+	if rep.Spec.ExportFilenameTemplate != "" {
+		exportURLQry.Set("filename_template", rep.Spec.ExportFilenameTemplate)
+	}
 	exportURL.RawQuery = exportURLQry.Encode()
 
 	sent := false
+	msg := &drivers.ScheduledReport{
+		Title:          rep.Spec.Title,
+		ReportTime:     t,
+		DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
+		OpenLink:       meta.OpenURL,
+		DownloadLink:   exportURL.String(),
+		EditLink:       meta.EditURL,
+	}
 	for _, notifier := range rep.Spec.Notifiers {
-		switch notifier.Connector {
-		case "email":
-			recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-			for _, recipient := range recipients {
-				err := r.C.Runtime.Email.SendScheduledReport(&email.ScheduledReport{
-					ToEmail:        recipient,
-					ToName:         "",
-					Title:          rep.Spec.Title,
-					ReportTime:     t,
-					DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
-					OpenLink:       meta.OpenURL,
-					DownloadLink:   exportURL.String(),
-					EditLink:       meta.EditURL,
-				})
-				sent = true
-				if err != nil {
-					return true, fmt.Errorf("failed to generate report for %q: %w", recipient, err)
-				}
+		err := func() (outErr error) {
+			n, release, err := acquireNotifier(ctx, r.C, notifier)
+			if err != nil {
+				return err
 			}
-		default:
-			err := func() (outErr error) {
-				conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
-				if err != nil {
-					return err
-				}
-				defer release()
-				n, err := conn.AsNotifier(notifier.Properties.AsMap())
-				if err != nil {
-					return err
+			defer release()
+			start := time.Now()
+			defer func() {
+				totalLatency := time.Since(start).Milliseconds()
+
+				if r.C.Activity != nil {
+					r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
+						attribute.Bool("failed", outErr != nil),
+						attribute.String("connector", notifier.Connector),
+						attribute.String("notification_type", "scheduled_report"),
+					)
 				}
-				msg := &drivers.ScheduledReport{
-					Title:          rep.Spec.Title,
-					ReportTime:     t,
-					DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
-					OpenLink:       meta.OpenURL,
-					DownloadLink:   exportURL.String(),
-					EditLink:       meta.EditURL,
-				}
-				start := time.Now()
-				defer func() {
-					totalLatency := time.Since(start).Milliseconds()
-
-					if r.C.Activity != nil {
-						r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
-							attribute.Bool("failed", outErr != nil),
-							attribute.String("connector", notifier.Connector),
-							attribute.String("notification_type", "scheduled_report"),
-						)
-					}
-				}()
-				err = n.SendScheduledReport(msg)
-				sent = true
-				if err != nil {
-					return fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
-				}
-				return nil
 			}()
+			err = n.SendScheduledReport(msg)
+			sent = true
 			if err != nil {
-				return sent, err
+				return fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
 			}
+			return nil
+		}()
+		if err != nil {
+			return sent, err
 		}
 	}
 
+	sendResourceNotification(ctx, r.C, rep.Spec.Notifiers, &drivers.ResourceNotification{
+		Type:         drivers.ResourceNotificationTypeReportReady,
+		ResourceName: self.Meta.Name.Name,
+		Annotations:  rep.Spec.Annotations,
+		Title:        rep.Spec.Title,
+		Body:         fmt.Sprintf("The report for %s is ready to download.", t.UTC().Format(time.RFC1123)),
+	})
+
 	return false, nil
 }
 
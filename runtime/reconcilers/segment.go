@@ -0,0 +1,166 @@
+package reconcilers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+func init() {
+	runtime.RegisterReconcilerInitializer(runtime.ResourceKindSegment, newSegmentReconciler)
+}
+
+type SegmentReconciler struct {
+	C *runtime.Controller
+}
+
+func newSegmentReconciler(c *runtime.Controller) runtime.Reconciler {
+	return &SegmentReconciler{C: c}
+}
+
+func (r *SegmentReconciler) Close(ctx context.Context) error {
+	return nil
+}
+
+func (r *SegmentReconciler) AssignSpec(from, to *runtimev1.Resource) error {
+	a := from.GetSegment()
+	b := to.GetSegment()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign spec from %T to %T", from.Resource, to.Resource)
+	}
+	b.Spec = a.Spec
+	return nil
+}
+
+func (r *SegmentReconciler) AssignState(from, to *runtimev1.Resource) error {
+	a := from.GetSegment()
+	b := to.GetSegment()
+	if a == nil || b == nil {
+		return fmt.Errorf("cannot assign state from %T to %T", from.Resource, to.Resource)
+	}
+	b.State = a.State
+	return nil
+}
+
+func (r *SegmentReconciler) ResetState(res *runtimev1.Resource) error {
+	res.GetSegment().State = &runtimev1.SegmentState{}
+	return nil
+}
+
+func (r *SegmentReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceName) runtime.ReconcileResult {
+	self, err := r.C.Get(ctx, n, true)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+	s := self.GetSegment()
+	if s == nil {
+		return runtime.ReconcileResult{Err: errors.New("not a segment")}
+	}
+
+	// Exit early for deletion
+	if self.Meta.DeletedOn != nil {
+		return runtime.ReconcileResult{}
+	}
+
+	// Validate the filter against the metrics view's valid spec and update ValidSpec
+	validateErr := r.validate(ctx, s.Spec)
+	if ctx.Err() != nil {
+		return runtime.ReconcileResult{Err: errors.Join(validateErr, ctx.Err())}
+	}
+	if validateErr == nil {
+		s.State.ValidSpec = s.Spec
+	} else {
+		s.State.ValidSpec = nil
+	}
+
+	// Update state. Even if the validation result is unchanged, we always update the state to ensure the state version is incremented.
+	err = r.C.UpdateState(ctx, self.Meta.Name, self)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
+
+	return runtime.ReconcileResult{Err: validateErr}
+}
+
+// validate checks that the segment's metrics view is valid and that its filter only references the metrics view's dimensions and measures.
+func (r *SegmentReconciler) validate(ctx context.Context, spec *runtimev1.SegmentSpec) error {
+	res, err := r.C.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: spec.MetricsView}, false)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return fmt.Errorf("dependency error: metrics view %q not found", spec.MetricsView)
+		}
+		return err
+	}
+	mv := res.GetMetricsView().State.ValidSpec
+	if mv == nil {
+		return fmt.Errorf("dependency error: metrics view %q is invalid", spec.MetricsView)
+	}
+
+	dims := make(map[string]bool, len(mv.Dimensions))
+	for _, d := range mv.Dimensions {
+		dims[strings.ToLower(d.Name)] = true
+	}
+	measures := make(map[string]bool, len(mv.Measures))
+	for _, m := range mv.Measures {
+		measures[strings.ToLower(m.Name)] = true
+	}
+
+	err = validateSegmentFilter(spec.Filter, dims, measures, false)
+	if err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	return nil
+}
+
+// validateSegmentFilter checks the names referenced in a segment's filter.
+// Measures are only allowed in the HAVING clause of subqueries.
+func validateSegmentFilter(e *runtimev1.Expression, dims, measures map[string]bool, allowMeasures bool) error {
+	if e == nil {
+		return nil
+	}
+
+	if ident := e.GetIdent(); ident != "" {
+		if !dims[strings.ToLower(ident)] && !(allowMeasures && measures[strings.ToLower(ident)]) {
+			if allowMeasures {
+				return fmt.Errorf("dimension or measure %q not found", ident)
+			}
+			return fmt.Errorf("dimension %q not found", ident)
+		}
+	}
+
+	if seg := e.GetSegment(); seg != "" {
+		return fmt.Errorf("cannot reference segment %q from a segment", seg)
+	}
+
+	if c := e.GetCond(); c != nil {
+		for _, e := range c.Exprs {
+			if err := validateSegmentFilter(e, dims, measures, allowMeasures); err != nil {
+				return err
+			}
+		}
+	}
+
+	if sq := e.GetSubquery(); sq != nil {
+		if !dims[strings.ToLower(sq.Dimension)] {
+			return fmt.Errorf("dimension %q not found", sq.Dimension)
+		}
+		for _, m := range sq.Measures {
+			if !measures[strings.ToLower(m)] {
+				return fmt.Errorf("measure %q not found", m)
+			}
+		}
+		if err := validateSegmentFilter(sq.Where, dims, measures, false); err != nil {
+			return err
+		}
+		if err := validateSegmentFilter(sq.Having, dims, measures, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
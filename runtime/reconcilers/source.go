@@ -146,6 +146,10 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
 		}
+		refreshOn, err = deferToRefreshWindow(ctx, r.C, refreshOn)
+		if err != nil {
+			return runtime.ReconcileResult{Err: err}
+		}
 	}
 
 	// Check if the table still exists (might have been corrupted/lost somehow)
@@ -261,6 +265,10 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	if err != nil {
 		return runtime.ReconcileResult{Err: err}
 	}
+	refreshOn, err = deferToRefreshWindow(ctx, r.C, refreshOn)
+	if err != nil {
+		return runtime.ReconcileResult{Err: err}
+	}
 
 	return runtime.ReconcileResult{Err: ingestErr, Retrigger: refreshOn}
 }
@@ -11,7 +11,10 @@ import (
 	"github.com/rilldata/rill/runtime"
 	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/maintenancewindow"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
 )
 
 // checkRefs checks that all refs exist, are idle, and have no errors.
@@ -96,6 +99,83 @@ func nextRefreshTime(t time.Time, schedule *runtimev1.Schedule) (time.Time, erro
 	return t2, nil
 }
 
+// deferToRefreshWindow defers a scheduled refresh time so that scheduled refreshes only run inside the instance's refresh maintenance window (if any).
+// If refreshOn has already passed, it is kept if the window is currently open, and otherwise deferred to the start of the next window.
+func deferToRefreshWindow(ctx context.Context, c *runtime.Controller, refreshOn time.Time) (time.Time, error) {
+	if refreshOn.IsZero() {
+		return refreshOn, nil
+	}
+
+	inst, err := c.Runtime.Instance(ctx, c.InstanceID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	cfg, err := inst.Config()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if cfg.RefreshMaintenanceWindow == "" {
+		return refreshOn, nil
+	}
+
+	w, err := maintenancewindow.Parse(cfg.RefreshMaintenanceWindow)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now := time.Now()
+	if refreshOn.Before(now) {
+		if w.Contains(now) {
+			return refreshOn, nil
+		}
+		return w.Next(now), nil
+	}
+	return w.Next(refreshOn), nil
+}
+
+// acquireNotifier returns a notifier for a notifier spec of an alert or report.
+// The "email" connector uses the runtime's email client. Other connectors must implement drivers.Notifier.
+func acquireNotifier(ctx context.Context, c *runtime.Controller, n *runtimev1.Notifier) (drivers.Notifier, func(), error) {
+	if n.Connector == "email" {
+		return c.Runtime.Email.AsNotifier(n.Properties.AsMap()), func() {}, nil
+	}
+
+	conn, release, err := c.Runtime.AcquireHandle(ctx, c.InstanceID, n.Connector)
+	if err != nil {
+		return nil, nil, err
+	}
+	notifier, err := conn.AsNotifier(n.Properties.AsMap())
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+	return notifier, release, nil
+}
+
+// sendResourceNotification sends an in-app notification about a resource to the admin service, which delivers it to the users among the email recipients of the notifiers.
+// It's best-effort: errors are logged, and nothing is sent if an admin service is not configured.
+func sendResourceNotification(ctx context.Context, c *runtime.Controller, notifiers []*runtimev1.Notifier, n *drivers.ResourceNotification) {
+	for _, notifier := range notifiers {
+		if notifier.Connector == "email" {
+			n.RecipientEmails = append(n.RecipientEmails, pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])...)
+		}
+	}
+
+	admin, release, err := c.Runtime.Admin(ctx, c.InstanceID)
+	if err != nil {
+		if !errors.Is(err, runtime.ErrAdminNotConfigured) {
+			c.Logger.Warn("Failed to get admin client for resource notification", zap.String("name", n.ResourceName), zap.Error(err))
+		}
+		return
+	}
+	defer release()
+
+	err = admin.SendResourceNotification(ctx, n)
+	if err != nil {
+		c.Logger.Warn("Failed to send resource notification", zap.String("name", n.ResourceName), zap.Error(err))
+	}
+}
+
 // olapTableInfo returns info about a table in an OLAP connector
 func olapTableInfo(ctx context.Context, c *runtime.Controller, connector, table string) (*drivers.Table, bool) {
 	if table == "" {
@@ -78,7 +78,7 @@ func (r *Runtime) WaitUntilIdle(ctx context.Context, instanceID string, ignoreHi
 
 // CreateInstance creates a new instance and starts a controller for it.
 func (r *Runtime) CreateInstance(ctx context.Context, inst *drivers.Instance) error {
-	return r.registryCache.create(ctx, inst)
+	return r.registryCache.create(ctx, inst, nil)
 }
 
 // EditInstance edits an existing instance.
@@ -109,6 +109,10 @@ func (r *Runtime) DeleteInstance(ctx context.Context, instanceID string) error {
 	// Wait for the controller to stop and the connection cache to be evicted
 	<-completed
 
+	r.slowQueries.Reset(instanceID)
+	r.queryCache.cache.PurgeInstance(instanceID)
+	r.queryWarmer.reset(instanceID)
+
 	if err := os.RemoveAll(filepath.Join(r.opts.DataDir, instanceID)); err != nil {
 		r.logger.Error("could not drop instance data directory", zap.Error(err), zap.String("instance_id", instanceID), observability.ZapCtx(ctx))
 	}
@@ -173,6 +177,10 @@ type instanceWithController struct {
 	ready     bool
 	readyCh   chan struct{}
 	reopen    bool
+
+	// restore is called once before the controller is started for the first time (optional).
+	// It's used to populate the instance's catalog and data before any reconcilers run.
+	restore func(ctx context.Context) error
 }
 
 func newRegistryCache(rt *Runtime, registry drivers.RegistryStore, logger *zap.Logger, ac *activity.Client) *registryCache {
@@ -200,7 +208,7 @@ func (r *registryCache) init(ctx context.Context) error {
 	}
 
 	for _, inst := range insts {
-		if err := r.add(inst); err != nil {
+		if err := r.add(inst, nil); err != nil {
 			return err
 		}
 	}
@@ -210,6 +218,36 @@ func (r *registryCache) init(ctx context.Context) error {
 	return nil
 }
 
+// drain waits for running controllers to finish their pending and in-flight reconciles, or until ctx is cancelled.
+// It then flushes each controller's catalog, so the catalog is persisted even if the controller is not stopped cleanly later.
+func (r *registryCache) drain(ctx context.Context) {
+	r.mu.RLock()
+	var ctrls []*Controller
+	for _, iwc := range r.instances {
+		if iwc.controller != nil {
+			ctrls = append(ctrls, iwc.controller)
+		}
+	}
+	r.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, ctrl := range ctrls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := ctrl.WaitUntilIdle(ctx, false)
+			if err != nil {
+				r.logger.Warn("drain: controller did not become idle", zap.String("instance_id", ctrl.InstanceID), zap.Error(err))
+			}
+			err = ctrl.Flush(ctx)
+			if err != nil {
+				r.logger.Warn("drain: failed to flush catalog", zap.String("instance_id", ctrl.InstanceID), zap.Error(err))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 func (r *registryCache) close(ctx context.Context) {
 	wg := sync.WaitGroup{}
 
@@ -273,6 +311,17 @@ func (r *registryCache) instanceHealth(ctx context.Context, instanceID string) (
 		res.Repo = repo.(drivers.Handle).Ping(ctx)
 		rr()
 	}
+
+	// check catalog error
+	catalog, cr, err := r.rt.Catalog(ctx, instanceID)
+	if err != nil {
+		res.Catalog = err
+	} else {
+		if h, ok := catalog.(drivers.Handle); ok {
+			res.Catalog = h.Ping(ctx)
+		}
+		cr()
+	}
 	return res, nil
 }
 
@@ -339,16 +388,16 @@ func (r *registryCache) getLogbuffer(instanceID string) (*logbuffer.Buffer, erro
 	return iwc.logbuffer, nil
 }
 
-func (r *registryCache) create(ctx context.Context, inst *drivers.Instance) error {
+func (r *registryCache) create(ctx context.Context, inst *drivers.Instance, restore func(ctx context.Context) error) error {
 	err := r.store.CreateInstance(ctx, inst)
 	if err != nil {
 		return err
 	}
 
-	return r.add(inst)
+	return r.add(inst, restore)
 }
 
-func (r *registryCache) add(inst *drivers.Instance) error {
+func (r *registryCache) add(inst *drivers.Instance, restore func(ctx context.Context) error) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -359,6 +408,7 @@ func (r *registryCache) add(inst *drivers.Instance) error {
 	iwc := &instanceWithController{
 		instanceID: inst.ID,
 		instance:   inst,
+		restore:    restore,
 	}
 	r.instances[inst.ID] = iwc
 	if r.rt.opts.DataDir != "" {
@@ -470,6 +520,16 @@ func (r *registryCache) restartController(iwc *instanceWithController) {
 			if err := r.updateProjectConfig(iwc); err != nil {
 				iwc.logger.Warn("failed to parse and update the project config before starting the controller", zap.Error(err))
 			}
+			r.mu.Lock()
+			restore := iwc.restore
+			iwc.restore = nil
+			r.mu.Unlock()
+			if restore != nil {
+				iwc.logger.Info("restoring instance before starting the controller")
+				if err := restore(iwc.ctx); err != nil {
+					iwc.logger.Error("failed to restore instance, starting the controller without restored data", zap.Error(err))
+				}
+			}
 			iwc.logger.Debug("controller starting")
 			ctrl, err := NewController(iwc.ctx, r.rt, iwc.instanceID, iwc.logger, r.activity)
 			if err == nil {
@@ -506,6 +506,54 @@ func TestRuntime_DeleteInstance_DropCorrupted(t *testing.T) {
 }
 
 // New returns a runtime configured for use in tests.
+func TestRuntime_Drain(t *testing.T) {
+	rt := newTestRuntime(t)
+	ctx := context.Background()
+
+	inst := &drivers.Instance{
+		Environment:      "test",
+		OLAPConnector:    "duckdb",
+		RepoConnector:    "repo",
+		CatalogConnector: "catalog",
+		Connectors: []*runtimev1.Connector{
+			{
+				Type:   "file",
+				Name:   "repo",
+				Config: map[string]string{"dsn": t.TempDir()},
+			},
+			{
+				Type:   "duckdb",
+				Name:   "duckdb",
+				Config: map[string]string{"dsn": ":memory:"},
+			},
+			{
+				Type:   "sqlite",
+				Name:   "catalog",
+				Config: map[string]string{"dsn": fmt.Sprintf("file:%s_catalog?mode=memory&cache=shared", t.Name())},
+			},
+		},
+	}
+	require.NoError(t, rt.CreateInstance(ctx, inst))
+	ctrl, err := rt.Controller(ctx, inst.ID)
+	require.NoError(t, err)
+
+	// Drain returns once the controller is idle
+	drainCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	rt.registryCache.drain(drainCtx)
+	require.NoError(t, drainCtx.Err())
+	require.NoError(t, ctrl.WaitUntilIdle(ctx, false))
+
+	// The controller keeps running after a drain
+	_, err = rt.Controller(ctx, inst.ID)
+	require.NoError(t, err)
+
+	// Drain doesn't block on a cancelled context
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	rt.registryCache.drain(cancelledCtx)
+}
+
 func newTestRuntime(t *testing.T) *Runtime {
 	globalConnectors := []*runtimev1.Connector{
 		{
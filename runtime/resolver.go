@@ -12,6 +12,9 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/jsonval"
+	"github.com/rilldata/rill/runtime/pkg/resultcache"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Resolver represents logic, such as a SQL query, that produces output data.
@@ -155,7 +158,13 @@ type ResolveResult struct {
 }
 
 // Resolve resolves a query using the given options.
-func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolveResult, error) {
+func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (_ ResolveResult, outErr error) {
+	ctx, span := tracer.Start(ctx, "Runtime.Resolve", trace.WithAttributes(
+		attribute.String("instance_id", opts.InstanceID),
+		attribute.String("resolver", opts.Resolver),
+	))
+	defer func() { endSpan(span, outErr) }()
+
 	// Initialize the resolver
 	initializer, ok := ResolverInitializers[opts.Resolver]
 	if !ok {
@@ -183,6 +192,7 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolveRes
 	if _, err := hash.Write([]byte(resolver.Key())); err != nil {
 		return ResolveResult{}, err
 	}
+	var tags []string
 	for _, ref := range resolver.Refs() {
 		res, err := ctrl.Get(ctx, ref, false)
 		if err != nil {
@@ -202,41 +212,60 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolveRes
 		if err := binary.Write(hash, binary.BigEndian, res.Meta.StateUpdatedOn.Nanos); err != nil {
 			return ResolveResult{}, err
 		}
+		tags = append(tags, queryCacheTag(res.Meta.Name))
 	}
 	sum := hex.EncodeToString(hash.Sum(nil))
 	key := fmt.Sprintf("inst:%s:resolver:%s:hash:%s", opts.InstanceID, opts.Resolver, sum)
+	span.SetAttributes(attribute.String("query_key", sum))
 
 	// Try to get from cache
-	if val, ok := r.queryCache.cache.Get(key); ok {
+	if val, ok := r.queryCache.cache.Get(opts.InstanceID, key); ok {
+		span.SetAttributes(attribute.Bool("cache_hit", true))
 		return val.(ResolveResult), nil
 	}
+	span.SetAttributes(attribute.Bool("cache_hit", false))
 
 	// Load with singleflight
 	val, err := r.queryCache.singleflight.Do(ctx, key, func(ctx context.Context) (any, error) {
 		// Try cache again
-		if val, ok := r.queryCache.cache.Get(key); ok {
+		if val, ok := r.queryCache.cache.Get(opts.InstanceID, key); ok {
 			return val, nil
 		}
 
-		res, err := resolver.ResolveInteractive(ctx)
-		if err != nil {
-			return ResolveResult{}, err
-		}
-		defer res.Close()
+		// Load from the shared cache or resolve the query
+		res, err := r.queryCache.loadShared(ctx, key, resolveResultCodec{}, func(ctx context.Context) (*loadedResult, error) {
+			res, err := resolver.ResolveInteractive(ctx)
+			if err != nil {
+				return nil, err
+			}
+			defer res.Close()
+
+			data, err := res.MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
 
-		data, err := res.MarshalJSON()
+			cRes := ResolveResult{
+				Data:   data,
+				Schema: res.Schema(),
+			}
+			return &loadedResult{Value: cRes, Size: int64(len(data)), Cache: res.Cache()}, nil
+		})
 		if err != nil {
 			return ResolveResult{}, err
 		}
 
-		cRes := ResolveResult{
-			Data:   data,
-			Schema: res.Schema(),
-		}
-		if res.Cache() {
-			r.queryCache.cache.Set(key, cRes, int64(len(data)))
+		if res.Cache {
+			r.queryCache.cache.Set(resultcache.Entry{
+				InstanceID: opts.InstanceID,
+				Key:        key,
+				Tags:       tags,
+				Value:      res.Value,
+				Size:       res.Size,
+				Codec:      resolveResultCodec{},
+			})
 		}
-		return cRes, nil
+		return res.Value, nil
 	})
 	if err != nil {
 		return ResolveResult{}, err
@@ -3,7 +3,6 @@ package resolvers
 import (
 	"context"
 	"errors"
-	"fmt"
 	"io"
 	"strconv"
 	"time"
@@ -11,8 +10,10 @@ import (
 	"github.com/mitchellh/hashstructure/v2"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/metricsview"
 	"github.com/rilldata/rill/runtime/pkg/mapstructureutil"
+	"github.com/rilldata/rill/runtime/pkg/queryerr"
 )
 
 func init() {
@@ -50,12 +51,15 @@ func newMetrics(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Res
 
 	res, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: qry.MetricsView}, false)
 	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, queryerr.Errorf(queryerr.ReasonMetricsViewNotFound, "metrics view %q not found", qry.MetricsView)
+		}
 		return nil, err
 	}
 
 	mv := res.GetMetricsView().State.ValidSpec
 	if mv == nil {
-		return nil, fmt.Errorf("metrics view %q is invalid", res.Meta.Name.Name)
+		return nil, queryerr.Errorf(queryerr.ReasonMetricsViewInvalid, "metrics view %q is invalid", res.Meta.Name.Name)
 	}
 
 	security, err := opts.Runtime.ResolveSecurity(opts.InstanceID, opts.Claims, res)
@@ -96,7 +100,11 @@ func (r *metricsResolver) Key() string {
 }
 
 func (r *metricsResolver) Refs() []*runtimev1.ResourceName {
-	return []*runtimev1.ResourceName{{Kind: runtime.ResourceKindMetricsView, Name: r.query.MetricsView}}
+	refs := []*runtimev1.ResourceName{{Kind: runtime.ResourceKindMetricsView, Name: r.query.MetricsView}}
+	for _, s := range r.query.Segments() {
+		refs = append(refs, &runtimev1.ResourceName{Kind: runtime.ResourceKindSegment, Name: s})
+	}
+	return refs
 }
 
 func (r *metricsResolver) Validate(ctx context.Context) error {
@@ -131,3 +131,37 @@ func TestPolicyMetricsSQLAPI(t *testing.T) {
 	require.Equal(t, "msn.com", resp[0]["domain"])
 	require.Equal(t, nil, resp[0]["publisher"])
 }
+
+func TestMetricsSQLAPIWithProjectBuilder(t *testing.T) {
+	rt, instanceID := testruntime.NewProject().
+		Seed("events", "country,amount\nDK,10\nDK,5\nUS,20\n").
+		Model("events_model", "SELECT * FROM events").
+		MetricsView("events_mv", testruntime.MetricsView{
+			Model:      "events_model",
+			Dimensions: []testruntime.Dimension{{Name: "country", Column: "country"}},
+			Measures:   []testruntime.Measure{{Name: "total", Expression: "SUM(amount)"}},
+		}).
+		API("totals_api", testruntime.API{
+			MetricsSQL: "SELECT country, total FROM events_mv ORDER BY country",
+		}).
+		Build(t)
+	testruntime.RequireReconcileState(t, rt, instanceID, 5, 0, 0)
+
+	api, err := rt.APIForName(context.Background(), instanceID, "totals_api")
+	require.NoError(t, err)
+
+	res, err := rt.Resolve(context.Background(), &runtime.ResolveOptions{
+		InstanceID:         instanceID,
+		Resolver:           api.Spec.Resolver,
+		ResolverProperties: api.Spec.ResolverProperties.AsMap(),
+		Claims:             &runtime.SecurityClaims{},
+	})
+	require.NoError(t, err)
+
+	var rows []map[string]interface{}
+	require.NoError(t, json.Unmarshal(res.Data, &rows))
+	require.Equal(t, []map[string]interface{}{
+		{"country": "DK", "total": float64(15)},
+		{"country": "US", "total": float64(20)},
+	}, rows)
+}
@@ -228,7 +228,7 @@ func (r *sqlResolver) generalExport(ctx context.Context, w io.Writer, filename s
 	case runtimev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
 		return fmt.Errorf("unspecified format")
 	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
-		return queries.WriteCSV(meta, data, w)
+		return queries.WriteCSV(meta, data, w, nil)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
 		return queries.WriteXLSX(meta, data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
@@ -24,6 +24,7 @@ const (
 	ResourceKindDashboard      string = "rill.runtime.v1.Dashboard"
 	ResourceKindAPI            string = "rill.runtime.v1.API"
 	ResourceKindConnector      string = "rill.runtime.v1.Connector"
+	ResourceKindSegment        string = "rill.runtime.v1.Segment"
 )
 
 // ResourceNameFromCompiler converts a compiler resource name to a runtime resource name.
@@ -51,6 +52,8 @@ func ResourceNameFromCompiler(name compilerv1.ResourceName) *runtimev1.ResourceN
 		return &runtimev1.ResourceName{Kind: ResourceKindAPI, Name: name.Name}
 	case compilerv1.ResourceKindConnector:
 		return &runtimev1.ResourceName{Kind: ResourceKindConnector, Name: name.Name}
+	case compilerv1.ResourceKindSegment:
+		return &runtimev1.ResourceName{Kind: ResourceKindSegment, Name: name.Name}
 	default:
 		panic(fmt.Errorf("unknown resource type %q", name.Kind))
 	}
@@ -81,6 +84,8 @@ func ResourceNameToCompiler(name *runtimev1.ResourceName) compilerv1.ResourceNam
 		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindAPI, Name: name.Name}
 	case ResourceKindConnector:
 		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindConnector, Name: name.Name}
+	case ResourceKindSegment:
+		return compilerv1.ResourceName{Kind: compilerv1.ResourceKindSegment, Name: name.Name}
 	default:
 		panic(fmt.Errorf("unknown resource type %q", name.Kind))
 	}
@@ -0,0 +1,27 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/rilldata/rill/runtime/pkg/runningquery"
+)
+
+// WithRunningQueries returns a copy of ctx that tracks queries executed on behalf of the instance while they're running.
+// The queryType and principal describe the request that issues the queries, and are reported alongside them by RunningQueries.
+func (r *Runtime) WithRunningQueries(ctx context.Context, instanceID, queryType, principal string) context.Context {
+	return runningquery.WithRegistry(ctx, r.runningQueries, runningquery.Source{
+		InstanceID: instanceID,
+		Type:       queryType,
+		Principal:  principal,
+	})
+}
+
+// RunningQueries returns the queries currently running for the instance, ordered by start time.
+func (r *Runtime) RunningQueries(instanceID string) []*runningquery.Query {
+	return r.runningQueries.List(instanceID)
+}
+
+// CancelQuery cancels a running query. It returns false if the instance doesn't have a running query with the ID.
+func (r *Runtime) CancelQuery(instanceID, queryID string) bool {
+	return r.runningQueries.Cancel(instanceID, queryID)
+}
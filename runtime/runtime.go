@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -12,6 +14,9 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/conncache"
 	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/runningquery"
+	"github.com/rilldata/rill/runtime/pkg/sharedcache"
+	"github.com/rilldata/rill/runtime/pkg/slowquery"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
@@ -24,11 +29,22 @@ type Options struct {
 	SystemConnectors             []*runtimev1.Connector
 	ConnectionCacheSize          int
 	QueryCacheSizeBytes          int64
+	QueryCacheInstanceSizeBytes  int64             // Memory budget for each instance's cached query results (not enforced if zero)
+	QueryCacheDiskSizeBytes      int64             // Budget for large query results cached in DataDir (disabled if zero)
+	QueryCacheDiskThresholdBytes int64             // Query results at least this size are cached on disk if enabled
+	SharedQueryCache             sharedcache.Cache // Optional cache for sharing query results between replicas (closed when the runtime is closed)
+	QueryCacheWarmingLimit       int               // Number of frequent queries replayed after a metrics view is refreshed (disabled if zero)
 	SecurityEngineCacheSize      int
 	ControllerLogBufferCapacity  int
 	ControllerLogBufferSizeBytes int64
 	AllowHostAccess              bool
 	DataDir                      string
+	SlowQueryThreshold           time.Duration // Queries that take longer are recorded in the slow query log (disabled if zero)
+	DrainTimeout                 time.Duration // Max time Close waits for in-flight reconciles to finish (doesn't wait if zero). It counts towards closeTimeout.
+	BackupURL                    string        // Object storage URL for DuckDB backups, e.g. s3://bucket/path (disabled if empty)
+	BackupInterval               time.Duration // Interval between scheduled backups of each instance (not scheduled if zero)
+	BackupRetention              int           // Number of backups to keep per instance (keeps all if zero)
+	Version                      string        // Version of the runtime reported in heartbeats to the admin service
 }
 
 type Runtime struct {
@@ -40,7 +56,14 @@ type Runtime struct {
 	registryCache  *registryCache
 	connCache      conncache.Cache
 	queryCache     *queryCache
+	queryWarmer    *queryWarmer
 	securityEngine *securityEngine
+	queryUsage     *queryUsage
+	slowQueries    *slowquery.Log
+	runningQueries *runningquery.Registry
+	draining       atomic.Bool
+	bgCancel       context.CancelFunc
+	bgWG           sync.WaitGroup
 }
 
 func New(ctx context.Context, opts *Options, logger *zap.Logger, ac *activity.Client, emailClient *email.Client) (*Runtime, error) {
@@ -53,8 +76,12 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, ac *activity.Cl
 		opts:           opts,
 		logger:         logger,
 		activity:       ac,
-		queryCache:     newQueryCache(opts.QueryCacheSizeBytes),
+		queryCache:     newQueryCache(opts),
+		queryWarmer:    newQueryWarmer(opts.QueryCacheWarmingLimit),
 		securityEngine: newSecurityEngine(opts.SecurityEngineCacheSize, logger),
+		queryUsage:     newQueryUsage(),
+		slowQueries:    slowquery.New(opts.SlowQueryThreshold),
+		runningQueries: runningquery.New(),
 	}
 
 	rt.connCache = rt.newConnectionCache()
@@ -75,6 +102,27 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, ac *activity.Cl
 		return nil, err
 	}
 
+	// Start background jobs. They're stopped in Close.
+	bgctx, cancel := context.WithCancel(context.Background())
+	rt.bgCancel = cancel
+	rt.bgWG.Add(4)
+	go func() {
+		defer rt.bgWG.Done()
+		rt.runQueryUsageFlusher(bgctx)
+	}()
+	go func() {
+		defer rt.bgWG.Done()
+		rt.runBackupScheduler(bgctx)
+	}()
+	go func() {
+		defer rt.bgWG.Done()
+		rt.runQueryCacheWarmer(bgctx)
+	}()
+	go func() {
+		defer rt.bgWG.Done()
+		rt.runHeartbeater(bgctx)
+	}()
+
 	return rt, nil
 }
 
@@ -82,15 +130,44 @@ func (r *Runtime) AllowHostAccess() bool {
 	return r.opts.AllowHostAccess
 }
 
+// closeTimeout is the max time Close waits for the runtime to shut down, including the time spent draining.
+const closeTimeout = 30 * time.Second
+
 func (r *Runtime) Close() error {
-	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
 	defer cancel()
+
+	// Give in-flight reconciles a chance to finish and flush the catalog before the controllers are cancelled
+	r.Drain()
+	if r.opts.DrainTimeout > 0 {
+		drainCtx, cancel := context.WithTimeout(ctx, r.opts.DrainTimeout)
+		r.registryCache.drain(drainCtx)
+		cancel()
+	}
+
+	// Stop background jobs before closing instances, so the final usage flush can still reach the admin service
+	r.bgCancel()
+	r.bgWG.Wait()
 	r.registryCache.close(ctx)
 	err1 := r.queryCache.close()
 	err2 := r.connCache.Close(ctx) // Also closes metastore // TODO: Propagate ctx cancellation
 	return errors.Join(err1, err2)
 }
 
+// Drain puts the runtime in draining mode before it's closed.
+// While draining, servers should stop accepting new queries and report the runtime as unready.
+// It is safe to call multiple times.
+func (r *Runtime) Drain() {
+	if r.draining.CompareAndSwap(false, true) {
+		r.logger.Info("runtime draining")
+	}
+}
+
+// Draining returns true if Drain or Close has been called.
+func (r *Runtime) Draining() bool {
+	return r.draining.Load()
+}
+
 func (r *Runtime) ResolveSecurity(instanceID string, claims *SecurityClaims, res *runtimev1.Resource) (*ResolvedSecurity, error) {
 	inst, err := r.Instance(context.Background(), instanceID)
 	if err != nil {
@@ -4,8 +4,8 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/hashicorp/golang-lru/simplelru"
@@ -14,11 +14,12 @@ import (
 	"github.com/rilldata/rill/runtime/drivers/slack"
 	"github.com/rilldata/rill/runtime/pkg/expressionpb"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
+	"github.com/rilldata/rill/runtime/pkg/queryerr"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-var ErrForbidden = errors.New("action not allowed")
+var ErrForbidden = queryerr.New(queryerr.ReasonSecurityDenied, "action not allowed")
 
 // SecurityClaims represents contextual information for the enforcement of security rules.
 type SecurityClaims struct {
@@ -27,10 +28,31 @@ type SecurityClaims struct {
 	// AdditionalRules are optional security rules to apply *in addition* to the built-in rules and the rules defined on the requested resource.
 	// These are currently leveraged by the admin service to enforce restrictions for magic auth tokens.
 	AdditionalRules []*runtimev1.SecurityRule
+	// AllowedResources optionally restricts access to only the listed resources. If it's empty, access is not restricted by resource.
+	// It's used to scope tokens (such as embed tokens, magic auth tokens and API keys) to specific metrics views or APIs,
+	// so they can't be used to enumerate or query other resources.
+	// Themes are always accessible since they only contain presentation settings needed to render the allowed resources.
+	// Names are matched case-insensitively, consistent with how resources are looked up in the catalog.
+	AllowedResources []*runtimev1.ResourceName
 	// SkipChecks enables completely skipping all security checks. Used in local development.
 	SkipChecks bool
 }
 
+// CanAccessResource returns false if AllowedResources is set and doesn't contain the resource.
+// Themes are always accessible (see AllowedResources).
+// It doesn't consider other security rules; use ResolveSecurity for a full access check.
+func (c *SecurityClaims) CanAccessResource(n *runtimev1.ResourceName) bool {
+	if c.SkipChecks || len(c.AllowedResources) == 0 || n.Kind == ResourceKindTheme {
+		return true
+	}
+	for _, r := range c.AllowedResources {
+		if r.Kind == n.Kind && strings.EqualFold(r.Name, n.Name) {
+			return true
+		}
+	}
+	return false
+}
+
 // Admin is a convenience function for extracting an "admin" bool from the user attributes.
 func (c *SecurityClaims) Admin() bool {
 	if c.UserAttributes == nil {
@@ -50,13 +72,27 @@ func (c *SecurityClaims) UserID() string {
 	return id
 }
 
+// UserEmail is a convenience function for extracting an "email" string from the user attributes.
+func (c *SecurityClaims) UserEmail() string {
+	if c.UserAttributes == nil {
+		return ""
+	}
+	email, _ := c.UserAttributes["email"].(string)
+	return email
+}
+
 // MarshalJSON serializes the SecurityClaims to JSON.
 // It serializes the AdditionalRules using protojson.
 func (c *SecurityClaims) MarshalJSON() ([]byte, error) {
 	tmp := securityClaimsJSON{
-		UserAttributes:  c.UserAttributes,
-		AdditionalRules: make([]json.RawMessage, len(c.AdditionalRules)),
-		SkipChecks:      c.SkipChecks,
+		UserAttributes:   c.UserAttributes,
+		AdditionalRules:  make([]json.RawMessage, len(c.AdditionalRules)),
+		AllowedResources: make([]resourceNameJSON, len(c.AllowedResources)),
+		SkipChecks:       c.SkipChecks,
+	}
+
+	for i, n := range c.AllowedResources {
+		tmp.AllowedResources[i] = resourceNameJSON{Kind: n.Kind, Name: n.Name}
 	}
 
 	for i, rule := range c.AdditionalRules {
@@ -87,6 +123,10 @@ func (c *SecurityClaims) UnmarshalJSON(data []byte) error {
 		}
 		c.AdditionalRules[i] = rule
 	}
+	c.AllowedResources = make([]*runtimev1.ResourceName, len(tmp.AllowedResources))
+	for i, n := range tmp.AllowedResources {
+		c.AllowedResources[i] = &runtimev1.ResourceName{Kind: n.Kind, Name: n.Name}
+	}
 	c.SkipChecks = tmp.SkipChecks
 
 	return nil
@@ -95,9 +135,16 @@ func (c *SecurityClaims) UnmarshalJSON(data []byte) error {
 // securityClaimsJSON is a JSON-serializable representation of SecurityClaims.
 // SecurityClaims can't be directly serialized to JSON because the SecurityRule proto is not directly JSON serializable.
 type securityClaimsJSON struct {
-	UserAttributes  map[string]any    `json:"attrs"`
-	AdditionalRules []json.RawMessage `json:"rules"`
-	SkipChecks      bool              `json:"skip"`
+	UserAttributes   map[string]any     `json:"attrs"`
+	AdditionalRules  []json.RawMessage  `json:"rules"`
+	AllowedResources []resourceNameJSON `json:"resources,omitempty"`
+	SkipChecks       bool               `json:"skip"`
+}
+
+// resourceNameJSON is a JSON-serializable representation of a runtimev1.ResourceName.
+type resourceNameJSON struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
 }
 
 // ResolvedSecurity represents the resolved security rules for a given claims against a specific resource.
@@ -197,6 +244,11 @@ func (p *securityEngine) resolveSecurity(instanceID, environment string, claims
 		return openAccess, nil
 	}
 
+	// If the claims are restricted to specific resources, deny access to all other resources
+	if !claims.CanAccessResource(r.Meta.Name) {
+		return closedAccess, nil
+	}
+
 	// Combine rules with any contained in the resource itself
 	rules := p.resolveRules(claims, r)
 
@@ -319,6 +371,9 @@ func (p *securityEngine) resolveRules(claims *SecurityClaims, r *runtimev1.Resou
 			// Prepend instead of append since the rule is likely to lead to a quick deny access
 			rules = append([]*runtimev1.SecurityRule{rule}, rules...)
 		}
+	// Everyone can access a segment. Queries that use it are still subject to the metrics view's security rules.
+	case ResourceKindSegment:
+		rules = append(rules, allowAccessRule)
 	// Everyone can access a theme.
 	case ResourceKindTheme:
 		rules = append(rules, allowAccessRule)
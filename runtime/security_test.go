@@ -515,3 +515,54 @@ func TestResolveMetricsView(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveSecurityAllowedResources(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		SecurityRules: []*runtimev1.SecurityRule{
+			{Rule: &runtimev1.SecurityRule_Access{Access: &runtimev1.SecurityRuleAccess{Allow: true}}},
+		},
+	}
+	newResource := func(name string) *runtimev1.Resource {
+		return &runtimev1.Resource{
+			Meta: &runtimev1.ResourceMeta{
+				Name:           &runtimev1.ResourceName{Kind: ResourceKindMetricsView, Name: name},
+				StateUpdatedOn: timestamppb.Now(),
+			},
+			Resource: &runtimev1.Resource_MetricsView{
+				MetricsView: &runtimev1.MetricsViewV2{
+					Spec:  mv,
+					State: &runtimev1.MetricsViewState{ValidSpec: mv},
+				},
+			},
+		}
+	}
+
+	claims := &SecurityClaims{
+		UserAttributes:   map[string]any{},
+		AllowedResources: []*runtimev1.ResourceName{{Kind: ResourceKindMetricsView, Name: "allowed"}},
+	}
+	p := newSecurityEngine(10, zap.NewNop())
+
+	got, err := p.resolveSecurity("", "test", claims, newResource("allowed"))
+	require.NoError(t, err)
+	require.True(t, got.CanAccess())
+
+	got, err = p.resolveSecurity("", "test", claims, newResource("other"))
+	require.NoError(t, err)
+	require.False(t, got.CanAccess())
+
+	// Names are matched case-insensitively
+	require.True(t, claims.CanAccessResource(&runtimev1.ResourceName{Kind: ResourceKindMetricsView, Name: "ALLOWED"}))
+	require.False(t, claims.CanAccessResource(&runtimev1.ResourceName{Kind: ResourceKindModel, Name: "allowed"}))
+
+	// Themes are always accessible
+	require.True(t, claims.CanAccessResource(&runtimev1.ResourceName{Kind: ResourceKindTheme, Name: "any"}))
+
+	// The allowed resources must survive a JSON roundtrip since claims are serialized in some places (e.g. download tokens)
+	data, err := claims.MarshalJSON()
+	require.NoError(t, err)
+	claims2 := &SecurityClaims{}
+	require.NoError(t, claims2.UnmarshalJSON(data))
+	require.True(t, claims2.CanAccessResource(&runtimev1.ResourceName{Kind: ResourceKindMetricsView, Name: "allowed"}))
+	require.False(t, claims2.CanAccessResource(&runtimev1.ResourceName{Kind: ResourceKindMetricsView, Name: "other"}))
+}
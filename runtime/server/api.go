@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/httputil"
@@ -28,9 +29,13 @@ func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 	s.addInstanceRequestAttributes(ctx, instanceID)
 
 	// Check if user has access to query for API data
-	if !auth.GetClaims(ctx).CanInstance(instanceID, auth.ReadAPI) {
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(instanceID, auth.ReadAPI) {
 		return httputil.Errorf(http.StatusForbidden, "does not have access to custom APIs")
 	}
+	if !claims.SecurityClaims().CanAccessResource(&runtimev1.ResourceName{Kind: runtime.ResourceKindAPI, Name: apiName}) {
+		return httputil.Errorf(http.StatusForbidden, "does not have access to the API %q", apiName)
+	}
 
 	// Parse args from the request body and URL query
 	args := make(map[string]any)
@@ -65,7 +70,7 @@ func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 		Resolver:           api.Spec.Resolver,
 		ResolverProperties: api.Spec.ResolverProperties.AsMap(),
 		Args:               args,
-		Claims:             auth.GetClaims(ctx).SecurityClaims(),
+		Claims:             claims.SecurityClaims(),
 	})
 	if err != nil {
 		return httputil.Error(http.StatusBadRequest, err)
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/apikey"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListAPIKeys implements RuntimeService.
+func (s *Server) ListAPIKeys(ctx context.Context, req *runtimev1.ListAPIKeysRequest) (*runtimev1.ListAPIKeysResponse, error) {
+	observability.AddRequestAttributes(ctx, attribute.String("args.instance_id", req.InstanceId))
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditInstance) {
+		return nil, ErrForbidden
+	}
+
+	keys, err := s.runtime.APIKeys(ctx, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	pbs := make([]*runtimev1.APIKey, len(keys))
+	for i, k := range keys {
+		pbs[i] = apiKeyToPB(k)
+	}
+
+	return &runtimev1.ListAPIKeysResponse{Keys: pbs}, nil
+}
+
+// CreateAPIKey implements RuntimeService.
+func (s *Server) CreateAPIKey(ctx context.Context, req *runtimev1.CreateAPIKeyRequest) (*runtimev1.CreateAPIKeyResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.name", req.Name),
+		attribute.StringSlice("args.permissions", req.Permissions),
+		attribute.Int("args.resources", len(req.Resources)),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.EditInstance) {
+		return nil, ErrForbidden
+	}
+
+	// The caller can't grant permissions that it doesn't have itself
+	for _, name := range req.Permissions {
+		p, err := auth.ParseInstancePermission(name)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if !claims.CanInstance(req.InstanceId, p) {
+			return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("can't grant permission %q", name))
+		}
+	}
+
+	// The caller can't grant access to resources that it can't access itself.
+	// If the caller is restricted to specific resources, the key must be restricted to a subset of them.
+	sec := claims.SecurityClaims()
+	if !sec.SkipChecks && len(sec.AllowedResources) > 0 {
+		if len(req.Resources) == 0 {
+			return nil, status.Error(codes.PermissionDenied, "can't create an unrestricted key from a resource-restricted caller")
+		}
+		for _, r := range req.Resources {
+			if !sec.CanAccessResource(r) {
+				return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("can't grant access to resource %q", r.Name))
+			}
+		}
+	}
+
+	key := apikey.NewRandom()
+	mdl := &drivers.APIKey{
+		ID:          key.ID.String(),
+		InstanceID:  req.InstanceId,
+		Name:        req.Name,
+		SecretHash:  key.SecretHash(),
+		Permissions: req.Permissions,
+		Resources:   req.Resources,
+	}
+	if req.TtlSeconds > 0 {
+		expiresOn := time.Now().Add(time.Duration(req.TtlSeconds) * time.Second)
+		mdl.ExpiresOn = &expiresOn
+	}
+
+	err := s.runtime.CreateAPIKey(ctx, mdl)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "instance not found")
+		}
+		return nil, err
+	}
+
+	return &runtimev1.CreateAPIKeyResponse{
+		Key:   apiKeyToPB(mdl),
+		Token: key.String(),
+	}, nil
+}
+
+// RevokeAPIKey implements RuntimeService.
+func (s *Server) RevokeAPIKey(ctx context.Context, req *runtimev1.RevokeAPIKeyRequest) (*runtimev1.RevokeAPIKeyResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.id", req.Id),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditInstance) {
+		return nil, ErrForbidden
+	}
+
+	key, err := s.runtime.APIKey(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "API key not found")
+		}
+		return nil, err
+	}
+	if key.InstanceID != req.InstanceId {
+		return nil, status.Error(codes.NotFound, "API key not found")
+	}
+
+	err = s.runtime.RevokeAPIKey(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1.RevokeAPIKeyResponse{}, nil
+}
+
+func apiKeyToPB(k *drivers.APIKey) *runtimev1.APIKey {
+	pb := &runtimev1.APIKey{
+		Id:          k.ID,
+		Name:        k.Name,
+		Permissions: k.Permissions,
+		Resources:   k.Resources,
+		CreatedOn:   timestamppb.New(k.CreatedOn),
+	}
+	if k.ExpiresOn != nil {
+		pb.ExpiresOn = timestamppb.New(*k.ExpiresOn)
+	}
+	return pb
+}
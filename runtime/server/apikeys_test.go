@@ -0,0 +1,86 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateAPIKeyResourceRestrictions(t *testing.T) {
+	server, instanceID := getTestServer(t)
+
+	mv := &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "ad_bids_metrics"}
+	other := &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "other"}
+
+	// A caller restricted to a single metrics view
+	ctx := auth.WithClaims(context.Background(), &restrictedClaims{
+		instanceID: instanceID,
+		resources:  []*runtimev1.ResourceName{mv},
+	})
+
+	// Can't mint an unrestricted key
+	_, err := server.CreateAPIKey(ctx, &runtimev1.CreateAPIKeyRequest{
+		InstanceId:  instanceID,
+		Name:        "unrestricted",
+		Permissions: []string{"read_metrics"},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Can't mint a key for a resource it can't access
+	_, err = server.CreateAPIKey(ctx, &runtimev1.CreateAPIKeyRequest{
+		InstanceId:  instanceID,
+		Name:        "other",
+		Permissions: []string{"read_metrics"},
+		Resources:   []*runtimev1.ResourceName{mv, other},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Can mint a key for a subset of its resources
+	res, err := server.CreateAPIKey(ctx, &runtimev1.CreateAPIKeyRequest{
+		InstanceId:  instanceID,
+		Name:        "subset",
+		Permissions: []string{"read_metrics"},
+		Resources:   []*runtimev1.ResourceName{mv},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Key.Resources, 1)
+
+	// An unrestricted caller can mint an unrestricted key
+	_, err = server.CreateAPIKey(testCtx(), &runtimev1.CreateAPIKeyRequest{
+		InstanceId:  instanceID,
+		Name:        "unrestricted",
+		Permissions: []string{"read_metrics"},
+	})
+	require.NoError(t, err)
+}
+
+// restrictedClaims implements auth.Claims for a caller that can edit an instance, but only access the listed resources.
+type restrictedClaims struct {
+	instanceID string
+	resources  []*runtimev1.ResourceName
+}
+
+func (c *restrictedClaims) Subject() string {
+	return "restricted"
+}
+
+func (c *restrictedClaims) Can(p auth.Permission) bool {
+	return false
+}
+
+func (c *restrictedClaims) CanInstance(instanceID string, p auth.Permission) bool {
+	return instanceID == c.instanceID
+}
+
+func (c *restrictedClaims) SecurityClaims() *runtime.SecurityClaims {
+	return &runtime.SecurityClaims{
+		UserAttributes:   map[string]any{"id": "restricted"},
+		AllowedResources: c.resources,
+	}
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/apikey"
+)
+
+// errInvalidAPIKey is returned for any API key that can't be authenticated.
+// We use the same error for unknown, revoked and mismatched keys to avoid leaking which keys exist.
+var errInvalidAPIKey = errors.New("invalid API key")
+
+// APIKeyStore looks up runtime API keys by ID. It is implemented by *runtime.Runtime.
+type APIKeyStore interface {
+	APIKey(ctx context.Context, id string) (*drivers.APIKey, error)
+}
+
+// ParseAPIKey authenticates a runtime API key and returns Claims for it if successful.
+func (a *Audience) ParseAPIKey(ctx context.Context, keyStr string) (Claims, error) {
+	if a.keys == nil {
+		return nil, errors.New("API key authentication is not enabled")
+	}
+
+	key, err := apikey.FromString(keyStr)
+	if err != nil {
+		return nil, err
+	}
+
+	mdl, err := a.keys.APIKey(ctx, key.ID.String())
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return nil, errInvalidAPIKey
+		}
+		return nil, err
+	}
+
+	if !key.Verify(mdl.SecretHash) {
+		return nil, errInvalidAPIKey
+	}
+
+	if mdl.ExpiresOn != nil && mdl.ExpiresOn.Before(time.Now()) {
+		return nil, errors.New("API key has expired")
+	}
+
+	return newAPIKeyClaims(mdl)
+}
+
+// apiKeyClaims implements Claims and resolves permissions based on a runtime API key.
+type apiKeyClaims struct {
+	key         *drivers.APIKey
+	permissions []Permission
+}
+
+var _ Claims = (*apiKeyClaims)(nil)
+
+func newAPIKeyClaims(key *drivers.APIKey) (*apiKeyClaims, error) {
+	ps := make([]Permission, len(key.Permissions))
+	for i, name := range key.Permissions {
+		p, err := ParseInstancePermission(name)
+		if err != nil {
+			return nil, err
+		}
+		ps[i] = p
+	}
+	return &apiKeyClaims{key: key, permissions: ps}, nil
+}
+
+func (c *apiKeyClaims) Subject() string {
+	return c.key.ID
+}
+
+func (c *apiKeyClaims) Can(p Permission) bool {
+	// API keys never grant system-level permissions
+	return false
+}
+
+func (c *apiKeyClaims) CanInstance(instanceID string, p Permission) bool {
+	if instanceID != c.key.InstanceID {
+		return false
+	}
+	for _, p2 := range c.permissions {
+		if p2 == p {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *apiKeyClaims) SecurityClaims() *runtime.SecurityClaims {
+	return &runtime.SecurityClaims{
+		UserAttributes: map[string]any{
+			"id":   c.key.ID,
+			"name": c.key.Name,
+		},
+		AllowedResources: c.key.Resources,
+	}
+}
@@ -28,6 +28,13 @@ type jwtClaims struct {
 	Instances map[string][]Permission `json:"ins,omitempty"`
 	Attrs     map[string]any          `json:"attr,omitempty"`
 	Security  []json.RawMessage       `json:"sec,omitempty"` // []*runtimev1.SecurityRule serialized with protojson
+	Resources []resourceClaim         `json:"res,omitempty"`
+}
+
+// resourceClaim is a resource that a token is restricted to.
+type resourceClaim struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
 }
 
 var _ Claims = (*jwtClaims)(nil)
@@ -81,9 +88,18 @@ func (c *jwtClaims) SecurityClaims() *runtime.SecurityClaims {
 		}
 	}
 
+	var resources []*runtimev1.ResourceName
+	if len(c.Resources) > 0 {
+		resources = make([]*runtimev1.ResourceName, len(c.Resources))
+		for i, r := range c.Resources {
+			resources[i] = &runtimev1.ResourceName{Kind: r.Kind, Name: r.Name}
+		}
+	}
+
 	return &runtime.SecurityClaims{
-		UserAttributes:  attrs,
-		AdditionalRules: rules,
+		UserAttributes:   attrs,
+		AdditionalRules:  rules,
+		AllowedResources: resources,
 	}
 }
 
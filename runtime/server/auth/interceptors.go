@@ -10,10 +10,13 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rilldata/rill/runtime/pkg/apikey"
 	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -36,6 +39,7 @@ func GetClaims(ctx context.Context) Claims {
 // The claim parsing logic is as follows
 // - When aud is nil, auth is considered disabled. We set a Claims that allows all actions (openClaims).
 // - When aud is not nil, we set a Claims based on a JWT set as a bearer token in the authorization header (jwtClaims).
+// - When aud is not nil and the bearer token is a runtime API key, we set a Claims based on the key's permissions (apiKeyClaims).
 // - When aud is not nil and no authorization header is passed, we set a Claims that denies any action (anonClaims).
 func UnaryServerInterceptor(aud *Audience) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
@@ -65,6 +69,32 @@ func StreamServerInterceptor(aud *Audience) grpc.StreamServerInterceptor {
 	}
 }
 
+// ClientCertUnaryServerInterceptor strips system-level permissions from the claims of requests that were not made over a
+// connection with a verified client certificate. It restricts control-plane calls (such as creating instances) to peers
+// authenticated with mutual TLS. It must run after UnaryServerInterceptor. If required is false, it does nothing.
+func ClientCertUnaryServerInterceptor(required bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if required {
+			ctx = restrictUnverifiedPeer(ctx)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ClientCertStreamServerInterceptor is the streaming variant of ClientCertUnaryServerInterceptor.
+func ClientCertStreamServerInterceptor(required bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !required {
+			return handler(srv, ss)
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = restrictUnverifiedPeer(ss.Context())
+
+		return handler(srv, wrapped)
+	}
+}
+
 // GatewayMiddleware is a gRPC-gateway middleware variant of UnaryServerInterceptor.
 // It should be used for non-gRPC HTTP endpoints mounted directly on the gRPC-gateway mux.
 func GatewayMiddleware(aud *Audience, next gateway.HandlerFunc) gateway.HandlerFunc {
@@ -112,6 +142,10 @@ func parseClaims(ctx context.Context, aud *Audience, authorizationHeader string)
 		if bearerToken == "" {
 			return nil, errors.New("no bearer token found in authorization header")
 		}
+		// API keys don't carry user attributes, so there's nothing to mimic
+		if apikey.IsAPIKey(bearerToken) {
+			return context.WithValue(ctx, claimsContextKey{}, openClaims{}), nil
+		}
 		_, _, err := jwt.NewParser().ParseUnverified(bearerToken, claims)
 		if err != nil {
 			return nil, err
@@ -134,6 +168,15 @@ func parseClaims(ctx context.Context, aud *Audience, authorizationHeader string)
 		return nil, errors.New("no bearer token found in authorization header")
 	}
 
+	// Handle runtime API keys
+	if apikey.IsAPIKey(bearerToken) {
+		claims, err := aud.ParseAPIKey(ctx, bearerToken)
+		if err != nil {
+			return nil, err
+		}
+		return context.WithValue(ctx, claimsContextKey{}, claims), nil
+	}
+
 	// Parse, validate and set claims from JWT
 	claims, err := aud.ParseAndValidate(bearerToken)
 	if err != nil {
@@ -156,6 +199,29 @@ func parseClaims(ctx context.Context, aud *Audience, authorizationHeader string)
 	return ctx, nil
 }
 
+// restrictUnverifiedPeer removes system-level permissions from the JWT claims in ctx unless the peer presented a verified client certificate.
+func restrictUnverifiedPeer(ctx context.Context) context.Context {
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.VerifiedChains) > 0 {
+			return ctx
+		}
+	}
+
+	claims, ok := GetClaims(ctx).(*jwtClaims)
+	if !ok || len(claims.System) == 0 {
+		return ctx
+	}
+
+	restricted := *claims
+	restricted.System = nil
+	return context.WithValue(ctx, claimsContextKey{}, &restricted)
+}
+
+// WithClaims wraps a context with the given claims. It's used for testing.
+func WithClaims(ctx context.Context, claims Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
 // WithOpen wraps a context with open claims. It's used for testing.
 // NOTE: We should remove this when the server tests support interceptors.
 func WithOpen(ctx context.Context) context.Context {
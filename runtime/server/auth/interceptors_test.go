@@ -1,13 +1,21 @@
 package auth
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/apikey"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 )
 
 func TestMiddleware(t *testing.T) {
@@ -65,4 +73,88 @@ func TestMiddleware(t *testing.T) {
 		handler.ServeHTTP(httptest.NewRecorder(), req)
 	})
 
+	t.Run("APIKey", func(t *testing.T) {
+		key := apikey.NewRandom()
+		store := testAPIKeyStore{key.ID.String(): &drivers.APIKey{
+			ID:          key.ID.String(),
+			InstanceID:  "default",
+			SecretHash:  key.SecretHash(),
+			Permissions: []string{"read_metrics"},
+		}}
+		aud := NewAPIKeyAudience(store)
+
+		var called bool
+		handler := HTTPMiddleware(aud, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			claims := GetClaims(r.Context())
+			require.NotNil(t, claims)
+			require.Equal(t, key.ID.String(), claims.Subject())
+			require.False(t, claims.Can(ManageInstances))
+			require.True(t, claims.CanInstance("default", ReadMetrics))
+			require.False(t, claims.CanInstance("default", ReadOLAP))
+			require.False(t, claims.CanInstance("other", ReadMetrics))
+		}))
+
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Header["Authorization"] = []string{fmt.Sprintf("Bearer %s", key.String())}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		require.True(t, called)
+
+		// A key with an unknown ID or the wrong secret is rejected
+		req = httptest.NewRequest("GET", "/", nil)
+		req.Header["Authorization"] = []string{fmt.Sprintf("Bearer %s", apikey.NewRandom().String())}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		// JWTs are rejected when no issuer is configured
+		req = httptest.NewRequest("GET", "/", nil)
+		req.Header["Authorization"] = []string{"Bearer eyJhbGciOiJSUzI1NiJ9.e30.c2ln"}
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+type testAPIKeyStore map[string]*drivers.APIKey
+
+func (s testAPIKeyStore) APIKey(ctx context.Context, id string) (*drivers.APIKey, error) {
+	k, ok := s[id]
+	if !ok {
+		return nil, drivers.ErrNotFound
+	}
+	return k, nil
+}
+
+func TestClientCertInterceptor(t *testing.T) {
+	claims := &jwtClaims{
+		System:    []Permission{ManageInstances},
+		Instances: map[string][]Permission{"default": {ReadOLAP}},
+	}
+	ctx := context.WithValue(context.Background(), claimsContextKey{}, claims)
+
+	check := func(ctx context.Context, required, canManage bool) {
+		interceptor := ClientCertUnaryServerInterceptor(required)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims := GetClaims(ctx)
+			require.Equal(t, canManage, claims.Can(ManageInstances))
+			require.True(t, claims.CanInstance("default", ReadOLAP))
+			return nil, nil
+		})
+		require.NoError(t, err)
+	}
+
+	// Not required
+	check(ctx, false, true)
+
+	// Required, but no verified client certificate
+	check(ctx, true, false)
+	check(peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{}}), true, false)
+
+	// Required and verified client certificate
+	verified := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{}}}}}
+	check(peer.NewContext(ctx, &peer.Peer{AuthInfo: verified}), true, true)
+
+	// The original claims are not modified
+	require.True(t, claims.Can(ManageInstances))
 }
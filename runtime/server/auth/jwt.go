@@ -115,6 +115,8 @@ type TokenOptions struct {
 	InstancePermissions map[string][]Permission
 	Attributes          map[string]any
 	SecurityRules       []*runtimev1.SecurityRule
+	// AllowedResources optionally restricts the token to only the listed resources (such as specific metrics views or APIs).
+	AllowedResources []*runtimev1.ResourceName
 }
 
 // NewToken issues a new JWT based on the provided options.
@@ -132,6 +134,14 @@ func (i *Issuer) NewToken(opts TokenOptions) (string, error) {
 		}
 	}
 
+	var resources []resourceClaim
+	if len(opts.AllowedResources) > 0 {
+		resources = make([]resourceClaim, len(opts.AllowedResources))
+		for i, r := range opts.AllowedResources {
+			resources[i] = resourceClaim{Kind: r.Kind, Name: r.Name}
+		}
+	}
+
 	// Create claims
 	now := time.Now()
 	claims := &jwtClaims{
@@ -147,6 +157,7 @@ func (i *Issuer) NewToken(opts TokenOptions) (string, error) {
 		Instances: opts.InstancePermissions,
 		Attrs:     opts.Attributes,
 		Security:  sec,
+		Resources: resources,
 	}
 
 	// Create token
@@ -173,10 +184,12 @@ func (i *Issuer) WellKnownHandler() http.Handler {
 // The Audience is used by the runtime to parse claims from a JWT.
 // It parses and validates tokens and resolves permissions.
 // It refreshes its JWKS in the background from {issuerURL}/.well-known/jwks.json.
+// If an APIKeyStore has been configured, it also accepts runtime API keys (see ParseAPIKey).
 type Audience struct {
 	issuerURL   string
 	audienceURL string
 	jwks        *keyfunc.JWKS
+	keys        APIKeyStore
 }
 
 // OpenAudience creates an Audience. Remember to call Close() when done.
@@ -232,13 +245,30 @@ func OpenAudience(ctx context.Context, logger *zap.Logger, issuerURL, audienceUR
 	}, nil
 }
 
+// NewAPIKeyAudience creates an Audience that only accepts runtime API keys.
+// It is used for self-hosted runtimes that are not managed by an admin service issuing JWTs.
+func NewAPIKeyAudience(keys APIKeyStore) *Audience {
+	return &Audience{keys: keys}
+}
+
+// SetAPIKeyStore enables authentication with runtime API keys looked up in the provided store.
+func (a *Audience) SetAPIKeyStore(keys APIKeyStore) {
+	a.keys = keys
+}
+
 // Close stops background refresh of the JWKS.
 func (a *Audience) Close() {
-	a.jwks.EndBackground()
+	if a.jwks != nil {
+		a.jwks.EndBackground()
+	}
 }
 
 // ParseAndValidate parses and validates a JWT and returns Claims if successful.
 func (a *Audience) ParseAndValidate(tokenStr string) (Claims, error) {
+	if a.jwks == nil {
+		return nil, fmt.Errorf("JWT authentication is not enabled")
+	}
+
 	claims := &jwtClaims{}
 
 	_, err := jwt.ParseWithClaims(tokenStr, claims, a.jwks.Keyfunc) // NOTE: also validates claims
@@ -7,6 +7,8 @@ import (
 	"testing"
 	"time"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -34,6 +36,24 @@ func TestTokens(t *testing.T) {
 		require.False(t, claims.CanInstance("unknown", ReadOLAP))
 	})
 
+	t.Run("Allowed resources", func(t *testing.T) {
+		token, err := iss.NewToken(TokenOptions{
+			AudienceURL:         aud.audienceURL,
+			Subject:             "alice",
+			TTL:                 time.Duration(time.Hour),
+			InstancePermissions: map[string][]Permission{"example": {ReadMetrics}},
+			AllowedResources:    []*runtimev1.ResourceName{{Kind: runtime.ResourceKindMetricsView, Name: "ad_bids"}},
+		})
+		require.NoError(t, err)
+
+		claims, err := aud.ParseAndValidate(token)
+		require.NoError(t, err)
+		sec := claims.SecurityClaims()
+		require.True(t, sec.CanAccessResource(&runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "AD_BIDS"}))
+		require.False(t, sec.CanAccessResource(&runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "other"}))
+		require.False(t, sec.CanAccessResource(&runtimev1.ResourceName{Kind: runtime.ResourceKindAPI, Name: "ad_bids"}))
+	})
+
 	t.Run("Expired", func(t *testing.T) {
 		token, err := iss.NewToken(TokenOptions{
 			AudienceURL:         aud.audienceURL,
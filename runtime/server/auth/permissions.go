@@ -1,5 +1,7 @@
 package auth
 
+import "fmt"
+
 // Permission represents runtime access permissions.
 type Permission int
 
@@ -18,3 +20,26 @@ const (
 	ReadProfiling Permission = 0x18
 	ReadAPI       Permission = 0x19
 )
+
+// instancePermissionNames maps the external names of instance-level permissions to their values.
+// The names are used to reference permissions in APIs, such as when creating API keys.
+var instancePermissionNames = map[string]Permission{
+	"read_instance":  ReadInstance,
+	"edit_instance":  EditInstance,
+	"read_repo":      ReadRepo,
+	"edit_repo":      EditRepo,
+	"read_objects":   ReadObjects,
+	"read_olap":      ReadOLAP,
+	"read_metrics":   ReadMetrics,
+	"read_profiling": ReadProfiling,
+	"read_api":       ReadAPI,
+}
+
+// ParseInstancePermission parses the name of an instance-level permission, such as "read_metrics".
+func ParseInstancePermission(name string) (Permission, error) {
+	p, ok := instancePermissionNames[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid instance permission %q", name)
+	}
+	return p, nil
+}
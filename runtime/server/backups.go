@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListBackups implements runtimev1.RuntimeServiceServer
+func (s *Server) ListBackups(ctx context.Context, req *runtimev1.ListBackupsRequest) (*runtimev1.ListBackupsResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditInstance) {
+		return nil, ErrForbidden
+	}
+
+	backups, err := s.runtime.ListBackups(ctx, req.InstanceId)
+	if err != nil {
+		return nil, backupErrorToStatus(err)
+	}
+
+	res := make([]*runtimev1.Backup, len(backups))
+	for i, b := range backups {
+		res[i] = backupToPB(b)
+	}
+
+	return &runtimev1.ListBackupsResponse{Backups: res}, nil
+}
+
+// CreateBackup implements runtimev1.RuntimeServiceServer
+func (s *Server) CreateBackup(ctx context.Context, req *runtimev1.CreateBackupRequest) (*runtimev1.CreateBackupResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.Bool("args.snapshot", req.Snapshot),
+	)
+
+	if !auth.GetClaims(ctx).Can(auth.ManageInstances) {
+		return nil, ErrForbidden
+	}
+
+	var b *runtime.Backup
+	var err error
+	if req.Snapshot {
+		b, err = s.runtime.SnapshotInstance(ctx, req.InstanceId)
+	} else {
+		b, err = s.runtime.BackupInstance(ctx, req.InstanceId)
+	}
+	if err != nil {
+		return nil, backupErrorToStatus(err)
+	}
+
+	return &runtimev1.CreateBackupResponse{Backup: backupToPB(b)}, nil
+}
+
+// RestoreBackup implements runtimev1.RuntimeServiceServer
+func (s *Server) RestoreBackup(ctx context.Context, req *runtimev1.RestoreBackupRequest) (*runtimev1.RestoreBackupResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.backup_id", req.BackupId),
+	)
+
+	if !auth.GetClaims(ctx).Can(auth.ManageInstances) {
+		return nil, ErrForbidden
+	}
+
+	b, err := s.runtime.RestoreInstance(ctx, req.InstanceId, req.BackupId)
+	if err != nil {
+		return nil, backupErrorToStatus(err)
+	}
+
+	return &runtimev1.RestoreBackupResponse{Backup: backupToPB(b)}, nil
+}
+
+// ResumeInstance implements runtimev1.RuntimeServiceServer
+func (s *Server) ResumeInstance(ctx context.Context, req *runtimev1.ResumeInstanceRequest) (*runtimev1.ResumeInstanceResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+	)
+
+	if !auth.GetClaims(ctx).Can(auth.ManageInstances) {
+		return nil, ErrForbidden
+	}
+
+	err := s.runtime.ResumeInstance(ctx, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1.ResumeInstanceResponse{}, nil
+}
+
+func backupToPB(b *runtime.Backup) *runtimev1.Backup {
+	tables := make([]string, len(b.Tables))
+	for i, t := range b.Tables {
+		tables[i] = t.Name
+	}
+	return &runtimev1.Backup{
+		Id:        b.ID,
+		CreatedOn: timestamppb.New(b.CreatedOn),
+		Tables:    tables,
+		SizeBytes: b.SizeBytes(),
+		Snapshot:  b.Catalog != "",
+	}
+}
+
+func backupErrorToStatus(err error) error {
+	if errors.Is(err, runtime.ErrBackupsNotConfigured) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, drivers.ErrNotFound) {
+		return status.Error(codes.NotFound, "backup not found")
+	}
+	return err
+}
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ListResources implements runtimev1.RuntimeServiceServer
@@ -178,6 +179,130 @@ func (s *Server) GetResource(ctx context.Context, req *runtimev1.GetResourceRequ
 	return &runtimev1.GetResourceResponse{Resource: r}, nil
 }
 
+// ListMetricsViewVersions implements runtimev1.RuntimeServiceServer
+func (s *Server) ListMetricsViewVersions(ctx context.Context, req *runtimev1.ListMetricsViewVersionsRequest) (*runtimev1.ListMetricsViewVersionsResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.metrics_view", req.MetricsViewName),
+		attribute.Int("args.limit", int(req.Limit)),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadObjects) {
+		return nil, ErrForbidden
+	}
+
+	ctrl, err := s.runtime.Controller(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: req.MetricsViewName}, false)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, status.Error(codes.NotFound, "metrics view not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, access, err := s.applySecurityPolicy(ctx, req.InstanceId, r)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !access {
+		return nil, status.Error(codes.NotFound, "metrics view not found")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 20
+	}
+
+	catalog, release, err := s.runtime.Catalog(ctx, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Fetch one extra version to compute the changes of the oldest returned version.
+	vs, err := catalog.FindMetricsViewVersions(ctx, r.Meta.Name.Name, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make([]*runtimev1.StructType, len(vs))
+	for i, v := range vs {
+		schemas[i] = &runtimev1.StructType{}
+		err := proto.Unmarshal(v.Schema, schemas[i])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal schema: %s", err.Error())
+		}
+	}
+
+	res := make([]*runtimev1.MetricsViewVersion, 0, limit)
+	for i, v := range vs {
+		if i == limit {
+			break
+		}
+		pb := &runtimev1.MetricsViewVersion{
+			RefreshedOn: timestamppb.New(v.RefreshedOn),
+			RowCount:    v.RowCount,
+			Schema:      schemas[i],
+		}
+		if i+1 < len(vs) {
+			pb.RowCountDelta = v.RowCount - vs[i+1].RowCount
+			pb.SchemaChanges = schemaChanges(schemas[i+1], schemas[i])
+		}
+		res = append(res, pb)
+	}
+
+	return &runtimev1.ListMetricsViewVersionsResponse{Versions: res}, nil
+}
+
+// schemaChanges returns the column changes from prev to curr.
+// Added and changed columns are returned in the order of curr, followed by removed columns in the order of prev.
+func schemaChanges(prev, curr *runtimev1.StructType) []*runtimev1.MetricsViewSchemaChange {
+	prevTypes := make(map[string]*runtimev1.Type, len(prev.Fields))
+	for _, f := range prev.Fields {
+		prevTypes[f.Name] = f.Type
+	}
+
+	var res []*runtimev1.MetricsViewSchemaChange
+	currNames := make(map[string]bool, len(curr.Fields))
+	for _, f := range curr.Fields {
+		currNames[f.Name] = true
+		pt, ok := prevTypes[f.Name]
+		if !ok {
+			res = append(res, &runtimev1.MetricsViewSchemaChange{
+				Kind:   runtimev1.MetricsViewSchemaChange_KIND_ADDED,
+				Column: f.Name,
+				Type:   f.Type,
+			})
+			continue
+		}
+		if !proto.Equal(pt, f.Type) {
+			res = append(res, &runtimev1.MetricsViewSchemaChange{
+				Kind:         runtimev1.MetricsViewSchemaChange_KIND_TYPE_CHANGED,
+				Column:       f.Name,
+				Type:         f.Type,
+				PreviousType: pt,
+			})
+		}
+	}
+
+	for _, f := range prev.Fields {
+		if !currNames[f.Name] {
+			res = append(res, &runtimev1.MetricsViewSchemaChange{
+				Kind:         runtimev1.MetricsViewSchemaChange_KIND_REMOVED,
+				Column:       f.Name,
+				PreviousType: f.Type,
+			})
+		}
+	}
+
+	return res
+}
+
 // CreateTrigger implements runtimev1.RuntimeServiceServer
 func (s *Server) CreateTrigger(ctx context.Context, req *runtimev1.CreateTriggerRequest) (*runtimev1.CreateTriggerResponse, error) {
 	s.addInstanceRequestAttributes(ctx, req.InstanceId)
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaChanges(t *testing.T) {
+	typ := func(code runtimev1.Type_Code) *runtimev1.Type {
+		return &runtimev1.Type{Code: code, Nullable: true}
+	}
+	prev := &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+		{Name: "a", Type: typ(runtimev1.Type_CODE_INT64)},
+		{Name: "b", Type: typ(runtimev1.Type_CODE_STRING)},
+		{Name: "c", Type: typ(runtimev1.Type_CODE_FLOAT64)},
+	}}
+	curr := &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+		{Name: "d", Type: typ(runtimev1.Type_CODE_BOOL)},
+		{Name: "a", Type: typ(runtimev1.Type_CODE_INT64)},
+		{Name: "c", Type: typ(runtimev1.Type_CODE_DECIMAL)},
+	}}
+
+	changes := schemaChanges(prev, curr)
+	require.Len(t, changes, 3)
+
+	require.Equal(t, runtimev1.MetricsViewSchemaChange_KIND_ADDED, changes[0].Kind)
+	require.Equal(t, "d", changes[0].Column)
+	require.Equal(t, runtimev1.Type_CODE_BOOL, changes[0].Type.Code)
+	require.Nil(t, changes[0].PreviousType)
+
+	require.Equal(t, runtimev1.MetricsViewSchemaChange_KIND_TYPE_CHANGED, changes[1].Kind)
+	require.Equal(t, "c", changes[1].Column)
+	require.Equal(t, runtimev1.Type_CODE_DECIMAL, changes[1].Type.Code)
+	require.Equal(t, runtimev1.Type_CODE_FLOAT64, changes[1].PreviousType.Code)
+
+	require.Equal(t, runtimev1.MetricsViewSchemaChange_KIND_REMOVED, changes[2].Kind)
+	require.Equal(t, "b", changes[2].Column)
+	require.Nil(t, changes[2].Type)
+
+	require.Empty(t, schemaChanges(curr, curr))
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// GetDataDiff implements runtimev1.RuntimeServiceServer
+func (s *Server) GetDataDiff(ctx context.Context, req *runtimev1.GetDataDiffRequest) (*runtimev1.GetDataDiffResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.base_instance_id", req.BaseInstanceId),
+		attribute.String("args.name.kind", req.Name.GetKind()),
+		attribute.String("args.name.name", req.Name.GetName()),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadOLAP) || !claims.CanInstance(req.BaseInstanceId, auth.ReadOLAP) {
+		return nil, ErrForbidden
+	}
+
+	if req.Name == nil || (req.Name.Kind != runtime.ResourceKindModel && req.Name.Kind != runtime.ResourceKindMetricsView) {
+		return nil, status.Error(codes.InvalidArgument, "name must reference a model or metrics view")
+	}
+
+	curr, err := s.resolveDataDiffSide(ctx, req.InstanceId, req.Name, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+	base, err := s.resolveDataDiffSide(ctx, req.BaseInstanceId, req.Name, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &runtimev1.GetDataDiffResponse{
+		RowCount:      curr.rowCount,
+		BaseRowCount:  base.rowCount,
+		SchemaChanges: schemaChanges(base.schema, curr.schema),
+	}
+
+	// Include the union of the measures in both instances, in the instance's order followed by measures that only exist in the base.
+	names := append([]string{}, curr.measureNames...)
+	for _, n := range base.measureNames {
+		if _, ok := curr.measures[n]; !ok {
+			names = append(names, n)
+		}
+	}
+	for _, n := range names {
+		m := &runtimev1.DataDiffMeasure{
+			Name:      n,
+			Value:     curr.measures[n],
+			BaseValue: base.measures[n],
+		}
+		if m.Value != nil && m.BaseValue != nil {
+			delta := *m.Value - *m.BaseValue
+			m.Delta = &delta
+		}
+		res.Measures = append(res.Measures, m)
+	}
+
+	return res, nil
+}
+
+// dataDiffSide describes the output of a model or metrics view in one of the instances being compared.
+type dataDiffSide struct {
+	rowCount     int64
+	schema       *runtimev1.StructType
+	measureNames []string
+	measures     map[string]*float64 // Nil values for non-numeric totals
+}
+
+// resolveDataDiffSide computes the row count, schema and (for metrics views) measure totals of a resource in an instance.
+func (s *Server) resolveDataDiffSide(ctx context.Context, instanceID string, name *runtimev1.ResourceName, priority int) (*dataDiffSide, error) {
+	ctrl, err := s.runtime.Controller(ctx, instanceID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r, err := ctrl.Get(ctx, name, false)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "resource not found in instance %q", instanceID)
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var connector, db, schema, table string
+	var mv *runtimev1.MetricsViewSpec
+	switch name.Kind {
+	case runtime.ResourceKindModel:
+		state := r.GetModel().State
+		if state.ResultTable == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "model has no output in instance %q", instanceID)
+		}
+		connector, table = state.ResultConnector, state.ResultTable
+	case runtime.ResourceKindMetricsView:
+		mv = r.GetMetricsView().State.ValidSpec
+		if mv == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "metrics view is not valid in instance %q", instanceID)
+		}
+		connector, db, schema, table = mv.Connector, mv.Database, mv.DatabaseSchema, mv.Table
+	}
+
+	olap, release, err := s.runtime.OLAP(ctx, instanceID, connector)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	tbl, err := olap.InformationSchema().Lookup(ctx, db, schema, table)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT COUNT(*) FROM %s", olap.Dialect().EscapeTable(db, schema, table)),
+		Priority: priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var rowCount int64
+	for rows.Next() {
+		if err := rows.Scan(&rowCount); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+	}
+	err = rows.Err()
+	_ = rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dataDiffSide{
+		rowCount: rowCount,
+		schema:   tbl.Schema,
+		measures: map[string]*float64{},
+	}
+	if mv == nil {
+		return res, nil
+	}
+
+	// Time comparison measures are excluded since totals have no comparison time range.
+	for _, m := range mv.Measures {
+		if m.Type != runtimev1.MetricsViewSpec_MEASURE_TYPE_TIME_COMPARISON {
+			res.measureNames = append(res.measureNames, m.Name)
+		}
+	}
+	if len(res.measureNames) == 0 {
+		return res, nil
+	}
+
+	q := &queries.MetricsViewTotals{
+		MetricsViewName: name.Name,
+		MeasureNames:    res.measureNames,
+		SecurityClaims:  auth.GetClaims(ctx).SecurityClaims(),
+	}
+	err = s.runtime.Query(ctx, instanceID, q, priority)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range res.measureNames {
+		res.measures[n] = nil
+		if v, ok := q.Result.Data.GetFields()[n]; ok {
+			if _, ok := v.GetKind().(*structpb.Value_NumberValue); ok {
+				f := v.GetNumberValue()
+				res.measures[n] = &f
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,88 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDataDiff(t *testing.T) {
+	rt, baseID := testruntime.NewInstance(t)
+
+	// Create a second instance on the same runtime to compare against the first
+	inst := &drivers.Instance{
+		Environment:      "test",
+		OLAPConnector:    "duckdb",
+		RepoConnector:    "repo",
+		CatalogConnector: "catalog",
+		Connectors: []*runtimev1.Connector{
+			{Type: "file", Name: "repo", Config: map[string]string{"dsn": t.TempDir()}},
+			{Type: "duckdb", Name: "duckdb", Config: map[string]string{"dsn": ":memory:"}},
+			{Type: "sqlite", Name: "catalog", Config: map[string]string{"dsn": fmt.Sprintf("file:%s_2?mode=memory&cache=shared", t.Name())}},
+		},
+	}
+	require.NoError(t, rt.CreateInstance(context.Background(), inst))
+	id := inst.ID
+
+	mv := `
+type: metrics_view
+model: m1
+dimensions:
+  - column: country
+measures:
+  - name: total
+    expression: SUM(amount)
+`
+	testruntime.PutFiles(t, rt, baseID, map[string]string{
+		"rill.yaml":         "",
+		"/models/m1.sql":    `SELECT * FROM (VALUES ('US', 10), ('DK', 5)) t(country, amount)`,
+		"/metrics/mv1.yaml": mv,
+	})
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"rill.yaml":         "",
+		"/models/m1.sql":    `SELECT * FROM (VALUES ('US', 10, true), ('DK', 5, false), ('SE', 7, true)) t(country, amount, active)`,
+		"/metrics/mv1.yaml": mv,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, baseID)
+	testruntime.ReconcileParserAndWait(t, rt, id)
+
+	srv, err := server.NewServer(context.Background(), &server.Options{}, rt, nil, ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	res, err := srv.GetDataDiff(testCtx(), &runtimev1.GetDataDiffRequest{
+		InstanceId:     id,
+		BaseInstanceId: baseID,
+		Name:           &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: "mv1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.RowCount)
+	require.Equal(t, int64(2), res.BaseRowCount)
+	require.Len(t, res.SchemaChanges, 1)
+	require.Equal(t, runtimev1.MetricsViewSchemaChange_KIND_ADDED, res.SchemaChanges[0].Kind)
+	require.Equal(t, "active", res.SchemaChanges[0].Column)
+	require.Len(t, res.Measures, 1)
+	require.Equal(t, "total", res.Measures[0].Name)
+	require.Equal(t, 22.0, res.Measures[0].GetValue())
+	require.Equal(t, 15.0, res.Measures[0].GetBaseValue())
+	require.Equal(t, 7.0, res.Measures[0].GetDelta())
+
+	res, err = srv.GetDataDiff(testCtx(), &runtimev1.GetDataDiffRequest{
+		InstanceId:     id,
+		BaseInstanceId: baseID,
+		Name:           &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "m1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.RowCount)
+	require.Equal(t, int64(2), res.BaseRowCount)
+	require.Len(t, res.SchemaChanges, 1)
+	require.Empty(t, res.Measures)
+}
@@ -16,6 +16,8 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/exportfilename"
+	"github.com/rilldata/rill/runtime/pkg/locale"
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"google.golang.org/grpc/codes"
@@ -76,6 +78,14 @@ func (s *Server) Export(ctx context.Context, req *runtimev1.ExportRequest) (*run
 		req.BakedQuery = ""
 	}
 
+	if err := exportfilename.Validate(req.FilenameTemplate); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := locale.Validate(req.Locale); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	tkn, err := s.generateDownloadToken(req, auth.GetClaims(ctx).SecurityClaims())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate download token: %s", err.Error())
@@ -128,9 +138,12 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			Limit:               limitPtr,
 			Offset:              r.Offset,
 			PivotOn:             r.PivotOn,
+			PivotColumns:        r.PivotColumns,
 			SecurityClaims:      claims,
 			Aliases:             r.Aliases,
 			Exact:               r.Exact,
+
+			ExecutionTimeoutSeconds: r.ExecutionTimeoutSeconds,
 		}
 	case *runtimev1.Query_MetricsViewToplistRequest:
 		r := v.MetricsViewToplistRequest
@@ -214,6 +227,8 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			Where:               r.Where,
 			Having:              r.Having,
 			SecurityClaims:      claims,
+
+			ExecutionTimeoutSeconds: r.ExecutionTimeoutSeconds,
 		}
 	case *runtimev1.Query_TableRowsRequest:
 		r := v.TableRowsRequest
@@ -232,11 +247,21 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	var user string
+	if claims != nil {
+		user = claims.UserEmail()
+	}
+
 	err = q.Export(req.Context(), s.runtime, request.InstanceId, w, &runtime.ExportOptions{
-		Format: request.Format,
+		Format:           request.Format,
+		FilenameTemplate: request.FilenameTemplate,
+		FilenameUser:     user,
+		Locale:           request.Locale,
 		PreWriteHook: func(filename string) error {
-			// Add timestamp to filename
-			filename += "_" + time.Now().Format("20060102150405")
+			// Add timestamp to filename, unless the filename is determined by a template
+			if request.FilenameTemplate == "" {
+				filename += "_" + time.Now().Format("20060102150405")
+			}
 
 			// Write HTTP headers
 			w.Header().Set("X-Content-Type-Options", "nosniff")
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errDraining is returned for new requests received while the runtime is shutting down.
+var errDraining = status.Error(codes.Unavailable, "runtime is shutting down")
+
+// drainUnaryServerInterceptor rejects new requests once the runtime has started draining.
+// Requests that were already in-flight are allowed to finish. Health checks are always served.
+func (s *Server) drainUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if s.runtime.Draining() && !isHealthMethod(info.FullMethod) {
+			return nil, errDraining
+		}
+		return handler(ctx, req)
+	}
+}
+
+// drainStreamServerInterceptor is a stream variant of drainUnaryServerInterceptor.
+func (s *Server) drainStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if s.runtime.Draining() && !isHealthMethod(info.FullMethod) {
+			return errDraining
+		}
+		return handler(srv, ss)
+	}
+}
+
+func isHealthMethod(fullMethodName string) bool {
+	switch fullMethodName {
+	case runtimev1.RuntimeService_Ping_FullMethodName, runtimev1.RuntimeService_Health_FullMethodName, runtimev1.RuntimeService_InstanceHealth_FullMethodName:
+		return true
+	}
+	return false
+}
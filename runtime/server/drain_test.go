@@ -0,0 +1,67 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDrainInterceptors(t *testing.T) {
+	rt, _ := testruntime.NewInstance(t)
+	srv, err := server.NewServer(context.Background(), &server.Options{}, rt, nil, ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	unary := srv.DrainUnaryServerInterceptor()
+	unaryHandler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	callUnary := func(method string) error {
+		_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, unaryHandler)
+		return err
+	}
+
+	stream := srv.DrainStreamServerInterceptor()
+	streamHandler := func(srv interface{}, stream grpc.ServerStream) error { return nil }
+	callStream := func(method string) error {
+		return stream(nil, nil, &grpc.StreamServerInfo{FullMethod: method}, streamHandler)
+	}
+
+	// Before draining, all requests are served
+	require.NoError(t, callUnary(runtimev1.RuntimeService_ListResources_FullMethodName))
+	require.NoError(t, callStream(runtimev1.RuntimeService_WatchResources_FullMethodName))
+
+	rt.Drain()
+
+	// While draining, new requests are rejected
+	require.Equal(t, codes.Unavailable, status.Code(callUnary(runtimev1.RuntimeService_ListResources_FullMethodName)))
+	require.Equal(t, codes.Unavailable, status.Code(callStream(runtimev1.RuntimeService_WatchResources_FullMethodName)))
+
+	// Health checks are still served
+	require.NoError(t, callUnary(runtimev1.RuntimeService_Health_FullMethodName))
+	require.NoError(t, callStream(runtimev1.RuntimeService_Health_FullMethodName))
+}
+
+func TestDrainReadiness(t *testing.T) {
+	rt, _ := testruntime.NewInstance(t)
+	srv, err := server.NewServer(context.Background(), &server.Options{}, rt, nil, ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	srv.ReadinessHandler(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rt.Drain()
+
+	rec = httptest.NewRecorder()
+	srv.ReadinessHandler(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
@@ -0,0 +1,21 @@
+package server
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc"
+)
+
+// Exports for testing unexported server internals from the server_test package.
+
+func (s *Server) DrainUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return s.drainUnaryServerInterceptor()
+}
+
+func (s *Server) DrainStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return s.drainStreamServerInterceptor()
+}
+
+func (s *Server) ReadinessHandler(w http.ResponseWriter, req *http.Request) {
+	s.readinessHandler(w, req)
+}
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"context"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/expressionpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FormatExpression implements RuntimeService.
+func (s *Server) FormatExpression(ctx context.Context, req *runtimev1.FormatExpressionRequest) (*runtimev1.FormatExpressionResponse, error) {
+	text, err := expressionpb.Format(req.Expression)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to format expression: %s", err.Error())
+	}
+	return &runtimev1.FormatExpressionResponse{Text: text}, nil
+}
+
+// ParseExpression implements RuntimeService.
+func (s *Server) ParseExpression(ctx context.Context, req *runtimev1.ParseExpressionRequest) (*runtimev1.ParseExpressionResponse, error) {
+	expr, err := expressionpb.Parse(req.Text)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse expression: %s", err.Error())
+	}
+	return &runtimev1.ParseExpressionResponse{Expression: expr}, nil
+}
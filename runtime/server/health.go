@@ -2,15 +2,22 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/healthcheck"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
 )
 
+// readinessTimeout bounds the time spent checking dependencies in readinessHandler.
+const readinessTimeout = 10 * time.Second
+
 // Health implements RuntimeService
 func (s *Server) Health(ctx context.Context, req *runtimev1.HealthRequest) (*runtimev1.HealthResponse, error) {
 	if !auth.GetClaims(ctx).Can(auth.ManageInstances) {
@@ -102,6 +109,40 @@ func (s *Server) healthCheckHandler(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// readinessHandler serves a structured readiness report for load balancers and k8s probes.
+// Only failures of runtime-wide dependencies (metastore and rate limiter) and draining make the runtime unready.
+// Failures of an instance's OLAP, repo or catalog are reported as degraded since other instances can still be served.
+func (s *Server) readinessHandler(w http.ResponseWriter, req *http.Request) {
+	ctx, cancel := context.WithTimeout(req.Context(), readinessTimeout)
+	defer cancel()
+
+	report := healthcheck.NewReport()
+	if s.runtime.Draining() {
+		report.Add("runtime", errors.New("runtime is shutting down"), true)
+	}
+	report.Add("limiter", s.limiter.Ping(ctx), true)
+
+	status, err := s.runtime.Health(ctx)
+	if err != nil {
+		report.Add("metastore", err, true)
+		report.WriteHTTP(w)
+		return
+	}
+	report.Add("metastore", status.Registry, true)
+	report.Add("conn_cache", status.HangingConn, false)
+	for id, h := range status.InstancesHealth {
+		if h == nil {
+			continue
+		}
+		report.Add(fmt.Sprintf("instances/%s/controller", id), h.Controller, false)
+		report.Add(fmt.Sprintf("instances/%s/olap", id), h.OLAP, false)
+		report.Add(fmt.Sprintf("instances/%s/repo", id), h.Repo, false)
+		report.Add(fmt.Sprintf("instances/%s/catalog", id), h.Catalog, false)
+	}
+
+	report.WriteHTTP(w)
+}
+
 func pingCloudfareDNS(ctx context.Context) error {
 	d := net.Dialer{}
 	conn, err := d.DialContext(ctx, "tcp", "1.1.1.1:53")
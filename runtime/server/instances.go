@@ -81,6 +81,12 @@ func (s *Server) CreateInstance(ctx context.Context, req *runtimev1.CreateInstan
 		attribute.String("args.ai_connector", req.AiConnector),
 		attribute.StringSlice("args.connectors", connectorsStrings(req.Connectors)),
 	)
+	if req.RestoreBackupId != "" {
+		observability.AddRequestAttributes(ctx,
+			attribute.String("args.restore_instance_id", req.RestoreInstanceId),
+			attribute.String("args.restore_backup_id", req.RestoreBackupId),
+		)
+	}
 
 	if !auth.GetClaims(ctx).Can(auth.ManageInstances) {
 		return nil, ErrForbidden
@@ -100,6 +106,19 @@ func (s *Server) CreateInstance(ctx context.Context, req *runtimev1.CreateInstan
 		WatchRepo:      req.WatchRepo,
 	}
 
+	if req.RestoreBackupId != "" {
+		if req.RestoreInstanceId == "" {
+			return nil, status.Error(codes.InvalidArgument, "restore_instance_id is required when restore_backup_id is set")
+		}
+		err := s.runtime.CreateInstanceFromSnapshot(ctx, inst, req.RestoreInstanceId, req.RestoreBackupId)
+		if err != nil {
+			return nil, backupErrorToStatus(err)
+		}
+		return &runtimev1.CreateInstanceResponse{
+			Instance: instanceToPB(inst, true),
+		}, nil
+	}
+
 	err := s.runtime.CreateInstance(ctx, inst)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -270,11 +289,17 @@ func (s *Server) WatchLogs(req *runtimev1.WatchLogsRequest, srv runtimev1.Runtim
 }
 
 func instanceToPB(inst *drivers.Instance, sensitive bool) *runtimev1.Instance {
+	featureFlags, err := inst.ResolveFeatureFlags()
+	if err != nil {
+		// Fall back to the flags from rill.yaml if an override is invalid
+		featureFlags = inst.FeatureFlags
+	}
+
 	pb := &runtimev1.Instance{
 		InstanceId:   inst.ID,
 		CreatedOn:    timestamppb.New(inst.CreatedOn),
 		UpdatedOn:    timestamppb.New(inst.UpdatedOn),
-		FeatureFlags: inst.FeatureFlags,
+		FeatureFlags: featureFlags,
 	}
 
 	if sensitive {
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/sqlparse"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// LintSQL implements RuntimeService.
+func (s *Server) LintSQL(ctx context.Context, req *runtimev1.LintSQLRequest) (*runtimev1.LintSQLResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.connector", req.Connector),
+		attribute.Bool("args.format", req.Format),
+	)
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	claims := auth.GetClaims(ctx)
+	if !claims.CanInstance(req.InstanceId, auth.ReadRepo) && !claims.CanInstance(req.InstanceId, auth.ReadOLAP) {
+		return nil, ErrForbidden
+	}
+
+	// The connector is only used to determine the SQL dialect
+	olap, release, err := s.runtime.OLAP(ctx, req.InstanceId, req.Connector)
+	if err != nil {
+		return nil, err
+	}
+	dialect := olap.Dialect()
+	release()
+
+	res := &runtimev1.LintSQLResponse{Dialect: dialect.String()}
+	for _, d := range sqlparse.Lint(req.Sql, dialect) {
+		res.Diagnostics = append(res.Diagnostics, &runtimev1.SQLDiagnostic{
+			Rule:     d.Rule,
+			Severity: sqlDiagnosticSeverityToPB(d.Severity),
+			Message:  d.Message,
+			Line:     uint32(d.Line),
+			Column:   uint32(d.Column),
+		})
+	}
+
+	if req.Format {
+		// If the SQL can't be tokenized, the problem is reported as a diagnostic, so we just don't return formatted SQL.
+		formatted, err := sqlparse.Format(req.Sql, dialect)
+		if err == nil {
+			res.FormattedSql = formatted
+		}
+	}
+
+	return res, nil
+}
+
+func sqlDiagnosticSeverityToPB(s sqlparse.Severity) runtimev1.SQLDiagnostic_Severity {
+	switch s {
+	case sqlparse.SeverityInfo:
+		return runtimev1.SQLDiagnostic_SEVERITY_INFO
+	case sqlparse.SeverityWarning:
+		return runtimev1.SQLDiagnostic_SEVERITY_WARNING
+	case sqlparse.SeverityError:
+		return runtimev1.SQLDiagnostic_SEVERITY_ERROR
+	default:
+		return runtimev1.SQLDiagnostic_SEVERITY_UNSPECIFIED
+	}
+}
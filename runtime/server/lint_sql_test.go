@@ -0,0 +1,46 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintSQL(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+
+	srv, err := server.NewServer(context.Background(), &server.Options{}, rt, nil, ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+
+	res, err := srv.LintSQL(testCtx(), &runtimev1.LintSQLRequest{
+		InstanceId: id,
+		Sql:        "with a as (select 1 as x) select * from t, u",
+		Format:     true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "duckdb", res.Dialect)
+	require.Len(t, res.Diagnostics, 3)
+	require.Equal(t, "unused_cte", res.Diagnostics[0].Rule)
+	require.Equal(t, "select_star", res.Diagnostics[1].Rule)
+	require.Equal(t, runtimev1.SQLDiagnostic_SEVERITY_WARNING, res.Diagnostics[1].Severity)
+	require.Equal(t, uint32(1), res.Diagnostics[1].Line)
+	require.Equal(t, uint32(34), res.Diagnostics[1].Column)
+	require.Equal(t, "cross_join", res.Diagnostics[2].Rule)
+	require.Equal(t, "WITH a AS (\n  SELECT\n    1 AS x\n)\nSELECT\n  *\nFROM t, u", res.FormattedSql)
+
+	res, err = srv.LintSQL(testCtx(), &runtimev1.LintSQLRequest{
+		InstanceId: id,
+		Sql:        "select 'x",
+		Format:     true,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Diagnostics, 1)
+	require.Equal(t, runtimev1.SQLDiagnostic_SEVERITY_ERROR, res.Diagnostics[0].Severity)
+	require.Empty(t, res.FormattedSql)
+}
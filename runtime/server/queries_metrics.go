@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
@@ -55,9 +56,14 @@ func (s *Server) MetricsViewAggregation(ctx context.Context, req *runtimev1.Metr
 		Limit:               &req.Limit,
 		Offset:              req.Offset,
 		PivotOn:             req.PivotOn,
+		PivotColumns:        req.PivotColumns,
 		SecurityClaims:      auth.GetClaims(ctx).SecurityClaims(),
 		Exact:               req.Exact,
 		Aliases:             req.Aliases,
+		AllowPartialResults: req.AllowPartialResults,
+		DecimalsAsStrings:   req.DecimalsAsStrings,
+
+		ExecutionTimeoutSeconds: req.ExecutionTimeoutSeconds,
 	}
 	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
@@ -168,6 +174,8 @@ func (s *Server) MetricsViewComparison(ctx context.Context, req *runtimev1.Metri
 		Exact:               req.Exact,
 		Filter:              req.Filter,
 		SecurityClaims:      auth.GetClaims(ctx).SecurityClaims(),
+
+		ExecutionTimeoutSeconds: req.ExecutionTimeoutSeconds,
 	}
 	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
@@ -207,6 +215,7 @@ func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.Metri
 		TimeZone:        req.TimeZone,
 		Filter:          req.Filter,
 		SecurityClaims:  auth.GetClaims(ctx).SecurityClaims(),
+		Forecast:        req.Forecast,
 	}
 	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
@@ -304,6 +313,7 @@ func (s *Server) MetricsViewTimeRange(ctx context.Context, req *runtimev1.Metric
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.instance_id", req.InstanceId),
 		attribute.String("args.metric_view", req.MetricsViewName),
+		attribute.StringSlice("args.time_zones", req.TimeZones),
 		attribute.Int("args.priority", int(req.Priority)),
 	)
 
@@ -313,6 +323,12 @@ func (s *Server) MetricsViewTimeRange(ctx context.Context, req *runtimev1.Metric
 		return nil, ErrForbidden
 	}
 
+	for _, tz := range req.TimeZones {
+		if _, err := time.LoadLocation(tz); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid time zone %q: %s", tz, err.Error())
+		}
+	}
+
 	mv, security, err := resolveMVAndSecurity(ctx, s.runtime, req.InstanceId, req.MetricsViewName)
 	if err != nil {
 		return nil, err
@@ -322,6 +338,8 @@ func (s *Server) MetricsViewTimeRange(ctx context.Context, req *runtimev1.Metric
 		MetricsViewName:    req.MetricsViewName,
 		MetricsView:        mv,
 		ResolvedMVSecurity: security,
+		TimeZones:          req.TimeZones,
+		TimeGrains:         req.TimeGrains,
 	}
 	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
@@ -331,6 +349,39 @@ func (s *Server) MetricsViewTimeRange(ctx context.Context, req *runtimev1.Metric
 	return q.Result, nil
 }
 
+func (s *Server) MetricsViewDefaults(ctx context.Context, req *runtimev1.MetricsViewDefaultsRequest) (*runtimev1.MetricsViewDefaultsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.metric_view", req.MetricsViewName),
+		attribute.String("args.time_zone", req.TimeZone),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	if req.TimeZone != "" {
+		if _, err := time.LoadLocation(req.TimeZone); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid time zone %q: %s", req.TimeZone, err.Error())
+		}
+	}
+
+	q := &queries.MetricsViewDefaults{
+		MetricsViewName: req.MetricsViewName,
+		TimeZone:        req.TimeZone,
+		SecurityClaims:  auth.GetClaims(ctx).SecurityClaims(),
+	}
+	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Result, nil
+}
+
 func (s *Server) MetricsViewSchema(ctx context.Context, req *runtimev1.MetricsViewSchemaRequest) (*runtimev1.MetricsViewSchemaResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.instance_id", req.InstanceId),
@@ -374,14 +425,45 @@ func (s *Server) MetricsViewSearch(ctx context.Context, req *runtimev1.MetricsVi
 
 	limit := int64(req.Limit)
 	q := &queries.MetricsViewSearch{
+		MetricsViewName:   req.MetricsViewName,
+		Dimensions:        req.Dimensions,
+		Search:            req.Search,
+		TimeRange:         req.TimeRange,
+		Where:             req.Where,
+		Having:            req.Having,
+		Priority:          req.Priority,
+		Limit:             &limit,
+		SecurityClaims:    auth.GetClaims(ctx).SecurityClaims(),
+		AccentInsensitive: req.AccentInsensitive,
+	}
+	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Result, nil
+}
+
+func (s *Server) MetricsViewValidateExpressions(ctx context.Context, req *runtimev1.MetricsViewValidateExpressionsRequest) (*runtimev1.MetricsViewValidateExpressionsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.metric_view", req.MetricsViewName),
+		attribute.Int("args.filter_count", filterCount(req.Where)),
+		attribute.StringSlice("args.having_names", req.HavingNames),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	q := &queries.MetricsViewValidateExpressions{
 		MetricsViewName: req.MetricsViewName,
-		Dimensions:      req.Dimensions,
-		Search:          req.Search,
-		TimeRange:       req.TimeRange,
 		Where:           req.Where,
 		Having:          req.Having,
-		Priority:        req.Priority,
-		Limit:           &limit,
+		HavingNames:     req.HavingNames,
 		SecurityClaims:  auth.GetClaims(ctx).SecurityClaims(),
 	}
 	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
@@ -392,6 +474,125 @@ func (s *Server) MetricsViewSearch(ctx context.Context, req *runtimev1.MetricsVi
 	return q.Result, nil
 }
 
+// MetricsViewContribution implements QueryService.
+func (s *Server) MetricsViewContribution(ctx context.Context, req *runtimev1.MetricsViewContributionRequest) (*runtimev1.MetricsViewContributionResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.metric_view", req.MetricsViewName),
+		attribute.String("args.measure", req.Measure),
+		attribute.String("args.dimension", req.Dimension),
+		attribute.String("args.base_time_range.start", safeTimeStr(req.TimeRange.GetStart())),
+		attribute.String("args.base_time_range.end", safeTimeStr(req.TimeRange.GetEnd())),
+		attribute.String("args.comparison_time_range.start", safeTimeStr(req.ComparisonTimeRange.GetStart())),
+		attribute.String("args.comparison_time_range.end", safeTimeStr(req.ComparisonTimeRange.GetEnd())),
+		attribute.Int("args.filter_count", filterCount(req.Where)),
+		attribute.Int64("args.limit", req.Limit),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	q := &queries.MetricsViewContribution{
+		MetricsViewName:     req.MetricsViewName,
+		MeasureName:         req.Measure,
+		DimensionName:       req.Dimension,
+		TimeRange:           req.TimeRange,
+		ComparisonTimeRange: req.ComparisonTimeRange,
+		Where:               req.Where,
+		Limit:               req.Limit,
+		SecurityClaims:      auth.GetClaims(ctx).SecurityClaims(),
+
+		ExecutionTimeoutSeconds: req.ExecutionTimeoutSeconds,
+	}
+	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Result, nil
+}
+
+// MetricsViewFunnel implements QueryService.
+func (s *Server) MetricsViewFunnel(ctx context.Context, req *runtimev1.MetricsViewFunnelRequest) (*runtimev1.MetricsViewFunnelResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.metric_view", req.MetricsViewName),
+		attribute.String("args.dimension", req.Dimension),
+		attribute.Int("args.steps", len(req.Steps)),
+		attribute.String("args.conversion_window", req.ConversionWindow),
+		attribute.String("args.time_range.start", safeTimeStr(req.TimeRange.GetStart())),
+		attribute.String("args.time_range.end", safeTimeStr(req.TimeRange.GetEnd())),
+		attribute.Int("args.filter_count", filterCount(req.Where)),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	q := &queries.MetricsViewFunnel{
+		MetricsViewName:  req.MetricsViewName,
+		DimensionName:    req.Dimension,
+		Steps:            req.Steps,
+		ConversionWindow: req.ConversionWindow,
+		TimeRange:        req.TimeRange,
+		Where:            req.Where,
+		SecurityClaims:   auth.GetClaims(ctx).SecurityClaims(),
+
+		ExecutionTimeoutSeconds: req.ExecutionTimeoutSeconds,
+	}
+	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Result, nil
+}
+
+func (s *Server) MetricsViewRetention(ctx context.Context, req *runtimev1.MetricsViewRetentionRequest) (*runtimev1.MetricsViewRetentionResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.metric_view", req.MetricsViewName),
+		attribute.String("args.dimension", req.Dimension),
+		attribute.String("args.time_grain", req.TimeGrain.String()),
+		attribute.Int("args.periods", int(req.Periods)),
+		attribute.String("args.time_range.start", safeTimeStr(req.TimeRange.GetStart())),
+		attribute.String("args.time_range.end", safeTimeStr(req.TimeRange.GetEnd())),
+		attribute.Int("args.filter_count", filterCount(req.Where)),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadMetrics) {
+		return nil, ErrForbidden
+	}
+
+	q := &queries.MetricsViewRetention{
+		MetricsViewName: req.MetricsViewName,
+		DimensionName:   req.Dimension,
+		TimeGrain:       req.TimeGrain,
+		Periods:         int(req.Periods),
+		TimeRange:       req.TimeRange,
+		Where:           req.Where,
+		SecurityClaims:  auth.GetClaims(ctx).SecurityClaims(),
+
+		ExecutionTimeoutSeconds: req.ExecutionTimeoutSeconds,
+	}
+	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Result, nil
+}
+
 func resolveMVAndSecurity(ctx context.Context, rt *runtime.Runtime, instanceID, metricsViewName string) (*runtimev1.MetricsViewSpec, *runtime.ResolvedSecurity, error) {
 	res, mv, err := lookupMetricsView(ctx, rt, instanceID, metricsViewName)
 	if err != nil {
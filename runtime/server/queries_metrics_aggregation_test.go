@@ -42,6 +42,69 @@ func TestMetricsViewAggregation_Toplist(t *testing.T) {
 	require.Equal(t, 1.0, tr.Data[1].Fields["__count"].GetNumberValue())
 }
 
+func TestMetricsViewAggregation_BuiltinMeasures(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	tr, err := server.MetricsViewAggregation(testCtx(), &runtimev1.MetricsViewAggregationRequest{
+		InstanceId:  instanceId,
+		MetricsView: "ad_bids_metrics",
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{Name: "__sum", BuiltinMeasure: runtimev1.BuiltinMeasure_BUILTIN_MEASURE_SUM, BuiltinMeasureArgs: []*structpb.Value{structpb.NewStringValue("numeric_dim")}},
+			{Name: "__max", BuiltinMeasure: runtimev1.BuiltinMeasure_BUILTIN_MEASURE_MAX, BuiltinMeasureArgs: []*structpb.Value{structpb.NewStringValue("domain")}},
+			{Name: "__last", BuiltinMeasure: runtimev1.BuiltinMeasure_BUILTIN_MEASURE_ARGMAX, BuiltinMeasureArgs: []*structpb.Value{structpb.NewStringValue("domain"), structpb.NewStringValue("timestamp")}},
+			{Name: "__first", BuiltinMeasure: runtimev1.BuiltinMeasure_BUILTIN_MEASURE_ARGMIN, BuiltinMeasureArgs: []*structpb.Value{structpb.NewStringValue("domain"), structpb.NewStringValue("timestamp")}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tr.Data))
+	require.Equal(t, 2.0, tr.Data[0].Fields["__sum"].GetNumberValue())
+	require.Equal(t, "yahoo.com", tr.Data[0].Fields["__max"].GetStringValue())
+	require.Equal(t, "yahoo.com", tr.Data[0].Fields["__last"].GetStringValue())
+	require.Equal(t, "msn.com", tr.Data[0].Fields["__first"].GetStringValue())
+
+	// Builtin measures validate their arguments
+	_, err = server.MetricsViewAggregation(testCtx(), &runtimev1.MetricsViewAggregationRequest{
+		InstanceId:  instanceId,
+		MetricsView: "ad_bids_metrics",
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{Name: "__last", BuiltinMeasure: runtimev1.BuiltinMeasure_BUILTIN_MEASURE_ARGMAX, BuiltinMeasureArgs: []*structpb.Value{structpb.NewStringValue("domain")}},
+		},
+	})
+	require.ErrorContains(t, err, "expects 2 argument(s)")
+}
+
+func TestMetricsViewAggregation_PartialResults(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	req := &runtimev1.MetricsViewAggregationRequest{
+		InstanceId:  instanceId,
+		MetricsView: "ad_bids_metrics",
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{Name: "measure_0"},
+			// AVG is not defined for strings, so this measure fails in the OLAP
+			{Name: "__avg", BuiltinMeasure: runtimev1.BuiltinMeasure_BUILTIN_MEASURE_AVG, BuiltinMeasureArgs: []*structpb.Value{structpb.NewStringValue("domain")}},
+		},
+	}
+
+	// Fails by default
+	_, err := server.MetricsViewAggregation(testCtx(), req)
+	require.Error(t, err)
+
+	// Returns the measures that succeeded along with the measure errors
+	req.AllowPartialResults = true
+	tr, err := server.MetricsViewAggregation(testCtx(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tr.Data))
+	require.Equal(t, 2.0, tr.Data[0].Fields["measure_0"].GetNumberValue())
+	require.NotContains(t, tr.Data[0].Fields, "__avg")
+	require.Len(t, tr.MeasureErrors, 1)
+	require.Equal(t, "__avg", tr.MeasureErrors[0].Measure)
+	require.Equal(t, "OLAP_ERROR", tr.MeasureErrors[0].Reason)
+	require.NotEmpty(t, tr.MeasureErrors[0].Message)
+}
+
 func TestMetricsViewAggregation_Totals(t *testing.T) {
 	t.Parallel()
 	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
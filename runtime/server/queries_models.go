@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const _modelChangesDefaultPageSize = 1000
+
+func (s *Server) ModelChanges(ctx context.Context, req *runtimev1.ModelChangesRequest) (*runtimev1.ModelChangesResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.model", req.ModelName),
+		attribute.Int64("args.since_version", req.SinceVersion),
+		attribute.Int("args.page_size", int(req.PageSize)),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadOLAP) {
+		return nil, ErrForbidden
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = _modelChangesDefaultPageSize
+	}
+
+	// The page token pins the version, so all pages of a sync return changes up to the same version.
+	var toVersion int64
+	var offset int
+	if req.PageToken != "" {
+		var err error
+		toVersion, offset, err = unmarshalModelChangesPageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	q := &queries.ModelChanges{
+		ModelName:    req.ModelName,
+		SinceVersion: req.SinceVersion,
+		ToVersion:    toVersion,
+		Limit:        pageSize,
+		Offset:       offset,
+	}
+	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	if err != nil {
+		if errors.Is(err, queries.ErrModelChangesExpired) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	var nextPageToken string
+	if q.Result.HasMore {
+		nextPageToken = marshalModelChangesPageToken(q.Result.Version, offset+len(q.Result.Data))
+	}
+
+	return &runtimev1.ModelChangesResponse{
+		Version:       q.Result.Version,
+		Schema:        q.Result.Schema,
+		Data:          q.Result.Data,
+		RemovedRows:   q.Result.RemovedRows,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func marshalModelChangesPageToken(version int64, offset int) string {
+	return base64.URLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", version, offset)))
+}
+
+func unmarshalModelChangesPageToken(token string) (int64, int, error) {
+	data, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid page token: %w", err)
+	}
+	var version int64
+	var offset int
+	_, err = fmt.Sscanf(string(data), "%d:%d", &version, &offset)
+	if err != nil || version <= 0 || offset < 0 {
+		return 0, 0, errors.New("invalid page token")
+	}
+	return version, offset, nil
+}
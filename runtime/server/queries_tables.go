@@ -12,6 +12,11 @@ import (
 
 const _tableHeadDefaultLimit = 25
 
+const (
+	_columnsProfileDefaultK             = 10
+	_columnsProfileDefaultHistogramBins = 20
+)
+
 // Table level profiling APIs.
 func (s *Server) TableCardinality(ctx context.Context, req *runtimev1.TableCardinalityRequest) (*runtimev1.TableCardinalityResponse, error) {
 	observability.AddRequestAttributes(ctx,
@@ -120,3 +125,50 @@ func (s *Server) TableRows(ctx context.Context, req *runtimev1.TableRowsRequest)
 		Data: q.Result,
 	}, nil
 }
+
+func (s *Server) TableColumnsProfile(ctx context.Context, req *runtimev1.TableColumnsProfileRequest) (*runtimev1.TableColumnsProfileResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.connector", req.Connector),
+		attribute.String("args.database", req.Database),
+		attribute.String("args.database_schema", req.DatabaseSchema),
+		attribute.String("args.table", req.TableName),
+		attribute.StringSlice("args.columns", req.ColumnNames),
+		attribute.Int("args.k", int(req.K)),
+		attribute.Int("args.histogram_bins", int(req.HistogramBins)),
+		attribute.Int("args.priority", int(req.Priority)),
+	)
+
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadProfiling) {
+		return nil, ErrForbidden
+	}
+
+	k := int(req.K)
+	if k == 0 {
+		k = _columnsProfileDefaultK
+	}
+
+	bins := int(req.HistogramBins)
+	if bins == 0 {
+		bins = _columnsProfileDefaultHistogramBins
+	}
+
+	q := &queries.TableColumnsProfile{
+		Connector:      req.Connector,
+		Database:       req.Database,
+		DatabaseSchema: req.DatabaseSchema,
+		TableName:      req.TableName,
+		ColumnNames:    req.ColumnNames,
+		K:              k,
+		HistogramBins:  bins,
+	}
+
+	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Result, nil
+}
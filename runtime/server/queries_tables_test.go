@@ -112,6 +112,64 @@ func TestServer_TableRows_EmptyModel(t *testing.T) {
 	require.Equal(t, 0, len(cr.Data))
 }
 
+func TestServer_TableColumnsProfile(t *testing.T) {
+	t.Parallel()
+	server, instanceId := getTableTestServerWithSql(t, `
+		SELECT * FROM (VALUES (1, 'a'), (2, 'a'), (3, 'b'), (10, NULL), (NULL, 'c')) t(num, str)
+	`)
+	res, err := server.TableColumnsProfile(testCtx(), &runtimev1.TableColumnsProfileRequest{
+		InstanceId:    instanceId,
+		TableName:     "test",
+		K:             2,
+		HistogramBins: 3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5.0, res.RowCount)
+	require.Len(t, res.Columns, 2)
+
+	num := res.Columns[0]
+	require.Equal(t, "num", num.Name)
+	require.Equal(t, 1.0, num.NullCount)
+	require.Equal(t, 0.2, num.NullRatio)
+	require.Equal(t, 1.0, num.Min.GetNumberValue())
+	require.Equal(t, 10.0, num.Max.GetNumberValue())
+	require.Len(t, num.Histogram.Bins, 3)
+	require.Equal(t, 1.0, num.Histogram.Bins[0].Low)
+	require.Equal(t, 3.0, num.Histogram.Bins[0].Count)
+	require.Equal(t, 0.0, num.Histogram.Bins[1].Count)
+	require.Equal(t, 1.0, num.Histogram.Bins[2].Count)
+	require.Len(t, num.TopK.Entries, 2)
+
+	str := res.Columns[1]
+	require.Equal(t, "str", str.Name)
+	require.Equal(t, 1.0, str.NullCount)
+	require.Equal(t, 3.0, str.Cardinality)
+	require.Equal(t, "a", str.Min.GetStringValue())
+	require.Equal(t, "c", str.Max.GetStringValue())
+	require.Nil(t, str.Histogram)
+	require.Len(t, str.TopK.Entries, 2)
+	require.Equal(t, "a", str.TopK.Entries[0].Value.GetStringValue())
+	require.Equal(t, 2.0, str.TopK.Entries[0].Count)
+
+	// Profile a subset of the columns
+	res, err = server.TableColumnsProfile(testCtx(), &runtimev1.TableColumnsProfileRequest{
+		InstanceId:  instanceId,
+		TableName:   "test",
+		ColumnNames: []string{"str"},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Columns, 1)
+	require.Equal(t, "str", res.Columns[0].Name)
+
+	// Unknown columns are rejected
+	_, err = server.TableColumnsProfile(testCtx(), &runtimev1.TableColumnsProfileRequest{
+		InstanceId:  instanceId,
+		TableName:   "test",
+		ColumnNames: []string{"missing"},
+	})
+	require.Error(t, err)
+}
+
 func getTableTestServer(t *testing.T) (*server.Server, string) {
 	rt, instanceID := testruntime.NewInstanceWithModel(t, "test", `
 		SELECT 1::int AS a, 10::int AS "b""b"
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetQueryCacheStats implements runtimev1.RuntimeServiceServer
+func (s *Server) GetQueryCacheStats(ctx context.Context, req *runtimev1.GetQueryCacheStatsRequest) (*runtimev1.GetQueryCacheStatsResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditInstance) {
+		return nil, ErrForbidden
+	}
+
+	stats := s.runtime.QueryCacheStats(req.InstanceId)
+	return &runtimev1.GetQueryCacheStatsResponse{
+		Entries:       stats.Entries,
+		SizeBytes:     stats.Bytes,
+		DiskEntries:   stats.DiskEntries,
+		DiskSizeBytes: stats.DiskBytes,
+		Hits:          stats.Hits,
+		Misses:        stats.Misses,
+		Evictions:     stats.Evictions,
+	}, nil
+}
+
+// PurgeQueryCache implements runtimev1.RuntimeServiceServer
+func (s *Server) PurgeQueryCache(ctx context.Context, req *runtimev1.PurgeQueryCacheRequest) (*runtimev1.PurgeQueryCacheResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.metrics_view", req.MetricsView),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditInstance) {
+		return nil, ErrForbidden
+	}
+
+	n := s.runtime.PurgeQueryCache(req.InstanceId, req.MetricsView)
+	return &runtimev1.PurgeQueryCacheResponse{PurgedEntries: int64(n)}, nil
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"path"
+
+	"github.com/rilldata/rill/runtime/pkg/querycost"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"google.golang.org/grpc"
+)
+
+// queryUsageUnaryServerInterceptor tracks the cost of the OLAP queries executed while handling an instance-scoped request,
+// and records it against the requesting subject. It also records the request's slow queries in the instance's slow query log,
+// and tracks its running queries so they can be listed and cancelled.
+// It must run after the auth interceptor.
+func (s *Server) queryUsageUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		r, ok := req.(interface{ GetInstanceId() string })
+		if !ok || r.GetInstanceId() == "" {
+			return handler(ctx, req)
+		}
+
+		ctx, tracker := querycost.WithTracker(ctx)
+		ctx = s.runtime.WithSlowQueryLog(ctx, r.GetInstanceId())
+		// The query type is the name of the RPC, e.g. "MetricsViewAggregation"
+		ctx = s.runtime.WithRunningQueries(ctx, r.GetInstanceId(), path.Base(info.FullMethod), auth.GetClaims(ctx).Subject())
+		res, err := handler(ctx, req)
+		s.runtime.RecordQueryUsage(r.GetInstanceId(), auth.GetClaims(ctx).Subject(), tracker.Stats())
+		return res, err
+	}
+}
+
+// queryUsageMiddleware is a HTTP middleware variant of queryUsageUnaryServerInterceptor.
+// It should be used for handlers mounted on paths with an {instance_id} wildcard.
+// The queryType is reported for the handler's running queries (the gRPC interceptor uses the RPC name).
+func (s *Server) queryUsageMiddleware(queryType string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		instanceID := r.PathValue("instance_id")
+		if instanceID == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, tracker := querycost.WithTracker(r.Context())
+		ctx = s.runtime.WithSlowQueryLog(ctx, instanceID)
+		ctx = s.runtime.WithRunningQueries(ctx, instanceID, queryType, auth.GetClaims(ctx).Subject())
+		next.ServeHTTP(w, r.WithContext(ctx))
+		s.runtime.RecordQueryUsage(instanceID, auth.GetClaims(ctx).Subject(), tracker.Stats())
+	})
+}
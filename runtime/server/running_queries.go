@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/sqlfingerprint"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListRunningQueries implements runtimev1.RuntimeServiceServer
+func (s *Server) ListRunningQueries(ctx context.Context, req *runtimev1.ListRunningQueriesRequest) (*runtimev1.ListRunningQueriesResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditInstance) {
+		return nil, ErrForbidden
+	}
+
+	now := time.Now()
+	running := s.runtime.RunningQueries(req.InstanceId)
+	queries := make([]*runtimev1.RunningQuery, len(running))
+	for i, q := range running {
+		queries[i] = &runtimev1.RunningQuery{
+			Id:          q.ID,
+			Sql:         q.SQL,
+			StartedOn:   timestamppb.New(q.StartedOn),
+			ElapsedMs:   now.Sub(q.StartedOn).Milliseconds(),
+			Type:        q.Type,
+			Fingerprint: sqlfingerprint.Fingerprint(q.SQL),
+			Principal:   q.Principal,
+		}
+	}
+
+	return &runtimev1.ListRunningQueriesResponse{Queries: queries}, nil
+}
+
+// CancelQuery implements runtimev1.RuntimeServiceServer
+func (s *Server) CancelQuery(ctx context.Context, req *runtimev1.CancelQueryRequest) (*runtimev1.CancelQueryResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.query_id", req.QueryId),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditInstance) {
+		return nil, ErrForbidden
+	}
+
+	if !s.runtime.CancelQuery(req.InstanceId, req.QueryId) {
+		return nil, status.Error(codes.NotFound, "query not found or already finished")
+	}
+
+	s.logger.Info("cancelled running query", zap.String("instance_id", req.InstanceId), zap.String("query_id", req.QueryId), zap.String("cancelled_by", auth.GetClaims(ctx).Subject()))
+
+	return &runtimev1.CancelQueryResponse{}, nil
+}
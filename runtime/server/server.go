@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net/http"
@@ -16,9 +17,12 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/graceful"
+	"github.com/rilldata/rill/runtime/pkg/healthcheck"
 	"github.com/rilldata/rill/runtime/pkg/httputil"
 	"github.com/rilldata/rill/runtime/pkg/middleware"
+	"github.com/rilldata/rill/runtime/pkg/mtls"
 	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/queryerr"
 	"github.com/rilldata/rill/runtime/pkg/ratelimit"
 	"github.com/rilldata/rill/runtime/pkg/securetoken"
 	"github.com/rilldata/rill/runtime/queries"
@@ -28,6 +32,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -46,6 +51,11 @@ type Options struct {
 	AuthAudienceURL string
 	TLSCertPath     string
 	TLSKeyPath      string
+	// MTLSCertPath, MTLSKeyPath and MTLSClientCAPath enable mutual TLS on the gRPC server when set.
+	// Requests that don't present a client certificate signed by the CA are not granted system-level permissions.
+	MTLSCertPath     string
+	MTLSKeyPath      string
+	MTLSClientCAPath string
 }
 
 type Server struct {
@@ -56,6 +66,7 @@ type Server struct {
 	opts     *Options
 	logger   *zap.Logger
 	aud      *auth.Audience
+	mtls     *mtls.Reloader
 	codec    *securetoken.Codec
 	limiter  ratelimit.Limiter
 	activity *activity.Client
@@ -89,11 +100,25 @@ func NewServer(ctx context.Context, opts *Options, rt *runtime.Runtime, logger *
 	}
 
 	if opts.AuthEnable {
-		aud, err := auth.OpenAudience(ctx, logger, opts.AuthIssuerURL, opts.AuthAudienceURL)
+		if opts.AuthIssuerURL == "" {
+			// Without an issuer, only runtime API keys can be used to authenticate
+			srv.aud = auth.NewAPIKeyAudience(rt)
+		} else {
+			aud, err := auth.OpenAudience(ctx, logger, opts.AuthIssuerURL, opts.AuthAudienceURL)
+			if err != nil {
+				return nil, err
+			}
+			aud.SetAPIKeyStore(rt)
+			srv.aud = aud
+		}
+	}
+
+	if opts.MTLSCertPath != "" {
+		r, err := mtls.NewReloader(opts.MTLSCertPath, opts.MTLSKeyPath, opts.MTLSClientCAPath)
 		if err != nil {
 			return nil, err
 		}
-		srv.aud = aud
+		srv.mtls = r
 	}
 
 	return srv, nil
@@ -121,27 +146,42 @@ func (s *Server) Ping(ctx context.Context, req *runtimev1.PingRequest) (*runtime
 
 // ServeGRPC Starts the gRPC server.
 func (s *Server) ServeGRPC(ctx context.Context) error {
-	server := grpc.NewServer(
+	opts := []grpc.ServerOption{
 		grpc.ChainStreamInterceptor(
 			middleware.TimeoutStreamServerInterceptor(timeoutSelector),
 			observability.LoggingStreamServerInterceptor(s.logger),
+			s.drainStreamServerInterceptor(),
 			grpc_validator.StreamServerInterceptor(),
 			auth.StreamServerInterceptor(s.aud),
+			auth.ClientCertStreamServerInterceptor(s.mtls != nil),
 			middleware.ActivityStreamServerInterceptor(s.activity),
+			middleware.QueryTagStreamServerInterceptor(),
 			errorMappingStreamServerInterceptor(),
 			grpc_auth.StreamServerInterceptor(s.checkRateLimit),
 		),
 		grpc.ChainUnaryInterceptor(
 			middleware.TimeoutUnaryServerInterceptor(timeoutSelector),
 			observability.LoggingUnaryServerInterceptor(s.logger),
+			s.drainUnaryServerInterceptor(),
 			grpc_validator.UnaryServerInterceptor(),
 			auth.UnaryServerInterceptor(s.aud),
+			auth.ClientCertUnaryServerInterceptor(s.mtls != nil),
+			s.queryUsageUnaryServerInterceptor(),
 			middleware.ActivityUnaryServerInterceptor(s.activity),
+			middleware.QueryTagUnaryServerInterceptor(),
 			errorMappingUnaryServerInterceptor(),
 			grpc_auth.UnaryServerInterceptor(s.checkRateLimit),
 		),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-	)
+	}
+
+	// Client certificates are optional at the TLS layer so that regular clients (such as the CLI) can still connect.
+	// Control-plane calls are restricted to verified peers by the ClientCert interceptors.
+	if s.mtls != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.mtls.ServerConfig(tls.VerifyClientCertIfGiven))))
+	}
+
+	server := grpc.NewServer(opts...)
 
 	runtimev1.RegisterRuntimeServiceServer(server, s)
 	runtimev1.RegisterQueryServiceServer(server, s)
@@ -170,8 +210,17 @@ func (s *Server) ServeHTTP(ctx context.Context, registerAdditionalHandlers func(
 // HTTPHandler HTTP handler serving REST gateway.
 func (s *Server) HTTPHandler(ctx context.Context, registerAdditionalHandlers func(mux *http.ServeMux)) (http.Handler, error) {
 	// Create REST gateway
-	gwMux := gateway.NewServeMux(gateway.WithErrorHandler(HTTPErrorHandler))
+	gwMux := gateway.NewServeMux(
+		gateway.WithErrorHandler(HTTPErrorHandler),
+		gateway.WithIncomingHeaderMatcher(middleware.QueryTagHeaderMatcher(gateway.DefaultHeaderMatcher)),
+	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if s.mtls != nil {
+		// The gateway connects to its own gRPC server over loopback, so there's no need to verify the server certificate.
+		// It deliberately doesn't present a client certificate, so requests proxied through the gateway don't get system-level permissions.
+		tlsConf := &tls.Config{InsecureSkipVerify: true} // nolint:gosec // Loopback connection to self
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConf))}
+	}
 	grpcAddress := fmt.Sprintf("localhost:%d", s.opts.GRPCPort)
 	err := runtimev1.RegisterRuntimeServiceHandlerFromEndpoint(ctx, gwMux, grpcAddress, opts)
 	if err != nil {
@@ -206,14 +255,18 @@ func (s *Server) HTTPHandler(ctx context.Context, registerAdditionalHandlers fun
 	// Add HTTP handler for health check
 	observability.MuxHandle(httpMux, "/v1/health", observability.Middleware("runtime", s.logger, http.HandlerFunc(s.healthCheckHandler)))
 
+	// Add liveness and readiness probes (not wrapped in observability middleware to avoid logging every probe)
+	httpMux.Handle("/healthz", healthcheck.LivenessHandler())
+	httpMux.HandleFunc("/readyz", s.readinessHandler)
+
 	// Add HTTP handler for query export downloads
 	observability.MuxHandle(httpMux, "/v1/download", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, http.HandlerFunc(s.downloadHandler))))
 
 	// Add handler for dynamic APIs, i.e. APIs backed by resolvers (such as custom APIs defined in YAML).
-	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/api/{name...}", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.apiHandler))))
+	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/api/{name...}", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, s.queryUsageMiddleware("API", httputil.Handler(s.apiHandler)))))
 
 	// Add handler for resolving component data
-	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/components/{name}/data", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.componentDataHandler))))
+	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/components/{name}/data", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, s.queryUsageMiddleware("ComponentData", httputil.Handler(s.componentDataHandler)))))
 	// Serving static assets
 	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/assets/{path...}", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.assetsHandler))))
 
@@ -315,17 +368,12 @@ func mapGRPCError(err error) error {
 	if err == nil {
 		return nil
 	}
-	if errors.Is(err, context.DeadlineExceeded) {
-		return status.Error(codes.DeadlineExceeded, err.Error())
-	}
-	if errors.Is(err, context.Canceled) {
-		return status.Error(codes.Canceled, err.Error())
-	}
-	if errors.Is(err, queries.ErrForbidden) {
-		return ErrForbidden
+	if errors.Is(err, queries.ErrForbidden) || errors.Is(err, runtime.ErrForbidden) {
+		// Don't leak details about why access was denied
+		return queryerr.Status(runtime.ErrForbidden).Err()
 	}
-	if errors.Is(err, runtime.ErrForbidden) {
-		return ErrForbidden
+	if s := queryerr.Status(err); s != nil {
+		return s.Err()
 	}
 	return err
 }
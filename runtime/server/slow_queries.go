@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultSlowQueriesLimit is the number of fingerprints returned by ListSlowQueries if no limit is provided.
+const defaultSlowQueriesLimit = 20
+
+// ListSlowQueries implements runtimev1.RuntimeServiceServer
+func (s *Server) ListSlowQueries(ctx context.Context, req *runtimev1.ListSlowQueriesRequest) (*runtimev1.ListSlowQueriesResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.Int("args.limit", int(req.Limit)),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditInstance) {
+		return nil, ErrForbidden
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultSlowQueriesLimit
+	}
+
+	entries := s.runtime.SlowQueries(req.InstanceId, limit)
+	queries := make([]*runtimev1.SlowQuery, len(entries))
+	for i, e := range entries {
+		queries[i] = &runtimev1.SlowQuery{
+			Fingerprint:     e.Fingerprint,
+			NormalizedSql:   e.NormalizedSQL,
+			Count:           e.Count,
+			TotalDurationMs: e.TotalDuration.Milliseconds(),
+			MaxDurationMs:   e.MaxDuration.Milliseconds(),
+			FirstSeenOn:     timestamppb.New(e.FirstSeenOn),
+			LastSeenOn:      timestamppb.New(e.LastSeenOn),
+		}
+	}
+
+	return &runtimev1.ListSlowQueriesResponse{
+		Queries:     queries,
+		ThresholdMs: uint32(s.runtime.SlowQueryThreshold().Milliseconds()),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/slowquery"
+)
+
+// WithSlowQueryLog returns a copy of ctx that records queries executed on behalf of the instance in the runtime's slow query log.
+// It is a no-op if the slow query log is disabled (see Options.SlowQueryThreshold).
+func (r *Runtime) WithSlowQueryLog(ctx context.Context, instanceID string) context.Context {
+	return slowquery.WithLog(ctx, r.slowQueries, instanceID)
+}
+
+// SlowQueries returns the n slowest query fingerprints (by total execution time) recorded for the instance.
+// If n is zero or negative, all recorded fingerprints are returned.
+func (r *Runtime) SlowQueries(instanceID string, n int) []slowquery.Entry {
+	return r.slowQueries.Top(instanceID, n)
+}
+
+// SlowQueryThreshold returns the execution time at which queries are recorded in the slow query log.
+// It returns zero if the slow query log is disabled.
+func (r *Runtime) SlowQueryThreshold() time.Duration {
+	return r.slowQueries.Threshold()
+}
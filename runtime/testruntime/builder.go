@@ -0,0 +1,152 @@
+package testruntime
+
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+
+	"github.com/rilldata/rill/runtime"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectBuilder defines a project in Go code for use in tests.
+// It is an alternative to the projects in the testdata directory for tests that need small, targeted fixtures.
+//
+// Example:
+//
+//	rt, id := testruntime.NewProject().
+//		Seed("events", "country,amount\nDK,10\nUS,20\n").
+//		Model("totals", "SELECT country, SUM(amount) AS amount FROM events GROUP BY country").
+//		MetricsView("totals_mv", testruntime.MetricsView{
+//			Model:      "totals",
+//			Dimensions: []testruntime.Dimension{{Name: "country", Column: "country"}},
+//			Measures:   []testruntime.Measure{{Name: "amount", Expression: "SUM(amount)"}},
+//		}).
+//		Build(t)
+type ProjectBuilder struct {
+	opts   InstanceOptions
+	errors []error
+}
+
+// MetricsView defines a metrics view for a ProjectBuilder.
+type MetricsView struct {
+	Model         string      `yaml:"model,omitempty"`
+	Table         string      `yaml:"table,omitempty"`
+	TimeDimension string      `yaml:"timeseries,omitempty"`
+	Dimensions    []Dimension `yaml:"dimensions,omitempty"`
+	Measures      []Measure   `yaml:"measures,omitempty"`
+	// Security is the metrics view's security policy in its YAML structure (e.g. {"access": true, "row_filter": "..."}).
+	Security map[string]any `yaml:"security,omitempty"`
+}
+
+// Dimension defines a dimension of a MetricsView.
+type Dimension struct {
+	Name       string `yaml:"name"`
+	Label      string `yaml:"label,omitempty"`
+	Column     string `yaml:"column,omitempty"`
+	Expression string `yaml:"expression,omitempty"`
+}
+
+// Measure defines a measure of a MetricsView.
+type Measure struct {
+	Name         string `yaml:"name"`
+	Label        string `yaml:"label,omitempty"`
+	Expression   string `yaml:"expression"`
+	FormatPreset string `yaml:"format_preset,omitempty"`
+	Unit         string `yaml:"unit,omitempty"`
+}
+
+// API defines a custom API for a ProjectBuilder. Exactly one of SQL or MetricsSQL should be set.
+type API struct {
+	Connector  string `yaml:"connector,omitempty"`
+	SQL        string `yaml:"sql,omitempty"`
+	MetricsSQL string `yaml:"metrics_sql,omitempty"`
+}
+
+// NewProject returns a ProjectBuilder for an empty project.
+func NewProject() *ProjectBuilder {
+	return &ProjectBuilder{
+		opts: InstanceOptions{
+			Files:     map[string]string{"rill.yaml": ""},
+			Variables: map[string]string{},
+		},
+	}
+}
+
+// Seed adds a seed with the given CSV contents. It is loaded as a source with the given name.
+func (b *ProjectBuilder) Seed(name, csv string) *ProjectBuilder {
+	return b.File(filepath.Join("seeds", name+".csv"), csv)
+}
+
+// Source adds a source that ingests data from the given connector.
+func (b *ProjectBuilder) Source(name, connector string, props map[string]any) *ProjectBuilder {
+	res := map[string]any{"type": "source", "connector": connector}
+	maps.Copy(res, props)
+	return b.yaml(filepath.Join("sources", name+".yaml"), res)
+}
+
+// Model adds a SQL model that runs on the default OLAP connector.
+func (b *ProjectBuilder) Model(name, sql string) *ProjectBuilder {
+	return b.File(filepath.Join("models", name+".sql"), sql)
+}
+
+// MetricsView adds a metrics view.
+func (b *ProjectBuilder) MetricsView(name string, mv MetricsView) *ProjectBuilder {
+	return b.yaml(filepath.Join("metrics", name+".yaml"), struct {
+		Type        string `yaml:"type"`
+		MetricsView `yaml:",inline"`
+	}{"metrics_view", mv})
+}
+
+// API adds a custom API.
+func (b *ProjectBuilder) API(name string, api API) *ProjectBuilder {
+	return b.yaml(filepath.Join("apis", name+".yaml"), struct {
+		Type string `yaml:"type"`
+		API  `yaml:",inline"`
+	}{"api", api})
+}
+
+// Variable sets a project variable.
+func (b *ProjectBuilder) Variable(name, value string) *ProjectBuilder {
+	b.opts.Variables[name] = value
+	return b
+}
+
+// File adds a file with the given contents at a path relative to the project root.
+// It can be used for resources that don't have a dedicated builder method.
+func (b *ProjectBuilder) File(path, contents string) *ProjectBuilder {
+	b.opts.Files[path] = contents
+	return b
+}
+
+// Options applies custom instance options. Files and variables are merged with those already added to the builder.
+func (b *ProjectBuilder) Options(opts InstanceOptions) *ProjectBuilder {
+	maps.Copy(b.opts.Files, opts.Files)
+	maps.Copy(b.opts.Variables, opts.Variables)
+	b.opts.WatchRepo = opts.WatchRepo
+	b.opts.StageChanges = opts.StageChanges
+	b.opts.OLAPDriver = opts.OLAPDriver
+	b.opts.OLAPConfig = opts.OLAPConfig
+	b.opts.Environment = opts.Environment
+	return b
+}
+
+// Build creates a runtime and an instance for the project, and waits for the project to be reconciled.
+// It does not check for parse or reconcile errors; use RequireReconcileState for that.
+func (b *ProjectBuilder) Build(t TestingT) (*runtime.Runtime, string) {
+	for _, err := range b.errors {
+		require.NoError(t, err)
+	}
+	return NewInstanceWithOptions(t, b.opts)
+}
+
+// yaml adds a file with the YAML serialization of v.
+func (b *ProjectBuilder) yaml(path string, v any) *ProjectBuilder {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		b.errors = append(b.errors, fmt.Errorf("failed to serialize %q: %w", path, err))
+		return b
+	}
+	return b.File(path, string(data))
+}
@@ -0,0 +1,236 @@
+package testruntime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/druid"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/clickhouse"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// FixtureTable is the name of the table that NewInstanceWithFixture loads the shared fixture dataset into.
+const FixtureTable = "fixture"
+
+// fixtureRows is a small dataset that exercises common sources of dialect drift: NULLs, ties, fractional values and data spanning month and year boundaries.
+// Columns: __time, publisher, domain, bid_price, impressions.
+var fixtureRows = [][]any{
+	{"2023-12-31 23:00:00", "Google", "google.com", 1.5, 10},
+	{"2024-01-01 00:00:00", "Google", "google.com", 2.25, 20},
+	{"2024-01-01 12:30:00", "Yahoo", "yahoo.com", 0.75, 5},
+	{"2024-01-02 08:00:00", nil, "msn.com", 3.0, 7},
+	{"2024-01-15 10:00:00", "Facebook", "facebook.com", 1.0, 12},
+	{"2024-01-31 23:59:59", "Yahoo", "news.yahoo.com", 4.5, 1},
+	{"2024-02-01 00:00:00", "Google", "news.google.com", 2.0, 30},
+	{"2024-02-03 16:45:00", nil, "google.com", 0.5, 3},
+	{"2024-02-10 09:15:00", "Facebook", "instagram.com", 5.25, 8},
+	{"2024-02-29 18:00:00", "Microsoft", "msn.com", 1.75, 15},
+	{"2024-03-01 00:00:00", "Microsoft", "bing.com", 2.5, 9},
+	{"2024-03-05 06:00:00", "Yahoo", "yahoo.com", 3.25, 4},
+}
+
+// OLAPTarget is an OLAP engine that cross-dialect tests run against.
+type OLAPTarget struct {
+	Driver string
+	Config map[string]string
+}
+
+// OLAPTargets returns the OLAP engines that are available for cross-dialect tests. DuckDB is always returned first.
+// ClickHouse and Druid run in containers and are skipped in short mode.
+func OLAPTargets(t *testing.T) []OLAPTarget {
+	targets := []OLAPTarget{{Driver: "duckdb", Config: map[string]string{"dsn": ":memory:"}}}
+
+	if !testing.Short() {
+		targets = append(targets,
+			OLAPTarget{Driver: "clickhouse", Config: map[string]string{"dsn": startClickHouse(t)}},
+			OLAPTarget{Driver: "druid", Config: map[string]string{"dsn": startDruid(t)}},
+		)
+	}
+
+	return targets
+}
+
+// NewInstanceWithFixture creates an instance that uses the target as its OLAP connector, loads the shared fixture dataset into FixtureTable, and then adds the files.
+// The files would usually contain metrics views on top of FixtureTable.
+func NewInstanceWithFixture(t *testing.T, target OLAPTarget, files map[string]string) (*runtime.Runtime, string) {
+	rt, id := NewInstanceWithOptions(t, InstanceOptions{
+		Files:      map[string]string{"rill.yaml": ""},
+		OLAPDriver: target.Driver,
+		OLAPConfig: target.Config,
+	})
+
+	olap, release, err := rt.OLAP(context.Background(), id, "")
+	require.NoError(t, err)
+	defer release()
+
+	for _, stmt := range fixtureStatements(t, olap.Dialect()) {
+		err := olap.Exec(context.Background(), &drivers.Statement{Query: stmt, LongRunning: true})
+		require.NoError(t, err)
+	}
+
+	PutFiles(t, rt, id, files)
+	ReconcileParserAndWait(t, rt, id)
+	return rt, id
+}
+
+// fixtureStatements returns the statements that create and populate FixtureTable in the given dialect.
+func fixtureStatements(t *testing.T, dialect drivers.Dialect) []string {
+	switch dialect {
+	case drivers.DialectDuckDB:
+		return []string{
+			fmt.Sprintf(`CREATE OR REPLACE TABLE %s ("__time" TIMESTAMP, publisher VARCHAR, domain VARCHAR, bid_price DOUBLE, impressions BIGINT)`, FixtureTable),
+			fmt.Sprintf(`INSERT INTO %s VALUES %s`, FixtureTable, fixtureValues(dialect)),
+		}
+	case drivers.DialectClickHouse:
+		return []string{
+			fmt.Sprintf(`CREATE OR REPLACE TABLE %s ("__time" DateTime('UTC'), publisher Nullable(String), domain String, bid_price Float64, impressions Int64) ENGINE = MergeTree ORDER BY tuple()`, FixtureTable),
+			fmt.Sprintf(`INSERT INTO %s VALUES %s`, FixtureTable, fixtureValues(dialect)),
+		}
+	case drivers.DialectDruid:
+		// The fixture is ingested when the Druid container is started (see startDruid)
+		return nil
+	default:
+		require.FailNow(t, fmt.Sprintf("no fixture for dialect %q", dialect))
+		return nil
+	}
+}
+
+// fixtureValues formats fixtureRows as a VALUES list.
+func fixtureValues(dialect drivers.Dialect) string {
+	rows := make([]string, len(fixtureRows))
+	for i, row := range fixtureRows {
+		vals := make([]string, len(row))
+		for j, v := range row {
+			switch v := v.(type) {
+			case nil:
+				vals[j] = "NULL"
+			case string:
+				vals[j] = dialect.EscapeStringValue(v)
+			default:
+				vals[j] = fmt.Sprint(v)
+			}
+		}
+		rows[i] = fmt.Sprintf("(%s)", strings.Join(vals, ", "))
+	}
+	return strings.Join(rows, ", ")
+}
+
+// startClickHouse starts a ClickHouse container that is terminated when the test finishes and returns its DSN.
+func startClickHouse(t *testing.T) string {
+	ctx := context.Background()
+	container, err := clickhouse.RunContainer(ctx,
+		testcontainers.WithImage("clickhouse/clickhouse-server:latest"),
+		clickhouse.WithUsername("clickhouse"),
+		clickhouse.WithPassword("clickhouse"),
+		clickhouse.WithConfigFile(testdataPath("clickhouse-config.xml")),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := container.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	host, err := container.Host(ctx)
+	require.NoError(t, err)
+	port, err := container.MappedPort(ctx, "9000/tcp")
+	require.NoError(t, err)
+
+	return fmt.Sprintf("clickhouse://clickhouse:clickhouse@%v:%v", host, port.Port())
+}
+
+// startDruid starts a Druid container that is terminated when the test finishes, ingests the fixture dataset into FixtureTable, and returns the DSN of its SQL API.
+func startDruid(t *testing.T) string {
+	ctx := context.Background()
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			WaitingFor:   wait.ForHTTP("/status/health").WithPort("8081").WithStartupTimeout(2 * time.Minute),
+			Image:        "gcr.io/rilldata/druid-micro:25.0.0",
+			ExposedPorts: []string{"8081/tcp", "8082/tcp"},
+			Cmd:          []string{"./bin/start-micro-quickstart"},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := container.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	coordinatorURL, err := container.PortEndpoint(ctx, "8081/tcp", "http")
+	require.NoError(t, err)
+	err = druid.Ingest(coordinatorURL, druidFixtureSpec(t), FixtureTable, 5*time.Minute)
+	require.NoError(t, err)
+
+	brokerURL, err := container.PortEndpoint(ctx, "8082/tcp", "http")
+	require.NoError(t, err)
+	dsn, err := url.JoinPath(brokerURL, "/druid/v2/sql")
+	require.NoError(t, err)
+	return dsn
+}
+
+// druidFixtureSpec returns a native batch ingestion spec that loads fixtureRows into FixtureTable from inline CSV.
+func druidFixtureSpec(t *testing.T) string {
+	var csv strings.Builder
+	for _, row := range fixtureRows {
+		for i, v := range row {
+			if i > 0 {
+				csv.WriteString(",")
+			}
+			if v != nil {
+				fmt.Fprint(&csv, v)
+			}
+		}
+		csv.WriteString("\n")
+	}
+
+	spec, err := json.Marshal(map[string]any{
+		"type": "index_parallel",
+		"spec": map[string]any{
+			"ioConfig": map[string]any{
+				"type":        "index_parallel",
+				"inputSource": map[string]any{"type": "inline", "data": csv.String()},
+				"inputFormat": map[string]any{"type": "csv", "columns": []string{"ts", "publisher", "domain", "bid_price", "impressions"}},
+			},
+			"tuningConfig": map[string]any{
+				"type":           "index_parallel",
+				"partitionsSpec": map[string]any{"type": "dynamic"},
+			},
+			"dataSchema": map[string]any{
+				"dataSource":    FixtureTable,
+				"timestampSpec": map[string]any{"column": "ts", "format": "yyyy-MM-dd HH:mm:ss"},
+				"dimensionsSpec": map[string]any{
+					"dimensions": []any{
+						"publisher",
+						"domain",
+						map[string]any{"type": "double", "name": "bid_price"},
+						map[string]any{"type": "long", "name": "impressions"},
+					},
+				},
+				"granularitySpec": map[string]any{
+					"queryGranularity":   "none",
+					"rollup":             false,
+					"segmentGranularity": "day",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return string(spec)
+}
+
+// testdataPath returns the absolute path of a file in the testdata directory.
+func testdataPath(name string) string {
+	_, currentFile, _, _ := goruntime.Caller(0)
+	return filepath.Join(currentFile, "..", "testdata", name)
+}
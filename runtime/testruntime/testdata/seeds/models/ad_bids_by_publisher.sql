@@ -0,0 +1 @@
+SELECT publisher, count(*) AS bids FROM ad_bids GROUP BY publisher
@@ -30,6 +30,8 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/s3"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
+	_ "github.com/rilldata/rill/runtime/drivers/teams"
+	_ "github.com/rilldata/rill/runtime/drivers/webhook"
 	_ "github.com/rilldata/rill/runtime/reconcilers"
 )
 
@@ -82,6 +84,12 @@ type InstanceOptions struct {
 	Variables    map[string]string
 	WatchRepo    bool
 	StageChanges bool
+	// OLAPDriver and OLAPConfig configure the instance's OLAP connector.
+	// If not set, they default to RILL_RUNTIME_TEST_OLAP_DRIVER and RILL_RUNTIME_TEST_OLAP_DSN (or an in-memory DuckDB).
+	OLAPDriver string
+	OLAPConfig map[string]string
+	// Environment sets the instance's environment. Defaults to "test".
+	Environment string
 }
 
 // NewInstanceWithOptions creates a runtime and an instance for use in tests.
@@ -89,22 +97,32 @@ type InstanceOptions struct {
 func NewInstanceWithOptions(t TestingT, opts InstanceOptions) (*runtime.Runtime, string) {
 	rt := New(t)
 
-	olapDriver := os.Getenv("RILL_RUNTIME_TEST_OLAP_DRIVER")
+	olapDriver := opts.OLAPDriver
+	olapConfig := opts.OLAPConfig
 	if olapDriver == "" {
-		olapDriver = "duckdb"
-	}
-	olapDSN := os.Getenv("RILL_RUNTIME_TEST_OLAP_DSN")
-	if olapDSN == "" {
-		olapDSN = ":memory:"
+		olapDriver = os.Getenv("RILL_RUNTIME_TEST_OLAP_DRIVER")
+		if olapDriver == "" {
+			olapDriver = "duckdb"
+		}
+		olapDSN := os.Getenv("RILL_RUNTIME_TEST_OLAP_DSN")
+		if olapDSN == "" {
+			olapDSN = ":memory:"
+		}
+		olapConfig = map[string]string{"dsn": olapDSN}
 	}
 
 	vars := make(map[string]string)
 	maps.Copy(vars, opts.Variables)
 	vars["rill.stage_changes"] = strconv.FormatBool(opts.StageChanges)
 
+	env := opts.Environment
+	if env == "" {
+		env = "test"
+	}
+
 	tmpDir := t.TempDir()
 	inst := &drivers.Instance{
-		Environment:      "test",
+		Environment:      env,
 		OLAPConnector:    olapDriver,
 		RepoConnector:    "repo",
 		CatalogConnector: "catalog",
@@ -117,7 +135,7 @@ func NewInstanceWithOptions(t TestingT, opts InstanceOptions) (*runtime.Runtime,
 			{
 				Type:   olapDriver,
 				Name:   olapDriver,
-				Config: map[string]string{"dsn": olapDSN},
+				Config: olapConfig,
 			},
 			{
 				Type: "sqlite",
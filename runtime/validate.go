@@ -75,6 +75,22 @@ func (r *Runtime) ValidateMetricsView(ctx context.Context, instanceID string, mv
 		}
 		return nil, fmt.Errorf("could not find table %q: %w", mv.Table, err)
 	}
+	// Check the unions and joins are supported and valid
+	if len(mv.UnionTables) > 0 || len(mv.LookupJoins) > 0 {
+		if olap.Dialect() != drivers.DialectDruid {
+			res.OtherErrs = append(res.OtherErrs, fmt.Errorf("'union_tables' and 'lookup_joins' are only supported for Druid"))
+			return res, nil
+		}
+		err := olap.Exec(ctx, &drivers.Statement{
+			Query:  fmt.Sprintf("SELECT 1 FROM %s", olap.Dialect().EscapeMetricsViewTable(mv)),
+			DryRun: true,
+		})
+		if err != nil {
+			res.OtherErrs = append(res.OtherErrs, fmt.Errorf("failed to validate 'union_tables' and 'lookup_joins': %w", err))
+			return res, nil
+		}
+	}
+
 	cols := make(map[string]*runtimev1.StructType_Field, len(t.Schema.Fields))
 	for _, f := range t.Schema.Fields {
 		cols[strings.ToLower(f.Name)] = f
@@ -117,11 +133,28 @@ func (r *Runtime) ValidateMetricsView(ctx context.Context, instanceID string, mv
 		validateIndividualDimensionsAndMeasures(ctx, olap, t, mv, cols, res)
 	}
 
+	// Check lookup tables exist and contain the configured key and value columns
+	for idx, d := range mv.Dimensions {
+		if d.LookupTable == "" {
+			continue
+		}
+		err := validateDimensionLookup(ctx, olap, mv, d)
+		if err != nil {
+			res.DimensionErrs = append(res.DimensionErrs, IndexErr{Idx: idx, Err: err})
+		}
+	}
+	slices.SortFunc(res.DimensionErrs, func(a, b IndexErr) int { return a.Idx - b.Idx })
+
 	// Pinot does have any native support for time shift using time grain specifiers
 	if olap.Dialect() == drivers.DialectPinot && (mv.FirstDayOfWeek > 1 || mv.FirstMonthOfYear > 1) {
 		res.OtherErrs = append(res.OtherErrs, fmt.Errorf("time shift not supported for Pinot dialect, so FirstDayOfWeek and FirstMonthOfYear should be 1"))
 	}
 
+	// FINAL is a ClickHouse-specific table modifier
+	if mv.Final && olap.Dialect() != drivers.DialectClickHouse {
+		res.OtherErrs = append(res.OtherErrs, fmt.Errorf("'final' is only supported for ClickHouse"))
+	}
+
 	// Check the default theme exists
 	if mv.DefaultTheme != "" {
 		_, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: ResourceKindTheme, Name: mv.DefaultTheme}, false)
@@ -164,13 +197,13 @@ func validateAllDimensionsAndMeasures(ctx context.Context, olap drivers.OLAPStor
 	}
 	if len(dimExprs) == 0 {
 		// Only metrics
-		query = fmt.Sprintf("SELECT 1, %s FROM %s GROUP BY 1", strings.Join(metricExprs, ","), olap.Dialect().EscapeTable(t.Database, t.DatabaseSchema, t.Name))
+		query = fmt.Sprintf("SELECT 1, %s FROM %s GROUP BY 1", strings.Join(metricExprs, ","), olap.Dialect().EscapeMetricsViewTable(mv))
 	} else if len(metricExprs) == 0 {
 		// No metrics
 		query = fmt.Sprintf(
 			"SELECT %s FROM %s %s GROUP BY %s",
 			strings.Join(dimExprs, ","),
-			olap.Dialect().EscapeTable(t.Database, t.DatabaseSchema, t.Name),
+			olap.Dialect().EscapeMetricsViewTable(mv),
 			strings.Join(unnestClauses, ""),
 			strings.Join(groupIndexes, ","),
 		)
@@ -179,7 +212,7 @@ func validateAllDimensionsAndMeasures(ctx context.Context, olap drivers.OLAPStor
 			"SELECT %s, %s FROM %s %s GROUP BY %s",
 			strings.Join(dimExprs, ","),
 			strings.Join(metricExprs, ","),
-			olap.Dialect().EscapeTable(t.Database, t.DatabaseSchema, t.Name),
+			olap.Dialect().EscapeMetricsViewTable(mv),
 			strings.Join(unnestClauses, ""),
 			strings.Join(groupIndexes, ","),
 		)
@@ -207,7 +240,7 @@ func validateIndividualDimensionsAndMeasures(ctx context.Context, olap drivers.O
 		idx := idx
 		d := d
 		grp.Go(func() error {
-			err := validateDimension(ctx, olap, t, d, cols)
+			err := validateDimension(ctx, olap, t, mv, d, cols)
 			if err != nil {
 				mu.Lock()
 				defer mu.Unlock()
@@ -230,7 +263,7 @@ func validateIndividualDimensionsAndMeasures(ctx context.Context, olap drivers.O
 		idx := idx
 		m := m
 		grp.Go(func() error {
-			err := validateMeasure(ctx, olap, t, m)
+			err := validateMeasure(ctx, olap, mv, m)
 			if err != nil {
 				mu.Lock()
 				defer mu.Unlock()
@@ -253,7 +286,7 @@ func validateIndividualDimensionsAndMeasures(ctx context.Context, olap drivers.O
 }
 
 // validateDimension validates a metrics view dimension.
-func validateDimension(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, d *runtimev1.MetricsViewSpec_DimensionV2, fields map[string]*runtimev1.StructType_Field) error {
+func validateDimension(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, mv *runtimev1.MetricsViewSpec, d *runtimev1.MetricsViewSpec_DimensionV2, fields map[string]*runtimev1.StructType_Field) error {
 	// Validate with a simple check if it's a column
 	if d.Column != "" {
 		if _, isColumn := fields[strings.ToLower(d.Column)]; !isColumn {
@@ -270,7 +303,7 @@ func validateDimension(ctx context.Context, olap drivers.OLAPStore, t *drivers.T
 
 	// Validate with a query if it's an expression
 	err := olap.Exec(ctx, &drivers.Statement{
-		Query:  fmt.Sprintf("SELECT %s FROM %s %s GROUP BY 1", expr, dialect.EscapeTable(t.Database, t.DatabaseSchema, t.Name), unnestClause),
+		Query:  fmt.Sprintf("SELECT %s FROM %s %s GROUP BY 1", expr, dialect.EscapeMetricsViewTable(mv), unnestClause),
 		DryRun: true,
 	})
 	if err != nil {
@@ -279,10 +312,36 @@ func validateDimension(ctx context.Context, olap drivers.OLAPStore, t *drivers.T
 	return nil
 }
 
+// validateDimensionLookup validates the lookup table of a metrics view dimension.
+func validateDimensionLookup(ctx context.Context, olap drivers.OLAPStore, mv *runtimev1.MetricsViewSpec, d *runtimev1.MetricsViewSpec_DimensionV2) error {
+	t, err := olap.InformationSchema().Lookup(ctx, mv.Database, mv.DatabaseSchema, d.LookupTable)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return fmt.Errorf("lookup table %q for dimension %q does not exist", d.LookupTable, d.Name)
+		}
+		return fmt.Errorf("failed to validate lookup table %q for dimension %q: %w", d.LookupTable, d.Name, err)
+	}
+
+	for _, col := range []string{d.LookupKeyColumn, d.LookupValueColumn} {
+		found := false
+		for _, f := range t.Schema.Fields {
+			if strings.EqualFold(f.Name, col) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("failed to validate lookup for dimension %q: column %q not found in table %q", d.Name, col, d.LookupTable)
+		}
+	}
+
+	return nil
+}
+
 // validateMeasure validates a metrics view measure.
-func validateMeasure(ctx context.Context, olap drivers.OLAPStore, t *drivers.Table, m *runtimev1.MetricsViewSpec_MeasureV2) error {
+func validateMeasure(ctx context.Context, olap drivers.OLAPStore, mv *runtimev1.MetricsViewSpec, m *runtimev1.MetricsViewSpec_MeasureV2) error {
 	err := olap.Exec(ctx, &drivers.Statement{
-		Query:  fmt.Sprintf("SELECT 1, (%s) FROM %s GROUP BY 1", m.Expression, olap.Dialect().EscapeTable(t.Database, t.DatabaseSchema, t.Name)),
+		Query:  fmt.Sprintf("SELECT 1, (%s) FROM %s GROUP BY 1", m.Expression, olap.Dialect().EscapeMetricsViewTable(mv)),
 		DryRun: true,
 	})
 	return err
@@ -18,6 +18,7 @@ export type DownloadReportRequest = {
   format: V1ExportFormat;
   bakedQuery: string;
   limit?: string;
+  filenameTemplate?: string;
 };
 
 export function createDownloadReportMutation<
@@ -47,6 +48,7 @@ export function createDownloadReportMutation<
         format: data.format,
         bakedQuery: data.bakedQuery,
         limit: data.limit,
+        filenameTemplate: data.filenameTemplate,
       },
     });
     const downloadUrl = `${get(runtime).host}${exportResp.downloadUrlPath}`;
@@ -283,6 +283,13 @@ export type QueryServiceExportBody = {
   format?: V1ExportFormat;
   query?: V1Query;
   bakedQuery?: string;
+  /** Optional template for the filename of the export (without extension).
+Supports the placeholders {metrics_view}, {time_range}, {date} and {user}.
+If not set, the filename is derived from the queried resource and suffixed with a timestamp. */
+  filenameTemplate?: string;
+  /** Optional locale for formatting the export, as a BCP 47 language tag like "de" or "fr-FR".
+It controls the decimal separator (and delimiter) of CSV files and month/day names in time labels. */
+  locale?: string;
 };
 
 export type QueryServiceColumnDescriptiveStatisticsParams = {
@@ -805,6 +812,7 @@ export interface V1ReportSpec {
   queryArgsJson?: string;
   exportLimit?: string;
   exportFormat?: V1ExportFormat;
+  exportFilenameTemplate?: string;
   notifiers?: V1Notifier[];
   annotations?: V1ReportSpecAnnotations;
   /** If true, will use the lowest watermark of its refs instead of the trigger time. */